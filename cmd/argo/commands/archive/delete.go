@@ -2,19 +2,26 @@ package archive
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 
 	client "github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
 func NewDeleteCommand() *cobra.Command {
+	var dryRun bool
 	command := &cobra.Command{
 		Use:   "delete UID...",
 		Short: "delete a workflow in the archive",
 		Example: `# Delete an archived workflow by its UID:
   argo archive delete abc123-def456-ghi789-jkl012
+
+# Print the archived workflows which would be deleted, and their phases, without deleting them:
+  argo archive delete abc123-def456-ghi789-jkl012 --dry-run
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
@@ -25,6 +32,18 @@ func NewDeleteCommand() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if dryRun {
+				var workflows wfv1.Workflows
+				for _, uid := range args {
+					wf, err := serviceClient.GetArchivedWorkflow(ctx, &workflowarchivepkg.GetArchivedWorkflowRequest{Uid: uid})
+					if err != nil {
+						return err
+					}
+					workflows = append(workflows, *wf)
+				}
+				common.PrintDeleteDryRun(os.Stdout, workflows, true)
+				return nil
+			}
 			for _, uid := range args {
 				if _, err = serviceClient.DeleteArchivedWorkflow(ctx, &workflowarchivepkg.DeleteArchivedWorkflowRequest{Uid: uid}); err != nil {
 					return err
@@ -34,5 +53,6 @@ func NewDeleteCommand() *cobra.Command {
 			return nil
 		},
 	}
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Do not delete the archived workflows, only print which workflows would be deleted, their phases, and how many there are")
 	return command
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"os"
 
 	"github.com/spf13/cobra"
 	"sigs.k8s.io/yaml"
@@ -13,13 +14,14 @@ import (
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/humanize"
+	"github.com/argoproj/argo-workflows/v3/util/printer"
 )
 
 func NewGetCommand() *cobra.Command {
-	var output = common.EnumFlagValue{
+	var output = common.WithTemplateOutputs(common.EnumFlagValue{
 		AllowedValues: []string{"json", "yaml", "wide"},
 		Value:         "wide",
-	}
+	})
 	command := &cobra.Command{
 		Use:   "get UID",
 		Short: "get a workflow in the archive",
@@ -54,7 +56,12 @@ func NewGetCommand() *cobra.Command {
 }
 
 func printWorkflow(wf *wfv1.Workflow, output string) {
-
+	if printer.IsTemplateOutput(output) {
+		if err := printer.PrintTemplate(os.Stdout, output, wf); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
 	switch output {
 	case "json":
 		output, err := json.Marshal(wf)
@@ -19,7 +19,7 @@ import (
 func NewListCommand() *cobra.Command {
 	var (
 		selector  string
-		output    = common.NewPrintWorkflowOutputValue("wide")
+		output    = common.WithTemplateOutputs(common.NewPrintWorkflowOutputValue("wide"))
 		chunkSize int64
 	)
 	command := &cobra.Command{
@@ -34,6 +34,9 @@ func NewListCommand() *cobra.Command {
 # List all archived workflows in YAML format:
   argo archive list -o yaml
 
+# List the UIDs of all archived workflows, using a JSONPath template:
+  argo archive list -o jsonpath='{range .items[*]}{.metadata.uid}{"\n"}{end}'
+
 # List archived workflows that have both labels:
   argo archive list -l key1=value1,key2=value2
 `,
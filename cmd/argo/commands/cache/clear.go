@@ -0,0 +1,47 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
+)
+
+func NewClearCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "clear CACHE KEY...",
+		Short: "delete entries of a memoization cache, so that the next workflows to use their keys run their nodes again",
+		Example: `# Delete a poisoned entry of a memoization cache:
+  argo cache clear my-cache my-key
+
+# Delete every entry of a memoization cache:
+  argo cache clear my-cache $(argo cache list my-cache -o name)
+`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewMemoizationCacheServiceClient()
+			if err != nil {
+				return err
+			}
+			name := args[0]
+			for _, key := range args[1:] {
+				if _, err := serviceClient.DeleteCacheEntry(ctx, &memoizationcachepkg.DeleteCacheEntryRequest{
+					Namespace: client.Namespace(ctx),
+					Name:      name,
+					Key:       key,
+				}); err != nil {
+					return err
+				}
+				fmt.Printf("Cache entry '%s' of '%s' deleted\n", key, name)
+			}
+			return nil
+		},
+	}
+	return command
+}
@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
+	"github.com/argoproj/argo-workflows/v3/util/humanize"
+)
+
+func NewListCommand() *cobra.Command {
+	output := common.EnumFlagValue{AllowedValues: []string{"wide", "name", "json", "yaml"}}
+	command := &cobra.Command{
+		Use:   "list [CACHE]",
+		Short: "list the entries of memoization caches",
+		Example: `# List the entries of every memoization cache in the namespace:
+  argo cache list
+
+# List the entries of a memoization cache:
+  argo cache list my-cache
+
+# List the entries with their outputs, in YAML format:
+  argo cache list my-cache -o yaml
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewMemoizationCacheServiceClient()
+			if err != nil {
+				return err
+			}
+			req := &memoizationcachepkg.ListCacheEntriesRequest{Namespace: client.Namespace(ctx)}
+			if len(args) == 1 {
+				req.Name = args[0]
+			}
+			entries, err := serviceClient.ListCacheEntries(ctx, req)
+			if err != nil {
+				return err
+			}
+			switch output := output.String(); {
+			case output == "json":
+				data, err := json.MarshalIndent(entries.Items, "", "    ")
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(data))
+			case output == "yaml":
+				data, err := yaml.Marshal(entries.Items)
+				if err != nil {
+					return err
+				}
+				fmt.Print(string(data))
+			case output == "" || output == "wide":
+				printTable(os.Stdout, entries.Items, output == "wide", time.Now())
+			case output == "name":
+				for _, entry := range entries.Items {
+					fmt.Println(entry.Key)
+				}
+			default:
+				return fmt.Errorf("unknown output mode: %s", output)
+			}
+			return nil
+		},
+	}
+	command.Flags().VarP(&output, "output", "o", "Output format. "+output.Usage())
+	return command
+}
+
+func printTable(out io.Writer, entries []*memoizationcachepkg.CacheEntry, wide bool, now time.Time) {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprint(w, "CACHE\tKEY\tAGE\tLAST HIT")
+	if wide {
+		_, _ = fmt.Fprint(w, "\tNODE ID")
+	}
+	_, _ = fmt.Fprint(w, "\n")
+	for _, entry := range entries {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s", entry.CacheName, entry.Key, age(entry.CreationTimestamp.Time, now), age(entry.LastHitTimestamp.Time, now))
+		if wide {
+			_, _ = fmt.Fprintf(w, "\t%s", entry.NodeID)
+		}
+		_, _ = fmt.Fprint(w, "\n")
+	}
+	_ = w.Flush()
+}
+
+func age(t, now time.Time) string {
+	if t.IsZero() {
+		return "N/A"
+	}
+	return humanize.RelativeDurationShort(t, now)
+}
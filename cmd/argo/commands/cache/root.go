@@ -0,0 +1,19 @@
+package cache
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func NewCacheCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "cache",
+		Short: "manage memoization caches",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	command.AddCommand(NewListCommand())
+	command.AddCommand(NewClearCommand())
+	return command
+}
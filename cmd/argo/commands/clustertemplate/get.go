@@ -9,7 +9,7 @@ import (
 )
 
 func NewGetCommand() *cobra.Command {
-	var output = common.NewPrintWorkflowOutputValue("")
+	var output = common.NewPrintTemplateOutputValue("")
 
 	command := &cobra.Command{
 		Use:   "get CLUSTER WORKFLOW_TEMPLATE...",
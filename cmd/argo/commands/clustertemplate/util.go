@@ -8,6 +8,7 @@ import (
 
 	"sigs.k8s.io/yaml"
 
+	cmdcommon "github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/humanize"
 	argoJson "github.com/argoproj/argo-workflows/v3/util/json"
@@ -65,6 +66,12 @@ func printClusterWorkflowTemplate(wf *wfv1.ClusterWorkflowTemplate, outFmt strin
 	case "yaml":
 		outBytes, _ := yaml.Marshal(wf)
 		fmt.Print(string(outBytes))
+	case "mermaid", "dot":
+		out, err := cmdcommon.PrintTemplatesGraph(wf.Spec.Templates, outFmt)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Print(out)
 	case "wide", "":
 		printClusterWorkflowTemplateHelper(wf)
 	default:
@@ -0,0 +1,55 @@
+package common
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/humanize"
+)
+
+// PrintDeleteDryRun prints the workflows which would be deleted, their phases, and how many there are of each phase
+func PrintDeleteDryRun(out io.Writer, workflows wfv1.Workflows, uid bool) {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprint(w, "NAMESPACE\tNAME\tSTATUS\tAGE\tFINISHED")
+	if uid {
+		_, _ = fmt.Fprint(w, "\tUID")
+	}
+	_, _ = fmt.Fprint(w, "\n")
+	phases := map[wfv1.WorkflowPhase]int{}
+	for _, wf := range workflows {
+		phase := wf.Status.Phase
+		if phase == "" {
+			phase = wfv1.WorkflowPending
+		}
+		phases[phase]++
+		finished := "-"
+		if !wf.Status.FinishedAt.IsZero() {
+			finished = humanize.RelativeDurationShort(wf.Status.FinishedAt.Time, time.Now()) + " ago"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s", wf.Namespace, wf.Name, phase, humanize.RelativeDurationShort(wf.CreationTimestamp.Time, time.Now()), finished)
+		if uid {
+			_, _ = fmt.Fprintf(w, "\t%s", wf.UID)
+		}
+		_, _ = fmt.Fprint(w, "\n")
+	}
+	_ = w.Flush()
+	var counts []string
+	for _, phase := range slices.Sorted(maps.Keys(phases)) {
+		counts = append(counts, fmt.Sprintf("%d %s", phases[phase], phase))
+	}
+	noun := "workflows"
+	if len(workflows) == 1 {
+		noun = "workflow"
+	}
+	_, _ = fmt.Fprintf(out, "\n%d %s would be deleted (dry-run)", len(workflows), noun)
+	if len(counts) > 0 {
+		_, _ = fmt.Fprintf(out, ": %s", strings.Join(counts, ", "))
+	}
+	_, _ = fmt.Fprintln(out)
+}
@@ -0,0 +1,44 @@
+package common
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestPrintDeleteDryRun(t *testing.T) {
+	created := metav1.NewTime(time.Now().Add(-3 * time.Hour))
+	finished := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	workflows := wfv1.Workflows{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "argo", Name: "wf-0", UID: "uid-0", CreationTimestamp: created}, Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowSucceeded, FinishedAt: finished}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "argo", Name: "wf-1", UID: "uid-1", CreationTimestamp: created}, Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowFailed, FinishedAt: finished}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "argo", Name: "wf-2", UID: "uid-2", CreationTimestamp: created}, Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowSucceeded, FinishedAt: finished}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "argo", Name: "wf-3", UID: "uid-3", CreationTimestamp: created}},
+	}
+	t.Run("Workflows", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		PrintDeleteDryRun(out, workflows, false)
+		assert.Equal(t, `NAMESPACE   NAME   STATUS      AGE   FINISHED
+argo        wf-0   Succeeded   3h    2h ago
+argo        wf-1   Failed      3h    2h ago
+argo        wf-2   Succeeded   3h    2h ago
+argo        wf-3   Pending     3h    -
+
+4 workflows would be deleted (dry-run): 1 Failed, 1 Pending, 2 Succeeded
+`, out.String())
+	})
+	t.Run("ArchivedWorkflow", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		PrintDeleteDryRun(out, workflows[:1], true)
+		assert.Equal(t, `NAMESPACE   NAME   STATUS      AGE   FINISHED   UID
+argo        wf-0   Succeeded   3h    2h ago     uid-0
+
+1 workflow would be deleted (dry-run): 1 Succeeded
+`, out.String())
+	})
+}
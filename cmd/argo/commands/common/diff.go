@@ -0,0 +1,187 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// SpecDifference is a field which differs between two workflow specs. Old is nil if the field was added, and New is
+// nil if it was removed.
+type SpecDifference struct {
+	Path string
+	Old  interface{}
+	New  interface{}
+}
+
+// DiffWorkflowSpecs returns the fields which differ between the specs, in order. Empty fields, such as those which
+// are defaulted to their zero value, are the same as unset fields, and the items of lists of named objects, such as
+// templates, parameters and DAG tasks, are matched by name rather than position.
+func DiffWorkflowSpecs(a, b *wfv1.WorkflowSpec) ([]SpecDifference, error) {
+	oldValue, err := toPrunedValue(a)
+	if err != nil {
+		return nil, err
+	}
+	newValue, err := toPrunedValue(b)
+	if err != nil {
+		return nil, err
+	}
+	var diffs []SpecDifference
+	diffValues("", oldValue, newValue, &diffs)
+	return diffs, nil
+}
+
+// PrintSpecDifferences prints the differences, one per line, prefixed with + if the field was added, - if it was
+// removed, and ~ if it was changed
+func PrintSpecDifferences(w io.Writer, diffs []SpecDifference) {
+	for _, d := range diffs {
+		switch {
+		case d.Old == nil:
+			_, _ = fmt.Fprintln(w, ansiFormat(fmt.Sprintf("+ %s: %s", d.Path, diffValueString(d.New)), FgGreen))
+		case d.New == nil:
+			_, _ = fmt.Fprintln(w, ansiFormat(fmt.Sprintf("- %s: %s", d.Path, diffValueString(d.Old)), FgRed))
+		default:
+			_, _ = fmt.Fprintln(w, ansiFormat(fmt.Sprintf("~ %s: %s -> %s", d.Path, diffValueString(d.Old), diffValueString(d.New)), FgYellow))
+		}
+	}
+}
+
+func diffValueString(v interface{}) string {
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+// toPrunedValue returns the value as JSON values, without empty fields
+func toPrunedValue(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return prune(value), nil
+}
+
+func prune(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, value := range v {
+			if value = prune(value); value == nil {
+				delete(v, k)
+			} else {
+				v[k] = value
+			}
+		}
+		if len(v) == 0 {
+			return nil
+		}
+	case []interface{}:
+		for i, value := range v {
+			// keep the positions of the items
+			if value = prune(value); value == nil {
+				value = map[string]interface{}{}
+			}
+			v[i] = value
+		}
+		if len(v) == 0 {
+			return nil
+		}
+	case string:
+		if v == "" {
+			return nil
+		}
+	}
+	return v
+}
+
+func diffValues(path string, oldValue, newValue interface{}, diffs *[]SpecDifference) {
+	oldMap, oldIsMap := oldValue.(map[string]interface{})
+	newMap, newIsMap := newValue.(map[string]interface{})
+	if oldIsMap && newIsMap {
+		var keys []string
+		for k := range oldMap {
+			keys = append(keys, k)
+		}
+		for k := range newMap {
+			if _, ok := oldMap[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			diffValues(joinPath(path, k), oldMap[k], newMap[k], diffs)
+		}
+		return
+	}
+	oldList, oldIsList := oldValue.([]interface{})
+	newList, newIsList := newValue.([]interface{})
+	if oldIsList && newIsList {
+		oldNames, oldNamed := itemNames(oldList)
+		newNames, newNamed := itemNames(newList)
+		if !oldNamed || !newNamed {
+			for i := range max(len(oldList), len(newList)) {
+				var o, n interface{}
+				if i < len(oldList) {
+					o = oldList[i]
+				}
+				if i < len(newList) {
+					n = newList[i]
+				}
+				diffValues(path+"["+strconv.Itoa(i)+"]", o, n, diffs)
+			}
+			return
+		}
+		names := slices.Clone(oldNames)
+		for _, name := range newNames {
+			if !slices.Contains(oldNames, name) {
+				names = append(names, name)
+			}
+		}
+		for _, name := range names {
+			var o, n interface{}
+			if i := slices.Index(oldNames, name); i >= 0 {
+				o = oldList[i]
+			}
+			if i := slices.Index(newNames, name); i >= 0 {
+				n = newList[i]
+			}
+			diffValues(path+"["+name+"]", o, n, diffs)
+		}
+		return
+	}
+	if !reflect.DeepEqual(oldValue, newValue) {
+		*diffs = append(*diffs, SpecDifference{Path: path, Old: oldValue, New: newValue})
+	}
+}
+
+// itemNames returns the names of the items, and whether every item is an object with a unique name
+func itemNames(items []interface{}) ([]string, bool) {
+	names := make([]string, len(items))
+	for i, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		name, ok := m["name"].(string)
+		if !ok || slices.Contains(names[:i], name) {
+			return nil, false
+		}
+		names[i] = name
+	}
+	return names, true
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,66 @@
+package common
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestDiffWorkflowSpecs(t *testing.T) {
+	a := wfv1.MustUnmarshalWorkflow(`
+spec:
+  entrypoint: main
+  serviceAccountName: ""
+  arguments:
+    parameters: [{name: a, value: "1"}, {name: b, value: "2"}]
+  templates:
+    - name: main
+      container: {image: "alpine:3.18", args: [x, z]}
+    - name: gone
+      suspend: {}
+`)
+	b := wfv1.MustUnmarshalWorkflow(`
+spec:
+  entrypoint: main
+  arguments:
+    parameters: [{name: b, value: "2"}, {name: a, value: "3"}]
+  templates:
+    - name: new
+      suspend: {}
+    - name: main
+      container: {image: "alpine:3.19", args: [x]}
+`)
+	t.Run("Same", func(t *testing.T) {
+		diffs, err := DiffWorkflowSpecs(&a.Spec, &a.Spec)
+		require.NoError(t, err)
+		assert.Empty(t, diffs)
+	})
+	t.Run("Different", func(t *testing.T) {
+		diffs, err := DiffWorkflowSpecs(&a.Spec, &b.Spec)
+		require.NoError(t, err)
+		assert.Equal(t, []SpecDifference{
+			{Path: "arguments.parameters[a].value", Old: "1", New: "3"},
+			{Path: "templates[main].container.args[1]", Old: "z"},
+			{Path: "templates[main].container.image", Old: "alpine:3.18", New: "alpine:3.19"},
+			{Path: "templates[gone]", Old: map[string]interface{}{"name": "gone"}},
+			{Path: "templates[new]", New: map[string]interface{}{"name": "new"}},
+		}, diffs)
+	})
+	t.Run("Print", func(t *testing.T) {
+		NoColor = true
+		w := &bytes.Buffer{}
+		PrintSpecDifferences(w, []SpecDifference{
+			{Path: "entrypoint", Old: "main", New: "other"},
+			{Path: "parallelism", New: float64(2)},
+			{Path: "suspend", Old: true},
+		})
+		assert.Equal(t, `~ entrypoint: "main" -> "other"
++ parallelism: 2
+- suspend: true
+`, w.String())
+	})
+}
@@ -7,18 +7,26 @@ import (
 	"strings"
 
 	"github.com/spf13/pflag"
+
+	"github.com/argoproj/argo-workflows/v3/util/printer"
 )
 
 // EnumFlagValue represents a CLI flag that can take one of a fixed set of values, and validates
 // that the provided value is one of the allowed values.
 // There's several libraries for this (e.g. https://github.com/thediveo/enumflag), but they're overkill.
+// Values starting with one of the AllowedPrefixes are also allowed, e.g. `jsonpath=` followed by a template.
 type EnumFlagValue struct {
-	AllowedValues []string
-	Value         string
+	AllowedValues   []string
+	AllowedPrefixes []string
+	Value           string
 }
 
 func (e *EnumFlagValue) Usage() string {
-	return fmt.Sprintf("One of: %s", strings.Join(e.AllowedValues, "|"))
+	values := slices.Clone(e.AllowedValues)
+	for _, prefix := range e.AllowedPrefixes {
+		values = append(values, prefix+"...")
+	}
+	return fmt.Sprintf("One of: %s", strings.Join(values, "|"))
 }
 
 func (e *EnumFlagValue) String() string {
@@ -26,7 +34,7 @@ func (e *EnumFlagValue) String() string {
 }
 
 func (e *EnumFlagValue) Set(v string) error {
-	if slices.Contains(e.AllowedValues, v) {
+	if slices.Contains(e.AllowedValues, v) || slices.ContainsFunc(e.AllowedPrefixes, func(prefix string) bool { return strings.HasPrefix(v, prefix) }) {
 		e.Value = v
 		return nil
 	} else {
@@ -46,3 +54,16 @@ func NewPrintWorkflowOutputValue(value string) EnumFlagValue {
 		Value:         value,
 	}
 }
+
+// WithTemplateOutputs also allows the output to be printed with a JSONPath or Go template, e.g. `-o jsonpath=...`
+func WithTemplateOutputs(output EnumFlagValue) EnumFlagValue {
+	output.AllowedPrefixes = append(output.AllowedPrefixes, printer.TemplateOutputPrefixes...)
+	return output
+}
+
+// NewPrintTemplateOutputValue is the output of a template, which can also be printed as a graph
+func NewPrintTemplateOutputValue(value string) EnumFlagValue {
+	output := NewPrintWorkflowOutputValue(value)
+	output.AllowedValues = append(output.AllowedValues, GraphOutputs...)
+	return output
+}
@@ -33,3 +33,13 @@ func TestEnumFlagValue(t *testing.T) {
 		require.Error(t, err, "One of: name|json|yaml|wide")
 	})
 }
+
+func TestWithTemplateOutputs(t *testing.T) {
+	e := WithTemplateOutputs(NewPrintWorkflowOutputValue(""))
+	assert.Equal(t, "One of: name|json|yaml|wide|jsonpath=...|go-template=...", e.Usage())
+	require.NoError(t, e.Set("jsonpath={.metadata.name}"))
+	assert.Equal(t, "jsonpath={.metadata.name}", e.String())
+	require.NoError(t, e.Set("go-template={{.metadata.name}}"))
+	assert.Equal(t, "go-template={{.metadata.name}}", e.String())
+	require.Error(t, e.Set("jsonpath"))
+}
@@ -0,0 +1,296 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"sigs.k8s.io/yaml"
+	yamlv3 "sigs.k8s.io/yaml/goyaml.v3"
+
+	wf "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfcommon "github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+var formatDocumentSeparator = regexp.MustCompile(`(?m)^---[ \t]*$\n?`)
+
+// formatKinds are the kinds of manifests that are formatted, other manifests are left as they are
+var formatKinds = []string{wf.WorkflowKind, wf.WorkflowTemplateKind, wf.ClusterWorkflowTemplateKind, wf.CronWorkflowKind}
+
+// formatTopLevelKeys are the keys of a manifest in the order they are formatted, before any other keys
+var formatTopLevelKeys = []string{"apiVersion", "kind", "metadata", "spec", "status"}
+
+// FormatManifests formats the workflows, workflow templates, cluster workflow templates and cron workflows of the YAML
+// manifests canonically. Each is parsed into the typed API, so that fields are spelt and typed as the API does, and
+// fields that are empty, such as those defaulted to their zero value, are dropped unless they were set explicitly.
+// Keys are ordered with apiVersion, kind, metadata, spec and status first at the top level, and name first elsewhere,
+// followed by the other keys alphabetically. Comments are kept on the fields they were on. Other manifests are not
+// changed.
+func FormatManifests(ctx context.Context, body []byte) ([]byte, error) {
+	var docs []string
+	for _, text := range formatDocumentSeparator.Split(string(body), -1) {
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		formatted, err := formatManifest(ctx, text)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, formatted)
+	}
+	return []byte(strings.Join(docs, "---\n")), nil
+}
+
+func formatManifest(ctx context.Context, text string) (string, error) {
+	var meta struct {
+		Kind string `json:"kind"`
+	}
+	if err := yaml.Unmarshal([]byte(text), &meta); err != nil || !slices.Contains(formatKinds, meta.Kind) {
+		return strings.TrimRight(text, "\n") + "\n", nil
+	}
+	results := wfcommon.ParseObjects(ctx, []byte(text), true)
+	if len(results) != 1 {
+		return "", fmt.Errorf("failed to parse %s", meta.Kind)
+	}
+	if results[0].Err != nil {
+		return "", fmt.Errorf("failed to parse %s %q: %w", meta.Kind, results[0].Unstructured.GetName(), results[0].Err)
+	}
+	value, err := toJSONValue(results[0].Object)
+	if err != nil {
+		return "", err
+	}
+	data, err := yaml.YAMLToJSON([]byte(text))
+	if err != nil {
+		return "", err
+	}
+	var source interface{}
+	if err := decodeJSON(data, &source); err != nil {
+		return "", err
+	}
+	value = pruneUnset(value, source)
+
+	var sourceDoc yamlv3.Node
+	if err := yamlv3.Unmarshal([]byte(text), &sourceDoc); err != nil {
+		return "", err
+	}
+	var sourceNode *yamlv3.Node
+	if len(sourceDoc.Content) > 0 {
+		sourceNode = sourceDoc.Content[0]
+	}
+	doc := &yamlv3.Node{
+		Kind:        yamlv3.DocumentNode,
+		HeadComment: sourceDoc.HeadComment,
+		FootComment: sourceDoc.FootComment,
+		Content:     []*yamlv3.Node{toFormattedNode(value, sourceNode, true)},
+	}
+	var buf bytes.Buffer
+	encoder := yamlv3.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc); err != nil {
+		return "", err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+	return unescapeSupplementary(buf.String()), nil
+}
+
+// formatSupplementaryEscape matches the escapes of characters beyond the basic multilingual plane, such as emoji,
+// which the encoder escapes in double-quoted strings, unless the backslash is itself escaped
+var formatSupplementaryEscape = regexp.MustCompile(`((?:^|[^\\])(?:\\\\)*)\\U([0-9A-F]{8})`)
+
+// unescapeSupplementary writes the characters escaped by the encoder as they are, as the author wrote them
+func unescapeSupplementary(text string) string {
+	return formatSupplementaryEscape.ReplaceAllStringFunc(text, func(match string) string {
+		groups := formatSupplementaryEscape.FindStringSubmatch(match)
+		r, err := strconv.ParseUint(groups[2], 16, 32)
+		if err != nil || !utf8.ValidRune(rune(r)) {
+			return match
+		}
+		return groups[1] + string(rune(r))
+	})
+}
+
+func toJSONValue(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	return value, decodeJSON(data, &value)
+}
+
+// decodeJSON decodes numbers as json.Number, so that integers are formatted as they were
+func decodeJSON(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+// pruneUnset removes the empty fields of the value which are not set in the source, such as those the typed API
+// defaults to their zero value, keeping those that are set explicitly, e.g. `emptyDir: {}`
+func pruneUnset(v, source interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		sourceMap, _ := source.(map[string]interface{})
+		for k, value := range v {
+			sourceValue, set := sourceMap[k]
+			value = pruneUnset(value, sourceValue)
+			if isEmpty(value) && !set {
+				delete(v, k)
+			} else {
+				v[k] = value
+			}
+		}
+	case []interface{}:
+		sourceList, _ := source.([]interface{})
+		for i, value := range v {
+			var sourceValue interface{}
+			if i < len(sourceList) {
+				sourceValue = sourceList[i]
+			}
+			v[i] = pruneUnset(value, sourceValue)
+		}
+	}
+	return v
+}
+
+// isEmpty returns whether the value is the zero value of its type
+func isEmpty(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case json.Number:
+		return v == "0"
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// formatKeyOrder returns the order of the keys of a mapping
+func formatKeyOrder(m map[string]interface{}, topLevel bool) []string {
+	first := []string{"name"}
+	if topLevel {
+		first = formatTopLevelKeys
+	}
+	var keys []string
+	for _, k := range first {
+		if _, ok := m[k]; ok {
+			keys = append(keys, k)
+		}
+	}
+	var rest []string
+	for k := range m {
+		if !slices.Contains(first, k) {
+			rest = append(rest, k)
+		}
+	}
+	slices.Sort(rest)
+	return append(keys, rest...)
+}
+
+// toFormattedNode returns the YAML node of the value, with the comments of the source node at the same path
+func toFormattedNode(v interface{}, source *yamlv3.Node, topLevel bool) *yamlv3.Node {
+	var node *yamlv3.Node
+	switch v := v.(type) {
+	case map[string]interface{}:
+		node = &yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map"}
+		// the comment before a mapping, e.g. at the top of a file, is on its first key, so stays before it
+		var firstKey *yamlv3.Node
+		var headComment string
+		if source != nil && source.Kind == yamlv3.MappingNode && len(source.Content) > 0 {
+			firstKey = source.Content[0]
+			headComment = firstKey.HeadComment
+		}
+		for i, k := range formatKeyOrder(v, topLevel) {
+			sourceKey, sourceValue := sourceMappingValue(source, k)
+			key := &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: k}
+			copyComments(key, sourceKey)
+			if sourceKey != nil && sourceKey == firstKey {
+				key.HeadComment = ""
+			}
+			if i == 0 {
+				key.HeadComment = strings.TrimSpace(headComment + "\n" + key.HeadComment)
+			}
+			node.Content = append(node.Content, key, toFormattedNode(v[k], sourceValue, false))
+		}
+	case []interface{}:
+		node = &yamlv3.Node{Kind: yamlv3.SequenceNode, Tag: "!!seq"}
+		for i, item := range v {
+			var sourceItem *yamlv3.Node
+			if source != nil && source.Kind == yamlv3.SequenceNode && i < len(source.Content) {
+				sourceItem = source.Content[i]
+			}
+			node.Content = append(node.Content, toFormattedNode(item, sourceItem, false))
+		}
+	case string:
+		node = &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: v}
+		if strings.Contains(v, "\n") {
+			node.Style = yamlv3.LiteralStyle
+		} else if !isYAMLString(v) {
+			node.Style = yamlv3.DoubleQuotedStyle
+		}
+	case json.Number:
+		tag := "!!int"
+		if strings.ContainsAny(string(v), ".eE") {
+			tag = "!!float"
+		}
+		node = &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: tag, Value: string(v)}
+	case bool:
+		node = &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!bool", Value: fmt.Sprint(v)}
+	default:
+		node = &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!null", Value: "null"}
+	}
+	copyComments(node, source)
+	return node
+}
+
+// isYAMLString returns whether the plain scalar is read as a string. Manifests are parsed as YAML 1.1, where e.g.
+// `off` is a boolean, which the encoder does not quote
+func isYAMLString(v string) bool {
+	var value interface{}
+	if err := yaml.Unmarshal([]byte(v), &value); err != nil {
+		// not a plain scalar, so the encoder quotes it anyway
+		return true
+	}
+	switch value.(type) {
+	case nil, bool, float64, int64:
+		return false
+	}
+	return true
+}
+
+// sourceMappingValue returns the key and value nodes of the key of the source mapping node, if it has it
+func sourceMappingValue(source *yamlv3.Node, key string) (*yamlv3.Node, *yamlv3.Node) {
+	if source == nil || source.Kind != yamlv3.MappingNode {
+		return nil, nil
+	}
+	for i := 0; i+1 < len(source.Content); i += 2 {
+		if source.Content[i].Value == key {
+			return source.Content[i], source.Content[i+1]
+		}
+	}
+	return nil, nil
+}
+
+func copyComments(node, source *yamlv3.Node) {
+	if source == nil {
+		return
+	}
+	node.HeadComment = source.HeadComment
+	node.LineComment = source.LineComment
+	node.FootComment = source.FootComment
+}
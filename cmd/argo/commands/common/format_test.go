@@ -0,0 +1,111 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func TestFormatManifests(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("Workflow", func(t *testing.T) {
+		formatted, err := FormatManifests(ctx, []byte(`# my workflow
+kind: Workflow
+metadata:
+  generateName: hello-
+apiVersion: argoproj.io/v1alpha1
+spec:
+  templates:
+  - container:
+      image: busybox
+      command: [echo]
+      args: ["🕓 hello: {{workflow.name}}"]
+      env: [{name: DEBUG, value: "off"}]
+    name: main # the entrypoint
+    volumes:
+      - name: work
+        emptyDir: {}
+  entrypoint: main
+  serviceAccountName: ""
+`))
+		require.NoError(t, err)
+		assert.Equal(t, `# my workflow
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: hello-
+spec:
+  entrypoint: main
+  templates:
+    - name: main # the entrypoint
+      container:
+        args:
+          - "🕓 hello: {{workflow.name}}"
+        command:
+          - echo
+        env:
+          - name: DEBUG
+            value: "off"
+        image: busybox
+      volumes:
+        - name: work
+          emptyDir: {}
+`, string(formatted))
+		again, err := FormatManifests(ctx, formatted)
+		require.NoError(t, err)
+		assert.Equal(t, string(formatted), string(again))
+	})
+	t.Run("MultiLine", func(t *testing.T) {
+		formatted, err := FormatManifests(ctx, []byte(`apiVersion: argoproj.io/v1alpha1
+kind: WorkflowTemplate
+metadata: {name: my-tmpl}
+spec:
+  templates:
+    - name: main
+      script: {image: python, source: "print(1)\nprint(2)\n"}
+`))
+		require.NoError(t, err)
+		assert.Contains(t, string(formatted), `
+        source: |
+          print(1)
+          print(2)
+`)
+	})
+	t.Run("OtherKinds", func(t *testing.T) {
+		formatted, err := FormatManifests(ctx, []byte(`kind: ConfigMap
+apiVersion: v1
+metadata: {name: my-cm}
+---
+kind: CronWorkflow
+apiVersion: argoproj.io/v1alpha1
+metadata: {name: my-cron}
+spec: {schedules: ["* * * * *"], workflowSpec: {entrypoint: main}}
+`))
+		require.NoError(t, err)
+		assert.Equal(t, `kind: ConfigMap
+apiVersion: v1
+metadata: {name: my-cm}
+---
+apiVersion: argoproj.io/v1alpha1
+kind: CronWorkflow
+metadata:
+  name: my-cron
+spec:
+  schedules:
+    - '* * * * *'
+  workflowSpec:
+    entrypoint: main
+`, string(formatted))
+	})
+	t.Run("UnknownField", func(t *testing.T) {
+		_, err := FormatManifests(ctx, []byte(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata: {name: my-wf}
+spec: {entrypoint: main, entrypont: main}
+`))
+		require.ErrorContains(t, err, `failed to parse Workflow "my-wf"`)
+	})
+}
@@ -4,16 +4,19 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"maps"
+	"slices"
 	"strings"
 	"text/tabwriter"
 
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/fields"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	argoutil "github.com/argoproj/argo-workflows/v3/util"
 	"github.com/argoproj/argo-workflows/v3/util/humanize"
 	"github.com/argoproj/argo-workflows/v3/util/printer"
+	"github.com/argoproj/argo-workflows/v3/workflow/sensitive"
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
 )
 
@@ -22,11 +25,25 @@ const onExitSuffix = "onExit"
 type GetFlags struct {
 	Output                  EnumFlagValue
 	NodeFieldSelectorString string
+	// Node is the name, display name or ID of the node to display the subtree of, rather than the whole workflow
+	Node string
+	// Collapse displays the subtrees of succeeded steps and DAGs as a single node
+	Collapse bool
+	// Recommendations displays the recommended requests and limits of each template
+	Recommendations bool
 
 	// Only used for backwards compatibility
 	Status string
 }
 
+// parameterValue returns the value of the parameter to print, which is masked if the parameter is sensitive
+func parameterValue(param wfv1.Parameter, value string) string {
+	if param.Sensitive || sensitive.IsEncrypted(value) {
+		return sensitive.Mask
+	}
+	return value
+}
+
 func statusToNodeFieldSelector(status string) string {
 	return fmt.Sprintf("phase=%s", status)
 }
@@ -40,11 +57,11 @@ func (g GetFlags) shouldPrint(node wfv1.NodeStatus) bool {
 		g.NodeFieldSelectorString = statusToNodeFieldSelector(g.Status)
 	}
 	if g.NodeFieldSelectorString != "" {
-		selector, err := fields.ParseSelector(g.NodeFieldSelectorString)
+		selector, err := util.ParseNodeFieldSelector(g.NodeFieldSelectorString)
 		if err != nil {
 			log.Fatalf("selector is invalid: %s", err)
 		}
-		return util.SelectorMatchesNode(selector, node)
+		return selector.Matches(node)
 	}
 	return true
 }
@@ -99,7 +116,7 @@ func PrintWorkflowHelper(wf *wfv1.Workflow, getArgs GetFlags) string {
 			if param.Value == nil {
 				continue
 			}
-			out += fmt.Sprintf(fmtStr, "  "+param.Name+":", *param.Value)
+			out += fmt.Sprintf(fmtStr, "  "+param.Name+":", parameterValue(param, param.Value.String()))
 		}
 	}
 	if wf.Status.Outputs != nil {
@@ -107,7 +124,7 @@ func PrintWorkflowHelper(wf *wfv1.Workflow, getArgs GetFlags) string {
 			out += fmt.Sprintf(fmtStr, "Output Parameters:", "")
 			for _, param := range wf.Status.Outputs.Parameters {
 				if param.HasValue() {
-					out += fmt.Sprintf(fmtStr, "  "+param.Name+":", param.GetValue())
+					out += fmt.Sprintf(fmtStr, "  "+param.Name+":", parameterValue(param, param.GetValue()))
 				}
 			}
 		}
@@ -163,12 +180,23 @@ func PrintWorkflowHelper(wf *wfv1.Workflow, getArgs GetFlags) string {
 		if mainRoot == nil {
 			panic("failed to get the entrypoint node")
 		}
-		mainRoot.renderNodes(w, wf, 0, " ", " ", getArgs)
-
 		onExitID := wf.NodeID(wf.Name + "." + onExitSuffix)
-		if onExitRoot, ok := roots[onExitID]; ok {
-			_, _ = fmt.Fprintf(w, "\t\t\t\t\t\n")
-			onExitRoot.renderNodes(w, wf, 0, " ", " ", getArgs)
+		if getArgs.Node != "" {
+			// print the subtree of the node, which may not exist yet
+			node := findRenderNode(wf, mainRoot, getArgs.Node)
+			if onExitRoot, ok := roots[onExitID]; ok && node == nil {
+				node = findRenderNode(wf, onExitRoot, getArgs.Node)
+			}
+			if node != nil {
+				node.renderNodes(w, wf, 0, " ", " ", getArgs)
+			}
+		} else {
+			mainRoot.renderNodes(w, wf, 0, " ", " ", getArgs)
+
+			if onExitRoot, ok := roots[onExitID]; ok {
+				_, _ = fmt.Fprintf(w, "\t\t\t\t\t\n")
+				onExitRoot.renderNodes(w, wf, 0, " ", " ", getArgs)
+			}
 		}
 		_ = w.Flush()
 		if getArgs.Output.String() == "short" {
@@ -177,11 +205,37 @@ func PrintWorkflowHelper(wf *wfv1.Workflow, getArgs GetFlags) string {
 			out += writerBuffer.String()
 		}
 	}
+	if getArgs.Recommendations {
+		out += printResourceRecommendations(wf)
+	}
 	writerBuffer := new(bytes.Buffer)
 	out += writerBuffer.String()
 	return out
 }
 
+// printResourceRecommendations returns a table of the recommended requests and limits of each template
+func printResourceRecommendations(wf *wfv1.Workflow) string {
+	if len(wf.Status.ResourceRecommendations) == 0 {
+		return "\nNo resource recommendations, they are made when the workflow completes, if the usage of its pods was captured\n"
+	}
+	quantity := func(resources apiv1.ResourceList, name apiv1.ResourceName) string {
+		if q, ok := resources[name]; ok {
+			return q.String()
+		}
+		return "-"
+	}
+	writerBuffer := new(bytes.Buffer)
+	w := tabwriter.NewWriter(writerBuffer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "TEMPLATE\tSAMPLES\tCPU REQUEST\tMEMORY REQUEST\tMEMORY LIMIT\n")
+	for _, key := range slices.Sorted(maps.Keys(wf.Status.ResourceRecommendations)) {
+		r := wf.Status.ResourceRecommendations[key]
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", key, r.Samples,
+			quantity(r.Requests, apiv1.ResourceCPU), quantity(r.Requests, apiv1.ResourceMemory), quantity(r.Limits, apiv1.ResourceMemory))
+	}
+	_ = w.Flush()
+	return "\n" + writerBuffer.String()
+}
+
 type nodeInfoInterface interface {
 	getID() string
 	getNodeStatus(wf *wfv1.Workflow) wfv1.NodeStatus
@@ -208,6 +262,8 @@ func (n *nodeInfo) getStartTime(wf *wfv1.Workflow) metav1.Time {
 type renderNode interface {
 	// Render this renderNode and its children
 	renderNodes(w *tabwriter.Writer, wf *wfv1.Workflow, depth int, nodePrefix string, childPrefix string, getArgs GetFlags)
+	// The render children of this renderNode
+	renderChildren() []renderNode
 	nodeInfoInterface
 }
 
@@ -228,6 +284,54 @@ type boundaryNode struct {
 	boundaryContained []renderNode // Can be nonBoundaryParent or executionNode or boundaryNode
 }
 
+func (nodeInfo *executionNode) renderChildren() []renderNode {
+	return nil
+}
+
+func (nodeInfo *nonBoundaryParentNode) renderChildren() []renderNode {
+	return nodeInfo.children
+}
+
+func (nodeInfo *boundaryNode) renderChildren() []renderNode {
+	return nodeInfo.boundaryContained
+}
+
+// findRenderNode returns the renderNode in the tree whose node has the name, display name or ID, if any
+func findRenderNode(wf *wfv1.Workflow, n renderNode, name string) renderNode {
+	node := n.getNodeStatus(wf)
+	if node.ID == name || node.Name == name || node.DisplayName == name {
+		return n
+	}
+	for _, child := range n.renderChildren() {
+		if found := findRenderNode(wf, child, name); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// countRenderNodes returns the number of renderNodes in the trees
+func countRenderNodes(nodes []renderNode) int {
+	count := len(nodes)
+	for _, n := range nodes {
+		count += countRenderNodes(n.renderChildren())
+	}
+	return count
+}
+
+// collapsed returns whether the node's children are collapsed into the node, which is the case for succeeded steps and
+// DAGs, other than the root of the tree
+func collapsed(node wfv1.NodeStatus, depth int, getArgs GetFlags) bool {
+	return getArgs.Collapse && depth > 0 && node.Phase == wfv1.NodeSucceeded
+}
+
+// printCollapsedNode prints the node, with the number of its descendants which are not printed
+func printCollapsedNode(w *tabwriter.Writer, wf *wfv1.Workflow, n renderNode, nodePrefix string, getArgs GetFlags) {
+	node := n.getNodeStatus(wf)
+	node.DisplayName = fmt.Sprintf("%s (+%d)", node.DisplayName, countRenderNodes(n.renderChildren()))
+	printNode(w, node, wf.Name, nodePrefix, getArgs, util.GetWorkflowPodNameVersion(wf))
+}
+
 func isBoundaryNode(node wfv1.NodeType) bool {
 	return (node == wfv1.NodeTypeDAG) || (node == wfv1.NodeTypeSteps)
 }
@@ -521,6 +625,10 @@ func printNode(w *tabwriter.Writer, node wfv1.NodeStatus, wfName, nodePrefix str
 // boundaryNode
 func (nodeInfo *boundaryNode) renderNodes(w *tabwriter.Writer, wf *wfv1.Workflow, depth int, nodePrefix string, childPrefix string, getArgs GetFlags) {
 	filtered, childIndent := filterNode(nodeInfo.getNodeStatus(wf), getArgs)
+	if !filtered && collapsed(nodeInfo.getNodeStatus(wf), depth, getArgs) {
+		printCollapsedNode(w, wf, nodeInfo, nodePrefix, getArgs)
+		return
+	}
 	if !filtered {
 		version := util.GetWorkflowPodNameVersion(wf)
 		printNode(w, nodeInfo.getNodeStatus(wf), wf.Name, nodePrefix, getArgs, version)
@@ -535,6 +643,10 @@ func (nodeInfo *boundaryNode) renderNodes(w *tabwriter.Writer, wf *wfv1.Workflow
 // nonBoundaryParentNode
 func (nodeInfo *nonBoundaryParentNode) renderNodes(w *tabwriter.Writer, wf *wfv1.Workflow, depth int, nodePrefix string, childPrefix string, getArgs GetFlags) {
 	filtered, childIndent := filterNode(nodeInfo.getNodeStatus(wf), getArgs)
+	if !filtered && collapsed(nodeInfo.getNodeStatus(wf), depth, getArgs) {
+		printCollapsedNode(w, wf, nodeInfo, nodePrefix, getArgs)
+		return
+	}
 	if !filtered {
 		version := util.GetWorkflowPodNameVersion(wf)
 		printNode(w, nodeInfo.getNodeStatus(wf), wf.Name, nodePrefix, getArgs, version)
@@ -161,6 +161,55 @@ status:
 		output := PrintWorkflowHelper(&wf, GetFlags{})
 		assert.Regexp(t, `EstimatedDuration: *1 second`, output)
 	})
+	t.Run("Recommendations", func(t *testing.T) {
+		var wf wfv1.Workflow
+		wfv1.MustUnmarshal(`
+status:
+  phase: Succeeded
+  resourceRecommendations:
+    main:
+      requests:
+        cpu: 250m
+        memory: 512Mi
+      limits:
+        memory: 640Mi
+      samples: 3
+    my-wftmpl/setup:
+      requests:
+        memory: 1Mi
+      samples: 1
+`, &wf)
+		output := PrintWorkflowHelper(&wf, GetFlags{Recommendations: true})
+		assert.Regexp(t, `TEMPLATE +SAMPLES +CPU REQUEST +MEMORY REQUEST +MEMORY LIMIT`, output)
+		assert.Regexp(t, `main +3 +250m +512Mi +640Mi\n`, output)
+		assert.Regexp(t, `my-wftmpl/setup +1 +- +1Mi +-\n`, output)
+		assert.NotContains(t, PrintWorkflowHelper(&wf, GetFlags{}), "TEMPLATE")
+		assert.Contains(t, PrintWorkflowHelper(&wfv1.Workflow{}, GetFlags{Recommendations: true}), "No resource recommendations")
+	})
+	t.Run("SensitiveParameters", func(t *testing.T) {
+		var wf wfv1.Workflow
+		wfv1.MustUnmarshal(`
+spec:
+  arguments:
+    parameters:
+    - name: message
+      value: hello
+    - name: token
+      value: my-token
+      sensitive: true
+status:
+  phase: Succeeded
+  outputs:
+    parameters:
+    - name: password
+      value: encrypted:v1:abc
+`, &wf)
+		output := PrintWorkflowHelper(&wf, GetFlags{})
+		assert.Regexp(t, `message: *hello`, output)
+		assert.Regexp(t, `token: *\*{6}`, output)
+		assert.Regexp(t, `password: *\*{6}`, output)
+		assert.NotContains(t, output, "my-token")
+	})
 	t.Run("IndexOrdering", func(t *testing.T) {
 		var wf wfv1.Workflow
 		wfv1.MustUnmarshal(`apiVersion: argoproj.io/v1alpha1
@@ -406,6 +455,42 @@ status:
 		expectedPodName = util.GeneratePodName(wf.GetObjectMeta().GetName(), "many-items-z26lj[0].sleep(11:eleven)", "sleep", "many-items-z26lj-3011405271", util.GetPodNameVersion())
 		assert.Contains(t, output, fmt.Sprintf("sleep(11:eleven)  sleep           %s  22s", expectedPodName))
 	})
+	nestedWf := `
+metadata: {name: my-wf}
+status:
+  phase: Running
+  nodes:
+    my-wf: {id: my-wf, name: my-wf, displayName: my-wf, type: Steps, templateName: main, phase: Running, children: [my-wf-1]}
+    my-wf-1: {id: my-wf-1, name: "my-wf[0]", displayName: "[0]", type: StepGroup, boundaryID: my-wf, phase: Running, children: [my-wf-2, my-wf-5]}
+    my-wf-2: {id: my-wf-2, name: "my-wf[0].inner", displayName: inner, type: Steps, templateName: inner, boundaryID: my-wf, phase: Succeeded, children: [my-wf-3]}
+    my-wf-3: {id: my-wf-3, name: "my-wf[0].inner[0]", displayName: "[0]", type: StepGroup, boundaryID: my-wf-2, phase: Succeeded, children: [my-wf-4]}
+    my-wf-4: {id: my-wf-4, name: "my-wf[0].inner[0].a", displayName: a, type: Pod, templateName: work, boundaryID: my-wf-2, phase: Succeeded}
+    my-wf-5: {id: my-wf-5, name: "my-wf[0].b", displayName: b, type: Pod, templateName: work, boundaryID: my-wf, phase: Running}
+`
+	t.Run("Collapse", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(nestedWf)
+		output := PrintWorkflowHelper(wf, GetFlags{})
+		assert.Contains(t, output, "inner  ")
+		assert.Contains(t, output, "a  ")
+		output = PrintWorkflowHelper(wf, GetFlags{Collapse: true})
+		assert.Contains(t, output, "inner (+2)")
+		assert.NotContains(t, output, "a  ")
+		assert.Contains(t, output, "b  ")
+	})
+	t.Run("Node", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(nestedWf)
+		output := PrintWorkflowHelper(wf, GetFlags{Node: "inner"})
+		assert.Contains(t, output, "inner  ")
+		assert.Contains(t, output, "a  ")
+		assert.NotContains(t, output, "my-wf  ")
+		assert.NotContains(t, output, "b  ")
+		output = PrintWorkflowHelper(wf, GetFlags{Node: "my-wf-5"})
+		assert.Contains(t, output, "b  ")
+		assert.NotContains(t, output, "inner")
+		output = PrintWorkflowHelper(wf, GetFlags{Node: "missing"})
+		assert.Contains(t, output, "STEP")
+		assert.NotContains(t, output, "my-wf  ")
+	})
 }
 
 func Test_printWorkflowHelperNudges(t *testing.T) {
@@ -0,0 +1,282 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// GraphOutputs are the output formats which render the structure of a workflow or template as a graph
+var GraphOutputs = []string{"mermaid", "dot"}
+
+// phaseColors are the colors of the phases of nodes, as in the UI
+var phaseColors = map[wfv1.NodePhase]string{
+	wfv1.NodePending:   "#8fa4b1",
+	wfv1.NodeRunning:   "#0dadea",
+	wfv1.NodeSucceeded: "#18be94",
+	wfv1.NodeSkipped:   "#d1d5d7",
+	wfv1.NodeFailed:    "#e96d76",
+	wfv1.NodeError:     "#e96d76",
+	wfv1.NodeOmitted:   "#d1d5d7",
+}
+
+type graphShape int
+
+const (
+	graphShapeBox graphShape = iota
+	graphShapeRounded
+	graphShapeCircle
+)
+
+type graphNode struct {
+	id    string
+	label string
+	phase wfv1.NodePhase
+	shape graphShape
+	// group is the subgraph of the node, if any
+	group string
+}
+
+type graph struct {
+	nodes  []graphNode
+	edges  [][2]string
+	groups []string
+}
+
+func (g *graph) addNode(label string, phase wfv1.NodePhase, shape graphShape, group string) string {
+	id := fmt.Sprintf("n%d", len(g.nodes))
+	g.nodes = append(g.nodes, graphNode{id: id, label: label, phase: phase, shape: shape, group: group})
+	if group != "" && !slices.Contains(g.groups, group) {
+		g.groups = append(g.groups, group)
+	}
+	return id
+}
+
+func (g *graph) addEdge(from, to string) {
+	g.edges = append(g.edges, [2]string{from, to})
+}
+
+// PrintWorkflowGraph renders the nodes of the workflow and their phases as a graph in the output format, or the
+// templates of the workflow if it has no nodes yet
+func PrintWorkflowGraph(wf *wfv1.Workflow, output string) (string, error) {
+	if len(wf.Status.Nodes) == 0 {
+		return PrintTemplatesGraph(wf.GetExecSpec().Templates, output)
+	}
+	ids := make([]string, 0, len(wf.Status.Nodes))
+	for id := range wf.Status.Nodes {
+		ids = append(ids, id)
+	}
+	slices.SortFunc(ids, func(a, b string) int {
+		if c := wf.Status.Nodes[a].StartedAt.Compare(wf.Status.Nodes[b].StartedAt.Time); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	})
+	g := &graph{}
+	graphIDs := make(map[string]string, len(ids))
+	for _, id := range ids {
+		node := wf.Status.Nodes[id]
+		graphIDs[id] = g.addNode(node.DisplayName, node.Phase, nodeTypeShape(node.Type), "")
+	}
+	for _, id := range ids {
+		for _, child := range wf.Status.Nodes[id].Children {
+			if to, ok := graphIDs[child]; ok {
+				g.addEdge(graphIDs[id], to)
+			}
+		}
+	}
+	return g.render(output)
+}
+
+// PrintTemplatesGraph renders the DAG and steps templates as a graph in the output format, with a subgraph for each
+// template, or a node for each template if there are no DAG or steps templates
+func PrintTemplatesGraph(templates []wfv1.Template, output string) (string, error) {
+	g := &graph{}
+	for _, tmpl := range templates {
+		switch {
+		case tmpl.DAG != nil:
+			dctx := templateDAGContext(tmpl.DAG.Tasks)
+			ids := make(map[string]string, len(tmpl.DAG.Tasks))
+			for _, task := range tmpl.DAG.Tasks {
+				ids[task.Name] = g.addNode(task.Name, "", graphShapeBox, tmpl.Name)
+			}
+			for _, task := range tmpl.DAG.Tasks {
+				dependencies, _ := common.GetTaskDependencies(context.Background(), &task, dctx)
+				names := make([]string, 0, len(dependencies))
+				for name := range dependencies {
+					names = append(names, name)
+				}
+				slices.Sort(names)
+				for _, name := range names {
+					if from, ok := ids[name]; ok {
+						g.addEdge(from, ids[task.Name])
+					}
+				}
+			}
+		case tmpl.Steps != nil:
+			var previous []string
+			for i, group := range tmpl.Steps {
+				id := g.addNode(fmt.Sprintf("[%d]", i), "", graphShapeCircle, tmpl.Name)
+				for _, from := range previous {
+					g.addEdge(from, id)
+				}
+				previous = nil
+				for _, step := range group.Steps {
+					stepID := g.addNode(step.Name, "", graphShapeBox, tmpl.Name)
+					g.addEdge(id, stepID)
+					previous = append(previous, stepID)
+				}
+			}
+		}
+	}
+	if len(g.nodes) == 0 {
+		for _, tmpl := range templates {
+			g.addNode(tmpl.Name, "", graphShapeBox, "")
+		}
+	}
+	return g.render(output)
+}
+
+func nodeTypeShape(nodeType wfv1.NodeType) graphShape {
+	switch nodeType {
+	case wfv1.NodeTypeStepGroup, wfv1.NodeTypeTaskGroup:
+		return graphShapeCircle
+	case wfv1.NodeTypeDAG, wfv1.NodeTypeSteps, wfv1.NodeTypeRetry:
+		return graphShapeRounded
+	}
+	return graphShapeBox
+}
+
+// templateDAGContext is the DAG context of the tasks of a template, used to get the dependencies of the tasks
+type templateDAGContext []wfv1.DAGTask
+
+func (d templateDAGContext) GetTask(_ context.Context, taskName string) *wfv1.DAGTask {
+	for i := range d {
+		if d[i].Name == taskName {
+			return &d[i]
+		}
+	}
+	return &wfv1.DAGTask{Name: taskName}
+}
+
+func (d templateDAGContext) GetTaskDependencies(ctx context.Context, taskName string) []string {
+	dependencies, _ := common.GetTaskDependencies(ctx, d.GetTask(ctx, taskName), d)
+	var names []string
+	for name := range dependencies {
+		names = append(names, name)
+	}
+	return names
+}
+
+func (d templateDAGContext) GetTaskFinishedAtTime(context.Context, string) time.Time {
+	return time.Time{}
+}
+
+func (g *graph) render(output string) (string, error) {
+	switch output {
+	case "mermaid":
+		return g.mermaid(), nil
+	case "dot":
+		return g.dot(), nil
+	}
+	return "", fmt.Errorf("unknown graph output format: %s", output)
+}
+
+func (g *graph) mermaid() string {
+	var sb strings.Builder
+	sb.WriteString("flowchart TD\n")
+	node := func(indent string, n graphNode) {
+		label := strings.ReplaceAll(n.label, `"`, "#quot;")
+		switch n.shape {
+		case graphShapeRounded:
+			fmt.Fprintf(&sb, "%s%s(\"%s\")", indent, n.id, label)
+		case graphShapeCircle:
+			fmt.Fprintf(&sb, "%s%s((\"%s\"))", indent, n.id, label)
+		default:
+			fmt.Fprintf(&sb, "%s%s[\"%s\"]", indent, n.id, label)
+		}
+		if n.phase != "" {
+			fmt.Fprintf(&sb, ":::%s", n.phase)
+		}
+		sb.WriteString("\n")
+	}
+	for i, group := range g.groups {
+		fmt.Fprintf(&sb, "  subgraph g%d[\"%s\"]\n", i, strings.ReplaceAll(group, `"`, "#quot;"))
+		for _, n := range g.nodes {
+			if n.group == group {
+				node("    ", n)
+			}
+		}
+		sb.WriteString("  end\n")
+	}
+	for _, n := range g.nodes {
+		if n.group == "" {
+			node("  ", n)
+		}
+	}
+	for _, e := range g.edges {
+		fmt.Fprintf(&sb, "  %s --> %s\n", e[0], e[1])
+	}
+	for _, phase := range g.phases() {
+		fmt.Fprintf(&sb, "  classDef %s fill:%s\n", phase, phaseColors[phase])
+	}
+	return sb.String()
+}
+
+func (g *graph) dot() string {
+	var sb strings.Builder
+	sb.WriteString("digraph {\n")
+	sb.WriteString("  node [shape=box, style=filled, fillcolor=\"#ffffff\"]\n")
+	quote := func(s string) string {
+		return `"` + strings.ReplaceAll(strings.ReplaceAll(s, `\`, `\\`), `"`, `\"`) + `"`
+	}
+	node := func(indent string, n graphNode) {
+		attrs := []string{"label=" + quote(n.label)}
+		switch n.shape {
+		case graphShapeRounded:
+			attrs = append(attrs, `style="rounded,filled"`)
+		case graphShapeCircle:
+			attrs = append(attrs, "shape=circle")
+		}
+		if n.phase != "" {
+			attrs = append(attrs, "fillcolor="+quote(phaseColors[n.phase]), "tooltip="+quote(string(n.phase)))
+		}
+		fmt.Fprintf(&sb, "%s%s [%s]\n", indent, n.id, strings.Join(attrs, ", "))
+	}
+	for i, group := range g.groups {
+		fmt.Fprintf(&sb, "  subgraph cluster_%d {\n    label=%s\n", i, quote(group))
+		for _, n := range g.nodes {
+			if n.group == group {
+				node("    ", n)
+			}
+		}
+		sb.WriteString("  }\n")
+	}
+	for _, n := range g.nodes {
+		if n.group == "" {
+			node("  ", n)
+		}
+	}
+	for _, e := range g.edges {
+		fmt.Fprintf(&sb, "  %s -> %s\n", e[0], e[1])
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// phases returns the phases of the nodes, in order
+func (g *graph) phases() []wfv1.NodePhase {
+	var phases []wfv1.NodePhase
+	for _, n := range g.nodes {
+		if n.phase != "" && !slices.Contains(phases, n.phase) {
+			phases = append(phases, n.phase)
+		}
+	}
+	slices.Sort(phases)
+	return phases
+}
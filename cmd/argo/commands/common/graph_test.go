@@ -0,0 +1,112 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestPrintWorkflowGraph(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata: {name: my-wf}
+status:
+  nodes:
+    my-wf: {id: my-wf, displayName: my-wf, type: Steps, phase: Running, children: [my-wf-1], startedAt: "2025-01-01T00:00:00Z"}
+    my-wf-1: {id: my-wf-1, displayName: "[0]", type: StepGroup, phase: Running, children: [my-wf-2, my-wf-3], startedAt: "2025-01-01T00:00:01Z"}
+    my-wf-2: {id: my-wf-2, displayName: a, type: Pod, phase: Succeeded, startedAt: "2025-01-01T00:00:02Z"}
+    my-wf-3: {id: my-wf-3, displayName: "say \"hi\"", type: Pod, phase: Failed, startedAt: "2025-01-01T00:00:02Z"}
+`)
+	t.Run("Mermaid", func(t *testing.T) {
+		out, err := PrintWorkflowGraph(wf, "mermaid")
+		require.NoError(t, err)
+		assert.Equal(t, `flowchart TD
+  n0("my-wf"):::Running
+  n1(("[0]")):::Running
+  n2["a"]:::Succeeded
+  n3["say #quot;hi#quot;"]:::Failed
+  n0 --> n1
+  n1 --> n2
+  n1 --> n3
+  classDef Failed fill:#e96d76
+  classDef Running fill:#0dadea
+  classDef Succeeded fill:#18be94
+`, out)
+	})
+	t.Run("DOT", func(t *testing.T) {
+		out, err := PrintWorkflowGraph(wf, "dot")
+		require.NoError(t, err)
+		assert.Equal(t, `digraph {
+  node [shape=box, style=filled, fillcolor="#ffffff"]
+  n0 [label="my-wf", style="rounded,filled", fillcolor="#0dadea", tooltip="Running"]
+  n1 [label="[0]", shape=circle, fillcolor="#0dadea", tooltip="Running"]
+  n2 [label="a", fillcolor="#18be94", tooltip="Succeeded"]
+  n3 [label="say \"hi\"", fillcolor="#e96d76", tooltip="Failed"]
+  n0 -> n1
+  n1 -> n2
+  n1 -> n3
+}
+`, out)
+	})
+	t.Run("Unknown", func(t *testing.T) {
+		_, err := PrintWorkflowGraph(wf, "svg")
+		require.EqualError(t, err, "unknown graph output format: svg")
+	})
+}
+
+func TestPrintTemplatesGraph(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+spec:
+  templates:
+    - name: main
+      dag:
+        tasks:
+          - {name: a, template: work}
+          - {name: b, template: work, dependencies: [a]}
+          - {name: c, template: work, depends: "a && b.Failed"}
+    - name: steps
+      steps:
+        - - {name: x, template: work}
+          - {name: "y", template: work}
+        - - {name: z, template: work}
+    - name: work
+      container: {image: busybox}
+`)
+	t.Run("Mermaid", func(t *testing.T) {
+		out, err := PrintWorkflowGraph(wf, "mermaid")
+		require.NoError(t, err)
+		assert.Equal(t, `flowchart TD
+  subgraph g0["main"]
+    n0["a"]
+    n1["b"]
+    n2["c"]
+  end
+  subgraph g1["steps"]
+    n3(("[0]"))
+    n4["x"]
+    n5["y"]
+    n6(("[1]"))
+    n7["z"]
+  end
+  n0 --> n1
+  n0 --> n2
+  n1 --> n2
+  n3 --> n4
+  n3 --> n5
+  n4 --> n6
+  n5 --> n6
+  n6 --> n7
+`, out)
+	})
+	t.Run("DOT", func(t *testing.T) {
+		out, err := PrintTemplatesGraph(wf.Spec.Templates[2:], "dot")
+		require.NoError(t, err)
+		assert.Equal(t, `digraph {
+  node [shape=box, style=filled, fillcolor="#ffffff"]
+  n0 [label="work"]
+}
+`, out)
+	})
+}
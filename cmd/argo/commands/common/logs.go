@@ -4,22 +4,88 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sync"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
 )
 
+// LogWorkflowsPollPeriod is how often LogWorkflows lists the workflows, to pick up new matches when following the logs
+var LogWorkflowsPollPeriod = 5 * time.Second
+
 func LogWorkflow(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace, workflow, podName, grep, selector string, logOptions *corev1.PodLogOptions) error {
-	// logs
-	stream, err := serviceClient.WorkflowLogs(ctx, &workflowpkg.WorkflowLogRequest{
+	return streamLogs(ctx, serviceClient, &workflowpkg.WorkflowLogRequest{
 		Name:       workflow,
 		Namespace:  namespace,
 		PodName:    podName,
 		LogOptions: logOptions,
 		Selector:   selector,
 		Grep:       grep,
+	}, func(event *workflowpkg.LogEntry) {
+		fmt.Println(ansiFormat(fmt.Sprintf("%s: %s", event.PodName, event.Content), ansiColorCode(event.PodName)))
 	})
+}
+
+// LogWorkflows prints the logs of the workflows matching the list options concurrently, prefixing each line with the
+// names of its workflow and pod. When following the logs, workflows which start matching later are picked up too.
+func LogWorkflows(ctx context.Context, out io.Writer, serviceClient workflowpkg.WorkflowServiceClient, namespace string, listOptions *metav1.ListOptions, grep string, logOptions *corev1.PodLogOptions) error {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		started = map[string]bool{}
+	)
+	defer wg.Wait()
+	ticker := time.NewTicker(LogWorkflowsPollPeriod)
+	defer ticker.Stop()
+	for {
+		list, err := serviceClient.ListWorkflows(ctx, &workflowpkg.WorkflowListRequest{
+			Namespace:   namespace,
+			ListOptions: listOptions,
+			Fields:      "items.metadata.name",
+		})
+		if err != nil {
+			return err
+		}
+		for _, wf := range list.Items {
+			if started[wf.Name] {
+				continue
+			}
+			started[wf.Name] = true
+			wg.Add(1)
+			go func(workflow string) {
+				defer wg.Done()
+				err := streamLogs(ctx, serviceClient, &workflowpkg.WorkflowLogRequest{
+					Name:       workflow,
+					Namespace:  namespace,
+					LogOptions: logOptions,
+					Grep:       grep,
+				}, func(event *workflowpkg.LogEntry) {
+					mu.Lock()
+					defer mu.Unlock()
+					_, _ = fmt.Fprintln(out, ansiFormat(fmt.Sprintf("%s/%s: %s", workflow, event.PodName, event.Content), ansiColorCode(workflow)))
+				})
+				if err != nil && ctx.Err() == nil {
+					logging.RequireLoggerFromContext(ctx).WithField("workflow", workflow).WithError(err).Warn(ctx, "Failed to get the logs of the workflow")
+				}
+			}(wf.Name)
+		}
+		if !logOptions.Follow {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func streamLogs(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, req *workflowpkg.WorkflowLogRequest, printEntry func(event *workflowpkg.LogEntry)) error {
+	stream, err := serviceClient.WorkflowLogs(ctx, req)
 	if err != nil {
 		return err
 	}
@@ -33,6 +99,6 @@ func LogWorkflow(ctx context.Context, serviceClient workflowpkg.WorkflowServiceC
 		if err != nil {
 			return err
 		}
-		fmt.Println(ansiFormat(fmt.Sprintf("%s: %s", event.PodName, event.Content), ansiColorCode(event.PodName)))
+		printEntry(event)
 	}
 }
@@ -0,0 +1,102 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	workflowmocks "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow/mocks"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+type logsClient struct {
+	grpc.ClientStream
+	podName string
+	lines   []string
+	// done is called when the logs end
+	done func()
+}
+
+func (c *logsClient) Recv() (*workflowpkg.LogEntry, error) {
+	if len(c.lines) == 0 {
+		if c.done != nil {
+			c.done()
+		}
+		return nil, io.EOF
+	}
+	line := c.lines[0]
+	c.lines = c.lines[1:]
+	return &workflowpkg.LogEntry{PodName: c.podName, Content: line}, nil
+}
+
+func workflowList(names ...string) *wfv1.WorkflowList {
+	list := &wfv1.WorkflowList{}
+	for _, name := range names {
+		list.Items = append(list.Items, wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: name}})
+	}
+	return list
+}
+
+func logRequest(name string) any {
+	return mock.MatchedBy(func(req *workflowpkg.WorkflowLogRequest) bool {
+		return req.Name == name && req.Namespace == "my-ns" && req.Grep == "my-grep"
+	})
+}
+
+// sortedLines returns the lines of the output in order, as the logs of workflows are printed concurrently
+func sortedLines(out string) []string {
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	slices.Sort(lines)
+	return lines
+}
+
+func TestLogWorkflows(t *testing.T) {
+	NoColor = true
+	defer func() { NoColor = false }()
+	listOptions := &metav1.ListOptions{LabelSelector: "my-label=my-value"}
+	listRequest := &workflowpkg.WorkflowListRequest{Namespace: "my-ns", ListOptions: listOptions, Fields: "items.metadata.name"}
+
+	t.Run("Once", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		c := &workflowmocks.WorkflowServiceClient{}
+		c.On("ListWorkflows", mock.Anything, listRequest).Return(workflowList("wf-a", "wf-b"), nil).Once()
+		c.On("WorkflowLogs", mock.Anything, logRequest("wf-a")).Return(&logsClient{podName: "pod-a", lines: []string{"a1", "a2"}}, nil)
+		c.On("WorkflowLogs", mock.Anything, logRequest("wf-b")).Return(&logsClient{podName: "pod-b", lines: []string{"b1"}}, nil)
+
+		var out bytes.Buffer
+		require.NoError(t, LogWorkflows(ctx, &out, c, "my-ns", listOptions, "my-grep", &corev1.PodLogOptions{}))
+		assert.Equal(t, []string{"wf-a/pod-a: a1", "wf-a/pod-a: a2", "wf-b/pod-b: b1"}, sortedLines(out.String()))
+		c.AssertExpectations(t)
+	})
+
+	t.Run("Follow", func(t *testing.T) {
+		defer func(period time.Duration) { LogWorkflowsPollPeriod = period }(LogWorkflowsPollPeriod)
+		LogWorkflowsPollPeriod = time.Millisecond
+		ctx, cancel := context.WithCancel(logging.TestContext(t.Context()))
+		defer cancel()
+		c := &workflowmocks.WorkflowServiceClient{}
+		// wf-b is created after the logs of wf-a are followed
+		c.On("ListWorkflows", mock.Anything, listRequest).Return(workflowList("wf-a"), nil).Once()
+		c.On("ListWorkflows", mock.Anything, listRequest).Return(workflowList("wf-a", "wf-b"), nil)
+		c.On("WorkflowLogs", mock.Anything, logRequest("wf-a")).Return(&logsClient{podName: "pod-a", lines: []string{"a1"}}, nil).Once()
+		c.On("WorkflowLogs", mock.Anything, logRequest("wf-b")).Return(&logsClient{podName: "pod-b", lines: []string{"b1"}, done: cancel}, nil).Once()
+
+		var out bytes.Buffer
+		require.NoError(t, LogWorkflows(ctx, &out, c, "my-ns", listOptions, "my-grep", &corev1.PodLogOptions{Follow: true}))
+		assert.Equal(t, []string{"wf-a/pod-a: a1", "wf-b/pod-b: b1"}, sortedLines(out.String()))
+		c.AssertExpectations(t)
+	})
+}
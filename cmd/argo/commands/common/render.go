@@ -0,0 +1,208 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/template"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/expand"
+)
+
+// RenderWorkflow resolves what it can of the workflow without running it: the values of its parameters, defaulting
+// those from valueFrom.default, the global variables such as {{workflow.parameters.x}}, the inputs of its entrypoint,
+// and the steps and DAG tasks with withItems, withSequence, or a withParam which is not an output, into a step or task
+// per item. Anything which is only known when running, such as outputs, is left as is.
+func RenderWorkflow(ctx context.Context, wf *wfv1.Workflow) error {
+	if wf.Spec.WorkflowTemplateRef != nil {
+		return fmt.Errorf("workflows of a workflowTemplateRef cannot be rendered, render the workflow template instead")
+	}
+	for i, param := range wf.Spec.Arguments.Parameters {
+		if param.Value == nil && param.ValueFrom != nil && param.ValueFrom.Default != nil {
+			wf.Spec.Arguments.Parameters[i].Value = param.ValueFrom.Default
+		}
+	}
+	globalParams, err := renderGlobalParams(wf)
+	if err != nil {
+		return err
+	}
+	spec, err := substitute(ctx, wf.Spec, globalParams)
+	if err != nil {
+		return err
+	}
+	for i := range spec.Templates {
+		tmpl := &spec.Templates[i]
+		if tmpl.Name == spec.Entrypoint {
+			if tmpl, err = renderEntrypoint(ctx, tmpl, &spec.Arguments); err != nil {
+				return err
+			}
+		}
+		if err := renderLoops(ctx, tmpl); err != nil {
+			return fmt.Errorf("templates.%s: %w", tmpl.Name, err)
+		}
+		spec.Templates[i] = *tmpl
+	}
+	wf.Spec = spec
+	return nil
+}
+
+// renderGlobalParams returns the global variables which are known before the workflow runs
+func renderGlobalParams(wf *wfv1.Workflow) (common.Parameters, error) {
+	globalParams := common.Parameters{
+		common.GlobalVarWorkflowMainEntrypoint: wf.Spec.Entrypoint,
+	}
+	if wf.Name != "" {
+		globalParams[common.GlobalVarWorkflowName] = wf.Name
+	}
+	if wf.Namespace != "" {
+		globalParams[common.GlobalVarWorkflowNamespace] = wf.Namespace
+	}
+	if wf.Spec.ServiceAccountName != "" {
+		globalParams[common.GlobalVarWorkflowServiceAccountName] = wf.Spec.ServiceAccountName
+	}
+	parameters, err := json.Marshal(wf.Spec.Arguments.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	globalParams[common.GlobalVarWorkflowParameters] = string(parameters)
+	globalParams[common.GlobalVarWorkflowParametersJSON] = string(parameters)
+	for _, param := range wf.Spec.Arguments.Parameters {
+		if param.Value != nil {
+			globalParams["workflow.parameters."+param.Name] = param.Value.String()
+		}
+	}
+	for k, v := range wf.Labels {
+		globalParams["workflow.labels."+k] = v
+	}
+	for k, v := range wf.Annotations {
+		globalParams["workflow.annotations."+k] = v
+	}
+	return globalParams, nil
+}
+
+// renderEntrypoint sets the inputs of the entrypoint from the arguments of the workflow, or their defaults
+func renderEntrypoint(ctx context.Context, tmpl *wfv1.Template, args *wfv1.Arguments) (*wfv1.Template, error) {
+	localParams := common.Parameters{}
+	for i, param := range tmpl.Inputs.Parameters {
+		if param.Default != nil {
+			param.Value = param.Default
+		}
+		if arg := args.GetParameterByName(param.Name); arg != nil && arg.Value != nil {
+			param.Value = arg.Value
+		}
+		if param.Value == nil && param.ValueFrom == nil {
+			return nil, fmt.Errorf("inputs.parameters.%s was not supplied", param.Name)
+		}
+		if param.Value != nil {
+			localParams["inputs.parameters."+param.Name] = param.Value.String()
+		}
+		tmpl.Inputs.Parameters[i] = param
+	}
+	newTmpl, err := substitute(ctx, *tmpl, localParams)
+	return &newTmpl, err
+}
+
+// renderLoops expands the steps and DAG tasks of the template with items that are known into a step or task per
+// item, named like the controller names them
+func renderLoops(ctx context.Context, tmpl *wfv1.Template) error {
+	for i, stepGroup := range tmpl.Steps {
+		var steps []wfv1.WorkflowStep
+		for _, step := range stepGroup.Steps {
+			expanded, err := renderStep(ctx, step)
+			if err != nil {
+				return err
+			}
+			steps = append(steps, expanded...)
+		}
+		tmpl.Steps[i].Steps = steps
+	}
+	if tmpl.DAG != nil {
+		var tasks []wfv1.DAGTask
+		for _, task := range tmpl.DAG.Tasks {
+			expanded, err := renderTask(ctx, task)
+			if err != nil {
+				return err
+			}
+			tasks = append(tasks, expanded...)
+		}
+		tmpl.DAG.Tasks = tasks
+	}
+	return nil
+}
+
+func renderStep(ctx context.Context, step wfv1.WorkflowStep) ([]wfv1.WorkflowStep, error) {
+	// a withParam of outputs is only known when running
+	if !step.ShouldExpand() || strings.Contains(step.WithParam, "{{") {
+		return []wfv1.WorkflowStep{step}, nil
+	}
+	items, err := expand.Items(step.WithItems, step.WithParam, step.WithSequence, step.When)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", step.Name, err)
+	}
+	step.WithItems, step.WithParam, step.WithSequence = nil, "", nil
+	t, err := newTemplate(step)
+	if err != nil {
+		return nil, err
+	}
+	steps := make([]wfv1.WorkflowStep, len(items))
+	for i, item := range items {
+		name, err := expand.SubstituteItem(ctx, t, step.Name, i, item, &steps[i], true)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", step.Name, err)
+		}
+		steps[i].Name = name
+		steps[i].Template = step.Template
+	}
+	return steps, nil
+}
+
+func renderTask(ctx context.Context, task wfv1.DAGTask) ([]wfv1.DAGTask, error) {
+	// a withParam of outputs is only known when running
+	if !task.ShouldExpand() || strings.Contains(task.WithParam, "{{") {
+		return []wfv1.DAGTask{task}, nil
+	}
+	items, err := expand.Items(task.WithItems, task.WithParam, task.WithSequence, task.When)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", task.Name, err)
+	}
+	task.WithItems, task.WithParam, task.WithSequence = nil, "", nil
+	t, err := newTemplate(task)
+	if err != nil {
+		return nil, err
+	}
+	tasks := make([]wfv1.DAGTask, len(items))
+	for i, item := range items {
+		name, err := expand.SubstituteItem(ctx, t, task.Name, i, item, &tasks[i], true)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", task.Name, err)
+		}
+		tasks[i].Name = name
+		tasks[i].Template = task.Template
+	}
+	return tasks, nil
+}
+
+func newTemplate(v interface{}) (template.Template, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return template.NewTemplate(string(data))
+}
+
+// substitute replaces the parameters in the value, leaving those it does not know
+func substitute[T any](ctx context.Context, value T, params common.Parameters) (T, error) {
+	var result T
+	data, err := json.Marshal(value)
+	if err != nil {
+		return result, err
+	}
+	s, err := template.Replace(ctx, string(data), params, true)
+	if err != nil {
+		return result, err
+	}
+	return result, json.Unmarshal([]byte(s), &result)
+}
@@ -0,0 +1,82 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func TestRenderWorkflow(t *testing.T) {
+	t.Run("Render", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(`
+metadata: {name: my-wf, labels: {team: a}}
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+      - {name: greeting, value: hello}
+      - {name: count, valueFrom: {default: "2", configMapKeyRef: {name: my-cm, key: count}}}
+  templates:
+    - name: main
+      inputs:
+        parameters: [{name: greeting}, {name: who, default: world}]
+      steps:
+        - - name: say
+            template: print
+            arguments: {parameters: [{name: msg, value: "{{inputs.parameters.greeting}} {{inputs.parameters.who}} {{item}}"}]}
+            withItems: [a, {b: c}]
+        - - name: results
+            template: print
+            arguments: {parameters: [{name: msg, value: "{{item}}"}]}
+            withParam: "{{steps.say.outputs.result}}"
+    - name: dag
+      dag:
+        tasks:
+          - name: count
+            template: print
+            arguments: {parameters: [{name: msg, value: "{{item}} of {{workflow.labels.team}}"}]}
+            withSequence: {count: "{{workflow.parameters.count}}"}
+    - name: print
+      inputs:
+        parameters: [{name: msg}]
+      container: {image: busybox, args: ["{{inputs.parameters.msg}} in {{workflow.name}}"]}
+`)
+		require.NoError(t, RenderWorkflow(logging.TestContext(t.Context()), wf))
+		assert.Equal(t, "2", wf.Spec.Arguments.Parameters[1].Value.String())
+		main := wf.Spec.Templates[0]
+		assert.Equal(t, "hello", main.Inputs.Parameters[0].Value.String())
+		require.Len(t, main.Steps[0].Steps, 2)
+		assert.Equal(t, "say(0:a)", main.Steps[0].Steps[0].Name)
+		assert.Equal(t, "print", main.Steps[0].Steps[0].Template)
+		assert.Equal(t, "hello world a", main.Steps[0].Steps[0].Arguments.Parameters[0].Value.String())
+		assert.Equal(t, "say(1:b:c)", main.Steps[0].Steps[1].Name)
+		assert.Equal(t, `hello world {"b":"c"}`, main.Steps[0].Steps[1].Arguments.Parameters[0].Value.String())
+		// outputs are only known when running
+		require.Len(t, main.Steps[1].Steps, 1)
+		assert.Equal(t, "{{steps.say.outputs.result}}", main.Steps[1].Steps[0].WithParam)
+		tasks := wf.Spec.Templates[1].DAG.Tasks
+		require.Len(t, tasks, 2)
+		assert.Equal(t, "count(1:1)", tasks[1].Name)
+		assert.Equal(t, "1 of a", tasks[1].Arguments.Parameters[0].Value.String())
+		assert.Equal(t, []string{"{{inputs.parameters.msg}} in my-wf"}, wf.Spec.Templates[2].Container.Args)
+	})
+	t.Run("MissingInput", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(`
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      inputs: {parameters: [{name: x}]}
+      container: {image: busybox}
+`)
+		require.EqualError(t, RenderWorkflow(logging.TestContext(t.Context()), wf), "inputs.parameters.x was not supplied")
+	})
+	t.Run("WorkflowTemplateRef", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(`spec: {workflowTemplateRef: {name: my-wft}}`)
+		require.Error(t, RenderWorkflow(logging.TestContext(t.Context()), wf))
+	})
+}
@@ -24,7 +24,8 @@ type CliSubmitOpts struct {
 
 func NewCliSubmitOpts() CliSubmitOpts {
 	return CliSubmitOpts{
-		Output: NewPrintWorkflowOutputValue(""),
+		Output:  NewPrintWorkflowOutputValue(""),
+		GetArgs: GetFlags{Collapse: true},
 	}
 }
 
@@ -5,41 +5,96 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"sync"
 
+	"github.com/expr-lang/expr"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util"
+	"github.com/argoproj/argo-workflows/v3/util/expr/argoexpr"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 )
 
+// WaitOpts are the options for waiting for workflows
+type WaitOpts struct {
+	IgnoreNotFound bool
+	Quiet          bool
+	// Any waits for any of the workflows, rather than all of them
+	Any bool
+	// Until is an expression a workflow must meet, rather than completing successfully
+	Until string
+}
+
 // waitWorkflows waits for the given workflowNames.
 func WaitWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace string, workflowNames []string, ignoreNotFound, quiet bool) {
-	var wg sync.WaitGroup
-	wfSuccessStatus := true
+	if !WaitWorkflowsUntil(ctx, serviceClient, namespace, workflowNames, WaitOpts{IgnoreNotFound: ignoreNotFound, Quiet: quiet}) {
+		os.Exit(1)
+	}
+}
 
+// WaitWorkflowsUntil waits for all, or any, of the workflows to complete successfully, or to meet the until expression,
+// returning whether they did
+func WaitWorkflowsUntil(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace string, workflowNames []string, opts WaitOpts) bool {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan bool, len(workflowNames))
 	for _, name := range workflowNames {
-		wg.Add(1)
 		go func(name string) {
-			if ok, _ := waitOnOne(ctx, serviceClient, name, namespace, ignoreNotFound, quiet); !ok {
-				wfSuccessStatus = false
+			ok, err := waitOnOne(ctx, serviceClient, name, namespace, opts)
+			if err != nil && ctx.Err() == nil {
+				logging.RequireLoggerFromContext(ctx).WithField("workflow", name).WithError(err).Warn(ctx, "Failed to wait for the workflow")
 			}
-			wg.Done()
+			results <- ok
 		}(name)
+	}
+	succeeded := 0
+	for range workflowNames {
+		if <-results {
+			succeeded++
+			if opts.Any {
+				return true
+			}
+		}
+	}
+	return !opts.Any && succeeded == len(workflowNames)
+}
 
+// ValidateUntil returns an error if the until expression is not valid
+func ValidateUntil(until string) error {
+	if until == "" {
+		return nil
 	}
-	wg.Wait()
+	_, err := expr.Compile(until)
+	return err
+}
 
-	if !wfSuccessStatus {
-		os.Exit(1)
+// untilEnv returns the environment of the until expression: the fields of the workflow, and its output parameters
+// by name as outputs.parameters
+func untilEnv(wf *wfv1.Workflow) (map[string]interface{}, error) {
+	env, err := runtime.DefaultUnstructuredConverter.ToUnstructured(wf)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := env["status"]; !ok {
+		env["status"] = map[string]interface{}{}
 	}
+	parameters := map[string]interface{}{}
+	if wf.Status.Outputs != nil {
+		for _, param := range wf.Status.Outputs.Parameters {
+			if param.HasValue() {
+				parameters[param.Name] = param.GetValue()
+			}
+		}
+	}
+	env["outputs"] = map[string]interface{}{"parameters": parameters}
+	return env, nil
 }
 
-func waitOnOne(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, wfName, namespace string, ignoreNotFound, quiet bool) (bool, error) {
+func waitOnOne(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, wfName, namespace string, opts WaitOpts) (bool, error) {
 	req := &workflowpkg.WatchWorkflowsRequest{
 		Namespace: namespace,
 		ListOptions: &metav1.ListOptions{
@@ -49,7 +104,7 @@ func waitOnOne(ctx context.Context, serviceClient workflowpkg.WorkflowServiceCli
 	}
 	stream, err := serviceClient.WatchWorkflows(ctx, req)
 	if err != nil {
-		if status.Code(err) == codes.NotFound && ignoreNotFound {
+		if status.Code(err) == codes.NotFound && opts.IgnoreNotFound {
 			return true, nil
 		}
 		return false, nil
@@ -72,11 +127,30 @@ func waitOnOne(ctx context.Context, serviceClient workflowpkg.WorkflowServiceCli
 			continue
 		}
 		wf := event.Object
-		if wf != nil && !wf.Status.FinishedAt.IsZero() {
-			if !quiet {
+		if wf == nil {
+			continue
+		}
+		if opts.Until != "" {
+			env, err := untilEnv(wf)
+			if err != nil {
+				return false, err
+			}
+			met, err := argoexpr.EvalBool(opts.Until, env)
+			if err != nil {
+				return false, err
+			}
+			if met {
+				if !opts.Quiet {
+					fmt.Printf("%s %s, condition met\n", wfName, wf.Status.Phase)
+				}
+				return true, nil
+			}
+		}
+		if !wf.Status.FinishedAt.IsZero() {
+			if !opts.Quiet {
 				fmt.Printf("%s %s at %v\n", wfName, wf.Status.Phase, wf.Status.FinishedAt)
 			}
-			if wf.Status.Phase == wfv1.WorkflowFailed || wf.Status.Phase == wfv1.WorkflowError {
+			if opts.Until != "" || wf.Status.Phase == wfv1.WorkflowFailed || wf.Status.Phase == wfv1.WorkflowError {
 				return false, nil
 			}
 			return true, nil
@@ -0,0 +1,89 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	workflowmocks "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow/mocks"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// watchClient sends the workflows, then blocks until the context is done, as a workflow which is not updated
+type watchClient struct {
+	grpc.ClientStream
+	ctx       context.Context
+	workflows []*wfv1.Workflow
+}
+
+func (c *watchClient) Recv() (*workflowpkg.WorkflowWatchEvent, error) {
+	if len(c.workflows) == 0 {
+		<-c.ctx.Done()
+		return nil, c.ctx.Err()
+	}
+	wf := c.workflows[0]
+	c.workflows = c.workflows[1:]
+	return &workflowpkg.WorkflowWatchEvent{Object: wf}, nil
+}
+
+func waitServiceClient(ctx context.Context, workflows map[string][]string) *workflowmocks.WorkflowServiceClient {
+	c := &workflowmocks.WorkflowServiceClient{}
+	for name, manifests := range workflows {
+		var wfs []*wfv1.Workflow
+		for _, manifest := range manifests {
+			wfs = append(wfs, wfv1.MustUnmarshalWorkflow(manifest))
+		}
+		c.On("WatchWorkflows", mock.Anything, mock.MatchedBy(func(req *workflowpkg.WatchWorkflowsRequest) bool {
+			return req.ListOptions.FieldSelector == "metadata.name="+name
+		})).Return(&watchClient{ctx: ctx, workflows: wfs}, nil).Once()
+	}
+	return c
+}
+
+const (
+	runningWf   = `status: {phase: Running}`
+	succeededWf = `status: {phase: Succeeded, finishedAt: "2025-01-01T00:00:00Z"}`
+	failedWf    = `status: {phase: Failed, finishedAt: "2025-01-01T00:00:00Z"}`
+	doneWf      = `status: {phase: Running, outputs: {parameters: [{name: x, value: done}]}}`
+)
+
+func TestWaitWorkflowsUntil(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		workflows map[string][]string
+		opts      WaitOpts
+		want      bool
+	}{
+		{"All", map[string][]string{"a": {runningWf, succeededWf}, "b": {succeededWf}}, WaitOpts{}, true},
+		{"AllFailed", map[string][]string{"a": {succeededWf}, "b": {failedWf}}, WaitOpts{}, false},
+		{"Any", map[string][]string{"a": {runningWf}, "b": {failedWf}, "c": {succeededWf}}, WaitOpts{Any: true}, true},
+		{"AnyFailed", map[string][]string{"a": {failedWf}, "b": {failedWf}}, WaitOpts{Any: true}, false},
+		{"Until", map[string][]string{"a": {runningWf, doneWf}}, WaitOpts{Until: `status.phase == "Succeeded" || outputs.parameters.x == "done"`}, true},
+		{"UntilPhase", map[string][]string{"a": {runningWf}}, WaitOpts{Until: `status.phase == "Running"`}, true},
+		{"UntilCompleted", map[string][]string{"a": {runningWf, succeededWf}}, WaitOpts{Until: `outputs.parameters.x == "done"`}, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(logging.TestContext(t.Context()))
+			defer cancel()
+			c := waitServiceClient(ctx, tt.workflows)
+			var names []string
+			for name := range tt.workflows {
+				names = append(names, name)
+			}
+			tt.opts.Quiet = true
+			assert.Equal(t, tt.want, WaitWorkflowsUntil(ctx, c, "my-ns", names, tt.opts))
+		})
+	}
+}
+
+func TestValidateUntil(t *testing.T) {
+	require.NoError(t, ValidateUntil(""))
+	require.NoError(t, ValidateUntil(`status.phase == "Succeeded"`))
+	require.Error(t, ValidateUntil(`status.phase ==`))
+}
@@ -4,6 +4,11 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -12,6 +17,7 @@ import (
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util"
 	"github.com/argoproj/argo-workflows/v3/util/errors"
+	"github.com/argoproj/argo-workflows/v3/util/humanize"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/workflow/packer"
 )
@@ -85,5 +91,193 @@ func printWorkflowStatus(ctx context.Context, wf *wfv1.Workflow, getArgs GetFlag
 	print("\033[H\033[2J")
 	print("\033[0;0H")
 	fmt.Print(PrintWorkflowHelper(wf, getArgs))
+	fmt.Printf("\n%s\n", progressBar(wf, time.Now()))
+	printRunningNodeEstimates(os.Stdout, wf, time.Now())
 	return nil
 }
+
+// printRunningNodeEstimates prints how long each running node has taken so far against how long the nodes of its
+// template usually take, so that a slow node stands out
+func printRunningNodeEstimates(out io.Writer, wf *wfv1.Workflow, now time.Time) {
+	var nodes []wfv1.NodeStatus
+	for _, node := range wf.Status.Nodes {
+		if node.Phase == wfv1.NodeRunning && node.EstimatedDuration > 0 && len(node.Children) == 0 && !node.StartedAt.IsZero() {
+			nodes = append(nodes, node)
+		}
+	}
+	if len(nodes) == 0 {
+		return
+	}
+	slices.SortFunc(nodes, func(a, b wfv1.NodeStatus) int { return strings.Compare(a.Name, b.Name) })
+	short := func(d time.Duration) string { return humanize.RelativeDurationShort(time.Time{}, time.Time{}.Add(d)) }
+	_, _ = fmt.Fprintln(out)
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprintln(w, "RUNNING\tDURATION\tESTIMATE\tP90\t")
+	for _, node := range nodes {
+		elapsed := now.Sub(node.StartedAt.Time)
+		p90, note := "-", ""
+		if node.EstimatedDurationP90 > 0 {
+			p90 = short(node.EstimatedDurationP90.ToDuration())
+			if elapsed > node.EstimatedDurationP90.ToDuration() {
+				note = "longer than usual"
+			}
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", node.DisplayName, short(elapsed), short(node.EstimatedDuration.ToDuration()), p90, note)
+	}
+	_ = w.Flush()
+}
+
+const progressBarWidth = 40
+
+// progressBar returns a bar of the completed and total pods of the workflow, with an estimate of its remaining time
+func progressBar(wf *wfv1.Workflow, now time.Time) string {
+	return progressBarOfWidth(wf, now, progressBarWidth)
+}
+
+func progressBarOfWidth(wf *wfv1.Workflow, now time.Time, width int) string {
+	var n, m int64
+	if wf.Status.Progress.IsValid() {
+		n, m = wf.Status.Progress.N(), wf.Status.Progress.M()
+	}
+	filled := 0
+	if m > 0 {
+		filled = int(n * int64(width) / m)
+	}
+	done, todo := "█", "░"
+	if NoUtf8 {
+		done, todo = "#", "-"
+	}
+	bar := fmt.Sprintf("%s%s %d/%d", strings.Repeat(done, filled), strings.Repeat(todo, width-filled), n, m)
+	if wf.Status.FinishedAt.IsZero() && !wf.Status.StartedAt.IsZero() && wf.Status.EstimatedDuration > 0 {
+		if remaining := wf.Status.EstimatedDuration.ToDuration() - now.Sub(wf.Status.StartedAt.Time); remaining > 0 {
+			bar += fmt.Sprintf(", about %s remaining", humanize.TruncatedDuration(remaining))
+		}
+	}
+	return bar
+}
+
+// watchWorkflowsFields are the fields of the workflows needed to print them in the dashboard
+const watchWorkflowsFields = "result.type,result.object.metadata.name,result.object.metadata.namespace,result.object.metadata.creationTimestamp," +
+	"result.object.status.phase,result.object.status.progress,result.object.status.startedAt,result.object.status.finishedAt," +
+	"result.object.status.estimatedDuration,result.object.status.message"
+
+// WatchWorkflows prints a dashboard of the workflows matching the selectors, one line per workflow, until all of them
+// have completed
+func WatchWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace string, labelSelector, fieldSelector string) error {
+	req := &workflowpkg.WatchWorkflowsRequest{
+		Namespace: namespace,
+		ListOptions: &metav1.ListOptions{
+			LabelSelector: labelSelector,
+			FieldSelector: fieldSelector,
+		},
+		Fields: watchWorkflowsFields,
+	}
+	stream, err := serviceClient.WatchWorkflows(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	eventChan := make(chan *workflowpkg.WorkflowWatchEvent)
+	go func() {
+		for {
+			event, err := stream.Recv()
+			if err == io.EOF {
+				logger := logging.RequireLoggerFromContext(ctx)
+				logger.Debug(ctx, "Re-establishing workflows watch")
+				stream, err = serviceClient.WatchWorkflows(ctx, req)
+				errors.CheckError(ctx, err)
+				continue
+			}
+			errors.CheckError(ctx, err)
+			if event == nil || event.Object == nil {
+				continue
+			}
+			eventChan <- event
+		}
+	}()
+
+	workflows := make(map[string]wfv1.Workflow)
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case event := <-eventChan:
+			key := event.Object.Namespace + "/" + event.Object.Name
+			if event.Type == "DELETED" {
+				delete(workflows, key)
+			} else {
+				workflows[key] = *event.Object
+			}
+		case <-ticker.C:
+			// If we don't, refresh the dashboard every second
+		case <-ctx.Done():
+			// When the context gets canceled
+			return nil
+		}
+
+		print("\033[H\033[2J")
+		print("\033[0;0H")
+		wfs := slices.Collect(maps.Values(workflows))
+		printWorkflowsDashboard(os.Stdout, wfs, namespace == "", time.Now())
+		if len(wfs) > 0 && !slices.ContainsFunc(wfs, func(wf wfv1.Workflow) bool { return wf.Status.FinishedAt.IsZero() }) {
+			return nil
+		}
+	}
+}
+
+// printWorkflowsDashboard prints one line per workflow, with its phase, progress and duration, oldest first
+func printWorkflowsDashboard(out io.Writer, workflows []wfv1.Workflow, allNamespaces bool, now time.Time) {
+	if len(workflows) == 0 {
+		_, _ = fmt.Fprintln(out, "Waiting for workflows...")
+		return
+	}
+	slices.SortFunc(workflows, func(a, b wfv1.Workflow) int {
+		if c := a.CreationTimestamp.Compare(b.CreationTimestamp.Time); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	if allNamespaces {
+		_, _ = fmt.Fprint(w, "NAMESPACE\t")
+	}
+	// the header is formatted too, so that the tabwriter aligns it with the colored phases
+	_, _ = fmt.Fprintf(w, "NAME\t%s\tPROGRESS\tDURATION\tMESSAGE\n", ansiFormat("STATUS", FgDefault))
+	phases := map[wfv1.WorkflowPhase]int{}
+	for _, wf := range workflows {
+		phase := wf.Status.Phase
+		if phase == "" {
+			phase = wfv1.WorkflowPending
+		}
+		phases[phase]++
+		if allNamespaces {
+			_, _ = fmt.Fprintf(w, "%s\t", wf.Namespace)
+		}
+		duration := "-"
+		if !wf.Status.StartedAt.IsZero() {
+			finishedAt := now
+			if !wf.Status.FinishedAt.IsZero() {
+				finishedAt = wf.Status.FinishedAt.Time
+			}
+			duration = humanize.RelativeDurationShort(wf.Status.StartedAt.Time, finishedAt)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", wf.Name, ansiFormat(string(phase), workflowPhaseColors[phase]), progressBarOfWidth(&wf, now, dashboardProgressBarWidth), duration, wf.Status.Message)
+	}
+	_ = w.Flush()
+	var counts []string
+	for _, phase := range slices.Sorted(maps.Keys(phases)) {
+		counts = append(counts, fmt.Sprintf("%d %s", phases[phase], phase))
+	}
+	_, _ = fmt.Fprintf(out, "\n%d workflows: %s\n", len(workflows), strings.Join(counts, ", "))
+}
+
+const dashboardProgressBarWidth = 20
+
+// workflowPhaseColors are the colors of the phases of workflows, as for the phases of nodes
+var workflowPhaseColors = map[wfv1.WorkflowPhase]int{
+	wfv1.WorkflowPending:   FgYellow,
+	wfv1.WorkflowRunning:   FgCyan,
+	wfv1.WorkflowSucceeded: FgGreen,
+	wfv1.WorkflowFailed:    FgRed,
+	wfv1.WorkflowError:     FgRed,
+}
@@ -0,0 +1,124 @@
+package common
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func Test_progressBar(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC)
+	wf := wfv1.MustUnmarshalWorkflow(`
+status:
+  phase: Running
+  progress: 10/40
+  startedAt: "2025-01-01T00:00:00Z"
+  estimatedDuration: 300
+`)
+	assert.Equal(t, "██████████░░░░░░░░░░░░░░░░░░░░░░░░░░░░░░ 10/40, about 4 minutes remaining", progressBar(wf, now))
+
+	NoUtf8 = true
+	defer func() { NoUtf8 = false }()
+	assert.Equal(t, "##########------------------------------ 10/40, about 4 minutes remaining", progressBar(wf, now))
+
+	wf.Status.FinishedAt = wf.Status.StartedAt
+	wf.Status.Progress = "40/40"
+	assert.Equal(t, "######################################## 40/40", progressBar(wf, now))
+
+	wf.Status.Progress = ""
+	assert.Equal(t, "---------------------------------------- 0/0", progressBar(wf, now))
+}
+
+func Test_printRunningNodeEstimates(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 30, 0, 0, time.UTC)
+	wf := wfv1.MustUnmarshalWorkflow(`
+status:
+  nodes:
+    wf:
+      name: wf
+      phase: Running
+      children: [wf-1, wf-2, wf-3, wf-4]
+      startedAt: "2025-01-01T00:00:00Z"
+    wf-1:
+      name: wf.train
+      displayName: train
+      phase: Running
+      startedAt: "2025-01-01T00:10:00Z"
+      estimatedDuration: 600
+      estimatedDurationP90: 1500
+    wf-2:
+      name: wf.evaluate
+      displayName: evaluate
+      phase: Running
+      startedAt: "2025-01-01T00:00:00Z"
+      estimatedDuration: 600
+      estimatedDurationP90: 660
+    wf-3:
+      name: wf.setup
+      displayName: setup
+      phase: Succeeded
+      startedAt: "2025-01-01T00:00:00Z"
+      estimatedDuration: 1
+    wf-4:
+      name: wf.new
+      displayName: new
+      phase: Running
+      startedAt: "2025-01-01T00:00:00Z"
+`)
+	out := &bytes.Buffer{}
+	printRunningNodeEstimates(out, wf, now)
+	assert.Equal(t, `
+RUNNING    DURATION   ESTIMATE   P90   
+evaluate   30m        10m        11m   longer than usual
+train      20m        10m        25m   
+`, out.String())
+
+	out.Reset()
+	printRunningNodeEstimates(out, &wfv1.Workflow{}, now)
+	assert.Empty(t, out.String())
+}
+
+func Test_printWorkflowsDashboard(t *testing.T) {
+	NoColor = true
+	defer func() { NoColor = false }()
+	now := time.Date(2025, 1, 1, 0, 10, 0, 0, time.UTC)
+	workflows := []wfv1.Workflow{
+		*wfv1.MustUnmarshalWorkflow(`
+metadata: {name: nightly-b, namespace: argo, creationTimestamp: "2025-01-01T00:01:00Z"}
+status: {phase: Running, progress: 1/4, startedAt: "2025-01-01T00:01:00Z"}
+`),
+		*wfv1.MustUnmarshalWorkflow(`
+metadata: {name: nightly-a, namespace: argo, creationTimestamp: "2025-01-01T00:00:00Z"}
+status: {phase: Failed, progress: 2/2, startedAt: "2025-01-01T00:00:00Z", finishedAt: "2025-01-01T00:02:00Z", message: "child failed"}
+`),
+		*wfv1.MustUnmarshalWorkflow(`
+metadata: {name: nightly-c, namespace: other, creationTimestamp: "2025-01-01T00:09:00Z"}
+`),
+	}
+	t.Run("Namespace", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		printWorkflowsDashboard(out, workflows, false, now)
+		assert.Equal(t, `NAME        STATUS    PROGRESS                   DURATION   MESSAGE
+nightly-a   Failed    ████████████████████ 2/2   2m         child failed
+nightly-b   Running   █████░░░░░░░░░░░░░░░ 1/4   9m         
+nightly-c   Pending   ░░░░░░░░░░░░░░░░░░░░ 0/0   -          
+
+3 workflows: 1 Failed, 1 Pending, 1 Running
+`, out.String())
+	})
+	t.Run("AllNamespaces", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		printWorkflowsDashboard(out, workflows[2:], true, now)
+		assert.Contains(t, out.String(), "NAMESPACE   NAME        STATUS")
+		assert.Contains(t, out.String(), "other       nightly-c   Pending")
+	})
+	t.Run("None", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		printWorkflowsDashboard(out, nil, false, now)
+		assert.Equal(t, "Waiting for workflows...\n", out.String())
+	})
+}
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"io"
@@ -9,9 +10,11 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient"
@@ -22,11 +25,14 @@ import (
 
 func NewCpCommand() *cobra.Command {
 	var (
-		namespace    string // --namespace
-		nodeID       string // --node-id
-		templateName string // --template-name
-		artifactName string // --artifact-name
-		customPath   string // --path
+		namespace         string // --namespace
+		nodeID            string // --node-id
+		nodeFieldSelector string // --node-field-selector
+		templateName      string // --template-name
+		artifactName      string // --artifact-name
+		customPath        string // --path
+		concurrency       int    // --concurrency
+		resume            bool   // --resume
 	)
 	command := &cobra.Command{
 		Use:   "cp my-wf output-directory ...",
@@ -38,6 +44,14 @@ func NewCpCommand() *cobra.Command {
 # Copy artifacts from a specific node in a workflow to a local output directory:
 
   argo cp my-wf output-directory --node-id=my-wf-node-id-123
+
+# Copy the artifacts named like "result-*" of the succeeded nodes of a fan-out, 16 at a time:
+
+  argo cp my-wf output-directory --node-field-selector phase=Succeeded,templateName=process --artifact-name 'result-*' --concurrency 16
+
+# Resume an interrupted copy, skipping the artifacts which were already copied:
+
+  argo cp my-wf output-directory --resume
 `,
 		SilenceUsage: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -45,6 +59,16 @@ func NewCpCommand() *cobra.Command {
 				cmd.HelpFunc()(cmd, args)
 				return fmt.Errorf("incorrect number of arguments")
 			}
+			if concurrency < 1 {
+				return fmt.Errorf("--concurrency must be at least 1")
+			}
+			if _, err := path.Match(artifactName, ""); err != nil {
+				return fmt.Errorf("invalid --artifact-name pattern: %w", err)
+			}
+			selector, err := wfutil.ParseNodeFieldSelector(nodeFieldSelector)
+			if err != nil {
+				return fmt.Errorf("invalid --node-field-selector: %w", err)
+			}
 			workflowName := args[0]
 			outputDir := args[1]
 
@@ -66,58 +90,103 @@ func NewCpCommand() *cobra.Command {
 			}
 
 			workflowName = workflow.Name
-			artifactSearchQuery := v1alpha1.ArtifactSearchQuery{
-				ArtifactName: artifactName,
-				TemplateName: templateName,
-				NodeId:       nodeID,
+			artifacts, err := searchArtifacts(workflow, v1alpha1.ArtifactSearchQuery{TemplateName: templateName, NodeId: nodeID}, artifactName, selector)
+			if err != nil {
+				return err
 			}
-			artifactSearchResults := workflow.SearchArtifacts(&artifactSearchQuery)
 
 			c := &http.Client{
 				Transport: &http.Transport{
 					TLSClientConfig: &tls.Config{
 						InsecureSkipVerify: client.ArgoServerOpts.InsecureSkipVerify,
 					},
+					MaxIdleConnsPerHost: concurrency,
 				},
 			}
 
-			for _, artifact := range artifactSearchResults {
+			g, ctx := errgroup.WithContext(ctx)
+			g.SetLimit(concurrency)
+			for _, artifact := range artifacts {
 				customPath := filepath.Join(outputDir, customPath)
-				nodeInfo := workflow.Status.Nodes.Find(func(n v1alpha1.NodeStatus) bool { return n.ID == artifact.NodeID })
-				if nodeInfo == nil {
-					return fmt.Errorf("could not get node status for node ID %s", artifact.NodeID)
-				}
-				customPath = strings.Replace(customPath, "{templateName}", wfutil.GetTemplateFromNode(*nodeInfo), 1)
+				customPath = strings.Replace(customPath, "{templateName}", wfutil.GetTemplateFromNode(workflow.Status.Nodes[artifact.NodeID]), 1)
 				customPath = strings.Replace(customPath, "{namespace}", namespace, 1)
 				customPath = strings.Replace(customPath, "{workflowName}", workflowName, 1)
 				customPath = strings.Replace(customPath, "{nodeId}", artifact.NodeID, 1)
 				customPath = strings.Replace(customPath, "{artifactName}", artifact.Name, 1)
-				err = os.MkdirAll(customPath, os.ModePerm)
-				if err != nil {
-					return fmt.Errorf("failed to create folder path: %w", err)
-				}
 				key, err := artifact.GetKey()
 				if err != nil {
 					return fmt.Errorf("error getting key for artifact: %w", err)
 				}
-				err = getAndStoreArtifactData(namespace, workflowName, artifact.NodeID, artifact.Name, path.Base(key), customPath, c, client.ArgoServerOpts)
-				if err != nil {
-					return fmt.Errorf("failed to get and store artifact data: %w", err)
-				}
+				g.Go(func() error {
+					if err := os.MkdirAll(customPath, os.ModePerm); err != nil {
+						return fmt.Errorf("failed to create folder path: %w", err)
+					}
+					if err := getAndStoreArtifactData(ctx, namespace, workflowName, artifact.NodeID, artifact.Name, path.Base(key), customPath, resume, c, client.ArgoServerOpts); err != nil {
+						return fmt.Errorf("failed to get and store artifact data: %w", err)
+					}
+					return nil
+				})
 			}
-			return nil
+			return g.Wait()
 		},
 	}
 	command.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace of workflow")
 	command.Flags().StringVar(&nodeID, "node-id", "", "id of node in workflow")
+	command.Flags().StringVar(&nodeFieldSelector, "node-field-selector", "", "selector of the nodes to copy the artifacts of, eg: --node-field-selector phase=Succeeded,templateName=my-template")
 	command.Flags().StringVar(&templateName, "template-name", "", "name of template in workflow")
-	command.Flags().StringVar(&artifactName, "artifact-name", "", "name of output artifact in workflow")
+	command.Flags().StringVar(&artifactName, "artifact-name", "", "name of output artifact in workflow, or a glob pattern of names, eg: 'result-*'")
 	command.Flags().StringVar(&customPath, "path", "{namespace}/{workflowName}/{nodeId}/outputs/{artifactName}", "use variables {workflowName}, {nodeId}, {templateName}, {artifactName}, and {namespace} to create a customized path to store the artifacts; example: {workflowName}/{templateName}/{artifactName}")
+	command.Flags().IntVar(&concurrency, "concurrency", 4, "number of artifacts to copy at the same time")
+	command.Flags().BoolVar(&resume, "resume", false, "skip the artifacts which were already copied, and resume the partially copied ones if the server supports range requests, or copy them again otherwise")
 	return command
 }
 
-func getAndStoreArtifactData(namespace string, workflowName string, nodeID string, artifactName string, fileName string, customPath string, c *http.Client, argoServerOpts apiclient.ArgoServerOpts) error {
-	request, err := http.NewRequest("GET", fmt.Sprintf("%s/artifacts/%s/%s/%s/%s", argoServerOpts.GetURL(), namespace, workflowName, nodeID, artifactName), nil)
+// searchArtifacts returns the output artifacts matching the query, of the nodes matching the selector, with names
+// matching the pattern
+func searchArtifacts(workflow *v1alpha1.Workflow, query v1alpha1.ArtifactSearchQuery, artifactName string, selector *wfutil.NodeFieldSelector) (v1alpha1.ArtifactSearchResults, error) {
+	var results v1alpha1.ArtifactSearchResults
+	for _, artifact := range workflow.SearchArtifacts(&query) {
+		node, ok := workflow.Status.Nodes[artifact.NodeID]
+		if !ok {
+			return nil, fmt.Errorf("could not get node status for node ID %s", artifact.NodeID)
+		}
+		if !selector.Matches(node) {
+			continue
+		}
+		if artifactName != "" {
+			if match, _ := path.Match(artifactName, artifact.Name); !match {
+				continue
+			}
+		}
+		results = append(results, artifact)
+	}
+	// copy in a stable order, so that progress is easy to follow
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].NodeID != results[j].NodeID {
+			return results[i].NodeID < results[j].NodeID
+		}
+		return results[i].Name < results[j].Name
+	})
+	return results, nil
+}
+
+// getAndStoreArtifactData copies the artifact to a ".partial" file, which is renamed once the copy is complete. When
+// resuming, complete copies are skipped, and the copy of a partial file continues from its end if the server
+// supports range requests.
+func getAndStoreArtifactData(ctx context.Context, namespace string, workflowName string, nodeID string, artifactName string, fileName string, customPath string, resume bool, c *http.Client, argoServerOpts apiclient.ArgoServerOpts) error {
+	artifactFilePath := filepath.Join(customPath, fileName)
+	partialFilePath := artifactFilePath + ".partial"
+	var offset int64
+	if resume {
+		if _, err := os.Stat(artifactFilePath); err == nil {
+			log.Printf("Skipped %q, already copied", artifactFilePath)
+			return nil
+		}
+		if info, err := os.Stat(partialFilePath); err == nil {
+			offset = info.Size()
+		}
+	}
+	request, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/artifacts/%s/%s/%s/%s", argoServerOpts.GetURL(), namespace, workflowName, nodeID, artifactName), nil)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
@@ -126,24 +195,38 @@ func getAndStoreArtifactData(namespace string, workflowName string, nodeID strin
 		return err
 	}
 	request.Header.Set("Authorization", authString)
+	if offset > 0 {
+		request.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
 	resp, err := c.Do(request)
 	if err != nil {
 		return fmt.Errorf("request failed with: %w", err)
 	}
 	defer resp.Body.Close()
-	if resp.StatusCode != 200 {
+	flag := os.O_CREATE | os.O_WRONLY
+	switch resp.StatusCode {
+	case http.StatusOK:
+		// the server ignored the range, so start again
+		flag |= os.O_TRUNC
+	case http.StatusPartialContent:
+		flag |= os.O_APPEND
+	default:
 		return fmt.Errorf("request failed %s", resp.Status)
 	}
-	artifactFilePath := filepath.Join(customPath, fileName)
-	fileWriter, err := os.Create(artifactFilePath)
+	fileWriter, err := os.OpenFile(partialFilePath, flag, 0o644)
 	if err != nil {
 		return fmt.Errorf("creating file failed: %w", err)
 	}
-	defer fileWriter.Close()
 	_, err = io.Copy(fileWriter, resp.Body)
+	if closeErr := fileWriter.Close(); err == nil {
+		err = closeErr
+	}
 	if err != nil {
 		return fmt.Errorf("copying file contents failed: %w", err)
 	}
-	log.Printf("Created %q", fileName)
+	if err := os.Rename(partialFilePath, artifactFilePath); err != nil {
+		return fmt.Errorf("renaming file failed: %w", err)
+	}
+	log.Printf("Created %q", artifactFilePath)
 	return nil
 }
@@ -0,0 +1,119 @@
+package commands
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apiclient"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wfutil "github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+func Test_searchArtifacts(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata: {name: my-wf}
+status:
+  nodes:
+    n1:
+      id: n1
+      phase: Succeeded
+      templateName: process
+      outputs: {artifacts: [{name: result-1}, {name: logs}]}
+    n0:
+      id: n0
+      phase: Succeeded
+      templateName: process
+      outputs: {artifacts: [{name: result-0}]}
+    n2:
+      id: n2
+      phase: Failed
+      templateName: process
+      outputs: {artifacts: [{name: result-2}]}
+`)
+	names := func(results wfv1.ArtifactSearchResults) []string {
+		var names []string
+		for _, r := range results {
+			names = append(names, r.NodeID+"/"+r.Name)
+		}
+		return names
+	}
+	selector := func(s string) *wfutil.NodeFieldSelector {
+		selector, err := wfutil.ParseNodeFieldSelector(s)
+		require.NoError(t, err)
+		return selector
+	}
+	t.Run("All", func(t *testing.T) {
+		results, err := searchArtifacts(wf, wfv1.ArtifactSearchQuery{}, "", selector(""))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"n0/result-0", "n1/logs", "n1/result-1", "n2/result-2"}, names(results))
+	})
+	t.Run("Filtered", func(t *testing.T) {
+		results, err := searchArtifacts(wf, wfv1.ArtifactSearchQuery{}, "result-*", selector("phase=Succeeded"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"n0/result-0", "n1/result-1"}, names(results))
+	})
+	t.Run("Set", func(t *testing.T) {
+		results, err := searchArtifacts(wf, wfv1.ArtifactSearchQuery{}, "result-*", selector("phase in (Failed,Error)"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"n2/result-2"}, names(results))
+	})
+}
+
+func Test_getAndStoreArtifactData(t *testing.T) {
+	t.Setenv("ARGO_TOKEN", "Bearer my-token")
+	const content = "0123456789"
+	var ranges []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/artifacts/my-ns/my-wf/n0/result", r.URL.Path)
+		assert.Equal(t, "Bearer my-token", r.Header.Get("Authorization"))
+		ranges = append(ranges, r.Header.Get("Range"))
+		if r.Header.Get("Range") == "bytes=4-" {
+			w.WriteHeader(http.StatusPartialContent)
+			_, _ = w.Write([]byte(content[4:]))
+			return
+		}
+		_, _ = w.Write([]byte(content))
+	}))
+	defer server.Close()
+	opts := apiclient.ArgoServerOpts{URL: strings.TrimPrefix(server.URL, "http://")}
+	get := func(dir string, resume bool) {
+		require.NoError(t, getAndStoreArtifactData(t.Context(), "my-ns", "my-wf", "n0", "result", "result.tgz", dir, resume, server.Client(), opts))
+		data, err := os.ReadFile(filepath.Join(dir, "result.tgz"))
+		require.NoError(t, err)
+		assert.Equal(t, content, string(data))
+		assert.NoFileExists(t, filepath.Join(dir, "result.tgz.partial"))
+	}
+	t.Run("Copy", func(t *testing.T) {
+		ranges = nil
+		get(t.TempDir(), false)
+		assert.Equal(t, []string{""}, ranges)
+	})
+	t.Run("ResumePartial", func(t *testing.T) {
+		ranges = nil
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "result.tgz.partial"), []byte(content[:4]), 0o644))
+		get(dir, true)
+		assert.Equal(t, []string{"bytes=4-"}, ranges)
+	})
+	t.Run("ResumeIgnoredRange", func(t *testing.T) {
+		ranges = nil
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "result.tgz.partial"), []byte("012345"), 0o644))
+		get(dir, true)
+		assert.Equal(t, []string{"bytes=6-"}, ranges)
+	})
+	t.Run("ResumeComplete", func(t *testing.T) {
+		ranges = nil
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "result.tgz"), []byte(content), 0o644))
+		get(dir, true)
+		assert.Empty(t, ranges)
+	})
+}
@@ -3,6 +3,7 @@ package cron
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"text/tabwriter"
 	"time"
@@ -15,6 +16,7 @@ import (
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/humanize"
+	"github.com/argoproj/argo-workflows/v3/util/printer"
 )
 
 type listFlags struct {
@@ -25,7 +27,7 @@ type listFlags struct {
 
 func NewListCommand() *cobra.Command {
 	var listArgs = listFlags{
-		output: common.EnumFlagValue{AllowedValues: []string{"wide", "name"}},
+		output: common.WithTemplateOutputs(common.EnumFlagValue{AllowedValues: []string{"wide", "name"}}),
 	}
 	command := &cobra.Command{
 		Use:   "list",
@@ -52,15 +54,17 @@ func NewListCommand() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			switch listArgs.output.String() {
-			case "", "wide":
-				printTable(ctx, cronWfList.Items, &listArgs)
-			case "name":
+			switch output := listArgs.output.String(); {
+			case printer.IsTemplateOutput(output):
+				return printer.PrintTemplate(os.Stdout, output, cronWfList)
+			case output == "" || output == "wide":
+				printTable(ctx, os.Stdout, cronWfList.Items, &listArgs, time.Now())
+			case output == "name":
 				for _, cronWf := range cronWfList.Items {
 					fmt.Println(cronWf.Name)
 				}
 			default:
-				return fmt.Errorf("unknown output mode: %s", listArgs.output.String())
+				return fmt.Errorf("unknown output mode: %s", output)
 			}
 			return nil
 		},
@@ -71,12 +75,12 @@ func NewListCommand() *cobra.Command {
 	return command
 }
 
-func printTable(ctx context.Context, wfList []wfv1.CronWorkflow, listArgs *listFlags) {
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+func printTable(ctx context.Context, out io.Writer, wfList []wfv1.CronWorkflow, listArgs *listFlags, now time.Time) {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
 	if listArgs.allNamespaces {
 		_, _ = fmt.Fprint(w, "NAMESPACE\t")
 	}
-	_, _ = fmt.Fprint(w, "NAME\tAGE\tLAST RUN\tNEXT RUN\tSCHEDULES\tTIMEZONE\tSUSPENDED")
+	_, _ = fmt.Fprint(w, "NAME\tAGE\tLAST RUN\tNEXT RUN\tLAST SUCCESS\tFAILURES\tSCHEDULES\tTIMEZONE\tSUSPENDED\tHEALTH")
 	_, _ = fmt.Fprint(w, "\n")
 	for _, cwf := range wfList {
 		if listArgs.allNamespaces {
@@ -84,17 +88,25 @@ func printTable(ctx context.Context, wfList []wfv1.CronWorkflow, listArgs *listF
 		}
 		var cleanLastScheduledTime string
 		if cwf.Status.LastScheduledTime != nil {
-			cleanLastScheduledTime = humanize.RelativeDurationShort(cwf.Status.LastScheduledTime.Time, time.Now())
+			cleanLastScheduledTime = humanize.RelativeDurationShort(cwf.Status.LastScheduledTime.Time, now)
 		} else {
 			cleanLastScheduledTime = "N/A"
 		}
 		var cleanNextScheduledTime string
 		if next, err := GetNextRuntime(ctx, &cwf); err == nil {
-			cleanNextScheduledTime = humanize.RelativeDurationShort(next, time.Now())
+			cleanNextScheduledTime = "in " + humanize.RelativeDurationShort(now, next)
 		} else {
 			cleanNextScheduledTime = "N/A"
 		}
-		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%t", cwf.Name, humanize.RelativeDurationShort(cwf.CreationTimestamp.Time, time.Now()), cleanLastScheduledTime, cleanNextScheduledTime, cwf.Spec.GetScheduleString(), cwf.Spec.Timezone, cwf.Spec.Suspend)
+		cleanLastSuccessfulTime := "N/A"
+		if cwf.Status.LastSuccessfulTime != nil {
+			cleanLastSuccessfulTime = humanize.RelativeDurationShort(cwf.Status.LastSuccessfulTime.Time, now)
+		}
+		health := "OK"
+		if isUnhealthy(&cwf) {
+			health = "WARNING"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%s\t%s\t%t\t%s", cwf.Name, humanize.RelativeDurationShort(cwf.CreationTimestamp.Time, now), cleanLastScheduledTime, cleanNextScheduledTime, cleanLastSuccessfulTime, cwf.Status.ConsecutiveFailures, cwf.Spec.GetScheduleString(), cwf.Spec.Timezone, cwf.Spec.Suspend, health)
 		_, _ = fmt.Fprintf(w, "\n")
 	}
 	_ = w.Flush()
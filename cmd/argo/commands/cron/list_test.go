@@ -0,0 +1,41 @@
+package cron
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func Test_printTable(t *testing.T) {
+	now := time.Now()
+	healthy := wfv1.MustUnmarshalCronWorkflow(invalidCwf)
+	healthy.Name = "healthy"
+	healthy.CreationTimestamp = metav1.NewTime(now.Add(-48 * time.Hour))
+	healthy.Status.LastScheduledTime = &metav1.Time{Time: now.Add(-time.Minute)}
+	healthy.Status.LastSuccessfulTime = &metav1.Time{Time: now.Add(-2 * time.Hour)}
+	healthy.Status.ConsecutiveFailures = 1
+	broken := healthy.DeepCopy()
+	broken.Name = "broken"
+	broken.Status.LastSuccessfulTime = nil
+	broken.Status.ConsecutiveFailures = 5
+
+	out := &bytes.Buffer{}
+	printTable(logging.TestContext(t.Context()), out, []wfv1.CronWorkflow{*healthy, *broken}, &listFlags{}, now)
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, []string{"NAME", "AGE", "LAST", "RUN", "NEXT", "RUN", "LAST", "SUCCESS", "FAILURES", "SCHEDULES", "TIMEZONE", "SUSPENDED", "HEALTH"}, strings.Fields(lines[0]))
+	// the next run is within the minute, so is the only column that depends on the wall clock
+	fields := strings.Fields(lines[1])
+	assert.Equal(t, "in", fields[3])
+	assert.Equal(t, []string{"healthy", "2d", "1m", "2h", "1", "*", "*", "*", "*", "*", "false", "OK"}, append(fields[:3], fields[5:]...))
+	fields = strings.Fields(lines[2])
+	assert.Equal(t, []string{"broken", "2d", "1m", "N/A", "5", "*", "*", "*", "*", "*", "false", "WARNING"}, append(fields[:3], fields[5:]...))
+}
@@ -37,6 +37,14 @@ func GetNextRuntime(ctx context.Context, cwf *v1alpha1.CronWorkflow) (time.Time,
 	return nextRunTime, nil
 }
 
+// consecutiveFailuresWarning is how many child workflows in a row must fail for a cron workflow to be reported as unhealthy
+const consecutiveFailuresWarning = 3
+
+// isUnhealthy returns true if the last runs of the cron workflow all failed
+func isUnhealthy(cwf *v1alpha1.CronWorkflow) bool {
+	return cwf.Status.ConsecutiveFailures >= consecutiveFailuresWarning
+}
+
 func generateCronWorkflows(ctx context.Context, filePaths []string, strict bool) []v1alpha1.CronWorkflow {
 	fileContents, err := util.ReadManifest(filePaths...)
 	if err != nil {
@@ -119,6 +127,16 @@ func getCronWorkflowGet(ctx context.Context, cwf *v1alpha1.CronWorkflow) string
 		out += fmt.Sprintf(fmtStr, "NextScheduledTime:", humanize.Timestamp(next)+" (assumes workflow-controller is in UTC)")
 	}
 
+	if cwf.Status.LastSuccessfulTime != nil {
+		out += fmt.Sprintf(fmtStr, "LastSuccessfulTime:", humanize.Timestamp(cwf.Status.LastSuccessfulTime.Time))
+	}
+	if cwf.Status.ConsecutiveFailures > 0 {
+		out += fmt.Sprintf(fmtStr, "ConsecutiveFailures:", cwf.Status.ConsecutiveFailures)
+	}
+	if isUnhealthy(cwf) {
+		out += fmt.Sprintf(fmtStr, "WARNING:", fmt.Sprintf("the last %d workflows failed", cwf.Status.ConsecutiveFailures))
+	}
+
 	if len(cwf.Status.Active) > 0 {
 		var activeWfNames []string
 		for _, activeWf := range cwf.Status.Active {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
@@ -104,3 +105,19 @@ func TestNextRuntimeWithMultipleSchedules(t *testing.T) {
 	assert.LessOrEqual(t, next.Unix(), time.Now().Add(1*time.Minute).Unix())
 	assert.Greater(t, next.Unix(), time.Now().Unix())
 }
+
+func TestPrintCronWorkflowHealth(t *testing.T) {
+	cronWf := v1alpha1.MustUnmarshalCronWorkflow(invalidCwf)
+	ctx := logging.TestContext(t.Context())
+	out := getCronWorkflowGet(ctx, cronWf)
+	assert.NotContains(t, out, "LastSuccessfulTime:")
+	assert.NotContains(t, out, "WARNING:")
+
+	cronWf.Status.LastSuccessfulTime = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+	cronWf.Status.ConsecutiveFailures = 3
+	out = getCronWorkflowGet(ctx, cronWf)
+	assert.Contains(t, out, "LastSuccessfulTime:")
+	assert.Contains(t, out, "(1 hour ago)")
+	assert.Regexp(t, `ConsecutiveFailures: +3\n`, out)
+	assert.Regexp(t, `WARNING: +the last 3 workflows failed\n`, out)
+}
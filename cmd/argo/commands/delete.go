@@ -3,6 +3,7 @@ package commands
 import (
 	"errors"
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/codes"
@@ -10,6 +11,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
@@ -37,6 +39,10 @@ func NewDeleteCommand() *cobra.Command {
 # Delete the latest workflow:
 
   argo delete @latest
+
+# Print the workflows which would be deleted, and their phases, without deleting them:
+
+  argo delete --completed --older 7d --dry-run
 `,
 		Args: func(cmd *cobra.Command, args []string) error {
 			if len(args) == 0 && !hasFilterFlag() {
@@ -56,6 +62,18 @@ func NewDeleteCommand() *cobra.Command {
 				flags.namespace = client.Namespace(ctx)
 			}
 			for _, name := range args {
+				if dryRun {
+					// get the workflow, so that its phase can be reported
+					wf, err := serviceClient.GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{Name: name, Namespace: flags.namespace})
+					if status.Code(err) == codes.NotFound {
+						fmt.Printf("Workflow '%s' not found\n", name)
+						continue
+					} else if err != nil {
+						return err
+					}
+					workflows = append(workflows, *wf)
+					continue
+				}
 				workflows = append(workflows, wfv1.Workflow{
 					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: flags.namespace},
 				})
@@ -73,12 +91,12 @@ func NewDeleteCommand() *cobra.Command {
 				return nil
 			}
 
-			for _, wf := range workflows {
-				if dryRun {
-					fmt.Printf("Workflow '%s' deleted (dry-run)\n", wf.Name)
-					continue
-				}
+			if dryRun {
+				common.PrintDeleteDryRun(os.Stdout, workflows, false)
+				return nil
+			}
 
+			for _, wf := range workflows {
 				_, err := serviceClient.DeleteWorkflow(ctx, &workflowpkg.WorkflowDeleteRequest{Name: wf.Name, Namespace: wf.Namespace, Force: force})
 				if err != nil {
 					if status.Code(err) == codes.NotFound {
@@ -105,7 +123,7 @@ func NewDeleteCommand() *cobra.Command {
 	command.Flags().StringVar(&flags.finishedBefore, "older", "", "Delete completed workflows finished before the specified duration (e.g. 10m, 3h, 1d)")
 	command.Flags().StringSliceVar(&flags.status, "status", []string{}, "Delete by status (comma separated)")
 	command.Flags().Int64VarP(&flags.chunkSize, "query-chunk-size", "", 0, "Run the list query in chunks (deletes will still be executed individually)")
-	command.Flags().BoolVar(&dryRun, "dry-run", false, "Do not delete the workflow, only print what would happen")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Do not delete the workflows, only print which workflows would be deleted, their phases, and how many there are")
 	command.Flags().BoolVar(&force, "force", false, "Force delete workflows by removing finalizers")
 	return command
 }
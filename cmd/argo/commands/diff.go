@@ -0,0 +1,192 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	"github.com/argoproj/argo-workflows/v3/pkg/apiclient"
+	clusterworkflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wfcommon "github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// diffSide is a spec to diff, and what it is the spec of
+type diffSide struct {
+	name string
+	spec *wfv1.WorkflowSpec
+}
+
+func NewDiffCommand() *cobra.Command {
+	var files []string
+	command := &cobra.Command{
+		Use:   "diff [wf/NAME...]",
+		Short: "show the differences between the specs of workflows, templates and files",
+		Long: `Show the differences between the specs of two workflows or manifest files, or between a workflow and the revision
+of the workflow template it was created from.
+
+The differences are of fields rather than lines, with the items of lists of named objects, such as templates,
+parameters and DAG tasks, matched by name. Empty fields, such as those defaulted to their zero value, are the same as
+unset fields. Added fields are prefixed with +, removed fields with -, and changed fields with ~.`,
+		Example: `# Show what changed in a workflow since the revision of the workflow template it was created from:
+
+  argo diff wf/my-wf
+
+# Show what changed between yesterday's run and today's:
+
+  argo diff wf/my-wf-yesterday wf/my-wf-today
+
+# Show the differences between two files:
+
+  argo diff -f a.yaml -f b.yaml
+
+# Show the differences between a workflow and a file:
+
+  argo diff wf/my-wf -f my-wf.yaml
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args)+len(files) == 0 || len(args)+len(files) > 2 {
+				return errors.New("a workflow and the revision of its template, or two workflows or files, are required")
+			}
+			ctx := cmd.Context()
+			var sides []diffSide
+			if len(args) > 0 {
+				ctx, apiClient, err := client.NewAPIClient(ctx)
+				if err != nil {
+					return err
+				}
+				namespace := client.Namespace(ctx)
+				for _, arg := range args {
+					name, ok := strings.CutPrefix(arg, "wf/")
+					if !ok {
+						name, ok = strings.CutPrefix(arg, "workflow/")
+					}
+					if !ok {
+						return fmt.Errorf("expected wf/NAME, got %q", arg)
+					}
+					wf, err := apiClient.NewWorkflowServiceClient(ctx).GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{Namespace: namespace, Name: name})
+					if err != nil {
+						return err
+					}
+					if len(args)+len(files) == 1 {
+						revision, err := getTemplateRevisionSide(ctx, apiClient, wf)
+						if err != nil {
+							return err
+						}
+						sides = append(sides, *revision)
+					}
+					spec := wf.Status.StoredWorkflowSpec
+					if spec == nil {
+						spec = &wf.Spec
+					}
+					sides = append(sides, diffSide{name: "wf/" + wf.Name, spec: spec})
+				}
+			}
+			for _, file := range files {
+				side, err := getFileSide(ctx, file)
+				if err != nil {
+					return err
+				}
+				sides = append(sides, *side)
+			}
+			diffs, err := common.DiffWorkflowSpecs(sides[0].spec, sides[1].spec)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("--- %s\n+++ %s\n", sides[0].name, sides[1].name)
+			if len(diffs) == 0 {
+				fmt.Println("no differences")
+				return nil
+			}
+			common.PrintSpecDifferences(os.Stdout, diffs)
+			return nil
+		},
+	}
+	command.Flags().StringArrayVarP(&files, "file", "f", nil, "A manifest file of a workflow, workflow template, cluster workflow template or cron workflow to diff")
+	command.Flags().BoolVar(&common.NoColor, "no-color", false, "Disable colorized output")
+	return command
+}
+
+// getTemplateRevisionSide returns the revision of the workflow template the workflow was created from
+func getTemplateRevisionSide(ctx context.Context, apiClient apiclient.Client, wf *wfv1.Workflow) (*diffSide, error) {
+	ref := wf.Spec.WorkflowTemplateRef
+	if ref == nil {
+		return nil, fmt.Errorf("workflow %s was not created from a workflow template, diff it with another workflow or file", wf.Name)
+	}
+	digest := wf.Annotations[wfcommon.AnnotationKeyWorkflowTemplateDigest]
+	if digest == "" {
+		return nil, fmt.Errorf("the revision of the template of workflow %s was not recorded", wf.Name)
+	}
+	var revisions *wfv1.WorkflowTemplateRevisionList
+	kind := "wftmpl"
+	if ref.ClusterScope {
+		kind = "cwftmpl"
+		serviceClient, err := apiClient.NewClusterWorkflowTemplateServiceClient()
+		if err != nil {
+			return nil, err
+		}
+		revisions, err = serviceClient.ListClusterWorkflowTemplateRevisions(ctx, &clusterworkflowtemplatepkg.ClusterWorkflowTemplateRevisionsRequest{Name: ref.Name})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		serviceClient, err := apiClient.NewWorkflowTemplateServiceClient()
+		if err != nil {
+			return nil, err
+		}
+		revisions, err = serviceClient.ListWorkflowTemplateRevisions(ctx, &workflowtemplatepkg.WorkflowTemplateRevisionsRequest{Namespace: wf.Namespace, Name: ref.Name})
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, revision := range revisions.Items {
+		if revision.Digest == digest {
+			return &diffSide{name: fmt.Sprintf("%s/%s (revision %d)", kind, ref.Name, revision.Revision), spec: &revision.Spec}, nil
+		}
+	}
+	return nil, fmt.Errorf("revision %s of %s/%s was not found, it may have been deleted", digest, kind, ref.Name)
+}
+
+// getFileSide returns the spec of the only workflow, workflow template, cluster workflow template or cron workflow of
+// the file
+func getFileSide(ctx context.Context, file string) (*diffSide, error) {
+	fileContents, err := util.ReadManifest(file)
+	if err != nil {
+		return nil, err
+	}
+	var specs []*wfv1.WorkflowSpec
+	for _, body := range fileContents {
+		for _, res := range wfcommon.ParseObjects(ctx, body, false) {
+			if res.Err != nil {
+				return nil, fmt.Errorf("failed to parse %s: %w", file, res.Err)
+			}
+			var spec *wfv1.WorkflowSpec
+			switch v := res.Object.(type) {
+			case *wfv1.Workflow:
+				spec = &v.Spec
+			case *wfv1.WorkflowTemplate:
+				spec = &v.Spec
+			case *wfv1.ClusterWorkflowTemplate:
+				spec = &v.Spec
+			case *wfv1.CronWorkflow:
+				spec = &v.Spec.WorkflowSpec
+			default:
+				continue
+			}
+			specs = append(specs, spec)
+		}
+	}
+	if len(specs) != 1 {
+		return nil, fmt.Errorf("%s must contain exactly one workflow, workflow template, cluster workflow template or cron workflow, not %d", file, len(specs))
+	}
+	return &diffSide{name: file, spec: specs[0]}, nil
+}
@@ -0,0 +1,42 @@
+package executorplugin
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	executorpluginpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/executorplugin"
+)
+
+func NewDisableCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "disable PLUGIN...",
+		Short: "disable an executor plugin, so that agent pods created from now on no longer run it",
+		Example: `# Disable an executor plugin:
+  argo executor-plugin disable hello
+`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewExecutorPluginServiceClient()
+			if err != nil {
+				return err
+			}
+			for _, name := range args {
+				if _, err := serviceClient.DisableExecutorPlugin(ctx, &executorpluginpkg.DisableExecutorPluginRequest{
+					Namespace: client.Namespace(ctx),
+					Name:      name,
+				}); err != nil {
+					return err
+				}
+				fmt.Printf("Executor plugin '%s' disabled\n", name)
+			}
+			return nil
+		},
+	}
+	return command
+}
@@ -0,0 +1,87 @@
+package executorplugin
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	executorpluginpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/executorplugin"
+	"github.com/argoproj/argo-workflows/v3/util/humanize"
+)
+
+func NewGetCommand() *cobra.Command {
+	output := common.EnumFlagValue{AllowedValues: []string{"json", "yaml"}}
+	command := &cobra.Command{
+		Use:   "get PLUGIN",
+		Short: "display the details of an executor plugin",
+		Example: `# Get an executor plugin:
+  argo executor-plugin get hello
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewExecutorPluginServiceClient()
+			if err != nil {
+				return err
+			}
+			p, err := serviceClient.GetExecutorPlugin(ctx, &executorpluginpkg.GetExecutorPluginRequest{
+				Namespace: client.Namespace(ctx),
+				Name:      args[0],
+			})
+			if err != nil {
+				return err
+			}
+			switch output.String() {
+			case "json":
+				data, err := json.MarshalIndent(p, "", "    ")
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(data))
+			case "yaml":
+				data, err := yaml.Marshal(p)
+				if err != nil {
+					return err
+				}
+				fmt.Print(string(data))
+			default:
+				printPlugin(p)
+			}
+			return nil
+		},
+	}
+	command.Flags().VarP(&output, "output", "o", "Output format. "+output.Usage())
+	return command
+}
+
+func printPlugin(p *executorpluginpkg.ExecutorPlugin) {
+	const fmtStr = "%-20s %v\n"
+	fmt.Printf(fmtStr, "Name:", p.Name)
+	fmt.Printf(fmtStr, "Namespace:", p.Namespace)
+	fmt.Printf(fmtStr, "ConfigMap:", p.ConfigMap)
+	fmt.Printf(fmtStr, "Image:", p.Image)
+	fmt.Printf(fmtStr, "Version:", p.Version)
+	if p.Needs != "" {
+		fmt.Printf(fmtStr, "Needs:", p.Needs)
+	}
+	if p.Description != "" {
+		fmt.Printf(fmtStr, "Description:", p.Description)
+	}
+	fmt.Printf(fmtStr, "Enabled:", p.Enabled)
+	fmt.Printf(fmtStr, "Health:", fmt.Sprintf("%s (%s)", p.Health.Status, p.Health.Message))
+	stats := p.InvocationStats
+	fmt.Printf(fmtStr, "Invocations:", stats.Invocations)
+	fmt.Printf(fmtStr, "Succeeded:", stats.Succeeded)
+	fmt.Printf(fmtStr, "Failed:", stats.Failed)
+	fmt.Printf(fmtStr, "Errored:", stats.Errored)
+	if stats.LastInvokedAt != nil {
+		fmt.Printf(fmtStr, "Last Invoked:", humanize.Timestamp(stats.LastInvokedAt.Time))
+	}
+}
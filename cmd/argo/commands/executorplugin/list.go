@@ -0,0 +1,94 @@
+package executorplugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	executorpluginpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/executorplugin"
+	"github.com/argoproj/argo-workflows/v3/util/humanize"
+)
+
+func NewListCommand() *cobra.Command {
+	output := common.EnumFlagValue{AllowedValues: []string{"wide", "name", "json", "yaml"}}
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "list the executor plugins installed in the namespace, with their health and invocation stats",
+		Example: `# List the executor plugins in the namespace:
+  argo executor-plugin list
+
+# List the executor plugins with their images and descriptions:
+  argo executor-plugin list -o wide
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewExecutorPluginServiceClient()
+			if err != nil {
+				return err
+			}
+			plugins, err := serviceClient.ListExecutorPlugins(ctx, &executorpluginpkg.ListExecutorPluginsRequest{Namespace: client.Namespace(ctx)})
+			if err != nil {
+				return err
+			}
+			switch output := output.String(); {
+			case output == "json":
+				data, err := json.MarshalIndent(plugins.Items, "", "    ")
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(data))
+			case output == "yaml":
+				data, err := yaml.Marshal(plugins.Items)
+				if err != nil {
+					return err
+				}
+				fmt.Print(string(data))
+			case output == "" || output == "wide":
+				printTable(os.Stdout, plugins.Items, output == "wide", time.Now())
+			case output == "name":
+				for _, p := range plugins.Items {
+					fmt.Println(p.Name)
+				}
+			default:
+				return fmt.Errorf("unknown output mode: %s", output)
+			}
+			return nil
+		},
+	}
+	command.Flags().VarP(&output, "output", "o", "Output format. "+output.Usage())
+	return command
+}
+
+func printTable(out io.Writer, plugins []*executorpluginpkg.ExecutorPlugin, wide bool, now time.Time) {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprint(w, "NAME\tVERSION\tENABLED\tHEALTH\tINVOCATIONS\tFAILED\tLAST INVOKED")
+	if wide {
+		_, _ = fmt.Fprint(w, "\tIMAGE\tNEEDS\tDESCRIPTION")
+	}
+	_, _ = fmt.Fprint(w, "\n")
+	for _, p := range plugins {
+		stats := p.InvocationStats
+		lastInvoked := "N/A"
+		if stats.LastInvokedAt != nil {
+			lastInvoked = humanize.RelativeDurationShort(stats.LastInvokedAt.Time, now)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%t\t%s\t%d\t%d\t%s", p.Name, p.Version, p.Enabled, p.Health.Status, stats.Invocations, stats.Failed+stats.Errored, lastInvoked)
+		if wide {
+			_, _ = fmt.Fprintf(w, "\t%s\t%s\t%s", p.Image, p.Needs, p.Description)
+		}
+		_, _ = fmt.Fprint(w, "\n")
+	}
+	_ = w.Flush()
+}
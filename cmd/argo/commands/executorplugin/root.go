@@ -14,6 +14,10 @@ func NewRootCommand() *cobra.Command {
 	}
 
 	command.AddCommand(NewBuildCommand())
+	command.AddCommand(NewListCommand())
+	command.AddCommand(NewGetCommand())
+	command.AddCommand(NewEnableCommand())
+	command.AddCommand(NewDisableCommand())
 
 	return command
 }
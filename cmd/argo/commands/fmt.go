@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	fileutil "github.com/argoproj/argo-workflows/v3/util/file"
+)
+
+func NewFmtCommand() *cobra.Command {
+	var check bool
+	command := &cobra.Command{
+		Use:   "fmt FILE...",
+		Short: "format files or directories of manifests canonically",
+		Long: `Format the workflows, workflow templates, cluster workflow templates and cron workflows of YAML files or
+directories of manifests canonically, rewriting the files that are not.
+
+Manifests are parsed into the typed API, so unknown fields are an error. Empty fields, such as those defaulted to their
+zero value, are dropped unless they were set explicitly. Keys are ordered with apiVersion, kind, metadata and spec
+first, and name first within objects, followed by the other keys alphabetically. Comments are kept. Other manifests,
+such as config maps, are left as they are.`,
+		Example: `# Format all manifests in a directory:
+
+  argo fmt ./manifests
+
+# Check that all manifests in a directory are formatted, e.g. in CI, without rewriting them:
+
+  argo fmt --check ./manifests
+
+# Format a manifest from stdin to stdout:
+
+  cat my-wf.yaml | argo fmt -
+`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			var unformatted []string
+			for _, arg := range args {
+				err := fileutil.WalkManifests(ctx, arg, func(path string, data []byte) error {
+					if filepath.Ext(path) == ".json" {
+						return nil
+					}
+					formatted, err := common.FormatManifests(ctx, data)
+					if err != nil {
+						return fmt.Errorf("%s: %w", path, err)
+					}
+					switch {
+					case check:
+						if !bytes.Equal(data, formatted) {
+							unformatted = append(unformatted, path)
+						}
+						return nil
+					case path == "stdin":
+						_, err = os.Stdout.Write(formatted)
+						return err
+					case bytes.Equal(data, formatted):
+						return nil
+					}
+					info, err := os.Stat(path)
+					if err != nil {
+						return err
+					}
+					if err := os.WriteFile(path, formatted, info.Mode()); err != nil {
+						return err
+					}
+					fmt.Println(path)
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+			}
+			if len(unformatted) > 0 {
+				for _, path := range unformatted {
+					fmt.Println(path)
+				}
+				return fmt.Errorf("%d file(s) are not formatted, run argo fmt to format them", len(unformatted))
+			}
+			return nil
+		},
+	}
+	command.Flags().BoolVar(&check, "check", false, "List the files that are not formatted, and fail if there are any, without rewriting them")
+	return command
+}
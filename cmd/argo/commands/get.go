@@ -3,6 +3,7 @@ package commands
 import (
 	"encoding/json"
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 	"sigs.k8s.io/yaml"
@@ -11,13 +12,14 @@ import (
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/printer"
 )
 
 func NewGetCommand() *cobra.Command {
 	var getArgs = common.GetFlags{
-		Output: common.EnumFlagValue{
-			AllowedValues: []string{"name", "json", "yaml", "short", "wide"},
-		},
+		Output: common.WithTemplateOutputs(common.EnumFlagValue{
+			AllowedValues: []string{"name", "json", "yaml", "short", "wide", "mermaid", "dot"},
+		}),
 	}
 
 	command := &cobra.Command{
@@ -29,6 +31,19 @@ func NewGetCommand() *cobra.Command {
 
 # Get the latest workflow:
   argo get @latest
+
+# Display the recommended requests and limits of each template, from the peak resource usage of its pods:
+  argo get my-wf --recommendations
+
+# Render the nodes of a workflow as a Mermaid diagram:
+  argo get my-wf -o mermaid
+
+# Render the nodes of a workflow as an image, using Graphviz:
+  argo get my-wf -o dot | dot -Tsvg > my-wf.svg
+
+# Print the phase of a workflow, using a JSONPath or Go template:
+  argo get my-wf -o jsonpath='{.status.phase}'
+  argo get my-wf -o go-template='{{.status.phase}}'
 `,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -60,10 +75,14 @@ func NewGetCommand() *cobra.Command {
 	command.Flags().BoolVar(&common.NoUtf8, "no-utf8", false, "Use plain 7-bits ascii characters")
 	command.Flags().StringVar(&getArgs.Status, "status", "", "Filter by status (Pending, Running, Succeeded, Skipped, Failed, Error)")
 	command.Flags().StringVar(&getArgs.NodeFieldSelectorString, "node-field-selector", "", "selector of node to display, eg: --node-field-selector phase=abc")
+	command.Flags().BoolVar(&getArgs.Recommendations, "recommendations", false, "Display the recommended requests and limits of each template, from the peak resource usage of its pods in this and prior archived workflows")
 	return command
 }
 
 func printWorkflow(wf *wfv1.Workflow, getArgs common.GetFlags) error {
+	if printer.IsTemplateOutput(getArgs.Output.String()) {
+		return printer.PrintTemplate(os.Stdout, getArgs.Output.String(), wf)
+	}
 	switch getArgs.Output.String() {
 	case "name":
 		fmt.Println(wf.Name)
@@ -75,6 +94,12 @@ func printWorkflow(wf *wfv1.Workflow, getArgs common.GetFlags) error {
 		fmt.Print(string(outBytes))
 	case "short", "wide", "":
 		fmt.Print(common.PrintWorkflowHelper(wf, getArgs))
+	case "mermaid", "dot":
+		out, err := common.PrintWorkflowGraph(wf, getArgs.Output.String())
+		if err != nil {
+			return err
+		}
+		fmt.Print(out)
 	default:
 		return fmt.Errorf("unknown output format: %s", getArgs.Output)
 	}
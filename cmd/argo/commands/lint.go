@@ -2,8 +2,10 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -12,6 +14,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/lint"
 	wf "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	"github.com/argoproj/argo-workflows/v3/workflow/podsecurity"
 )
 
 var allKinds = []string{wf.WorkflowPlural, wf.WorkflowTemplatePlural, wf.CronWorkflowPlural, wf.ClusterWorkflowTemplatePlural}
@@ -21,10 +24,13 @@ func NewLintCommand() *cobra.Command {
 		strict    bool
 		lintKinds []string
 		output    = common.EnumFlagValue{
-			AllowedValues: []string{"pretty", "simple"},
+			AllowedValues: []string{"pretty", "simple", "json", "sarif"},
 			Value:         "pretty",
 		}
-		offline bool
+		offline             bool
+		templatesDirs       []string
+		podSecurityStandard = common.EnumFlagValue{AllowedValues: []string{podsecurity.Restricted}}
+		rulesFiles          []string
 	)
 
 	command := &cobra.Command{
@@ -37,25 +43,47 @@ func NewLintCommand() *cobra.Command {
 
 # Lint only manifests of Workflows and CronWorkflows from stdin:
 
-  cat manifests.yaml | argo lint --kinds=workflows,cronworkflows -`,
+  cat manifests.yaml | argo lint --kinds=workflows,cronworkflows -
+
+# Lint manifests against the restricted Pod Security Standard:
+
+  argo lint --pod-security-standard=restricted ./manifests
+
+# Lint manifests offline against the CRD schemas, resolving referenced templates from a directory:
+
+  argo lint --offline --templates-dir ./templates ./workflows
+
+# Lint manifests against custom rules, reporting the results as SARIF for CI:
+
+  argo lint --rules ./lint-rules.yaml -o sarif ./manifests`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runLint(cmd.Context(), args, offline, lintKinds, output.String(), strict)
+			if len(templatesDirs) > 0 && !offline {
+				return errors.New("--templates-dir can only be used with --offline")
+			}
+			rules, err := lint.LoadRules(rulesFiles)
+			if err != nil {
+				return err
+			}
+			return runLint(cmd.Context(), args, offline, templatesDirs, lintKinds, output.String(), strict, podSecurityStandard.String(), rules)
 		},
 	}
 
 	command.Flags().StringSliceVar(&lintKinds, "kinds", []string{"all"}, fmt.Sprintf("Which kinds will be linted. Can be: %s", strings.Join(allKinds, "|")))
 	command.Flags().VarP(&output, "output", "o", "Linting results output format. "+output.Usage())
 	command.Flags().BoolVar(&strict, "strict", true, "Perform strict workflow validation")
-	command.Flags().BoolVar(&offline, "offline", false, "perform offline linting. For resources referencing other resources, the references will be resolved from the provided args")
+	command.Flags().BoolVar(&offline, "offline", false, "perform offline linting. For resources referencing other resources, the references will be resolved from the provided args. With --strict, manifests are validated against the CRD schemas of this version")
+	command.Flags().StringSliceVar(&templatesDirs, "templates-dir", nil, "Files or directories of templates to resolve references from when linting offline, which are not linted themselves")
 	command.Flags().BoolVar(&common.NoColor, "no-color", false, "Disable colorized output")
+	command.Flags().Var(&podSecurityStandard, "pod-security-standard", "Validate the security settings of manifests against a Pod Security Standard. "+podSecurityStandard.Usage())
+	command.Flags().StringArrayVar(&rulesFiles, "rules", nil, "Files of custom rules to check manifests against, as CEL expressions or Go plugins. Only rules of severity error fail linting")
 
 	return command
 }
 
-func runLint(ctx context.Context, args []string, offline bool, lintKinds []string, output string, strict bool) error {
+func runLint(ctx context.Context, args []string, offline bool, templatesDirs []string, lintKinds []string, output string, strict bool, podSecurityStandard string, rules lint.Rules) error {
 	client.Offline = offline
-	client.OfflineFiles = args
+	client.OfflineFiles = append(slices.Clone(args), templatesDirs...)
 	ctx, apiClient, err := client.NewAPIClient(ctx)
 	if err != nil {
 		return err
@@ -65,10 +93,13 @@ func runLint(ctx context.Context, args []string, offline bool, lintKinds []strin
 		lintKinds = allKinds
 	}
 	ops := lint.LintOptions{
-		Files:            args,
-		Strict:           strict,
-		DefaultNamespace: client.Namespace(ctx),
-		Printer:          os.Stdout,
+		Files:               args,
+		Strict:              strict,
+		ValidateSchemas:     offline && strict,
+		DefaultNamespace:    client.Namespace(ctx),
+		PodSecurityStandard: podSecurityStandard,
+		Rules:               rules,
+		Printer:             os.Stdout,
 	}
 	return lint.RunLint(ctx, apiClient, lintKinds, output, offline, ops)
 }
@@ -91,7 +91,7 @@ spec:
 		logging.SetExitFunc(func(int) { fatal = true })
 
 		ctx := logging.TestContext(t.Context())
-		err = runLint(ctx, []string{workflowPath}, true, nil, "pretty", true)
+		err = runLint(ctx, []string{workflowPath}, true, nil, nil, "pretty", true, "", nil)
 
 		require.NoError(t, err)
 		assert.True(t, fatal, "should have exited")
@@ -103,7 +103,7 @@ spec:
 		logging.SetExitFunc(func(int) { fatal = true })
 
 		ctx := logging.TestContext(t.Context())
-		err = runLint(ctx, []string{workflowPath, clusterWftmplPath}, true, nil, "pretty", true)
+		err = runLint(ctx, []string{workflowPath, clusterWftmplPath}, true, nil, nil, "pretty", true, "", nil)
 
 		require.NoError(t, err)
 		assert.True(t, fatal, "should have exited")
@@ -115,7 +115,7 @@ spec:
 		logging.SetExitFunc(func(int) { fatal = true })
 
 		ctx := logging.TestContext(t.Context())
-		err = runLint(ctx, []string{workflowPath, wftmplPath}, true, nil, "pretty", true)
+		err = runLint(ctx, []string{workflowPath, wftmplPath}, true, nil, nil, "pretty", true, "", nil)
 
 		require.NoError(t, err)
 		assert.True(t, fatal, "should have exited")
@@ -127,7 +127,7 @@ spec:
 		logging.SetExitFunc(func(int) { fatal = true })
 
 		ctx := logging.TestContext(t.Context())
-		err = runLint(ctx, []string{wftmplPath}, true, nil, "pretty", true)
+		err = runLint(ctx, []string{wftmplPath}, true, nil, nil, "pretty", true, "", nil)
 
 		require.NoError(t, err)
 		assert.False(t, fatal, "should not have exited")
@@ -139,7 +139,7 @@ spec:
 		logging.SetExitFunc(func(int) { fatal = true })
 
 		ctx := logging.TestContext(t.Context())
-		err = runLint(ctx, []string{clusterWftmplPath}, true, nil, "pretty", true)
+		err = runLint(ctx, []string{clusterWftmplPath}, true, nil, nil, "pretty", true, "", nil)
 
 		require.NoError(t, err)
 		assert.False(t, fatal, "should not have exited")
@@ -151,7 +151,7 @@ spec:
 		logging.SetExitFunc(func(int) { fatal = true })
 
 		ctx := logging.TestContext(t.Context())
-		err = runLint(ctx, []string{workflowPath, wftmplPath, clusterWftmplPath}, true, nil, "pretty", true)
+		err = runLint(ctx, []string{workflowPath, wftmplPath, clusterWftmplPath}, true, nil, nil, "pretty", true, "", nil)
 
 		require.NoError(t, err)
 		assert.False(t, fatal, "should not have exited")
@@ -163,7 +163,19 @@ spec:
 		logging.SetExitFunc(func(int) { fatal = true })
 
 		ctx := logging.TestContext(t.Context())
-		err = runLint(ctx, []string{dir}, true, nil, "pretty", true)
+		err = runLint(ctx, []string{dir}, true, nil, nil, "pretty", true, "", nil)
+
+		require.NoError(t, err)
+		assert.False(t, fatal, "should not have exited")
+	})
+
+	t.Run("linting a workflow with references from templates dirs", func(t *testing.T) {
+		defer func() { logging.SetExitFunc(nil) }()
+		var fatal bool
+		logging.SetExitFunc(func(int) { fatal = true })
+
+		ctx := logging.TestContext(t.Context())
+		err = runLint(ctx, []string{workflowPath}, true, []string{wftmplPath, clusterWftmplPath}, nil, "pretty", true, "", nil)
 
 		require.NoError(t, err)
 		assert.False(t, fatal, "should not have exited")
@@ -181,7 +193,7 @@ spec:
 		defer func() { _ = os.Stdin.Close() }() // close previously opened path to avoid errors trying to remove the file.
 
 		ctx := logging.TestContext(t.Context())
-		err = runLint(ctx, []string{workflowPath, wftmplPath, "-"}, true, nil, "pretty", true)
+		err = runLint(ctx, []string{workflowPath, wftmplPath, "-"}, true, nil, nil, "pretty", true, "", nil)
 
 		require.NoError(t, err)
 		assert.False(t, fatal, "should not have exited")
@@ -214,7 +226,7 @@ spec:
 		logging.SetExitFunc(func(int) { fatal = true })
 
 		ctx := logging.TestContext(t.Context())
-		err = runLint(ctx, []string{workflowCaseSensitivePath}, true, nil, "pretty", true)
+		err = runLint(ctx, []string{workflowCaseSensitivePath}, true, nil, nil, "pretty", true, "", nil)
 
 		require.NoError(t, err)
 		assert.True(t, fatal, "should have exited")
@@ -226,7 +238,7 @@ spec:
 		logging.SetExitFunc(func(int) { fatal = true })
 
 		ctx := logging.TestContext(t.Context())
-		err = runLint(ctx, []string{workflowCaseSensitivePath}, true, nil, "pretty", false)
+		err = runLint(ctx, []string{workflowCaseSensitivePath}, true, nil, nil, "pretty", false, "", nil)
 
 		require.NoError(t, err)
 		assert.False(t, fatal, "should not have exited")
@@ -287,7 +299,7 @@ spec:
 		var fatal bool
 		logging.SetExitFunc(func(int) { fatal = true })
 		ctx := logging.TestContext(t.Context())
-		err = runLint(ctx, []string{workflowMultiDocsPath}, true, nil, "pretty", false)
+		err = runLint(ctx, []string{workflowMultiDocsPath}, true, nil, nil, "pretty", false, "", nil)
 
 		require.NoError(t, err)
 		assert.False(t, fatal, "should not have exited")
@@ -45,6 +45,9 @@ var (
 )
 
 func (f listFlags) displayFields() string {
+	if printer.IsTemplateOutput(f.output.String()) {
+		return ""
+	}
 	switch f.output.String() {
 	case "name":
 		return nameFields
@@ -59,7 +62,7 @@ func (f listFlags) displayFields() string {
 
 func NewListCommand() *cobra.Command {
 	var (
-		listArgs      = listFlags{output: cmdcommon.NewPrintWorkflowOutputValue("")}
+		listArgs      = listFlags{output: cmdcommon.WithTemplateOutputs(cmdcommon.NewPrintWorkflowOutputValue(""))}
 		allNamespaces bool
 	)
 	command := &cobra.Command{
@@ -89,6 +92,10 @@ func NewListCommand() *cobra.Command {
 # List workflows in YAML format:
   argo list -o yaml
 
+# List the names and phases of workflows, using a JSONPath or Go template:
+  argo list -o jsonpath='{range .items[*]}{.metadata.name}{"\t"}{.status.phase}{"\n"}{end}'
+  argo list -o go-template='{{range .items}}{{.metadata.name}}{{"\t"}}{{.status.phase}}{{"\n"}}{{end}}'
+
 # List workflows that have both labels:
   argo list -l label1=value1,label2=value2
 `,
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"errors"
+	"os"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -15,16 +16,22 @@ import (
 
 func NewLogsCommand() *cobra.Command {
 	var (
-		since     time.Duration
-		sinceTime string
-		tailLines int64
-		grep      string
-		selector  string
+		since         time.Duration
+		sinceTime     string
+		tailLines     int64
+		grep          string
+		selector      string
+		fieldSelector string
 	)
 	logOptions := &corev1.PodLogOptions{}
 	command := &cobra.Command{
-		Use:   "logs WORKFLOW [POD]",
+		Use:   "logs [WORKFLOW [POD]]",
 		Short: "view logs of a pod or workflow",
+		Long: `View the logs of a pod or workflow.
+
+Without a workflow, view the logs of all the workflows matching --selector and --field-selector concurrently,
+prefixing each line with the names of its workflow and pod. When following the logs, workflows which start matching
+later are picked up too.`,
 		Example: `# Print the logs of a workflow:
 
   argo logs my-wf
@@ -51,17 +58,35 @@ func NewLogsCommand() *cobra.Command {
 
 # Print the logs of the latest workflow:
   argo logs @latest
+
+# Follow the logs of all the workflows of a cron workflow, including the ones it creates later:
+
+  argo logs -l workflows.argoproj.io/cron-workflow=my-cron --follow
+
+# Follow the logs of all the running workflows:
+
+  argo logs -l workflows.argoproj.io/phase=Running --follow
 `,
-		Args: cobra.RangeArgs(1, 2),
+		Args: cobra.RangeArgs(0, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// parse all the args
-			workflow := args[0]
+			workflow := ""
 			podName := ""
 
+			if len(args) > 0 {
+				workflow = args[0]
+			} else if selector == "" && fieldSelector == "" {
+				return errors.New("a workflow, or --selector or --field-selector, is required")
+			}
+
 			if len(args) == 2 {
 				podName = args[1]
 			}
 
+			if workflow != "" && fieldSelector != "" {
+				return errors.New("--field-selector cannot be used with a workflow")
+			}
+
 			if since > 0 && sinceTime != "" {
 				return errors.New("--since-time and --since cannot be used together")
 			}
@@ -92,6 +117,10 @@ func NewLogsCommand() *cobra.Command {
 			serviceClient := apiClient.NewWorkflowServiceClient(ctx)
 			namespace := client.Namespace(ctx)
 
+			if workflow == "" {
+				listOptions := &metav1.ListOptions{LabelSelector: selector, FieldSelector: fieldSelector}
+				return common.LogWorkflows(ctx, os.Stdout, serviceClient, namespace, listOptions, grep, logOptions)
+			}
 			return common.LogWorkflow(ctx, serviceClient, namespace, workflow, podName, grep, selector, logOptions)
 		},
 	}
@@ -102,7 +131,8 @@ func NewLogsCommand() *cobra.Command {
 	command.Flags().StringVar(&sinceTime, "since-time", "", "Only return logs after a specific date (RFC3339). Defaults to all logs. Only one of since-time / since may be used.")
 	command.Flags().Int64Var(&tailLines, "tail", -1, "If set, the number of lines from the end of the logs to show. If not specified, logs are shown from the creation of the container or sinceSeconds or sinceTime")
 	command.Flags().StringVar(&grep, "grep", "", "grep for lines")
-	command.Flags().StringVarP(&selector, "selector", "l", "", "log selector for some pod")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "log selector for some pod, or without a workflow, selector (label query) of the workflows to view the logs of")
+	command.Flags().StringVar(&fieldSelector, "field-selector", "", "Without a workflow, selector (field query) of the workflows to view the logs of, e.g. --field-selector metadata.name=my-wf")
 	command.Flags().BoolVar(&logOptions.Timestamps, "timestamps", false, "Include timestamps on each line in the log output")
 	command.Flags().BoolVar(&common.NoColor, "no-color", false, "Disable colorized output")
 	return command
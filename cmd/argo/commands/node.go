@@ -7,10 +7,10 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
-	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfutil "github.com/argoproj/argo-workflows/v3/workflow/util"
 )
 
 type setOps struct {
@@ -18,6 +18,7 @@ type setOps struct {
 	phase             string   // --phase
 	outputParameters  []string // --output-parameters
 	nodeFieldSelector string   // --node-field-selector
+	allowUndeclared   bool     // --allow-undeclared
 }
 
 func NewNodeCommand() *cobra.Command {
@@ -26,6 +27,7 @@ func NewNodeCommand() *cobra.Command {
 	command := &cobra.Command{
 		Use:   "node ACTION WORKFLOW FLAGS",
 		Short: "perform action on a node in a workflow",
+		Long:  "Perform an action on a node in a workflow. 'set' targets suspended nodes, and failed nodes whose retry node is still retrying them.",
 		Example: `# Set outputs to a node within a workflow:
 
   argo node set my-wf --output-parameter parameter-name="Hello, world!" --node-field-selector displayName=approve
@@ -33,6 +35,14 @@ func NewNodeCommand() *cobra.Command {
 # Set the message of a node within a workflow:
 
   argo node set my-wf --message "We did it!"" --node-field-selector displayName=approve
+
+# Set an output parameter the suspend node does not declare, e.g. to pass data from an approval:
+
+  argo node set my-wf --output-parameter reviewer=alice --allow-undeclared --node-field-selector displayName=approve
+
+# Supply the outputs of a failed attempt of a node being retried and mark it succeeded, so the retry succeeds:
+
+  argo node set my-wf --output-parameter result=42 --phase Succeeded --node-field-selector displayName=flaky
 `,
 		Args: cobra.MinimumNArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -69,18 +79,19 @@ func NewNodeCommand() *cobra.Command {
 			serviceClient := apiClient.NewWorkflowServiceClient(ctx)
 			namespace := client.Namespace(ctx)
 
-			selector, err := fields.ParseSelector(setArgs.nodeFieldSelector)
+			selector, err := wfutil.ParseNodeFieldSelector(setArgs.nodeFieldSelector)
 			if err != nil {
 				return fmt.Errorf("unable to parse node field selector '%s': %s", setArgs.nodeFieldSelector, err)
 			}
 
 			_, err = serviceClient.SetWorkflow(ctx, &workflowpkg.WorkflowSetRequest{
-				Name:              args[1],
-				Namespace:         namespace,
-				NodeFieldSelector: selector.String(),
-				Message:           setArgs.message,
-				Phase:             setArgs.phase,
-				OutputParameters:  outputParameters,
+				Name:                            args[1],
+				Namespace:                       namespace,
+				NodeFieldSelector:               selector.String(),
+				Message:                         setArgs.message,
+				Phase:                           setArgs.phase,
+				OutputParameters:                outputParameters,
+				AllowUndeclaredOutputParameters: setArgs.allowUndeclared,
 			})
 			if err != nil {
 				return err
@@ -93,5 +104,6 @@ func NewNodeCommand() *cobra.Command {
 	command.Flags().StringVar(&setArgs.phase, "phase", "", "Phase to set the node to, eg: --phase Succeeded")
 	command.Flags().StringArrayVarP(&setArgs.outputParameters, "output-parameter", "p", []string{}, "Set a \"supplied\" output parameter of node, eg: --output-parameter parameter-name=\"Hello, world!\"")
 	command.Flags().StringVarP(&setArgs.message, "message", "m", "", "Set the message of a node, eg: --message \"Hello, world!\"")
+	command.Flags().BoolVar(&setArgs.allowUndeclared, "allow-undeclared", false, "Allow setting output parameters that a suspend node does not declare")
 	return command
 }
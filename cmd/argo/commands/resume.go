@@ -5,14 +5,15 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
-	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfutil "github.com/argoproj/argo-workflows/v3/workflow/util"
 )
 
 type resumeOps struct {
 	nodeFieldSelector string // --node-field-selector
+	next              bool   // --next
 }
 
 func NewResumeCommand() *cobra.Command {
@@ -36,11 +37,18 @@ func NewResumeCommand() *cobra.Command {
 # Resume multiple workflows by node field selector:
 		
   argo resume --node-field-selector inputs.paramaters.myparam.value=abc		
+
+# Step through a workflow that has spec.stepThrough, running the next node that is paused:
+
+  argo resume my-wf --next
 `,
 		Args: func(cmd *cobra.Command, args []string) error {
 			if len(args) == 0 && resumeArgs.nodeFieldSelector == "" {
 				return errors.New("requires either node field selector or workflow")
 			}
+			if resumeArgs.next && (len(args) == 0 || resumeArgs.nodeFieldSelector != "") {
+				return errors.New("--next requires a workflow, and cannot be used with --node-field-selector")
+			}
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -52,12 +60,32 @@ func NewResumeCommand() *cobra.Command {
 			serviceClient := apiClient.NewWorkflowServiceClient(ctx)
 			namespace := client.Namespace(ctx)
 
-			selector, err := fields.ParseSelector(resumeArgs.nodeFieldSelector)
+			selector, err := wfutil.ParseNodeFieldSelector(resumeArgs.nodeFieldSelector)
 			if err != nil {
 				return fmt.Errorf("unable to parse node field selector '%s': %s", resumeArgs.nodeFieldSelector, err)
 			}
 
 			for _, wfName := range args {
+				if resumeArgs.next {
+					wf, err := serviceClient.GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{Name: wfName, Namespace: namespace})
+					if err != nil {
+						return err
+					}
+					node := wfutil.NextStepThroughNode(wf)
+					if node == nil {
+						return fmt.Errorf("workflow %s is not paused for step-through", wfName)
+					}
+					_, err = serviceClient.ResumeWorkflow(ctx, &workflowpkg.WorkflowResumeRequest{
+						Name:              wfName,
+						Namespace:         namespace,
+						NodeFieldSelector: "id=" + node.ID,
+					})
+					if err != nil {
+						return fmt.Errorf("failed to resume %s: %+v", wfName, err)
+					}
+					fmt.Printf("workflow %s resumed (%s)\n", wfName, node.Message)
+					continue
+				}
 				_, err := serviceClient.ResumeWorkflow(ctx, &workflowpkg.WorkflowResumeRequest{
 					Name:              wfName,
 					Namespace:         namespace,
@@ -71,6 +99,7 @@ func NewResumeCommand() *cobra.Command {
 			return nil
 		},
 	}
-	command.Flags().StringVar(&resumeArgs.nodeFieldSelector, "node-field-selector", "", "selector of node to resume, eg: --node-field-selector inputs.paramaters.myparam.value=abc")
+	command.Flags().StringVar(&resumeArgs.nodeFieldSelector, "node-field-selector", "", "selector of node to resume, supporting regular expressions with =~ and !~ and sets with in and notin, eg: --node-field-selector inputs.paramaters.myparam.value=abc or --node-field-selector 'displayName=~^approve-'")
+	command.Flags().BoolVar(&resumeArgs.next, "next", false, "resume only the node that has been paused for step-through the longest, see spec.stepThrough")
 	return command
 }
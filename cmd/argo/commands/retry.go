@@ -4,20 +4,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wfutil "github.com/argoproj/argo-workflows/v3/workflow/util"
 )
 
 type retryOps struct {
 	nodeFieldSelector string // --node-field-selector
 	restartSuccessful bool   // --restart-successful
+	dryRun            bool   // --dry-run
 	namespace         string // --namespace
 	labelSelector     string // --selector
 	fieldSelector     string // --field-selector
@@ -74,6 +80,12 @@ func NewRetryCommand() *cobra.Command {
 
 # Restart node with id 5 on successful workflow, using node-field-selector
   argo retry my-wf --restart-successful --node-field-selector id=5
+
+# Restart the succeeded nodes of the templates named like "process-", using a regular expression:
+  argo retry my-wf --restart-successful --node-field-selector 'templateName=~^process-,phase=Succeeded'
+
+# Print which nodes would be reset, without retrying:
+  argo retry my-wf --restart-successful --node-field-selector 'displayName=~^shard-(1|2)$' --dry-run
 `,
 		Args: func(cmd *cobra.Command, args []string) error {
 			if len(args) == 0 && !retryOpts.hasSelector() {
@@ -99,7 +111,8 @@ func NewRetryCommand() *cobra.Command {
 	command.Flags().BoolVar(&cliSubmitOpts.Watch, "watch", false, "watch the workflow until it completes, only works when a single workflow is retried")
 	command.Flags().BoolVar(&cliSubmitOpts.Log, "log", false, "log the workflow until it completes")
 	command.Flags().BoolVar(&retryOpts.restartSuccessful, "restart-successful", false, "indicates to restart successful nodes matching the --node-field-selector")
-	command.Flags().StringVar(&retryOpts.nodeFieldSelector, "node-field-selector", "", "selector of nodes to reset, eg: --node-field-selector inputs.paramaters.myparam.value=abc")
+	command.Flags().StringVar(&retryOpts.nodeFieldSelector, "node-field-selector", "", "selector of nodes to reset, supporting regular expressions with =~ and !~ and sets with in and notin, eg: --node-field-selector inputs.paramaters.myparam.value=abc or --node-field-selector 'templateName=~^process-,phase in (Failed,Error)'")
+	command.Flags().BoolVar(&retryOpts.dryRun, "dry-run", false, "Do not retry the workflows, only print which nodes would be re-run")
 	command.Flags().StringVarP(&retryOpts.labelSelector, "selector", "l", "", "Selector (label query) to filter on, not including uninitialized ones, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
 	command.Flags().StringVar(&retryOpts.fieldSelector, "field-selector", "", "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selector key1=value1,key2=value2). The server only supports a limited number of field queries per type.")
 	return command
@@ -107,7 +120,7 @@ func NewRetryCommand() *cobra.Command {
 
 // retryWorkflows retries workflows by given retryArgs or workflow names
 func retryWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, retryOpts retryOps, cliSubmitOpts common.CliSubmitOpts, args []string) error {
-	selector, err := fields.ParseSelector(retryOpts.nodeFieldSelector)
+	selector, err := wfutil.ParseNodeFieldSelector(retryOpts.nodeFieldSelector)
 	if err != nil {
 		return fmt.Errorf("unable to parse node field selector '%s': %s", retryOpts.nodeFieldSelector, err)
 	}
@@ -141,6 +154,13 @@ func retryWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServi
 		}
 		retriedNames[wf.Name] = true
 
+		if retryOpts.dryRun {
+			if err := printRetryDryRun(ctx, serviceClient, wf, retryOpts, cliSubmitOpts); err != nil {
+				return err
+			}
+			continue
+		}
+
 		lastRetried, err = serviceClient.RetryWorkflow(ctx, &workflowpkg.WorkflowRetryRequest{
 			Name:              wf.Name,
 			Namespace:         wf.Namespace,
@@ -155,9 +175,44 @@ func retryWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServi
 			return err
 		}
 	}
-	if len(retriedNames) == 1 {
+	if len(retriedNames) == 1 && !retryOpts.dryRun {
 		// watch or wait when there is only one workflow retried
 		return common.WaitWatchOrLog(ctx, serviceClient, lastRetried.Namespace, []string{lastRetried.Name}, cliSubmitOpts)
 	}
 	return nil
 }
+
+// printRetryDryRun prints the nodes of the workflow which would be re-run, because they are deleted, or reset to
+// running, because they are the parents of deleted nodes
+func printRetryDryRun(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, wf wfv1.Workflow, retryOpts retryOps, cliSubmitOpts common.CliSubmitOpts) error {
+	got, err := serviceClient.GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{Name: wf.Name, Namespace: wf.Namespace})
+	if err != nil {
+		return err
+	}
+	retried, _, err := wfutil.FormulateRetryWorkflow(ctx, got.DeepCopy(), retryOpts.restartSuccessful, retryOpts.nodeFieldSelector, cliSubmitOpts.Parameters)
+	if err != nil {
+		return fmt.Errorf("cannot retry workflow %s: %w", got.Name, err)
+	}
+	nodes := slices.SortedFunc(maps.Values(got.Status.Nodes), func(a, b wfv1.NodeStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	fmt.Printf("Workflow '%s' would be retried (dry-run):\n", got.Name)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAME\tID\tTEMPLATE\tPHASE\tACTION")
+	count := 0
+	for _, node := range nodes {
+		var action string
+		if retriedNode, ok := retried.Status.Nodes[node.ID]; !ok {
+			action = "re-run"
+		} else if retriedNode.Phase != node.Phase {
+			action = "reset"
+		} else {
+			continue
+		}
+		count++
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", node.Name, node.ID, wfutil.GetTemplateFromNode(node), node.Phase, action)
+	}
+	_ = w.Flush()
+	fmt.Printf("%d nodes would be reset or re-run\n", count)
+	return nil
+}
@@ -151,3 +151,24 @@ func Test_retryWorkflows(t *testing.T) {
 		require.Errorf(t, err, "mock error")
 	})
 }
+
+func Test_retryWorkflowsDryRun(t *testing.T) {
+	c := &workflowmocks.WorkflowServiceClient{}
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata: {name: my-wf, namespace: argo, labels: {}}
+status:
+  phase: Succeeded
+  nodes:
+    my-wf: {id: my-wf, name: my-wf, phase: Succeeded, type: DAG, children: [my-wf-1, my-wf-2]}
+    my-wf-1: {id: my-wf-1, name: my-wf.process-1, displayName: process-1, templateName: process, phase: Succeeded, type: Pod, boundaryID: my-wf}
+    my-wf-2: {id: my-wf-2, name: my-wf.report, displayName: report, templateName: report, phase: Succeeded, type: Pod, boundaryID: my-wf}
+`)
+	c.On("GetWorkflow", mock.Anything, &workflowpkg.WorkflowGetRequest{Name: "my-wf", Namespace: "argo"}).Return(wf, nil)
+	ctx := logging.TestContext(t.Context())
+	err := retryWorkflows(ctx, c, retryOps{namespace: "argo", restartSuccessful: true, nodeFieldSelector: "templateName=~^proc", dryRun: true}, common.CliSubmitOpts{}, []string{"my-wf"})
+	require.NoError(t, err)
+	c.AssertNotCalled(t, "RetryWorkflow", mock.Anything, mock.Anything)
+
+	err = retryWorkflows(ctx, c, retryOps{namespace: "argo", nodeFieldSelector: "templateName=~(", dryRun: true}, common.CliSubmitOpts{}, []string{"my-wf"})
+	require.Error(t, err)
+}
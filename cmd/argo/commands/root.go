@@ -12,11 +12,13 @@ import (
 	"github.com/argoproj/argo-workflows/v3"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/archive"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/auth"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/cache"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/clustertemplate"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/cron"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/executorplugin"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/template"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/tui"
 	cmdutil "github.com/argoproj/argo-workflows/v3/util/cmd"
 	grpcutil "github.com/argoproj/argo-workflows/v3/util/grpc"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
@@ -95,6 +97,8 @@ If your server is behind an ingress with a path (running "argo server --base-hre
 	}
 	command.AddCommand(NewCompletionCommand())
 	command.AddCommand(NewDeleteCommand())
+	command.AddCommand(NewDiffCommand())
+	command.AddCommand(NewFmtCommand())
 	command.AddCommand(NewGetCommand())
 	command.AddCommand(NewLintCommand())
 	command.AddCommand(NewListCommand())
@@ -112,7 +116,10 @@ If your server is behind an ingress with a path (running "argo server --base-hre
 	command.AddCommand(NewStopCommand())
 	command.AddCommand(NewNodeCommand())
 	command.AddCommand(NewTerminateCommand())
+	command.AddCommand(NewTopCommand())
+	command.AddCommand(tui.NewTUICommand())
 	command.AddCommand(archive.NewArchiveCommand())
+	command.AddCommand(cache.NewCacheCommand())
 	command.AddCommand(NewVersionCommand())
 	command.AddCommand(template.NewTemplateCommand())
 	command.AddCommand(cron.NewCronWorkflowCommand())
@@ -27,6 +27,7 @@ import (
 	wfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-workflows/v3/server/apiserver"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/event"
 	"github.com/argoproj/argo-workflows/v3/server/types"
 	cmdutil "github.com/argoproj/argo-workflows/v3/util/cmd"
 	"github.com/argoproj/argo-workflows/v3/util/help"
@@ -51,6 +52,7 @@ func NewServerCommand() *cobra.Command {
 		eventOperationQueueSize  int
 		eventWorkerCount         int
 		eventAsyncDispatch       bool
+		eventQuota               event.Quota
 		frameOptions             string
 		accessControlAllowOrigin string
 		apiRateLimit             uint64
@@ -168,6 +170,7 @@ See %s`, help.ArgoServer()),
 				EventOperationQueueSize:  eventOperationQueueSize,
 				EventWorkerCount:         eventWorkerCount,
 				EventAsyncDispatch:       eventAsyncDispatch,
+				EventQuota:               eventQuota,
 				XFrameOptions:            frameOptions,
 				AccessControlAllowOrigin: accessControlAllowOrigin,
 				APIRateLimit:             apiRateLimit,
@@ -208,6 +211,8 @@ See %s`, help.ArgoServer()),
 	command.Flags().IntVar(&eventOperationQueueSize, "event-operation-queue-size", 16, "how many events operations that can be queued at once")
 	command.Flags().IntVar(&eventWorkerCount, "event-worker-count", 4, "how many event workers to run")
 	command.Flags().BoolVar(&eventAsyncDispatch, "event-async-dispatch", false, "dispatch event async")
+	command.Flags().IntVar(&eventQuota.MaxInFlight, "event-namespace-max-in-flight", 0, "how many events each namespace can have queued or being dispatched at once, 0 for unlimited")
+	command.Flags().IntVar(&eventQuota.MaxPerMinute, "event-namespace-max-per-minute", 0, "how many events each namespace can send per minute, 0 for unlimited")
 	command.Flags().StringVar(&frameOptions, "x-frame-options", "DENY", "Set X-Frame-Options header in HTTP responses.")
 	command.Flags().StringVar(&accessControlAllowOrigin, "access-control-allow-origin", "", "Set Access-Control-Allow-Origin header in HTTP responses.")
 	command.Flags().Uint64Var(&apiRateLimit, "api-rate-limit", 1000, "Set limit per IP for api ratelimiter")
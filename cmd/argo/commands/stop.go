@@ -4,23 +4,27 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wfutil "github.com/argoproj/argo-workflows/v3/workflow/util"
 )
 
 type stopOps struct {
-	message           string // --message
-	nodeFieldSelector string // --node-field-selector
-	namespace         string // --namespace
-	labelSelector     string // --selector
-	fieldSelector     string // --field-selector
-	dryRun            bool   // --dry-run
+	message           string        // --message
+	nodeFieldSelector string        // --node-field-selector
+	namespace         string        // --namespace
+	labelSelector     string        // --selector
+	fieldSelector     string        // --field-selector
+	dryRun            bool          // --dry-run
+	reason            string        // --reason
+	gracePeriod       time.Duration // --grace-period
+	subtree           bool          // --subtree
 }
 
 // hasSelector returns true if the CLI arguments selects multiple workflows
@@ -53,11 +57,22 @@ func NewStopCommand() *cobra.Command {
 # Stop multiple workflows by field selector
 
   argo stop --field-selector metadata.namespace=argo
+
+# Stop a workflow, recording why, and delete its pods if they have not exited a minute after being signalled:
+
+  argo stop my-wf --reason "bad input data" --grace-period 1m
+
+# Stop only a step and its descendants, letting the rest of the workflow finish:
+
+  argo stop my-wf --subtree --node-field-selector displayName=process-batch
 `,
 		Args: func(cmd *cobra.Command, args []string) error {
 			if len(args) == 0 && !stopArgs.hasSelector() {
 				return errors.New("requires either selector or workflow")
 			}
+			if stopArgs.subtree && stopArgs.nodeFieldSelector == "" {
+				return errors.New("--subtree requires --node-field-selector")
+			}
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -73,16 +88,19 @@ func NewStopCommand() *cobra.Command {
 		},
 	}
 	command.Flags().StringVar(&stopArgs.message, "message", "", "Message to add to previously running nodes")
-	command.Flags().StringVar(&stopArgs.nodeFieldSelector, "node-field-selector", "", "selector of node to stop, eg: --node-field-selector inputs.paramaters.myparam.value=abc")
+	command.Flags().StringVar(&stopArgs.nodeFieldSelector, "node-field-selector", "", "selector of node to stop, supporting regular expressions with =~ and !~ and sets with in and notin, eg: --node-field-selector inputs.paramaters.myparam.value=abc or --node-field-selector 'displayName=~^approve-'")
 	command.Flags().StringVarP(&stopArgs.labelSelector, "selector", "l", "", "Selector (label query) to filter on, not including uninitialized ones, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
 	command.Flags().StringVar(&stopArgs.fieldSelector, "field-selector", "", "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selector key1=value1,key2=value2). The server only supports a limited number of field queries per type.")
 	command.Flags().BoolVar(&stopArgs.dryRun, "dry-run", false, "If true, only print the workflows that would be stopped, without stopping them.")
+	command.Flags().StringVar(&stopArgs.reason, "reason", "", "Why the workflow is stopped, recorded in its status and events")
+	command.Flags().DurationVar(&stopArgs.gracePeriod, "grace-period", 0, "How long pods have to exit once signalled before they are deleted, e.g. 30s. Defaults to waiting for them to exit")
+	command.Flags().BoolVar(&stopArgs.subtree, "subtree", false, "Stop only the nodes selected by --node-field-selector and their descendants, letting the other nodes finish")
 	return command
 }
 
 // stopWorkflows stops workflows by given stopArgs or workflow names
 func stopWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, stopArgs stopOps, args []string) error {
-	selector, err := fields.ParseSelector(stopArgs.nodeFieldSelector)
+	selector, err := wfutil.ParseNodeFieldSelector(stopArgs.nodeFieldSelector)
 	if err != nil {
 		return fmt.Errorf("unable to parse node field selector '%s': %s", stopArgs.nodeFieldSelector, err)
 	}
@@ -119,12 +137,18 @@ func stopWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServic
 			fmt.Printf("workflow %s stopped (dry-run)\n", wf.Name)
 			continue
 		}
-		wf, err := serviceClient.StopWorkflow(ctx, &workflowpkg.WorkflowStopRequest{
+		req := &workflowpkg.WorkflowStopRequest{
 			Name:              wf.Name,
 			Namespace:         wf.Namespace,
 			NodeFieldSelector: selector.String(),
 			Message:           stopArgs.message,
-		})
+			Reason:            stopArgs.reason,
+			Subtree:           stopArgs.subtree,
+		}
+		if stopArgs.gracePeriod > 0 {
+			req.GracePeriod = stopArgs.gracePeriod.String()
+		}
+		wf, err := serviceClient.StopWorkflow(ctx, req)
 		if err != nil {
 			return err
 		}
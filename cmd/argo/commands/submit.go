@@ -53,6 +53,10 @@ func NewSubmitCommand() *cobra.Command {
 
   argo submit --from cronwf/my-cron-wf
 
+# Submit a workflow from a cron workflow, as if it had been scheduled at a time, e.g. to re-run a missed day:
+
+  argo submit --from cronwf/my-cron-wf --scheduled-time 2024-07-01T06:00:00Z
+
 # Submit multiple workflows from stdin:
 
   cat my-wf.yaml | argo submit -
@@ -84,6 +88,10 @@ func NewSubmitCommand() *cobra.Command {
 				}
 			}
 
+			if err := applyScheduledTime(&submitOpts, cliSubmitOpts.ScheduledTime); err != nil {
+				return err
+			}
+
 			serviceClient := apiClient.NewWorkflowServiceClient(ctx)
 			namespace := client.Namespace(ctx)
 			if from != "" {
@@ -103,7 +111,9 @@ func NewSubmitCommand() *cobra.Command {
 	command.Flags().StringVar(&from, "from", "", "Submit from an existing `kind/name` E.g., --from=cronwf/hello-world-cwf")
 	command.Flags().StringVar(&cliSubmitOpts.GetArgs.Status, "status", "", "Filter by status (Pending, Running, Succeeded, Skipped, Failed, Error). Should only be used with --watch.")
 	command.Flags().StringVar(&cliSubmitOpts.GetArgs.NodeFieldSelectorString, "node-field-selector", "", "selector of node to display, eg: --node-field-selector phase=abc")
-	command.Flags().StringVar(&cliSubmitOpts.ScheduledTime, "scheduled-time", "", "Override the workflow's scheduledTime parameter (useful for backfilling). The time must be RFC3339")
+	command.Flags().StringVar(&cliSubmitOpts.GetArgs.Node, "watch-node", "", "Only display the subtree of the node with this name, display name or ID. Should only be used with --watch.")
+	command.Flags().BoolVar(&cliSubmitOpts.GetArgs.Collapse, "collapse", true, "Display the succeeded steps and DAGs as a single node. Should only be used with --watch.")
+	command.Flags().StringVar(&cliSubmitOpts.ScheduledTime, "scheduled-time", "", "Submit the workflow as if it had been scheduled by a cron workflow at this time, setting the scheduled-time annotation and {{workflow.scheduledTime}} (useful for backfilling). The time must be RFC3339")
 
 	// Only complete files with appropriate extension.
 	ctx, _, err := cmdutil.CmdContextWithLogger(command, string(logging.Info), string(logging.Text))
@@ -177,6 +187,24 @@ func validateOptions(workflows []wfv1.Workflow, submitOpts *wfv1.SubmitOpts, cli
 	return nil
 }
 
+// applyScheduledTime annotates the workflows with the scheduled time, as the cron workflow controller does, so that
+// `{{workflow.scheduledTime}}` is the time as if a cron workflow had been scheduled then
+func applyScheduledTime(submitOpts *wfv1.SubmitOpts, scheduledTime string) error {
+	if scheduledTime == "" {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, scheduledTime)
+	if err != nil {
+		return fmt.Errorf("scheduled-time contains invalid time.RFC3339 format. (e.g.: `2006-01-02T15:04:05-07:00`)")
+	}
+	annotation := fmt.Sprintf("%s=%s", wfcommon.AnnotationKeyCronWfScheduledTime, t.Format(time.RFC3339))
+	if submitOpts.Annotations != "" {
+		annotation = submitOpts.Annotations + "," + annotation
+	}
+	submitOpts.Annotations = annotation
+	return nil
+}
+
 func submitWorkflowFromResource(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace string, resourceIdentifier string, submitOpts *wfv1.SubmitOpts, cliOpts *common.CliSubmitOpts) error {
 	parts := strings.SplitN(resourceIdentifier, "/", 2)
 	if len(parts) != 2 {
@@ -190,14 +218,6 @@ func submitWorkflowFromResource(ctx context.Context, serviceClient workflowpkg.W
 	if err := validateOptions([]wfv1.Workflow{tempwf}, submitOpts, cliOpts); err != nil {
 		return err
 	}
-	if cliOpts.ScheduledTime != "" {
-		_, err := time.Parse(time.RFC3339, cliOpts.ScheduledTime)
-		if err != nil {
-			return fmt.Errorf("scheduled-time contains invalid time.RFC3339 format. (e.g.: `2006-01-02T15:04:05-07:00`)")
-		}
-		submitOpts.Annotations = fmt.Sprintf("%s=%s", wfcommon.AnnotationKeyCronWfScheduledTime, cliOpts.ScheduledTime)
-	}
-
 	created, err := serviceClient.SubmitWorkflow(ctx, &workflowpkg.WorkflowSubmitRequest{
 		Namespace:     namespace,
 		ResourceKind:  kind,
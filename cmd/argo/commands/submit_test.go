@@ -68,3 +68,24 @@ func Test_submitWorkflows(t *testing.T) {
 		assert.Equal(t, priorityCLI, *wfC.Workflow.Spec.Priority)
 	})
 }
+
+func Test_applyScheduledTime(t *testing.T) {
+	t.Run("None", func(t *testing.T) {
+		opts := &wfv1.SubmitOpts{Annotations: "a=b"}
+		require.NoError(t, applyScheduledTime(opts, ""))
+		assert.Equal(t, "a=b", opts.Annotations)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		require.Error(t, applyScheduledTime(&wfv1.SubmitOpts{}, "2024-07-01"))
+	})
+	t.Run("Valid", func(t *testing.T) {
+		opts := &wfv1.SubmitOpts{}
+		require.NoError(t, applyScheduledTime(opts, "2024-07-01T06:00:00Z"))
+		assert.Equal(t, "workflows.argoproj.io/scheduled-time=2024-07-01T06:00:00Z", opts.Annotations)
+	})
+	t.Run("WithAnnotations", func(t *testing.T) {
+		opts := &wfv1.SubmitOpts{Annotations: "a=b"}
+		require.NoError(t, applyScheduledTime(opts, "2024-07-01T08:00:00+02:00"))
+		assert.Equal(t, "a=b,workflows.argoproj.io/scheduled-time=2024-07-01T08:00:00+02:00", opts.Annotations)
+	})
+}
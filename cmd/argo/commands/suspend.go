@@ -2,14 +2,17 @@ package commands
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
 func NewSuspendCommand() *cobra.Command {
+	var until string
 	command := &cobra.Command{
 		Use:   "suspend WORKFLOW1 WORKFLOW2...",
 		Short: "suspend zero or more workflows (opposite of resume)",
@@ -19,8 +22,18 @@ func NewSuspendCommand() *cobra.Command {
 
 # Suspend the latest workflow:
   argo suspend @latest
+
+# Suspend a workflow for two hours, after which the controller resumes it:
+  argo suspend my-wf --until 2h
+
+# Suspend a workflow until a given time:
+  argo suspend my-wf --until 2026-01-02T15:04:05Z
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			resumeAt, err := parseUntil(until, time.Now())
+			if err != nil {
+				return err
+			}
 			ctx := cmd.Context()
 			ctx, apiClient, err := client.NewAPIClient(ctx)
 			if err != nil {
@@ -28,18 +41,43 @@ func NewSuspendCommand() *cobra.Command {
 			}
 			serviceClient := apiClient.NewWorkflowServiceClient(ctx)
 			namespace := client.Namespace(ctx)
+			req := &workflowpkg.WorkflowSuspendRequest{Namespace: namespace}
+			if !resumeAt.IsZero() {
+				req.ResumeAt = resumeAt.Format(time.RFC3339)
+			}
 			for _, wfName := range args {
-				_, err := serviceClient.SuspendWorkflow(ctx, &workflowpkg.WorkflowSuspendRequest{
-					Name:      wfName,
-					Namespace: namespace,
-				})
+				req.Name = wfName
+				_, err := serviceClient.SuspendWorkflow(ctx, req)
 				if err != nil {
 					return fmt.Errorf("failed to suspended %s: %+v", wfName, err)
 				}
-				fmt.Printf("workflow %s suspended\n", wfName)
+				if resumeAt.IsZero() {
+					fmt.Printf("workflow %s suspended\n", wfName)
+				} else {
+					fmt.Printf("workflow %s suspended until %s\n", wfName, req.ResumeAt)
+				}
 			}
 			return nil
 		},
 	}
+	command.Flags().StringVar(&until, "until", "", "Resume the workflow automatically at this time, either RFC3339 (e.g. 2026-01-02T15:04:05Z) or a duration from now (e.g. 30m, 2h)")
 	return command
 }
+
+// parseUntil returns the time --until refers to, or the zero time if it is empty.
+func parseUntil(until string, now time.Time) (time.Time, error) {
+	if until == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, until); err == nil {
+		return t.UTC(), nil
+	}
+	d, err := wfv1.ParseStringToDuration(until)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("--until must be an RFC3339 time or a duration: %w", err)
+	}
+	if d <= 0 {
+		return time.Time{}, fmt.Errorf("--until duration must be positive, got %s", until)
+	}
+	return now.Add(d).UTC().Truncate(time.Second), nil
+}
@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseUntil(t *testing.T) {
+	now := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+	t.Run("Empty", func(t *testing.T) {
+		resumeAt, err := parseUntil("", now)
+		require.NoError(t, err)
+		assert.True(t, resumeAt.IsZero())
+	})
+	t.Run("Duration", func(t *testing.T) {
+		resumeAt, err := parseUntil("2h", now)
+		require.NoError(t, err)
+		assert.Equal(t, now.Add(2*time.Hour), resumeAt)
+	})
+	t.Run("Timestamp", func(t *testing.T) {
+		resumeAt, err := parseUntil("2026-01-03T01:00:00+01:00", now)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC), resumeAt)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := parseUntil("tomorrow", now)
+		require.Error(t, err)
+		_, err = parseUntil("-1h", now)
+		require.Error(t, err)
+	})
+}
@@ -9,7 +9,7 @@ import (
 )
 
 func NewGetCommand() *cobra.Command {
-	var output = common.NewPrintWorkflowOutputValue("")
+	var output = common.NewPrintTemplateOutputValue("")
 
 	command := &cobra.Command{
 		Use:   "get WORKFLOW_TEMPLATE...",
@@ -20,6 +20,9 @@ func NewGetCommand() *cobra.Command {
 
 # Get information about a workflow template in YAML format:
   argo template get my-template -o yaml
+
+# Render the DAG and steps of a workflow template as a Mermaid diagram:
+  argo template get my-template -o mermaid
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
@@ -0,0 +1,214 @@
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	cmdcommon "github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+type cliNewOpts struct {
+	containers []string // --container
+	dag        bool     // --dag
+}
+
+// scaffoldContainer is a container of the --container flag
+type scaffoldContainer struct {
+	name    string
+	image   string
+	command []string
+}
+
+func NewNewCommand() *cobra.Command {
+	var (
+		cliNewOpts cliNewOpts
+		output     = cmdcommon.EnumFlagValue{AllowedValues: []string{"yaml", "json"}, Value: "yaml"}
+	)
+	command := &cobra.Command{
+		Use:   "new NAME",
+		Short: "scaffold a workflow template",
+		Long: `Print a new workflow template, with stubs of inputs and outputs, and best-practice defaults of resources, retries
+and labels, to edit and then create.
+
+Each container runs in a template of its own. With more than one container they run in turn, as steps, or as the
+tasks of a DAG with --dag, each taking the output of the one before as its input.`,
+		Example: `
+# Scaffold a workflow template running a container:
+  argo template new my-template --container image=alpine:3.20 > my-template.yaml
+
+# Scaffold a DAG of two containers:
+  argo template new my-pipeline --dag --container name=build,image=golang:1.24,command="go build ./..." --container name=test,image=golang:1.24,command="go test ./..."
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			wftmpl, err := scaffoldWorkflowTemplate(args[0], &cliNewOpts)
+			if err != nil {
+				return err
+			}
+			var data []byte
+			if output.String() == "json" {
+				data, err = json.MarshalIndent(wftmpl, "", "    ")
+				data = append(data, '\n')
+			} else {
+				data, err = yaml.Marshal(wftmpl)
+			}
+			if err != nil {
+				return err
+			}
+			_, err = os.Stdout.Write(data)
+			return err
+		},
+	}
+	command.Flags().StringArrayVar(&cliNewOpts.containers, "container", []string{"image=argoproj/argosay:v2"}, "A container to run, as comma separated name=NAME, image=IMAGE and command=COMMAND, of which only the image is required, e.g. --container name=main,image=alpine:3.20,command=\"echo hello\"")
+	command.Flags().BoolVar(&cliNewOpts.dag, "dag", false, "Run the containers as the tasks of a DAG, rather than as steps")
+	command.Flags().VarP(&output, "output", "o", "Output format. "+output.Usage())
+	return command
+}
+
+// parseScaffoldContainer parses a --container flag, e.g. name=main,image=alpine:3.20,command="echo hello"
+func parseScaffoldContainer(value string, index int) (scaffoldContainer, error) {
+	c := scaffoldContainer{}
+	for _, pair := range strings.Split(value, ",") {
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok {
+			return c, fmt.Errorf("invalid container %q, expected comma separated KEY=VALUE", value)
+		}
+		v = strings.Trim(v, `"'`)
+		switch k {
+		case "name":
+			c.name = v
+		case "image":
+			c.image = v
+		case "command":
+			c.command = strings.Fields(v)
+		default:
+			return c, fmt.Errorf("invalid container %q, unknown key %q, must be name, image or command", value, k)
+		}
+	}
+	if c.image == "" {
+		return c, fmt.Errorf("invalid container %q, the image is required", value)
+	}
+	if c.name == "" {
+		c.name = fmt.Sprintf("step-%d", index+1)
+	}
+	return c, nil
+}
+
+// scaffoldWorkflowTemplate returns a new workflow template running the containers of the options
+func scaffoldWorkflowTemplate(name string, opts *cliNewOpts) (*wfv1.WorkflowTemplate, error) {
+	if len(opts.containers) == 0 {
+		return nil, fmt.Errorf("at least one --container is required")
+	}
+	var containers []scaffoldContainer
+	for i, value := range opts.containers {
+		c, err := parseScaffoldContainer(value, i)
+		if err != nil {
+			return nil, err
+		}
+		containers = append(containers, c)
+	}
+
+	labels := map[string]string{"app.kubernetes.io/name": name}
+	wftmpl := &wfv1.WorkflowTemplate{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: workflow.APIVersion,
+			Kind:       workflow.WorkflowTemplateKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Spec: wfv1.WorkflowSpec{
+			Entrypoint: "main",
+			Arguments: wfv1.Arguments{Parameters: []wfv1.Parameter{
+				{Name: "input", Value: wfv1.AnyStringPtr("hello")},
+			}},
+			// the workflows of the template are labelled too, so they can be selected with `argo list -l`
+			WorkflowMetadata: &wfv1.WorkflowMetadata{Labels: labels},
+		},
+	}
+
+	for _, c := range containers {
+		wftmpl.Spec.Templates = append(wftmpl.Spec.Templates, scaffoldContainerTemplate(c))
+	}
+	if len(containers) == 1 && !opts.dag {
+		wftmpl.Spec.Entrypoint = containers[0].name
+		wftmpl.Spec.Templates[0].Inputs.Parameters[0].Value = nil
+		return wftmpl, nil
+	}
+
+	main := wfv1.Template{Name: "main"}
+	for i, c := range containers {
+		input := "{{workflow.parameters.input}}"
+		if i > 0 {
+			if opts.dag {
+				input = fmt.Sprintf("{{tasks.%s.outputs.parameters.result}}", containers[i-1].name)
+			} else {
+				input = fmt.Sprintf("{{steps.%s.outputs.parameters.result}}", containers[i-1].name)
+			}
+		}
+		arguments := wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "input", Value: wfv1.AnyStringPtr(input)}}}
+		if opts.dag {
+			if main.DAG == nil {
+				main.DAG = &wfv1.DAGTemplate{}
+			}
+			task := wfv1.DAGTask{Name: c.name, Template: c.name, Arguments: arguments}
+			if i > 0 {
+				task.Depends = containers[i-1].name
+			}
+			main.DAG.Tasks = append(main.DAG.Tasks, task)
+		} else {
+			main.Steps = append(main.Steps, wfv1.ParallelSteps{Steps: []wfv1.WorkflowStep{{Name: c.name, Template: c.name, Arguments: arguments}}})
+		}
+	}
+	wftmpl.Spec.Templates = append([]wfv1.Template{main}, wftmpl.Spec.Templates...)
+	return wftmpl, nil
+}
+
+// scaffoldContainerTemplate returns the template of a container, with an input and an output parameter, and resources
+// and retries to edit
+func scaffoldContainerTemplate(c scaffoldContainer) wfv1.Template {
+	return wfv1.Template{
+		Name: c.name,
+		Inputs: wfv1.Inputs{Parameters: []wfv1.Parameter{
+			{Name: "input", Value: wfv1.AnyStringPtr("")},
+		}},
+		Outputs: wfv1.Outputs{Parameters: []wfv1.Parameter{
+			// the container writes its result to the file, and the default is used if it does not
+			{Name: "result", ValueFrom: &wfv1.ValueFrom{Path: "/tmp/result", Default: wfv1.AnyStringPtr("")}},
+		}},
+		Container: &apiv1.Container{
+			Image:   c.image,
+			Command: c.command,
+			Env: []apiv1.EnvVar{
+				{Name: "INPUT", Value: "{{inputs.parameters.input}}"},
+			},
+			Resources: apiv1.ResourceRequirements{
+				Requests: apiv1.ResourceList{
+					apiv1.ResourceCPU:    resource.MustParse("100m"),
+					apiv1.ResourceMemory: resource.MustParse("128Mi"),
+				},
+				Limits: apiv1.ResourceList{
+					apiv1.ResourceMemory: resource.MustParse("256Mi"),
+				},
+			},
+		},
+		RetryStrategy: &wfv1.RetryStrategy{
+			Limit: ptr.To(intstr.FromInt32(2)),
+			Backoff: &wfv1.Backoff{
+				Duration:    "10s",
+				Factor:      ptr.To(intstr.FromInt32(2)),
+				MaxDuration: "5m",
+			},
+		},
+	}
+}
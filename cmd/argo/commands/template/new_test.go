@@ -0,0 +1,64 @@
+package template
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
+	"github.com/argoproj/argo-workflows/v3/workflow/validate"
+)
+
+func Test_scaffoldWorkflowTemplate(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	clientset := fake.NewSimpleClientset()
+	wftmplGetter := templateresolution.WrapWorkflowTemplateInterface(clientset.ArgoprojV1alpha1().WorkflowTemplates(metav1.NamespaceDefault))
+	cwftmplGetter := templateresolution.WrapClusterWorkflowTemplateInterface(clientset.ArgoprojV1alpha1().ClusterWorkflowTemplates())
+
+	t.Run("Container", func(t *testing.T) {
+		wftmpl, err := scaffoldWorkflowTemplate("my-template", &cliNewOpts{containers: []string{"image=alpine:3.20"}})
+		require.NoError(t, err)
+		require.NoError(t, validate.ValidateWorkflowTemplate(ctx, wftmplGetter, cwftmplGetter, wftmpl, nil, validate.ValidateOpts{}))
+		assert.Equal(t, "step-1", wftmpl.Spec.Entrypoint)
+		require.Len(t, wftmpl.Spec.Templates, 1)
+		tmpl := wftmpl.Spec.Templates[0]
+		assert.Equal(t, "alpine:3.20", tmpl.Container.Image)
+		assert.Equal(t, "128Mi", tmpl.Container.Resources.Requests.Memory().String())
+		assert.Equal(t, "2", tmpl.RetryStrategy.Limit.String())
+		assert.Equal(t, "my-template", wftmpl.Labels["app.kubernetes.io/name"])
+		assert.Equal(t, "my-template", wftmpl.Spec.WorkflowMetadata.Labels["app.kubernetes.io/name"])
+	})
+
+	t.Run("Steps", func(t *testing.T) {
+		wftmpl, err := scaffoldWorkflowTemplate("my-steps", &cliNewOpts{containers: []string{"image=alpine:3.20", "name=second,image=alpine:3.20,command=\"echo hello\""}})
+		require.NoError(t, err)
+		require.NoError(t, validate.ValidateWorkflowTemplate(ctx, wftmplGetter, cwftmplGetter, wftmpl, nil, validate.ValidateOpts{}))
+		require.Len(t, wftmpl.Spec.Templates, 3)
+		main := wftmpl.Spec.Templates[0]
+		require.Len(t, main.Steps, 2)
+		assert.Equal(t, "{{steps.step-1.outputs.parameters.result}}", main.Steps[1].Steps[0].Arguments.Parameters[0].Value.String())
+		assert.Equal(t, []string{"echo", "hello"}, wftmpl.Spec.Templates[2].Container.Command)
+	})
+
+	t.Run("DAG", func(t *testing.T) {
+		wftmpl, err := scaffoldWorkflowTemplate("my-dag", &cliNewOpts{dag: true, containers: []string{"name=build,image=golang:1.24", "name=test,image=golang:1.24"}})
+		require.NoError(t, err)
+		require.NoError(t, validate.ValidateWorkflowTemplate(ctx, wftmplGetter, cwftmplGetter, wftmpl, nil, validate.ValidateOpts{}))
+		main := wftmpl.Spec.Templates[0]
+		require.NotNil(t, main.DAG)
+		require.Len(t, main.DAG.Tasks, 2)
+		assert.Equal(t, "build", main.DAG.Tasks[1].Depends)
+		assert.Equal(t, "{{tasks.build.outputs.parameters.result}}", main.DAG.Tasks[1].Arguments.Parameters[0].Value.String())
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for _, container := range []string{"name=main", "image", "image=alpine,cpu=1"} {
+			_, err := scaffoldWorkflowTemplate("my-template", &cliNewOpts{containers: []string{container}})
+			require.Error(t, err, container)
+		}
+	})
+}
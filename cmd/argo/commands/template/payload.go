@@ -0,0 +1,54 @@
+package template
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/templatesignature"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+func NewPayloadCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "payload FILE",
+		Short: "print the payload of a workflow template or cluster workflow template to sign",
+		Example: `
+# Sign a workflow template with a cosign key pair:
+  argo template payload my-template.yaml > payload.json
+  cosign sign-blob --key cosign.key payload.json
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fileContents, err := util.ReadManifest(args[0])
+			if err != nil {
+				return err
+			}
+			var templates []wfv1.WorkflowSpecHolder
+			for _, body := range fileContents {
+				for _, res := range common.ParseObjects(cmd.Context(), body, false) {
+					switch obj := res.Object.(type) {
+					case *wfv1.WorkflowTemplate, *wfv1.ClusterWorkflowTemplate:
+						if res.Err != nil {
+							return res.Err
+						}
+						templates = append(templates, obj.(wfv1.WorkflowSpecHolder))
+					}
+				}
+			}
+			if len(templates) != 1 {
+				return fmt.Errorf("%s must contain exactly one workflow template or cluster workflow template, not %d", args[0], len(templates))
+			}
+			payload, err := templatesignature.Payload(templates[0].GetWorkflowSpec())
+			if err != nil {
+				return err
+			}
+			_, err = os.Stdout.Write(payload)
+			return err
+		},
+	}
+	return command
+}
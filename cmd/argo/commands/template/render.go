@@ -0,0 +1,116 @@
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	cmdcommon "github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+func NewRenderCommand() *cobra.Command {
+	var (
+		submitOpts     wfv1.SubmitOpts
+		parametersFile string
+		output         = cmdcommon.EnumFlagValue{AllowedValues: []string{"yaml", "json"}, Value: "yaml"}
+	)
+	command := &cobra.Command{
+		Use:   "render FILE",
+		Short: "print the workflow of a workflow template, workflow or cron workflow, as it would be run, without a cluster",
+		Long: `Print the workflow of a workflow template, cluster workflow template, workflow or cron workflow, as it would be
+run with the parameters, without a cluster.
+
+The parameters are defaulted and substituted, as are the global variables such as {{workflow.parameters.x}} and the
+inputs of the entrypoint, and the steps and DAG tasks with withItems, withSequence, or a withParam which is not an
+output, are expanded into a step or task per item. Anything which is only known when running, such as outputs, is
+printed as is.`,
+		Example: `
+# Render a workflow template with a parameter:
+  argo template render my-template.yaml -p message=hello
+
+# Render a workflow with the parameters of a file, from another entrypoint:
+  argo template render my-workflow.yaml --parameter-file params.yaml --entrypoint other
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if parametersFile != "" {
+				if err := util.ReadParametersFile(parametersFile, &submitOpts); err != nil {
+					return err
+				}
+			}
+			fileContents, err := util.ReadManifest(args[0])
+			if err != nil {
+				return err
+			}
+			var workflows []*wfv1.Workflow
+			for _, body := range fileContents {
+				for _, res := range common.ParseObjects(ctx, body, false) {
+					if wf := toRenderedWorkflow(res.Object); wf != nil {
+						if res.Err != nil {
+							return res.Err
+						}
+						workflows = append(workflows, wf)
+					}
+				}
+			}
+			if len(workflows) != 1 {
+				return fmt.Errorf("%s must contain exactly one workflow template, cluster workflow template, workflow or cron workflow, not %d", args[0], len(workflows))
+			}
+			wf := workflows[0]
+			if err := util.ApplySubmitOpts(wf, &submitOpts); err != nil {
+				return err
+			}
+			if err := cmdcommon.RenderWorkflow(ctx, wf); err != nil {
+				return err
+			}
+			var data []byte
+			if output.String() == "json" {
+				data, err = json.MarshalIndent(wf, "", "    ")
+				data = append(data, '\n')
+			} else {
+				data, err = yaml.Marshal(wf)
+			}
+			if err != nil {
+				return err
+			}
+			_, err = os.Stdout.Write(data)
+			return err
+		},
+	}
+	command.Flags().StringVar(&submitOpts.Entrypoint, "entrypoint", "", "override entrypoint")
+	command.Flags().StringArrayVarP(&submitOpts.Parameters, "parameter", "p", []string{}, "pass an input parameter")
+	command.Flags().StringVarP(&parametersFile, "parameter-file", "f", "", "pass a file containing all input parameters")
+	command.Flags().VarP(&output, "output", "o", "Output format. "+output.Usage())
+	return command
+}
+
+// toRenderedWorkflow returns the workflow which the object runs, with the spec of templates inlined, or nil if it does
+// not run one
+func toRenderedWorkflow(obj metav1.Object) *wfv1.Workflow {
+	var wf *wfv1.Workflow
+	switch v := obj.(type) {
+	case *wfv1.Workflow:
+		return v
+	case *wfv1.WorkflowTemplate:
+		wf = common.NewWorkflowFromWorkflowTemplate(v.Name, false)
+		wf.Spec = v.Spec
+	case *wfv1.ClusterWorkflowTemplate:
+		wf = common.NewWorkflowFromWorkflowTemplate(v.Name, true)
+		wf.Spec = v.Spec
+	case *wfv1.CronWorkflow:
+		wf = common.ConvertCronWorkflowToWorkflow(v)
+	default:
+		return nil
+	}
+	wf.TypeMeta = metav1.TypeMeta{Kind: workflow.WorkflowKind, APIVersion: wfv1.SchemeGroupVersion.String()}
+	return wf
+}
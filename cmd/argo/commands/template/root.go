@@ -19,6 +19,9 @@ func NewTemplateCommand() *cobra.Command {
 	command.AddCommand(NewDeleteCommand())
 	command.AddCommand(NewLintCommand())
 	command.AddCommand(NewUpdateCommand())
+	command.AddCommand(NewPayloadCommand())
+	command.AddCommand(NewRenderCommand())
+	command.AddCommand(NewNewCommand())
 
 	return command
 }
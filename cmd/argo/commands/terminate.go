@@ -3,6 +3,7 @@ package commands
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -13,10 +14,12 @@ import (
 )
 
 type terminateOption struct {
-	namespace string
-	labels    string
-	fields    string
-	dryRun    bool
+	namespace   string
+	labels      string
+	fields      string
+	dryRun      bool
+	reason      string
+	gracePeriod time.Duration
 }
 
 func (t *terminateOption) isList() bool {
@@ -61,6 +64,10 @@ func NewTerminateCommand() *cobra.Command {
 # Terminate multiple workflows by field selector
 
   argo terminate --field-selector metadata.namespace=argo
+
+# Terminate a workflow, recording why, and delete its pods if they have not exited 30 seconds after being signalled:
+
+  argo terminate my-wf --reason "runaway costs" --grace-period 30s
 `,
 		Args: func(cmd *cobra.Command, args []string) error {
 			if len(args) == 0 && !t.isList() {
@@ -99,10 +106,15 @@ func NewTerminateCommand() *cobra.Command {
 					continue
 				}
 
-				wf, err := serviceClient.TerminateWorkflow(ctx, &workflowpkg.WorkflowTerminateRequest{
+				req := &workflowpkg.WorkflowTerminateRequest{
 					Name:      w.Name,
 					Namespace: w.Namespace,
-				})
+					Reason:    t.reason,
+				}
+				if t.gracePeriod > 0 {
+					req.GracePeriod = t.gracePeriod.String()
+				}
+				wf, err := serviceClient.TerminateWorkflow(ctx, req)
 				if err != nil {
 					return err
 				}
@@ -115,5 +127,7 @@ func NewTerminateCommand() *cobra.Command {
 	command.Flags().StringVarP(&t.labels, "selector", "l", "", "Selector (label query) to filter on, not including uninitialized ones, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
 	command.Flags().StringVar(&t.fields, "field-selector", "", "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selector key1=value1,key2=value2). The server only supports a limited number of field queries per type.")
 	command.Flags().BoolVar(&t.dryRun, "dry-run", false, "Do not terminate the workflow, only print what would happen")
+	command.Flags().StringVar(&t.reason, "reason", "", "Why the workflow is terminated, recorded in its status and events")
+	command.Flags().DurationVar(&t.gracePeriod, "grace-period", 0, "How long pods have to exit once signalled before they are deleted, e.g. 30s. Defaults to waiting for them to exit")
 	return command
 }
@@ -0,0 +1,236 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	metricsclientset "k8s.io/metrics/pkg/client/clientset/versioned"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/packer"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+func NewTopCommand() *cobra.Command {
+	var (
+		refresh time.Duration
+		once    bool
+	)
+	command := &cobra.Command{
+		Use:   "top WORKFLOW",
+		Short: "display the CPU and memory usage of the nodes of a workflow",
+		Long: `Display the CPU and memory usage of the nodes of a workflow, from the metrics API of the Kubernetes metrics-server.
+
+Pods use their containers' current usage, and steps, DAGs and the workflow use the total usage of their pods.
+Only running pods have usage. The usage is refreshed until the workflow completes.
+
+Requires access to the Kubernetes API, as the metrics API is not available via the Argo Server.`,
+		Example: `# Display the usage of a workflow, refreshing every 5s until it completes:
+
+  argo top my-wf
+
+# Display the usage of the latest workflow once:
+
+  argo top @latest --once
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			ctx, apiClient, err := client.NewAPIClient(ctx)
+			if err != nil {
+				return err
+			}
+			serviceClient := apiClient.NewWorkflowServiceClient(ctx)
+			namespace := client.Namespace(ctx)
+			restConfig, err := client.GetConfig().ClientConfig()
+			if err != nil {
+				return fmt.Errorf("argo top requires access to the Kubernetes API: %w", err)
+			}
+			metricsClient, err := metricsclientset.NewForConfig(restConfig)
+			if err != nil {
+				return err
+			}
+			ticker := time.NewTicker(refresh)
+			defer ticker.Stop()
+			for {
+				wf, err := serviceClient.GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{Name: args[0], Namespace: namespace})
+				if err != nil {
+					return err
+				}
+				usage, err := getPodUsage(ctx, metricsClient, wf)
+				if err != nil {
+					return err
+				}
+				if !once {
+					print("\033[H\033[2J")
+					print("\033[0;0H")
+				}
+				if err := printTop(ctx, os.Stdout, wf, usage); err != nil {
+					return err
+				}
+				if once || !wf.Status.FinishedAt.IsZero() {
+					return nil
+				}
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-ticker.C:
+				}
+			}
+		},
+	}
+	command.Flags().DurationVar(&refresh, "refresh", 5*time.Second, "How often to refresh the usage")
+	command.Flags().BoolVar(&once, "once", false, "Display the usage once, rather than refreshing it until the workflow completes")
+	return command
+}
+
+// getPodUsage returns the usage of each running pod of the workflow, by pod name
+func getPodUsage(ctx context.Context, metricsClient metricsclientset.Interface, wf *wfv1.Workflow) (map[string]apiv1.ResourceList, error) {
+	list, err := metricsClient.MetricsV1beta1().PodMetricses(wf.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: common.LabelKeyWorkflow + "=" + wf.Name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the usage of pods from the metrics API, is the metrics-server installed? %w", err)
+	}
+	return sumPodUsage(list.Items), nil
+}
+
+func sumPodUsage(podMetrics []metricsv1beta1.PodMetrics) map[string]apiv1.ResourceList {
+	usage := make(map[string]apiv1.ResourceList, len(podMetrics))
+	for _, pod := range podMetrics {
+		total := apiv1.ResourceList{}
+		for _, container := range pod.Containers {
+			addUsage(total, container.Usage)
+		}
+		usage[pod.Name] = total
+	}
+	return usage
+}
+
+func addUsage(total, usage apiv1.ResourceList) {
+	for _, name := range []apiv1.ResourceName{apiv1.ResourceCPU, apiv1.ResourceMemory} {
+		if quantity, ok := usage[name]; ok {
+			sum := total[name]
+			sum.Add(quantity)
+			total[name] = sum
+		}
+	}
+}
+
+// printTop prints the node tree of the workflow with the usage of each node: the usage of the pod of pod nodes,
+// and the total usage of the pods beneath other nodes
+func printTop(ctx context.Context, out io.Writer, wf *wfv1.Workflow, usage map[string]apiv1.ResourceList) error {
+	if err := packer.DecompressWorkflow(ctx, wf); err != nil {
+		return err
+	}
+	podNameVersion := util.GetWorkflowPodNameVersion(wf)
+	podName := func(node wfv1.NodeStatus) string {
+		if node.Type != wfv1.NodeTypePod {
+			return ""
+		}
+		return util.GeneratePodName(wf.Name, node.Name, util.GetTemplateFromNode(node), node.ID, podNameVersion)
+	}
+	// nodeUsage is the total usage of the pods beneath the node, counting each pod once
+	var nodeUsage func(id string, total apiv1.ResourceList, visited map[string]bool) bool
+	nodeUsage = func(id string, total apiv1.ResourceList, visited map[string]bool) bool {
+		if visited[id] {
+			return false
+		}
+		visited[id] = true
+		node, ok := wf.Status.Nodes[id]
+		if !ok {
+			return false
+		}
+		found := false
+		if pod, ok := usage[podName(node)]; ok {
+			addUsage(total, pod)
+			found = true
+		}
+		for _, child := range node.Children {
+			found = nodeUsage(child, total, visited) || found
+		}
+		return found
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NODE\tPOD\tCPU(cores)\tMEMORY(bytes)")
+	printed := map[string]bool{}
+	var printNode func(id string, depth int)
+	printNode = func(id string, depth int) {
+		node, ok := wf.Status.Nodes[id]
+		if !ok || printed[id] {
+			return
+		}
+		printed[id] = true
+		total := apiv1.ResourceList{}
+		cpu, memory := "-", "-"
+		if nodeUsage(id, total, map[string]bool{}) {
+			cpu, memory = formatCPU(total), formatMemory(total)
+		}
+		pod := podName(node)
+		if pod == "" {
+			pod = "-"
+		}
+		_, _ = fmt.Fprintf(w, "%s%s\t%s\t%s\t%s\n", strings.Repeat("  ", depth), node.DisplayName, pod, cpu, memory)
+		for _, child := range node.Children {
+			printNode(child, depth+1)
+		}
+	}
+	for _, id := range rootNodes(wf.Status.Nodes) {
+		printNode(id, 0)
+	}
+
+	total := apiv1.ResourceList{}
+	for _, pod := range usage {
+		addUsage(total, pod)
+	}
+	_, _ = fmt.Fprintf(w, "TOTAL\t%d pods\t%s\t%s\n", len(usage), formatCPU(total), formatMemory(total))
+	return w.Flush()
+}
+
+// rootNodes returns the nodes that are not the child of another node, such as the workflow's node and its exit
+// handler's node, in the order they started
+func rootNodes(nodes wfv1.Nodes) []string {
+	children := map[string]bool{}
+	for _, node := range nodes {
+		for _, child := range node.Children {
+			children[child] = true
+		}
+	}
+	var roots []string
+	for id := range nodes {
+		if !children[id] {
+			roots = append(roots, id)
+		}
+	}
+	slices.SortFunc(roots, func(a, b string) int {
+		if c := nodes[a].StartedAt.Compare(nodes[b].StartedAt.Time); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	})
+	return roots
+}
+
+func formatCPU(usage apiv1.ResourceList) string {
+	cpu := usage[apiv1.ResourceCPU]
+	return fmt.Sprintf("%dm", cpu.MilliValue())
+}
+
+func formatMemory(usage apiv1.ResourceList) string {
+	memory := usage[apiv1.ResourceMemory]
+	return fmt.Sprintf("%dMi", memory.Value()/(1024*1024))
+}
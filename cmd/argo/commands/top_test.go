@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	metricsfake "k8s.io/metrics/pkg/client/clientset/versioned/fake"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func podMetrics(name string, containers ...apiv1.ResourceList) metricsv1beta1.PodMetrics {
+	pod := metricsv1beta1.PodMetrics{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-ns", Labels: map[string]string{common.LabelKeyWorkflow: "my-wf"}}}
+	for _, usage := range containers {
+		pod.Containers = append(pod.Containers, metricsv1beta1.ContainerMetrics{Usage: usage})
+	}
+	return pod
+}
+
+func usage(cpu, memory string) apiv1.ResourceList {
+	return apiv1.ResourceList{apiv1.ResourceCPU: resource.MustParse(cpu), apiv1.ResourceMemory: resource.MustParse(memory)}
+}
+
+func Test_printTop(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata: {name: my-wf, namespace: my-ns}
+status:
+  nodes:
+    my-wf: {id: my-wf, name: my-wf, displayName: my-wf, type: Steps, templateName: main, children: [my-wf-1], startedAt: "2025-01-01T00:00:00Z"}
+    my-wf-1: {id: my-wf-1, name: "my-wf[0]", displayName: "[0]", type: StepGroup, templateName: main, children: [my-wf-2, my-wf-3]}
+    my-wf-2: {id: my-wf-2, name: "my-wf[0].a", displayName: a, type: Pod, templateName: work}
+    my-wf-3: {id: my-wf-3, name: "my-wf[0].b", displayName: b, type: Pod, templateName: work}
+    my-wf-4: {id: my-wf-4, name: my-wf.onExit, displayName: my-wf.onExit, type: Pod, templateName: exit, startedAt: "2025-01-01T00:01:00Z"}
+`)
+	podA, podB := "my-wf-work-4289984342", "my-wf-work-4273206723"
+
+	metricsClient := metricsfake.NewSimpleClientset()
+	// the fake clientset cannot track PodMetrics, as their resource is "pods"
+	metricsClient.PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		assert.Equal(t, "workflows.argoproj.io/workflow=my-wf", action.(k8stesting.ListAction).GetListRestrictions().Labels.String())
+		return true, &metricsv1beta1.PodMetricsList{Items: []metricsv1beta1.PodMetrics{
+			podMetrics(podA, usage("100m", "64Mi"), usage("50m", "16Mi")),
+			podMetrics(podB, usage("250m", "128Mi")),
+		}}, nil
+	})
+	podUsage, err := getPodUsage(ctx, metricsClient, wf)
+	require.NoError(t, err)
+	require.Len(t, podUsage, 2)
+
+	var out bytes.Buffer
+	require.NoError(t, printTop(ctx, &out, wf, podUsage))
+	assert.Equal(t, `NODE          POD                    CPU(cores)  MEMORY(bytes)
+my-wf         -                      400m        208Mi
+  [0]         -                      400m        208Mi
+    a         my-wf-work-4289984342  150m        80Mi
+    b         my-wf-work-4273206723  250m        128Mi
+my-wf.onExit  my-wf-exit-288712010   -           -
+TOTAL         2 pods                 400m        208Mi
+`, out.String())
+}
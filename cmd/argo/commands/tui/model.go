@@ -0,0 +1,512 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/humanize"
+	"github.com/argoproj/argo-workflows/v3/util/printer"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/packer"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+type view int
+
+const (
+	workflowsView view = iota
+	workflowView
+	logsView
+)
+
+const (
+	// listFields are the fields of the workflows needed by the workflows view, so the node trees are not listed
+	listFields = "metadata,items.metadata.name,items.metadata.namespace,items.metadata.creationTimestamp,items.metadata.labels,items.status.phase,items.status.message,items.status.startedAt,items.status.finishedAt,items.status.progress"
+	// maxLogLines is the number of log lines kept by the logs view
+	maxLogLines = 1000
+	// chromeLines is the number of lines of the header and footer around the table of a view
+	chromeLines = 4
+)
+
+type (
+	tickMsg      struct{}
+	workflowsMsg struct {
+		workflows wfv1.Workflows
+		err       error
+	}
+	workflowMsg struct {
+		workflow *wfv1.Workflow
+		err      error
+	}
+	logMsg struct {
+		lines <-chan string
+		line  string
+		done  bool
+	}
+	actionMsg struct {
+		status string
+		err    error
+	}
+)
+
+// action is an operation on a workflow, bound to a key
+type action struct {
+	verb string
+	done string
+	run  func(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace, name string) error
+}
+
+var actions = map[string]action{
+	"r": {verb: "retry", done: "retried", run: func(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace, name string) error {
+		_, err := serviceClient.RetryWorkflow(ctx, &workflowpkg.WorkflowRetryRequest{Name: name, Namespace: namespace})
+		return err
+	}},
+	"s": {verb: "stop", done: "stopped", run: func(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace, name string) error {
+		_, err := serviceClient.StopWorkflow(ctx, &workflowpkg.WorkflowStopRequest{Name: name, Namespace: namespace})
+		return err
+	}},
+	"p": {verb: "suspend", done: "suspended", run: func(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace, name string) error {
+		_, err := serviceClient.SuspendWorkflow(ctx, &workflowpkg.WorkflowSuspendRequest{Name: name, Namespace: namespace})
+		return err
+	}},
+	"u": {verb: "resume", done: "resumed", run: func(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace, name string) error {
+		_, err := serviceClient.ResumeWorkflow(ctx, &workflowpkg.WorkflowResumeRequest{Name: name, Namespace: namespace})
+		return err
+	}},
+}
+
+// confirmation is an action waiting for the user to confirm it
+type confirmation struct {
+	action action
+	name   string
+}
+
+// treeNode is a node of the workflow view's node tree
+type treeNode struct {
+	depth   int
+	node    wfv1.NodeStatus
+	podName string
+}
+
+type model struct {
+	ctx           context.Context
+	serviceClient workflowpkg.WorkflowServiceClient
+	namespace     string
+	refresh       time.Duration
+
+	view   view
+	height int
+	status string
+
+	confirm *confirmation
+
+	workflows wfv1.Workflows
+	selected  int
+
+	workflow     *wfv1.Workflow
+	nodes        []treeNode
+	selectedNode int
+
+	podName  string
+	logs     []string
+	logLines <-chan string
+	stopLogs context.CancelFunc
+}
+
+func newModel(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace string, refresh time.Duration) *model {
+	return &model{ctx: ctx, serviceClient: serviceClient, namespace: namespace, refresh: refresh}
+}
+
+func (m *model) Init() tea.Cmd {
+	return tea.Batch(m.listWorkflows, m.tick())
+}
+
+func (m *model) tick() tea.Cmd {
+	return tea.Tick(m.refresh, func(time.Time) tea.Msg { return tickMsg{} })
+}
+
+func (m *model) listWorkflows() tea.Msg {
+	list, err := m.serviceClient.ListWorkflows(m.ctx, &workflowpkg.WorkflowListRequest{
+		Namespace:   m.namespace,
+		ListOptions: &metav1.ListOptions{},
+		Fields:      listFields,
+	})
+	if err != nil {
+		return workflowsMsg{err: err}
+	}
+	return workflowsMsg{workflows: list.Items}
+}
+
+func (m *model) getWorkflow(name string) tea.Cmd {
+	return func() tea.Msg {
+		wf, err := m.serviceClient.GetWorkflow(m.ctx, &workflowpkg.WorkflowGetRequest{Name: name, Namespace: m.namespace})
+		if err == nil {
+			err = packer.DecompressWorkflow(m.ctx, wf)
+		}
+		return workflowMsg{workflow: wf, err: err}
+	}
+}
+
+func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.height = msg.Height
+	case tea.KeyMsg:
+		return m, m.handleKey(msg.String())
+	case tickMsg:
+		switch m.view {
+		case workflowsView:
+			return m, tea.Batch(m.listWorkflows, m.tick())
+		case workflowView:
+			return m, tea.Batch(m.getWorkflow(m.workflow.Name), m.tick())
+		}
+		return m, m.tick()
+	case workflowsMsg:
+		if msg.err != nil {
+			m.status = msg.err.Error()
+			return m, nil
+		}
+		sort.Sort(msg.workflows)
+		m.workflows = msg.workflows
+		m.selected = clamp(m.selected, len(m.workflows))
+	case workflowMsg:
+		if msg.err != nil {
+			m.status = msg.err.Error()
+			return m, nil
+		}
+		// ignore a workflow that is no longer open
+		if m.workflow == nil || m.workflow.Name != msg.workflow.Name {
+			return m, nil
+		}
+		m.workflow = msg.workflow
+		m.nodes = nodeTree(msg.workflow)
+		m.selectedNode = clamp(m.selectedNode, len(m.nodes))
+	case logMsg:
+		// ignore the logs of a stream that has been closed
+		if msg.lines != m.logLines {
+			return m, nil
+		}
+		if msg.done {
+			m.status = "end of logs"
+			return m, nil
+		}
+		m.logs = append(m.logs, msg.line)
+		if len(m.logs) > maxLogLines {
+			m.logs = m.logs[len(m.logs)-maxLogLines:]
+		}
+		return m, waitForLog(msg.lines)
+	case actionMsg:
+		if msg.err != nil {
+			m.status = msg.err.Error()
+		} else {
+			m.status = msg.status
+		}
+	}
+	return m, nil
+}
+
+func (m *model) handleKey(key string) tea.Cmd {
+	if m.confirm != nil {
+		confirm := m.confirm
+		m.confirm = nil
+		if key != "y" {
+			m.status = "cancelled"
+			return nil
+		}
+		m.status = fmt.Sprintf("%s %s...", confirm.action.verb, confirm.name)
+		return func() tea.Msg {
+			if err := confirm.action.run(m.ctx, m.serviceClient, m.namespace, confirm.name); err != nil {
+				return actionMsg{err: fmt.Errorf("failed to %s %s: %w", confirm.action.verb, confirm.name, err)}
+			}
+			return actionMsg{status: fmt.Sprintf("%s %s", confirm.action.done, confirm.name)}
+		}
+	}
+	switch key {
+	case "q", "ctrl+c":
+		m.closeLogs()
+		return tea.Quit
+	case "up", "k":
+		m.move(-1)
+	case "down", "j":
+		m.move(1)
+	case "enter":
+		switch m.view {
+		case workflowsView:
+			if len(m.workflows) == 0 {
+				return nil
+			}
+			wf := m.workflows[m.selected]
+			m.workflow = &wf
+			m.nodes = nil
+			m.selectedNode = 0
+			m.status = ""
+			m.view = workflowView
+			return m.getWorkflow(wf.Name)
+		case workflowView:
+			if len(m.nodes) == 0 || m.nodes[m.selectedNode].podName == "" {
+				return nil
+			}
+			return m.openLogs(m.nodes[m.selectedNode].podName)
+		}
+	case "esc":
+		switch m.view {
+		case workflowView:
+			m.workflow = nil
+			m.nodes = nil
+			m.status = ""
+			m.view = workflowsView
+			return m.listWorkflows
+		case logsView:
+			m.closeLogs()
+			m.status = ""
+			m.view = workflowView
+			return m.getWorkflow(m.workflow.Name)
+		}
+	default:
+		if a, ok := actions[key]; ok {
+			if name := m.selectedWorkflow(); name != "" {
+				m.confirm = &confirmation{action: a, name: name}
+				m.status = fmt.Sprintf("%s %s? (y/n)", a.verb, name)
+			}
+		}
+	}
+	return nil
+}
+
+func (m *model) move(delta int) {
+	switch m.view {
+	case workflowsView:
+		m.selected = clamp(m.selected+delta, len(m.workflows))
+	case workflowView:
+		m.selectedNode = clamp(m.selectedNode+delta, len(m.nodes))
+	}
+}
+
+// selectedWorkflow returns the name of the workflow the actions apply to, if any
+func (m *model) selectedWorkflow() string {
+	switch m.view {
+	case workflowsView:
+		if len(m.workflows) > 0 {
+			return m.workflows[m.selected].Name
+		}
+	case workflowView:
+		return m.workflow.Name
+	}
+	return ""
+}
+
+func (m *model) openLogs(podName string) tea.Cmd {
+	ctx, cancel := context.WithCancel(m.ctx)
+	lines := make(chan string)
+	go streamLogs(ctx, m.serviceClient, &workflowpkg.WorkflowLogRequest{
+		Name:       m.workflow.Name,
+		Namespace:  m.namespace,
+		PodName:    podName,
+		LogOptions: &corev1.PodLogOptions{Container: common.MainContainerName, Follow: true},
+	}, lines)
+	m.podName = podName
+	m.logs = nil
+	m.logLines = lines
+	m.stopLogs = cancel
+	m.status = ""
+	m.view = logsView
+	return waitForLog(lines)
+}
+
+func (m *model) closeLogs() {
+	if m.stopLogs != nil {
+		m.stopLogs()
+	}
+	m.stopLogs = nil
+	m.logLines = nil
+	m.logs = nil
+}
+
+// streamLogs sends the lines of the logs to lines until the stream ends or the context is done, then closes lines
+func streamLogs(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, req *workflowpkg.WorkflowLogRequest, lines chan<- string) {
+	defer close(lines)
+	send := func(line string) bool {
+		select {
+		case lines <- line:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	stream, err := serviceClient.WorkflowLogs(ctx, req)
+	if err != nil {
+		send(fmt.Sprintf("failed to get logs: %v", err))
+		return
+	}
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF || ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			send(fmt.Sprintf("failed to get logs: %v", err))
+			return
+		}
+		if !send(event.Content) {
+			return
+		}
+	}
+}
+
+func waitForLog(lines <-chan string) tea.Cmd {
+	return func() tea.Msg {
+		line, ok := <-lines
+		return logMsg{lines: lines, line: line, done: !ok}
+	}
+}
+
+func (m *model) View() string {
+	var header, body, help string
+	switch m.view {
+	case workflowsView:
+		header = fmt.Sprintf("Workflows in %s (%d)", m.namespace, len(m.workflows))
+		body = m.workflowsTable()
+		help = "enter: open  r: retry  s: stop  p: suspend  u: resume  q: quit"
+	case workflowView:
+		header = fmt.Sprintf("Workflow %s  %s  %s", m.workflow.Name, printer.WorkflowStatus(m.workflow), m.workflow.Status.Progress)
+		body = m.nodesTable()
+		help = "enter: logs  esc: back  r: retry  s: stop  p: suspend  u: resume  q: quit"
+	case logsView:
+		header = fmt.Sprintf("Logs of %s", m.podName)
+		body = m.logsText()
+		help = "esc: back  q: quit"
+	}
+	return header + "\n\n" + body + "\n" + m.status + "\n" + help
+}
+
+func (m *model) workflowsTable() string {
+	if len(m.workflows) == 0 {
+		return "No workflows found\n"
+	}
+	rows := []string{"NAME\tSTATUS\tAGE\tDURATION\tPROGRESS\tMESSAGE"}
+	start, end := window(len(m.workflows), m.selected, m.rows())
+	for _, wf := range m.workflows[start:end] {
+		rows = append(rows, fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s", wf.Name, printer.WorkflowStatus(&wf),
+			humanize.RelativeDurationShort(wf.CreationTimestamp.Time, time.Now()),
+			humanize.RelativeDurationShort(wf.Status.StartedAt.Time, wf.Status.FinishedAt.Time),
+			wf.Status.Progress, wf.Status.Message))
+	}
+	return table(rows, m.selected-start)
+}
+
+func (m *model) nodesTable() string {
+	if len(m.nodes) == 0 {
+		return "No nodes\n"
+	}
+	rows := []string{"NAME\tTYPE\tPHASE\tDURATION\tMESSAGE"}
+	start, end := window(len(m.nodes), m.selectedNode, m.rows())
+	for _, n := range m.nodes[start:end] {
+		rows = append(rows, fmt.Sprintf("%s%s\t%s\t%s\t%s\t%s", strings.Repeat("  ", n.depth), n.node.DisplayName, n.node.Type,
+			n.node.Phase, humanize.RelativeDurationShort(n.node.StartedAt.Time, n.node.FinishedAt.Time), n.node.Message))
+	}
+	return table(rows, m.selectedNode-start)
+}
+
+func (m *model) logsText() string {
+	if len(m.logs) == 0 {
+		return "No logs yet\n"
+	}
+	start := max(0, len(m.logs)-m.rows())
+	return strings.Join(m.logs[start:], "\n") + "\n"
+}
+
+// rows returns the number of rows of a table that fit in the terminal
+func (m *model) rows() int {
+	if m.height <= chromeLines+1 {
+		// the size of the terminal is not known yet
+		return 20
+	}
+	return m.height - chromeLines - 1
+}
+
+// table aligns the header and rows, marking the selected row
+func table(rows []string, selected int) string {
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	for i, row := range rows {
+		marker := "  "
+		if i == selected+1 {
+			marker = "> "
+		}
+		_, _ = fmt.Fprintln(w, marker+row)
+	}
+	_ = w.Flush()
+	return sb.String()
+}
+
+// window returns the range of n rows to show so that the selected row is visible
+func window(n, selected, size int) (int, int) {
+	if n <= size {
+		return 0, n
+	}
+	start := max(0, selected-size+1)
+	return start, start + size
+}
+
+func clamp(i, n int) int {
+	return max(0, min(i, n-1))
+}
+
+// nodeTree flattens the nodes of the workflow into a tree, starting from the nodes that are not the child of
+// another node, such as the workflow's node and its exit handler's node
+func nodeTree(wf *wfv1.Workflow) []treeNode {
+	children := map[string]bool{}
+	for _, node := range wf.Status.Nodes {
+		for _, child := range node.Children {
+			children[child] = true
+		}
+	}
+	var roots []string
+	for id := range wf.Status.Nodes {
+		if !children[id] {
+			roots = append(roots, id)
+		}
+	}
+	slices.SortFunc(roots, func(a, b string) int {
+		if c := wf.Status.Nodes[a].StartedAt.Compare(wf.Status.Nodes[b].StartedAt.Time); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	})
+
+	podNameVersion := util.GetWorkflowPodNameVersion(wf)
+	var tree []treeNode
+	visited := map[string]bool{}
+	var walk func(id string, depth int)
+	walk = func(id string, depth int) {
+		node, ok := wf.Status.Nodes[id]
+		if !ok || visited[id] {
+			return
+		}
+		visited[id] = true
+		n := treeNode{depth: depth, node: node}
+		if node.Type == wfv1.NodeTypePod {
+			n.podName = util.GeneratePodName(wf.Name, node.Name, util.GetTemplateFromNode(node), node.ID, podNameVersion)
+		}
+		tree = append(tree, n)
+		for _, child := range node.Children {
+			walk(child, depth+1)
+		}
+	}
+	for _, id := range roots {
+		walk(id, 0)
+	}
+	return tree
+}
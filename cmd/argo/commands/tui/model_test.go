@@ -0,0 +1,158 @@
+package tui
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	workflowmocks "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow/mocks"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+type logsClient struct {
+	grpc.ClientStream
+	lines []string
+}
+
+func (c *logsClient) Recv() (*workflowpkg.LogEntry, error) {
+	if len(c.lines) == 0 {
+		return nil, io.EOF
+	}
+	line := c.lines[0]
+	c.lines = c.lines[1:]
+	return &workflowpkg.LogEntry{Content: line}, nil
+}
+
+var testWorkflow = `
+metadata: {name: my-wf, namespace: my-ns}
+status:
+  phase: Running
+  progress: 1/2
+  nodes:
+    my-wf: {id: my-wf, name: my-wf, displayName: my-wf, type: Steps, templateName: main, phase: Running, children: [my-wf-1], startedAt: "2025-01-01T00:00:00Z"}
+    my-wf-1: {id: my-wf-1, name: "my-wf[0]", displayName: "[0]", type: StepGroup, templateName: main, phase: Running, children: [my-wf-2, my-wf-3]}
+    my-wf-2: {id: my-wf-2, name: "my-wf[0].a", displayName: a, type: Pod, templateName: work, phase: Succeeded}
+    my-wf-3: {id: my-wf-3, name: "my-wf[0].b", displayName: b, type: Pod, templateName: work, phase: Running}
+`
+
+// send updates the model with the message, then with the messages of the commands it returns, except ticks
+func send(t *testing.T, m *model, msg tea.Msg) {
+	t.Helper()
+	_, cmd := m.Update(msg)
+	run(t, m, cmd)
+}
+
+func run(t *testing.T, m *model, cmd tea.Cmd) {
+	t.Helper()
+	if cmd == nil {
+		return
+	}
+	switch msg := cmd().(type) {
+	case tea.BatchMsg:
+		for _, cmd := range msg {
+			run(t, m, cmd)
+		}
+	case tickMsg:
+	default:
+		send(t, m, msg)
+	}
+}
+
+func key(k string) tea.KeyMsg {
+	switch k {
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	case "down":
+		return tea.KeyMsg{Type: tea.KeyDown}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+}
+
+func TestModel(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(testWorkflow)
+	c := &workflowmocks.WorkflowServiceClient{}
+	c.On("ListWorkflows", mock.Anything, mock.MatchedBy(func(req *workflowpkg.WorkflowListRequest) bool {
+		return req.Namespace == "my-ns" && req.Fields == listFields
+	})).Return(&wfv1.WorkflowList{Items: wfv1.Workflows{*wf}}, nil)
+	c.On("GetWorkflow", mock.Anything, &workflowpkg.WorkflowGetRequest{Name: "my-wf", Namespace: "my-ns"}).Return(wf, nil)
+	c.On("WorkflowLogs", mock.Anything, mock.MatchedBy(func(req *workflowpkg.WorkflowLogRequest) bool {
+		return req.Name == "my-wf" && req.PodName == "my-wf-work-4273206723" && req.LogOptions.Container == "main" && req.LogOptions.Follow
+	})).Return(&logsClient{lines: []string{"hello", "world"}}, nil)
+	c.On("StopWorkflow", mock.Anything, &workflowpkg.WorkflowStopRequest{Name: "my-wf", Namespace: "my-ns"}).Return(wf, nil)
+
+	m := newModel(ctx, c, "my-ns", time.Millisecond)
+	run(t, m, m.Init())
+	require.Len(t, m.workflows, 1)
+	assert.Contains(t, m.View(), "Workflows in my-ns (1)")
+	assert.Contains(t, m.View(), "> my-wf")
+
+	t.Run("Workflow", func(t *testing.T) {
+		send(t, m, key("enter"))
+		assert.Equal(t, workflowView, m.view)
+		require.Len(t, m.nodes, 4)
+		send(t, m, tickMsg{})
+		assert.Equal(t, []int{0, 1, 2, 2}, []int{m.nodes[0].depth, m.nodes[1].depth, m.nodes[2].depth, m.nodes[3].depth})
+		assert.Contains(t, m.View(), "Workflow my-wf  Running  1/2")
+
+		// non-pod nodes have no logs
+		send(t, m, key("enter"))
+		assert.Equal(t, workflowView, m.view)
+	})
+
+	t.Run("Logs", func(t *testing.T) {
+		for range 3 {
+			send(t, m, key("down"))
+		}
+		assert.Contains(t, m.View(), ">     b  Pod")
+		send(t, m, key("enter"))
+		assert.Equal(t, logsView, m.view)
+		assert.Equal(t, []string{"hello", "world"}, m.logs)
+		assert.Equal(t, "end of logs", m.status)
+		assert.Contains(t, m.View(), "Logs of my-wf-work-4273206723")
+
+		send(t, m, key("esc"))
+		assert.Equal(t, workflowView, m.view)
+		assert.Nil(t, m.logs)
+	})
+
+	t.Run("Action", func(t *testing.T) {
+		send(t, m, key("s"))
+		assert.Equal(t, "stop my-wf? (y/n)", m.status)
+		send(t, m, key("n"))
+		assert.Equal(t, "cancelled", m.status)
+		c.AssertNotCalled(t, "StopWorkflow", mock.Anything, mock.Anything)
+
+		send(t, m, key("s"))
+		send(t, m, key("y"))
+		assert.Equal(t, "stopped my-wf", m.status)
+		c.AssertNumberOfCalls(t, "StopWorkflow", 1)
+	})
+
+	t.Run("Back", func(t *testing.T) {
+		send(t, m, key("esc"))
+		assert.Equal(t, workflowsView, m.view)
+		assert.Nil(t, m.workflow)
+		_, cmd := m.Update(key("q"))
+		assert.IsType(t, tea.QuitMsg{}, cmd())
+	})
+}
+
+func TestWindow(t *testing.T) {
+	start, end := window(5, 3, 10)
+	assert.Equal(t, []int{0, 5}, []int{start, end})
+	start, end = window(30, 3, 10)
+	assert.Equal(t, []int{0, 10}, []int{start, end})
+	start, end = window(30, 25, 10)
+	assert.Equal(t, []int{16, 26}, []int{start, end})
+}
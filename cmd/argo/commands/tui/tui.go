@@ -0,0 +1,56 @@
+package tui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+)
+
+func NewTUICommand() *cobra.Command {
+	var refresh time.Duration
+	command := &cobra.Command{
+		Use:   "tui",
+		Short: "interactive terminal UI for workflows",
+		Long: `Interactive, full-screen terminal UI for the workflows of a namespace.
+
+The workflows view lists the workflows, the workflow view shows the live node tree of a workflow, and the logs view
+streams the logs of a pod node. Workflows can be retried, stopped, suspended and resumed from the workflows and
+workflow views, after confirming.
+
+Keys:
+
+  up/k, down/j  move the selection
+  enter         open the selected workflow, or the logs of the selected node
+  esc           go back
+  r             retry the workflow
+  s             stop the workflow
+  p             suspend the workflow
+  u             resume the workflow
+  q, ctrl+c     quit`,
+		Example: `# Browse the workflows of the current namespace:
+
+  argo tui
+
+# Browse the workflows of another namespace, refreshing every 5s:
+
+  argo tui -n my-ns --refresh 5s
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			ctx, apiClient, err := client.NewAPIClient(ctx)
+			if err != nil {
+				return err
+			}
+			serviceClient := apiClient.NewWorkflowServiceClient(ctx)
+			m := newModel(ctx, serviceClient, client.Namespace(ctx), refresh)
+			_, err = tea.NewProgram(m, tea.WithAltScreen(), tea.WithContext(ctx)).Run()
+			return err
+		},
+	}
+	command.Flags().DurationVar(&refresh, "refresh", 2*time.Second, "How often to refresh the workflows and the node tree")
+	return command
+}
@@ -1,17 +1,34 @@
 package commands
 
 import (
+	"errors"
+	"os"
+
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 )
 
 func NewWaitCommand() *cobra.Command {
-	var ignoreNotFound bool
+	var (
+		waitOpts common.WaitOpts
+		selector string
+		all      bool
+	)
 	command := &cobra.Command{
 		Use:   "wait [WORKFLOW...]",
 		Short: "waits for workflows to complete",
+		Long: `Waits for workflows to complete, exiting with a non-zero status if any of them did not succeed.
+
+With --any, waits for any of the workflows to succeed instead, exiting with a non-zero status if none of them did.
+
+With --until, waits for the workflows to meet an expression instead of succeeding, such as
+'status.phase == "Running"'. The expression can use the fields of the workflow, such as metadata.labels and
+status.phase, and its output parameters by name, as outputs.parameters. A workflow which completes without meeting
+the expression did not succeed.`,
 		Example: `# Wait on a workflow:
 
   argo wait my-wf
@@ -19,8 +36,25 @@ func NewWaitCommand() *cobra.Command {
 # Wait on the latest workflow:
 
   argo wait @latest
+
+# Wait for any of the workflows of a cron workflow to succeed:
+
+  argo wait -l workflows.argoproj.io/cron-workflow=my-cron --any
+
+# Wait until a workflow succeeds or outputs a parameter:
+
+  argo wait my-wf --until 'status.phase == "Succeeded" || outputs.parameters.x == "done"'
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if all && waitOpts.Any {
+				return errors.New("--all and --any cannot be used together")
+			}
+			if len(args) == 0 && selector == "" {
+				return errors.New("a workflow, or --selector, is required")
+			}
+			if err := common.ValidateUntil(waitOpts.Until); err != nil {
+				return err
+			}
 			ctx := cmd.Context()
 			ctx, apiClient, err := client.NewAPIClient(ctx)
 			if err != nil {
@@ -28,10 +62,33 @@ func NewWaitCommand() *cobra.Command {
 			}
 			serviceClient := apiClient.NewWorkflowServiceClient(ctx)
 			namespace := client.Namespace(ctx)
-			common.WaitWorkflows(ctx, serviceClient, namespace, args, ignoreNotFound, false)
+			workflowNames := args
+			if selector != "" {
+				list, err := serviceClient.ListWorkflows(ctx, &workflowpkg.WorkflowListRequest{
+					Namespace:   namespace,
+					ListOptions: &metav1.ListOptions{LabelSelector: selector},
+					Fields:      "items.metadata.name",
+				})
+				if err != nil {
+					return err
+				}
+				for _, wf := range list.Items {
+					workflowNames = append(workflowNames, wf.Name)
+				}
+				if len(workflowNames) == 0 {
+					return errors.New("no workflows found")
+				}
+			}
+			if !common.WaitWorkflowsUntil(ctx, serviceClient, namespace, workflowNames, waitOpts) {
+				os.Exit(1)
+			}
 			return nil
 		},
 	}
-	command.Flags().BoolVar(&ignoreNotFound, "ignore-not-found", false, "Ignore the wait if the workflow is not found")
+	command.Flags().BoolVar(&waitOpts.IgnoreNotFound, "ignore-not-found", false, "Ignore the wait if the workflow is not found")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Selector (label query) of the workflows to wait for, as well as the listed workflows")
+	command.Flags().BoolVar(&all, "all", false, "Wait for all of the workflows (the default)")
+	command.Flags().BoolVar(&waitOpts.Any, "any", false, "Wait for any of the workflows")
+	command.Flags().StringVar(&waitOpts.Until, "until", "", "Wait until the workflows meet this expression, rather than until they succeed")
 	return command
 }
@@ -1,6 +1,8 @@
 package commands
 
 import (
+	"errors"
+
 	"github.com/spf13/cobra"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
@@ -8,11 +10,16 @@ import (
 )
 
 func NewWatchCommand() *cobra.Command {
-	var getArgs common.GetFlags
+	var (
+		getArgs       common.GetFlags
+		labelSelector string
+		fieldSelector string
+		allNamespaces bool
+	)
 
 	command := &cobra.Command{
-		Use:   "watch WORKFLOW",
-		Short: "watch a workflow until it completes",
+		Use:   "watch [WORKFLOW | --selector SELECTOR]",
+		Short: "watch a workflow, or the workflows matching a selector, until they complete",
 		Example: `# Watch a workflow:
 
   argo watch my-wf
@@ -20,8 +27,25 @@ func NewWatchCommand() *cobra.Command {
 # Watch the latest workflow:
 
   argo watch @latest
+
+# Watch a step of a workflow, with its succeeded steps and DAGs expanded:
+
+  argo watch my-wf --watch-node my-step --collapse=false
+
+# Watch the phase, progress and duration of all the workflows with a label, one line per workflow:
+
+  argo watch -l app=nightly
 `,
-		Args: cobra.ExactArgs(1),
+		Args: func(cmd *cobra.Command, args []string) error {
+			hasSelector := labelSelector != "" || fieldSelector != "" || allNamespaces
+			if hasSelector && len(args) > 0 {
+				return errors.New("cannot watch a workflow and a selector at the same time")
+			}
+			if !hasSelector && len(args) != 1 {
+				return errors.New("requires either a workflow or a selector")
+			}
+			return nil
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 			ctx, apiClient, err := client.NewAPIClient(ctx)
@@ -30,10 +54,21 @@ func NewWatchCommand() *cobra.Command {
 			}
 			serviceClient := apiClient.NewWorkflowServiceClient(ctx)
 			namespace := client.Namespace(ctx)
+			if len(args) == 0 {
+				if allNamespaces {
+					namespace = ""
+				}
+				return common.WatchWorkflows(ctx, serviceClient, namespace, labelSelector, fieldSelector)
+			}
 			return common.WatchWorkflow(ctx, serviceClient, namespace, args[0], getArgs)
 		},
 	}
 	command.Flags().StringVar(&getArgs.Status, "status", "", "Filter by status (Pending, Running, Succeeded, Skipped, Failed, Error)")
 	command.Flags().StringVar(&getArgs.NodeFieldSelectorString, "node-field-selector", "", "selector of node to display, eg: --node-field-selector phase=abc")
+	command.Flags().StringVar(&getArgs.Node, "watch-node", "", "Only display the subtree of the node with this name, display name or ID")
+	command.Flags().BoolVar(&getArgs.Collapse, "collapse", true, "Display the succeeded steps and DAGs as a single node")
+	command.Flags().StringVarP(&labelSelector, "selector", "l", "", "Watch the workflows matching this selector (label query), one line per workflow, e.g. -l key1=value1,key2=value2")
+	command.Flags().StringVar(&fieldSelector, "field-selector", "", "Watch the workflows matching this selector (field query), one line per workflow, e.g. --field-selector metadata.name=my-wf")
+	command.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "Watch the workflows of all namespaces, one line per workflow")
 	return command
 }
@@ -0,0 +1,49 @@
+package lint
+
+import (
+	"encoding/json"
+)
+
+// builtinRule is the rule the errors of the built-in checks are reported as in machine-readable output
+const builtinRule = "argo"
+
+type formatterJSON struct{}
+
+type jsonResults struct {
+	Success bool         `json:"success"`
+	Results []jsonResult `json:"results"`
+}
+
+type jsonResult struct {
+	File     string    `json:"file"`
+	Findings []Finding `json:"findings"`
+}
+
+// Format prints nothing, as the results are a single document printed by Summarize
+func (f formatterJSON) Format(*LintResult) string {
+	return ""
+}
+
+func (f formatterJSON) Summarize(l *LintResults) string {
+	out := jsonResults{Success: l.Success, Results: []jsonResult{}}
+	for _, r := range l.Results {
+		if !r.Linted {
+			continue
+		}
+		out.Results = append(out.Results, jsonResult{File: r.File, Findings: allFindings(r)})
+	}
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return err.Error() + "\n"
+	}
+	return string(data) + "\n"
+}
+
+// allFindings returns the errors of the built-in checks, followed by the findings of the custom rules
+func allFindings(r *LintResult) []Finding {
+	findings := make([]Finding, 0, len(r.Errs)+len(r.Findings))
+	for _, e := range r.Errs {
+		findings = append(findings, Finding{Rule: builtinRule, Severity: SeverityError, Message: e.Error()})
+	}
+	return append(findings, r.Findings...)
+}
@@ -0,0 +1,51 @@
+package lint
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONSummarize(t *testing.T) {
+	msg := formatterJSON{}.Summarize(&LintResults{
+		Results: []*LintResult{
+			{
+				File:     "test1",
+				Errs:     []error{fmt.Errorf("some error")},
+				Findings: []Finding{{Object: `"foo" (Workflow)`, Rule: "team-label", Severity: SeverityWarning, Message: "must have a team label"}},
+				Linted:   true,
+			},
+			{File: "test2", Linted: true},
+			{File: "test3"},
+		},
+	})
+	expected := `{
+  "success": false,
+  "results": [
+    {
+      "file": "test1",
+      "findings": [
+        {
+          "rule": "argo",
+          "severity": "error",
+          "message": "some error"
+        },
+        {
+          "object": "\"foo\" (Workflow)",
+          "rule": "team-label",
+          "severity": "warning",
+          "message": "must have a team label"
+        }
+      ]
+    },
+    {
+      "file": "test2",
+      "findings": []
+    }
+  ]
+}
+`
+	assert.Equal(t, expected, msg)
+	assert.Empty(t, formatterJSON{}.Format(&LintResult{File: "test1", Linted: true, Errs: []error{fmt.Errorf("some error")}}))
+}
@@ -21,7 +21,7 @@ func (f formatterPretty) Format(l *LintResult) string {
 		return ""
 	}
 
-	if len(l.Errs) == 0 {
+	if len(l.Errs) == 0 && len(l.Findings) == 0 {
 		return ""
 	}
 
@@ -31,6 +31,9 @@ func (f formatterPretty) Format(l *LintResult) string {
 	for _, e := range l.Errs {
 		fmt.Fprintf(sb, "%s%s %s\n", lintIndentation, color.Ize(color.Red, "✖"), e)
 	}
+	for _, f := range l.Findings {
+		fmt.Fprintf(sb, "%s%s %s\n", lintIndentation, severitySymbol(f.Severity), f)
+	}
 	sb.WriteString("\n")
 
 	return sb.String()
@@ -38,7 +41,14 @@ func (f formatterPretty) Format(l *LintResult) string {
 
 func (f formatterPretty) Summarize(l *LintResults) string {
 	setColorize()
+	warnings := 0
+	for _, r := range l.Results {
+		warnings += r.countFindings(SeverityWarning)
+	}
 	if l.Success {
+		if warnings > 0 {
+			return fmt.Sprintf("%s no linting errors found, %s\n", color.Ize(color.Green, "✔"), color.Ize(color.Yellow, fmt.Sprintf("%d warnings", warnings)))
+		}
 		return fmt.Sprintf("%s no linting errors found!\n", color.Ize(color.Green, "✔"))
 	}
 
@@ -48,12 +58,23 @@ func (f formatterPretty) Summarize(l *LintResults) string {
 
 	totErr := 0
 	for _, r := range l.Results {
-		totErr += len(r.Errs)
+		totErr += len(r.Errs) + r.countFindings(SeverityError)
 	}
 
 	return fmt.Sprintln(color.Ize(color.Red, fmt.Sprintf("✖ %d linting errors found!", totErr)))
 }
 
+func severitySymbol(severity Severity) string {
+	switch severity {
+	case SeverityError:
+		return color.Ize(color.Red, "✖")
+	case SeverityWarning:
+		return color.Ize(color.Yellow, "⚠")
+	default:
+		return color.Ize(color.Blue, "ℹ")
+	}
+}
+
 func setColorize() {
 	color.Toggle(!common.NoColor)
 }
@@ -27,6 +27,22 @@ func TestPrettySummarize(t *testing.T) {
 		expected := fmt.Sprintf("%s\n", color.Ize(color.Red, "✖ found nothing to lint in the specified paths, failing..."))
 		assert.Equal(t, expected, msg)
 	})
+	t.Run("Warnings", func(t *testing.T) {
+		msg := formatterPretty{}.Summarize(&LintResults{
+			Results: []*LintResult{{Linted: true, Findings: []Finding{{Severity: SeverityWarning}, {Severity: SeverityInfo}}}},
+			Success: true,
+		})
+		expected := fmt.Sprintf("%s no linting errors found, %s\n", color.Ize(color.Green, "✔"), color.Ize(color.Yellow, "1 warnings"))
+		assert.Equal(t, expected, msg)
+	})
+	t.Run("Rule errors", func(t *testing.T) {
+		msg := formatterPretty{}.Summarize(&LintResults{
+			Results:        []*LintResult{{Linted: true, Errs: []error{fmt.Errorf("some error")}, Findings: []Finding{{Severity: SeverityError}, {Severity: SeverityWarning}}}},
+			anythingLinted: true,
+		})
+		expected := fmt.Sprintln(color.Ize(color.Red, "✖ 2 linting errors found!"))
+		assert.Equal(t, expected, msg)
+	})
 }
 
 func TestPrettyFormat(t *testing.T) {
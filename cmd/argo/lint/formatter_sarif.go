@@ -0,0 +1,112 @@
+package lint
+
+import (
+	"encoding/json"
+	"slices"
+)
+
+// formatterSARIF prints the results in the Static Analysis Results Interchange Format, which CI systems such as
+// GitHub code scanning import
+type formatterSARIF struct{}
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// Format prints nothing, as the results are a single document printed by Summarize
+func (f formatterSARIF) Format(*LintResult) string {
+	return ""
+}
+
+func (f formatterSARIF) Summarize(l *LintResults) string {
+	run := sarifRun{
+		Tool:    sarifTool{Driver: sarifDriver{Name: "argo lint", InformationURI: "https://argo-workflows.readthedocs.io/", Rules: []sarifRule{}}},
+		Results: []sarifResult{},
+	}
+	var ruleIDs []string
+	for _, r := range l.Results {
+		if !r.Linted {
+			continue
+		}
+		for _, finding := range allFindings(r) {
+			if !slices.Contains(ruleIDs, finding.Rule) {
+				ruleIDs = append(ruleIDs, finding.Rule)
+				run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{ID: finding.Rule})
+			}
+			text := finding.Message
+			if finding.Object != "" {
+				text = "in " + finding.Object + ": " + text
+			}
+			run.Results = append(run.Results, sarifResult{
+				RuleID:    finding.Rule,
+				Level:     sarifLevel(finding.Severity),
+				Message:   sarifMessage{Text: text},
+				Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: r.File}}}},
+			})
+		}
+	}
+	data, err := json.MarshalIndent(sarifLog{
+		Version: "2.1.0",
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Runs:    []sarifRun{run},
+	}, "", "  ")
+	if err != nil {
+		return err.Error() + "\n"
+	}
+	return string(data) + "\n"
+}
+
+func sarifLevel(severity Severity) string {
+	switch severity {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	default:
+		return "note"
+	}
+}
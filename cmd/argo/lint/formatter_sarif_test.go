@@ -0,0 +1,40 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSARIFSummarize(t *testing.T) {
+	msg := formatterSARIF{}.Summarize(&LintResults{
+		Results: []*LintResult{
+			{
+				File: "test1",
+				Errs: []error{fmt.Errorf("some error")},
+				Findings: []Finding{
+					{Object: `"foo" (Workflow)`, Rule: "team-label", Severity: SeverityWarning, Message: "must have a team label"},
+					{Object: `"bar" (Workflow)`, Rule: "team-label", Severity: SeverityInfo, Message: "must have a team label"},
+				},
+				Linted: true,
+			},
+		},
+	})
+	log := sarifLog{}
+	require.NoError(t, json.Unmarshal([]byte(msg), &log))
+	assert.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 1)
+	run := log.Runs[0]
+	assert.Equal(t, []sarifRule{{ID: "argo"}, {ID: "team-label"}}, run.Tool.Driver.Rules)
+	require.Len(t, run.Results, 3)
+	assert.Equal(t, "argo", run.Results[0].RuleID)
+	assert.Equal(t, "error", run.Results[0].Level)
+	assert.Equal(t, "some error", run.Results[0].Message.Text)
+	assert.Equal(t, "test1", run.Results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	assert.Equal(t, "warning", run.Results[1].Level)
+	assert.Equal(t, `in "foo" (Workflow): must have a team label`, run.Results[1].Message.Text)
+	assert.Equal(t, "note", run.Results[2].Level)
+}
@@ -12,7 +12,7 @@ func (f formatterSimple) Format(l *LintResult) string {
 		return ""
 	}
 
-	if len(l.Errs) == 0 {
+	if len(l.Errs) == 0 && len(l.Findings) == 0 {
 		return ""
 	}
 
@@ -20,6 +20,9 @@ func (f formatterSimple) Format(l *LintResult) string {
 	for _, e := range l.Errs {
 		fmt.Fprintf(sb, "%s: %s\n", l.File, e)
 	}
+	for _, f := range l.Findings {
+		fmt.Fprintf(sb, "%s: %s: %s\n", l.File, f.Severity, f)
+	}
 
 	return sb.String()
 }
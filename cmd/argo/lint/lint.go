@@ -19,6 +19,7 @@ import (
 	fileutil "github.com/argoproj/argo-workflows/v3/util/file"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/podsecurity"
 )
 
 type ServiceClients struct {
@@ -35,6 +36,17 @@ type LintOptions struct {
 	Formatter        Formatter
 	ServiceClients   ServiceClients
 
+	// ValidateSchemas if true the manifests are validated against the bundled CRD schemas of this version, reporting
+	// every unknown field, unsupported enum value and missing required field
+	ValidateSchemas bool
+
+	// PodSecurityStandard if not empty is the Pod Security Standard the security settings of the manifests are
+	// validated against
+	PodSecurityStandard string
+
+	// Rules are the custom rules the objects are checked against
+	Rules Rules
+
 	// Printer if not nil the lint result is written to this writer after each
 	// file is linted.
 	Printer io.Writer
@@ -45,6 +57,8 @@ type LintResult struct {
 	File   string
 	Errs   []error
 	Linted bool
+	// Findings are the violations of custom rules
+	Findings []Finding
 }
 
 // LintResults represents the result of linting objects from multiple sources
@@ -67,6 +81,8 @@ var (
 	formatters = map[string]Formatter{
 		"pretty": formatterPretty{},
 		"simple": formatterSimple{},
+		"json":   formatterJSON{},
+		"sarif":  formatterSARIF{},
 	}
 )
 
@@ -173,6 +189,9 @@ func lintData(ctx context.Context, src string, data []byte, opts *LintOptions) *
 					&clusterworkflowtemplatepkg.ClusterWorkflowTemplateLintRequest{Template: v},
 				)
 			}
+			if err == nil {
+				err = checkPodSecurity(opts, &v.Spec)
+			}
 		case *wfv1.CronWorkflow:
 			objName = getObjectName(wf.CronWorkflowKind, v, i)
 			if opts.ServiceClients.CronWorkflowsClient == nil {
@@ -186,6 +205,9 @@ func lintData(ctx context.Context, src string, data []byte, opts *LintOptions) *
 					&cronworkflowpkg.LintCronWorkflowRequest{Namespace: namespace, CronWorkflow: v},
 				)
 			}
+			if err == nil {
+				err = checkPodSecurity(opts, &v.Spec.WorkflowSpec)
+			}
 		case *wfv1.Workflow:
 			objName = getObjectName(wf.WorkflowKind, v, i)
 			if opts.ServiceClients.WorkflowsClient == nil {
@@ -199,6 +221,9 @@ func lintData(ctx context.Context, src string, data []byte, opts *LintOptions) *
 					&workflowpkg.WorkflowLintRequest{Namespace: namespace, Workflow: v},
 				)
 			}
+			if err == nil {
+				err = checkPodSecurity(opts, &v.Spec)
+			}
 		case *wfv1.WorkflowEventBinding:
 			// noop
 		case *wfv1.WorkflowTemplate:
@@ -214,10 +239,27 @@ func lintData(ctx context.Context, src string, data []byte, opts *LintOptions) *
 					&workflowtemplatepkg.WorkflowTemplateLintRequest{Namespace: namespace, Template: v},
 				)
 			}
+			if err == nil {
+				err = checkPodSecurity(opts, &v.Spec)
+			}
 		default:
 			continue // silently ignore unknown kinds
 		}
 
+		if pr.Err == nil {
+			res.Findings = append(res.Findings, opts.Rules.check(objName, obj)...)
+		}
+
+		if opts.ValidateSchemas && pr.Unstructured != nil {
+			// the schema errors include the ones of strict parsing, and more, so they are reported instead
+			if schemaErrs := validateSchema(pr.Unstructured); len(schemaErrs) > 0 {
+				for _, schemaErr := range schemaErrs {
+					res.Errs = append(res.Errs, fmt.Errorf("in %s: %w", objName, schemaErr))
+				}
+				continue
+			}
+		}
+
 		if err != nil {
 			res.Errs = append(res.Errs, fmt.Errorf("in %s: %w", objName, err))
 		}
@@ -226,6 +268,17 @@ func lintData(ctx context.Context, src string, data []byte, opts *LintOptions) *
 	return res
 }
 
+// checkPodSecurity validates the security settings of the spec against the Pod Security Standard of the options
+func checkPodSecurity(opts *LintOptions, spec *wfv1.WorkflowSpec) error {
+	if opts.PodSecurityStandard != podsecurity.Restricted {
+		return nil
+	}
+	if err := podsecurity.CheckWorkflowSpec(spec); err != nil {
+		return fmt.Errorf("spec.%w", err)
+	}
+	return nil
+}
+
 func (l *LintResults) Msg() string {
 	return l.msg
 }
@@ -241,7 +294,7 @@ func (l *LintResults) evaluate() *LintResults {
 		}
 		l.anythingLinted = true
 
-		if len(r.Errs) == 0 {
+		if len(r.Errs) == 0 && r.countFindings(SeverityError) == 0 {
 			continue
 		}
 		success = false
@@ -257,6 +310,17 @@ func (l *LintResults) evaluate() *LintResults {
 	return l
 }
 
+// countFindings returns the number of findings of the severity
+func (r *LintResult) countFindings(severity Severity) int {
+	n := 0
+	for _, f := range r.Findings {
+		if f.Severity == severity {
+			n++
+		}
+	}
+	return n
+}
+
 func (l *LintResults) buildMsg() string {
 	sb := &strings.Builder{}
 	for _, r := range l.Results {
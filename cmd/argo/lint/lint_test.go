@@ -129,6 +129,101 @@ func TestLintMultipleKinds(t *testing.T) {
 	wftServiceSclientMock.AssertNumberOfCalls(t, "LintWorkflowTemplate", 1)
 }
 
+func TestLintPodSecurityStandard(t *testing.T) {
+	file, err := os.CreateTemp("", "*.yaml")
+	require.NoError(t, err)
+	err = os.WriteFile(file.Name(), []byte(`
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: host-network-
+spec:
+  entrypoint: main
+  hostNetwork: true
+  templates:
+  - name: main
+    container:
+      image: argoproj/argosay:v2
+`), 0o600)
+	require.NoError(t, err)
+	defer os.Remove(file.Name())
+
+	fmtr, err := GetFormatter("simple")
+	require.NoError(t, err)
+
+	wfServiceClientMock := &workflowmocks.WorkflowServiceClient{}
+	wfServiceClientMock.On("LintWorkflow", mock.Anything, mock.Anything).Return(&v1alpha1.Workflow{}, nil)
+
+	ctx := logging.TestContext(t.Context())
+	opts := &LintOptions{
+		Files:          []string{file.Name()},
+		ServiceClients: ServiceClients{WorkflowsClient: wfServiceClientMock},
+		Formatter:      fmtr,
+	}
+	res, err := Lint(ctx, opts)
+	require.NoError(t, err)
+	assert.True(t, res.Success)
+
+	opts.PodSecurityStandard = "restricted"
+	res, err = Lint(ctx, opts)
+	require.NoError(t, err)
+	assert.False(t, res.Success)
+	assert.Contains(t, res.msg, fmt.Sprintf(`%s: in "host-network-" (Workflow): spec.hostNetwork must not be true`, file.Name()))
+}
+
+func TestLintValidateSchemas(t *testing.T) {
+	file, err := os.CreateTemp("", "*.yaml")
+	require.NoError(t, err)
+	err = os.WriteFile(file.Name(), []byte(`
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: schema-
+spec:
+  entrypoint: main
+  artifactGC:
+    strategy: OnPodDone
+  templates:
+  - name: main
+    retryStrategy:
+      limit: 2
+    container:
+      image: argoproj/argosay:v2
+      unknown: true
+    volumes:
+    - {}
+`), 0o600)
+	require.NoError(t, err)
+	defer os.Remove(file.Name())
+
+	fmtr, err := GetFormatter("simple")
+	require.NoError(t, err)
+
+	wfServiceClientMock := &workflowmocks.WorkflowServiceClient{}
+	wfServiceClientMock.On("LintWorkflow", mock.Anything, mock.Anything).Return(&v1alpha1.Workflow{}, nil)
+
+	ctx := logging.TestContext(t.Context())
+	opts := &LintOptions{
+		Files:          []string{file.Name()},
+		ServiceClients: ServiceClients{WorkflowsClient: wfServiceClientMock},
+		Formatter:      fmtr,
+	}
+	res, err := Lint(ctx, opts)
+	require.NoError(t, err)
+	assert.True(t, res.Success)
+
+	opts.ValidateSchemas = true
+	res, err = Lint(ctx, opts)
+	require.NoError(t, err)
+	assert.False(t, res.Success)
+	prefix := fmt.Sprintf(`%s: in "schema-" (Workflow): `, file.Name())
+	assert.Equal(t, []string{
+		`spec.artifactGC.strategy: Unsupported value: "OnPodDone": supported values: "", "OnWorkflowCompletion", "OnWorkflowDeletion", "Never"`,
+		`unknown field "spec.templates[0].container.unknown"`,
+		`spec.templates[0].volumes[0].name: Required value`,
+	}, strings.Split(strings.ReplaceAll(strings.TrimSpace(res.msg[:strings.LastIndex(res.msg, "\n")]), prefix, ""), "\n"))
+}
+
 func TestLintWithOutput(t *testing.T) {
 	file, err := os.CreateTemp("", "*.yaml")
 	require.NoError(t, err)
@@ -0,0 +1,200 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"plugin"
+	"slices"
+
+	"github.com/google/cel-go/cel"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// Severity is how serious a violation of a rule is, only errors fail linting
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+// celCostLimit stops a badly written rule from stalling linting, it is the same as a Kubernetes ValidatingAdmissionPolicy's
+const celCostLimit = 1000000
+
+// Rule is a custom lint rule, which organizations can ship in a rules file as a CEL expression, or as a Go plugin
+type Rule interface {
+	// Name identifies the rule in the lint output
+	Name() string
+	Severity() Severity
+	// Check returns a message for every violation of the rule by the object, which is a *Workflow, *WorkflowTemplate,
+	// *CronWorkflow or *ClusterWorkflowTemplate
+	Check(obj metav1.Object) []string
+}
+
+// Finding is a violation of a rule
+type Finding struct {
+	Object   string   `json:"object,omitempty"`
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("in %s: %s (%s)", f.Object, f.Message, f.Rule)
+}
+
+// Rules are the custom rules objects are checked against
+type Rules []Rule
+
+// RulesFile is the format of a rules file, e.g.
+//
+//	rules:
+//	  - name: resource-requests
+//	    severity: warning
+//	    kinds: [Workflow, WorkflowTemplate]
+//	    expression: object.spec.templates.all(t, !has(t.container) || has(t.container.resources.requests))
+//	    message: containers must request resources
+//	plugins:
+//	  - ./my-rules.so
+type RulesFile struct {
+	Rules []CELRule `json:"rules,omitempty"`
+	// Plugins are the paths of Go plugins, each exporting a `func Rules() []lint.Rule`
+	Plugins []string `json:"plugins,omitempty"`
+}
+
+// CELRule is a rule which is a CEL expression of the `object` being linted that returns true if the object complies
+type CELRule struct {
+	RuleName string `json:"name"`
+	// RuleSeverity is error, warning or info, and defaults to error
+	RuleSeverity Severity `json:"severity,omitempty"`
+	// Kinds are the kinds of objects the rule applies to, all of them if empty
+	Kinds      []string `json:"kinds,omitempty"`
+	Expression string   `json:"expression"`
+	// Message is reported when the object violates the rule, the expression if empty
+	Message string `json:"message,omitempty"`
+
+	program cel.Program
+}
+
+// LoadRules loads the rules of the rules files
+func LoadRules(paths []string) (Rules, error) {
+	var rules Rules
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		file := RulesFile{}
+		if err := yaml.UnmarshalStrict(data, &file); err != nil {
+			return nil, fmt.Errorf("invalid rules file %s: %w", path, err)
+		}
+		for _, r := range file.Rules {
+			if err := r.compile(); err != nil {
+				return nil, fmt.Errorf("invalid rules file %s: %w", path, err)
+			}
+			rules = append(rules, r)
+		}
+		for _, p := range file.Plugins {
+			pluginRules, err := loadPlugin(p)
+			if err != nil {
+				return nil, fmt.Errorf("invalid rules file %s: %w", path, err)
+			}
+			rules = append(rules, pluginRules...)
+		}
+	}
+	return rules, nil
+}
+
+func loadPlugin(path string) (Rules, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load plugin %s: %w", path, err)
+	}
+	sym, err := p.Lookup("Rules")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load plugin %s: %w", path, err)
+	}
+	rules, ok := sym.(func() []Rule)
+	if !ok {
+		return nil, fmt.Errorf("plugin %s must export `func Rules() []lint.Rule`, not %T", path, sym)
+	}
+	return rules(), nil
+}
+
+func (r *CELRule) compile() error {
+	if r.RuleName == "" {
+		return fmt.Errorf("rules must have a name")
+	}
+	switch r.RuleSeverity {
+	case "":
+		r.RuleSeverity = SeverityError
+	case SeverityError, SeverityWarning, SeverityInfo:
+	default:
+		return fmt.Errorf("rule %q has an invalid severity %q, must be one of %s, %s or %s", r.RuleName, r.RuleSeverity, SeverityError, SeverityWarning, SeverityInfo)
+	}
+	// JSON numbers are doubles, so allow comparing them with integer literals, e.g. `object.spec.parallelism <= 10`
+	env, err := cel.NewEnv(cel.Variable("object", cel.DynType), cel.CrossTypeNumericComparisons(true))
+	if err != nil {
+		return err
+	}
+	ast, issues := env.Compile(r.Expression)
+	if issues.Err() != nil {
+		return fmt.Errorf("rule %q is invalid: %w", r.RuleName, issues.Err())
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return fmt.Errorf("rule %q must return a bool, not %s", r.RuleName, ast.OutputType())
+	}
+	r.program, err = env.Program(ast, cel.CostLimit(celCostLimit))
+	if err != nil {
+		return fmt.Errorf("rule %q is invalid: %w", r.RuleName, err)
+	}
+	return nil
+}
+
+func (r CELRule) Name() string {
+	return r.RuleName
+}
+
+func (r CELRule) Severity() Severity {
+	return r.RuleSeverity
+}
+
+func (r CELRule) Check(obj metav1.Object) []string {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return []string{err.Error()}
+	}
+	object := map[string]interface{}{}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return []string{err.Error()}
+	}
+	if kind, _ := object["kind"].(string); len(r.Kinds) > 0 && !slices.Contains(r.Kinds, kind) {
+		return nil
+	}
+	val, _, err := r.program.Eval(map[string]interface{}{"object": object})
+	if err != nil {
+		// a rule that cannot be evaluated, e.g. because a field is missing, is a violation, so mistakes fail closed
+		return []string{err.Error()}
+	}
+	if ok, isBool := val.Value().(bool); isBool && ok {
+		return nil
+	}
+	if r.Message != "" {
+		return []string{r.Message}
+	}
+	return []string{r.Expression}
+}
+
+// check returns the findings of the rules for the object
+func (rs Rules) check(objName string, obj metav1.Object) []Finding {
+	var findings []Finding
+	for _, r := range rs {
+		for _, msg := range r.Check(obj) {
+			findings = append(findings, Finding{Object: objName, Rule: r.Name(), Severity: r.Severity(), Message: msg})
+		}
+	}
+	return findings
+}
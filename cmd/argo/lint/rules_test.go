@@ -0,0 +1,145 @@
+package lint
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	workflowmocks "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow/mocks"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func writeRulesFile(t *testing.T, data string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "rules.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+	return path
+}
+
+func TestLoadRules(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		rules, err := LoadRules([]string{writeRulesFile(t, `
+rules:
+  - name: no-latest
+    expression: object.spec.templates.all(t, !has(t.container) || !t.container.image.endsWith(":latest"))
+  - name: team-label
+    severity: warning
+    kinds: [WorkflowTemplate]
+    expression: has(object.metadata.labels) && "team" in object.metadata.labels
+`)})
+		require.NoError(t, err)
+		require.Len(t, rules, 2)
+		assert.Equal(t, "no-latest", rules[0].Name())
+		assert.Equal(t, SeverityError, rules[0].Severity())
+		assert.Equal(t, SeverityWarning, rules[1].Severity())
+	})
+	for name, data := range map[string]string{
+		"UnknownField":    "rules: [{name: a, expression: 'true', foo: bar}]",
+		"NoName":          "rules: [{expression: 'true'}]",
+		"InvalidSeverity": "rules: [{name: a, severity: fatal, expression: 'true'}]",
+		"InvalidCEL":      "rules: [{name: a, expression: 'object.'}]",
+		"NotBool":         "rules: [{name: a, expression: '1 + 1'}]",
+		"MissingPlugin":   "plugins: [./does-not-exist.so]",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := LoadRules([]string{writeRulesFile(t, data)})
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestRulesCheck(t *testing.T) {
+	rules, err := LoadRules([]string{writeRulesFile(t, `
+rules:
+  - name: no-latest
+    expression: object.spec.templates.all(t, !has(t.container) || !t.container.image.endsWith(":latest"))
+    message: images must be pinned
+  - name: parallelism
+    severity: warning
+    expression: object.spec.parallelism <= 10
+  - name: templates-only
+    kinds: [WorkflowTemplate]
+    expression: "false"
+`)})
+	require.NoError(t, err)
+
+	wf := v1alpha1.MustUnmarshalWorkflow(`
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: my-wf
+spec:
+  parallelism: 20
+  templates:
+  - name: main
+    container:
+      image: argoproj/argosay:latest
+`)
+	assert.Equal(t, []Finding{
+		{Object: "my-wf", Rule: "no-latest", Severity: SeverityError, Message: "images must be pinned"},
+		{Object: "my-wf", Rule: "parallelism", Severity: SeverityWarning, Message: "object.spec.parallelism <= 10"},
+	}, rules.check("my-wf", wf))
+
+	// a rule that cannot be evaluated is a violation
+	wf.Spec.Parallelism = nil
+	findings := rules.check("my-wf", wf)
+	require.Len(t, findings, 2)
+	assert.Equal(t, "parallelism", findings[1].Rule)
+	assert.Contains(t, findings[1].Message, "no such key")
+}
+
+func TestLintRules(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "wf.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(`
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: rules-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: argoproj/argosay:v2
+`), 0o600))
+
+	wfServiceClientMock := &workflowmocks.WorkflowServiceClient{}
+	wfServiceClientMock.On("LintWorkflow", mock.Anything, mock.Anything).Return(&v1alpha1.Workflow{}, nil)
+
+	ctx := logging.TestContext(t.Context())
+	lint := func(severity Severity) *LintResults {
+		rules, err := LoadRules([]string{writeRulesFile(t, fmt.Sprintf(`
+rules:
+  - name: resource-requests
+    severity: %s
+    expression: object.spec.templates.all(t, has(t.container.resources.requests))
+    message: containers must request resources
+`, severity))})
+		require.NoError(t, err)
+		fmtr, err := GetFormatter("simple")
+		require.NoError(t, err)
+		res, err := Lint(ctx, &LintOptions{
+			Files:          []string{file},
+			ServiceClients: ServiceClients{WorkflowsClient: wfServiceClientMock},
+			Formatter:      fmtr,
+			Rules:          rules,
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	res := lint(SeverityError)
+	assert.False(t, res.Success)
+	assert.Contains(t, res.msg, fmt.Sprintf(`%s: error: in "rules-" (Workflow): containers must request resources (resource-requests)`, file))
+
+	// only errors fail linting
+	res = lint(SeverityWarning)
+	assert.True(t, res.Success)
+	assert.Contains(t, res.msg, fmt.Sprintf(`%s: warning: in "rules-" (Workflow): containers must request resources (resource-requests)`, file))
+}
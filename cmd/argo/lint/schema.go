@@ -0,0 +1,190 @@
+package lint
+
+import (
+	"compress/gzip"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// schemas are the structural schemas of the CRDs of this version, generated by `go run ./hack/manifests lintschema`
+//
+//go:embed schemas/*.json.gz
+var schemas embed.FS
+
+// schema is the subset of a structural schema which objects are validated against
+type schema struct {
+	Type                  string             `json:"type,omitempty"`
+	Properties            map[string]*schema `json:"properties,omitempty"`
+	AdditionalProperties  *schema            `json:"additionalProperties,omitempty"`
+	Items                 *schema            `json:"items,omitempty"`
+	Required              []string           `json:"required,omitempty"`
+	Enum                  []interface{}      `json:"enum,omitempty"`
+	IntOrString           bool               `json:"x-kubernetes-int-or-string,omitempty"`
+	PreserveUnknownFields bool               `json:"x-kubernetes-preserve-unknown-fields,omitempty"`
+}
+
+var (
+	schemasMu     sync.Mutex
+	schemasByKind = map[string]*schema{}
+)
+
+// schemaForKind returns the schema of the kind, or nil if there is no schema for the kind
+func schemaForKind(kind string) (*schema, error) {
+	schemasMu.Lock()
+	defer schemasMu.Unlock()
+	if s, ok := schemasByKind[kind]; ok {
+		return s, nil
+	}
+	f, err := schemas.Open("schemas/argoproj.io_" + strings.ToLower(kind) + "s.json.gz")
+	if err != nil {
+		schemasByKind[kind] = nil
+		return nil, nil
+	}
+	defer f.Close()
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	s := &schema{}
+	if err := json.NewDecoder(r).Decode(s); err != nil {
+		return nil, fmt.Errorf("failed to decode the schema of %s: %w", kind, err)
+	}
+	schemasByKind[kind] = s
+	return s, nil
+}
+
+// validateSchema validates the object against the schema of its kind, returning an error for each unknown field,
+// missing required field, unsupported enum value and value of the wrong type
+func validateSchema(un *unstructured.Unstructured) []error {
+	s, err := schemaForKind(un.GetKind())
+	if err != nil {
+		return []error{err}
+	}
+	if s == nil {
+		return nil
+	}
+	var errs []error
+	s.validate(nil, un.Object, &errs)
+	return errs
+}
+
+func (s *schema) validate(path *field.Path, value interface{}, errs *[]error) {
+	if value == nil {
+		return
+	}
+	if s.IntOrString {
+		if _, ok := value.(string); !ok && !isInteger(value) {
+			*errs = append(*errs, field.Invalid(path, value, "must be an integer or a string"))
+		}
+		return
+	}
+	switch s.Type {
+	case "object":
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			*errs = append(*errs, field.Invalid(path, value, "must be of type object"))
+			return
+		}
+		s.validateObject(path, obj, errs)
+	case "array":
+		items, ok := value.([]interface{})
+		if !ok {
+			*errs = append(*errs, field.Invalid(path, value, "must be of type array"))
+			return
+		}
+		if s.Items != nil {
+			for i, item := range items {
+				s.Items.validate(path.Index(i), item, errs)
+			}
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			*errs = append(*errs, field.Invalid(path, value, "must be of type string"))
+			return
+		}
+	case "integer":
+		if !isInteger(value) {
+			*errs = append(*errs, field.Invalid(path, value, "must be of type integer"))
+			return
+		}
+	case "number":
+		if !isInteger(value) && !isFloat(value) {
+			*errs = append(*errs, field.Invalid(path, value, "must be of type number"))
+			return
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			*errs = append(*errs, field.Invalid(path, value, "must be of type boolean"))
+			return
+		}
+	}
+	if len(s.Enum) > 0 && !slices.ContainsFunc(s.Enum, func(v interface{}) bool { return fmt.Sprint(v) == fmt.Sprint(value) }) {
+		var supported []string
+		for _, v := range s.Enum {
+			supported = append(supported, fmt.Sprint(v))
+		}
+		*errs = append(*errs, field.NotSupported(path, value, supported))
+	}
+}
+
+func (s *schema) validateObject(path *field.Path, obj map[string]interface{}, errs *[]error) {
+	for _, name := range s.Required {
+		if _, ok := obj[name]; !ok {
+			*errs = append(*errs, field.Required(child(path, name), ""))
+		}
+	}
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		switch property, ok := s.Properties[name]; {
+		case ok:
+			property.validate(child(path, name), obj[name], errs)
+		case s.AdditionalProperties != nil:
+			s.AdditionalProperties.validate(child(path, name), obj[name], errs)
+		case len(s.Properties) > 0 && !s.PreserveUnknownFields:
+			*errs = append(*errs, fmt.Errorf("unknown field %q", child(path, name).String()))
+		}
+	}
+}
+
+func child(path *field.Path, name string) *field.Path {
+	if path == nil {
+		return field.NewPath(name)
+	}
+	return path.Child(name)
+}
+
+func isInteger(value interface{}) bool {
+	switch v := value.(type) {
+	case int, int32, int64:
+		return true
+	case float64:
+		return v == math.Trunc(v)
+	case json.Number:
+		_, err := v.Int64()
+		return err == nil
+	}
+	return false
+}
+
+func isFloat(value interface{}) bool {
+	switch v := value.(type) {
+	case float32, float64:
+		return true
+	case json.Number:
+		_, err := v.Float64()
+		return err == nil
+	}
+	return false
+}
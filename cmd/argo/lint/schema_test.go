@@ -0,0 +1,65 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+func TestValidateSchema(t *testing.T) {
+	validate := func(t *testing.T, manifest string) []string {
+		t.Helper()
+		un := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal([]byte(manifest), un); err != nil {
+			t.Fatal(err)
+		}
+		var errs []string
+		for _, err := range validateSchema(un) {
+			errs = append(errs, err.Error())
+		}
+		return errs
+	}
+	t.Run("Valid", func(t *testing.T) {
+		assert.Empty(t, validate(t, `
+kind: CronWorkflow
+metadata: {name: my-cron, labels: {a: b}}
+spec:
+  schedules: ["* * * * *"]
+  workflowSpec:
+    workflowTemplateRef: {name: my-wft}
+    arguments: {parameters: [{name: x, value: "1"}]}
+    activeDeadlineSeconds: 10
+    templates:
+      - name: main
+        retryStrategy: {limit: 2}
+        resource: {action: create, manifest: "{}"}
+`))
+	})
+	t.Run("Types", func(t *testing.T) {
+		assert.Equal(t, []string{
+			`spec.activeDeadlineSeconds: Invalid value: 1.5: must be of type integer`,
+			`spec.templates[0].retryStrategy.limit: Invalid value: true: must be an integer or a string`,
+			`spec.templates[1]: Invalid value: "main": must be of type object`,
+		}, validate(t, `
+kind: ClusterWorkflowTemplate
+metadata: {name: my-cwft}
+spec:
+  activeDeadlineSeconds: 1.5
+  templates:
+    - {name: main, retryStrategy: {limit: true}}
+    - main
+`))
+	})
+	t.Run("Required", func(t *testing.T) {
+		assert.Equal(t, []string{`metadata: Required value`}, validate(t, `
+kind: WorkflowTemplate
+spec: {}
+`))
+	})
+	t.Run("UnknownKind", func(t *testing.T) {
+		assert.Empty(t, validate(t, `kind: WorkflowEventBinding
+spec: {unknown: true}`))
+	})
+}
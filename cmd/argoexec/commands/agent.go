@@ -122,6 +122,7 @@ func initAgentExecutor(ctx context.Context) *executor.AgentExecutor {
 	addresses := getPluginAddresses(ctx)
 	names := getPluginNames(ctx)
 	var plugins []executorplugins.TemplateExecutor
+	var parameterSources []executorplugins.ParameterSource
 	for i, address := range addresses {
 		name := names[i]
 		filename := tokenFilename(name)
@@ -133,8 +134,10 @@ func initAgentExecutor(ctx context.Context) *executor.AgentExecutor {
 			logger.WithError(err).WithFatal().Error(ctx, "Failed to read token file")
 			os.Exit(1)
 		}
-		plugins = append(plugins, rpc.New(address, string(data)))
+		plugin := rpc.New(address, string(data))
+		plugins = append(plugins, plugin)
+		parameterSources = append(parameterSources, plugin)
 	}
 
-	return executor.NewAgentExecutor(clientSet, restClient, config, namespace, workflowName, workflowUID, plugins)
+	return executor.NewAgentExecutor(clientSet, restClient, config, namespace, workflowName, workflowUID, plugins, parameterSources)
 }
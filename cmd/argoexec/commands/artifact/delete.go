@@ -16,10 +16,14 @@ import (
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	workflow "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
 	wfv1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/util/retry"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
 	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
 	executor "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	artifactmetrics "github.com/argoproj/argo-workflows/v3/workflow/artifacts/metrics"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	wfexecutor "github.com/argoproj/argo-workflows/v3/workflow/executor"
 )
 
 func NewArtifactDeleteCommand() *cobra.Command {
@@ -42,7 +46,18 @@ func NewArtifactDeleteCommand() *cobra.Command {
 				artifactGCTaskInterface := workflowInterface.ArgoprojV1alpha1().WorkflowArtifactGCTasks(namespace)
 				labelSelector := fmt.Sprintf("%s = %s", common.LabelKeyArtifactGCPodHash, podName)
 
-				err = deleteArtifacts(labelSelector, ctx, artifactGCTaskInterface)
+				metrics, err := wfexecutor.NewMetrics(ctx)
+				if err != nil {
+					// metrics must not fail the garbage collection
+					logging.RequireLoggerFromContext(ctx).WithError(err).Warn(ctx, "Failed to initialize metrics")
+				}
+				defer func() {
+					if err := metrics.Shutdown(ctx); err != nil {
+						logging.RequireLoggerFromContext(ctx).WithError(err).Warn(ctx, "Failed to flush metrics")
+					}
+				}()
+
+				err = deleteArtifacts(labelSelector, ctx, artifactGCTaskInterface, metrics)
 				if err != nil {
 					return err
 				}
@@ -52,7 +67,7 @@ func NewArtifactDeleteCommand() *cobra.Command {
 	}
 }
 
-func deleteArtifacts(labelSelector string, ctx context.Context, artifactGCTaskInterface wfv1alpha1.WorkflowArtifactGCTaskInterface) error {
+func deleteArtifacts(labelSelector string, ctx context.Context, artifactGCTaskInterface wfv1alpha1.WorkflowArtifactGCTaskInterface, metrics *telemetry.Metrics) error {
 
 	taskList, err := artifactGCTaskInterface.List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
 	if err != nil {
@@ -83,6 +98,7 @@ func deleteArtifacts(labelSelector string, ctx context.Context, artifactGCTaskIn
 				if err != nil {
 					return err
 				}
+				drv = artifactmetrics.New(drv, &artifact, metrics)
 
 				err = waitutil.Backoff(retry.DefaultRetry(ctx), func() (bool, error) {
 					err = drv.Delete(ctx, &artifact)
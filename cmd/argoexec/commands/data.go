@@ -35,6 +35,7 @@ func execData(ctx context.Context) error {
 	wfExecutor.InitializeOutput(bgCtx)
 	defer wfExecutor.HandleError(bgCtx)
 	defer wfExecutor.FinalizeOutput(bgCtx) //Ensures the LabelKeyReportOutputsCompleted is set to true.
+	defer wfExecutor.FlushMetrics(bgCtx)
 
 	err := wfExecutor.Data(ctx)
 	if err != nil {
@@ -63,6 +63,20 @@ func NewEmissaryCommand() *cobra.Command {
 				return fmt.Errorf("failed to create ctr directory: %w", err)
 			}
 
+			// Sample the resource usage for right-sizing recommendations. This must be written before the exit code,
+			// as the wait container reports it once the exit code exists.
+			sampler := emissary.NewResourceUsageSampler(emissary.CgroupRoot)
+			samplerCtx, stopSampler := context.WithCancel(ctx)
+			go sampler.Run(samplerCtx, emissary.ResourceUsageSampleInterval)
+			defer func() {
+				stopSampler()
+				if usage := sampler.PeakUsage(); len(usage) > 0 {
+					if err := emissary.WriteResourceUsage(containerName, usage); err != nil {
+						logger.WithError(err).Error(ctx, "failed to write resource usage")
+					}
+				}
+			}()
+
 			name, args := args[0], args[1:]
 
 			data, err := os.ReadFile(varRunArgo + "/template")
@@ -26,6 +26,7 @@ func NewInitCommand() *cobra.Command {
 func loadArtifacts(ctx context.Context) error {
 	wfExecutor := initExecutor(ctx)
 	defer wfExecutor.HandleError(ctx)
+	defer wfExecutor.FlushMetrics(ctx)
 	defer stats.LogStats()
 
 	if err := wfExecutor.Init(); err != nil {
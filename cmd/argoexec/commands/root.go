@@ -170,6 +170,11 @@ func initExecutor(ctx context.Context) *executor.WorkflowExecutor {
 		annotationPatchTickDuration,
 		progressFileTickDuration,
 	)
+	wfExecutor.Metrics, err = executor.NewMetrics(ctx)
+	if err != nil {
+		// metrics must not fail the workflow
+		logger.WithError(err).Warn(ctx, "Failed to initialize metrics")
+	}
 
 	logger.
 		WithFields(version.Fields()).
@@ -36,6 +36,7 @@ func waitContainer(ctx context.Context) error {
 
 	defer wfExecutor.HandleError(bgCtx)    // Must be placed at the bottom of defers stack.
 	defer wfExecutor.FinalizeOutput(bgCtx) // Ensures the LabelKeyReportOutputsCompleted is set to true.
+	defer wfExecutor.FlushMetrics(bgCtx)
 	defer stats.LogStats()
 	stats.StartStatsTicker(5 * time.Minute)
 
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"os/signal"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/argoproj/pkg/stats"
@@ -32,6 +34,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/util/logs"
 	pprofutil "github.com/argoproj/argo-workflows/v3/util/pprof"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller"
 	"github.com/argoproj/argo-workflows/v3/workflow/events"
@@ -63,6 +66,7 @@ func NewRootCommand() *cobra.Command {
 		namespaced              bool   // --namespaced
 		managedNamespace        string // --managed-namespace
 		executorPlugins         bool
+		drainTimeout            time.Duration // --drain-timeout
 	)
 
 	command := cobra.Command{
@@ -89,6 +93,16 @@ func NewRootCommand() *cobra.Command {
 			ctx, cancel := context.WithCancel(ctx)
 			defer cancel()
 
+			shutdownTracing, err := telemetry.InitTracing(ctx, "argo-workflows-controller")
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := shutdownTracing(context.WithoutCancel(ctx)); err != nil {
+					log.WithError(err).Warn(ctx, "failed to export traces")
+				}
+			}()
+
 			version := argo.GetVersion()
 			config = restclient.AddUserAgent(config, fmt.Sprintf("argo-workflows/%s argo-controller", version.Version))
 			config.Burst = burst
@@ -118,8 +132,15 @@ func NewRootCommand() *cobra.Command {
 				return err
 			}
 
+			if drainTimeout > 0 {
+				go drainOnRequest(ctx, wfController, drainTimeout, cancel)
+			}
+
+			// closed when leadership has been released, so that the next leader does not wait for the lease to expire
+			leaderElectionDone := make(chan struct{})
 			leaderElectionOff := os.Getenv("LEADER_ELECTION_DISABLE")
 			if leaderElectionOff == "true" {
+				close(leaderElectionDone)
 				log.Info(ctx, "Leader election is turned off. Running in single-instance mode")
 				log.WithField("id", "single-instance").Info(ctx, "starting leading")
 
@@ -147,45 +168,53 @@ func NewRootCommand() *cobra.Command {
 					wg.Done()
 				}()
 
-				go leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
-					Lock: &resourcelock.LeaseLock{
-						LeaseMeta: metav1.ObjectMeta{Name: leaderName, Namespace: namespace}, Client: kubeclientset.CoordinationV1(),
-						LockConfig: resourcelock.ResourceLockConfig{Identity: nodeID, EventRecorder: events.NewEventRecorderManager(kubeclientset).Get(ctx, namespace)},
-					},
-					ReleaseOnCancel: false,
-					LeaseDuration:   env.LookupEnvDurationOr(ctx, "LEADER_ELECTION_LEASE_DURATION", 15*time.Second),
-					RenewDeadline:   env.LookupEnvDurationOr(ctx, "LEADER_ELECTION_RENEW_DEADLINE", 10*time.Second),
-					RetryPeriod:     env.LookupEnvDurationOr(ctx, "LEADER_ELECTION_RETRY_PERIOD", 5*time.Second),
-					Callbacks: leaderelection.LeaderCallbacks{
-						OnStartedLeading: func(ctx context.Context) {
-							dummyCancel()
-							wg.Wait()
-							go wfController.Run(ctx, workflowWorkers, workflowTTLWorkers, podCleanupWorkers, cronWorkflowWorkers, workflowArchiveWorkers)
-							wg.Add(1)
-							go func() {
-								wfController.RunPrometheusServer(ctx, false)
-								wg.Done()
-							}()
-						},
-						OnStoppedLeading: func() {
-							log.WithField("id", nodeID).Info(ctx, "stopped leading")
-							cancel()
-							wg.Wait()
-							go wfController.RunPrometheusServer(dummyCtx, true)
+				go func() {
+					defer close(leaderElectionDone)
+					leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+						Lock: &resourcelock.LeaseLock{
+							LeaseMeta: metav1.ObjectMeta{Name: leaderName, Namespace: namespace}, Client: kubeclientset.CoordinationV1(),
+							LockConfig: resourcelock.ResourceLockConfig{Identity: nodeID, EventRecorder: events.NewEventRecorderManager(kubeclientset).Get(ctx, namespace)},
 						},
-						OnNewLeader: func(identity string) {
-							log.WithField("leader", identity).Info(ctx, "new leader")
+						ReleaseOnCancel: drainTimeout > 0,
+						LeaseDuration:   env.LookupEnvDurationOr(ctx, "LEADER_ELECTION_LEASE_DURATION", 15*time.Second),
+						RenewDeadline:   env.LookupEnvDurationOr(ctx, "LEADER_ELECTION_RENEW_DEADLINE", 10*time.Second),
+						RetryPeriod:     env.LookupEnvDurationOr(ctx, "LEADER_ELECTION_RETRY_PERIOD", 5*time.Second),
+						Callbacks: leaderelection.LeaderCallbacks{
+							OnStartedLeading: func(ctx context.Context) {
+								dummyCancel()
+								wg.Wait()
+								go wfController.Run(ctx, workflowWorkers, workflowTTLWorkers, podCleanupWorkers, cronWorkflowWorkers, workflowArchiveWorkers)
+								wg.Add(1)
+								go func() {
+									wfController.RunPrometheusServer(ctx, false)
+									wg.Done()
+								}()
+							},
+							OnStoppedLeading: func() {
+								log.WithField("id", nodeID).Info(ctx, "stopped leading")
+								cancel()
+								wg.Wait()
+								go wfController.RunPrometheusServer(dummyCtx, true)
+							},
+							OnNewLeader: func(identity string) {
+								log.WithField("leader", identity).Info(ctx, "new leader")
+							},
 						},
-					},
-				})
+					})
+				}()
 			}
 			http.Handle("/healthz", controller.LogMiddleware(log, http.HandlerFunc(wfController.Healthz)))
+			if token := os.Getenv("ARGO_ADMIN_TOKEN"); token != "" {
+				log.Info(ctx, "enabling admin endpoints")
+				http.Handle("/admin/", controller.LogMiddleware(log, wfController.AdminHandler(token)))
+			}
 
 			go func() {
 				log.Error(ctx, http.ListenAndServe(":6060", nil).Error())
 			}()
 
 			<-ctx.Done()
+			<-leaderElectionDone
 			return nil
 		},
 	}
@@ -208,6 +237,7 @@ func NewRootCommand() *cobra.Command {
 	command.Flags().BoolVar(&namespaced, "namespaced", false, "run workflow-controller as namespaced mode")
 	command.Flags().StringVar(&managedNamespace, "managed-namespace", "", "namespace that workflow-controller watches, default to the installation namespace")
 	command.Flags().BoolVar(&executorPlugins, "executor-plugins", false, "enable executor plugins")
+	command.Flags().DurationVar(&drainTimeout, "drain-timeout", 0, "How long to drain the controller for when it is terminated, or a drain is requested, before stopping. 0, the default, disables draining.")
 	ctx, log, err := cmdutil.CmdContextWithLogger(&command, logLevel, logFormat)
 	if err != nil {
 		logging.InitLogger().WithError(err).WithFatal().Error(command.Context(), "Failed to create workflow-controller logger")
@@ -235,6 +265,23 @@ func NewRootCommand() *cobra.Command {
 	return &command
 }
 
+// drainOnRequest drains the controller when it is terminated, or a drain is requested, and then stops it
+func drainOnRequest(ctx context.Context, wfController *controller.WorkflowController, drainTimeout time.Duration, stop context.CancelFunc) {
+	terminated := make(chan os.Signal, 1)
+	signal.Notify(terminated, syscall.SIGTERM)
+	defer signal.Stop(terminated)
+	select {
+	case <-ctx.Done():
+		return
+	case <-terminated:
+	case <-wfController.DrainRequests():
+	}
+	drainCtx, cancel := context.WithTimeout(ctx, drainTimeout)
+	defer cancel()
+	wfController.Drain(drainCtx)
+	stop()
+}
+
 func main() {
 	if err := NewRootCommand().Execute(); err != nil {
 		fmt.Println(err)
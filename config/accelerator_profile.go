@@ -0,0 +1,50 @@
+package config
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// DefaultAcceleratorProfile is the name of the accelerator profile of a GPU request without a type
+const DefaultAcceleratorProfile = "default"
+
+// AcceleratorProfile is a type of GPU that templates request with gpu.type. It holds what a pod needs to be scheduled
+// onto nodes with the GPUs, so that users do not need to get the resource, node selector and tolerations right
+// themselves.
+type AcceleratorProfile struct {
+	// ResourceName is the extended resource of the GPUs. Defaults to "nvidia.com/gpu"
+	ResourceName apiv1.ResourceName `json:"resourceName,omitempty"`
+	// NodeSelector selects the nodes with the GPUs, e.g. by the GPU product label
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations tolerate the taints of the nodes with the GPUs
+	Tolerations []apiv1.Toleration `json:"tolerations,omitempty"`
+	// RuntimeClassName is the runtime class for the GPUs, if the nodes need one
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
+}
+
+// GetResourceName returns the extended resource of the GPUs
+func (p AcceleratorProfile) GetResourceName() apiv1.ResourceName {
+	if p.ResourceName == "" {
+		return "nvidia.com/gpu"
+	}
+	return p.ResourceName
+}
+
+// nvidiaAcceleratorProfile is the default accelerator profile if none is configured. NVIDIA GPU nodes are commonly
+// tainted with the GPU resource, e.g. by GKE and the NVIDIA GPU operator.
+var nvidiaAcceleratorProfile = AcceleratorProfile{
+	Tolerations: []apiv1.Toleration{{Key: "nvidia.com/gpu", Operator: apiv1.TolerationOpExists, Effect: apiv1.TaintEffectNoSchedule}},
+}
+
+// GetAcceleratorProfile returns the accelerator profile of the type of GPU, the default profile if the type is empty
+func (c Config) GetAcceleratorProfile(gpuType string) (AcceleratorProfile, bool) {
+	if gpuType == "" {
+		gpuType = DefaultAcceleratorProfile
+	}
+	if p, ok := c.AcceleratorProfiles[gpuType]; ok {
+		return p, true
+	}
+	if gpuType == DefaultAcceleratorProfile {
+		return nvidiaAcceleratorProfile, true
+	}
+	return AcceleratorProfile{}, false
+}
@@ -0,0 +1,29 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+func TestConfig_GetAcceleratorProfile(t *testing.T) {
+	p, ok := Config{}.GetAcceleratorProfile("")
+	assert.True(t, ok)
+	assert.Equal(t, apiv1.ResourceName("nvidia.com/gpu"), p.GetResourceName())
+	assert.Len(t, p.Tolerations, 1)
+
+	_, ok = Config{}.GetAcceleratorProfile("tpu")
+	assert.False(t, ok)
+
+	c := Config{AcceleratorProfiles: map[string]AcceleratorProfile{
+		"default": {ResourceName: "amd.com/gpu"},
+		"tpu":     {ResourceName: "google.com/tpu"},
+	}}
+	p, ok = c.GetAcceleratorProfile("")
+	assert.True(t, ok)
+	assert.Equal(t, apiv1.ResourceName("amd.com/gpu"), p.GetResourceName())
+	p, ok = c.GetAcceleratorProfile("tpu")
+	assert.True(t, ok)
+	assert.Equal(t, apiv1.ResourceName("google.com/tpu"), p.GetResourceName())
+}
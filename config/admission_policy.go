@@ -0,0 +1,26 @@
+package config
+
+// AdmissionPolicy is a CEL rule that every workflow must satisfy before it runs
+type AdmissionPolicy struct {
+	// Name identifies the policy in the rejection message
+	Name string `json:"name"`
+	// Expression is a CEL expression that must evaluate to true for the workflow to be admitted.
+	// The workflow is available as `object`, with its spec merged with any workflowTemplateRef and the workflow defaults.
+	Expression string `json:"expression"`
+	// Message is the reason given when a workflow is rejected, defaults to the expression
+	Message string `json:"message,omitempty"`
+	// Namespaces the policy applies to, defaults to all namespaces
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+func (p AdmissionPolicy) AppliesTo(namespace string) bool {
+	if len(p.Namespaces) == 0 {
+		return true
+	}
+	for _, n := range p.Namespaces {
+		if n == namespace {
+			return true
+		}
+	}
+	return false
+}
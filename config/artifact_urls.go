@@ -0,0 +1,25 @@
+package config
+
+import (
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ArtifactURLs configures the Argo Server minting signed URLs of artifacts, which can be used to download the artifacts
+// without a token until they expire
+type ArtifactURLs struct {
+	// SigningKeySecret references a secret, in the namespace of the Argo Server, of the key to sign URLs with. URLs signed
+	// with a previous key are no longer valid after it is changed.
+	SigningKeySecret apiv1.SecretKeySelector `json:"signingKeySecret"`
+	// MaxExpiry is the longest time that a URL can be valid for. Defaults to 1h.
+	MaxExpiry metav1.Duration `json:"maxExpiry,omitempty"`
+}
+
+func (c ArtifactURLs) GetMaxExpiry() time.Duration {
+	if c.MaxExpiry.Duration > 0 {
+		return c.MaxExpiry.Duration
+	}
+	return time.Hour
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"net/url"
+	"path"
 	"time"
 
 	metricsdk "go.opentelemetry.io/otel/sdk/metric"
@@ -20,6 +21,51 @@ type ResourceRateLimit struct {
 	Limit float64 `json:"limit"`
 	// Burst allows temporary spikes above the limit
 	Burst int `json:"burst"`
+	// Adaptive adapts the limit to the load of the Kubernetes API server, rather than it being fixed
+	Adaptive *AdaptiveRateLimit `json:"adaptive,omitempty"`
+}
+
+// AdaptiveRateLimit adapts a rate limit to the load of the Kubernetes API server. The limit starts at the limit of the
+// rate limit, is increased additively each second the API server does not throttle requests, and is decreased
+// multiplicatively when it does, i.e. responds with 429 Too Many Requests or times out (AIMD).
+type AdaptiveRateLimit struct {
+	// MinLimit is the lowest the limit is decreased to, defaults to 1
+	MinLimit float64 `json:"minLimit,omitempty"`
+	// MaxLimit is the highest the limit is increased to, defaults to the limit
+	MaxLimit float64 `json:"maxLimit,omitempty"`
+	// Increase is how much the limit is increased by each second the API server does not throttle requests, defaults to 1
+	Increase float64 `json:"increase,omitempty"`
+	// Decrease is the factor, between 0 and 1, the limit is multiplied by when the API server throttles requests,
+	// defaults to 0.5
+	Decrease float64 `json:"decrease,omitempty"`
+}
+
+func (a AdaptiveRateLimit) GetMinLimit() float64 {
+	if a.MinLimit > 0 {
+		return a.MinLimit
+	}
+	return 1
+}
+
+func (a AdaptiveRateLimit) GetMaxLimit(limit float64) float64 {
+	if a.MaxLimit > 0 {
+		return a.MaxLimit
+	}
+	return limit
+}
+
+func (a AdaptiveRateLimit) GetIncrease() float64 {
+	if a.Increase > 0 {
+		return a.Increase
+	}
+	return 1
+}
+
+func (a AdaptiveRateLimit) GetDecrease() float64 {
+	if a.Decrease > 0 && a.Decrease < 1 {
+		return a.Decrease
+	}
+	return 0.5
 }
 
 // Config contains the root of the configuration settings for the workflow controller
@@ -72,6 +118,13 @@ type Config struct {
 	// ResourceRateLimit limits the rate at which pods are created
 	ResourceRateLimit *ResourceRateLimit `json:"resourceRateLimit,omitempty"`
 
+	// WorkflowUpdateRateLimit limits the rate at which workflows are updated, unlimited by default
+	WorkflowUpdateRateLimit *ResourceRateLimit `json:"workflowUpdateRateLimit,omitempty"`
+
+	// WorkflowQueueFairness shares the workers of the controller fairly between namespaces. Changing whether it is set
+	// requires restarting the controller.
+	WorkflowQueueFairness *QueueFairness `json:"workflowQueueFairness,omitempty"`
+
 	// Persistence contains the workflow persistence DB configuration
 	Persistence *PersistConfig `json:"persistence,omitempty"`
 
@@ -84,6 +137,23 @@ type Config struct {
 	// WorkflowDefaults are values that will apply to all Workflows from this controller, unless overridden on the Workflow-level
 	WorkflowDefaults *wfv1.Workflow `json:"workflowDefaults,omitempty"`
 
+	// WorkflowDefaultsProfiles are named workflow defaults for the workflows they select by namespace or label.
+	// They take precedence over WorkflowDefaults, and profiles later in the list take precedence over earlier ones.
+	WorkflowDefaultsProfiles []WorkflowDefaultsProfile `json:"workflowDefaultsProfiles,omitempty"`
+
+	// PodDefaults are defaults for the priority class, runtime class, node selector and tolerations of the pods of the
+	// workflows they select by namespace or label, beneath the settings of templates and workflows. Defaults later in
+	// the list take precedence over earlier ones.
+	PodDefaults []PodDefaults `json:"podDefaults,omitempty"`
+
+	// ExecutorDefaults are settings of the executor containers of the workflows they select by namespace or label. They
+	// take precedence over Executor, and defaults later in the list take precedence over earlier ones.
+	ExecutorDefaults []ExecutorDefaults `json:"executorDefaults,omitempty"`
+
+	// AcceleratorProfiles are the types of GPU that templates request with gpu.type, by name. A request without a type
+	// uses the "default" profile, which is NVIDIA GPUs if not configured.
+	AcceleratorProfiles map[string]AcceleratorProfile `json:"acceleratorProfiles,omitempty"`
+
 	// PodSpecLogStrategy enables the logging of podspec on controller log.
 	PodSpecLogStrategy PodSpecLogStrategy `json:"podSpecLogStrategy,omitempty"`
 
@@ -118,6 +188,74 @@ type Config struct {
 
 	// Synchronization via databases config
 	Synchronization *SyncConfig `json:"synchronization,omitempty"`
+
+	// EventSources configures sources, such as Kafka, the Argo Server consumes events from
+	EventSources *EventSourcesConfig `json:"eventSources,omitempty"`
+
+	// LifecycleEvents configures publishing workflow and node phase transitions to a message bus
+	LifecycleEvents *LifecycleEvents `json:"lifecycleEvents,omitempty"`
+
+	// Notifications configures the channels workflows send notifications to
+	Notifications *Notifications `json:"notifications,omitempty"`
+
+	// Pricing configures estimating the cost of workflows and their nodes
+	Pricing *Pricing `json:"pricing,omitempty"`
+
+	// AdmissionPolicies are CEL rules that workflows must satisfy to run, workflows that violate them fail with a SpecError condition
+	AdmissionPolicies []AdmissionPolicy `json:"admissionPolicies,omitempty"`
+
+	// ImagePolicy restricts the container images workflows may run, workflows that violate it fail with a SpecError condition
+	ImagePolicy *ImagePolicy `json:"imagePolicy,omitempty"`
+
+	// ImageDigestPinning resolves the tags of the images of workflows to digests when they are created, so that all of
+	// their pods run the same image
+	ImageDigestPinning *ImageDigestPinning `json:"imageDigestPinning,omitempty"`
+
+	// RequireShutdownReason requires a reason to stop or terminate workflows through the API, which is recorded in
+	// their status and events
+	RequireShutdownReason bool `json:"requireShutdownReason,omitempty"`
+
+	// PodSecurityStandard is the Pod Security Standard the pods of workflows must satisfy. If "restricted", the security
+	// contexts of pods are set to satisfy it, and workflows that violate it fail with a SpecError condition.
+	PodSecurityStandard string `json:"podSecurityStandard,omitempty"`
+
+	// ExecutorServiceAccounts map namespaces to the service account the executor uses, unless a workflow or template
+	// sets executor.serviceAccountName
+	ExecutorServiceAccounts []ExecutorServiceAccount `json:"executorServiceAccounts,omitempty"`
+
+	// Informers restricts the workflows, pods and configmaps the controller caches, to reduce its memory
+	Informers *InformersConfig `json:"informers,omitempty"`
+
+	// ExprFunctions are the optional functions, such as uuid, hash, regexReplace and formatTime, to make available in expressions
+	ExprFunctions []string `json:"exprFunctions,omitempty"`
+
+	// WorkflowTemplateRevisionHistoryLimit is the number of revisions of each WorkflowTemplate and ClusterWorkflowTemplate
+	// to keep, so workflows can pin them. Defaults to 10, zero or less disables recording revisions.
+	WorkflowTemplateRevisionHistoryLimit *int `json:"workflowTemplateRevisionHistoryLimit,omitempty"`
+
+	// StoredTemplates is how workflows keep the templates they resolve from WorkflowTemplates and ClusterWorkflowTemplates,
+	// either `Resolved` (default) or `Pinned`
+	StoredTemplates StoredTemplates `json:"storedTemplates,omitempty"`
+
+	// WorkflowTemplateGrants allow workflows to reference the WorkflowTemplates of other namespaces
+	WorkflowTemplateGrants []WorkflowTemplateGrant `json:"workflowTemplateGrants,omitempty"`
+
+	// TemplateImports configures importing WorkflowTemplates from OCI registries
+	TemplateImports *TemplateImports `json:"templateImports,omitempty"`
+
+	// TemplateSignatures configures verifying the signatures of WorkflowTemplates and ClusterWorkflowTemplates. If set,
+	// workflows can only reference signed templates.
+	TemplateSignatures *TemplateSignatures `json:"templateSignatures,omitempty"`
+
+	// SensitiveParameters configures the encryption of the values of sensitive parameters in the status of workflows
+	SensitiveParameters *SensitiveParameters `json:"sensitiveParameters,omitempty"`
+
+	// ArtifactURLs configures signed URLs to download artifacts without a token
+	ArtifactURLs *ArtifactURLs `json:"artifactURLs,omitempty"`
+
+	// ArtifactManifest enables the controller writing a JSON manifest of the output artifacts of each workflow to its
+	// artifact repository when it completes
+	ArtifactManifest bool `json:"artifactManifest,omitempty"`
 }
 
 func (c Config) GetExecutor() *apiv1.Container {
@@ -137,6 +275,23 @@ func (c Config) GetResourceRateLimit() ResourceRateLimit {
 	}
 }
 
+func (c Config) GetWorkflowUpdateRateLimit() ResourceRateLimit {
+	if c.WorkflowUpdateRateLimit != nil {
+		return *c.WorkflowUpdateRateLimit
+	}
+	return ResourceRateLimit{
+		Limit: math.MaxFloat32,
+		Burst: math.MaxInt32,
+	}
+}
+
+func (c Config) GetWorkflowTemplateRevisionHistoryLimit() int {
+	if c.WorkflowTemplateRevisionHistoryLimit != nil {
+		return *c.WorkflowTemplateRevisionHistoryLimit
+	}
+	return 10
+}
+
 func (c Config) GetPodGCDeleteDelayDuration() time.Duration {
 	if c.PodGCDeleteDelayDuration == nil {
 		return 5 * time.Second
@@ -215,6 +370,10 @@ type PersistConfig struct {
 	ArchiveLabelSelector *metav1.LabelSelector `json:"archiveLabelSelector,omitempty"`
 	// ArchiveTTL is the time to live for archived Workflows
 	ArchiveTTL TTL `json:"archiveTTL,omitempty"`
+	// EventArchive stores the events received by the Argo Server, so they can be replayed
+	EventArchive bool `json:"eventArchive,omitempty"`
+	// EventArchiveTTL is the time to live for archived events
+	EventArchiveTTL TTL `json:"eventArchiveTTL,omitempty"`
 	// ClusterName is the name of the cluster (or technically controller) for the persistence database
 	ClusterName string `json:"clusterName,omitempty"`
 	// SkipMigration skips database migration even if needed
@@ -269,6 +428,12 @@ type ConnectionPool struct {
 	MaxOpenConns int `json:"maxOpenConns,omitempty"`
 	// ConnMaxLifetime sets the maximum amount of time a connection may be reused
 	ConnMaxLifetime TTL `json:"connMaxLifetime,omitempty"`
+	// ConnMaxIdleTime sets the maximum amount of time a connection may be idle before being closed
+	ConnMaxIdleTime TTL `json:"connMaxIdleTime,omitempty"`
+	// StatementTimeout sets the maximum amount of time a statement may run for before the database aborts it, 0 means no timeout.
+	// For PostgreSQL this applies to all statements (statement_timeout). For MySQL this only applies to read-only
+	// SELECT statements (max_execution_time), inserts, updates and deletes are not limited
+	StatementTimeout TTL `json:"statementTimeout,omitempty"`
 }
 
 // DatabaseConfig contains common database connection settings
@@ -319,6 +484,8 @@ type MetricModifier struct {
 	// HistogramBuckets allow configuring of the buckets used in a histogram
 	// Has no effect on non-histogram buckets
 	HistogramBuckets []float64 `json:"histogramBuckets,omitempty"`
+	// MaxSeries is the maximum number of series of this metric, overriding the MaxSeries of all metrics
+	MaxSeries int `json:"maxSeries,omitempty"`
 }
 
 // MetricsTemporality defines the temporality of OpenTelemetry metrics
@@ -329,6 +496,9 @@ const (
 	MetricsTemporalityCumulative MetricsTemporality = "Cumulative"
 	// MetricsTemporalityDelta indicates delta temporality
 	MetricsTemporalityDelta MetricsTemporality = "Delta"
+	// MetricsTemporalityLowMemory indicates delta temporality for counters and histograms, and cumulative temporality
+	// for up-down counters and observable counters, as the OpenTelemetry "lowmemory" preference
+	MetricsTemporalityLowMemory MetricsTemporality = "LowMemory"
 )
 
 // MetricsConfig defines a config for a metrics server
@@ -351,9 +521,97 @@ type MetricsConfig struct {
 	// Modifiers configure metrics by name
 	Modifiers map[string]MetricModifier `json:"modifiers,omitempty"`
 	// Temporality of the OpenTelemetry metrics.
-	// Enum of Cumulative or Delta, defaulting to Cumulative.
+	// Enum of Cumulative, Delta or LowMemory, defaulting to Cumulative.
 	// No effect on Prometheus metrics, which are always Cumulative.
 	Temporality MetricsTemporality `json:"temporality,omitempty"`
+	// MaxSeries is the maximum number of series of each metric, default is no maximum. Once a metric has this many
+	// series, the measurements of new series are folded into a single series with the attribute overflow="true".
+	MaxSeries int `json:"maxSeries,omitempty"`
+	// ResourcesDurationLabel is the workflow label whose value groups the resources_duration metric, e.g. "team",
+	// in addition to the namespace
+	ResourcesDurationLabel string `json:"resourcesDurationLabel,omitempty"`
+	// TemplateDuration enables the template_duration metric for the templates it allows
+	TemplateDuration *TemplateDurationConfig `json:"templateDuration,omitempty"`
+	// TLS configures the certificate of the metrics server when secure, instead of a self-signed certificate
+	TLS *MetricsTLSConfig `json:"tls,omitempty"`
+	// Auth configures authentication of requests to the metrics server
+	Auth *MetricsAuthConfig `json:"auth,omitempty"`
+	// OTLP configures the push of metrics to an OpenTelemetry collector, which is enabled by setting the
+	// OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_METRICS_ENDPOINT environment variable
+	OTLP *MetricsOTLPConfig `json:"otlp,omitempty"`
+}
+
+// MetricsOTLPConfig configures the push of metrics to an OpenTelemetry collector. Unset fields default to the
+// OTEL_METRIC_EXPORT_* environment variables, then the OpenTelemetry defaults.
+type MetricsOTLPConfig struct {
+	// Interval between pushes, default is 60s
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// Timeout of each push, default is 30s
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Retry configures the retries of failed pushes, default is retrying with exponential backoff
+	Retry *MetricsOTLPRetryConfig `json:"retry,omitempty"`
+	// ResourceAttributes are added to the resource of the metrics, e.g. to identify the cluster
+	ResourceAttributes map[string]string `json:"resourceAttributes,omitempty"`
+}
+
+// MetricsOTLPRetryConfig configures the exponential backoff of retries of failed pushes
+type MetricsOTLPRetryConfig struct {
+	// Disabled turns off retries
+	Disabled bool `json:"disabled,omitempty"`
+	// InitialInterval is the time to wait before the first retry, default is 5s
+	InitialInterval *metav1.Duration `json:"initialInterval,omitempty"`
+	// MaxInterval is the maximum time to wait between retries, default is 30s
+	MaxInterval *metav1.Duration `json:"maxInterval,omitempty"`
+	// MaxElapsedTime is the maximum time to spend retrying a push, default is 1m
+	MaxElapsedTime *metav1.Duration `json:"maxElapsedTime,omitempty"`
+}
+
+// MetricsTLSConfig configures the certificates of the metrics server, which are reloaded every minute so they can be
+// rotated without a restart
+type MetricsTLSConfig struct {
+	// CertFile is the path of the PEM encoded certificate, which needs KeyFile
+	CertFile string `json:"certFile,omitempty"`
+	// KeyFile is the path of the PEM encoded key of the certificate
+	KeyFile string `json:"keyFile,omitempty"`
+	// SecretName is the name of a kubernetes.io/tls secret, in the controller's namespace, holding the certificate.
+	// Used instead of CertFile and KeyFile.
+	SecretName string `json:"secretName,omitempty"`
+	// ClientCAFile is the path of PEM encoded CA certificates. If set, clients must present a certificate signed by
+	// one of these CAs (mTLS).
+	ClientCAFile string `json:"clientCAFile,omitempty"`
+}
+
+// MetricsAuthConfig configures authentication of requests to the metrics server
+type MetricsAuthConfig struct {
+	// BearerTokenSecret is the key of a secret, in the controller's namespace, holding the token requests must bear in
+	// their Authorization header
+	BearerTokenSecret *apiv1.SecretKeySelector `json:"bearerTokenSecret,omitempty"`
+}
+
+// TemplateDurationConfig configures the template_duration metric, which is opt-in as each template is a separate series
+type TemplateDurationConfig struct {
+	// Templates are the templates to record the durations of nodes for: the template's name for templates in the workflow,
+	// or "<WorkflowTemplate name>/<template name>" for templates referenced by templateRef. Wildcards are supported,
+	// e.g. "my-wftmpl/*".
+	Templates []string `json:"templates,omitempty"`
+}
+
+// Allowed returns whether to record the durations of nodes of the template, whose templateRef is empty for templates
+// in the workflow
+func (c *TemplateDurationConfig) Allowed(templateRef, template string) bool {
+	if c == nil {
+		return false
+	}
+	name := template
+	if templateRef != "" {
+		name = templateRef + "/" + template
+	}
+	for _, pattern := range c.Templates {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
 }
 
 func (mc *MetricsConfig) GetSecure(defaultValue bool) bool {
@@ -373,11 +631,31 @@ func (mc *MetricsConfig) GetTemporality() metricsdk.TemporalitySelector {
 		return func(metricsdk.InstrumentKind) metricdata.Temporality {
 			return metricdata.DeltaTemporality
 		}
+	case MetricsTemporalityLowMemory:
+		return func(kind metricsdk.InstrumentKind) metricdata.Temporality {
+			switch kind {
+			case metricsdk.InstrumentKindCounter, metricsdk.InstrumentKindHistogram:
+				return metricdata.DeltaTemporality
+			default:
+				return metricdata.CumulativeTemporality
+			}
+		}
 	default:
 		return metricsdk.DefaultTemporalitySelector
 	}
 }
 
+// StoredTemplates defines how workflows keep the templates they resolve from WorkflowTemplates and ClusterWorkflowTemplates
+type StoredTemplates string
+
+const (
+	// StoredTemplatesResolved stores the resolved templates in the status of workflows
+	StoredTemplatesResolved StoredTemplates = "Resolved"
+	// StoredTemplatesPinned only stores the digests of the revisions of the templates they were resolved from in the
+	// status of workflows, and resolves them from those revisions again when they are needed, so that workflows are smaller
+	StoredTemplatesPinned StoredTemplates = "Pinned"
+)
+
 // WorkflowRestrictions contains restrictions for workflow execution
 type WorkflowRestrictions struct {
 	// TemplateReferencing controls how templates can be referenced
@@ -2,9 +2,12 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metricsdk "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
@@ -32,3 +35,118 @@ func TestSanitize(t *testing.T) {
 		}
 	}
 }
+
+func TestWorkflowTemplateGranted(t *testing.T) {
+	c := Config{WorkflowTemplateGrants: []WorkflowTemplateGrant{
+		{Namespace: "shared", Templates: []string{"build"}, ConsumerNamespaces: []string{"team-a"}},
+		{Namespace: "public", ConsumerNamespaces: []string{"*"}},
+	}}
+	assert.True(t, c.WorkflowTemplateGranted("team-b", "anything", "team-b"))
+	assert.True(t, c.WorkflowTemplateGranted("shared", "build", "team-a"))
+	assert.False(t, c.WorkflowTemplateGranted("shared", "deploy", "team-a"))
+	assert.False(t, c.WorkflowTemplateGranted("shared", "build", "team-b"))
+	assert.True(t, c.WorkflowTemplateGranted("public", "anything", "team-b"))
+	assert.False(t, c.WorkflowTemplateGranted("private", "anything", "team-b"))
+}
+
+func TestImagePolicy(t *testing.T) {
+	var p *ImagePolicy
+	require.NoError(t, p.Check("alpine"))
+
+	p = &ImagePolicy{Allowed: []string{"docker.io/library/*", "ghcr.io/my-org/*"}, Blocked: []string{"*:latest"}}
+	require.NoError(t, p.Check("alpine:3.19"))
+	require.NoError(t, p.Check("docker.io/library/alpine:3.19"))
+	require.NoError(t, p.Check("ghcr.io/my-org/team/app:v1"))
+	require.NoError(t, p.Check("ghcr.io/my-org/app@sha256:abc"))
+	require.EqualError(t, p.Check("alpine"), `image "alpine" is blocked by "*:latest"`)
+	require.EqualError(t, p.Check("ghcr.io/my-org/app"), `image "ghcr.io/my-org/app" is blocked by "*:latest"`)
+	require.EqualError(t, p.Check("quay.io/my-org/app:v1"), `image "quay.io/my-org/app:v1" is not allowed, it must match one of ["docker.io/library/*" "ghcr.io/my-org/*"]`)
+	require.ErrorContains(t, p.Check("my-org/app:v1"), "is not allowed")
+	require.NoError(t, (&ImagePolicy{Allowed: []string{"localhost:5000/*"}}).Check("localhost:5000/app:v1"))
+
+	p = &ImagePolicy{RequireDigest: true}
+	require.NoError(t, p.Check("alpine@sha256:abc"))
+	require.EqualError(t, p.Check("alpine:3.19"), `image "alpine:3.19" is not pinned to a digest`)
+}
+
+func TestGetExecutorServiceAccount(t *testing.T) {
+	assert.Nil(t, Config{}.GetExecutorServiceAccount("my-ns"))
+	c := Config{ExecutorServiceAccounts: []ExecutorServiceAccount{
+		{ServiceAccountName: "default-executor"},
+		{Namespaces: []string{"my-ns", "other-ns"}, ServiceAccountName: "my-executor", Token: &ExecutorServiceAccountToken{}},
+	}}
+	assert.Equal(t, "my-executor", c.GetExecutorServiceAccount("my-ns").ServiceAccountName)
+	assert.Equal(t, "default-executor", c.GetExecutorServiceAccount("argo").ServiceAccountName)
+	assert.Equal(t, 24*time.Hour, c.GetExecutorServiceAccount("my-ns").Token.GetExpiration())
+}
+
+func TestInformersConfig(t *testing.T) {
+	var c *InformersConfig
+	require.NoError(t, c.Validate())
+	assert.Empty(t, c.LabelRequirements())
+	assert.Empty(t, c.GetTrimPodFields())
+
+	c = &InformersConfig{LabelSelector: "team=data,tier!=test", TrimPodFields: []string{PodFieldManagedFields, PodFieldVolumes}}
+	require.NoError(t, c.Validate())
+	assert.Len(t, c.LabelRequirements(), 2)
+	assert.Equal(t, "team=data,tier!=test", c.LabelRequirements()[0].String()+","+c.LabelRequirements()[1].String())
+
+	require.ErrorContains(t, (&InformersConfig{LabelSelector: "team in (data"}).Validate(), "informers.labelSelector is invalid")
+	require.ErrorContains(t, (&InformersConfig{TrimPodFields: []string{"spec.containers"}}).Validate(), `informers.trimPodFields: "spec.containers" must be one of`)
+}
+
+func TestNormalizeImage(t *testing.T) {
+	for image, normalized := range map[string]string{
+		"alpine":                         "docker.io/library/alpine:latest",
+		"alpine:3.19":                    "docker.io/library/alpine:3.19",
+		"alpine@sha256:abc":              "docker.io/library/alpine@sha256:abc",
+		"my-org/app":                     "docker.io/my-org/app:latest",
+		"ghcr.io/my-org/app:v1":          "ghcr.io/my-org/app:v1",
+		"localhost/app":                  "localhost/app:latest",
+		"registry:5000/app":              "registry:5000/app:latest",
+		"registry:5000/app:v1@sha256:ab": "registry:5000/app:v1@sha256:ab",
+	} {
+		assert.Equal(t, normalized, normalizeImage(image), image)
+	}
+}
+
+func TestQueueFairness(t *testing.T) {
+	var f *QueueFairness
+	require.NoError(t, f.Validate())
+
+	f = &QueueFairness{NamespaceWeights: map[string]int{"tenant-b": 3}}
+	require.NoError(t, f.Validate())
+	assert.Equal(t, 3, f.GetWeight("tenant-b"))
+	assert.Equal(t, 1, f.GetWeight("tenant-a"))
+	f.DefaultWeight = 2
+	assert.Equal(t, 2, f.GetWeight("tenant-a"))
+
+	require.ErrorContains(t, (&QueueFairness{DefaultWeight: -1}).Validate(), "defaultWeight must not be negative")
+	require.ErrorContains(t, (&QueueFairness{NamespaceWeights: map[string]int{"tenant-b": 0}}).Validate(), `weight of namespace "tenant-b" must be greater than zero`)
+}
+
+func TestTemplateDurationConfig_Allowed(t *testing.T) {
+	var c *TemplateDurationConfig
+	assert.False(t, c.Allowed("", "main"))
+
+	c = &TemplateDurationConfig{Templates: []string{"main", "my-wftmpl/*"}}
+	assert.True(t, c.Allowed("", "main"))
+	assert.False(t, c.Allowed("", "other"))
+	assert.False(t, c.Allowed("other-wftmpl", "main"))
+	assert.True(t, c.Allowed("my-wftmpl", "build"))
+	assert.False(t, (&TemplateDurationConfig{Templates: []string{"*"}}).Allowed("my-wftmpl", "build"), "wildcards do not match templateRefs")
+}
+
+func TestMetricsConfig_GetTemporality(t *testing.T) {
+	lowMemory := (&MetricsConfig{Temporality: MetricsTemporalityLowMemory}).GetTemporality()
+	assert.Equal(t, metricdata.DeltaTemporality, lowMemory(metricsdk.InstrumentKindCounter))
+	assert.Equal(t, metricdata.DeltaTemporality, lowMemory(metricsdk.InstrumentKindHistogram))
+	assert.Equal(t, metricdata.CumulativeTemporality, lowMemory(metricsdk.InstrumentKindUpDownCounter))
+	assert.Equal(t, metricdata.CumulativeTemporality, lowMemory(metricsdk.InstrumentKindObservableCounter))
+
+	delta := (&MetricsConfig{Temporality: MetricsTemporalityDelta}).GetTemporality()
+	assert.Equal(t, metricdata.DeltaTemporality, delta(metricsdk.InstrumentKindUpDownCounter))
+
+	cumulative := (&MetricsConfig{}).GetTemporality()
+	assert.Equal(t, metricdata.CumulativeTemporality, cumulative(metricsdk.InstrumentKindCounter))
+}
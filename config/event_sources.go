@@ -0,0 +1,59 @@
+package config
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// EventSourcesConfig configures sources the Argo Server consumes events from, in addition to the event API
+type EventSourcesConfig struct {
+	// Kafka is a list of Kafka topics to consume events from
+	Kafka []KafkaEventSource `json:"kafka,omitempty"`
+}
+
+// KafkaEventSource consumes messages from Kafka topics and dispatches them to WorkflowEventBindings.
+// The topic is used as the event discriminator and the message value as the payload.
+type KafkaEventSource struct {
+	// Name uniquely identifies this source, and is used in the default consumer group
+	Name string `json:"name"`
+	// Brokers is the list of seed brokers, e.g. `kafka:9092`
+	Brokers []string `json:"brokers"`
+	// Topics to consume from
+	Topics []string `json:"topics"`
+	// ConsumerGroup is the consumer group to join, defaults to `argo-workflows-{name}`
+	ConsumerGroup string `json:"consumerGroup,omitempty"`
+	// Namespace is the namespace whose WorkflowEventBindings messages are dispatched to
+	Namespace string `json:"namespace"`
+	// TLS configures a TLS connection to the brokers
+	TLS *KafkaTLSConfig `json:"tls,omitempty"`
+	// SASL configures SASL authentication with the brokers
+	SASL *KafkaSASLConfig `json:"sasl,omitempty"`
+}
+
+func (s KafkaEventSource) GetConsumerGroup() string {
+	if s.ConsumerGroup != "" {
+		return s.ConsumerGroup
+	}
+	return "argo-workflows-" + s.Name
+}
+
+// KafkaTLSConfig configures TLS for Kafka. Secrets are read from the Argo Server's namespace.
+type KafkaTLSConfig struct {
+	// CASecret references a secret containing the PEM encoded CA certificate
+	CASecret *apiv1.SecretKeySelector `json:"caSecret,omitempty"`
+	// CertSecret references a secret containing the PEM encoded client certificate
+	CertSecret *apiv1.SecretKeySelector `json:"certSecret,omitempty"`
+	// KeySecret references a secret containing the PEM encoded client key
+	KeySecret *apiv1.SecretKeySelector `json:"keySecret,omitempty"`
+	// InsecureSkipVerify skips verification of the brokers' certificates
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// KafkaSASLConfig configures SASL for Kafka. Secrets are read from the Argo Server's namespace.
+type KafkaSASLConfig struct {
+	// Mechanism is one of `PLAIN`, `SCRAM-SHA-256` or `SCRAM-SHA-512`, defaults to `PLAIN`
+	Mechanism string `json:"mechanism,omitempty"`
+	// UserSecret references a secret containing the user name
+	UserSecret apiv1.SecretKeySelector `json:"userSecret"`
+	// PasswordSecret references a secret containing the password
+	PasswordSecret apiv1.SecretKeySelector `json:"passwordSecret"`
+}
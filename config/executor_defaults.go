@@ -0,0 +1,41 @@
+package config
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ExecutorDefaults are settings of the executor containers of the workflows they select, i.e. the init and wait
+// containers of their pods and the containers of their agent pods, for namespaces where the settings of the executor do
+// not suit, e.g. because of a LimitRange. A workflow must match both the namespaces and the selector to be selected, an
+// empty namespaces or selector matches every workflow.
+type ExecutorDefaults struct {
+	// Namespaces selects workflows in these namespaces
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Selector selects workflows by their labels
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// Image of the executor, e.g. of a mirror of the registry
+	Image string `json:"image,omitempty"`
+	// ImagePullPolicy of the executor
+	ImagePullPolicy apiv1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// Resources of the init and wait containers
+	Resources *apiv1.ResourceRequirements `json:"resources,omitempty"`
+	// SecurityContext of the executor containers
+	SecurityContext *apiv1.SecurityContext `json:"securityContext,omitempty"`
+	// AgentResources are the resources of the containers of agent pods
+	AgentResources *apiv1.ResourceRequirements `json:"agentResources,omitempty"`
+}
+
+// Selects returns whether the defaults apply to a workflow in the namespace with the labels
+func (d ExecutorDefaults) Selects(namespace string, workflowLabels map[string]string) (bool, error) {
+	return selectsWorkflow(d.Namespaces, d.Selector, namespace, workflowLabels)
+}
+
+// Validate returns an error if the selector is invalid
+func (d ExecutorDefaults) Validate() error {
+	if d.Selector == nil {
+		return nil
+	}
+	_, err := metav1.LabelSelectorAsSelector(d.Selector)
+	return err
+}
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"slices"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ExecutorServiceAccount maps namespaces to the service account the executor of the pods of their workflows, i.e. the
+// init and wait containers, and the agent, use, instead of the service account of the pod
+type ExecutorServiceAccount struct {
+	// Namespaces are the namespaces of the workflows the mapping applies to. A mapping without namespaces applies to
+	// the namespaces no other mapping applies to.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// ServiceAccountName is the name of the service account, in the namespace of the workflow
+	ServiceAccountName string `json:"serviceAccountName"`
+	// Token configures a token of the service account to be requested for each pod, instead of mounting the
+	// long-lived token secret of the service account
+	Token *ExecutorServiceAccountToken `json:"token,omitempty"`
+}
+
+// ExecutorServiceAccountToken configures requesting a token of the executor service account for each pod. The token is
+// stored in a secret owned by the workflow, and is no longer valid once the secret is deleted.
+type ExecutorServiceAccountToken struct {
+	// Expiration is how long the token is valid for, it must be longer than the pods run. Defaults to 24h.
+	Expiration metav1.Duration `json:"expiration,omitempty"`
+	// Audiences are the audiences of the token, defaults to the audiences of the Kubernetes API server
+	Audiences []string `json:"audiences,omitempty"`
+}
+
+func (t ExecutorServiceAccountToken) GetExpiration() time.Duration {
+	if t.Expiration.Duration > 0 {
+		return t.Expiration.Duration
+	}
+	return 24 * time.Hour
+}
+
+// GetExecutorServiceAccount returns the executor service account mapping of the namespace, or nil if there is none
+func (c Config) GetExecutorServiceAccount(namespace string) *ExecutorServiceAccount {
+	var fallback *ExecutorServiceAccount
+	for i, m := range c.ExecutorServiceAccounts {
+		if slices.Contains(m.Namespaces, namespace) {
+			return &c.ExecutorServiceAccounts[i]
+		}
+		if len(m.Namespaces) == 0 && fallback == nil {
+			fallback = &c.ExecutorServiceAccounts[i]
+		}
+	}
+	return fallback
+}
@@ -0,0 +1,24 @@
+package config
+
+// ImageDigestPinning configures resolving the tags of the images of workflows to digests when they are created, so
+// that every pod of a workflow, including retries, runs the same image even if a tag is pushed to again
+type ImageDigestPinning struct {
+	// Enabled resolves tags to digests, with HEAD requests to registries using the image pull secrets of the workflow
+	Enabled bool `json:"enabled,omitempty"`
+	// Exclude are the patterns of the images not to pin, e.g. "my-registry.local/*", as for the image policy
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// Pins returns whether the image is pinned to a digest
+func (p *ImageDigestPinning) Pins(image string) bool {
+	if p == nil || !p.Enabled {
+		return false
+	}
+	normalized := normalizeImage(image)
+	for _, pattern := range p.Exclude {
+		if matchesPattern(pattern, normalized) {
+			return false
+		}
+	}
+	return true
+}
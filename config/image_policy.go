@@ -0,0 +1,79 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ImagePolicy restricts the container images workflows may run. Patterns match the image as Docker resolves it, e.g.
+// "alpine" is "docker.io/library/alpine:latest", and "*" in a pattern matches any characters, e.g. "ghcr.io/my-org/*".
+type ImagePolicy struct {
+	// Allowed are the patterns of the images workflows may run, defaults to any image
+	Allowed []string `json:"allowed,omitempty"`
+	// Blocked are the patterns of the images workflows may not run, even if they are allowed
+	Blocked []string `json:"blocked,omitempty"`
+	// RequireDigest requires images to be pinned to a digest, e.g. "alpine@sha256:..."
+	RequireDigest bool `json:"requireDigest,omitempty"`
+}
+
+// Check returns an error if workflows may not run the image
+func (p *ImagePolicy) Check(image string) error {
+	if p == nil {
+		return nil
+	}
+	normalized := normalizeImage(image)
+	if p.RequireDigest && !strings.Contains(image, "@") {
+		return fmt.Errorf("image %q is not pinned to a digest", image)
+	}
+	for _, pattern := range p.Blocked {
+		if matchesPattern(pattern, normalized) {
+			return fmt.Errorf("image %q is blocked by %q", image, pattern)
+		}
+	}
+	if len(p.Allowed) == 0 {
+		return nil
+	}
+	for _, pattern := range p.Allowed {
+		if matchesPattern(pattern, normalized) {
+			return nil
+		}
+	}
+	return fmt.Errorf("image %q is not allowed, it must match one of %q", image, p.Allowed)
+}
+
+// normalizeImage returns the image with the registry, repository and tag that Docker defaults to
+func normalizeImage(image string) string {
+	name, digest, hasDigest := strings.Cut(image, "@")
+	if i := strings.Index(name, "/"); i < 0 {
+		name = "docker.io/library/" + name
+	} else if domain := name[:i]; !strings.ContainsAny(domain, ".:") && domain != "localhost" {
+		name = "docker.io/" + name
+	}
+	if !hasDigest && !strings.Contains(name[strings.LastIndex(name, "/"):], ":") {
+		name += ":latest"
+	}
+	if hasDigest {
+		return name + "@" + digest
+	}
+	return name
+}
+
+// matchesPattern returns whether the pattern, in which "*" matches any characters, matches all of s
+func matchesPattern(pattern, s string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == s
+	}
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+	return strings.HasSuffix(s, parts[len(parts)-1])
+}
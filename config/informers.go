@@ -0,0 +1,65 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// The fields of pods that may be trimmed before they are cached. The controller does not read them from the pods it
+// has created.
+const (
+	PodFieldManagedFields    = "metadata.managedFields"
+	PodFieldVolumes          = "spec.volumes"
+	PodFieldInitContainers   = "spec.initContainers"
+	PodFieldAffinity         = "spec.affinity"
+	PodFieldTolerations      = "spec.tolerations"
+	PodFieldNodeSelector     = "spec.nodeSelector"
+	PodFieldImagePullSecrets = "spec.imagePullSecrets"
+)
+
+var TrimmablePodFields = []string{PodFieldManagedFields, PodFieldVolumes, PodFieldInitContainers, PodFieldAffinity, PodFieldTolerations, PodFieldNodeSelector, PodFieldImagePullSecrets}
+
+// InformersConfig restricts the objects the informers of the controller cache, to reduce its memory. Changes only take
+// effect once the controller is restarted.
+type InformersConfig struct {
+	// LabelSelector selects the workflows, pods and configmaps the controller watches, in addition to its instance ID.
+	// The labels of the workflow the selector requires are added to its pods, configmaps must be labelled by the user.
+	LabelSelector string `json:"labelSelector,omitempty"`
+	// TrimPodFields are the fields removed from pods before they are cached, one of metadata.managedFields,
+	// spec.volumes, spec.initContainers, spec.affinity, spec.tolerations, spec.nodeSelector and spec.imagePullSecrets
+	TrimPodFields []string `json:"trimPodFields,omitempty"`
+}
+
+func (c *InformersConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if _, err := labels.ParseToRequirements(c.LabelSelector); err != nil {
+		return fmt.Errorf("informers.labelSelector is invalid: %w", err)
+	}
+	for _, field := range c.TrimPodFields {
+		if !slices.Contains(TrimmablePodFields, field) {
+			return fmt.Errorf("informers.trimPodFields: %q must be one of %v", field, TrimmablePodFields)
+		}
+	}
+	return nil
+}
+
+// LabelRequirements returns the requirements of the label selector, or none if it is not set or invalid
+func (c *InformersConfig) LabelRequirements() labels.Requirements {
+	if c == nil {
+		return nil
+	}
+	requirements, _ := labels.ParseToRequirements(c.LabelSelector)
+	return requirements
+}
+
+// GetTrimPodFields returns the fields removed from pods before they are cached
+func (c *InformersConfig) GetTrimPodFields() []string {
+	if c == nil {
+		return nil
+	}
+	return c.TrimPodFields
+}
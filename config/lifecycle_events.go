@@ -0,0 +1,49 @@
+package config
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// LifecycleEvents configures publishing workflow and node phase transitions to a message bus.
+// Exactly one of Kafka or NATS must be specified.
+type LifecycleEvents struct {
+	// Kafka publishes events to a Kafka topic
+	Kafka *KafkaLifecycleEvents `json:"kafka,omitempty"`
+	// NATS publishes events to a NATS JetStream subject
+	NATS *NATSLifecycleEvents `json:"nats,omitempty"`
+	// Nodes controls whether node phase transitions are published as well as workflow phase transitions, defaults to true
+	Nodes *bool `json:"nodes,omitempty"`
+}
+
+func (e LifecycleEvents) IsNodesEnabled() bool {
+	return e.Nodes == nil || *e.Nodes
+}
+
+// KafkaLifecycleEvents publishes events to a Kafka topic, keyed by workflow UID so each workflow's events are ordered
+type KafkaLifecycleEvents struct {
+	// Brokers is the list of seed brokers, e.g. `kafka:9092`
+	Brokers []string `json:"brokers"`
+	// Topic to publish to
+	Topic string `json:"topic"`
+	// TLS configures a TLS connection to the brokers
+	TLS *KafkaTLSConfig `json:"tls,omitempty"`
+	// SASL configures SASL authentication with the brokers
+	SASL *KafkaSASLConfig `json:"sasl,omitempty"`
+}
+
+// NATSLifecycleEvents publishes events to a NATS JetStream subject. A stream must capture the subject.
+// Secrets are read from the controller's namespace.
+type NATSLifecycleEvents struct {
+	// URL of the NATS server, e.g. `nats://nats:4222`, use `tls://` for TLS
+	URL string `json:"url"`
+	// Subject to publish to
+	Subject string `json:"subject"`
+	// TokenSecret references a secret containing an authentication token
+	TokenSecret *apiv1.SecretKeySelector `json:"tokenSecret,omitempty"`
+	// UserSecret references a secret containing the user name
+	UserSecret *apiv1.SecretKeySelector `json:"userSecret,omitempty"`
+	// PasswordSecret references a secret containing the password
+	PasswordSecret *apiv1.SecretKeySelector `json:"passwordSecret,omitempty"`
+	// CASecret references a secret containing the PEM encoded CA certificate
+	CASecret *apiv1.SecretKeySelector `json:"caSecret,omitempty"`
+}
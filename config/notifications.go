@@ -0,0 +1,59 @@
+package config
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// Notifications configures the channels that workflows' notifications are sent to.
+// Secrets are read from the controller's namespace.
+type Notifications struct {
+	// Channels are the channels workflows can send notifications to, by name
+	Channels map[string]NotificationChannel `json:"channels,omitempty"`
+}
+
+// NotificationChannel sends notifications to exactly one of Slack, email or a webhook
+type NotificationChannel struct {
+	// Slack posts notifications to a Slack incoming webhook
+	Slack *SlackNotificationChannel `json:"slack,omitempty"`
+	// Email sends notifications by SMTP
+	Email *EmailNotificationChannel `json:"email,omitempty"`
+	// Webhook posts notifications as JSON to a URL
+	Webhook *WebhookNotificationChannel `json:"webhook,omitempty"`
+}
+
+// SlackNotificationChannel posts notifications to a Slack incoming webhook
+type SlackNotificationChannel struct {
+	// URLSecret references a secret containing the URL of the incoming webhook
+	URLSecret apiv1.SecretKeySelector `json:"urlSecret"`
+}
+
+// EmailNotificationChannel sends notifications by SMTP
+type EmailNotificationChannel struct {
+	// Host is the host name of the SMTP server
+	Host string `json:"host"`
+	// Port of the SMTP server, defaults to 587
+	Port int `json:"port,omitempty"`
+	// From is the sender's address
+	From string `json:"from"`
+	// To are the recipients' addresses
+	To []string `json:"to"`
+	// UsernameSecret references a secret containing the user name to authenticate with
+	UsernameSecret *apiv1.SecretKeySelector `json:"usernameSecret,omitempty"`
+	// PasswordSecret references a secret containing the password to authenticate with
+	PasswordSecret *apiv1.SecretKeySelector `json:"passwordSecret,omitempty"`
+}
+
+func (c EmailNotificationChannel) GetPort() int {
+	if c.Port == 0 {
+		return 587
+	}
+	return c.Port
+}
+
+// WebhookNotificationChannel posts notifications as JSON to a URL
+type WebhookNotificationChannel struct {
+	// URL to post to
+	URL string `json:"url"`
+	// AuthorizationSecret references a secret containing the value of the Authorization header
+	AuthorizationSecret *apiv1.SecretKeySelector `json:"authorizationSecret,omitempty"`
+}
@@ -0,0 +1,31 @@
+package config
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PodDefaults are defaults for the pods of the workflows they select, to steer them onto the right nodes without
+// editing every template. A workflow must match both the namespaces and the selector to be selected, an empty
+// namespaces or selector matches every workflow.
+// The defaults are beneath the settings of the template and the workflow: a priority or runtime class of the pod is
+// kept, node selector labels of the pod take precedence, and tolerations are added to those of the pod.
+type PodDefaults struct {
+	// Namespaces selects workflows in these namespaces
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Selector selects workflows by their labels
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// PriorityClassName of the pods
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// RuntimeClassName of the pods
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
+	// NodeSelector labels of the pods
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations of the pods
+	Tolerations []apiv1.Toleration `json:"tolerations,omitempty"`
+}
+
+// Selects returns whether the defaults apply to a workflow in the namespace with the labels
+func (d PodDefaults) Selects(namespace string, workflowLabels map[string]string) (bool, error) {
+	return selectsWorkflow(d.Namespaces, d.Selector, namespace, workflowLabels)
+}
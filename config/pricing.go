@@ -0,0 +1,59 @@
+package config
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// Pricing configures estimating the cost of workflows from the resources duration of their pods, i.e. the resources
+// each pod requested, or the defaults, multiplied by how long it ran for
+type Pricing struct {
+	// Prices are the default prices
+	Prices PricingRates `json:"prices,omitempty"`
+	// Currency the prices are in, e.g. USD. It is only shown to users.
+	Currency string `json:"currency,omitempty"`
+	// GPUResourceName is the extended resource of GPUs. Defaults to "nvidia.com/gpu"
+	GPUResourceName apiv1.ResourceName `json:"gpuResourceName,omitempty"`
+	// NodePoolLabel is the label of nodes that names their pool, e.g. `cloud.google.com/gke-nodepool`.
+	// A pod is in the pool named by its node selector for the label.
+	NodePoolLabel string `json:"nodePoolLabel,omitempty"`
+	// NodePools are the prices of pods in each node pool, by the name of the pool, in place of the default prices
+	NodePools map[string]PricingRates `json:"nodePools,omitempty"`
+}
+
+// PricingRates are prices per hour
+type PricingRates struct {
+	// CPUHour is the price of a CPU for an hour
+	CPUHour float64 `json:"cpuHour,omitempty"`
+	// MemoryGBHour is the price of a gigabyte (1Gi) of memory for an hour
+	MemoryGBHour float64 `json:"memoryGBHour,omitempty"`
+	// GPUHour is the price of a GPU for an hour
+	GPUHour float64 `json:"gpuHour,omitempty"`
+}
+
+// GetGPUResourceName returns the extended resource of GPUs
+func (p Pricing) GetGPUResourceName() apiv1.ResourceName {
+	if p.GPUResourceName == "" {
+		return "nvidia.com/gpu"
+	}
+	return p.GPUResourceName
+}
+
+// Cost returns the estimated cost of the resources duration of a pod with the node selector
+func (p Pricing) Cost(nodeSelector map[string]string, d wfv1.ResourcesDuration) float64 {
+	rates := p.Prices
+	if pool, ok := nodeSelector[p.NodePoolLabel]; ok && p.NodePoolLabel != "" {
+		if poolRates, ok := p.NodePools[pool]; ok {
+			rates = poolRates
+		}
+	}
+	hours := func(name apiv1.ResourceName) float64 {
+		return d[name].Duration().Hours()
+	}
+	// memory durations are in units of 100Mi
+	memoryGB := wfv1.ResourceQuantityDenominator(apiv1.ResourceMemory).AsApproximateFloat64() / float64(1<<30)
+	return rates.CPUHour*hours(apiv1.ResourceCPU) +
+		rates.MemoryGBHour*hours(apiv1.ResourceMemory)*memoryGB +
+		rates.GPUHour*hours(p.GetGPUResourceName())
+}
@@ -0,0 +1,39 @@
+package config
+
+import "fmt"
+
+// QueueFairness shares the workers of a queue fairly between namespaces, so that a namespace that queues many
+// workflows only delays its own workflows
+type QueueFairness struct {
+	// DefaultWeight is the weight of the namespaces that are not in NamespaceWeights, defaults to 1
+	DefaultWeight int `json:"defaultWeight,omitempty"`
+	// NamespaceWeights are the weights of namespaces. When namespaces have workflows queued, each is given workers in
+	// proportion to its weight, e.g. a namespace with weight 2 is given twice as many as one with weight 1.
+	NamespaceWeights map[string]int `json:"namespaceWeights,omitempty"`
+}
+
+func (f *QueueFairness) Validate() error {
+	if f == nil {
+		return nil
+	}
+	if f.DefaultWeight < 0 {
+		return fmt.Errorf("workflowQueueFairness.defaultWeight must not be negative")
+	}
+	for namespace, weight := range f.NamespaceWeights {
+		if weight <= 0 {
+			return fmt.Errorf("workflowQueueFairness.namespaceWeights: weight of namespace %q must be greater than zero", namespace)
+		}
+	}
+	return nil
+}
+
+// GetWeight returns the weight of the namespace
+func (f QueueFairness) GetWeight(namespace string) int {
+	if weight, ok := f.NamespaceWeights[namespace]; ok {
+		return weight
+	}
+	if f.DefaultWeight > 0 {
+		return f.DefaultWeight
+	}
+	return 1
+}
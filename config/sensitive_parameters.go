@@ -0,0 +1,12 @@
+package config
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// SensitiveParameters configures the encryption of the values of sensitive parameters
+type SensitiveParameters struct {
+	// EncryptionKeySecret references a secret, in the namespace of the controller, of the key to encrypt the values with.
+	// Values encrypted with a previous key cannot be decrypted after it is changed.
+	EncryptionKeySecret apiv1.SecretKeySelector `json:"encryptionKeySecret"`
+}
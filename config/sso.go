@@ -33,8 +33,36 @@ type SSOConfig struct {
 	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
 	// FilterGroupsRegex filters groups using regular expressions
 	FilterGroupsRegex []string `json:"filterGroupsRegex,omitempty"`
+	// GroupsClaimPath is a JSONPath to the groups claim, e.g. `resource_access.argo.roles`, and overrides
+	// CustomGroupClaimName
+	GroupsClaimPath string `json:"groupsClaimPath,omitempty"`
+	// GroupsClaimSource is the token the groups claim is read from, either `idToken` (default) or `accessToken`
+	GroupsClaimSource string `json:"groupsClaimSource,omitempty"`
+	// GroupsRefreshInterval is how often the groups of a session are refreshed, using its refresh token. Groups are
+	// only read at login if not set.
+	GroupsRefreshInterval metav1.Duration `json:"groupsRefreshInterval,omitempty"`
+	// TokenExchange enables exchanging SSO sessions for short-lived tokens restricted to namespaces and verbs
+	TokenExchange *SSOTokenExchange `json:"tokenExchange,omitempty"`
 }
 
+// SSOTokenExchange configures exchanging SSO sessions for tokens
+type SSOTokenExchange struct {
+	// MaxExpiry is the longest a token may be valid for, defaults to 1h. Tokens never outlive the session.
+	MaxExpiry metav1.Duration `json:"maxExpiry,omitempty"`
+}
+
+func (e SSOTokenExchange) GetMaxExpiry() time.Duration {
+	if e.MaxExpiry.Duration > 0 {
+		return e.MaxExpiry.Duration
+	}
+	return time.Hour
+}
+
+const (
+	GroupsClaimSourceIDToken     = "idToken"
+	GroupsClaimSourceAccessToken = "accessToken"
+)
+
 func (c SSOConfig) GetSessionExpiry() time.Duration {
 	if c.SessionExpiry.Duration > 0 {
 		return c.SessionExpiry.Duration
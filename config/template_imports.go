@@ -0,0 +1,37 @@
+package config
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TemplateImports configures importing WorkflowTemplates from OCI registries
+type TemplateImports struct {
+	// Registries are the registries templates may be imported from, e.g. "ghcr.io", defaults to all registries
+	Registries []string `json:"registries,omitempty"`
+	// PublicKeys are PEM encoded cosign public keys. If set, each imported template must have been signed with one of them.
+	PublicKeys []string `json:"publicKeys,omitempty"`
+	// TagTTL is how long the digest that a tag resolves to is cached, defaults to 5m
+	TagTTL *metav1.Duration `json:"tagTTL,omitempty"`
+}
+
+// AllowsRegistry returns whether templates may be imported from the registry
+func (c *TemplateImports) AllowsRegistry(registry string) bool {
+	if c == nil || len(c.Registries) == 0 {
+		return true
+	}
+	for _, r := range c.Registries {
+		if r == registry {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *TemplateImports) GetTagTTL() time.Duration {
+	if c == nil || c.TagTTL == nil {
+		return 5 * time.Minute
+	}
+	return c.TagTTL.Duration
+}
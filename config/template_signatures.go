@@ -0,0 +1,7 @@
+package config
+
+// TemplateSignatures configures verifying the cosign signatures of WorkflowTemplates and ClusterWorkflowTemplates
+type TemplateSignatures struct {
+	// PublicKeys are PEM encoded cosign public keys, templates signed with any of them are verified
+	PublicKeys []string `json:"publicKeys,omitempty"`
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// WorkflowDefaultsProfile is a named set of workflow defaults for the workflows it selects.
+// A workflow must match both the namespaces and the selector to be selected, an empty namespaces or selector matches every workflow.
+type WorkflowDefaultsProfile struct {
+	// Name of the profile
+	Name string `json:"name"`
+	// Namespaces selects workflows in these namespaces
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Selector selects workflows by their labels
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// WorkflowDefaults are the defaults for the selected workflows, in the same form as the top-level workflowDefaults
+	WorkflowDefaults *wfv1.Workflow `json:"workflowDefaults,omitempty"`
+}
+
+// Selects returns whether the profile applies to a workflow in the namespace with the labels
+func (p WorkflowDefaultsProfile) Selects(namespace string, workflowLabels map[string]string) (bool, error) {
+	return selectsWorkflow(p.Namespaces, p.Selector, namespace, workflowLabels)
+}
+
+// selectsWorkflow returns whether a workflow in the namespace with the labels is in one of the namespaces and matches
+// the selector, an empty namespaces or selector matches every workflow
+func selectsWorkflow(namespaces []string, workflowSelector *metav1.LabelSelector, namespace string, workflowLabels map[string]string) (bool, error) {
+	if len(namespaces) > 0 && !slices.Contains(namespaces, namespace) {
+		return false, nil
+	}
+	if workflowSelector == nil {
+		return true, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(workflowSelector)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(workflowLabels)), nil
+}
@@ -0,0 +1,27 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWorkflowDefaultsProfile_Selects(t *testing.T) {
+	selects := func(p WorkflowDefaultsProfile, namespace string, labels map[string]string) bool {
+		selected, err := p.Selects(namespace, labels)
+		require.NoError(t, err)
+		return selected
+	}
+	assert.True(t, selects(WorkflowDefaultsProfile{}, "my-ns", nil))
+	assert.True(t, selects(WorkflowDefaultsProfile{Namespaces: []string{"my-ns"}}, "my-ns", nil))
+	assert.False(t, selects(WorkflowDefaultsProfile{Namespaces: []string{"my-ns"}}, "other-ns", nil))
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"type": "batch"}}
+	assert.True(t, selects(WorkflowDefaultsProfile{Selector: selector}, "my-ns", map[string]string{"type": "batch"}))
+	assert.False(t, selects(WorkflowDefaultsProfile{Selector: selector}, "my-ns", nil))
+	assert.False(t, selects(WorkflowDefaultsProfile{Namespaces: []string{"other-ns"}, Selector: selector}, "my-ns", map[string]string{"type": "batch"}), "must match both")
+
+	_, err := WorkflowDefaultsProfile{Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "type", Operator: "Bad"}}}}.Selects("my-ns", nil)
+	assert.Error(t, err)
+}
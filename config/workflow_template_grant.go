@@ -0,0 +1,39 @@
+package config
+
+// WorkflowTemplateGrant allows the workflows of other namespaces to reference the WorkflowTemplates of a namespace
+type WorkflowTemplateGrant struct {
+	// Namespace is the namespace of the WorkflowTemplates
+	Namespace string `json:"namespace"`
+	// Templates are the names of the WorkflowTemplates granted, defaults to all of them
+	Templates []string `json:"templates,omitempty"`
+	// ConsumerNamespaces are the namespaces whose workflows may reference the WorkflowTemplates, "*" grants all namespaces
+	ConsumerNamespaces []string `json:"consumerNamespaces"`
+}
+
+func (g WorkflowTemplateGrant) grants(namespace, name, consumerNamespace string) bool {
+	return g.Namespace == namespace &&
+		(len(g.Templates) == 0 || containsOrWildcard(g.Templates, name)) &&
+		containsOrWildcard(g.ConsumerNamespaces, consumerNamespace)
+}
+
+func containsOrWildcard(values []string, value string) bool {
+	for _, v := range values {
+		if v == "*" || v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// WorkflowTemplateGranted returns whether workflows in the consumer namespace may reference the WorkflowTemplate
+func (c Config) WorkflowTemplateGranted(namespace, name, consumerNamespace string) bool {
+	if namespace == consumerNamespace {
+		return true
+	}
+	for _, g := range c.WorkflowTemplateGrants {
+		if g.grants(namespace, name, consumerNamespace) {
+			return true
+		}
+	}
+	return false
+}
@@ -20,3 +20,8 @@ find manifests/base/crds/full -name 'argoproj.io*.yaml' | while read -r file; do
   cp "$file" "$minimal"
   go run ./hack/manifests minimizecrd "$minimal"
 done
+
+# schemas for `argo lint --offline --strict`
+for kind in workflows workflowtemplates clusterworkflowtemplates cronworkflows; do
+  go run ./hack/manifests lintschema "manifests/base/crds/full/argoproj.io_${kind}.yaml" cmd/argo/lint/schemas
+done
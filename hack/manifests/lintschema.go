@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// lintSchema writes the structural schema of the CRD, without descriptions, to the directory as gzipped JSON, for
+// `argo lint --offline --strict` to embed.
+func lintSchema(filename, dir string) {
+	crd := ParseYaml(Read(filename))
+	versions := nestedFieldNoCopy[[]interface{}](crd, "spec", "versions")
+	version := obj(versions[0].(map[string]interface{}))
+	schema := nestedFieldNoCopy[map[string]interface{}](&version, "schema", "openAPIV3Schema")
+	removeDescriptions(schema)
+	data, err := json.Marshal(schema)
+	if err != nil {
+		panic(err)
+	}
+	var buf bytes.Buffer
+	w, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		panic(err)
+	}
+	if _, err := w.Write(data); err != nil {
+		panic(err)
+	}
+	if err := w.Close(); err != nil {
+		panic(err)
+	}
+	name := strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename)) + ".json.gz"
+	if err := os.WriteFile(filepath.Join(dir, name), buf.Bytes(), 0o600); err != nil {
+		panic(err)
+	}
+}
+
+// removeDescriptions removes the descriptions of the schema and its nested schemas, but not properties named description
+func removeDescriptions(schema map[string]interface{}) {
+	delete(schema, "description")
+	for key, value := range schema {
+		switch value := value.(type) {
+		case map[string]interface{}:
+			if key != "properties" {
+				removeDescriptions(value)
+				continue
+			}
+			for _, property := range value {
+				if nested, ok := property.(map[string]interface{}); ok {
+					removeDescriptions(nested)
+				}
+			}
+		case []interface{}:
+			for _, item := range value {
+				if nested, ok := item.(map[string]interface{}); ok {
+					removeDescriptions(nested)
+				}
+			}
+		}
+	}
+}
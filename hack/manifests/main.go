@@ -10,6 +10,8 @@ func main() {
 		cleanCRD(os.Args[2])
 	case "minimizecrd":
 		minimizeCRD(os.Args[2])
+	case "lintschema":
+		lintSchema(os.Args[2], os.Args[3])
 	default:
 		panic(os.Args[1])
 	}
@@ -0,0 +1,201 @@
+package sqldb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/upper/db/v4"
+
+	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/sqldb"
+)
+
+const eventArchiveTableName = "argo_archived_events"
+
+// ArchivedEvent is an event received by the Argo Server
+type ArchivedEvent struct {
+	UID           string
+	Namespace     string
+	Discriminator string
+	// Metadata are the `x-` headers the event was received with
+	Metadata map[string][]string
+	// Payload is JSON
+	Payload    []byte
+	ReceivedAt time.Time
+}
+
+type archivedEventRecord struct {
+	ClusterName   string    `db:"clustername"`
+	InstanceID    string    `db:"instanceid"`
+	UID           string    `db:"uid"`
+	Namespace     string    `db:"namespace"`
+	Discriminator string    `db:"discriminator"`
+	Metadata      string    `db:"metadata"`
+	Payload       string    `db:"payload"`
+	ReceivedAt    time.Time `db:"receivedat"`
+}
+
+type EventArchive interface {
+	ArchiveEvent(ctx context.Context, event *ArchivedEvent) error
+	// list events, with the most recently received events first
+	ListEvents(ctx context.Context, namespace, discriminator string, limit, offset int) ([]ArchivedEvent, error)
+	GetEvent(ctx context.Context, namespace, uid string) (*ArchivedEvent, error)
+	DeleteExpiredEvents(ctx context.Context, ttl time.Duration) error
+	IsEnabled() bool
+}
+
+type eventArchive struct {
+	session           db.Session
+	clusterName       string
+	instanceIDService instanceid.Service
+	dbType            sqldb.DBType
+}
+
+// NewEventArchive returns a new eventArchive
+func NewEventArchive(session db.Session, clusterName string, instanceIDService instanceid.Service) EventArchive {
+	return &eventArchive{session: session, clusterName: clusterName, instanceIDService: instanceIDService, dbType: sqldb.DBTypeFor(session)}
+}
+
+func (r *eventArchive) IsEnabled() bool {
+	return true
+}
+
+func (r *eventArchive) ArchiveEvent(ctx context.Context, event *ArchivedEvent) error {
+	logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"uid": event.UID, "namespace": event.Namespace}).Debug(ctx, "Archiving event")
+	metadata, err := json.Marshal(event.Metadata)
+	if err != nil {
+		return err
+	}
+	payload := event.Payload
+	if len(payload) == 0 {
+		payload = []byte("null")
+	}
+	if r.dbType == sqldb.Postgres {
+		payload = bytes.ReplaceAll(payload, []byte("\\u0000"), []byte(postgresNullReplacement))
+	}
+	_, err = r.session.Collection(eventArchiveTableName).
+		Insert(&archivedEventRecord{
+			ClusterName:   r.clusterName,
+			InstanceID:    r.instanceIDService.InstanceID(),
+			UID:           event.UID,
+			Namespace:     event.Namespace,
+			Discriminator: event.Discriminator,
+			Metadata:      string(metadata),
+			Payload:       string(payload),
+			ReceivedAt:    event.ReceivedAt.UTC(),
+		})
+	return err
+}
+
+func (r *eventArchive) ListEvents(ctx context.Context, namespace, discriminator string, limit, offset int) ([]ArchivedEvent, error) {
+	query := r.session.SQL().
+		SelectFrom(eventArchiveTableName).
+		Where(r.clusterAndInstanceID())
+	if namespace != "" {
+		query = query.And(db.Cond{"namespace": namespace})
+	}
+	if discriminator != "" {
+		query = query.And(db.Cond{"discriminator": discriminator})
+	}
+	var records []archivedEventRecord
+	err := query.OrderBy("-receivedat").Limit(limit).Offset(offset).All(&records)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]ArchivedEvent, len(records))
+	for i, record := range records {
+		event, err := r.toEvent(record)
+		if err != nil {
+			return nil, err
+		}
+		events[i] = *event
+	}
+	return events, nil
+}
+
+func (r *eventArchive) GetEvent(ctx context.Context, namespace, uid string) (*ArchivedEvent, error) {
+	var record archivedEventRecord
+	err := r.session.SQL().
+		SelectFrom(eventArchiveTableName).
+		Where(r.clusterAndInstanceID()).
+		And(db.Cond{"namespace": namespace}).
+		And(db.Cond{"uid": uid}).
+		One(&record)
+	if err != nil {
+		if err == db.ErrNoMoreRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toEvent(record)
+}
+
+func (r *eventArchive) toEvent(record archivedEventRecord) (*ArchivedEvent, error) {
+	event := &ArchivedEvent{
+		UID:           record.UID,
+		Namespace:     record.Namespace,
+		Discriminator: record.Discriminator,
+		Payload:       []byte(record.Payload),
+		ReceivedAt:    record.ReceivedAt,
+	}
+	if r.dbType == sqldb.Postgres {
+		event.Payload = bytes.ReplaceAll(event.Payload, []byte(postgresNullReplacement), []byte("\\u0000"))
+	}
+	if err := json.Unmarshal([]byte(record.Metadata), &event.Metadata); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (r *eventArchive) DeleteExpiredEvents(ctx context.Context, ttl time.Duration) error {
+	logger := logging.RequireLoggerFromContext(ctx)
+	rs, err := r.session.SQL().
+		DeleteFrom(eventArchiveTableName).
+		Where(r.clusterAndInstanceID()).
+		And(fmt.Sprintf("receivedat < current_timestamp - interval '%d' second", int(ttl.Seconds()))).
+		Exec()
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := rs.RowsAffected()
+	if err != nil {
+		return err
+	}
+	logger.WithFields(logging.Fields{"rowsAffected": rowsAffected}).Info(ctx, "Deleted archived events")
+	return nil
+}
+
+func (r *eventArchive) clusterAndInstanceID() *db.AndExpr {
+	return db.And(
+		db.Cond{"clustername": r.clusterName},
+		db.Cond{"instanceid": r.instanceIDService.InstanceID()},
+	)
+}
+
+var NullEventArchive EventArchive = &nullEventArchive{}
+
+type nullEventArchive struct{}
+
+func (r *nullEventArchive) ArchiveEvent(context.Context, *ArchivedEvent) error {
+	return nil
+}
+
+func (r *nullEventArchive) ListEvents(context.Context, string, string, int, int) ([]ArchivedEvent, error) {
+	return nil, nil
+}
+
+func (r *nullEventArchive) GetEvent(context.Context, string, string) (*ArchivedEvent, error) {
+	return nil, nil
+}
+
+func (r *nullEventArchive) DeleteExpiredEvents(context.Context, time.Duration) error {
+	return nil
+}
+
+func (r *nullEventArchive) IsEnabled() bool {
+	return false
+}
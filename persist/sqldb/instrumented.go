@@ -0,0 +1,131 @@
+package sqldb
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
+)
+
+// QueryObserver is called with the name and duration of every completed persistence operation
+type QueryObserver func(ctx context.Context, operation string, duration time.Duration)
+
+// NewInstrumentedWorkflowArchive wraps a WorkflowArchive so that the duration of each operation is reported to observer
+func NewInstrumentedWorkflowArchive(archive WorkflowArchive, observer QueryObserver) WorkflowArchive {
+	if observer == nil || !archive.IsEnabled() {
+		return archive
+	}
+	return &instrumentedWorkflowArchive{archive: archive, observer: observer}
+}
+
+type instrumentedWorkflowArchive struct {
+	archive  WorkflowArchive
+	observer QueryObserver
+}
+
+func (r *instrumentedWorkflowArchive) observe(ctx context.Context, operation string, start time.Time) {
+	r.observer(ctx, operation, time.Since(start))
+}
+
+func (r *instrumentedWorkflowArchive) ArchiveWorkflow(ctx context.Context, wf *wfv1.Workflow) error {
+	defer r.observe(ctx, "archive_workflow", time.Now())
+	return r.archive.ArchiveWorkflow(ctx, wf)
+}
+
+func (r *instrumentedWorkflowArchive) ListWorkflows(ctx context.Context, options sutils.ListOptions) (wfv1.Workflows, error) {
+	defer r.observe(ctx, "list_workflows", time.Now())
+	return r.archive.ListWorkflows(ctx, options)
+}
+
+func (r *instrumentedWorkflowArchive) CountWorkflows(ctx context.Context, options sutils.ListOptions) (int64, error) {
+	defer r.observe(ctx, "count_workflows", time.Now())
+	return r.archive.CountWorkflows(ctx, options)
+}
+
+func (r *instrumentedWorkflowArchive) GetWorkflow(ctx context.Context, uid string, namespace string, name string) (*wfv1.Workflow, error) {
+	defer r.observe(ctx, "get_workflow", time.Now())
+	return r.archive.GetWorkflow(ctx, uid, namespace, name)
+}
+
+func (r *instrumentedWorkflowArchive) GetWorkflowForEstimator(ctx context.Context, namespace string, requirements []labels.Requirement) (*wfv1.Workflow, error) {
+	defer r.observe(ctx, "get_workflow_for_estimator", time.Now())
+	return r.archive.GetWorkflowForEstimator(ctx, namespace, requirements)
+}
+
+func (r *instrumentedWorkflowArchive) ListWorkflowsForEstimator(ctx context.Context, namespace string, requirements []labels.Requirement, limit int) (wfv1.Workflows, error) {
+	defer r.observe(ctx, "list_workflows_for_estimator", time.Now())
+	return r.archive.ListWorkflowsForEstimator(ctx, namespace, requirements, limit)
+}
+
+func (r *instrumentedWorkflowArchive) DeleteWorkflow(ctx context.Context, uid string) error {
+	defer r.observe(ctx, "delete_workflow", time.Now())
+	return r.archive.DeleteWorkflow(ctx, uid)
+}
+
+func (r *instrumentedWorkflowArchive) DeleteExpiredWorkflows(ctx context.Context, ttl time.Duration) error {
+	defer r.observe(ctx, "delete_expired_workflows", time.Now())
+	return r.archive.DeleteExpiredWorkflows(ctx, ttl)
+}
+
+func (r *instrumentedWorkflowArchive) IsEnabled() bool {
+	return r.archive.IsEnabled()
+}
+
+func (r *instrumentedWorkflowArchive) ListWorkflowsLabelKeys(ctx context.Context) (*wfv1.LabelKeys, error) {
+	defer r.observe(ctx, "list_label_keys", time.Now())
+	return r.archive.ListWorkflowsLabelKeys(ctx)
+}
+
+func (r *instrumentedWorkflowArchive) ListWorkflowsLabelValues(ctx context.Context, key string) (*wfv1.LabelValues, error) {
+	defer r.observe(ctx, "list_label_values", time.Now())
+	return r.archive.ListWorkflowsLabelValues(ctx, key)
+}
+
+// NewInstrumentedOffloadNodeStatusRepo wraps an OffloadNodeStatusRepo so that the duration of each operation is reported to observer
+func NewInstrumentedOffloadNodeStatusRepo(repo OffloadNodeStatusRepo, observer QueryObserver) OffloadNodeStatusRepo {
+	if observer == nil || !repo.IsEnabled() {
+		return repo
+	}
+	return &instrumentedOffloadNodeStatusRepo{repo: repo, observer: observer}
+}
+
+type instrumentedOffloadNodeStatusRepo struct {
+	repo     OffloadNodeStatusRepo
+	observer QueryObserver
+}
+
+func (r *instrumentedOffloadNodeStatusRepo) observe(ctx context.Context, operation string, start time.Time) {
+	r.observer(ctx, operation, time.Since(start))
+}
+
+func (r *instrumentedOffloadNodeStatusRepo) Save(ctx context.Context, uid, namespace string, nodes wfv1.Nodes) (string, error) {
+	defer r.observe(ctx, "offload_save", time.Now())
+	return r.repo.Save(ctx, uid, namespace, nodes)
+}
+
+func (r *instrumentedOffloadNodeStatusRepo) Get(ctx context.Context, uid, version string) (wfv1.Nodes, error) {
+	defer r.observe(ctx, "offload_get", time.Now())
+	return r.repo.Get(ctx, uid, version)
+}
+
+func (r *instrumentedOffloadNodeStatusRepo) List(ctx context.Context, namespace string) (map[UUIDVersion]wfv1.Nodes, error) {
+	defer r.observe(ctx, "offload_list", time.Now())
+	return r.repo.List(ctx, namespace)
+}
+
+func (r *instrumentedOffloadNodeStatusRepo) ListOldOffloads(ctx context.Context, namespace string) (map[string][]string, error) {
+	defer r.observe(ctx, "offload_list_old", time.Now())
+	return r.repo.ListOldOffloads(ctx, namespace)
+}
+
+func (r *instrumentedOffloadNodeStatusRepo) Delete(ctx context.Context, uid, version string) error {
+	defer r.observe(ctx, "offload_delete", time.Now())
+	return r.repo.Delete(ctx, uid, version)
+}
+
+func (r *instrumentedOffloadNodeStatusRepo) IsEnabled() bool {
+	return r.repo.IsEnabled()
+}
@@ -0,0 +1,63 @@
+package sqldb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+type enabledWorkflowArchive struct {
+	nullWorkflowArchive
+}
+
+func (r *enabledWorkflowArchive) IsEnabled() bool {
+	return true
+}
+
+func TestNewInstrumentedWorkflowArchive(t *testing.T) {
+	t.Run("Disabled", func(t *testing.T) {
+		archive := NewInstrumentedWorkflowArchive(NullWorkflowArchive, func(context.Context, string, time.Duration) {})
+		assert.Equal(t, NullWorkflowArchive, archive)
+	})
+	t.Run("Enabled", func(t *testing.T) {
+		var operations []string
+		archive := NewInstrumentedWorkflowArchive(&enabledWorkflowArchive{}, func(_ context.Context, operation string, _ time.Duration) {
+			operations = append(operations, operation)
+		})
+		require.NoError(t, archive.ArchiveWorkflow(t.Context(), &wfv1.Workflow{}))
+		_, err := archive.ListWorkflowsLabelKeys(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"archive_workflow", "list_label_keys"}, operations)
+	})
+}
+
+type enabledOffloadNodeStatusRepo struct {
+	explosiveOffloadNodeStatusRepo
+}
+
+func (r *enabledOffloadNodeStatusRepo) IsEnabled() bool {
+	return true
+}
+
+func TestNewInstrumentedOffloadNodeStatusRepo(t *testing.T) {
+	t.Run("Disabled", func(t *testing.T) {
+		repo := NewInstrumentedOffloadNodeStatusRepo(ExplosiveOffloadNodeStatusRepo, func(context.Context, string, time.Duration) {})
+		assert.Equal(t, ExplosiveOffloadNodeStatusRepo, repo)
+	})
+	t.Run("Enabled", func(t *testing.T) {
+		var operations []string
+		repo := NewInstrumentedOffloadNodeStatusRepo(&enabledOffloadNodeStatusRepo{}, func(_ context.Context, operation string, _ time.Duration) {
+			operations = append(operations, operation)
+		})
+		_, err := repo.Get(t.Context(), "my-uid", "my-version")
+		require.Error(t, err)
+		_, err = repo.List(t.Context(), "my-ns")
+		require.Error(t, err)
+		assert.Equal(t, []string{"offload_get", "offload_list"}, operations)
+	})
+}
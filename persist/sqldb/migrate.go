@@ -226,5 +226,34 @@ func Migrate(ctx context.Context, session db.Session, clusterName, tableName str
 		}),
 		// add index on creationtimestamp column
 		sqldb.AnsiSQLChange(`create index argo_archived_workflows_i5 on argo_archived_workflows (creationtimestamp)`),
+		// events received by the Argo Server, so they can be replayed
+		sqldb.ByType(dbType, sqldb.TypedChanges{
+			sqldb.MySQL: sqldb.AnsiSQLChange(`create table if not exists argo_archived_events (
+    clustername varchar(64) not null,
+    instanceid varchar(64) not null,
+    uid varchar(128) not null,
+    namespace varchar(256) not null,
+    discriminator varchar(256) not null,
+    metadata json not null,
+    payload json not null,
+    receivedat timestamp not null default CURRENT_TIMESTAMP,
+    primary key (clustername, uid)
+)`),
+			sqldb.Postgres: sqldb.AnsiSQLChange(`create table if not exists argo_archived_events (
+    clustername varchar(64) not null,
+    instanceid varchar(64) not null,
+    uid varchar(128) not null,
+    namespace varchar(256) not null,
+    discriminator varchar(256) not null,
+    metadata jsonb not null,
+    payload jsonb not null,
+    receivedat timestamp not null default CURRENT_TIMESTAMP,
+    primary key (clustername, uid)
+)`),
+		}),
+		sqldb.AnsiSQLChange(`create index argo_archived_events_i1 on argo_archived_events (clustername,instanceid,namespace,receivedat)`),
+		sqldb.AnsiSQLChange(`create index argo_archived_events_i2 on argo_archived_events (clustername,instanceid,receivedat)`),
+		// the estimated cost of workflows, when the controller has pricing configured
+		sqldb.AnsiSQLChange(`alter table argo_archived_workflows add column estimatedcost double precision null`),
 	})
 }
@@ -544,6 +544,86 @@ func (_c *WorkflowArchive_ListWorkflows_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// ListWorkflowsForEstimator provides a mock function for the type WorkflowArchive
+func (_mock *WorkflowArchive) ListWorkflowsForEstimator(ctx context.Context, namespace string, requirements []labels.Requirement, limit int) (v1alpha1.Workflows, error) {
+	ret := _mock.Called(ctx, namespace, requirements, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorkflowsForEstimator")
+	}
+
+	var r0 v1alpha1.Workflows
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []labels.Requirement, int) (v1alpha1.Workflows, error)); ok {
+		return returnFunc(ctx, namespace, requirements, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []labels.Requirement, int) v1alpha1.Workflows); ok {
+		r0 = returnFunc(ctx, namespace, requirements, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(v1alpha1.Workflows)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []labels.Requirement, int) error); ok {
+		r1 = returnFunc(ctx, namespace, requirements, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorkflowArchive_ListWorkflowsForEstimator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWorkflowsForEstimator'
+type WorkflowArchive_ListWorkflowsForEstimator_Call struct {
+	*mock.Call
+}
+
+// ListWorkflowsForEstimator is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespace string
+//   - requirements []labels.Requirement
+//   - limit int
+func (_e *WorkflowArchive_Expecter) ListWorkflowsForEstimator(ctx interface{}, namespace interface{}, requirements interface{}, limit interface{}) *WorkflowArchive_ListWorkflowsForEstimator_Call {
+	return &WorkflowArchive_ListWorkflowsForEstimator_Call{Call: _e.mock.On("ListWorkflowsForEstimator", ctx, namespace, requirements, limit)}
+}
+
+func (_c *WorkflowArchive_ListWorkflowsForEstimator_Call) Run(run func(ctx context.Context, namespace string, requirements []labels.Requirement, limit int)) *WorkflowArchive_ListWorkflowsForEstimator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []labels.Requirement
+		if args[2] != nil {
+			arg2 = args[2].([]labels.Requirement)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *WorkflowArchive_ListWorkflowsForEstimator_Call) Return(workflows v1alpha1.Workflows, err error) *WorkflowArchive_ListWorkflowsForEstimator_Call {
+	_c.Call.Return(workflows, err)
+	return _c
+}
+
+func (_c *WorkflowArchive_ListWorkflowsForEstimator_Call) RunAndReturn(run func(ctx context.Context, namespace string, requirements []labels.Requirement, limit int) (v1alpha1.Workflows, error)) *WorkflowArchive_ListWorkflowsForEstimator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListWorkflowsLabelKeys provides a mock function for the type WorkflowArchive
 func (_mock *WorkflowArchive) ListWorkflowsLabelKeys(ctx context.Context) (*v1alpha1.LabelKeys, error) {
 	ret := _mock.Called(ctx)
@@ -39,6 +39,10 @@ func (r *nullWorkflowArchive) GetWorkflowForEstimator(ctx context.Context, names
 	return nil, fmt.Errorf("getting archived workflow for estimator not supported")
 }
 
+func (r *nullWorkflowArchive) ListWorkflowsForEstimator(ctx context.Context, namespace string, requirements []labels.Requirement, limit int) (wfv1.Workflows, error) {
+	return nil, fmt.Errorf("listing archived workflows for estimator not supported")
+}
+
 func (r *nullWorkflowArchive) DeleteWorkflow(ctx context.Context, uid string) error {
 	return fmt.Errorf("deleting archived workflows not supported")
 }
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -39,6 +40,7 @@ type archivedWorkflowMetadata struct {
 	StartedAt         time.Time          `db:"startedat"`
 	FinishedAt        time.Time          `db:"finishedat"`
 	CreationTimestamp time.Time          `db:"creationtimestamp,omitempty"`
+	EstimatedCost     *float64           `db:"estimatedcost,omitempty"`
 
 	// The following fields are not stored as columns in the database, and they are stored as JSON strings in the workflow column, and will be loaded from there.
 	Labels            string `db:"labels,omitempty"`
@@ -74,6 +76,8 @@ type WorkflowArchive interface {
 	CountWorkflows(ctx context.Context, options sutils.ListOptions) (int64, error)
 	GetWorkflow(ctx context.Context, uid string, namespace string, name string) (*wfv1.Workflow, error)
 	GetWorkflowForEstimator(ctx context.Context, namespace string, requirements []labels.Requirement) (*wfv1.Workflow, error)
+	// ListWorkflowsForEstimator lists up to limit of the most recently started succeeded workflows, with their nodes
+	ListWorkflowsForEstimator(ctx context.Context, namespace string, requirements []labels.Requirement, limit int) (wfv1.Workflows, error)
 	DeleteWorkflow(ctx context.Context, uid string) error
 	DeleteExpiredWorkflows(ctx context.Context, ttl time.Duration) error
 	IsEnabled() bool
@@ -130,6 +134,7 @@ func (r *workflowArchive) ArchiveWorkflow(ctx context.Context, wf *wfv1.Workflow
 					StartedAt:         wf.Status.StartedAt.Time,
 					FinishedAt:        wf.Status.FinishedAt.Time,
 					CreationTimestamp: wf.CreationTimestamp.Time,
+					EstimatedCost:     estimatedCost(wf),
 				},
 				Workflow: string(workflow),
 			})
@@ -164,7 +169,7 @@ func (r *workflowArchive) ArchiveWorkflow(ctx context.Context, wf *wfv1.Workflow
 
 func (r *workflowArchive) ListWorkflows(ctx context.Context, options sutils.ListOptions) (wfv1.Workflows, error) {
 	var archivedWfs []archivedWorkflowMetadata
-	var baseSelector = r.session.SQL().Select("name", "namespace", "uid", "phase", "startedat", "finishedat", "creationtimestamp")
+	var baseSelector = r.session.SQL().Select("name", "namespace", "uid", "phase", "startedat", "finishedat", "creationtimestamp", "estimatedcost")
 
 	switch r.dbType {
 	case sqldb.MySQL:
@@ -268,12 +273,31 @@ func (r *workflowArchive) ListWorkflows(ctx context.Context, options sutils.List
 				Message:           md.Message,
 				EstimatedDuration: wfv1.EstimatedDuration(md.EstimatedDuration),
 				ResourcesDuration: resourcesDuration,
+				EstimatedCost:     amount(md.EstimatedCost),
 			},
 		}
 	}
 	return wfs, nil
 }
 
+func estimatedCost(wf *wfv1.Workflow) *float64 {
+	if wf.Status.EstimatedCost == nil {
+		return nil
+	}
+	cost, err := wf.Status.EstimatedCost.Float64()
+	if err != nil {
+		return nil
+	}
+	return &cost
+}
+
+func amount(v *float64) *wfv1.Amount {
+	if v == nil {
+		return nil
+	}
+	return &wfv1.Amount{Value: json.Number(strconv.FormatFloat(*v, 'f', -1, 64))}
+}
+
 func (r *workflowArchive) CountWorkflows(ctx context.Context, options sutils.ListOptions) (int64, error) {
 	total := &archivedWorkflowCount{}
 
@@ -462,6 +486,43 @@ func (r *workflowArchive) GetWorkflowForEstimator(ctx context.Context, namespace
 
 }
 
+func (r *workflowArchive) ListWorkflowsForEstimator(ctx context.Context, namespace string, requirements []labels.Requirement, limit int) (wfv1.Workflows, error) {
+	selector := r.session.SQL().
+		Select("workflow").
+		From(archiveTableName).
+		Where(r.clusterManagedNamespaceAndInstanceID()).
+		And(phaseEqual(string(wfv1.NodeSucceeded)))
+
+	selector, err := BuildArchivedWorkflowSelector(selector, archiveTableName, archiveLabelsTableName, r.dbType, sutils.ListOptions{
+		Namespace:         namespace,
+		LabelRequirements: requirements,
+		Limit:             limit,
+		Offset:            0,
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []archivedWorkflowRecord
+	err = selector.All(&records)
+	if err != nil {
+		return nil, err
+	}
+
+	wfs := make(wfv1.Workflows, 0, len(records))
+	for _, record := range records {
+		if r.dbType == sqldb.Postgres {
+			record.Workflow = strings.ReplaceAll(record.Workflow, postgresNullReplacement, "\\u0000")
+		}
+		wf := wfv1.Workflow{}
+		if err := json.Unmarshal([]byte(record.Workflow), &wf); err != nil {
+			return nil, err
+		}
+		wfs = append(wfs, wf)
+	}
+	return wfs, nil
+}
+
 func (r *workflowArchive) DeleteWorkflow(ctx context.Context, uid string) error {
 	logger := logging.RequireLoggerFromContext(ctx)
 	rs, err := r.session.SQL().
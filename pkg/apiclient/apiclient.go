@@ -8,7 +8,9 @@ import (
 
 	clusterworkflowtmplpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
+	executorpluginpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/executorplugin"
 	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
 	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
@@ -23,6 +25,8 @@ type Client interface {
 	NewWorkflowTemplateServiceClient() (workflowtemplatepkg.WorkflowTemplateServiceClient, error)
 	NewClusterWorkflowTemplateServiceClient() (clusterworkflowtmplpkg.ClusterWorkflowTemplateServiceClient, error)
 	NewInfoServiceClient() (infopkg.InfoServiceClient, error)
+	NewMemoizationCacheServiceClient() (memoizationcachepkg.MemoizationCacheServiceClient, error)
+	NewExecutorPluginServiceClient() (executorpluginpkg.ExecutorPluginServiceClient, error)
 }
 
 type Opts struct {
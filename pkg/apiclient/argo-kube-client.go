@@ -14,7 +14,9 @@ import (
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
+	executorpluginpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/executorplugin"
 	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
@@ -22,6 +24,8 @@ import (
 	"github.com/argoproj/argo-workflows/v3/server/auth"
 	clusterworkflowtmplserver "github.com/argoproj/argo-workflows/v3/server/clusterworkflowtemplate"
 	cronworkflowserver "github.com/argoproj/argo-workflows/v3/server/cronworkflow"
+	executorpluginserver "github.com/argoproj/argo-workflows/v3/server/executorplugin"
+	memoizationcacheserver "github.com/argoproj/argo-workflows/v3/server/memoizationcache"
 	"github.com/argoproj/argo-workflows/v3/server/types"
 	workflowserver "github.com/argoproj/argo-workflows/v3/server/workflow"
 	"github.com/argoproj/argo-workflows/v3/server/workflow/store"
@@ -177,7 +181,7 @@ func (a *argoKubeClient) startStores(ctx context.Context, restConfig *restclient
 
 func (a *argoKubeClient) NewWorkflowServiceClient(ctx context.Context) workflowpkg.WorkflowServiceClient {
 	wfArchive := sqldb.NullWorkflowArchive
-	wfServer := workflowserver.NewWorkflowServer(ctx, a.instanceIDService, argoKubeOffloadNodeStatusRepo, wfArchive, a.wfClient, a.wfLister, a.wfStore, a.wfTmplStore, a.cwfTmplStore, nil, &a.namespace)
+	wfServer := workflowserver.NewWorkflowServer(ctx, a.instanceIDService, argoKubeOffloadNodeStatusRepo, wfArchive, a.wfClient, a.wfLister, a.wfStore, a.wfTmplStore, a.cwfTmplStore, nil, false, &a.namespace)
 	go wfServer.Run(a.opts.CachingCloseCh)
 	return &errorTranslatingWorkflowServiceClient{&argoKubeWorkflowServiceClient{wfServer}}
 }
@@ -198,6 +202,14 @@ func (a *argoKubeClient) NewInfoServiceClient() (infopkg.InfoServiceClient, erro
 	return nil, ErrNoArgoServer
 }
 
+func (a *argoKubeClient) NewMemoizationCacheServiceClient() (memoizationcachepkg.MemoizationCacheServiceClient, error) {
+	return &errorTranslatingMemoizationCacheServiceClient{&argoKubeMemoizationCacheServiceClient{memoizationcacheserver.NewMemoizationCacheServer()}}, nil
+}
+
+func (a *argoKubeClient) NewExecutorPluginServiceClient() (executorpluginpkg.ExecutorPluginServiceClient, error) {
+	return &errorTranslatingExecutorPluginServiceClient{&argoKubeExecutorPluginServiceClient{executorpluginserver.NewExecutorPluginServer()}}, nil
+}
+
 func (a *argoKubeClient) NewClusterWorkflowTemplateServiceClient() (clusterworkflowtemplate.ClusterWorkflowTemplateServiceClient, error) {
-	return &errorTranslatingWorkflowClusterTemplateServiceClient{&argoKubeWorkflowClusterTemplateServiceClient{clusterworkflowtmplserver.NewClusterWorkflowTemplateServer(a.instanceIDService, a.cwfTmplStore, nil)}}, nil
+	return &errorTranslatingWorkflowClusterTemplateServiceClient{&argoKubeWorkflowClusterTemplateServiceClient{clusterworkflowtmplserver.NewClusterWorkflowTemplateServer(a.instanceIDService, a.cwfTmplStore, nil, a.namespace)}}, nil
 }
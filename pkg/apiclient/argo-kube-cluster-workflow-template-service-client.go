@@ -38,3 +38,7 @@ func (a *argoKubeWorkflowClusterTemplateServiceClient) DeleteClusterWorkflowTemp
 func (a *argoKubeWorkflowClusterTemplateServiceClient) LintClusterWorkflowTemplate(ctx context.Context, req *clusterworkflowtmplpkg.ClusterWorkflowTemplateLintRequest, opts ...grpc.CallOption) (*v1alpha1.ClusterWorkflowTemplate, error) {
 	return a.delegate.LintClusterWorkflowTemplate(ctx, req)
 }
+
+func (a *argoKubeWorkflowClusterTemplateServiceClient) ListClusterWorkflowTemplateRevisions(ctx context.Context, req *clusterworkflowtmplpkg.ClusterWorkflowTemplateRevisionsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplateRevisionList, error) {
+	return a.delegate.ListClusterWorkflowTemplateRevisions(ctx, req)
+}
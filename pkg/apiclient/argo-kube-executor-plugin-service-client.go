@@ -0,0 +1,31 @@
+package apiclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	executorpluginpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/executorplugin"
+)
+
+type argoKubeExecutorPluginServiceClient struct {
+	delegate executorpluginpkg.ExecutorPluginServiceServer
+}
+
+var _ executorpluginpkg.ExecutorPluginServiceClient = &argoKubeExecutorPluginServiceClient{}
+
+func (c *argoKubeExecutorPluginServiceClient) ListExecutorPlugins(ctx context.Context, req *executorpluginpkg.ListExecutorPluginsRequest, _ ...grpc.CallOption) (*executorpluginpkg.ExecutorPluginList, error) {
+	return c.delegate.ListExecutorPlugins(ctx, req)
+}
+
+func (c *argoKubeExecutorPluginServiceClient) GetExecutorPlugin(ctx context.Context, req *executorpluginpkg.GetExecutorPluginRequest, _ ...grpc.CallOption) (*executorpluginpkg.ExecutorPlugin, error) {
+	return c.delegate.GetExecutorPlugin(ctx, req)
+}
+
+func (c *argoKubeExecutorPluginServiceClient) EnableExecutorPlugin(ctx context.Context, req *executorpluginpkg.EnableExecutorPluginRequest, _ ...grpc.CallOption) (*executorpluginpkg.ExecutorPlugin, error) {
+	return c.delegate.EnableExecutorPlugin(ctx, req)
+}
+
+func (c *argoKubeExecutorPluginServiceClient) DisableExecutorPlugin(ctx context.Context, req *executorpluginpkg.DisableExecutorPluginRequest, _ ...grpc.CallOption) (*executorpluginpkg.ExecutorPlugin, error) {
+	return c.delegate.DisableExecutorPlugin(ctx, req)
+}
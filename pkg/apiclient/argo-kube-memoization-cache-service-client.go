@@ -0,0 +1,23 @@
+package apiclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
+)
+
+type argoKubeMemoizationCacheServiceClient struct {
+	delegate memoizationcachepkg.MemoizationCacheServiceServer
+}
+
+var _ memoizationcachepkg.MemoizationCacheServiceClient = &argoKubeMemoizationCacheServiceClient{}
+
+func (c *argoKubeMemoizationCacheServiceClient) ListCacheEntries(ctx context.Context, req *memoizationcachepkg.ListCacheEntriesRequest, _ ...grpc.CallOption) (*memoizationcachepkg.CacheEntryList, error) {
+	return c.delegate.ListCacheEntries(ctx, req)
+}
+
+func (c *argoKubeMemoizationCacheServiceClient) DeleteCacheEntry(ctx context.Context, req *memoizationcachepkg.DeleteCacheEntryRequest, _ ...grpc.CallOption) (*memoizationcachepkg.CacheEntryDeletedResponse, error) {
+	return c.delegate.DeleteCacheEntry(ctx, req)
+}
@@ -396,6 +396,55 @@ func (m *ClusterWorkflowTemplateLintRequest) GetCreateOptions() *v1.CreateOption
 	return nil
 }
 
+type ClusterWorkflowTemplateRevisionsRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterWorkflowTemplateRevisionsRequest) Reset() {
+	*m = ClusterWorkflowTemplateRevisionsRequest{}
+}
+func (m *ClusterWorkflowTemplateRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ClusterWorkflowTemplateRevisionsRequest) ProtoMessage()    {}
+func (*ClusterWorkflowTemplateRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_688d96b5f613e598, []int{7}
+}
+func (m *ClusterWorkflowTemplateRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterWorkflowTemplateRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterWorkflowTemplateRevisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterWorkflowTemplateRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterWorkflowTemplateRevisionsRequest.Merge(m, src)
+}
+func (m *ClusterWorkflowTemplateRevisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterWorkflowTemplateRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterWorkflowTemplateRevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterWorkflowTemplateRevisionsRequest proto.InternalMessageInfo
+
+func (m *ClusterWorkflowTemplateRevisionsRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ClusterWorkflowTemplateCreateRequest)(nil), "clusterworkflowtemplate.ClusterWorkflowTemplateCreateRequest")
 	proto.RegisterType((*ClusterWorkflowTemplateGetRequest)(nil), "clusterworkflowtemplate.ClusterWorkflowTemplateGetRequest")
@@ -404,6 +453,7 @@ func init() {
 	proto.RegisterType((*ClusterWorkflowTemplateDeleteRequest)(nil), "clusterworkflowtemplate.ClusterWorkflowTemplateDeleteRequest")
 	proto.RegisterType((*ClusterWorkflowTemplateDeleteResponse)(nil), "clusterworkflowtemplate.ClusterWorkflowTemplateDeleteResponse")
 	proto.RegisterType((*ClusterWorkflowTemplateLintRequest)(nil), "clusterworkflowtemplate.ClusterWorkflowTemplateLintRequest")
+	proto.RegisterType((*ClusterWorkflowTemplateRevisionsRequest)(nil), "clusterworkflowtemplate.ClusterWorkflowTemplateRevisionsRequest")
 }
 
 func init() {
@@ -411,49 +461,52 @@ func init() {
 }
 
 var fileDescriptor_688d96b5f613e598 = []byte{
-	// 671 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcf, 0x6b, 0xd4, 0x40,
-	0x14, 0x66, 0x16, 0x11, 0x9d, 0xd2, 0xcb, 0x1c, 0xb4, 0xc4, 0x76, 0xd1, 0xa1, 0x52, 0x5d, 0xed,
-	0xc4, 0xb4, 0x3d, 0x48, 0x45, 0x0f, 0x6d, 0xa5, 0x1e, 0x2a, 0x4a, 0xea, 0x0f, 0x2a, 0x88, 0x4c,
-	0xd3, 0x31, 0x1b, 0x37, 0x9b, 0x89, 0x99, 0xd9, 0x94, 0x22, 0x5e, 0xbc, 0x79, 0xf2, 0x20, 0xfe,
-	0x29, 0xfe, 0x0f, 0x1e, 0x15, 0xff, 0x01, 0x59, 0x44, 0xf4, 0xe4, 0x4d, 0x3c, 0x4a, 0x26, 0x3f,
-	0x17, 0x9d, 0x36, 0xbb, 0x74, 0x7b, 0xf0, 0x16, 0x66, 0xe6, 0xbd, 0xf7, 0x7d, 0xef, 0x7d, 0xf3,
-	0x65, 0xe0, 0xcd, 0xb0, 0xe3, 0x9a, 0x34, 0xf4, 0x1c, 0xdf, 0x63, 0x81, 0x34, 0x1d, 0xbf, 0x27,
-	0x24, 0x8b, 0x76, 0x79, 0xd4, 0x79, 0xea, 0xf3, 0x5d, 0xc9, 0xba, 0xa1, 0x4f, 0x25, 0xcb, 0xd7,
-	0xe7, 0xf3, 0x8d, 0xf9, 0x7c, 0x87, 0x84, 0x11, 0x97, 0x1c, 0x9d, 0xd6, 0x04, 0x1a, 0xd3, 0x2e,
-	0xe7, 0xae, 0xcf, 0x92, 0x12, 0x26, 0x0d, 0x02, 0x2e, 0xa9, 0xf4, 0x78, 0x20, 0xd2, 0x30, 0x63,
-	0xa9, 0x73, 0x55, 0x10, 0x8f, 0x27, 0xbb, 0x5d, 0xea, 0xb4, 0xbd, 0x80, 0x45, 0x7b, 0x66, 0x86,
-	0x48, 0x98, 0x5d, 0x26, 0xa9, 0x19, 0x5b, 0xa6, 0xcb, 0x02, 0x16, 0x51, 0xc9, 0x76, 0xb2, 0xa8,
-	0xdb, 0xae, 0x27, 0xdb, 0xbd, 0x6d, 0xe2, 0xf0, 0xae, 0x49, 0x23, 0x97, 0x87, 0x11, 0x7f, 0xa6,
-	0x3e, 0x0a, 0x78, 0xa2, 0x4c, 0x92, 0x2f, 0x99, 0xb1, 0x45, 0xfd, 0xb0, 0x4d, 0xff, 0x4a, 0x87,
-	0x7f, 0x03, 0x38, 0xbb, 0x9a, 0xc2, 0x7f, 0x98, 0x1d, 0xbe, 0x97, 0xc1, 0x5f, 0x8d, 0x18, 0x95,
-	0xcc, 0x66, 0xcf, 0x7b, 0x4c, 0x48, 0xd4, 0x83, 0x27, 0x72, 0x5e, 0x53, 0xe0, 0x2c, 0xb8, 0x30,
-	0xb1, 0xb0, 0x45, 0x4a, 0x28, 0x24, 0x87, 0xa2, 0x3e, 0x9e, 0x14, 0x50, 0x48, 0xbc, 0x48, 0xc2,
-	0x8e, 0x4b, 0x12, 0x34, 0x24, 0x5f, 0x25, 0x39, 0x1a, 0xa2, 0xa9, 0x6c, 0x17, 0xa5, 0xd0, 0x16,
-	0x9c, 0x74, 0x14, 0x8e, 0x3b, 0xa1, 0xea, 0xdd, 0x54, 0x43, 0xd5, 0x5e, 0x24, 0x69, 0xf3, 0x48,
-	0xb5, 0x79, 0x65, 0xa5, 0xa4, 0x79, 0x24, 0xb6, 0xc8, 0x6a, 0x35, 0xd4, 0x1e, 0xcc, 0x84, 0x5f,
-	0x03, 0x78, 0x4e, 0x03, 0x60, 0x9d, 0xc9, 0x9c, 0x37, 0x82, 0xc7, 0x02, 0xda, 0x4d, 0x39, 0x9f,
-	0xb4, 0xd5, 0x37, 0xba, 0x0b, 0xa1, 0xcb, 0xe4, 0x20, 0xa2, 0x2b, 0xf5, 0x10, 0xad, 0x17, 0x71,
-	0x76, 0x25, 0x07, 0xde, 0x83, 0x58, 0x03, 0x65, 0xc3, 0x13, 0x05, 0x96, 0x4d, 0x38, 0xe1, 0x7b,
-	0xa2, 0x28, 0x9c, 0x8e, 0xc1, 0xaa, 0x57, 0x78, 0xa3, 0x0c, 0xb4, 0xab, 0x59, 0xf0, 0x7b, 0xbd,
-	0x02, 0xee, 0x87, 0x3b, 0x15, 0x05, 0x9c, 0xaa, 0x76, 0x62, 0xa5, 0x31, 0x05, 0xb2, 0x6e, 0x54,
-	0x95, 0xd1, 0x38, 0x32, 0x65, 0xe0, 0x77, 0x7a, 0xdc, 0x6b, 0xcc, 0x67, 0x25, 0xee, 0x7f, 0x4d,
-	0x70, 0x0b, 0x4e, 0xee, 0xa8, 0x43, 0x23, 0xc9, 0x6a, 0xad, 0x1a, 0x6a, 0x0f, 0x66, 0xc2, 0x73,
-	0xf0, 0xfc, 0x01, 0xb0, 0x44, 0xc8, 0x03, 0xc1, 0xf0, 0x2f, 0xb0, 0xcf, 0xd0, 0x03, 0xf9, 0xdf,
-	0x5e, 0xbc, 0x85, 0x37, 0x13, 0xb0, 0xa9, 0x01, 0xb0, 0xc9, 0xa2, 0xd8, 0x73, 0x18, 0xfa, 0x0e,
-	0xe0, 0x4c, 0x9a, 0x43, 0x73, 0x10, 0x5d, 0x27, 0x1a, 0xd7, 0x25, 0x75, 0xec, 0xcc, 0x18, 0x5f,
-	0x0f, 0xf1, 0xfc, 0xab, 0xcf, 0x5f, 0xdf, 0x36, 0xe6, 0x30, 0x56, 0xbe, 0x1f, 0x5b, 0xfa, 0xff,
-	0x87, 0x58, 0x06, 0x2d, 0xf4, 0x0d, 0x40, 0x63, 0x9d, 0x49, 0x1d, 0xcf, 0xe5, 0x61, 0x79, 0x96,
-	0xde, 0x35, 0x4e, 0x92, 0x96, 0x22, 0x79, 0x09, 0x5d, 0x3c, 0x98, 0xa4, 0xf9, 0x22, 0xb9, 0x72,
-	0x2f, 0x13, 0xa2, 0xd3, 0x89, 0x0b, 0x69, 0x52, 0x0a, 0x74, 0x6d, 0x58, 0xaa, 0x15, 0x6f, 0x34,
-	0x1e, 0x8f, 0x8d, 0x6b, 0x52, 0x05, 0xb7, 0x14, 0xdf, 0x59, 0x54, 0x63, 0xa8, 0xe8, 0x27, 0x80,
-	0x33, 0xa9, 0x75, 0x1e, 0x9a, 0x78, 0x07, 0x9c, 0x78, 0x9c, 0x73, 0x5d, 0x52, 0x3c, 0x89, 0x51,
-	0x7f, 0xae, 0x89, 0x86, 0x3f, 0x01, 0x38, 0x93, 0xba, 0xdb, 0xa1, 0x31, 0x1e, 0xf0, 0x70, 0xe3,
-	0xc6, 0xa8, 0xe1, 0x99, 0xd7, 0x66, 0x72, 0x6d, 0x0d, 0x21, 0xd7, 0x1f, 0x00, 0x9e, 0x49, 0x7c,
-	0x58, 0xc7, 0x68, 0x04, 0xb5, 0x06, 0x47, 0x71, 0x33, 0x17, 0x14, 0xd5, 0xcb, 0x78, 0xae, 0x06,
-	0x55, 0xdf, 0x0b, 0xe4, 0x32, 0x68, 0xad, 0x3c, 0xf8, 0xd0, 0x6f, 0x82, 0x8f, 0xfd, 0x26, 0xf8,
-	0xd2, 0x6f, 0x82, 0x47, 0xb7, 0xea, 0x3f, 0x31, 0xf7, 0x7f, 0x39, 0x6f, 0x1f, 0x57, 0x8f, 0xcc,
-	0xc5, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe8, 0x0f, 0x8e, 0xa4, 0x69, 0x0b, 0x00, 0x00,
+	// 720 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcf, 0x6b, 0x14, 0x31,
+	0x14, 0x26, 0x8b, 0x88, 0xa6, 0xf4, 0x92, 0x83, 0x96, 0xb1, 0x5d, 0x34, 0x54, 0x56, 0xab, 0xcd,
+	0xb8, 0xdd, 0x0a, 0xd2, 0x52, 0x91, 0xb6, 0x52, 0x0f, 0x15, 0x65, 0xea, 0x0f, 0x2a, 0xa8, 0xa4,
+	0xd3, 0x38, 0x1d, 0x77, 0x76, 0x32, 0x4e, 0xb2, 0x53, 0x8a, 0x78, 0x11, 0x3c, 0x78, 0x16, 0xf1,
+	0x2f, 0xf1, 0x7f, 0xf0, 0xa8, 0xf8, 0x0f, 0x48, 0x11, 0xd1, 0x93, 0x37, 0xf1, 0x28, 0x93, 0xf9,
+	0xb9, 0x6a, 0xda, 0xd9, 0xda, 0xed, 0xc1, 0xdb, 0x90, 0xe4, 0xbd, 0xf7, 0x7d, 0xef, 0x7b, 0xf9,
+	0xb2, 0x0b, 0xaf, 0x06, 0x6d, 0xc7, 0xa4, 0x81, 0x6b, 0x7b, 0x2e, 0xf3, 0xa5, 0x69, 0x7b, 0x5d,
+	0x21, 0x59, 0xb8, 0xc9, 0xc3, 0xf6, 0x23, 0x8f, 0x6f, 0x4a, 0xd6, 0x09, 0x3c, 0x2a, 0x59, 0xb6,
+	0x3e, 0x99, 0x6d, 0x4c, 0x66, 0x3b, 0x24, 0x08, 0xb9, 0xe4, 0xe8, 0xb8, 0x26, 0xd0, 0x18, 0x75,
+	0x38, 0x77, 0x3c, 0x16, 0x97, 0x30, 0xa9, 0xef, 0x73, 0x49, 0xa5, 0xcb, 0x7d, 0x91, 0x84, 0x19,
+	0xd3, 0xed, 0x4b, 0x82, 0xb8, 0x3c, 0xde, 0xed, 0x50, 0x7b, 0xc3, 0xf5, 0x59, 0xb8, 0x65, 0xa6,
+	0x88, 0x84, 0xd9, 0x61, 0x92, 0x9a, 0x51, 0xd3, 0x74, 0x98, 0xcf, 0x42, 0x2a, 0xd9, 0x7a, 0x1a,
+	0x75, 0xdd, 0x71, 0xe5, 0x46, 0x77, 0x8d, 0xd8, 0xbc, 0x63, 0xd2, 0xd0, 0xe1, 0x41, 0xc8, 0x1f,
+	0xab, 0x8f, 0x1c, 0x9e, 0x28, 0x92, 0x64, 0x4b, 0x66, 0xd4, 0xa4, 0x5e, 0xb0, 0x41, 0xff, 0x48,
+	0x87, 0x7f, 0x02, 0x38, 0xbe, 0x90, 0xc0, 0xbf, 0x9b, 0x1e, 0xbe, 0x95, 0xc2, 0x5f, 0x08, 0x19,
+	0x95, 0xcc, 0x62, 0x4f, 0xba, 0x4c, 0x48, 0xd4, 0x85, 0x47, 0x32, 0x5e, 0x23, 0xe0, 0x24, 0x38,
+	0x33, 0x34, 0xb5, 0x4a, 0x0a, 0x28, 0x24, 0x83, 0xa2, 0x3e, 0x1e, 0xe6, 0x50, 0x48, 0xd4, 0x22,
+	0x41, 0xdb, 0x21, 0x31, 0x1a, 0x92, 0xad, 0x92, 0x0c, 0x0d, 0xd1, 0x54, 0xb6, 0xf2, 0x52, 0x68,
+	0x15, 0x0e, 0xdb, 0x0a, 0xc7, 0x8d, 0x40, 0xf5, 0x6e, 0xa4, 0xa6, 0x6a, 0xb7, 0x48, 0xd2, 0x3c,
+	0x52, 0x6e, 0x5e, 0x51, 0x29, 0x6e, 0x1e, 0x89, 0x9a, 0x64, 0xa1, 0x1c, 0x6a, 0xf5, 0x66, 0xc2,
+	0x2f, 0x01, 0x3c, 0xa5, 0x01, 0xb0, 0xc4, 0x64, 0xc6, 0x1b, 0xc1, 0x43, 0x3e, 0xed, 0x24, 0x9c,
+	0x8f, 0x5a, 0xea, 0x1b, 0xdd, 0x84, 0xd0, 0x61, 0xb2, 0x17, 0xd1, 0x85, 0x6a, 0x88, 0x96, 0xf2,
+	0x38, 0xab, 0x94, 0x03, 0x6f, 0x41, 0xac, 0x81, 0xb2, 0xec, 0x8a, 0x1c, 0xcb, 0x0a, 0x1c, 0xf2,
+	0x5c, 0x91, 0x17, 0x4e, 0x64, 0x68, 0x56, 0x2b, 0xbc, 0x5c, 0x04, 0x5a, 0xe5, 0x2c, 0xf8, 0xad,
+	0x7e, 0x02, 0x6e, 0x07, 0xeb, 0xa5, 0x09, 0x38, 0x56, 0xee, 0xc4, 0x7c, 0x6d, 0x04, 0xa4, 0xdd,
+	0x28, 0x4f, 0x46, 0xed, 0xc0, 0x26, 0x03, 0xbf, 0xd6, 0xe3, 0x5e, 0x64, 0x1e, 0x2b, 0x70, 0xff,
+	0x4d, 0xc1, 0x55, 0x38, 0xbc, 0xae, 0x0e, 0xed, 0x69, 0xac, 0x16, 0xcb, 0xa1, 0x56, 0x6f, 0x26,
+	0xdc, 0x80, 0xa7, 0x77, 0x81, 0x25, 0x02, 0xee, 0x0b, 0x86, 0x7f, 0x80, 0x1d, 0x44, 0xf7, 0xe5,
+	0xff, 0x7b, 0xf1, 0xe6, 0x60, 0x43, 0x57, 0x9f, 0x45, 0xae, 0x50, 0x21, 0x7a, 0xed, 0xa6, 0xde,
+	0x0c, 0xc3, 0xba, 0x26, 0x7e, 0x85, 0x85, 0x91, 0x6b, 0x33, 0xf4, 0x15, 0xc0, 0xb1, 0x04, 0x82,
+	0xe6, 0x20, 0x9a, 0x23, 0x1a, 0xd3, 0x26, 0x55, 0xdc, 0xd0, 0x18, 0x9c, 0x04, 0x78, 0xf2, 0xf9,
+	0xc7, 0xcf, 0xaf, 0x6a, 0x0d, 0x8c, 0xd5, 0xb3, 0x11, 0x35, 0xf5, 0xcf, 0x8f, 0x98, 0x01, 0x13,
+	0xe8, 0x0b, 0x80, 0xc6, 0x12, 0x93, 0x3a, 0x9e, 0x33, 0xfd, 0xf2, 0x2c, 0xac, 0x6f, 0x90, 0x24,
+	0x9b, 0x8a, 0xe4, 0x39, 0x74, 0x76, 0x77, 0x92, 0xe6, 0xd3, 0x58, 0xf5, 0x67, 0x31, 0xd1, 0xd1,
+	0xd8, 0xc4, 0x34, 0x29, 0x05, 0x9a, 0xed, 0x97, 0x6a, 0xc9, 0x5a, 0x8d, 0xfb, 0x03, 0xe3, 0x1a,
+	0x57, 0xc1, 0x13, 0x8a, 0xef, 0x38, 0xaa, 0x20, 0x2a, 0xfa, 0x0e, 0xe0, 0x58, 0xe2, 0xbc, 0xfb,
+	0x36, 0xbc, 0x3d, 0x46, 0x3e, 0x48, 0x5d, 0xa7, 0x15, 0x4f, 0x62, 0x54, 0xd7, 0x35, 0x9e, 0xe1,
+	0x0f, 0x00, 0x8e, 0x25, 0xe6, 0xb8, 0x6f, 0x8c, 0x7b, 0x9e, 0x00, 0xe3, 0xf2, 0x5e, 0xc3, 0x53,
+	0xab, 0x4e, 0xc7, 0x75, 0xa2, 0x8f, 0x71, 0xfd, 0x06, 0xe0, 0x89, 0xd8, 0xc6, 0x75, 0x8c, 0xf6,
+	0x30, 0xad, 0xfe, 0x41, 0xdc, 0xcc, 0x29, 0x45, 0xf5, 0x3c, 0x6e, 0x54, 0xa0, 0xea, 0xb9, 0xbe,
+	0x8c, 0xf5, 0x7b, 0x51, 0x83, 0xe3, 0x3b, 0x5c, 0xcd, 0xdc, 0xd5, 0xd1, 0x95, 0x7e, 0x49, 0xff,
+	0xfe, 0x20, 0x18, 0x0f, 0xfe, 0x9d, 0xb9, 0xae, 0x86, 0xba, 0xa8, 0xb3, 0x8a, 0xfe, 0x45, 0xd4,
+	0xaa, 0xac, 0xb4, 0x19, 0x66, 0x18, 0xe7, 0xef, 0xbc, 0xdb, 0xae, 0x83, 0xf7, 0xdb, 0x75, 0xf0,
+	0x69, 0xbb, 0x0e, 0xee, 0x5d, 0xab, 0xfe, 0x4b, 0x7d, 0xe7, 0x3f, 0x20, 0x6b, 0x87, 0xd5, 0x6f,
+	0xf5, 0xd6, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf2, 0x03, 0x81, 0x5d, 0xb0, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -474,6 +527,7 @@ type ClusterWorkflowTemplateServiceClient interface {
 	UpdateClusterWorkflowTemplate(ctx context.Context, in *ClusterWorkflowTemplateUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.ClusterWorkflowTemplate, error)
 	DeleteClusterWorkflowTemplate(ctx context.Context, in *ClusterWorkflowTemplateDeleteRequest, opts ...grpc.CallOption) (*ClusterWorkflowTemplateDeleteResponse, error)
 	LintClusterWorkflowTemplate(ctx context.Context, in *ClusterWorkflowTemplateLintRequest, opts ...grpc.CallOption) (*v1alpha1.ClusterWorkflowTemplate, error)
+	ListClusterWorkflowTemplateRevisions(ctx context.Context, in *ClusterWorkflowTemplateRevisionsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplateRevisionList, error)
 }
 
 type clusterWorkflowTemplateServiceClient struct {
@@ -538,6 +592,15 @@ func (c *clusterWorkflowTemplateServiceClient) LintClusterWorkflowTemplate(ctx c
 	return out, nil
 }
 
+func (c *clusterWorkflowTemplateServiceClient) ListClusterWorkflowTemplateRevisions(ctx context.Context, in *ClusterWorkflowTemplateRevisionsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplateRevisionList, error) {
+	out := new(v1alpha1.WorkflowTemplateRevisionList)
+	err := c.cc.Invoke(ctx, "/clusterworkflowtemplate.ClusterWorkflowTemplateService/ListClusterWorkflowTemplateRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClusterWorkflowTemplateServiceServer is the server API for ClusterWorkflowTemplateService service.
 type ClusterWorkflowTemplateServiceServer interface {
 	CreateClusterWorkflowTemplate(context.Context, *ClusterWorkflowTemplateCreateRequest) (*v1alpha1.ClusterWorkflowTemplate, error)
@@ -546,6 +609,7 @@ type ClusterWorkflowTemplateServiceServer interface {
 	UpdateClusterWorkflowTemplate(context.Context, *ClusterWorkflowTemplateUpdateRequest) (*v1alpha1.ClusterWorkflowTemplate, error)
 	DeleteClusterWorkflowTemplate(context.Context, *ClusterWorkflowTemplateDeleteRequest) (*ClusterWorkflowTemplateDeleteResponse, error)
 	LintClusterWorkflowTemplate(context.Context, *ClusterWorkflowTemplateLintRequest) (*v1alpha1.ClusterWorkflowTemplate, error)
+	ListClusterWorkflowTemplateRevisions(context.Context, *ClusterWorkflowTemplateRevisionsRequest) (*v1alpha1.WorkflowTemplateRevisionList, error)
 }
 
 // UnimplementedClusterWorkflowTemplateServiceServer can be embedded to have forward compatible implementations.
@@ -570,6 +634,9 @@ func (*UnimplementedClusterWorkflowTemplateServiceServer) DeleteClusterWorkflowT
 func (*UnimplementedClusterWorkflowTemplateServiceServer) LintClusterWorkflowTemplate(ctx context.Context, req *ClusterWorkflowTemplateLintRequest) (*v1alpha1.ClusterWorkflowTemplate, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LintClusterWorkflowTemplate not implemented")
 }
+func (*UnimplementedClusterWorkflowTemplateServiceServer) ListClusterWorkflowTemplateRevisions(ctx context.Context, req *ClusterWorkflowTemplateRevisionsRequest) (*v1alpha1.WorkflowTemplateRevisionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClusterWorkflowTemplateRevisions not implemented")
+}
 
 func RegisterClusterWorkflowTemplateServiceServer(s *grpc.Server, srv ClusterWorkflowTemplateServiceServer) {
 	s.RegisterService(&_ClusterWorkflowTemplateService_serviceDesc, srv)
@@ -683,6 +750,24 @@ func _ClusterWorkflowTemplateService_LintClusterWorkflowTemplate_Handler(srv int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterWorkflowTemplateService_ListClusterWorkflowTemplateRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterWorkflowTemplateRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterWorkflowTemplateServiceServer).ListClusterWorkflowTemplateRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clusterworkflowtemplate.ClusterWorkflowTemplateService/ListClusterWorkflowTemplateRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterWorkflowTemplateServiceServer).ListClusterWorkflowTemplateRevisions(ctx, req.(*ClusterWorkflowTemplateRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ClusterWorkflowTemplateService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "clusterworkflowtemplate.ClusterWorkflowTemplateService",
 	HandlerType: (*ClusterWorkflowTemplateServiceServer)(nil),
@@ -711,6 +796,10 @@ var _ClusterWorkflowTemplateService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LintClusterWorkflowTemplate",
 			Handler:    _ClusterWorkflowTemplateService_LintClusterWorkflowTemplate_Handler,
 		},
+		{
+			MethodName: "ListClusterWorkflowTemplateRevisions",
+			Handler:    _ClusterWorkflowTemplateService_ListClusterWorkflowTemplateRevisions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/apiclient/clusterworkflowtemplate/cluster-workflow-template.proto",
@@ -1022,6 +1111,40 @@ func (m *ClusterWorkflowTemplateLintRequest) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *ClusterWorkflowTemplateRevisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterWorkflowTemplateRevisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterWorkflowTemplateRevisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintClusterWorkflowTemplate(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintClusterWorkflowTemplate(dAtA []byte, offset int, v uint64) int {
 	offset -= sovClusterWorkflowTemplate(v)
 	base := offset
@@ -1161,6 +1284,22 @@ func (m *ClusterWorkflowTemplateLintRequest) Size() (n int) {
 	return n
 }
 
+func (m *ClusterWorkflowTemplateRevisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovClusterWorkflowTemplate(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovClusterWorkflowTemplate(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1908,6 +2047,89 @@ func (m *ClusterWorkflowTemplateLintRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClusterWorkflowTemplateRevisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterWorkflowTemplate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterWorkflowTemplateRevisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterWorkflowTemplateRevisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterWorkflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterWorkflowTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterWorkflowTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterWorkflowTemplate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClusterWorkflowTemplate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipClusterWorkflowTemplate(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
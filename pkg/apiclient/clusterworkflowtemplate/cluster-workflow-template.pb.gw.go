@@ -351,6 +351,60 @@ func local_request_ClusterWorkflowTemplateService_LintClusterWorkflowTemplate_0(
 
 }
 
+func request_ClusterWorkflowTemplateService_ListClusterWorkflowTemplateRevisions_0(ctx context.Context, marshaler runtime.Marshaler, client ClusterWorkflowTemplateServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq ClusterWorkflowTemplateRevisionsRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "name")
+	}
+
+	protoReq.Name, err = runtime.String(val)
+
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "name", err)
+	}
+
+	msg, err := client.ListClusterWorkflowTemplateRevisions(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_ClusterWorkflowTemplateService_ListClusterWorkflowTemplateRevisions_0(ctx context.Context, marshaler runtime.Marshaler, server ClusterWorkflowTemplateServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq ClusterWorkflowTemplateRevisionsRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "name")
+	}
+
+	protoReq.Name, err = runtime.String(val)
+
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "name", err)
+	}
+
+	msg, err := server.ListClusterWorkflowTemplateRevisions(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
 // RegisterClusterWorkflowTemplateServiceHandlerServer registers the http handlers for service ClusterWorkflowTemplateService to "mux".
 // UnaryRPC     :call ClusterWorkflowTemplateServiceServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -495,6 +549,29 @@ func RegisterClusterWorkflowTemplateServiceHandlerServer(ctx context.Context, mu
 
 	})
 
+	mux.Handle("GET", pattern_ClusterWorkflowTemplateService_ListClusterWorkflowTemplateRevisions_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateIncomingContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_ClusterWorkflowTemplateService_ListClusterWorkflowTemplateRevisions_0(rctx, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_ClusterWorkflowTemplateService_ListClusterWorkflowTemplateRevisions_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
 	return nil
 }
 
@@ -656,6 +733,26 @@ func RegisterClusterWorkflowTemplateServiceHandlerClient(ctx context.Context, mu
 
 	})
 
+	mux.Handle("GET", pattern_ClusterWorkflowTemplateService_ListClusterWorkflowTemplateRevisions_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_ClusterWorkflowTemplateService_ListClusterWorkflowTemplateRevisions_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_ClusterWorkflowTemplateService_ListClusterWorkflowTemplateRevisions_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
 	return nil
 }
 
@@ -671,6 +768,8 @@ var (
 	pattern_ClusterWorkflowTemplateService_DeleteClusterWorkflowTemplate_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"api", "v1", "cluster-workflow-templates", "name"}, "", runtime.AssumeColonVerbOpt(true)))
 
 	pattern_ClusterWorkflowTemplateService_LintClusterWorkflowTemplate_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "cluster-workflow-templates", "lint"}, "", runtime.AssumeColonVerbOpt(true)))
+
+	pattern_ClusterWorkflowTemplateService_ListClusterWorkflowTemplateRevisions_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"api", "v1", "cluster-workflow-templates", "name", "revisions"}, "", runtime.AssumeColonVerbOpt(true)))
 )
 
 var (
@@ -685,4 +784,6 @@ var (
 	forward_ClusterWorkflowTemplateService_DeleteClusterWorkflowTemplate_0 = runtime.ForwardResponseMessage
 
 	forward_ClusterWorkflowTemplateService_LintClusterWorkflowTemplate_0 = runtime.ForwardResponseMessage
+
+	forward_ClusterWorkflowTemplateService_ListClusterWorkflowTemplateRevisions_0 = runtime.ForwardResponseMessage
 )
@@ -45,3 +45,8 @@ func (a errorTranslatingWorkflowClusterTemplateServiceClient) LintClusterWorkflo
 	template, err := a.delegate.LintClusterWorkflowTemplate(ctx, req)
 	return template, grpcutil.TranslateError(err)
 }
+
+func (a errorTranslatingWorkflowClusterTemplateServiceClient) ListClusterWorkflowTemplateRevisions(ctx context.Context, req *clusterworkflowtmplpkg.ClusterWorkflowTemplateRevisionsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplateRevisionList, error) {
+	revisions, err := a.delegate.ListClusterWorkflowTemplateRevisions(ctx, req)
+	return revisions, grpcutil.TranslateError(err)
+}
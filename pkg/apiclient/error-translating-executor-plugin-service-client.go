@@ -0,0 +1,36 @@
+package apiclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	executorpluginpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/executorplugin"
+	grpcutil "github.com/argoproj/argo-workflows/v3/util/grpc"
+)
+
+type errorTranslatingExecutorPluginServiceClient struct {
+	delegate executorpluginpkg.ExecutorPluginServiceClient
+}
+
+var _ executorpluginpkg.ExecutorPluginServiceClient = &errorTranslatingExecutorPluginServiceClient{}
+
+func (c *errorTranslatingExecutorPluginServiceClient) ListExecutorPlugins(ctx context.Context, req *executorpluginpkg.ListExecutorPluginsRequest, _ ...grpc.CallOption) (*executorpluginpkg.ExecutorPluginList, error) {
+	plugins, err := c.delegate.ListExecutorPlugins(ctx, req)
+	return plugins, grpcutil.TranslateError(err)
+}
+
+func (c *errorTranslatingExecutorPluginServiceClient) GetExecutorPlugin(ctx context.Context, req *executorpluginpkg.GetExecutorPluginRequest, _ ...grpc.CallOption) (*executorpluginpkg.ExecutorPlugin, error) {
+	plugin, err := c.delegate.GetExecutorPlugin(ctx, req)
+	return plugin, grpcutil.TranslateError(err)
+}
+
+func (c *errorTranslatingExecutorPluginServiceClient) EnableExecutorPlugin(ctx context.Context, req *executorpluginpkg.EnableExecutorPluginRequest, _ ...grpc.CallOption) (*executorpluginpkg.ExecutorPlugin, error) {
+	plugin, err := c.delegate.EnableExecutorPlugin(ctx, req)
+	return plugin, grpcutil.TranslateError(err)
+}
+
+func (c *errorTranslatingExecutorPluginServiceClient) DisableExecutorPlugin(ctx context.Context, req *executorpluginpkg.DisableExecutorPluginRequest, _ ...grpc.CallOption) (*executorpluginpkg.ExecutorPlugin, error) {
+	plugin, err := c.delegate.DisableExecutorPlugin(ctx, req)
+	return plugin, grpcutil.TranslateError(err)
+}
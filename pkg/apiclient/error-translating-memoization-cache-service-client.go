@@ -0,0 +1,26 @@
+package apiclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
+	grpcutil "github.com/argoproj/argo-workflows/v3/util/grpc"
+)
+
+type errorTranslatingMemoizationCacheServiceClient struct {
+	delegate memoizationcachepkg.MemoizationCacheServiceClient
+}
+
+var _ memoizationcachepkg.MemoizationCacheServiceClient = &errorTranslatingMemoizationCacheServiceClient{}
+
+func (c *errorTranslatingMemoizationCacheServiceClient) ListCacheEntries(ctx context.Context, req *memoizationcachepkg.ListCacheEntriesRequest, _ ...grpc.CallOption) (*memoizationcachepkg.CacheEntryList, error) {
+	entries, err := c.delegate.ListCacheEntries(ctx, req)
+	return entries, grpcutil.TranslateError(err)
+}
+
+func (c *errorTranslatingMemoizationCacheServiceClient) DeleteCacheEntry(ctx context.Context, req *memoizationcachepkg.DeleteCacheEntryRequest, _ ...grpc.CallOption) (*memoizationcachepkg.CacheEntryDeletedResponse, error) {
+	resp, err := c.delegate.DeleteCacheEntry(ctx, req)
+	return resp, grpcutil.TranslateError(err)
+}
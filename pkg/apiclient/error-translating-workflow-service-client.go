@@ -100,3 +100,8 @@ func (c *errorTranslatingWorkflowServiceClient) SubmitWorkflow(ctx context.Conte
 	workflow, err := c.delegate.SubmitWorkflow(ctx, req)
 	return workflow, grpcutil.TranslateError(err)
 }
+
+func (c *errorTranslatingWorkflowServiceClient) DiffWorkflows(ctx context.Context, req *workflowpkg.WorkflowDiffRequest, opts ...grpc.CallOption) (*workflowpkg.WorkflowDiffResponse, error) {
+	diff, err := c.delegate.DiffWorkflows(ctx, req)
+	return diff, grpcutil.TranslateError(err)
+}
@@ -194,47 +194,344 @@ func (m *ListWorkflowEventBindingsRequest) GetListOptions() *v1.ListOptions {
 	return nil
 }
 
+// ArchivedEvent is an event that was received, stored so that it can be replayed
+type ArchivedEvent struct {
+	Uid           string         `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Namespace     string         `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Discriminator string         `protobuf:"bytes,3,opt,name=discriminator,proto3" json:"discriminator,omitempty"`
+	Payload       *v1alpha1.Item `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	// The `x-` headers the event was received with, multiple values are comma separated.
+	Metadata             map[string]string `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ReceivedAt           *v1.Time          `protobuf:"bytes,6,opt,name=receivedAt,proto3" json:"receivedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ArchivedEvent) Reset()         { *m = ArchivedEvent{} }
+func (m *ArchivedEvent) String() string { return proto.CompactTextString(m) }
+func (*ArchivedEvent) ProtoMessage()    {}
+func (*ArchivedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d80a0d2509a47d1c, []int{3}
+}
+func (m *ArchivedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedEvent.Merge(m, src)
+}
+func (m *ArchivedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedEvent proto.InternalMessageInfo
+
+func (m *ArchivedEvent) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ArchivedEvent) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ArchivedEvent) GetDiscriminator() string {
+	if m != nil {
+		return m.Discriminator
+	}
+	return ""
+}
+
+func (m *ArchivedEvent) GetPayload() *v1alpha1.Item {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *ArchivedEvent) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ArchivedEvent) GetReceivedAt() *v1.Time {
+	if m != nil {
+		return m.ReceivedAt
+	}
+	return nil
+}
+
+type ArchivedEventList struct {
+	Items                []*ArchivedEvent `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ArchivedEventList) Reset()         { *m = ArchivedEventList{} }
+func (m *ArchivedEventList) String() string { return proto.CompactTextString(m) }
+func (*ArchivedEventList) ProtoMessage()    {}
+func (*ArchivedEventList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d80a0d2509a47d1c, []int{4}
+}
+func (m *ArchivedEventList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedEventList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedEventList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedEventList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedEventList.Merge(m, src)
+}
+func (m *ArchivedEventList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedEventList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedEventList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedEventList proto.InternalMessageInfo
+
+func (m *ArchivedEventList) GetItems() []*ArchivedEvent {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ListArchivedEventsRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Only list events with this discriminator.
+	Discriminator string `protobuf:"bytes,2,opt,name=discriminator,proto3" json:"discriminator,omitempty"`
+	// The maximum number of events to return, most recently received first. Defaults to 100.
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// The number of events to skip.
+	Offset               int32    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListArchivedEventsRequest) Reset()         { *m = ListArchivedEventsRequest{} }
+func (m *ListArchivedEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListArchivedEventsRequest) ProtoMessage()    {}
+func (*ListArchivedEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d80a0d2509a47d1c, []int{5}
+}
+func (m *ListArchivedEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListArchivedEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListArchivedEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListArchivedEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListArchivedEventsRequest.Merge(m, src)
+}
+func (m *ListArchivedEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListArchivedEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListArchivedEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListArchivedEventsRequest proto.InternalMessageInfo
+
+func (m *ListArchivedEventsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ListArchivedEventsRequest) GetDiscriminator() string {
+	if m != nil {
+		return m.Discriminator
+	}
+	return ""
+}
+
+func (m *ListArchivedEventsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListArchivedEventsRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ReplayArchivedEventRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Uid       string `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Only dispatch the event to this binding, rather than to every binding in the namespace.
+	// The binding's current spec is used, so this can be used to re-process events after fixing a binding.
+	BindingName          string   `protobuf:"bytes,3,opt,name=bindingName,proto3" json:"bindingName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplayArchivedEventRequest) Reset()         { *m = ReplayArchivedEventRequest{} }
+func (m *ReplayArchivedEventRequest) String() string { return proto.CompactTextString(m) }
+func (*ReplayArchivedEventRequest) ProtoMessage()    {}
+func (*ReplayArchivedEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d80a0d2509a47d1c, []int{6}
+}
+func (m *ReplayArchivedEventRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplayArchivedEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplayArchivedEventRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplayArchivedEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayArchivedEventRequest.Merge(m, src)
+}
+func (m *ReplayArchivedEventRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplayArchivedEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayArchivedEventRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayArchivedEventRequest proto.InternalMessageInfo
+
+func (m *ReplayArchivedEventRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ReplayArchivedEventRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ReplayArchivedEventRequest) GetBindingName() string {
+	if m != nil {
+		return m.BindingName
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventRequest)(nil), "event.EventRequest")
 	proto.RegisterType((*EventResponse)(nil), "event.EventResponse")
 	proto.RegisterType((*ListWorkflowEventBindingsRequest)(nil), "event.ListWorkflowEventBindingsRequest")
+	proto.RegisterType((*ArchivedEvent)(nil), "event.ArchivedEvent")
+	proto.RegisterMapType((map[string]string)(nil), "event.ArchivedEvent.MetadataEntry")
+	proto.RegisterType((*ArchivedEventList)(nil), "event.ArchivedEventList")
+	proto.RegisterType((*ListArchivedEventsRequest)(nil), "event.ListArchivedEventsRequest")
+	proto.RegisterType((*ReplayArchivedEventRequest)(nil), "event.ReplayArchivedEventRequest")
 }
 
 func init() { proto.RegisterFile("pkg/apiclient/event/event.proto", fileDescriptor_d80a0d2509a47d1c) }
 
 var fileDescriptor_d80a0d2509a47d1c = []byte{
-	// 481 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x41, 0x6b, 0x14, 0x31,
-	0x18, 0x25, 0x15, 0x95, 0x66, 0x5b, 0x84, 0xe8, 0x61, 0x5d, 0xca, 0xba, 0x0c, 0x82, 0x45, 0xd9,
-	0x84, 0xd9, 0x55, 0x11, 0xbd, 0x15, 0x15, 0x84, 0x8a, 0x30, 0x3d, 0x08, 0xbd, 0x68, 0x76, 0xf6,
-	0x73, 0x36, 0xee, 0x4c, 0x12, 0x93, 0x34, 0xa5, 0x94, 0x5e, 0xfc, 0x0b, 0xe2, 0x3f, 0xf1, 0x47,
-	0x78, 0x14, 0xc4, 0xab, 0xc8, 0xe2, 0x0f, 0x91, 0xc9, 0xce, 0xec, 0xcc, 0xa2, 0x62, 0xc1, 0xcb,
-	0x90, 0x79, 0xc9, 0xf7, 0xf2, 0xde, 0xf7, 0xf2, 0xe1, 0x1b, 0x7a, 0x9e, 0x31, 0xae, 0x45, 0x9a,
-	0x0b, 0x90, 0x8e, 0x81, 0x5f, 0x7d, 0xa9, 0x36, 0xca, 0x29, 0x72, 0x31, 0xfc, 0xf4, 0x76, 0x32,
-	0xa5, 0xb2, 0x1c, 0xca, 0xa3, 0x8c, 0x4b, 0xa9, 0x1c, 0x77, 0x42, 0x49, 0xbb, 0x3c, 0xd4, 0xbb,
-	0x3b, 0x7f, 0x60, 0xa9, 0x50, 0xe5, 0x6e, 0xc1, 0xd3, 0x99, 0x90, 0x60, 0x4e, 0x58, 0xc5, 0x6c,
-	0x59, 0x01, 0x8e, 0x33, 0x1f, 0xb3, 0x0c, 0x24, 0x18, 0xee, 0x60, 0x5a, 0x55, 0x3d, 0xcf, 0x84,
-	0x9b, 0x1d, 0x4d, 0x68, 0xaa, 0x0a, 0xc6, 0x4d, 0xa6, 0xb4, 0x51, 0x6f, 0xc3, 0x62, 0x78, 0xac,
-	0xcc, 0xfc, 0x4d, 0xae, 0x8e, 0x6d, 0x43, 0x52, 0x43, 0xcc, 0xc7, 0x3c, 0xd7, 0x33, 0xfe, 0x1b,
-	0x5d, 0xf4, 0x09, 0xe1, 0xad, 0x27, 0xa5, 0xd8, 0x04, 0xde, 0x1d, 0x81, 0x75, 0x64, 0x07, 0x6f,
-	0x4a, 0x5e, 0x80, 0xd5, 0x3c, 0x85, 0x2e, 0x1a, 0xa0, 0xdd, 0xcd, 0xa4, 0x01, 0xc8, 0x4d, 0xbc,
-	0x3d, 0x15, 0x36, 0x35, 0xa2, 0x10, 0x92, 0x3b, 0x65, 0xba, 0x1b, 0xe1, 0xc4, 0x3a, 0x48, 0x5e,
-	0xe3, 0xcb, 0x9a, 0x9f, 0xe4, 0x8a, 0x4f, 0xbb, 0x17, 0x06, 0x68, 0xb7, 0x33, 0x7a, 0x4a, 0x1b,
-	0xd5, 0xb4, 0x56, 0x1d, 0x16, 0xaf, 0x56, 0xaa, 0xa9, 0x1f, 0x53, 0x3d, 0xcf, 0x68, 0x29, 0x9c,
-	0xd6, 0x28, 0xad, 0x85, 0xd3, 0x67, 0x0e, 0x8a, 0xa4, 0xa6, 0x8d, 0xae, 0xe0, 0xed, 0x4a, 0xb5,
-	0xd5, 0x4a, 0x5a, 0x88, 0x3e, 0x22, 0x3c, 0xd8, 0x17, 0xd6, 0xbd, 0xac, 0x0a, 0xc3, 0xee, 0x9e,
-	0x90, 0x53, 0x21, 0x33, 0x7b, 0x3e, 0x6f, 0x07, 0xb8, 0x93, 0x0b, 0xeb, 0x5e, 0xe8, 0x10, 0x52,
-	0x70, 0xd6, 0x19, 0xc5, 0x74, 0x99, 0x12, 0x6d, 0xa7, 0xd4, 0xe8, 0x2c, 0x53, 0xa2, 0x3e, 0xa6,
-	0xfb, 0x4d, 0x61, 0xd2, 0x66, 0x19, 0x7d, 0xdf, 0xa8, 0xfa, 0x7b, 0x00, 0xc6, 0x8b, 0x14, 0x88,
-	0xc7, 0x5b, 0x09, 0xa4, 0x20, 0x3c, 0x04, 0x98, 0x5c, 0xa5, 0xcb, 0x87, 0xd3, 0x0e, 0xa1, 0x77,
-	0x6d, 0x1d, 0xac, 0x3c, 0x3e, 0x7a, 0xff, 0xf5, 0xe7, 0x87, 0x8d, 0x7b, 0xd1, 0xed, 0xf0, 0xa0,
-	0x7c, 0xbc, 0x7c, 0x72, 0x96, 0x9d, 0xae, 0x3c, 0x9c, 0xb1, 0xd3, 0xb5, 0x24, 0xce, 0x1e, 0xd6,
-	0x1d, 0x23, 0xdf, 0x10, 0xbe, 0xfe, 0xd7, 0x06, 0x91, 0x5b, 0xd5, 0x85, 0xff, 0x6a, 0x61, 0xef,
-	0xf0, 0xff, 0x93, 0xfc, 0x13, 0x7f, 0x79, 0x6f, 0x34, 0x0e, 0xfe, 0x86, 0xe4, 0x4e, 0xed, 0xaf,
-	0xae, 0x1d, 0x06, 0x71, 0xc3, 0x49, 0xa5, 0xa5, 0x6d, 0x78, 0xef, 0xf1, 0xe7, 0x45, 0x1f, 0x7d,
-	0x59, 0xf4, 0xd1, 0x8f, 0x45, 0x1f, 0x1d, 0xde, 0x3f, 0xff, 0x74, 0xb4, 0x87, 0x77, 0x72, 0x29,
-	0x4c, 0xc3, 0xf8, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xec, 0x7f, 0xc9, 0x38, 0xda, 0x03, 0x00,
-	0x00,
+	// 757 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x5f, 0x6b, 0x13, 0x4b,
+	0x14, 0x67, 0x93, 0xa6, 0xf7, 0x76, 0xd2, 0x70, 0xaf, 0xd3, 0x20, 0x31, 0x94, 0x1a, 0x57, 0xc5,
+	0x92, 0x92, 0x59, 0x93, 0xaa, 0x94, 0x16, 0x94, 0x16, 0x2b, 0x28, 0xad, 0xc2, 0x56, 0x10, 0xfa,
+	0xa2, 0xd3, 0xdd, 0xe9, 0x66, 0xcc, 0xee, 0xce, 0xba, 0x33, 0xd9, 0x12, 0x4a, 0x5e, 0x7c, 0x15,
+	0x41, 0x10, 0xbf, 0x89, 0x1f, 0xc2, 0x47, 0x41, 0x7c, 0x97, 0xe2, 0x9b, 0x5f, 0x42, 0x76, 0x76,
+	0x36, 0xd9, 0xa5, 0x91, 0x04, 0xd4, 0x97, 0x65, 0xe7, 0xcc, 0xf9, 0xf3, 0x3b, 0xbf, 0xf3, 0x9b,
+	0x19, 0x70, 0x39, 0xe8, 0x39, 0x06, 0x0e, 0xa8, 0xe5, 0x52, 0xe2, 0x0b, 0x83, 0x44, 0xa3, 0x2f,
+	0x0a, 0x42, 0x26, 0x18, 0x2c, 0xc9, 0x45, 0x7d, 0xd9, 0x61, 0xcc, 0x71, 0x49, 0xec, 0x6a, 0x60,
+	0xdf, 0x67, 0x02, 0x0b, 0xca, 0x7c, 0x9e, 0x38, 0xd5, 0x6f, 0xf5, 0x36, 0x38, 0xa2, 0x2c, 0xde,
+	0xf5, 0xb0, 0xd5, 0xa5, 0x3e, 0x09, 0x07, 0x86, 0xca, 0xcc, 0x0d, 0x8f, 0x08, 0x6c, 0x44, 0x6d,
+	0xc3, 0x21, 0x3e, 0x09, 0xb1, 0x20, 0xb6, 0x8a, 0xda, 0x77, 0xa8, 0xe8, 0xf6, 0x8f, 0x90, 0xc5,
+	0x3c, 0x03, 0x87, 0x0e, 0x0b, 0x42, 0xf6, 0x52, 0xfe, 0xb4, 0x4e, 0x58, 0xd8, 0x3b, 0x76, 0xd9,
+	0x09, 0x1f, 0x27, 0x49, 0x4d, 0x46, 0xd4, 0xc6, 0x6e, 0xd0, 0xc5, 0xe7, 0xd2, 0xe9, 0x1f, 0x35,
+	0xb0, 0xb8, 0x1b, 0x83, 0x35, 0xc9, 0xab, 0x3e, 0xe1, 0x02, 0x2e, 0x83, 0x05, 0x1f, 0x7b, 0x84,
+	0x07, 0xd8, 0x22, 0x35, 0xad, 0xa1, 0xad, 0x2e, 0x98, 0x63, 0x03, 0xbc, 0x06, 0x2a, 0x36, 0xe5,
+	0x56, 0x48, 0x3d, 0xea, 0x63, 0xc1, 0xc2, 0x5a, 0x41, 0x7a, 0xe4, 0x8d, 0xf0, 0x05, 0xf8, 0x27,
+	0xc0, 0x03, 0x97, 0x61, 0xbb, 0x56, 0x6c, 0x68, 0xab, 0xe5, 0xce, 0x03, 0x34, 0x46, 0x8d, 0x52,
+	0xd4, 0xf2, 0xe7, 0xf9, 0x08, 0x35, 0x8a, 0xd6, 0x51, 0xd0, 0x73, 0x50, 0x0c, 0x1c, 0xa5, 0x56,
+	0x94, 0x02, 0x47, 0x0f, 0x05, 0xf1, 0xcc, 0x34, 0xad, 0xfe, 0x1f, 0xa8, 0x28, 0xd4, 0x3c, 0x60,
+	0x3e, 0x27, 0xfa, 0x07, 0x0d, 0x34, 0xf6, 0x28, 0x17, 0xcf, 0x54, 0xa0, 0xdc, 0xdd, 0xa1, 0xbe,
+	0x4d, 0x7d, 0x87, 0xcf, 0xd6, 0xdb, 0x01, 0x28, 0xbb, 0x94, 0x8b, 0x27, 0x81, 0x1c, 0x92, 0xec,
+	0xac, 0xdc, 0x69, 0xa3, 0x64, 0x4a, 0x28, 0x3b, 0xa5, 0x31, 0xce, 0x78, 0x4a, 0x28, 0x6a, 0xa3,
+	0xbd, 0x71, 0xa0, 0x99, 0xcd, 0xa2, 0xbf, 0x2b, 0x82, 0xca, 0x76, 0x68, 0x75, 0x69, 0x44, 0x6c,
+	0x89, 0x09, 0xfe, 0x0f, 0x8a, 0x7d, 0x6a, 0xab, 0xf2, 0xf1, 0x6f, 0x1e, 0x56, 0x61, 0x2a, 0xe5,
+	0xc5, 0x29, 0x94, 0xcf, 0xfd, 0x15, 0xca, 0xe1, 0x5d, 0xf0, 0x6f, 0xdc, 0xad, 0x8d, 0x05, 0xae,
+	0x95, 0x1a, 0xc5, 0xd5, 0x72, 0x47, 0x47, 0x89, 0xe6, 0x73, 0xfd, 0xa1, 0x7d, 0xe5, 0xb4, 0xeb,
+	0x8b, 0x70, 0x60, 0x8e, 0x62, 0xe0, 0x23, 0x00, 0x42, 0x62, 0x91, 0xd8, 0x71, 0x5b, 0xd4, 0xe6,
+	0x25, 0xc8, 0xe6, 0x6c, 0xec, 0x3e, 0xa5, 0x1e, 0x31, 0x33, 0xd1, 0xf5, 0x2d, 0x50, 0xc9, 0x95,
+	0x89, 0x49, 0xed, 0x91, 0x41, 0x4a, 0x6a, 0x8f, 0x0c, 0x60, 0x15, 0x94, 0x22, 0xec, 0xf6, 0x53,
+	0x42, 0x93, 0xc5, 0x66, 0x61, 0x43, 0xd3, 0xef, 0x81, 0x0b, 0x39, 0xc4, 0xf1, 0xec, 0x60, 0x13,
+	0x94, 0xa8, 0x20, 0x1e, 0xaf, 0x69, 0xb2, 0xb5, 0xea, 0xa4, 0xd6, 0xcc, 0xc4, 0x45, 0x7f, 0xab,
+	0x81, 0x4b, 0x71, 0x50, 0x6e, 0x93, 0xff, 0xc9, 0x03, 0x54, 0x05, 0x25, 0x97, 0x7a, 0x54, 0xc8,
+	0x59, 0x97, 0xcc, 0x64, 0x01, 0x2f, 0x82, 0x79, 0x76, 0x7c, 0xcc, 0x89, 0x90, 0x23, 0x2e, 0x99,
+	0x6a, 0xa5, 0xfb, 0xa0, 0x6e, 0x92, 0xc0, 0xc5, 0x83, 0x3c, 0xda, 0x99, 0xf0, 0x28, 0x35, 0x16,
+	0xc6, 0x6a, 0x6c, 0x80, 0xf2, 0x51, 0x72, 0x6e, 0x1e, 0x63, 0x8f, 0x28, 0xb5, 0x65, 0x4d, 0x9d,
+	0x1f, 0x73, 0xea, 0xce, 0x38, 0x20, 0x61, 0x44, 0x2d, 0x02, 0x23, 0xb0, 0x68, 0x26, 0xc3, 0x49,
+	0x24, 0xbe, 0xa4, 0xd8, 0xcb, 0xe2, 0xa8, 0x57, 0xf3, 0x46, 0x75, 0x6e, 0xb7, 0x5e, 0x7f, 0xf9,
+	0xfe, 0xbe, 0x70, 0x5b, 0x6f, 0xca, 0x4b, 0x32, 0x6a, 0x27, 0xd7, 0x28, 0x37, 0x4e, 0x47, 0x10,
+	0x87, 0xc6, 0x69, 0x8e, 0x9c, 0xe1, 0xe6, 0x48, 0x92, 0x5f, 0xd5, 0x20, 0x26, 0x1e, 0x7a, 0x78,
+	0x43, 0x15, 0x9c, 0x76, 0x2d, 0xd4, 0x0f, 0x7f, 0xff, 0xa8, 0x4c, 0xca, 0x1f, 0xd7, 0xd5, 0xd7,
+	0x65, 0x7f, 0x2d, 0xb8, 0x96, 0xf6, 0x97, 0xc6, 0xb6, 0x24, 0xb8, 0x96, 0xe2, 0x35, 0xd7, 0x30,
+	0x1c, 0x02, 0x78, 0x5e, 0x5f, 0xb0, 0x91, 0xe9, 0x67, 0xa2, 0xf4, 0xea, 0xb5, 0x49, 0xaa, 0x95,
+	0x30, 0xd6, 0x24, 0x8c, 0xeb, 0xf0, 0x6a, 0x0a, 0x03, 0x2b, 0x97, 0xd6, 0x79, 0xbe, 0xe1, 0x1b,
+	0x0d, 0x2c, 0x4d, 0x10, 0x14, 0xbc, 0xa2, 0xd2, 0xff, 0x5a, 0x6c, 0x53, 0x86, 0x7c, 0x73, 0x86,
+	0xea, 0xc6, 0x69, 0x9f, 0xda, 0x43, 0x23, 0x94, 0x35, 0x36, 0xb5, 0xe6, 0xce, 0xfd, 0x4f, 0x67,
+	0x2b, 0xda, 0xe7, 0xb3, 0x15, 0xed, 0xdb, 0xd9, 0x8a, 0x76, 0x78, 0x67, 0xf6, 0xe7, 0x2f, 0xfb,
+	0x3a, 0x1f, 0xcd, 0xcb, 0xe7, 0x6e, 0xfd, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2a, 0xdc, 0x42,
+	0xc4, 0xbb, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -251,6 +548,8 @@ const _ = grpc.SupportPackageIsVersion4
 type EventServiceClient interface {
 	ReceiveEvent(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*EventResponse, error)
 	ListWorkflowEventBindings(ctx context.Context, in *ListWorkflowEventBindingsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowEventBindingList, error)
+	ListArchivedEvents(ctx context.Context, in *ListArchivedEventsRequest, opts ...grpc.CallOption) (*ArchivedEventList, error)
+	ReplayArchivedEvent(ctx context.Context, in *ReplayArchivedEventRequest, opts ...grpc.CallOption) (*EventResponse, error)
 }
 
 type eventServiceClient struct {
@@ -279,10 +578,30 @@ func (c *eventServiceClient) ListWorkflowEventBindings(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *eventServiceClient) ListArchivedEvents(ctx context.Context, in *ListArchivedEventsRequest, opts ...grpc.CallOption) (*ArchivedEventList, error) {
+	out := new(ArchivedEventList)
+	err := c.cc.Invoke(ctx, "/event.EventService/ListArchivedEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ReplayArchivedEvent(ctx context.Context, in *ReplayArchivedEventRequest, opts ...grpc.CallOption) (*EventResponse, error) {
+	out := new(EventResponse)
+	err := c.cc.Invoke(ctx, "/event.EventService/ReplayArchivedEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EventServiceServer is the server API for EventService service.
 type EventServiceServer interface {
 	ReceiveEvent(context.Context, *EventRequest) (*EventResponse, error)
 	ListWorkflowEventBindings(context.Context, *ListWorkflowEventBindingsRequest) (*v1alpha1.WorkflowEventBindingList, error)
+	ListArchivedEvents(context.Context, *ListArchivedEventsRequest) (*ArchivedEventList, error)
+	ReplayArchivedEvent(context.Context, *ReplayArchivedEventRequest) (*EventResponse, error)
 }
 
 // UnimplementedEventServiceServer can be embedded to have forward compatible implementations.
@@ -295,6 +614,12 @@ func (*UnimplementedEventServiceServer) ReceiveEvent(ctx context.Context, req *E
 func (*UnimplementedEventServiceServer) ListWorkflowEventBindings(ctx context.Context, req *ListWorkflowEventBindingsRequest) (*v1alpha1.WorkflowEventBindingList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflowEventBindings not implemented")
 }
+func (*UnimplementedEventServiceServer) ListArchivedEvents(ctx context.Context, req *ListArchivedEventsRequest) (*ArchivedEventList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArchivedEvents not implemented")
+}
+func (*UnimplementedEventServiceServer) ReplayArchivedEvent(ctx context.Context, req *ReplayArchivedEventRequest) (*EventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayArchivedEvent not implemented")
+}
 
 func RegisterEventServiceServer(s *grpc.Server, srv EventServiceServer) {
 	s.RegisterService(&_EventService_serviceDesc, srv)
@@ -336,6 +661,42 @@ func _EventService_ListWorkflowEventBindings_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_ListArchivedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArchivedEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListArchivedEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/event.EventService/ListArchivedEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListArchivedEvents(ctx, req.(*ListArchivedEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ReplayArchivedEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayArchivedEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ReplayArchivedEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/event.EventService/ReplayArchivedEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ReplayArchivedEvent(ctx, req.(*ReplayArchivedEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _EventService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "event.EventService",
 	HandlerType: (*EventServiceServer)(nil),
@@ -348,6 +709,14 @@ var _EventService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListWorkflowEventBindings",
 			Handler:    _EventService_ListWorkflowEventBindings_Handler,
 		},
+		{
+			MethodName: "ListArchivedEvents",
+			Handler:    _EventService_ListArchivedEvents_Handler,
+		},
+		{
+			MethodName: "ReplayArchivedEvent",
+			Handler:    _EventService_ReplayArchivedEvent_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/apiclient/event/event.proto",
@@ -479,41 +848,272 @@ func (m *ListWorkflowEventBindingsRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ArchivedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ArchivedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Discriminator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.Payload != nil {
-		l = m.Payload.Size()
-		n += 1 + l + sovEvent(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
+	if m.ReceivedAt != nil {
+		{
+			size, err := m.ReceivedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintEvent(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Payload != nil {
+		{
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Discriminator) > 0 {
+		i -= len(m.Discriminator)
+		copy(dAtA[i:], m.Discriminator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Discriminator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedEventList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedEventList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedEventList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListArchivedEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListArchivedEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListArchivedEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Offset != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Limit != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Discriminator) > 0 {
+		i -= len(m.Discriminator)
+		copy(dAtA[i:], m.Discriminator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Discriminator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayArchivedEventRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayArchivedEventRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayArchivedEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BindingName) > 0 {
+		i -= len(m.BindingName)
+		copy(dAtA[i:], m.BindingName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.BindingName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Discriminator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *EventResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -546,46 +1146,607 @@ func (m *ListWorkflowEventBindingsRequest) Size() (n int) {
 	return n
 }
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ArchivedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Discriminator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + len(v) + sovEvent(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
+		}
+	}
+	if m.ReceivedAt != nil {
+		l = m.ReceivedAt.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ArchivedEventList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *EventRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
+
+func (m *ListArchivedEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Discriminator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovEvent(uint64(m.Limit))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovEvent(uint64(m.Offset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplayArchivedEventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.BindingName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discriminator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Discriminator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payload == nil {
+				m.Payload = &v1alpha1.Item{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListWorkflowEventBindingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListWorkflowEventBindingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListWorkflowEventBindingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ListOptions == nil {
+				m.ListOptions = &v1.ListOptions{}
+			}
+			if err := m.ListOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchivedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discriminator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Discriminator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Payload == nil {
+				m.Payload = &v1alpha1.Item{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -595,29 +1756,124 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Discriminator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -627,27 +1883,82 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Discriminator = string(dAtA[iNdEx:postIndex])
+			if m.ReceivedAt == nil {
+				m.ReceivedAt = &v1.Time{}
+			}
+			if err := m.ReceivedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchivedEventList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedEventList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedEventList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -674,10 +1985,8 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Payload == nil {
-				m.Payload = &v1alpha1.Item{}
-			}
-			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, &ArchivedEvent{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -703,7 +2012,7 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventResponse) Unmarshal(dAtA []byte) error {
+func (m *ListArchivedEventsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -726,12 +2035,114 @@ func (m *EventResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListArchivedEventsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListArchivedEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discriminator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Discriminator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -754,7 +2165,7 @@ func (m *EventResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListWorkflowEventBindingsRequest) Unmarshal(dAtA []byte) error {
+func (m *ReplayArchivedEventRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -777,10 +2188,10 @@ func (m *ListWorkflowEventBindingsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListWorkflowEventBindingsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplayArchivedEventRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListWorkflowEventBindingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplayArchivedEventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -817,9 +2228,9 @@ func (m *ListWorkflowEventBindingsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -829,27 +2240,55 @@ func (m *ListWorkflowEventBindingsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ListOptions == nil {
-				m.ListOptions = &v1.ListOptions{}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindingName", wireType)
 			}
-			if err := m.ListOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.BindingName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,2660 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pkg/apiclient/executorplugin/executor-plugin.proto
+
+package executorplugin
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ListExecutorPluginsRequest struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListExecutorPluginsRequest) Reset()         { *m = ListExecutorPluginsRequest{} }
+func (m *ListExecutorPluginsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListExecutorPluginsRequest) ProtoMessage()    {}
+func (*ListExecutorPluginsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3436adc9159855f4, []int{0}
+}
+func (m *ListExecutorPluginsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListExecutorPluginsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListExecutorPluginsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListExecutorPluginsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListExecutorPluginsRequest.Merge(m, src)
+}
+func (m *ListExecutorPluginsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListExecutorPluginsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListExecutorPluginsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListExecutorPluginsRequest proto.InternalMessageInfo
+
+func (m *ListExecutorPluginsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type GetExecutorPluginRequest struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetExecutorPluginRequest) Reset()         { *m = GetExecutorPluginRequest{} }
+func (m *GetExecutorPluginRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExecutorPluginRequest) ProtoMessage()    {}
+func (*GetExecutorPluginRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3436adc9159855f4, []int{1}
+}
+func (m *GetExecutorPluginRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetExecutorPluginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetExecutorPluginRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetExecutorPluginRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExecutorPluginRequest.Merge(m, src)
+}
+func (m *GetExecutorPluginRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetExecutorPluginRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExecutorPluginRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExecutorPluginRequest proto.InternalMessageInfo
+
+func (m *GetExecutorPluginRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *GetExecutorPluginRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type EnableExecutorPluginRequest struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EnableExecutorPluginRequest) Reset()         { *m = EnableExecutorPluginRequest{} }
+func (m *EnableExecutorPluginRequest) String() string { return proto.CompactTextString(m) }
+func (*EnableExecutorPluginRequest) ProtoMessage()    {}
+func (*EnableExecutorPluginRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3436adc9159855f4, []int{2}
+}
+func (m *EnableExecutorPluginRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnableExecutorPluginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnableExecutorPluginRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnableExecutorPluginRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnableExecutorPluginRequest.Merge(m, src)
+}
+func (m *EnableExecutorPluginRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnableExecutorPluginRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnableExecutorPluginRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnableExecutorPluginRequest proto.InternalMessageInfo
+
+func (m *EnableExecutorPluginRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *EnableExecutorPluginRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type DisableExecutorPluginRequest struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisableExecutorPluginRequest) Reset()         { *m = DisableExecutorPluginRequest{} }
+func (m *DisableExecutorPluginRequest) String() string { return proto.CompactTextString(m) }
+func (*DisableExecutorPluginRequest) ProtoMessage()    {}
+func (*DisableExecutorPluginRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3436adc9159855f4, []int{3}
+}
+func (m *DisableExecutorPluginRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisableExecutorPluginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisableExecutorPluginRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DisableExecutorPluginRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisableExecutorPluginRequest.Merge(m, src)
+}
+func (m *DisableExecutorPluginRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisableExecutorPluginRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisableExecutorPluginRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisableExecutorPluginRequest proto.InternalMessageInfo
+
+func (m *DisableExecutorPluginRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *DisableExecutorPluginRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ExecutorPluginHealth struct {
+	// Healthy, Unhealthy, or Unknown if no agent pod is running the plugin
+	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExecutorPluginHealth) Reset()         { *m = ExecutorPluginHealth{} }
+func (m *ExecutorPluginHealth) String() string { return proto.CompactTextString(m) }
+func (*ExecutorPluginHealth) ProtoMessage()    {}
+func (*ExecutorPluginHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3436adc9159855f4, []int{4}
+}
+func (m *ExecutorPluginHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutorPluginHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutorPluginHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutorPluginHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutorPluginHealth.Merge(m, src)
+}
+func (m *ExecutorPluginHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutorPluginHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutorPluginHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutorPluginHealth proto.InternalMessageInfo
+
+func (m *ExecutorPluginHealth) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ExecutorPluginHealth) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// ExecutorPluginInvocationStats are counted from the plugin nodes of the workflows in the namespace
+type ExecutorPluginInvocationStats struct {
+	Invocations          int64    `protobuf:"varint,1,opt,name=invocations,proto3" json:"invocations,omitempty"`
+	Succeeded            int64    `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed               int64    `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Errored              int64    `protobuf:"varint,4,opt,name=errored,proto3" json:"errored,omitempty"`
+	LastInvokedAt        *v1.Time `protobuf:"bytes,5,opt,name=lastInvokedAt,proto3" json:"lastInvokedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExecutorPluginInvocationStats) Reset()         { *m = ExecutorPluginInvocationStats{} }
+func (m *ExecutorPluginInvocationStats) String() string { return proto.CompactTextString(m) }
+func (*ExecutorPluginInvocationStats) ProtoMessage()    {}
+func (*ExecutorPluginInvocationStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3436adc9159855f4, []int{5}
+}
+func (m *ExecutorPluginInvocationStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutorPluginInvocationStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutorPluginInvocationStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutorPluginInvocationStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutorPluginInvocationStats.Merge(m, src)
+}
+func (m *ExecutorPluginInvocationStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutorPluginInvocationStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutorPluginInvocationStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutorPluginInvocationStats proto.InternalMessageInfo
+
+func (m *ExecutorPluginInvocationStats) GetInvocations() int64 {
+	if m != nil {
+		return m.Invocations
+	}
+	return 0
+}
+
+func (m *ExecutorPluginInvocationStats) GetSucceeded() int64 {
+	if m != nil {
+		return m.Succeeded
+	}
+	return 0
+}
+
+func (m *ExecutorPluginInvocationStats) GetFailed() int64 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *ExecutorPluginInvocationStats) GetErrored() int64 {
+	if m != nil {
+		return m.Errored
+	}
+	return 0
+}
+
+func (m *ExecutorPluginInvocationStats) GetLastInvokedAt() *v1.Time {
+	if m != nil {
+		return m.LastInvokedAt
+	}
+	return nil
+}
+
+type ExecutorPlugin struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The name of the config map the plugin is installed from
+	ConfigMap string `protobuf:"bytes,3,opt,name=configMap,proto3" json:"configMap,omitempty"`
+	Image     string `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`
+	// The version of the plugin, i.e. the tag of its image
+	Version string `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	// The versions of Argo Workflows the plugin needs
+	Needs                string                         `protobuf:"bytes,6,opt,name=needs,proto3" json:"needs,omitempty"`
+	Description          string                         `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	Enabled              bool                           `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Health               *ExecutorPluginHealth          `protobuf:"bytes,9,opt,name=health,proto3" json:"health,omitempty"`
+	InvocationStats      *ExecutorPluginInvocationStats `protobuf:"bytes,10,opt,name=invocationStats,proto3" json:"invocationStats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *ExecutorPlugin) Reset()         { *m = ExecutorPlugin{} }
+func (m *ExecutorPlugin) String() string { return proto.CompactTextString(m) }
+func (*ExecutorPlugin) ProtoMessage()    {}
+func (*ExecutorPlugin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3436adc9159855f4, []int{6}
+}
+func (m *ExecutorPlugin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutorPlugin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutorPlugin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutorPlugin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutorPlugin.Merge(m, src)
+}
+func (m *ExecutorPlugin) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutorPlugin) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutorPlugin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutorPlugin proto.InternalMessageInfo
+
+func (m *ExecutorPlugin) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ExecutorPlugin) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ExecutorPlugin) GetConfigMap() string {
+	if m != nil {
+		return m.ConfigMap
+	}
+	return ""
+}
+
+func (m *ExecutorPlugin) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
+func (m *ExecutorPlugin) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *ExecutorPlugin) GetNeeds() string {
+	if m != nil {
+		return m.Needs
+	}
+	return ""
+}
+
+func (m *ExecutorPlugin) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ExecutorPlugin) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *ExecutorPlugin) GetHealth() *ExecutorPluginHealth {
+	if m != nil {
+		return m.Health
+	}
+	return nil
+}
+
+func (m *ExecutorPlugin) GetInvocationStats() *ExecutorPluginInvocationStats {
+	if m != nil {
+		return m.InvocationStats
+	}
+	return nil
+}
+
+type ExecutorPluginList struct {
+	Items                []*ExecutorPlugin `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ExecutorPluginList) Reset()         { *m = ExecutorPluginList{} }
+func (m *ExecutorPluginList) String() string { return proto.CompactTextString(m) }
+func (*ExecutorPluginList) ProtoMessage()    {}
+func (*ExecutorPluginList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3436adc9159855f4, []int{7}
+}
+func (m *ExecutorPluginList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutorPluginList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutorPluginList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutorPluginList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutorPluginList.Merge(m, src)
+}
+func (m *ExecutorPluginList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutorPluginList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutorPluginList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutorPluginList proto.InternalMessageInfo
+
+func (m *ExecutorPluginList) GetItems() []*ExecutorPlugin {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ListExecutorPluginsRequest)(nil), "executorplugin.ListExecutorPluginsRequest")
+	proto.RegisterType((*GetExecutorPluginRequest)(nil), "executorplugin.GetExecutorPluginRequest")
+	proto.RegisterType((*EnableExecutorPluginRequest)(nil), "executorplugin.EnableExecutorPluginRequest")
+	proto.RegisterType((*DisableExecutorPluginRequest)(nil), "executorplugin.DisableExecutorPluginRequest")
+	proto.RegisterType((*ExecutorPluginHealth)(nil), "executorplugin.ExecutorPluginHealth")
+	proto.RegisterType((*ExecutorPluginInvocationStats)(nil), "executorplugin.ExecutorPluginInvocationStats")
+	proto.RegisterType((*ExecutorPlugin)(nil), "executorplugin.ExecutorPlugin")
+	proto.RegisterType((*ExecutorPluginList)(nil), "executorplugin.ExecutorPluginList")
+}
+
+func init() {
+	proto.RegisterFile("pkg/apiclient/executorplugin/executor-plugin.proto", fileDescriptor_3436adc9159855f4)
+}
+
+var fileDescriptor_3436adc9159855f4 = []byte{
+	// 731 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdd, 0x6a, 0x13, 0x4f,
+	0x14, 0x67, 0x93, 0x26, 0x6d, 0xa6, 0xfc, 0xfb, 0xc7, 0xb1, 0x95, 0x25, 0xc6, 0x10, 0x96, 0x22,
+	0x21, 0x6d, 0x76, 0x69, 0x5a, 0xb0, 0x14, 0x3f, 0x50, 0x2c, 0x56, 0x69, 0xb1, 0x6c, 0x05, 0xc1,
+	0xbb, 0xe9, 0xee, 0xe9, 0x66, 0xcc, 0xee, 0xce, 0xba, 0x33, 0x49, 0x15, 0xe9, 0x8d, 0x2f, 0x20,
+	0xd8, 0x37, 0x10, 0x7c, 0x17, 0x2f, 0x05, 0x5f, 0x40, 0xaa, 0x2f, 0xe1, 0x9d, 0xcc, 0x6c, 0x3e,
+	0xba, 0xdb, 0x34, 0x6d, 0xa1, 0x77, 0xf3, 0x3b, 0x1f, 0xbf, 0xf3, 0x9b, 0x33, 0x67, 0xcf, 0xa2,
+	0x56, 0xd4, 0xf1, 0x2c, 0x12, 0x51, 0xc7, 0xa7, 0x10, 0x0a, 0x0b, 0xde, 0x83, 0xd3, 0x15, 0x2c,
+	0x8e, 0xfc, 0xae, 0x47, 0xc3, 0x21, 0x6c, 0x26, 0xd8, 0x8c, 0x62, 0x26, 0x18, 0x9e, 0x4b, 0x47,
+	0x95, 0x2b, 0x1e, 0x63, 0x9e, 0x0f, 0x92, 0xc6, 0x22, 0x61, 0xc8, 0x04, 0x11, 0x94, 0x85, 0x3c,
+	0x89, 0x2e, 0xaf, 0x75, 0xd6, 0xb9, 0x49, 0x99, 0xf4, 0x06, 0xc4, 0x69, 0xd3, 0x10, 0xe2, 0x0f,
+	0x56, 0xbf, 0x2a, 0xb7, 0x02, 0x10, 0xc4, 0xea, 0xad, 0x58, 0x1e, 0x84, 0x10, 0x13, 0x01, 0x6e,
+	0x92, 0x65, 0x6c, 0xa0, 0xf2, 0x36, 0xe5, 0x62, 0xb3, 0x5f, 0x69, 0x57, 0x55, 0xe2, 0x36, 0xbc,
+	0xeb, 0x02, 0x17, 0xb8, 0x82, 0x4a, 0x21, 0x09, 0x80, 0x47, 0xc4, 0x01, 0x5d, 0xab, 0x69, 0xf5,
+	0x92, 0x3d, 0x32, 0x18, 0xdb, 0x48, 0x7f, 0x06, 0x99, 0xd4, 0x4b, 0x65, 0x62, 0x8c, 0xa6, 0x24,
+	0xd0, 0x73, 0xca, 0xa1, 0xce, 0xc6, 0x4b, 0x74, 0x7b, 0x33, 0x24, 0xfb, 0x3e, 0x5c, 0x17, 0xe1,
+	0x2e, 0xaa, 0x3c, 0xa5, 0xfc, 0x3a, 0x19, 0xb7, 0xd0, 0x7c, 0x9a, 0x6a, 0x0b, 0x88, 0x2f, 0xda,
+	0xf8, 0x16, 0x2a, 0x72, 0x41, 0x44, 0x97, 0xf7, 0x69, 0xfa, 0x08, 0xeb, 0x68, 0x3a, 0x00, 0xce,
+	0x89, 0x37, 0xa0, 0x19, 0x40, 0xe3, 0xb7, 0x86, 0xee, 0xa4, 0xa9, 0x9e, 0x87, 0x3d, 0xe6, 0xa8,
+	0x07, 0xdd, 0x13, 0x44, 0x70, 0x5c, 0x43, 0xb3, 0x74, 0x68, 0x4a, 0x88, 0xf3, 0xf6, 0x69, 0x93,
+	0xd4, 0xcf, 0xbb, 0x8e, 0x03, 0xe0, 0x82, 0xab, 0xf8, 0xf3, 0xf6, 0xc8, 0x20, 0x35, 0x1d, 0x10,
+	0xea, 0x83, 0xab, 0xe7, 0x95, 0xab, 0x8f, 0xa4, 0x26, 0x88, 0x63, 0x16, 0x83, 0xab, 0x4f, 0x29,
+	0xc7, 0x00, 0xe2, 0x5d, 0xf4, 0x9f, 0x4f, 0xb8, 0x90, 0x42, 0x3a, 0xe0, 0x3e, 0x16, 0x7a, 0xa1,
+	0xa6, 0xd5, 0x67, 0x5b, 0x0d, 0x33, 0x19, 0x2c, 0xf3, 0xf4, 0x60, 0x99, 0x51, 0xc7, 0x93, 0x06,
+	0x6e, 0xca, 0xc1, 0x32, 0x7b, 0x2b, 0xe6, 0x2b, 0x1a, 0x80, 0x9d, 0x26, 0x30, 0xfe, 0xe6, 0xd0,
+	0x5c, 0xfa, 0x96, 0xc3, 0xb6, 0x6a, 0xa3, 0xb6, 0xa6, 0x1f, 0x22, 0x97, 0x7d, 0x88, 0x0a, 0x2a,
+	0x39, 0x2c, 0x3c, 0xa0, 0xde, 0x0e, 0x89, 0xd4, 0x5d, 0x4a, 0xf6, 0xc8, 0x80, 0xe7, 0x51, 0x81,
+	0x06, 0xb2, 0xc1, 0x53, 0xca, 0x93, 0x00, 0x79, 0xc9, 0x1e, 0xc4, 0x9c, 0xb2, 0x50, 0x5d, 0xa2,
+	0x64, 0x0f, 0xa0, 0x8c, 0x0f, 0x01, 0x5c, 0xae, 0x17, 0x93, 0x78, 0x05, 0x64, 0xb3, 0x5d, 0xe0,
+	0x4e, 0x4c, 0x23, 0xd9, 0x5a, 0x7d, 0x5a, 0xf9, 0x4e, 0x9b, 0x54, 0xdb, 0xd4, 0x74, 0xba, 0xfa,
+	0x4c, 0x4d, 0xab, 0xcf, 0xd8, 0x03, 0x88, 0xef, 0xa3, 0x62, 0x5b, 0x8d, 0x81, 0x5e, 0x52, 0xfd,
+	0x5a, 0x34, 0xd3, 0x9f, 0xad, 0x39, 0x6e, 0x64, 0xec, 0x7e, 0x0e, 0x7e, 0x8d, 0xfe, 0xa7, 0xe9,
+	0x97, 0xd7, 0x91, 0xa2, 0x69, 0x4e, 0xa6, 0xc9, 0x8c, 0x8b, 0x9d, 0x65, 0x31, 0x5e, 0x20, 0x9c,
+	0xce, 0x90, 0x9f, 0x39, 0x5e, 0x43, 0x05, 0x2a, 0x20, 0x90, 0xf3, 0x94, 0xaf, 0xcf, 0xb6, 0xaa,
+	0x93, 0x8b, 0xd8, 0x49, 0x70, 0xeb, 0x73, 0x01, 0x2d, 0xa4, 0x3d, 0x7b, 0x10, 0xf7, 0xa8, 0x03,
+	0xf8, 0x58, 0x43, 0x37, 0xc7, 0xec, 0x0f, 0xdc, 0xc8, 0x12, 0x9f, 0xbf, 0x64, 0xca, 0xc6, 0x64,
+	0x11, 0x32, 0xd3, 0x58, 0xfe, 0xf4, 0xf3, 0xcf, 0x71, 0xee, 0x2e, 0x5e, 0x54, 0xcb, 0xaf, 0xb7,
+	0x92, 0xdd, 0x98, 0xdc, 0xfa, 0x38, 0x9c, 0x98, 0x23, 0xfc, 0x45, 0x43, 0x37, 0xce, 0x6c, 0x26,
+	0x5c, 0xcf, 0xd6, 0x39, 0x6f, 0x79, 0x95, 0x2f, 0x68, 0x8b, 0xb1, 0xaa, 0xd4, 0x34, 0xf1, 0xd2,
+	0x65, 0xd4, 0x24, 0xe7, 0x23, 0xfc, 0x55, 0x43, 0xf3, 0xe3, 0x16, 0x1c, 0x5e, 0x3a, 0x53, 0xed,
+	0xfc, 0x35, 0x78, 0xa1, 0xb4, 0x07, 0x4a, 0xda, 0xbd, 0x72, 0xeb, 0x0a, 0xd2, 0xac, 0x64, 0x94,
+	0x37, 0xb4, 0x06, 0xfe, 0xa6, 0xa1, 0x85, 0xb1, 0x4b, 0x13, 0x2f, 0x67, 0x0b, 0x4f, 0xda, 0xad,
+	0x17, 0xca, 0x7c, 0xa8, 0x64, 0xae, 0x97, 0x57, 0xaf, 0x22, 0xd3, 0x4d, 0x2a, 0x6e, 0x68, 0x8d,
+	0x27, 0x3b, 0xdf, 0x4f, 0xaa, 0xda, 0x8f, 0x93, 0xaa, 0xf6, 0xeb, 0xa4, 0xaa, 0xbd, 0x79, 0xe4,
+	0x51, 0xd1, 0xee, 0xee, 0x9b, 0x0e, 0x0b, 0x2c, 0x12, 0x7b, 0x2c, 0x8a, 0xd9, 0x5b, 0x75, 0x68,
+	0x1e, 0xb2, 0xb8, 0x73, 0xe0, 0xb3, 0x43, 0x6e, 0x4d, 0xfa, 0xff, 0xee, 0x17, 0xd5, 0xcf, 0x70,
+	0xf5, 0xdf, 0x00, 0x3c, 0xf0, 0x0d, 0xbb, 0xa6, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ExecutorPluginServiceClient is the client API for ExecutorPluginService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ExecutorPluginServiceClient interface {
+	ListExecutorPlugins(ctx context.Context, in *ListExecutorPluginsRequest, opts ...grpc.CallOption) (*ExecutorPluginList, error)
+	GetExecutorPlugin(ctx context.Context, in *GetExecutorPluginRequest, opts ...grpc.CallOption) (*ExecutorPlugin, error)
+	EnableExecutorPlugin(ctx context.Context, in *EnableExecutorPluginRequest, opts ...grpc.CallOption) (*ExecutorPlugin, error)
+	DisableExecutorPlugin(ctx context.Context, in *DisableExecutorPluginRequest, opts ...grpc.CallOption) (*ExecutorPlugin, error)
+}
+
+type executorPluginServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewExecutorPluginServiceClient(cc *grpc.ClientConn) ExecutorPluginServiceClient {
+	return &executorPluginServiceClient{cc}
+}
+
+func (c *executorPluginServiceClient) ListExecutorPlugins(ctx context.Context, in *ListExecutorPluginsRequest, opts ...grpc.CallOption) (*ExecutorPluginList, error) {
+	out := new(ExecutorPluginList)
+	err := c.cc.Invoke(ctx, "/executorplugin.ExecutorPluginService/ListExecutorPlugins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorPluginServiceClient) GetExecutorPlugin(ctx context.Context, in *GetExecutorPluginRequest, opts ...grpc.CallOption) (*ExecutorPlugin, error) {
+	out := new(ExecutorPlugin)
+	err := c.cc.Invoke(ctx, "/executorplugin.ExecutorPluginService/GetExecutorPlugin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorPluginServiceClient) EnableExecutorPlugin(ctx context.Context, in *EnableExecutorPluginRequest, opts ...grpc.CallOption) (*ExecutorPlugin, error) {
+	out := new(ExecutorPlugin)
+	err := c.cc.Invoke(ctx, "/executorplugin.ExecutorPluginService/EnableExecutorPlugin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorPluginServiceClient) DisableExecutorPlugin(ctx context.Context, in *DisableExecutorPluginRequest, opts ...grpc.CallOption) (*ExecutorPlugin, error) {
+	out := new(ExecutorPlugin)
+	err := c.cc.Invoke(ctx, "/executorplugin.ExecutorPluginService/DisableExecutorPlugin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExecutorPluginServiceServer is the server API for ExecutorPluginService service.
+type ExecutorPluginServiceServer interface {
+	ListExecutorPlugins(context.Context, *ListExecutorPluginsRequest) (*ExecutorPluginList, error)
+	GetExecutorPlugin(context.Context, *GetExecutorPluginRequest) (*ExecutorPlugin, error)
+	EnableExecutorPlugin(context.Context, *EnableExecutorPluginRequest) (*ExecutorPlugin, error)
+	DisableExecutorPlugin(context.Context, *DisableExecutorPluginRequest) (*ExecutorPlugin, error)
+}
+
+// UnimplementedExecutorPluginServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedExecutorPluginServiceServer struct {
+}
+
+func (*UnimplementedExecutorPluginServiceServer) ListExecutorPlugins(ctx context.Context, req *ListExecutorPluginsRequest) (*ExecutorPluginList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExecutorPlugins not implemented")
+}
+func (*UnimplementedExecutorPluginServiceServer) GetExecutorPlugin(ctx context.Context, req *GetExecutorPluginRequest) (*ExecutorPlugin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExecutorPlugin not implemented")
+}
+func (*UnimplementedExecutorPluginServiceServer) EnableExecutorPlugin(ctx context.Context, req *EnableExecutorPluginRequest) (*ExecutorPlugin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableExecutorPlugin not implemented")
+}
+func (*UnimplementedExecutorPluginServiceServer) DisableExecutorPlugin(ctx context.Context, req *DisableExecutorPluginRequest) (*ExecutorPlugin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableExecutorPlugin not implemented")
+}
+
+func RegisterExecutorPluginServiceServer(s *grpc.Server, srv ExecutorPluginServiceServer) {
+	s.RegisterService(&_ExecutorPluginService_serviceDesc, srv)
+}
+
+func _ExecutorPluginService_ListExecutorPlugins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExecutorPluginsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorPluginServiceServer).ListExecutorPlugins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/executorplugin.ExecutorPluginService/ListExecutorPlugins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorPluginServiceServer).ListExecutorPlugins(ctx, req.(*ListExecutorPluginsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorPluginService_GetExecutorPlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExecutorPluginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorPluginServiceServer).GetExecutorPlugin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/executorplugin.ExecutorPluginService/GetExecutorPlugin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorPluginServiceServer).GetExecutorPlugin(ctx, req.(*GetExecutorPluginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorPluginService_EnableExecutorPlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableExecutorPluginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorPluginServiceServer).EnableExecutorPlugin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/executorplugin.ExecutorPluginService/EnableExecutorPlugin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorPluginServiceServer).EnableExecutorPlugin(ctx, req.(*EnableExecutorPluginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutorPluginService_DisableExecutorPlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableExecutorPluginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorPluginServiceServer).DisableExecutorPlugin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/executorplugin.ExecutorPluginService/DisableExecutorPlugin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorPluginServiceServer).DisableExecutorPlugin(ctx, req.(*DisableExecutorPluginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ExecutorPluginService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "executorplugin.ExecutorPluginService",
+	HandlerType: (*ExecutorPluginServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListExecutorPlugins",
+			Handler:    _ExecutorPluginService_ListExecutorPlugins_Handler,
+		},
+		{
+			MethodName: "GetExecutorPlugin",
+			Handler:    _ExecutorPluginService_GetExecutorPlugin_Handler,
+		},
+		{
+			MethodName: "EnableExecutorPlugin",
+			Handler:    _ExecutorPluginService_EnableExecutorPlugin_Handler,
+		},
+		{
+			MethodName: "DisableExecutorPlugin",
+			Handler:    _ExecutorPluginService_DisableExecutorPlugin_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/apiclient/executorplugin/executor-plugin.proto",
+}
+
+func (m *ListExecutorPluginsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListExecutorPluginsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListExecutorPluginsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintExecutorPlugin(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetExecutorPluginRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetExecutorPluginRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetExecutorPluginRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintExecutorPlugin(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintExecutorPlugin(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnableExecutorPluginRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnableExecutorPluginRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnableExecutorPluginRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintExecutorPlugin(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintExecutorPlugin(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DisableExecutorPluginRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisableExecutorPluginRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisableExecutorPluginRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintExecutorPlugin(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintExecutorPlugin(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutorPluginHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutorPluginHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutorPluginHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintExecutorPlugin(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintExecutorPlugin(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutorPluginInvocationStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutorPluginInvocationStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutorPluginInvocationStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastInvokedAt != nil {
+		{
+			size, err := m.LastInvokedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExecutorPlugin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Errored != 0 {
+		i = encodeVarintExecutorPlugin(dAtA, i, uint64(m.Errored))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Failed != 0 {
+		i = encodeVarintExecutorPlugin(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Succeeded != 0 {
+		i = encodeVarintExecutorPlugin(dAtA, i, uint64(m.Succeeded))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Invocations != 0 {
+		i = encodeVarintExecutorPlugin(dAtA, i, uint64(m.Invocations))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutorPlugin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutorPlugin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutorPlugin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.InvocationStats != nil {
+		{
+			size, err := m.InvocationStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExecutorPlugin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Health != nil {
+		{
+			size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExecutorPlugin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintExecutorPlugin(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Needs) > 0 {
+		i -= len(m.Needs)
+		copy(dAtA[i:], m.Needs)
+		i = encodeVarintExecutorPlugin(dAtA, i, uint64(len(m.Needs)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintExecutorPlugin(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintExecutorPlugin(dAtA, i, uint64(len(m.Image)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConfigMap) > 0 {
+		i -= len(m.ConfigMap)
+		copy(dAtA[i:], m.ConfigMap)
+		i = encodeVarintExecutorPlugin(dAtA, i, uint64(len(m.ConfigMap)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintExecutorPlugin(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintExecutorPlugin(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutorPluginList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutorPluginList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutorPluginList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExecutorPlugin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExecutorPlugin(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExecutorPlugin(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ListExecutorPluginsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovExecutorPlugin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetExecutorPluginRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovExecutorPlugin(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovExecutorPlugin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EnableExecutorPluginRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovExecutorPlugin(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovExecutorPlugin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DisableExecutorPluginRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovExecutorPlugin(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovExecutorPlugin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExecutorPluginHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovExecutorPlugin(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovExecutorPlugin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExecutorPluginInvocationStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Invocations != 0 {
+		n += 1 + sovExecutorPlugin(uint64(m.Invocations))
+	}
+	if m.Succeeded != 0 {
+		n += 1 + sovExecutorPlugin(uint64(m.Succeeded))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovExecutorPlugin(uint64(m.Failed))
+	}
+	if m.Errored != 0 {
+		n += 1 + sovExecutorPlugin(uint64(m.Errored))
+	}
+	if m.LastInvokedAt != nil {
+		l = m.LastInvokedAt.Size()
+		n += 1 + l + sovExecutorPlugin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExecutorPlugin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovExecutorPlugin(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovExecutorPlugin(uint64(l))
+	}
+	l = len(m.ConfigMap)
+	if l > 0 {
+		n += 1 + l + sovExecutorPlugin(uint64(l))
+	}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovExecutorPlugin(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovExecutorPlugin(uint64(l))
+	}
+	l = len(m.Needs)
+	if l > 0 {
+		n += 1 + l + sovExecutorPlugin(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovExecutorPlugin(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.Health != nil {
+		l = m.Health.Size()
+		n += 1 + l + sovExecutorPlugin(uint64(l))
+	}
+	if m.InvocationStats != nil {
+		l = m.InvocationStats.Size()
+		n += 1 + l + sovExecutorPlugin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExecutorPluginList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovExecutorPlugin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovExecutorPlugin(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExecutorPlugin(x uint64) (n int) {
+	return sovExecutorPlugin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ListExecutorPluginsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutorPlugin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListExecutorPluginsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListExecutorPluginsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutorPlugin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetExecutorPluginRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutorPlugin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetExecutorPluginRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetExecutorPluginRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutorPlugin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnableExecutorPluginRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutorPlugin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnableExecutorPluginRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnableExecutorPluginRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutorPlugin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DisableExecutorPluginRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutorPlugin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DisableExecutorPluginRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DisableExecutorPluginRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutorPlugin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutorPluginHealth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutorPlugin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutorPluginHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutorPluginHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutorPlugin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutorPluginInvocationStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutorPlugin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutorPluginInvocationStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutorPluginInvocationStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invocations", wireType)
+			}
+			m.Invocations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Invocations |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			m.Succeeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Succeeded |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errored", wireType)
+			}
+			m.Errored = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Errored |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInvokedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastInvokedAt == nil {
+				m.LastInvokedAt = &v1.Time{}
+			}
+			if err := m.LastInvokedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutorPlugin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutorPlugin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutorPlugin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutorPlugin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutorPlugin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigMap = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Needs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Needs = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Health == nil {
+				m.Health = &ExecutorPluginHealth{}
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvocationStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InvocationStats == nil {
+				m.InvocationStats = &ExecutorPluginInvocationStats{}
+			}
+			if err := m.InvocationStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutorPlugin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutorPluginList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutorPlugin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutorPluginList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutorPluginList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &ExecutorPlugin{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutorPlugin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecutorPlugin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipExecutorPlugin(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowExecutorPlugin
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExecutorPlugin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthExecutorPlugin
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupExecutorPlugin
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthExecutorPlugin
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthExecutorPlugin        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowExecutorPlugin          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupExecutorPlugin = fmt.Errorf("proto: unexpected end of group")
+)
@@ -30,3 +30,8 @@ func (h ClusterWorkflowTemplateServiceClient) UpdateClusterWorkflowTemplate(ctx
 	out := &wfv1.ClusterWorkflowTemplate{}
 	return out, h.Put(ctx, in, out, "/api/v1/cluster-workflow-templates/{name}")
 }
+
+func (h ClusterWorkflowTemplateServiceClient) ListClusterWorkflowTemplateRevisions(ctx context.Context, in *clusterworkflowtemplate.ClusterWorkflowTemplateRevisionsRequest, _ ...grpc.CallOption) (*wfv1.WorkflowTemplateRevisionList, error) {
+	out := &wfv1.WorkflowTemplateRevisionList{}
+	return out, h.Get(ctx, in, out, "/api/v1/cluster-workflow-templates/{name}/revisions")
+}
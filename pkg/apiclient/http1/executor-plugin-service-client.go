@@ -0,0 +1,31 @@
+package http1
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	executorpluginpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/executorplugin"
+)
+
+type ExecutorPluginServiceClient = Facade
+
+func (h ExecutorPluginServiceClient) ListExecutorPlugins(ctx context.Context, in *executorpluginpkg.ListExecutorPluginsRequest, _ ...grpc.CallOption) (*executorpluginpkg.ExecutorPluginList, error) {
+	out := &executorpluginpkg.ExecutorPluginList{}
+	return out, h.Get(ctx, in, out, "/api/v1/executor-plugins/{namespace}")
+}
+
+func (h ExecutorPluginServiceClient) GetExecutorPlugin(ctx context.Context, in *executorpluginpkg.GetExecutorPluginRequest, _ ...grpc.CallOption) (*executorpluginpkg.ExecutorPlugin, error) {
+	out := &executorpluginpkg.ExecutorPlugin{}
+	return out, h.Get(ctx, in, out, "/api/v1/executor-plugins/{namespace}/{name}")
+}
+
+func (h ExecutorPluginServiceClient) EnableExecutorPlugin(ctx context.Context, in *executorpluginpkg.EnableExecutorPluginRequest, _ ...grpc.CallOption) (*executorpluginpkg.ExecutorPlugin, error) {
+	out := &executorpluginpkg.ExecutorPlugin{}
+	return out, h.Put(ctx, in, out, "/api/v1/executor-plugins/{namespace}/{name}/enable")
+}
+
+func (h ExecutorPluginServiceClient) DisableExecutorPlugin(ctx context.Context, in *executorpluginpkg.DisableExecutorPluginRequest, _ ...grpc.CallOption) (*executorpluginpkg.ExecutorPlugin, error) {
+	out := &executorpluginpkg.ExecutorPlugin{}
+	return out, h.Put(ctx, in, out, "/api/v1/executor-plugins/{namespace}/{name}/disable")
+}
@@ -0,0 +1,21 @@
+package http1
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
+)
+
+type MemoizationCacheServiceClient = Facade
+
+func (h MemoizationCacheServiceClient) ListCacheEntries(ctx context.Context, in *memoizationcachepkg.ListCacheEntriesRequest, _ ...grpc.CallOption) (*memoizationcachepkg.CacheEntryList, error) {
+	out := &memoizationcachepkg.CacheEntryList{}
+	return out, h.Get(ctx, in, out, "/api/v1/memoization-caches/{namespace}")
+}
+
+func (h MemoizationCacheServiceClient) DeleteCacheEntry(ctx context.Context, in *memoizationcachepkg.DeleteCacheEntryRequest, _ ...grpc.CallOption) (*memoizationcachepkg.CacheEntryDeletedResponse, error) {
+	out := &memoizationcachepkg.CacheEntryDeletedResponse{}
+	return out, h.Delete(ctx, in, out, "/api/v1/memoization-caches/{namespace}/{name}/{key}")
+}
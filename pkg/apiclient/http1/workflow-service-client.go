@@ -107,3 +107,8 @@ func (h WorkflowServiceClient) SubmitWorkflow(ctx context.Context, in *workflowp
 	out := &wfv1.Workflow{}
 	return out, h.Post(ctx, in, out, "/api/v1/workflows/{namespace}/submit")
 }
+
+func (h WorkflowServiceClient) DiffWorkflows(ctx context.Context, in *workflowpkg.WorkflowDiffRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowDiffResponse, error) {
+	out := &workflowpkg.WorkflowDiffResponse{}
+	return out, h.Get(ctx, in, out, "/api/v1/workflows/{namespace}/{name}/diff")
+}
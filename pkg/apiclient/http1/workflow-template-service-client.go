@@ -40,3 +40,8 @@ func (h WorkflowTemplateServiceClient) LintWorkflowTemplate(ctx context.Context,
 	out := &wfv1.WorkflowTemplate{}
 	return out, h.Post(ctx, in, out, "/api/v1/workflow-templates/{namespace}/lint")
 }
+
+func (h WorkflowTemplateServiceClient) ListWorkflowTemplateRevisions(ctx context.Context, in *workflowtemplatepkg.WorkflowTemplateRevisionsRequest, _ ...grpc.CallOption) (*wfv1.WorkflowTemplateRevisionList, error) {
+	out := &wfv1.WorkflowTemplateRevisionList{}
+	return out, h.Get(ctx, in, out, "/api/v1/workflow-templates/{namespace}/{name}/revisions")
+}
@@ -0,0 +1,1598 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pkg/apiclient/memoizationcache/memoization-cache.proto
+
+package memoizationcache
+
+import (
+	context "context"
+	fmt "fmt"
+	v1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ListCacheEntriesRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The name of the cache, the entries of every cache in the namespace if empty
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCacheEntriesRequest) Reset()         { *m = ListCacheEntriesRequest{} }
+func (m *ListCacheEntriesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCacheEntriesRequest) ProtoMessage()    {}
+func (*ListCacheEntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9acfa579c248340, []int{0}
+}
+func (m *ListCacheEntriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListCacheEntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListCacheEntriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListCacheEntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCacheEntriesRequest.Merge(m, src)
+}
+func (m *ListCacheEntriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListCacheEntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCacheEntriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCacheEntriesRequest proto.InternalMessageInfo
+
+func (m *ListCacheEntriesRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ListCacheEntriesRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type CacheEntry struct {
+	// The name of the cache, i.e. its ConfigMap
+	CacheName string `protobuf:"bytes,1,opt,name=cacheName,proto3" json:"cacheName,omitempty"`
+	Key       string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// The ID of the node that saved the outputs
+	NodeID               string            `protobuf:"bytes,3,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Outputs              *v1alpha1.Outputs `protobuf:"bytes,4,opt,name=outputs,proto3" json:"outputs,omitempty"`
+	CreationTimestamp    *v1.Time          `protobuf:"bytes,5,opt,name=creationTimestamp,proto3" json:"creationTimestamp,omitempty"`
+	LastHitTimestamp     *v1.Time          `protobuf:"bytes,6,opt,name=lastHitTimestamp,proto3" json:"lastHitTimestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CacheEntry) Reset()         { *m = CacheEntry{} }
+func (m *CacheEntry) String() string { return proto.CompactTextString(m) }
+func (*CacheEntry) ProtoMessage()    {}
+func (*CacheEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9acfa579c248340, []int{1}
+}
+func (m *CacheEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CacheEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CacheEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CacheEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheEntry.Merge(m, src)
+}
+func (m *CacheEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *CacheEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CacheEntry proto.InternalMessageInfo
+
+func (m *CacheEntry) GetCacheName() string {
+	if m != nil {
+		return m.CacheName
+	}
+	return ""
+}
+
+func (m *CacheEntry) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *CacheEntry) GetNodeID() string {
+	if m != nil {
+		return m.NodeID
+	}
+	return ""
+}
+
+func (m *CacheEntry) GetOutputs() *v1alpha1.Outputs {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+func (m *CacheEntry) GetCreationTimestamp() *v1.Time {
+	if m != nil {
+		return m.CreationTimestamp
+	}
+	return nil
+}
+
+func (m *CacheEntry) GetLastHitTimestamp() *v1.Time {
+	if m != nil {
+		return m.LastHitTimestamp
+	}
+	return nil
+}
+
+type CacheEntryList struct {
+	Items                []*CacheEntry `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *CacheEntryList) Reset()         { *m = CacheEntryList{} }
+func (m *CacheEntryList) String() string { return proto.CompactTextString(m) }
+func (*CacheEntryList) ProtoMessage()    {}
+func (*CacheEntryList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9acfa579c248340, []int{2}
+}
+func (m *CacheEntryList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CacheEntryList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CacheEntryList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CacheEntryList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheEntryList.Merge(m, src)
+}
+func (m *CacheEntryList) XXX_Size() int {
+	return m.Size()
+}
+func (m *CacheEntryList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheEntryList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CacheEntryList proto.InternalMessageInfo
+
+func (m *CacheEntryList) GetItems() []*CacheEntry {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type DeleteCacheEntryRequest struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Key                  string   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCacheEntryRequest) Reset()         { *m = DeleteCacheEntryRequest{} }
+func (m *DeleteCacheEntryRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCacheEntryRequest) ProtoMessage()    {}
+func (*DeleteCacheEntryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9acfa579c248340, []int{3}
+}
+func (m *DeleteCacheEntryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteCacheEntryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteCacheEntryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteCacheEntryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCacheEntryRequest.Merge(m, src)
+}
+func (m *DeleteCacheEntryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteCacheEntryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCacheEntryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCacheEntryRequest proto.InternalMessageInfo
+
+func (m *DeleteCacheEntryRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *DeleteCacheEntryRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeleteCacheEntryRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type CacheEntryDeletedResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CacheEntryDeletedResponse) Reset()         { *m = CacheEntryDeletedResponse{} }
+func (m *CacheEntryDeletedResponse) String() string { return proto.CompactTextString(m) }
+func (*CacheEntryDeletedResponse) ProtoMessage()    {}
+func (*CacheEntryDeletedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9acfa579c248340, []int{4}
+}
+func (m *CacheEntryDeletedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CacheEntryDeletedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CacheEntryDeletedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CacheEntryDeletedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheEntryDeletedResponse.Merge(m, src)
+}
+func (m *CacheEntryDeletedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CacheEntryDeletedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheEntryDeletedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CacheEntryDeletedResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ListCacheEntriesRequest)(nil), "memoizationcache.ListCacheEntriesRequest")
+	proto.RegisterType((*CacheEntry)(nil), "memoizationcache.CacheEntry")
+	proto.RegisterType((*CacheEntryList)(nil), "memoizationcache.CacheEntryList")
+	proto.RegisterType((*DeleteCacheEntryRequest)(nil), "memoizationcache.DeleteCacheEntryRequest")
+	proto.RegisterType((*CacheEntryDeletedResponse)(nil), "memoizationcache.CacheEntryDeletedResponse")
+}
+
+func init() {
+	proto.RegisterFile("pkg/apiclient/memoizationcache/memoization-cache.proto", fileDescriptor_c9acfa579c248340)
+}
+
+var fileDescriptor_c9acfa579c248340 = []byte{
+	// 569 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xdd, 0x8a, 0xd3, 0x40,
+	0x14, 0xc7, 0x49, 0xbb, 0x5b, 0xd9, 0x59, 0x90, 0x3a, 0x17, 0x36, 0xd6, 0x52, 0x4a, 0x2e, 0xa4,
+	0xae, 0xec, 0x84, 0xb6, 0x2a, 0x82, 0x57, 0x6a, 0x05, 0x17, 0x5d, 0x17, 0xa2, 0x88, 0x08, 0x22,
+	0xb3, 0xe9, 0x31, 0x1d, 0xf3, 0x31, 0x31, 0x33, 0xcd, 0x52, 0x97, 0xde, 0xf8, 0x02, 0x5e, 0xf8,
+	0x10, 0xbe, 0x8a, 0x97, 0x82, 0x78, 0x2f, 0xc5, 0x3b, 0x5f, 0x42, 0x66, 0xd2, 0x24, 0xa5, 0xb5,
+	0xcb, 0xea, 0xdd, 0xc9, 0xf9, 0xf8, 0xcd, 0x99, 0xff, 0x39, 0x19, 0x74, 0x3b, 0xf6, 0x3d, 0x9b,
+	0xc6, 0xcc, 0x0d, 0x18, 0x44, 0xd2, 0x0e, 0x21, 0xe4, 0xec, 0x03, 0x95, 0x8c, 0x47, 0x2e, 0x75,
+	0xc7, 0xb0, 0xec, 0xd8, 0xd7, 0x1e, 0x12, 0x27, 0x5c, 0x72, 0x5c, 0x5f, 0xcd, 0x6c, 0xb6, 0x3c,
+	0xce, 0xbd, 0x00, 0x14, 0xcc, 0xa6, 0x51, 0xc4, 0xa5, 0x8e, 0x89, 0x2c, 0xbf, 0x79, 0xd3, 0xbf,
+	0x23, 0x08, 0xe3, 0x2a, 0x1a, 0x52, 0x77, 0xcc, 0x22, 0x48, 0xa6, 0xf6, 0xe2, 0x6c, 0x61, 0x87,
+	0x20, 0xa9, 0x9d, 0xf6, 0x6c, 0x0f, 0x22, 0x48, 0xa8, 0x84, 0xd1, 0xa2, 0xea, 0xd0, 0x63, 0x72,
+	0x3c, 0x39, 0x26, 0x2e, 0x0f, 0x6d, 0x9a, 0x78, 0x3c, 0x4e, 0xf8, 0x3b, 0x6d, 0xec, 0x9f, 0xf0,
+	0xc4, 0x7f, 0x1b, 0xf0, 0x13, 0x51, 0x42, 0x72, 0x97, 0x9d, 0xf6, 0x68, 0x10, 0x8f, 0xe9, 0x1a,
+	0xce, 0x7a, 0x8c, 0x1a, 0x4f, 0x98, 0x90, 0x0f, 0x54, 0xbf, 0x0f, 0x23, 0x99, 0x30, 0x10, 0x0e,
+	0xbc, 0x9f, 0x80, 0x90, 0xb8, 0x85, 0x76, 0x22, 0x1a, 0x82, 0x88, 0xa9, 0x0b, 0xa6, 0xd1, 0x31,
+	0xba, 0x3b, 0x4e, 0xe9, 0xc0, 0x18, 0x6d, 0xa9, 0x0f, 0xb3, 0xa2, 0x03, 0xda, 0xb6, 0x7e, 0x57,
+	0x10, 0x2a, 0x48, 0x53, 0x05, 0xd0, 0x3a, 0x3c, 0xa5, 0x61, 0x01, 0x28, 0x1c, 0xb8, 0x8e, 0xaa,
+	0x3e, 0x4c, 0x17, 0xf5, 0xca, 0xc4, 0x97, 0x51, 0x2d, 0xe2, 0x23, 0x38, 0x18, 0x9a, 0x55, 0xed,
+	0x5c, 0x7c, 0x61, 0x17, 0x5d, 0xe0, 0x13, 0x19, 0x4f, 0xa4, 0x30, 0xb7, 0x3a, 0x46, 0x77, 0xb7,
+	0x7f, 0x40, 0x4a, 0x11, 0x48, 0x2e, 0x82, 0x36, 0xde, 0x14, 0x22, 0x90, 0x74, 0x40, 0x62, 0xdf,
+	0x23, 0x4a, 0x07, 0x92, 0x7b, 0x49, 0xae, 0x03, 0x39, 0xca, 0x80, 0x4e, 0x4e, 0xc6, 0x2f, 0xd1,
+	0x25, 0x37, 0x01, 0x3d, 0xa0, 0xe7, 0x2c, 0x04, 0x21, 0x69, 0x18, 0x9b, 0xdb, 0xfa, 0xb8, 0x3d,
+	0x92, 0x4d, 0x8a, 0x2c, 0x4f, 0xaa, 0x84, 0xab, 0x49, 0x91, 0xb4, 0x47, 0x54, 0x99, 0xb3, 0x0e,
+	0xc1, 0x2f, 0x50, 0x3d, 0xa0, 0x42, 0x3e, 0x62, 0xb2, 0x04, 0xd7, 0xfe, 0x19, 0xbc, 0xc6, 0xb0,
+	0x86, 0xe8, 0x62, 0x29, 0xb6, 0x1a, 0x22, 0xee, 0xa3, 0x6d, 0x26, 0x21, 0x14, 0xa6, 0xd1, 0xa9,
+	0x76, 0x77, 0xfb, 0x2d, 0xb2, 0xba, 0x91, 0xa4, 0x2c, 0x70, 0xb2, 0x54, 0xeb, 0x35, 0x6a, 0x0c,
+	0x21, 0x00, 0x09, 0x4b, 0xa1, 0xff, 0x5d, 0x80, 0x7c, 0xa6, 0xd5, 0x62, 0xa6, 0xd6, 0x55, 0x74,
+	0xa5, 0x04, 0x67, 0x07, 0x8d, 0x1c, 0x10, 0x31, 0x8f, 0x04, 0xf4, 0x7f, 0x54, 0x50, 0xe3, 0xb0,
+	0x6c, 0x51, 0x27, 0x3e, 0x83, 0x24, 0x65, 0x2e, 0xe0, 0x4f, 0x06, 0xaa, 0xaf, 0x6e, 0x26, 0xbe,
+	0xbe, 0x7e, 0xa3, 0x0d, 0xdb, 0xdb, 0xec, 0x9c, 0x75, 0x79, 0x55, 0x64, 0x91, 0x8f, 0xdf, 0x7f,
+	0x7d, 0xae, 0x74, 0xf1, 0x35, 0xfd, 0x7f, 0xa6, 0xbd, 0xf5, 0x1f, 0x5b, 0xd8, 0xa7, 0xc5, 0x7d,
+	0x67, 0xf8, 0x8b, 0x81, 0xea, 0xab, 0x52, 0xfd, 0xad, 0xa3, 0x0d, 0x72, 0x36, 0x6f, 0x9c, 0xd5,
+	0xd1, 0x8a, 0x34, 0xd6, 0x5d, 0xdd, 0xdc, 0xad, 0xbd, 0xc1, 0xf9, 0x9a, 0xcb, 0xec, 0x99, 0x7d,
+	0xea, 0xc3, 0x74, 0x76, 0xff, 0xe8, 0xeb, 0xbc, 0x6d, 0x7c, 0x9b, 0xb7, 0x8d, 0x9f, 0xf3, 0xb6,
+	0xf1, 0xea, 0xde, 0xf9, 0x5f, 0x8c, 0x0d, 0x4f, 0xde, 0x71, 0x4d, 0x3f, 0x16, 0x83, 0x3f, 0x03,
+	0x00, 0x4f, 0xa0, 0x66, 0x3a, 0x1b, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MemoizationCacheServiceClient is the client API for MemoizationCacheService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MemoizationCacheServiceClient interface {
+	ListCacheEntries(ctx context.Context, in *ListCacheEntriesRequest, opts ...grpc.CallOption) (*CacheEntryList, error)
+	DeleteCacheEntry(ctx context.Context, in *DeleteCacheEntryRequest, opts ...grpc.CallOption) (*CacheEntryDeletedResponse, error)
+}
+
+type memoizationCacheServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMemoizationCacheServiceClient(cc *grpc.ClientConn) MemoizationCacheServiceClient {
+	return &memoizationCacheServiceClient{cc}
+}
+
+func (c *memoizationCacheServiceClient) ListCacheEntries(ctx context.Context, in *ListCacheEntriesRequest, opts ...grpc.CallOption) (*CacheEntryList, error) {
+	out := new(CacheEntryList)
+	err := c.cc.Invoke(ctx, "/memoizationcache.MemoizationCacheService/ListCacheEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoizationCacheServiceClient) DeleteCacheEntry(ctx context.Context, in *DeleteCacheEntryRequest, opts ...grpc.CallOption) (*CacheEntryDeletedResponse, error) {
+	out := new(CacheEntryDeletedResponse)
+	err := c.cc.Invoke(ctx, "/memoizationcache.MemoizationCacheService/DeleteCacheEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MemoizationCacheServiceServer is the server API for MemoizationCacheService service.
+type MemoizationCacheServiceServer interface {
+	ListCacheEntries(context.Context, *ListCacheEntriesRequest) (*CacheEntryList, error)
+	DeleteCacheEntry(context.Context, *DeleteCacheEntryRequest) (*CacheEntryDeletedResponse, error)
+}
+
+// UnimplementedMemoizationCacheServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedMemoizationCacheServiceServer struct {
+}
+
+func (*UnimplementedMemoizationCacheServiceServer) ListCacheEntries(ctx context.Context, req *ListCacheEntriesRequest) (*CacheEntryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCacheEntries not implemented")
+}
+func (*UnimplementedMemoizationCacheServiceServer) DeleteCacheEntry(ctx context.Context, req *DeleteCacheEntryRequest) (*CacheEntryDeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCacheEntry not implemented")
+}
+
+func RegisterMemoizationCacheServiceServer(s *grpc.Server, srv MemoizationCacheServiceServer) {
+	s.RegisterService(&_MemoizationCacheService_serviceDesc, srv)
+}
+
+func _MemoizationCacheService_ListCacheEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCacheEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoizationCacheServiceServer).ListCacheEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/memoizationcache.MemoizationCacheService/ListCacheEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoizationCacheServiceServer).ListCacheEntries(ctx, req.(*ListCacheEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoizationCacheService_DeleteCacheEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCacheEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoizationCacheServiceServer).DeleteCacheEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/memoizationcache.MemoizationCacheService/DeleteCacheEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoizationCacheServiceServer).DeleteCacheEntry(ctx, req.(*DeleteCacheEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MemoizationCacheService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "memoizationcache.MemoizationCacheService",
+	HandlerType: (*MemoizationCacheServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCacheEntries",
+			Handler:    _MemoizationCacheService_ListCacheEntries_Handler,
+		},
+		{
+			MethodName: "DeleteCacheEntry",
+			Handler:    _MemoizationCacheService_DeleteCacheEntry_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/apiclient/memoizationcache/memoization-cache.proto",
+}
+
+func (m *ListCacheEntriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListCacheEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListCacheEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CacheEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastHitTimestamp != nil {
+		{
+			size, err := m.LastHitTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMemoizationCache(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CreationTimestamp != nil {
+		{
+			size, err := m.CreationTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMemoizationCache(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Outputs != nil {
+		{
+			size, err := m.Outputs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMemoizationCache(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NodeID) > 0 {
+		i -= len(m.NodeID)
+		copy(dAtA[i:], m.NodeID)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.NodeID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CacheName) > 0 {
+		i -= len(m.CacheName)
+		copy(dAtA[i:], m.CacheName)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.CacheName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CacheEntryList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheEntryList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheEntryList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMemoizationCache(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteCacheEntryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteCacheEntryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteCacheEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CacheEntryDeletedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheEntryDeletedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheEntryDeletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMemoizationCache(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMemoizationCache(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ListCacheEntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CacheEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CacheName)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.NodeID)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	if m.Outputs != nil {
+		l = m.Outputs.Size()
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	if m.CreationTimestamp != nil {
+		l = m.CreationTimestamp.Size()
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	if m.LastHitTimestamp != nil {
+		l = m.LastHitTimestamp.Size()
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CacheEntryList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovMemoizationCache(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteCacheEntryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CacheEntryDeletedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovMemoizationCache(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMemoizationCache(x uint64) (n int) {
+	return sovMemoizationCache(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ListCacheEntriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMemoizationCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListCacheEntriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListCacheEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMemoizationCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CacheEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMemoizationCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CacheEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CacheEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Outputs == nil {
+				m.Outputs = &v1alpha1.Outputs{}
+			}
+			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreationTimestamp == nil {
+				m.CreationTimestamp = &v1.Time{}
+			}
+			if err := m.CreationTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHitTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastHitTimestamp == nil {
+				m.LastHitTimestamp = &v1.Time{}
+			}
+			if err := m.LastHitTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMemoizationCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CacheEntryList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMemoizationCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CacheEntryList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CacheEntryList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &CacheEntry{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMemoizationCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteCacheEntryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMemoizationCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteCacheEntryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteCacheEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMemoizationCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CacheEntryDeletedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMemoizationCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CacheEntryDeletedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CacheEntryDeletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMemoizationCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMemoizationCache(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMemoizationCache
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMemoizationCache
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMemoizationCache
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMemoizationCache
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMemoizationCache        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMemoizationCache          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMemoizationCache = fmt.Errorf("proto: unexpected end of group")
+)
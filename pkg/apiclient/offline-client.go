@@ -6,7 +6,9 @@ import (
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
+	executorpluginpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/executorplugin"
 	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
@@ -135,6 +137,14 @@ func (c *offlineClient) NewInfoServiceClient() (infopkg.InfoServiceClient, error
 	return nil, ErrNoArgoServer
 }
 
+func (c *offlineClient) NewMemoizationCacheServiceClient() (memoizationcachepkg.MemoizationCacheServiceClient, error) {
+	return nil, ErrNoArgoServer
+}
+
+func (c *offlineClient) NewExecutorPluginServiceClient() (executorpluginpkg.ExecutorPluginServiceClient, error) {
+	return nil, ErrNoArgoServer
+}
+
 type offlineWorkflowTemplateNamespacedGetter struct {
 	namespace         string
 	workflowTemplates map[string]*wfv1.WorkflowTemplate
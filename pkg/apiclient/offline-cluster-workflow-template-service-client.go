@@ -45,3 +45,7 @@ func (o OfflineClusterWorkflowTemplateServiceClient) LintClusterWorkflowTemplate
 	}
 	return req.Template, nil
 }
+
+func (o OfflineClusterWorkflowTemplateServiceClient) ListClusterWorkflowTemplateRevisions(_ context.Context, req *clusterworkflowtmplpkg.ClusterWorkflowTemplateRevisionsRequest, _ ...grpc.CallOption) (*v1alpha1.WorkflowTemplateRevisionList, error) {
+	return nil, ErrOffline
+}
@@ -89,3 +89,7 @@ func (o OfflineWorkflowServiceClient) WorkflowLogs(context.Context, *workflowpkg
 func (o OfflineWorkflowServiceClient) SubmitWorkflow(context.Context, *workflowpkg.WorkflowSubmitRequest, ...grpc.CallOption) (*wfv1.Workflow, error) {
 	return nil, ErrOffline
 }
+
+func (o OfflineWorkflowServiceClient) DiffWorkflows(context.Context, *workflowpkg.WorkflowDiffRequest, ...grpc.CallOption) (*workflowpkg.WorkflowDiffResponse, error) {
+	return nil, ErrOffline
+}
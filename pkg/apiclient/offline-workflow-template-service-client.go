@@ -45,3 +45,7 @@ func (o OfflineWorkflowTemplateServiceClient) LintWorkflowTemplate(ctx context.C
 	}
 	return req.Template, nil
 }
+
+func (o OfflineWorkflowTemplateServiceClient) ListWorkflowTemplateRevisions(_ context.Context, req *workflowtemplatepkg.WorkflowTemplateRevisionsRequest, _ ...grpc.CallOption) (*v1alpha1.WorkflowTemplateRevisionList, error) {
+	return nil, ErrOffline
+}
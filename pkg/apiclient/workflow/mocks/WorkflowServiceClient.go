@@ -214,6 +214,93 @@ func (_c *WorkflowServiceClient_DeleteWorkflow_Call) RunAndReturn(run func(ctx c
 	return _c
 }
 
+// DiffWorkflows provides a mock function for the type WorkflowServiceClient
+func (_mock *WorkflowServiceClient) DiffWorkflows(ctx context.Context, in *workflow.WorkflowDiffRequest, opts ...grpc.CallOption) (*workflow.WorkflowDiffResponse, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffWorkflows")
+	}
+
+	var r0 *workflow.WorkflowDiffResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowDiffRequest, ...grpc.CallOption) (*workflow.WorkflowDiffResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowDiffRequest, ...grpc.CallOption) *workflow.WorkflowDiffResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workflow.WorkflowDiffResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowDiffRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorkflowServiceClient_DiffWorkflows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffWorkflows'
+type WorkflowServiceClient_DiffWorkflows_Call struct {
+	*mock.Call
+}
+
+// DiffWorkflows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *workflow.WorkflowDiffRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkflowServiceClient_Expecter) DiffWorkflows(ctx interface{}, in interface{}, opts ...interface{}) *WorkflowServiceClient_DiffWorkflows_Call {
+	return &WorkflowServiceClient_DiffWorkflows_Call{Call: _e.mock.On("DiffWorkflows",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkflowServiceClient_DiffWorkflows_Call) Run(run func(ctx context.Context, in *workflow.WorkflowDiffRequest, opts ...grpc.CallOption)) *WorkflowServiceClient_DiffWorkflows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *workflow.WorkflowDiffRequest
+		if args[1] != nil {
+			arg1 = args[1].(*workflow.WorkflowDiffRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *WorkflowServiceClient_DiffWorkflows_Call) Return(workflowDiffResponse *workflow.WorkflowDiffResponse, err error) *WorkflowServiceClient_DiffWorkflows_Call {
+	_c.Call.Return(workflowDiffResponse, err)
+	return _c
+}
+
+func (_c *WorkflowServiceClient_DiffWorkflows_Call) RunAndReturn(run func(ctx context.Context, in *workflow.WorkflowDiffRequest, opts ...grpc.CallOption) (*workflow.WorkflowDiffResponse, error)) *WorkflowServiceClient_DiffWorkflows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWorkflow provides a mock function for the type WorkflowServiceClient
 func (_mock *WorkflowServiceClient) GetWorkflow(ctx context.Context, in *workflow.WorkflowGetRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	// grpc.CallOption
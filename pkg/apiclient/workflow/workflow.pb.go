@@ -490,8 +490,12 @@ func (m *WorkflowResumeRequest) GetNodeFieldSelector() string {
 }
 
 type WorkflowTerminateRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Reason is why the workflow is terminated, recorded in its status and events
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// GracePeriod is how long pods have to exit once signalled, e.g. "30s", before they are deleted
+	GracePeriod          string   `protobuf:"bytes,4,opt,name=gracePeriod,proto3" json:"gracePeriod,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -544,11 +548,31 @@ func (m *WorkflowTerminateRequest) GetNamespace() string {
 	return ""
 }
 
+func (m *WorkflowTerminateRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *WorkflowTerminateRequest) GetGracePeriod() string {
+	if m != nil {
+		return m.GracePeriod
+	}
+	return ""
+}
+
 type WorkflowStopRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	NodeFieldSelector    string   `protobuf:"bytes,3,opt,name=nodeFieldSelector,proto3" json:"nodeFieldSelector,omitempty"`
-	Message              string   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Name              string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace         string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NodeFieldSelector string `protobuf:"bytes,3,opt,name=nodeFieldSelector,proto3" json:"nodeFieldSelector,omitempty"`
+	Message           string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Reason is why the workflow is stopped, recorded in its status and events
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// GracePeriod is how long pods have to exit once signalled, e.g. "30s", before they are deleted
+	GracePeriod string `protobuf:"bytes,6,opt,name=gracePeriod,proto3" json:"gracePeriod,omitempty"`
+	// Subtree stops the nodes selected by the node field selector and their descendants, letting the other nodes finish
+	Subtree              bool     `protobuf:"varint,7,opt,name=subtree,proto3" json:"subtree,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -615,16 +639,39 @@ func (m *WorkflowStopRequest) GetMessage() string {
 	return ""
 }
 
+func (m *WorkflowStopRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *WorkflowStopRequest) GetGracePeriod() string {
+	if m != nil {
+		return m.GracePeriod
+	}
+	return ""
+}
+
+func (m *WorkflowStopRequest) GetSubtree() bool {
+	if m != nil {
+		return m.Subtree
+	}
+	return false
+}
+
 type WorkflowSetRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	NodeFieldSelector    string   `protobuf:"bytes,3,opt,name=nodeFieldSelector,proto3" json:"nodeFieldSelector,omitempty"`
-	Message              string   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	Phase                string   `protobuf:"bytes,5,opt,name=phase,proto3" json:"phase,omitempty"`
-	OutputParameters     string   `protobuf:"bytes,6,opt,name=outputParameters,proto3" json:"outputParameters,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Name              string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace         string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NodeFieldSelector string `protobuf:"bytes,3,opt,name=nodeFieldSelector,proto3" json:"nodeFieldSelector,omitempty"`
+	Message           string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Phase             string `protobuf:"bytes,5,opt,name=phase,proto3" json:"phase,omitempty"`
+	OutputParameters  string `protobuf:"bytes,6,opt,name=outputParameters,proto3" json:"outputParameters,omitempty"`
+	// AllowUndeclaredOutputParameters adds output parameters that a suspend node does not declare.
+	AllowUndeclaredOutputParameters bool     `protobuf:"varint,7,opt,name=allowUndeclaredOutputParameters,proto3" json:"allowUndeclaredOutputParameters,omitempty"`
+	XXX_NoUnkeyedLiteral            struct{} `json:"-"`
+	XXX_unrecognized                []byte   `json:"-"`
+	XXX_sizecache                   int32    `json:"-"`
 }
 
 func (m *WorkflowSetRequest) Reset()         { *m = WorkflowSetRequest{} }
@@ -702,9 +749,18 @@ func (m *WorkflowSetRequest) GetOutputParameters() string {
 	return ""
 }
 
+func (m *WorkflowSetRequest) GetAllowUndeclaredOutputParameters() bool {
+	if m != nil {
+		return m.AllowUndeclaredOutputParameters
+	}
+	return false
+}
+
 type WorkflowSuspendRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// ResumeAt is the RFC3339 time at which the controller resumes the workflow. If empty, the workflow stays suspended until it is resumed.
+	ResumeAt             string   `protobuf:"bytes,3,opt,name=resumeAt,proto3" json:"resumeAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -757,6 +813,13 @@ func (m *WorkflowSuspendRequest) GetNamespace() string {
 	return ""
 }
 
+func (m *WorkflowSuspendRequest) GetResumeAt() string {
+	if m != nil {
+		return m.ResumeAt
+	}
+	return ""
+}
+
 type WorkflowLogRequest struct {
 	Name                 string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace            string             `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -1310,6 +1373,300 @@ func (m *WorkflowSubmitRequest) GetSubmitOptions() *v1alpha1.SubmitOpts {
 	return nil
 }
 
+type WorkflowDiffRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Name is the workflow to compare, which may be archived
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// OtherName is the workflow to compare it to, which may be archived. Defaults to the workflow it was resubmitted from.
+	OtherName            string   `protobuf:"bytes,3,opt,name=otherName,proto3" json:"otherName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowDiffRequest) Reset()         { *m = WorkflowDiffRequest{} }
+func (m *WorkflowDiffRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowDiffRequest) ProtoMessage()    {}
+func (*WorkflowDiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{19}
+}
+func (m *WorkflowDiffRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowDiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowDiffRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowDiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowDiffRequest.Merge(m, src)
+}
+func (m *WorkflowDiffRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowDiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowDiffRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowDiffRequest proto.InternalMessageInfo
+
+func (m *WorkflowDiffRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *WorkflowDiffRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WorkflowDiffRequest) GetOtherName() string {
+	if m != nil {
+		return m.OtherName
+	}
+	return ""
+}
+
+type WorkflowSpecChange struct {
+	// Path is the path of the field that changed, e.g. "spec.templates[name=main].container.image"
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Old is the JSON of the field in the other workflow, empty if it is not set
+	Old string `protobuf:"bytes,2,opt,name=old,proto3" json:"old,omitempty"`
+	// New is the JSON of the field in the workflow, empty if it is not set
+	New                  string   `protobuf:"bytes,3,opt,name=new,proto3" json:"new,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowSpecChange) Reset()         { *m = WorkflowSpecChange{} }
+func (m *WorkflowSpecChange) String() string { return proto.CompactTextString(m) }
+func (*WorkflowSpecChange) ProtoMessage()    {}
+func (*WorkflowSpecChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{20}
+}
+func (m *WorkflowSpecChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowSpecChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowSpecChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowSpecChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowSpecChange.Merge(m, src)
+}
+func (m *WorkflowSpecChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowSpecChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowSpecChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowSpecChange proto.InternalMessageInfo
+
+func (m *WorkflowSpecChange) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *WorkflowSpecChange) GetOld() string {
+	if m != nil {
+		return m.Old
+	}
+	return ""
+}
+
+func (m *WorkflowSpecChange) GetNew() string {
+	if m != nil {
+		return m.New
+	}
+	return ""
+}
+
+type WorkflowNodeChange struct {
+	// Name is the name of the node relative to its workflow, e.g. ".a[0].b", empty for the root node
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName string `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	// OldPhase is the phase of the node in the other workflow, empty if it has no such node
+	OldPhase string `protobuf:"bytes,3,opt,name=oldPhase,proto3" json:"oldPhase,omitempty"`
+	// NewPhase is the phase of the node in the workflow, empty if it has no such node
+	NewPhase             string   `protobuf:"bytes,4,opt,name=newPhase,proto3" json:"newPhase,omitempty"`
+	OldMessage           string   `protobuf:"bytes,5,opt,name=oldMessage,proto3" json:"oldMessage,omitempty"`
+	NewMessage           string   `protobuf:"bytes,6,opt,name=newMessage,proto3" json:"newMessage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowNodeChange) Reset()         { *m = WorkflowNodeChange{} }
+func (m *WorkflowNodeChange) String() string { return proto.CompactTextString(m) }
+func (*WorkflowNodeChange) ProtoMessage()    {}
+func (*WorkflowNodeChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{21}
+}
+func (m *WorkflowNodeChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowNodeChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowNodeChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowNodeChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowNodeChange.Merge(m, src)
+}
+func (m *WorkflowNodeChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowNodeChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowNodeChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowNodeChange proto.InternalMessageInfo
+
+func (m *WorkflowNodeChange) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WorkflowNodeChange) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *WorkflowNodeChange) GetOldPhase() string {
+	if m != nil {
+		return m.OldPhase
+	}
+	return ""
+}
+
+func (m *WorkflowNodeChange) GetNewPhase() string {
+	if m != nil {
+		return m.NewPhase
+	}
+	return ""
+}
+
+func (m *WorkflowNodeChange) GetOldMessage() string {
+	if m != nil {
+		return m.OldMessage
+	}
+	return ""
+}
+
+func (m *WorkflowNodeChange) GetNewMessage() string {
+	if m != nil {
+		return m.NewMessage
+	}
+	return ""
+}
+
+type WorkflowDiffResponse struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	OtherName string `protobuf:"bytes,2,opt,name=otherName,proto3" json:"otherName,omitempty"`
+	// SpecChanges are the changes to the spec that the workflows ran
+	SpecChanges []*WorkflowSpecChange `protobuf:"bytes,3,rep,name=specChanges,proto3" json:"specChanges,omitempty"`
+	// NodeChanges are the nodes whose phase changed
+	NodeChanges          []*WorkflowNodeChange `protobuf:"bytes,4,rep,name=nodeChanges,proto3" json:"nodeChanges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *WorkflowDiffResponse) Reset()         { *m = WorkflowDiffResponse{} }
+func (m *WorkflowDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*WorkflowDiffResponse) ProtoMessage()    {}
+func (*WorkflowDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{22}
+}
+func (m *WorkflowDiffResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowDiffResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowDiffResponse.Merge(m, src)
+}
+func (m *WorkflowDiffResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowDiffResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowDiffResponse proto.InternalMessageInfo
+
+func (m *WorkflowDiffResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WorkflowDiffResponse) GetOtherName() string {
+	if m != nil {
+		return m.OtherName
+	}
+	return ""
+}
+
+func (m *WorkflowDiffResponse) GetSpecChanges() []*WorkflowSpecChange {
+	if m != nil {
+		return m.SpecChanges
+	}
+	return nil
+}
+
+func (m *WorkflowDiffResponse) GetNodeChanges() []*WorkflowNodeChange {
+	if m != nil {
+		return m.NodeChanges
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*WorkflowCreateRequest)(nil), "workflow.WorkflowCreateRequest")
 	proto.RegisterType((*WorkflowGetRequest)(nil), "workflow.WorkflowGetRequest")
@@ -1330,6 +1687,10 @@ func init() {
 	proto.RegisterType((*LogEntry)(nil), "workflow.LogEntry")
 	proto.RegisterType((*WorkflowLintRequest)(nil), "workflow.WorkflowLintRequest")
 	proto.RegisterType((*WorkflowSubmitRequest)(nil), "workflow.WorkflowSubmitRequest")
+	proto.RegisterType((*WorkflowDiffRequest)(nil), "workflow.WorkflowDiffRequest")
+	proto.RegisterType((*WorkflowSpecChange)(nil), "workflow.WorkflowSpecChange")
+	proto.RegisterType((*WorkflowNodeChange)(nil), "workflow.WorkflowNodeChange")
+	proto.RegisterType((*WorkflowDiffResponse)(nil), "workflow.WorkflowDiffResponse")
 }
 
 func init() {
@@ -1337,100 +1698,119 @@ func init() {
 }
 
 var fileDescriptor_1f6bb75f9e833cb6 = []byte{
-	// 1486 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xcd, 0x8f, 0x14, 0x45,
-	0x1b, 0xc0, 0x53, 0xb3, 0xb0, 0x2c, 0xb5, 0x1f, 0x40, 0xbd, 0xc0, 0x3b, 0x6f, 0x07, 0x96, 0xa5,
-	0x78, 0xc1, 0x65, 0x61, 0xbb, 0xf7, 0x03, 0x15, 0x4c, 0x34, 0x01, 0x16, 0x36, 0xe2, 0x88, 0x64,
-	0xc6, 0xc4, 0xe8, 0xc5, 0xf4, 0xf6, 0x3c, 0xd3, 0xdb, 0x6c, 0x4f, 0x57, 0x5b, 0x55, 0x33, 0x64,
-	0x45, 0x4c, 0xf4, 0xa2, 0x07, 0x12, 0x0f, 0x1e, 0xbd, 0x99, 0x18, 0x3d, 0x18, 0x35, 0x26, 0x26,
-	0x46, 0x13, 0xe3, 0xc1, 0x83, 0x47, 0x12, 0xae, 0x1e, 0x0c, 0xf1, 0x1f, 0xf0, 0x3f, 0x30, 0x55,
-	0xfd, 0xbd, 0x33, 0x0c, 0xed, 0xee, 0xa0, 0xdc, 0xaa, 0xaa, 0xab, 0xea, 0xf9, 0xd5, 0xf3, 0x54,
-	0x3d, 0x1f, 0x33, 0xf8, 0x64, 0xb8, 0xe1, 0x5a, 0x76, 0xe8, 0x39, 0xbe, 0x07, 0x81, 0xb4, 0x6e,
-	0x31, 0xbe, 0xd1, 0xf2, 0xd9, 0xad, 0xb4, 0x61, 0x86, 0x9c, 0x49, 0x46, 0xc6, 0x92, 0xbe, 0x71,
-	0xc4, 0x65, 0xcc, 0xf5, 0x41, 0xad, 0xb1, 0xec, 0x20, 0x60, 0xd2, 0x96, 0x1e, 0x0b, 0x44, 0x34,
-	0xcf, 0x38, 0xb7, 0x71, 0x5e, 0x98, 0x1e, 0x53, 0x5f, 0xdb, 0xb6, 0xb3, 0xee, 0x05, 0xc0, 0x37,
-	0xad, 0x58, 0x84, 0xb0, 0xda, 0x20, 0x6d, 0xab, 0xbb, 0x68, 0xb9, 0x10, 0x00, 0xb7, 0x25, 0x34,
-	0xe3, 0x55, 0x2f, 0xbb, 0x9e, 0x5c, 0xef, 0xac, 0x99, 0x0e, 0x6b, 0x5b, 0x36, 0x77, 0x59, 0xc8,
-	0xd9, 0x4d, 0xdd, 0x98, 0x4f, 0xc4, 0x8a, 0x6c, 0x93, 0x14, 0xb1, 0xbb, 0x68, 0xfb, 0xe1, 0xba,
-	0xdd, 0xbb, 0x1d, 0xcd, 0x20, 0x2c, 0x87, 0x71, 0xe8, 0x23, 0x92, 0xfe, 0x5c, 0xc1, 0x87, 0x5e,
-	0x8b, 0x77, 0xba, 0xcc, 0xc1, 0x96, 0x50, 0x87, 0xb7, 0x3a, 0x20, 0x24, 0x39, 0x82, 0xf7, 0x06,
-	0x76, 0x1b, 0x44, 0x68, 0x3b, 0x50, 0x45, 0x33, 0x68, 0x76, 0x6f, 0x3d, 0x1b, 0x20, 0x2d, 0x9c,
-	0xaa, 0xa2, 0x5a, 0x99, 0x41, 0xb3, 0xe3, 0x4b, 0xd7, 0xcc, 0x8c, 0xde, 0x4c, 0xe8, 0x75, 0xe3,
-	0xcd, 0x94, 0xde, 0xec, 0x2e, 0x9b, 0xe1, 0x86, 0x6b, 0xaa, 0x03, 0x98, 0xa9, 0x6a, 0x93, 0x03,
-	0x98, 0x09, 0x48, 0x3d, 0xdd, 0x9b, 0x50, 0x8c, 0xbd, 0x40, 0x48, 0x3b, 0x70, 0xe0, 0xc5, 0x95,
-	0xea, 0x88, 0xc2, 0xb8, 0x54, 0xa9, 0xa2, 0x7a, 0x6e, 0x94, 0x50, 0x3c, 0x21, 0x80, 0x77, 0x81,
-	0xaf, 0xf0, 0xcd, 0x7a, 0x27, 0xa8, 0xee, 0x9a, 0x41, 0xb3, 0x63, 0xf5, 0xc2, 0x18, 0x79, 0x1d,
-	0x4f, 0x3a, 0xfa, 0x78, 0xaf, 0x84, 0xda, 0x4e, 0xd5, 0xdd, 0x1a, 0x7a, 0xd9, 0x8c, 0x74, 0x64,
-	0xe6, 0x0d, 0x95, 0x21, 0x2a, 0x43, 0x99, 0xdd, 0x45, 0xf3, 0x72, 0x7e, 0x69, 0xbd, 0xb8, 0x13,
-	0xfd, 0x06, 0x61, 0x92, 0x90, 0xaf, 0x82, 0x4c, 0xf4, 0x47, 0xf0, 0x2e, 0xa5, 0xae, 0x58, 0x75,
-	0xba, 0x5d, 0xd4, 0x69, 0x65, 0xab, 0x4e, 0x6f, 0x60, 0xec, 0x82, 0x4c, 0x00, 0x47, 0x34, 0xe0,
-	0x42, 0x39, 0xc0, 0xd5, 0x74, 0x5d, 0x3d, 0xb7, 0x07, 0x39, 0x8c, 0x47, 0x5b, 0x1e, 0xf8, 0x4d,
-	0xa1, 0x75, 0xb2, 0xb7, 0x1e, 0xf7, 0xe8, 0xdd, 0x0a, 0xfe, 0x4f, 0x82, 0x5c, 0xf3, 0x84, 0x2c,
-	0x67, 0xf3, 0x06, 0x1e, 0xf7, 0x3d, 0x91, 0x02, 0x46, 0x66, 0x5f, 0x2c, 0x07, 0x58, 0xcb, 0x16,
-	0xd6, 0xf3, 0xbb, 0xe4, 0x10, 0x47, 0xf2, 0x88, 0x64, 0x1a, 0x63, 0x25, 0xf9, 0xaa, 0xe7, 0x4b,
-	0xe0, 0x31, 0x7e, 0x6e, 0x44, 0x19, 0x3d, 0x32, 0x43, 0xf3, 0x62, 0x4b, 0xcd, 0xd8, 0xad, 0x67,
-	0x14, 0xc6, 0xc8, 0x29, 0x3c, 0xd5, 0xf2, 0x02, 0x4f, 0xac, 0x43, 0xf3, 0x12, 0xb4, 0x18, 0x87,
-	0xea, 0xa8, 0x9e, 0xb5, 0x65, 0x94, 0x7e, 0x80, 0xf0, 0x7f, 0xd3, 0xbb, 0x07, 0xa2, 0xb3, 0xd6,
-	0xf6, 0x76, 0x60, 0x46, 0x03, 0x8f, 0xb5, 0xa1, 0xcd, 0xbc, 0xb7, 0xa1, 0xa9, 0xcf, 0x34, 0x56,
-	0x4f, 0xfb, 0xea, 0x54, 0xa1, 0xcd, 0xed, 0x36, 0x48, 0xe0, 0xea, 0x0e, 0x8e, 0xa8, 0x53, 0x65,
-	0x23, 0xf4, 0x17, 0x84, 0x0f, 0x66, 0x24, 0x92, 0x6f, 0x6e, 0x1f, 0xe3, 0x2c, 0x3e, 0xc0, 0x41,
-	0x48, 0x9b, 0xcb, 0x46, 0xc7, 0x71, 0x40, 0x88, 0x56, 0xc7, 0x8f, 0x79, 0x7a, 0x3f, 0xa8, 0xd9,
-	0x01, 0x6b, 0xc2, 0x55, 0xa5, 0xfc, 0x06, 0xf8, 0xe0, 0x48, 0x96, 0x68, 0xbd, 0xf7, 0xc3, 0x23,
-	0x8f, 0x71, 0x2b, 0x73, 0x2a, 0x4a, 0x9f, 0x6d, 0xd8, 0xd1, 0x31, 0x7a, 0xc1, 0x46, 0x1e, 0x02,
-	0x46, 0x6b, 0xb8, 0x9a, 0x08, 0x7e, 0x15, 0x78, 0xdb, 0x0b, 0x72, 0x0e, 0xed, 0x6f, 0xcb, 0xa6,
-	0x1f, 0xa1, 0xec, 0x99, 0x34, 0x24, 0x0b, 0xff, 0xa1, 0x53, 0x90, 0x2a, 0xde, 0xd3, 0x06, 0x21,
-	0x6c, 0x17, 0x62, 0x13, 0x24, 0x5d, 0x7a, 0x2f, 0xe7, 0x6b, 0x1a, 0x3b, 0xf1, 0x35, 0x43, 0x02,
-	0x22, 0x07, 0xf1, 0xee, 0x70, 0xdd, 0x16, 0x10, 0xbf, 0xbf, 0xa8, 0x43, 0xe6, 0xf0, 0x7e, 0xd6,
-	0x91, 0x61, 0x47, 0xde, 0xc8, 0x6e, 0x49, 0xf4, 0xf4, 0x7a, 0xc6, 0xe9, 0x35, 0x7c, 0x38, 0x3d,
-	0x51, 0x47, 0x84, 0x10, 0x34, 0xb7, 0x6f, 0xb0, 0xfb, 0x39, 0xf5, 0xd4, 0x98, 0xbb, 0x7d, 0xf5,
-	0x54, 0xf1, 0x9e, 0x90, 0x35, 0xaf, 0xab, 0x45, 0x91, 0x52, 0x92, 0x2e, 0xb9, 0x88, 0xb1, 0xcf,
-	0xdc, 0xc4, 0x07, 0xee, 0xd2, 0x3e, 0xf0, 0x78, 0xce, 0x07, 0x9a, 0x2a, 0xd2, 0x2a, 0x8f, 0x77,
-	0x83, 0x35, 0x6b, 0xe9, 0xc4, 0x7a, 0x6e, 0x91, 0xc2, 0x71, 0x39, 0x84, 0xb1, 0xca, 0x74, 0x5b,
-	0x39, 0x0d, 0x91, 0x98, 0x21, 0xd2, 0x54, 0xda, 0xa7, 0x3f, 0xa0, 0xec, 0x39, 0xad, 0x80, 0x0f,
-	0x3b, 0xb8, 0xd2, 0x2a, 0x0e, 0x36, 0xf5, 0x16, 0xc5, 0x30, 0x53, 0x32, 0x0e, 0xae, 0xe4, 0x97,
-	0xd6, 0x8b, 0x3b, 0xa9, 0xab, 0xd0, 0x62, 0xdc, 0x81, 0x38, 0xfe, 0x46, 0x1d, 0x5a, 0xcd, 0xcc,
-	0x9b, 0xb0, 0x8b, 0x90, 0x05, 0x02, 0xe8, 0xa7, 0xea, 0x58, 0xb6, 0x74, 0xd6, 0x93, 0xef, 0xe2,
-	0xc9, 0x0b, 0x43, 0xf4, 0x6e, 0xee, 0x46, 0x69, 0xd8, 0x2b, 0x5d, 0x08, 0xb4, 0xe2, 0xe5, 0x66,
-	0x98, 0x2a, 0x5e, 0xb5, 0xc9, 0x1a, 0x1e, 0x65, 0x6b, 0x37, 0xc1, 0x91, 0x8f, 0x21, 0x21, 0x8a,
-	0x77, 0x56, 0x91, 0x8a, 0x64, 0x18, 0xff, 0xa2, 0xc2, 0xe8, 0x0b, 0x78, 0xac, 0xc6, 0xdc, 0x2b,
-	0x81, 0xe4, 0x9b, 0xea, 0xb5, 0x38, 0x2c, 0x90, 0x10, 0xc8, 0x58, 0x78, 0xd2, 0xcd, 0xbf, 0xa3,
-	0x4a, 0xe1, 0x1d, 0xd1, 0x4f, 0x50, 0x3e, 0x05, 0x09, 0xe4, 0x13, 0x95, 0x76, 0xd2, 0x3f, 0x73,
-	0x4f, 0xae, 0x51, 0xc8, 0x07, 0x06, 0xf3, 0x51, 0x3c, 0xc1, 0x41, 0xb0, 0x0e, 0x77, 0xe0, 0x25,
-	0x2f, 0x68, 0xc6, 0x87, 0x2e, 0x8c, 0xe5, 0xe7, 0xe4, 0x1c, 0x4c, 0x61, 0x8c, 0x70, 0x3c, 0x19,
-	0xa5, 0x21, 0x45, 0x47, 0x53, 0xdb, 0xf9, 0x61, 0x1b, 0xc9, 0xb6, 0xa2, 0x5e, 0x14, 0xb1, 0xf4,
-	0xdb, 0x21, 0xbc, 0x2f, 0x8b, 0x2d, 0xbc, 0xeb, 0x39, 0x40, 0x3e, 0x47, 0x78, 0x2a, 0x4a, 0x7e,
-	0x93, 0x2f, 0xe4, 0x58, 0xb6, 0x69, 0xdf, 0xc2, 0xc1, 0x18, 0xa2, 0x45, 0xe8, 0xec, 0xfb, 0xf7,
-	0xff, 0xf8, 0xb8, 0x42, 0xe9, 0x51, 0x5d, 0xc4, 0x74, 0x17, 0xad, 0xac, 0x10, 0xba, 0x9d, 0x6a,
-	0xfd, 0xce, 0x73, 0x68, 0x8e, 0x7c, 0x86, 0xf0, 0xf8, 0x2a, 0xc8, 0x14, 0xf3, 0x48, 0x2f, 0x66,
-	0x96, 0x9c, 0x0f, 0x95, 0xf1, 0xac, 0x66, 0x3c, 0x45, 0xfe, 0x3f, 0x90, 0x31, 0x6a, 0xdf, 0x51,
-	0x9c, 0x93, 0xea, 0x51, 0xa5, 0x4e, 0x8f, 0x1c, 0xed, 0x25, 0xcd, 0xe5, 0xe4, 0xc6, 0xf5, 0xe1,
-	0xa1, 0xaa, 0x6d, 0xe9, 0x49, 0x8d, 0x7b, 0x8c, 0x0c, 0x56, 0x29, 0x79, 0x17, 0x4f, 0x15, 0x9d,
-	0x73, 0xc1, 0xf0, 0xfd, 0xdc, 0xb6, 0xd1, 0x47, 0xe5, 0x99, 0xaf, 0xa2, 0x67, 0xb4, 0xdc, 0x93,
-	0xe4, 0xc4, 0x56, 0xb9, 0xf3, 0xa0, 0x7d, 0x59, 0x5e, 0xfa, 0x02, 0x22, 0x02, 0x8f, 0xe7, 0x1c,
-	0x5d, 0xc1, 0x9c, 0x3d, 0xfe, 0xcf, 0xf8, 0x5f, 0xbf, 0x00, 0x1c, 0x89, 0x3d, 0xad, 0xc5, 0x9e,
-	0x20, 0xc7, 0x13, 0xb1, 0x42, 0x72, 0xb0, 0xdb, 0x56, 0x5f, 0xa1, 0xef, 0x21, 0x3c, 0x15, 0x45,
-	0xa9, 0x41, 0xd7, 0xbd, 0x10, 0x83, 0x8d, 0x99, 0x87, 0x4f, 0x88, 0x03, 0x5d, 0x7c, 0x41, 0xe6,
-	0xca, 0x5d, 0x90, 0x6f, 0x11, 0x9e, 0xd4, 0xa9, 0x7f, 0x8a, 0x30, 0xdd, 0x2b, 0x21, 0x5f, 0x1b,
-	0x0c, 0xf5, 0x32, 0x3f, 0xad, 0x59, 0x2d, 0x63, 0xae, 0x0c, 0xab, 0xc5, 0x15, 0x86, 0x7a, 0x7d,
-	0x3f, 0x22, 0xbc, 0x3f, 0xa9, 0x9c, 0x52, 0xee, 0xe3, 0xfd, 0xb8, 0x0b, 0xd5, 0xd5, 0x50, 0xd1,
-	0xcf, 0x6b, 0xf4, 0x25, 0x63, 0xbe, 0x24, 0x7a, 0x44, 0xa2, 0xe8, 0xbf, 0x43, 0x78, 0x2a, 0xaa,
-	0x53, 0x06, 0x99, 0xbd, 0x50, 0xc9, 0x0c, 0x95, 0xfc, 0x19, 0x4d, 0xbe, 0x60, 0x9c, 0x29, 0x4d,
-	0xde, 0x06, 0xc5, 0xfd, 0x3d, 0xc2, 0xfb, 0xe2, 0x9c, 0x39, 0x05, 0xef, 0x73, 0x1d, 0x8b, 0x69,
-	0xf5, 0x50, 0xc9, 0x9f, 0xd5, 0xe4, 0x8b, 0xc6, 0xd9, 0x52, 0xe4, 0x22, 0x02, 0x51, 0xe8, 0x3f,
-	0x21, 0x7c, 0x20, 0xad, 0xd0, 0x52, 0x78, 0xda, 0x0b, 0xbf, 0xb5, 0x8c, 0x1b, 0x2a, 0xfe, 0x05,
-	0x8d, 0xbf, 0x6c, 0x98, 0xa5, 0xf0, 0x65, 0x82, 0xa2, 0x0e, 0xf0, 0x35, 0xc2, 0x13, 0xaa, 0x26,
-	0x4c, 0xd9, 0xfb, 0xb8, 0xf1, 0x5c, 0xcd, 0x38, 0x54, 0xec, 0x73, 0x1a, 0xdb, 0x34, 0x4e, 0x97,
-	0xd3, 0xba, 0x64, 0xa1, 0x22, 0xfe, 0x12, 0xe1, 0xf1, 0xc6, 0xe0, 0x08, 0xd9, 0x78, 0x3c, 0x11,
-	0x72, 0x59, 0xf3, 0xce, 0x1b, 0xb3, 0xe5, 0x78, 0x41, 0x3f, 0xca, 0x2f, 0x10, 0x9e, 0x50, 0x89,
-	0xe1, 0x20, 0x05, 0xe7, 0x12, 0xc7, 0xa1, 0x02, 0xcf, 0x6b, 0xe0, 0xa7, 0x28, 0x1d, 0x0c, 0xec,
-	0x7b, 0x81, 0x46, 0x7d, 0x07, 0xef, 0x89, 0xaa, 0x3d, 0xd1, 0x4f, 0xa9, 0x59, 0x21, 0x6a, 0x90,
-	0xec, 0x6b, 0x92, 0x3c, 0xd3, 0xe7, 0xb5, 0xac, 0x73, 0x64, 0xa9, 0x94, 0x72, 0x6e, 0xc7, 0xf9,
-	0xf3, 0x1d, 0xcb, 0x67, 0xee, 0x87, 0x15, 0xb4, 0x80, 0x88, 0xc4, 0x13, 0x39, 0x51, 0xdb, 0x41,
-	0x58, 0xd0, 0x08, 0x73, 0xa4, 0x9c, 0x7d, 0x7c, 0xe6, 0x2e, 0x20, 0xf2, 0x15, 0xc2, 0x53, 0x8d,
-	0xa2, 0xbf, 0x3f, 0xd6, 0xcf, 0xf5, 0x3c, 0x2e, 0x6f, 0x6f, 0x69, 0xe6, 0xd3, 0xf4, 0x11, 0x41,
-	0x35, 0x75, 0xf2, 0x97, 0x56, 0x7f, 0x7d, 0x30, 0x8d, 0xee, 0x3d, 0x98, 0x46, 0xbf, 0x3f, 0x98,
-	0x46, 0x6f, 0x5c, 0x28, 0xff, 0x53, 0xfb, 0x96, 0xbf, 0x04, 0xd6, 0x46, 0xf5, 0x2f, 0xe7, 0xcb,
-	0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x5f, 0x40, 0x23, 0x33, 0x18, 0x00, 0x00,
+	// 1780 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0x4f, 0x8f, 0x1c, 0x47,
+	0x15, 0xc0, 0x55, 0xb3, 0xf6, 0x7a, 0x5d, 0xb3, 0xbb, 0x71, 0x8a, 0xc4, 0x0c, 0x23, 0x67, 0xbd,
+	0xae, 0xe0, 0xb0, 0x5e, 0x7b, 0x7b, 0xf6, 0x8f, 0x81, 0xc4, 0x12, 0x91, 0x6c, 0x6f, 0x62, 0xfe,
+	0x6c, 0x9c, 0x55, 0x0f, 0x08, 0x85, 0x0b, 0xea, 0xed, 0x7e, 0xd3, 0xd3, 0x71, 0x4f, 0x57, 0x53,
+	0x55, 0x33, 0xab, 0x25, 0x04, 0x29, 0x1c, 0x80, 0x43, 0x6e, 0xdc, 0xe0, 0x86, 0x84, 0xe0, 0x80,
+	0x00, 0x21, 0x21, 0x21, 0x90, 0x10, 0x42, 0x1c, 0x72, 0x44, 0xca, 0x17, 0x88, 0x0c, 0x5f, 0x80,
+	0x6f, 0x80, 0xaa, 0xba, 0xab, 0xbb, 0x7a, 0x67, 0x76, 0xb6, 0xe5, 0x1d, 0x13, 0xdf, 0xea, 0x4f,
+	0x57, 0xbd, 0xdf, 0x7b, 0xf5, 0xea, 0xbd, 0xaa, 0x6a, 0x7c, 0x3d, 0x7d, 0x14, 0x76, 0xbc, 0x34,
+	0xf2, 0xe3, 0x08, 0x12, 0xd9, 0x39, 0x64, 0xfc, 0x51, 0x2f, 0x66, 0x87, 0x45, 0xc1, 0x49, 0x39,
+	0x93, 0x8c, 0x2c, 0x98, 0x7a, 0xfb, 0x4a, 0xc8, 0x58, 0x18, 0x83, 0x1a, 0xd3, 0xf1, 0x92, 0x84,
+	0x49, 0x4f, 0x46, 0x2c, 0x11, 0xd9, 0x77, 0xed, 0xdb, 0x8f, 0x5e, 0x15, 0x4e, 0xc4, 0x54, 0xef,
+	0xc0, 0xf3, 0xfb, 0x51, 0x02, 0xfc, 0xa8, 0x93, 0x8b, 0x10, 0x9d, 0x01, 0x48, 0xaf, 0x33, 0xda,
+	0xea, 0x84, 0x90, 0x00, 0xf7, 0x24, 0x04, 0xf9, 0xa8, 0xb7, 0xc2, 0x48, 0xf6, 0x87, 0x07, 0x8e,
+	0xcf, 0x06, 0x1d, 0x8f, 0x87, 0x2c, 0xe5, 0xec, 0x5d, 0x5d, 0xd8, 0x30, 0x62, 0x45, 0x39, 0x49,
+	0x81, 0x38, 0xda, 0xf2, 0xe2, 0xb4, 0xef, 0x8d, 0x4f, 0x47, 0x4b, 0x88, 0x8e, 0xcf, 0x38, 0x4c,
+	0x10, 0x49, 0xff, 0xde, 0xc0, 0x2f, 0x7e, 0x3b, 0x9f, 0xe9, 0x3e, 0x07, 0x4f, 0x82, 0x0b, 0xdf,
+	0x1b, 0x82, 0x90, 0xe4, 0x0a, 0xbe, 0x98, 0x78, 0x03, 0x10, 0xa9, 0xe7, 0x43, 0x0b, 0xad, 0xa2,
+	0xb5, 0x8b, 0x6e, 0xd9, 0x40, 0x7a, 0xb8, 0x30, 0x45, 0xab, 0xb1, 0x8a, 0xd6, 0x9a, 0xdb, 0x5f,
+	0x77, 0x4a, 0x7a, 0xc7, 0xd0, 0xeb, 0xc2, 0x77, 0x0b, 0x7a, 0x67, 0xb4, 0xe3, 0xa4, 0x8f, 0x42,
+	0x47, 0x29, 0xe0, 0x14, 0xa6, 0x35, 0x0a, 0x38, 0x06, 0xc4, 0x2d, 0xe6, 0x26, 0x14, 0xe3, 0x28,
+	0x11, 0xd2, 0x4b, 0x7c, 0xf8, 0xda, 0x6e, 0x6b, 0x4e, 0x61, 0xdc, 0x6b, 0xb4, 0x90, 0x6b, 0xb5,
+	0x12, 0x8a, 0x17, 0x05, 0xf0, 0x11, 0xf0, 0x5d, 0x7e, 0xe4, 0x0e, 0x93, 0xd6, 0xb9, 0x55, 0xb4,
+	0xb6, 0xe0, 0x56, 0xda, 0xc8, 0x3b, 0x78, 0xc9, 0xd7, 0xea, 0xbd, 0x9d, 0xea, 0x75, 0x6a, 0x9d,
+	0xd7, 0xd0, 0x3b, 0x4e, 0x66, 0x23, 0xc7, 0x5e, 0xa8, 0x12, 0x51, 0x2d, 0x94, 0x33, 0xda, 0x72,
+	0xee, 0xdb, 0x43, 0xdd, 0xea, 0x4c, 0xf4, 0x0f, 0x08, 0x13, 0x43, 0xfe, 0x00, 0xa4, 0xb1, 0x1f,
+	0xc1, 0xe7, 0x94, 0xb9, 0x72, 0xd3, 0xe9, 0x72, 0xd5, 0xa6, 0x8d, 0xe3, 0x36, 0xdd, 0xc7, 0x38,
+	0x04, 0x69, 0x00, 0xe7, 0x34, 0xe0, 0x66, 0x3d, 0xc0, 0x07, 0xc5, 0x38, 0xd7, 0x9a, 0x83, 0x5c,
+	0xc6, 0xf3, 0xbd, 0x08, 0xe2, 0x40, 0x68, 0x9b, 0x5c, 0x74, 0xf3, 0x1a, 0xfd, 0xb0, 0x81, 0x3f,
+	0x63, 0x90, 0xf7, 0x22, 0x21, 0xeb, 0xad, 0x79, 0x17, 0x37, 0xe3, 0x48, 0x14, 0x80, 0xd9, 0xb2,
+	0x6f, 0xd5, 0x03, 0xdc, 0x2b, 0x07, 0xba, 0xf6, 0x2c, 0x16, 0xe2, 0x9c, 0x8d, 0x48, 0x56, 0x30,
+	0x56, 0x92, 0xdf, 0x8c, 0x62, 0x09, 0x3c, 0xc7, 0xb7, 0x5a, 0xd4, 0xa2, 0x67, 0xcb, 0x10, 0xdc,
+	0xed, 0xa9, 0x2f, 0xce, 0xeb, 0x2f, 0x2a, 0x6d, 0xe4, 0x15, 0xbc, 0xdc, 0x8b, 0x92, 0x48, 0xf4,
+	0x21, 0xb8, 0x07, 0x3d, 0xc6, 0xa1, 0x35, 0xaf, 0xbf, 0x3a, 0xd6, 0x4a, 0x7f, 0x82, 0xf0, 0x67,
+	0x0b, 0xdf, 0x03, 0x31, 0x3c, 0x18, 0x44, 0x67, 0x58, 0xc6, 0x36, 0x5e, 0x18, 0xc0, 0x80, 0x45,
+	0xdf, 0x87, 0x40, 0xeb, 0xb4, 0xe0, 0x16, 0x75, 0xa5, 0x55, 0xea, 0x71, 0x6f, 0x00, 0x12, 0xb8,
+	0xf2, 0xc1, 0x39, 0xa5, 0x55, 0xd9, 0x42, 0xff, 0x89, 0xf0, 0x0b, 0x25, 0x89, 0xe4, 0x47, 0x4f,
+	0x8e, 0x71, 0x0b, 0x3f, 0xcf, 0x41, 0x48, 0x8f, 0xcb, 0xee, 0xd0, 0xf7, 0x41, 0x88, 0xde, 0x30,
+	0xce, 0x79, 0xc6, 0x3b, 0xd4, 0xd7, 0x09, 0x0b, 0xe0, 0x4d, 0x65, 0xfc, 0x2e, 0xc4, 0xe0, 0x4b,
+	0x66, 0xac, 0x3e, 0xde, 0x71, 0xaa, 0x1a, 0x87, 0xf8, 0x45, 0xdb, 0x9e, 0x03, 0x38, 0x93, 0x1a,
+	0xe3, 0x60, 0x73, 0x27, 0x80, 0xd1, 0x1f, 0x23, 0xdc, 0x32, 0x92, 0xbf, 0x09, 0x7c, 0x10, 0x25,
+	0x9e, 0x3c, 0x83, 0xf0, 0xcb, 0x78, 0x9e, 0x83, 0x27, 0x58, 0x62, 0x9c, 0x33, 0xab, 0x91, 0x55,
+	0xdc, 0x0c, 0xb9, 0xe7, 0xc3, 0x3e, 0xf0, 0x88, 0x05, 0xb9, 0x9d, 0xec, 0x26, 0xfa, 0x6f, 0x54,
+	0xee, 0xb0, 0xae, 0x64, 0xe9, 0xff, 0xc9, 0x00, 0xa4, 0x85, 0x2f, 0x0c, 0x40, 0x08, 0x2f, 0x84,
+	0x9c, 0xca, 0x54, 0x2d, 0x5d, 0xce, 0x4f, 0xd3, 0x65, 0x7e, 0x4c, 0x17, 0x35, 0xa7, 0x18, 0x1e,
+	0x48, 0x0e, 0xd0, 0xba, 0xa0, 0xfd, 0xc7, 0x54, 0xe9, 0xcf, 0x1b, 0x65, 0xe8, 0xeb, 0x82, 0xfc,
+	0xf4, 0x95, 0x7c, 0x01, 0x9f, 0x4f, 0xfb, 0x9e, 0x80, 0x5c, 0xc7, 0xac, 0x42, 0xd6, 0xf1, 0x25,
+	0x36, 0x94, 0xe9, 0x50, 0xee, 0x97, 0x4e, 0x9b, 0xe9, 0x39, 0xd6, 0x4e, 0xbe, 0x8a, 0xaf, 0x7a,
+	0x71, 0xcc, 0x0e, 0xbf, 0x95, 0x04, 0xe0, 0xc7, 0x1e, 0x87, 0xe0, 0xed, 0xe3, 0x43, 0x33, 0x23,
+	0x9c, 0xf6, 0x19, 0xed, 0xe1, 0xcb, 0x85, 0x6d, 0x86, 0x22, 0x85, 0x24, 0x38, 0x53, 0x4c, 0xe1,
+	0x7a, 0x23, 0xdd, 0x95, 0xb9, 0x59, 0x8a, 0x3a, 0xfd, 0xd8, 0xca, 0x3f, 0x7b, 0x2c, 0x7c, 0x72,
+	0x21, 0x2d, 0x7c, 0x21, 0x65, 0xc1, 0x43, 0x35, 0x28, 0x93, 0x61, 0xaa, 0xe4, 0x2e, 0xc6, 0x31,
+	0x0b, 0x4d, 0xe0, 0x3f, 0xa7, 0x03, 0xff, 0x35, 0x2b, 0xf0, 0x3b, 0xea, 0x78, 0xa1, 0xc2, 0xfc,
+	0x3e, 0x0b, 0xf6, 0x8a, 0x0f, 0x5d, 0x6b, 0x90, 0xc2, 0x09, 0x39, 0xa4, 0xf9, 0xc2, 0xe8, 0xb2,
+	0xd2, 0x4a, 0x98, 0xc5, 0xce, 0xd6, 0xa3, 0xa8, 0xd3, 0xbf, 0xa0, 0x32, 0x86, 0xec, 0x42, 0x0c,
+	0x67, 0xd9, 0xc6, 0xef, 0xe0, 0xa5, 0x40, 0x4f, 0x51, 0xcd, 0xad, 0x35, 0x93, 0xff, 0xae, 0x3d,
+	0xd4, 0xad, 0xce, 0xa4, 0x1c, 0xae, 0xc7, 0xb8, 0x0f, 0xf9, 0xa1, 0x23, 0xab, 0xd0, 0x56, 0xb9,
+	0xf4, 0x86, 0x5d, 0xa4, 0x2c, 0x11, 0x40, 0x7f, 0xa9, 0xd4, 0xf2, 0xa4, 0xdf, 0x37, 0xfd, 0xe2,
+	0xd9, 0xcb, 0xbd, 0xf4, 0x43, 0xcb, 0xa3, 0x34, 0xec, 0x1b, 0x23, 0x48, 0xb4, 0xe1, 0xe5, 0x51,
+	0x5a, 0x18, 0x5e, 0x95, 0xc9, 0x01, 0x9e, 0x67, 0x07, 0xef, 0x82, 0x2f, 0x9f, 0xc2, 0x29, 0x30,
+	0x9f, 0x59, 0xa5, 0x67, 0x52, 0x62, 0x7c, 0x8a, 0x06, 0xa3, 0xaf, 0xe3, 0x85, 0x3d, 0x16, 0xbe,
+	0x91, 0x48, 0x7e, 0xa4, 0x76, 0x8b, 0xcf, 0x12, 0x09, 0x89, 0xcc, 0x85, 0x9b, 0xaa, 0xbd, 0x8f,
+	0x1a, 0x95, 0x7d, 0x44, 0x7f, 0x81, 0xec, 0x73, 0x57, 0x22, 0x9f, 0xa9, 0xb3, 0x36, 0xfd, 0xaf,
+	0xb5, 0xe5, 0xba, 0x95, 0x43, 0xd0, 0x74, 0x3e, 0x8a, 0x17, 0x39, 0x08, 0x36, 0xe4, 0x3e, 0x7c,
+	0x23, 0x4a, 0x82, 0x5c, 0xe9, 0x4a, 0x9b, 0xfd, 0x8d, 0x15, 0x60, 0x2a, 0x6d, 0x84, 0xe3, 0xa5,
+	0xec, 0xec, 0x55, 0x0d, 0x34, 0x7b, 0x67, 0x57, 0xb6, 0x6b, 0xa6, 0x15, 0x6e, 0x55, 0x04, 0x85,
+	0x72, 0x41, 0x76, 0xa3, 0x5e, 0xaf, 0x9e, 0xc2, 0x26, 0x02, 0x35, 0xaa, 0x11, 0x88, 0xc9, 0x3e,
+	0x70, 0x4b, 0xbb, 0xb2, 0x81, 0xee, 0x59, 0x79, 0x32, 0x05, 0xff, 0x7e, 0xdf, 0x4b, 0x42, 0x3d,
+	0x4f, 0xea, 0xc9, 0xbe, 0xd9, 0x50, 0xaa, 0x4c, 0x2e, 0xe1, 0x39, 0x16, 0x1b, 0x1b, 0xaa, 0xa2,
+	0x6a, 0x49, 0xe0, 0x30, 0x9f, 0x53, 0x15, 0xe9, 0x3f, 0xac, 0xfd, 0xf9, 0x90, 0x05, 0x50, 0x4e,
+	0x37, 0x16, 0x18, 0x57, 0x71, 0x33, 0x88, 0x44, 0x1a, 0x7b, 0x47, 0x96, 0x3f, 0xda, 0x4d, 0x2a,
+	0x08, 0xb3, 0x38, 0xd8, 0xd7, 0x59, 0x33, 0x4f, 0x2d, 0xa6, 0xae, 0xfa, 0x12, 0x38, 0xcc, 0xfa,
+	0xb2, 0x4c, 0x5b, 0xd4, 0xd5, 0x19, 0x90, 0xc5, 0xc1, 0x5b, 0x79, 0x1e, 0xce, 0xc2, 0xba, 0xd5,
+	0xa2, 0xfa, 0x13, 0x38, 0x34, 0xfd, 0x59, 0x78, 0xb7, 0x5a, 0xe8, 0x47, 0xd6, 0x51, 0x37, 0x33,
+	0x7d, 0x16, 0x22, 0x4f, 0x8a, 0xef, 0xa5, 0x75, 0x1b, 0xc7, 0xac, 0x4b, 0x5e, 0xc7, 0x4d, 0x51,
+	0x58, 0x55, 0x05, 0xb3, 0xb9, 0xb5, 0xe6, 0xf6, 0x95, 0xd2, 0x0f, 0xc6, 0x4d, 0xef, 0xda, 0x03,
+	0xd4, 0xf8, 0xa4, 0x30, 0xa3, 0x72, 0xbb, 0x13, 0xc6, 0x97, 0xb6, 0x76, 0xed, 0x01, 0xdb, 0x9f,
+	0x5c, 0xc6, 0xcf, 0x15, 0x32, 0x80, 0x8f, 0x22, 0x1f, 0xc8, 0xaf, 0x11, 0x5e, 0xce, 0xae, 0x8d,
+	0xa6, 0x87, 0x5c, 0x1d, 0x9f, 0xb1, 0x72, 0xe5, 0x6e, 0xcf, 0x70, 0x5b, 0xd3, 0xb5, 0x1f, 0x7d,
+	0xfc, 0x9f, 0x9f, 0x35, 0xe8, 0x1d, 0xb4, 0x4e, 0x5f, 0xd2, 0x2f, 0x00, 0xa3, 0xad, 0xe2, 0xc9,
+	0x40, 0x74, 0xde, 0x2b, 0x9c, 0xf9, 0x7d, 0xf2, 0x2b, 0x84, 0x9b, 0x0f, 0x40, 0x16, 0x98, 0x13,
+	0x14, 0x2f, 0xaf, 0xb5, 0x33, 0x65, 0xbc, 0xa5, 0x19, 0x5f, 0x21, 0x9f, 0x9f, 0x0a, 0x98, 0x95,
+	0x35, 0xe7, 0x92, 0x8a, 0xcc, 0x66, 0xb8, 0x20, 0x2f, 0x8d, 0x93, 0x5a, 0xb7, 0xd9, 0xf6, 0xc3,
+	0xd9, 0xa1, 0xaa, 0x69, 0xe9, 0x75, 0x8d, 0x7b, 0x95, 0x9c, 0x62, 0xcf, 0x1f, 0xe2, 0xe5, 0x6a,
+	0x86, 0xaf, 0x2c, 0xfc, 0xa4, 0xdc, 0xdf, 0x9e, 0x60, 0xf2, 0x32, 0xe1, 0xd1, 0x9b, 0x5a, 0xee,
+	0x75, 0xf2, 0xf2, 0x71, 0xb9, 0x1b, 0xa0, 0xfa, 0x2b, 0xd2, 0x37, 0x11, 0x11, 0xb8, 0x59, 0x0e,
+	0x16, 0x95, 0xe5, 0x1c, 0x4b, 0xa2, 0xed, 0xcf, 0x4d, 0x3a, 0xc5, 0x65, 0x62, 0x6f, 0x68, 0xb1,
+	0x2f, 0x93, 0x6b, 0x46, 0xac, 0x90, 0x1c, 0xbc, 0x41, 0x67, 0xa2, 0xd0, 0x0f, 0x10, 0x5e, 0xce,
+	0x8e, 0x3a, 0xd3, 0xdc, 0xbd, 0x72, 0x90, 0x6b, 0xaf, 0x9e, 0xfc, 0x41, 0x7e, 0x5a, 0xca, 0x1d,
+	0x64, 0xbd, 0x9e, 0x83, 0xfc, 0x11, 0xe1, 0x25, 0x7d, 0x69, 0x2e, 0x10, 0x56, 0xc6, 0x25, 0xd8,
+	0xb7, 0xea, 0x99, 0x3a, 0xf3, 0x17, 0x35, 0x6b, 0xe7, 0x0e, 0x5a, 0x6f, 0xaf, 0xd7, 0xc1, 0xed,
+	0x70, 0x45, 0x42, 0xfe, 0x8a, 0xf0, 0x25, 0xf3, 0xe6, 0x50, 0x70, 0x5f, 0x9b, 0xc4, 0x5d, 0x79,
+	0x97, 0x98, 0x29, 0xfa, 0xab, 0x1a, 0x7d, 0xbb, 0xbd, 0x51, 0x93, 0x3b, 0x23, 0xb9, 0x83, 0xd6,
+	0xc9, 0x9f, 0x10, 0x5e, 0xce, 0x6e, 0xf8, 0xd3, 0x96, 0xbd, 0xf2, 0x06, 0x30, 0x53, 0xf2, 0x2f,
+	0x69, 0xf2, 0xcd, 0xf6, 0xcd, 0xda, 0xe4, 0x03, 0x50, 0xdc, 0x7f, 0x46, 0xf8, 0xb9, 0xfc, 0x52,
+	0x56, 0x80, 0x4f, 0x70, 0xc7, 0xea, 0xbd, 0x6d, 0xa6, 0xe4, 0x5f, 0xd6, 0xe4, 0x5b, 0xca, 0x5d,
+	0x6e, 0xd5, 0x82, 0x17, 0x19, 0x0b, 0xf9, 0x1b, 0xc2, 0xcf, 0x17, 0x4f, 0x1b, 0x05, 0x3c, 0x1d,
+	0x87, 0x3f, 0xfe, 0xfe, 0x31, 0x53, 0xfc, 0xd7, 0x34, 0xfe, 0x8e, 0xc2, 0x77, 0x6a, 0xe1, 0x4b,
+	0x43, 0x43, 0x7e, 0x8f, 0xf0, 0xa2, 0x7a, 0x12, 0x29, 0xd8, 0x27, 0x84, 0x71, 0xeb, 0xc9, 0x64,
+	0xa6, 0xd8, 0xb7, 0x35, 0xb6, 0xd3, 0xbe, 0x51, 0xcf, 0xe4, 0x92, 0xa5, 0xca, 0x5b, 0x7e, 0x8b,
+	0x70, 0xb3, 0x3b, 0x3d, 0x43, 0x76, 0x9f, 0x4e, 0x86, 0xdc, 0xd1, 0xbc, 0x1b, 0xca, 0xcc, 0x6b,
+	0xf5, 0x90, 0x41, 0x92, 0xdf, 0x20, 0xbc, 0xa8, 0x6e, 0x17, 0xd3, 0x0c, 0x6c, 0xdd, 0x3e, 0x66,
+	0x0a, 0xbc, 0xa1, 0x81, 0xbf, 0xa0, 0x8e, 0x1d, 0x74, 0x3a, 0x70, 0x1c, 0x25, 0x92, 0xfc, 0x00,
+	0x5f, 0xc8, 0x9e, 0x0c, 0xc4, 0x24, 0xa3, 0x96, 0xaf, 0x19, 0x6d, 0x52, 0xf6, 0x9a, 0x1b, 0x18,
+	0xfd, 0x8a, 0x96, 0x75, 0x9b, 0x6c, 0xd7, 0xb2, 0xcc, 0x7b, 0xf9, 0x25, 0xec, 0xfd, 0x4e, 0xcc,
+	0xc2, 0x9f, 0x36, 0xd0, 0x26, 0x22, 0x12, 0x2f, 0x5a, 0xa2, 0x9e, 0x04, 0x61, 0x53, 0x23, 0xac,
+	0x93, 0x7a, 0x8b, 0x13, 0xb3, 0x70, 0x13, 0x91, 0xdf, 0x21, 0xbc, 0xdc, 0xad, 0xc6, 0xfb, 0xab,
+	0x93, 0x42, 0xcf, 0xd3, 0x8a, 0xf6, 0x1d, 0xcd, 0x7c, 0x83, 0x9e, 0x92, 0x54, 0xcb, 0x20, 0xff,
+	0x01, 0xc2, 0x4b, 0xea, 0x84, 0x3e, 0xf5, 0xe0, 0x65, 0xdd, 0x9e, 0xda, 0x2b, 0x27, 0x75, 0xe7,
+	0x69, 0x7d, 0x4b, 0x13, 0xdc, 0x24, 0xf5, 0x76, 0x61, 0x10, 0xf5, 0x7a, 0xf7, 0x1e, 0x7c, 0xf4,
+	0x78, 0x05, 0xfd, 0xeb, 0xf1, 0x0a, 0xfa, 0xe4, 0xf1, 0x0a, 0xfa, 0xce, 0x6b, 0xf5, 0x7f, 0x94,
+	0x1d, 0xfb, 0xa1, 0x77, 0x30, 0xaf, 0xff, 0x7b, 0xed, 0xfc, 0x6f, 0x00, 0x45, 0xad, 0xe2, 0xc7,
+	0xf1, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1463,6 +1843,7 @@ type WorkflowServiceClient interface {
 	PodLogs(ctx context.Context, in *WorkflowLogRequest, opts ...grpc.CallOption) (WorkflowService_PodLogsClient, error)
 	WorkflowLogs(ctx context.Context, in *WorkflowLogRequest, opts ...grpc.CallOption) (WorkflowService_WorkflowLogsClient, error)
 	SubmitWorkflow(ctx context.Context, in *WorkflowSubmitRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	DiffWorkflows(ctx context.Context, in *WorkflowDiffRequest, opts ...grpc.CallOption) (*WorkflowDiffResponse, error)
 }
 
 type workflowServiceClient struct {
@@ -1719,6 +2100,15 @@ func (c *workflowServiceClient) SubmitWorkflow(ctx context.Context, in *Workflow
 	return out, nil
 }
 
+func (c *workflowServiceClient) DiffWorkflows(ctx context.Context, in *WorkflowDiffRequest, opts ...grpc.CallOption) (*WorkflowDiffResponse, error) {
+	out := new(WorkflowDiffResponse)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/DiffWorkflows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkflowServiceServer is the server API for WorkflowService service.
 type WorkflowServiceServer interface {
 	CreateWorkflow(context.Context, *WorkflowCreateRequest) (*v1alpha1.Workflow, error)
@@ -1739,6 +2129,7 @@ type WorkflowServiceServer interface {
 	PodLogs(*WorkflowLogRequest, WorkflowService_PodLogsServer) error
 	WorkflowLogs(*WorkflowLogRequest, WorkflowService_WorkflowLogsServer) error
 	SubmitWorkflow(context.Context, *WorkflowSubmitRequest) (*v1alpha1.Workflow, error)
+	DiffWorkflows(context.Context, *WorkflowDiffRequest) (*WorkflowDiffResponse, error)
 }
 
 // UnimplementedWorkflowServiceServer can be embedded to have forward compatible implementations.
@@ -1796,6 +2187,9 @@ func (*UnimplementedWorkflowServiceServer) WorkflowLogs(req *WorkflowLogRequest,
 func (*UnimplementedWorkflowServiceServer) SubmitWorkflow(ctx context.Context, req *WorkflowSubmitRequest) (*v1alpha1.Workflow, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitWorkflow not implemented")
 }
+func (*UnimplementedWorkflowServiceServer) DiffWorkflows(ctx context.Context, req *WorkflowDiffRequest) (*WorkflowDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffWorkflows not implemented")
+}
 
 func RegisterWorkflowServiceServer(s *grpc.Server, srv WorkflowServiceServer) {
 	s.RegisterService(&_WorkflowService_serviceDesc, srv)
@@ -2119,6 +2513,24 @@ func _WorkflowService_SubmitWorkflow_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkflowService_DiffWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).DiffWorkflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/DiffWorkflows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).DiffWorkflows(ctx, req.(*WorkflowDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _WorkflowService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "workflow.WorkflowService",
 	HandlerType: (*WorkflowServiceServer)(nil),
@@ -2175,6 +2587,10 @@ var _WorkflowService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitWorkflow",
 			Handler:    _WorkflowService_SubmitWorkflow_Handler,
 		},
+		{
+			MethodName: "DiffWorkflows",
+			Handler:    _WorkflowService_DiffWorkflows_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2609,6 +3025,20 @@ func (m *WorkflowTerminateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.GracePeriod) > 0 {
+		i -= len(m.GracePeriod)
+		copy(dAtA[i:], m.GracePeriod)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.GracePeriod)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Namespace) > 0 {
 		i -= len(m.Namespace)
 		copy(dAtA[i:], m.Namespace)
@@ -2650,9 +3080,33 @@ func (m *WorkflowStopRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
+	if m.Subtree {
+		i--
+		if m.Subtree {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.GracePeriod) > 0 {
+		i -= len(m.GracePeriod)
+		copy(dAtA[i:], m.GracePeriod)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.GracePeriod)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
 		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Message)))
 		i--
 		dAtA[i] = 0x22
@@ -2705,6 +3159,16 @@ func (m *WorkflowSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AllowUndeclaredOutputParameters {
+		i--
+		if m.AllowUndeclaredOutputParameters {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.OutputParameters) > 0 {
 		i -= len(m.OutputParameters)
 		copy(dAtA[i:], m.OutputParameters)
@@ -2774,6 +3238,13 @@ func (m *WorkflowSuspendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ResumeAt) > 0 {
+		i -= len(m.ResumeAt)
+		copy(dAtA[i:], m.ResumeAt)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.ResumeAt)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Namespace) > 0 {
 		i -= len(m.Namespace)
 		copy(dAtA[i:], m.Namespace)
@@ -3247,6 +3718,240 @@ func (m *WorkflowSubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkflowDiffRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowDiffRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowDiffRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.OtherName) > 0 {
+		i -= len(m.OtherName)
+		copy(dAtA[i:], m.OtherName)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.OtherName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowSpecChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowSpecChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowSpecChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.New) > 0 {
+		i -= len(m.New)
+		copy(dAtA[i:], m.New)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.New)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Old) > 0 {
+		i -= len(m.Old)
+		copy(dAtA[i:], m.Old)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Old)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowNodeChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowNodeChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowNodeChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NewMessage) > 0 {
+		i -= len(m.NewMessage)
+		copy(dAtA[i:], m.NewMessage)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NewMessage)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.OldMessage) > 0 {
+		i -= len(m.OldMessage)
+		copy(dAtA[i:], m.OldMessage)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.OldMessage)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NewPhase) > 0 {
+		i -= len(m.NewPhase)
+		copy(dAtA[i:], m.NewPhase)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NewPhase)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OldPhase) > 0 {
+		i -= len(m.OldPhase)
+		copy(dAtA[i:], m.OldPhase)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.OldPhase)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DisplayName) > 0 {
+		i -= len(m.DisplayName)
+		copy(dAtA[i:], m.DisplayName)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.DisplayName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowDiffResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowDiffResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NodeChanges) > 0 {
+		for iNdEx := len(m.NodeChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NodeChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SpecChanges) > 0 {
+		for iNdEx := len(m.SpecChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpecChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.OtherName) > 0 {
+		i -= len(m.OtherName)
+		copy(dAtA[i:], m.OtherName)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.OtherName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintWorkflow(dAtA []byte, offset int, v uint64) int {
 	offset -= sovWorkflow(v)
 	base := offset
@@ -3453,6 +4158,14 @@ func (m *WorkflowTerminateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorkflow(uint64(l))
 	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.GracePeriod)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3481,6 +4194,17 @@ func (m *WorkflowStopRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorkflow(uint64(l))
 	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.GracePeriod)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.Subtree {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3517,6 +4241,9 @@ func (m *WorkflowSetRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorkflow(uint64(l))
 	}
+	if m.AllowUndeclaredOutputParameters {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3537,6 +4264,10 @@ func (m *WorkflowSuspendRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorkflow(uint64(l))
 	}
+	l = len(m.ResumeAt)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3750,16 +4481,132 @@ func (m *WorkflowSubmitRequest) Size() (n int) {
 	return n
 }
 
-func sovWorkflow(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *WorkflowDiffRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.OtherName)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func sozWorkflow(x uint64) (n int) {
-	return sovWorkflow(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *WorkflowSpecChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Old)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.New)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *WorkflowCreateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+
+func (m *WorkflowNodeChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.DisplayName)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.OldPhase)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.NewPhase)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.OldMessage)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.NewMessage)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.OtherName)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if len(m.SpecChanges) > 0 {
+		for _, e := range m.SpecChanges {
+			l = e.Size()
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
+	if len(m.NodeChanges) > 0 {
+		for _, e := range m.NodeChanges {
+			l = e.Size()
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovWorkflow(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWorkflow(x uint64) (n int) {
+	return sovWorkflow(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WorkflowCreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -4999,6 +5846,70 @@ func (m *WorkflowTerminateRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GracePeriod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflow(dAtA[iNdEx:])
@@ -5178,6 +6089,90 @@ func (m *WorkflowStopRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GracePeriod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subtree", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Subtree = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflow(dAtA[iNdEx:])
@@ -5421,6 +6416,26 @@ func (m *WorkflowSetRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.OutputParameters = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowUndeclaredOutputParameters", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowUndeclaredOutputParameters = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflow(dAtA[iNdEx:])
@@ -5536,6 +6551,38 @@ func (m *WorkflowSuspendRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResumeAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflow(dAtA[iNdEx:])
@@ -6833,6 +7880,726 @@ func (m *WorkflowSubmitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WorkflowDiffRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowDiffRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowDiffRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OtherName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowSpecChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowSpecChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowSpecChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Old", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Old = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field New", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.New = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowNodeChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowNodeChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowNodeChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPhase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldPhase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPhase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPhase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowDiffResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowDiffResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OtherName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecChanges = append(m.SpecChanges, &WorkflowSpecChange{})
+			if err := m.SpecChanges[len(m.SpecChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeChanges = append(m.NodeChanges, &WorkflowNodeChange{})
+			if err := m.NodeChanges[len(m.NodeChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipWorkflow(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -388,6 +388,93 @@ func (_c *WorkflowTemplateServiceClient_LintWorkflowTemplate_Call) RunAndReturn(
 	return _c
 }
 
+// ListWorkflowTemplateRevisions provides a mock function for the type WorkflowTemplateServiceClient
+func (_mock *WorkflowTemplateServiceClient) ListWorkflowTemplateRevisions(ctx context.Context, in *workflowtemplate.WorkflowTemplateRevisionsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplateRevisionList, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorkflowTemplateRevisions")
+	}
+
+	var r0 *v1alpha1.WorkflowTemplateRevisionList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflowtemplate.WorkflowTemplateRevisionsRequest, ...grpc.CallOption) (*v1alpha1.WorkflowTemplateRevisionList, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflowtemplate.WorkflowTemplateRevisionsRequest, ...grpc.CallOption) *v1alpha1.WorkflowTemplateRevisionList); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.WorkflowTemplateRevisionList)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *workflowtemplate.WorkflowTemplateRevisionsRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorkflowTemplateServiceClient_ListWorkflowTemplateRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWorkflowTemplateRevisions'
+type WorkflowTemplateServiceClient_ListWorkflowTemplateRevisions_Call struct {
+	*mock.Call
+}
+
+// ListWorkflowTemplateRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *workflowtemplate.WorkflowTemplateRevisionsRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkflowTemplateServiceClient_Expecter) ListWorkflowTemplateRevisions(ctx interface{}, in interface{}, opts ...interface{}) *WorkflowTemplateServiceClient_ListWorkflowTemplateRevisions_Call {
+	return &WorkflowTemplateServiceClient_ListWorkflowTemplateRevisions_Call{Call: _e.mock.On("ListWorkflowTemplateRevisions",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkflowTemplateServiceClient_ListWorkflowTemplateRevisions_Call) Run(run func(ctx context.Context, in *workflowtemplate.WorkflowTemplateRevisionsRequest, opts ...grpc.CallOption)) *WorkflowTemplateServiceClient_ListWorkflowTemplateRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *workflowtemplate.WorkflowTemplateRevisionsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*workflowtemplate.WorkflowTemplateRevisionsRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *WorkflowTemplateServiceClient_ListWorkflowTemplateRevisions_Call) Return(workflowTemplateRevisionList *v1alpha1.WorkflowTemplateRevisionList, err error) *WorkflowTemplateServiceClient_ListWorkflowTemplateRevisions_Call {
+	_c.Call.Return(workflowTemplateRevisionList, err)
+	return _c
+}
+
+func (_c *WorkflowTemplateServiceClient_ListWorkflowTemplateRevisions_Call) RunAndReturn(run func(ctx context.Context, in *workflowtemplate.WorkflowTemplateRevisionsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplateRevisionList, error)) *WorkflowTemplateServiceClient_ListWorkflowTemplateRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListWorkflowTemplates provides a mock function for the type WorkflowTemplateServiceClient
 func (_mock *WorkflowTemplateServiceClient) ListWorkflowTemplates(ctx context.Context, in *workflowtemplate.WorkflowTemplateListRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplateList, error) {
 	// grpc.CallOption
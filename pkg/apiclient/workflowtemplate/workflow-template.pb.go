@@ -452,6 +452,61 @@ func (m *WorkflowTemplateLintRequest) GetCreateOptions() *v1.CreateOptions {
 	return nil
 }
 
+type WorkflowTemplateRevisionsRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowTemplateRevisionsRequest) Reset()         { *m = WorkflowTemplateRevisionsRequest{} }
+func (m *WorkflowTemplateRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowTemplateRevisionsRequest) ProtoMessage()    {}
+func (*WorkflowTemplateRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_215375a0ab97a62a, []int{7}
+}
+func (m *WorkflowTemplateRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowTemplateRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowTemplateRevisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowTemplateRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowTemplateRevisionsRequest.Merge(m, src)
+}
+func (m *WorkflowTemplateRevisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowTemplateRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowTemplateRevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowTemplateRevisionsRequest proto.InternalMessageInfo
+
+func (m *WorkflowTemplateRevisionsRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WorkflowTemplateRevisionsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*WorkflowTemplateCreateRequest)(nil), "workflowtemplate.WorkflowTemplateCreateRequest")
 	proto.RegisterType((*WorkflowTemplateGetRequest)(nil), "workflowtemplate.WorkflowTemplateGetRequest")
@@ -460,6 +515,7 @@ func init() {
 	proto.RegisterType((*WorkflowTemplateDeleteRequest)(nil), "workflowtemplate.WorkflowTemplateDeleteRequest")
 	proto.RegisterType((*WorkflowTemplateDeleteResponse)(nil), "workflowtemplate.WorkflowTemplateDeleteResponse")
 	proto.RegisterType((*WorkflowTemplateLintRequest)(nil), "workflowtemplate.WorkflowTemplateLintRequest")
+	proto.RegisterType((*WorkflowTemplateRevisionsRequest)(nil), "workflowtemplate.WorkflowTemplateRevisionsRequest")
 }
 
 func init() {
@@ -467,51 +523,55 @@ func init() {
 }
 
 var fileDescriptor_215375a0ab97a62a = []byte{
-	// 700 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0xcf, 0x4e, 0x13, 0x41,
-	0x1c, 0xce, 0x14, 0x63, 0x64, 0x08, 0x89, 0x19, 0xb5, 0x36, 0x2b, 0x36, 0xcd, 0x1e, 0x0c, 0x01,
-	0x3b, 0x43, 0x41, 0x09, 0xe1, 0x26, 0x90, 0x70, 0xc1, 0x40, 0x16, 0xff, 0x04, 0x2f, 0x66, 0x28,
-	0x3f, 0x97, 0xb5, 0xdb, 0x99, 0x75, 0x67, 0x28, 0x31, 0x86, 0x8b, 0x07, 0xe3, 0xdd, 0x17, 0xf0,
-	0x01, 0x3c, 0xf1, 0x0e, 0x26, 0x9e, 0x0c, 0xc6, 0x83, 0x57, 0x43, 0x7c, 0x01, 0xdf, 0xc0, 0xec,
-	0x74, 0xb7, 0xbb, 0xdd, 0x82, 0x2c, 0xc4, 0x9e, 0xbc, 0x4d, 0xa7, 0xf3, 0xfb, 0xfd, 0xbe, 0xef,
-	0x9b, 0x6f, 0xbf, 0x5d, 0x3c, 0x1f, 0xb4, 0x5c, 0xc6, 0x03, 0xaf, 0xe9, 0x7b, 0x20, 0x34, 0xdb,
-	0x97, 0x61, 0xeb, 0x85, 0x2f, 0xf7, 0x35, 0xb4, 0x03, 0x9f, 0x6b, 0xe8, 0x6d, 0xd4, 0x93, 0x1d,
-	0x1a, 0x84, 0x52, 0x4b, 0x72, 0x35, 0x7f, 0xd2, 0x9a, 0x70, 0xa5, 0x74, 0x7d, 0x88, 0x9a, 0x31,
-	0x2e, 0x84, 0xd4, 0x5c, 0x7b, 0x52, 0xa8, 0xee, 0x79, 0xeb, 0x5e, 0x6b, 0x41, 0x51, 0x4f, 0x46,
-	0xff, 0xb6, 0x79, 0x73, 0xd7, 0x13, 0x10, 0xbe, 0x66, 0xf1, 0x6c, 0xc5, 0xda, 0xa0, 0x39, 0xeb,
-	0x34, 0x98, 0x0b, 0x02, 0x42, 0xae, 0x61, 0x27, 0xae, 0x7a, 0xe8, 0x7a, 0x7a, 0x77, 0x6f, 0x9b,
-	0x36, 0x65, 0x9b, 0xf1, 0xd0, 0x95, 0x41, 0x28, 0x5f, 0x9a, 0x45, 0x3d, 0x19, 0xaf, 0xd2, 0x26,
-	0xc9, 0x16, 0xeb, 0x34, 0xb8, 0x1f, 0xec, 0xf2, 0x81, 0x76, 0xf6, 0xfb, 0x12, 0xbe, 0xfd, 0x34,
-	0x3e, 0xf5, 0x28, 0xc6, 0xbd, 0x1c, 0x02, 0xd7, 0xe0, 0xc0, 0xab, 0x3d, 0x50, 0x9a, 0x4c, 0xe0,
-	0x51, 0xc1, 0xdb, 0xa0, 0x02, 0xde, 0x84, 0x0a, 0xaa, 0xa1, 0xc9, 0x51, 0x27, 0xdd, 0x20, 0x02,
-	0x5f, 0x49, 0xe8, 0x56, 0x4a, 0x35, 0x34, 0x39, 0x36, 0xeb, 0xd0, 0x14, 0x21, 0x4d, 0x10, 0x9a,
-	0xc5, 0xf3, 0x1e, 0x42, 0xda, 0x99, 0xa3, 0x41, 0xcb, 0xa5, 0x11, 0x48, 0x9a, 0xec, 0xd2, 0x04,
-	0x24, 0xcd, 0x03, 0x72, 0x7a, 0x33, 0xc8, 0x16, 0x1e, 0x6f, 0x1a, 0x78, 0xeb, 0x81, 0xd1, 0xb2,
-	0x32, 0x62, 0x86, 0xce, 0xd1, 0xae, 0x98, 0x34, 0x2b, 0x66, 0x3a, 0x22, 0x12, 0x93, 0x76, 0x1a,
-	0x74, 0x39, 0x5b, 0xea, 0xf4, 0x77, 0xb2, 0x3f, 0x22, 0x6c, 0xe5, 0x27, 0xaf, 0x82, 0x4e, 0x74,
-	0x20, 0xf8, 0x52, 0x44, 0x3b, 0x96, 0xc0, 0xac, 0xfb, 0xb5, 0x29, 0xe5, 0xb5, 0xd9, 0xc0, 0xd8,
-	0x05, 0xdd, 0x0f, 0x74, 0xa6, 0x18, 0xd0, 0xd5, 0x5e, 0x9d, 0x93, 0xe9, 0x61, 0x1f, 0x22, 0x7c,
-	0x2b, 0x0f, 0x71, 0xcd, 0x53, 0xba, 0xd8, 0x5d, 0xd5, 0xf0, 0x58, 0xf4, 0x63, 0x83, 0x6b, 0x0d,
-	0xa1, 0x88, 0xf1, 0x66, 0xb7, 0xc8, 0x26, 0x1e, 0xf3, 0x3d, 0x95, 0x83, 0xdc, 0x28, 0x06, 0x79,
-	0x2d, 0x2d, 0x74, 0xb2, 0x5d, 0xec, 0xcf, 0x68, 0xd0, 0x62, 0x8f, 0x83, 0x9d, 0x8c, 0xc5, 0xca,
-	0x59, 0x69, 0x97, 0x4a, 0x15, 0x54, 0x48, 0xde, 0xac, 0xf5, 0x46, 0x86, 0x6f, 0x3d, 0xfb, 0xd3,
-	0x09, 0x3c, 0x56, 0xc0, 0x87, 0x94, 0xc7, 0xf9, 0x2d, 0xb2, 0x85, 0xc7, 0x77, 0x4c, 0x8b, 0x0b,
-	0xd9, 0x79, 0x25, 0x5b, 0xea, 0xf4, 0x77, 0xb2, 0x6b, 0xb8, 0x7a, 0x1a, 0x5a, 0x15, 0x48, 0xa1,
-	0xc0, 0x7e, 0x57, 0x3a, 0xc9, 0x4d, 0x42, 0xff, 0x6f, 0x4f, 0xfe, 0xec, 0xef, 0x51, 0x7c, 0x33,
-	0x3f, 0x79, 0x13, 0xc2, 0x8e, 0xd7, 0x04, 0x72, 0x84, 0x70, 0xb9, 0x5b, 0x9c, 0x3f, 0x41, 0x18,
-	0xcd, 0x27, 0x3e, 0xfd, 0x6b, 0x94, 0x5a, 0x43, 0x10, 0xc8, 0x6e, 0xbc, 0xfd, 0xfe, 0xeb, 0x43,
-	0x69, 0xda, 0xbe, 0x63, 0xde, 0x32, 0x9d, 0xc6, 0xe0, 0xeb, 0x49, 0xb1, 0x37, 0xbd, 0x5b, 0x3b,
-	0x58, 0x44, 0x53, 0xe4, 0x2b, 0xc2, 0xd7, 0x56, 0x41, 0x0f, 0xf0, 0xb9, 0x7b, 0x36, 0x9f, 0x34,
-	0x0f, 0x87, 0x42, 0xe6, 0xbe, 0x21, 0xc3, 0x48, 0xbd, 0x18, 0x99, 0xee, 0xfa, 0x20, 0x22, 0x74,
-	0x23, 0x8a, 0x9f, 0x7c, 0x3f, 0x45, 0xea, 0x67, 0x53, 0xca, 0xe4, 0xa7, 0xf5, 0xe4, 0xdf, 0x73,
-	0x8a, 0xda, 0xdb, 0xd4, 0xf0, 0x9a, 0x24, 0x05, 0x2f, 0x89, 0xfc, 0x40, 0xb8, 0xdc, 0x8d, 0xc8,
-	0x8b, 0x98, 0xae, 0x2f, 0x5c, 0x87, 0x72, 0x4f, 0x0b, 0x86, 0xcf, 0xac, 0x75, 0xbe, 0x7b, 0x8a,
-	0xbc, 0x77, 0x88, 0x70, 0xb9, 0x1b, 0x43, 0x17, 0x61, 0xd6, 0x17, 0xb7, 0xd6, 0x4c, 0xf1, 0x82,
-	0x38, 0xf1, 0x62, 0x7f, 0x4d, 0x9d, 0xd3, 0x5f, 0xdf, 0x10, 0xbe, 0x1e, 0x05, 0xe3, 0x00, 0xe4,
-	0x42, 0xf6, 0x12, 0x43, 0x7d, 0x64, 0xe6, 0x0d, 0xa5, 0x19, 0x7b, 0xba, 0x20, 0x25, 0xdf, 0x13,
-	0x7a, 0x11, 0x4d, 0x2d, 0xad, 0x7f, 0x39, 0xae, 0xa2, 0xa3, 0xe3, 0x2a, 0xfa, 0x79, 0x5c, 0x45,
-	0xcf, 0x1e, 0x14, 0xff, 0xaa, 0x3c, 0xe5, 0xb3, 0x78, 0xfb, 0xb2, 0xf9, 0xa0, 0x9c, 0xfb, 0x13,
-	0x00, 0x00, 0xff, 0xff, 0xa4, 0xd4, 0xed, 0x3d, 0x3f, 0x0b, 0x00, 0x00,
+	// 753 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0x41, 0x4f, 0x13, 0x4d,
+	0x18, 0xce, 0x94, 0x2f, 0x5f, 0x3e, 0xa6, 0x21, 0xf9, 0x32, 0x6a, 0x6d, 0x56, 0x68, 0x9a, 0x3d,
+	0x18, 0x02, 0x76, 0x86, 0x16, 0x45, 0xf4, 0x62, 0x04, 0x12, 0x2e, 0x18, 0xc8, 0x82, 0x1a, 0x3c,
+	0x68, 0x86, 0x32, 0x2e, 0x6b, 0xb7, 0x33, 0xeb, 0xce, 0xb0, 0xc4, 0x18, 0x2e, 0x1e, 0x8c, 0x77,
+	0xff, 0x80, 0x3f, 0xc0, 0x13, 0xff, 0xc1, 0xc4, 0x93, 0xc1, 0x78, 0xf0, 0x6a, 0x88, 0x07, 0x7f,
+	0x80, 0x3f, 0xc0, 0xec, 0x74, 0xb7, 0xbb, 0xdd, 0x82, 0x6c, 0x1b, 0x7b, 0xf2, 0x36, 0x0c, 0xf3,
+	0xbe, 0xef, 0xf3, 0xbc, 0xef, 0x33, 0xcf, 0x6c, 0xe1, 0x82, 0xd7, 0xb2, 0x09, 0xf5, 0x9c, 0xa6,
+	0xeb, 0x30, 0xae, 0xc8, 0x81, 0xf0, 0x5b, 0x4f, 0x5d, 0x71, 0xa0, 0x58, 0xdb, 0x73, 0xa9, 0x62,
+	0xdd, 0x8d, 0x5a, 0xbc, 0x83, 0x3d, 0x5f, 0x28, 0x81, 0xfe, 0xcf, 0x9e, 0x34, 0x26, 0x6d, 0x21,
+	0x6c, 0x97, 0x85, 0xc9, 0x08, 0xe5, 0x5c, 0x28, 0xaa, 0x1c, 0xc1, 0x65, 0xe7, 0xbc, 0x71, 0xbd,
+	0xb5, 0x28, 0xb1, 0x23, 0xc2, 0xff, 0xb6, 0x69, 0x73, 0xcf, 0xe1, 0xcc, 0x7f, 0x41, 0xa2, 0xda,
+	0x92, 0xb4, 0x99, 0xa2, 0x24, 0xa8, 0x13, 0x9b, 0x71, 0xe6, 0x53, 0xc5, 0x76, 0xa3, 0xa8, 0x7b,
+	0xb6, 0xa3, 0xf6, 0xf6, 0x77, 0x70, 0x53, 0xb4, 0x09, 0xf5, 0x6d, 0xe1, 0xf9, 0xe2, 0x99, 0x5e,
+	0xd4, 0xe2, 0xf2, 0x32, 0x49, 0x12, 0x6f, 0x91, 0xa0, 0x4e, 0x5d, 0x6f, 0x8f, 0xf6, 0xa5, 0x33,
+	0xdf, 0x14, 0xe0, 0xd4, 0xc3, 0xe8, 0xd4, 0x56, 0x84, 0x7b, 0xd9, 0x67, 0x54, 0x31, 0x8b, 0x3d,
+	0xdf, 0x67, 0x52, 0xa1, 0x49, 0x38, 0xce, 0x69, 0x9b, 0x49, 0x8f, 0x36, 0x59, 0x19, 0x54, 0xc1,
+	0xf4, 0xb8, 0x95, 0x6c, 0x20, 0x0e, 0xff, 0x8b, 0xe9, 0x96, 0x0b, 0x55, 0x30, 0x5d, 0x6c, 0x58,
+	0x38, 0x41, 0x88, 0x63, 0x84, 0x7a, 0xf1, 0xa4, 0x8b, 0x10, 0x07, 0xf3, 0xd8, 0x6b, 0xd9, 0x38,
+	0x04, 0x89, 0xe3, 0x5d, 0x1c, 0x83, 0xc4, 0x59, 0x40, 0x56, 0xb7, 0x06, 0xda, 0x86, 0x13, 0x4d,
+	0x0d, 0x6f, 0xdd, 0xd3, 0xbd, 0x2c, 0x8f, 0xe9, 0xa2, 0xf3, 0xb8, 0xd3, 0x4c, 0x9c, 0x6e, 0x66,
+	0x52, 0x22, 0x6c, 0x26, 0x0e, 0xea, 0x78, 0x39, 0x1d, 0x6a, 0xf5, 0x66, 0x32, 0xdf, 0x01, 0x68,
+	0x64, 0x2b, 0xaf, 0x32, 0x15, 0xf7, 0x01, 0xc1, 0x7f, 0x42, 0xda, 0x51, 0x0b, 0xf4, 0xba, 0xb7,
+	0x37, 0x85, 0x6c, 0x6f, 0x36, 0x20, 0xb4, 0x99, 0xea, 0x05, 0x3a, 0x97, 0x0f, 0xe8, 0x6a, 0x37,
+	0xce, 0x4a, 0xe5, 0x30, 0x8f, 0x00, 0xbc, 0x92, 0x85, 0xb8, 0xe6, 0x48, 0x95, 0x6f, 0x56, 0x55,
+	0x58, 0x0c, 0xff, 0xd8, 0xa0, 0x4a, 0x31, 0x9f, 0x47, 0x78, 0xd3, 0x5b, 0x68, 0x13, 0x16, 0x5d,
+	0x47, 0x66, 0x20, 0xd7, 0xf3, 0x41, 0x5e, 0x4b, 0x02, 0xad, 0x74, 0x16, 0xf3, 0x03, 0xe8, 0x97,
+	0xd8, 0x7d, 0x6f, 0x37, 0x25, 0xb1, 0x52, 0xba, 0xb5, 0x4b, 0x85, 0x32, 0xc8, 0xd5, 0xde, 0xb4,
+	0xf4, 0xc6, 0x46, 0x2f, 0x3d, 0xf3, 0xfd, 0x29, 0x3c, 0x56, 0x98, 0xcb, 0x12, 0x1e, 0x83, 0x4b,
+	0x64, 0x1b, 0x4e, 0xec, 0xea, 0x14, 0x43, 0xc9, 0x79, 0x25, 0x1d, 0x6a, 0xf5, 0x66, 0x32, 0xab,
+	0xb0, 0x72, 0x16, 0x5a, 0xe9, 0x09, 0x2e, 0x99, 0xf9, 0xba, 0x70, 0x9a, 0x9a, 0xb8, 0xfa, 0xeb,
+	0x6e, 0xfe, 0x16, 0xac, 0xf6, 0x15, 0x66, 0x81, 0x23, 0xf5, 0xd9, 0x61, 0x67, 0xdb, 0xf8, 0x51,
+	0x84, 0x97, 0xb3, 0x69, 0x37, 0x99, 0x1f, 0x38, 0x4d, 0x86, 0x8e, 0x01, 0x2c, 0x75, 0x20, 0x65,
+	0x4f, 0x20, 0x82, 0xb3, 0xef, 0x08, 0xfe, 0xad, 0x41, 0x1b, 0x23, 0x68, 0xbb, 0x59, 0x7f, 0xf5,
+	0xe5, 0xfb, 0xdb, 0xc2, 0xac, 0x79, 0x55, 0xbf, 0x5d, 0x41, 0xbd, 0xff, 0xd1, 0x93, 0xe4, 0x65,
+	0x97, 0xea, 0xe1, 0x6d, 0x30, 0x83, 0x3e, 0x01, 0x78, 0x61, 0x95, 0xa9, 0x3e, 0x3e, 0xd7, 0xce,
+	0xe7, 0x93, 0xb8, 0xec, 0x48, 0xc8, 0xdc, 0xd0, 0x64, 0x08, 0xaa, 0xe5, 0x23, 0xd3, 0x59, 0x1f,
+	0x86, 0x84, 0x2e, 0x85, 0xa6, 0x96, 0xcd, 0x27, 0x51, 0xed, 0x7c, 0x4a, 0x29, 0x57, 0x36, 0x1e,
+	0xfc, 0x79, 0x4e, 0x61, 0x7a, 0x13, 0x6b, 0x5e, 0xd3, 0x28, 0xe7, 0x90, 0xd0, 0x57, 0x00, 0x4b,
+	0x1d, 0xe3, 0x1d, 0x46, 0x74, 0x3d, 0x96, 0x3d, 0x92, 0x39, 0x2d, 0x6a, 0x3e, 0x0d, 0x63, 0xb0,
+	0x39, 0x85, 0xda, 0x3b, 0x02, 0xb0, 0xd4, 0x31, 0xb7, 0x61, 0x98, 0xf5, 0x98, 0xb8, 0x31, 0x97,
+	0x3f, 0x20, 0xf2, 0xd1, 0x48, 0x5f, 0x33, 0x03, 0xea, 0xeb, 0x33, 0x80, 0x17, 0x43, 0xbb, 0xed,
+	0x83, 0x9c, 0x4b, 0x5e, 0x7c, 0xa4, 0x57, 0x66, 0x41, 0x53, 0x9a, 0x33, 0x67, 0x73, 0x52, 0x72,
+	0x1d, 0xae, 0xc2, 0x41, 0xfc, 0x04, 0x70, 0xea, 0xb4, 0x3b, 0xd3, 0xb5, 0x53, 0xd4, 0x38, 0x9f,
+	0x5c, 0xd6, 0x7b, 0x8d, 0xc7, 0x23, 0x78, 0x58, 0xa2, 0x1a, 0xfa, 0x22, 0xdd, 0xd1, 0x6c, 0x6f,
+	0xa1, 0x9b, 0x03, 0x0d, 0x90, 0xf8, 0x31, 0xce, 0xa5, 0xf5, 0x8f, 0x27, 0x15, 0x70, 0x7c, 0x52,
+	0x01, 0xdf, 0x4e, 0x2a, 0xe0, 0xd1, 0xdd, 0xfc, 0x9f, 0xe8, 0x67, 0xfc, 0xc6, 0xd8, 0xf9, 0x57,
+	0x7f, 0x9d, 0xcf, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x11, 0x49, 0x78, 0x8c, 0x0c, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -532,6 +592,7 @@ type WorkflowTemplateServiceClient interface {
 	UpdateWorkflowTemplate(ctx context.Context, in *WorkflowTemplateUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplate, error)
 	DeleteWorkflowTemplate(ctx context.Context, in *WorkflowTemplateDeleteRequest, opts ...grpc.CallOption) (*WorkflowTemplateDeleteResponse, error)
 	LintWorkflowTemplate(ctx context.Context, in *WorkflowTemplateLintRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplate, error)
+	ListWorkflowTemplateRevisions(ctx context.Context, in *WorkflowTemplateRevisionsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplateRevisionList, error)
 }
 
 type workflowTemplateServiceClient struct {
@@ -596,6 +657,15 @@ func (c *workflowTemplateServiceClient) LintWorkflowTemplate(ctx context.Context
 	return out, nil
 }
 
+func (c *workflowTemplateServiceClient) ListWorkflowTemplateRevisions(ctx context.Context, in *WorkflowTemplateRevisionsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplateRevisionList, error) {
+	out := new(v1alpha1.WorkflowTemplateRevisionList)
+	err := c.cc.Invoke(ctx, "/workflowtemplate.WorkflowTemplateService/ListWorkflowTemplateRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkflowTemplateServiceServer is the server API for WorkflowTemplateService service.
 type WorkflowTemplateServiceServer interface {
 	CreateWorkflowTemplate(context.Context, *WorkflowTemplateCreateRequest) (*v1alpha1.WorkflowTemplate, error)
@@ -604,6 +674,7 @@ type WorkflowTemplateServiceServer interface {
 	UpdateWorkflowTemplate(context.Context, *WorkflowTemplateUpdateRequest) (*v1alpha1.WorkflowTemplate, error)
 	DeleteWorkflowTemplate(context.Context, *WorkflowTemplateDeleteRequest) (*WorkflowTemplateDeleteResponse, error)
 	LintWorkflowTemplate(context.Context, *WorkflowTemplateLintRequest) (*v1alpha1.WorkflowTemplate, error)
+	ListWorkflowTemplateRevisions(context.Context, *WorkflowTemplateRevisionsRequest) (*v1alpha1.WorkflowTemplateRevisionList, error)
 }
 
 // UnimplementedWorkflowTemplateServiceServer can be embedded to have forward compatible implementations.
@@ -628,6 +699,9 @@ func (*UnimplementedWorkflowTemplateServiceServer) DeleteWorkflowTemplate(ctx co
 func (*UnimplementedWorkflowTemplateServiceServer) LintWorkflowTemplate(ctx context.Context, req *WorkflowTemplateLintRequest) (*v1alpha1.WorkflowTemplate, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LintWorkflowTemplate not implemented")
 }
+func (*UnimplementedWorkflowTemplateServiceServer) ListWorkflowTemplateRevisions(ctx context.Context, req *WorkflowTemplateRevisionsRequest) (*v1alpha1.WorkflowTemplateRevisionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflowTemplateRevisions not implemented")
+}
 
 func RegisterWorkflowTemplateServiceServer(s *grpc.Server, srv WorkflowTemplateServiceServer) {
 	s.RegisterService(&_WorkflowTemplateService_serviceDesc, srv)
@@ -741,6 +815,24 @@ func _WorkflowTemplateService_LintWorkflowTemplate_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkflowTemplateService_ListWorkflowTemplateRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowTemplateRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowTemplateServiceServer).ListWorkflowTemplateRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowtemplate.WorkflowTemplateService/ListWorkflowTemplateRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowTemplateServiceServer).ListWorkflowTemplateRevisions(ctx, req.(*WorkflowTemplateRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _WorkflowTemplateService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "workflowtemplate.WorkflowTemplateService",
 	HandlerType: (*WorkflowTemplateServiceServer)(nil),
@@ -769,6 +861,10 @@ var _WorkflowTemplateService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LintWorkflowTemplate",
 			Handler:    _WorkflowTemplateService_LintWorkflowTemplate_Handler,
 		},
+		{
+			MethodName: "ListWorkflowTemplateRevisions",
+			Handler:    _WorkflowTemplateService_ListWorkflowTemplateRevisions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/apiclient/workflowtemplate/workflow-template.proto",
@@ -1129,6 +1225,47 @@ func (m *WorkflowTemplateLintRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkflowTemplateRevisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowTemplateRevisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowTemplateRevisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflowTemplate(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflowTemplate(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintWorkflowTemplate(dAtA []byte, offset int, v uint64) int {
 	offset -= sovWorkflowTemplate(v)
 	base := offset
@@ -1296,6 +1433,26 @@ func (m *WorkflowTemplateLintRequest) Size() (n int) {
 	return n
 }
 
+func (m *WorkflowTemplateRevisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflowTemplate(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflowTemplate(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovWorkflowTemplate(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2267,6 +2424,121 @@ func (m *WorkflowTemplateLintRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WorkflowTemplateRevisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowTemplate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowTemplateRevisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowTemplateRevisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowTemplate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipWorkflowTemplate(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
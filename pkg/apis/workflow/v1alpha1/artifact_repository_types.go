@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"path"
 	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var (
@@ -70,6 +72,46 @@ func (a *ArtifactRepository) ToArtifactLocation() *ArtifactLocation {
 	return l
 }
 
+// WorkflowArtifactRepository is an artifact repository that workflows in its namespace can reference by name.
+// The controller periodically probes it and reports whether it is reachable in its status.
+// +genclient
+// +kubebuilder:resource:shortName=wfar
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+type WorkflowArtifactRepository struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Spec              ArtifactRepository               `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	Status            WorkflowArtifactRepositoryStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// WorkflowArtifactRepositoryStatus is the result of the last probe of the repository by the controller
+type WorkflowArtifactRepositoryStatus struct {
+	// Conditions is a list of conditions the repository may have, e.g. whether it is reachable
+	Conditions Conditions `json:"conditions,omitempty" protobuf:"bytes,1,rep,name=conditions"`
+	// LastProbedAt is the time the controller last probed the repository
+	LastProbedAt *metav1.Time `json:"lastProbedAt,omitempty" protobuf:"bytes,2,opt,name=lastProbedAt"`
+}
+
+// Unreachable returns the message of the last probe if it found the repository unreachable.
+// A repository that has never been probed is not unreachable.
+func (s WorkflowArtifactRepositoryStatus) Unreachable() (string, bool) {
+	for _, c := range s.Conditions {
+		if c.Type == ConditionTypeReachable && c.Status == metav1.ConditionFalse {
+			return c.Message, true
+		}
+	}
+	return "", false
+}
+
+// WorkflowArtifactRepositoryList is list of WorkflowArtifactRepository resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type WorkflowArtifactRepositoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []WorkflowArtifactRepository `json:"items" protobuf:"bytes,2,opt,name=items"`
+}
+
 // S3ArtifactRepository defines the controller configuration for an S3 artifact repository
 type S3ArtifactRepository struct {
 	S3Bucket `json:",inline" protobuf:"bytes,1,opt,name=s3Bucket"`
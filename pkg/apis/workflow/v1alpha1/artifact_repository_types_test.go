@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 )
 
@@ -69,3 +70,13 @@ func TestArtifactRepository_IsArchiveLogs(t *testing.T) {
 	assert.False(t, (&ArtifactRepository{ArchiveLogs: ptr.To(false)}).IsArchiveLogs())
 	assert.True(t, (&ArtifactRepository{ArchiveLogs: ptr.To(true)}).IsArchiveLogs())
 }
+
+func TestWorkflowArtifactRepositoryStatus_Unreachable(t *testing.T) {
+	_, unreachable := WorkflowArtifactRepositoryStatus{}.Unreachable()
+	assert.False(t, unreachable)
+	_, unreachable = WorkflowArtifactRepositoryStatus{Conditions: Conditions{{Type: ConditionTypeReachable, Status: metav1.ConditionTrue}}}.Unreachable()
+	assert.False(t, unreachable)
+	message, unreachable := WorkflowArtifactRepositoryStatus{Conditions: Conditions{{Type: ConditionTypeReachable, Status: metav1.ConditionFalse, Message: "bucket not found"}}}.Unreachable()
+	assert.True(t, unreachable)
+	assert.Equal(t, "bucket not found", message)
+}
@@ -98,6 +98,12 @@ type CronWorkflowStatus struct {
 	// v3.6 and after: Phase is an enum of Active or Stopped. It changes to Stopped when stopStrategy.expression is true
 	// +optional
 	Phase CronWorkflowPhase `json:"phase" protobuf:"varint,6,rep,name=phase"`
+	// LastSuccessfulTime is the time the last child workflow to succeed finished
+	// +optional
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty" protobuf:"bytes,7,opt,name=lastSuccessfulTime"`
+	// ConsecutiveFailures counts how many child workflows failed, or failed to be submitted, since the last one succeeded
+	// +optional
+	ConsecutiveFailures int64 `json:"consecutiveFailures,omitempty" protobuf:"varint,8,opt,name=consecutiveFailures"`
 }
 
 type CronWorkflowPhase string
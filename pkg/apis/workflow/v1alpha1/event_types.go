@@ -1,6 +1,9 @@
 package v1alpha1
 
 import (
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -34,6 +37,36 @@ type WorkflowEventBindingSpec struct {
 type Event struct {
 	// Selector (https://github.com/expr-lang/expr) that we must must match the event. E.g. `payload.message == "test"`
 	Selector string `json:"selector" protobuf:"bytes,1,opt,name=selector"`
+	// Idempotency de-duplicates repeated deliveries of the same event
+	Idempotency *EventIdempotency `json:"idempotency,omitempty" protobuf:"bytes,2,opt,name=idempotency"`
+	// Schema is a JSON Schema that the payload must satisfy, events with other payloads are rejected
+	Schema *EventSchema `json:"schema,omitempty" protobuf:"bytes,3,opt,name=schema"`
+}
+
+// EventSchema is a JSON Schema (https://json-schema.org), either inline or in a ConfigMap.
+type EventSchema struct {
+	// Inline is the JSON Schema
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Inline *Object `json:"inline,omitempty" protobuf:"bytes,1,opt,name=inline"`
+	// ConfigMapKeyRef is a ConfigMap key, in the same namespace as the WorkflowEventBinding, that holds the JSON Schema
+	ConfigMapKeyRef *apiv1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty" protobuf:"bytes,2,opt,name=configMapKeyRef"`
+}
+
+// EventIdempotency ensures that at most one workflow is submitted for events with the same key within a window
+type EventIdempotency struct {
+	// Key (https://github.com/expr-lang/expr) that identifies the event, evaluated in the same environment as the selector.
+	// E.g. `metadata["x-delivery-id"][0]` or `payload.id`. It must evaluate to a string.
+	Key string `json:"key" protobuf:"bytes,1,opt,name=key"`
+	// Window is how long a key is remembered for. Defaults to 1h.
+	Window *metav1.Duration `json:"window,omitempty" protobuf:"bytes,2,opt,name=window"`
+}
+
+// GetWindow returns the window, or the default window if not specified
+func (e *EventIdempotency) GetWindow() time.Duration {
+	if e == nil || e.Window == nil {
+		return time.Hour
+	}
+	return e.Window.Duration
 }
 
 type Submit struct {
@@ -14,6 +14,8 @@ import (
 	k8s_io_api_core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
 	v12 "k8s.io/api/policy/v1"
+	k8s_io_apimachinery_pkg_api_resource "k8s.io/apimachinery/pkg/api/resource"
+	resource "k8s.io/apimachinery/pkg/api/resource"
 	k8s_io_apimachinery_pkg_apis_meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v11 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -36,10 +38,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+func (m *AWSSigV4Auth) Reset()      { *m = AWSSigV4Auth{} }
+func (*AWSSigV4Auth) ProtoMessage() {}
+func (*AWSSigV4Auth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{0}
+}
+func (m *AWSSigV4Auth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AWSSigV4Auth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AWSSigV4Auth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AWSSigV4Auth.Merge(m, src)
+}
+func (m *AWSSigV4Auth) XXX_Size() int {
+	return m.Size()
+}
+func (m *AWSSigV4Auth) XXX_DiscardUnknown() {
+	xxx_messageInfo_AWSSigV4Auth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AWSSigV4Auth proto.InternalMessageInfo
+
 func (m *Amount) Reset()      { *m = Amount{} }
 func (*Amount) ProtoMessage() {}
 func (*Amount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{0}
+	return fileDescriptor_724696e352c3df5f, []int{1}
 }
 func (m *Amount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -67,7 +97,7 @@ var xxx_messageInfo_Amount proto.InternalMessageInfo
 func (m *ArchiveStrategy) Reset()      { *m = ArchiveStrategy{} }
 func (*ArchiveStrategy) ProtoMessage() {}
 func (*ArchiveStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{1}
+	return fileDescriptor_724696e352c3df5f, []int{2}
 }
 func (m *ArchiveStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -95,7 +125,7 @@ var xxx_messageInfo_ArchiveStrategy proto.InternalMessageInfo
 func (m *Arguments) Reset()      { *m = Arguments{} }
 func (*Arguments) ProtoMessage() {}
 func (*Arguments) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{2}
+	return fileDescriptor_724696e352c3df5f, []int{3}
 }
 func (m *Arguments) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,7 +153,7 @@ var xxx_messageInfo_Arguments proto.InternalMessageInfo
 func (m *ArtGCStatus) Reset()      { *m = ArtGCStatus{} }
 func (*ArtGCStatus) ProtoMessage() {}
 func (*ArtGCStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{3}
+	return fileDescriptor_724696e352c3df5f, []int{4}
 }
 func (m *ArtGCStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -151,7 +181,7 @@ var xxx_messageInfo_ArtGCStatus proto.InternalMessageInfo
 func (m *Artifact) Reset()      { *m = Artifact{} }
 func (*Artifact) ProtoMessage() {}
 func (*Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{4}
+	return fileDescriptor_724696e352c3df5f, []int{5}
 }
 func (m *Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,7 +209,7 @@ var xxx_messageInfo_Artifact proto.InternalMessageInfo
 func (m *ArtifactGC) Reset()      { *m = ArtifactGC{} }
 func (*ArtifactGC) ProtoMessage() {}
 func (*ArtifactGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{5}
+	return fileDescriptor_724696e352c3df5f, []int{6}
 }
 func (m *ArtifactGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -207,7 +237,7 @@ var xxx_messageInfo_ArtifactGC proto.InternalMessageInfo
 func (m *ArtifactGCSpec) Reset()      { *m = ArtifactGCSpec{} }
 func (*ArtifactGCSpec) ProtoMessage() {}
 func (*ArtifactGCSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{6}
+	return fileDescriptor_724696e352c3df5f, []int{7}
 }
 func (m *ArtifactGCSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,7 +265,7 @@ var xxx_messageInfo_ArtifactGCSpec proto.InternalMessageInfo
 func (m *ArtifactGCStatus) Reset()      { *m = ArtifactGCStatus{} }
 func (*ArtifactGCStatus) ProtoMessage() {}
 func (*ArtifactGCStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{7}
+	return fileDescriptor_724696e352c3df5f, []int{8}
 }
 func (m *ArtifactGCStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,7 +293,7 @@ var xxx_messageInfo_ArtifactGCStatus proto.InternalMessageInfo
 func (m *ArtifactLocation) Reset()      { *m = ArtifactLocation{} }
 func (*ArtifactLocation) ProtoMessage() {}
 func (*ArtifactLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{8}
+	return fileDescriptor_724696e352c3df5f, []int{9}
 }
 func (m *ArtifactLocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,7 +321,7 @@ var xxx_messageInfo_ArtifactLocation proto.InternalMessageInfo
 func (m *ArtifactNodeSpec) Reset()      { *m = ArtifactNodeSpec{} }
 func (*ArtifactNodeSpec) ProtoMessage() {}
 func (*ArtifactNodeSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{9}
+	return fileDescriptor_724696e352c3df5f, []int{10}
 }
 func (m *ArtifactNodeSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +349,7 @@ var xxx_messageInfo_ArtifactNodeSpec proto.InternalMessageInfo
 func (m *ArtifactPaths) Reset()      { *m = ArtifactPaths{} }
 func (*ArtifactPaths) ProtoMessage() {}
 func (*ArtifactPaths) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{10}
+	return fileDescriptor_724696e352c3df5f, []int{11}
 }
 func (m *ArtifactPaths) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +377,7 @@ var xxx_messageInfo_ArtifactPaths proto.InternalMessageInfo
 func (m *ArtifactRepository) Reset()      { *m = ArtifactRepository{} }
 func (*ArtifactRepository) ProtoMessage() {}
 func (*ArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{11}
+	return fileDescriptor_724696e352c3df5f, []int{12}
 }
 func (m *ArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -375,7 +405,7 @@ var xxx_messageInfo_ArtifactRepository proto.InternalMessageInfo
 func (m *ArtifactRepositoryRef) Reset()      { *m = ArtifactRepositoryRef{} }
 func (*ArtifactRepositoryRef) ProtoMessage() {}
 func (*ArtifactRepositoryRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{12}
+	return fileDescriptor_724696e352c3df5f, []int{13}
 }
 func (m *ArtifactRepositoryRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +433,7 @@ var xxx_messageInfo_ArtifactRepositoryRef proto.InternalMessageInfo
 func (m *ArtifactRepositoryRefStatus) Reset()      { *m = ArtifactRepositoryRefStatus{} }
 func (*ArtifactRepositoryRefStatus) ProtoMessage() {}
 func (*ArtifactRepositoryRefStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{13}
+	return fileDescriptor_724696e352c3df5f, []int{14}
 }
 func (m *ArtifactRepositoryRefStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -431,7 +461,7 @@ var xxx_messageInfo_ArtifactRepositoryRefStatus proto.InternalMessageInfo
 func (m *ArtifactResult) Reset()      { *m = ArtifactResult{} }
 func (*ArtifactResult) ProtoMessage() {}
 func (*ArtifactResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{14}
+	return fileDescriptor_724696e352c3df5f, []int{15}
 }
 func (m *ArtifactResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +489,7 @@ var xxx_messageInfo_ArtifactResult proto.InternalMessageInfo
 func (m *ArtifactResultNodeStatus) Reset()      { *m = ArtifactResultNodeStatus{} }
 func (*ArtifactResultNodeStatus) ProtoMessage() {}
 func (*ArtifactResultNodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{15}
+	return fileDescriptor_724696e352c3df5f, []int{16}
 }
 func (m *ArtifactResultNodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +517,7 @@ var xxx_messageInfo_ArtifactResultNodeStatus proto.InternalMessageInfo
 func (m *ArtifactSearchQuery) Reset()      { *m = ArtifactSearchQuery{} }
 func (*ArtifactSearchQuery) ProtoMessage() {}
 func (*ArtifactSearchQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{16}
+	return fileDescriptor_724696e352c3df5f, []int{17}
 }
 func (m *ArtifactSearchQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +545,7 @@ var xxx_messageInfo_ArtifactSearchQuery proto.InternalMessageInfo
 func (m *ArtifactSearchResult) Reset()      { *m = ArtifactSearchResult{} }
 func (*ArtifactSearchResult) ProtoMessage() {}
 func (*ArtifactSearchResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{17}
+	return fileDescriptor_724696e352c3df5f, []int{18}
 }
 func (m *ArtifactSearchResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,7 +573,7 @@ var xxx_messageInfo_ArtifactSearchResult proto.InternalMessageInfo
 func (m *ArtifactoryArtifact) Reset()      { *m = ArtifactoryArtifact{} }
 func (*ArtifactoryArtifact) ProtoMessage() {}
 func (*ArtifactoryArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{18}
+	return fileDescriptor_724696e352c3df5f, []int{19}
 }
 func (m *ArtifactoryArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +601,7 @@ var xxx_messageInfo_ArtifactoryArtifact proto.InternalMessageInfo
 func (m *ArtifactoryArtifactRepository) Reset()      { *m = ArtifactoryArtifactRepository{} }
 func (*ArtifactoryArtifactRepository) ProtoMessage() {}
 func (*ArtifactoryArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{19}
+	return fileDescriptor_724696e352c3df5f, []int{20}
 }
 func (m *ArtifactoryArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,7 +629,7 @@ var xxx_messageInfo_ArtifactoryArtifactRepository proto.InternalMessageInfo
 func (m *ArtifactoryAuth) Reset()      { *m = ArtifactoryAuth{} }
 func (*ArtifactoryAuth) ProtoMessage() {}
 func (*ArtifactoryAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{20}
+	return fileDescriptor_724696e352c3df5f, []int{21}
 }
 func (m *ArtifactoryAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,7 +657,7 @@ var xxx_messageInfo_ArtifactoryAuth proto.InternalMessageInfo
 func (m *AzureArtifact) Reset()      { *m = AzureArtifact{} }
 func (*AzureArtifact) ProtoMessage() {}
 func (*AzureArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{21}
+	return fileDescriptor_724696e352c3df5f, []int{22}
 }
 func (m *AzureArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +685,7 @@ var xxx_messageInfo_AzureArtifact proto.InternalMessageInfo
 func (m *AzureArtifactRepository) Reset()      { *m = AzureArtifactRepository{} }
 func (*AzureArtifactRepository) ProtoMessage() {}
 func (*AzureArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{22}
+	return fileDescriptor_724696e352c3df5f, []int{23}
 }
 func (m *AzureArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +713,7 @@ var xxx_messageInfo_AzureArtifactRepository proto.InternalMessageInfo
 func (m *AzureBlobContainer) Reset()      { *m = AzureBlobContainer{} }
 func (*AzureBlobContainer) ProtoMessage() {}
 func (*AzureBlobContainer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{23}
+	return fileDescriptor_724696e352c3df5f, []int{24}
 }
 func (m *AzureBlobContainer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,7 +741,7 @@ var xxx_messageInfo_AzureBlobContainer proto.InternalMessageInfo
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{24}
+	return fileDescriptor_724696e352c3df5f, []int{25}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -739,7 +769,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *BasicAuth) Reset()      { *m = BasicAuth{} }
 func (*BasicAuth) ProtoMessage() {}
 func (*BasicAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{25}
+	return fileDescriptor_724696e352c3df5f, []int{26}
 }
 func (m *BasicAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,7 +797,7 @@ var xxx_messageInfo_BasicAuth proto.InternalMessageInfo
 func (m *Cache) Reset()      { *m = Cache{} }
 func (*Cache) ProtoMessage() {}
 func (*Cache) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{26}
+	return fileDescriptor_724696e352c3df5f, []int{27}
 }
 func (m *Cache) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -792,10 +822,38 @@ func (m *Cache) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Cache proto.InternalMessageInfo
 
+func (m *ChildWorkflowTemplate) Reset()      { *m = ChildWorkflowTemplate{} }
+func (*ChildWorkflowTemplate) ProtoMessage() {}
+func (*ChildWorkflowTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{28}
+}
+func (m *ChildWorkflowTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChildWorkflowTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ChildWorkflowTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChildWorkflowTemplate.Merge(m, src)
+}
+func (m *ChildWorkflowTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChildWorkflowTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChildWorkflowTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChildWorkflowTemplate proto.InternalMessageInfo
+
 func (m *ClientCertAuth) Reset()      { *m = ClientCertAuth{} }
 func (*ClientCertAuth) ProtoMessage() {}
 func (*ClientCertAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{27}
+	return fileDescriptor_724696e352c3df5f, []int{29}
 }
 func (m *ClientCertAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -823,7 +881,7 @@ var xxx_messageInfo_ClientCertAuth proto.InternalMessageInfo
 func (m *ClusterWorkflowTemplate) Reset()      { *m = ClusterWorkflowTemplate{} }
 func (*ClusterWorkflowTemplate) ProtoMessage() {}
 func (*ClusterWorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{28}
+	return fileDescriptor_724696e352c3df5f, []int{30}
 }
 func (m *ClusterWorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +909,7 @@ var xxx_messageInfo_ClusterWorkflowTemplate proto.InternalMessageInfo
 func (m *ClusterWorkflowTemplateList) Reset()      { *m = ClusterWorkflowTemplateList{} }
 func (*ClusterWorkflowTemplateList) ProtoMessage() {}
 func (*ClusterWorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{29}
+	return fileDescriptor_724696e352c3df5f, []int{31}
 }
 func (m *ClusterWorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +937,7 @@ var xxx_messageInfo_ClusterWorkflowTemplateList proto.InternalMessageInfo
 func (m *Column) Reset()      { *m = Column{} }
 func (*Column) ProtoMessage() {}
 func (*Column) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{30}
+	return fileDescriptor_724696e352c3df5f, []int{32}
 }
 func (m *Column) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -907,7 +965,7 @@ var xxx_messageInfo_Column proto.InternalMessageInfo
 func (m *Condition) Reset()      { *m = Condition{} }
 func (*Condition) ProtoMessage() {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{31}
+	return fileDescriptor_724696e352c3df5f, []int{33}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -935,7 +993,7 @@ var xxx_messageInfo_Condition proto.InternalMessageInfo
 func (m *ContainerNode) Reset()      { *m = ContainerNode{} }
 func (*ContainerNode) ProtoMessage() {}
 func (*ContainerNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{32}
+	return fileDescriptor_724696e352c3df5f, []int{34}
 }
 func (m *ContainerNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -963,7 +1021,7 @@ var xxx_messageInfo_ContainerNode proto.InternalMessageInfo
 func (m *ContainerSetRetryStrategy) Reset()      { *m = ContainerSetRetryStrategy{} }
 func (*ContainerSetRetryStrategy) ProtoMessage() {}
 func (*ContainerSetRetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{33}
+	return fileDescriptor_724696e352c3df5f, []int{35}
 }
 func (m *ContainerSetRetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -991,7 +1049,7 @@ var xxx_messageInfo_ContainerSetRetryStrategy proto.InternalMessageInfo
 func (m *ContainerSetTemplate) Reset()      { *m = ContainerSetTemplate{} }
 func (*ContainerSetTemplate) ProtoMessage() {}
 func (*ContainerSetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{34}
+	return fileDescriptor_724696e352c3df5f, []int{36}
 }
 func (m *ContainerSetTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1077,7 @@ var xxx_messageInfo_ContainerSetTemplate proto.InternalMessageInfo
 func (m *ContinueOn) Reset()      { *m = ContinueOn{} }
 func (*ContinueOn) ProtoMessage() {}
 func (*ContinueOn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{35}
+	return fileDescriptor_724696e352c3df5f, []int{37}
 }
 func (m *ContinueOn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1047,7 +1105,7 @@ var xxx_messageInfo_ContinueOn proto.InternalMessageInfo
 func (m *Counter) Reset()      { *m = Counter{} }
 func (*Counter) ProtoMessage() {}
 func (*Counter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{36}
+	return fileDescriptor_724696e352c3df5f, []int{38}
 }
 func (m *Counter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1075,7 +1133,7 @@ var xxx_messageInfo_Counter proto.InternalMessageInfo
 func (m *CreateS3BucketOptions) Reset()      { *m = CreateS3BucketOptions{} }
 func (*CreateS3BucketOptions) ProtoMessage() {}
 func (*CreateS3BucketOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{37}
+	return fileDescriptor_724696e352c3df5f, []int{39}
 }
 func (m *CreateS3BucketOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1103,7 +1161,7 @@ var xxx_messageInfo_CreateS3BucketOptions proto.InternalMessageInfo
 func (m *CronWorkflow) Reset()      { *m = CronWorkflow{} }
 func (*CronWorkflow) ProtoMessage() {}
 func (*CronWorkflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{38}
+	return fileDescriptor_724696e352c3df5f, []int{40}
 }
 func (m *CronWorkflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1131,7 +1189,7 @@ var xxx_messageInfo_CronWorkflow proto.InternalMessageInfo
 func (m *CronWorkflowList) Reset()      { *m = CronWorkflowList{} }
 func (*CronWorkflowList) ProtoMessage() {}
 func (*CronWorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{39}
+	return fileDescriptor_724696e352c3df5f, []int{41}
 }
 func (m *CronWorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1159,7 +1217,7 @@ var xxx_messageInfo_CronWorkflowList proto.InternalMessageInfo
 func (m *CronWorkflowSpec) Reset()      { *m = CronWorkflowSpec{} }
 func (*CronWorkflowSpec) ProtoMessage() {}
 func (*CronWorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{40}
+	return fileDescriptor_724696e352c3df5f, []int{42}
 }
 func (m *CronWorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1187,7 +1245,7 @@ var xxx_messageInfo_CronWorkflowSpec proto.InternalMessageInfo
 func (m *CronWorkflowStatus) Reset()      { *m = CronWorkflowStatus{} }
 func (*CronWorkflowStatus) ProtoMessage() {}
 func (*CronWorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{41}
+	return fileDescriptor_724696e352c3df5f, []int{43}
 }
 func (m *CronWorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1212,10 +1270,94 @@ func (m *CronWorkflowStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CronWorkflowStatus proto.InternalMessageInfo
 
+func (m *DAGHook) Reset()      { *m = DAGHook{} }
+func (*DAGHook) ProtoMessage() {}
+func (*DAGHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{44}
+}
+func (m *DAGHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DAGHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DAGHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DAGHook.Merge(m, src)
+}
+func (m *DAGHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *DAGHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_DAGHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DAGHook proto.InternalMessageInfo
+
+func (m *DAGOneOf) Reset()      { *m = DAGOneOf{} }
+func (*DAGOneOf) ProtoMessage() {}
+func (*DAGOneOf) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{45}
+}
+func (m *DAGOneOf) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DAGOneOf) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DAGOneOf) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DAGOneOf.Merge(m, src)
+}
+func (m *DAGOneOf) XXX_Size() int {
+	return m.Size()
+}
+func (m *DAGOneOf) XXX_DiscardUnknown() {
+	xxx_messageInfo_DAGOneOf.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DAGOneOf proto.InternalMessageInfo
+
+func (m *DAGOneOfBranch) Reset()      { *m = DAGOneOfBranch{} }
+func (*DAGOneOfBranch) ProtoMessage() {}
+func (*DAGOneOfBranch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{46}
+}
+func (m *DAGOneOfBranch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DAGOneOfBranch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DAGOneOfBranch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DAGOneOfBranch.Merge(m, src)
+}
+func (m *DAGOneOfBranch) XXX_Size() int {
+	return m.Size()
+}
+func (m *DAGOneOfBranch) XXX_DiscardUnknown() {
+	xxx_messageInfo_DAGOneOfBranch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DAGOneOfBranch proto.InternalMessageInfo
+
 func (m *DAGTask) Reset()      { *m = DAGTask{} }
 func (*DAGTask) ProtoMessage() {}
 func (*DAGTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{42}
+	return fileDescriptor_724696e352c3df5f, []int{47}
 }
 func (m *DAGTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,7 +1385,7 @@ var xxx_messageInfo_DAGTask proto.InternalMessageInfo
 func (m *DAGTemplate) Reset()      { *m = DAGTemplate{} }
 func (*DAGTemplate) ProtoMessage() {}
 func (*DAGTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{43}
+	return fileDescriptor_724696e352c3df5f, []int{48}
 }
 func (m *DAGTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1271,7 +1413,7 @@ var xxx_messageInfo_DAGTemplate proto.InternalMessageInfo
 func (m *Data) Reset()      { *m = Data{} }
 func (*Data) ProtoMessage() {}
 func (*Data) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{44}
+	return fileDescriptor_724696e352c3df5f, []int{49}
 }
 func (m *Data) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1441,7 @@ var xxx_messageInfo_Data proto.InternalMessageInfo
 func (m *DataSource) Reset()      { *m = DataSource{} }
 func (*DataSource) ProtoMessage() {}
 func (*DataSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{45}
+	return fileDescriptor_724696e352c3df5f, []int{50}
 }
 func (m *DataSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1327,7 +1469,7 @@ var xxx_messageInfo_DataSource proto.InternalMessageInfo
 func (m *Event) Reset()      { *m = Event{} }
 func (*Event) ProtoMessage() {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{46}
+	return fileDescriptor_724696e352c3df5f, []int{51}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1352,10 +1494,66 @@ func (m *Event) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Event proto.InternalMessageInfo
 
+func (m *EventIdempotency) Reset()      { *m = EventIdempotency{} }
+func (*EventIdempotency) ProtoMessage() {}
+func (*EventIdempotency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{52}
+}
+func (m *EventIdempotency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventIdempotency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventIdempotency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventIdempotency.Merge(m, src)
+}
+func (m *EventIdempotency) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventIdempotency) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventIdempotency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventIdempotency proto.InternalMessageInfo
+
+func (m *EventSchema) Reset()      { *m = EventSchema{} }
+func (*EventSchema) ProtoMessage() {}
+func (*EventSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{53}
+}
+func (m *EventSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSchema.Merge(m, src)
+}
+func (m *EventSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSchema proto.InternalMessageInfo
+
 func (m *ExecutorConfig) Reset()      { *m = ExecutorConfig{} }
 func (*ExecutorConfig) ProtoMessage() {}
 func (*ExecutorConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{47}
+	return fileDescriptor_724696e352c3df5f, []int{54}
 }
 func (m *ExecutorConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1383,7 +1581,7 @@ var xxx_messageInfo_ExecutorConfig proto.InternalMessageInfo
 func (m *GCSArtifact) Reset()      { *m = GCSArtifact{} }
 func (*GCSArtifact) ProtoMessage() {}
 func (*GCSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{48}
+	return fileDescriptor_724696e352c3df5f, []int{55}
 }
 func (m *GCSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1411,7 +1609,7 @@ var xxx_messageInfo_GCSArtifact proto.InternalMessageInfo
 func (m *GCSArtifactRepository) Reset()      { *m = GCSArtifactRepository{} }
 func (*GCSArtifactRepository) ProtoMessage() {}
 func (*GCSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{49}
+	return fileDescriptor_724696e352c3df5f, []int{56}
 }
 func (m *GCSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1439,7 +1637,7 @@ var xxx_messageInfo_GCSArtifactRepository proto.InternalMessageInfo
 func (m *GCSBucket) Reset()      { *m = GCSBucket{} }
 func (*GCSBucket) ProtoMessage() {}
 func (*GCSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{50}
+	return fileDescriptor_724696e352c3df5f, []int{57}
 }
 func (m *GCSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1464,10 +1662,38 @@ func (m *GCSBucket) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GCSBucket proto.InternalMessageInfo
 
+func (m *GPURequest) Reset()      { *m = GPURequest{} }
+func (*GPURequest) ProtoMessage() {}
+func (*GPURequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{58}
+}
+func (m *GPURequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GPURequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GPURequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GPURequest.Merge(m, src)
+}
+func (m *GPURequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GPURequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GPURequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GPURequest proto.InternalMessageInfo
+
 func (m *Gauge) Reset()      { *m = Gauge{} }
 func (*Gauge) ProtoMessage() {}
 func (*Gauge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{51}
+	return fileDescriptor_724696e352c3df5f, []int{59}
 }
 func (m *Gauge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1495,7 +1721,7 @@ var xxx_messageInfo_Gauge proto.InternalMessageInfo
 func (m *GitArtifact) Reset()      { *m = GitArtifact{} }
 func (*GitArtifact) ProtoMessage() {}
 func (*GitArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{52}
+	return fileDescriptor_724696e352c3df5f, []int{60}
 }
 func (m *GitArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1523,7 +1749,7 @@ var xxx_messageInfo_GitArtifact proto.InternalMessageInfo
 func (m *HDFSArtifact) Reset()      { *m = HDFSArtifact{} }
 func (*HDFSArtifact) ProtoMessage() {}
 func (*HDFSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{53}
+	return fileDescriptor_724696e352c3df5f, []int{61}
 }
 func (m *HDFSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1551,7 +1777,7 @@ var xxx_messageInfo_HDFSArtifact proto.InternalMessageInfo
 func (m *HDFSArtifactRepository) Reset()      { *m = HDFSArtifactRepository{} }
 func (*HDFSArtifactRepository) ProtoMessage() {}
 func (*HDFSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{54}
+	return fileDescriptor_724696e352c3df5f, []int{62}
 }
 func (m *HDFSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,7 +1805,7 @@ var xxx_messageInfo_HDFSArtifactRepository proto.InternalMessageInfo
 func (m *HDFSConfig) Reset()      { *m = HDFSConfig{} }
 func (*HDFSConfig) ProtoMessage() {}
 func (*HDFSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{55}
+	return fileDescriptor_724696e352c3df5f, []int{63}
 }
 func (m *HDFSConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1607,7 +1833,7 @@ var xxx_messageInfo_HDFSConfig proto.InternalMessageInfo
 func (m *HDFSKrbConfig) Reset()      { *m = HDFSKrbConfig{} }
 func (*HDFSKrbConfig) ProtoMessage() {}
 func (*HDFSKrbConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{56}
+	return fileDescriptor_724696e352c3df5f, []int{64}
 }
 func (m *HDFSKrbConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +1861,7 @@ var xxx_messageInfo_HDFSKrbConfig proto.InternalMessageInfo
 func (m *HTTP) Reset()      { *m = HTTP{} }
 func (*HTTP) ProtoMessage() {}
 func (*HTTP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{57}
+	return fileDescriptor_724696e352c3df5f, []int{65}
 }
 func (m *HTTP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1663,7 +1889,7 @@ var xxx_messageInfo_HTTP proto.InternalMessageInfo
 func (m *HTTPArtifact) Reset()      { *m = HTTPArtifact{} }
 func (*HTTPArtifact) ProtoMessage() {}
 func (*HTTPArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{58}
+	return fileDescriptor_724696e352c3df5f, []int{66}
 }
 func (m *HTTPArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1691,7 +1917,7 @@ var xxx_messageInfo_HTTPArtifact proto.InternalMessageInfo
 func (m *HTTPAuth) Reset()      { *m = HTTPAuth{} }
 func (*HTTPAuth) ProtoMessage() {}
 func (*HTTPAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{59}
+	return fileDescriptor_724696e352c3df5f, []int{67}
 }
 func (m *HTTPAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,7 +1945,7 @@ var xxx_messageInfo_HTTPAuth proto.InternalMessageInfo
 func (m *HTTPBodySource) Reset()      { *m = HTTPBodySource{} }
 func (*HTTPBodySource) ProtoMessage() {}
 func (*HTTPBodySource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{60}
+	return fileDescriptor_724696e352c3df5f, []int{68}
 }
 func (m *HTTPBodySource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1747,7 +1973,7 @@ var xxx_messageInfo_HTTPBodySource proto.InternalMessageInfo
 func (m *HTTPHeader) Reset()      { *m = HTTPHeader{} }
 func (*HTTPHeader) ProtoMessage() {}
 func (*HTTPHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{61}
+	return fileDescriptor_724696e352c3df5f, []int{69}
 }
 func (m *HTTPHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1775,7 +2001,7 @@ var xxx_messageInfo_HTTPHeader proto.InternalMessageInfo
 func (m *HTTPHeaderSource) Reset()      { *m = HTTPHeaderSource{} }
 func (*HTTPHeaderSource) ProtoMessage() {}
 func (*HTTPHeaderSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{62}
+	return fileDescriptor_724696e352c3df5f, []int{70}
 }
 func (m *HTTPHeaderSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1800,10 +2026,38 @@ func (m *HTTPHeaderSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HTTPHeaderSource proto.InternalMessageInfo
 
+func (m *HTTPTemplateAuth) Reset()      { *m = HTTPTemplateAuth{} }
+func (*HTTPTemplateAuth) ProtoMessage() {}
+func (*HTTPTemplateAuth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{71}
+}
+func (m *HTTPTemplateAuth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPTemplateAuth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPTemplateAuth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPTemplateAuth.Merge(m, src)
+}
+func (m *HTTPTemplateAuth) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPTemplateAuth) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPTemplateAuth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPTemplateAuth proto.InternalMessageInfo
+
 func (m *Header) Reset()      { *m = Header{} }
 func (*Header) ProtoMessage() {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{63}
+	return fileDescriptor_724696e352c3df5f, []int{72}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1831,7 +2085,7 @@ var xxx_messageInfo_Header proto.InternalMessageInfo
 func (m *Histogram) Reset()      { *m = Histogram{} }
 func (*Histogram) ProtoMessage() {}
 func (*Histogram) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{64}
+	return fileDescriptor_724696e352c3df5f, []int{73}
 }
 func (m *Histogram) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1859,7 +2113,7 @@ var xxx_messageInfo_Histogram proto.InternalMessageInfo
 func (m *Inputs) Reset()      { *m = Inputs{} }
 func (*Inputs) ProtoMessage() {}
 func (*Inputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{65}
+	return fileDescriptor_724696e352c3df5f, []int{74}
 }
 func (m *Inputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1887,7 +2141,7 @@ var xxx_messageInfo_Inputs proto.InternalMessageInfo
 func (m *Item) Reset()      { *m = Item{} }
 func (*Item) ProtoMessage() {}
 func (*Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{66}
+	return fileDescriptor_724696e352c3df5f, []int{75}
 }
 func (m *Item) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1912,10 +2166,38 @@ func (m *Item) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Item proto.InternalMessageInfo
 
+func (m *JobTemplate) Reset()      { *m = JobTemplate{} }
+func (*JobTemplate) ProtoMessage() {}
+func (*JobTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{76}
+}
+func (m *JobTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *JobTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobTemplate.Merge(m, src)
+}
+func (m *JobTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobTemplate proto.InternalMessageInfo
+
 func (m *LabelKeys) Reset()      { *m = LabelKeys{} }
 func (*LabelKeys) ProtoMessage() {}
 func (*LabelKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{67}
+	return fileDescriptor_724696e352c3df5f, []int{77}
 }
 func (m *LabelKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1943,7 +2225,7 @@ var xxx_messageInfo_LabelKeys proto.InternalMessageInfo
 func (m *LabelValueFrom) Reset()      { *m = LabelValueFrom{} }
 func (*LabelValueFrom) ProtoMessage() {}
 func (*LabelValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{68}
+	return fileDescriptor_724696e352c3df5f, []int{78}
 }
 func (m *LabelValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1971,7 +2253,7 @@ var xxx_messageInfo_LabelValueFrom proto.InternalMessageInfo
 func (m *LabelValues) Reset()      { *m = LabelValues{} }
 func (*LabelValues) ProtoMessage() {}
 func (*LabelValues) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{69}
+	return fileDescriptor_724696e352c3df5f, []int{79}
 }
 func (m *LabelValues) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1999,7 +2281,7 @@ var xxx_messageInfo_LabelValues proto.InternalMessageInfo
 func (m *LifecycleHook) Reset()      { *m = LifecycleHook{} }
 func (*LifecycleHook) ProtoMessage() {}
 func (*LifecycleHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{70}
+	return fileDescriptor_724696e352c3df5f, []int{80}
 }
 func (m *LifecycleHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2027,7 +2309,7 @@ var xxx_messageInfo_LifecycleHook proto.InternalMessageInfo
 func (m *Link) Reset()      { *m = Link{} }
 func (*Link) ProtoMessage() {}
 func (*Link) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{71}
+	return fileDescriptor_724696e352c3df5f, []int{81}
 }
 func (m *Link) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2055,7 +2337,7 @@ var xxx_messageInfo_Link proto.InternalMessageInfo
 func (m *ManifestFrom) Reset()      { *m = ManifestFrom{} }
 func (*ManifestFrom) ProtoMessage() {}
 func (*ManifestFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{72}
+	return fileDescriptor_724696e352c3df5f, []int{82}
 }
 func (m *ManifestFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2083,7 +2365,7 @@ var xxx_messageInfo_ManifestFrom proto.InternalMessageInfo
 func (m *MemoizationStatus) Reset()      { *m = MemoizationStatus{} }
 func (*MemoizationStatus) ProtoMessage() {}
 func (*MemoizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{73}
+	return fileDescriptor_724696e352c3df5f, []int{83}
 }
 func (m *MemoizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2111,7 +2393,7 @@ var xxx_messageInfo_MemoizationStatus proto.InternalMessageInfo
 func (m *Memoize) Reset()      { *m = Memoize{} }
 func (*Memoize) ProtoMessage() {}
 func (*Memoize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{74}
+	return fileDescriptor_724696e352c3df5f, []int{84}
 }
 func (m *Memoize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2139,7 +2421,7 @@ var xxx_messageInfo_Memoize proto.InternalMessageInfo
 func (m *Metadata) Reset()      { *m = Metadata{} }
 func (*Metadata) ProtoMessage() {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{75}
+	return fileDescriptor_724696e352c3df5f, []int{85}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2167,7 +2449,7 @@ var xxx_messageInfo_Metadata proto.InternalMessageInfo
 func (m *MetricLabel) Reset()      { *m = MetricLabel{} }
 func (*MetricLabel) ProtoMessage() {}
 func (*MetricLabel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{76}
+	return fileDescriptor_724696e352c3df5f, []int{86}
 }
 func (m *MetricLabel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2195,7 +2477,7 @@ var xxx_messageInfo_MetricLabel proto.InternalMessageInfo
 func (m *Metrics) Reset()      { *m = Metrics{} }
 func (*Metrics) ProtoMessage() {}
 func (*Metrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{77}
+	return fileDescriptor_724696e352c3df5f, []int{87}
 }
 func (m *Metrics) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2223,7 +2505,7 @@ var xxx_messageInfo_Metrics proto.InternalMessageInfo
 func (m *Mutex) Reset()      { *m = Mutex{} }
 func (*Mutex) ProtoMessage() {}
 func (*Mutex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{78}
+	return fileDescriptor_724696e352c3df5f, []int{88}
 }
 func (m *Mutex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2251,7 +2533,7 @@ var xxx_messageInfo_Mutex proto.InternalMessageInfo
 func (m *MutexHolding) Reset()      { *m = MutexHolding{} }
 func (*MutexHolding) ProtoMessage() {}
 func (*MutexHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{79}
+	return fileDescriptor_724696e352c3df5f, []int{89}
 }
 func (m *MutexHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2279,7 +2561,7 @@ var xxx_messageInfo_MutexHolding proto.InternalMessageInfo
 func (m *MutexStatus) Reset()      { *m = MutexStatus{} }
 func (*MutexStatus) ProtoMessage() {}
 func (*MutexStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{80}
+	return fileDescriptor_724696e352c3df5f, []int{90}
 }
 func (m *MutexStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2307,7 +2589,7 @@ var xxx_messageInfo_MutexStatus proto.InternalMessageInfo
 func (m *NodeFlag) Reset()      { *m = NodeFlag{} }
 func (*NodeFlag) ProtoMessage() {}
 func (*NodeFlag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{81}
+	return fileDescriptor_724696e352c3df5f, []int{91}
 }
 func (m *NodeFlag) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2335,7 +2617,7 @@ var xxx_messageInfo_NodeFlag proto.InternalMessageInfo
 func (m *NodeResult) Reset()      { *m = NodeResult{} }
 func (*NodeResult) ProtoMessage() {}
 func (*NodeResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{82}
+	return fileDescriptor_724696e352c3df5f, []int{92}
 }
 func (m *NodeResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2363,7 +2645,7 @@ var xxx_messageInfo_NodeResult proto.InternalMessageInfo
 func (m *NodeStatus) Reset()      { *m = NodeStatus{} }
 func (*NodeStatus) ProtoMessage() {}
 func (*NodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{83}
+	return fileDescriptor_724696e352c3df5f, []int{93}
 }
 func (m *NodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2391,7 +2673,7 @@ var xxx_messageInfo_NodeStatus proto.InternalMessageInfo
 func (m *NodeSynchronizationStatus) Reset()      { *m = NodeSynchronizationStatus{} }
 func (*NodeSynchronizationStatus) ProtoMessage() {}
 func (*NodeSynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{84}
+	return fileDescriptor_724696e352c3df5f, []int{94}
 }
 func (m *NodeSynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2419,7 +2701,7 @@ var xxx_messageInfo_NodeSynchronizationStatus proto.InternalMessageInfo
 func (m *NoneStrategy) Reset()      { *m = NoneStrategy{} }
 func (*NoneStrategy) ProtoMessage() {}
 func (*NoneStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{85}
+	return fileDescriptor_724696e352c3df5f, []int{95}
 }
 func (m *NoneStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2444,10 +2726,38 @@ func (m *NoneStrategy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NoneStrategy proto.InternalMessageInfo
 
+func (m *Notification) Reset()      { *m = Notification{} }
+func (*Notification) ProtoMessage() {}
+func (*Notification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{96}
+}
+func (m *Notification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Notification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Notification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notification.Merge(m, src)
+}
+func (m *Notification) XXX_Size() int {
+	return m.Size()
+}
+func (m *Notification) XXX_DiscardUnknown() {
+	xxx_messageInfo_Notification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Notification proto.InternalMessageInfo
+
 func (m *OAuth2Auth) Reset()      { *m = OAuth2Auth{} }
 func (*OAuth2Auth) ProtoMessage() {}
 func (*OAuth2Auth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{86}
+	return fileDescriptor_724696e352c3df5f, []int{97}
 }
 func (m *OAuth2Auth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2475,7 +2785,7 @@ var xxx_messageInfo_OAuth2Auth proto.InternalMessageInfo
 func (m *OAuth2EndpointParam) Reset()      { *m = OAuth2EndpointParam{} }
 func (*OAuth2EndpointParam) ProtoMessage() {}
 func (*OAuth2EndpointParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{87}
+	return fileDescriptor_724696e352c3df5f, []int{98}
 }
 func (m *OAuth2EndpointParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2503,7 +2813,7 @@ var xxx_messageInfo_OAuth2EndpointParam proto.InternalMessageInfo
 func (m *OSSArtifact) Reset()      { *m = OSSArtifact{} }
 func (*OSSArtifact) ProtoMessage() {}
 func (*OSSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{88}
+	return fileDescriptor_724696e352c3df5f, []int{99}
 }
 func (m *OSSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2531,7 +2841,7 @@ var xxx_messageInfo_OSSArtifact proto.InternalMessageInfo
 func (m *OSSArtifactRepository) Reset()      { *m = OSSArtifactRepository{} }
 func (*OSSArtifactRepository) ProtoMessage() {}
 func (*OSSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{89}
+	return fileDescriptor_724696e352c3df5f, []int{100}
 }
 func (m *OSSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2559,7 +2869,7 @@ var xxx_messageInfo_OSSArtifactRepository proto.InternalMessageInfo
 func (m *OSSBucket) Reset()      { *m = OSSBucket{} }
 func (*OSSBucket) ProtoMessage() {}
 func (*OSSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{90}
+	return fileDescriptor_724696e352c3df5f, []int{101}
 }
 func (m *OSSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2587,7 +2897,7 @@ var xxx_messageInfo_OSSBucket proto.InternalMessageInfo
 func (m *OSSLifecycleRule) Reset()      { *m = OSSLifecycleRule{} }
 func (*OSSLifecycleRule) ProtoMessage() {}
 func (*OSSLifecycleRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{91}
+	return fileDescriptor_724696e352c3df5f, []int{102}
 }
 func (m *OSSLifecycleRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2615,7 +2925,7 @@ var xxx_messageInfo_OSSLifecycleRule proto.InternalMessageInfo
 func (m *Object) Reset()      { *m = Object{} }
 func (*Object) ProtoMessage() {}
 func (*Object) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{92}
+	return fileDescriptor_724696e352c3df5f, []int{103}
 }
 func (m *Object) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2643,7 +2953,7 @@ var xxx_messageInfo_Object proto.InternalMessageInfo
 func (m *Outputs) Reset()      { *m = Outputs{} }
 func (*Outputs) ProtoMessage() {}
 func (*Outputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{93}
+	return fileDescriptor_724696e352c3df5f, []int{104}
 }
 func (m *Outputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2671,7 +2981,7 @@ var xxx_messageInfo_Outputs proto.InternalMessageInfo
 func (m *ParallelSteps) Reset()      { *m = ParallelSteps{} }
 func (*ParallelSteps) ProtoMessage() {}
 func (*ParallelSteps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{94}
+	return fileDescriptor_724696e352c3df5f, []int{105}
 }
 func (m *ParallelSteps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2699,7 +3009,7 @@ var xxx_messageInfo_ParallelSteps proto.InternalMessageInfo
 func (m *Parameter) Reset()      { *m = Parameter{} }
 func (*Parameter) ProtoMessage() {}
 func (*Parameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{95}
+	return fileDescriptor_724696e352c3df5f, []int{106}
 }
 func (m *Parameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2724,10 +3034,38 @@ func (m *Parameter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Parameter proto.InternalMessageInfo
 
+func (m *ParameterSchema) Reset()      { *m = ParameterSchema{} }
+func (*ParameterSchema) ProtoMessage() {}
+func (*ParameterSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{107}
+}
+func (m *ParameterSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParameterSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ParameterSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParameterSchema.Merge(m, src)
+}
+func (m *ParameterSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParameterSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParameterSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParameterSchema proto.InternalMessageInfo
+
 func (m *Plugin) Reset()      { *m = Plugin{} }
 func (*Plugin) ProtoMessage() {}
 func (*Plugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{96}
+	return fileDescriptor_724696e352c3df5f, []int{108}
 }
 func (m *Plugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2755,7 +3093,7 @@ var xxx_messageInfo_Plugin proto.InternalMessageInfo
 func (m *PodGC) Reset()      { *m = PodGC{} }
 func (*PodGC) ProtoMessage() {}
 func (*PodGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{97}
+	return fileDescriptor_724696e352c3df5f, []int{109}
 }
 func (m *PodGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2783,7 +3121,7 @@ var xxx_messageInfo_PodGC proto.InternalMessageInfo
 func (m *Prometheus) Reset()      { *m = Prometheus{} }
 func (*Prometheus) ProtoMessage() {}
 func (*Prometheus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{98}
+	return fileDescriptor_724696e352c3df5f, []int{110}
 }
 func (m *Prometheus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2811,7 +3149,7 @@ var xxx_messageInfo_Prometheus proto.InternalMessageInfo
 func (m *RawArtifact) Reset()      { *m = RawArtifact{} }
 func (*RawArtifact) ProtoMessage() {}
 func (*RawArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{99}
+	return fileDescriptor_724696e352c3df5f, []int{111}
 }
 func (m *RawArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2836,10 +3174,38 @@ func (m *RawArtifact) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RawArtifact proto.InternalMessageInfo
 
+func (m *ResourceRecommendation) Reset()      { *m = ResourceRecommendation{} }
+func (*ResourceRecommendation) ProtoMessage() {}
+func (*ResourceRecommendation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{112}
+}
+func (m *ResourceRecommendation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceRecommendation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ResourceRecommendation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceRecommendation.Merge(m, src)
+}
+func (m *ResourceRecommendation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceRecommendation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceRecommendation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceRecommendation proto.InternalMessageInfo
+
 func (m *ResourceTemplate) Reset()      { *m = ResourceTemplate{} }
 func (*ResourceTemplate) ProtoMessage() {}
 func (*ResourceTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{100}
+	return fileDescriptor_724696e352c3df5f, []int{113}
 }
 func (m *ResourceTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2867,7 +3233,7 @@ var xxx_messageInfo_ResourceTemplate proto.InternalMessageInfo
 func (m *RetryAffinity) Reset()      { *m = RetryAffinity{} }
 func (*RetryAffinity) ProtoMessage() {}
 func (*RetryAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{101}
+	return fileDescriptor_724696e352c3df5f, []int{114}
 }
 func (m *RetryAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2895,7 +3261,7 @@ var xxx_messageInfo_RetryAffinity proto.InternalMessageInfo
 func (m *RetryNodeAntiAffinity) Reset()      { *m = RetryNodeAntiAffinity{} }
 func (*RetryNodeAntiAffinity) ProtoMessage() {}
 func (*RetryNodeAntiAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{102}
+	return fileDescriptor_724696e352c3df5f, []int{115}
 }
 func (m *RetryNodeAntiAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2923,7 +3289,7 @@ var xxx_messageInfo_RetryNodeAntiAffinity proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{103}
+	return fileDescriptor_724696e352c3df5f, []int{116}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2951,7 +3317,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *S3Artifact) Reset()      { *m = S3Artifact{} }
 func (*S3Artifact) ProtoMessage() {}
 func (*S3Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{104}
+	return fileDescriptor_724696e352c3df5f, []int{117}
 }
 func (m *S3Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2979,7 +3345,7 @@ var xxx_messageInfo_S3Artifact proto.InternalMessageInfo
 func (m *S3ArtifactRepository) Reset()      { *m = S3ArtifactRepository{} }
 func (*S3ArtifactRepository) ProtoMessage() {}
 func (*S3ArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{105}
+	return fileDescriptor_724696e352c3df5f, []int{118}
 }
 func (m *S3ArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3007,7 +3373,7 @@ var xxx_messageInfo_S3ArtifactRepository proto.InternalMessageInfo
 func (m *S3Bucket) Reset()      { *m = S3Bucket{} }
 func (*S3Bucket) ProtoMessage() {}
 func (*S3Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{106}
+	return fileDescriptor_724696e352c3df5f, []int{119}
 }
 func (m *S3Bucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3035,7 +3401,7 @@ var xxx_messageInfo_S3Bucket proto.InternalMessageInfo
 func (m *S3EncryptionOptions) Reset()      { *m = S3EncryptionOptions{} }
 func (*S3EncryptionOptions) ProtoMessage() {}
 func (*S3EncryptionOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{107}
+	return fileDescriptor_724696e352c3df5f, []int{120}
 }
 func (m *S3EncryptionOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3060,10 +3426,38 @@ func (m *S3EncryptionOptions) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_S3EncryptionOptions proto.InternalMessageInfo
 
+func (m *ScratchVolume) Reset()      { *m = ScratchVolume{} }
+func (*ScratchVolume) ProtoMessage() {}
+func (*ScratchVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{121}
+}
+func (m *ScratchVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScratchVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ScratchVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScratchVolume.Merge(m, src)
+}
+func (m *ScratchVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScratchVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScratchVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScratchVolume proto.InternalMessageInfo
+
 func (m *ScriptTemplate) Reset()      { *m = ScriptTemplate{} }
 func (*ScriptTemplate) ProtoMessage() {}
 func (*ScriptTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{108}
+	return fileDescriptor_724696e352c3df5f, []int{122}
 }
 func (m *ScriptTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3091,7 +3485,7 @@ var xxx_messageInfo_ScriptTemplate proto.InternalMessageInfo
 func (m *SemaphoreHolding) Reset()      { *m = SemaphoreHolding{} }
 func (*SemaphoreHolding) ProtoMessage() {}
 func (*SemaphoreHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{109}
+	return fileDescriptor_724696e352c3df5f, []int{123}
 }
 func (m *SemaphoreHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3119,7 +3513,7 @@ var xxx_messageInfo_SemaphoreHolding proto.InternalMessageInfo
 func (m *SemaphoreRef) Reset()      { *m = SemaphoreRef{} }
 func (*SemaphoreRef) ProtoMessage() {}
 func (*SemaphoreRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{110}
+	return fileDescriptor_724696e352c3df5f, []int{124}
 }
 func (m *SemaphoreRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3147,7 +3541,7 @@ var xxx_messageInfo_SemaphoreRef proto.InternalMessageInfo
 func (m *SemaphoreStatus) Reset()      { *m = SemaphoreStatus{} }
 func (*SemaphoreStatus) ProtoMessage() {}
 func (*SemaphoreStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{111}
+	return fileDescriptor_724696e352c3df5f, []int{125}
 }
 func (m *SemaphoreStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3175,7 +3569,7 @@ var xxx_messageInfo_SemaphoreStatus proto.InternalMessageInfo
 func (m *Sequence) Reset()      { *m = Sequence{} }
 func (*Sequence) ProtoMessage() {}
 func (*Sequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{112}
+	return fileDescriptor_724696e352c3df5f, []int{126}
 }
 func (m *Sequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3200,10 +3594,38 @@ func (m *Sequence) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Sequence proto.InternalMessageInfo
 
+func (m *StepThrough) Reset()      { *m = StepThrough{} }
+func (*StepThrough) ProtoMessage() {}
+func (*StepThrough) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{127}
+}
+func (m *StepThrough) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StepThrough) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StepThrough) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StepThrough.Merge(m, src)
+}
+func (m *StepThrough) XXX_Size() int {
+	return m.Size()
+}
+func (m *StepThrough) XXX_DiscardUnknown() {
+	xxx_messageInfo_StepThrough.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StepThrough proto.InternalMessageInfo
+
 func (m *StopStrategy) Reset()      { *m = StopStrategy{} }
 func (*StopStrategy) ProtoMessage() {}
 func (*StopStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{113}
+	return fileDescriptor_724696e352c3df5f, []int{128}
 }
 func (m *StopStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3231,7 +3653,7 @@ var xxx_messageInfo_StopStrategy proto.InternalMessageInfo
 func (m *Submit) Reset()      { *m = Submit{} }
 func (*Submit) ProtoMessage() {}
 func (*Submit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{114}
+	return fileDescriptor_724696e352c3df5f, []int{129}
 }
 func (m *Submit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3259,7 +3681,7 @@ var xxx_messageInfo_Submit proto.InternalMessageInfo
 func (m *SubmitOpts) Reset()      { *m = SubmitOpts{} }
 func (*SubmitOpts) ProtoMessage() {}
 func (*SubmitOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{115}
+	return fileDescriptor_724696e352c3df5f, []int{130}
 }
 func (m *SubmitOpts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3287,7 +3709,7 @@ var xxx_messageInfo_SubmitOpts proto.InternalMessageInfo
 func (m *SuppliedValueFrom) Reset()      { *m = SuppliedValueFrom{} }
 func (*SuppliedValueFrom) ProtoMessage() {}
 func (*SuppliedValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{116}
+	return fileDescriptor_724696e352c3df5f, []int{131}
 }
 func (m *SuppliedValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3315,7 +3737,7 @@ var xxx_messageInfo_SuppliedValueFrom proto.InternalMessageInfo
 func (m *SuspendTemplate) Reset()      { *m = SuspendTemplate{} }
 func (*SuspendTemplate) ProtoMessage() {}
 func (*SuspendTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{117}
+	return fileDescriptor_724696e352c3df5f, []int{132}
 }
 func (m *SuspendTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3343,7 +3765,7 @@ var xxx_messageInfo_SuspendTemplate proto.InternalMessageInfo
 func (m *SyncDatabaseRef) Reset()      { *m = SyncDatabaseRef{} }
 func (*SyncDatabaseRef) ProtoMessage() {}
 func (*SyncDatabaseRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{118}
+	return fileDescriptor_724696e352c3df5f, []int{133}
 }
 func (m *SyncDatabaseRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3371,7 +3793,7 @@ var xxx_messageInfo_SyncDatabaseRef proto.InternalMessageInfo
 func (m *Synchronization) Reset()      { *m = Synchronization{} }
 func (*Synchronization) ProtoMessage() {}
 func (*Synchronization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{119}
+	return fileDescriptor_724696e352c3df5f, []int{134}
 }
 func (m *Synchronization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3399,7 +3821,7 @@ var xxx_messageInfo_Synchronization proto.InternalMessageInfo
 func (m *SynchronizationStatus) Reset()      { *m = SynchronizationStatus{} }
 func (*SynchronizationStatus) ProtoMessage() {}
 func (*SynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{120}
+	return fileDescriptor_724696e352c3df5f, []int{135}
 }
 func (m *SynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3427,7 +3849,7 @@ var xxx_messageInfo_SynchronizationStatus proto.InternalMessageInfo
 func (m *TTLStrategy) Reset()      { *m = TTLStrategy{} }
 func (*TTLStrategy) ProtoMessage() {}
 func (*TTLStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{121}
+	return fileDescriptor_724696e352c3df5f, []int{136}
 }
 func (m *TTLStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3455,7 +3877,7 @@ var xxx_messageInfo_TTLStrategy proto.InternalMessageInfo
 func (m *TarStrategy) Reset()      { *m = TarStrategy{} }
 func (*TarStrategy) ProtoMessage() {}
 func (*TarStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{122}
+	return fileDescriptor_724696e352c3df5f, []int{137}
 }
 func (m *TarStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3483,7 +3905,7 @@ var xxx_messageInfo_TarStrategy proto.InternalMessageInfo
 func (m *Template) Reset()      { *m = Template{} }
 func (*Template) ProtoMessage() {}
 func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{123}
+	return fileDescriptor_724696e352c3df5f, []int{138}
 }
 func (m *Template) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3508,10 +3930,66 @@ func (m *Template) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Template proto.InternalMessageInfo
 
+func (m *TemplateExtends) Reset()      { *m = TemplateExtends{} }
+func (*TemplateExtends) ProtoMessage() {}
+func (*TemplateExtends) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{139}
+}
+func (m *TemplateExtends) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TemplateExtends) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TemplateExtends) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TemplateExtends.Merge(m, src)
+}
+func (m *TemplateExtends) XXX_Size() int {
+	return m.Size()
+}
+func (m *TemplateExtends) XXX_DiscardUnknown() {
+	xxx_messageInfo_TemplateExtends.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TemplateExtends proto.InternalMessageInfo
+
+func (m *TemplateImport) Reset()      { *m = TemplateImport{} }
+func (*TemplateImport) ProtoMessage() {}
+func (*TemplateImport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{140}
+}
+func (m *TemplateImport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TemplateImport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TemplateImport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TemplateImport.Merge(m, src)
+}
+func (m *TemplateImport) XXX_Size() int {
+	return m.Size()
+}
+func (m *TemplateImport) XXX_DiscardUnknown() {
+	xxx_messageInfo_TemplateImport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TemplateImport proto.InternalMessageInfo
+
 func (m *TemplateRef) Reset()      { *m = TemplateRef{} }
 func (*TemplateRef) ProtoMessage() {}
 func (*TemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{124}
+	return fileDescriptor_724696e352c3df5f, []int{141}
 }
 func (m *TemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3539,7 +4017,7 @@ var xxx_messageInfo_TemplateRef proto.InternalMessageInfo
 func (m *TransformationStep) Reset()      { *m = TransformationStep{} }
 func (*TransformationStep) ProtoMessage() {}
 func (*TransformationStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{125}
+	return fileDescriptor_724696e352c3df5f, []int{142}
 }
 func (m *TransformationStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3567,7 +4045,7 @@ var xxx_messageInfo_TransformationStep proto.InternalMessageInfo
 func (m *UserContainer) Reset()      { *m = UserContainer{} }
 func (*UserContainer) ProtoMessage() {}
 func (*UserContainer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{126}
+	return fileDescriptor_724696e352c3df5f, []int{143}
 }
 func (m *UserContainer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3595,7 +4073,7 @@ var xxx_messageInfo_UserContainer proto.InternalMessageInfo
 func (m *ValueFrom) Reset()      { *m = ValueFrom{} }
 func (*ValueFrom) ProtoMessage() {}
 func (*ValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{127}
+	return fileDescriptor_724696e352c3df5f, []int{144}
 }
 func (m *ValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3623,7 +4101,7 @@ var xxx_messageInfo_ValueFrom proto.InternalMessageInfo
 func (m *Version) Reset()      { *m = Version{} }
 func (*Version) ProtoMessage() {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{128}
+	return fileDescriptor_724696e352c3df5f, []int{145}
 }
 func (m *Version) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3651,7 +4129,7 @@ var xxx_messageInfo_Version proto.InternalMessageInfo
 func (m *VolumeClaimGC) Reset()      { *m = VolumeClaimGC{} }
 func (*VolumeClaimGC) ProtoMessage() {}
 func (*VolumeClaimGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{129}
+	return fileDescriptor_724696e352c3df5f, []int{146}
 }
 func (m *VolumeClaimGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3679,7 +4157,7 @@ var xxx_messageInfo_VolumeClaimGC proto.InternalMessageInfo
 func (m *Workflow) Reset()      { *m = Workflow{} }
 func (*Workflow) ProtoMessage() {}
 func (*Workflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{130}
+	return fileDescriptor_724696e352c3df5f, []int{147}
 }
 func (m *Workflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3707,7 +4185,7 @@ var xxx_messageInfo_Workflow proto.InternalMessageInfo
 func (m *WorkflowArtifactGCTask) Reset()      { *m = WorkflowArtifactGCTask{} }
 func (*WorkflowArtifactGCTask) ProtoMessage() {}
 func (*WorkflowArtifactGCTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{131}
+	return fileDescriptor_724696e352c3df5f, []int{148}
 }
 func (m *WorkflowArtifactGCTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3735,7 +4213,7 @@ var xxx_messageInfo_WorkflowArtifactGCTask proto.InternalMessageInfo
 func (m *WorkflowArtifactGCTaskList) Reset()      { *m = WorkflowArtifactGCTaskList{} }
 func (*WorkflowArtifactGCTaskList) ProtoMessage() {}
 func (*WorkflowArtifactGCTaskList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{132}
+	return fileDescriptor_724696e352c3df5f, []int{149}
 }
 func (m *WorkflowArtifactGCTaskList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3760,10 +4238,94 @@ func (m *WorkflowArtifactGCTaskList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WorkflowArtifactGCTaskList proto.InternalMessageInfo
 
+func (m *WorkflowArtifactRepository) Reset()      { *m = WorkflowArtifactRepository{} }
+func (*WorkflowArtifactRepository) ProtoMessage() {}
+func (*WorkflowArtifactRepository) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{150}
+}
+func (m *WorkflowArtifactRepository) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowArtifactRepository) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkflowArtifactRepository) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowArtifactRepository.Merge(m, src)
+}
+func (m *WorkflowArtifactRepository) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowArtifactRepository) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowArtifactRepository.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowArtifactRepository proto.InternalMessageInfo
+
+func (m *WorkflowArtifactRepositoryList) Reset()      { *m = WorkflowArtifactRepositoryList{} }
+func (*WorkflowArtifactRepositoryList) ProtoMessage() {}
+func (*WorkflowArtifactRepositoryList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{151}
+}
+func (m *WorkflowArtifactRepositoryList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowArtifactRepositoryList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkflowArtifactRepositoryList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowArtifactRepositoryList.Merge(m, src)
+}
+func (m *WorkflowArtifactRepositoryList) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowArtifactRepositoryList) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowArtifactRepositoryList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowArtifactRepositoryList proto.InternalMessageInfo
+
+func (m *WorkflowArtifactRepositoryStatus) Reset()      { *m = WorkflowArtifactRepositoryStatus{} }
+func (*WorkflowArtifactRepositoryStatus) ProtoMessage() {}
+func (*WorkflowArtifactRepositoryStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{152}
+}
+func (m *WorkflowArtifactRepositoryStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowArtifactRepositoryStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkflowArtifactRepositoryStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowArtifactRepositoryStatus.Merge(m, src)
+}
+func (m *WorkflowArtifactRepositoryStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowArtifactRepositoryStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowArtifactRepositoryStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowArtifactRepositoryStatus proto.InternalMessageInfo
+
 func (m *WorkflowEventBinding) Reset()      { *m = WorkflowEventBinding{} }
 func (*WorkflowEventBinding) ProtoMessage() {}
 func (*WorkflowEventBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{133}
+	return fileDescriptor_724696e352c3df5f, []int{153}
 }
 func (m *WorkflowEventBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3791,7 +4353,7 @@ var xxx_messageInfo_WorkflowEventBinding proto.InternalMessageInfo
 func (m *WorkflowEventBindingList) Reset()      { *m = WorkflowEventBindingList{} }
 func (*WorkflowEventBindingList) ProtoMessage() {}
 func (*WorkflowEventBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{134}
+	return fileDescriptor_724696e352c3df5f, []int{154}
 }
 func (m *WorkflowEventBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3819,7 +4381,7 @@ var xxx_messageInfo_WorkflowEventBindingList proto.InternalMessageInfo
 func (m *WorkflowEventBindingSpec) Reset()      { *m = WorkflowEventBindingSpec{} }
 func (*WorkflowEventBindingSpec) ProtoMessage() {}
 func (*WorkflowEventBindingSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{135}
+	return fileDescriptor_724696e352c3df5f, []int{155}
 }
 func (m *WorkflowEventBindingSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3847,7 +4409,7 @@ var xxx_messageInfo_WorkflowEventBindingSpec proto.InternalMessageInfo
 func (m *WorkflowLevelArtifactGC) Reset()      { *m = WorkflowLevelArtifactGC{} }
 func (*WorkflowLevelArtifactGC) ProtoMessage() {}
 func (*WorkflowLevelArtifactGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{136}
+	return fileDescriptor_724696e352c3df5f, []int{156}
 }
 func (m *WorkflowLevelArtifactGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3875,7 +4437,7 @@ var xxx_messageInfo_WorkflowLevelArtifactGC proto.InternalMessageInfo
 func (m *WorkflowList) Reset()      { *m = WorkflowList{} }
 func (*WorkflowList) ProtoMessage() {}
 func (*WorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{137}
+	return fileDescriptor_724696e352c3df5f, []int{157}
 }
 func (m *WorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3903,7 +4465,7 @@ var xxx_messageInfo_WorkflowList proto.InternalMessageInfo
 func (m *WorkflowMetadata) Reset()      { *m = WorkflowMetadata{} }
 func (*WorkflowMetadata) ProtoMessage() {}
 func (*WorkflowMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{138}
+	return fileDescriptor_724696e352c3df5f, []int{158}
 }
 func (m *WorkflowMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3931,7 +4493,7 @@ var xxx_messageInfo_WorkflowMetadata proto.InternalMessageInfo
 func (m *WorkflowSpec) Reset()      { *m = WorkflowSpec{} }
 func (*WorkflowSpec) ProtoMessage() {}
 func (*WorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{139}
+	return fileDescriptor_724696e352c3df5f, []int{159}
 }
 func (m *WorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3959,7 +4521,7 @@ var xxx_messageInfo_WorkflowSpec proto.InternalMessageInfo
 func (m *WorkflowStatus) Reset()      { *m = WorkflowStatus{} }
 func (*WorkflowStatus) ProtoMessage() {}
 func (*WorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{140}
+	return fileDescriptor_724696e352c3df5f, []int{160}
 }
 func (m *WorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3987,7 +4549,7 @@ var xxx_messageInfo_WorkflowStatus proto.InternalMessageInfo
 func (m *WorkflowStep) Reset()      { *m = WorkflowStep{} }
 func (*WorkflowStep) ProtoMessage() {}
 func (*WorkflowStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{141}
+	return fileDescriptor_724696e352c3df5f, []int{161}
 }
 func (m *WorkflowStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4015,7 +4577,7 @@ var xxx_messageInfo_WorkflowStep proto.InternalMessageInfo
 func (m *WorkflowTaskResult) Reset()      { *m = WorkflowTaskResult{} }
 func (*WorkflowTaskResult) ProtoMessage() {}
 func (*WorkflowTaskResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{142}
+	return fileDescriptor_724696e352c3df5f, []int{162}
 }
 func (m *WorkflowTaskResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4043,7 +4605,7 @@ var xxx_messageInfo_WorkflowTaskResult proto.InternalMessageInfo
 func (m *WorkflowTaskResultList) Reset()      { *m = WorkflowTaskResultList{} }
 func (*WorkflowTaskResultList) ProtoMessage() {}
 func (*WorkflowTaskResultList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{143}
+	return fileDescriptor_724696e352c3df5f, []int{163}
 }
 func (m *WorkflowTaskResultList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4071,7 +4633,7 @@ var xxx_messageInfo_WorkflowTaskResultList proto.InternalMessageInfo
 func (m *WorkflowTaskSet) Reset()      { *m = WorkflowTaskSet{} }
 func (*WorkflowTaskSet) ProtoMessage() {}
 func (*WorkflowTaskSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{144}
+	return fileDescriptor_724696e352c3df5f, []int{164}
 }
 func (m *WorkflowTaskSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4099,7 +4661,7 @@ var xxx_messageInfo_WorkflowTaskSet proto.InternalMessageInfo
 func (m *WorkflowTaskSetList) Reset()      { *m = WorkflowTaskSetList{} }
 func (*WorkflowTaskSetList) ProtoMessage() {}
 func (*WorkflowTaskSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{145}
+	return fileDescriptor_724696e352c3df5f, []int{165}
 }
 func (m *WorkflowTaskSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4127,7 +4689,7 @@ var xxx_messageInfo_WorkflowTaskSetList proto.InternalMessageInfo
 func (m *WorkflowTaskSetSpec) Reset()      { *m = WorkflowTaskSetSpec{} }
 func (*WorkflowTaskSetSpec) ProtoMessage() {}
 func (*WorkflowTaskSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{146}
+	return fileDescriptor_724696e352c3df5f, []int{166}
 }
 func (m *WorkflowTaskSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4155,7 +4717,7 @@ var xxx_messageInfo_WorkflowTaskSetSpec proto.InternalMessageInfo
 func (m *WorkflowTaskSetStatus) Reset()      { *m = WorkflowTaskSetStatus{} }
 func (*WorkflowTaskSetStatus) ProtoMessage() {}
 func (*WorkflowTaskSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{147}
+	return fileDescriptor_724696e352c3df5f, []int{167}
 }
 func (m *WorkflowTaskSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4183,7 +4745,7 @@ var xxx_messageInfo_WorkflowTaskSetStatus proto.InternalMessageInfo
 func (m *WorkflowTemplate) Reset()      { *m = WorkflowTemplate{} }
 func (*WorkflowTemplate) ProtoMessage() {}
 func (*WorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{148}
+	return fileDescriptor_724696e352c3df5f, []int{168}
 }
 func (m *WorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4211,7 +4773,7 @@ var xxx_messageInfo_WorkflowTemplate proto.InternalMessageInfo
 func (m *WorkflowTemplateList) Reset()      { *m = WorkflowTemplateList{} }
 func (*WorkflowTemplateList) ProtoMessage() {}
 func (*WorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{149}
+	return fileDescriptor_724696e352c3df5f, []int{169}
 }
 func (m *WorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4239,7 +4801,7 @@ var xxx_messageInfo_WorkflowTemplateList proto.InternalMessageInfo
 func (m *WorkflowTemplateRef) Reset()      { *m = WorkflowTemplateRef{} }
 func (*WorkflowTemplateRef) ProtoMessage() {}
 func (*WorkflowTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{150}
+	return fileDescriptor_724696e352c3df5f, []int{170}
 }
 func (m *WorkflowTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4264,10 +4826,66 @@ func (m *WorkflowTemplateRef) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WorkflowTemplateRef proto.InternalMessageInfo
 
+func (m *WorkflowTemplateRevision) Reset()      { *m = WorkflowTemplateRevision{} }
+func (*WorkflowTemplateRevision) ProtoMessage() {}
+func (*WorkflowTemplateRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{171}
+}
+func (m *WorkflowTemplateRevision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowTemplateRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkflowTemplateRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowTemplateRevision.Merge(m, src)
+}
+func (m *WorkflowTemplateRevision) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowTemplateRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowTemplateRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowTemplateRevision proto.InternalMessageInfo
+
+func (m *WorkflowTemplateRevisionList) Reset()      { *m = WorkflowTemplateRevisionList{} }
+func (*WorkflowTemplateRevisionList) ProtoMessage() {}
+func (*WorkflowTemplateRevisionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{172}
+}
+func (m *WorkflowTemplateRevisionList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowTemplateRevisionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkflowTemplateRevisionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowTemplateRevisionList.Merge(m, src)
+}
+func (m *WorkflowTemplateRevisionList) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowTemplateRevisionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowTemplateRevisionList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowTemplateRevisionList proto.InternalMessageInfo
+
 func (m *ZipStrategy) Reset()      { *m = ZipStrategy{} }
 func (*ZipStrategy) ProtoMessage() {}
 func (*ZipStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{151}
+	return fileDescriptor_724696e352c3df5f, []int{173}
 }
 func (m *ZipStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4293,6 +4911,7 @@ func (m *ZipStrategy) XXX_DiscardUnknown() {
 var xxx_messageInfo_ZipStrategy proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterType((*AWSSigV4Auth)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.AWSSigV4Auth")
 	proto.RegisterType((*Amount)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Amount")
 	proto.RegisterType((*ArchiveStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArchiveStrategy")
 	proto.RegisterType((*Arguments)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Arguments")
@@ -4328,6 +4947,7 @@ func init() {
 	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Backoff")
 	proto.RegisterType((*BasicAuth)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.BasicAuth")
 	proto.RegisterType((*Cache)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Cache")
+	proto.RegisterType((*ChildWorkflowTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ChildWorkflowTemplate")
 	proto.RegisterType((*ClientCertAuth)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ClientCertAuth")
 	proto.RegisterType((*ClusterWorkflowTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ClusterWorkflowTemplate")
 	proto.RegisterType((*ClusterWorkflowTemplateList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ClusterWorkflowTemplateList")
@@ -4343,16 +4963,22 @@ func init() {
 	proto.RegisterType((*CronWorkflowList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowList")
 	proto.RegisterType((*CronWorkflowSpec)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowSpec")
 	proto.RegisterType((*CronWorkflowStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowStatus")
+	proto.RegisterType((*DAGHook)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DAGHook")
+	proto.RegisterType((*DAGOneOf)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DAGOneOf")
+	proto.RegisterType((*DAGOneOfBranch)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DAGOneOfBranch")
 	proto.RegisterType((*DAGTask)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DAGTask")
 	proto.RegisterMapType((LifecycleHooks)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DAGTask.HooksEntry")
 	proto.RegisterType((*DAGTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DAGTemplate")
 	proto.RegisterType((*Data)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Data")
 	proto.RegisterType((*DataSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DataSource")
 	proto.RegisterType((*Event)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Event")
+	proto.RegisterType((*EventIdempotency)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.EventIdempotency")
+	proto.RegisterType((*EventSchema)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.EventSchema")
 	proto.RegisterType((*ExecutorConfig)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ExecutorConfig")
 	proto.RegisterType((*GCSArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GCSArtifact")
 	proto.RegisterType((*GCSArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GCSArtifactRepository")
 	proto.RegisterType((*GCSBucket)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GCSBucket")
+	proto.RegisterType((*GPURequest)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GPURequest")
 	proto.RegisterType((*Gauge)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Gauge")
 	proto.RegisterType((*GitArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GitArtifact")
 	proto.RegisterType((*HDFSArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HDFSArtifact")
@@ -4365,10 +4991,12 @@ func init() {
 	proto.RegisterType((*HTTPBodySource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPBodySource")
 	proto.RegisterType((*HTTPHeader)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPHeader")
 	proto.RegisterType((*HTTPHeaderSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPHeaderSource")
+	proto.RegisterType((*HTTPTemplateAuth)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPTemplateAuth")
 	proto.RegisterType((*Header)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Header")
 	proto.RegisterType((*Histogram)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Histogram")
 	proto.RegisterType((*Inputs)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Inputs")
 	proto.RegisterType((*Item)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Item")
+	proto.RegisterType((*JobTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.JobTemplate")
 	proto.RegisterType((*LabelKeys)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.LabelKeys")
 	proto.RegisterType((*LabelValueFrom)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.LabelValueFrom")
 	proto.RegisterType((*LabelValues)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.LabelValues")
@@ -4387,10 +5015,16 @@ func init() {
 	proto.RegisterType((*MutexStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.MutexStatus")
 	proto.RegisterType((*NodeFlag)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeFlag")
 	proto.RegisterType((*NodeResult)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeResult")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeResult.IntermediateParametersEntry")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeResult.PeakResourceUsageEntry")
 	proto.RegisterType((*NodeStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeStatus")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeStatus.ImageDigestsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeStatus.IntermediateParametersEntry")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeStatus.PeakResourceUsageEntry")
 	proto.RegisterMapType((ResourcesDuration)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeStatus.ResourcesDurationEntry")
 	proto.RegisterType((*NodeSynchronizationStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeSynchronizationStatus")
 	proto.RegisterType((*NoneStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NoneStrategy")
+	proto.RegisterType((*Notification)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Notification")
 	proto.RegisterType((*OAuth2Auth)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.OAuth2Auth")
 	proto.RegisterType((*OAuth2EndpointParam)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.OAuth2EndpointParam")
 	proto.RegisterType((*OSSArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.OSSArtifact")
@@ -4401,10 +5035,14 @@ func init() {
 	proto.RegisterType((*Outputs)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Outputs")
 	proto.RegisterType((*ParallelSteps)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ParallelSteps")
 	proto.RegisterType((*Parameter)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Parameter")
+	proto.RegisterType((*ParameterSchema)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ParameterSchema")
 	proto.RegisterType((*Plugin)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Plugin")
 	proto.RegisterType((*PodGC)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.PodGC")
 	proto.RegisterType((*Prometheus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Prometheus")
 	proto.RegisterType((*RawArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RawArtifact")
+	proto.RegisterType((*ResourceRecommendation)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ResourceRecommendation")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ResourceRecommendation.LimitsEntry")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ResourceRecommendation.RequestsEntry")
 	proto.RegisterType((*ResourceTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ResourceTemplate")
 	proto.RegisterType((*RetryAffinity)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryAffinity")
 	proto.RegisterType((*RetryNodeAntiAffinity)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryNodeAntiAffinity")
@@ -4413,11 +5051,13 @@ func init() {
 	proto.RegisterType((*S3ArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3ArtifactRepository")
 	proto.RegisterType((*S3Bucket)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3Bucket")
 	proto.RegisterType((*S3EncryptionOptions)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3EncryptionOptions")
+	proto.RegisterType((*ScratchVolume)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ScratchVolume")
 	proto.RegisterType((*ScriptTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ScriptTemplate")
 	proto.RegisterType((*SemaphoreHolding)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreHolding")
 	proto.RegisterType((*SemaphoreRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreRef")
 	proto.RegisterType((*SemaphoreStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreStatus")
 	proto.RegisterType((*Sequence)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Sequence")
+	proto.RegisterType((*StepThrough)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.StepThrough")
 	proto.RegisterType((*StopStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.StopStrategy")
 	proto.RegisterType((*Submit)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Submit")
 	proto.RegisterType((*SubmitOpts)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SubmitOpts")
@@ -4431,6 +5071,8 @@ func init() {
 	proto.RegisterType((*Template)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Template")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Template.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Template.NodeSelectorEntry")
+	proto.RegisterType((*TemplateExtends)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.TemplateExtends")
+	proto.RegisterType((*TemplateImport)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.TemplateImport")
 	proto.RegisterType((*TemplateRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.TemplateRef")
 	proto.RegisterType((*TransformationStep)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.TransformationStep")
 	proto.RegisterType((*UserContainer)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.UserContainer")
@@ -4440,6 +5082,9 @@ func init() {
 	proto.RegisterType((*Workflow)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Workflow")
 	proto.RegisterType((*WorkflowArtifactGCTask)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowArtifactGCTask")
 	proto.RegisterType((*WorkflowArtifactGCTaskList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowArtifactGCTaskList")
+	proto.RegisterType((*WorkflowArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowArtifactRepository")
+	proto.RegisterType((*WorkflowArtifactRepositoryList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowArtifactRepositoryList")
+	proto.RegisterType((*WorkflowArtifactRepositoryStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowArtifactRepositoryStatus")
 	proto.RegisterType((*WorkflowEventBinding)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowEventBinding")
 	proto.RegisterType((*WorkflowEventBindingList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowEventBindingList")
 	proto.RegisterType((*WorkflowEventBindingSpec)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowEventBindingSpec")
@@ -4453,10 +5098,13 @@ func init() {
 	proto.RegisterMapType((LifecycleHooks)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowSpec.HooksEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowSpec.NodeSelectorEntry")
 	proto.RegisterType((*WorkflowStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowStatus")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowStatus.ImageDigestsEntry")
 	proto.RegisterMapType((Nodes)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowStatus.NodesEntry")
+	proto.RegisterMapType((map[string]ResourceRecommendation)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowStatus.ResourceRecommendationsEntry")
 	proto.RegisterMapType((ResourcesDuration)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowStatus.ResourcesDurationEntry")
 	proto.RegisterMapType((map[string]Template)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowStatus.StoredTemplatesEntry")
 	proto.RegisterMapType((map[string]bool)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowStatus.TaskResultsCompletionStatusEntry")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowStatus.TemplateDigestsEntry")
 	proto.RegisterType((*WorkflowStep)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowStep")
 	proto.RegisterMapType((LifecycleHooks)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowStep.HooksEntry")
 	proto.RegisterType((*WorkflowTaskResult)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowTaskResult")
@@ -4470,6 +5118,8 @@ func init() {
 	proto.RegisterType((*WorkflowTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowTemplate")
 	proto.RegisterType((*WorkflowTemplateList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowTemplateList")
 	proto.RegisterType((*WorkflowTemplateRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowTemplateRef")
+	proto.RegisterType((*WorkflowTemplateRevision)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowTemplateRevision")
+	proto.RegisterType((*WorkflowTemplateRevisionList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowTemplateRevisionList")
 	proto.RegisterType((*ZipStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ZipStrategy")
 }
 
@@ -4478,711 +5128,913 @@ func init() {
 }
 
 var fileDescriptor_724696e352c3df5f = []byte{
-	// 11256 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x6d, 0x70, 0x24, 0xc7,
-	0x75, 0x18, 0x67, 0x81, 0xc5, 0xc7, 0x5b, 0x00, 0x87, 0xeb, 0xfb, 0x5a, 0x82, 0xe4, 0x81, 0x1e,
-	0x8a, 0x0c, 0x69, 0x51, 0x38, 0xf1, 0x28, 0x25, 0x8c, 0x94, 0x48, 0xc2, 0xc7, 0x01, 0x07, 0x02,
-	0x38, 0x80, 0xbd, 0x38, 0x9e, 0x49, 0xd1, 0x92, 0x06, 0xbb, 0x8d, 0xdd, 0x21, 0x76, 0x67, 0x96,
-	0x33, 0xb3, 0xb8, 0x03, 0x3f, 0x24, 0x85, 0xfa, 0xa2, 0x62, 0xd9, 0x8a, 0x65, 0x4a, 0x96, 0xe4,
-	0x24, 0xa5, 0x28, 0x52, 0xa2, 0x92, 0x5d, 0x49, 0xd9, 0xbf, 0x12, 0xfb, 0x5f, 0x7e, 0xb8, 0x94,
-	0x72, 0x2a, 0x91, 0x2b, 0x4a, 0x59, 0x3f, 0x62, 0x30, 0x82, 0x13, 0x55, 0x2a, 0x29, 0xfd, 0xb0,
-	0x2a, 0x4e, 0xe2, 0xcb, 0x47, 0xb9, 0xfa, 0x73, 0xba, 0x67, 0x67, 0x71, 0x00, 0xae, 0x01, 0xaa,
-	0xec, 0x5f, 0xc0, 0xbe, 0x7e, 0xfd, 0x5e, 0x77, 0x4f, 0xf7, 0xeb, 0xd7, 0xef, 0xbd, 0x7e, 0x0d,
-	0x6b, 0x75, 0x3f, 0x69, 0x74, 0x36, 0xa6, 0xaa, 0x61, 0xeb, 0x92, 0x17, 0xd5, 0xc3, 0x76, 0x14,
-	0xbe, 0xc8, 0xfe, 0x79, 0xd7, 0xcd, 0x30, 0xda, 0xda, 0x6c, 0x86, 0x37, 0xe3, 0x4b, 0xdb, 0x4f,
-	0x5e, 0x6a, 0x6f, 0xd5, 0x2f, 0x79, 0x6d, 0x3f, 0xbe, 0x24, 0xa1, 0x97, 0xb6, 0x9f, 0xf0, 0x9a,
-	0xed, 0x86, 0xf7, 0xc4, 0xa5, 0x3a, 0x09, 0x48, 0xe4, 0x25, 0xa4, 0x36, 0xd5, 0x8e, 0xc2, 0x24,
-	0x44, 0x1f, 0x4a, 0x29, 0x4e, 0x49, 0x8a, 0xec, 0x9f, 0x8f, 0x2a, 0x8a, 0x53, 0xdb, 0x4f, 0x4e,
-	0xb5, 0xb7, 0xea, 0x53, 0x94, 0xe2, 0x94, 0x84, 0x4e, 0x49, 0x8a, 0x13, 0xef, 0xd2, 0xda, 0x54,
-	0x0f, 0xeb, 0xe1, 0x25, 0x46, 0x78, 0xa3, 0xb3, 0xc9, 0x7e, 0xb1, 0x1f, 0xec, 0x3f, 0xce, 0x70,
-	0xc2, 0xdd, 0x7a, 0x2a, 0x9e, 0xf2, 0x43, 0xda, 0xbe, 0x4b, 0xd5, 0x30, 0x22, 0x97, 0xb6, 0xbb,
-	0x1a, 0x35, 0xf1, 0x0e, 0x0d, 0xa7, 0x1d, 0x36, 0xfd, 0xea, 0x4e, 0x1e, 0xd6, 0x7b, 0x52, 0xac,
-	0x96, 0x57, 0x6d, 0xf8, 0x01, 0x89, 0x76, 0xd2, 0xae, 0xb7, 0x48, 0xe2, 0xe5, 0xd5, 0xba, 0xd4,
-	0xab, 0x56, 0xd4, 0x09, 0x12, 0xbf, 0x45, 0xba, 0x2a, 0xfc, 0xf5, 0x3b, 0x55, 0x88, 0xab, 0x0d,
-	0xd2, 0xf2, 0xba, 0xea, 0x3d, 0xd9, 0xab, 0x5e, 0x27, 0xf1, 0x9b, 0x97, 0xfc, 0x20, 0x89, 0x93,
-	0x28, 0x5b, 0xc9, 0xbd, 0x02, 0x03, 0xd3, 0xad, 0xb0, 0x13, 0x24, 0xe8, 0xfd, 0x50, 0xdc, 0xf6,
-	0x9a, 0x1d, 0x52, 0x76, 0x1e, 0x74, 0x1e, 0x1d, 0x9e, 0x79, 0xf8, 0x7b, 0xbb, 0x93, 0xf7, 0xec,
-	0xed, 0x4e, 0x16, 0x9f, 0xa5, 0xc0, 0xdb, 0xbb, 0x93, 0x67, 0x49, 0x50, 0x0d, 0x6b, 0x7e, 0x50,
-	0xbf, 0xf4, 0x62, 0x1c, 0x06, 0x53, 0xd7, 0x3a, 0xad, 0x0d, 0x12, 0x61, 0x5e, 0xc7, 0xfd, 0xf7,
-	0x05, 0x38, 0x35, 0x1d, 0x55, 0x1b, 0xfe, 0x36, 0xa9, 0x24, 0x94, 0x7e, 0x7d, 0x07, 0x35, 0xa0,
-	0x2f, 0xf1, 0x22, 0x46, 0xae, 0x74, 0x79, 0x65, 0xea, 0x6e, 0xbf, 0xfb, 0xd4, 0xba, 0x17, 0x49,
-	0xda, 0x33, 0x83, 0x7b, 0xbb, 0x93, 0x7d, 0xeb, 0x5e, 0x84, 0x29, 0x0b, 0xd4, 0x84, 0xfe, 0x20,
-	0x0c, 0x48, 0xb9, 0xc0, 0x58, 0x5d, 0xbb, 0x7b, 0x56, 0xd7, 0xc2, 0x40, 0xf5, 0x63, 0x66, 0x68,
-	0x6f, 0x77, 0xb2, 0x9f, 0x42, 0x30, 0xe3, 0x42, 0xfb, 0xf5, 0xb2, 0xdf, 0x2e, 0xf7, 0xd9, 0xea,
-	0xd7, 0xf3, 0x7e, 0xdb, 0xec, 0xd7, 0xf3, 0x7e, 0x1b, 0x53, 0x16, 0xee, 0xe7, 0x0b, 0x30, 0x3c,
-	0x1d, 0xd5, 0x3b, 0x2d, 0x12, 0x24, 0x31, 0xfa, 0x04, 0x40, 0xdb, 0x8b, 0xbc, 0x16, 0x49, 0x48,
-	0x14, 0x97, 0x9d, 0x07, 0xfb, 0x1e, 0x2d, 0x5d, 0x5e, 0xba, 0x7b, 0xf6, 0x6b, 0x92, 0xe6, 0x0c,
-	0x12, 0x9f, 0x1c, 0x14, 0x28, 0xc6, 0x1a, 0x4b, 0xf4, 0x0a, 0x0c, 0x7b, 0x51, 0xe2, 0x6f, 0x7a,
-	0xd5, 0x24, 0x2e, 0x17, 0x18, 0xff, 0xa7, 0xef, 0x9e, 0xff, 0xb4, 0x20, 0x39, 0x73, 0x5a, 0xb0,
-	0x1f, 0x96, 0x90, 0x18, 0xa7, 0xfc, 0xdc, 0xdf, 0xed, 0x87, 0xd2, 0x74, 0x94, 0x2c, 0xcc, 0x56,
-	0x12, 0x2f, 0xe9, 0xc4, 0xe8, 0x0f, 0x1c, 0x38, 0x13, 0xf3, 0x61, 0xf3, 0x49, 0xbc, 0x16, 0x85,
-	0x55, 0x12, 0xc7, 0xa4, 0x26, 0xc6, 0x65, 0xd3, 0x4a, 0xbb, 0x24, 0xb3, 0xa9, 0x4a, 0x37, 0xa3,
-	0x2b, 0x41, 0x12, 0xed, 0xcc, 0x3c, 0x21, 0xda, 0x7c, 0x26, 0x07, 0xe3, 0xf5, 0xb7, 0x26, 0x91,
-	0xec, 0x0a, 0xa5, 0xc4, 0x3f, 0x31, 0xce, 0x6b, 0x35, 0xfa, 0x9a, 0x03, 0x23, 0xed, 0xb0, 0x16,
-	0x63, 0x52, 0x0d, 0x3b, 0x6d, 0x52, 0x13, 0xc3, 0xfb, 0x51, 0xbb, 0xdd, 0x58, 0xd3, 0x38, 0xf0,
-	0xf6, 0x9f, 0x15, 0xed, 0x1f, 0xd1, 0x8b, 0xb0, 0xd1, 0x14, 0xf4, 0x14, 0x8c, 0x04, 0x61, 0x52,
-	0x69, 0x93, 0xaa, 0xbf, 0xe9, 0x93, 0x1a, 0x9b, 0xf8, 0x43, 0x69, 0xcd, 0x6b, 0x5a, 0x19, 0x36,
-	0x30, 0x27, 0xe6, 0xa1, 0xdc, 0x6b, 0xe4, 0xd0, 0x38, 0xf4, 0x6d, 0x91, 0x1d, 0x2e, 0x6c, 0x30,
-	0xfd, 0x17, 0x9d, 0x95, 0x02, 0x88, 0x2e, 0xe3, 0x21, 0x21, 0x59, 0xde, 0x57, 0x78, 0xca, 0x99,
-	0xf8, 0x20, 0x9c, 0xee, 0x6a, 0xfa, 0x61, 0x08, 0xb8, 0xdf, 0x1f, 0x80, 0x21, 0xf9, 0x29, 0xd0,
-	0x83, 0xd0, 0x1f, 0x78, 0x2d, 0x29, 0xe7, 0x46, 0x44, 0x3f, 0xfa, 0xaf, 0x79, 0x2d, 0xba, 0xc2,
-	0xbd, 0x16, 0xa1, 0x18, 0x6d, 0x2f, 0x69, 0x30, 0x3a, 0x1a, 0xc6, 0x9a, 0x97, 0x34, 0x30, 0x2b,
-	0x41, 0xf7, 0x43, 0x7f, 0x2b, 0xac, 0x11, 0x36, 0x16, 0x45, 0x2e, 0x21, 0x56, 0xc2, 0x1a, 0xc1,
-	0x0c, 0x4a, 0xeb, 0x6f, 0x46, 0x61, 0xab, 0xdc, 0x6f, 0xd6, 0x9f, 0x8f, 0xc2, 0x16, 0x66, 0x25,
-	0xe8, 0xab, 0x0e, 0x8c, 0xcb, 0xb9, 0xbd, 0x1c, 0x56, 0xbd, 0xc4, 0x0f, 0x83, 0x72, 0x91, 0x49,
-	0x14, 0x6c, 0x6f, 0x49, 0x49, 0xca, 0x33, 0x65, 0xd1, 0x84, 0xf1, 0x6c, 0x09, 0xee, 0x6a, 0x05,
-	0xba, 0x0c, 0x50, 0x6f, 0x86, 0x1b, 0x5e, 0x93, 0x0e, 0x48, 0x79, 0x80, 0x75, 0x41, 0x49, 0x86,
-	0x05, 0x55, 0x82, 0x35, 0x2c, 0x74, 0x0b, 0x06, 0x3d, 0x2e, 0xfd, 0xcb, 0x83, 0xac, 0x13, 0xcf,
-	0xd8, 0xe8, 0x84, 0xb1, 0x9d, 0xcc, 0x94, 0xf6, 0x76, 0x27, 0x07, 0x05, 0x10, 0x4b, 0x76, 0xe8,
-	0x71, 0x18, 0x0a, 0xdb, 0xb4, 0xdd, 0x5e, 0xb3, 0x3c, 0xc4, 0x26, 0xe6, 0xb8, 0x68, 0xeb, 0xd0,
-	0xaa, 0x80, 0x63, 0x85, 0x81, 0x1e, 0x83, 0xc1, 0xb8, 0xb3, 0x41, 0xbf, 0x63, 0x79, 0x98, 0x75,
-	0xec, 0x94, 0x40, 0x1e, 0xac, 0x70, 0x30, 0x96, 0xe5, 0xe8, 0xbd, 0x50, 0x8a, 0x48, 0xb5, 0x13,
-	0xc5, 0x84, 0x7e, 0xd8, 0x32, 0x30, 0xda, 0x67, 0x04, 0x7a, 0x09, 0xa7, 0x45, 0x58, 0xc7, 0x43,
-	0x1f, 0x80, 0x31, 0xfa, 0x81, 0xaf, 0xdc, 0x6a, 0x47, 0x24, 0x8e, 0xe9, 0x57, 0x2d, 0x31, 0x46,
-	0xe7, 0x45, 0xcd, 0xb1, 0x79, 0xa3, 0x14, 0x67, 0xb0, 0xd1, 0xab, 0x00, 0x9e, 0x92, 0x19, 0xe5,
-	0x11, 0x36, 0x98, 0xcb, 0xf6, 0x66, 0xc4, 0xc2, 0xec, 0xcc, 0x18, 0xfd, 0x8e, 0xe9, 0x6f, 0xac,
-	0xf1, 0xa3, 0xe3, 0x53, 0x23, 0x4d, 0x92, 0x90, 0x5a, 0x79, 0x94, 0x75, 0x58, 0x8d, 0xcf, 0x1c,
-	0x07, 0x63, 0x59, 0xee, 0xfe, 0x46, 0x01, 0x34, 0x2a, 0x68, 0x06, 0x86, 0x84, 0x5c, 0x13, 0x4b,
-	0x72, 0xe6, 0x11, 0xf9, 0x1d, 0xe4, 0x17, 0xbc, 0xbd, 0x9b, 0x2b, 0x0f, 0x55, 0x3d, 0xf4, 0x1a,
-	0x94, 0xda, 0x61, 0x6d, 0x85, 0x24, 0x5e, 0xcd, 0x4b, 0x3c, 0xb1, 0x9b, 0x5b, 0xd8, 0x61, 0x24,
-	0xc5, 0x99, 0x53, 0xf4, 0xd3, 0xad, 0xa5, 0x2c, 0xb0, 0xce, 0x0f, 0x3d, 0x0d, 0x28, 0x26, 0xd1,
-	0xb6, 0x5f, 0x25, 0xd3, 0xd5, 0x2a, 0x55, 0x89, 0xd8, 0x02, 0xe8, 0x63, 0x9d, 0x99, 0x10, 0x9d,
-	0x41, 0x95, 0x2e, 0x0c, 0x9c, 0x53, 0xcb, 0xfd, 0x41, 0x01, 0xc6, 0xb4, 0xbe, 0xb6, 0x49, 0x15,
-	0x7d, 0xc7, 0x81, 0x53, 0x6a, 0x3b, 0x9b, 0xd9, 0xb9, 0x46, 0x67, 0x15, 0xdf, 0xac, 0x88, 0xcd,
-	0xef, 0x4b, 0x79, 0xa9, 0x9f, 0x82, 0x0f, 0x97, 0xf5, 0x17, 0x44, 0x1f, 0x4e, 0x65, 0x4a, 0x71,
-	0xb6, 0x59, 0x13, 0x5f, 0x71, 0xe0, 0x6c, 0x1e, 0x89, 0x1c, 0x99, 0xdb, 0xd0, 0x65, 0xae, 0x55,
-	0xe1, 0x45, 0xb9, 0xd2, 0xce, 0xe8, 0x72, 0xfc, 0xff, 0x17, 0x60, 0x5c, 0x9f, 0x42, 0x4c, 0x13,
-	0xf8, 0x57, 0x0e, 0x9c, 0x93, 0x3d, 0xc0, 0x24, 0xee, 0x34, 0x33, 0xc3, 0xdb, 0xb2, 0x3a, 0xbc,
-	0x7c, 0x27, 0x9d, 0xce, 0xe3, 0xc7, 0x87, 0xf9, 0x01, 0x31, 0xcc, 0xe7, 0x72, 0x71, 0x70, 0x7e,
-	0x53, 0x27, 0xbe, 0xe5, 0xc0, 0x44, 0x6f, 0xa2, 0x39, 0x03, 0xdf, 0x36, 0x07, 0xfe, 0x79, 0x7b,
-	0x9d, 0xe4, 0xec, 0xd9, 0xf0, 0xb3, 0xce, 0xea, 0x1f, 0xe0, 0xb7, 0x86, 0xa0, 0x6b, 0x0f, 0x41,
-	0x4f, 0x40, 0x49, 0x88, 0xe3, 0xe5, 0xb0, 0x1e, 0xb3, 0x46, 0x0e, 0xf1, 0xb5, 0x36, 0x9d, 0x82,
-	0xb1, 0x8e, 0x83, 0x6a, 0x50, 0x88, 0x9f, 0x14, 0x4d, 0xb7, 0x20, 0xde, 0x2a, 0x4f, 0x2a, 0x2d,
-	0x72, 0x60, 0x6f, 0x77, 0xb2, 0x50, 0x79, 0x12, 0x17, 0xe2, 0x27, 0xa9, 0xa6, 0x5e, 0xf7, 0x13,
-	0x7b, 0x9a, 0xfa, 0x82, 0x9f, 0x28, 0x3e, 0x4c, 0x53, 0x5f, 0xf0, 0x13, 0x4c, 0x59, 0xd0, 0x13,
-	0x48, 0x23, 0x49, 0xda, 0x6c, 0xc7, 0xb7, 0x72, 0x02, 0xb9, 0xba, 0xbe, 0xbe, 0xa6, 0x78, 0x31,
-	0xfd, 0x82, 0x42, 0x30, 0xe3, 0x82, 0xde, 0x70, 0xe8, 0x88, 0xf3, 0xc2, 0x30, 0xda, 0x11, 0x8a,
-	0xc3, 0x75, 0x7b, 0x53, 0x20, 0x8c, 0x76, 0x14, 0x73, 0xf1, 0x21, 0x55, 0x01, 0xd6, 0x59, 0xb3,
-	0x8e, 0xd7, 0x36, 0x63, 0xa6, 0x27, 0xd8, 0xe9, 0xf8, 0xdc, 0x7c, 0x25, 0xd3, 0xf1, 0xb9, 0xf9,
-	0x0a, 0x66, 0x5c, 0xe8, 0x07, 0x8d, 0xbc, 0x9b, 0x42, 0xc7, 0xb0, 0xf0, 0x41, 0xb1, 0x77, 0xd3,
-	0xfc, 0xa0, 0xd8, 0xbb, 0x89, 0x29, 0x0b, 0xca, 0x29, 0x8c, 0x63, 0xa6, 0x52, 0x58, 0xe1, 0xb4,
-	0x5a, 0xa9, 0x98, 0x9c, 0x56, 0x2b, 0x15, 0x4c, 0x59, 0xb0, 0x49, 0x5a, 0x8d, 0x99, 0x3e, 0x62,
-	0x67, 0x92, 0xce, 0x66, 0x38, 0x2d, 0xcc, 0x56, 0x30, 0x65, 0x41, 0x45, 0x86, 0xf7, 0x72, 0x27,
-	0xe2, 0xca, 0x4c, 0xe9, 0xf2, 0xaa, 0x85, 0xf9, 0x42, 0xc9, 0x29, 0x6e, 0xc3, 0x7b, 0xbb, 0x93,
-	0x45, 0x06, 0xc2, 0x9c, 0x91, 0xfb, 0xfb, 0x7d, 0xa9, 0xb8, 0x90, 0xf2, 0x1c, 0xfd, 0x2a, 0xdb,
-	0x08, 0x85, 0x2c, 0x10, 0xaa, 0xaf, 0x73, 0x6c, 0xaa, 0xef, 0x19, 0xbe, 0xe3, 0x19, 0xec, 0x70,
-	0x96, 0x3f, 0xfa, 0x92, 0xd3, 0x7d, 0xb6, 0xf5, 0xec, 0xef, 0x65, 0xe9, 0xc6, 0xcc, 0xf7, 0x8a,
-	0x7d, 0x8f, 0xbc, 0x13, 0x6f, 0x38, 0xa9, 0x12, 0x11, 0xf7, 0xda, 0x07, 0x3e, 0x66, 0xee, 0x03,
-	0x16, 0x0f, 0xe4, 0xba, 0xdc, 0xff, 0xbc, 0x03, 0xa3, 0x12, 0x4e, 0xd5, 0xe3, 0x18, 0xdd, 0x82,
-	0x21, 0xd9, 0x52, 0xf1, 0xf5, 0x6c, 0xda, 0x02, 0x94, 0x12, 0xaf, 0x1a, 0xa3, 0xb8, 0xb9, 0xdf,
-	0x19, 0x00, 0x94, 0xee, 0x55, 0xed, 0x30, 0xf6, 0x99, 0x24, 0x3a, 0xc2, 0x2e, 0x14, 0x68, 0xbb,
-	0xd0, 0xb3, 0x36, 0x77, 0xa1, 0xb4, 0x59, 0xc6, 0x7e, 0xf4, 0xa5, 0x8c, 0xdc, 0xe6, 0x1b, 0xd3,
-	0x47, 0x8f, 0x45, 0x6e, 0x6b, 0x4d, 0xd8, 0x5f, 0x82, 0x6f, 0x0b, 0x09, 0xce, 0xb7, 0xae, 0x5f,
-	0xb0, 0x2b, 0xc1, 0xb5, 0x56, 0x64, 0x65, 0x79, 0xc4, 0x25, 0x2c, 0xdf, 0xbb, 0x6e, 0x58, 0x95,
-	0xb0, 0x1a, 0x57, 0x53, 0xd6, 0x46, 0x5c, 0xd6, 0x0e, 0xd8, 0xe2, 0xa9, 0xc9, 0xda, 0x2c, 0x4f,
-	0x25, 0x75, 0x5f, 0x96, 0x52, 0x97, 0xef, 0x5a, 0xcf, 0x59, 0x96, 0xba, 0x1a, 0xdf, 0x6e, 0xf9,
-	0xfb, 0x12, 0x9c, 0xeb, 0xc6, 0xc3, 0x64, 0x13, 0x5d, 0x82, 0xe1, 0x6a, 0x18, 0x6c, 0xfa, 0xf5,
-	0x15, 0xaf, 0x2d, 0xce, 0x6b, 0x4a, 0x16, 0xcd, 0xca, 0x02, 0x9c, 0xe2, 0xa0, 0x07, 0xb8, 0xe0,
-	0xe1, 0x16, 0x91, 0x92, 0x40, 0xed, 0x5b, 0x22, 0x3b, 0x4c, 0x0a, 0xbd, 0x6f, 0xe8, 0xab, 0xdf,
-	0x98, 0xbc, 0xe7, 0x93, 0xff, 0xf1, 0xc1, 0x7b, 0xdc, 0x3f, 0xec, 0x83, 0xfb, 0x72, 0x79, 0x0a,
-	0x6d, 0xfd, 0xb7, 0x0c, 0x6d, 0x5d, 0x2b, 0x17, 0x52, 0xe4, 0x86, 0x4d, 0x45, 0x56, 0x23, 0x9f,
-	0xa7, 0x97, 0x6b, 0xc5, 0x38, 0xbf, 0x51, 0x74, 0xa0, 0x02, 0xaf, 0x45, 0xe2, 0xb6, 0x57, 0x25,
-	0xa2, 0xf7, 0x6a, 0xa0, 0xae, 0xc9, 0x02, 0x9c, 0xe2, 0xf0, 0x23, 0xf4, 0xa6, 0xd7, 0x69, 0x26,
-	0xc2, 0x50, 0xa6, 0x1d, 0xa1, 0x19, 0x18, 0xcb, 0x72, 0xf4, 0xf7, 0x1d, 0x40, 0xdd, 0x5c, 0xc5,
-	0x42, 0x5c, 0x3f, 0x8e, 0x71, 0x98, 0x39, 0xbf, 0xa7, 0x1d, 0xc2, 0xb5, 0x9e, 0xe6, 0xb4, 0x43,
-	0xfb, 0xa6, 0x1f, 0x4f, 0xf7, 0x21, 0x7e, 0x38, 0x38, 0x80, 0x0d, 0x8d, 0x99, 0x5a, 0xaa, 0x55,
-	0x12, 0xc7, 0xdc, 0x1c, 0xa7, 0x9b, 0x5a, 0x18, 0x18, 0xcb, 0x72, 0x34, 0x09, 0x45, 0x12, 0x45,
-	0x61, 0x24, 0xce, 0xda, 0x6c, 0x1a, 0x5f, 0xa1, 0x00, 0xcc, 0xe1, 0xee, 0x8f, 0x0b, 0x50, 0xee,
-	0x75, 0x3a, 0x41, 0xbf, 0xa3, 0x9d, 0xab, 0xc5, 0xc9, 0x49, 0x1c, 0xfc, 0xc2, 0xe3, 0x3b, 0x13,
-	0x65, 0x0f, 0x80, 0x3d, 0x4e, 0xd8, 0xa2, 0x14, 0x67, 0x1b, 0x38, 0xf1, 0xa6, 0x76, 0xc2, 0xd6,
-	0x49, 0xe4, 0x6c, 0xf0, 0x9b, 0xe6, 0x06, 0xbf, 0x66, 0xbb, 0x53, 0xfa, 0x36, 0xff, 0xc7, 0x45,
-	0x38, 0x23, 0x4b, 0x2b, 0x84, 0x6e, 0x95, 0xcf, 0x74, 0x48, 0xb4, 0x83, 0xfe, 0xc8, 0x81, 0xb3,
-	0x5e, 0xd6, 0x74, 0xe3, 0x93, 0x63, 0x18, 0x68, 0x8d, 0xeb, 0xd4, 0x74, 0x0e, 0x47, 0x3e, 0xd0,
-	0x97, 0xc5, 0x40, 0x9f, 0xcd, 0x43, 0xe9, 0x61, 0x77, 0xcf, 0xed, 0x00, 0x7a, 0x0a, 0x46, 0x24,
-	0x9c, 0x99, 0x7b, 0xf8, 0x12, 0x57, 0xc6, 0xed, 0x69, 0xad, 0x0c, 0x1b, 0x98, 0xb4, 0x66, 0x42,
-	0x5a, 0xed, 0xa6, 0x97, 0x10, 0xcd, 0x50, 0xa4, 0x6a, 0xae, 0x6b, 0x65, 0xd8, 0xc0, 0x44, 0x8f,
-	0xc0, 0x40, 0x10, 0xd6, 0xc8, 0x62, 0x4d, 0x18, 0x88, 0xc7, 0x44, 0x9d, 0x81, 0x6b, 0x0c, 0x8a,
-	0x45, 0x29, 0x7a, 0x38, 0xb5, 0xc6, 0x15, 0xd9, 0x12, 0x2a, 0xe5, 0x59, 0xe2, 0xd0, 0x3f, 0x72,
-	0x60, 0x98, 0xd6, 0x58, 0xdf, 0x69, 0x13, 0xba, 0xb7, 0xd1, 0x2f, 0x52, 0x3b, 0x9e, 0x2f, 0x72,
-	0x4d, 0xb2, 0x31, 0x4d, 0x1d, 0xc3, 0x0a, 0xfe, 0xfa, 0x5b, 0x93, 0x43, 0xf2, 0x07, 0x4e, 0x5b,
-	0x35, 0xb1, 0x00, 0xf7, 0xf6, 0xfc, 0x9a, 0x87, 0x72, 0x05, 0xfc, 0x2d, 0x18, 0x33, 0x1b, 0x71,
-	0x28, 0x3f, 0xc0, 0xbf, 0xd0, 0x96, 0x1d, 0xef, 0x97, 0x90, 0x67, 0x6f, 0x9b, 0x36, 0xab, 0x26,
-	0xc3, 0x9c, 0x98, 0x7a, 0xe6, 0x64, 0x98, 0x13, 0x93, 0x61, 0xce, 0xfd, 0x03, 0x27, 0x5d, 0x9a,
-	0x9a, 0x9a, 0x47, 0x37, 0xe6, 0x4e, 0xd4, 0x14, 0x82, 0x58, 0x6d, 0xcc, 0xd7, 0xf1, 0x32, 0xa6,
-	0x70, 0xf4, 0xa6, 0x26, 0x1d, 0x69, 0xb5, 0x8e, 0x70, 0x6b, 0x58, 0x32, 0xd1, 0x1b, 0x84, 0xbb,
-	0xe5, 0x9f, 0x28, 0xc0, 0xd9, 0x26, 0xb8, 0x5f, 0x2a, 0xc0, 0x03, 0xfb, 0x2a, 0xad, 0xb9, 0x0d,
-	0x77, 0xde, 0xf6, 0x86, 0xd3, 0x6d, 0x2d, 0x22, 0xed, 0xf0, 0x3a, 0x5e, 0x16, 0xdf, 0x4b, 0x6d,
-	0x6b, 0x98, 0x83, 0xb1, 0x2c, 0xa7, 0xaa, 0xc3, 0x16, 0xd9, 0x99, 0x0f, 0xa3, 0x96, 0x97, 0x08,
-	0xe9, 0xa0, 0x54, 0x87, 0x25, 0x59, 0x80, 0x53, 0x1c, 0xf7, 0x8f, 0x1c, 0xc8, 0x36, 0x00, 0x79,
-	0x30, 0xd6, 0x89, 0x49, 0x44, 0xb7, 0xd4, 0x0a, 0xa9, 0x46, 0x44, 0x4e, 0xcf, 0x87, 0xa7, 0xb8,
-	0xb7, 0x9f, 0xf6, 0x70, 0xaa, 0x1a, 0x46, 0x64, 0x6a, 0xfb, 0x89, 0x29, 0x8e, 0xb1, 0x44, 0x76,
-	0x2a, 0xa4, 0x49, 0x28, 0x8d, 0x19, 0xb4, 0xb7, 0x3b, 0x39, 0x76, 0xdd, 0x20, 0x80, 0x33, 0x04,
-	0x29, 0x8b, 0xb6, 0x17, 0xc7, 0x37, 0xc3, 0xa8, 0x26, 0x58, 0x14, 0x0e, 0xcd, 0x62, 0xcd, 0x20,
-	0x80, 0x33, 0x04, 0xdd, 0x1f, 0xd0, 0xe3, 0xa3, 0xae, 0xb5, 0xa2, 0x6f, 0x50, 0xdd, 0x87, 0x42,
-	0x66, 0x9a, 0xe1, 0xc6, 0x6c, 0x18, 0x24, 0x9e, 0x1f, 0x10, 0x19, 0x2c, 0xb0, 0x6e, 0x49, 0x47,
-	0x36, 0x68, 0xa7, 0x36, 0xfc, 0xee, 0x32, 0x9c, 0xd3, 0x16, 0xaa, 0xe3, 0x6c, 0x34, 0xc3, 0x8d,
-	0xac, 0x17, 0x90, 0x22, 0x61, 0x56, 0xe2, 0xfe, 0xd4, 0x81, 0x0b, 0x3d, 0x94, 0x71, 0xf4, 0x15,
-	0x07, 0x46, 0x37, 0x7e, 0x26, 0xfa, 0x66, 0x36, 0x03, 0x7d, 0x00, 0xc6, 0x28, 0x80, 0xee, 0x44,
-	0x62, 0x6e, 0x16, 0x4c, 0x0f, 0xd5, 0x8c, 0x51, 0x8a, 0x33, 0xd8, 0xee, 0xaf, 0x15, 0x20, 0x87,
-	0x0b, 0x7a, 0x1c, 0x86, 0x48, 0x50, 0x6b, 0x87, 0x7e, 0x90, 0x08, 0x61, 0xa4, 0xa4, 0xde, 0x15,
-	0x01, 0xc7, 0x0a, 0x43, 0x9c, 0x3f, 0xc4, 0xc0, 0x14, 0xba, 0xce, 0x1f, 0xa2, 0xe5, 0x29, 0x0e,
-	0xaa, 0xc3, 0xb8, 0xc7, 0xfd, 0x2b, 0x6c, 0xee, 0xb1, 0x69, 0xda, 0x77, 0x98, 0x69, 0x7a, 0x96,
-	0xb9, 0x3f, 0x33, 0x24, 0x70, 0x17, 0x51, 0xf4, 0x5e, 0x28, 0x75, 0x62, 0x52, 0x99, 0x5b, 0x9a,
-	0x8d, 0x48, 0x8d, 0x9f, 0x8a, 0x35, 0xbf, 0xdf, 0xf5, 0xb4, 0x08, 0xeb, 0x78, 0xee, 0x9f, 0x38,
-	0x30, 0x38, 0xe3, 0x55, 0xb7, 0xc2, 0xcd, 0x4d, 0x3a, 0x14, 0xb5, 0x4e, 0x94, 0x1a, 0xb6, 0xb4,
-	0xa1, 0x98, 0x13, 0x70, 0xac, 0x30, 0xd0, 0x3a, 0x0c, 0xf0, 0x05, 0x2f, 0x96, 0xdd, 0xbb, 0xb5,
-	0xfe, 0xa8, 0x38, 0x1e, 0x36, 0x1d, 0x3a, 0x89, 0xdf, 0x9c, 0xe2, 0x71, 0x3c, 0x53, 0x8b, 0x41,
-	0xb2, 0x1a, 0x55, 0x92, 0xc8, 0x0f, 0xea, 0x33, 0x40, 0xb7, 0x8b, 0x79, 0x46, 0x03, 0x0b, 0x5a,
-	0xb4, 0x1b, 0x2d, 0xef, 0x96, 0x64, 0x27, 0xc4, 0x8f, 0xea, 0xc6, 0x4a, 0x5a, 0x84, 0x75, 0x3c,
-	0xba, 0x9b, 0x54, 0xbd, 0xb6, 0xd0, 0x4b, 0xd4, 0x6e, 0x32, 0xeb, 0xb5, 0x31, 0x85, 0xbb, 0x7f,
-	0xe8, 0xc0, 0xf0, 0x8c, 0x17, 0xfb, 0xd5, 0xbf, 0x44, 0xb2, 0xe9, 0x23, 0x50, 0x9c, 0xf5, 0xaa,
-	0x0d, 0x82, 0xae, 0x67, 0xcf, 0xc4, 0xa5, 0xcb, 0x8f, 0xe6, 0xb1, 0x51, 0xe7, 0x63, 0x9d, 0xd3,
-	0x68, 0xaf, 0x93, 0xb3, 0xfb, 0x96, 0x03, 0x63, 0xb3, 0x4d, 0x9f, 0x04, 0xc9, 0x2c, 0x89, 0x12,
-	0x36, 0x70, 0x75, 0x18, 0xaf, 0x2a, 0xc8, 0x51, 0x86, 0x8e, 0x4d, 0xe6, 0xd9, 0x0c, 0x09, 0xdc,
-	0x45, 0x14, 0xd5, 0xe0, 0x14, 0x87, 0xa5, 0x8b, 0xe6, 0x50, 0xe3, 0xc7, 0x8c, 0xa7, 0xb3, 0x26,
-	0x05, 0x9c, 0x25, 0xe9, 0xfe, 0xc4, 0x81, 0x0b, 0xb3, 0xcd, 0x4e, 0x9c, 0x90, 0xe8, 0x86, 0x10,
-	0x56, 0x52, 0xfb, 0x45, 0x1f, 0x83, 0xa1, 0x96, 0x74, 0xe8, 0x3a, 0x77, 0x98, 0xdf, 0x4c, 0xdc,
-	0x51, 0x6c, 0xda, 0x98, 0xd5, 0x8d, 0x17, 0x49, 0x35, 0x59, 0x21, 0x89, 0x97, 0x46, 0x1f, 0xa4,
-	0x30, 0xac, 0xa8, 0xa2, 0x36, 0xf4, 0xc7, 0x6d, 0x52, 0xb5, 0x17, 0xfc, 0x25, 0xfb, 0x50, 0x69,
-	0x93, 0x6a, 0x2a, 0xf6, 0x99, 0x2b, 0x92, 0x71, 0x72, 0xff, 0x8f, 0x03, 0xf7, 0xf5, 0xe8, 0xef,
-	0xb2, 0x1f, 0x27, 0xe8, 0x85, 0xae, 0x3e, 0x4f, 0x1d, 0xac, 0xcf, 0xb4, 0x36, 0xeb, 0xb1, 0x92,
-	0x17, 0x12, 0xa2, 0xf5, 0xf7, 0xe3, 0x50, 0xf4, 0x13, 0xd2, 0x92, 0x56, 0x6a, 0x0b, 0xf6, 0xa4,
-	0x1e, 0x7d, 0x99, 0x19, 0x95, 0x21, 0x80, 0x8b, 0x94, 0x1f, 0xe6, 0x6c, 0xdd, 0x2d, 0x18, 0x98,
-	0x0d, 0x9b, 0x9d, 0x56, 0x70, 0xb0, 0x40, 0x9a, 0x64, 0xa7, 0x4d, 0xb2, 0x5b, 0x28, 0x3b, 0x1d,
-	0xb0, 0x12, 0x69, 0x57, 0xea, 0xcb, 0xb7, 0x2b, 0xb9, 0xff, 0xda, 0x01, 0xba, 0xaa, 0x6a, 0xbe,
-	0x70, 0x34, 0x72, 0x72, 0x9c, 0xe1, 0x03, 0x3a, 0xb9, 0xdb, 0xbb, 0x93, 0xa3, 0x0a, 0x51, 0xa3,
-	0xff, 0x11, 0x18, 0x88, 0xd9, 0x89, 0x5d, 0xb4, 0x61, 0x5e, 0xaa, 0xd7, 0xfc, 0x1c, 0x7f, 0x7b,
-	0x77, 0xf2, 0x40, 0x51, 0x9d, 0x53, 0x8a, 0xb6, 0xf0, 0x89, 0x0a, 0xaa, 0x54, 0x1f, 0x6c, 0x91,
-	0x38, 0xf6, 0xea, 0xf2, 0x00, 0xa8, 0xf4, 0xc1, 0x15, 0x0e, 0xc6, 0xb2, 0xdc, 0xfd, 0xb2, 0x03,
-	0xa3, 0x6a, 0x6f, 0xa3, 0xda, 0x3d, 0xba, 0xa6, 0xef, 0x82, 0x7c, 0xa6, 0x3c, 0xd0, 0x43, 0xe2,
-	0x88, 0x7d, 0x7e, 0xff, 0x4d, 0xf2, 0x3d, 0x30, 0x52, 0x23, 0x6d, 0x12, 0xd4, 0x48, 0x50, 0xa5,
-	0xa7, 0x73, 0x3a, 0x43, 0x86, 0x67, 0xc6, 0xe9, 0x71, 0x74, 0x4e, 0x83, 0x63, 0x03, 0xcb, 0xfd,
-	0xa6, 0x03, 0xf7, 0x2a, 0x72, 0x15, 0x92, 0x60, 0x92, 0x44, 0x3b, 0x2a, 0x8a, 0xf3, 0x70, 0x9b,
-	0xd9, 0x0d, 0xaa, 0x1e, 0x27, 0x11, 0x67, 0x7e, 0xb4, 0xdd, 0xac, 0xc4, 0x95, 0x69, 0x46, 0x04,
-	0x4b, 0x6a, 0xee, 0xaf, 0xf4, 0xc1, 0x59, 0xbd, 0x91, 0x4a, 0xc0, 0x7c, 0xca, 0x01, 0x50, 0x23,
-	0x40, 0xf7, 0xeb, 0x3e, 0x3b, 0xae, 0x2d, 0xe3, 0x4b, 0xa5, 0x22, 0x48, 0x81, 0x63, 0xac, 0xb1,
-	0x45, 0xcf, 0xc1, 0xc8, 0x36, 0x5d, 0x14, 0x64, 0x85, 0x6a, 0x13, 0x71, 0xb9, 0x8f, 0x35, 0x63,
-	0x32, 0xef, 0x63, 0x3e, 0x9b, 0xe2, 0xa5, 0xd6, 0x02, 0x0d, 0x18, 0x63, 0x83, 0x14, 0x3d, 0x08,
-	0x8d, 0x46, 0xfa, 0x27, 0x11, 0x26, 0xf3, 0x0f, 0x5b, 0xec, 0x63, 0xf6, 0xab, 0xcf, 0x9c, 0xde,
-	0xdb, 0x9d, 0x1c, 0x35, 0x40, 0xd8, 0x6c, 0x84, 0xfb, 0x1c, 0xb0, 0xb1, 0xf0, 0x83, 0x0e, 0x59,
-	0x0d, 0xd0, 0x43, 0xd2, 0x84, 0xc7, 0xdd, 0x2e, 0x4a, 0x72, 0xe8, 0x66, 0x3c, 0x7a, 0xd4, 0xdd,
-	0xf4, 0xfc, 0x26, 0x8b, 0x6e, 0xa4, 0x58, 0xea, 0xa8, 0x3b, 0xcf, 0xa0, 0x58, 0x94, 0xba, 0x53,
-	0x30, 0x38, 0x4b, 0xfb, 0x4e, 0x22, 0x4a, 0x57, 0x0f, 0x4a, 0x1e, 0x35, 0x82, 0x92, 0x65, 0xf0,
-	0xf1, 0x3a, 0x9c, 0x9b, 0x8d, 0x88, 0x97, 0x90, 0xca, 0x93, 0x33, 0x9d, 0xea, 0x16, 0x49, 0x78,
-	0xe4, 0x57, 0x8c, 0xde, 0x0f, 0xa3, 0x21, 0xdb, 0x32, 0x96, 0xc3, 0xea, 0x96, 0x1f, 0xd4, 0x85,
-	0x45, 0xf6, 0x9c, 0xa0, 0x32, 0xba, 0xaa, 0x17, 0x62, 0x13, 0xd7, 0xfd, 0xcf, 0x05, 0x18, 0x99,
-	0x8d, 0xc2, 0x40, 0x8a, 0xc5, 0x13, 0xd8, 0xca, 0x12, 0x63, 0x2b, 0xb3, 0xe0, 0x0d, 0xd5, 0xdb,
-	0xdf, 0x6b, 0x3b, 0x43, 0xaf, 0x2a, 0x11, 0xd9, 0x67, 0xeb, 0x84, 0x62, 0xf0, 0x65, 0xb4, 0xd3,
-	0x8f, 0x6d, 0x0a, 0x50, 0xf7, 0xbf, 0x38, 0x30, 0xae, 0xa3, 0x9f, 0xc0, 0x0e, 0x1a, 0x9b, 0x3b,
-	0xe8, 0x35, 0xbb, 0xfd, 0xed, 0xb1, 0x6d, 0xbe, 0x35, 0x68, 0xf6, 0x93, 0xb9, 0xc2, 0xbf, 0xea,
-	0xc0, 0xc8, 0x4d, 0x0d, 0x20, 0x3a, 0x6b, 0x5b, 0x89, 0x79, 0x87, 0x14, 0x33, 0x3a, 0xf4, 0x76,
-	0xe6, 0x37, 0x36, 0x5a, 0x42, 0xe5, 0x7e, 0x5c, 0x6d, 0x90, 0x5a, 0xa7, 0x29, 0xb7, 0x6f, 0x35,
-	0xa4, 0x15, 0x01, 0xc7, 0x0a, 0x03, 0xbd, 0x00, 0xa7, 0xab, 0x61, 0x50, 0xed, 0x44, 0x11, 0x09,
-	0xaa, 0x3b, 0x6b, 0xec, 0x0a, 0x85, 0xd8, 0x10, 0xa7, 0x44, 0xb5, 0xd3, 0xb3, 0x59, 0x84, 0xdb,
-	0x79, 0x40, 0xdc, 0x4d, 0x88, 0xfb, 0x12, 0x62, 0xba, 0x65, 0x89, 0xf3, 0x98, 0xe6, 0x4b, 0x60,
-	0x60, 0x2c, 0xcb, 0xd1, 0x75, 0xb8, 0x10, 0x27, 0x5e, 0x94, 0xf8, 0x41, 0x7d, 0x8e, 0x78, 0xb5,
-	0xa6, 0x1f, 0xd0, 0xa3, 0x44, 0x18, 0xd4, 0xb8, 0xa7, 0xb1, 0x6f, 0xe6, 0xbe, 0xbd, 0xdd, 0xc9,
-	0x0b, 0x95, 0x7c, 0x14, 0xdc, 0xab, 0x2e, 0xfa, 0x08, 0x4c, 0x08, 0x6f, 0xc5, 0x66, 0xa7, 0xf9,
-	0x74, 0xb8, 0x11, 0x5f, 0xf5, 0x63, 0x7a, 0xcc, 0x5f, 0xf6, 0x5b, 0x7e, 0xc2, 0xfc, 0x89, 0xc5,
-	0x99, 0x8b, 0x7b, 0xbb, 0x93, 0x13, 0x95, 0x9e, 0x58, 0x78, 0x1f, 0x0a, 0x08, 0xc3, 0x79, 0x2e,
-	0xfc, 0xba, 0x68, 0x0f, 0x32, 0xda, 0x13, 0x7b, 0xbb, 0x93, 0xe7, 0xe7, 0x73, 0x31, 0x70, 0x8f,
-	0x9a, 0xf4, 0x0b, 0x26, 0x7e, 0x8b, 0xbc, 0x1c, 0x06, 0x84, 0xc5, 0xb1, 0x68, 0x5f, 0x70, 0x5d,
-	0xc0, 0xb1, 0xc2, 0x40, 0x2f, 0xa6, 0x33, 0x91, 0x2e, 0x17, 0x11, 0x8f, 0x72, 0x78, 0x09, 0xc7,
-	0x8e, 0x26, 0x37, 0x34, 0x4a, 0x2c, 0xd0, 0xd2, 0xa0, 0x8d, 0x3e, 0xed, 0xc0, 0x48, 0x9c, 0x84,
-	0xea, 0xda, 0x83, 0x08, 0x48, 0xb1, 0x30, 0xed, 0x2b, 0x1a, 0x55, 0xae, 0xf8, 0xe8, 0x10, 0x6c,
-	0x70, 0x45, 0xef, 0x84, 0x61, 0x39, 0x81, 0xe3, 0x72, 0x89, 0xe9, 0x4a, 0xec, 0x18, 0x27, 0xe7,
-	0x77, 0x8c, 0xd3, 0x72, 0xaa, 0xca, 0xde, 0x6c, 0x90, 0x80, 0x85, 0xe4, 0x6a, 0xaa, 0xec, 0x8d,
-	0x06, 0x09, 0x30, 0x2b, 0x71, 0x7f, 0xdc, 0x07, 0xa8, 0x5b, 0xf0, 0xa1, 0x25, 0x18, 0xf0, 0xaa,
-	0x89, 0xbf, 0x2d, 0xc3, 0x11, 0x1f, 0xca, 0x53, 0x0a, 0xf8, 0x00, 0x62, 0xb2, 0x49, 0xe8, 0xbc,
-	0x27, 0xa9, 0xb4, 0x9c, 0x66, 0x55, 0xb1, 0x20, 0x81, 0x42, 0x38, 0xdd, 0xf4, 0xe2, 0x44, 0xb6,
-	0xb0, 0x46, 0x3f, 0xa4, 0xd8, 0x2e, 0x7e, 0xfe, 0x60, 0x9f, 0x8a, 0xd6, 0x98, 0x39, 0x47, 0xd7,
-	0xe3, 0x72, 0x96, 0x10, 0xee, 0xa6, 0x8d, 0x3e, 0xc1, 0xb4, 0x2b, 0xae, 0xfa, 0x4a, 0xb5, 0x66,
-	0xc9, 0x8a, 0xe6, 0xc1, 0x69, 0x1a, 0x9a, 0x95, 0x60, 0x83, 0x35, 0x96, 0xe8, 0x12, 0x0c, 0xb3,
-	0x75, 0x43, 0x6a, 0x84, 0xaf, 0xfe, 0xbe, 0x54, 0x09, 0xae, 0xc8, 0x02, 0x9c, 0xe2, 0x68, 0x5a,
-	0x06, 0x5f, 0xf0, 0x3d, 0xb4, 0x0c, 0xf4, 0x14, 0x14, 0xdb, 0x0d, 0x2f, 0x96, 0x21, 0xee, 0xae,
-	0x94, 0xda, 0x6b, 0x14, 0xc8, 0x44, 0x93, 0xf6, 0x2d, 0x19, 0x10, 0xf3, 0x0a, 0xee, 0xbf, 0x01,
-	0x18, 0x9c, 0x9b, 0x5e, 0x58, 0xf7, 0xe2, 0xad, 0x03, 0x9c, 0x81, 0xe8, 0x32, 0x14, 0xca, 0x6a,
-	0x56, 0x90, 0x4a, 0x25, 0x16, 0x2b, 0x0c, 0x14, 0xc0, 0x80, 0x1f, 0x50, 0xc9, 0x53, 0x1e, 0xb3,
-	0xe5, 0x86, 0x50, 0xe7, 0x39, 0x66, 0x27, 0x5a, 0x64, 0xd4, 0xb1, 0xe0, 0x82, 0x5e, 0x85, 0x61,
-	0x4f, 0xde, 0x30, 0x12, 0xfb, 0xff, 0x92, 0x0d, 0xfb, 0xba, 0x20, 0xa9, 0x47, 0x38, 0x09, 0x10,
-	0x4e, 0x19, 0xa2, 0x4f, 0x3a, 0x50, 0x92, 0x5d, 0xc7, 0x64, 0x53, 0xb8, 0xbe, 0x57, 0xec, 0xf5,
-	0x19, 0x93, 0x4d, 0x1e, 0xfe, 0xa2, 0x01, 0xb0, 0xce, 0xb2, 0xeb, 0xcc, 0x54, 0x3c, 0xc8, 0x99,
-	0x09, 0xdd, 0x84, 0xe1, 0x9b, 0x7e, 0xd2, 0x60, 0x3b, 0xbc, 0x70, 0xb9, 0xcd, 0xdf, 0x7d, 0xab,
-	0x29, 0xb9, 0x74, 0xc4, 0x6e, 0x48, 0x06, 0x38, 0xe5, 0x45, 0x97, 0x03, 0xfd, 0xc1, 0x6e, 0x68,
-	0xb1, 0xbd, 0x61, 0xd8, 0xac, 0xc0, 0x0a, 0x70, 0x8a, 0x43, 0x87, 0x78, 0x84, 0xfe, 0xaa, 0x90,
-	0x97, 0x3a, 0x54, 0xb4, 0x88, 0x90, 0x46, 0x0b, 0xf3, 0x4a, 0x52, 0xe4, 0x83, 0x75, 0x43, 0xe3,
-	0x81, 0x0d, 0x8e, 0x4a, 0x74, 0x0e, 0xf7, 0x12, 0x9d, 0xe8, 0x55, 0x7e, 0x86, 0xe3, 0x87, 0x09,
-	0xb1, 0x1b, 0x2c, 0xdb, 0x39, 0xdf, 0x70, 0x9a, 0xfc, 0xd6, 0x43, 0xfa, 0x1b, 0x6b, 0xfc, 0xa8,
-	0xc4, 0x08, 0x83, 0x2b, 0xb7, 0xfc, 0x44, 0xdc, 0xd5, 0x50, 0x12, 0x63, 0x95, 0x41, 0xb1, 0x28,
-	0xe5, 0xa1, 0x1d, 0x74, 0x12, 0xc4, 0x62, 0x17, 0xd0, 0x42, 0x3b, 0x18, 0x18, 0xcb, 0x72, 0xf4,
-	0x0f, 0x1c, 0x28, 0x36, 0xc2, 0x70, 0x2b, 0x2e, 0x8f, 0xb2, 0xc9, 0x61, 0x41, 0xa7, 0x16, 0x12,
-	0x67, 0xea, 0x2a, 0x25, 0x6b, 0xde, 0x3e, 0x2b, 0x32, 0xd8, 0xed, 0xdd, 0xc9, 0xb1, 0x65, 0x7f,
-	0x93, 0x54, 0x77, 0xaa, 0x4d, 0xc2, 0x20, 0xaf, 0xbf, 0xa5, 0x41, 0xae, 0x6c, 0x93, 0x20, 0xc1,
-	0xbc, 0x55, 0x13, 0x9f, 0x77, 0x00, 0x52, 0x42, 0x39, 0x3e, 0x54, 0x62, 0x46, 0x1d, 0x58, 0x38,
-	0x50, 0x1b, 0x4d, 0xd3, 0x9d, 0xb2, 0xff, 0xce, 0x81, 0x12, 0xed, 0x9c, 0x14, 0x81, 0x8f, 0xc0,
-	0x40, 0xe2, 0x45, 0x75, 0x22, 0xfd, 0x08, 0xea, 0x73, 0xac, 0x33, 0x28, 0x16, 0xa5, 0x28, 0x80,
-	0x62, 0xe2, 0xc5, 0x5b, 0x52, 0x8d, 0x5f, 0xb4, 0x36, 0xc4, 0xa9, 0x06, 0x4f, 0x7f, 0xc5, 0x98,
-	0xb3, 0x41, 0x8f, 0xc2, 0x10, 0xdd, 0x3a, 0xe6, 0xbd, 0x58, 0x86, 0xf6, 0x8c, 0x50, 0x21, 0x3e,
-	0x2f, 0x60, 0x58, 0x95, 0xba, 0xbf, 0x56, 0x80, 0xfe, 0x39, 0x7e, 0xa0, 0x1b, 0x88, 0xc3, 0x4e,
-	0x54, 0x25, 0x42, 0xb1, 0xb7, 0x30, 0xa7, 0x29, 0xdd, 0x0a, 0xa3, 0xa9, 0x1d, 0xa9, 0xd8, 0x6f,
-	0x2c, 0x78, 0xa1, 0x37, 0x1d, 0x18, 0x4b, 0x22, 0x2f, 0x88, 0x37, 0x99, 0xc7, 0xc6, 0x0f, 0x03,
-	0x31, 0x44, 0x16, 0x66, 0xe1, 0xba, 0x41, 0xb7, 0x92, 0x90, 0x76, 0xea, 0x38, 0x32, 0xcb, 0x70,
-	0xa6, 0x0d, 0xee, 0xaf, 0x3b, 0x00, 0x69, 0xeb, 0xd1, 0x1b, 0x0e, 0x8c, 0x7a, 0x7a, 0x48, 0xa9,
-	0x18, 0xa3, 0x55, 0x7b, 0xee, 0x5d, 0x46, 0x96, 0xdb, 0x32, 0x0c, 0x10, 0x36, 0x19, 0xbb, 0xef,
-	0x85, 0x22, 0x5b, 0x1d, 0xec, 0xd0, 0x23, 0x6c, 0xdf, 0x59, 0x63, 0x97, 0xb4, 0x89, 0x63, 0x85,
-	0xe1, 0xbe, 0x00, 0x63, 0x57, 0x6e, 0x91, 0x6a, 0x27, 0x09, 0x23, 0x6e, 0xf9, 0xef, 0x71, 0x85,
-	0xc8, 0x39, 0xd2, 0x15, 0xa2, 0xef, 0x3a, 0x50, 0xd2, 0xe2, 0x0b, 0xe9, 0x4e, 0x5d, 0x9f, 0xad,
-	0x70, 0x03, 0x87, 0x18, 0xaa, 0x25, 0x2b, 0x11, 0x8c, 0x9c, 0x64, 0xba, 0x8d, 0x28, 0x10, 0x4e,
-	0x19, 0xde, 0x21, 0xfe, 0xcf, 0xfd, 0x7d, 0x07, 0xce, 0xe5, 0x06, 0x43, 0xbe, 0xcd, 0xcd, 0x36,
-	0x7c, 0xf0, 0x85, 0x03, 0xf8, 0xe0, 0x7f, 0xdb, 0x81, 0x94, 0x12, 0x15, 0x45, 0x1b, 0x69, 0xcb,
-	0x35, 0x51, 0x24, 0x38, 0x89, 0x52, 0xf4, 0x2a, 0x5c, 0x30, 0xbf, 0xe0, 0x11, 0xfd, 0x2d, 0xfc,
-	0x70, 0x9a, 0x4f, 0x09, 0xf7, 0x62, 0xe1, 0x7e, 0xcd, 0x81, 0xe2, 0x82, 0xd7, 0xa9, 0x93, 0x03,
-	0x99, 0xcb, 0xa8, 0x1c, 0x8b, 0x88, 0xd7, 0x4c, 0xe4, 0xd1, 0x41, 0xc8, 0x31, 0x2c, 0x60, 0x58,
-	0x95, 0xa2, 0x69, 0x18, 0x0e, 0xdb, 0xc4, 0x70, 0x21, 0x3e, 0x24, 0x47, 0x6f, 0x55, 0x16, 0xd0,
-	0x6d, 0x87, 0x71, 0x57, 0x10, 0x9c, 0xd6, 0x72, 0xbf, 0x3e, 0x00, 0x25, 0xed, 0xda, 0x0c, 0xd5,
-	0x05, 0x22, 0xd2, 0x0e, 0xb3, 0xfa, 0x32, 0x9d, 0x30, 0x98, 0x95, 0xd0, 0x35, 0x18, 0x91, 0x6d,
-	0x3f, 0xe6, 0x62, 0xcb, 0x58, 0x83, 0x58, 0xc0, 0xb1, 0xc2, 0x40, 0x93, 0x50, 0xac, 0x91, 0x76,
-	0xd2, 0x60, 0xcd, 0xeb, 0xe7, 0xb1, 0x83, 0x73, 0x14, 0x80, 0x39, 0x9c, 0x22, 0x6c, 0x92, 0xa4,
-	0xda, 0x60, 0x96, 0x61, 0x11, 0x5c, 0x38, 0x4f, 0x01, 0x98, 0xc3, 0x73, 0xbc, 0x98, 0xc5, 0xe3,
-	0xf7, 0x62, 0x0e, 0x58, 0xf6, 0x62, 0xa2, 0x36, 0x9c, 0x89, 0xe3, 0xc6, 0x5a, 0xe4, 0x6f, 0x7b,
-	0x09, 0x49, 0x67, 0xdf, 0xe0, 0x61, 0xf8, 0x5c, 0x60, 0x17, 0xd9, 0x2b, 0x57, 0xb3, 0x54, 0x70,
-	0x1e, 0x69, 0x54, 0x81, 0x73, 0x7e, 0x10, 0x93, 0x6a, 0x27, 0x22, 0x8b, 0xf5, 0x20, 0x8c, 0xc8,
-	0xd5, 0x30, 0xa6, 0xe4, 0xc4, 0x35, 0x5c, 0x15, 0x6e, 0xbb, 0x98, 0x87, 0x84, 0xf3, 0xeb, 0xa2,
-	0x05, 0x38, 0x5d, 0xf3, 0x63, 0x6f, 0xa3, 0x49, 0x2a, 0x9d, 0x8d, 0x56, 0xc8, 0x8f, 0xe6, 0xc3,
-	0x8c, 0xe0, 0xbd, 0xd2, 0x8e, 0x34, 0x97, 0x45, 0xc0, 0xdd, 0x75, 0xd0, 0x53, 0x30, 0x12, 0xfb,
-	0x41, 0xbd, 0x49, 0x66, 0x22, 0x2f, 0xa8, 0x36, 0xc4, 0xfd, 0x5d, 0x65, 0x6f, 0xaf, 0x68, 0x65,
-	0xd8, 0xc0, 0x64, 0x6b, 0x9e, 0xd7, 0xc9, 0x68, 0x83, 0x02, 0x5b, 0x94, 0xa2, 0x69, 0x38, 0x25,
-	0xfb, 0x50, 0xd9, 0xf2, 0xdb, 0xeb, 0xcb, 0x15, 0xa6, 0x15, 0x0e, 0xa5, 0xc1, 0x44, 0x8b, 0x66,
-	0x31, 0xce, 0xe2, 0xbb, 0x3f, 0x74, 0x60, 0x44, 0x8f, 0x96, 0xa7, 0xca, 0x3a, 0x34, 0xe6, 0xe6,
-	0x2b, 0x7c, 0x3b, 0xb1, 0xa7, 0x34, 0x5c, 0x55, 0x34, 0xd3, 0xf3, 0x76, 0x0a, 0xc3, 0x1a, 0xcf,
-	0x03, 0xdc, 0x7d, 0x7f, 0x08, 0x8a, 0x9b, 0x21, 0xd5, 0x69, 0xfa, 0x4c, 0x5b, 0xff, 0x3c, 0x05,
-	0x62, 0x5e, 0xe6, 0xfe, 0x0f, 0x07, 0xce, 0xe7, 0x5f, 0x04, 0xf8, 0x59, 0xe8, 0xe4, 0x65, 0x00,
-	0xda, 0x15, 0x63, 0x5f, 0xd0, 0xb2, 0x5f, 0xc8, 0x12, 0xac, 0x61, 0x1d, 0xac, 0xdb, 0xff, 0xb6,
-	0x00, 0x1a, 0x4f, 0xf4, 0x05, 0x07, 0x46, 0x29, 0xdb, 0xa5, 0x68, 0xc3, 0xe8, 0xed, 0xaa, 0x9d,
-	0xde, 0x2a, 0xb2, 0xa9, 0x4b, 0xc3, 0x00, 0x63, 0x93, 0x39, 0x7a, 0x27, 0x0c, 0x7b, 0xb5, 0x5a,
-	0x44, 0xe2, 0x58, 0x39, 0x07, 0x99, 0xc1, 0x6b, 0x5a, 0x02, 0x71, 0x5a, 0x4e, 0xe5, 0x70, 0xa3,
-	0xb6, 0x19, 0x53, 0xd1, 0x26, 0x64, 0xbf, 0x92, 0xc3, 0x94, 0x09, 0x85, 0x63, 0x85, 0x81, 0x9e,
-	0x85, 0xf3, 0x35, 0x2f, 0xf1, 0xb8, 0x0a, 0x48, 0xa2, 0xb5, 0x28, 0x4c, 0x48, 0x95, 0xed, 0x1b,
-	0x3c, 0x96, 0xe4, 0xa2, 0xa8, 0x7b, 0x7e, 0x2e, 0x17, 0x0b, 0xf7, 0xa8, 0xed, 0xfe, 0x72, 0x3f,
-	0x98, 0x7d, 0x42, 0x35, 0x38, 0xb5, 0x15, 0x6d, 0xcc, 0xb2, 0x98, 0x8d, 0xa3, 0xc4, 0x4e, 0xb0,
-	0x98, 0x86, 0x25, 0x93, 0x02, 0xce, 0x92, 0x14, 0x5c, 0x96, 0xc8, 0x4e, 0xe2, 0x6d, 0x1c, 0x39,
-	0x72, 0x62, 0xc9, 0xa4, 0x80, 0xb3, 0x24, 0xd1, 0x7b, 0xa1, 0xb4, 0x15, 0x6d, 0xc8, 0xdd, 0x23,
-	0x1b, 0xa5, 0xb3, 0x94, 0x16, 0x61, 0x1d, 0x8f, 0x7e, 0x9a, 0xad, 0x68, 0x83, 0x6e, 0xd8, 0x32,
-	0xc7, 0x84, 0xfa, 0x34, 0x4b, 0x02, 0x8e, 0x15, 0x06, 0x6a, 0x03, 0xda, 0x92, 0xa3, 0xa7, 0x22,
-	0x54, 0xc4, 0x26, 0x77, 0xf0, 0x00, 0x17, 0x76, 0x73, 0x60, 0xa9, 0x8b, 0x0e, 0xce, 0xa1, 0x8d,
-	0x9e, 0x83, 0x0b, 0x5b, 0xd1, 0x86, 0xd0, 0x63, 0xd6, 0x22, 0x3f, 0xa8, 0xfa, 0x6d, 0x23, 0x9f,
-	0xc4, 0xa4, 0x68, 0xee, 0x85, 0xa5, 0x7c, 0x34, 0xdc, 0xab, 0xbe, 0xfb, 0x3b, 0xfd, 0xc0, 0x6e,
-	0xc2, 0x52, 0x31, 0xdd, 0x22, 0x49, 0x23, 0xac, 0x65, 0x55, 0xb3, 0x15, 0x06, 0xc5, 0xa2, 0x54,
-	0xc6, 0xc7, 0x16, 0x7a, 0xc4, 0xc7, 0xde, 0x84, 0xc1, 0x06, 0xf1, 0x6a, 0x24, 0x92, 0xc6, 0xcd,
-	0x65, 0x3b, 0x77, 0x77, 0xaf, 0x32, 0xa2, 0xa9, 0x85, 0x80, 0xff, 0x8e, 0xb1, 0xe4, 0x86, 0xde,
-	0x07, 0x63, 0x54, 0xc7, 0x0a, 0x3b, 0x89, 0xf4, 0x4f, 0x70, 0xe3, 0x26, 0xdb, 0xec, 0xd7, 0x8d,
-	0x12, 0x9c, 0xc1, 0x44, 0x73, 0x30, 0x2e, 0x7c, 0x09, 0xca, 0x68, 0x2a, 0x06, 0x56, 0x25, 0xfa,
-	0xa8, 0x64, 0xca, 0x71, 0x57, 0x0d, 0x16, 0xdf, 0x18, 0xd6, 0xb8, 0x3b, 0x59, 0x8f, 0x6f, 0x0c,
-	0x6b, 0x3b, 0x98, 0x95, 0xa0, 0x97, 0x61, 0x88, 0xfe, 0x9d, 0x8f, 0xc2, 0x96, 0x30, 0x1b, 0xad,
-	0xd9, 0x19, 0x1d, 0xca, 0x43, 0x1c, 0x62, 0x99, 0xee, 0x39, 0x23, 0xb8, 0x60, 0xc5, 0x8f, 0x1e,
-	0xa5, 0xf4, 0xed, 0xf2, 0x59, 0x12, 0xf9, 0x9b, 0x3b, 0x4c, 0x9f, 0x19, 0x4a, 0x8f, 0x52, 0x8b,
-	0x5d, 0x18, 0x38, 0xa7, 0x96, 0xfb, 0x85, 0x02, 0x8c, 0xe8, 0x17, 0xaa, 0xef, 0x14, 0x34, 0x1d,
-	0xa7, 0x93, 0x82, 0x1f, 0x9c, 0xaf, 0x5a, 0xe8, 0xf6, 0x9d, 0x26, 0x44, 0x03, 0xfa, 0xbd, 0x8e,
-	0x50, 0x64, 0xad, 0xd8, 0xe7, 0x58, 0x8f, 0x3b, 0x49, 0x83, 0xdf, 0xbc, 0x63, 0xe1, 0xcc, 0x8c,
-	0x83, 0xfb, 0x99, 0x3e, 0x18, 0x92, 0x85, 0xe8, 0xd3, 0x0e, 0x40, 0x1a, 0x37, 0x26, 0x44, 0xe9,
-	0x9a, 0x8d, 0xa0, 0x22, 0x3d, 0xe4, 0x4d, 0x33, 0xf3, 0x2b, 0x38, 0xd6, 0xf8, 0xa2, 0x04, 0x06,
-	0x42, 0xda, 0xb8, 0xcb, 0xf6, 0x92, 0x02, 0xac, 0x52, 0xc6, 0x97, 0x19, 0xf7, 0xd4, 0xa2, 0xc7,
-	0x60, 0x58, 0xf0, 0xa2, 0x87, 0xd3, 0x0d, 0x19, 0xce, 0x68, 0xcf, 0xfa, 0xad, 0x22, 0x24, 0xd3,
-	0xb3, 0xa6, 0x02, 0xe1, 0x94, 0xa1, 0xfb, 0x04, 0x8c, 0x99, 0x8b, 0x81, 0x1e, 0x56, 0x36, 0x76,
-	0x12, 0xc2, 0x4d, 0x21, 0x23, 0xfc, 0xb0, 0x32, 0x43, 0x01, 0x98, 0xc3, 0xdd, 0x1f, 0x38, 0x00,
-	0xa9, 0x78, 0x39, 0x80, 0xf7, 0xe1, 0x21, 0xdd, 0x8e, 0xd7, 0xeb, 0x44, 0xf8, 0x09, 0x18, 0x66,
-	0xff, 0xb0, 0x85, 0xde, 0x67, 0x2b, 0xf8, 0x20, 0x6d, 0xa7, 0x58, 0xea, 0x4c, 0xd7, 0x78, 0x56,
-	0x32, 0xc2, 0x29, 0x4f, 0x37, 0x84, 0xf1, 0x2c, 0x36, 0xfa, 0x30, 0x8c, 0xc4, 0x72, 0x5b, 0x4d,
-	0xaf, 0x07, 0x1e, 0x70, 0xfb, 0xe5, 0xae, 0x3f, 0xad, 0x3a, 0x36, 0x88, 0xb9, 0xab, 0x30, 0x60,
-	0x75, 0x08, 0xdd, 0x6f, 0x3b, 0x30, 0xcc, 0xbc, 0xaf, 0xf5, 0xc8, 0x6b, 0xa5, 0x55, 0xfa, 0xf6,
-	0x19, 0xf5, 0x18, 0x06, 0xb9, 0xf9, 0x40, 0x46, 0x2d, 0x59, 0x90, 0x32, 0x3c, 0x97, 0x5f, 0x2a,
-	0x65, 0xb8, 0x9d, 0x22, 0xc6, 0x92, 0x93, 0xfb, 0xd9, 0x02, 0x0c, 0x2c, 0x06, 0xed, 0xce, 0x5f,
-	0xf9, 0x7c, 0x72, 0x2b, 0xd0, 0xbf, 0x98, 0x90, 0x96, 0x99, 0xf6, 0x70, 0x64, 0xe6, 0x61, 0x3d,
-	0xe5, 0x61, 0xd9, 0x4c, 0x79, 0x88, 0xbd, 0x9b, 0x32, 0xa8, 0x4f, 0x98, 0xaf, 0xd3, 0x2b, 0x92,
-	0x8f, 0xc3, 0xf0, 0xb2, 0xb7, 0x41, 0x9a, 0x4b, 0x64, 0x87, 0x5d, 0x68, 0xe4, 0x01, 0x26, 0x4e,
-	0x6a, 0x73, 0x30, 0x82, 0x41, 0xe6, 0x60, 0x8c, 0x61, 0xab, 0xc5, 0x40, 0x4f, 0x24, 0x24, 0xcd,
-	0x19, 0xe5, 0x98, 0x27, 0x12, 0x2d, 0x5f, 0x94, 0x86, 0xe5, 0x4e, 0x41, 0x29, 0xa5, 0x72, 0x00,
-	0xae, 0x3f, 0x2d, 0xc0, 0xa8, 0x61, 0x85, 0x37, 0x7c, 0x93, 0xce, 0x1d, 0x7d, 0x93, 0x86, 0xaf,
-	0xb0, 0xf0, 0x76, 0xfb, 0x0a, 0xfb, 0x4e, 0xde, 0x57, 0x68, 0x7e, 0xa4, 0xfe, 0x03, 0x7d, 0xa4,
-	0x37, 0x1d, 0xe8, 0x5f, 0xf6, 0x83, 0xad, 0x83, 0x09, 0x9a, 0xb8, 0x1a, 0xb6, 0xbb, 0x04, 0x4d,
-	0x85, 0x02, 0x31, 0x2f, 0x93, 0xaa, 0x4b, 0x5f, 0x0f, 0xd5, 0x25, 0x75, 0x9e, 0xf4, 0xef, 0xe7,
-	0x3c, 0x71, 0x3f, 0xed, 0xc0, 0xc8, 0x8a, 0x17, 0xf8, 0x9b, 0x24, 0x4e, 0xd8, 0x04, 0x4c, 0x8e,
-	0xf5, 0x06, 0xdc, 0x48, 0x8f, 0x5c, 0x0e, 0xaf, 0x3b, 0x70, 0x7a, 0x85, 0xb4, 0x42, 0xff, 0x65,
-	0x2f, 0x0d, 0xae, 0xa5, 0x7d, 0x6c, 0xf8, 0x89, 0x88, 0x25, 0x54, 0x7d, 0xbc, 0xea, 0x27, 0x98,
-	0xc2, 0xef, 0x60, 0x8b, 0x66, 0x77, 0x4b, 0xe8, 0x49, 0x4e, 0xbb, 0x95, 0x99, 0x86, 0xcd, 0xca,
-	0x02, 0x9c, 0xe2, 0xb8, 0xbf, 0xeb, 0xc0, 0x20, 0x6f, 0x84, 0x8a, 0x47, 0x76, 0x7a, 0xd0, 0x6e,
-	0x40, 0x91, 0xd5, 0x13, 0xd3, 0x7f, 0xc1, 0x82, 0x9e, 0x44, 0xc9, 0xf1, 0xc5, 0xca, 0xfe, 0xc5,
-	0x9c, 0x01, 0x3b, 0xdf, 0x78, 0xb7, 0xa6, 0x55, 0x5c, 0x71, 0x7a, 0xbe, 0x61, 0x50, 0x2c, 0x4a,
-	0xdd, 0xaf, 0xf7, 0xc1, 0x90, 0x4a, 0x61, 0xc6, 0x12, 0x4c, 0x04, 0x41, 0x98, 0x78, 0x3c, 0x5e,
-	0x83, 0x0b, 0xf5, 0x0f, 0xdb, 0x4b, 0xa1, 0x36, 0x35, 0x9d, 0x52, 0xe7, 0x3e, 0x48, 0x75, 0x5a,
-	0xd5, 0x4a, 0xb0, 0xde, 0x08, 0xf4, 0x71, 0x18, 0x68, 0x52, 0x31, 0x25, 0x65, 0xfc, 0xb3, 0x16,
-	0x9b, 0xc3, 0xe4, 0x9f, 0x68, 0x89, 0x1a, 0x21, 0x0e, 0xc4, 0x82, 0xeb, 0xc4, 0x07, 0x60, 0x3c,
-	0xdb, 0xea, 0x3b, 0x5d, 0x1a, 0x1d, 0xd6, 0xaf, 0x9c, 0xfe, 0x4d, 0x21, 0x66, 0x0f, 0x5f, 0xd5,
-	0x7d, 0x06, 0x4a, 0x2b, 0x24, 0x89, 0xfc, 0x2a, 0x23, 0x70, 0xa7, 0xc9, 0x75, 0x20, 0x45, 0xe3,
-	0x73, 0x6c, 0xb2, 0x52, 0x9a, 0x31, 0x7a, 0x15, 0xa0, 0x1d, 0x85, 0xf4, 0xa0, 0x4b, 0x3a, 0xf2,
-	0x63, 0x5b, 0x50, 0x9c, 0xd7, 0x14, 0x4d, 0xee, 0x36, 0x4f, 0x7f, 0x63, 0x8d, 0x9f, 0xfb, 0x86,
-	0x03, 0xc5, 0x95, 0x4e, 0x42, 0x6e, 0x1d, 0x40, 0xb4, 0x1d, 0x3a, 0x8d, 0xc2, 0xe3, 0x30, 0x44,
-	0x3f, 0xf0, 0x86, 0x17, 0x4b, 0x83, 0x5b, 0x1a, 0x76, 0x2e, 0xe0, 0x58, 0x61, 0xb8, 0x1f, 0x86,
-	0x11, 0xd6, 0x92, 0xab, 0x61, 0x93, 0x6e, 0xd7, 0x74, 0x24, 0x5b, 0xf4, 0x77, 0xd6, 0x0f, 0xc2,
-	0x90, 0x30, 0x2f, 0xa3, 0x2b, 0xac, 0x11, 0x36, 0x6b, 0xea, 0x02, 0x9a, 0x9a, 0x3f, 0x57, 0x19,
-	0x14, 0x8b, 0x52, 0xf7, 0x53, 0x05, 0x28, 0xb1, 0x8a, 0x42, 0x3a, 0xed, 0xc0, 0x60, 0x83, 0xf3,
-	0x11, 0x43, 0x6e, 0x21, 0x6e, 0x4d, 0x6f, 0xbd, 0x76, 0x46, 0xe4, 0x00, 0x2c, 0xf9, 0x51, 0xd6,
-	0x37, 0x3d, 0x3f, 0xa1, 0xac, 0x0b, 0xc7, 0xcb, 0xfa, 0x06, 0x67, 0x83, 0x25, 0x3f, 0xf7, 0x17,
-	0x81, 0x5d, 0xec, 0x9e, 0x6f, 0x7a, 0x75, 0x3e, 0x72, 0xe1, 0x16, 0xa9, 0x09, 0x11, 0xad, 0x8d,
-	0x1c, 0x85, 0x62, 0x51, 0xca, 0x2f, 0xcb, 0x26, 0x91, 0xaf, 0x22, 0xbe, 0xb5, 0xcb, 0xb2, 0x0c,
-	0x2c, 0xe3, 0xfb, 0x6b, 0xee, 0x97, 0x0b, 0x00, 0x2c, 0x3f, 0x1e, 0xbf, 0x8f, 0xfd, 0x6e, 0x19,
-	0x9c, 0x65, 0xfa, 0x4e, 0x55, 0x70, 0x16, 0xbb, 0x71, 0xae, 0x07, 0x65, 0xe9, 0x17, 0x31, 0x0a,
-	0xfb, 0x5f, 0xc4, 0x40, 0x6d, 0x18, 0x0c, 0x3b, 0x09, 0xd5, 0x81, 0x85, 0x12, 0x61, 0x21, 0x74,
-	0x60, 0x95, 0x13, 0xe4, 0xb7, 0x17, 0xc4, 0x0f, 0x2c, 0xd9, 0xa0, 0xa7, 0x60, 0xa8, 0x1d, 0x85,
-	0x75, 0xaa, 0x13, 0x88, 0x7d, 0xf9, 0x7e, 0x39, 0x9b, 0xd7, 0x04, 0xfc, 0xb6, 0xf6, 0x3f, 0x56,
-	0xd8, 0xee, 0x3f, 0x3c, 0xcd, 0xc7, 0x45, 0xcc, 0xbd, 0x09, 0x28, 0xf8, 0xd2, 0xe2, 0x05, 0x82,
-	0x44, 0x61, 0x71, 0x0e, 0x17, 0xfc, 0x9a, 0x5a, 0x85, 0x85, 0x9e, 0xab, 0xf0, 0xbd, 0x50, 0xaa,
-	0xf9, 0x71, 0xbb, 0xe9, 0xed, 0x5c, 0xcb, 0x31, 0x37, 0xce, 0xa5, 0x45, 0x58, 0xc7, 0x43, 0x8f,
-	0x8b, 0x6b, 0x37, 0xfd, 0x86, 0x89, 0x49, 0x5e, 0xbb, 0x49, 0xef, 0xfb, 0xf3, 0x1b, 0x37, 0xd9,
-	0xbc, 0x08, 0xc5, 0x03, 0xe7, 0x45, 0xc8, 0x6a, 0x78, 0x03, 0x27, 0xaf, 0xe1, 0xbd, 0x1f, 0x46,
-	0xe5, 0x4f, 0xa6, 0x75, 0x95, 0xcf, 0xb2, 0xd6, 0x2b, 0xf3, 0xfa, 0xba, 0x5e, 0x88, 0x4d, 0xdc,
-	0x74, 0xd2, 0x0e, 0x1e, 0x74, 0xd2, 0x5e, 0x06, 0xd8, 0x08, 0x3b, 0x41, 0xcd, 0x8b, 0x76, 0x16,
-	0xe7, 0x44, 0x90, 0xae, 0x52, 0x28, 0x67, 0x54, 0x09, 0xd6, 0xb0, 0xf4, 0x89, 0x3e, 0x7c, 0x87,
-	0x89, 0xfe, 0x61, 0x18, 0x66, 0x01, 0xcd, 0xa4, 0x36, 0x9d, 0x88, 0xa8, 0xaa, 0xc3, 0x44, 0x89,
-	0xa6, 0x71, 0x96, 0x92, 0x08, 0x4e, 0xe9, 0xa1, 0x8f, 0x00, 0x6c, 0xfa, 0x81, 0x1f, 0x37, 0x18,
-	0xf5, 0xd2, 0xa1, 0xa9, 0xab, 0x7e, 0xce, 0x2b, 0x2a, 0x58, 0xa3, 0x88, 0x5e, 0x80, 0xd3, 0x24,
-	0x4e, 0xfc, 0x96, 0x97, 0x90, 0x9a, 0xba, 0xc7, 0x5a, 0x66, 0x36, 0x52, 0x15, 0x52, 0x7e, 0x25,
-	0x8b, 0x70, 0x3b, 0x0f, 0x88, 0xbb, 0x09, 0x19, 0x2b, 0x72, 0xe2, 0x30, 0x2b, 0x12, 0xfd, 0x6f,
-	0x07, 0x4e, 0x47, 0x84, 0x87, 0xda, 0xc4, 0xaa, 0x61, 0xe7, 0x98, 0x38, 0xae, 0xda, 0x48, 0x3d,
-	0xaf, 0x72, 0xcc, 0xe0, 0x2c, 0x17, 0xae, 0xe7, 0x10, 0xd9, 0xfb, 0xae, 0xf2, 0xdb, 0x79, 0xc0,
-	0xd7, 0xdf, 0x9a, 0x9c, 0xec, 0x7e, 0x02, 0x41, 0x11, 0xa7, 0x2b, 0xef, 0xef, 0xbe, 0x35, 0x39,
-	0x2e, 0x7f, 0xa7, 0x83, 0xd6, 0xd5, 0x49, 0xba, 0xad, 0xb6, 0xc3, 0xda, 0xe2, 0x9a, 0x08, 0x7f,
-	0x53, 0xdb, 0xea, 0x1a, 0x05, 0x62, 0x5e, 0x86, 0x1e, 0xa5, 0x3b, 0x37, 0x69, 0x85, 0x81, 0x4a,
-	0x22, 0x3c, 0xc2, 0x77, 0x6d, 0x0e, 0xc3, 0xaa, 0x94, 0x1e, 0x39, 0x02, 0xb1, 0xa5, 0x94, 0xef,
-	0xb3, 0x75, 0xe4, 0x90, 0x9b, 0x14, 0xe7, 0x2a, 0x7f, 0x61, 0xc5, 0x09, 0x35, 0x61, 0xc0, 0x67,
-	0x06, 0x10, 0x11, 0x61, 0x6b, 0xc1, 0xea, 0xc2, 0x0d, 0x2a, 0x32, 0xbe, 0x96, 0x89, 0x7e, 0xc1,
-	0x43, 0xdf, 0x6b, 0x4e, 0x9d, 0xcc, 0x5e, 0xf3, 0x28, 0x0c, 0x55, 0x1b, 0x7e, 0xb3, 0x16, 0x91,
-	0xa0, 0x3c, 0xce, 0x2c, 0x01, 0x6c, 0x24, 0x66, 0x05, 0x0c, 0xab, 0x52, 0xf4, 0x37, 0x60, 0x34,
-	0xec, 0x24, 0x4c, 0xb4, 0xd0, 0x71, 0x8a, 0xcb, 0xa7, 0x19, 0x3a, 0x8b, 0x97, 0x5a, 0xd5, 0x0b,
-	0xb0, 0x89, 0x47, 0x45, 0x7c, 0x23, 0x8c, 0x59, 0x3a, 0x24, 0x26, 0xe2, 0xcf, 0x9b, 0x22, 0xfe,
-	0xaa, 0x56, 0x86, 0x0d, 0x4c, 0xf4, 0x55, 0x07, 0x4e, 0xb7, 0xb2, 0xe7, 0xbd, 0xf2, 0x05, 0x36,
-	0x32, 0x15, 0x1b, 0xe7, 0x82, 0x0c, 0x69, 0x1e, 0xe9, 0xde, 0x05, 0xc6, 0xdd, 0x8d, 0x60, 0x89,
-	0xc9, 0xe2, 0x9d, 0xa0, 0xda, 0x88, 0xc2, 0xc0, 0x6c, 0xde, 0xbd, 0xb6, 0xee, 0xdb, 0xb1, 0xb5,
-	0x9d, 0xc7, 0x62, 0xe6, 0xde, 0xbd, 0xdd, 0xc9, 0x73, 0xb9, 0x45, 0x38, 0xbf, 0x51, 0xe8, 0x43,
-	0x30, 0x9e, 0x78, 0xf1, 0x16, 0xd7, 0x97, 0x68, 0x4d, 0x52, 0x2b, 0xdf, 0xcf, 0x83, 0x1c, 0xf6,
-	0x76, 0x27, 0xc7, 0xd7, 0x33, 0x65, 0xb8, 0x0b, 0x7b, 0x62, 0x0e, 0xce, 0xe7, 0x4b, 0x98, 0x3b,
-	0x1d, 0x71, 0xfa, 0xf4, 0x23, 0xce, 0x3c, 0xdc, 0xdb, 0xb3, 0x5b, 0x74, 0xaf, 0x92, 0xfa, 0xaa,
-	0x63, 0xee, 0x55, 0x5d, 0xfa, 0xe5, 0x18, 0x8c, 0xe8, 0xaf, 0x6e, 0xb8, 0xff, 0xaf, 0x0f, 0x20,
-	0xb5, 0xe0, 0x23, 0x0f, 0xc6, 0xb8, 0xb7, 0x60, 0x71, 0xee, 0xc8, 0xb9, 0x06, 0x66, 0x0d, 0x02,
-	0x38, 0x43, 0x10, 0xb5, 0x00, 0x71, 0x08, 0xff, 0x7d, 0x14, 0xaf, 0x2f, 0x73, 0x92, 0xce, 0x76,
-	0x11, 0xc1, 0x39, 0x84, 0x69, 0x8f, 0x92, 0x70, 0x8b, 0x04, 0xd7, 0xf1, 0xf2, 0x51, 0xf2, 0x59,
-	0x70, 0x3f, 0xa1, 0x41, 0x00, 0x67, 0x08, 0x22, 0x17, 0x06, 0x98, 0xd1, 0x48, 0x46, 0xb5, 0x33,
-	0x01, 0xc5, 0x74, 0x95, 0x18, 0x8b, 0x12, 0xf4, 0x65, 0x07, 0xc6, 0x64, 0x5a, 0x0e, 0x66, 0xa7,
-	0x95, 0xf1, 0xec, 0xd7, 0x6d, 0x79, 0x60, 0xae, 0xe8, 0xd4, 0xd3, 0x68, 0x51, 0x03, 0x1c, 0xe3,
-	0x4c, 0x23, 0xdc, 0xe7, 0xe0, 0x4c, 0x4e, 0x75, 0x2b, 0x47, 0xe8, 0xef, 0x3a, 0x50, 0xd2, 0xb2,
-	0x45, 0xa2, 0x57, 0x61, 0x38, 0xac, 0x58, 0x0f, 0x51, 0x5c, 0xad, 0x74, 0x85, 0x28, 0x2a, 0x10,
-	0x4e, 0x19, 0x1e, 0x24, 0xb2, 0x32, 0x37, 0xb5, 0xe5, 0xdb, 0xdc, 0xec, 0x43, 0x47, 0x56, 0xfe,
-	0x72, 0x11, 0x52, 0x4a, 0x87, 0x4c, 0x17, 0x93, 0xc6, 0x61, 0x16, 0xf6, 0x8d, 0xc3, 0xac, 0xc1,
-	0x29, 0x8f, 0x79, 0xb9, 0x8f, 0x98, 0x24, 0x86, 0x27, 0x0b, 0x36, 0x29, 0xe0, 0x2c, 0x49, 0xca,
-	0x25, 0x4e, 0xab, 0x32, 0x2e, 0xfd, 0x87, 0xe6, 0x52, 0x31, 0x29, 0xe0, 0x2c, 0x49, 0xf4, 0x02,
-	0x94, 0xab, 0xec, 0x56, 0x33, 0xef, 0xe3, 0xe2, 0xe6, 0xb5, 0x30, 0x59, 0x8b, 0x48, 0x4c, 0x82,
-	0x44, 0xa4, 0x83, 0x7b, 0x50, 0x8c, 0x42, 0x79, 0xb6, 0x07, 0x1e, 0xee, 0x49, 0x81, 0x1e, 0x74,
-	0x98, 0x9b, 0xdc, 0x4f, 0x76, 0x98, 0x10, 0x11, 0xf1, 0x03, 0xea, 0xa0, 0x53, 0xd1, 0x0b, 0xb1,
-	0x89, 0x8b, 0x7e, 0xc9, 0x81, 0xd1, 0xa6, 0x74, 0x24, 0xe0, 0x4e, 0x53, 0xe6, 0x36, 0xc5, 0x56,
-	0xa6, 0xdf, 0xb2, 0x4e, 0x99, 0x6b, 0x23, 0x06, 0x08, 0x9b, 0xbc, 0xb3, 0x19, 0x7b, 0x86, 0x0e,
-	0x98, 0xb1, 0xe7, 0x07, 0x0e, 0x8c, 0x67, 0xb9, 0xa1, 0x2d, 0x78, 0xa0, 0xe5, 0x45, 0x5b, 0x8b,
-	0xc1, 0x66, 0xc4, 0x6e, 0xaf, 0x24, 0x7c, 0x32, 0x4c, 0x6f, 0x26, 0x24, 0x9a, 0xf3, 0x76, 0xb8,
-	0x63, 0xb6, 0xa8, 0x1e, 0xc7, 0x7a, 0x60, 0x65, 0x3f, 0x64, 0xbc, 0x3f, 0x2d, 0x54, 0x81, 0x73,
-	0x14, 0x81, 0x25, 0xf4, 0xf3, 0xc3, 0x20, 0x65, 0x52, 0x60, 0x4c, 0x54, 0x04, 0xe5, 0x4a, 0x1e,
-	0x12, 0xce, 0xaf, 0xeb, 0x5e, 0x81, 0x01, 0x7e, 0x99, 0xf0, 0xae, 0x3c, 0x5b, 0xee, 0x7f, 0x28,
-	0x80, 0x54, 0x2d, 0xff, 0x6a, 0x3b, 0x0a, 0xe9, 0x26, 0x1a, 0x31, 0xb5, 0x49, 0xd8, 0x4b, 0xd8,
-	0x26, 0x2a, 0x52, 0x67, 0x8a, 0x12, 0xaa, 0x73, 0x93, 0x5b, 0x7e, 0x32, 0x1b, 0xd6, 0xa4, 0x95,
-	0x84, 0xe9, 0xdc, 0x57, 0x04, 0x0c, 0xab, 0x52, 0xf7, 0xd3, 0x0e, 0x8c, 0xd2, 0x5e, 0x36, 0x9b,
-	0xa4, 0x59, 0x49, 0x48, 0x3b, 0x46, 0x31, 0x14, 0x63, 0xfa, 0x8f, 0x3d, 0x63, 0x62, 0x7a, 0x01,
-	0x95, 0xb4, 0x35, 0x2f, 0x12, 0x65, 0x82, 0x39, 0x2f, 0xf7, 0x3b, 0x7d, 0x30, 0xac, 0x06, 0xfb,
-	0x00, 0xf6, 0xdb, 0xcb, 0x69, 0x56, 0x5b, 0x2e, 0x81, 0xcb, 0x5a, 0x46, 0xdb, 0xdb, 0x74, 0xe8,
-	0x82, 0x1d, 0x9e, 0xbf, 0x23, 0x4d, 0x6f, 0xfb, 0xb8, 0xe9, 0x04, 0x3f, 0xaf, 0xcf, 0x3f, 0x0d,
-	0x5f, 0x78, 0xc3, 0x6f, 0xe9, 0x31, 0x08, 0xfd, 0xb6, 0x76, 0x33, 0xe5, 0x60, 0xed, 0x1d, 0x7c,
-	0x90, 0x79, 0xf0, 0xa8, 0x78, 0xa0, 0x07, 0x8f, 0x1e, 0x83, 0x7e, 0x12, 0x74, 0x5a, 0x4c, 0x55,
-	0x1a, 0x66, 0x87, 0x8c, 0xfe, 0x2b, 0x41, 0xa7, 0x65, 0xf6, 0x8c, 0xa1, 0xa0, 0x0f, 0x40, 0xa9,
-	0x46, 0xe2, 0x6a, 0xe4, 0xb3, 0xa4, 0x14, 0xc2, 0x36, 0x74, 0x3f, 0x33, 0xb8, 0xa5, 0x60, 0xb3,
-	0xa2, 0x5e, 0xc1, 0x7d, 0x19, 0x06, 0xd6, 0x9a, 0x9d, 0xba, 0x1f, 0xa0, 0x36, 0x0c, 0xf0, 0x14,
-	0x15, 0x62, 0xb7, 0xb7, 0x70, 0x72, 0xe5, 0xa2, 0x42, 0x8b, 0x8f, 0xe1, 0xf7, 0x90, 0x05, 0x1f,
-	0xf7, 0x53, 0x05, 0xa0, 0x87, 0xfb, 0x85, 0x59, 0xf4, 0xb7, 0xbb, 0xde, 0xf7, 0xf9, 0xb9, 0x9c,
-	0xf7, 0x7d, 0x46, 0x19, 0x72, 0xce, 0xd3, 0x3e, 0x4d, 0x18, 0x65, 0xde, 0x18, 0xb9, 0x07, 0x0a,
-	0xb5, 0xfa, 0xc9, 0x03, 0x66, 0x75, 0xd0, 0xab, 0x8a, 0x1d, 0x41, 0x07, 0x61, 0x93, 0x38, 0x5a,
-	0x81, 0x33, 0x3c, 0x39, 0xea, 0x1c, 0x69, 0x7a, 0x3b, 0x99, 0x24, 0x68, 0xf7, 0xc9, 0x27, 0xdb,
-	0xe6, 0xba, 0x51, 0x70, 0x5e, 0x3d, 0xf7, 0xf7, 0xfa, 0x41, 0xf3, 0x81, 0x1c, 0x60, 0xb5, 0xbc,
-	0x94, 0xf1, 0x78, 0xad, 0x58, 0xf1, 0x78, 0x49, 0x37, 0x12, 0x97, 0x40, 0xa6, 0x93, 0x8b, 0x36,
-	0xaa, 0x41, 0x9a, 0x6d, 0xd1, 0x47, 0xd5, 0xa8, 0xab, 0xa4, 0xd9, 0xc6, 0xac, 0x44, 0xdd, 0xc2,
-	0xec, 0xef, 0x79, 0x0b, 0xb3, 0x01, 0xc5, 0xba, 0xd7, 0xa9, 0x13, 0x11, 0x1b, 0x6a, 0xc1, 0xb9,
-	0xc9, 0xee, 0x85, 0x70, 0xe7, 0x26, 0xfb, 0x17, 0x73, 0x06, 0x74, 0xb1, 0x37, 0x64, 0xb0, 0x8c,
-	0x30, 0xf3, 0x5a, 0x58, 0xec, 0x2a, 0xfe, 0x86, 0x2f, 0x76, 0xf5, 0x13, 0xa7, 0xcc, 0x50, 0x1b,
-	0x06, 0xab, 0x3c, 0xb7, 0x8c, 0xd0, 0x59, 0x16, 0x6d, 0x5c, 0x33, 0x65, 0x04, 0xb9, 0x3d, 0x46,
-	0xfc, 0xc0, 0x92, 0x8d, 0x7b, 0x09, 0x4a, 0xda, 0x33, 0x23, 0xf4, 0x33, 0xa8, 0xb4, 0x26, 0xda,
-	0x67, 0x98, 0xf3, 0x12, 0x0f, 0xb3, 0x12, 0xf7, 0x9b, 0xfd, 0xa0, 0xac, 0x71, 0xfa, 0xa5, 0x48,
-	0xaf, 0xaa, 0x25, 0x61, 0x32, 0x12, 0x04, 0x84, 0x01, 0x16, 0xa5, 0x54, 0xaf, 0x6b, 0x91, 0xa8,
-	0xae, 0xce, 0xd1, 0x42, 0x5c, 0x2b, 0xbd, 0x6e, 0x45, 0x2f, 0xc4, 0x26, 0x2e, 0x55, 0xca, 0x5b,
-	0x22, 0x26, 0x20, 0x1b, 0xf2, 0x2d, 0x63, 0x05, 0xb0, 0xc2, 0x60, 0x59, 0x1c, 0x5a, 0x5a, 0x08,
-	0x81, 0x08, 0x11, 0xb5, 0xe1, 0x92, 0xd2, 0xa8, 0xf2, 0x50, 0x2e, 0x1d, 0x82, 0x0d, 0xae, 0x68,
-	0x01, 0x4e, 0xc7, 0x24, 0x59, 0xbd, 0x19, 0x90, 0x48, 0xe5, 0x4f, 0x10, 0x69, 0x42, 0xd4, 0x95,
-	0x91, 0x4a, 0x16, 0x01, 0x77, 0xd7, 0xc9, 0x8d, 0xaa, 0x2d, 0x1e, 0x3a, 0xaa, 0x76, 0x0e, 0xc6,
-	0x37, 0x3d, 0xbf, 0xd9, 0x89, 0x48, 0xcf, 0xd8, 0xdc, 0xf9, 0x4c, 0x39, 0xee, 0xaa, 0xc1, 0x6e,
-	0x2d, 0x35, 0xbd, 0x7a, 0x5c, 0x1e, 0xd4, 0x6e, 0x2d, 0x51, 0x00, 0xe6, 0x70, 0xf7, 0x37, 0x1d,
-	0xe0, 0xf9, 0x99, 0xa6, 0x37, 0x37, 0xfd, 0xc0, 0x4f, 0x76, 0xd0, 0xd7, 0x1c, 0x18, 0x0f, 0xc2,
-	0x1a, 0x99, 0x0e, 0x12, 0x5f, 0x02, 0xed, 0xe5, 0xd4, 0x67, 0xbc, 0xae, 0x65, 0xc8, 0x73, 0x53,
-	0x53, 0x16, 0x8a, 0xbb, 0x9a, 0xe1, 0x5e, 0x80, 0x73, 0xb9, 0x04, 0xdc, 0x1f, 0xf4, 0x81, 0x99,
-	0x66, 0x0a, 0x3d, 0x03, 0xc5, 0x26, 0x4b, 0x7c, 0xe2, 0x1c, 0x31, 0x7f, 0x18, 0x1b, 0x2b, 0x9e,
-	0x19, 0x85, 0x53, 0x42, 0x73, 0x50, 0x62, 0xb9, 0xab, 0x44, 0x5a, 0x9a, 0x82, 0x91, 0xef, 0xa1,
-	0x84, 0xd3, 0xa2, 0xdb, 0xe6, 0x4f, 0xac, 0x57, 0x43, 0xaf, 0xc0, 0xe0, 0x06, 0x4f, 0xf0, 0x69,
-	0xcf, 0x6b, 0x28, 0x32, 0x86, 0x32, 0xdd, 0x48, 0xa6, 0x0f, 0xbd, 0x9d, 0xfe, 0x8b, 0x25, 0x47,
-	0xb4, 0x03, 0x43, 0x9e, 0xfc, 0xa6, 0xfd, 0xb6, 0xae, 0x90, 0x18, 0xf3, 0x47, 0x84, 0xe8, 0xc8,
-	0x6f, 0xa8, 0xd8, 0x65, 0x82, 0x9e, 0x8a, 0x07, 0x0a, 0x7a, 0xfa, 0xb6, 0x03, 0x90, 0xbe, 0x86,
-	0x82, 0x6e, 0xc1, 0x50, 0xfc, 0xa4, 0x61, 0xa8, 0xb0, 0x91, 0x7e, 0x40, 0x50, 0xd4, 0xae, 0xe8,
-	0x0a, 0x08, 0x56, 0xdc, 0xee, 0x64, 0x5c, 0xf9, 0xa9, 0x03, 0x67, 0xf3, 0x5e, 0x6d, 0x79, 0x1b,
-	0x5b, 0x7c, 0x58, 0xbb, 0x8a, 0xa8, 0xb0, 0x16, 0x91, 0x4d, 0xff, 0x56, 0x4e, 0x9a, 0x69, 0x5e,
-	0x80, 0x53, 0x1c, 0xf7, 0x4f, 0x07, 0x41, 0x31, 0x3e, 0x26, 0x3b, 0xcc, 0x23, 0xf4, 0xcc, 0x54,
-	0x4f, 0x75, 0x2e, 0x85, 0x87, 0x19, 0x14, 0x8b, 0x52, 0x7a, 0x6e, 0x92, 0xe1, 0xfa, 0x42, 0x64,
-	0xb3, 0x59, 0x28, 0xc3, 0xfa, 0xb1, 0x2a, 0xcd, 0xb3, 0xec, 0x14, 0x4f, 0xc4, 0xb2, 0x33, 0x60,
-	0xdf, 0xb2, 0xd3, 0x02, 0x14, 0xf3, 0x85, 0xc2, 0xcc, 0x29, 0x82, 0xd1, 0xc8, 0xa1, 0x0d, 0xcd,
-	0x95, 0x2e, 0x22, 0x38, 0x87, 0x30, 0x8b, 0xc2, 0x08, 0x9b, 0x64, 0x1a, 0x5f, 0x13, 0x87, 0x8f,
-	0x34, 0x0a, 0x83, 0x83, 0xb1, 0x2c, 0x3f, 0xa2, 0x29, 0x05, 0xfd, 0xb6, 0xb3, 0x8f, 0xad, 0x6a,
-	0xd8, 0xd6, 0x16, 0x94, 0x9b, 0xe3, 0x8f, 0x9d, 0xa4, 0x8e, 0x62, 0x00, 0xfb, 0xba, 0x03, 0xa7,
-	0x49, 0x50, 0x8d, 0x76, 0x18, 0x1d, 0x41, 0x4d, 0x38, 0xc9, 0xaf, 0xdb, 0x58, 0xeb, 0x57, 0xb2,
-	0xc4, 0xb9, 0x2f, 0xaa, 0x0b, 0x8c, 0xbb, 0x9b, 0x81, 0x56, 0x61, 0xa8, 0xea, 0x89, 0x79, 0x51,
-	0x3a, 0xcc, 0xbc, 0xe0, 0xae, 0xbe, 0x69, 0x31, 0x1b, 0x14, 0x11, 0xf7, 0xc7, 0x05, 0x38, 0x93,
-	0xd3, 0x24, 0x76, 0x93, 0xac, 0x45, 0x17, 0xc0, 0x62, 0x2d, 0xbb, 0xfc, 0x97, 0x04, 0x1c, 0x2b,
-	0x0c, 0xb4, 0x06, 0x67, 0xb7, 0x5a, 0x71, 0x4a, 0x65, 0x36, 0x0c, 0x12, 0x72, 0x4b, 0x0a, 0x03,
-	0xe9, 0x40, 0x3f, 0xbb, 0x94, 0x83, 0x83, 0x73, 0x6b, 0x52, 0x6d, 0x89, 0x04, 0xde, 0x46, 0x93,
-	0xa4, 0x45, 0x22, 0xdc, 0x4b, 0x69, 0x4b, 0x57, 0x32, 0xe5, 0xb8, 0xab, 0x06, 0x7a, 0xc3, 0x81,
-	0xfb, 0x62, 0x12, 0x6d, 0x93, 0xa8, 0xe2, 0xd7, 0xc8, 0x6c, 0x27, 0x4e, 0xc2, 0x16, 0x89, 0x8e,
-	0x68, 0x9d, 0x9d, 0xdc, 0xdb, 0x9d, 0xbc, 0xaf, 0xd2, 0x9b, 0x1a, 0xde, 0x8f, 0x95, 0xfb, 0x86,
-	0x03, 0x63, 0x15, 0x76, 0x76, 0x57, 0xaa, 0xbb, 0xed, 0x2c, 0xaf, 0x8f, 0xa8, 0xa4, 0x22, 0x19,
-	0x21, 0x6c, 0xa6, 0x01, 0x71, 0x5f, 0x84, 0xf1, 0x0a, 0x69, 0x79, 0xed, 0x06, 0xbb, 0x5f, 0xcd,
-	0x03, 0xc8, 0x2e, 0xc1, 0x70, 0x2c, 0x61, 0xd9, 0x77, 0x9f, 0x14, 0x32, 0x4e, 0x71, 0xd0, 0xc3,
-	0x3c, 0xd8, 0x4d, 0x5e, 0x85, 0x1a, 0xe6, 0x87, 0x1c, 0x1e, 0x21, 0x17, 0x63, 0x59, 0xe6, 0x7e,
-	0xbb, 0x00, 0x23, 0x69, 0x7d, 0xb2, 0x89, 0xea, 0x70, 0xaa, 0xaa, 0x5d, 0x23, 0x4c, 0x2f, 0x70,
-	0x1c, 0xfc, 0xc6, 0x21, 0x4f, 0x3e, 0x6d, 0x12, 0xc1, 0x59, 0xaa, 0x87, 0x8f, 0x2c, 0x7c, 0x25,
-	0x13, 0x59, 0x68, 0xe5, 0x41, 0x89, 0xca, 0x4e, 0x50, 0x55, 0x71, 0x89, 0x64, 0x53, 0x86, 0x3c,
-	0x74, 0x05, 0x2a, 0x7e, 0xb1, 0x00, 0xa7, 0xd4, 0x38, 0x09, 0x27, 0xe9, 0x6b, 0xd9, 0x78, 0x42,
-	0x6c, 0x23, 0x37, 0x93, 0xf9, 0xe1, 0xf7, 0x89, 0x29, 0x7c, 0x2d, 0x1b, 0x53, 0x78, 0xac, 0xec,
-	0xbb, 0xfc, 0xbe, 0xdf, 0x2e, 0xc0, 0x90, 0xca, 0x14, 0xf5, 0x0c, 0x14, 0xd9, 0xb1, 0xf9, 0xee,
-	0x94, 0x7f, 0x76, 0x04, 0xc7, 0x9c, 0x12, 0x25, 0xc9, 0x62, 0x96, 0x8e, 0x9c, 0x8f, 0x78, 0x98,
-	0x1b, 0x4f, 0xbd, 0x28, 0xc1, 0x9c, 0x12, 0x5a, 0x82, 0x3e, 0x12, 0xd4, 0xc4, 0xe4, 0x39, 0x3c,
-	0x41, 0xf6, 0x3c, 0xdc, 0x95, 0xa0, 0x86, 0x29, 0x15, 0x96, 0xae, 0x8e, 0x2b, 0x7b, 0x99, 0x80,
-	0x7d, 0xa1, 0xe9, 0x89, 0x52, 0x77, 0x06, 0x8c, 0x54, 0x86, 0x47, 0xba, 0x30, 0xf2, 0x4b, 0x7d,
-	0x30, 0x50, 0xe9, 0x6c, 0xd0, 0x33, 0xd1, 0xb7, 0x1c, 0x38, 0x73, 0x33, 0x93, 0xf0, 0x3b, 0x5d,
-	0xa4, 0xd7, 0xed, 0x19, 0xa1, 0xf5, 0xd8, 0x3b, 0x65, 0x7a, 0xcb, 0x29, 0xc4, 0x79, 0xcd, 0x31,
-	0x72, 0xee, 0xf6, 0x1d, 0x4b, 0xce, 0xdd, 0x5b, 0xc7, 0x7c, 0xa9, 0x65, 0xb4, 0xd7, 0x85, 0x16,
-	0xf7, 0xf7, 0x8a, 0x00, 0xfc, 0x6b, 0xac, 0xb6, 0x93, 0x83, 0x98, 0x15, 0x9f, 0x82, 0x91, 0x3a,
-	0x09, 0x48, 0x24, 0x23, 0x2b, 0x33, 0x6f, 0x55, 0x2d, 0x68, 0x65, 0xd8, 0xc0, 0x64, 0x93, 0x25,
-	0x48, 0xa2, 0x1d, 0xae, 0xe7, 0x67, 0x2f, 0xae, 0xa8, 0x12, 0xac, 0x61, 0xa1, 0x29, 0xc3, 0xeb,
-	0xc3, 0x03, 0x08, 0xc6, 0xf6, 0x71, 0xd2, 0x7c, 0x00, 0xc6, 0xcc, 0x04, 0x35, 0x42, 0xdb, 0x54,
-	0x0e, 0x7f, 0x33, 0xaf, 0x0d, 0xce, 0x60, 0xd3, 0x85, 0x50, 0x8b, 0x76, 0x70, 0x27, 0x10, 0x6a,
-	0xa7, 0x5a, 0x08, 0x73, 0x0c, 0x8a, 0x45, 0x29, 0xcb, 0xec, 0xc1, 0x36, 0x60, 0x0e, 0x17, 0xd9,
-	0x41, 0xd2, 0xcc, 0x1e, 0x5a, 0x19, 0x36, 0x30, 0x29, 0x07, 0x61, 0x96, 0x05, 0x73, 0xa9, 0x65,
-	0x6c, 0xa9, 0x6d, 0x18, 0x0b, 0x4d, 0x73, 0x12, 0xd7, 0xc1, 0xde, 0x73, 0xc0, 0xa9, 0x67, 0xd4,
-	0xe5, 0x81, 0x1a, 0x19, 0xeb, 0x53, 0x86, 0x3e, 0xd5, 0xbb, 0xf5, 0x6b, 0x1b, 0x23, 0x66, 0x60,
-	0x6e, 0xcf, 0x9b, 0x15, 0x6b, 0x70, 0xb6, 0x1d, 0xd6, 0xd6, 0x22, 0x3f, 0x8c, 0xfc, 0x64, 0x67,
-	0xb6, 0xe9, 0xc5, 0x31, 0x9b, 0x18, 0xa3, 0xa6, 0x3e, 0xb6, 0x96, 0x83, 0x83, 0x73, 0x6b, 0xd2,
-	0x03, 0x59, 0x5b, 0x00, 0x59, 0x78, 0x5c, 0x91, 0xef, 0x64, 0x12, 0x11, 0xab, 0x52, 0xf7, 0x0c,
-	0x9c, 0xae, 0x74, 0xda, 0xed, 0xa6, 0x4f, 0x6a, 0xca, 0xab, 0xe2, 0x7e, 0x10, 0x4e, 0x89, 0x8c,
-	0xbc, 0x4a, 0xfb, 0x39, 0x54, 0xfe, 0x78, 0xf7, 0xdd, 0x70, 0x2a, 0xb3, 0x95, 0xde, 0x21, 0xe2,
-	0xc3, 0xfd, 0xaf, 0x7d, 0xbc, 0x8a, 0x16, 0x7c, 0x84, 0x5e, 0xc9, 0x6a, 0x39, 0x76, 0x72, 0xcb,
-	0x6a, 0xfa, 0x8d, 0x48, 0x14, 0x9b, 0xa7, 0x31, 0x35, 0xe4, 0xdd, 0x03, 0x6b, 0x57, 0x84, 0x58,
-	0x84, 0x3e, 0xdf, 0x87, 0x8c, 0x0b, 0x0c, 0x1f, 0x07, 0x50, 0x6c, 0x65, 0xfa, 0x02, 0xdb, 0xfd,
-	0x64, 0x2b, 0x5e, 0x41, 0x62, 0xac, 0x71, 0x44, 0x01, 0x0c, 0xb2, 0x86, 0x10, 0x79, 0x81, 0xd5,
-	0x5a, 0x5f, 0x99, 0x92, 0xb9, 0xc2, 0x69, 0x63, 0xc9, 0xc4, 0xfd, 0x5c, 0x01, 0xf2, 0x63, 0xe4,
-	0xd0, 0xc7, 0xbb, 0x3f, 0xf8, 0x33, 0x16, 0x07, 0x42, 0x04, 0xe9, 0xf5, 0xfe, 0xe6, 0x81, 0xf9,
-	0xcd, 0x57, 0x2c, 0x8d, 0x83, 0xe0, 0xdb, 0xf5, 0xe5, 0xdd, 0xff, 0xe5, 0x40, 0x69, 0x7d, 0x7d,
-	0x59, 0x29, 0x03, 0x18, 0xce, 0xc7, 0x3c, 0x37, 0x04, 0x0b, 0x04, 0x98, 0x0d, 0x5b, 0x6d, 0x1e,
-	0x17, 0x20, 0xe2, 0x15, 0x58, 0xfa, 0xe8, 0x4a, 0x2e, 0x06, 0xee, 0x51, 0x13, 0x2d, 0xc2, 0x19,
-	0xbd, 0xa4, 0xa2, 0x3d, 0xe6, 0x59, 0x14, 0xa9, 0xa2, 0xba, 0x8b, 0x71, 0x5e, 0x9d, 0x2c, 0x29,
-	0x61, 0xff, 0x66, 0x1b, 0x7a, 0x0e, 0x29, 0x51, 0x8c, 0xf3, 0xea, 0xb8, 0xab, 0x50, 0x5a, 0xf7,
-	0x22, 0xd5, 0xf1, 0x0f, 0xc1, 0x78, 0x35, 0x6c, 0x49, 0x05, 0x67, 0x99, 0x6c, 0x93, 0xa6, 0xe8,
-	0x32, 0x7f, 0x22, 0x27, 0x53, 0x86, 0xbb, 0xb0, 0xdd, 0xdf, 0x78, 0x10, 0xd4, 0x5d, 0xd7, 0x03,
-	0xec, 0xc1, 0x6d, 0x15, 0x3d, 0x5c, 0xb4, 0x1c, 0x3d, 0xac, 0x76, 0xa3, 0x4c, 0x04, 0x71, 0x92,
-	0x46, 0x10, 0x0f, 0xd8, 0x8e, 0x20, 0x56, 0x6a, 0x79, 0x57, 0x14, 0xf1, 0x57, 0x1c, 0x18, 0x09,
-	0xc2, 0x1a, 0x51, 0x0e, 0xdb, 0x41, 0xb6, 0xc2, 0x5f, 0xb0, 0x77, 0x19, 0x83, 0x47, 0xc3, 0x0a,
-	0xf2, 0x3c, 0xb2, 0x5d, 0x6d, 0xe2, 0x7a, 0x11, 0x36, 0xda, 0x81, 0xe6, 0x35, 0x4b, 0x38, 0x77,
-	0x38, 0xdd, 0x9f, 0x77, 0xa2, 0xbc, 0xa3, 0x59, 0xfb, 0x96, 0xa6, 0x59, 0x0e, 0xdb, 0xb2, 0xf0,
-	0xca, 0x7b, 0x89, 0x9a, 0xdf, 0x4c, 0x66, 0x40, 0x4f, 0x35, 0x4e, 0x17, 0x06, 0x78, 0x08, 0xbc,
-	0x48, 0x4a, 0xc6, 0xdc, 0xb9, 0x3c, 0x3c, 0x1e, 0x8b, 0x12, 0x94, 0xc8, 0xa0, 0x90, 0x92, 0xad,
-	0xf7, 0x4c, 0x8c, 0xa0, 0x93, 0xfc, 0xa8, 0x10, 0xf4, 0xb4, 0x6e, 0xa9, 0x18, 0x39, 0x88, 0xa5,
-	0x62, 0xb4, 0xa7, 0x95, 0xe2, 0x0b, 0x0e, 0x8c, 0x54, 0xb5, 0xf7, 0x45, 0xca, 0x8f, 0xda, 0x7a,
-	0x66, 0x3d, 0xef, 0x19, 0x18, 0xee, 0x25, 0x34, 0xde, 0x33, 0x31, 0xb8, 0xb3, 0x4c, 0xac, 0xcc,
-	0x2c, 0xc3, 0x94, 0x23, 0x2b, 0x19, 0x4e, 0x4c, 0x33, 0x8f, 0x0c, 0xae, 0xa5, 0x30, 0x2c, 0x78,
-	0xa1, 0x57, 0x61, 0x48, 0xde, 0xa2, 0x10, 0xb7, 0x0d, 0xb0, 0x0d, 0xb7, 0x8d, 0xe9, 0x1b, 0x96,
-	0xe9, 0x1b, 0x39, 0x14, 0x2b, 0x8e, 0xa8, 0x01, 0x7d, 0x35, 0xaf, 0x2e, 0xee, 0x1d, 0xac, 0xd8,
-	0x49, 0x8f, 0x2b, 0x79, 0xb2, 0x43, 0xec, 0xdc, 0xf4, 0x02, 0xa6, 0x2c, 0xd0, 0xad, 0xf4, 0x81,
-	0x86, 0x71, 0x6b, 0xbb, 0xaf, 0xa9, 0x48, 0x72, 0x9d, 0xa0, 0xeb, 0xbd, 0x87, 0x9a, 0x70, 0xa7,
-	0xff, 0x35, 0xc6, 0x76, 0xde, 0x4e, 0x7e, 0x5d, 0x9e, 0x31, 0x27, 0x75, 0xc9, 0x53, 0x2e, 0x8d,
-	0x24, 0x69, 0x97, 0x7f, 0xde, 0x16, 0x17, 0x96, 0xf7, 0x85, 0xbf, 0x88, 0xbf, 0xbe, 0xbe, 0x86,
-	0x19, 0x75, 0xd4, 0x84, 0x81, 0x36, 0x8b, 0xf4, 0x29, 0xbf, 0xd3, 0xd6, 0xde, 0xc2, 0x23, 0x87,
-	0xf8, 0xdc, 0xe4, 0xff, 0x63, 0xc1, 0x03, 0x5d, 0x81, 0x41, 0xfe, 0xce, 0x10, 0xbf, 0xf7, 0x51,
-	0xba, 0x3c, 0xd1, 0xfb, 0xb5, 0xa2, 0x74, 0xa3, 0xe0, 0xbf, 0x63, 0x2c, 0xeb, 0xa2, 0x2f, 0x3a,
-	0x30, 0x46, 0x25, 0x6a, 0xfa, 0x30, 0x52, 0x19, 0xd9, 0x92, 0x59, 0xd7, 0x63, 0xaa, 0x91, 0x48,
-	0x59, 0xa3, 0x0e, 0x92, 0x8b, 0x06, 0x3b, 0x9c, 0x61, 0x8f, 0x5e, 0x83, 0xa1, 0xd8, 0xaf, 0x91,
-	0xaa, 0x17, 0xc5, 0xe5, 0x33, 0xc7, 0xd3, 0x94, 0xd4, 0x81, 0x27, 0x18, 0x61, 0xc5, 0x12, 0xfd,
-	0x2a, 0x7b, 0xb8, 0xb6, 0xda, 0xf0, 0xb7, 0xc9, 0x72, 0x58, 0xe5, 0x07, 0x9f, 0xb3, 0xb6, 0xd6,
-	0xbe, 0x74, 0x55, 0x4a, 0xca, 0xc2, 0xaf, 0x65, 0xb2, 0xc3, 0x59, 0xfe, 0xe8, 0xef, 0x38, 0x70,
-	0x8e, 0xbf, 0x20, 0x91, 0x7d, 0x14, 0xe5, 0xdc, 0x11, 0x8d, 0x58, 0xec, 0xc2, 0xca, 0x74, 0x1e,
-	0x49, 0x9c, 0xcf, 0x89, 0xe5, 0x7b, 0x36, 0xdf, 0xb1, 0x3a, 0x6f, 0xd5, 0x91, 0x7d, 0xf0, 0xb7,
-	0xab, 0xd0, 0x13, 0x50, 0x6a, 0x8b, 0xed, 0xd0, 0x8f, 0x5b, 0xec, 0xfa, 0x51, 0x1f, 0xbf, 0x18,
-	0xba, 0x96, 0x82, 0xb1, 0x8e, 0x63, 0x24, 0xff, 0x7e, 0x6c, 0xbf, 0xe4, 0xdf, 0xe8, 0x3a, 0x94,
-	0x92, 0xb0, 0x29, 0xf2, 0xdf, 0xc6, 0xe5, 0x32, 0x9b, 0x81, 0x17, 0xf3, 0xd6, 0xd6, 0xba, 0x42,
-	0x4b, 0xcf, 0xfa, 0x29, 0x2c, 0xc6, 0x3a, 0x1d, 0x16, 0xb0, 0x2d, 0x5e, 0xe6, 0x88, 0xd8, 0x21,
-	0xff, 0xde, 0x4c, 0xc0, 0xb6, 0x5e, 0x88, 0x4d, 0x5c, 0xb4, 0x00, 0xa7, 0xdb, 0x5d, 0x56, 0x02,
-	0x7e, 0xed, 0x51, 0xc5, 0xc8, 0x74, 0x9b, 0x08, 0xba, 0xeb, 0xf4, 0x48, 0x70, 0x7d, 0xff, 0x51,
-	0x12, 0x5c, 0xa3, 0x1a, 0xdc, 0xef, 0x75, 0x92, 0x90, 0x65, 0x2c, 0x32, 0xab, 0xf0, 0x88, 0xf4,
-	0x07, 0x79, 0x90, 0xfb, 0xde, 0xee, 0xe4, 0xfd, 0xd3, 0xfb, 0xe0, 0xe1, 0x7d, 0xa9, 0xa0, 0x97,
-	0x61, 0x88, 0x88, 0x24, 0xdd, 0xe5, 0x9f, 0xb3, 0xb5, 0xf5, 0x9b, 0x69, 0xbf, 0x65, 0xb0, 0x2f,
-	0x87, 0x61, 0xc5, 0x0f, 0xad, 0x43, 0xa9, 0x11, 0xc6, 0xc9, 0x74, 0xd3, 0xf7, 0x62, 0x12, 0x97,
-	0x1f, 0x60, 0x53, 0x21, 0x57, 0xa3, 0xba, 0x2a, 0xd1, 0xd2, 0x99, 0x70, 0x35, 0xad, 0x89, 0x75,
-	0x32, 0x88, 0x30, 0x27, 0x35, 0x0b, 0xc7, 0x97, 0x0e, 0xb8, 0x8b, 0xac, 0x63, 0x8f, 0xe4, 0x51,
-	0x5e, 0x0b, 0x6b, 0x15, 0x13, 0x5b, 0x79, 0xa9, 0x75, 0x20, 0xce, 0xd2, 0x44, 0x4f, 0xc1, 0x48,
-	0x3b, 0xac, 0x55, 0xda, 0xa4, 0xba, 0xe6, 0x25, 0xd5, 0x46, 0x79, 0xd2, 0xb4, 0x36, 0xae, 0x69,
-	0x65, 0xd8, 0xc0, 0x44, 0x6d, 0x18, 0x6c, 0xf1, 0x0c, 0x15, 0xe5, 0x87, 0x6c, 0x9d, 0x58, 0x44,
-	0xca, 0x0b, 0x61, 0x19, 0xe0, 0x3f, 0xb0, 0x64, 0x83, 0xfe, 0xb1, 0x03, 0xa7, 0x32, 0xd7, 0xe4,
-	0xca, 0xef, 0xb0, 0xe9, 0xdb, 0xd1, 0x08, 0xcf, 0x3c, 0xc2, 0x86, 0xcf, 0x04, 0xde, 0xee, 0x06,
-	0xe1, 0x6c, 0x8b, 0xf8, 0xb8, 0xb0, 0x34, 0x33, 0xe5, 0x87, 0xed, 0x8d, 0x0b, 0x23, 0x28, 0xc7,
-	0x85, 0xfd, 0xc0, 0x92, 0x0d, 0x7a, 0x0c, 0x06, 0x45, 0xea, 0xc8, 0xf2, 0x23, 0xa6, 0xeb, 0x5f,
-	0x64, 0x98, 0xc4, 0xb2, 0xbc, 0x2b, 0x75, 0xcc, 0xe3, 0xb6, 0x52, 0xc7, 0xa8, 0xf3, 0xde, 0xe1,
-	0x53, 0xc7, 0x4c, 0x7c, 0x10, 0x4e, 0x77, 0x9d, 0x12, 0x0f, 0x95, 0xbb, 0xe5, 0x2e, 0x73, 0xbf,
-	0xb8, 0xbf, 0xee, 0x80, 0x9e, 0x2c, 0xc0, 0xfa, 0x73, 0x3f, 0x4f, 0xc1, 0x48, 0x95, 0xbf, 0xbe,
-	0xca, 0xd3, 0x0d, 0xf4, 0x9b, 0xc6, 0xec, 0x59, 0xad, 0x0c, 0x1b, 0x98, 0xee, 0x55, 0x40, 0xdd,
-	0x6f, 0x31, 0x1c, 0xc9, 0x2b, 0xf4, 0x4f, 0x1d, 0x18, 0x35, 0xd4, 0x1b, 0xeb, 0x1e, 0xeb, 0x79,
-	0x40, 0x2d, 0x3f, 0x8a, 0xc2, 0x48, 0x7f, 0xe6, 0x52, 0xa4, 0x04, 0x61, 0x91, 0x2c, 0x2b, 0x5d,
-	0xa5, 0x38, 0xa7, 0x86, 0xfb, 0xcf, 0xfb, 0x21, 0x0d, 0xe1, 0x57, 0x99, 0xaa, 0x9d, 0x9e, 0x99,
-	0xaa, 0x1f, 0x87, 0xa1, 0x17, 0xe3, 0x30, 0x58, 0x4b, 0xf3, 0x59, 0xab, 0x6f, 0xf1, 0x74, 0x65,
-	0xf5, 0x1a, 0xc3, 0x54, 0x18, 0x0c, 0xfb, 0xa5, 0x79, 0xbf, 0x99, 0x74, 0x27, 0x3c, 0x7e, 0xfa,
-	0x19, 0x0e, 0xc7, 0x0a, 0x83, 0xbd, 0x78, 0xb9, 0x4d, 0x94, 0x97, 0x23, 0x7d, 0xf1, 0x92, 0x3f,
-	0xb3, 0xc2, 0xca, 0xd0, 0x25, 0x18, 0x56, 0x1e, 0x12, 0xe1, 0x76, 0x51, 0x23, 0xa5, 0xdc, 0x28,
-	0x38, 0xc5, 0x61, 0xba, 0xab, 0xb0, 0xaa, 0x0b, 0x6b, 0x4f, 0xc5, 0xc6, 0x49, 0x2a, 0x63, 0xa7,
-	0xe7, 0x1b, 0x96, 0x04, 0x63, 0xc5, 0x32, 0xcf, 0x6b, 0x3f, 0x7c, 0x2c, 0x5e, 0x7b, 0xed, 0x3e,
-	0x49, 0xf1, 0xa0, 0xf7, 0x49, 0xcc, 0xb9, 0x3d, 0x74, 0xa0, 0xb9, 0xfd, 0x99, 0x3e, 0x18, 0x7c,
-	0x96, 0x44, 0xec, 0xa9, 0x80, 0xc7, 0x60, 0x70, 0x9b, 0xff, 0x9b, 0xbd, 0x8c, 0x2c, 0x30, 0xb0,
-	0x2c, 0xa7, 0xdf, 0x6d, 0xa3, 0xe3, 0x37, 0x6b, 0x73, 0xe9, 0x2a, 0x4e, 0x53, 0x79, 0xca, 0x02,
-	0x9c, 0xe2, 0xd0, 0x0a, 0x75, 0x7a, 0x08, 0x69, 0xb5, 0xfc, 0x24, 0x1b, 0x84, 0xb7, 0x20, 0x0b,
-	0x70, 0x8a, 0x83, 0x1e, 0x81, 0x81, 0xba, 0x9f, 0xac, 0x7b, 0xf5, 0xac, 0xdb, 0x77, 0x81, 0x41,
-	0xb1, 0x28, 0x65, 0x3e, 0x3f, 0x3f, 0x59, 0x8f, 0x08, 0x33, 0x42, 0x77, 0x65, 0x53, 0x59, 0xd0,
-	0xca, 0xb0, 0x81, 0xc9, 0x9a, 0x14, 0x8a, 0x9e, 0x89, 0x08, 0xe4, 0xb4, 0x49, 0xb2, 0x00, 0xa7,
-	0x38, 0x74, 0xfe, 0x57, 0xc3, 0x56, 0xdb, 0x6f, 0x8a, 0xd8, 0x78, 0x6d, 0xfe, 0xcf, 0x0a, 0x38,
-	0x56, 0x18, 0x14, 0x9b, 0x8a, 0x30, 0x2a, 0x7e, 0xb2, 0xaf, 0x0b, 0xae, 0x09, 0x38, 0x56, 0x18,
-	0xee, 0xb3, 0x30, 0xca, 0x57, 0xf2, 0x6c, 0xd3, 0xf3, 0x5b, 0x0b, 0xb3, 0xe8, 0x4a, 0xd7, 0x7d,
-	0x92, 0xc7, 0x72, 0xee, 0x93, 0x9c, 0x33, 0x2a, 0x75, 0xdf, 0x2b, 0x71, 0x7f, 0x58, 0x80, 0xa1,
-	0x13, 0x7c, 0xa0, 0xf5, 0xc4, 0xdf, 0x1a, 0x47, 0xb7, 0x32, 0x8f, 0xb3, 0xae, 0xd9, 0xbc, 0x1e,
-	0xb6, 0xef, 0xc3, 0xac, 0xff, 0xad, 0x00, 0xe7, 0x25, 0xaa, 0x3c, 0x76, 0x2e, 0xcc, 0xb2, 0x47,
-	0xef, 0x8e, 0x7f, 0xa0, 0x23, 0x63, 0xa0, 0xd7, 0xec, 0x1d, 0x9c, 0x17, 0x66, 0x7b, 0x0e, 0xf5,
-	0xcb, 0x99, 0xa1, 0xc6, 0x56, 0xb9, 0xee, 0x3f, 0xd8, 0x7f, 0xee, 0xc0, 0x44, 0xfe, 0x60, 0x9f,
-	0xc0, 0x7b, 0xb8, 0xaf, 0x99, 0xef, 0xe1, 0xfe, 0x82, 0xbd, 0x29, 0x66, 0x76, 0xa5, 0xc7, 0xcb,
-	0xb8, 0xff, 0xd3, 0x81, 0xb3, 0xb2, 0x02, 0xdb, 0x3d, 0x67, 0xfc, 0x80, 0x45, 0x26, 0x1d, 0xff,
-	0x34, 0x7b, 0xd5, 0x98, 0x66, 0xcf, 0xdb, 0xeb, 0xb8, 0xde, 0x8f, 0x5e, 0x13, 0xce, 0xfd, 0x33,
-	0x07, 0xca, 0x79, 0x15, 0x4e, 0xe0, 0x93, 0xbf, 0x62, 0x7e, 0xf2, 0x67, 0x8f, 0xa7, 0xe7, 0xbd,
-	0x3f, 0x78, 0xb9, 0xd7, 0x40, 0xa1, 0xa6, 0xd4, 0xab, 0x1c, 0x5b, 0xee, 0x73, 0xce, 0x22, 0x5f,
-	0x41, 0x6b, 0xc2, 0x40, 0xcc, 0x42, 0x70, 0xc4, 0x14, 0xb8, 0x6a, 0x43, 0xdb, 0xa2, 0xf4, 0x84,
-	0x3b, 0x80, 0xfd, 0x8f, 0x05, 0x0f, 0xf7, 0x37, 0x0b, 0x70, 0x41, 0xbd, 0x73, 0x4d, 0xb6, 0x49,
-	0x33, 0x5d, 0x1f, 0xec, 0x55, 0x14, 0x4f, 0xfd, 0xb4, 0xf7, 0x2a, 0x4a, 0xca, 0x22, 0x5d, 0x0b,
-	0x29, 0x0c, 0x6b, 0x3c, 0x51, 0x05, 0xce, 0xb1, 0x57, 0x4c, 0xe6, 0xfd, 0xc0, 0x6b, 0xfa, 0x2f,
-	0x93, 0x08, 0x93, 0x56, 0xb8, 0xed, 0x35, 0x85, 0xa6, 0xae, 0xee, 0xa3, 0xcf, 0xe7, 0x21, 0xe1,
-	0xfc, 0xba, 0x5d, 0x66, 0x84, 0xbe, 0x83, 0x9a, 0x11, 0xdc, 0x3f, 0x76, 0x60, 0xe4, 0x04, 0x5f,
-	0x05, 0x0f, 0xcd, 0x25, 0xf1, 0xb4, 0xbd, 0x25, 0xd1, 0x63, 0x19, 0xec, 0x16, 0xa1, 0xeb, 0xa1,
-	0x64, 0xf4, 0x59, 0x47, 0x05, 0x29, 0xf1, 0x60, 0xd0, 0x8f, 0xd8, 0x6b, 0xc7, 0x61, 0xb2, 0xa6,
-	0xa2, 0xaf, 0x67, 0xec, 0x01, 0x05, 0x5b, 0x09, 0xce, 0xba, 0x5a, 0x73, 0x84, 0x94, 0xb2, 0x5f,
-	0x71, 0x00, 0x78, 0x3b, 0x45, 0xca, 0x7a, 0xda, 0xb6, 0x8d, 0x63, 0x1b, 0x29, 0xca, 0x84, 0x37,
-	0x4d, 0x2d, 0xa1, 0xb4, 0x00, 0x6b, 0x2d, 0xb9, 0x8b, 0x5c, 0xb1, 0x77, 0x9d, 0xa6, 0xf6, 0x8b,
-	0x0e, 0x9c, 0xca, 0x34, 0x37, 0xa7, 0xfe, 0xa6, 0xf9, 0xae, 0xa7, 0x05, 0xcd, 0xca, 0x4c, 0x64,
-	0xae, 0x1b, 0x4f, 0xfe, 0xa5, 0x0b, 0xc6, 0x0b, 0xf3, 0xe8, 0x15, 0x18, 0x96, 0x96, 0x0f, 0x39,
-	0xbd, 0x6d, 0xbe, 0x6f, 0xac, 0x8e, 0x37, 0x12, 0x12, 0xe3, 0x94, 0x5f, 0x26, 0x06, 0xb2, 0x70,
-	0xa0, 0x18, 0xc8, 0xb7, 0xf7, 0x75, 0xe4, 0x7c, 0x63, 0x7b, 0xff, 0xb1, 0x18, 0xdb, 0xef, 0xb7,
-	0x6e, 0x6c, 0x7f, 0xe0, 0x84, 0x8d, 0xed, 0x9a, 0x3f, 0xb3, 0x78, 0x17, 0xfe, 0xcc, 0x57, 0xe0,
-	0xec, 0x76, 0x7a, 0xe8, 0x54, 0x33, 0x49, 0x24, 0xc5, 0x7a, 0x2c, 0xd7, 0xc4, 0x4e, 0x0f, 0xd0,
-	0x71, 0x42, 0x82, 0x44, 0x3b, 0xae, 0xa6, 0xe1, 0x97, 0xcf, 0xe6, 0x90, 0xc3, 0xb9, 0x4c, 0xb2,
-	0x8e, 0xa9, 0xc1, 0x03, 0x38, 0xa6, 0xbe, 0xe3, 0xc0, 0x39, 0xaf, 0xeb, 0x02, 0x23, 0x26, 0x9b,
-	0x22, 0x3a, 0xe6, 0x86, 0x3d, 0x15, 0xc2, 0x20, 0x2f, 0x3c, 0x80, 0x79, 0x45, 0x38, 0xbf, 0x41,
-	0xe8, 0xe1, 0x34, 0x4a, 0x80, 0x07, 0xed, 0xe6, 0xbb, 0xf4, 0xbf, 0x9e, 0x0d, 0x3d, 0x02, 0x36,
-	0xf4, 0x1f, 0xb3, 0x7b, 0xda, 0xb6, 0x10, 0x7e, 0x54, 0xba, 0x8b, 0xf0, 0xa3, 0x8c, 0x97, 0x70,
-	0xc4, 0x92, 0x97, 0x30, 0x80, 0x71, 0xbf, 0xe5, 0xd5, 0xc9, 0x5a, 0xa7, 0xd9, 0xe4, 0x37, 0x92,
-	0xe4, 0x0b, 0xd4, 0xb9, 0x16, 0xbc, 0xe5, 0xb0, 0xea, 0x35, 0x45, 0xce, 0x0f, 0x15, 0xb0, 0xac,
-	0x6e, 0x5e, 0x2d, 0x66, 0x28, 0xe1, 0x2e, 0xda, 0x74, 0xc2, 0xb2, 0xfc, 0x8e, 0x24, 0xa1, 0xa3,
-	0xcd, 0x62, 0x5c, 0x86, 0xf8, 0x84, 0xbd, 0x9a, 0x82, 0xb1, 0x8e, 0x83, 0x96, 0x60, 0xb8, 0x16,
-	0xc4, 0xe2, 0x2e, 0xf6, 0x29, 0x26, 0xcc, 0xde, 0x45, 0x45, 0xe0, 0xdc, 0xb5, 0x8a, 0xba, 0x85,
-	0x7d, 0x7f, 0x4e, 0xc2, 0x52, 0x55, 0x8e, 0xd3, 0xfa, 0x68, 0x85, 0x11, 0x13, 0x6f, 0xeb, 0xf1,
-	0xd0, 0x93, 0x07, 0x7b, 0x78, 0xc1, 0xe6, 0xae, 0xc9, 0xd7, 0x01, 0x47, 0x05, 0x3b, 0xf1, 0x48,
-	0x5e, 0x4a, 0x41, 0x7b, 0x09, 0xfc, 0xf4, 0xbe, 0x2f, 0x81, 0xb3, 0x4c, 0xc5, 0x49, 0x53, 0x79,
-	0xb2, 0x2f, 0x5a, 0xcb, 0x54, 0x9c, 0x06, 0x75, 0x8a, 0x4c, 0xc5, 0x29, 0x00, 0xeb, 0x2c, 0xd1,
-	0x6a, 0x2f, 0x8f, 0xfe, 0x19, 0x26, 0x34, 0x0e, 0xef, 0x9f, 0xd7, 0x43, 0xbf, 0xcf, 0xee, 0x17,
-	0xfa, 0xdd, 0xed, 0x8a, 0x3e, 0x77, 0x08, 0x57, 0x74, 0x83, 0xe5, 0x90, 0x5d, 0x98, 0x15, 0xde,
-	0x7f, 0x0b, 0xe7, 0x3b, 0x96, 0x73, 0x86, 0x07, 0xc9, 0xb2, 0x7f, 0x31, 0x67, 0xd0, 0x33, 0x3a,
-	0xfe, 0xc2, 0x91, 0xa3, 0xe3, 0x33, 0xfe, 0xdc, 0x7b, 0x8f, 0xcd, 0x9f, 0x3b, 0x71, 0x02, 0xfe,
-	0xdc, 0xfb, 0x0e, 0xec, 0xcf, 0xbd, 0x05, 0x67, 0xda, 0x61, 0x6d, 0xce, 0x8f, 0xa3, 0x0e, 0xbb,
-	0x6f, 0x39, 0xd3, 0xa9, 0xd5, 0x49, 0xc2, 0x1c, 0xc2, 0xa5, 0xcb, 0xef, 0xd2, 0x1b, 0xd9, 0x66,
-	0xab, 0x52, 0x2e, 0xb8, 0x4c, 0x05, 0x66, 0x07, 0x61, 0xd1, 0xbe, 0x39, 0x85, 0x38, 0x8f, 0x85,
-	0xee, 0x49, 0x7e, 0xf0, 0x64, 0x3c, 0xc9, 0x1f, 0x82, 0xa1, 0xb8, 0xd1, 0x49, 0x6a, 0xe1, 0xcd,
-	0x80, 0x85, 0x0b, 0x0c, 0xcf, 0xbc, 0x43, 0xd9, 0xa5, 0x05, 0xfc, 0xf6, 0xee, 0xe4, 0xb8, 0xfc,
-	0x5f, 0x33, 0x49, 0x0b, 0x08, 0xfa, 0x46, 0x8f, 0x9b, 0x55, 0xee, 0x71, 0xde, 0xac, 0xba, 0x70,
-	0xa8, 0x5b, 0x55, 0x79, 0xee, 0xf2, 0x87, 0x7e, 0xe6, 0xdc, 0xe5, 0x5f, 0x73, 0x60, 0x74, 0x5b,
-	0xb7, 0xff, 0x0b, 0x97, 0xbe, 0x85, 0x80, 0x21, 0xc3, 0xad, 0x30, 0xe3, 0x52, 0xa1, 0x65, 0x80,
-	0x6e, 0x67, 0x01, 0xd8, 0x6c, 0x49, 0x4e, 0x30, 0xd3, 0xc3, 0x6f, 0x57, 0x30, 0xd3, 0x6b, 0x50,
-	0x6a, 0x87, 0x35, 0x79, 0x62, 0x65, 0x7e, 0x7e, 0xbb, 0xb1, 0xcc, 0x5c, 0xff, 0x4c, 0x59, 0x60,
-	0x9d, 0x1f, 0xfa, 0x82, 0x03, 0xe3, 0xf2, 0x90, 0x25, 0xfc, 0x77, 0xb1, 0x88, 0xc6, 0xb4, 0x79,
-	0xb6, 0xe3, 0x49, 0x8d, 0x33, 0x7c, 0x70, 0x17, 0x67, 0xaa, 0x90, 0xa8, 0xe0, 0xb7, 0x7a, 0xcc,
-	0x82, 0x8e, 0x85, 0x42, 0x32, 0x9d, 0x82, 0xb1, 0x8e, 0x83, 0xbe, 0xe9, 0x40, 0xb1, 0x11, 0x86,
-	0x5b, 0x71, 0xf9, 0x31, 0x26, 0xd0, 0x9f, 0xb3, 0xac, 0x68, 0x5e, 0xa5, 0xb4, 0xb9, 0x86, 0xf9,
-	0x84, 0x34, 0x04, 0x31, 0xd8, 0xed, 0xdd, 0xc9, 0x31, 0xe3, 0x3d, 0xae, 0xf8, 0xf5, 0xb7, 0x34,
-	0x88, 0x30, 0x54, 0xb2, 0xa6, 0xa1, 0x37, 0x1d, 0x18, 0xbf, 0x99, 0xb1, 0x4e, 0x88, 0x70, 0x54,
-	0x6c, 0xdf, 0xee, 0xc1, 0x87, 0x3b, 0x0b, 0xc5, 0x5d, 0x2d, 0x40, 0x9f, 0x37, 0xad, 0x96, 0x3c,
-	0x6e, 0xd5, 0xe2, 0x00, 0x66, 0xac, 0xa4, 0xfc, 0x3a, 0x52, 0xbe, 0xf9, 0xf2, 0xee, 0x83, 0x45,
-	0x68, 0x67, 0xd2, 0x8f, 0x95, 0x53, 0x95, 0x98, 0xc6, 0x13, 0x0b, 0x8b, 0xdd, 0xf8, 0xfc, 0xba,
-	0xed, 0xe4, 0xcd, 0xf3, 0x30, 0x66, 0x3a, 0xea, 0xd0, 0x7b, 0xcc, 0x37, 0x51, 0x2e, 0x66, 0x9f,
-	0x97, 0x18, 0x95, 0xf8, 0xc6, 0x13, 0x13, 0xc6, 0x1b, 0x10, 0x85, 0x63, 0x7d, 0x03, 0xa2, 0xef,
-	0x64, 0xde, 0x80, 0x18, 0x3f, 0x8e, 0x37, 0x20, 0x4e, 0x1f, 0xea, 0x0d, 0x08, 0xed, 0x0d, 0x8e,
-	0xfe, 0x3b, 0xbc, 0xc1, 0x31, 0x0d, 0xa7, 0xe4, 0x9d, 0x23, 0x22, 0xd2, 0xec, 0x73, 0x1f, 0xbe,
-	0x7a, 0x26, 0x7e, 0xd6, 0x2c, 0xc6, 0x59, 0x7c, 0xba, 0xc8, 0x8a, 0x01, 0xab, 0x39, 0x60, 0x2b,
-	0x28, 0xcb, 0x9c, 0x5a, 0xec, 0x2c, 0x2c, 0x44, 0x94, 0x8c, 0xb2, 0x2e, 0x32, 0xd8, 0x6d, 0xf9,
-	0x0f, 0xe6, 0x2d, 0x40, 0x2f, 0x40, 0x39, 0xdc, 0xdc, 0x6c, 0x86, 0x5e, 0x2d, 0x7d, 0xa8, 0x42,
-	0x06, 0x19, 0xf0, 0x5b, 0xb5, 0x2a, 0x2b, 0xf1, 0x6a, 0x0f, 0x3c, 0xdc, 0x93, 0x02, 0xfa, 0x0e,
-	0x55, 0x4c, 0x92, 0x30, 0x22, 0xb5, 0xd4, 0xf0, 0x32, 0xcc, 0xfa, 0x4c, 0xac, 0xf7, 0xb9, 0x62,
-	0xf2, 0xe1, 0xbd, 0x57, 0x1f, 0x25, 0x53, 0x8a, 0xb3, 0xcd, 0x42, 0x11, 0x9c, 0x6f, 0xe7, 0xd9,
-	0x7d, 0x62, 0x71, 0x53, 0x6a, 0x3f, 0xeb, 0x93, 0x7a, 0x0c, 0x3d, 0xd7, 0x72, 0x14, 0xe3, 0x1e,
-	0x94, 0xf5, 0xc7, 0x24, 0x86, 0x4e, 0xe6, 0x31, 0x89, 0x4f, 0x00, 0x54, 0x65, 0x52, 0x3a, 0x69,
-	0x49, 0x58, 0xb2, 0x72, 0x85, 0x87, 0xd3, 0xd4, 0xde, 0x05, 0x56, 0x6c, 0xb0, 0xc6, 0x12, 0xfd,
-	0xdf, 0xdc, 0xd7, 0x56, 0xb8, 0xb9, 0xa4, 0x6e, 0x7d, 0x4e, 0xfc, 0xcc, 0xbd, 0xb8, 0xf2, 0x4f,
-	0x1c, 0x98, 0xe0, 0x33, 0x2f, 0xab, 0xdc, 0x53, 0xd5, 0x42, 0xdc, 0x29, 0xb2, 0x1d, 0x87, 0xc2,
-	0x93, 0x4b, 0x19, 0x5c, 0x99, 0xd7, 0x7a, 0x9f, 0x96, 0xa0, 0xaf, 0xe4, 0x1c, 0x29, 0x4e, 0xd9,
-	0x32, 0x40, 0xe6, 0xbf, 0x99, 0x71, 0x66, 0xef, 0x20, 0xa7, 0x88, 0x7f, 0xd6, 0xd3, 0x3e, 0x8a,
-	0x58, 0xf3, 0x7e, 0xf1, 0x98, 0xec, 0xa3, 0xfa, 0xc3, 0x1e, 0x87, 0xb2, 0x92, 0x7e, 0xd1, 0x81,
-	0x71, 0x2f, 0x13, 0x37, 0xc2, 0x8c, 0x3a, 0x56, 0x0c, 0x4c, 0xd3, 0x51, 0x1a, 0x8c, 0xc2, 0x94,
-	0xbc, 0x6c, 0x88, 0x0a, 0xee, 0x62, 0x8e, 0x7e, 0xe8, 0xc0, 0x7d, 0xe9, 0xeb, 0x21, 0x71, 0x7a,
-	0x47, 0x58, 0x34, 0xee, 0x2c, 0x5b, 0x8d, 0x2f, 0x59, 0x5f, 0x8d, 0xeb, 0xbd, 0x79, 0xf2, 0x75,
-	0xf9, 0x90, 0x58, 0x97, 0xf7, 0xed, 0x83, 0x89, 0xf7, 0x6b, 0xfa, 0xc4, 0x67, 0x1d, 0xfe, 0xbc,
-	0x5a, 0x4f, 0x95, 0x6f, 0xc3, 0x54, 0xf9, 0x96, 0x6d, 0x3e, 0xf0, 0xa4, 0xeb, 0x9e, 0xbf, 0xe2,
-	0xc0, 0xd9, 0xbc, 0x1d, 0x29, 0xa7, 0x49, 0x1f, 0x33, 0x9b, 0x64, 0xf1, 0x94, 0xa5, 0x37, 0xc8,
-	0xca, 0xeb, 0x30, 0x13, 0xd7, 0xe0, 0xc1, 0x3b, 0x7d, 0xc5, 0x3b, 0xd1, 0x1b, 0xd2, 0xd5, 0xe2,
-	0x3f, 0x1b, 0xd6, 0x5c, 0x8a, 0x09, 0x69, 0x5b, 0x0f, 0xc8, 0x0e, 0x60, 0xc0, 0x0f, 0x9a, 0x7e,
-	0x40, 0xc4, 0x3d, 0x51, 0x9b, 0x67, 0x58, 0xf1, 0x3e, 0x14, 0xa5, 0x8e, 0x05, 0x97, 0xb7, 0xd9,
-	0xc3, 0x98, 0x7d, 0x71, 0xaf, 0xff, 0xe4, 0x5f, 0xdc, 0xbb, 0x09, 0xc3, 0x37, 0xfd, 0xa4, 0xc1,
-	0x22, 0x23, 0x84, 0xe3, 0xce, 0xc2, 0xfd, 0x4a, 0x4a, 0x2e, 0xed, 0xfb, 0x0d, 0xc9, 0x00, 0xa7,
-	0xbc, 0xd0, 0x25, 0xce, 0x98, 0x85, 0x61, 0x67, 0xe3, 0x63, 0x6f, 0xc8, 0x02, 0x9c, 0xe2, 0xd0,
-	0xc1, 0x1a, 0xa1, 0xbf, 0x64, 0xb6, 0x2a, 0x91, 0x40, 0xda, 0x46, 0x62, 0x50, 0x41, 0x91, 0xdf,
-	0x62, 0xbe, 0xa1, 0xf1, 0xc0, 0x06, 0x47, 0x95, 0xc3, 0x7b, 0xa8, 0x67, 0x0e, 0xef, 0x57, 0x99,
-	0xc2, 0x96, 0xf8, 0x41, 0x87, 0xac, 0x06, 0x22, 0x78, 0x7b, 0xd9, 0xce, 0x9d, 0x6b, 0x4e, 0x93,
-	0x1f, 0xc1, 0xd3, 0xdf, 0x58, 0xe3, 0xa7, 0xf9, 0x4f, 0x4a, 0xfb, 0xfa, 0x4f, 0x52, 0x93, 0xcb,
-	0x88, 0x75, 0x93, 0x4b, 0x42, 0xda, 0x56, 0x4c, 0x2e, 0x3f, 0x53, 0xe6, 0x80, 0x3f, 0x77, 0x00,
-	0x29, 0xbd, 0x4b, 0x09, 0xd4, 0x13, 0x88, 0x90, 0xfc, 0xa4, 0x03, 0x10, 0xa8, 0x77, 0x59, 0xed,
-	0xee, 0x82, 0x9c, 0x66, 0xda, 0x80, 0x14, 0x86, 0x35, 0x9e, 0xee, 0x9f, 0x3a, 0x69, 0x20, 0x72,
-	0xda, 0xf7, 0x13, 0x88, 0x08, 0xdb, 0x31, 0x23, 0xc2, 0xd6, 0x2d, 0x9a, 0xee, 0x55, 0x37, 0x7a,
-	0xc4, 0x86, 0xfd, 0xa4, 0x00, 0xa7, 0x74, 0xe4, 0x0a, 0x39, 0x89, 0x8f, 0x7d, 0xd3, 0x08, 0x87,
-	0xbd, 0x6e, 0xb7, 0xbf, 0x15, 0xe1, 0x01, 0xca, 0x0b, 0xbd, 0xfe, 0x44, 0x26, 0xf4, 0xfa, 0x86,
-	0x7d, 0xd6, 0xfb, 0xc7, 0x5f, 0xff, 0x77, 0x07, 0xce, 0x64, 0x6a, 0x9c, 0xc0, 0x04, 0xdb, 0x36,
-	0x27, 0xd8, 0x33, 0xd6, 0x7b, 0xdd, 0x63, 0x76, 0x7d, 0xab, 0xd0, 0xd5, 0x5b, 0x76, 0x88, 0xfb,
-	0x8c, 0x03, 0x45, 0xaa, 0x2d, 0xcb, 0xe0, 0xac, 0x8f, 0x1d, 0xcb, 0x0c, 0x60, 0x7a, 0xbd, 0x90,
-	0xce, 0xaa, 0x7d, 0x0c, 0x86, 0x39, 0xf7, 0x89, 0x4f, 0x3b, 0x00, 0x29, 0xd2, 0xdb, 0xa5, 0x02,
-	0xbb, 0xdf, 0x2d, 0xc0, 0xb9, 0xdc, 0x69, 0x84, 0x3e, 0xa7, 0x2c, 0x72, 0x8e, 0xed, 0xd0, 0x43,
-	0x83, 0x91, 0x6e, 0x98, 0x1b, 0x35, 0x0c, 0x73, 0xc2, 0x1e, 0xf7, 0x76, 0x1d, 0x60, 0x84, 0x98,
-	0xd6, 0x06, 0xeb, 0xc7, 0x4e, 0x1a, 0xcd, 0xaa, 0xf2, 0x29, 0xfd, 0x25, 0xbc, 0x91, 0xe3, 0xfe,
-	0x44, 0xbb, 0xae, 0x20, 0x3b, 0x7a, 0x02, 0xb2, 0xe2, 0xa6, 0x29, 0x2b, 0xb0, 0x7d, 0x3f, 0x72,
-	0x0f, 0x61, 0xf1, 0x12, 0xe4, 0x39, 0x96, 0x0f, 0x96, 0xae, 0xd2, 0xb8, 0xdb, 0x5a, 0x38, 0xf0,
-	0xdd, 0xd6, 0x51, 0x28, 0x3d, 0xef, 0xab, 0x54, 0xa7, 0x33, 0x53, 0xdf, 0xfb, 0xd1, 0xc5, 0x7b,
-	0xbe, 0xff, 0xa3, 0x8b, 0xf7, 0xfc, 0xf0, 0x47, 0x17, 0xef, 0xf9, 0xe4, 0xde, 0x45, 0xe7, 0x7b,
-	0x7b, 0x17, 0x9d, 0xef, 0xef, 0x5d, 0x74, 0x7e, 0xb8, 0x77, 0xd1, 0xf9, 0x4f, 0x7b, 0x17, 0x9d,
-	0xbf, 0xf7, 0x27, 0x17, 0xef, 0x79, 0x7e, 0x48, 0x76, 0xec, 0x2f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xdc, 0x36, 0x17, 0xe9, 0xb5, 0xda, 0x00, 0x00,
+	// 13387 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x90, 0x24, 0xd9,
+	0x55, 0x18, 0xac, 0xac, 0xea, 0xe7, 0xed, 0xe7, 0xe4, 0xbc, 0x72, 0x7b, 0x67, 0xa7, 0x87, 0x5c,
+	0xed, 0xb2, 0x0b, 0x52, 0x8f, 0x76, 0x46, 0xf0, 0x89, 0xc7, 0x27, 0xd4, 0x8f, 0x99, 0x9e, 0xd9,
+	0x79, 0x74, 0xef, 0xa9, 0x9e, 0x1d, 0xf4, 0x40, 0x28, 0xbb, 0xea, 0x76, 0x55, 0xaa, 0xab, 0x32,
+	0x4b, 0x99, 0x59, 0x33, 0xd3, 0xab, 0x17, 0x12, 0xac, 0x84, 0x40, 0x20, 0xc0, 0x42, 0x80, 0x8c,
+	0xc3, 0x32, 0x16, 0x36, 0x06, 0x82, 0x40, 0xfe, 0xe1, 0x70, 0x40, 0x38, 0x02, 0xf3, 0x03, 0xcb,
+	0x8f, 0x70, 0x40, 0x20, 0x02, 0x45, 0xd8, 0xcc, 0xc2, 0x80, 0x09, 0xc2, 0x0e, 0x7e, 0x40, 0x18,
+	0x6c, 0xc6, 0x36, 0xe1, 0x38, 0xf7, 0x95, 0xf7, 0x66, 0x65, 0xf5, 0x74, 0xf7, 0xdc, 0xee, 0x15,
+	0xe0, 0x5f, 0xdd, 0x75, 0xee, 0xb9, 0xe7, 0xdc, 0x7b, 0xf3, 0x3e, 0xcf, 0x93, 0xac, 0x37, 0xc3,
+	0xac, 0xd5, 0xdb, 0x5c, 0xa8, 0xc7, 0x9d, 0xf3, 0x41, 0xd2, 0x8c, 0xbb, 0x49, 0xfc, 0x7e, 0xf6,
+	0xcf, 0x9b, 0xef, 0xc6, 0xc9, 0xf6, 0x56, 0x3b, 0xbe, 0x9b, 0x9e, 0xbf, 0x73, 0xf1, 0x7c, 0x77,
+	0xbb, 0x79, 0x3e, 0xe8, 0x86, 0xe9, 0x79, 0x09, 0x3d, 0x7f, 0xe7, 0x85, 0xa0, 0xdd, 0x6d, 0x05,
+	0x2f, 0x9c, 0x6f, 0xd2, 0x88, 0x26, 0x41, 0x46, 0x1b, 0x0b, 0xdd, 0x24, 0xce, 0x62, 0xf7, 0x1d,
+	0x39, 0xc5, 0x05, 0x49, 0x91, 0xfd, 0xf3, 0xdd, 0x8a, 0xe2, 0xc2, 0x9d, 0x8b, 0x0b, 0xdd, 0xed,
+	0xe6, 0x02, 0x52, 0x5c, 0x90, 0xd0, 0x05, 0x49, 0x71, 0xee, 0xcd, 0x5a, 0x9b, 0x9a, 0x71, 0x33,
+	0x3e, 0xcf, 0x08, 0x6f, 0xf6, 0xb6, 0xd8, 0x2f, 0xf6, 0x83, 0xfd, 0xc7, 0x19, 0xce, 0xf9, 0xdb,
+	0x6f, 0x4b, 0x17, 0xc2, 0x18, 0xdb, 0x77, 0xbe, 0x1e, 0x27, 0xf4, 0xfc, 0x9d, 0xbe, 0x46, 0xcd,
+	0xbd, 0x51, 0xc3, 0xe9, 0xc6, 0xed, 0xb0, 0xbe, 0x53, 0x86, 0xf5, 0xd6, 0x1c, 0xab, 0x13, 0xd4,
+	0x5b, 0x61, 0x44, 0x93, 0x1d, 0xd9, 0xf5, 0xf3, 0x09, 0x4d, 0xe3, 0x5e, 0x52, 0xa7, 0xfb, 0xaa,
+	0x95, 0x9e, 0xef, 0xd0, 0x2c, 0x28, 0xe3, 0x75, 0x7e, 0x50, 0xad, 0xa4, 0x17, 0x65, 0x61, 0xa7,
+	0x9f, 0xcd, 0x37, 0x3f, 0xaa, 0x42, 0x5a, 0x6f, 0xd1, 0x4e, 0xd0, 0x57, 0xef, 0xe2, 0xa0, 0x7a,
+	0xbd, 0x2c, 0x6c, 0x9f, 0x0f, 0xa3, 0x2c, 0xcd, 0x92, 0x62, 0x25, 0xff, 0xa7, 0xab, 0x64, 0x72,
+	0xf1, 0x76, 0xad, 0x16, 0x36, 0x5f, 0x7e, 0xeb, 0x62, 0x2f, 0x6b, 0xb9, 0xcf, 0x92, 0x91, 0x84,
+	0x36, 0xc3, 0x38, 0xf2, 0x9c, 0x73, 0xce, 0x73, 0xe3, 0x4b, 0xd3, 0x5f, 0xbe, 0x3f, 0xff, 0x86,
+	0x07, 0xf7, 0xe7, 0x47, 0x80, 0x41, 0x41, 0x94, 0xba, 0xcf, 0x93, 0xd1, 0x94, 0x26, 0x77, 0xc2,
+	0x3a, 0xf5, 0x2a, 0x0c, 0x71, 0x46, 0x20, 0x8e, 0xd6, 0x38, 0x18, 0x64, 0xb9, 0xdb, 0x20, 0x33,
+	0x41, 0xbd, 0x4e, 0xd3, 0xf4, 0x1a, 0xdd, 0xa9, 0xd1, 0x7a, 0x42, 0x33, 0xaf, 0x7a, 0xce, 0x79,
+	0x6e, 0xe2, 0xc2, 0x33, 0x0b, 0xbc, 0xc9, 0x38, 0x3f, 0x16, 0xf0, 0x8b, 0x2e, 0xdc, 0x79, 0x61,
+	0x81, 0x63, 0x30, 0xd4, 0x36, 0xad, 0x67, 0x71, 0xb2, 0x74, 0xfc, 0xc1, 0xfd, 0xf9, 0x99, 0x45,
+	0x93, 0x02, 0x14, 0x49, 0x22, 0x97, 0x34, 0xaf, 0xca, 0xb8, 0x0c, 0xed, 0x9b, 0x4b, 0xcd, 0xa4,
+	0x00, 0x45, 0x92, 0x6e, 0x87, 0xb8, 0x29, 0x4d, 0xd3, 0x30, 0x8e, 0x36, 0xe2, 0x6d, 0x1a, 0x09,
+	0x46, 0xc3, 0xfb, 0x61, 0x74, 0xea, 0xc1, 0xfd, 0x79, 0xb7, 0xd6, 0x47, 0x04, 0x4a, 0x08, 0xfb,
+	0x97, 0xc8, 0xc8, 0x62, 0x27, 0xee, 0x45, 0x99, 0xfb, 0x6d, 0x64, 0xf8, 0x4e, 0xd0, 0xee, 0x51,
+	0xf1, 0x59, 0x9e, 0x11, 0xa3, 0x3d, 0xfc, 0x32, 0x02, 0x1f, 0xde, 0x9f, 0x3f, 0x41, 0xa3, 0x7a,
+	0xdc, 0x08, 0xa3, 0xe6, 0xf9, 0xf7, 0xa7, 0x71, 0xb4, 0x70, 0xb3, 0xd7, 0xd9, 0xa4, 0x09, 0xf0,
+	0x3a, 0xfe, 0x6f, 0x57, 0xc8, 0xcc, 0x62, 0x52, 0x6f, 0x85, 0x77, 0x68, 0x2d, 0xc3, 0xcf, 0xdf,
+	0xdc, 0x71, 0x5b, 0xa4, 0x9a, 0x05, 0x09, 0x23, 0x37, 0x71, 0xe1, 0xc6, 0xc2, 0xe3, 0x2e, 0xe6,
+	0x85, 0x8d, 0x20, 0x91, 0xb4, 0x97, 0x46, 0x1f, 0xdc, 0x9f, 0xaf, 0x6e, 0x04, 0x09, 0x20, 0x0b,
+	0xb7, 0x4d, 0x86, 0xa2, 0x38, 0xe2, 0xf3, 0x64, 0xe2, 0xc2, 0xcd, 0xc7, 0x67, 0x75, 0x33, 0x8e,
+	0x54, 0x3f, 0x96, 0xc6, 0x1e, 0xdc, 0x9f, 0x1f, 0x42, 0x08, 0x30, 0x2e, 0xd8, 0xaf, 0x57, 0xc2,
+	0xae, 0x57, 0xb5, 0xd5, 0xaf, 0x77, 0x85, 0x5d, 0xb3, 0x5f, 0xef, 0x0a, 0xbb, 0x80, 0x2c, 0xfc,
+	0x4f, 0x55, 0xc8, 0xf8, 0x62, 0xd2, 0xec, 0x75, 0x68, 0x94, 0xa5, 0xee, 0x47, 0x09, 0xe9, 0x06,
+	0x49, 0xd0, 0xa1, 0x19, 0x4d, 0x52, 0xcf, 0x39, 0x57, 0x7d, 0x6e, 0xe2, 0xc2, 0xb5, 0xc7, 0x67,
+	0xbf, 0x2e, 0x69, 0x2e, 0xb9, 0xe2, 0x93, 0x13, 0x05, 0x4a, 0x41, 0x63, 0xe9, 0x7e, 0x90, 0x8c,
+	0x07, 0x49, 0x16, 0x6e, 0x05, 0xf5, 0x2c, 0xf5, 0x2a, 0x8c, 0xff, 0x8b, 0x8f, 0xcf, 0x7f, 0x51,
+	0x90, 0x5c, 0x3a, 0x26, 0xd8, 0x8f, 0x4b, 0x48, 0x0a, 0x39, 0x3f, 0xff, 0x57, 0x86, 0xc8, 0xc4,
+	0x62, 0x92, 0xad, 0x2e, 0xd7, 0xb2, 0x20, 0xeb, 0xa5, 0xee, 0xbf, 0x77, 0xc8, 0xf1, 0x94, 0x0f,
+	0x5b, 0x48, 0xd3, 0xf5, 0x24, 0xc6, 0xc5, 0x4a, 0x1b, 0x62, 0x5c, 0xb6, 0xac, 0xb4, 0x4b, 0x32,
+	0x5b, 0xa8, 0xf5, 0x33, 0xba, 0x14, 0x65, 0xc9, 0xce, 0xd2, 0x0b, 0xa2, 0xcd, 0xc7, 0x4b, 0x30,
+	0x3e, 0xfe, 0xda, 0xbc, 0x2b, 0xbb, 0xb2, 0xba, 0x2c, 0x10, 0x76, 0xa0, 0xac, 0xd5, 0xee, 0x4f,
+	0x39, 0x64, 0xb2, 0x1b, 0x37, 0x52, 0xa0, 0xf5, 0xb8, 0xd7, 0xa5, 0x0d, 0x31, 0xbc, 0xdf, 0x6d,
+	0xb7, 0x1b, 0xeb, 0x1a, 0x07, 0xde, 0xfe, 0x13, 0xa2, 0xfd, 0x93, 0x7a, 0x11, 0x18, 0x4d, 0x71,
+	0xdf, 0x46, 0x26, 0xa3, 0x38, 0xab, 0x75, 0x69, 0x3d, 0xdc, 0x0a, 0x69, 0x83, 0x4d, 0xfc, 0xb1,
+	0xbc, 0xe6, 0x4d, 0xad, 0x0c, 0x0c, 0xcc, 0xb9, 0xcb, 0xc4, 0x1b, 0x34, 0x72, 0xee, 0x2c, 0xa9,
+	0x6e, 0xd3, 0x1d, 0xbe, 0xd9, 0x00, 0xfe, 0xeb, 0x9e, 0x90, 0x1b, 0x10, 0x2e, 0xe3, 0x31, 0xb1,
+	0xb3, 0x7c, 0x6b, 0xe5, 0x6d, 0xce, 0xdc, 0x77, 0x90, 0x63, 0x7d, 0x4d, 0xdf, 0x0f, 0x01, 0xff,
+	0xaf, 0xc7, 0xc8, 0x98, 0xfc, 0x14, 0xee, 0x39, 0x32, 0x14, 0x05, 0x1d, 0xb9, 0xcf, 0x4d, 0x8a,
+	0x7e, 0x0c, 0xdd, 0x0c, 0x3a, 0xb8, 0xc2, 0x83, 0x0e, 0x45, 0x8c, 0x6e, 0x90, 0xb5, 0xbc, 0x8a,
+	0x89, 0xb1, 0x1e, 0x64, 0x2d, 0x60, 0x25, 0xee, 0x19, 0x32, 0xd4, 0x89, 0x1b, 0x94, 0x8d, 0xc5,
+	0x30, 0xdf, 0x21, 0x6e, 0xc4, 0x0d, 0x0a, 0x0c, 0x8a, 0xf5, 0xb7, 0x92, 0xb8, 0xe3, 0x0d, 0x99,
+	0xf5, 0x2f, 0x27, 0x71, 0x07, 0x58, 0x89, 0xfb, 0x93, 0x0e, 0x99, 0x95, 0x73, 0xfb, 0x7a, 0x5c,
+	0x0f, 0x32, 0x3c, 0x0f, 0xf9, 0x26, 0x0f, 0xf6, 0x96, 0x94, 0xa4, 0xbc, 0xe4, 0x89, 0x26, 0xcc,
+	0x16, 0x4b, 0xa0, 0xaf, 0x15, 0xee, 0x05, 0x42, 0x9a, 0xed, 0x78, 0x33, 0x68, 0xe3, 0x80, 0x78,
+	0x23, 0xac, 0x0b, 0x6a, 0x67, 0x58, 0x55, 0x25, 0xa0, 0x61, 0xb9, 0xf7, 0xc8, 0x68, 0xc0, 0x77,
+	0x7f, 0x6f, 0x94, 0x75, 0xe2, 0x25, 0x1b, 0x9d, 0x30, 0x8e, 0x93, 0xa5, 0x09, 0x3c, 0xfa, 0x05,
+	0x10, 0x24, 0x3b, 0xf7, 0x4d, 0x64, 0x2c, 0xee, 0x62, 0xbb, 0x83, 0xb6, 0x37, 0xc6, 0x26, 0xe6,
+	0xac, 0x68, 0xeb, 0xd8, 0x9a, 0x80, 0x83, 0xc2, 0x60, 0x77, 0x8a, 0xde, 0x26, 0x7e, 0x47, 0x6f,
+	0xbc, 0x70, 0xa7, 0xe0, 0x60, 0x90, 0xe5, 0xee, 0x37, 0x91, 0x89, 0x84, 0xd6, 0x7b, 0x49, 0x4a,
+	0xf1, 0xc3, 0x7a, 0x84, 0xd1, 0x3e, 0x2e, 0xd0, 0x27, 0x20, 0x2f, 0x02, 0x1d, 0xcf, 0x7d, 0x3b,
+	0x99, 0xc6, 0x0f, 0x7c, 0xe9, 0x5e, 0x37, 0xe1, 0x87, 0xad, 0x37, 0xc1, 0x18, 0x9d, 0x12, 0x35,
+	0xa7, 0x2f, 0x1b, 0xa5, 0x50, 0xc0, 0x76, 0x3f, 0x44, 0x48, 0xa0, 0xf6, 0x0c, 0x6f, 0x92, 0x0d,
+	0xe6, 0x75, 0x7b, 0x33, 0x62, 0x75, 0x79, 0x69, 0x1a, 0xbf, 0x63, 0xfe, 0x1b, 0x34, 0x7e, 0x38,
+	0x3e, 0x0d, 0xda, 0xa6, 0x19, 0x6d, 0x78, 0x53, 0xac, 0xc3, 0x6a, 0x7c, 0x56, 0x38, 0x18, 0x64,
+	0xb9, 0xbb, 0x42, 0xc6, 0x83, 0x66, 0x33, 0xa1, 0xcd, 0x20, 0xa3, 0xde, 0x34, 0xeb, 0xe3, 0xb3,
+	0x6a, 0x03, 0x97, 0x05, 0x0f, 0xef, 0xcf, 0x1f, 0x93, 0xac, 0x14, 0x10, 0xf2, 0x8a, 0xee, 0x27,
+	0x1c, 0x42, 0xd4, 0xaf, 0x86, 0x37, 0x73, 0xae, 0x7a, 0x48, 0x2b, 0x40, 0xcd, 0x60, 0xd5, 0x8c,
+	0x06, 0x68, 0x9c, 0xdd, 0xf3, 0x64, 0x3c, 0x0d, 0x5f, 0xa1, 0x4b, 0x3b, 0x19, 0x4d, 0xbd, 0xd9,
+	0x73, 0xce, 0x73, 0xd5, 0xfc, 0x3c, 0xaa, 0xc9, 0x02, 0xc8, 0x71, 0x70, 0xe2, 0xd5, 0x5b, 0xb4,
+	0xbe, 0x9d, 0xf6, 0x3a, 0xde, 0x31, 0xd6, 0x7d, 0x35, 0xf1, 0x96, 0x05, 0x1c, 0x14, 0x86, 0xff,
+	0xf7, 0x2b, 0x44, 0x1b, 0x73, 0x77, 0x89, 0x8c, 0x89, 0x53, 0x40, 0x6c, 0x60, 0x6a, 0xec, 0xc6,
+	0xe4, 0x7c, 0x7f, 0x78, 0xbf, 0xf4, 0xf4, 0x50, 0xf5, 0xdc, 0x0f, 0x93, 0x89, 0x6e, 0xdc, 0xb8,
+	0x41, 0xb3, 0xa0, 0x11, 0x64, 0x81, 0xb8, 0xfb, 0x58, 0x38, 0x8f, 0x25, 0xc5, 0xa5, 0x19, 0x9c,
+	0xe8, 0xeb, 0x39, 0x0b, 0xd0, 0xf9, 0xb9, 0x2f, 0x12, 0x57, 0x5c, 0xbf, 0x17, 0xeb, 0x75, 0xbc,
+	0x40, 0xb2, 0xed, 0xa2, 0xca, 0x3a, 0x33, 0x27, 0x3a, 0xe3, 0xd6, 0xfa, 0x30, 0xa0, 0xa4, 0x96,
+	0xff, 0x95, 0x0a, 0x99, 0xd6, 0xfa, 0xda, 0xa5, 0x75, 0xf7, 0xe7, 0x1c, 0x32, 0xa3, 0x0e, 0xff,
+	0xa5, 0x9d, 0x9b, 0xb8, 0x06, 0xf9, 0xd1, 0x4e, 0x6d, 0xae, 0x06, 0xe4, 0xb5, 0xb0, 0x68, 0xf2,
+	0xe1, 0x27, 0xe3, 0x69, 0xd1, 0x87, 0x99, 0x42, 0x29, 0x14, 0x9b, 0x35, 0xf7, 0x39, 0x87, 0x9c,
+	0x28, 0x23, 0x51, 0x72, 0x42, 0xb5, 0xf4, 0x13, 0xca, 0xea, 0x44, 0x47, 0xae, 0xd8, 0x19, 0xe3,
+	0xd4, 0xab, 0x90, 0x59, 0x7d, 0x0a, 0xb1, 0x7b, 0xd3, 0xaf, 0x3b, 0xe4, 0xa4, 0xec, 0x01, 0xd0,
+	0xb4, 0xd7, 0x2e, 0x0c, 0x6f, 0xc7, 0xea, 0xf0, 0x32, 0x9e, 0x0b, 0x8b, 0x65, 0xfc, 0xf8, 0x30,
+	0x3f, 0x25, 0x86, 0xf9, 0x64, 0x29, 0x0e, 0x94, 0x37, 0x75, 0xee, 0x8b, 0x0e, 0x99, 0x1b, 0x4c,
+	0xb4, 0x64, 0xe0, 0xbb, 0xe6, 0xc0, 0xbf, 0xcb, 0x5e, 0x27, 0x39, 0x7b, 0x36, 0xfc, 0xac, 0xb3,
+	0xfa, 0x07, 0xf8, 0xc5, 0x31, 0xd2, 0x77, 0xe2, 0xba, 0x2f, 0x90, 0x09, 0x71, 0x78, 0x5d, 0x8f,
+	0x9b, 0x29, 0x6b, 0xe4, 0x18, 0x5f, 0x6b, 0x8b, 0x39, 0x18, 0x74, 0x1c, 0xb7, 0x41, 0x2a, 0xe9,
+	0x45, 0xaf, 0x62, 0xeb, 0x30, 0xa8, 0x5d, 0x54, 0x77, 0xee, 0x91, 0x07, 0xf7, 0xe7, 0x2b, 0xb5,
+	0x8b, 0x50, 0x49, 0x2f, 0xe2, 0xbb, 0xa6, 0x19, 0x66, 0xf6, 0xde, 0x35, 0xab, 0x61, 0xa6, 0xf8,
+	0xb0, 0x77, 0xcd, 0x6a, 0x98, 0x01, 0xb2, 0xc0, 0xf7, 0x5a, 0x2b, 0xcb, 0xba, 0xde, 0x90, 0xad,
+	0xf7, 0xda, 0x95, 0x8d, 0x8d, 0x75, 0xc5, 0x8b, 0xdd, 0xc6, 0x10, 0x02, 0x8c, 0x8b, 0xfb, 0xfd,
+	0x0e, 0x8e, 0x38, 0x2f, 0x8c, 0x93, 0x1d, 0x71, 0xcd, 0xba, 0x65, 0x6f, 0x0a, 0xc4, 0xc9, 0x8e,
+	0x62, 0x2e, 0x3e, 0xa4, 0x2a, 0x00, 0x9d, 0x35, 0xeb, 0x78, 0x63, 0x2b, 0xf5, 0x46, 0xac, 0x75,
+	0x7c, 0xe5, 0x72, 0xad, 0xd0, 0xf1, 0x95, 0xcb, 0x35, 0x60, 0x5c, 0xf0, 0x83, 0x26, 0xc1, 0x5d,
+	0x6f, 0xd4, 0xd6, 0x07, 0x85, 0xe0, 0xae, 0xf9, 0x41, 0x21, 0xb8, 0x0b, 0xc8, 0x02, 0x39, 0xc5,
+	0x69, 0xea, 0x8d, 0xd9, 0xe2, 0xb4, 0x56, 0xab, 0x99, 0x9c, 0xd6, 0x6a, 0x35, 0x40, 0x16, 0x6c,
+	0x92, 0xd6, 0x53, 0x6f, 0xdc, 0x16, 0xa7, 0xd5, 0xe5, 0x02, 0xa7, 0xd5, 0xe5, 0x1a, 0x20, 0x0b,
+	0xdc, 0x32, 0x82, 0x57, 0x7a, 0x09, 0xbf, 0xfa, 0x4d, 0x5c, 0x58, 0xb3, 0x30, 0x5f, 0x90, 0x9c,
+	0xe2, 0x36, 0x8e, 0xc2, 0x15, 0x06, 0x02, 0xce, 0xc8, 0xff, 0x8d, 0x6a, 0xbe, 0x5d, 0xc8, 0xfd,
+	0xdc, 0xfd, 0x51, 0x76, 0x10, 0x8a, 0xbd, 0x40, 0x3c, 0x14, 0x9c, 0x43, 0x7b, 0x28, 0x70, 0x49,
+	0x98, 0xc9, 0x0e, 0x8a, 0xfc, 0xdd, 0x1f, 0x73, 0xfa, 0x25, 0x01, 0x81, 0xfd, 0xb3, 0x4c, 0x01,
+	0x52, 0x7e, 0x56, 0xec, 0x2a, 0x20, 0x98, 0xfb, 0x7e, 0x87, 0x4c, 0x9b, 0x15, 0x4a, 0xce, 0x81,
+	0xf7, 0x99, 0xe7, 0x80, 0x45, 0xf1, 0x85, 0xbe, 0xef, 0x7f, 0xca, 0x21, 0x53, 0x12, 0x8e, 0x8f,
+	0x89, 0xd4, 0xbd, 0x47, 0xc6, 0x64, 0x4b, 0x3d, 0xc7, 0x36, 0xeb, 0xfc, 0xe6, 0xa9, 0x1a, 0xa3,
+	0xb8, 0xf9, 0x3f, 0x37, 0x42, 0xd4, 0x3d, 0x12, 0x68, 0x37, 0x4e, 0x43, 0xb6, 0x13, 0x1d, 0xe0,
+	0x14, 0x8a, 0xb4, 0x53, 0xe8, 0x65, 0x9b, 0xa7, 0x50, 0xde, 0x2c, 0xe3, 0x3c, 0xfa, 0xb1, 0xc2,
+	0xbe, 0xcd, 0x0f, 0xa6, 0xef, 0x3e, 0x94, 0x7d, 0x5b, 0x6b, 0xc2, 0xee, 0x3b, 0xf8, 0x1d, 0xb1,
+	0x83, 0xf3, 0xa3, 0xeb, 0x3b, 0xed, 0xee, 0xe0, 0x5a, 0x2b, 0x8a, 0x7b, 0x79, 0xc2, 0x77, 0x58,
+	0x7e, 0x76, 0xdd, 0xb6, 0xba, 0xc3, 0x6a, 0x5c, 0xcd, 0xbd, 0x36, 0xe1, 0x7b, 0xed, 0x88, 0x2d,
+	0x9e, 0xab, 0xcb, 0x03, 0x79, 0xaa, 0x5d, 0xf7, 0x15, 0xb9, 0xeb, 0xf2, 0x53, 0xeb, 0x9d, 0x96,
+	0x77, 0x5d, 0x8d, 0x6f, 0xff, 0xfe, 0xfb, 0x3b, 0x0e, 0x39, 0xd9, 0x8f, 0x08, 0x74, 0x0b, 0x5f,
+	0x87, 0xf5, 0x38, 0xda, 0x0a, 0x9b, 0x37, 0x82, 0xae, 0x78, 0xb0, 0xa9, 0xcd, 0x68, 0x59, 0x16,
+	0x40, 0x8e, 0xe3, 0x3e, 0xc5, 0x77, 0x1e, 0x2e, 0x40, 0x9a, 0x10, 0xa8, 0xd5, 0x6b, 0x74, 0x87,
+	0x6f, 0x43, 0x9b, 0x64, 0x4e, 0x36, 0xb4, 0x9f, 0xa1, 0x78, 0x44, 0xf9, 0xa2, 0xd6, 0xdc, 0xed,
+	0x81, 0x98, 0xb0, 0x0b, 0x95, 0x6f, 0x1d, 0xfb, 0xc9, 0x2f, 0xcc, 0xbf, 0xe1, 0x7b, 0xfe, 0xf3,
+	0xb9, 0x37, 0xf8, 0xbf, 0x55, 0x25, 0x4f, 0x96, 0xf6, 0x4b, 0x3c, 0x09, 0x7e, 0xd1, 0x78, 0x12,
+	0x68, 0xe5, 0x9e, 0x63, 0xeb, 0xd3, 0x97, 0xb2, 0x2f, 0xbb, 0xfc, 0x6b, 0xc5, 0x70, 0x32, 0x18,
+	0xf4, 0x31, 0x50, 0x4a, 0x97, 0x76, 0x03, 0xa5, 0x1a, 0x52, 0x1f, 0xe3, 0xa6, 0x2c, 0x80, 0x1c,
+	0x87, 0x4b, 0x35, 0xb6, 0x82, 0x5e, 0x3b, 0xf3, 0xaa, 0x45, 0xa9, 0x06, 0x03, 0x83, 0x2c, 0x77,
+	0x7f, 0xda, 0x21, 0x6e, 0x3f, 0x57, 0xb1, 0xda, 0x37, 0x0e, 0x63, 0x1c, 0xb8, 0xb6, 0xa6, 0x64,
+	0x00, 0xdc, 0x60, 0xb7, 0x6f, 0xfa, 0x11, 0x32, 0x6d, 0xbe, 0x40, 0xf6, 0x20, 0xd6, 0x64, 0xd2,
+	0x2f, 0xa6, 0xd4, 0xf2, 0x2a, 0xe6, 0x38, 0xd4, 0x38, 0x18, 0x64, 0xb9, 0x3b, 0x4f, 0x86, 0x69,
+	0x92, 0xc4, 0x89, 0x98, 0x8b, 0x6c, 0xad, 0x5c, 0x42, 0x00, 0x70, 0xb8, 0xff, 0xc7, 0x15, 0xe2,
+	0x0d, 0x7a, 0x02, 0xb9, 0xff, 0x5c, 0x7b, 0xbc, 0xf3, 0x42, 0xa9, 0xaf, 0x88, 0x0f, 0xef, 0xe1,
+	0x55, 0x28, 0x48, 0x07, 0x3c, 0xe3, 0x45, 0x29, 0x14, 0x1b, 0x38, 0xf7, 0x59, 0xed, 0x19, 0xaf,
+	0x93, 0x28, 0xb9, 0x45, 0x6c, 0x99, 0xb7, 0x88, 0x75, 0xdb, 0x9d, 0xd2, 0xef, 0x12, 0xbf, 0x37,
+	0x4c, 0x8e, 0xcb, 0xd2, 0x1a, 0xc5, 0xf3, 0xf8, 0xa5, 0x1e, 0x4d, 0x76, 0xdc, 0xdf, 0x75, 0xc8,
+	0x89, 0xa0, 0x28, 0x1f, 0x0a, 0xe9, 0x21, 0x0c, 0xb4, 0xc6, 0x75, 0x61, 0xb1, 0x84, 0x23, 0x1f,
+	0xe8, 0x0b, 0x62, 0xa0, 0x4f, 0x94, 0xa1, 0x0c, 0x50, 0x85, 0x94, 0x76, 0x00, 0xf5, 0x0d, 0x12,
+	0xce, 0x64, 0x4a, 0x7c, 0x89, 0x2b, 0x7d, 0xc3, 0xa2, 0x56, 0x06, 0x06, 0x26, 0xd6, 0xcc, 0x68,
+	0xa7, 0xdb, 0x0e, 0x32, 0xaa, 0x49, 0xa3, 0x54, 0xcd, 0x0d, 0xad, 0x0c, 0x0c, 0x4c, 0x54, 0x4a,
+	0x47, 0x71, 0x83, 0x5e, 0x6d, 0x78, 0x43, 0xa6, 0x52, 0xfa, 0x26, 0x83, 0x82, 0x28, 0x75, 0x9f,
+	0xc9, 0x05, 0xa4, 0xc3, 0x6c, 0x09, 0x4d, 0x94, 0x0a, 0x47, 0xff, 0x91, 0x43, 0xc6, 0xb1, 0xc6,
+	0xc6, 0x4e, 0x97, 0xe2, 0x01, 0x8a, 0x5f, 0xa4, 0x71, 0x38, 0x5f, 0xe4, 0xa6, 0x64, 0x63, 0xca,
+	0x53, 0xc6, 0x15, 0xfc, 0xe3, 0xaf, 0xcd, 0x8f, 0xc9, 0x1f, 0x90, 0xb7, 0x6a, 0x6e, 0x95, 0x3c,
+	0x31, 0xf0, 0x6b, 0xee, 0x4b, 0x3b, 0xf3, 0xed, 0x64, 0xda, 0x6c, 0xc4, 0xbe, 0x54, 0x33, 0xff,
+	0x52, 0x5b, 0x76, 0xbc, 0x5f, 0x62, 0x3f, 0x7b, 0xdd, 0xae, 0xcc, 0x6a, 0x32, 0xac, 0x78, 0x95,
+	0x92, 0xc9, 0xb0, 0x22, 0x26, 0xc3, 0x8a, 0x8f, 0x2a, 0xc8, 0x92, 0xbb, 0x24, 0x1e, 0xfe, 0xbd,
+	0xa4, 0xed, 0x39, 0xe6, 0xe1, 0x7f, 0x0b, 0xae, 0x03, 0xc2, 0xdd, 0xcf, 0x6a, 0xbb, 0x23, 0x56,
+	0xeb, 0x09, 0x4d, 0x93, 0x25, 0xad, 0x89, 0x41, 0xb8, 0x7f, 0xff, 0x13, 0x05, 0x50, 0x6c, 0x82,
+	0xff, 0x63, 0x15, 0xf2, 0xd4, 0xae, 0x37, 0xe3, 0xd2, 0x86, 0x3b, 0xaf, 0x7b, 0xc3, 0xf1, 0x58,
+	0x4b, 0x68, 0x37, 0xbe, 0x05, 0xd7, 0x8b, 0x86, 0x22, 0xc0, 0xc1, 0x20, 0xcb, 0xf1, 0xea, 0xb0,
+	0x4d, 0x77, 0x2e, 0xc7, 0x49, 0x27, 0xc8, 0xbc, 0xaa, 0x79, 0x75, 0xb8, 0x26, 0x0b, 0x20, 0xc7,
+	0xf1, 0x7f, 0xd7, 0x21, 0xc5, 0x06, 0xb8, 0x01, 0x99, 0xee, 0xa5, 0x34, 0xc1, 0x23, 0x55, 0x58,
+	0x67, 0x38, 0xfb, 0xb1, 0xce, 0x70, 0x51, 0x0b, 0x74, 0xcb, 0x20, 0x00, 0x05, 0x82, 0xc8, 0xa2,
+	0x1b, 0xa4, 0xe9, 0xdd, 0x38, 0x69, 0x08, 0x16, 0x95, 0x7d, 0xb3, 0x58, 0x37, 0x08, 0x40, 0x81,
+	0xa0, 0xff, 0x15, 0x7c, 0xa3, 0xea, 0x57, 0x63, 0xf7, 0x0b, 0x78, 0xf7, 0x41, 0xc8, 0x52, 0x3b,
+	0xde, 0x5c, 0x8e, 0xa3, 0x2c, 0x08, 0x23, 0x2a, 0xed, 0x37, 0x36, 0x2c, 0x5d, 0xc4, 0x0d, 0xda,
+	0xb9, 0xa2, 0xa0, 0xbf, 0x0c, 0x4a, 0xda, 0x82, 0x77, 0x9c, 0xcd, 0x76, 0xbc, 0x59, 0x54, 0xcc,
+	0x22, 0x12, 0xb0, 0x12, 0xff, 0xcf, 0x1d, 0x72, 0x7a, 0xc0, 0x8d, 0xdf, 0xfd, 0x9c, 0x43, 0xa6,
+	0x36, 0xbf, 0x26, 0xfa, 0x66, 0x36, 0x03, 0x95, 0x86, 0x08, 0xc0, 0x93, 0x48, 0xcc, 0xcd, 0x8a,
+	0xa9, 0x34, 0x5c, 0x32, 0x4a, 0xa1, 0x80, 0xed, 0xff, 0xbd, 0x0a, 0x29, 0xe1, 0x82, 0x2a, 0x2a,
+	0x1a, 0x35, 0xba, 0x71, 0x18, 0x65, 0x62, 0x33, 0x52, 0xbb, 0xde, 0x25, 0x01, 0x07, 0x85, 0x21,
+	0xde, 0x38, 0x62, 0x60, 0x2a, 0x7d, 0x6f, 0x1c, 0xd1, 0xf2, 0x1c, 0xc7, 0x6d, 0x92, 0xd9, 0x80,
+	0x2b, 0x71, 0x0e, 0x68, 0x76, 0x75, 0x82, 0x69, 0xa4, 0x0b, 0x24, 0xa0, 0x8f, 0x28, 0xaa, 0x62,
+	0x7b, 0x29, 0xad, 0xad, 0x5c, 0x5b, 0x4e, 0x68, 0x83, 0x3f, 0xbd, 0x35, 0x55, 0xec, 0xad, 0xbc,
+	0x08, 0x74, 0x3c, 0xff, 0x0f, 0x1d, 0x32, 0xba, 0x14, 0xd4, 0xb7, 0xe3, 0xad, 0x2d, 0x1c, 0x8a,
+	0x46, 0x2f, 0xc9, 0xa5, 0x67, 0xda, 0x50, 0xac, 0x08, 0x38, 0x28, 0x0c, 0x77, 0x83, 0x8c, 0xf0,
+	0x05, 0x2f, 0x96, 0xdd, 0x5b, 0xb4, 0xfe, 0x28, 0xcb, 0x37, 0x36, 0x1d, 0xd0, 0xf2, 0x6d, 0x81,
+	0x5b, 0xbe, 0x2d, 0x5c, 0x8d, 0xb2, 0xb5, 0xa4, 0x96, 0x25, 0x61, 0xd4, 0x5c, 0x22, 0x78, 0x5c,
+	0x5c, 0x66, 0x34, 0x40, 0xd0, 0xc2, 0x6e, 0x74, 0x82, 0x7b, 0x92, 0x9d, 0xd8, 0x7e, 0x54, 0x37,
+	0x6e, 0xe4, 0x45, 0xa0, 0xe3, 0xe1, 0x69, 0x52, 0x0f, 0xba, 0xde, 0x90, 0x79, 0x9a, 0x2c, 0x07,
+	0x5d, 0x40, 0xb8, 0xff, 0x5b, 0x0e, 0x19, 0x5f, 0x0a, 0xd2, 0xb0, 0xfe, 0xb7, 0x68, 0x6f, 0x7a,
+	0x2f, 0x19, 0x5e, 0x0e, 0xea, 0x2d, 0xea, 0xde, 0x2a, 0xbe, 0xbb, 0x27, 0x2e, 0x3c, 0x57, 0xc6,
+	0x46, 0xbd, 0xc1, 0x75, 0x4e, 0x53, 0x83, 0x5e, 0xe7, 0xfe, 0xbf, 0xae, 0x90, 0x93, 0xcb, 0xad,
+	0xb0, 0xdd, 0x90, 0x4f, 0x6b, 0x79, 0x33, 0xc4, 0x4b, 0x47, 0x47, 0x6a, 0x54, 0x1d, 0xeb, 0x1a,
+	0x55, 0x35, 0xe7, 0x24, 0x04, 0x14, 0x37, 0xb7, 0x4b, 0x86, 0xd2, 0x2e, 0xad, 0xdb, 0xb3, 0x61,
+	0x93, 0x7d, 0x43, 0x49, 0x6a, 0xbe, 0x55, 0xe2, 0x2f, 0x60, 0x9c, 0xdc, 0x6f, 0x27, 0xa3, 0xf5,
+	0x20, 0xad, 0x07, 0x0d, 0x5a, 0x90, 0x38, 0x8c, 0x2e, 0x73, 0xf0, 0xc3, 0xfb, 0xf3, 0x33, 0xe2,
+	0x5f, 0x75, 0x65, 0x97, 0x55, 0xfc, 0xd7, 0x1c, 0x32, 0xbd, 0xdc, 0x0e, 0x69, 0x94, 0x2d, 0xd3,
+	0x24, 0x63, 0x93, 0xaf, 0x49, 0x66, 0xeb, 0x0a, 0x72, 0x90, 0xe9, 0xc7, 0x36, 0x84, 0xe5, 0x02,
+	0x09, 0xe8, 0x23, 0x8a, 0x96, 0x98, 0x1c, 0x96, 0x6f, 0x3c, 0x95, 0x7d, 0x5b, 0x62, 0x2e, 0x9b,
+	0x14, 0xa0, 0x48, 0xd2, 0xff, 0x53, 0x87, 0x9c, 0x5e, 0x6e, 0xf7, 0xd2, 0x8c, 0x26, 0x7d, 0xf3,
+	0xe4, 0x7d, 0x7d, 0xf3, 0x64, 0xf0, 0x1e, 0xc1, 0xbe, 0x0f, 0x62, 0x63, 0x63, 0xd6, 0x36, 0xdf,
+	0x4f, 0xeb, 0x19, 0x7e, 0xff, 0xdc, 0x24, 0x21, 0x87, 0xbd, 0x9e, 0xf3, 0xc1, 0xff, 0x5f, 0x0e,
+	0x79, 0x72, 0x40, 0x7f, 0xaf, 0x87, 0x69, 0xe6, 0xbe, 0xa7, 0xaf, 0xcf, 0x0b, 0x7b, 0xeb, 0x33,
+	0xd6, 0xbe, 0x41, 0xf5, 0xf9, 0x2f, 0x21, 0x5a, 0x7f, 0x3f, 0x42, 0x86, 0xc3, 0x8c, 0x76, 0xa4,
+	0x3a, 0xc1, 0x82, 0xe0, 0x6f, 0x40, 0x5f, 0x96, 0xa6, 0xa4, 0x65, 0xeb, 0x55, 0xe4, 0x07, 0x9c,
+	0xad, 0xbf, 0x4d, 0x46, 0x96, 0xe3, 0x76, 0xaf, 0x13, 0xed, 0xcd, 0x3e, 0x2c, 0xdb, 0xe9, 0xd2,
+	0xe2, 0x35, 0x84, 0xbd, 0xb0, 0x58, 0x89, 0x94, 0xff, 0x55, 0xcb, 0xe5, 0x7f, 0xfe, 0xbf, 0x75,
+	0x08, 0xee, 0x4c, 0x8d, 0x50, 0x68, 0x84, 0x39, 0x39, 0xce, 0xf0, 0x29, 0x9d, 0xdc, 0xc3, 0xfb,
+	0xf3, 0x53, 0x0a, 0x51, 0xa3, 0xff, 0x5e, 0x32, 0x92, 0x32, 0xa9, 0x87, 0x68, 0xc3, 0x65, 0xf9,
+	0x44, 0xe1, 0xb2, 0x90, 0x87, 0xf7, 0xe7, 0xf7, 0x64, 0x4b, 0xbe, 0xa0, 0x68, 0xf3, 0x7a, 0x20,
+	0xa8, 0xe2, 0x9d, 0xba, 0x43, 0xd3, 0x34, 0x68, 0xca, 0xbd, 0x41, 0xdd, 0xa9, 0x6f, 0x70, 0x30,
+	0xc8, 0x72, 0xff, 0xc7, 0x1d, 0x32, 0xa5, 0xee, 0x07, 0xf8, 0x42, 0x72, 0x6f, 0xea, 0x37, 0x09,
+	0x3e, 0x53, 0x9e, 0x1a, 0xb0, 0x6b, 0x8b, 0xbb, 0xd2, 0xee, 0x17, 0x8d, 0xb7, 0x92, 0xc9, 0x06,
+	0xed, 0xd2, 0xa8, 0x41, 0xa3, 0x7a, 0x48, 0xf9, 0x0c, 0x19, 0x5f, 0x9a, 0xc5, 0x27, 0xfd, 0x8a,
+	0x06, 0x07, 0x03, 0xcb, 0xff, 0x19, 0x87, 0x3c, 0xa1, 0xc8, 0xd5, 0x68, 0x06, 0x34, 0x4b, 0x76,
+	0x94, 0x71, 0xf2, 0xfe, 0x2e, 0x04, 0xb7, 0xf1, 0x89, 0x91, 0x25, 0x9c, 0xf9, 0xc1, 0x6e, 0x04,
+	0x13, 0xfc, 0x41, 0xc2, 0x88, 0x80, 0xa4, 0xe6, 0xff, 0x70, 0x95, 0x9c, 0xd0, 0x1b, 0xa9, 0x36,
+	0x98, 0xef, 0x75, 0x08, 0x51, 0x23, 0x80, 0x77, 0x9e, 0xaa, 0x1d, 0x1d, 0xa4, 0xf1, 0xa5, 0xf2,
+	0x2d, 0x48, 0x81, 0x53, 0xd0, 0xd8, 0xba, 0xef, 0x24, 0x93, 0x77, 0x70, 0x51, 0xd0, 0x1b, 0x78,
+	0x23, 0x4b, 0xbd, 0x2a, 0x6b, 0xc6, 0x7c, 0xd9, 0xc7, 0x7c, 0x39, 0xc7, 0xcb, 0x25, 0x2e, 0x1a,
+	0x30, 0x05, 0x83, 0x14, 0x3e, 0x26, 0xa7, 0x12, 0xfd, 0x93, 0x08, 0xdd, 0xc6, 0xbb, 0x2d, 0xf6,
+	0xb1, 0xf8, 0xd5, 0x97, 0x8e, 0x3d, 0xb8, 0x3f, 0x3f, 0x65, 0x80, 0xc0, 0x6c, 0x84, 0xff, 0x4e,
+	0xc2, 0xc6, 0x22, 0x8c, 0x7a, 0x74, 0x2d, 0x72, 0x9f, 0x96, 0x62, 0x50, 0xae, 0x1f, 0x53, 0x3b,
+	0x87, 0x2e, 0x0a, 0x45, 0x71, 0xc1, 0x56, 0x10, 0xb6, 0x99, 0xd1, 0x2e, 0x62, 0x29, 0x71, 0xc1,
+	0x65, 0x06, 0x05, 0x51, 0xea, 0x2f, 0x90, 0xd1, 0x65, 0xec, 0x3b, 0x4d, 0x90, 0xae, 0x6e, 0x6b,
+	0x3f, 0x65, 0xd8, 0xda, 0x4b, 0x9b, 0xfa, 0x0d, 0x72, 0x72, 0x39, 0xa1, 0x41, 0x46, 0x6b, 0x17,
+	0x97, 0x7a, 0xf5, 0x6d, 0x9a, 0x71, 0x83, 0xc6, 0xd4, 0xfd, 0x36, 0x32, 0x15, 0xb3, 0x23, 0xe3,
+	0x7a, 0x5c, 0xdf, 0x0e, 0xa3, 0xa6, 0x90, 0x6a, 0x9f, 0x14, 0x54, 0xa6, 0xd6, 0xf4, 0x42, 0x30,
+	0x71, 0xfd, 0x3f, 0xaa, 0x90, 0xc9, 0xe5, 0x24, 0x8e, 0xe4, 0xb6, 0x78, 0x04, 0x47, 0x59, 0x66,
+	0x1c, 0x65, 0x16, 0xd4, 0xd6, 0x7a, 0xfb, 0x07, 0x5e, 0x6f, 0x3e, 0xa4, 0xb6, 0xc8, 0xaa, 0xad,
+	0x57, 0x9e, 0xc1, 0x97, 0xd1, 0xce, 0x3f, 0xb6, 0xb9, 0x81, 0xfa, 0xff, 0xc5, 0x21, 0xb3, 0x3a,
+	0xfa, 0x11, 0x9c, 0xa0, 0xa9, 0x79, 0x82, 0xde, 0xb4, 0xdb, 0xdf, 0x01, 0xc7, 0xe6, 0x6b, 0xa3,
+	0x66, 0x3f, 0x99, 0xcd, 0xc2, 0x4f, 0x3a, 0x64, 0xf2, 0xae, 0x06, 0x10, 0x9d, 0xb5, 0x7d, 0x89,
+	0x79, 0xa3, 0xdc, 0x66, 0x74, 0xe8, 0xc3, 0xc2, 0x6f, 0x30, 0x5a, 0x82, 0xfb, 0x3e, 0x7a, 0x37,
+	0x35, 0x7a, 0x6d, 0x79, 0x7c, 0xab, 0x21, 0xad, 0x09, 0x38, 0x28, 0x0c, 0xf7, 0x3d, 0xe4, 0x58,
+	0x3d, 0x8e, 0xea, 0xbd, 0x24, 0xa1, 0x51, 0x7d, 0x67, 0x9d, 0xb9, 0x7b, 0x89, 0x03, 0x71, 0x41,
+	0x54, 0x3b, 0xb6, 0x5c, 0x44, 0x78, 0x58, 0x06, 0x84, 0x7e, 0x42, 0x5c, 0x1f, 0x93, 0xe2, 0x91,
+	0x25, 0xde, 0xb4, 0x9a, 0x3e, 0x86, 0x81, 0x41, 0x96, 0xbb, 0xb7, 0xc8, 0xe9, 0x34, 0x0b, 0x92,
+	0x2c, 0x8c, 0x9a, 0x2b, 0x34, 0x68, 0xb4, 0xc3, 0x08, 0x9f, 0x63, 0x71, 0xd4, 0xe0, 0x2a, 0xe1,
+	0xea, 0xd2, 0x93, 0x0f, 0xee, 0xcf, 0x9f, 0xae, 0x95, 0xa3, 0xc0, 0xa0, 0xba, 0xee, 0x7b, 0xc9,
+	0x9c, 0xd0, 0xf8, 0x6c, 0xf5, 0xda, 0x2f, 0xc6, 0x9b, 0xe9, 0x95, 0x30, 0x45, 0x51, 0xc9, 0xf5,
+	0xb0, 0x13, 0x66, 0x4c, 0xf1, 0x3b, 0xbc, 0x74, 0x16, 0x75, 0x90, 0xb5, 0x81, 0x58, 0xb0, 0x0b,
+	0x05, 0x17, 0xc8, 0x29, 0xbe, 0xf9, 0xf5, 0xd1, 0x1e, 0x65, 0xb4, 0xe7, 0x1e, 0xdc, 0x9f, 0x3f,
+	0x75, 0xb9, 0x14, 0x03, 0x06, 0xd4, 0xc4, 0x2f, 0x98, 0x85, 0x1d, 0xfa, 0x0a, 0x3a, 0xfc, 0x8c,
+	0x99, 0x5f, 0x70, 0x43, 0xc0, 0x41, 0x61, 0xb8, 0xef, 0xcf, 0x67, 0x22, 0x2e, 0x17, 0x6f, 0xfc,
+	0x80, 0x3b, 0x1c, 0x7b, 0x9a, 0xdc, 0xd6, 0x28, 0xb1, 0xe7, 0x9b, 0x41, 0xdb, 0xfd, 0x3e, 0x87,
+	0x4c, 0xa6, 0x59, 0xac, 0xbc, 0x79, 0x3c, 0x62, 0x6b, 0xda, 0xd7, 0x34, 0xaa, 0xfc, 0xe2, 0xa3,
+	0x43, 0xc0, 0xe0, 0xea, 0x7e, 0x23, 0x19, 0x97, 0x13, 0x38, 0xf5, 0x26, 0xd8, 0x5d, 0x89, 0x3d,
+	0x85, 0xe5, 0xfc, 0x46, 0x33, 0x66, 0xf9, 0x2f, 0x5e, 0x65, 0xef, 0xb6, 0x68, 0xe4, 0x4d, 0x9a,
+	0x57, 0xd9, 0xdb, 0x2d, 0x1a, 0x01, 0x2b, 0xf1, 0xff, 0xd9, 0x30, 0x71, 0xfb, 0x37, 0x3e, 0xf7,
+	0x1a, 0x19, 0x09, 0xea, 0x19, 0x5a, 0xfc, 0x73, 0x85, 0xd3, 0xd3, 0x65, 0x97, 0x02, 0x3e, 0x80,
+	0x40, 0xb7, 0x28, 0xce, 0x7b, 0x9a, 0xef, 0x96, 0x8b, 0xac, 0x2a, 0x08, 0x12, 0x6e, 0x4c, 0x8e,
+	0xb5, 0x83, 0x34, 0x93, 0x2d, 0x6c, 0xe0, 0x87, 0x14, 0xc7, 0xc5, 0x37, 0xec, 0xed, 0x53, 0x61,
+	0x8d, 0xa5, 0x93, 0xb8, 0x1e, 0xaf, 0x17, 0x09, 0x41, 0x3f, 0x6d, 0xf4, 0xa5, 0xaa, 0xcb, 0xab,
+	0xaf, 0xbc, 0xd6, 0x5c, 0xb3, 0x72, 0xf3, 0xe0, 0x34, 0x8d, 0x9b, 0x95, 0x60, 0x03, 0x1a, 0x4b,
+	0x66, 0x6f, 0x8e, 0xeb, 0x86, 0x36, 0x28, 0x5f, 0xfd, 0xba, 0xbd, 0xb9, 0x2c, 0x80, 0x1c, 0x47,
+	0xbb, 0x65, 0xf0, 0x05, 0x3f, 0xe0, 0x96, 0xe1, 0xbe, 0x8d, 0x0c, 0x77, 0x5b, 0x41, 0x2a, 0x3d,
+	0x37, 0xe4, 0x9b, 0x7e, 0x78, 0x1d, 0x81, 0x6c, 0x6b, 0xd2, 0xbe, 0x25, 0x03, 0x02, 0xaf, 0xe0,
+	0x26, 0xc4, 0x65, 0x03, 0xa5, 0x96, 0x33, 0xfb, 0x0a, 0xa3, 0xfb, 0xfe, 0x0a, 0x4c, 0xa1, 0x7d,
+	0xbd, 0x8f, 0x12, 0x94, 0x50, 0x77, 0x6f, 0x90, 0xe3, 0xf5, 0x38, 0x4a, 0x69, 0xbd, 0x87, 0xf3,
+	0x00, 0xbb, 0xd2, 0x4b, 0x28, 0x37, 0x24, 0xac, 0x2e, 0x3d, 0x29, 0x9d, 0xab, 0x96, 0xfb, 0x51,
+	0xa0, 0xac, 0x9e, 0xff, 0x47, 0x55, 0x32, 0xba, 0xb2, 0xb8, 0x7a, 0x25, 0x8e, 0xb7, 0xf7, 0xf0,
+	0x8c, 0xc3, 0x9d, 0x44, 0xdc, 0xb7, 0x8b, 0x67, 0x81, 0xbc, 0x87, 0x83, 0xc2, 0x70, 0x3f, 0x84,
+	0x36, 0x6f, 0xc2, 0x17, 0x4f, 0x5c, 0x29, 0xae, 0xd9, 0x50, 0x7b, 0x08, 0x92, 0xba, 0x75, 0x9b,
+	0x00, 0x41, 0xce, 0xd0, 0xfd, 0x1e, 0x87, 0x4c, 0xc8, 0xa6, 0xa0, 0x65, 0xc6, 0x90, 0x35, 0xaf,
+	0xca, 0x9c, 0x28, 0x37, 0x7d, 0xd2, 0x00, 0xa0, 0xb3, 0xc4, 0x4b, 0x6b, 0x16, 0xa4, 0xdb, 0xfc,
+	0xc4, 0xd1, 0x2e, 0xad, 0x1b, 0x08, 0x04, 0x5e, 0xe6, 0x9e, 0x27, 0x23, 0x6c, 0x36, 0x71, 0xad,
+	0xe7, 0xf8, 0xd2, 0x69, 0x9c, 0xa2, 0x6c, 0x9a, 0xa5, 0x0f, 0x85, 0x56, 0x92, 0xfd, 0x02, 0x81,
+	0x86, 0xee, 0x46, 0x34, 0x77, 0x96, 0x19, 0x35, 0xdd, 0x8d, 0x34, 0x47, 0x19, 0x0d, 0xcb, 0xff,
+	0x7d, 0x87, 0x8c, 0xad, 0x2c, 0xae, 0xae, 0x45, 0x74, 0x6d, 0x6b, 0x0f, 0xdf, 0xd9, 0x64, 0x51,
+	0xd9, 0x0b, 0x0b, 0xf7, 0x23, 0x64, 0x6c, 0x33, 0x09, 0xa2, 0x7a, 0x8b, 0xca, 0xed, 0xc1, 0x82,
+	0x96, 0x5f, 0xb6, 0x79, 0x89, 0x51, 0xce, 0x67, 0xdb, 0x92, 0xe0, 0x04, 0x8a, 0xa7, 0xff, 0x31,
+	0x87, 0x4c, 0x9b, 0xe8, 0xd8, 0x51, 0x1c, 0xe3, 0x62, 0x47, 0x71, 0xf8, 0x81, 0x95, 0xb8, 0x3e,
+	0x19, 0x61, 0x4f, 0x07, 0xf9, 0x44, 0x66, 0x52, 0x68, 0xf6, 0xa6, 0x48, 0x41, 0x94, 0xec, 0xc3,
+	0x18, 0xc6, 0xff, 0x0f, 0x84, 0xad, 0x26, 0x64, 0x60, 0x7d, 0x35, 0x45, 0x64, 0x24, 0x8c, 0xf0,
+	0x2a, 0xe2, 0x4d, 0xdb, 0x12, 0xb3, 0x2a, 0x01, 0x0f, 0xeb, 0xf6, 0x55, 0x46, 0x1d, 0x04, 0x97,
+	0xff, 0xb7, 0x7a, 0x8b, 0x42, 0x94, 0xe1, 0xbd, 0x08, 0x51, 0xdc, 0xbb, 0x64, 0xfc, 0x6e, 0x98,
+	0xb5, 0xd8, 0x95, 0x5f, 0xd8, 0x31, 0x5c, 0x7e, 0xfc, 0x56, 0x23, 0xb9, 0x7c, 0xc4, 0x6e, 0x4b,
+	0x06, 0x90, 0xf3, 0xc2, 0xf3, 0x11, 0x7f, 0x30, 0x4f, 0x64, 0xb1, 0x2b, 0x18, 0x15, 0x58, 0x01,
+	0xe4, 0x38, 0x38, 0xc4, 0x93, 0xf8, 0xab, 0x46, 0x3f, 0xd0, 0xc3, 0xbb, 0x86, 0x37, 0x66, 0x6b,
+	0x5e, 0x49, 0x8a, 0x7c, 0xb0, 0x6e, 0x6b, 0x3c, 0xc0, 0xe0, 0xa8, 0xee, 0x52, 0xe3, 0x83, 0xee,
+	0x52, 0xe8, 0xdd, 0x57, 0x57, 0xd2, 0x05, 0x8f, 0xd8, 0x72, 0xe8, 0xc8, 0x25, 0x16, 0xdc, 0xbb,
+	0x2f, 0xff, 0x0d, 0x1a, 0x3f, 0xbc, 0x42, 0xc4, 0xd1, 0xa5, 0x7b, 0x61, 0x26, 0x7c, 0x12, 0xd5,
+	0x15, 0x62, 0x8d, 0x41, 0x41, 0x94, 0xf2, 0x2d, 0x02, 0x27, 0x41, 0x2a, 0xae, 0x85, 0xda, 0x16,
+	0xc1, 0xc0, 0x20, 0xcb, 0xdd, 0x7f, 0xe0, 0x90, 0xe1, 0x56, 0x1c, 0x6f, 0xa7, 0xde, 0xd4, 0xb9,
+	0xaa, 0x9d, 0x47, 0xb6, 0xd8, 0x71, 0x16, 0xf0, 0x10, 0x4f, 0x4d, 0x2f, 0xeb, 0x61, 0x06, 0x7b,
+	0x78, 0x7f, 0x7e, 0xfa, 0x7a, 0xb8, 0x45, 0xeb, 0x3b, 0xf5, 0x36, 0x65, 0x90, 0x8f, 0xbf, 0xa6,
+	0x41, 0x2e, 0xdd, 0xa1, 0x51, 0x06, 0xbc, 0x55, 0x73, 0x9f, 0x72, 0x08, 0xc9, 0x09, 0x95, 0x18,
+	0xa6, 0x50, 0xd3, 0x94, 0xcb, 0x82, 0x84, 0xcd, 0x68, 0x9a, 0x6e, 0xe9, 0xf2, 0xcb, 0x55, 0x32,
+	0x81, 0x9d, 0x93, 0x5b, 0xe0, 0xb3, 0x64, 0x24, 0x0b, 0x92, 0x26, 0xcd, 0x8a, 0x81, 0x30, 0x36,
+	0x18, 0x14, 0x44, 0xa9, 0x1b, 0xc9, 0x73, 0x97, 0xbf, 0xeb, 0xaf, 0x5a, 0x1b, 0xe2, 0x01, 0x47,
+	0xf8, 0x73, 0x64, 0x0c, 0xef, 0x92, 0x97, 0x83, 0x54, 0x1e, 0x11, 0x93, 0xb8, 0x89, 0x5f, 0x16,
+	0x30, 0x50, 0xa5, 0xd8, 0x32, 0xfe, 0xf1, 0x87, 0x2c, 0xb6, 0x0c, 0x87, 0x2d, 0x6f, 0x19, 0xfe,
+	0x4a, 0xc5, 0xd7, 0x74, 0x63, 0x32, 0x1c, 0xe3, 0x81, 0xc8, 0x36, 0x2f, 0x2b, 0x6b, 0x5b, 0x1d,
+	0xb1, 0x8a, 0x21, 0xfb, 0x09, 0x9c, 0x0f, 0x2a, 0xd6, 0x87, 0x56, 0xb8, 0x08, 0x6b, 0x84, 0xc7,
+	0x6c, 0xf1, 0x1c, 0x5b, 0x8b, 0x16, 0xe9, 0xd6, 0x18, 0x4d, 0x4d, 0x88, 0xc4, 0x7e, 0x83, 0xe0,
+	0x85, 0x32, 0xd2, 0xe9, 0x2c, 0x09, 0xa2, 0x74, 0x8b, 0xe9, 0xf9, 0xf9, 0xed, 0xc5, 0xd2, 0x32,
+	0xdb, 0x30, 0xe8, 0xd6, 0x32, 0xda, 0xcd, 0xcd, 0x0d, 0xcc, 0x32, 0x28, 0xb4, 0xc1, 0xff, 0x09,
+	0x87, 0x90, 0xbc, 0xf5, 0xe8, 0x5f, 0x35, 0x15, 0xe8, 0xde, 0x0e, 0x9e, 0x63, 0x6b, 0x2d, 0x19,
+	0x4e, 0x14, 0x5c, 0x7a, 0x6b, 0x80, 0xc0, 0x64, 0xec, 0xff, 0x72, 0x85, 0x0c, 0xb3, 0xf5, 0xcf,
+	0xe4, 0x3c, 0x42, 0xdd, 0x57, 0x94, 0xef, 0x4b, 0x35, 0x20, 0x28, 0x0c, 0xf7, 0x55, 0x87, 0x4c,
+	0x84, 0x0d, 0xda, 0xe9, 0xc6, 0x19, 0xca, 0x67, 0xec, 0x49, 0x2a, 0x59, 0x63, 0xae, 0xe6, 0x94,
+	0xf9, 0x21, 0xad, 0x01, 0x40, 0xe7, 0xeb, 0x7e, 0x80, 0x8c, 0xf0, 0xd8, 0x3b, 0xf6, 0xbc, 0xf0,
+	0x58, 0x0b, 0x6a, 0x8c, 0x28, 0xbf, 0x18, 0xf1, 0xff, 0x41, 0x30, 0xf2, 0x5f, 0x75, 0xc8, 0x6c,
+	0xb1, 0x95, 0x52, 0x7d, 0xe5, 0x0c, 0x30, 0x5f, 0x07, 0x32, 0x72, 0x37, 0x8c, 0x1a, 0xf1, 0x5d,
+	0xaf, 0xb2, 0x1f, 0x29, 0xa6, 0x54, 0xac, 0xf0, 0x76, 0xdc, 0x66, 0x14, 0x40, 0x50, 0xf2, 0xff,
+	0xc8, 0x21, 0x13, 0x5a, 0x5b, 0xdd, 0xb6, 0xba, 0x20, 0xf2, 0xd9, 0x74, 0xc5, 0x82, 0xcf, 0x03,
+	0x93, 0x46, 0x94, 0x5e, 0x0f, 0x9b, 0x64, 0xa6, 0xae, 0xd9, 0x10, 0xe0, 0x1d, 0xad, 0xb2, 0x4f,
+	0x73, 0x03, 0xae, 0x54, 0x36, 0x89, 0x40, 0x91, 0xaa, 0xff, 0x13, 0x15, 0x32, 0x7d, 0xe9, 0x1e,
+	0xbe, 0x5b, 0xe3, 0x84, 0x23, 0x0f, 0xf0, 0xa4, 0x76, 0x0e, 0xe2, 0x49, 0x8d, 0x06, 0x13, 0x32,
+	0xba, 0x54, 0xba, 0x5b, 0x0f, 0x40, 0x20, 0x01, 0xfd, 0x40, 0x2f, 0x4c, 0x28, 0xbf, 0xc3, 0x32,
+	0x29, 0x91, 0x2c, 0x49, 0x21, 0xa7, 0xe4, 0x6e, 0xb2, 0xd0, 0x47, 0xbd, 0x24, 0xcc, 0x76, 0xf0,
+	0x6e, 0x41, 0xef, 0x49, 0x4b, 0x9f, 0xa7, 0x07, 0x28, 0xdc, 0x75, 0x54, 0x15, 0xf8, 0x48, 0x07,
+	0x42, 0x91, 0xa0, 0xff, 0xf3, 0x0e, 0x99, 0xd0, 0x3c, 0x44, 0xf0, 0xc6, 0xde, 0x5c, 0xae, 0x71,
+	0xcd, 0x87, 0xe7, 0xd8, 0xba, 0xb1, 0xaf, 0x4a, 0x92, 0xf9, 0x75, 0x52, 0x81, 0x20, 0x67, 0xf8,
+	0x08, 0x07, 0x0e, 0xff, 0x37, 0x1c, 0x72, 0xb2, 0xd4, 0x9d, 0xe5, 0x75, 0x6e, 0xb6, 0x61, 0xe0,
+	0x58, 0xd9, 0x83, 0x81, 0xe3, 0x97, 0x1c, 0x92, 0x53, 0xc2, 0x2b, 0xc9, 0x66, 0xde, 0x72, 0xed,
+	0x4a, 0x22, 0x38, 0x89, 0x52, 0xf7, 0x43, 0xe4, 0xb4, 0x39, 0xf9, 0x0e, 0x68, 0x88, 0xc1, 0xa5,
+	0xd6, 0xe5, 0x94, 0x60, 0x10, 0x0b, 0xbf, 0x46, 0xc8, 0xea, 0xfa, 0x2d, 0x9c, 0xba, 0x34, 0xcd,
+	0x50, 0x2c, 0xc1, 0xca, 0x59, 0x93, 0x87, 0xf3, 0x83, 0x9c, 0xe9, 0xda, 0x80, 0x97, 0x3d, 0x5a,
+	0x63, 0xcf, 0x8e, 0x8e, 0xd5, 0xa0, 0xd7, 0xa4, 0x7b, 0x52, 0xce, 0xe1, 0x25, 0x29, 0xa1, 0x41,
+	0x3b, 0x93, 0x82, 0x4a, 0x71, 0x49, 0x02, 0x01, 0x03, 0x55, 0xea, 0x2e, 0x92, 0xf1, 0xb8, 0x4b,
+	0x0d, 0xa3, 0xaf, 0xa7, 0xe5, 0x27, 0x59, 0x93, 0x05, 0x78, 0xa7, 0x65, 0xdc, 0x15, 0x04, 0xf2,
+	0x5a, 0xee, 0x55, 0x52, 0xcd, 0xb2, 0xb6, 0x37, 0x74, 0xa0, 0xcd, 0x96, 0x87, 0xca, 0xda, 0xb8,
+	0x0e, 0x48, 0x03, 0x37, 0x1b, 0x6e, 0xa4, 0xbe, 0x16, 0x2d, 0xc7, 0x9d, 0x6e, 0x9b, 0xaa, 0xc8,
+	0x33, 0x63, 0xf9, 0x66, 0xb3, 0xd2, 0x87, 0x01, 0x25, 0xb5, 0xfc, 0xcf, 0x8f, 0x90, 0x09, 0xcd,
+	0xc9, 0x1b, 0x07, 0x39, 0xa1, 0xdd, 0xb8, 0x28, 0x23, 0xc0, 0xc5, 0x01, 0xac, 0x04, 0x4f, 0xe5,
+	0x84, 0xde, 0x09, 0x35, 0x39, 0x8c, 0x3a, 0x95, 0x41, 0xc0, 0x41, 0x61, 0xa0, 0x13, 0x4a, 0x83,
+	0x76, 0xb3, 0x16, 0x1b, 0xb5, 0x21, 0xee, 0x84, 0xb2, 0x82, 0x00, 0xe0, 0x70, 0x44, 0xd8, 0xa2,
+	0x59, 0xbd, 0xc5, 0xee, 0x9f, 0xc2, 0x4b, 0xe5, 0x32, 0x02, 0x80, 0xc3, 0x4b, 0xcc, 0xe1, 0x86,
+	0x0f, 0xdf, 0x1c, 0x6e, 0xc4, 0xb2, 0x39, 0x9c, 0xdb, 0x25, 0xc7, 0xd3, 0xb4, 0xb5, 0x9e, 0x84,
+	0x77, 0x82, 0x8c, 0xe6, 0x2b, 0x6d, 0x74, 0x3f, 0x7c, 0x4e, 0xb3, 0x20, 0x55, 0xb5, 0x2b, 0x45,
+	0x2a, 0x50, 0x46, 0xda, 0xad, 0x91, 0x93, 0x21, 0x93, 0xae, 0x26, 0xf4, 0x6a, 0x33, 0x8a, 0x13,
+	0x7a, 0x25, 0x4e, 0x91, 0x9c, 0x08, 0xb1, 0xa3, 0xfc, 0xb6, 0xae, 0x96, 0x21, 0x41, 0x79, 0x5d,
+	0x77, 0x95, 0x1c, 0x6b, 0x84, 0x69, 0xb0, 0xd9, 0xa6, 0xb5, 0xde, 0x66, 0x27, 0xe6, 0xfa, 0x89,
+	0x71, 0x46, 0xf0, 0x09, 0xa9, 0x4c, 0x5b, 0x29, 0x22, 0x40, 0x7f, 0x1d, 0x74, 0xf3, 0x48, 0xc3,
+	0xa8, 0xd9, 0xa6, 0x5c, 0x30, 0x26, 0x62, 0xf3, 0x28, 0xa3, 0x83, 0x9a, 0x56, 0x06, 0x06, 0x26,
+	0xdb, 0xdf, 0x78, 0x9d, 0xc2, 0x0b, 0x58, 0x60, 0x8b, 0x52, 0x77, 0x91, 0xcc, 0xc8, 0x3e, 0xd4,
+	0xb6, 0xc3, 0xee, 0xc6, 0xf5, 0x1a, 0x7b, 0x09, 0x8f, 0xe5, 0x56, 0xe9, 0x57, 0xcd, 0x62, 0x28,
+	0xe2, 0xfb, 0x5f, 0x75, 0xc8, 0xa4, 0xee, 0xdb, 0x89, 0x02, 0x0a, 0xd2, 0x5a, 0xb9, 0x5c, 0xe3,
+	0xa7, 0xbe, 0xbd, 0x77, 0xc4, 0x15, 0x45, 0x33, 0x17, 0x6a, 0xe6, 0x30, 0xd0, 0x78, 0xee, 0x21,
+	0xae, 0xd5, 0xd3, 0x64, 0x78, 0x2b, 0xc6, 0x67, 0x4e, 0xd5, 0x34, 0x78, 0xb8, 0x8c, 0x40, 0xe0,
+	0x65, 0xfe, 0x7f, 0x77, 0xc8, 0xa9, 0x72, 0xb7, 0xd5, 0xaf, 0x85, 0x4e, 0x5e, 0xc0, 0x30, 0x79,
+	0x59, 0xcb, 0x38, 0x03, 0xb5, 0xc8, 0x76, 0xb2, 0x04, 0x34, 0xac, 0xbd, 0x75, 0xfb, 0x3f, 0x56,
+	0x88, 0xc6, 0xd3, 0xfd, 0xb4, 0x43, 0xa6, 0x90, 0xed, 0xb5, 0x64, 0xd3, 0xe8, 0xed, 0x9a, 0x9d,
+	0xde, 0x2a, 0xb2, 0xb9, 0x5d, 0x87, 0x01, 0x06, 0x93, 0x39, 0x6a, 0xfd, 0x82, 0x46, 0x23, 0xa1,
+	0x69, 0xaa, 0xc4, 0xbf, 0xec, 0x3e, 0xb7, 0x28, 0x81, 0x90, 0x97, 0xe3, 0x3e, 0x8c, 0x5e, 0xc5,
+	0xb8, 0xb5, 0x79, 0x55, 0x73, 0x1f, 0x46, 0x26, 0x08, 0x07, 0x85, 0xe1, 0xbe, 0x4c, 0x4e, 0xa1,
+	0xb6, 0x93, 0xbf, 0x0a, 0x69, 0xb2, 0x9e, 0xc4, 0x19, 0xad, 0xb3, 0x73, 0x83, 0x1b, 0x25, 0x9f,
+	0x15, 0x75, 0x4f, 0xad, 0x94, 0x62, 0xc1, 0x80, 0xda, 0xfe, 0x0f, 0x0d, 0x11, 0xb3, 0x4f, 0x68,
+	0xd8, 0xb9, 0x9d, 0x6c, 0x2e, 0x33, 0xe3, 0xdf, 0x83, 0x18, 0x90, 0xb2, 0x9b, 0xe6, 0x35, 0x93,
+	0x02, 0x14, 0x49, 0x0a, 0x2e, 0xd7, 0xe8, 0x4e, 0x16, 0x6c, 0x1e, 0xd8, 0x7c, 0xf4, 0x9a, 0x49,
+	0x01, 0x8a, 0x24, 0xd1, 0xdc, 0x7b, 0x3b, 0xd9, 0x94, 0xa7, 0x47, 0xd1, 0xdc, 0xfb, 0x5a, 0x5e,
+	0x04, 0x3a, 0x1e, 0x7e, 0x9a, 0xed, 0x64, 0x13, 0xef, 0x11, 0x32, 0x7e, 0x9c, 0xfa, 0x34, 0xd7,
+	0x04, 0x1c, 0x14, 0x86, 0xdb, 0x25, 0xee, 0xb6, 0x1c, 0x3d, 0xf5, 0xf6, 0xf0, 0x86, 0x07, 0x5f,
+	0xfc, 0x4b, 0x9f, 0x2e, 0x4c, 0x63, 0x77, 0xad, 0x8f, 0x0e, 0x94, 0xd0, 0x76, 0xdf, 0x49, 0x4e,
+	0x6f, 0x27, 0x9b, 0xe2, 0xce, 0xb6, 0x9e, 0x84, 0x51, 0x3d, 0xec, 0x1a, 0xb1, 0xe2, 0xe6, 0x45,
+	0x73, 0x4f, 0x5f, 0x2b, 0x47, 0x83, 0x41, 0xf5, 0xfd, 0x2f, 0x0e, 0x13, 0x16, 0xb7, 0x05, 0xb7,
+	0xe9, 0x0e, 0xcd, 0x5a, 0x71, 0xa3, 0x78, 0x0d, 0xbd, 0xc1, 0xa0, 0x20, 0x4a, 0xa5, 0xa3, 0x55,
+	0x65, 0x80, 0xa3, 0xd5, 0x5d, 0x32, 0xda, 0xa2, 0x41, 0x83, 0x26, 0x52, 0x85, 0x73, 0xdd, 0x4e,
+	0xa4, 0x99, 0x2b, 0x8c, 0x68, 0x2e, 0x15, 0xe5, 0xbf, 0x53, 0x90, 0xdc, 0xdc, 0x6f, 0x25, 0xd3,
+	0x78, 0xf5, 0x8b, 0x7b, 0x99, 0x34, 0xd2, 0xe0, 0x1a, 0x5e, 0x76, 0xd8, 0x6f, 0x18, 0x25, 0x50,
+	0xc0, 0x74, 0x57, 0xc8, 0xac, 0x30, 0xa8, 0x50, 0x9a, 0x63, 0x31, 0xb0, 0x2a, 0x88, 0x5f, 0xad,
+	0x50, 0x0e, 0x7d, 0x35, 0x98, 0xa3, 0x4c, 0xdc, 0xd8, 0xf1, 0x86, 0xcd, 0x9d, 0x7e, 0x29, 0x6e,
+	0xec, 0x00, 0x2b, 0x71, 0x5f, 0x21, 0x63, 0xf8, 0x17, 0xc3, 0xd1, 0x09, 0x51, 0xf9, 0xba, 0x9d,
+	0xd1, 0x41, 0x1e, 0x42, 0xae, 0xc5, 0xae, 0xc4, 0x4b, 0x82, 0x0b, 0x28, 0x7e, 0x78, 0x09, 0xd5,
+	0x8f, 0xcb, 0x97, 0x69, 0x12, 0x6e, 0xed, 0x78, 0xa3, 0xe6, 0x25, 0xf4, 0x6a, 0x1f, 0x06, 0x94,
+	0xd4, 0x42, 0x3b, 0xe9, 0xa0, 0x27, 0xe2, 0xf9, 0x59, 0x11, 0xd9, 0x60, 0x1f, 0xa4, 0x8c, 0x96,
+	0x79, 0xa2, 0xb1, 0x50, 0x0c, 0xf8, 0x1f, 0x30, 0x4e, 0xfe, 0xa7, 0x2b, 0x64, 0x52, 0x0f, 0x38,
+	0xf4, 0x28, 0x7f, 0xbf, 0x34, 0x9f, 0x86, 0x5c, 0x7a, 0x67, 0x41, 0x94, 0xf1, 0xc8, 0x29, 0xd8,
+	0x12, 0xc3, 0x52, 0xb5, 0xa5, 0x05, 0x61, 0x3d, 0x2e, 0x1b, 0x8e, 0x57, 0xab, 0x64, 0x4c, 0x16,
+	0xa2, 0x09, 0x0c, 0xc9, 0xcd, 0xf5, 0x3d, 0xc7, 0xd6, 0xc4, 0x32, 0x3d, 0x0d, 0x34, 0xeb, 0x0a,
+	0x05, 0x07, 0x8d, 0x2f, 0x8a, 0x6b, 0x63, 0x6c, 0xdc, 0x05, 0x7b, 0x41, 0xb3, 0xd6, 0x90, 0xf1,
+	0x05, 0xc6, 0x3d, 0xd7, 0x9b, 0x30, 0x18, 0x08, 0x5e, 0xf8, 0xf4, 0xdf, 0x94, 0x9e, 0x38, 0xf6,
+	0x74, 0x8c, 0xca, 0xb9, 0x27, 0x7f, 0xc9, 0x2b, 0x10, 0xe4, 0x0c, 0xfd, 0x17, 0xc8, 0xb4, 0xb9,
+	0xfc, 0xf0, 0x79, 0xb4, 0xc9, 0xe2, 0x19, 0xe2, 0x67, 0x98, 0xe4, 0xcf, 0x23, 0x1e, 0xc7, 0x90,
+	0xc3, 0xd1, 0x07, 0x90, 0xe4, 0x1b, 0xda, 0x1e, 0x74, 0xbc, 0x4f, 0xeb, 0xda, 0x92, 0x41, 0x4f,
+	0xe3, 0x8f, 0x92, 0x71, 0xf6, 0x0f, 0xdb, 0x5a, 0xaa, 0x36, 0x97, 0x25, 0x6f, 0xa7, 0xd8, 0x5c,
+	0xd8, 0xed, 0xe6, 0x65, 0xc9, 0x08, 0x72, 0x9e, 0x7e, 0x4c, 0x66, 0x8b, 0xd8, 0xee, 0xbb, 0xc9,
+	0xa4, 0x8a, 0xb4, 0x9d, 0x47, 0xb6, 0xd8, 0xe3, 0x81, 0xcf, 0x2d, 0xae, 0xb4, 0xea, 0x60, 0x10,
+	0xf3, 0xff, 0xda, 0x21, 0xb3, 0xc5, 0x6d, 0xc3, 0xed, 0xaa, 0x39, 0xe8, 0x1c, 0xc2, 0x1c, 0x24,
+	0x25, 0xf3, 0x0f, 0x3d, 0xa6, 0xef, 0xa6, 0x2c, 0xd2, 0xba, 0x3d, 0xb7, 0x11, 0x3d, 0x76, 0x3b,
+	0xdf, 0xd0, 0x25, 0x04, 0x14, 0x37, 0x7f, 0x8d, 0x8c, 0x58, 0x9d, 0x43, 0xfe, 0xcf, 0x3a, 0x64,
+	0x9c, 0x59, 0xfd, 0x35, 0x51, 0xb7, 0xab, 0xaa, 0x54, 0x77, 0x99, 0x76, 0x29, 0x19, 0xe5, 0xd2,
+	0x29, 0xa9, 0x8e, 0xb2, 0xb0, 0xcd, 0xf2, 0xd0, 0xe8, 0xf9, 0x36, 0xcb, 0xc5, 0x60, 0x29, 0x48,
+	0x4e, 0xfe, 0x27, 0x2a, 0x64, 0xe4, 0x6a, 0xd4, 0xed, 0xfd, 0x9d, 0x0f, 0xcf, 0x7d, 0x83, 0x0c,
+	0xa1, 0xe2, 0xde, 0x8c, 0x22, 0x3f, 0xb9, 0xf4, 0x8c, 0x1e, 0x41, 0xde, 0x33, 0x23, 0xc8, 0x43,
+	0x70, 0x57, 0x3a, 0x93, 0x08, 0x2d, 0x69, 0x1e, 0xde, 0xe4, 0x0b, 0x15, 0x32, 0xf1, 0x62, 0xbc,
+	0xa9, 0x74, 0xa5, 0xdf, 0x48, 0x86, 0xb6, 0xc3, 0x48, 0xde, 0x07, 0xe5, 0x2b, 0x7c, 0xe8, 0x5a,
+	0x18, 0x35, 0x1e, 0xde, 0x9f, 0x1f, 0x7d, 0x31, 0xde, 0xc4, 0x7f, 0x81, 0x21, 0x19, 0x4e, 0x7c,
+	0x95, 0x23, 0x75, 0xe2, 0x7b, 0xbf, 0xb0, 0x74, 0xaf, 0x5a, 0x56, 0x59, 0x94, 0xb9, 0x6b, 0xbd,
+	0x89, 0x8c, 0x5f, 0x0f, 0x36, 0x69, 0xfb, 0x1a, 0xdd, 0x61, 0xf1, 0x5a, 0xb8, 0xed, 0xb7, 0x93,
+	0x4b, 0xc2, 0x0c, 0x3b, 0xed, 0x15, 0x32, 0xcd, 0xb0, 0xd5, 0x86, 0x59, 0xb0, 0x8a, 0x72, 0xf6,
+	0x64, 0x78, 0xb5, 0x40, 0x26, 0x72, 0x2a, 0x7b, 0xe0, 0xfa, 0xe7, 0x15, 0x32, 0x65, 0xe8, 0xc3,
+	0x0d, 0x2b, 0x21, 0x67, 0x7f, 0x36, 0x77, 0x95, 0xd7, 0xdb, 0x6a, 0xa7, 0x7a, 0xf4, 0x56, 0x3b,
+	0xe6, 0x47, 0x1a, 0xda, 0xd3, 0x47, 0xfa, 0xac, 0x43, 0x86, 0xae, 0x87, 0xd1, 0xf6, 0xde, 0xf6,
+	0xe2, 0xb4, 0x1e, 0x77, 0xfb, 0xf6, 0xe2, 0x1a, 0x02, 0x81, 0x97, 0xc9, 0xeb, 0x6d, 0x75, 0xc0,
+	0xf5, 0x36, 0x37, 0x63, 0x18, 0xda, 0xcd, 0x8c, 0xc1, 0x47, 0xeb, 0xe8, 0x1b, 0x41, 0x14, 0x6e,
+	0xd1, 0x34, 0x63, 0x13, 0x30, 0x3b, 0xd4, 0x00, 0x1f, 0x93, 0x03, 0xe2, 0xe1, 0x7d, 0xdc, 0x21,
+	0xc7, 0x6e, 0xd0, 0x4e, 0x1c, 0xbe, 0x12, 0xe4, 0x7e, 0x6f, 0xd8, 0xc7, 0x56, 0x98, 0x09, 0x37,
+	0x1f, 0xd5, 0xc7, 0x2b, 0x18, 0xb0, 0xb4, 0x15, 0x3e, 0x4a, 0x1b, 0xc4, 0x5c, 0xe7, 0x51, 0xbe,
+	0xa0, 0x05, 0x9d, 0xc9, 0x3d, 0xda, 0x64, 0x01, 0xe4, 0x38, 0xfe, 0xaf, 0x38, 0x64, 0x94, 0x37,
+	0x82, 0x3e, 0x4a, 0xd7, 0xda, 0x22, 0xc3, 0xac, 0x9e, 0x98, 0xfe, 0xab, 0x16, 0xee, 0xd2, 0x48,
+	0x8e, 0x2f, 0x56, 0xf6, 0x2f, 0x70, 0x06, 0xec, 0xd5, 0x1d, 0xdc, 0x5b, 0x54, 0x2e, 0x7f, 0xf9,
+	0xab, 0x9b, 0x41, 0x41, 0x94, 0xfa, 0x9f, 0xaf, 0x12, 0xb5, 0xf7, 0xf1, 0x20, 0x7d, 0x51, 0x14,
+	0x67, 0x01, 0x37, 0xa5, 0xe6, 0xe7, 0xde, 0xbb, 0xed, 0xed, 0xb7, 0x0b, 0x8b, 0x39, 0x75, 0x6e,
+	0x0d, 0xa4, 0x64, 0x28, 0x5a, 0x09, 0xe8, 0x8d, 0x70, 0x3f, 0x42, 0x46, 0xda, 0xb8, 0x4d, 0xc9,
+	0x63, 0xf0, 0x65, 0x8b, 0xcd, 0x61, 0xfb, 0x9f, 0x68, 0x89, 0x1a, 0x21, 0x0e, 0x04, 0xc1, 0x75,
+	0xee, 0xed, 0x64, 0xb6, 0xd8, 0xea, 0x47, 0xc5, 0xc4, 0x19, 0xd7, 0x23, 0xea, 0x7c, 0x8b, 0xd8,
+	0x66, 0xf7, 0x5f, 0xd5, 0x7f, 0x89, 0x4c, 0xdc, 0xa0, 0x59, 0x12, 0xd6, 0x19, 0x81, 0x47, 0x4d,
+	0xae, 0x3d, 0xdd, 0xc5, 0x3e, 0xc9, 0x26, 0x2b, 0xd2, 0x4c, 0xd1, 0x80, 0xad, 0x9b, 0xc4, 0x28,
+	0x7e, 0xa1, 0x3d, 0xf9, 0xb1, 0x2d, 0x5c, 0x6c, 0xd7, 0x15, 0x4d, 0x6e, 0xc0, 0x96, 0xff, 0x06,
+	0x8d, 0x9f, 0xff, 0xfd, 0x0e, 0x19, 0xbe, 0xd1, 0xcb, 0xe8, 0xbd, 0x3d, 0x6c, 0x6d, 0xfb, 0x8e,
+	0x12, 0x87, 0x1e, 0xa1, 0x41, 0x16, 0x6c, 0x06, 0x29, 0x5f, 0x00, 0x5a, 0x26, 0x81, 0x15, 0x01,
+	0x07, 0x85, 0xe1, 0xbf, 0x9b, 0x4c, 0xb2, 0x96, 0x5c, 0x89, 0xdb, 0x78, 0xa3, 0xc1, 0x91, 0xec,
+	0xe0, 0xef, 0xa2, 0xd2, 0x90, 0x21, 0x01, 0x2f, 0xc3, 0x15, 0xd6, 0x8a, 0xdb, 0x0d, 0x15, 0x5f,
+	0x43, 0xcd, 0x9f, 0x2b, 0x0c, 0x0a, 0xa2, 0xd4, 0xff, 0xde, 0x0a, 0x99, 0x60, 0x15, 0xc5, 0xee,
+	0xb4, 0x43, 0x46, 0x5b, 0x9c, 0x8f, 0x18, 0x72, 0x0b, 0xf7, 0x7a, 0xbd, 0xf5, 0x9a, 0x1c, 0x81,
+	0x03, 0x40, 0xf2, 0x43, 0xd6, 0x77, 0x83, 0x10, 0x7d, 0x87, 0xbc, 0xca, 0xe1, 0xb2, 0xbe, 0xcd,
+	0xd9, 0x80, 0xe4, 0x87, 0xe7, 0x18, 0x0b, 0x5c, 0x75, 0xb9, 0x1d, 0x34, 0xf9, 0xd0, 0xc5, 0xdb,
+	0xb4, 0x21, 0xf6, 0x68, 0x6d, 0xe8, 0x10, 0x0a, 0xa2, 0x94, 0x07, 0x03, 0xca, 0x92, 0x50, 0x79,
+	0x63, 0x6a, 0xc1, 0x80, 0x18, 0x58, 0xfa, 0xde, 0x36, 0x50, 0x40, 0x9b, 0x66, 0xb4, 0xbb, 0xd1,
+	0x4a, 0xe2, 0x5e, 0xb3, 0x25, 0xbe, 0xb9, 0xda, 0x5c, 0x6a, 0x79, 0x11, 0xe8, 0x78, 0xfe, 0xaf,
+	0x8d, 0x12, 0x82, 0xcd, 0x12, 0x61, 0xaa, 0xde, 0x22, 0xfd, 0x2d, 0x4c, 0x83, 0x0d, 0xe5, 0x6f,
+	0xa1, 0x99, 0xbc, 0x73, 0x44, 0xdd, 0xb7, 0xba, 0xb2, 0xbb, 0x6f, 0xb5, 0xdb, 0x25, 0xa3, 0x71,
+	0x2f, 0xc3, 0xe7, 0x85, 0xb8, 0x7c, 0x58, 0x30, 0xb1, 0x5b, 0xe3, 0x04, 0xb9, 0x43, 0xb2, 0xf8,
+	0x01, 0x92, 0x8d, 0xfb, 0x36, 0x32, 0xd6, 0x4d, 0xe2, 0x26, 0xde, 0x25, 0xc4, 0x79, 0x7e, 0x46,
+	0xae, 0x82, 0x75, 0x01, 0x7f, 0xa8, 0xfd, 0x0f, 0x0a, 0xdb, 0xfd, 0x62, 0x85, 0x1c, 0xeb, 0xd2,
+	0x60, 0x5b, 0x1a, 0x90, 0xdc, 0x62, 0x3d, 0xe4, 0x96, 0x7a, 0x75, 0x1b, 0x29, 0x99, 0xe4, 0x90,
+	0x2f, 0xac, 0x17, 0xb9, 0xf0, 0xdd, 0xf8, 0x47, 0x1c, 0xa9, 0x45, 0xec, 0x43, 0x78, 0x78, 0x7f,
+	0x7e, 0xbe, 0x3f, 0xb7, 0x9b, 0xb2, 0x82, 0x41, 0x67, 0xca, 0x8f, 0xbf, 0xb6, 0x2b, 0x0a, 0x6e,
+	0x19, 0x3f, 0xf0, 0xda, 0xfc, 0x9b, 0xf7, 0x92, 0xd7, 0x6d, 0xe1, 0xa5, 0x5e, 0x10, 0x65, 0x61,
+	0xb6, 0x03, 0xfd, 0x03, 0xe2, 0xfe, 0x9a, 0x43, 0x4e, 0x85, 0x51, 0x46, 0x93, 0x0e, 0x6d, 0x84,
+	0x41, 0x46, 0xf3, 0xc7, 0x9c, 0xb0, 0xaf, 0x6e, 0x59, 0x1d, 0xab, 0xab, 0xa5, 0xac, 0xf8, 0x80,
+	0x29, 0xbd, 0x4d, 0x39, 0x12, 0x0c, 0x68, 0xe7, 0x5c, 0x46, 0x4e, 0x95, 0x7f, 0x82, 0x92, 0x93,
+	0x6a, 0xc5, 0xb4, 0xaf, 0xdd, 0xd5, 0x78, 0x61, 0xa1, 0x7f, 0x00, 0xb5, 0x43, 0xf1, 0x2a, 0x79,
+	0x72, 0x97, 0xce, 0xec, 0xeb, 0x90, 0xfc, 0xd8, 0x19, 0xbe, 0x84, 0xc5, 0xf6, 0x3a, 0x47, 0x2a,
+	0xa1, 0x7c, 0x5a, 0x12, 0x31, 0x26, 0x95, 0xab, 0x2b, 0x50, 0x09, 0x1b, 0xea, 0xa0, 0xa9, 0x0c,
+	0x3c, 0x68, 0xbe, 0x89, 0x4c, 0x34, 0xc2, 0xb4, 0xdb, 0x0e, 0x76, 0x6e, 0x96, 0xe8, 0x79, 0x56,
+	0xf2, 0x22, 0xd0, 0xf1, 0xdc, 0x37, 0x09, 0x8b, 0x94, 0x21, 0x43, 0xb6, 0x2f, 0x83, 0x3e, 0xe4,
+	0x11, 0xfb, 0x18, 0x56, 0x5f, 0x64, 0xc3, 0xe1, 0x3d, 0x47, 0x36, 0x2c, 0x3e, 0x62, 0x46, 0x8e,
+	0xfe, 0x11, 0xf3, 0x6d, 0x64, 0x4a, 0xfe, 0x64, 0x0f, 0x0b, 0xef, 0x04, 0x6b, 0xbd, 0xd2, 0x6b,
+	0x6e, 0xe8, 0x85, 0x60, 0xe2, 0xe6, 0xfb, 0xeb, 0xe8, 0x5e, 0xf7, 0xd7, 0x0b, 0x84, 0x6c, 0xc6,
+	0xbd, 0xa8, 0x11, 0x24, 0x3b, 0x57, 0x57, 0xbc, 0x31, 0xf3, 0xcd, 0xb4, 0xa4, 0x4a, 0x40, 0xc3,
+	0xd2, 0xf7, 0xe4, 0xf1, 0x47, 0xec, 0xc9, 0xef, 0x26, 0xe3, 0xcc, 0x9d, 0x96, 0x36, 0x16, 0x33,
+	0x8f, 0xec, 0xdb, 0x3b, 0x2e, 0xf7, 0xf2, 0x93, 0x44, 0x20, 0xa7, 0xe7, 0xbe, 0x97, 0x90, 0xad,
+	0x30, 0x0a, 0xd3, 0x16, 0xa3, 0x3e, 0xb1, 0x6f, 0xea, 0xaa, 0x9f, 0x97, 0x15, 0x15, 0xd0, 0x28,
+	0xa2, 0x43, 0x33, 0x4d, 0xb3, 0xb0, 0x13, 0x64, 0xb4, 0xa1, 0x22, 0x51, 0x79, 0x4c, 0x39, 0xa5,
+	0x1c, 0x9a, 0x2f, 0x15, 0x11, 0x1e, 0x96, 0x01, 0xa1, 0x9f, 0x90, 0x4b, 0xc9, 0x89, 0x3e, 0xe0,
+	0xfa, 0xb7, 0xbc, 0xc5, 0x3b, 0xcb, 0x18, 0x48, 0x2b, 0xfe, 0x13, 0x97, 0x4a, 0x70, 0xca, 0x79,
+	0x94, 0x92, 0x33, 0xce, 0xa8, 0xb9, 0x7d, 0x9d, 0x51, 0x6f, 0x27, 0xd3, 0xf2, 0xff, 0xdb, 0x34,
+	0x6c, 0xb6, 0x32, 0xef, 0x29, 0xd6, 0x34, 0x65, 0xf9, 0xbc, 0x6e, 0x94, 0x42, 0x01, 0x7b, 0xc0,
+	0x19, 0x37, 0x6f, 0xf3, 0x8c, 0x93, 0xa9, 0xda, 0xfe, 0x96, 0x9e, 0x71, 0xe7, 0x6c, 0x9e, 0x71,
+	0x62, 0xac, 0x0e, 0xe1, 0x8c, 0x73, 0x7f, 0x69, 0x60, 0x4c, 0xec, 0xaf, 0x63, 0x8b, 0xf2, 0xbb,
+	0x0e, 0x29, 0x26, 0x36, 0xef, 0xd2, 0xd2, 0x13, 0xfb, 0x8e, 0x8a, 0xfd, 0x3f, 0x1d, 0x72, 0x4c,
+	0x7e, 0x9c, 0x54, 0x2d, 0xed, 0x93, 0x87, 0x30, 0x35, 0xa1, 0xc8, 0x85, 0x8f, 0x34, 0x95, 0x33,
+	0xb3, 0xaf, 0xfc, 0x61, 0x19, 0x70, 0x6f, 0x73, 0x71, 0x56, 0xfe, 0xce, 0xb7, 0x9d, 0xbe, 0x4e,
+	0xba, 0x1f, 0x73, 0xc8, 0x94, 0xda, 0x28, 0x96, 0xe3, 0x34, 0xf3, 0xfc, 0x73, 0x8e, 0x55, 0x05,
+	0x00, 0xf3, 0x3c, 0xb8, 0xa4, 0xb3, 0x00, 0x93, 0x23, 0x0b, 0x79, 0x11, 0x76, 0x82, 0x26, 0x5d,
+	0x09, 0x9b, 0x34, 0xcd, 0x52, 0xef, 0x69, 0x36, 0xf2, 0xef, 0xb5, 0x3b, 0xd1, 0x35, 0x06, 0x85,
+	0xfc, 0x8d, 0x7a, 0x11, 0x18, 0x2d, 0xc1, 0xa7, 0x69, 0x37, 0x6e, 0x5c, 0x5d, 0xf7, 0x26, 0xcd,
+	0xa7, 0xe9, 0x3a, 0x02, 0x81, 0x97, 0xa1, 0x3d, 0x6b, 0x23, 0xa0, 0x9d, 0x38, 0x52, 0xa9, 0xdf,
+	0x26, 0xf9, 0xcb, 0x97, 0xc3, 0x40, 0x95, 0xa2, 0xd8, 0x2e, 0x12, 0xaf, 0x32, 0xef, 0x49, 0x5b,
+	0x62, 0x3b, 0xf9, 0xce, 0xe3, 0x5c, 0xe5, 0x2f, 0x50, 0x9c, 0xb8, 0x3b, 0x00, 0x7b, 0x08, 0x4d,
+	0xdb, 0xfa, 0xb6, 0x5c, 0x6f, 0x23, 0xdd, 0x01, 0xf0, 0x7f, 0x10, 0x3c, 0xf4, 0x77, 0xd7, 0xcc,
+	0xd1, 0xbc, 0xbb, 0x9e, 0xc3, 0x74, 0x72, 0x61, 0xbb, 0x91, 0xd0, 0xc8, 0x9b, 0x65, 0xd2, 0xf4,
+	0x49, 0x9e, 0x4a, 0x8e, 0xc3, 0x40, 0x95, 0xba, 0xff, 0x1f, 0x99, 0x8a, 0x7b, 0x19, 0xbb, 0xbb,
+	0xe0, 0x38, 0xa5, 0xde, 0x31, 0x86, 0xce, 0xa6, 0xe8, 0x9a, 0x5e, 0x00, 0x26, 0x1e, 0xde, 0x21,
+	0x5b, 0x71, 0xca, 0x22, 0xa6, 0xb3, 0x3b, 0xe4, 0x29, 0xf3, 0x0e, 0x79, 0x45, 0x2b, 0x03, 0x03,
+	0x13, 0x27, 0xf7, 0xb1, 0x4e, 0x51, 0x66, 0xea, 0x9d, 0x66, 0x23, 0x53, 0xb3, 0x21, 0x5b, 0x2b,
+	0x90, 0xe6, 0x81, 0x1c, 0xfa, 0xc0, 0xd0, 0xdf, 0x08, 0x96, 0xbb, 0x20, 0xdd, 0x89, 0xea, 0xad,
+	0x24, 0x8e, 0xcc, 0xe6, 0x3d, 0x61, 0x2b, 0x9c, 0x14, 0x5b, 0x80, 0x65, 0x2c, 0xf8, 0x2e, 0x5d,
+	0x5a, 0x04, 0xe5, 0x8d, 0x72, 0xdf, 0x41, 0x66, 0x33, 0xf4, 0xd7, 0x66, 0x8f, 0x33, 0xac, 0x49,
+	0x1b, 0xde, 0x19, 0x6e, 0xbe, 0x8a, 0x96, 0x3d, 0x1b, 0x85, 0x32, 0xe8, 0xc3, 0xfe, 0x1b, 0xff,
+	0xf8, 0x9a, 0x5b, 0x21, 0xa7, 0xca, 0x4f, 0x90, 0x47, 0x51, 0xa9, 0x16, 0x52, 0xc2, 0xf6, 0xed,
+	0x86, 0xfb, 0x7a, 0x03, 0x5e, 0x26, 0x4f, 0x0c, 0xfc, 0xb0, 0xf8, 0x1c, 0x90, 0x52, 0x2f, 0xc7,
+	0x7c, 0x0e, 0xf4, 0x49, 0xa9, 0xa6, 0xc9, 0xa4, 0x9e, 0x2d, 0xda, 0x8f, 0xf0, 0x77, 0x16, 0x6e,
+	0x85, 0x22, 0x57, 0xd2, 0xf3, 0x64, 0xb4, 0xde, 0x0a, 0xa2, 0x88, 0xb6, 0x8b, 0xa4, 0x96, 0x39,
+	0x18, 0x64, 0xb9, 0x7b, 0x91, 0x8c, 0xd0, 0x3b, 0x42, 0xd5, 0x85, 0x4b, 0x1a, 0xbd, 0x23, 0x46,
+	0x98, 0x4f, 0x14, 0xde, 0x67, 0xa7, 0xcc, 0xa0, 0x1d, 0x02, 0xd5, 0xff, 0x3f, 0x55, 0x42, 0x72,
+	0xbb, 0x00, 0x34, 0x47, 0xe7, 0x76, 0x30, 0x57, 0x57, 0x0e, 0x1c, 0x00, 0x76, 0xd9, 0x20, 0x00,
+	0x05, 0x82, 0x98, 0xa1, 0x9c, 0x43, 0xf8, 0xef, 0x83, 0x58, 0x50, 0x32, 0x83, 0xc3, 0xe5, 0x3e,
+	0x22, 0x50, 0x42, 0x18, 0x7b, 0x94, 0xc5, 0xdb, 0x34, 0xba, 0x05, 0xd7, 0x0f, 0x12, 0x64, 0x98,
+	0xdb, 0xdc, 0x19, 0x04, 0xa0, 0x40, 0x10, 0xe3, 0x26, 0x30, 0x55, 0x97, 0xf4, 0x8a, 0x17, 0x7e,
+	0x72, 0x08, 0x01, 0x51, 0xe2, 0xfe, 0xb8, 0x43, 0xa6, 0x65, 0xac, 0x64, 0x36, 0xfd, 0xa5, 0xbc,
+	0xe6, 0x96, 0x2d, 0xbb, 0x8e, 0x4b, 0x3a, 0xf5, 0xfc, 0x49, 0x62, 0x80, 0x53, 0x28, 0x34, 0xc2,
+	0x7f, 0x27, 0x39, 0x5e, 0x52, 0xdd, 0x8a, 0xe0, 0x1f, 0x3d, 0xb2, 0xb4, 0x3c, 0x41, 0xa8, 0x8d,
+	0x8d, 0x6b, 0xd6, 0x5d, 0x9b, 0xd6, 0x6a, 0x7d, 0xae, 0x4d, 0x0a, 0x04, 0x39, 0xc3, 0xbd, 0x78,
+	0x64, 0x95, 0x26, 0x35, 0x7a, 0x9d, 0x9b, 0xbd, 0x6f, 0x8f, 0xac, 0x1f, 0x1a, 0x26, 0x39, 0xa5,
+	0x7d, 0xc6, 0xf0, 0xce, 0xfd, 0xb7, 0x2a, 0xbb, 0xfa, 0x6f, 0x35, 0xc8, 0x4c, 0xc0, 0x2c, 0x46,
+	0x0f, 0x18, 0xb9, 0x9b, 0xa7, 0x89, 0x33, 0x29, 0x40, 0x91, 0x24, 0x72, 0x49, 0xf3, 0xaa, 0x8c,
+	0xcb, 0xd0, 0xbe, 0xb9, 0xd4, 0x4c, 0x0a, 0x50, 0x24, 0xe9, 0xbe, 0x87, 0x78, 0xf5, 0x84, 0x06,
+	0x19, 0xe5, 0x7d, 0xbc, 0xba, 0x75, 0x33, 0xce, 0xd6, 0x13, 0x9a, 0xd2, 0x28, 0x13, 0x7e, 0x4d,
+	0xe7, 0xc4, 0x28, 0x78, 0xcb, 0x03, 0xf0, 0x60, 0x20, 0x05, 0x94, 0x5d, 0x49, 0x47, 0x45, 0xb6,
+	0x89, 0x78, 0x23, 0xa6, 0xec, 0xaa, 0xa6, 0x17, 0x82, 0x89, 0xeb, 0xfe, 0xa0, 0x43, 0xa6, 0xda,
+	0xd2, 0xfc, 0x01, 0x7a, 0x6d, 0x2e, 0xc4, 0xb2, 0x62, 0x0e, 0xb7, 0x56, 0xab, 0x5d, 0xd7, 0x29,
+	0xf3, 0xfb, 0x9f, 0x01, 0x02, 0x93, 0x77, 0x31, 0x8c, 0xfa, 0xd8, 0x1e, 0xc3, 0xa8, 0x7f, 0xc5,
+	0x21, 0xb3, 0x45, 0x6e, 0xee, 0x36, 0x79, 0xaa, 0x13, 0x24, 0xdb, 0x57, 0xa3, 0xad, 0x84, 0x45,
+	0xbf, 0xc8, 0xf8, 0x64, 0x58, 0xdc, 0xca, 0x68, 0xb2, 0x12, 0xec, 0xa4, 0xc2, 0x19, 0xef, 0x19,
+	0x41, 0xfd, 0xa9, 0x1b, 0xbb, 0x21, 0xc3, 0xee, 0xb4, 0xd0, 0x1b, 0x09, 0x11, 0x98, 0x57, 0x5a,
+	0x18, 0x47, 0x39, 0x93, 0x0a, 0x63, 0xa2, 0xbc, 0x91, 0x6e, 0x94, 0x21, 0x41, 0x79, 0x5d, 0xff,
+	0x12, 0x19, 0xe1, 0xc6, 0x35, 0x8f, 0x65, 0xb2, 0xe4, 0xff, 0x4e, 0x85, 0xc8, 0xcb, 0xfc, 0xdf,
+	0x6d, 0x0b, 0x30, 0x3c, 0x44, 0x13, 0x76, 0x51, 0x15, 0x22, 0x70, 0x76, 0x88, 0x8a, 0x7c, 0x46,
+	0xa2, 0x04, 0x5f, 0x39, 0xf4, 0x5e, 0x98, 0x2d, 0x63, 0xba, 0x61, 0x91, 0x1c, 0x9f, 0xed, 0x64,
+	0x02, 0x06, 0xaa, 0x14, 0xad, 0x45, 0xa6, 0xb0, 0x97, 0xed, 0x36, 0x6d, 0xa3, 0x2a, 0x2e, 0xc5,
+	0xf0, 0x96, 0x29, 0xfe, 0x63, 0x4f, 0x05, 0x9a, 0x47, 0xb4, 0xa3, 0x5d, 0xcd, 0xf6, 0x05, 0x99,
+	0x00, 0xe7, 0xe5, 0xff, 0xf5, 0x10, 0x19, 0x57, 0x83, 0xbd, 0xa7, 0x50, 0x53, 0x2a, 0xba, 0x12,
+	0xdf, 0x81, 0x3d, 0x2d, 0xb2, 0x12, 0x4a, 0xab, 0x17, 0xa3, 0x1d, 0x1e, 0x10, 0x38, 0xcf, 0x39,
+	0xf6, 0x26, 0xd3, 0xba, 0xf1, 0x94, 0x3e, 0xff, 0x34, 0x7c, 0x8e, 0xe4, 0xde, 0xd3, 0xad, 0x6b,
+	0x87, 0x6c, 0x9d, 0x66, 0xca, 0x2c, 0x6c, 0xb0, 0x59, 0x2d, 0xca, 0xd5, 0xf3, 0x94, 0xff, 0x42,
+	0x03, 0xa1, 0x66, 0xe4, 0xaa, 0x2a, 0x01, 0x0d, 0xcb, 0x7d, 0x9e, 0x0c, 0xd1, 0xa8, 0xd7, 0x11,
+	0xc1, 0xc0, 0xf0, 0x59, 0x37, 0x74, 0x29, 0xea, 0x75, 0xcc, 0x9e, 0x31, 0x14, 0xf7, 0xed, 0x64,
+	0xa2, 0x41, 0xd3, 0x7a, 0x12, 0xb2, 0x28, 0xb7, 0x42, 0xdc, 0x7f, 0x86, 0xe9, 0x50, 0x72, 0xb0,
+	0x59, 0x51, 0xaf, 0xe0, 0xf6, 0x54, 0xec, 0x84, 0x31, 0x5b, 0x39, 0x69, 0xd4, 0x97, 0x1f, 0x1c,
+	0x3f, 0x01, 0x0f, 0xe8, 0x98, 0x35, 0x20, 0x68, 0x0b, 0x67, 0x46, 0x75, 0x40, 0xaf, 0x09, 0x38,
+	0x28, 0x0c, 0x16, 0xf6, 0x8f, 0x46, 0x69, 0xc8, 0x02, 0x27, 0x72, 0xbf, 0xc5, 0x5c, 0x21, 0x20,
+	0x0b, 0x20, 0xc7, 0xf1, 0xff, 0x95, 0x43, 0x66, 0x0a, 0xcd, 0x78, 0x54, 0xbc, 0x70, 0x85, 0xae,
+	0xe9, 0x8f, 0x9e, 0x27, 0xa3, 0xdd, 0x20, 0xcb, 0x68, 0x12, 0x15, 0x75, 0xce, 0xeb, 0x1c, 0x0c,
+	0xb2, 0x1c, 0x53, 0x5c, 0x75, 0xc2, 0x28, 0xec, 0xf4, 0xb8, 0xf1, 0x76, 0x95, 0x0b, 0x2c, 0x6e,
+	0x70, 0x10, 0xc8, 0x32, 0x86, 0x16, 0xdc, 0x63, 0x68, 0x43, 0x1a, 0x1a, 0x07, 0x81, 0x2c, 0xf3,
+	0x5f, 0x21, 0x23, 0xeb, 0xed, 0x5e, 0x33, 0x8c, 0x98, 0x3d, 0x34, 0xdb, 0x70, 0xad, 0x07, 0x74,
+	0xc8, 0xed, 0xf1, 0xd9, 0x6f, 0x10, 0x7c, 0xd0, 0x8c, 0x02, 0x85, 0x5c, 0xab, 0xcb, 0xee, 0xff,
+	0xdf, 0x97, 0x6f, 0xff, 0xeb, 0x4a, 0xf2, 0xed, 0x4f, 0x31, 0xe4, 0x92, 0x54, 0xfb, 0x6d, 0x32,
+	0xc5, 0x2c, 0x7b, 0xe4, 0xcd, 0x44, 0x3c, 0x76, 0x2e, 0xee, 0x31, 0x78, 0xaf, 0x5e, 0x55, 0x9c,
+	0xd3, 0x3a, 0x08, 0x4c, 0xe2, 0x18, 0x13, 0x91, 0x3b, 0x5b, 0xaf, 0xd0, 0x76, 0xb0, 0x53, 0xc8,
+	0x17, 0xa2, 0x62, 0x22, 0xae, 0xf4, 0xa3, 0x40, 0x59, 0x3d, 0xff, 0x57, 0x87, 0x88, 0x66, 0x4f,
+	0xb3, 0x87, 0x3d, 0xec, 0x03, 0x05, 0xeb, 0xa9, 0x1b, 0x56, 0xac, 0xa7, 0xa4, 0x49, 0x12, 0x5f,
+	0x44, 0xa6, 0xc1, 0x14, 0x36, 0xaa, 0x45, 0xdb, 0x5d, 0xaf, 0x6a, 0x36, 0xea, 0x0a, 0x6d, 0x77,
+	0x81, 0x95, 0xa8, 0xd8, 0x5a, 0x43, 0x03, 0x63, 0x6b, 0xb5, 0xc8, 0x70, 0x13, 0x3d, 0xe8, 0xbd,
+	0x61, 0x5b, 0x86, 0x72, 0xcc, 0x21, 0x9f, 0x1b, 0xca, 0xb1, 0x7f, 0x81, 0x33, 0xc0, 0x2d, 0xb8,
+	0x25, 0x6d, 0xd3, 0xbd, 0x11, 0x5b, 0x5b, 0xb0, 0x32, 0x77, 0xe7, 0x5b, 0xb0, 0xfa, 0x09, 0x39,
+	0x33, 0x94, 0x4b, 0xd6, 0x79, 0x08, 0x71, 0x6f, 0xd4, 0x96, 0x5c, 0x52, 0xc4, 0x24, 0xe7, 0xeb,
+	0x57, 0xfc, 0x00, 0xc9, 0xc6, 0x3f, 0x4f, 0x26, 0xb4, 0xb4, 0xdf, 0xf8, 0x19, 0x54, 0xf4, 0x6a,
+	0xed, 0x33, 0xa0, 0x81, 0x14, 0xb0, 0x12, 0xff, 0x4b, 0x23, 0xb9, 0x7c, 0x07, 0x68, 0x3d, 0xee,
+	0x74, 0x68, 0xd4, 0xe0, 0xa2, 0x90, 0x4f, 0x57, 0xd0, 0xfd, 0x9f, 0x45, 0x6d, 0x90, 0xa7, 0xf8,
+	0xd6, 0xe3, 0xb7, 0xbf, 0x9c, 0xd9, 0x82, 0x08, 0x0f, 0x21, 0xe4, 0xe4, 0x9f, 0x74, 0xf2, 0x38,
+	0x03, 0x1c, 0xfe, 0x7a, 0xa9, 0xcb, 0xd4, 0x08, 0xb8, 0xdf, 0x57, 0x21, 0x23, 0xed, 0xb0, 0x13,
+	0xaa, 0xbb, 0x5a, 0xe3, 0xd0, 0x06, 0x83, 0x45, 0x4e, 0x16, 0x43, 0xf1, 0xaa, 0xa3, 0xac, 0x16,
+	0x19, 0xf4, 0xf5, 0x1a, 0x08, 0xd1, 0x77, 0x16, 0x05, 0x3b, 0xc0, 0x98, 0x12, 0xa9, 0x38, 0x6f,
+	0xf2, 0x28, 0xd8, 0x1c, 0x0c, 0xb2, 0x7c, 0x6e, 0x9b, 0x4c, 0x19, 0x9f, 0xf5, 0x50, 0x45, 0x9e,
+	0x21, 0x99, 0xd0, 0x86, 0xed, 0x30, 0x59, 0xf9, 0xbf, 0x3f, 0x44, 0x94, 0xa2, 0x4b, 0x0f, 0x0f,
+	0x17, 0xd4, 0xb5, 0xfc, 0x14, 0x46, 0xec, 0xe4, 0x38, 0x02, 0x51, 0x8a, 0x2f, 0xd4, 0x0e, 0x4d,
+	0x9a, 0x4a, 0x02, 0xe9, 0x55, 0xcc, 0x17, 0xea, 0x0d, 0xbd, 0x10, 0x4c, 0x5c, 0xbc, 0xbd, 0x74,
+	0x84, 0x4d, 0x76, 0xd1, 0x11, 0x5c, 0xda, 0x6a, 0x83, 0xc2, 0x60, 0x01, 0xae, 0x3b, 0x9a, 0x09,
+	0xb7, 0xb8, 0x69, 0xd9, 0x30, 0x09, 0xd4, 0xa8, 0x72, 0x77, 0x2b, 0x1d, 0x02, 0x06, 0x57, 0x0c,
+	0x24, 0x91, 0xd2, 0x6c, 0xed, 0x6e, 0x44, 0x13, 0x15, 0x5a, 0xda, 0x1b, 0x32, 0x03, 0x49, 0xd4,
+	0x8a, 0x08, 0xd0, 0x5f, 0xa7, 0xd4, 0xd7, 0x76, 0x78, 0xdf, 0xbe, 0xb6, 0x2b, 0x64, 0x76, 0x8b,
+	0x07, 0x20, 0x1e, 0xe8, 0xb1, 0x7b, 0xb9, 0x50, 0x0e, 0x7d, 0x35, 0x58, 0x2c, 0x93, 0x76, 0xd0,
+	0x4c, 0xbd, 0x51, 0x2d, 0x96, 0x09, 0x02, 0x80, 0xc3, 0xf5, 0x74, 0x4d, 0xe3, 0xfb, 0x4f, 0xd7,
+	0xf4, 0x0b, 0x0e, 0xe1, 0x89, 0x2f, 0x16, 0xb7, 0xd0, 0x1c, 0x24, 0xdb, 0x71, 0x7f, 0xca, 0x21,
+	0xb3, 0xa8, 0x5e, 0x5b, 0x8c, 0xb2, 0x50, 0x02, 0xed, 0x25, 0x7c, 0x66, 0xbc, 0x6e, 0x16, 0xc8,
+	0x73, 0x25, 0x47, 0x11, 0x0a, 0x7d, 0xcd, 0xf0, 0x4f, 0x93, 0x93, 0xa5, 0x04, 0xfc, 0xaf, 0x54,
+	0x89, 0x99, 0xbf, 0xc3, 0x7d, 0x89, 0x0c, 0xb3, 0x8d, 0xc4, 0x73, 0x0e, 0x98, 0x98, 0x85, 0x8d,
+	0x34, 0x0f, 0x39, 0xcf, 0x29, 0xb9, 0x2b, 0x64, 0x82, 0x25, 0x05, 0x11, 0xf1, 0xfe, 0x2b, 0xc6,
+	0x68, 0x4f, 0x40, 0x5e, 0xf4, 0xd0, 0xfc, 0x09, 0x7a, 0x35, 0xf7, 0x83, 0x64, 0x74, 0x93, 0x67,
+	0x9f, 0xb3, 0x67, 0xbb, 0x29, 0xd2, 0xd9, 0xb1, 0x37, 0xa2, 0xcc, 0x6d, 0xf7, 0x30, 0xff, 0x17,
+	0x24, 0x47, 0x77, 0x87, 0x8c, 0x05, 0xf2, 0x9b, 0x0e, 0xd9, 0x0a, 0x4b, 0x61, 0xcc, 0x1f, 0xe1,
+	0x60, 0x21, 0xbf, 0xa1, 0x62, 0x57, 0x70, 0x59, 0x19, 0xde, 0x93, 0xcb, 0xca, 0xcf, 0x3a, 0x84,
+	0xd4, 0x2e, 0xaa, 0x5b, 0xc6, 0x3d, 0x32, 0x96, 0x5e, 0x34, 0x04, 0xb6, 0x36, 0xc2, 0xb8, 0x0a,
+	0x8a, 0x5a, 0x20, 0x40, 0x01, 0x01, 0xc5, 0xed, 0x51, 0x42, 0xe6, 0x3f, 0x77, 0xc8, 0x89, 0xda,
+	0xc5, 0x12, 0x19, 0xf3, 0xeb, 0xd7, 0xe2, 0xfd, 0xca, 0x97, 0x45, 0x85, 0xf5, 0x84, 0x6e, 0x85,
+	0xf7, 0x4a, 0x72, 0xa0, 0xf2, 0x02, 0xc8, 0x71, 0xfc, 0x3f, 0x1b, 0x25, 0x8a, 0xf1, 0x21, 0xc9,
+	0xa3, 0x9f, 0x45, 0xd9, 0x51, 0x33, 0x7f, 0xe5, 0x28, 0x3c, 0x60, 0x50, 0x10, 0xa5, 0x28, 0x3f,
+	0x92, 0x21, 0x00, 0xc4, 0x86, 0xcf, 0x66, 0xa1, 0x0c, 0x15, 0x00, 0xaa, 0xb4, 0x4c, 0xc2, 0x3d,
+	0x7c, 0x24, 0x12, 0xee, 0x11, 0xfb, 0x12, 0xee, 0x0e, 0x06, 0x08, 0x64, 0x0b, 0x85, 0x89, 0x95,
+	0x05, 0xa3, 0xc9, 0x7d, 0x2b, 0xdc, 0x6a, 0x7d, 0x44, 0xa0, 0x84, 0x30, 0x33, 0xa1, 0x8f, 0xdb,
+	0x74, 0x11, 0x6e, 0x0a, 0x21, 0x4c, 0x6e, 0x42, 0xcf, 0xc1, 0x20, 0xcb, 0x0f, 0x28, 0x52, 0x76,
+	0xbf, 0xe4, 0xec, 0x22, 0xb3, 0x1f, 0xb7, 0x75, 0x04, 0x95, 0x26, 0x4f, 0x5a, 0x3a, 0x73, 0x40,
+	0x45, 0xc0, 0xe7, 0x1d, 0x72, 0x8c, 0x46, 0xf5, 0x64, 0x87, 0xd1, 0x11, 0xd4, 0x84, 0xfd, 0xe7,
+	0x2d, 0x1b, 0x6b, 0xfd, 0x52, 0x91, 0x38, 0xb7, 0x82, 0xe8, 0x03, 0x43, 0x7f, 0x33, 0xdc, 0x35,
+	0x32, 0x56, 0x0f, 0xc4, 0xbc, 0x98, 0xd8, 0xcf, 0xbc, 0xe0, 0x46, 0x26, 0x8b, 0x62, 0x36, 0x28,
+	0x22, 0x98, 0xde, 0xff, 0x78, 0x49, 0x93, 0x58, 0x74, 0x9a, 0x0e, 0x2e, 0x80, 0xab, 0x8d, 0xe2,
+	0xf2, 0xbf, 0x26, 0xe0, 0xa0, 0x30, 0xdc, 0x75, 0x72, 0x62, 0xbb, 0x93, 0xe6, 0x54, 0x64, 0xec,
+	0xc8, 0x8a, 0x61, 0xb4, 0x79, 0xe2, 0x5a, 0x09, 0x0e, 0x94, 0xd6, 0xc4, 0xbb, 0x16, 0x8d, 0x30,
+	0x1c, 0x58, 0x5e, 0x24, 0x1c, 0x37, 0xd4, 0x5d, 0xeb, 0x52, 0xa1, 0x1c, 0xfa, 0x6a, 0x60, 0xc4,
+	0xda, 0x27, 0x53, 0x9a, 0xdc, 0xa1, 0x49, 0x2d, 0x6c, 0xd0, 0xe5, 0x5e, 0x9a, 0xc5, 0x1d, 0x9a,
+	0x1c, 0x50, 0x4b, 0x35, 0xff, 0xe0, 0xfe, 0xfc, 0x93, 0xb5, 0xc1, 0xd4, 0x60, 0x37, 0x56, 0xfe,
+	0x6f, 0x3b, 0x64, 0xaa, 0x56, 0x4f, 0x82, 0xac, 0xde, 0xe2, 0xc9, 0xd2, 0xdc, 0xdb, 0x64, 0x28,
+	0x0d, 0x5f, 0xa1, 0x9e, 0x73, 0x90, 0x57, 0x85, 0xee, 0xbf, 0x12, 0x27, 0x41, 0x93, 0xd6, 0xc2,
+	0x57, 0x28, 0x30, 0x82, 0x2c, 0x6c, 0x1a, 0x07, 0x2e, 0xb7, 0x83, 0x34, 0x2d, 0xe6, 0xd5, 0xaf,
+	0x69, 0x65, 0x60, 0x60, 0xe2, 0x91, 0xc1, 0xec, 0xe0, 0x30, 0x7a, 0x56, 0xf1, 0xc8, 0xb8, 0x21,
+	0x0b, 0x20, 0xc7, 0x41, 0x47, 0xad, 0xe9, 0x1a, 0x93, 0xcc, 0xaa, 0xe7, 0x8c, 0xed, 0xa4, 0x80,
+	0xcf, 0xaa, 0x88, 0xcc, 0x85, 0xa3, 0xc5, 0x8c, 0xa1, 0xec, 0xbf, 0x9f, 0xcc, 0xd6, 0x68, 0x27,
+	0xe8, 0xb6, 0x58, 0x24, 0x3a, 0xee, 0xd4, 0xc4, 0xa4, 0xb0, 0x02, 0x26, 0xa6, 0xb1, 0x26, 0x85,
+	0x15, 0x05, 0x90, 0xe3, 0xa0, 0xb0, 0x93, 0xbb, 0x66, 0x49, 0xd3, 0x8c, 0x09, 0xe9, 0x2c, 0xc5,
+	0x83, 0xae, 0xf0, 0x7f, 0xfc, 0x9f, 0xad, 0x90, 0xc9, 0xbc, 0x3e, 0xdd, 0x2a, 0x0b, 0x2b, 0xeb,
+	0x1c, 0x46, 0x58, 0xd9, 0xfd, 0x7b, 0xbb, 0x7d, 0xb0, 0xe0, 0xed, 0x66, 0x45, 0x5e, 0x8e, 0xc6,
+	0x34, 0xca, 0x57, 0x8e, 0x6e, 0x49, 0x13, 0xc2, 0x3e, 0xe7, 0xb9, 0xcf, 0x54, 0xc8, 0x8c, 0x1a,
+	0x27, 0x61, 0x72, 0xf3, 0xe1, 0xa2, 0x8f, 0x9b, 0x05, 0x25, 0x69, 0xf1, 0xc3, 0xef, 0xe2, 0xe7,
+	0xf6, 0xe1, 0xa2, 0x9f, 0xdb, 0xa1, 0xb2, 0xef, 0xb3, 0x22, 0xfa, 0x37, 0x15, 0x32, 0xa6, 0xf2,
+	0x08, 0xbc, 0xa4, 0x47, 0x34, 0x3d, 0xf0, 0x93, 0xc6, 0x88, 0x7f, 0xfa, 0x12, 0x2a, 0xcf, 0x82,
+	0x24, 0xf3, 0x2a, 0x8f, 0x43, 0x92, 0xb9, 0x2c, 0x00, 0xa7, 0xe4, 0x5e, 0x23, 0x55, 0xcc, 0x5c,
+	0x56, 0x3d, 0x20, 0x41, 0x16, 0x96, 0xf4, 0x52, 0xd4, 0x00, 0xa4, 0xc2, 0xb2, 0x1b, 0xf1, 0x2b,
+	0x6c, 0xc1, 0x89, 0x5c, 0xdc, 0x5f, 0x45, 0x29, 0xca, 0x1f, 0xd3, 0x8c, 0x76, 0x8b, 0x71, 0xad,
+	0x50, 0x67, 0x07, 0xac, 0xc4, 0x7f, 0x99, 0xe8, 0xae, 0x7b, 0x28, 0x2b, 0x60, 0x36, 0xa4, 0x21,
+	0x6d, 0x37, 0x6a, 0x66, 0x40, 0x70, 0x25, 0x2b, 0xb8, 0x59, 0x44, 0x80, 0xfe, 0x3a, 0xfe, 0x12,
+	0x31, 0x72, 0x6e, 0x1d, 0x28, 0x7c, 0xc2, 0x0f, 0x56, 0xc9, 0x08, 0xc6, 0xb1, 0x0c, 0x33, 0xf7,
+	0x8b, 0x0e, 0x39, 0x7e, 0xb7, 0x90, 0x99, 0x36, 0xdf, 0x1e, 0x6e, 0xd9, 0x53, 0x6e, 0x6a, 0xc4,
+	0x73, 0xe5, 0x41, 0x49, 0x21, 0x94, 0x35, 0xc7, 0x48, 0x0e, 0x59, 0x3d, 0x94, 0xe4, 0x90, 0xf7,
+	0x0e, 0x39, 0xc4, 0xc3, 0xd4, 0xa0, 0xf0, 0x0e, 0xfe, 0xaf, 0x0e, 0x13, 0xc2, 0xbf, 0xc6, 0x5a,
+	0x37, 0xdb, 0x8b, 0x62, 0xe4, 0x6d, 0x64, 0xb2, 0x49, 0x23, 0x9a, 0x48, 0x27, 0xac, 0xc2, 0x01,
+	0xba, 0xaa, 0x95, 0x81, 0x81, 0xc9, 0x26, 0x0b, 0x4a, 0x11, 0xf9, 0xbb, 0xa9, 0x18, 0xc6, 0x41,
+	0x95, 0x80, 0x86, 0xe5, 0x2e, 0x18, 0xd6, 0x04, 0xdc, 0x30, 0x6d, 0x7a, 0x17, 0xe5, 0xff, 0xdb,
+	0xc9, 0xb4, 0x19, 0x30, 0x59, 0xdc, 0xde, 0x95, 0x21, 0x99, 0x19, 0x67, 0x19, 0x0a, 0xd8, 0xb8,
+	0x04, 0x1b, 0xc9, 0x0e, 0xf4, 0x22, 0x71, 0x8d, 0x57, 0x4b, 0x70, 0x85, 0x41, 0x41, 0x94, 0xb2,
+	0x6b, 0x04, 0xbb, 0xd0, 0x70, 0xb8, 0x50, 0x7a, 0xe6, 0xd7, 0x08, 0xad, 0x0c, 0x0c, 0x4c, 0xe4,
+	0x20, 0x14, 0x4b, 0xc4, 0x5c, 0xe4, 0x05, 0x6d, 0x50, 0x97, 0x4c, 0xc7, 0xa6, 0x70, 0x8f, 0xdf,
+	0x69, 0xdf, 0xba, 0xc7, 0xa9, 0x67, 0xd4, 0xe5, 0x06, 0x80, 0x26, 0x0c, 0x0a, 0xf4, 0xf1, 0x1d,
+	0xa3, 0x07, 0x31, 0x98, 0x34, 0x7d, 0xf8, 0x06, 0xc6, 0x19, 0x58, 0x27, 0x27, 0xba, 0x71, 0x63,
+	0x3d, 0x09, 0x63, 0x16, 0xc8, 0x1c, 0xef, 0x4a, 0x6c, 0x62, 0x4c, 0x99, 0xf7, 0xdb, 0xf5, 0x12,
+	0x1c, 0x28, 0xad, 0x89, 0x0f, 0xdc, 0xae, 0x00, 0x32, 0x43, 0xf7, 0x61, 0x7e, 0x86, 0x4a, 0x44,
+	0x50, 0xa5, 0xfe, 0x71, 0x72, 0xac, 0xd6, 0xeb, 0x76, 0xdb, 0x21, 0x6d, 0x28, 0x6d, 0xbd, 0xff,
+	0x1d, 0x64, 0x46, 0xa4, 0x8e, 0x54, 0xf7, 0xae, 0x7d, 0x25, 0x3a, 0xf6, 0xdf, 0x42, 0x66, 0x0a,
+	0x87, 0xf8, 0x23, 0x2c, 0x09, 0xfd, 0x3f, 0xa9, 0x92, 0x99, 0x82, 0x11, 0x2d, 0xda, 0xa1, 0x98,
+	0xf7, 0x2b, 0x3b, 0x49, 0x10, 0xb5, 0x9b, 0x95, 0xc8, 0x68, 0x58, 0x76, 0x57, 0x6b, 0x49, 0x4f,
+	0x7c, 0x6b, 0x01, 0x33, 0x98, 0xbf, 0x3a, 0x3f, 0x01, 0x0d, 0x77, 0xfe, 0x8f, 0x10, 0xa2, 0xd8,
+	0xca, 0x10, 0x93, 0xb6, 0xfb, 0xc9, 0x56, 0xbc, 0x82, 0xa4, 0xa0, 0x71, 0x74, 0x23, 0x32, 0xca,
+	0x1a, 0x42, 0x65, 0xc4, 0x2b, 0x6b, 0x7d, 0xe5, 0xba, 0x7c, 0x4e, 0x1b, 0x24, 0x13, 0xff, 0x93,
+	0x15, 0x52, 0x6e, 0xed, 0xee, 0x7e, 0xa4, 0xff, 0x83, 0xbf, 0x64, 0x71, 0x20, 0x38, 0x97, 0x5d,
+	0xbe, 0x79, 0x64, 0x7e, 0xf3, 0x1b, 0x96, 0xc6, 0x41, 0xf0, 0xed, 0xfb, 0xf2, 0xfe, 0xff, 0x70,
+	0xc8, 0xc4, 0xc6, 0xc6, 0x75, 0x75, 0x19, 0x00, 0x72, 0x2a, 0xe5, 0xf1, 0x3b, 0x99, 0x81, 0x99,
+	0x16, 0x59, 0xdd, 0xc9, 0xf3, 0x9c, 0xd6, 0x4a, 0x31, 0x60, 0x40, 0x4d, 0xf7, 0x2a, 0x39, 0xae,
+	0x97, 0x08, 0x45, 0x84, 0xb0, 0x79, 0xe3, 0xe1, 0xbc, 0xfb, 0x8b, 0xa1, 0xac, 0x4e, 0x91, 0x94,
+	0xd0, 0x46, 0x78, 0xd5, 0x72, 0x52, 0xa2, 0x18, 0xca, 0xea, 0xf8, 0x6b, 0x64, 0x62, 0x23, 0x48,
+	0x54, 0xc7, 0xdf, 0x41, 0x66, 0xeb, 0x71, 0x47, 0x5e, 0x70, 0xae, 0xd3, 0x3b, 0xc2, 0xcc, 0x7d,
+	0x98, 0x8b, 0xfa, 0x97, 0x0b, 0x65, 0xd0, 0x87, 0xed, 0xff, 0x8b, 0xe7, 0x89, 0x8a, 0xfc, 0xb4,
+	0x87, 0x33, 0xf8, 0x1e, 0x19, 0xa5, 0xf7, 0x32, 0x96, 0x9b, 0x6a, 0xc1, 0xd6, 0x3c, 0x93, 0xec,
+	0x2f, 0x71, 0xc2, 0x7c, 0xf6, 0x8b, 0x1f, 0x20, 0xd9, 0xa1, 0xfd, 0x8a, 0xf0, 0x40, 0x1a, 0xb6,
+	0xec, 0x81, 0xa4, 0xce, 0xc1, 0x82, 0x17, 0x52, 0x96, 0x7b, 0x21, 0x8d, 0xd8, 0xf6, 0x42, 0x52,
+	0x4f, 0x91, 0x3e, 0x4f, 0xa4, 0xcf, 0x39, 0x64, 0x12, 0xaf, 0xbf, 0xea, 0xb6, 0x3c, 0xca, 0xf6,
+	0x96, 0xf7, 0xd8, 0x1b, 0xe7, 0x85, 0x9b, 0x1a, 0xf9, 0x82, 0x1f, 0x9b, 0x5e, 0x04, 0x46, 0x3b,
+	0xdc, 0xcb, 0x9a, 0x4e, 0x83, 0x2b, 0x1e, 0xcf, 0x94, 0xbd, 0xa2, 0x1f, 0xa9, 0xa0, 0xd0, 0xc3,
+	0xc3, 0x8d, 0x1f, 0x69, 0x78, 0x38, 0x9f, 0x8c, 0x70, 0x37, 0x3a, 0x61, 0xfa, 0xc5, 0x4c, 0x61,
+	0xb8, 0x8b, 0x1d, 0x88, 0x12, 0x37, 0x93, 0x66, 0x8e, 0x13, 0xb6, 0x52, 0xfe, 0x1b, 0x66, 0x94,
+	0xe5, 0x76, 0x8e, 0xee, 0x8b, 0xba, 0x74, 0x66, 0x72, 0x2f, 0xd2, 0x99, 0xa9, 0x81, 0x92, 0x99,
+	0x4f, 0x3b, 0x64, 0xb2, 0xae, 0xa5, 0xe0, 0xf7, 0x9e, 0x3b, 0xe7, 0xd8, 0x09, 0xc2, 0xa4, 0x27,
+	0xf6, 0x57, 0xd9, 0x1e, 0x99, 0xb6, 0x58, 0x2f, 0x01, 0x83, 0x3b, 0x4b, 0xdd, 0xc5, 0x44, 0x51,
+	0xde, 0x94, 0xad, 0x68, 0xb4, 0xa6, 0x68, 0x4b, 0x9a, 0x05, 0x22, 0x0c, 0x04, 0x2f, 0xf7, 0x43,
+	0x68, 0xea, 0x22, 0x04, 0x54, 0xd3, 0xb6, 0x8c, 0xbe, 0x8b, 0x36, 0x02, 0x32, 0xe7, 0x08, 0x87,
+	0x82, 0xe2, 0xe8, 0xb6, 0x48, 0xb5, 0x11, 0x34, 0xbd, 0x19, 0x5b, 0xa7, 0xa1, 0x96, 0xb6, 0x8e,
+	0x3f, 0xdc, 0x57, 0x16, 0x57, 0x01, 0x59, 0xb8, 0x77, 0xc8, 0xe8, 0x56, 0x18, 0x05, 0xed, 0xf6,
+	0x8e, 0xf7, 0xe6, 0x43, 0xc9, 0xa0, 0xc7, 0x77, 0xe3, 0xcb, 0x9c, 0x07, 0x48, 0x66, 0x78, 0x0e,
+	0xc8, 0xdc, 0xe9, 0xb3, 0xd6, 0xee, 0x1b, 0xe6, 0xd5, 0x99, 0x73, 0xee, 0x4b, 0xc5, 0xde, 0x10,
+	0x26, 0x50, 0x5f, 0x7f, 0xce, 0xb1, 0x93, 0x0d, 0x13, 0x2f, 0xdb, 0x3c, 0xaa, 0x72, 0x6e, 0x46,
+	0x85, 0x5c, 0x5a, 0x59, 0xd6, 0xf5, 0xbe, 0xc1, 0x16, 0x17, 0x16, 0x1b, 0x98, 0x71, 0xc1, 0xff,
+	0x80, 0x51, 0x47, 0xaf, 0xda, 0x2e, 0xb3, 0xce, 0xf4, 0xbe, 0xd1, 0xd6, 0x99, 0xc6, 0xad, 0x3d,
+	0xf9, 0x9a, 0xe0, 0xff, 0x83, 0xe0, 0xe1, 0xfe, 0x88, 0x43, 0xa6, 0x98, 0x1b, 0xab, 0x14, 0x3f,
+	0x78, 0xe7, 0xad, 0xe9, 0x7a, 0x74, 0xb2, 0xea, 0x03, 0x32, 0x5b, 0x4b, 0xa3, 0x08, 0xcc, 0x06,
+	0xe0, 0x42, 0x79, 0x7f, 0xbc, 0xe9, 0x7d, 0xb3, 0xad, 0x85, 0xa2, 0xc5, 0x2c, 0xe5, 0x0b, 0xe5,
+	0xc5, 0x78, 0x13, 0x90, 0x85, 0x7b, 0x89, 0x8c, 0xde, 0x61, 0x02, 0x7e, 0xee, 0xb0, 0x3b, 0x71,
+	0x61, 0xae, 0x6c, 0x7f, 0xe5, 0x3a, 0x80, 0xfc, 0x74, 0xe6, 0xbf, 0x53, 0x90, 0x75, 0x71, 0xbd,
+	0xa5, 0x5c, 0x5d, 0xe0, 0x5d, 0xb4, 0xb5, 0xde, 0x0c, 0xfd, 0x83, 0x98, 0xf5, 0x1c, 0x04, 0x92,
+	0x99, 0xdb, 0x24, 0xd5, 0x66, 0xb7, 0xe7, 0xbd, 0xd5, 0x56, 0x28, 0xe3, 0x3c, 0x81, 0x13, 0x1f,
+	0x27, 0xfc, 0x8d, 0x1c, 0xdc, 0xcf, 0x38, 0x64, 0x1a, 0xcf, 0x69, 0xb5, 0xa3, 0xa7, 0x9e, 0x6b,
+	0xeb, 0x24, 0xc4, 0x24, 0x0b, 0xf9, 0x09, 0xa6, 0x04, 0x23, 0x57, 0x0d, 0x76, 0x50, 0x60, 0xef,
+	0x7e, 0x98, 0x8c, 0xa5, 0x61, 0x83, 0xd6, 0x83, 0x24, 0xf5, 0x8e, 0x1f, 0x4e, 0x53, 0x72, 0x05,
+	0xbf, 0x60, 0x04, 0x8a, 0xa5, 0xfb, 0xa3, 0x0e, 0x99, 0x09, 0x92, 0x7a, 0x2b, 0xbc, 0x43, 0xaf,
+	0xc7, 0xdc, 0xa9, 0xd4, 0x3b, 0x61, 0xeb, 0x44, 0x91, 0xa6, 0x0c, 0x92, 0xb2, 0xd0, 0x7b, 0x9b,
+	0xec, 0xa0, 0xc8, 0xdf, 0xfd, 0xb9, 0x81, 0xd1, 0x31, 0xbe, 0xc9, 0xd6, 0x8a, 0x2e, 0x0d, 0x7e,
+	0x71, 0x80, 0xb8, 0x18, 0x1f, 0xc3, 0xa6, 0xd6, 0xb3, 0xf0, 0x0e, 0x5d, 0xa1, 0x41, 0x03, 0x93,
+	0xfd, 0xc9, 0x9c, 0x0c, 0x27, 0x0f, 0x28, 0xb9, 0xe6, 0x6d, 0x28, 0x23, 0x09, 0xe5, 0x9c, 0x58,
+	0x86, 0xcc, 0x44, 0xb7, 0x5a, 0x62, 0xbe, 0xf7, 0xf6, 0x6c, 0x72, 0x24, 0x59, 0xbe, 0xe1, 0x19,
+	0x20, 0x30, 0x19, 0xbb, 0x2f, 0x90, 0x89, 0xae, 0xb8, 0x0f, 0x86, 0x69, 0x87, 0xf9, 0xf0, 0x57,
+	0x79, 0xf8, 0xa6, 0xf5, 0x1c, 0x0c, 0x3a, 0x8e, 0x91, 0x0f, 0xf6, 0xf9, 0x5d, 0xf3, 0xc1, 0xde,
+	0x22, 0x13, 0x59, 0xdc, 0x16, 0x59, 0xcb, 0x52, 0xcf, 0x63, 0x8b, 0xe5, 0x6c, 0xd9, 0x3e, 0xb7,
+	0xa1, 0xd0, 0x72, 0x31, 0x5b, 0x0e, 0x4b, 0x41, 0xa7, 0xe3, 0xb6, 0xc8, 0x0c, 0x3a, 0x5b, 0x34,
+	0x7a, 0xed, 0x30, 0x6a, 0xae, 0x06, 0x19, 0x4d, 0xbd, 0x17, 0xce, 0x55, 0x07, 0x69, 0x68, 0xd7,
+	0xe3, 0x46, 0xcd, 0xc0, 0xce, 0x93, 0x36, 0x99, 0xf0, 0x14, 0x8a, 0x64, 0xdd, 0x7b, 0xe4, 0x78,
+	0x37, 0x6e, 0xac, 0x84, 0x69, 0xd2, 0x63, 0x9a, 0xe2, 0xa5, 0x5e, 0x03, 0x03, 0xdb, 0x5e, 0x60,
+	0x5f, 0xeb, 0xcd, 0x3a, 0xb7, 0x2e, 0x33, 0xf2, 0x12, 0xfc, 0x8a, 0x15, 0x30, 0x4a, 0x33, 0x7f,
+	0x57, 0x97, 0x14, 0x42, 0x19, 0x0b, 0xe6, 0x67, 0xc8, 0x1b, 0x43, 0x13, 0x26, 0x43, 0x7c, 0xa2,
+	0xe0, 0x67, 0xa8, 0x17, 0x82, 0x89, 0x8b, 0x4a, 0x8e, 0x6e, 0x9f, 0x10, 0x72, 0xce, 0x54, 0x72,
+	0xf4, 0x4b, 0x20, 0xfb, 0xeb, 0x0c, 0x48, 0x45, 0x79, 0xe6, 0x40, 0xa9, 0x28, 0x1b, 0xe4, 0x4c,
+	0xd0, 0xcb, 0x62, 0xa6, 0x14, 0x36, 0xab, 0x70, 0x47, 0xca, 0x73, 0xdc, 0x37, 0xf3, 0xc1, 0xfd,
+	0xf9, 0x33, 0x8b, 0xbb, 0xe0, 0xc1, 0xae, 0x54, 0x30, 0x8d, 0x09, 0x15, 0xe9, 0x34, 0xbd, 0xaf,
+	0xb3, 0x75, 0xbf, 0x37, 0x13, 0x74, 0x4a, 0x1f, 0x35, 0x0e, 0x03, 0xc5, 0xcf, 0xdd, 0x20, 0x13,
+	0xad, 0x38, 0xcd, 0x16, 0xdb, 0x61, 0x80, 0x49, 0x97, 0x9e, 0x3a, 0x57, 0x1d, 0xf4, 0x6c, 0xba,
+	0x22, 0xd1, 0xf2, 0xd9, 0x7e, 0x25, 0xaf, 0x09, 0x3a, 0x19, 0x97, 0xf6, 0xe7, 0xda, 0x3c, 0xcb,
+	0x3a, 0xf6, 0xec, 0xa0, 0xd9, 0x7e, 0x90, 0x74, 0x9b, 0x28, 0xc6, 0xef, 0xc6, 0x0d, 0x9c, 0xa9,
+	0xeb, 0xec, 0x36, 0x31, 0x6f, 0x2a, 0x33, 0xd6, 0xb5, 0x32, 0x30, 0x30, 0xd1, 0x09, 0xa1, 0xc3,
+	0xc3, 0xc1, 0x7a, 0x4f, 0xdb, 0x12, 0x4b, 0x88, 0xf8, 0xb2, 0x42, 0xf0, 0xc8, 0x7f, 0x80, 0x64,
+	0xe3, 0xfe, 0x63, 0x87, 0xcc, 0x14, 0xe2, 0x69, 0x78, 0x6f, 0xb4, 0xa9, 0xb4, 0xd6, 0x08, 0x2f,
+	0x3d, 0xcb, 0x86, 0xcf, 0x04, 0x3e, 0xec, 0x07, 0x41, 0xb1, 0x45, 0x7c, 0x5c, 0x58, 0x4c, 0x67,
+	0xef, 0x19, 0x7b, 0xe3, 0xc2, 0x08, 0xca, 0x71, 0x61, 0x3f, 0x40, 0xb2, 0x41, 0x4b, 0x2d, 0x91,
+	0x3d, 0xc8, 0x7b, 0xd6, 0xb4, 0xd4, 0x12, 0x49, 0x86, 0x40, 0x96, 0x63, 0x4a, 0xa2, 0x42, 0xe4,
+	0xb3, 0xb7, 0xe4, 0x29, 0x89, 0x1e, 0x11, 0xf5, 0xac, 0x18, 0xe3, 0xf9, 0x4d, 0xb6, 0x62, 0x3c,
+	0x2b, 0x81, 0xd0, 0xfe, 0x63, 0x3c, 0x63, 0x00, 0x90, 0x3e, 0x31, 0xd2, 0xbe, 0xe2, 0x90, 0x3c,
+	0x66, 0x90, 0x66, 0xff, 0xd7, 0x1d, 0x32, 0x53, 0x90, 0x1c, 0xee, 0x33, 0xba, 0x7d, 0x31, 0x34,
+	0x63, 0xe5, 0xc8, 0x43, 0x33, 0xfa, 0xff, 0xc9, 0x21, 0xd3, 0xb2, 0xf0, 0x6a, 0xa7, 0x1b, 0x27,
+	0xd9, 0x1e, 0x64, 0xb0, 0x2c, 0x8b, 0x67, 0x33, 0x4c, 0xb3, 0x64, 0xa7, 0x3f, 0x8b, 0x27, 0x87,
+	0x83, 0xc2, 0x40, 0xdd, 0x67, 0xa2, 0x6e, 0x64, 0x5e, 0xd5, 0xd4, 0x7d, 0x6a, 0x77, 0x35, 0x0d,
+	0x0b, 0x75, 0x4e, 0x59, 0xd0, 0xf4, 0x86, 0x4c, 0x9d, 0xd3, 0x46, 0xd0, 0x04, 0x84, 0x33, 0x55,
+	0x25, 0x8b, 0xfb, 0x22, 0xec, 0x00, 0x72, 0x55, 0x25, 0x83, 0x82, 0x28, 0xc5, 0x44, 0xe5, 0x7a,
+	0xd7, 0xf7, 0xd6, 0x35, 0xf5, 0x01, 0x2b, 0x8f, 0xfc, 0x80, 0x6f, 0x23, 0x93, 0xf5, 0x76, 0x2f,
+	0x65, 0x9e, 0x99, 0x71, 0x57, 0x9a, 0xa4, 0xaa, 0x3d, 0x74, 0x59, 0x2b, 0x03, 0x03, 0xd3, 0xbf,
+	0x42, 0xdc, 0xfe, 0x04, 0xec, 0x07, 0xb2, 0x29, 0xf8, 0xa7, 0x0e, 0x99, 0x32, 0x1e, 0x13, 0xd6,
+	0x2d, 0xad, 0x2e, 0x13, 0xb7, 0x13, 0x26, 0x49, 0x9c, 0xf0, 0x07, 0x22, 0x33, 0xf8, 0x4a, 0x45,
+	0x74, 0x65, 0x66, 0x57, 0x7a, 0xa3, 0xaf, 0x14, 0x4a, 0x6a, 0xf8, 0x0f, 0x87, 0x49, 0xee, 0x58,
+	0xac, 0x72, 0x51, 0x3a, 0x03, 0x73, 0x51, 0xbe, 0x89, 0x8c, 0xa1, 0xd3, 0xfd, 0x7a, 0x9e, 0xb1,
+	0x52, 0x7d, 0x8b, 0x17, 0x6b, 0x6b, 0x37, 0x19, 0xa6, 0xc2, 0x60, 0xd8, 0x1f, 0xb8, 0x1c, 0xb6,
+	0xb3, 0xfe, 0x94, 0x86, 0x2f, 0xbe, 0xc4, 0xe1, 0xa0, 0x30, 0x30, 0xfe, 0x09, 0x8b, 0x9f, 0x23,
+	0x74, 0xe4, 0x4a, 0x28, 0xca, 0xa2, 0xed, 0x00, 0x2f, 0x43, 0xa3, 0x2a, 0xa5, 0x5f, 0x17, 0x73,
+	0x51, 0x8d, 0x94, 0x52, 0xc2, 0x43, 0x8e, 0xc3, 0x5e, 0x8a, 0x42, 0x27, 0xeb, 0x8d, 0xd8, 0x8a,
+	0x8e, 0xd5, 0xa7, 0xe5, 0xe5, 0xf7, 0x11, 0x09, 0x06, 0xc5, 0xb2, 0xcc, 0xda, 0x6c, 0xfc, 0x50,
+	0xac, 0xcd, 0x8a, 0xc9, 0x94, 0x88, 0xc5, 0x64, 0x4a, 0x9a, 0x4c, 0x6a, 0xe2, 0x08, 0x64, 0x52,
+	0x9a, 0xc3, 0xfe, 0xf0, 0x5e, 0x1d, 0xf6, 0xcd, 0x65, 0x3a, 0xb6, 0xa7, 0x65, 0xfa, 0x6a, 0x95,
+	0x8c, 0xbe, 0x4c, 0x93, 0x54, 0x84, 0x84, 0xba, 0xc3, 0xff, 0x2d, 0x86, 0x84, 0x12, 0x18, 0x20,
+	0xcb, 0x71, 0x0a, 0x6e, 0xf6, 0xc2, 0x76, 0x63, 0x25, 0xdf, 0x90, 0xf2, 0x2c, 0x60, 0xb2, 0x00,
+	0x72, 0x1c, 0xac, 0xd0, 0x44, 0xe9, 0x45, 0x07, 0x5d, 0x62, 0x0a, 0xa6, 0x9a, 0xab, 0xb2, 0x00,
+	0x72, 0x1c, 0xdc, 0x4b, 0x9b, 0x61, 0xb6, 0xa1, 0x76, 0x5b, 0xb5, 0x97, 0xae, 0x32, 0x28, 0x88,
+	0x52, 0x66, 0xfc, 0x12, 0x66, 0x1b, 0x09, 0x65, 0xda, 0xd8, 0xbe, 0x08, 0xc4, 0xab, 0x5a, 0x19,
+	0x18, 0x98, 0xac, 0x49, 0xb1, 0xe8, 0x99, 0x37, 0x52, 0x68, 0x92, 0x2c, 0x80, 0x1c, 0x07, 0x97,
+	0x32, 0xaa, 0x09, 0xc3, 0xb6, 0x70, 0x73, 0xd5, 0x96, 0xf2, 0xb2, 0x80, 0x83, 0xc2, 0x40, 0x6c,
+	0xdc, 0x8d, 0x71, 0x27, 0xf5, 0xc6, 0x4c, 0xec, 0x75, 0x01, 0x07, 0x85, 0xe1, 0xbf, 0x4c, 0xa6,
+	0xf8, 0xa6, 0xb4, 0xdc, 0x0e, 0xc2, 0xce, 0xea, 0xb2, 0x7b, 0xa9, 0xcf, 0x35, 0xfc, 0xf9, 0x12,
+	0xd7, 0xf0, 0x93, 0x46, 0xa5, 0x7e, 0x17, 0x71, 0xff, 0xab, 0x15, 0x32, 0xa6, 0xa4, 0x8a, 0xba,
+	0xd5, 0x94, 0x73, 0x28, 0x56, 0x53, 0x5d, 0x91, 0x68, 0xc8, 0x5a, 0x9a, 0x2f, 0xd9, 0x76, 0xf6,
+	0x74, 0x2d, 0x49, 0x37, 0xe4, 0xde, 0x23, 0x23, 0x29, 0x0f, 0xac, 0x57, 0xb5, 0xf5, 0xcc, 0x52,
+	0x3c, 0xb9, 0x9a, 0x3d, 0xb7, 0xe0, 0x65, 0xbf, 0x41, 0xf0, 0xf3, 0xff, 0x6b, 0x85, 0x9c, 0x92,
+	0xa8, 0x52, 0xf4, 0xb3, 0xba, 0x8c, 0xa1, 0xf2, 0x8e, 0x60, 0xa0, 0x13, 0x63, 0xa0, 0xd7, 0xed,
+	0xc9, 0xb5, 0x56, 0x97, 0x07, 0x0e, 0xf5, 0x2b, 0x85, 0xa1, 0x06, 0xab, 0x5c, 0x77, 0x1f, 0xec,
+	0xbf, 0x72, 0xc8, 0x5c, 0xf9, 0x60, 0xa3, 0x23, 0xb0, 0xfb, 0x9e, 0xbe, 0x01, 0xdf, 0x63, 0x3a,
+	0x7a, 0xac, 0xcd, 0x86, 0x5b, 0x2d, 0x4e, 0x09, 0xd1, 0x06, 0xfb, 0xc3, 0x32, 0x9f, 0x14, 0x37,
+	0xc1, 0xfd, 0x4e, 0x7b, 0x53, 0xcc, 0xec, 0x4a, 0x7e, 0xde, 0x1b, 0xd9, 0xaa, 0xbe, 0xb7, 0xda,
+	0xdf, 0x77, 0xcd, 0xc7, 0xeb, 0xf0, 0x27, 0xdb, 0x1d, 0x63, 0xb2, 0x6d, 0x1c, 0x86, 0x10, 0xb5,
+	0x74, 0xc2, 0xfd, 0x80, 0x53, 0x98, 0x71, 0x9b, 0xf6, 0x47, 0x3e, 0x6f, 0xc2, 0x23, 0x66, 0xe0,
+	0xc7, 0x2b, 0xe4, 0xec, 0xe0, 0xca, 0x47, 0x30, 0x0b, 0x3f, 0xe6, 0x98, 0xd3, 0xf0, 0x3d, 0x87,
+	0x39, 0x18, 0x03, 0xa6, 0xe2, 0xab, 0x15, 0x72, 0xee, 0x51, 0x23, 0x88, 0xf1, 0xa6, 0xea, 0xd2,
+	0x7f, 0xd8, 0x62, 0xbc, 0x29, 0xe5, 0x93, 0xac, 0xa5, 0x59, 0x55, 0x6c, 0x40, 0x63, 0xe9, 0xbe,
+	0x8f, 0x4c, 0xb6, 0x83, 0x34, 0x5b, 0x4f, 0xe2, 0x4d, 0x16, 0xaf, 0xbe, 0xb2, 0xef, 0x78, 0xf5,
+	0xec, 0x32, 0x78, 0x5d, 0xa3, 0x01, 0x06, 0x45, 0xff, 0x2f, 0x1d, 0x72, 0x42, 0x8e, 0x03, 0xbb,
+	0x9b, 0x2f, 0x85, 0x11, 0xb3, 0xd7, 0x3f, 0xfc, 0xc5, 0xf8, 0x21, 0x63, 0x31, 0xbe, 0xcb, 0xde,
+	0x24, 0xd0, 0xfb, 0x31, 0xe8, 0x0c, 0xf0, 0xff, 0xc2, 0x21, 0x5e, 0x59, 0x85, 0x23, 0x98, 0xff,
+	0x1f, 0x34, 0xa7, 0xff, 0xcb, 0x87, 0xd3, 0xf3, 0x01, 0x13, 0xff, 0x2f, 0x07, 0xf4, 0x1b, 0x87,
+	0xc6, 0x6d, 0xcb, 0x57, 0x9b, 0x63, 0xcb, 0xb4, 0x93, 0xb3, 0x28, 0x7f, 0xfe, 0xb5, 0xc9, 0x48,
+	0xca, 0xcc, 0xc3, 0xbd, 0x8a, 0xad, 0x87, 0x08, 0x37, 0x37, 0x17, 0x06, 0x23, 0xec, 0x7f, 0x10,
+	0x3c, 0xfc, 0x5f, 0xa8, 0x90, 0xd3, 0xb2, 0xe3, 0xcc, 0x32, 0x2e, 0x3f, 0xb2, 0x50, 0x50, 0x44,
+	0x02, 0xf5, 0xd3, 0x5e, 0x3e, 0xd9, 0x9c, 0x45, 0xbe, 0x16, 0x72, 0x18, 0x68, 0x3c, 0x31, 0x06,
+	0xdf, 0x56, 0x9c, 0xd4, 0x29, 0x33, 0xc4, 0x08, 0x5f, 0xa1, 0x09, 0xd0, 0x4e, 0x7c, 0x27, 0x68,
+	0x0b, 0x39, 0x80, 0x8a, 0xc1, 0x77, 0xb9, 0x0c, 0x09, 0xca, 0xeb, 0xf6, 0xc9, 0xa0, 0xab, 0x7b,
+	0x95, 0x41, 0xfb, 0xbf, 0xe7, 0x90, 0x49, 0x35, 0x5a, 0x87, 0xbf, 0x24, 0x62, 0x73, 0x49, 0xbc,
+	0x68, 0x6f, 0x49, 0x0c, 0x58, 0x06, 0xf7, 0x87, 0xc9, 0xac, 0x44, 0x51, 0xb9, 0xf6, 0x3e, 0xe1,
+	0x28, 0x03, 0x7a, 0xc7, 0x56, 0x74, 0xf9, 0x22, 0x93, 0xbd, 0xe4, 0xb7, 0x43, 0x5f, 0x58, 0x43,
+	0x20, 0x5c, 0xb1, 0x95, 0x65, 0xa0, 0xaf, 0x35, 0x07, 0x48, 0xfe, 0xf7, 0x39, 0x87, 0x10, 0xde,
+	0x4e, 0x91, 0x80, 0xba, 0x6a, 0xf7, 0x42, 0x53, 0x18, 0x29, 0x64, 0xc2, 0x9b, 0xa6, 0x96, 0x50,
+	0x5e, 0x00, 0x5a, 0x4b, 0x1e, 0x23, 0xab, 0xdf, 0x63, 0x27, 0x14, 0xfc, 0x8c, 0x43, 0x66, 0x0a,
+	0xcd, 0x2d, 0xa9, 0xbf, 0x65, 0x06, 0xb4, 0xb1, 0xf0, 0xd8, 0x31, 0x53, 0xce, 0xea, 0xd2, 0xf3,
+	0x2f, 0x3d, 0x9b, 0x2f, 0x60, 0xb6, 0xb7, 0x7f, 0x90, 0x8c, 0x4b, 0xb9, 0xaa, 0x9c, 0xde, 0x2f,
+	0xda, 0x93, 0x84, 0xe7, 0x12, 0x07, 0x09, 0x49, 0x21, 0xe7, 0x57, 0xf0, 0xcf, 0xa9, 0xec, 0xc9,
+	0x3f, 0xc7, 0xc8, 0x4d, 0x5b, 0x3d, 0xea, 0xdc, 0xb4, 0xe5, 0x9a, 0xda, 0xa1, 0x43, 0xd1, 0xd4,
+	0x9e, 0xb1, 0xae, 0xa9, 0x7d, 0xea, 0x88, 0x35, 0xb5, 0x9a, 0xf1, 0xd5, 0xf0, 0x63, 0x18, 0x5f,
+	0x7d, 0x90, 0x9c, 0xb8, 0x93, 0xcb, 0x81, 0xd4, 0x4c, 0x12, 0x81, 0xc0, 0x9f, 0x2f, 0xd5, 0xcf,
+	0xd2, 0x24, 0x0d, 0xd3, 0x8c, 0x46, 0x99, 0x26, 0x41, 0xca, 0x5d, 0x83, 0x5e, 0x2e, 0x21, 0x07,
+	0xa5, 0x4c, 0x8a, 0x96, 0x1b, 0xa3, 0x7b, 0xb0, 0xdc, 0x18, 0x6c, 0xa6, 0x33, 0xf6, 0xb5, 0x66,
+	0xa6, 0xf3, 0x4c, 0x6e, 0xcf, 0xc9, 0x1d, 0xca, 0xca, 0x8d, 0x2f, 0x3f, 0x5f, 0x34, 0x4e, 0x27,
+	0x6c, 0xe8, 0xdf, 0x67, 0x57, 0x00, 0x66, 0xc1, 0x40, 0x7d, 0xe2, 0x31, 0x0c, 0xd4, 0x0b, 0x66,
+	0x34, 0x93, 0x87, 0x67, 0x46, 0xf3, 0xe6, 0xc3, 0x31, 0xa3, 0x89, 0xc8, 0x2c, 0xcb, 0x40, 0xb3,
+	0xde, 0x6b, 0xb7, 0xb9, 0xa4, 0x3f, 0xf5, 0xa6, 0xce, 0x55, 0x07, 0x69, 0x22, 0xd0, 0xac, 0xac,
+	0x2d, 0x62, 0x77, 0x2a, 0xb7, 0x3d, 0x15, 0xcf, 0xe1, 0x6a, 0x81, 0x12, 0xf4, 0xd1, 0xc6, 0xa5,
+	0xc1, 0xf2, 0x95, 0xd0, 0x0c, 0xbf, 0x2b, 0xb3, 0xb7, 0x1e, 0x5b, 0x9a, 0x91, 0x56, 0x16, 0x02,
+	0x0c, 0x3a, 0x8e, 0x7b, 0x8d, 0x8c, 0x37, 0xa2, 0x54, 0x44, 0x78, 0x9a, 0x61, 0xdb, 0xe6, 0x9b,
+	0x71, 0xb3, 0x5d, 0xb9, 0x59, 0x53, 0xb1, 0x9d, 0xce, 0x94, 0xc4, 0xbc, 0x53, 0xe5, 0x90, 0xd7,
+	0x77, 0x6f, 0x30, 0x62, 0x7c, 0x0f, 0x12, 0xe6, 0xc8, 0xe7, 0x06, 0x8c, 0xe9, 0xca, 0xcd, 0x9a,
+	0xd8, 0xab, 0xa6, 0x04, 0x3b, 0xfe, 0x13, 0x72, 0x0a, 0x28, 0x92, 0x8f, 0x23, 0x8c, 0x89, 0xec,
+	0x1d, 0x33, 0x45, 0xf2, 0x6b, 0x0c, 0x0a, 0xa2, 0x94, 0xeb, 0x8f, 0xb3, 0xb6, 0x32, 0x2a, 0x3b,
+	0x6b, 0x4d, 0x7f, 0x9c, 0xbb, 0x36, 0x09, 0xfd, 0x71, 0x0e, 0x00, 0x9d, 0xa5, 0xbb, 0x36, 0xc8,
+	0xb8, 0xee, 0x38, 0xdb, 0x9e, 0xf6, 0x6f, 0x2a, 0xa7, 0x3b, 0x40, 0x9e, 0xd8, 0xcd, 0x01, 0xb2,
+	0xdf, 0x62, 0xea, 0xe4, 0x3e, 0x2c, 0xa6, 0x5a, 0x2c, 0x27, 0xd2, 0xea, 0xb2, 0x77, 0xca, 0xd6,
+	0x4b, 0x92, 0xc5, 0x8e, 0xe5, 0xae, 0x62, 0xec, 0x5f, 0xe0, 0x0c, 0x06, 0xfa, 0x88, 0x9e, 0x3e,
+	0xb0, 0x8f, 0x68, 0xc1, 0xec, 0xe8, 0x89, 0x43, 0x33, 0x3b, 0x9a, 0x3b, 0x02, 0xb3, 0xa3, 0x27,
+	0xf7, 0x6c, 0x76, 0x34, 0xc0, 0x36, 0x6f, 0xfe, 0xf0, 0x6d, 0xf3, 0x34, 0x83, 0xa7, 0x73, 0x47,
+	0x63, 0xf0, 0xf4, 0x0e, 0x32, 0x96, 0xb6, 0x7a, 0x59, 0x23, 0xbe, 0x1b, 0x31, 0xab, 0xb6, 0xf1,
+	0xa5, 0x37, 0x2a, 0xa5, 0x94, 0x80, 0x3f, 0xc4, 0xe0, 0x84, 0xe2, 0x7f, 0x4d, 0x1f, 0x25, 0x20,
+	0xee, 0x17, 0x06, 0xc4, 0x17, 0xf0, 0x0f, 0x33, 0xbe, 0xc0, 0xe9, 0x7d, 0xc5, 0x16, 0x28, 0xb3,
+	0xea, 0x7a, 0xfa, 0x6b, 0xce, 0xaa, 0xeb, 0xa7, 0x1c, 0x32, 0x75, 0x47, 0x57, 0xfe, 0x79, 0x6f,
+	0xb4, 0x65, 0xbb, 0x6b, 0xe8, 0x14, 0x97, 0x7c, 0xdc, 0xb4, 0x0c, 0xd0, 0xc3, 0x22, 0x00, 0xcc,
+	0x96, 0x94, 0xd8, 0x15, 0x3f, 0xf3, 0x7a, 0xd9, 0x15, 0x7f, 0x98, 0x4c, 0x74, 0xe3, 0x86, 0x7c,
+	0x1b, 0x33, 0x73, 0x34, 0xbb, 0x7e, 0x75, 0xfc, 0xa6, 0x9b, 0xb3, 0x00, 0x9d, 0x1f, 0xfa, 0x9c,
+	0xcd, 0xca, 0xe7, 0x9c, 0x50, 0xde, 0xa7, 0xde, 0xd7, 0xdb, 0x6a, 0x84, 0x7a, 0x45, 0xf2, 0x24,
+	0x5d, 0x05, 0x3e, 0xd0, 0xc7, 0x19, 0x2f, 0x24, 0xca, 0x64, 0xbe, 0x99, 0x7a, 0xcf, 0xe5, 0x17,
+	0x92, 0xc5, 0x1c, 0x0c, 0x3a, 0x8e, 0xfb, 0x33, 0x0e, 0x19, 0x6e, 0xc5, 0xf1, 0x76, 0xea, 0x3d,
+	0xcf, 0x36, 0xf4, 0x77, 0x5a, 0xbe, 0xd2, 0xa2, 0x2f, 0x95, 0x90, 0xa1, 0xc8, 0x44, 0xac, 0xc3,
+	0x0c, 0xf6, 0xf0, 0xfe, 0xfc, 0xb4, 0xe1, 0x71, 0x95, 0x7e, 0xfc, 0x35, 0x0d, 0x22, 0x44, 0xa2,
+	0xac, 0x69, 0xee, 0x67, 0x1d, 0x32, 0x7b, 0xb7, 0x20, 0x07, 0xf1, 0xbe, 0xc1, 0x96, 0x92, 0xb2,
+	0x28, 0x61, 0xe1, 0xc3, 0x5d, 0x84, 0x42, 0x5f, 0x0b, 0xdc, 0x4f, 0x99, 0xf2, 0x51, 0xee, 0xcb,
+	0x64, 0x71, 0x00, 0x0b, 0xf2, 0x58, 0xee, 0x94, 0x3f, 0x40, 0x50, 0xfa, 0x41, 0x32, 0x1a, 0x32,
+	0x43, 0x3a, 0x69, 0x27, 0xb9, 0x6e, 0x6f, 0xfe, 0x71, 0x0b, 0xbd, 0xfc, 0x81, 0xca, 0x7f, 0xa7,
+	0x20, 0x39, 0xb2, 0x84, 0x33, 0x91, 0x96, 0x7d, 0x0c, 0x9d, 0xa4, 0x2d, 0x45, 0x25, 0xd0, 0x93,
+	0x9a, 0xe5, 0x97, 0x2c, 0x1d, 0x9a, 0x82, 0xc9, 0x9b, 0xdd, 0x4f, 0xf5, 0x0c, 0xfb, 0xe7, 0x6d,
+	0xdd, 0x4f, 0xb5, 0x00, 0x3f, 0x7c, 0x55, 0x0d, 0x4a, 0xd6, 0xff, 0xf8, 0x56, 0xa2, 0x38, 0xb5,
+	0xf2, 0xa5, 0x53, 0x52, 0x95, 0x9a, 0x42, 0x33, 0xdb, 0xee, 0x8f, 0xba, 0xcc, 0xec, 0x4f, 0xce,
+	0x92, 0x69, 0xd3, 0x66, 0xc2, 0x7d, 0xab, 0xcc, 0x8e, 0xcd, 0x4d, 0x57, 0xce, 0x16, 0xb3, 0x63,
+	0x17, 0x92, 0xc6, 0x71, 0x64, 0x33, 0x85, 0x75, 0xe5, 0x50, 0x53, 0x58, 0x57, 0x8f, 0x26, 0x85,
+	0xf5, 0xac, 0xad, 0x14, 0xd6, 0x7a, 0x6e, 0xe9, 0x63, 0xfb, 0xca, 0x2d, 0xad, 0xa5, 0x10, 0x1f,
+	0x7a, 0x44, 0x0a, 0xf1, 0x45, 0x32, 0x23, 0xe3, 0x20, 0x50, 0x91, 0xc4, 0x93, 0x9b, 0x53, 0xa9,
+	0x27, 0xf6, 0xb2, 0x59, 0x0c, 0x45, 0x7c, 0xdc, 0xf2, 0x86, 0xa3, 0xb8, 0xa1, 0x84, 0x4f, 0xef,
+	0xb6, 0x6d, 0x8e, 0xc3, 0x64, 0x20, 0xe2, 0xc0, 0x90, 0x9e, 0x72, 0xc3, 0x0c, 0xf6, 0x50, 0xfe,
+	0x03, 0xbc, 0x05, 0x98, 0x81, 0x2b, 0xde, 0xda, 0x6a, 0xc7, 0x41, 0x23, 0xcf, 0x55, 0x2b, 0xed,
+	0xbd, 0x78, 0xa4, 0x1f, 0x95, 0x81, 0x6b, 0x6d, 0x00, 0x1e, 0x0c, 0xa4, 0x80, 0x42, 0xac, 0x99,
+	0x34, 0x8b, 0x13, 0xda, 0xc8, 0x05, 0x6e, 0xe3, 0xac, 0xcf, 0xd4, 0x7a, 0x9f, 0x6b, 0x26, 0x1f,
+	0xde, 0xfb, 0x5c, 0xee, 0x61, 0x96, 0x42, 0xb1, 0x59, 0xac, 0xa9, 0xea, 0x2e, 0x20, 0xf2, 0x00,
+	0x9f, 0x3c, 0xa4, 0xa6, 0x6e, 0x98, 0x7c, 0x0a, 0x4d, 0x2d, 0x94, 0x42, 0xb1, 0x59, 0x6e, 0x42,
+	0x4e, 0x75, 0xcb, 0x44, 0x93, 0xa9, 0x37, 0xfa, 0x48, 0x01, 0xa9, 0xca, 0xa9, 0x5d, 0x2a, 0xdc,
+	0x4c, 0x61, 0x00, 0x65, 0x3d, 0xab, 0xee, 0xd8, 0xd1, 0x64, 0xd5, 0x35, 0x2d, 0x24, 0xa6, 0x8e,
+	0xde, 0x42, 0xe2, 0x7f, 0x97, 0x66, 0xe5, 0xe6, 0x12, 0xbd, 0xa6, 0xf5, 0x39, 0xf1, 0x37, 0x20,
+	0x33, 0xf7, 0xe9, 0x23, 0xcf, 0xcc, 0xfd, 0xd3, 0xc5, 0xcc, 0xdc, 0x9e, 0x6d, 0x8d, 0xe0, 0x63,
+	0x65, 0xe7, 0xfe, 0xb2, 0x43, 0x4e, 0x27, 0xa5, 0x59, 0x3b, 0x52, 0xef, 0x14, 0x6b, 0x69, 0xe7,
+	0xd0, 0xe6, 0x49, 0x81, 0x1f, 0x6f, 0xf4, 0xbc, 0x68, 0xf4, 0xe9, 0x01, 0x58, 0x30, 0xa8, 0xb9,
+	0xee, 0x3f, 0x71, 0xc8, 0x1c, 0xdf, 0x12, 0x8b, 0x32, 0x00, 0x7c, 0x81, 0x88, 0x30, 0x18, 0xb6,
+	0x6d, 0x55, 0x79, 0x64, 0x6b, 0x83, 0x2b, 0xc2, 0x61, 0x97, 0x96, 0xa0, 0x8a, 0xb8, 0x4f, 0xf2,
+	0x30, 0x63, 0x4b, 0x23, 0x52, 0x9e, 0x2a, 0xfa, 0xf8, 0x83, 0xbd, 0x08, 0x1b, 0x7e, 0x69, 0xa0,
+	0xc2, 0xc6, 0x65, 0xcd, 0xfb, 0xae, 0x43, 0x52, 0xd8, 0xe8, 0xf9, 0xac, 0xf7, 0xa5, 0xb6, 0xf9,
+	0x8c, 0x43, 0x66, 0x83, 0x82, 0x6d, 0xa9, 0x77, 0xdc, 0xd6, 0x3d, 0x7f, 0x31, 0x51, 0x44, 0xf9,
+	0x5b, 0xb0, 0x68, 0xc6, 0x0a, 0x7d, 0xcc, 0x99, 0x24, 0x40, 0x02, 0x65, 0x0e, 0x11, 0xef, 0x09,
+	0x5b, 0x92, 0x00, 0xd9, 0x00, 0xb3, 0x39, 0x92, 0x0f, 0xf4, 0x71, 0x76, 0xbf, 0xea, 0x90, 0x27,
+	0xf3, 0x1c, 0xde, 0x69, 0x1e, 0xdf, 0x4b, 0x8c, 0xd5, 0x09, 0xb6, 0xc4, 0x3f, 0x60, 0xff, 0x7a,
+	0x30, 0x98, 0x27, 0x5f, 0xe6, 0x4f, 0x8b, 0x65, 0xfe, 0xe4, 0x2e, 0x98, 0xb0, 0x5b, 0xd3, 0xe7,
+	0x3e, 0xe1, 0x10, 0x92, 0x5f, 0x12, 0x4b, 0x9e, 0x46, 0x9b, 0xe6, 0xd3, 0xe8, 0xba, 0xa5, 0x54,
+	0xec, 0xbc, 0x69, 0xda, 0x1b, 0xed, 0x87, 0x31, 0x2b, 0x43, 0xc9, 0xcd, 0xad, 0xa4, 0x49, 0xef,
+	0x33, 0x9b, 0x64, 0x51, 0x36, 0xa4, 0x37, 0x68, 0x89, 0x9c, 0x28, 0xbb, 0x9e, 0xfd, 0x0d, 0x4c,
+	0x93, 0x3e, 0xf7, 0x45, 0x87, 0x9c, 0xd9, 0xed, 0xb8, 0x28, 0x21, 0x16, 0x99, 0x63, 0xfc, 0x9d,
+	0x87, 0x95, 0xd5, 0x4a, 0x6f, 0xe6, 0x4d, 0x72, 0xee, 0x51, 0x33, 0xfe, 0x51, 0xdd, 0x1e, 0xd3,
+	0x9f, 0xda, 0x7f, 0x31, 0xae, 0x99, 0xa7, 0x64, 0xb4, 0x6b, 0xdd, 0x75, 0x30, 0xc2, 0x68, 0x72,
+	0xa8, 0xf8, 0xf2, 0xa6, 0x6c, 0xcf, 0x44, 0x6e, 0x5e, 0x78, 0x95, 0x51, 0x07, 0xc1, 0xe5, 0x75,
+	0xb6, 0x56, 0x29, 0x7a, 0xba, 0x0e, 0x1d, 0xb9, 0xa7, 0xab, 0x7b, 0x97, 0x8c, 0xdf, 0x0d, 0xb3,
+	0x16, 0xb3, 0xb2, 0x13, 0x46, 0x20, 0x16, 0xa2, 0x2a, 0x21, 0xb9, 0xbc, 0xef, 0xb7, 0x25, 0x03,
+	0xc8, 0x79, 0xa1, 0xfb, 0x13, 0xfe, 0x60, 0x0e, 0x83, 0x45, 0xf7, 0xa7, 0xdb, 0xb2, 0x00, 0x72,
+	0x1c, 0x1c, 0xac, 0x49, 0xfc, 0x25, 0xe3, 0x81, 0x7b, 0xa3, 0xb6, 0x66, 0x88, 0xa4, 0xc8, 0xcd,
+	0xae, 0x6f, 0x6b, 0x3c, 0xc0, 0xe0, 0xa8, 0xb2, 0x2d, 0x8e, 0x0d, 0xcc, 0xb6, 0xf8, 0x21, 0xf6,
+	0xb2, 0xca, 0xc2, 0xa8, 0x47, 0xd7, 0x22, 0x6f, 0xdc, 0xd6, 0x06, 0xbf, 0xac, 0x68, 0x72, 0x21,
+	0x6b, 0xfe, 0x1b, 0x34, 0x7e, 0x9a, 0x86, 0x7c, 0x62, 0x57, 0x0d, 0x79, 0x2e, 0x54, 0x9f, 0xb4,
+	0x2e, 0x54, 0xcf, 0x68, 0xd7, 0x8a, 0x50, 0xfd, 0x6b, 0x4a, 0xc4, 0xf8, 0x57, 0x0e, 0x71, 0xd5,
+	0x95, 0x59, 0x6d, 0xa8, 0x47, 0x60, 0x6d, 0x8f, 0x26, 0xce, 0x28, 0x4d, 0xe2, 0x0c, 0xed, 0xde,
+	0x18, 0x38, 0xcd, 0xbc, 0x01, 0x39, 0x0c, 0x34, 0x9e, 0xfe, 0x9f, 0x39, 0xe4, 0x54, 0x7f, 0xdf,
+	0x8f, 0xc0, 0xba, 0x78, 0xc7, 0xb4, 0x2e, 0xde, 0xb0, 0xa8, 0x9c, 0x55, 0xdd, 0x18, 0x60, 0x67,
+	0xfc, 0xa7, 0x15, 0x32, 0xa3, 0x23, 0xd7, 0xe8, 0x51, 0x7c, 0xec, 0xbb, 0x86, 0x6b, 0xc5, 0x2d,
+	0xbb, 0xfd, 0xad, 0x09, 0x1d, 0x7f, 0x99, 0xa3, 0xd3, 0x47, 0x0b, 0x7e, 0x4e, 0xb7, 0xed, 0xb3,
+	0xde, 0xdd, 0xb9, 0xe9, 0xbf, 0x39, 0xe4, 0x78, 0xa1, 0xc6, 0x11, 0x4c, 0xb0, 0x3b, 0xe6, 0x04,
+	0x7b, 0xc9, 0x7a, 0xaf, 0x07, 0xcc, 0xae, 0x2f, 0x56, 0xfa, 0x7a, 0xcb, 0xde, 0xdf, 0xaf, 0x3a,
+	0x64, 0x18, 0x5f, 0x16, 0xd2, 0xd0, 0xf7, 0x7d, 0x87, 0x32, 0x03, 0xd8, 0x1b, 0x48, 0xec, 0xce,
+	0xaa, 0x7d, 0x0c, 0x06, 0x9c, 0xfb, 0xdc, 0xf7, 0x39, 0x84, 0xe4, 0x48, 0xaf, 0xd7, 0x73, 0xc1,
+	0xff, 0xf9, 0x0a, 0x39, 0x59, 0x3a, 0x8d, 0xdc, 0x4f, 0x2a, 0x29, 0xbf, 0x63, 0x5b, 0x68, 0x65,
+	0x30, 0xd2, 0x85, 0xfd, 0x53, 0x86, 0xb0, 0x5f, 0xc8, 0xf8, 0x5f, 0xaf, 0xc7, 0x9e, 0xd8, 0xa6,
+	0xb5, 0xc1, 0xfa, 0x63, 0x27, 0xf7, 0x8c, 0x90, 0x83, 0xf9, 0xb7, 0xd1, 0xe1, 0xda, 0xff, 0x53,
+	0xcd, 0xf5, 0x4d, 0x76, 0xf4, 0x08, 0xf6, 0x8a, 0xbb, 0xe6, 0x5e, 0x01, 0xf6, 0x2d, 0x85, 0x06,
+	0x6c, 0x16, 0xff, 0x4e, 0xdf, 0x1a, 0xf7, 0x15, 0xb4, 0xa5, 0x18, 0x86, 0xa5, 0xb2, 0xd7, 0x30,
+	0x2c, 0x5a, 0x20, 0x99, 0xea, 0x6e, 0x81, 0x64, 0xcc, 0xf4, 0x4a, 0x43, 0x8f, 0x4e, 0xaf, 0xe4,
+	0xff, 0x6e, 0x85, 0x78, 0xfd, 0x9d, 0xb9, 0x13, 0x32, 0x8d, 0x56, 0xce, 0xd5, 0xd9, 0x95, 0x2b,
+	0x8b, 0xb3, 0xc3, 0xeb, 0xf0, 0x97, 0xbd, 0x1e, 0x67, 0x87, 0xc3, 0x41, 0x61, 0xb8, 0x29, 0x39,
+	0xc6, 0x92, 0xd7, 0x61, 0x36, 0xbf, 0xb0, 0x43, 0xd3, 0x2c, 0xe8, 0x74, 0x0f, 0xa0, 0x7e, 0x55,
+	0x01, 0xe3, 0x96, 0x8b, 0xc4, 0xa0, 0x9f, 0xbe, 0x5a, 0x16, 0x43, 0x47, 0xb6, 0x2c, 0xfe, 0xa1,
+	0x43, 0xce, 0x0c, 0x1a, 0x59, 0xb6, 0x3c, 0x3e, 0x2a, 0x27, 0x30, 0xdf, 0x32, 0xdf, 0x75, 0x18,
+	0xa6, 0x6e, 0x9c, 0xdd, 0x80, 0x89, 0x3c, 0x45, 0x26, 0xde, 0x15, 0xaa, 0x44, 0x41, 0x4b, 0x0b,
+	0x5f, 0xfe, 0x83, 0xb3, 0x6f, 0xf8, 0xcd, 0x3f, 0x38, 0xfb, 0x86, 0xaf, 0xfe, 0xc1, 0xd9, 0x37,
+	0x7c, 0xcf, 0x83, 0xb3, 0xce, 0x97, 0x1f, 0x9c, 0x75, 0x7e, 0xf3, 0xc1, 0x59, 0xe7, 0xab, 0x0f,
+	0xce, 0x3a, 0xbf, 0xff, 0xe0, 0xac, 0xf3, 0x23, 0x7f, 0x78, 0xf6, 0x0d, 0xef, 0x1a, 0x93, 0xdc,
+	0xfe, 0xef, 0x00, 0xa0, 0xfe, 0x12, 0x4d, 0x48, 0x0d, 0x01, 0x00,
+}
+
+func (m *AWSSigV4Auth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AWSSigV4Auth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AWSSigV4Auth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SessionTokenSecret != nil {
+		{
+			size, err := m.SessionTokenSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SecretKeySecret != nil {
+		{
+			size, err := m.SecretKeySecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AccessKeySecret != nil {
+		{
+			size, err := m.AccessKeySecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Service)
+	copy(dAtA[i:], m.Service)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Service)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Region)
+	copy(dAtA[i:], m.Region)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *Amount) Marshal() (dAtA []byte, err error) {
@@ -5428,6 +6280,37 @@ func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Checksum)
+	copy(dAtA[i:], m.Checksum)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Checksum)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SizeBytes))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x80
+	if len(m.Aggregated) > 0 {
+		for iNdEx := len(m.Aggregated) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Aggregated[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	i -= len(m.Aggregate)
+	copy(dAtA[i:], m.Aggregate)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Aggregate)))
+	i--
+	dAtA[i] = 0x72
 	i--
 	if m.Deleted {
 		dAtA[i] = 1
@@ -6036,6 +6919,11 @@ func (m *ArtifactRepositoryRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.WorkflowArtifactRepository)
+	copy(dAtA[i:], m.WorkflowArtifactRepository)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WorkflowArtifactRepository)))
+	i--
+	dAtA[i] = 0x1a
 	i -= len(m.Key)
 	copy(dAtA[i:], m.Key)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
@@ -6731,6 +7619,54 @@ func (m *Cache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChildWorkflowTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChildWorkflowTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChildWorkflowTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Cascade)
+	copy(dAtA[i:], m.Cascade)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Cascade)))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ClientCertAuth) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7416,6 +8352,21 @@ func (m *CronWorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ConsecutiveFailures))
+	i--
+	dAtA[i] = 0x40
+	if m.LastSuccessfulTime != nil {
+		{
+			size, err := m.LastSuccessfulTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	i -= len(m.Phase)
 	copy(dAtA[i:], m.Phase)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
@@ -7470,7 +8421,7 @@ func (m *CronWorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DAGTask) Marshal() (dAtA []byte, err error) {
+func (m *DAGHook) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7480,124 +8431,290 @@ func (m *DAGTask) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DAGTask) MarshalTo(dAtA []byte) (int, error) {
+func (m *DAGHook) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DAGTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DAGHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Inline != nil {
-		{
-			size, err := m.Inline.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
-	}
-	if len(m.Hooks) > 0 {
-		keysForHooks := make([]string, 0, len(m.Hooks))
-		for k := range m.Hooks {
-			keysForHooks = append(keysForHooks, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForHooks)
-		for iNdEx := len(keysForHooks) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Hooks[LifecycleEvent(keysForHooks[iNdEx])]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForHooks[iNdEx])
-			copy(dAtA[i:], keysForHooks[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHooks[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x6a
-		}
-	}
-	i -= len(m.Depends)
-	copy(dAtA[i:], m.Depends)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Depends)))
-	i--
-	dAtA[i] = 0x62
-	i -= len(m.OnExit)
-	copy(dAtA[i:], m.OnExit)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OnExit)))
-	i--
-	dAtA[i] = 0x5a
-	if m.ContinueOn != nil {
-		{
-			size, err := m.ContinueOn.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
-	}
-	i -= len(m.When)
-	copy(dAtA[i:], m.When)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.When)))
-	i--
-	dAtA[i] = 0x4a
-	if m.WithSequence != nil {
-		{
-			size, err := m.WithSequence.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	i -= len(m.WithParam)
-	copy(dAtA[i:], m.WithParam)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WithParam)))
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
 	i--
 	dAtA[i] = 0x3a
-	if len(m.WithItems) > 0 {
-		for iNdEx := len(m.WithItems) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.WithItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
+	if len(m.Phases) > 0 {
+		for iNdEx := len(m.Phases) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Phases[iNdEx])
+			copy(dAtA[i:], m.Phases[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phases[iNdEx])))
 			i--
 			dAtA[i] = 0x32
 		}
 	}
-	if len(m.Dependencies) > 0 {
-		for iNdEx := len(m.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Dependencies[iNdEx])
-			copy(dAtA[i:], m.Dependencies[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Dependencies[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
+	i -= len(m.Tasks)
+	copy(dAtA[i:], m.Tasks)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Tasks)))
+	i--
+	dAtA[i] = 0x2a
+	if m.TemplateRef != nil {
+		{
+			size, err := m.TemplateRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Template)
+	copy(dAtA[i:], m.Template)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DAGOneOf) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DAGOneOf) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DAGOneOf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Branches) > 0 {
+		for iNdEx := len(m.Branches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Branches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DAGOneOfBranch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DAGOneOfBranch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DAGOneOfBranch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Default {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Task)
+	copy(dAtA[i:], m.Task)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Task)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DAGTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DAGTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DAGTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Inline != nil {
+		{
+			size, err := m.Inline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Hooks) > 0 {
+		keysForHooks := make([]string, 0, len(m.Hooks))
+		for k := range m.Hooks {
+			keysForHooks = append(keysForHooks, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHooks)
+		for iNdEx := len(keysForHooks) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Hooks[LifecycleEvent(keysForHooks[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHooks[iNdEx])
+			copy(dAtA[i:], keysForHooks[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHooks[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	i -= len(m.Depends)
+	copy(dAtA[i:], m.Depends)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Depends)))
+	i--
+	dAtA[i] = 0x62
+	i -= len(m.OnExit)
+	copy(dAtA[i:], m.OnExit)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OnExit)))
+	i--
+	dAtA[i] = 0x5a
+	if m.ContinueOn != nil {
+		{
+			size, err := m.ContinueOn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	i -= len(m.When)
+	copy(dAtA[i:], m.When)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.When)))
+	i--
+	dAtA[i] = 0x4a
+	if m.WithSequence != nil {
+		{
+			size, err := m.WithSequence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	i -= len(m.WithParam)
+	copy(dAtA[i:], m.WithParam)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WithParam)))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.WithItems) > 0 {
+		for iNdEx := len(m.WithItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Dependencies) > 0 {
+		for iNdEx := len(m.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Dependencies[iNdEx])
+			copy(dAtA[i:], m.Dependencies[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Dependencies[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.TemplateRef != nil {
 		{
 			size, err := m.TemplateRef.MarshalToSizedBuffer(dAtA[:i])
@@ -7653,6 +8770,34 @@ func (m *DAGTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OneOf) > 0 {
+		for iNdEx := len(m.OneOf) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OneOf[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Hooks) > 0 {
+		for iNdEx := len(m.Hooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.FailFast != nil {
 		i--
 		if *m.FailFast {
@@ -7787,6 +8932,30 @@ func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Schema != nil {
+		{
+			size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Idempotency != nil {
+		{
+			size, err := m.Idempotency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	i -= len(m.Selector)
 	copy(dAtA[i:], m.Selector)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Selector)))
@@ -7795,6 +8964,93 @@ func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventIdempotency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventIdempotency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventIdempotency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != nil {
+		{
+			size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConfigMapKeyRef != nil {
+		{
+			size, err := m.ConfigMapKeyRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Inline != nil {
+		{
+			size, err := m.Inline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ExecutorConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7815,6 +9071,30 @@ func (m *ExecutorConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SecurityContext != nil {
+		{
+			size, err := m.SecurityContext.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Resources != nil {
+		{
+			size, err := m.Resources.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	i -= len(m.ServiceAccountName)
 	copy(dAtA[i:], m.ServiceAccountName)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccountName)))
@@ -7939,6 +9219,37 @@ func (m *GCSBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GPURequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GPURequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GPURequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Count))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *Gauge) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7959,6 +9270,26 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.DeleteOnCompletion {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	if m.TTL != nil {
+		{
+			size, err := m.TTL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	i -= len(m.Operation)
 	copy(dAtA[i:], m.Operation)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Operation)))
@@ -8340,6 +9671,18 @@ func (m *HTTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Auth != nil {
+		{
+			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.BodyFrom != nil {
 		{
 			size, err := m.BodyFrom.MarshalToSizedBuffer(dAtA[:i])
@@ -8619,6 +9962,53 @@ func (m *HTTPHeaderSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTTPTemplateAuth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPTemplateAuth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPTemplateAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AWSSigV4 != nil {
+		{
+			size, err := m.AWSSigV4.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OAuth2 != nil {
+		{
+			size, err := m.OAuth2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Header) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8775,6 +10165,54 @@ func (m *Item) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *JobTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *LabelKeys) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9373,6 +10811,14 @@ func (m *NodeFlag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	i--
+	if m.StepThrough {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i--
 	if m.Retried {
 		dAtA[i] = 1
 	} else {
@@ -9411,6 +10857,59 @@ func (m *NodeResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IntermediateParameters) > 0 {
+		keysForIntermediateParameters := make([]string, 0, len(m.IntermediateParameters))
+		for k := range m.IntermediateParameters {
+			keysForIntermediateParameters = append(keysForIntermediateParameters, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForIntermediateParameters)
+		for iNdEx := len(keysForIntermediateParameters) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.IntermediateParameters[string(keysForIntermediateParameters[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForIntermediateParameters[iNdEx])
+			copy(dAtA[i:], keysForIntermediateParameters[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForIntermediateParameters[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PeakResourceUsage) > 0 {
+		keysForPeakResourceUsage := make([]string, 0, len(m.PeakResourceUsage))
+		for k := range m.PeakResourceUsage {
+			keysForPeakResourceUsage = append(keysForPeakResourceUsage, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForPeakResourceUsage)
+		for iNdEx := len(keysForPeakResourceUsage) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.PeakResourceUsage[k8s_io_api_core_v1.ResourceName(keysForPeakResourceUsage[iNdEx])]
+			baseI := i
+			{
+				size, err := ((*resource.Quantity)(&v)).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForPeakResourceUsage[iNdEx])
+			copy(dAtA[i:], keysForPeakResourceUsage[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForPeakResourceUsage[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	i -= len(m.Progress)
 	copy(dAtA[i:], m.Progress)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Progress)))
@@ -9461,6 +10960,127 @@ func (m *NodeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ImageDigests) > 0 {
+		keysForImageDigests := make([]string, 0, len(m.ImageDigests))
+		for k := range m.ImageDigests {
+			keysForImageDigests = append(keysForImageDigests, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForImageDigests)
+		for iNdEx := len(keysForImageDigests) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.ImageDigests[string(keysForImageDigests[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForImageDigests[iNdEx])
+			copy(dAtA[i:], keysForImageDigests[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForImageDigests[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.EstimatedCost != nil {
+		{
+			size, err := m.EstimatedCost.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.ArtifactRepositoryRef != nil {
+		{
+			size, err := m.ArtifactRepositoryRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.IntermediateParameters) > 0 {
+		keysForIntermediateParameters := make([]string, 0, len(m.IntermediateParameters))
+		for k := range m.IntermediateParameters {
+			keysForIntermediateParameters = append(keysForIntermediateParameters, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForIntermediateParameters)
+		for iNdEx := len(keysForIntermediateParameters) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.IntermediateParameters[string(keysForIntermediateParameters[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForIntermediateParameters[iNdEx])
+			copy(dAtA[i:], keysForIntermediateParameters[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForIntermediateParameters[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.PeakResourceUsage) > 0 {
+		keysForPeakResourceUsage := make([]string, 0, len(m.PeakResourceUsage))
+		for k := range m.PeakResourceUsage {
+			keysForPeakResourceUsage = append(keysForPeakResourceUsage, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForPeakResourceUsage)
+		for iNdEx := len(keysForPeakResourceUsage) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.PeakResourceUsage[k8s_io_api_core_v1.ResourceName(keysForPeakResourceUsage[iNdEx])]
+			baseI := i
+			{
+				size, err := ((*resource.Quantity)(&v)).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForPeakResourceUsage[iNdEx])
+			copy(dAtA[i:], keysForPeakResourceUsage[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForPeakResourceUsage[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.EstimatedDurationP90))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xf0
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ProgressWeight))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe8
 	if m.TaskResultSynced != nil {
 		i--
 		if *m.TaskResultSynced {
@@ -9752,6 +11372,43 @@ func (m *NoneStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Notification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Notification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Notification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Events[iNdEx])
+			copy(dAtA[i:], m.Events[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Events[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Channel)
+	copy(dAtA[i:], m.Channel)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Channel)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *OAuth2Auth) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10214,6 +11871,34 @@ func (m *Parameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.Sensitive {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x50
+	i--
+	if m.Optional {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x48
+	if m.Schema != nil {
+		{
+			size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Description != nil {
 		i -= len(*m.Description)
 		copy(dAtA[i:], *m.Description)
@@ -10269,6 +11954,49 @@ func (m *Parameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ParameterSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParameterSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParameterSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Maximum != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Maximum))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Minimum != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Minimum))
+		i--
+		dAtA[i] = 0x18
+	}
+	i -= len(m.Pattern)
+	copy(dAtA[i:], m.Pattern)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Pattern)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Plugin) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10463,6 +12191,90 @@ func (m *RawArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResourceRecommendation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceRecommendation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceRecommendation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Samples))
+	i--
+	dAtA[i] = 0x18
+	if len(m.Limits) > 0 {
+		keysForLimits := make([]string, 0, len(m.Limits))
+		for k := range m.Limits {
+			keysForLimits = append(keysForLimits, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLimits)
+		for iNdEx := len(keysForLimits) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Limits[k8s_io_api_core_v1.ResourceName(keysForLimits[iNdEx])]
+			baseI := i
+			{
+				size, err := ((*resource.Quantity)(&v)).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForLimits[iNdEx])
+			copy(dAtA[i:], keysForLimits[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLimits[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Requests) > 0 {
+		keysForRequests := make([]string, 0, len(m.Requests))
+		for k := range m.Requests {
+			keysForRequests = append(keysForRequests, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForRequests)
+		for iNdEx := len(keysForRequests) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Requests[k8s_io_api_core_v1.ResourceName(keysForRequests[iNdEx])]
+			baseI := i
+			{
+				size, err := ((*resource.Quantity)(&v)).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForRequests[iNdEx])
+			copy(dAtA[i:], keysForRequests[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForRequests[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ResourceTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10483,6 +12295,11 @@ func (m *ResourceTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Cascade)
+	copy(dAtA[i:], m.Cascade)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Cascade)))
+	i--
+	dAtA[i] = 0x4a
 	if m.ManifestFrom != nil {
 		{
 			size, err := m.ManifestFrom.MarshalToSizedBuffer(dAtA[:i])
@@ -10934,6 +12751,49 @@ func (m *S3EncryptionOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScratchVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScratchVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScratchVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.MountPath)
+	copy(dAtA[i:], m.MountPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MountPath)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.StorageClass)
+	copy(dAtA[i:], m.StorageClass)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StorageClass)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.StorageSize.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ScriptTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11132,6 +12992,11 @@ func (m *Sequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Step)
+	copy(dAtA[i:], m.Step)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Step)))
+	i--
+	dAtA[i] = 0x2a
 	i -= len(m.Format)
 	copy(dAtA[i:], m.Format)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
@@ -11176,6 +13041,34 @@ func (m *Sequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StepThrough) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StepThrough) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StepThrough) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.NodeFieldSelector)
+	copy(dAtA[i:], m.NodeFieldSelector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NodeFieldSelector)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *StopStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11646,6 +13539,141 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.ArtifactRepositoryRef != nil {
+		{
+			size, err := m.ArtifactRepositoryRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.GPU != nil {
+		{
+			size, err := m.GPU.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.Scratch != nil {
+		{
+			size, err := m.Scratch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.PodDisruptionBudget != nil {
+		{
+			size, err := m.PodDisruptionBudget.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.SchedulingGates) > 0 {
+		for iNdEx := len(m.SchedulingGates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SchedulingGates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.ProgressWeight != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ProgressWeight))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.ChildWorkflow != nil {
+		{
+			size, err := m.ChildWorkflow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.Extends != nil {
+		{
+			size, err := m.Extends.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.Finally != nil {
+		{
+			size, err := m.Finally.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xea
+	}
 	if len(m.Annotations) > 0 {
 		keysForAnnotations := make([]string, 0, len(m.Annotations))
 		for k := range m.Annotations {
@@ -12146,6 +14174,94 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TemplateExtends) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TemplateExtends) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TemplateExtends) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TemplateRef != nil {
+		{
+			size, err := m.TemplateRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Template)
+	copy(dAtA[i:], m.Template)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TemplateImport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TemplateImport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TemplateImport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Digest)
+	copy(dAtA[i:], m.Digest)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Digest)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Tag)
+	copy(dAtA[i:], m.Tag)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Tag)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Repository)
+	copy(dAtA[i:], m.Repository)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Repository)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Registry)
+	copy(dAtA[i:], m.Registry)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Registry)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TemplateRef) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12278,6 +14394,30 @@ func (m *ValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Plugin != nil {
+		{
+			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.SecretKeyRef != nil {
+		{
+			size, err := m.SecretKeyRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.ConfigMapKeyRef != nil {
 		{
 			size, err := m.ConfigMapKeyRef.MarshalToSizedBuffer(dAtA[:i])
@@ -12586,6 +14726,155 @@ func (m *WorkflowArtifactGCTaskList) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkflowArtifactRepository) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowArtifactRepository) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowArtifactRepository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowArtifactRepositoryList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowArtifactRepositoryList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowArtifactRepositoryList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowArtifactRepositoryStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowArtifactRepositoryStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowArtifactRepositoryStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastProbedAt != nil {
+		{
+			size, err := m.LastProbedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *WorkflowEventBinding) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12934,6 +15223,68 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StepThrough != nil {
+		{
+			size, err := m.StepThrough.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(m.Notifications) > 0 {
+		for iNdEx := len(m.Notifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Notifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.SchedulingGates) > 0 {
+		for iNdEx := len(m.SchedulingGates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SchedulingGates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.Imports) > 0 {
+		for iNdEx := len(m.Imports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Imports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
 	if m.ArtifactGC != nil {
 		{
 			size, err := m.ArtifactGC.MarshalToSizedBuffer(dAtA[:i])
@@ -13457,6 +15808,117 @@ func (m *WorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ArtifactManifest != nil {
+		{
+			size, err := m.ArtifactManifest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.ImageDigests) > 0 {
+		keysForImageDigests := make([]string, 0, len(m.ImageDigests))
+		for k := range m.ImageDigests {
+			keysForImageDigests = append(keysForImageDigests, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForImageDigests)
+		for iNdEx := len(keysForImageDigests) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.ImageDigests[string(keysForImageDigests[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForImageDigests[iNdEx])
+			copy(dAtA[i:], keysForImageDigests[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForImageDigests[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.EstimatedCost != nil {
+		{
+			size, err := m.EstimatedCost.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.ResourceRecommendations) > 0 {
+		keysForResourceRecommendations := make([]string, 0, len(m.ResourceRecommendations))
+		for k := range m.ResourceRecommendations {
+			keysForResourceRecommendations = append(keysForResourceRecommendations, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForResourceRecommendations)
+		for iNdEx := len(keysForResourceRecommendations) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.ResourceRecommendations[string(keysForResourceRecommendations[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForResourceRecommendations[iNdEx])
+			copy(dAtA[i:], keysForResourceRecommendations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForResourceRecommendations[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.TemplateDigests) > 0 {
+		keysForTemplateDigests := make([]string, 0, len(m.TemplateDigests))
+		for k := range m.TemplateDigests {
+			keysForTemplateDigests = append(keysForTemplateDigests, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForTemplateDigests)
+		for iNdEx := len(keysForTemplateDigests) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.TemplateDigests[string(keysForTemplateDigests[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForTemplateDigests[iNdEx])
+			copy(dAtA[i:], keysForTemplateDigests[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForTemplateDigests[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
 	if len(m.TaskResultsCompletionStatus) > 0 {
 		keysForTaskResultsCompletionStatus := make([]string, 0, len(m.TaskResultsCompletionStatus))
 		for k := range m.TaskResultsCompletionStatus {
@@ -14266,6 +16728,16 @@ func (m *WorkflowTemplateRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Digest)
+	copy(dAtA[i:], m.Digest)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Digest)))
+	i--
+	dAtA[i] = 0x1a
 	i--
 	if m.ClusterScope {
 		dAtA[i] = 1
@@ -14282,6 +16754,94 @@ func (m *WorkflowTemplateRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkflowTemplateRevision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowTemplateRevision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowTemplateRevision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.CreationTimestamp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Revision))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Digest)
+	copy(dAtA[i:], m.Digest)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Digest)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowTemplateRevisionList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowTemplateRevisionList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowTemplateRevisionList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ZipStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14316,6 +16876,31 @@ func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *AWSSigV4Auth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Region)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Service)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AccessKeySecret != nil {
+		l = m.AccessKeySecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SecretKeySecret != nil {
+		l = m.SecretKeySecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SessionTokenSecret != nil {
+		l = m.SessionTokenSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *Amount) Size() (n int) {
 	if m == nil {
 		return 0
@@ -14429,6 +17014,17 @@ func (m *Artifact) Size() (n int) {
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	n += 2
+	l = len(m.Aggregate)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Aggregated) > 0 {
+		for _, e := range m.Aggregated {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2 + sovGenerated(uint64(m.SizeBytes))
+	l = len(m.Checksum)
+	n += 2 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -14612,6 +17208,8 @@ func (m *ArtifactRepositoryRef) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Key)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.WorkflowArtifactRepository)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -14852,6 +17450,21 @@ func (m *Cache) Size() (n int) {
 	return n
 }
 
+func (m *ChildWorkflowTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Cascade)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ClientCertAuth) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15119,6 +17732,77 @@ func (m *CronWorkflowStatus) Size() (n int) {
 	n += 1 + sovGenerated(uint64(m.Failed))
 	l = len(m.Phase)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.LastSuccessfulTime != nil {
+		l = m.LastSuccessfulTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.ConsecutiveFailures))
+	return n
+}
+
+func (m *DAGHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Template)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Arguments.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TemplateRef != nil {
+		l = m.TemplateRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Tasks)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Phases) > 0 {
+		for _, s := range m.Phases {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *DAGOneOf) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Branches) > 0 {
+		for _, e := range m.Branches {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DAGOneOfBranch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Task)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
 	return n
 }
 
@@ -15199,6 +17883,18 @@ func (m *DAGTemplate) Size() (n int) {
 	if m.FailFast != nil {
 		n += 2
 	}
+	if len(m.Hooks) > 0 {
+		for _, e := range m.Hooks {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.OneOf) > 0 {
+		for _, e := range m.OneOf {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -15240,6 +17936,46 @@ func (m *Event) Size() (n int) {
 	_ = l
 	l = len(m.Selector)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Idempotency != nil {
+		l = m.Idempotency.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Schema != nil {
+		l = m.Schema.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *EventIdempotency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Window != nil {
+		l = m.Window.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Inline != nil {
+		l = m.Inline.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ConfigMapKeyRef != nil {
+		l = m.ConfigMapKeyRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -15251,6 +17987,14 @@ func (m *ExecutorConfig) Size() (n int) {
 	_ = l
 	l = len(m.ServiceAccountName)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Resources != nil {
+		l = m.Resources.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SecurityContext != nil {
+		l = m.SecurityContext.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -15295,6 +18039,18 @@ func (m *GCSBucket) Size() (n int) {
 	return n
 }
 
+func (m *GPURequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Count))
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Gauge) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15308,6 +18064,11 @@ func (m *Gauge) Size() (n int) {
 	}
 	l = len(m.Operation)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.TTL != nil {
+		l = m.TTL.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
 	return n
 }
 
@@ -15455,6 +18216,10 @@ func (m *HTTP) Size() (n int) {
 		l = m.BodyFrom.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Auth != nil {
+		l = m.Auth.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -15537,6 +18302,23 @@ func (m *HTTPHeaderSource) Size() (n int) {
 	return n
 }
 
+func (m *HTTPTemplateAuth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OAuth2 != nil {
+		l = m.OAuth2.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.AWSSigV4 != nil {
+		l = m.AWSSigV4.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *Header) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15601,6 +18383,21 @@ func (m *Item) Size() (n int) {
 	return n
 }
 
+func (m *JobTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Metadata.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *LabelKeys) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15831,6 +18628,7 @@ func (m *NodeFlag) Size() (n int) {
 	_ = l
 	n += 2
 	n += 2
+	n += 2
 	return n
 }
 
@@ -15850,6 +18648,23 @@ func (m *NodeResult) Size() (n int) {
 	}
 	l = len(m.Progress)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.PeakResourceUsage) > 0 {
+		for k, v := range m.PeakResourceUsage {
+			_ = k
+			_ = v
+			l = ((*resource.Quantity)(&v)).Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.IntermediateParameters) > 0 {
+		for k, v := range m.IntermediateParameters {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -15938,6 +18753,41 @@ func (m *NodeStatus) Size() (n int) {
 	if m.TaskResultSynced != nil {
 		n += 3
 	}
+	n += 2 + sovGenerated(uint64(m.ProgressWeight))
+	n += 2 + sovGenerated(uint64(m.EstimatedDurationP90))
+	if len(m.PeakResourceUsage) > 0 {
+		for k, v := range m.PeakResourceUsage {
+			_ = k
+			_ = v
+			l = ((*resource.Quantity)(&v)).Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.IntermediateParameters) > 0 {
+		for k, v := range m.IntermediateParameters {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.ArtifactRepositoryRef != nil {
+		l = m.ArtifactRepositoryRef.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.EstimatedCost != nil {
+		l = m.EstimatedCost.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.ImageDigests) > 0 {
+		for k, v := range m.ImageDigests {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -15961,6 +18811,23 @@ func (m *NoneStrategy) Size() (n int) {
 	return n
 }
 
+func (m *Notification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Events) > 0 {
+		for _, s := range m.Events {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *OAuth2Auth) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16162,6 +19029,31 @@ func (m *Parameter) Size() (n int) {
 		l = len(*m.Description)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Schema != nil {
+		l = m.Schema.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	n += 2
+	return n
+}
+
+func (m *ParameterSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Pattern)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Minimum != nil {
+		n += 1 + sovGenerated(uint64(*m.Minimum))
+	}
+	if m.Maximum != nil {
+		n += 1 + sovGenerated(uint64(*m.Maximum))
+	}
 	return n
 }
 
@@ -16237,6 +19129,34 @@ func (m *RawArtifact) Size() (n int) {
 	return n
 }
 
+func (m *ResourceRecommendation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for k, v := range m.Requests {
+			_ = k
+			_ = v
+			l = ((*resource.Quantity)(&v)).Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Limits) > 0 {
+		for k, v := range m.Limits {
+			_ = k
+			_ = v
+			l = ((*resource.Quantity)(&v)).Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.Samples))
+	return n
+}
+
 func (m *ResourceTemplate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16264,6 +19184,8 @@ func (m *ResourceTemplate) Size() (n int) {
 		l = m.ManifestFrom.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.Cascade)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -16405,6 +19327,21 @@ func (m *S3EncryptionOptions) Size() (n int) {
 	return n
 }
 
+func (m *ScratchVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StorageSize.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.StorageClass)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MountPath)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ScriptTemplate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16495,6 +19432,19 @@ func (m *Sequence) Size() (n int) {
 	}
 	l = len(m.Format)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Step)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *StepThrough) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeFieldSelector)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -16831,6 +19781,81 @@ func (m *Template) Size() (n int) {
 			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if m.Finally != nil {
+		l = m.Finally.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Extends != nil {
+		l = m.Extends.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.ChildWorkflow != nil {
+		l = m.ChildWorkflow.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.ProgressWeight != nil {
+		n += 2 + sovGenerated(uint64(*m.ProgressWeight))
+	}
+	if len(m.SchedulingGates) > 0 {
+		for _, e := range m.SchedulingGates {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.PodDisruptionBudget != nil {
+		l = m.PodDisruptionBudget.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Scratch != nil {
+		l = m.Scratch.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.GPU != nil {
+		l = m.GPU.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.ArtifactRepositoryRef != nil {
+		l = m.ArtifactRepositoryRef.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *TemplateExtends) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Template)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TemplateRef != nil {
+		l = m.TemplateRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *TemplateImport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Registry)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Repository)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Tag)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Digest)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -16903,6 +19928,14 @@ func (m *ValueFrom) Size() (n int) {
 		l = m.ConfigMapKeyRef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.SecretKeyRef != nil {
+		l = m.SecretKeyRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Plugin != nil {
+		l = m.Plugin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16989,6 +20022,57 @@ func (m *WorkflowArtifactGCTaskList) Size() (n int) {
 	return n
 }
 
+func (m *WorkflowArtifactRepository) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WorkflowArtifactRepositoryList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WorkflowArtifactRepositoryStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.LastProbedAt != nil {
+		l = m.LastProbedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *WorkflowEventBinding) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17267,6 +20351,28 @@ func (m *WorkflowSpec) Size() (n int) {
 		l = m.ArtifactGC.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Imports) > 0 {
+		for _, e := range m.Imports {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.SchedulingGates) > 0 {
+		for _, e := range m.SchedulingGates {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Notifications) > 0 {
+		for _, e := range m.Notifications {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.StepThrough != nil {
+		l = m.StepThrough.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17357,6 +20463,39 @@ func (m *WorkflowStatus) Size() (n int) {
 			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if len(m.TemplateDigests) > 0 {
+		for k, v := range m.TemplateDigests {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ResourceRecommendations) > 0 {
+		for k, v := range m.ResourceRecommendations {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.EstimatedCost != nil {
+		l = m.EstimatedCost.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.ImageDigests) > 0 {
+		for k, v := range m.ImageDigests {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.ArtifactManifest != nil {
+		l = m.ArtifactManifest.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17549,6 +20688,41 @@ func (m *WorkflowTemplateRef) Size() (n int) {
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
 	n += 2
+	l = len(m.Digest)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WorkflowTemplateRevision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Digest)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Revision))
+	l = m.CreationTimestamp.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WorkflowTemplateRevisionList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -17567,6 +20741,20 @@ func sovGenerated(x uint64) (n int) {
 func sozGenerated(x uint64) (n int) {
 	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (this *AWSSigV4Auth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AWSSigV4Auth{`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
+		`AccessKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccessKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`SecretKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`SessionTokenSecret:` + strings.Replace(fmt.Sprintf("%v", this.SessionTokenSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Amount) String() string {
 	if this == nil {
 		return "nil"
@@ -17646,6 +20834,11 @@ func (this *Artifact) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForAggregated := "[]ArtifactLocation{"
+	for _, f := range this.Aggregated {
+		repeatedStringForAggregated += strings.Replace(strings.Replace(f.String(), "ArtifactLocation", "ArtifactLocation", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForAggregated += "}"
 	s := strings.Join([]string{`&Artifact{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
@@ -17660,6 +20853,10 @@ func (this *Artifact) String() string {
 		`FromExpression:` + fmt.Sprintf("%v", this.FromExpression) + `,`,
 		`ArtifactGC:` + strings.Replace(this.ArtifactGC.String(), "ArtifactGC", "ArtifactGC", 1) + `,`,
 		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
+		`Aggregate:` + fmt.Sprintf("%v", this.Aggregate) + `,`,
+		`Aggregated:` + repeatedStringForAggregated + `,`,
+		`SizeBytes:` + fmt.Sprintf("%v", this.SizeBytes) + `,`,
+		`Checksum:` + fmt.Sprintf("%v", this.Checksum) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17963,6 +21160,18 @@ func (this *Cache) String() string {
 	}, "")
 	return s
 }
+func (this *ChildWorkflowTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChildWorkflowTemplate{`,
+		`Metadata:` + strings.Replace(strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
+		`Cascade:` + fmt.Sprintf("%v", this.Cascade) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ClientCertAuth) String() string {
 	if this == nil {
 		return "nil"
@@ -18170,6 +21379,53 @@ func (this *CronWorkflowStatus) String() string {
 		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
 		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`LastSuccessfulTime:` + strings.Replace(fmt.Sprintf("%v", this.LastSuccessfulTime), "Time", "v11.Time", 1) + `,`,
+		`ConsecutiveFailures:` + fmt.Sprintf("%v", this.ConsecutiveFailures) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DAGHook) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DAGHook{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
+		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
+		`TemplateRef:` + strings.Replace(this.TemplateRef.String(), "TemplateRef", "TemplateRef", 1) + `,`,
+		`Tasks:` + fmt.Sprintf("%v", this.Tasks) + `,`,
+		`Phases:` + fmt.Sprintf("%v", this.Phases) + `,`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DAGOneOf) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForBranches := "[]DAGOneOfBranch{"
+	for _, f := range this.Branches {
+		repeatedStringForBranches += strings.Replace(strings.Replace(f.String(), "DAGOneOfBranch", "DAGOneOfBranch", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForBranches += "}"
+	s := strings.Join([]string{`&DAGOneOf{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`Branches:` + repeatedStringForBranches + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DAGOneOfBranch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DAGOneOfBranch{`,
+		`Task:` + fmt.Sprintf("%v", this.Task) + `,`,
+		`Values:` + fmt.Sprintf("%v", this.Values) + `,`,
+		`Default:` + fmt.Sprintf("%v", this.Default) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18221,10 +21477,22 @@ func (this *DAGTemplate) String() string {
 		repeatedStringForTasks += strings.Replace(strings.Replace(f.String(), "DAGTask", "DAGTask", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForTasks += "}"
+	repeatedStringForHooks := "[]DAGHook{"
+	for _, f := range this.Hooks {
+		repeatedStringForHooks += strings.Replace(strings.Replace(f.String(), "DAGHook", "DAGHook", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHooks += "}"
+	repeatedStringForOneOf := "[]DAGOneOf{"
+	for _, f := range this.OneOf {
+		repeatedStringForOneOf += strings.Replace(strings.Replace(f.String(), "DAGOneOf", "DAGOneOf", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForOneOf += "}"
 	s := strings.Join([]string{`&DAGTemplate{`,
 		`Target:` + fmt.Sprintf("%v", this.Target) + `,`,
 		`Tasks:` + repeatedStringForTasks + `,`,
 		`FailFast:` + valueToStringGenerated(this.FailFast) + `,`,
+		`Hooks:` + repeatedStringForHooks + `,`,
+		`OneOf:` + repeatedStringForOneOf + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18261,6 +21529,30 @@ func (this *Event) String() string {
 	}
 	s := strings.Join([]string{`&Event{`,
 		`Selector:` + fmt.Sprintf("%v", this.Selector) + `,`,
+		`Idempotency:` + strings.Replace(this.Idempotency.String(), "EventIdempotency", "EventIdempotency", 1) + `,`,
+		`Schema:` + strings.Replace(this.Schema.String(), "EventSchema", "EventSchema", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventIdempotency) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventIdempotency{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Window:` + strings.Replace(fmt.Sprintf("%v", this.Window), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventSchema) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventSchema{`,
+		`Inline:` + strings.Replace(this.Inline.String(), "Object", "Object", 1) + `,`,
+		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18271,6 +21563,8 @@ func (this *ExecutorConfig) String() string {
 	}
 	s := strings.Join([]string{`&ExecutorConfig{`,
 		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
+		`Resources:` + strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceRequirements", "v1.ResourceRequirements", 1) + `,`,
+		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "SecurityContext", "v1.SecurityContext", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18308,6 +21602,17 @@ func (this *GCSBucket) String() string {
 	}, "")
 	return s
 }
+func (this *GPURequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GPURequest{`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Gauge) String() string {
 	if this == nil {
 		return "nil"
@@ -18316,6 +21621,8 @@ func (this *Gauge) String() string {
 		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
 		`Realtime:` + valueToStringGenerated(this.Realtime) + `,`,
 		`Operation:` + fmt.Sprintf("%v", this.Operation) + `,`,
+		`TTL:` + strings.Replace(fmt.Sprintf("%v", this.TTL), "Duration", "v11.Duration", 1) + `,`,
+		`DeleteOnCompletion:` + fmt.Sprintf("%v", this.DeleteOnCompletion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18411,6 +21718,7 @@ func (this *HTTP) String() string {
 		`SuccessCondition:` + fmt.Sprintf("%v", this.SuccessCondition) + `,`,
 		`InsecureSkipVerify:` + fmt.Sprintf("%v", this.InsecureSkipVerify) + `,`,
 		`BodyFrom:` + strings.Replace(this.BodyFrom.String(), "HTTPBodySource", "HTTPBodySource", 1) + `,`,
+		`Auth:` + strings.Replace(this.Auth.String(), "HTTPTemplateAuth", "HTTPTemplateAuth", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18476,6 +21784,17 @@ func (this *HTTPHeaderSource) String() string {
 	}, "")
 	return s
 }
+func (this *HTTPTemplateAuth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPTemplateAuth{`,
+		`OAuth2:` + strings.Replace(this.OAuth2.String(), "OAuth2Auth", "OAuth2Auth", 1) + `,`,
+		`AWSSigV4:` + strings.Replace(this.AWSSigV4.String(), "AWSSigV4Auth", "AWSSigV4Auth", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Header) String() string {
 	if this == nil {
 		return "nil"
@@ -18524,6 +21843,18 @@ func (this *Inputs) String() string {
 	}, "")
 	return s
 }
+func (this *JobTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobTemplate{`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Metadata:` + strings.Replace(strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "Object", "Object", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *LabelKeys) String() string {
 	if this == nil {
 		return "nil"
@@ -18722,6 +22053,7 @@ func (this *NodeFlag) String() string {
 	s := strings.Join([]string{`&NodeFlag{`,
 		`Hooked:` + fmt.Sprintf("%v", this.Hooked) + `,`,
 		`Retried:` + fmt.Sprintf("%v", this.Retried) + `,`,
+		`StepThrough:` + fmt.Sprintf("%v", this.StepThrough) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18730,11 +22062,33 @@ func (this *NodeResult) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForPeakResourceUsage := make([]string, 0, len(this.PeakResourceUsage))
+	for k := range this.PeakResourceUsage {
+		keysForPeakResourceUsage = append(keysForPeakResourceUsage, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForPeakResourceUsage)
+	mapStringForPeakResourceUsage := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForPeakResourceUsage {
+		mapStringForPeakResourceUsage += fmt.Sprintf("%v: %v,", k, this.PeakResourceUsage[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForPeakResourceUsage += "}"
+	keysForIntermediateParameters := make([]string, 0, len(this.IntermediateParameters))
+	for k := range this.IntermediateParameters {
+		keysForIntermediateParameters = append(keysForIntermediateParameters, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForIntermediateParameters)
+	mapStringForIntermediateParameters := "map[string]string{"
+	for _, k := range keysForIntermediateParameters {
+		mapStringForIntermediateParameters += fmt.Sprintf("%v: %v,", k, this.IntermediateParameters[k])
+	}
+	mapStringForIntermediateParameters += "}"
 	s := strings.Join([]string{`&NodeResult{`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`Outputs:` + strings.Replace(this.Outputs.String(), "Outputs", "Outputs", 1) + `,`,
 		`Progress:` + fmt.Sprintf("%v", this.Progress) + `,`,
+		`PeakResourceUsage:` + mapStringForPeakResourceUsage + `,`,
+		`IntermediateParameters:` + mapStringForIntermediateParameters + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18753,6 +22107,36 @@ func (this *NodeStatus) String() string {
 		mapStringForResourcesDuration += fmt.Sprintf("%v: %v,", k, this.ResourcesDuration[k8s_io_api_core_v1.ResourceName(k)])
 	}
 	mapStringForResourcesDuration += "}"
+	keysForPeakResourceUsage := make([]string, 0, len(this.PeakResourceUsage))
+	for k := range this.PeakResourceUsage {
+		keysForPeakResourceUsage = append(keysForPeakResourceUsage, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForPeakResourceUsage)
+	mapStringForPeakResourceUsage := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForPeakResourceUsage {
+		mapStringForPeakResourceUsage += fmt.Sprintf("%v: %v,", k, this.PeakResourceUsage[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForPeakResourceUsage += "}"
+	keysForIntermediateParameters := make([]string, 0, len(this.IntermediateParameters))
+	for k := range this.IntermediateParameters {
+		keysForIntermediateParameters = append(keysForIntermediateParameters, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForIntermediateParameters)
+	mapStringForIntermediateParameters := "map[string]string{"
+	for _, k := range keysForIntermediateParameters {
+		mapStringForIntermediateParameters += fmt.Sprintf("%v: %v,", k, this.IntermediateParameters[k])
+	}
+	mapStringForIntermediateParameters += "}"
+	keysForImageDigests := make([]string, 0, len(this.ImageDigests))
+	for k := range this.ImageDigests {
+		keysForImageDigests = append(keysForImageDigests, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForImageDigests)
+	mapStringForImageDigests := "map[string]string{"
+	for _, k := range keysForImageDigests {
+		mapStringForImageDigests += fmt.Sprintf("%v: %v,", k, this.ImageDigests[k])
+	}
+	mapStringForImageDigests += "}"
 	s := strings.Join([]string{`&NodeStatus{`,
 		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
@@ -18780,6 +22164,13 @@ func (this *NodeStatus) String() string {
 		`Progress:` + fmt.Sprintf("%v", this.Progress) + `,`,
 		`NodeFlag:` + strings.Replace(this.NodeFlag.String(), "NodeFlag", "NodeFlag", 1) + `,`,
 		`TaskResultSynced:` + valueToStringGenerated(this.TaskResultSynced) + `,`,
+		`ProgressWeight:` + fmt.Sprintf("%v", this.ProgressWeight) + `,`,
+		`EstimatedDurationP90:` + fmt.Sprintf("%v", this.EstimatedDurationP90) + `,`,
+		`PeakResourceUsage:` + mapStringForPeakResourceUsage + `,`,
+		`IntermediateParameters:` + mapStringForIntermediateParameters + `,`,
+		`ArtifactRepositoryRef:` + strings.Replace(fmt.Sprintf("%v", this.ArtifactRepositoryRef), "ArtifactRepositoryRefStatus", "ArtifactRepositoryRefStatus", 1) + `,`,
+		`EstimatedCost:` + strings.Replace(this.EstimatedCost.String(), "Amount", "Amount", 1) + `,`,
+		`ImageDigests:` + mapStringForImageDigests + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18803,6 +22194,17 @@ func (this *NoneStrategy) String() string {
 	}, "")
 	return s
 }
+func (this *Notification) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Notification{`,
+		`Channel:` + fmt.Sprintf("%v", this.Channel) + `,`,
+		`Events:` + fmt.Sprintf("%v", this.Events) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *OAuth2Auth) String() string {
 	if this == nil {
 		return "nil"
@@ -18943,6 +22345,22 @@ func (this *Parameter) String() string {
 		`GlobalName:` + fmt.Sprintf("%v", this.GlobalName) + `,`,
 		`Enum:` + fmt.Sprintf("%v", this.Enum) + `,`,
 		`Description:` + valueToStringGenerated(this.Description) + `,`,
+		`Schema:` + strings.Replace(this.Schema.String(), "ParameterSchema", "ParameterSchema", 1) + `,`,
+		`Optional:` + fmt.Sprintf("%v", this.Optional) + `,`,
+		`Sensitive:` + fmt.Sprintf("%v", this.Sensitive) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ParameterSchema) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ParameterSchema{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Pattern:` + fmt.Sprintf("%v", this.Pattern) + `,`,
+		`Minimum:` + valueToStringGenerated(this.Minimum) + `,`,
+		`Maximum:` + valueToStringGenerated(this.Maximum) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19000,6 +22418,38 @@ func (this *RawArtifact) String() string {
 	}, "")
 	return s
 }
+func (this *ResourceRecommendation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForRequests := make([]string, 0, len(this.Requests))
+	for k := range this.Requests {
+		keysForRequests = append(keysForRequests, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRequests)
+	mapStringForRequests := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForRequests {
+		mapStringForRequests += fmt.Sprintf("%v: %v,", k, this.Requests[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForRequests += "}"
+	keysForLimits := make([]string, 0, len(this.Limits))
+	for k := range this.Limits {
+		keysForLimits = append(keysForLimits, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLimits)
+	mapStringForLimits := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForLimits {
+		mapStringForLimits += fmt.Sprintf("%v: %v,", k, this.Limits[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForLimits += "}"
+	s := strings.Join([]string{`&ResourceRecommendation{`,
+		`Requests:` + mapStringForRequests + `,`,
+		`Limits:` + mapStringForLimits + `,`,
+		`Samples:` + fmt.Sprintf("%v", this.Samples) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ResourceTemplate) String() string {
 	if this == nil {
 		return "nil"
@@ -19013,6 +22463,7 @@ func (this *ResourceTemplate) String() string {
 		`FailureCondition:` + fmt.Sprintf("%v", this.FailureCondition) + `,`,
 		`Flags:` + fmt.Sprintf("%v", this.Flags) + `,`,
 		`ManifestFrom:` + strings.Replace(this.ManifestFrom.String(), "ManifestFrom", "ManifestFrom", 1) + `,`,
+		`Cascade:` + fmt.Sprintf("%v", this.Cascade) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19107,6 +22558,18 @@ func (this *S3EncryptionOptions) String() string {
 	}, "")
 	return s
 }
+func (this *ScratchVolume) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScratchVolume{`,
+		`StorageSize:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StorageSize), "Quantity", "resource.Quantity", 1), `&`, ``, 1) + `,`,
+		`StorageClass:` + fmt.Sprintf("%v", this.StorageClass) + `,`,
+		`MountPath:` + fmt.Sprintf("%v", this.MountPath) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ScriptTemplate) String() string {
 	if this == nil {
 		return "nil"
@@ -19171,6 +22634,17 @@ func (this *Sequence) String() string {
 		`Start:` + strings.Replace(fmt.Sprintf("%v", this.Start), "IntOrString", "intstr.IntOrString", 1) + `,`,
 		`End:` + strings.Replace(fmt.Sprintf("%v", this.End), "IntOrString", "intstr.IntOrString", 1) + `,`,
 		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`Step:` + fmt.Sprintf("%v", this.Step) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StepThrough) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StepThrough{`,
+		`NodeFieldSelector:` + fmt.Sprintf("%v", this.NodeFieldSelector) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19337,6 +22811,11 @@ func (this *Template) String() string {
 		repeatedStringForHostAliases += fmt.Sprintf("%v", f) + ","
 	}
 	repeatedStringForHostAliases += "}"
+	repeatedStringForSchedulingGates := "[]PodSchedulingGate{"
+	for _, f := range this.SchedulingGates {
+		repeatedStringForSchedulingGates += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForSchedulingGates += "}"
 	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
 	for k := range this.NodeSelector {
 		keysForNodeSelector = append(keysForNodeSelector, k)
@@ -19397,6 +22876,41 @@ func (this *Template) String() string {
 		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTP", "HTTP", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "Plugin", "Plugin", 1) + `,`,
 		`Annotations:` + mapStringForAnnotations + `,`,
+		`Finally:` + strings.Replace(this.Finally.String(), "LifecycleHook", "LifecycleHook", 1) + `,`,
+		`Extends:` + strings.Replace(this.Extends.String(), "TemplateExtends", "TemplateExtends", 1) + `,`,
+		`ChildWorkflow:` + strings.Replace(this.ChildWorkflow.String(), "ChildWorkflowTemplate", "ChildWorkflowTemplate", 1) + `,`,
+		`ProgressWeight:` + valueToStringGenerated(this.ProgressWeight) + `,`,
+		`SchedulingGates:` + repeatedStringForSchedulingGates + `,`,
+		`PodDisruptionBudget:` + strings.Replace(fmt.Sprintf("%v", this.PodDisruptionBudget), "PodDisruptionBudgetSpec", "v12.PodDisruptionBudgetSpec", 1) + `,`,
+		`Scratch:` + strings.Replace(this.Scratch.String(), "ScratchVolume", "ScratchVolume", 1) + `,`,
+		`GPU:` + strings.Replace(this.GPU.String(), "GPURequest", "GPURequest", 1) + `,`,
+		`ArtifactRepositoryRef:` + strings.Replace(fmt.Sprintf("%v", this.ArtifactRepositoryRef), "ArtifactRepositoryRef", "ArtifactRepositoryRef", 1) + `,`,
+		`Job:` + strings.Replace(this.Job.String(), "JobTemplate", "JobTemplate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TemplateExtends) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TemplateExtends{`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
+		`TemplateRef:` + strings.Replace(this.TemplateRef.String(), "TemplateRef", "TemplateRef", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TemplateImport) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TemplateImport{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Registry:` + fmt.Sprintf("%v", this.Registry) + `,`,
+		`Repository:` + fmt.Sprintf("%v", this.Repository) + `,`,
+		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
+		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19448,6 +22962,8 @@ func (this *ValueFrom) String() string {
 		`Event:` + fmt.Sprintf("%v", this.Event) + `,`,
 		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
 		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`SecretKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeyRef), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Plugin:` + strings.Replace(this.Plugin.String(), "Plugin", "Plugin", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19519,6 +23035,50 @@ func (this *WorkflowArtifactGCTaskList) String() string {
 	}, "")
 	return s
 }
+func (this *WorkflowArtifactRepository) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowArtifactRepository{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ArtifactRepository", "ArtifactRepository", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "WorkflowArtifactRepositoryStatus", "WorkflowArtifactRepositoryStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowArtifactRepositoryList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]WorkflowArtifactRepository{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "WorkflowArtifactRepository", "WorkflowArtifactRepository", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&WorkflowArtifactRepositoryList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowArtifactRepositoryStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForConditions += "}"
+	s := strings.Join([]string{`&WorkflowArtifactRepositoryStatus{`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`LastProbedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastProbedAt), "Time", "v11.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *WorkflowEventBinding) String() string {
 	if this == nil {
 		return "nil"
@@ -19661,6 +23221,21 @@ func (this *WorkflowSpec) String() string {
 		repeatedStringForHostAliases += fmt.Sprintf("%v", f) + ","
 	}
 	repeatedStringForHostAliases += "}"
+	repeatedStringForImports := "[]TemplateImport{"
+	for _, f := range this.Imports {
+		repeatedStringForImports += strings.Replace(strings.Replace(f.String(), "TemplateImport", "TemplateImport", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForImports += "}"
+	repeatedStringForSchedulingGates := "[]PodSchedulingGate{"
+	for _, f := range this.SchedulingGates {
+		repeatedStringForSchedulingGates += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForSchedulingGates += "}"
+	repeatedStringForNotifications := "[]Notification{"
+	for _, f := range this.Notifications {
+		repeatedStringForNotifications += strings.Replace(strings.Replace(f.String(), "Notification", "Notification", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForNotifications += "}"
 	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
 	for k := range this.NodeSelector {
 		keysForNodeSelector = append(keysForNodeSelector, k)
@@ -19723,6 +23298,10 @@ func (this *WorkflowSpec) String() string {
 		`Hooks:` + mapStringForHooks + `,`,
 		`WorkflowMetadata:` + strings.Replace(this.WorkflowMetadata.String(), "WorkflowMetadata", "WorkflowMetadata", 1) + `,`,
 		`ArtifactGC:` + strings.Replace(this.ArtifactGC.String(), "WorkflowLevelArtifactGC", "WorkflowLevelArtifactGC", 1) + `,`,
+		`Imports:` + repeatedStringForImports + `,`,
+		`SchedulingGates:` + repeatedStringForSchedulingGates + `,`,
+		`Notifications:` + repeatedStringForNotifications + `,`,
+		`StepThrough:` + strings.Replace(this.StepThrough.String(), "StepThrough", "StepThrough", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19781,6 +23360,36 @@ func (this *WorkflowStatus) String() string {
 		mapStringForTaskResultsCompletionStatus += fmt.Sprintf("%v: %v,", k, this.TaskResultsCompletionStatus[k])
 	}
 	mapStringForTaskResultsCompletionStatus += "}"
+	keysForTemplateDigests := make([]string, 0, len(this.TemplateDigests))
+	for k := range this.TemplateDigests {
+		keysForTemplateDigests = append(keysForTemplateDigests, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTemplateDigests)
+	mapStringForTemplateDigests := "map[string]string{"
+	for _, k := range keysForTemplateDigests {
+		mapStringForTemplateDigests += fmt.Sprintf("%v: %v,", k, this.TemplateDigests[k])
+	}
+	mapStringForTemplateDigests += "}"
+	keysForResourceRecommendations := make([]string, 0, len(this.ResourceRecommendations))
+	for k := range this.ResourceRecommendations {
+		keysForResourceRecommendations = append(keysForResourceRecommendations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForResourceRecommendations)
+	mapStringForResourceRecommendations := "map[string]ResourceRecommendation{"
+	for _, k := range keysForResourceRecommendations {
+		mapStringForResourceRecommendations += fmt.Sprintf("%v: %v,", k, this.ResourceRecommendations[k])
+	}
+	mapStringForResourceRecommendations += "}"
+	keysForImageDigests := make([]string, 0, len(this.ImageDigests))
+	for k := range this.ImageDigests {
+		keysForImageDigests = append(keysForImageDigests, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForImageDigests)
+	mapStringForImageDigests := "map[string]string{"
+	for _, k := range keysForImageDigests {
+		mapStringForImageDigests += fmt.Sprintf("%v: %v,", k, this.ImageDigests[k])
+	}
+	mapStringForImageDigests += "}"
 	s := strings.Join([]string{`&WorkflowStatus{`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
 		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
@@ -19801,6 +23410,11 @@ func (this *WorkflowStatus) String() string {
 		`ArtifactRepositoryRef:` + strings.Replace(fmt.Sprintf("%v", this.ArtifactRepositoryRef), "ArtifactRepositoryRefStatus", "ArtifactRepositoryRefStatus", 1) + `,`,
 		`ArtifactGCStatus:` + strings.Replace(this.ArtifactGCStatus.String(), "ArtGCStatus", "ArtGCStatus", 1) + `,`,
 		`TaskResultsCompletionStatus:` + mapStringForTaskResultsCompletionStatus + `,`,
+		`TemplateDigests:` + mapStringForTemplateDigests + `,`,
+		`ResourceRecommendations:` + mapStringForResourceRecommendations + `,`,
+		`EstimatedCost:` + strings.Replace(this.EstimatedCost.String(), "Amount", "Amount", 1) + `,`,
+		`ImageDigests:` + mapStringForImageDigests + `,`,
+		`ArtifactManifest:` + strings.Replace(this.ArtifactManifest.String(), "Artifact", "Artifact", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19970,6 +23584,36 @@ func (this *WorkflowTemplateRef) String() string {
 	s := strings.Join([]string{`&WorkflowTemplateRef{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`ClusterScope:` + fmt.Sprintf("%v", this.ClusterScope) + `,`,
+		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowTemplateRevision) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowTemplateRevision{`,
+		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`CreationTimestamp:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreationTimestamp), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowTemplateRevisionList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]WorkflowTemplateRevision{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "WorkflowTemplateRevision", "WorkflowTemplateRevision", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&WorkflowTemplateRevisionList{`,
+		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19991,7 +23635,7 @@ func valueToStringGenerated(v interface{}) string {
 	pv := reflect.Indirect(rv).Interface()
 	return fmt.Sprintf("*%v", pv)
 }
-func (m *Amount) Unmarshal(dAtA []byte) error {
+func (m *AWSSigV4Auth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20014,15 +23658,15 @@ func (m *Amount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Amount: wiretype end group for non-group")
+			return fmt.Errorf("proto: AWSSigV4Auth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Amount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AWSSigV4Auth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20050,63 +23694,13 @@ func (m *Amount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = encoding_json.Number(dAtA[iNdEx:postIndex])
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ArchiveStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ArchiveStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArchiveStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tar", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20116,31 +23710,303 @@ func (m *ArchiveStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tar == nil {
-				m.Tar = &TarStrategy{}
-			}
-			if err := m.Tar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Service = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field None", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccessKeySecret == nil {
+				m.AccessKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecretKeySecret == nil {
+				m.SecretKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionTokenSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SessionTokenSecret == nil {
+				m.SessionTokenSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.SessionTokenSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Amount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Amount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Amount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = encoding_json.Number(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchiveStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchiveStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchiveStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tar", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tar == nil {
+				m.Tar = &TarStrategy{}
+			}
+			if err := m.Tar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field None", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21055,6 +24921,123 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Deleted = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aggregate = ArtifactAggregate(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aggregated = append(m.Aggregated, ArtifactLocation{})
+			if err := m.Aggregated[len(m.Aggregated)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22657,6 +26640,38 @@ func (m *ArtifactRepositoryRef) Unmarshal(dAtA []byte) error {
 			}
 			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowArtifactRepository", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowArtifactRepository = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24849,7 +28864,7 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClientCertAuth) Unmarshal(dAtA []byte) error {
+func (m *ChildWorkflowTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24872,15 +28887,15 @@ func (m *ClientCertAuth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClientCertAuth: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChildWorkflowTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClientCertAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChildWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientCertSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24907,102 +28922,13 @@ func (m *ClientCertAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientCertSecret == nil {
-				m.ClientCertSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.ClientCertSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientKeySecret", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ClientKeySecret == nil {
-				m.ClientKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.ClientKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25029,15 +28955,15 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cascade", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25047,24 +28973,23 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Cascade = CascadeStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25087,7 +29012,7 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
+func (m *ClientCertAuth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25110,15 +29035,15 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplateList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClientCertAuth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClientCertAuth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCertSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25145,13 +29070,16 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ClientCertSecret == nil {
+				m.ClientCertSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.ClientCertSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25178,8 +29106,10 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ClusterWorkflowTemplate{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ClientKeySecret == nil {
+				m.ClientKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.ClientKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25204,7 +29134,7 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Column) Unmarshal(dAtA []byte) error {
+func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25227,17 +29157,17 @@ func (m *Column) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Column: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterWorkflowTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Column: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25247,29 +29177,30 @@ func (m *Column) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25279,55 +29210,24 @@ func (m *Column) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25350,7 +29250,7 @@ func (m *Column) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Condition) Unmarshal(dAtA []byte) error {
+func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25373,17 +29273,17 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterWorkflowTemplateList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterWorkflowTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25393,29 +29293,30 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = ConditionType(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25425,55 +29326,25 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = k8s_io_apimachinery_pkg_apis_meta_v1.ConditionStatus(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, ClusterWorkflowTemplate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25496,7 +29367,7 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerNode) Unmarshal(dAtA []byte) error {
+func (m *Column) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25519,17 +29390,17 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: Column: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Column: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25539,28 +29410,27 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25588,7 +29458,39 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25611,7 +29513,7 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
+func (m *Condition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25634,15 +29536,15 @@ func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerSetRetryStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerSetRetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25670,13 +29572,13 @@ func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
+			m.Type = ConditionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25686,26 +29588,287 @@ func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Retries == nil {
-				m.Retries = &intstr.IntOrString{}
+			m.Status = k8s_io_apimachinery_pkg_apis_meta_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			if err := m.Retries.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerSetRetryStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerSetRetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retries == nil {
+				m.Retries = &intstr.IntOrString{}
+			}
+			if err := m.Retries.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -26989,6 +31152,61 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.Phase = CronWorkflowPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSuccessfulTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastSuccessfulTime == nil {
+				m.LastSuccessfulTime = &v11.Time{}
+			}
+			if err := m.LastSuccessfulTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27010,7 +31228,7 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DAGTask) Unmarshal(dAtA []byte) error {
+func (m *DAGHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27033,10 +31251,10 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DAGTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: DAGHook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DAGTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DAGHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -27174,7 +31392,7 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27202,45 +31420,11 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			m.Tasks = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithItems", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WithItems = append(m.WithItems, Item{})
-			if err := m.WithItems[len(m.WithItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithParam", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phases", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27268,47 +31452,11 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithParam = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithSequence", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WithSequence == nil {
-				m.WithSequence = &Sequence{}
-			}
-			if err := m.WithSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phases = append(m.Phases, NodePhase(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27336,47 +31484,61 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.When = string(dAtA[iNdEx:postIndex])
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOn", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContinueOn == nil {
-				m.ContinueOn = &ContinueOn{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DAGOneOf) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.ContinueOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 11:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DAGOneOf: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DAGOneOf: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnExit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27404,11 +31566,11 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OnExit = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depends", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27436,140 +31598,11 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depends = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Hooks == nil {
-				m.Hooks = make(LifecycleHooks)
-			}
-			var mapkey LifecycleEvent
-			mapvalue := &LifecycleHook{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = LifecycleEvent(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &LifecycleHook{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Hooks[LifecycleEvent(mapkey)] = *mapvalue
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branches", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27596,10 +31629,8 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Inline == nil {
-				m.Inline = &Template{}
-			}
-			if err := m.Inline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Branches = append(m.Branches, DAGOneOfBranch{})
+			if err := m.Branches[len(m.Branches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -27624,7 +31655,7 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
+func (m *DAGOneOfBranch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27647,15 +31678,15 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DAGTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: DAGOneOfBranch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DAGTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DAGOneOfBranch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27683,13 +31714,13 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
+			m.Task = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27699,29 +31730,27 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, DAGTask{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -27738,8 +31767,7 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.FailFast = &b
+			m.Default = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27761,7 +31789,7 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Data) Unmarshal(dAtA []byte) error {
+func (m *DAGTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27784,17 +31812,17 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Data: wiretype end group for non-group")
+			return fmt.Errorf("proto: DAGTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Data: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DAGTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27804,30 +31832,29 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transformation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27837,79 +31864,60 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Transformation = append(m.Transformation, TransformationStep{})
-			if err := m.Transformation[len(m.Transformation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DataSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DataSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactPaths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27936,66 +31944,16 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ArtifactPaths == nil {
-				m.ArtifactPaths = &ArtifactPaths{}
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
 			}
-			if err := m.ArtifactPaths.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Event) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Event: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28023,61 +31981,45 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Selector = string(dAtA[iNdEx:postIndex])
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithItems", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.WithItems = append(m.WithItems, Item{})
+			if err := m.WithItems[len(m.WithItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithParam", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28105,61 +32047,11 @@ func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			m.WithParam = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GCSArtifact: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithSequence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28186,13 +32078,16 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.WithSequence == nil {
+				m.WithSequence = &Sequence{}
+			}
+			if err := m.WithSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28220,61 +32115,11 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.When = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GCSArtifactRepository: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28301,13 +32146,16 @@ func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ContinueOn == nil {
+				m.ContinueOn = &ContinueOn{}
+			}
+			if err := m.ContinueOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnExit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28335,63 +32183,45 @@ func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyFormat = string(dAtA[iNdEx:postIndex])
+			m.OnExit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depends", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GCSBucket) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GCSBucket: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Depends = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28401,27 +32231,124 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
+			if m.Hooks == nil {
+				m.Hooks = make(LifecycleHooks)
+			}
+			var mapkey LifecycleEvent
+			mapvalue := &LifecycleHook{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = LifecycleEvent(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &LifecycleHook{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Hooks[LifecycleEvent(mapkey)] = *mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28448,10 +32375,10 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ServiceAccountKeySecret == nil {
-				m.ServiceAccountKeySecret = &v1.SecretKeySelector{}
+			if m.Inline == nil {
+				m.Inline = &Template{}
 			}
-			if err := m.ServiceAccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Inline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28476,7 +32403,7 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Gauge) Unmarshal(dAtA []byte) error {
+func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28499,15 +32426,15 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+			return fmt.Errorf("proto: DAGTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DAGTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28535,11 +32462,45 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tasks = append(m.Tasks, DAGTask{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Realtime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -28557,12 +32518,12 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				}
 			}
 			b := bool(v != 0)
-			m.Realtime = &b
-		case 3:
+			m.FailFast = &b
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28572,23 +32533,59 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operation = GaugeOperation(dAtA[iNdEx:postIndex])
+			m.Hooks = append(m.Hooks, DAGHook{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneOf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OneOf = append(m.OneOf, DAGOneOf{})
+			if err := m.OneOf[len(m.OneOf)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28611,7 +32608,7 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitArtifact) Unmarshal(dAtA []byte) error {
+func (m *Data) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28634,17 +32631,17 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: Data: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Data: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28654,29 +32651,30 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transformation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28686,49 +32684,81 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Transformation = append(m.Transformation, TransformationStep{})
+			if err := m.Transformation[len(m.Transformation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Depth = &v
-		case 4:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fetch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactPaths", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28738,29 +32768,83 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fetch = append(m.Fetch, string(dAtA[iNdEx:postIndex]))
+			if m.ArtifactPaths == nil {
+				m.ArtifactPaths = &ArtifactPaths{}
+			}
+			if err := m.ArtifactPaths.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28770,31 +32854,27 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Idempotency", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28821,16 +32901,16 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
+			if m.Idempotency == nil {
+				m.Idempotency = &EventIdempotency{}
 			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Idempotency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28857,76 +32937,66 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SSHPrivateKeySecret == nil {
-				m.SSHPrivateKeySecret = &v1.SecretKeySelector{}
+			if m.Schema == nil {
+				m.Schema = &EventSchema{}
 			}
-			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.InsecureIgnoreHostKey = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableSubmodules", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventIdempotency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			m.DisableSubmodules = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SingleBranch", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.SingleBranch = bool(v != 0)
-		case 11:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventIdempotency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventIdempotency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28954,13 +33024,13 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLS", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28970,12 +33040,28 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.InsecureSkipTLS = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Window == nil {
+				m.Window = &v11.Duration{}
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28997,7 +33083,7 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
+func (m *EventSchema) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29020,15 +33106,15 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSchema: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSchema: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29055,15 +33141,18 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Inline == nil {
+				m.Inline = &Object{}
+			}
+			if err := m.Inline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29073,44 +33162,28 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			if m.ConfigMapKeyRef == nil {
+				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Force = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29132,7 +33205,7 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSArtifactRepository) Unmarshal(dAtA []byte) error {
+func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29155,17 +33228,17 @@ func (m *HDFSArtifactRepository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSArtifactRepository: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29175,30 +33248,29 @@ func (m *HDFSArtifactRepository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathFormat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29208,29 +33280,33 @@ func (m *HDFSArtifactRepository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathFormat = string(dAtA[iNdEx:postIndex])
+			if m.Resources == nil {
+				m.Resources = &v1.ResourceRequirements{}
+			}
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29240,12 +33316,28 @@ func (m *HDFSArtifactRepository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecurityContext == nil {
+				m.SecurityContext = &v1.SecurityContext{}
+			}
+			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29267,7 +33359,7 @@ func (m *HDFSArtifactRepository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
+func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29290,15 +33382,15 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: GCSArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GCSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSKrbConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29325,13 +33417,13 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HDFSKrbConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29359,13 +33451,63 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GCSArtifactRepository: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GCSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29375,27 +33517,28 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HDFSUser = string(dAtA[iNdEx:postIndex])
+			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataTransferProtection", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29423,7 +33566,7 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataTransferProtection = string(dAtA[iNdEx:postIndex])
+			m.KeyFormat = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29446,7 +33589,7 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
+func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29469,17 +33612,17 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSKrbConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: GCSBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSKrbConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GCSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29489,31 +33632,27 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbCCacheSecret == nil {
-				m.KrbCCacheSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29540,16 +33679,85 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbKeytabSecret == nil {
-				m.KrbKeytabSecret = &v1.SecretKeySelector{}
+			if m.ServiceAccountKeySecret == nil {
+				m.ServiceAccountKeySecret = &v1.SecretKeySelector{}
 			}
-			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ServiceAccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GPURequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GPURequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GPURequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29577,11 +33785,61 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbUsername = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Gauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29609,13 +33867,34 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbRealm = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Realtime", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Realtime = &b
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29625,33 +33904,29 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbConfigConfigMap == nil {
-				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
-			}
-			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operation = GaugeOperation(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29661,24 +33936,48 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
+			if m.TTL == nil {
+				m.TTL = &v11.Duration{}
+			}
+			if err := m.TTL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteOnCompletion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeleteOnCompletion = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29700,7 +33999,7 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTP) Unmarshal(dAtA []byte) error {
+func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29723,15 +34022,15 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTP: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29759,11 +34058,11 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Method = string(dAtA[iNdEx:postIndex])
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29791,13 +34090,33 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Depth = &v
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fetch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29807,31 +34126,29 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headers = append(m.Headers, HTTPHeader{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Fetch = append(m.Fetch, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
 			}
-			var v int64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29841,17 +34158,33 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TimeoutSeconds = &v
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UsernameSecret == nil {
+				m.UsernameSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29861,29 +34194,33 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Body = string(dAtA[iNdEx:postIndex])
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKeySecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29893,27 +34230,31 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
+			if m.SSHPrivateKeySecret == nil {
+				m.SSHPrivateKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipVerify", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -29930,12 +34271,52 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.InsecureSkipVerify = bool(v != 0)
-		case 8:
+			m.InsecureIgnoreHostKey = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableSubmodules", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableSubmodules = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SingleBranch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SingleBranch = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BodyFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29945,28 +34326,44 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BodyFrom == nil {
-				m.BodyFrom = &HTTPBodySource{}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLS", wireType)
 			}
-			if err := m.BodyFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.InsecureSkipTLS = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29988,7 +34385,7 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
+func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30011,17 +34408,17 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30031,29 +34428,30 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30063,31 +34461,29 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headers = append(m.Headers, Header{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30097,28 +34493,12 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Auth == nil {
-				m.Auth = &HTTPAuth{}
-			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -30140,7 +34520,7 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPAuth) Unmarshal(dAtA []byte) error {
+func (m *HDFSArtifactRepository) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30163,15 +34543,15 @@ func (m *HTTPAuth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPAuth: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSArtifactRepository: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientCert", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30198,15 +34578,15 @@ func (m *HTTPAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClientCert.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OAuth2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PathFormat", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30216,30 +34596,29 @@ func (m *HTTPAuth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OAuth2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PathFormat = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30249,25 +34628,12 @@ func (m *HTTPAuth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -30289,7 +34655,7 @@ func (m *HTTPAuth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPBodySource) Unmarshal(dAtA []byte) error {
+func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30312,17 +34678,17 @@ func (m *HTTPBodySource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPBodySource: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPBodySource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSKrbConfig", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30332,79 +34698,28 @@ func (m *HTTPBodySource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bytes = append(m.Bytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.Bytes == nil {
-				m.Bytes = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.HDFSKrbConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPHeader: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPHeader: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30432,11 +34747,11 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30464,13 +34779,13 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.HDFSUser = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTransferProtection", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30480,27 +34795,23 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueFrom == nil {
-				m.ValueFrom = &HTTPHeaderSource{}
-			}
-			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DataTransferProtection = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30523,7 +34834,7 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
+func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30546,15 +34857,15 @@ func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPHeaderSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSKrbConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPHeaderSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSKrbConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30581,66 +34892,84 @@ func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKeyRef == nil {
-				m.SecretKeyRef = &v1.SecretKeySelector{}
+			if m.KrbCCacheSecret == nil {
+				m.KrbCCacheSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Header) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if m.KrbKeytabSecret == nil {
+				m.KrbKeytabSecret = &v1.SecretKeySelector{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Header: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KrbUsername = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30668,11 +34997,47 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.KrbRealm = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KrbConfigConfigMap == nil {
+				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
+			}
+			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30700,7 +35065,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30723,7 +35088,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Histogram) Unmarshal(dAtA []byte) error {
+func (m *HTTP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30746,15 +35111,15 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30782,11 +35147,43 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30813,64 +35210,118 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Buckets = append(m.Buckets, Amount{})
-			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Headers = append(m.Headers, HTTPHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimeoutSeconds = &v
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Inputs) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipVerify", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Inputs: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Inputs: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureSkipVerify = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyFrom", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30897,14 +35348,16 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, Parameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BodyFrom == nil {
+				m.BodyFrom = &HTTPBodySource{}
+			}
+			if err := m.BodyFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30931,8 +35384,10 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Artifacts = append(m.Artifacts, Artifact{})
-			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Auth == nil {
+				m.Auth = &HTTPTemplateAuth{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30957,7 +35412,7 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Item) Unmarshal(dAtA []byte) error {
+func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30980,17 +35435,17 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Item: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31000,81 +35455,63 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LabelKeys) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Headers = append(m.Headers, Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LabelKeys: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LabelKeys: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31084,23 +35521,27 @@ func (m *LabelKeys) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, string(dAtA[iNdEx:postIndex]))
+			if m.Auth == nil {
+				m.Auth = &HTTPAuth{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31123,7 +35564,7 @@ func (m *LabelKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LabelValueFrom) Unmarshal(dAtA []byte) error {
+func (m *HTTPAuth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31146,17 +35587,17 @@ func (m *LabelValueFrom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LabelValueFrom: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPAuth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LabelValueFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPAuth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCert", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31166,23 +35607,90 @@ func (m *LabelValueFrom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
+			if err := m.ClientCert.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OAuth2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OAuth2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31205,7 +35713,7 @@ func (m *LabelValueFrom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LabelValues) Unmarshal(dAtA []byte) error {
+func (m *HTTPBodySource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31228,17 +35736,17 @@ func (m *LabelValues) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LabelValues: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPBodySource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LabelValues: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPBodySource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31248,23 +35756,25 @@ func (m *LabelValues) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, string(dAtA[iNdEx:postIndex]))
+			m.Bytes = append(m.Bytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bytes == nil {
+				m.Bytes = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31287,7 +35797,7 @@ func (m *LabelValues) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
+func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31310,15 +35820,15 @@ func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LifecycleHook: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LifecycleHook: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31346,13 +35856,13 @@ func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31362,28 +35872,27 @@ func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31410,45 +35919,13 @@ func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemplateRef == nil {
-				m.TemplateRef = &TemplateRef{}
+			if m.ValueFrom == nil {
+				m.ValueFrom = &HTTPHeaderSource{}
 			}
-			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -31470,7 +35947,7 @@ func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Link) Unmarshal(dAtA []byte) error {
+func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31493,81 +35970,17 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Link: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPHeaderSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Link: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPHeaderSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Scope = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31577,55 +35990,27 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.SecretKeyRef == nil {
+				m.SecretKeyRef = &v1.SecretKeySelector{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31648,7 +36033,7 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ManifestFrom) Unmarshal(dAtA []byte) error {
+func (m *HTTPTemplateAuth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31671,15 +36056,15 @@ func (m *ManifestFrom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManifestFrom: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPTemplateAuth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManifestFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPTemplateAuth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OAuth2", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31706,10 +36091,46 @@ func (m *ManifestFrom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Artifact == nil {
-				m.Artifact = &Artifact{}
+			if m.OAuth2 == nil {
+				m.OAuth2 = &OAuth2Auth{}
 			}
-			if err := m.Artifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OAuth2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSSigV4", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AWSSigV4 == nil {
+				m.AWSSigV4 = &AWSSigV4Auth{}
+			}
+			if err := m.AWSSigV4.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -31734,7 +36155,7 @@ func (m *ManifestFrom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
+func (m *Header) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31757,35 +36178,15 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemoizationStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemoizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hit", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Hit = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31813,11 +36214,11 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31845,7 +36246,7 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CacheName = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31868,7 +36269,7 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Memoize) Unmarshal(dAtA []byte) error {
+func (m *Histogram) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31891,15 +36292,15 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Memoize: wiretype end group for non-group")
+			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Memoize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31927,11 +36328,11 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31958,45 +36359,11 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cache == nil {
-				m.Cache = &Cache{}
-			}
-			if err := m.Cache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Buckets = append(m.Buckets, Amount{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MaxAge = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32018,7 +36385,7 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *Inputs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32041,15 +36408,15 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: Inputs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Inputs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32076,107 +36443,14 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Parameters = append(m.Parameters, Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32203,103 +36477,10 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Artifacts = append(m.Artifacts, Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32322,7 +36503,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetricLabel) Unmarshal(dAtA []byte) error {
+func (m *Item) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32345,49 +36526,17 @@ func (m *MetricLabel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetricLabel: wiretype end group for non-group")
+			return fmt.Errorf("proto: Item: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetricLabel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32397,23 +36546,25 @@ func (m *MetricLabel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32436,7 +36587,7 @@ func (m *MetricLabel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metrics) Unmarshal(dAtA []byte) error {
+func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32459,15 +36610,47 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metrics: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metrics: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = JobKind(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32494,8 +36677,40 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prometheus = append(m.Prometheus, &Prometheus{})
-			if err := m.Prometheus[len(m.Prometheus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -32520,7 +36735,7 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Mutex) Unmarshal(dAtA []byte) error {
+func (m *LabelKeys) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32543,47 +36758,15 @@ func (m *Mutex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Mutex: wiretype end group for non-group")
+			return fmt.Errorf("proto: LabelKeys: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Mutex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LabelKeys: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32611,28 +36794,8 @@ func (m *Mutex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Database = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32654,7 +36817,7 @@ func (m *Mutex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MutexHolding) Unmarshal(dAtA []byte) error {
+func (m *LabelValueFrom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32677,15 +36840,15 @@ func (m *MutexHolding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MutexHolding: wiretype end group for non-group")
+			return fmt.Errorf("proto: LabelValueFrom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MutexHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LabelValueFrom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32713,11 +36876,61 @@ func (m *MutexHolding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mutex = string(dAtA[iNdEx:postIndex])
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LabelValues) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LabelValues: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LabelValues: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32745,7 +36958,7 @@ func (m *MutexHolding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holder = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32768,7 +36981,7 @@ func (m *MutexHolding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MutexStatus) Unmarshal(dAtA []byte) error {
+func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32791,15 +37004,47 @@ func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MutexStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: LifecycleHook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MutexStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LifecycleHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32826,14 +37071,13 @@ func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holding = append(m.Holding, MutexHolding{})
-			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32860,66 +37104,18 @@ func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Waiting = append(m.Waiting, MutexHolding{})
-			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
+			}
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NodeFlag) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NodeFlag: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeFlag: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hooked", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32929,32 +37125,24 @@ func (m *NodeFlag) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Hooked = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retried", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Retried = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32976,7 +37164,7 @@ func (m *NodeFlag) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeResult) Unmarshal(dAtA []byte) error {
+func (m *Link) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32999,15 +37187,15 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: Link: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Link: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33035,11 +37223,11 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33067,13 +37255,13 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Scope = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33083,31 +37271,27 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Outputs == nil {
-				m.Outputs = &Outputs{}
-			}
-			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33135,7 +37319,7 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Progress = Progress(dAtA[iNdEx:postIndex])
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -33158,7 +37342,7 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeStatus) Unmarshal(dAtA []byte) error {
+func (m *ManifestFrom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33181,17 +37365,17 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManifestFrom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManifestFrom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifact", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33201,29 +37385,83 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			if m.Artifact == nil {
+				m.Artifact = &Artifact{}
+			}
+			if err := m.Artifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemoizationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemoizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hit", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33233,27 +37471,15 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.Hit = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33281,11 +37507,11 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33313,11 +37539,61 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = NodeType(dAtA[iNdEx:postIndex])
+			m.CacheName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Memoize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Memoize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Memoize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33345,11 +37621,11 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33376,16 +37652,16 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemplateRef == nil {
-				m.TemplateRef = &TemplateRef{}
+			if m.Cache == nil {
+				m.Cache = &Cache{}
 			}
-			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33413,75 +37689,61 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
+			m.MaxAge = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BoundaryID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BoundaryID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33508,13 +37770,107 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33541,13 +37897,157 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetricLabel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricLabel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricLabel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodIP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33575,11 +38075,241 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodIP = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metrics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metrics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metrics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prometheus = append(m.Prometheus, &Prometheus{})
+			if err := m.Prometheus[len(m.Prometheus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Mutex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Mutex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Mutex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Daemoned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -33596,11 +38326,3165 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Daemoned = &b
-		case 14:
+			m.Database = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MutexHolding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MutexHolding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MutexHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mutex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MutexStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MutexStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MutexStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holding = append(m.Holding, MutexHolding{})
+			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Waiting = append(m.Waiting, MutexHolding{})
+			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeFlag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeFlag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeFlag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hooked = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retried", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Retried = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepThrough", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StepThrough = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Outputs == nil {
+				m.Outputs = &Outputs{}
+			}
+			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Progress = Progress(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeakResourceUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PeakResourceUsage == nil {
+				m.PeakResourceUsage = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PeakResourceUsage[k8s_io_api_core_v1.ResourceName(mapkey)] = ((k8s_io_apimachinery_pkg_api_resource.Quantity)(*mapvalue))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediateParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IntermediateParameters == nil {
+				m.IntermediateParameters = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.IntermediateParameters[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = NodeType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
+			}
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundaryID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BoundaryID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodIP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Daemoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Daemoned = &b
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Inputs == nil {
+				m.Inputs = &Inputs{}
+			}
+			if err := m.Inputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Outputs == nil {
+				m.Outputs = &Outputs{}
+			}
+			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundNodes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundNodes = append(m.OutboundNodes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateScope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateScope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourcesDuration == nil {
+				m.ResourcesDuration = make(ResourcesDuration)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ResourcesDuration[k8s_io_api_core_v1.ResourceName(mapkey)] = ((ResourceDuration)(mapvalue))
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostNodeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostNodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoizationStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MemoizationStatus == nil {
+				m.MemoizationStatus = &MemoizationStatus{}
+			}
+			if err := m.MemoizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDuration", wireType)
+			}
+			m.EstimatedDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedDuration |= EstimatedDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SynchronizationStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SynchronizationStatus == nil {
+				m.SynchronizationStatus = &NodeSynchronizationStatus{}
+			}
+			if err := m.SynchronizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Progress = Progress(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFlag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeFlag == nil {
+				m.NodeFlag = &NodeFlag{}
+			}
+			if err := m.NodeFlag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskResultSynced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.TaskResultSynced = &b
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressWeight", wireType)
+			}
+			m.ProgressWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProgressWeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDurationP90", wireType)
+			}
+			m.EstimatedDurationP90 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedDurationP90 |= EstimatedDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeakResourceUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PeakResourceUsage == nil {
+				m.PeakResourceUsage = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PeakResourceUsage[k8s_io_api_core_v1.ResourceName(mapkey)] = ((k8s_io_apimachinery_pkg_api_resource.Quantity)(*mapvalue))
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediateParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IntermediateParameters == nil {
+				m.IntermediateParameters = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.IntermediateParameters[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactRepositoryRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArtifactRepositoryRef == nil {
+				m.ArtifactRepositoryRef = &ArtifactRepositoryRefStatus{}
+			}
+			if err := m.ArtifactRepositoryRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedCost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EstimatedCost == nil {
+				m.EstimatedCost = &Amount{}
+			}
+			if err := m.EstimatedCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageDigests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ImageDigests == nil {
+				m.ImageDigests = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ImageDigests[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeSynchronizationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeSynchronizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Waiting = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoneStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoneStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoneStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Notification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Notification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Notification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, WorkflowPhase(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OAuth2Auth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OAuth2Auth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OAuth2Auth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIDSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientIDSecret == nil {
+				m.ClientIDSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.ClientIDSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSecretSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientSecretSecret == nil {
+				m.ClientSecretSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.ClientSecretSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenURLSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TokenURLSecret == nil {
+				m.TokenURLSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.TokenURLSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndpointParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndpointParams = append(m.EndpointParams, OAuth2EndpointParam{})
+			if err := m.EndpointParams[len(m.EndpointParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OAuth2EndpointParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OAuth2EndpointParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OAuth2EndpointParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSSArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OSSBucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OSSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSSArtifactRepository: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OSSBucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OSSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OSSBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSSBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33627,16 +41511,16 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Inputs == nil {
-				m.Inputs = &Inputs{}
+			if m.AccessKeySecret == nil {
+				m.AccessKeySecret = &v1.SecretKeySelector{}
 			}
-			if err := m.Inputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33663,18 +41547,18 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Outputs == nil {
-				m.Outputs = &Outputs{}
+			if m.SecretKeySecret == nil {
+				m.SecretKeySecret = &v1.SecretKeySelector{}
 			}
-			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketIfNotPresent", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33684,27 +41568,15 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 17:
+			m.CreateBucketIfNotPresent = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutboundNodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33732,13 +41604,13 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutboundNodes = append(m.OutboundNodes, string(dAtA[iNdEx:postIndex]))
+			m.SecurityToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LifecycleRule", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33748,29 +41620,33 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemplateScope = string(dAtA[iNdEx:postIndex])
+			if m.LifecycleRule == nil {
+				m.LifecycleRule = &OSSLifecycleRule{}
+			}
+			if err := m.LifecycleRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesDuration", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseSDKCreds", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33780,110 +41656,86 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.UseSDKCreds = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourcesDuration == nil {
-				m.ResourcesDuration = make(ResourcesDuration)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			var mapkey k8s_io_api_core_v1.ResourceName
-			var mapvalue int64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSSLifecycleRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSSLifecycleRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkInfrequentAccessAfterDays", wireType)
+			}
+			m.MarkInfrequentAccessAfterDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarkInfrequentAccessAfterDays |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.ResourcesDuration[k8s_io_api_core_v1.ResourceName(mapkey)] = ((ResourceDuration)(mapvalue))
-			iNdEx = postIndex
-		case 22:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostNodeName", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkDeletionAfterDays", wireType)
 			}
-			var stringLen uint64
+			m.MarkDeletionAfterDays = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33893,29 +41745,66 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MarkDeletionAfterDays |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostNodeName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 23:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Object) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Object: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemoizationStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33925,33 +41814,81 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MemoizationStatus == nil {
-				m.MemoizationStatus = &MemoizationStatus{}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
-			if err := m.MemoizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 24:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDuration", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.EstimatedDuration = 0
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Outputs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Outputs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Outputs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33961,14 +41898,29 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EstimatedDuration |= EstimatedDuration(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 25:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SynchronizationStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33995,16 +41947,14 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SynchronizationStatus == nil {
-				m.SynchronizationStatus = &NodeSynchronizationStatus{}
-			}
-			if err := m.SynchronizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Artifacts = append(m.Artifacts, Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34032,13 +41982,14 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Progress = Progress(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Result = &s
 			iNdEx = postIndex
-		case 27:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeFlag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34048,49 +41999,25 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeFlag == nil {
-				m.NodeFlag = &NodeFlag{}
-			}
-			if err := m.NodeFlag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ExitCode = &s
 			iNdEx = postIndex
-		case 28:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskResultSynced", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.TaskResultSynced = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34112,7 +42039,7 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
+func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34135,17 +42062,17 @@ func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeSynchronizationStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParallelSteps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeSynchronizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParallelSteps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34155,74 +42082,26 @@ func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Waiting = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			m.Steps = append(m.Steps, WorkflowStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NoneStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NoneStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NoneStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34244,7 +42123,7 @@ func (m *NoneStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OAuth2Auth) Unmarshal(dAtA []byte) error {
+func (m *Parameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34267,17 +42146,17 @@ func (m *OAuth2Auth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OAuth2Auth: wiretype end group for non-group")
+			return fmt.Errorf("proto: Parameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OAuth2Auth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Parameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientIDSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34287,33 +42166,29 @@ func (m *OAuth2Auth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientIDSecret == nil {
-				m.ClientIDSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.ClientIDSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientSecretSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34323,31 +42198,61 @@ func (m *OAuth2Auth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientSecretSecret == nil {
-				m.ClientSecretSecret = &v1.SecretKeySelector{}
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Default = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			if err := m.ClientSecretSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Value = &s
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenURLSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34374,16 +42279,16 @@ func (m *OAuth2Auth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenURLSecret == nil {
-				m.TokenURLSecret = &v1.SecretKeySelector{}
+			if m.ValueFrom == nil {
+				m.ValueFrom = &ValueFrom{}
 			}
-			if err := m.TokenURLSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34411,11 +42316,76 @@ func (m *OAuth2Auth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			m.GlobalName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndpointParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enum = append(m.Enum, AnyString(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Description = &s
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34442,11 +42412,53 @@ func (m *OAuth2Auth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndpointParams = append(m.EndpointParams, OAuth2EndpointParam{})
-			if err := m.EndpointParams[len(m.EndpointParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Schema == nil {
+				m.Schema = &ParameterSchema{}
+			}
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Optional", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Optional = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sensitive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sensitive = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34468,7 +42480,7 @@ func (m *OAuth2Auth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OAuth2EndpointParam) Unmarshal(dAtA []byte) error {
+func (m *ParameterSchema) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34491,15 +42503,15 @@ func (m *OAuth2EndpointParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OAuth2EndpointParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParameterSchema: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OAuth2EndpointParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParameterSchema: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34527,11 +42539,11 @@ func (m *OAuth2EndpointParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Type = ParameterType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34559,8 +42571,48 @@ func (m *OAuth2EndpointParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Pattern = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minimum", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Minimum = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maximum", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Maximum = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34582,7 +42634,7 @@ func (m *OAuth2EndpointParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
+func (m *Plugin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34605,15 +42657,15 @@ func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSSArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: Plugin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Plugin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OSSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34640,42 +42692,10 @@ func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OSSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34697,7 +42717,7 @@ func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
+func (m *PodGC) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34720,15 +42740,47 @@ func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSSArtifactRepository: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodGC: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodGC: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OSSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = PodGCStrategy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34755,13 +42807,16 @@ func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OSSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LabelSelector == nil {
+				m.LabelSelector = &v11.LabelSelector{}
+			}
+			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteDelayDuration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34789,7 +42844,7 @@ func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyFormat = string(dAtA[iNdEx:postIndex])
+			m.DeleteDelayDuration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34812,7 +42867,7 @@ func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSSBucket) Unmarshal(dAtA []byte) error {
+func (m *Prometheus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34835,15 +42890,15 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSSBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: Prometheus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Prometheus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34871,43 +42926,11 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34934,18 +42957,16 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessKeySecret == nil {
-				m.AccessKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Labels = append(m.Labels, &MetricLabel{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Help", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34955,51 +42976,27 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKeySecret == nil {
-				m.SecretKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Help = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketIfNotPresent", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.CreateBucketIfNotPresent = bool(v != 0)
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35027,11 +43024,11 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityToken = string(dAtA[iNdEx:postIndex])
+			m.When = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LifecycleRule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35044,102 +43041,32 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 				b := dAtA[iNdEx]
 				iNdEx++
 				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LifecycleRule == nil {
-				m.LifecycleRule = &OSSLifecycleRule{}
-			}
-			if err := m.LifecycleRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseSDKCreds", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UseSDKCreds = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Gauge == nil {
+				m.Gauge = &Gauge{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OSSLifecycleRule: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSSLifecycleRule: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkInfrequentAccessAfterDays", wireType)
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.MarkInfrequentAccessAfterDays = 0
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Histogram", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35149,16 +43076,33 @@ func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarkInfrequentAccessAfterDays |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkDeletionAfterDays", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.MarkDeletionAfterDays = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Histogram == nil {
+				m.Histogram = &Histogram{}
+			}
+			if err := m.Histogram.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35168,11 +43112,28 @@ func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarkDeletionAfterDays |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Counter == nil {
+				m.Counter = &Counter{}
+			}
+			if err := m.Counter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35194,7 +43155,7 @@ func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Object) Unmarshal(dAtA []byte) error {
+func (m *RawArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35217,17 +43178,17 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Object: wiretype end group for non-group")
+			return fmt.Errorf("proto: RawArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RawArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35237,25 +43198,23 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.Data = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35278,7 +43237,7 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Outputs) Unmarshal(dAtA []byte) error {
+func (m *ResourceRecommendation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35301,15 +43260,15 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Outputs: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceRecommendation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Outputs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceRecommendation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35336,14 +43295,109 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, Parameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Requests == nil {
+				m.Requests = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Requests[k8s_io_api_core_v1.ResourceName(mapkey)] = ((k8s_io_apimachinery_pkg_api_resource.Quantity)(*mapvalue))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35370,49 +43424,111 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Artifacts = append(m.Artifacts, Artifact{})
-			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if m.Limits == nil {
+				m.Limits = make(k8s_io_api_core_v1.ResourceList)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Result = &s
+			m.Limits[k8s_io_api_core_v1.ResourceName(mapkey)] = ((k8s_io_apimachinery_pkg_api_resource.Quantity)(*mapvalue))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
 			}
-			var stringLen uint64
+			m.Samples = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35422,25 +43538,11 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Samples |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.ExitCode = &s
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35462,7 +43564,7 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
+func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35485,17 +43587,17 @@ func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParallelSteps: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParallelSteps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35505,79 +43607,27 @@ func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, WorkflowStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Parameter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Parameter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Parameter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeStrategy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35605,11 +43655,11 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.MergeStrategy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35637,12 +43687,31 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Default = &s
+			m.Manifest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetOwnerReference", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetOwnerReference = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35670,14 +43739,13 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Value = &s
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCondition", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35687,31 +43755,27 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueFrom == nil {
-				m.ValueFrom = &ValueFrom{}
-			}
-			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FailureCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35739,13 +43803,13 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GlobalName = string(dAtA[iNdEx:postIndex])
+			m.Flags = append(m.Flags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestFrom", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35755,27 +43819,31 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Enum = append(m.Enum, AnyString(dAtA[iNdEx:postIndex]))
+			if m.ManifestFrom == nil {
+				m.ManifestFrom = &ManifestFrom{}
+			}
+			if err := m.ManifestFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cascade", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35803,8 +43871,7 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Description = &s
+			m.Cascade = CascadeStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35827,7 +43894,7 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Plugin) Unmarshal(dAtA []byte) error {
+func (m *RetryAffinity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35850,15 +43917,15 @@ func (m *Plugin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Plugin: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryAffinity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Plugin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAntiAffinity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35885,7 +43952,10 @@ func (m *Plugin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NodeAntiAffinity == nil {
+				m.NodeAntiAffinity = &RetryNodeAntiAffinity{}
+			}
+			if err := m.NodeAntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -35910,7 +43980,7 @@ func (m *Plugin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodGC) Unmarshal(dAtA []byte) error {
+func (m *RetryNodeAntiAffinity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35933,112 +44003,12 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodGC: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryNodeAntiAffinity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodGC: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryNodeAntiAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Strategy = PodGCStrategy(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LabelSelector == nil {
-				m.LabelSelector = &v11.LabelSelector{}
-			}
-			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteDelayDuration", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeleteDelayDuration = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36060,7 +44030,7 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Prometheus) Unmarshal(dAtA []byte) error {
+func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36083,17 +44053,17 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Prometheus: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Prometheus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36103,29 +44073,33 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Limit == nil {
+				m.Limit = &intstr.IntOrString{}
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36135,31 +44109,29 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, &MetricLabel{})
-			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RetryPolicy = RetryPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Help", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36169,29 +44141,33 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Help = string(dAtA[iNdEx:postIndex])
+			if m.Backoff == nil {
+				m.Backoff = &Backoff{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36201,29 +44177,33 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.When = string(dAtA[iNdEx:postIndex])
+			if m.Affinity == nil {
+				m.Affinity = &RetryAffinity{}
+			}
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36233,31 +44213,77 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gauge == nil {
-				m.Gauge = &Gauge{}
-			}
-			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *S3Artifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: S3Artifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: S3Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Histogram", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S3Bucket", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36284,18 +44310,15 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Histogram == nil {
-				m.Histogram = &Histogram{}
-			}
-			if err := m.Histogram.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.S3Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36305,27 +44328,23 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Counter == nil {
-				m.Counter = &Counter{}
-			}
-			if err := m.Counter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36348,7 +44367,7 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawArtifact) Unmarshal(dAtA []byte) error {
+func (m *S3ArtifactRepository) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36371,15 +44390,48 @@ func (m *RawArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: S3ArtifactRepository: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: S3ArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S3Bucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.S3Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36407,7 +44459,39 @@ func (m *RawArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.KeyFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36430,7 +44514,7 @@ func (m *RawArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
+func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36453,15 +44537,15 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: S3Bucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: S3Bucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36489,11 +44573,11 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Action = string(dAtA[iNdEx:postIndex])
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36521,11 +44605,11 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MergeStrategy = string(dAtA[iNdEx:postIndex])
+			m.Bucket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36553,11 +44637,11 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manifest = string(dAtA[iNdEx:postIndex])
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetOwnerReference", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -36574,12 +44658,13 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.SetOwnerReference = bool(v != 0)
+			b := bool(v != 0)
+			m.Insecure = &b
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36589,27 +44674,67 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
+			if m.AccessKeySecret == nil {
+				m.AccessKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureCondition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecretKeySecret == nil {
+				m.SecretKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36637,13 +44762,69 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailureCondition = string(dAtA[iNdEx:postIndex])
+			m.RoleARN = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseSDKCreds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseSDKCreds = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketIfNotPresent", wireType)
 			}
-			var stringLen uint64
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateBucketIfNotPresent == nil {
+				m.CreateBucketIfNotPresent = &CreateS3BucketOptions{}
+			}
+			if err := m.CreateBucketIfNotPresent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionOptions", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36653,27 +44834,31 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Flags = append(m.Flags, string(dAtA[iNdEx:postIndex]))
+			if m.EncryptionOptions == nil {
+				m.EncryptionOptions = &S3EncryptionOptions{}
+			}
+			if err := m.EncryptionOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManifestFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CASecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36700,66 +44885,16 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ManifestFrom == nil {
-				m.ManifestFrom = &ManifestFrom{}
+			if m.CASecret == nil {
+				m.CASecret = &v1.SecretKeySelector{}
 			}
-			if err := m.ManifestFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CASecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RetryAffinity) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RetryAffinity: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeAntiAffinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionTokenSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36786,10 +44921,10 @@ func (m *RetryAffinity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeAntiAffinity == nil {
-				m.NodeAntiAffinity = &RetryNodeAntiAffinity{}
+			if m.SessionTokenSecret == nil {
+				m.SessionTokenSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.NodeAntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SessionTokenSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -36814,57 +44949,7 @@ func (m *RetryAffinity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RetryNodeAntiAffinity) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RetryNodeAntiAffinity: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryNodeAntiAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
+func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36887,17 +44972,17 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: S3EncryptionOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: S3EncryptionOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KmsKeyId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36907,31 +44992,27 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Limit == nil {
-				m.Limit = &intstr.IntOrString{}
-			}
-			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KmsKeyId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KmsEncryptionContext", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36959,13 +45040,13 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RetryPolicy = RetryPolicy(dAtA[iNdEx:postIndex])
+			m.KmsEncryptionContext = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableEncryption", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36975,31 +45056,15 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Backoff == nil {
-				m.Backoff = &Backoff{}
-			}
-			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.EnableEncryption = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSideCustomerKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37026,45 +45091,13 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Affinity == nil {
-				m.Affinity = &RetryAffinity{}
+			if m.ServerSideCustomerKeySecret == nil {
+				m.ServerSideCustomerKeySecret = &v1.SecretKeySelector{}
 			}
-			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ServerSideCustomerKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37086,7 +45119,7 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *S3Artifact) Unmarshal(dAtA []byte) error {
+func (m *ScratchVolume) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37109,15 +45142,15 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: S3Artifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScratchVolume: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: S3Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScratchVolume: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageSize", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37144,13 +45177,13 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.S3Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StorageSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageClass", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37178,7 +45211,39 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.StorageClass = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MountPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MountPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37201,7 +45266,7 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *S3ArtifactRepository) Unmarshal(dAtA []byte) error {
+func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37224,15 +45289,15 @@ func (m *S3ArtifactRepository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: S3ArtifactRepository: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScriptTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: S3ArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScriptTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37259,45 +45324,13 @@ func (m *S3ArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.S3Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KeyFormat = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37325,7 +45358,7 @@ func (m *S3ArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyPrefix = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37348,7 +45381,7 @@ func (m *S3ArtifactRepository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *S3Bucket) Unmarshal(dAtA []byte) error {
+func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37371,15 +45404,15 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: S3Bucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: SemaphoreHolding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: S3Bucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SemaphoreHolding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37407,11 +45440,11 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			m.Semaphore = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37439,100 +45472,61 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
+			m.Holders = append(m.Holders, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Insecure = &b
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessKeySecret == nil {
-				m.AccessKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SemaphoreRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SemaphoreRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37559,16 +45553,16 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKeySecret == nil {
-				m.SecretKeySecret = &v1.SecretKeySelector{}
+			if m.ConfigMapKeyRef == nil {
+				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
 			}
-			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37589,74 +45583,18 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RoleARN = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseSDKCreds", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UseSDKCreds = bool(v != 0)
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketIfNotPresent", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreateBucketIfNotPresent == nil {
-				m.CreateBucketIfNotPresent = &CreateS3BucketOptions{}
-			}
-			if err := m.CreateBucketIfNotPresent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37683,16 +45621,66 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EncryptionOptions == nil {
-				m.EncryptionOptions = &S3EncryptionOptions{}
+			if m.Database == nil {
+				m.Database = &SyncDatabaseRef{}
 			}
-			if err := m.EncryptionOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Database.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SemaphoreStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SemaphoreStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CASecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37719,16 +45707,14 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CASecret == nil {
-				m.CASecret = &v1.SecretKeySelector{}
-			}
-			if err := m.CASecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Holding = append(m.Holding, SemaphoreHolding{})
+			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionTokenSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37755,10 +45741,8 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SessionTokenSecret == nil {
-				m.SessionTokenSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.SessionTokenSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Waiting = append(m.Waiting, SemaphoreHolding{})
+			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -37783,7 +45767,7 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
+func (m *Sequence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37806,17 +45790,17 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: S3EncryptionOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: Sequence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: S3EncryptionOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Sequence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KmsKeyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37826,29 +45810,33 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KmsKeyId = string(dAtA[iNdEx:postIndex])
+			if m.Count == nil {
+				m.Count = &intstr.IntOrString{}
+			}
+			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KmsEncryptionContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37858,29 +45846,33 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KmsEncryptionContext = string(dAtA[iNdEx:postIndex])
+			if m.Start == nil {
+				m.Start = &intstr.IntOrString{}
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableEncryption", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37890,17 +45882,33 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableEncryption = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.End == nil {
+				m.End = &intstr.IntOrString{}
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerSideCustomerKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37910,27 +45918,55 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ServerSideCustomerKeySecret == nil {
-				m.ServerSideCustomerKeySecret = &v1.SecretKeySelector{}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
 			}
-			if err := m.ServerSideCustomerKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Step = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37953,7 +45989,7 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
+func (m *StepThrough) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37976,48 +46012,15 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScriptTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: StepThrough: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScriptTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StepThrough: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38045,7 +46048,7 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38068,7 +46071,7 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
+func (m *StopStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38091,47 +46094,15 @@ func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SemaphoreHolding: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SemaphoreHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Semaphore = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38159,7 +46130,7 @@ func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holders = append(m.Holders, string(dAtA[iNdEx:postIndex]))
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38182,7 +46153,7 @@ func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
+func (m *Submit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38205,15 +46176,15 @@ func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SemaphoreRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: Submit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SemaphoreRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Submit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplateRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38240,18 +46211,15 @@ func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigMapKeyRef == nil {
-				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
-			}
-			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowTemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38261,27 +46229,31 @@ func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.Arguments == nil {
+				m.Arguments = &Arguments{}
+			}
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38308,10 +46280,7 @@ func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Database == nil {
-				m.Database = &SyncDatabaseRef{}
-			}
-			if err := m.Database.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -38336,7 +46305,7 @@ func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
+func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38359,17 +46328,17 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SemaphoreStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubmitOpts: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SemaphoreStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubmitOpts: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38379,31 +46348,197 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holding = append(m.Holding, SemaphoreHolding{})
-			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenerateName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenerateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entrypoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entrypoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerDryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ServerDryRun = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38413,79 +46548,27 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Waiting = append(m.Waiting, SemaphoreHolding{})
-			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Labels = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Sequence) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Sequence: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sequence: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerReference", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38512,18 +46595,18 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Count == nil {
-				m.Count = &intstr.IntOrString{}
+			if m.OwnerReference == nil {
+				m.OwnerReference = &v11.OwnerReference{}
 			}
-			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OwnerReference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38533,33 +46616,29 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Start == nil {
-				m.Start = &intstr.IntOrString{}
-			}
-			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Annotations = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodPriorityClassName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38569,33 +46648,29 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.End == nil {
-				m.End = &intstr.IntOrString{}
-			}
-			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PodPriorityClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38605,24 +46680,62 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			m.Priority = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Format = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuppliedValueFrom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuppliedValueFrom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuppliedValueFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -38644,7 +46757,7 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopStrategy) Unmarshal(dAtA []byte) error {
+func (m *SuspendTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38667,15 +46780,15 @@ func (m *StopStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuspendTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuspendTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38703,7 +46816,7 @@ func (m *StopStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38726,7 +46839,7 @@ func (m *StopStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Submit) Unmarshal(dAtA []byte) error {
+func (m *SyncDatabaseRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38749,86 +46862,17 @@ func (m *Submit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Submit: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncDatabaseRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Submit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncDatabaseRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplateRef", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.WorkflowTemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Arguments == nil {
-				m.Arguments = &Arguments{}
-			}
-			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38838,24 +46882,23 @@ func (m *Submit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38878,7 +46921,7 @@ func (m *Submit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
+func (m *Synchronization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38901,17 +46944,17 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubmitOpts: wiretype end group for non-group")
+			return fmt.Errorf("proto: Synchronization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubmitOpts: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Synchronization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38921,29 +46964,33 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Semaphore == nil {
+				m.Semaphore = &SemaphoreRef{}
+			}
+			if err := m.Semaphore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenerateName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38953,61 +47000,33 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GenerateName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entrypoint", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.Mutex == nil {
+				m.Mutex = &Mutex{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Mutex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Entrypoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Semaphores", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39017,29 +47036,31 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, string(dAtA[iNdEx:postIndex]))
+			m.Semaphores = append(m.Semaphores, &SemaphoreRef{})
+			if err := m.Semaphores[len(m.Semaphores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutexes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39049,137 +47070,81 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DryRun = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerDryRun", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ServerDryRun = bool(v != 0)
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Mutexes = append(m.Mutexes, &Mutex{})
+			if err := m.Mutexes[len(m.Mutexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerReference", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SynchronizationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OwnerReference == nil {
-				m.OwnerReference = &v11.OwnerReference{}
-			}
-			if err := m.OwnerReference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 12:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SynchronizationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SynchronizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39189,29 +47154,33 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Annotations = string(dAtA[iNdEx:postIndex])
+			if m.Semaphore == nil {
+				m.Semaphore = &SemaphoreStatus{}
+			}
+			if err := m.Semaphore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodPriorityClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39221,44 +47190,28 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodPriorityClassName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			if m.Mutex == nil {
+				m.Mutex = &MutexStatus{}
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Mutex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Priority = &v
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39280,7 +47233,7 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuppliedValueFrom) Unmarshal(dAtA []byte) error {
+func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39303,12 +47256,72 @@ func (m *SuppliedValueFrom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuppliedValueFrom: wiretype end group for non-group")
+			return fmt.Errorf("proto: TTLStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuppliedValueFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TTLStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterCompletion", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SecondsAfterCompletion = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterSuccess", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SecondsAfterSuccess = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterFailure", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SecondsAfterFailure = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39330,7 +47343,7 @@ func (m *SuppliedValueFrom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuspendTemplate) Unmarshal(dAtA []byte) error {
+func (m *TarStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39353,17 +47366,17 @@ func (m *SuspendTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuspendTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: TarStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuspendTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TarStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressionLevel", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39373,24 +47386,12 @@ func (m *SuspendTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.CompressionLevel = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39412,7 +47413,7 @@ func (m *SuspendTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncDatabaseRef) Unmarshal(dAtA []byte) error {
+func (m *Template) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39435,15 +47436,15 @@ func (m *SyncDatabaseRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncDatabaseRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: Template: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncDatabaseRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Template: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39471,61 +47472,44 @@ func (m *SyncDatabaseRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Synchronization) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Inputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Synchronization: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Synchronization: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39552,16 +47536,13 @@ func (m *Synchronization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Semaphore == nil {
-				m.Semaphore = &SemaphoreRef{}
-			}
-			if err := m.Semaphore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39588,16 +47569,107 @@ func (m *Synchronization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Mutex == nil {
-				m.Mutex = &Mutex{}
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
 			}
-			if err := m.Mutex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.NodeSelector[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Semaphores", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39624,14 +47696,16 @@ func (m *Synchronization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Semaphores = append(m.Semaphores, &SemaphoreRef{})
-			if err := m.Semaphores[len(m.Semaphores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Affinity == nil {
+				m.Affinity = &v1.Affinity{}
+			}
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mutexes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39658,64 +47732,34 @@ func (m *Synchronization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mutexes = append(m.Mutexes, &Mutex{})
-			if err := m.Mutexes[len(m.Mutexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SynchronizationStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Daemon", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SynchronizationStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SynchronizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			b := bool(v != 0)
+			m.Daemon = &b
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39742,16 +47786,14 @@ func (m *SynchronizationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Semaphore == nil {
-				m.Semaphore = &SemaphoreStatus{}
-			}
-			if err := m.Semaphore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Steps = append(m.Steps, ParallelSteps{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39778,68 +47820,18 @@ func (m *SynchronizationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Mutex == nil {
-				m.Mutex = &MutexStatus{}
-			}
-			if err := m.Mutex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Container == nil {
+				m.Container = &v1.Container{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TTLStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TTLStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterCompletion", wireType)
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Script", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39849,17 +47841,33 @@ func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SecondsAfterCompletion = &v
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterSuccess", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int32
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Script == nil {
+				m.Script = &ScriptTemplate{}
+			}
+			if err := m.Script.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39869,17 +47877,33 @@ func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SecondsAfterSuccess = &v
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterFailure", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int32
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = &ResourceTemplate{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DAG", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39889,67 +47913,33 @@ func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SecondsAfterFailure = &v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TarStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.DAG == nil {
+				m.DAG = &DAGTemplate{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.DAG.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TarStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TarStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompressionLevel", wireType)
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39959,67 +47949,33 @@ func (m *TarStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CompressionLevel = &v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Template) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Suspend == nil {
+				m.Suspend = &SuspendTemplate{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Suspend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Template: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Template: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40029,27 +47985,29 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Volumes = append(m.Volumes, v1.Volume{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitContainers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40076,13 +48034,14 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Inputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InitContainers = append(m.InitContainers, UserContainer{})
+			if err := m.InitContainers[len(m.InitContainers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sidecars", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40109,13 +48068,14 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sidecars = append(m.Sidecars, UserContainer{})
+			if err := m.Sidecars[len(m.Sidecars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveLocation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40142,107 +48102,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeSelector == nil {
-				m.NodeSelector = make(map[string]string)
+			if m.ArchiveLocation == nil {
+				m.ArchiveLocation = &ArtifactLocation{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.ArchiveLocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.NodeSelector[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 8:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveDeadlineSeconds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40269,16 +48138,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Affinity == nil {
-				m.Affinity = &v1.Affinity{}
+			if m.ActiveDeadlineSeconds == nil {
+				m.ActiveDeadlineSeconds = &intstr.IntOrString{}
 			}
-			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ActiveDeadlineSeconds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40305,15 +48174,18 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &RetryStrategy{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 23:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Daemon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
 			}
-			var v int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40323,16 +48195,15 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Daemon = &b
-		case 11:
+			m.Parallelism = &v
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40359,16 +48230,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, ParallelSteps{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tolerations = append(m.Tolerations, v1.Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulerName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40378,31 +48249,91 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Container == nil {
-				m.Container = &v1.Container{}
+			m.SchedulerName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
 			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Script", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAliases", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40429,16 +48360,14 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Script == nil {
-				m.Script = &ScriptTemplate{}
-			}
-			if err := m.Script.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HostAliases = append(m.HostAliases, v1.HostAlias{})
+			if err := m.HostAliases[len(m.HostAliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40465,18 +48394,18 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resource == nil {
-				m.Resource = &ResourceTemplate{}
+			if m.SecurityContext == nil {
+				m.SecurityContext = &v1.PodSecurityContext{}
 			}
-			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DAG", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpecPatch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40486,31 +48415,48 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DAG == nil {
-				m.DAG = &DAGTemplate{}
+			m.PodSpecPatch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutomountServiceAccountToken", wireType)
 			}
-			if err := m.DAG.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 16:
+			b := bool(v != 0)
+			m.AutomountServiceAccountToken = &b
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40537,16 +48483,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Suspend == nil {
-				m.Suspend = &SuspendTemplate{}
+			if m.Executor == nil {
+				m.Executor = &ExecutorConfig{}
 			}
-			if err := m.Suspend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Executor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40573,14 +48519,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volumes = append(m.Volumes, v1.Volume{})
-			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Metrics == nil {
+				m.Metrics = &Metrics{}
+			}
+			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitContainers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Synchronization", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40607,14 +48555,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitContainers = append(m.InitContainers, UserContainer{})
-			if err := m.InitContainers[len(m.InitContainers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Synchronization == nil {
+				m.Synchronization = &Synchronization{}
+			}
+			if err := m.Synchronization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sidecars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memoize", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40641,16 +48591,18 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sidecars = append(m.Sidecars, UserContainer{})
-			if err := m.Sidecars[len(m.Sidecars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Memoize == nil {
+				m.Memoize = &Memoize{}
+			}
+			if err := m.Memoize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 38:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveLocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40660,31 +48612,27 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ArchiveLocation == nil {
-				m.ArchiveLocation = &ArtifactLocation{}
-			}
-			if err := m.ArchiveLocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Timeout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 21:
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveDeadlineSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40711,16 +48659,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActiveDeadlineSeconds == nil {
-				m.ActiveDeadlineSeconds = &intstr.IntOrString{}
+			if m.Data == nil {
+				m.Data = &Data{}
 			}
-			if err := m.ActiveDeadlineSeconds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40747,18 +48695,18 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RetryStrategy == nil {
-				m.RetryStrategy = &RetryStrategy{}
+			if m.ContainerSet == nil {
+				m.ContainerSet = &ContainerSetTemplate{}
 			}
-			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ContainerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
+		case 41:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
 			}
-			var v int64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40768,15 +48716,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Parallelism = &v
-		case 24:
+			b := bool(v != 0)
+			m.FailFast = &b
+		case 42:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40803,16 +48752,18 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, v1.Toleration{})
-			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.HTTP == nil {
+				m.HTTP = &HTTP{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
+		case 43:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulerName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40822,29 +48773,33 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SchedulerName = string(dAtA[iNdEx:postIndex])
+			if m.Plugin == nil {
+				m.Plugin = &Plugin{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 26:
+		case 44:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40854,29 +48809,124 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 28:
+		case 45:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Finally", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40886,27 +48936,31 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			if m.Finally == nil {
+				m.Finally = &LifecycleHook{}
+			}
+			if err := m.Finally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 29:
+		case 46:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAliases", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Extends", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40933,14 +48987,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAliases = append(m.HostAliases, v1.HostAlias{})
-			if err := m.HostAliases[len(m.HostAliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Extends == nil {
+				m.Extends = &TemplateExtends{}
+			}
+			if err := m.Extends.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 30:
+		case 47:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40967,18 +49023,38 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.PodSecurityContext{}
+			if m.ChildWorkflow == nil {
+				m.ChildWorkflow = &ChildWorkflowTemplate{}
 			}
-			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChildWorkflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 31:
+		case 48:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressWeight", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProgressWeight = &v
+		case 49:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpecPatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingGates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40988,29 +49064,31 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSpecPatch = string(dAtA[iNdEx:postIndex])
+			m.SchedulingGates = append(m.SchedulingGates, v1.PodSchedulingGate{})
+			if err := m.SchedulingGates[len(m.SchedulingGates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 32:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutomountServiceAccountToken", wireType)
+		case 50:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodDisruptionBudget", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41020,16 +49098,31 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.AutomountServiceAccountToken = &b
-		case 33:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PodDisruptionBudget == nil {
+				m.PodDisruptionBudget = &v12.PodDisruptionBudgetSpec{}
+			}
+			if err := m.PodDisruptionBudget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 51:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scratch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -41056,16 +49149,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Executor == nil {
-				m.Executor = &ExecutorConfig{}
+			if m.Scratch == nil {
+				m.Scratch = &ScratchVolume{}
 			}
-			if err := m.Executor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Scratch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 35:
+		case 52:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GPU", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -41092,16 +49185,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metrics == nil {
-				m.Metrics = &Metrics{}
+			if m.GPU == nil {
+				m.GPU = &GPURequest{}
 			}
-			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GPU.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 36:
+		case 53:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Synchronization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactRepositoryRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -41128,16 +49221,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Synchronization == nil {
-				m.Synchronization = &Synchronization{}
+			if m.ArtifactRepositoryRef == nil {
+				m.ArtifactRepositoryRef = &ArtifactRepositoryRef{}
 			}
-			if err := m.Synchronization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ArtifactRepositoryRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 37:
+		case 54:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memoize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -41164,16 +49257,66 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Memoize == nil {
-				m.Memoize = &Memoize{}
+			if m.Job == nil {
+				m.Job = &JobTemplate{}
 			}
-			if err := m.Memoize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 38:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TemplateExtends) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TemplateExtends: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TemplateExtends: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41201,11 +49344,11 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Timeout = string(dAtA[iNdEx:postIndex])
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 39:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -41232,18 +49375,68 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &Data{}
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
 			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 40:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TemplateImport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TemplateImport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TemplateImport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41253,33 +49446,29 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContainerSet == nil {
-				m.ContainerSet = &ContainerSetTemplate{}
-			}
-			if err := m.ContainerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 41:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registry", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41289,18 +49478,29 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.FailFast = &b
-		case 42:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Registry = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repository", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41310,33 +49510,29 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HTTP == nil {
-				m.HTTP = &HTTP{}
-			}
-			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Repository = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 43:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41346,33 +49542,29 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugin == nil {
-				m.Plugin = &Plugin{}
-			}
-			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 44:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41382,118 +49574,23 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Annotations[mapkey] = mapvalue
+			m.Digest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -41813,8 +49910,427 @@ func (m *UserContainer) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.MirrorVolumeMounts = &b
+			b := bool(v != 0)
+			m.MirrorVolumeMounts = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValueFrom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValueFrom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValueFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSONPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JQFilter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JQFilter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Default = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplied", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Supplied == nil {
+				m.Supplied = &SuppliedValueFrom{}
+			}
+			if err := m.Supplied.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Event = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigMapKeyRef == nil {
+				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
+			}
+			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecretKeyRef == nil {
+				m.SecretKeyRef = &v1.SecretKeySelector{}
+			}
+			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plugin == nil {
+				m.Plugin = &Plugin{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -41836,7 +50352,7 @@ func (m *UserContainer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValueFrom) Unmarshal(dAtA []byte) error {
+func (m *Version) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41859,15 +50375,15 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValueFrom: wiretype end group for non-group")
+			return fmt.Errorf("proto: Version: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValueFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Version: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41895,11 +50411,11 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildDate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41927,11 +50443,11 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JSONPath = string(dAtA[iNdEx:postIndex])
+			m.BuildDate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JQFilter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GitCommit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41959,11 +50475,11 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JQFilter = string(dAtA[iNdEx:postIndex])
+			m.GitCommit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GitTag", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41991,11 +50507,11 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameter = string(dAtA[iNdEx:postIndex])
+			m.GitTag = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GitTreeState", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42023,14 +50539,13 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Default = &s
+			m.GitTreeState = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supplied", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GoVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42040,31 +50555,27 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Supplied == nil {
-				m.Supplied = &SuppliedValueFrom{}
-			}
-			if err := m.Supplied.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GoVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Compiler", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42092,11 +50603,11 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Event = string(dAtA[iNdEx:postIndex])
+			m.Compiler = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42124,13 +50635,63 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
+			m.Platform = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VolumeClaimGC) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VolumeClaimGC: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VolumeClaimGC: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42140,27 +50701,23 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigMapKeyRef == nil {
-				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
-			}
-			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Strategy = VolumeClaimGCStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -42183,7 +50740,7 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Version) Unmarshal(dAtA []byte) error {
+func (m *Workflow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42206,17 +50763,17 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Version: wiretype end group for non-group")
+			return fmt.Errorf("proto: Workflow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Version: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Workflow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42226,29 +50783,30 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42258,29 +50816,30 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuildDate = string(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42290,29 +50849,80 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitCommit = string(dAtA[iNdEx:postIndex])
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowArtifactGCTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowArtifactGCTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowArtifactGCTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitTag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42322,29 +50932,30 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitTag = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitTreeState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42354,29 +50965,30 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitTreeState = string(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42386,29 +50998,80 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GoVersion = string(dAtA[iNdEx:postIndex])
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowArtifactGCTaskList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowArtifactGCTaskList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowArtifactGCTaskList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Compiler", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42418,29 +51081,30 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Compiler = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42450,23 +51114,25 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Platform = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, WorkflowArtifactGCTask{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -42489,7 +51155,7 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VolumeClaimGC) Unmarshal(dAtA []byte) error {
+func (m *WorkflowArtifactRepository) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42512,17 +51178,17 @@ func (m *VolumeClaimGC) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VolumeClaimGC: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowArtifactRepository: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VolumeClaimGC: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42532,23 +51198,90 @@ func (m *VolumeClaimGC) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Strategy = VolumeClaimGCStrategy(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -42571,7 +51304,7 @@ func (m *VolumeClaimGC) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Workflow) Unmarshal(dAtA []byte) error {
+func (m *WorkflowArtifactRepositoryList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42594,15 +51327,15 @@ func (m *Workflow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Workflow: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowArtifactRepositoryList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Workflow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowArtifactRepositoryList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42629,46 +51362,13 @@ func (m *Workflow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42695,7 +51395,8 @@ func (m *Workflow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, WorkflowArtifactRepository{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -42720,7 +51421,7 @@ func (m *Workflow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowArtifactGCTask) Unmarshal(dAtA []byte) error {
+func (m *WorkflowArtifactRepositoryStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42743,15 +51444,15 @@ func (m *WorkflowArtifactGCTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowArtifactGCTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowArtifactRepositoryStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowArtifactGCTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowArtifactRepositoryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42778,129 +51479,14 @@ func (m *WorkflowArtifactGCTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WorkflowArtifactGCTaskList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowArtifactGCTaskList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowArtifactGCTaskList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastProbedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42927,41 +51513,10 @@ func (m *WorkflowArtifactGCTaskList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.LastProbedAt == nil {
+				m.LastProbedAt = &v11.Time{}
 			}
-			m.Items = append(m.Items, WorkflowArtifactGCTask{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastProbedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -45558,6 +54113,144 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Imports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Imports = append(m.Imports, TemplateImport{})
+			if err := m.Imports[len(m.Imports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingGates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchedulingGates = append(m.SchedulingGates, v1.PodSchedulingGate{})
+			if err := m.SchedulingGates[len(m.SchedulingGates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notifications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Notifications = append(m.Notifications, Notification{})
+			if err := m.Notifications[len(m.Notifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepThrough", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StepThrough == nil {
+				m.StepThrough = &StepThrough{}
+			}
+			if err := m.StepThrough.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -46587,6 +55280,461 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.TaskResultsCompletionStatus[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateDigests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TemplateDigests == nil {
+				m.TemplateDigests = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TemplateDigests[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRecommendations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceRecommendations == nil {
+				m.ResourceRecommendations = make(map[string]ResourceRecommendation)
+			}
+			var mapkey string
+			mapvalue := &ResourceRecommendation{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ResourceRecommendation{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ResourceRecommendations[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedCost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EstimatedCost == nil {
+				m.EstimatedCost = &Amount{}
+			}
+			if err := m.EstimatedCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageDigests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ImageDigests == nil {
+				m.ImageDigests = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ImageDigests[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactManifest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArtifactManifest == nil {
+				m.ArtifactManifest = &Artifact{}
+			}
+			if err := m.ArtifactManifest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -48329,6 +57477,321 @@ func (m *WorkflowTemplateRef) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ClusterScope = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowTemplateRevision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowTemplateRevision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowTemplateRevision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreationTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowTemplateRevisionList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowTemplateRevisionList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowTemplateRevisionList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, WorkflowTemplateRevision{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"errors"
 	"net/http"
 
 	v1 "k8s.io/api/core/v1"
@@ -52,6 +53,53 @@ type HTTP struct {
 	BodyFrom *HTTPBodySource `json:"bodyFrom,omitempty" protobuf:"bytes,8,opt,name=bodyFrom"`
 	// InsecureSkipVerify is a bool when if set to true will skip TLS verification for the HTTP client
 	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty" protobuf:"bytes,7,opt,name=insecureSkipVerify"`
+	// Auth authenticates the HTTP Request, so that tokens and keys need not be passed in headers or parameters
+	Auth *HTTPTemplateAuth `json:"auth,omitempty" protobuf:"bytes,9,opt,name=auth"`
+}
+
+// HTTPTemplateAuth is how the requests of HTTP templates are authenticated
+type HTTPTemplateAuth struct {
+	// OAuth2 sends an access token, acquired with the OAuth2 client credentials grant, as a bearer token.
+	// The agent reuses tokens until they expire.
+	OAuth2 *OAuth2Auth `json:"oauth2,omitempty" protobuf:"bytes,1,opt,name=oauth2"`
+	// AWSSigV4 signs requests with AWS Signature Version 4
+	AWSSigV4 *AWSSigV4Auth `json:"awsSigV4,omitempty" protobuf:"bytes,2,opt,name=awsSigV4"`
+}
+
+func (a *HTTPTemplateAuth) Validate() error {
+	if a.OAuth2 != nil && a.AWSSigV4 != nil {
+		return errors.New("oauth2 and awsSigV4 cannot both be set")
+	}
+	if a.OAuth2 != nil && (a.OAuth2.ClientIDSecret == nil || a.OAuth2.ClientSecretSecret == nil || a.OAuth2.TokenURLSecret == nil) {
+		return errors.New("oauth2 requires clientIDSecret, clientSecretSecret and tokenURLSecret")
+	}
+	if a.AWSSigV4 != nil {
+		if a.AWSSigV4.Region == "" || a.AWSSigV4.Service == "" {
+			return errors.New("awsSigV4 requires region and service")
+		}
+		if (a.AWSSigV4.AccessKeySecret == nil) != (a.AWSSigV4.SecretKeySecret == nil) {
+			return errors.New("awsSigV4 requires both accessKeySecret and secretKeySecret, or neither")
+		}
+		if a.AWSSigV4.SessionTokenSecret != nil && a.AWSSigV4.AccessKeySecret == nil {
+			return errors.New("awsSigV4 requires accessKeySecret and secretKeySecret with sessionTokenSecret")
+		}
+	}
+	return nil
+}
+
+// AWSSigV4Auth signs requests with AWS Signature Version 4
+type AWSSigV4Auth struct {
+	// Region is the region of the service, e.g. us-east-1
+	Region string `json:"region" protobuf:"bytes,1,opt,name=region"`
+	// Service is the signing name of the service, e.g. execute-api for API Gateway
+	Service string `json:"service" protobuf:"bytes,2,opt,name=service"`
+	// AccessKeySecret is the secret selector to the access key ID.
+	// If not set, the agent's default credentials are used, e.g. those of the IAM role of its service account.
+	AccessKeySecret *v1.SecretKeySelector `json:"accessKeySecret,omitempty" protobuf:"bytes,3,opt,name=accessKeySecret"`
+	// SecretKeySecret is the secret selector to the secret access key
+	SecretKeySecret *v1.SecretKeySelector `json:"secretKeySecret,omitempty" protobuf:"bytes,4,opt,name=secretKeySecret"`
+	// SessionTokenSecret is the secret selector to the session token of temporary credentials
+	SessionTokenSecret *v1.SecretKeySelector `json:"sessionTokenSecret,omitempty" protobuf:"bytes,5,opt,name=sessionTokenSecret"`
 }
 
 func (h *HTTP) GetBodyBytes() []byte {
@@ -27,3 +27,15 @@ func (p *Plugin) UnmarshalJSON(value []byte) error {
 	}
 	return nil
 }
+
+// Name returns the name of the plugin the object is for, i.e. its only key
+func (p *Plugin) Name() (string, error) {
+	m := map[string]interface{}{}
+	if err := json.Unmarshal(p.Value, &m); err != nil {
+		return "", err
+	}
+	for name := range m {
+		return name, nil
+	}
+	return "", fmt.Errorf("expected exactly one key, got 0")
+}
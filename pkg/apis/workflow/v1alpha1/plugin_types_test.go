@@ -20,3 +20,10 @@ func TestPlugin_UnmarshalJSON(t *testing.T) {
 		require.NoError(t, p.UnmarshalJSON([]byte(`{"foo":1}`)))
 	})
 }
+
+func TestPlugin_Name(t *testing.T) {
+	p := Plugin{Object{Value: []byte(`{"foo":{"bar":1}}`)}}
+	name, err := p.Name()
+	require.NoError(t, err)
+	require.Equal(t, "foo", name)
+}
@@ -44,6 +44,15 @@ func (in Progress) Complete() Progress {
 	return Progress(fmt.Sprintf("%v/%v", in.M(), in.M()))
 }
 
+// Weighted returns the progress with N and M multiplied by the weight, so that it counts the weight times as much as
+// an unweighted progress when they are added up. A weight of one or less leaves the progress unchanged.
+func (in Progress) Weighted(weight int64) Progress {
+	if weight <= 1 || !in.IsValid() {
+		return in
+	}
+	return Progress(fmt.Sprintf("%v/%v", in.N()*weight, in.M()*weight))
+}
+
 func (in Progress) IsValid() bool {
 	return in != "" && in.N() >= 0 && in.N() <= in.M() && in.M() > 0
 }
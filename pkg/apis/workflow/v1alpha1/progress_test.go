@@ -28,4 +28,10 @@ func TestProgress(t *testing.T) {
 	t.Run("Complete", func(t *testing.T) {
 		assert.Equal(t, Progress("100/100"), Progress("0/100").Complete())
 	})
+	t.Run("Weighted", func(t *testing.T) {
+		assert.Equal(t, Progress("1/2"), Progress("1/2").Weighted(0))
+		assert.Equal(t, Progress("1/2"), Progress("1/2").Weighted(1))
+		assert.Equal(t, Progress("3/6"), Progress("1/2").Weighted(3))
+		assert.Equal(t, Progress(""), Progress("").Weighted(3))
+	})
 }
@@ -50,6 +50,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&WorkflowTaskResultList{},
 		&WorkflowArtifactGCTask{},
 		&WorkflowArtifactGCTaskList{},
+		&WorkflowArtifactRepository{},
+		&WorkflowArtifactRepositoryList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -35,4 +36,8 @@ type NodeResult struct {
 	Message  string    `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
 	Outputs  *Outputs  `json:"outputs,omitempty" protobuf:"bytes,3,opt,name=outputs"`
 	Progress Progress  `json:"progress,omitempty" protobuf:"bytes,4,opt,name=progress,casttype=Progress"`
+	// PeakResourceUsage is the peak CPU and memory used by the main containers
+	PeakResourceUsage apiv1.ResourceList `json:"peakResourceUsage,omitempty" protobuf:"bytes,5,rep,name=peakResourceUsage,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName,castvalue=k8s.io/apimachinery/pkg/api/resource.Quantity"`
+	// IntermediateParameters are the parameters the main container published while it ran
+	IntermediateParameters map[string]string `json:"intermediateParameters,omitempty" protobuf:"bytes,6,rep,name=intermediateParameters"`
 }
@@ -39,6 +39,22 @@ type WorkflowTemplateList struct {
 	Items           WorkflowTemplates `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
+// WorkflowTemplateRevision is a revision of the spec of a WorkflowTemplate or ClusterWorkflowTemplate
+type WorkflowTemplateRevision struct {
+	// Digest identifies the revision, and can be used to pin it in a workflowTemplateRef
+	Digest string `json:"digest" protobuf:"bytes,1,opt,name=digest"`
+	// Revision is the number of the revision, revisions are numbered in the order they were recorded
+	Revision int64 `json:"revision" protobuf:"varint,2,opt,name=revision"`
+	// CreationTimestamp is when the revision was recorded
+	CreationTimestamp metav1.Time  `json:"creationTimestamp" protobuf:"bytes,3,opt,name=creationTimestamp"`
+	Spec              WorkflowSpec `json:"spec" protobuf:"bytes,4,opt,name=spec"`
+}
+
+// WorkflowTemplateRevisionList is the revision history of a template, newest first
+type WorkflowTemplateRevisionList struct {
+	Items []WorkflowTemplateRevision `json:"items" protobuf:"bytes,1,rep,name=items"`
+}
+
 var _ TemplateHolder = &WorkflowTemplate{}
 
 // GetTemplateByName retrieves a defined template by its name
@@ -14,6 +14,7 @@ import (
 	"runtime"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -35,17 +36,19 @@ type TemplateType string
 
 // Possible template types
 const (
-	TemplateTypeContainer    TemplateType = "Container"
-	TemplateTypeContainerSet TemplateType = "ContainerSet"
-	TemplateTypeSteps        TemplateType = "Steps"
-	TemplateTypeScript       TemplateType = "Script"
-	TemplateTypeResource     TemplateType = "Resource"
-	TemplateTypeDAG          TemplateType = "DAG"
-	TemplateTypeSuspend      TemplateType = "Suspend"
-	TemplateTypeData         TemplateType = "Data"
-	TemplateTypeHTTP         TemplateType = "HTTP"
-	TemplateTypePlugin       TemplateType = "Plugin"
-	TemplateTypeUnknown      TemplateType = "Unknown"
+	TemplateTypeContainer     TemplateType = "Container"
+	TemplateTypeContainerSet  TemplateType = "ContainerSet"
+	TemplateTypeSteps         TemplateType = "Steps"
+	TemplateTypeScript        TemplateType = "Script"
+	TemplateTypeResource      TemplateType = "Resource"
+	TemplateTypeDAG           TemplateType = "DAG"
+	TemplateTypeSuspend       TemplateType = "Suspend"
+	TemplateTypeData          TemplateType = "Data"
+	TemplateTypeHTTP          TemplateType = "HTTP"
+	TemplateTypePlugin        TemplateType = "Plugin"
+	TemplateTypeChildWorkflow TemplateType = "ChildWorkflow"
+	TemplateTypeJob           TemplateType = "Job"
+	TemplateTypeUnknown       TemplateType = "Unknown"
 )
 
 // NodePhase is a label for the condition of a node at the current time.
@@ -74,17 +77,19 @@ type NodeType string
 
 // Node types
 const (
-	NodeTypePod       NodeType = "Pod"
-	NodeTypeContainer NodeType = "Container"
-	NodeTypeSteps     NodeType = "Steps"
-	NodeTypeStepGroup NodeType = "StepGroup"
-	NodeTypeDAG       NodeType = "DAG"
-	NodeTypeTaskGroup NodeType = "TaskGroup"
-	NodeTypeRetry     NodeType = "Retry"
-	NodeTypeSkipped   NodeType = "Skipped"
-	NodeTypeSuspend   NodeType = "Suspend"
-	NodeTypeHTTP      NodeType = "HTTP"
-	NodeTypePlugin    NodeType = "Plugin"
+	NodeTypePod           NodeType = "Pod"
+	NodeTypeContainer     NodeType = "Container"
+	NodeTypeSteps         NodeType = "Steps"
+	NodeTypeStepGroup     NodeType = "StepGroup"
+	NodeTypeDAG           NodeType = "DAG"
+	NodeTypeTaskGroup     NodeType = "TaskGroup"
+	NodeTypeRetry         NodeType = "Retry"
+	NodeTypeSkipped       NodeType = "Skipped"
+	NodeTypeSuspend       NodeType = "Suspend"
+	NodeTypeHTTP          NodeType = "HTTP"
+	NodeTypePlugin        NodeType = "Plugin"
+	NodeTypeChildWorkflow NodeType = "ChildWorkflow"
+	NodeTypeJob           NodeType = "Job"
 )
 
 // ArtifactGCStrategy is the strategy when to delete artifacts for GC.
@@ -324,6 +329,12 @@ type WorkflowSpec struct {
 	// +patchMergeKey=key
 	Tolerations []apiv1.Toleration `json:"tolerations,omitempty" patchStrategy:"merge" patchMergeKey:"key" protobuf:"bytes,12,opt,name=tolerations"`
 
+	// SchedulingGates to apply to workflow pods, which are not scheduled until external controllers, e.g. of queues,
+	// remove the gates. Can be overridden by the scheduling gates of the template
+	// +patchStrategy=merge
+	// +patchMergeKey=name
+	SchedulingGates []apiv1.PodSchedulingGate `json:"schedulingGates,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,45,rep,name=schedulingGates"`
+
 	// ImagePullSecrets is a list of references to secrets in the same namespace to use for pulling any images
 	// in pods that reference this ServiceAccount. ImagePullSecrets are distinct from Secrets because Secrets
 	// can be mounted in the pod, but ImagePullSecrets are only accessed by the kubelet.
@@ -434,6 +445,54 @@ type WorkflowSpec struct {
 	// ArtifactGC describes the strategy to use when deleting artifacts from completed or deleted workflows (applies to all output Artifacts
 	// unless Artifact.ArtifactGC is specified, which overrides this)
 	ArtifactGC *WorkflowLevelArtifactGC `json:"artifactGC,omitempty" protobuf:"bytes,43,opt,name=artifactGC"`
+
+	// Imports are WorkflowTemplates published to OCI registries, which templateRefs can refer to by the name of the import
+	// +patchStrategy=merge
+	// +patchMergeKey=name
+	Imports []TemplateImport `json:"imports,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,44,rep,name=imports"`
+
+	// Notifications are sent by the controller to the channels configured in it when the workflow changes phase.
+	// Unlike exit handlers, they are sent even if the workflow fails before its exit handler can run.
+	Notifications []Notification `json:"notifications,omitempty" protobuf:"bytes,46,rep,name=notifications"`
+
+	// StepThrough pauses the workflow before each node that runs a pod, HTTP request or plugin, so that you can
+	// step through it with `argo resume --next`, inspecting the outputs of each node before the next one runs
+	StepThrough *StepThrough `json:"stepThrough,omitempty" protobuf:"bytes,47,opt,name=stepThrough"`
+}
+
+// StepThrough configures pausing a workflow before its nodes run
+type StepThrough struct {
+	// NodeFieldSelector selects the nodes to pause before, e.g. `templateName=~^train-`. Defaults to all of them.
+	// +optional
+	NodeFieldSelector string `json:"nodeFieldSelector,omitempty" protobuf:"bytes,1,opt,name=nodeFieldSelector"`
+}
+
+// Notification is sent to a channel configured in the controller when the workflow changes to one of the phases
+type Notification struct {
+	// Channel is the name of a channel in the controller's `notifications` config
+	Channel string `json:"channel" protobuf:"bytes,1,opt,name=channel"`
+	// Events are the workflow phases to notify of, any of Running, Succeeded, Failed and Error.
+	// Defaults to Succeeded, Failed and Error.
+	// +optional
+	Events []WorkflowPhase `json:"events,omitempty" protobuf:"bytes,2,rep,name=events,casttype=WorkflowPhase"`
+}
+
+// NotifiesOf returns whether the notification is sent when the workflow changes to the phase
+func (n Notification) NotifiesOf(phase WorkflowPhase) bool {
+	if len(n.Events) == 0 {
+		return phase.Completed()
+	}
+	return slices.Contains(n.Events, phase)
+}
+
+// GetImport returns the import of the given name, or nil if there is none
+func (wfs *WorkflowSpec) GetImport(name string) *TemplateImport {
+	for i := range wfs.Imports {
+		if wfs.Imports[i].Name == name {
+			return &wfs.Imports[i]
+		}
+	}
+	return nil
 }
 
 type LabelValueFrom struct {
@@ -615,6 +674,10 @@ type Template struct {
 	// Name is the name of the template
 	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
 
+	// Extends is the template this template inherits from. Its fields, such as the container, metadata, retry strategy,
+	// and inputs, are merged with this template's, which take precedence.
+	Extends *TemplateExtends `json:"extends,omitempty" protobuf:"bytes,46,opt,name=extends"`
+
 	// Inputs describe what inputs parameters and artifacts are supplied to this template
 	Inputs Inputs `json:"inputs,omitempty" protobuf:"bytes,5,opt,name=inputs"`
 
@@ -653,6 +716,10 @@ type Template struct {
 	// DAG template subtype which runs a DAG
 	DAG *DAGTemplate `json:"dag,omitempty" protobuf:"bytes,15,opt,name=dag"`
 
+	// Finally is invoked after the steps or DAG tasks of this template complete, whether they succeeded or failed.
+	// Its arguments can refer to their outputs, `{{status}}`, the phase they completed with, and `{{failures}}`, a JSON list of their failed nodes.
+	Finally *LifecycleHook `json:"finally,omitempty" protobuf:"bytes,45,opt,name=finally"`
+
 	// Suspend template subtype which can suspend a workflow when reaching the step
 	Suspend *SuspendTemplate `json:"suspend,omitempty" protobuf:"bytes,16,opt,name=suspend"`
 
@@ -668,11 +735,26 @@ type Template struct {
 	// +kubebuilder:pruning:PreserveUnknownFields
 	Plugin *Plugin `json:"plugin,omitempty" protobuf:"bytes,43,opt,name=plugin"`
 
+	// ChildWorkflow runs a complete workflow spec as a child Workflow, whose phase becomes the phase of the node
+	ChildWorkflow *ChildWorkflowTemplate `json:"childWorkflow,omitempty" protobuf:"bytes,47,opt,name=childWorkflow"`
+
+	// Job creates a Kubernetes Job or JobSet, whose completion or failure becomes the phase of the node
+	Job *JobTemplate `json:"job,omitempty" protobuf:"bytes,54,opt,name=job"`
+
 	// Volumes is a list of volumes that can be mounted by containers in a template.
 	// +patchStrategy=merge
 	// +patchMergeKey=name
 	Volumes []apiv1.Volume `json:"volumes,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,17,opt,name=volumes"`
 
+	// Scratch is a volume for the pod of the template, mounted in its main containers. It is a generic ephemeral volume,
+	// so its claim is created with the pod and deleted with it, unlike volumeClaimTemplates, which last as long as the
+	// workflow.
+	Scratch *ScratchVolume `json:"scratch,omitempty" protobuf:"bytes,51,opt,name=scratch"`
+
+	// GPU requests GPUs for the main container of the template. It is expanded to the resource, node selector,
+	// tolerations and runtime class of the accelerator profile of its type in the controller configuration.
+	GPU *GPURequest `json:"gpu,omitempty" protobuf:"bytes,52,opt,name=gpu"`
+
 	// InitContainers is a list of containers which run before the main container.
 	// +patchStrategy=merge
 	// +patchMergeKey=name
@@ -690,6 +772,11 @@ type Template struct {
 	// <workflowname>/<nodename> in the key.
 	ArchiveLocation *ArtifactLocation `json:"archiveLocation,omitempty" protobuf:"bytes,20,opt,name=archiveLocation"`
 
+	// ArtifactRepositoryRef specifies the configMap name and key containing the artifact repository config to use
+	// for this template's artifacts and logs, in place of the workflow's artifact repository.
+	// Ignored if ArchiveLocation is set.
+	ArtifactRepositoryRef *ArtifactRepositoryRef `json:"artifactRepositoryRef,omitempty" protobuf:"bytes,53,opt,name=artifactRepositoryRef"`
+
 	// Optional duration in seconds relative to the StartTime that the pod may be active on a node
 	// before the system actively tries to terminate the pod; value must be positive integer
 	// This field is only applicable to container and script templates.
@@ -712,6 +799,18 @@ type Template struct {
 	// +patchMergeKey=key
 	Tolerations []apiv1.Toleration `json:"tolerations,omitempty" patchStrategy:"merge" patchMergeKey:"key" protobuf:"bytes,24,opt,name=tolerations"`
 
+	// SchedulingGates to apply to the pod, which is not scheduled until external controllers remove the gates.
+	// While the pod is gated, the node is pending and its timeout is not enforced.
+	// +patchStrategy=merge
+	// +patchMergeKey=name
+	SchedulingGates []apiv1.PodSchedulingGate `json:"schedulingGates,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,49,rep,name=schedulingGates"`
+
+	// PodDisruptionBudget holds the number of concurrent disruptions that you allow for the pods of this template,
+	// e.g. those fanned out by `withItems`. The controller creates it when the first pod is created, and deletes it when
+	// the workflow completes. The selector is always limited to the pods of the template in this workflow.
+	// +optional
+	PodDisruptionBudget *policyv1.PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty" protobuf:"bytes,50,opt,name=podDisruptionBudget"`
+
 	// If specified, the pod will be dispatched by specified scheduler.
 	// Or it will be dispatched by workflow scope scheduler if specified.
 	// If neither specified, the pod will be dispatched by default scheduler.
@@ -758,6 +857,12 @@ type Template struct {
 	// This duration also includes time in which the node spends in Pending state. This duration may not be applied to Step or DAG templates.
 	Timeout string `json:"timeout,omitempty" protobuf:"bytes,38,opt,name=timeout"`
 
+	// ProgressWeight is how much the nodes of this template count towards the progress of the workflow, relative to
+	// others, e.g. a training step that takes hours could have a weight of 3600 and a setup step that takes a second a
+	// weight of 1. If not set, the weight is the estimated duration in seconds of the node from previous runs, if any.
+	// +optional
+	ProgressWeight *int64 `json:"progressWeight,omitempty" protobuf:"varint,48,opt,name=progressWeight"`
+
 	// Annotations is a list of annotations to add to the template at runtime
 	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,44,opt,name=annotations"`
 }
@@ -907,6 +1012,106 @@ type Parameter struct {
 
 	// Description is the parameter description
 	Description *AnyString `json:"description,omitempty" protobuf:"bytes,7,opt,name=description"`
+
+	// Schema constrains the values of the parameter, which are validated when the workflow is submitted and when the template is called
+	Schema *ParameterSchema `json:"schema,omitempty" protobuf:"bytes,8,opt,name=schema"`
+
+	// Optional indicates an output parameter may not be produced. If its file does not exist, the parameter
+	// has its default value (or is empty) instead of the node erroring, and `outputs.parameters.<name>.supplied` is false
+	Optional bool `json:"optional,omitempty" protobuf:"varint,9,opt,name=optional"`
+
+	// Sensitive values are encrypted in the status of the workflow, and therefore in the archive and the API, and are
+	// masked by the CLI. They are still substituted into the templates that refer to them. Requires the controller to
+	// be configured with `sensitiveParameters`.
+	Sensitive bool `json:"sensitive,omitempty" protobuf:"varint,10,opt,name=sensitive"`
+}
+
+// ParameterType is the type of a parameter's value
+type ParameterType string
+
+const (
+	ParameterTypeString  ParameterType = "string"
+	ParameterTypeInteger ParameterType = "integer"
+	ParameterTypeBoolean ParameterType = "boolean"
+	// ParameterTypeEnum values must be in the parameter's enum
+	ParameterTypeEnum ParameterType = "enum"
+)
+
+// ParameterSchema constrains the values of a parameter
+type ParameterSchema struct {
+	// Type of the value, defaults to string. An enum value must be in the parameter's enum.
+	// +kubebuilder:validation:Enum="";string;integer;boolean;enum
+	Type ParameterType `json:"type,omitempty" protobuf:"bytes,1,opt,name=type,casttype=ParameterType"`
+
+	// Pattern is a regular expression that a string value must match
+	Pattern string `json:"pattern,omitempty" protobuf:"bytes,2,opt,name=pattern"`
+
+	// Minimum is the smallest value an integer may have
+	Minimum *int64 `json:"minimum,omitempty" protobuf:"varint,3,opt,name=minimum"`
+
+	// Maximum is the largest value an integer may have
+	Maximum *int64 `json:"maximum,omitempty" protobuf:"varint,4,opt,name=maximum"`
+}
+
+// Validate checks the schema itself is valid, e.g. that its pattern compiles
+func (s *ParameterSchema) Validate(enum []AnyString) error {
+	switch s.Type {
+	case "", ParameterTypeString, ParameterTypeBoolean:
+	case ParameterTypeInteger:
+		if s.Minimum != nil && s.Maximum != nil && *s.Minimum > *s.Maximum {
+			return fmt.Errorf("schema.minimum %d is greater than schema.maximum %d", *s.Minimum, *s.Maximum)
+		}
+	case ParameterTypeEnum:
+		if len(enum) == 0 {
+			return fmt.Errorf("schema.type enum requires an enum")
+		}
+	default:
+		return fmt.Errorf("schema.type %q must be one of: string, integer, boolean, enum", s.Type)
+	}
+	if (s.Minimum != nil || s.Maximum != nil) && s.Type != ParameterTypeInteger {
+		return fmt.Errorf("schema.minimum and schema.maximum are only allowed for integers")
+	}
+	if s.Pattern != "" {
+		if _, err := regexp.Compile(s.Pattern); err != nil {
+			return fmt.Errorf("schema.pattern is invalid: %w", err)
+		}
+	}
+	return nil
+}
+
+// ValidateValue checks the value is allowed by the schema
+func (s *ParameterSchema) ValidateValue(value string, enum []AnyString) error {
+	switch s.Type {
+	case ParameterTypeInteger:
+		i, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not an integer", value)
+		}
+		if s.Minimum != nil && i < *s.Minimum {
+			return fmt.Errorf("%d is less than the minimum %d", i, *s.Minimum)
+		}
+		if s.Maximum != nil && i > *s.Maximum {
+			return fmt.Errorf("%d is greater than the maximum %d", i, *s.Maximum)
+		}
+	case ParameterTypeBoolean:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("%q is not a boolean", value)
+		}
+	case ParameterTypeEnum:
+		if !slices.Contains(enum, AnyString(value)) {
+			return fmt.Errorf("%q is not one of the enum values", value)
+		}
+	}
+	if s.Pattern != "" {
+		matched, err := regexp.MatchString(s.Pattern, value)
+		if err != nil {
+			return fmt.Errorf("schema.pattern is invalid: %w", err)
+		}
+		if !matched {
+			return fmt.Errorf("%q does not match the pattern %q", value, s.Pattern)
+		}
+	}
+	return nil
 }
 
 // ValueFrom describes a location in which to obtain the value to a parameter
@@ -933,6 +1138,15 @@ type ValueFrom struct {
 	// ConfigMapKeyRef is configmap selector for input parameter configuration
 	ConfigMapKeyRef *apiv1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty" protobuf:"bytes,9,opt,name=configMapKeyRef"`
 
+	// SecretKeyRef is a secret selector for input parameter configuration, resolved at runtime. Its value is not
+	// stored in the status of the workflow.
+	SecretKeyRef *apiv1.SecretKeySelector `json:"secretKeyRef,omitempty" protobuf:"bytes,10,opt,name=secretKeyRef"`
+
+	// Plugin is the configuration of the parameter source plugin to get the value of an input parameter of an HTTP
+	// or plugin template from, e.g. HashiCorp Vault. The value is got by the agent when the node starts, and is not
+	// stored in the status of the workflow.
+	Plugin *Plugin `json:"plugin,omitempty" protobuf:"bytes,11,opt,name=plugin"`
+
 	// Default specifies a value to be used if retrieving the value from the specified source fails
 	Default *AnyString `json:"default,omitempty" protobuf:"bytes,5,opt,name=default"`
 
@@ -944,6 +1158,32 @@ func (p *Parameter) HasValue() bool {
 	return p.Value != nil || p.Default != nil || p.ValueFrom != nil
 }
 
+// IsSecret returns whether the value of the parameter is from a secret
+func (p *Parameter) IsSecret() bool {
+	return p.ValueFrom != nil && p.ValueFrom.SecretKeyRef != nil
+}
+
+// IsFromPlugin returns whether the value of the parameter is got from a parameter source plugin
+func (p *Parameter) IsFromPlugin() bool {
+	return p.ValueFrom != nil && p.ValueFrom.Plugin != nil
+}
+
+// IsSupplied returns false if the parameter is an optional output parameter whose file did not exist
+func (p *Parameter) IsSupplied() bool {
+	return !p.Optional || p.Value != nil
+}
+
+// GetOutputValue returns the value of an output parameter, which is its default, or empty, if it was not supplied
+func (p *Parameter) GetOutputValue() string {
+	if p.Value != nil {
+		return p.Value.String()
+	}
+	if p.ValueFrom != nil && p.ValueFrom.Default != nil {
+		return p.ValueFrom.Default.String()
+	}
+	return ""
+}
+
 func (p *Parameter) GetValue() string {
 	if p.Value != nil {
 		return p.Value.String()
@@ -999,6 +1239,36 @@ type Artifact struct {
 
 	// Has this been deleted?
 	Deleted bool `json:"deleted,omitempty" protobuf:"varint,13,opt,name=deleted"`
+
+	// Aggregate is how an input artifact from the outputs of a fan-out step or task is loaded:
+	// Directory (the default) loads the artifact of each of its nodes into a sub-directory named after its index,
+	// Manifest writes a JSON list of their locations instead.
+	// +kubebuilder:validation:Enum="";Directory;Manifest
+	Aggregate ArtifactAggregate `json:"aggregate,omitempty" protobuf:"bytes,14,opt,name=aggregate,casttype=ArtifactAggregate"`
+
+	// Aggregated are the locations of the artifacts of the nodes of a fan-out step or task,
+	// when this artifact is from its outputs.
+	Aggregated []ArtifactLocation `json:"aggregated,omitempty" protobuf:"bytes,15,rep,name=aggregated"`
+
+	// SizeBytes is the size in bytes of the saved output artifact, e.g. of its archive, set by the executor
+	SizeBytes int64 `json:"sizeBytes,omitempty" protobuf:"varint,16,opt,name=sizeBytes"`
+
+	// Checksum is the checksum of the saved output artifact, e.g. "sha256:...", set by the executor.
+	// It is not set for directories saved without an archive.
+	Checksum string `json:"checksum,omitempty" protobuf:"bytes,17,opt,name=checksum"`
+}
+
+// ArtifactAggregate is how the artifacts of a fan-out step or task are loaded as one input artifact
+type ArtifactAggregate string
+
+const (
+	ArtifactAggregateDirectory ArtifactAggregate = "Directory"
+	ArtifactAggregateManifest  ArtifactAggregate = "Manifest"
+)
+
+// HasLocationOrKey returns whether the artifact has a location or key, or aggregates artifacts that do
+func (a *Artifact) HasLocationOrKey() bool {
+	return len(a.Aggregated) > 0 || a.ArtifactLocation.HasLocationOrKey()
 }
 
 // ArtifactGC returns the ArtifactGC that was defined by the artifact.  If none was provided, a default value is returned.
@@ -1118,6 +1388,36 @@ func (agc *ArtifactGC) GetStrategy() ArtifactGCStrategy {
 	return ArtifactGCStrategyUndefined
 }
 
+// ScratchVolume is a volume for the pod of a template, whose claim is created with the pod and deleted with it
+type ScratchVolume struct {
+	// Size of the volume, e.g. 50Gi
+	StorageSize resource.Quantity `json:"size" protobuf:"bytes,1,opt,name=size"`
+
+	// StorageClass of the volume, the default storage class if not set
+	StorageClass string `json:"storageClass,omitempty" protobuf:"bytes,2,opt,name=storageClass"`
+
+	// MountPath is where the volume is mounted in the main containers. Defaults to "/scratch"
+	MountPath string `json:"mountPath,omitempty" protobuf:"bytes,3,opt,name=mountPath"`
+}
+
+// GetMountPath returns where the volume is mounted
+func (s *ScratchVolume) GetMountPath() string {
+	if s.MountPath == "" {
+		return "/scratch"
+	}
+	return s.MountPath
+}
+
+// GPURequest is a request for GPUs of a type
+type GPURequest struct {
+	// Count of GPUs
+	Count int32 `json:"count" protobuf:"varint,1,opt,name=count"`
+
+	// Type of GPU, the name of an accelerator profile in the controller configuration. Defaults to the "default"
+	// profile, or NVIDIA GPUs if there is none.
+	Type string `json:"type,omitempty" protobuf:"bytes,2,opt,name=type"`
+}
+
 // VolumeClaimGC describes how to delete volumes from completed Workflows
 type VolumeClaimGC struct {
 	// Strategy is the strategy to use. One of "OnWorkflowCompletion", "OnWorkflowSuccess". Defaults to "OnWorkflowSuccess"
@@ -1317,6 +1617,8 @@ type ArtifactRepositoryRef struct {
 	ConfigMap string `json:"configMap,omitempty" protobuf:"bytes,1,opt,name=configMap"`
 	// The config map key. Defaults to the value of the "workflows.argoproj.io/default-artifact-repository" annotation.
 	Key string `json:"key,omitempty" protobuf:"bytes,2,opt,name=key"`
+	// The name of a WorkflowArtifactRepository in the workflow's namespace. If set, ConfigMap and Key are ignored.
+	WorkflowArtifactRepository string `json:"workflowArtifactRepository,omitempty" protobuf:"bytes,3,opt,name=workflowArtifactRepository"`
 }
 
 func (r *ArtifactRepositoryRef) GetConfigMapOr(configMap string) string {
@@ -1337,6 +1639,9 @@ func (r *ArtifactRepositoryRef) String() string {
 	if r == nil {
 		return "nil"
 	}
+	if r.WorkflowArtifactRepository != "" {
+		return r.WorkflowArtifactRepository
+	}
 	return fmt.Sprintf("%s#%s", r.ConfigMap, r.Key)
 }
 
@@ -1660,14 +1965,20 @@ type Sequence struct {
 	// Count is number of elements in the sequence (default: 0). Not to be used with end
 	Count *intstr.IntOrString `json:"count,omitempty" protobuf:"bytes,1,opt,name=count"`
 
-	// Number at which to start the sequence (default: 0)
+	// Number or date at which to start the sequence (default: 0).
+	// A date, such as 2024-01-01 or 2024-01-01T00:00:00Z, makes this a sequence of dates.
 	Start *intstr.IntOrString `json:"start,omitempty" protobuf:"bytes,2,opt,name=start"`
 
-	// Number at which to end the sequence (default: 0). Not to be used with Count
+	// Number or date at which to end the sequence (default: 0). Not to be used with Count
 	End *intstr.IntOrString `json:"end,omitempty" protobuf:"bytes,3,opt,name=end"`
 
-	// Format is a printf format string to format the value in the sequence
+	// Format is a printf format string to format the value in the sequence.
+	// For a sequence of dates it is a Go time layout, such as 2006-01-02 (default: the layout of start).
 	Format string `json:"format,omitempty" protobuf:"bytes,4,opt,name=format"`
+
+	// Step is the increment between the values in the sequence: a number (default: 1),
+	// or a duration such as 24h for a sequence of dates (default: 24h)
+	Step string `json:"step,omitempty" protobuf:"bytes,5,opt,name=step"`
 }
 
 // TemplateRef is a reference of template resource.
@@ -1680,6 +1991,121 @@ type TemplateRef struct {
 	ClusterScope bool `json:"clusterScope,omitempty" protobuf:"varint,4,opt,name=clusterScope"`
 }
 
+// ChildWorkflowTemplate is a workflow spec that is run as a child Workflow of the workflow it is part of.
+// The child is named after the node, is deleted with its parent, and its exported (global) outputs become the node's outputs.
+type ChildWorkflowTemplate struct {
+	// Metadata sets the labels and annotations of the child workflow
+	Metadata Metadata `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec is the spec of the child workflow. Only its arguments are substituted with the parameters of this template,
+	// the rest is substituted by the child workflow itself.
+	// Note: this struct is defined recursively, so we need "x-kubernetes-preserve-unknown-fields: true" in the validation schema.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Spec WorkflowSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Cascade is the operations on this workflow that cascade to the child workflow, defaults to "All"
+	// +kubebuilder:validation:Enum="";All;Shutdown;None
+	Cascade CascadeStrategy `json:"cascade,omitempty" protobuf:"bytes,3,opt,name=cascade,casttype=CascadeStrategy"`
+}
+
+// GetCascade returns the operations that cascade to the child workflow
+func (t *ChildWorkflowTemplate) GetCascade() CascadeStrategy {
+	if t.Cascade == "" {
+		return CascadeAll
+	}
+	return t.Cascade
+}
+
+// CascadeStrategy is the operations on a workflow that cascade to the workflows its templates created
+type CascadeStrategy string
+
+const (
+	// CascadeAll cascades terminating, stopping, suspending and resuming the workflow
+	CascadeAll CascadeStrategy = "All"
+	// CascadeShutdown cascades terminating and stopping the workflow
+	CascadeShutdown CascadeStrategy = "Shutdown"
+	// CascadeNone cascades nothing
+	CascadeNone CascadeStrategy = "None"
+)
+
+// Shutdown returns whether terminating and stopping the workflow cascades
+func (s CascadeStrategy) Shutdown() bool {
+	return s == CascadeAll || s == CascadeShutdown
+}
+
+// Suspend returns whether suspending and resuming the workflow cascades
+func (s CascadeStrategy) Suspend() bool {
+	return s == CascadeAll
+}
+
+// JobKind is the kind of object a job template creates
+type JobKind string
+
+const (
+	// JobKindJob is a batch/v1 Job
+	JobKindJob JobKind = "Job"
+	// JobKindJobSet is a jobset.x-k8s.io/v1alpha2 JobSet, which requires the JobSet controller to be installed
+	JobKindJobSet JobKind = "JobSet"
+)
+
+// JobTemplate is a Kubernetes Job, or JobSet, that is created for the node of the template and owned by the workflow.
+// It is named after the node, and its pods are labelled with the workflow so their logs can be retrieved with it.
+type JobTemplate struct {
+	// Kind is the kind of object to create, either "Job" (default) or "JobSet"
+	// +kubebuilder:validation:Enum=Job;JobSet
+	Kind JobKind `json:"kind,omitempty" protobuf:"bytes,1,opt,name=kind,casttype=JobKind"`
+	// Metadata sets the labels and annotations of the Job or JobSet
+	Metadata Metadata `json:"metadata,omitempty" protobuf:"bytes,2,opt,name=metadata"`
+	// Spec is the spec of the Job or JobSet, e.g. of an Indexed Job
+	// Note: the structure of the spec is that of the kind, so we need to have
+	// "x-kubernetes-preserve-unknown-fields: true" in the validation schema.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Spec Object `json:"spec" protobuf:"bytes,3,opt,name=spec"`
+}
+
+// GetKind returns the kind of object to create, defaulting to a Job
+func (t *JobTemplate) GetKind() JobKind {
+	if t == nil || t.Kind == "" {
+		return JobKindJob
+	}
+	return t.Kind
+}
+
+// TemplateExtends is a reference to the template another template extends
+type TemplateExtends struct {
+	// Template is the name of a template in the same workflow or workflow template
+	Template string `json:"template,omitempty" protobuf:"bytes,1,opt,name=template"`
+	// TemplateRef is a reference to a template in another workflow template
+	TemplateRef *TemplateRef `json:"templateRef,omitempty" protobuf:"bytes,2,opt,name=templateRef"`
+}
+
+func (e *TemplateExtends) GetTemplate() *Template {
+	return nil
+}
+
+func (e *TemplateExtends) GetTemplateRef() *TemplateRef {
+	return e.TemplateRef
+}
+
+func (e *TemplateExtends) GetTemplateName() string {
+	return e.Template
+}
+
+func (e *TemplateExtends) GetName() string {
+	if e.TemplateRef != nil {
+		return e.TemplateRef.Template
+	}
+	return e.Template
+}
+
+func (e *TemplateExtends) IsDAGTask() bool {
+	return false
+}
+
+func (e *TemplateExtends) IsWorkflowStep() bool {
+	return false
+}
+
+var _ TemplateReferenceHolder = &TemplateExtends{}
+
 // Synchronization holds synchronization lock configuration
 type Synchronization struct {
 	// Semaphore holds the Semaphore configuration - deprecated, use semaphores instead
@@ -1730,12 +2156,51 @@ type Mutex struct {
 	Database bool `json:"database,omitempty" protobuf:"bytes,3,opt,name=database"`
 }
 
+// TemplateImport imports a WorkflowTemplate published as an artifact to an OCI registry. templateRefs refer to the
+// imported WorkflowTemplate by the name of the import, rather than by the name of a WorkflowTemplate resource.
+type TemplateImport struct {
+	// Name is the name templateRefs refer to the imported WorkflowTemplate by. An import takes precedence over a
+	// WorkflowTemplate of the same name.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Registry is the host of the OCI registry, e.g. "ghcr.io"
+	Registry string `json:"registry" protobuf:"bytes,2,opt,name=registry"`
+	// Repository is the repository of the artifact in the registry, e.g. "my-org/ci-templates"
+	Repository string `json:"repository" protobuf:"bytes,3,opt,name=repository"`
+	// Tag of the artifact to import, e.g. "v1.2.0"
+	Tag string `json:"tag,omitempty" protobuf:"bytes,4,opt,name=tag"`
+	// Digest of the artifact to import, e.g. "sha256:4f3c...". It takes precedence over the tag, so pins the
+	// WorkflowTemplate even if the tag is moved.
+	Digest string `json:"digest,omitempty" protobuf:"bytes,5,opt,name=digest"`
+}
+
+// GetReference returns the reference of the artifact, by digest if it has one, e.g. "ghcr.io/my-org/ci-templates:v1.2.0"
+func (i TemplateImport) GetReference() string {
+	if i.Digest != "" {
+		return i.Registry + "/" + i.Repository + "@" + i.Digest
+	}
+	return i.Registry + "/" + i.Repository + ":" + i.Tag
+}
+
 // WorkflowTemplateRef is a reference to a WorkflowTemplate resource.
 type WorkflowTemplateRef struct {
 	// Name is the resource name of the workflow template.
 	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
 	// ClusterScope indicates the referred template is cluster scoped (i.e. a ClusterWorkflowTemplate).
 	ClusterScope bool `json:"clusterScope,omitempty" protobuf:"varint,2,opt,name=clusterScope"`
+	// Digest pins the revision of the template to run, e.g. "sha256:4f3c...", rather than its latest revision.
+	// The digests of a template's revisions are listed by the API, and recorded on the workflows that run them.
+	Digest string `json:"digest,omitempty" protobuf:"bytes,3,opt,name=digest"`
+	// Namespace of the referred WorkflowTemplate, defaults to the workflow's namespace. The workflow controller must
+	// be configured to grant the workflow's namespace access to the templates of another namespace.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,4,opt,name=namespace"`
+}
+
+// GetNamespace returns the namespace of the referred WorkflowTemplate, given the namespace of the workflow
+func (ref *WorkflowTemplateRef) GetNamespace(workflowNamespace string) string {
+	if ref.Namespace != "" {
+		return ref.Namespace
+	}
+	return workflowNamespace
 }
 
 func (ref *WorkflowTemplateRef) ToTemplateRef(template string) *TemplateRef {
@@ -1991,6 +2456,11 @@ type WorkflowStatus struct {
 	// StoredTemplates is a mapping between a template ref and the node's status.
 	StoredTemplates map[string]Template `json:"storedTemplates,omitempty" protobuf:"bytes,9,rep,name=storedTemplates"`
 
+	// TemplateDigests are the digests of the revisions of the WorkflowTemplates and ClusterWorkflowTemplates templates
+	// were resolved from, by "namespaced/<name>" or "cluster/<name>", when the controller pins them instead of storing the
+	// resolved templates. The templates are resolved from these revisions again when they are needed.
+	TemplateDigests map[string]string `json:"templateDigests,omitempty" protobuf:"bytes,21,rep,name=templateDigests"`
+
 	// PersistentVolumeClaims tracks all PVCs that were created as part of the workflow.
 	// The contents of this list are drained at the end of the workflow.
 	PersistentVolumeClaims []apiv1.Volume `json:"persistentVolumeClaims,omitempty" protobuf:"bytes,7,rep,name=persistentVolumeClaims"`
@@ -2004,6 +2474,18 @@ type WorkflowStatus struct {
 	// ResourcesDuration is the total for the workflow
 	ResourcesDuration ResourcesDuration `json:"resourcesDuration,omitempty" protobuf:"bytes,12,opt,name=resourcesDuration"`
 
+	// EstimatedCost is the total estimated cost of the workflow's pods, priced by the controller's pricing config
+	EstimatedCost *Amount `json:"estimatedCost,omitempty" protobuf:"bytes,23,opt,name=estimatedCost"`
+
+	// ImageDigests are the digests the tags of the workflow's images resolved to, keyed by image, when the controller
+	// pins images to digests. Every pod of the workflow runs the images at these digests.
+	ImageDigests map[string]string `json:"imageDigests,omitempty" protobuf:"bytes,24,rep,name=imageDigests"`
+
+	// ResourceRecommendations are the recommended requests and limits of each template, keyed by the template name, or
+	// "<workflow template>/<template>" for templates referenced by templateRef. They are from the peak resource usage of
+	// the nodes of this and prior archived workflows, and are populated when the workflow completes.
+	ResourceRecommendations map[string]ResourceRecommendation `json:"resourceRecommendations,omitempty" protobuf:"bytes,22,rep,name=resourceRecommendations"`
+
 	// StoredWorkflowSpec stores the WorkflowTemplate spec for future execution.
 	StoredWorkflowSpec *WorkflowSpec `json:"storedWorkflowTemplateSpec,omitempty" protobuf:"bytes,14,opt,name=storedWorkflowTemplateSpec"`
 
@@ -2016,6 +2498,10 @@ type WorkflowStatus struct {
 	// ArtifactGCStatus maintains the status of Artifact Garbage Collection
 	ArtifactGCStatus *ArtGCStatus `json:"artifactGCStatus,omitempty" protobuf:"bytes,19,opt,name=artifactGCStatus"`
 
+	// ArtifactManifest is the JSON manifest of the output artifacts of the workflow, written to its artifact repository
+	// by the controller when the workflow completes, if enabled
+	ArtifactManifest *Artifact `json:"artifactManifest,omitempty" protobuf:"bytes,25,opt,name=artifactManifest"`
+
 	// TaskResultsCompletionStatus tracks task result completion status (mapped by node ID). Used to prevent premature archiving and garbage collection.
 	TaskResultsCompletionStatus map[string]bool `json:"taskResultsCompletionStatus,omitempty" protobuf:"bytes,20,opt,name=taskResultsCompletionStatus"`
 }
@@ -2196,6 +2682,19 @@ func (in ResourceDuration) String() string {
 	return in.Duration().String()
 }
 
+// ResourceRecommendation is the recommended requests and limits of a template
+type ResourceRecommendation struct {
+	// Requests are the recommended requests, from the 90th percentile of the peak usage
+	Requests apiv1.ResourceList `json:"requests,omitempty" protobuf:"bytes,1,rep,name=requests,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName,castvalue=k8s.io/apimachinery/pkg/api/resource.Quantity"`
+
+	// Limits are the recommended limits, from the maximum of the peak usage with headroom. Only memory has a limit, as
+	// a CPU limit throttles rather than protects the node.
+	Limits apiv1.ResourceList `json:"limits,omitempty" protobuf:"bytes,2,rep,name=limits,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName,castvalue=k8s.io/apimachinery/pkg/api/resource.Quantity"`
+
+	// Samples is the number of nodes the recommendation is from
+	Samples int64 `json:"samples,omitempty" protobuf:"varint,3,opt,name=samples"`
+}
+
 // This contains each duration by request requested.
 // e.g. 100m CPU * 1h, 1Gi memory * 1h
 type ResourcesDuration map[apiv1.ResourceName]ResourceDuration
@@ -2303,6 +2802,10 @@ const (
 	ConditionTypeMetricsError ConditionType = "MetricsError"
 	// ConditionTypeArtifactGCError is an error on artifact garbage collection
 	ConditionTypeArtifactGCError ConditionType = "ArtifactGCError"
+	// ConditionTypeShutdown signifies the workflow was stopped or terminated, its message is by whom and why
+	ConditionTypeShutdown ConditionType = "Shutdown"
+	// ConditionTypeReachable signifies whether the controller could reach a WorkflowArtifactRepository when it last probed it
+	ConditionTypeReachable ConditionType = "Reachable"
 )
 
 type Condition struct {
@@ -2363,12 +2866,40 @@ type NodeStatus struct {
 	// EstimatedDuration in seconds.
 	EstimatedDuration EstimatedDuration `json:"estimatedDuration,omitempty" protobuf:"varint,24,opt,name=estimatedDuration,casttype=EstimatedDuration"`
 
+	// EstimatedDurationP90 in seconds, is the 90th percentile of the durations of the nodes of the template in archived
+	// workflows, an estimate of how long the node could take when the estimated duration is the median
+	EstimatedDurationP90 EstimatedDuration `json:"estimatedDurationP90,omitempty" protobuf:"varint,30,opt,name=estimatedDurationP90,casttype=EstimatedDuration"`
+
 	// Progress to completion
 	Progress Progress `json:"progress,omitempty" protobuf:"bytes,26,opt,name=progress,casttype=Progress"`
 
+	// ProgressWeight is how much the progress of this node counts towards the progress of the workflow, zero if the
+	// node is not weighted
+	ProgressWeight int64 `json:"progressWeight,omitempty" protobuf:"varint,29,opt,name=progressWeight"`
+
+	// PeakResourceUsage is the peak CPU and memory used by the main containers of the node's pod, as captured by the
+	// executor. This is populated when the node completes.
+	PeakResourceUsage apiv1.ResourceList `json:"peakResourceUsage,omitempty" protobuf:"bytes,31,rep,name=peakResourceUsage,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName,castvalue=k8s.io/apimachinery/pkg/api/resource.Quantity"`
+
+	// IntermediateParameters are the parameters the main container of the node's pod published while it ran, by name.
+	// They are visible before the node completes.
+	IntermediateParameters map[string]string `json:"intermediateParameters,omitempty" protobuf:"bytes,32,rep,name=intermediateParameters"`
+
+	// ArtifactRepositoryRef is the artifact repository the node's template resolved its artifactRepositoryRef to.
+	// It is not set for nodes that use the workflow's artifact repository.
+	ArtifactRepositoryRef *ArtifactRepositoryRefStatus `json:"artifactRepositoryRef,omitempty" protobuf:"bytes,33,opt,name=artifactRepositoryRef"`
+
 	// ResourcesDuration is indicative, but not accurate, resource duration. This is populated when the nodes completes.
 	ResourcesDuration ResourcesDuration `json:"resourcesDuration,omitempty" protobuf:"bytes,21,opt,name=resourcesDuration"`
 
+	// EstimatedCost is the estimated cost of the node's resources duration, priced by the controller's pricing config.
+	// This is populated when the node completes.
+	EstimatedCost *Amount `json:"estimatedCost,omitempty" protobuf:"bytes,34,opt,name=estimatedCost"`
+
+	// ImageDigests are the digests of the images the node's pod ran, keyed by image, when the controller pins images to
+	// digests
+	ImageDigests map[string]string `json:"imageDigests,omitempty" protobuf:"bytes,35,rep,name=imageDigests"`
+
 	// PodIP captures the IP of the pod for daemoned steps
 	PodIP string `json:"podIP,omitempty" protobuf:"bytes,12,opt,name=podIP"`
 
@@ -2594,6 +3125,12 @@ func (n *NodeStatus) IsActiveSuspendNode() bool {
 	return n.Type == NodeTypeSuspend && n.Phase == NodeRunning
 }
 
+// IsActiveStepThroughNode returns whether this node is an active suspend node pausing the workflow before a node runs,
+// because the workflow is being stepped through
+func (n *NodeStatus) IsActiveStepThroughNode() bool {
+	return n.IsActiveSuspendNode() && n.NodeFlag != nil && n.NodeFlag.StepThrough
+}
+
 // IsTaskSetNode returns whether this node uses the taskset
 func (n *NodeStatus) IsTaskSetNode() bool {
 	return n.Type == NodeTypeHTTP || n.Type == NodeTypePlugin
@@ -3089,6 +3626,14 @@ func (o *OSSArtifact) HasLocation() bool {
 type ExecutorConfig struct {
 	// ServiceAccountName specifies the service account name of the executor container.
 	ServiceAccountName string `json:"serviceAccountName,omitempty" protobuf:"bytes,1,opt,name=serviceAccountName"`
+
+	// Resources of the executor containers, i.e. the init and wait containers. Overrides the resources of the
+	// controller's executor configuration, e.g. to satisfy the LimitRange of the namespace.
+	Resources *apiv1.ResourceRequirements `json:"resources,omitempty" protobuf:"bytes,2,opt,name=resources"`
+
+	// SecurityContext of the executor containers, i.e. the init and wait containers. Overrides the security context
+	// of the controller's executor configuration.
+	SecurityContext *apiv1.SecurityContext `json:"securityContext,omitempty" protobuf:"bytes,3,opt,name=securityContext"`
 }
 
 // ScriptTemplate is a template subtype to enable scripting through code steps
@@ -3133,6 +3678,11 @@ type ResourceTemplate struct {
 	// 	"--validate=false"  # disable resource validation
 	// ]
 	Flags []string `json:"flags,omitempty" protobuf:"varint,7,opt,name=flags"`
+
+	// Cascade is the operations on this workflow that cascade to the workflow the manifest creates, if it is a
+	// Workflow in the same namespace, defaults to "None"
+	// +kubebuilder:validation:Enum="";All;Shutdown;None
+	Cascade CascadeStrategy `json:"cascade,omitempty" protobuf:"bytes,9,opt,name=cascade,casttype=CascadeStrategy"`
 }
 
 type ManifestFrom struct {
@@ -3172,6 +3722,12 @@ func (tmpl *Template) GetType() TemplateType {
 	if tmpl.Plugin != nil {
 		return TemplateTypePlugin
 	}
+	if tmpl.ChildWorkflow != nil {
+		return TemplateTypeChildWorkflow
+	}
+	if tmpl.Job != nil {
+		return TemplateTypeJob
+	}
 	return TemplateTypeUnknown
 }
 
@@ -3192,6 +3748,10 @@ func (tmpl *Template) GetNodeType() NodeType {
 		return NodeTypeHTTP
 	case TemplateTypePlugin:
 		return NodeTypePlugin
+	case TemplateTypeChildWorkflow:
+		return NodeTypeChildWorkflow
+	case TemplateTypeJob:
+		return NodeTypeJob
 	}
 	return ""
 }
@@ -3290,6 +3850,102 @@ type DAGTemplate struct {
 	// completion (either success or failure), regardless of the failed outcomes of branches in the DAG.
 	// More info and example about this feature at https://github.com/argoproj/argo-workflows/issues/1442
 	FailFast *bool `json:"failFast,omitempty" protobuf:"varint,3,opt,name=failFast"`
+
+	// Hooks are run for every task of the DAG that matches their filters, e.g. to notify on the failure of any task
+	// +patchStrategy=merge
+	// +patchMergeKey=name
+	Hooks []DAGHook `json:"hooks,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,4,rep,name=hooks"`
+
+	// OneOf are groups of mutually exclusive tasks, of which exactly one runs. The others are skipped, so that tasks
+	// which depend on them run as they would after a task skipped by its `when` condition.
+	// +patchStrategy=merge
+	// +patchMergeKey=name
+	OneOf []DAGOneOf `json:"oneOf,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,5,rep,name=oneOf"`
+}
+
+// GetOneOf returns the oneOf group the task is a branch of, if any
+func (d *DAGTemplate) GetOneOf(taskName string) *DAGOneOf {
+	for i, oneOf := range d.OneOf {
+		for _, branch := range oneOf.Branches {
+			if branch.Task == taskName {
+				return &d.OneOf[i]
+			}
+		}
+	}
+	return nil
+}
+
+// DAGOneOf is a group of mutually exclusive tasks of a DAG, the branches, of which exactly one runs, selected by the
+// value of an expression. All the tasks of the group must have the same dependencies, and no `when` condition.
+type DAGOneOf struct {
+	// Name of the group
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Expression whose value selects the branch to run, e.g. `inputs.parameters.environment`
+	Expression string `json:"expression" protobuf:"bytes,2,opt,name=expression"`
+	// Branches of the group
+	Branches []DAGOneOfBranch `json:"branches" protobuf:"bytes,3,rep,name=branches"`
+}
+
+// Select returns the branch selected by the value of the expression, if any
+func (o DAGOneOf) Select(value string) *DAGOneOfBranch {
+	var defaultBranch *DAGOneOfBranch
+	for i, branch := range o.Branches {
+		if slices.Contains(branch.Values, value) {
+			return &o.Branches[i]
+		}
+		if branch.Default {
+			defaultBranch = &o.Branches[i]
+		}
+	}
+	return defaultBranch
+}
+
+// DAGOneOfBranch is a branch of a oneOf group
+type DAGOneOfBranch struct {
+	// Task is the name of the task of the branch
+	Task string `json:"task" protobuf:"bytes,1,opt,name=task"`
+	// Values of the expression that select the branch
+	Values []string `json:"values,omitempty" protobuf:"bytes,2,rep,name=values"`
+	// Default selects the branch when the value of the expression selects no other branch
+	Default bool `json:"default,omitempty" protobuf:"varint,3,opt,name=default"`
+}
+
+// DAGHook is a lifecycle hook of a DAG, run for each of its tasks that matches its filters.
+// Its arguments and expression can refer to the task that triggered it as `task`, e.g. `{{task.name}}`, `{{task.status}}`
+// and `{{task.outputs.parameters.message}}`.
+type DAGHook struct {
+	// Name of the hook, unique within the DAG. The hook node of a task is named `<task node name>.hooks.<name>`
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Template is the name of the template to execute by the hook
+	Template string `json:"template,omitempty" protobuf:"bytes,2,opt,name=template"`
+	// Arguments hold arguments to the template
+	Arguments Arguments `json:"arguments,omitempty" protobuf:"bytes,3,opt,name=arguments"`
+	// TemplateRef is the reference to the template resource to execute by the hook
+	TemplateRef *TemplateRef `json:"templateRef,omitempty" protobuf:"bytes,4,opt,name=templateRef"`
+	// Tasks is a regular expression the whole name of a task must match for the hook to run for it, e.g. `deploy-.*`.
+	// Defaults to all tasks.
+	Tasks string `json:"tasks,omitempty" protobuf:"bytes,5,opt,name=tasks"`
+	// Phases of a task the hook runs for, e.g. `[Failed, Error]`. Defaults to those of completed tasks:
+	// Succeeded, Failed and Error.
+	Phases []NodePhase `json:"phases,omitempty" protobuf:"bytes,6,rep,name=phases,casttype=NodePhase"`
+	// Expression is an additional condition for the hook to run for a task
+	Expression string `json:"expression,omitempty" protobuf:"bytes,7,opt,name=expression"`
+}
+
+// MatchesTask returns whether the hook runs for the task with the given name
+func (h DAGHook) MatchesTask(taskName string) (bool, error) {
+	if h.Tasks == "" {
+		return true, nil
+	}
+	return regexp.MatchString("^(?:"+h.Tasks+")$", taskName)
+}
+
+// MatchesPhase returns whether the hook runs for a task in the given phase
+func (h DAGHook) MatchesPhase(phase NodePhase) bool {
+	if len(h.Phases) == 0 {
+		return phase.Completed()
+	}
+	return slices.Contains(h.Phases, phase)
 }
 
 // DAGTask represents a node in the graph during DAG execution
@@ -3795,18 +4451,27 @@ type Gauge struct {
 	Value string `json:"value" protobuf:"bytes,1,opt,name=value"`
 	// Realtime emits this metric in real time if applicable
 	Realtime *bool `json:"realtime" protobuf:"varint,2,opt,name=realtime"`
-	// Operation defines the operation to apply with value and the metrics' current value
+	// Operation defines the operation to apply with value and the metrics' current value.
+	// Delete deletes the series of the metric with these labels, and ignores value.
 	// +optional
 	Operation GaugeOperation `json:"operation,omitempty" protobuf:"bytes,3,opt,name=operation"`
+	// TTL deletes the series once it has not been emitted for this long, such as "10m", overriding the metricsTTL of
+	// the controller
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty" protobuf:"bytes,4,opt,name=ttl"`
+	// DeleteOnCompletion deletes the series when the workflow that emitted it completes
+	// +optional
+	DeleteOnCompletion bool `json:"deleteOnCompletion,omitempty" protobuf:"varint,5,opt,name=deleteOnCompletion"`
 }
 
 // A GaugeOperation is the set of operations that can be used in a gauge metric.
 type GaugeOperation string
 
 const (
-	GaugeOperationSet GaugeOperation = "Set"
-	GaugeOperationAdd GaugeOperation = "Add"
-	GaugeOperationSub GaugeOperation = "Sub"
+	GaugeOperationSet    GaugeOperation = "Set"
+	GaugeOperationAdd    GaugeOperation = "Add"
+	GaugeOperationSub    GaugeOperation = "Sub"
+	GaugeOperationDelete GaugeOperation = "Delete"
 )
 
 // Histogram is a Histogram prometheus metric
@@ -3833,8 +4498,10 @@ type Counter struct {
 
 // Memoization enables caching for the Outputs of the template
 type Memoize struct {
-	// Key is the key to use as the caching key
-	Key string `json:"key" protobuf:"bytes,1,opt,name=key"`
+	// Key is the key to use as the caching key. If empty, the key is the name of the template and a hash of its
+	// resolved inputs, so that a DAG or steps template is looked up by the inputs of the whole subgraph.
+	// +optional
+	Key string `json:"key,omitempty" protobuf:"bytes,1,opt,name=key"`
 	// Cache sets and configures the kind of cache
 	Cache *Cache `json:"cache" protobuf:"bytes,2,opt,name=cache"`
 	// MaxAge is the maximum age (e.g. "180s", "24h") of an entry that is still considered valid. If an entry is older
@@ -4069,6 +4736,9 @@ type NodeFlag struct {
 	Hooked bool `json:"hooked,omitempty" protobuf:"varint,1,opt,name=hooked"`
 	// Retried tracks whether or not this node was retried by retryStrategy
 	Retried bool `json:"retried,omitempty" protobuf:"varint,2,opt,name=retried"`
+	// StepThrough tracks whether or not this node is a suspend node that pauses the workflow before a node runs,
+	// because the workflow is being stepped through
+	StepThrough bool `json:"stepThrough,omitempty" protobuf:"varint,3,opt,name=stepThrough"`
 }
 
 type TemplateAnnotation string
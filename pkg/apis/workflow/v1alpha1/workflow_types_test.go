@@ -1681,3 +1681,57 @@ func TestInlineStore(t *testing.T) {
 		})
 	}
 }
+
+func TestParameterSchema(t *testing.T) {
+	t.Run("Validate", func(t *testing.T) {
+		require.NoError(t, (&ParameterSchema{}).Validate(nil))
+		require.NoError(t, (&ParameterSchema{Type: ParameterTypeInteger, Minimum: ptr.To(int64(1)), Maximum: ptr.To(int64(1))}).Validate(nil))
+		require.EqualError(t, (&ParameterSchema{Type: ParameterTypeInteger, Minimum: ptr.To(int64(2)), Maximum: ptr.To(int64(1))}).Validate(nil), "schema.minimum 2 is greater than schema.maximum 1")
+		require.EqualError(t, (&ParameterSchema{Minimum: ptr.To(int64(1))}).Validate(nil), "schema.minimum and schema.maximum are only allowed for integers")
+		require.EqualError(t, (&ParameterSchema{Type: ParameterTypeEnum}).Validate(nil), "schema.type enum requires an enum")
+		require.ErrorContains(t, (&ParameterSchema{Pattern: "("}).Validate(nil), "schema.pattern is invalid")
+	})
+	t.Run("ValidateValue", func(t *testing.T) {
+		integer := &ParameterSchema{Type: ParameterTypeInteger, Minimum: ptr.To(int64(1)), Maximum: ptr.To(int64(10))}
+		require.NoError(t, integer.ValidateValue("5", nil))
+		require.EqualError(t, integer.ValidateValue("ten", nil), `"ten" is not an integer`)
+		require.EqualError(t, integer.ValidateValue("0", nil), "0 is less than the minimum 1")
+		require.EqualError(t, integer.ValidateValue("11", nil), "11 is greater than the maximum 10")
+
+		boolean := &ParameterSchema{Type: ParameterTypeBoolean}
+		require.NoError(t, boolean.ValidateValue("true", nil))
+		require.EqualError(t, boolean.ValidateValue("yes", nil), `"yes" is not a boolean`)
+
+		enum := &ParameterSchema{Type: ParameterTypeEnum}
+		require.NoError(t, enum.ValidateValue("a", []AnyString{"a", "b"}))
+		require.EqualError(t, enum.ValidateValue("c", []AnyString{"a", "b"}), `"c" is not one of the enum values`)
+
+		pattern := &ParameterSchema{Pattern: "^v[0-9]+$"}
+		require.NoError(t, pattern.ValidateValue("v1", nil))
+		require.EqualError(t, pattern.ValidateValue("1", nil), `"1" does not match the pattern "^v[0-9]+$"`)
+	})
+}
+
+func TestDAGHook(t *testing.T) {
+	t.Run("MatchesTask", func(t *testing.T) {
+		matches, err := DAGHook{}.MatchesTask("deploy")
+		require.NoError(t, err)
+		assert.True(t, matches)
+		matches, err = DAGHook{Tasks: "deploy-.*"}.MatchesTask("deploy-a")
+		require.NoError(t, err)
+		assert.True(t, matches)
+		matches, err = DAGHook{Tasks: "deploy"}.MatchesTask("deploy-a")
+		require.NoError(t, err)
+		assert.False(t, matches)
+		_, err = DAGHook{Tasks: "("}.MatchesTask("deploy")
+		require.Error(t, err)
+	})
+	t.Run("MatchesPhase", func(t *testing.T) {
+		assert.True(t, DAGHook{}.MatchesPhase(NodeSucceeded))
+		assert.True(t, DAGHook{}.MatchesPhase(NodeFailed))
+		assert.False(t, DAGHook{}.MatchesPhase(NodeRunning))
+		assert.False(t, DAGHook{}.MatchesPhase(NodeSkipped))
+		assert.True(t, DAGHook{Phases: []NodePhase{NodeFailed}}.MatchesPhase(NodeFailed))
+		assert.False(t, DAGHook{Phases: []NodePhase{NodeFailed}}.MatchesPhase(NodeSucceeded))
+	})
+}
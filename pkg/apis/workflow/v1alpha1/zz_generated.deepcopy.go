@@ -15,6 +15,37 @@ import (
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSigV4Auth) DeepCopyInto(out *AWSSigV4Auth) {
+	*out = *in
+	if in.AccessKeySecret != nil {
+		in, out := &in.AccessKeySecret, &out.AccessKeySecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKeySecret != nil {
+		in, out := &in.SecretKeySecret, &out.SecretKeySecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SessionTokenSecret != nil {
+		in, out := &in.SessionTokenSecret, &out.SessionTokenSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSigV4Auth.
+func (in *AWSSigV4Auth) DeepCopy() *AWSSigV4Auth {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSigV4Auth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Amount) DeepCopyInto(out *Amount) {
 	*out = *in
@@ -141,6 +172,13 @@ func (in *Artifact) DeepCopyInto(out *Artifact) {
 		*out = new(ArtifactGC)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Aggregated != nil {
+		in, out := &in.Aggregated, &out.Aggregated
+		*out = make([]ArtifactLocation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -744,6 +782,24 @@ func (in *Cache) DeepCopy() *Cache {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChildWorkflowTemplate) DeepCopyInto(out *ChildWorkflowTemplate) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChildWorkflowTemplate.
+func (in *ChildWorkflowTemplate) DeepCopy() *ChildWorkflowTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ChildWorkflowTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientCertAuth) DeepCopyInto(out *ClientCertAuth) {
 	*out = *in
@@ -1155,6 +1211,10 @@ func (in *CronWorkflowStatus) DeepCopyInto(out *CronWorkflowStatus) {
 		*out = make(Conditions, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastSuccessfulTime != nil {
+		in, out := &in.LastSuccessfulTime, &out.LastSuccessfulTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -1168,6 +1228,77 @@ func (in *CronWorkflowStatus) DeepCopy() *CronWorkflowStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DAGHook) DeepCopyInto(out *DAGHook) {
+	*out = *in
+	in.Arguments.DeepCopyInto(&out.Arguments)
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(TemplateRef)
+		**out = **in
+	}
+	if in.Phases != nil {
+		in, out := &in.Phases, &out.Phases
+		*out = make([]NodePhase, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DAGHook.
+func (in *DAGHook) DeepCopy() *DAGHook {
+	if in == nil {
+		return nil
+	}
+	out := new(DAGHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DAGOneOf) DeepCopyInto(out *DAGOneOf) {
+	*out = *in
+	if in.Branches != nil {
+		in, out := &in.Branches, &out.Branches
+		*out = make([]DAGOneOfBranch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DAGOneOf.
+func (in *DAGOneOf) DeepCopy() *DAGOneOf {
+	if in == nil {
+		return nil
+	}
+	out := new(DAGOneOf)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DAGOneOfBranch) DeepCopyInto(out *DAGOneOfBranch) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DAGOneOfBranch.
+func (in *DAGOneOfBranch) DeepCopy() *DAGOneOfBranch {
+	if in == nil {
+		return nil
+	}
+	out := new(DAGOneOfBranch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DAGTask) DeepCopyInto(out *DAGTask) {
 	*out = *in
@@ -1239,6 +1370,20 @@ func (in *DAGTemplate) DeepCopyInto(out *DAGTemplate) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make([]DAGHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OneOf != nil {
+		in, out := &in.OneOf, &out.OneOf
+		*out = make([]DAGOneOf, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1298,6 +1443,16 @@ func (in *DataSource) DeepCopy() *DataSource {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Event) DeepCopyInto(out *Event) {
 	*out = *in
+	if in.Idempotency != nil {
+		in, out := &in.Idempotency, &out.Idempotency
+		*out = new(EventIdempotency)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSchema)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1311,9 +1466,66 @@ func (in *Event) DeepCopy() *Event {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventIdempotency) DeepCopyInto(out *EventIdempotency) {
+	*out = *in
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventIdempotency.
+func (in *EventIdempotency) DeepCopy() *EventIdempotency {
+	if in == nil {
+		return nil
+	}
+	out := new(EventIdempotency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSchema) DeepCopyInto(out *EventSchema) {
+	*out = *in
+	if in.Inline != nil {
+		in, out := &in.Inline, &out.Inline
+		*out = new(Object)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSchema.
+func (in *EventSchema) DeepCopy() *EventSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecutorConfig) DeepCopyInto(out *ExecutorConfig) {
 	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1382,6 +1594,22 @@ func (in *GCSBucket) DeepCopy() *GCSBucket {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GPURequest) DeepCopyInto(out *GPURequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPURequest.
+func (in *GPURequest) DeepCopy() *GPURequest {
+	if in == nil {
+		return nil
+	}
+	out := new(GPURequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Gauge) DeepCopyInto(out *Gauge) {
 	*out = *in
@@ -1390,6 +1618,11 @@ func (in *Gauge) DeepCopyInto(out *Gauge) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -1551,6 +1784,11 @@ func (in *HTTP) DeepCopyInto(out *HTTP) {
 		*out = new(HTTPBodySource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(HTTPTemplateAuth)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1694,6 +1932,32 @@ func (in HTTPHeaders) DeepCopy() HTTPHeaders {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPTemplateAuth) DeepCopyInto(out *HTTPTemplateAuth) {
+	*out = *in
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(OAuth2Auth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWSSigV4 != nil {
+		in, out := &in.AWSSigV4, &out.AWSSigV4
+		*out = new(AWSSigV4Auth)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPTemplateAuth.
+func (in *HTTPTemplateAuth) DeepCopy() *HTTPTemplateAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPTemplateAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Header) DeepCopyInto(out *Header) {
 	*out = *in
@@ -1771,6 +2035,24 @@ func (in *Item) DeepCopy() *Item {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobTemplate) DeepCopyInto(out *JobTemplate) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobTemplate.
+func (in *JobTemplate) DeepCopy() *JobTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(JobTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LabelKeys) DeepCopyInto(out *LabelKeys) {
 	*out = *in
@@ -2102,6 +2384,20 @@ func (in *NodeResult) DeepCopyInto(out *NodeResult) {
 		*out = new(Outputs)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PeakResourceUsage != nil {
+		in, out := &in.PeakResourceUsage, &out.PeakResourceUsage
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.IntermediateParameters != nil {
+		in, out := &in.IntermediateParameters, &out.IntermediateParameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -2125,6 +2421,25 @@ func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
 	}
 	in.StartedAt.DeepCopyInto(&out.StartedAt)
 	in.FinishedAt.DeepCopyInto(&out.FinishedAt)
+	if in.PeakResourceUsage != nil {
+		in, out := &in.PeakResourceUsage, &out.PeakResourceUsage
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.IntermediateParameters != nil {
+		in, out := &in.IntermediateParameters, &out.IntermediateParameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ArtifactRepositoryRef != nil {
+		in, out := &in.ArtifactRepositoryRef, &out.ArtifactRepositoryRef
+		*out = new(ArtifactRepositoryRefStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ResourcesDuration != nil {
 		in, out := &in.ResourcesDuration, &out.ResourcesDuration
 		*out = make(ResourcesDuration, len(*in))
@@ -2132,6 +2447,18 @@ func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.EstimatedCost != nil {
+		in, out := &in.EstimatedCost, &out.EstimatedCost
+		*out = new(Amount)
+		**out = **in
+	}
+	if in.ImageDigests != nil {
+		in, out := &in.ImageDigests, &out.ImageDigests
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.Daemoned != nil {
 		in, out := &in.Daemoned, &out.Daemoned
 		*out = new(bool)
@@ -2244,6 +2571,27 @@ func (in *NoneStrategy) DeepCopy() *NoneStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Notification) DeepCopyInto(out *Notification) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]WorkflowPhase, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Notification.
+func (in *Notification) DeepCopy() *Notification {
+	if in == nil {
+		return nil
+	}
+	out := new(Notification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OAuth2Auth) DeepCopyInto(out *OAuth2Auth) {
 	*out = *in
@@ -2494,6 +2842,11 @@ func (in *Parameter) DeepCopyInto(out *Parameter) {
 		*out = new(AnyString)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(ParameterSchema)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2507,6 +2860,32 @@ func (in *Parameter) DeepCopy() *Parameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParameterSchema) DeepCopyInto(out *ParameterSchema) {
+	*out = *in
+	if in.Minimum != nil {
+		in, out := &in.Minimum, &out.Minimum
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Maximum != nil {
+		in, out := &in.Maximum, &out.Maximum
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterSchema.
+func (in *ParameterSchema) DeepCopy() *ParameterSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(ParameterSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Plugin) DeepCopyInto(out *Plugin) {
 	*out = *in
@@ -2603,6 +2982,36 @@ func (in *RawArtifact) DeepCopy() *RawArtifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRecommendation) DeepCopyInto(out *ResourceRecommendation) {
+	*out = *in
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecommendation.
+func (in *ResourceRecommendation) DeepCopy() *ResourceRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceTemplate) DeepCopyInto(out *ResourceTemplate) {
 	*out = *in
@@ -2825,6 +3234,23 @@ func (in *S3EncryptionOptions) DeepCopy() *S3EncryptionOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScratchVolume) DeepCopyInto(out *ScratchVolume) {
+	*out = *in
+	out.StorageSize = in.StorageSize.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScratchVolume.
+func (in *ScratchVolume) DeepCopy() *ScratchVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(ScratchVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScriptTemplate) DeepCopyInto(out *ScriptTemplate) {
 	*out = *in
@@ -2950,6 +3376,22 @@ func (in *Sequence) DeepCopy() *Sequence {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepThrough) DeepCopyInto(out *StepThrough) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepThrough.
+func (in *StepThrough) DeepCopy() *StepThrough {
+	if in == nil {
+		return nil
+	}
+	out := new(StepThrough)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StopStrategy) DeepCopyInto(out *StopStrategy) {
 	*out = *in
@@ -3197,6 +3639,11 @@ func (in *TarStrategy) DeepCopy() *TarStrategy {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Template) DeepCopyInto(out *Template) {
 	*out = *in
+	if in.Extends != nil {
+		in, out := &in.Extends, &out.Extends
+		*out = new(TemplateExtends)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Inputs.DeepCopyInto(&out.Inputs)
 	in.Outputs.DeepCopyInto(&out.Outputs)
 	if in.NodeSelector != nil {
@@ -3249,6 +3696,11 @@ func (in *Template) DeepCopyInto(out *Template) {
 		*out = new(DAGTemplate)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Finally != nil {
+		in, out := &in.Finally, &out.Finally
+		*out = new(LifecycleHook)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Suspend != nil {
 		in, out := &in.Suspend, &out.Suspend
 		*out = new(SuspendTemplate)
@@ -3269,6 +3721,16 @@ func (in *Template) DeepCopyInto(out *Template) {
 		*out = new(Plugin)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ChildWorkflow != nil {
+		in, out := &in.ChildWorkflow, &out.ChildWorkflow
+		*out = new(ChildWorkflowTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(JobTemplate)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
 		*out = make([]v1.Volume, len(*in))
@@ -3276,6 +3738,16 @@ func (in *Template) DeepCopyInto(out *Template) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Scratch != nil {
+		in, out := &in.Scratch, &out.Scratch
+		*out = new(ScratchVolume)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GPU != nil {
+		in, out := &in.GPU, &out.GPU
+		*out = new(GPURequest)
+		**out = **in
+	}
 	if in.InitContainers != nil {
 		in, out := &in.InitContainers, &out.InitContainers
 		*out = make([]UserContainer, len(*in))
@@ -3295,6 +3767,11 @@ func (in *Template) DeepCopyInto(out *Template) {
 		*out = new(ArtifactLocation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ArtifactRepositoryRef != nil {
+		in, out := &in.ArtifactRepositoryRef, &out.ArtifactRepositoryRef
+		*out = new(ArtifactRepositoryRef)
+		**out = **in
+	}
 	if in.ActiveDeadlineSeconds != nil {
 		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
 		*out = new(intstr.IntOrString)
@@ -3322,6 +3799,16 @@ func (in *Template) DeepCopyInto(out *Template) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SchedulingGates != nil {
+		in, out := &in.SchedulingGates, &out.SchedulingGates
+		*out = make([]v1.PodSchedulingGate, len(*in))
+		copy(*out, *in)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(policyv1.PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AutomountServiceAccountToken != nil {
 		in, out := &in.AutomountServiceAccountToken, &out.AutomountServiceAccountToken
 		*out = new(bool)
@@ -3330,7 +3817,7 @@ func (in *Template) DeepCopyInto(out *Template) {
 	if in.Executor != nil {
 		in, out := &in.Executor, &out.Executor
 		*out = new(ExecutorConfig)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.HostAliases != nil {
 		in, out := &in.HostAliases, &out.HostAliases
@@ -3359,6 +3846,11 @@ func (in *Template) DeepCopyInto(out *Template) {
 		*out = new(Memoize)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ProgressWeight != nil {
+		in, out := &in.ProgressWeight, &out.ProgressWeight
+		*out = new(int64)
+		**out = **in
+	}
 	if in.Annotations != nil {
 		in, out := &in.Annotations, &out.Annotations
 		*out = make(map[string]string, len(*in))
@@ -3379,6 +3871,43 @@ func (in *Template) DeepCopy() *Template {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateExtends) DeepCopyInto(out *TemplateExtends) {
+	*out = *in
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(TemplateRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateExtends.
+func (in *TemplateExtends) DeepCopy() *TemplateExtends {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateExtends)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateImport) DeepCopyInto(out *TemplateImport) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateImport.
+func (in *TemplateImport) DeepCopy() *TemplateImport {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TemplateRef) DeepCopyInto(out *TemplateRef) {
 	*out = *in
@@ -3466,6 +3995,16 @@ func (in *ValueFrom) DeepCopyInto(out *ValueFrom) {
 		*out = new(v1.ConfigMapKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(Plugin)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Default != nil {
 		in, out := &in.Default, &out.Default
 		*out = new(AnyString)
@@ -3605,6 +4144,92 @@ func (in *WorkflowArtifactGCTaskList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowArtifactRepository) DeepCopyInto(out *WorkflowArtifactRepository) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowArtifactRepository.
+func (in *WorkflowArtifactRepository) DeepCopy() *WorkflowArtifactRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowArtifactRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkflowArtifactRepository) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowArtifactRepositoryList) DeepCopyInto(out *WorkflowArtifactRepositoryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkflowArtifactRepository, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowArtifactRepositoryList.
+func (in *WorkflowArtifactRepositoryList) DeepCopy() *WorkflowArtifactRepositoryList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowArtifactRepositoryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkflowArtifactRepositoryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowArtifactRepositoryStatus) DeepCopyInto(out *WorkflowArtifactRepositoryStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastProbedAt != nil {
+		in, out := &in.LastProbedAt, &out.LastProbedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowArtifactRepositoryStatus.
+func (in *WorkflowArtifactRepositoryStatus) DeepCopy() *WorkflowArtifactRepositoryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowArtifactRepositoryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowEventBinding) DeepCopyInto(out *WorkflowEventBinding) {
 	*out = *in
@@ -3668,7 +4293,7 @@ func (in *WorkflowEventBindingList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowEventBindingSpec) DeepCopyInto(out *WorkflowEventBindingSpec) {
 	*out = *in
-	out.Event = in.Event
+	in.Event.DeepCopyInto(&out.Event)
 	if in.Submit != nil {
 		in, out := &in.Submit, &out.Submit
 		*out = new(Submit)
@@ -3793,7 +4418,7 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 	if in.Executor != nil {
 		in, out := &in.Executor, &out.Executor
 		*out = new(ExecutorConfig)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
@@ -3843,6 +4468,11 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SchedulingGates != nil {
+		in, out := &in.SchedulingGates, &out.SchedulingGates
+		*out = make([]v1.PodSchedulingGate, len(*in))
+		copy(*out, *in)
+	}
 	if in.ImagePullSecrets != nil {
 		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
 		*out = make([]v1.LocalObjectReference, len(*in))
@@ -3957,6 +4587,23 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 		*out = new(WorkflowLevelArtifactGC)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Imports != nil {
+		in, out := &in.Imports, &out.Imports
+		*out = make([]TemplateImport, len(*in))
+		copy(*out, *in)
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]Notification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StepThrough != nil {
+		in, out := &in.StepThrough, &out.StepThrough
+		*out = new(StepThrough)
+		**out = **in
+	}
 	return
 }
 
@@ -3989,6 +4636,13 @@ func (in *WorkflowStatus) DeepCopyInto(out *WorkflowStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.TemplateDigests != nil {
+		in, out := &in.TemplateDigests, &out.TemplateDigests
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.PersistentVolumeClaims != nil {
 		in, out := &in.PersistentVolumeClaims, &out.PersistentVolumeClaims
 		*out = make([]v1.Volume, len(*in))
@@ -4013,6 +4667,25 @@ func (in *WorkflowStatus) DeepCopyInto(out *WorkflowStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.EstimatedCost != nil {
+		in, out := &in.EstimatedCost, &out.EstimatedCost
+		*out = new(Amount)
+		**out = **in
+	}
+	if in.ImageDigests != nil {
+		in, out := &in.ImageDigests, &out.ImageDigests
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ResourceRecommendations != nil {
+		in, out := &in.ResourceRecommendations, &out.ResourceRecommendations
+		*out = make(map[string]ResourceRecommendation, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.StoredWorkflowSpec != nil {
 		in, out := &in.StoredWorkflowSpec, &out.StoredWorkflowSpec
 		*out = new(WorkflowSpec)
@@ -4033,6 +4706,11 @@ func (in *WorkflowStatus) DeepCopyInto(out *WorkflowStatus) {
 		*out = new(ArtGCStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ArtifactManifest != nil {
+		in, out := &in.ArtifactManifest, &out.ArtifactManifest
+		*out = new(Artifact)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TaskResultsCompletionStatus != nil {
 		in, out := &in.TaskResultsCompletionStatus, &out.TaskResultsCompletionStatus
 		*out = make(map[string]bool, len(*in))
@@ -4347,6 +5025,47 @@ func (in *WorkflowTemplateRef) DeepCopy() *WorkflowTemplateRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTemplateRevision) DeepCopyInto(out *WorkflowTemplateRevision) {
+	*out = *in
+	in.CreationTimestamp.DeepCopyInto(&out.CreationTimestamp)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTemplateRevision.
+func (in *WorkflowTemplateRevision) DeepCopy() *WorkflowTemplateRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTemplateRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTemplateRevisionList) DeepCopyInto(out *WorkflowTemplateRevisionList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkflowTemplateRevision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTemplateRevisionList.
+func (in *WorkflowTemplateRevisionList) DeepCopy() *WorkflowTemplateRevisionList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTemplateRevisionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in WorkflowTemplates) DeepCopyInto(out *WorkflowTemplates) {
 	{
@@ -0,0 +1,126 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeWorkflowArtifactRepositories implements WorkflowArtifactRepositoryInterface
+type FakeWorkflowArtifactRepositories struct {
+	Fake *FakeArgoprojV1alpha1
+	ns   string
+}
+
+var workflowartifactrepositoriesResource = schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "workflowartifactrepositories"}
+
+var workflowartifactrepositoriesKind = schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "WorkflowArtifactRepository"}
+
+// Get takes name of the workflowArtifactRepository, and returns the corresponding workflowArtifactRepository object, and an error if there is any.
+func (c *FakeWorkflowArtifactRepositories) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.WorkflowArtifactRepository, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(workflowartifactrepositoriesResource, c.ns, name), &v1alpha1.WorkflowArtifactRepository{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WorkflowArtifactRepository), err
+}
+
+// List takes label and field selectors, and returns the list of WorkflowArtifactRepositories that match those selectors.
+func (c *FakeWorkflowArtifactRepositories) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.WorkflowArtifactRepositoryList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(workflowartifactrepositoriesResource, workflowartifactrepositoriesKind, c.ns, opts), &v1alpha1.WorkflowArtifactRepositoryList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.WorkflowArtifactRepositoryList{ListMeta: obj.(*v1alpha1.WorkflowArtifactRepositoryList).ListMeta}
+	for _, item := range obj.(*v1alpha1.WorkflowArtifactRepositoryList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested workflowArtifactRepositories.
+func (c *FakeWorkflowArtifactRepositories) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(workflowartifactrepositoriesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a workflowArtifactRepository and creates it.  Returns the server's representation of the workflowArtifactRepository, and an error, if there is any.
+func (c *FakeWorkflowArtifactRepositories) Create(ctx context.Context, workflowArtifactRepository *v1alpha1.WorkflowArtifactRepository, opts v1.CreateOptions) (result *v1alpha1.WorkflowArtifactRepository, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(workflowartifactrepositoriesResource, c.ns, workflowArtifactRepository), &v1alpha1.WorkflowArtifactRepository{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WorkflowArtifactRepository), err
+}
+
+// Update takes the representation of a workflowArtifactRepository and updates it. Returns the server's representation of the workflowArtifactRepository, and an error, if there is any.
+func (c *FakeWorkflowArtifactRepositories) Update(ctx context.Context, workflowArtifactRepository *v1alpha1.WorkflowArtifactRepository, opts v1.UpdateOptions) (result *v1alpha1.WorkflowArtifactRepository, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(workflowartifactrepositoriesResource, c.ns, workflowArtifactRepository), &v1alpha1.WorkflowArtifactRepository{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WorkflowArtifactRepository), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeWorkflowArtifactRepositories) UpdateStatus(ctx context.Context, workflowArtifactRepository *v1alpha1.WorkflowArtifactRepository, opts v1.UpdateOptions) (*v1alpha1.WorkflowArtifactRepository, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(workflowartifactrepositoriesResource, "status", c.ns, workflowArtifactRepository), &v1alpha1.WorkflowArtifactRepository{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WorkflowArtifactRepository), err
+}
+
+// Delete takes name of the workflowArtifactRepository and deletes it. Returns an error if one occurs.
+func (c *FakeWorkflowArtifactRepositories) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(workflowartifactrepositoriesResource, c.ns, name), &v1alpha1.WorkflowArtifactRepository{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeWorkflowArtifactRepositories) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(workflowartifactrepositoriesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.WorkflowArtifactRepositoryList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched workflowArtifactRepository.
+func (c *FakeWorkflowArtifactRepositories) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.WorkflowArtifactRepository, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(workflowartifactrepositoriesResource, c.ns, name, pt, data, subresources...), &v1alpha1.WorkflowArtifactRepository{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WorkflowArtifactRepository), err
+}
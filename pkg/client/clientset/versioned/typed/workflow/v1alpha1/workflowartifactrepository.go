@@ -0,0 +1,179 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	scheme "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// WorkflowArtifactRepositoriesGetter has a method to return a WorkflowArtifactRepositoryInterface.
+// A group's client should implement this interface.
+type WorkflowArtifactRepositoriesGetter interface {
+	WorkflowArtifactRepositories(namespace string) WorkflowArtifactRepositoryInterface
+}
+
+// WorkflowArtifactRepositoryInterface has methods to work with WorkflowArtifactRepository resources.
+type WorkflowArtifactRepositoryInterface interface {
+	Create(ctx context.Context, workflowArtifactRepository *v1alpha1.WorkflowArtifactRepository, opts v1.CreateOptions) (*v1alpha1.WorkflowArtifactRepository, error)
+	Update(ctx context.Context, workflowArtifactRepository *v1alpha1.WorkflowArtifactRepository, opts v1.UpdateOptions) (*v1alpha1.WorkflowArtifactRepository, error)
+	UpdateStatus(ctx context.Context, workflowArtifactRepository *v1alpha1.WorkflowArtifactRepository, opts v1.UpdateOptions) (*v1alpha1.WorkflowArtifactRepository, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.WorkflowArtifactRepository, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.WorkflowArtifactRepositoryList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.WorkflowArtifactRepository, err error)
+	WorkflowArtifactRepositoryExpansion
+}
+
+// workflowArtifactRepositories implements WorkflowArtifactRepositoryInterface
+type workflowArtifactRepositories struct {
+	client rest.Interface
+	ns     string
+}
+
+// newWorkflowArtifactRepositories returns a WorkflowArtifactRepositories
+func newWorkflowArtifactRepositories(c *ArgoprojV1alpha1Client, namespace string) *workflowArtifactRepositories {
+	return &workflowArtifactRepositories{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the workflowArtifactRepository, and returns the corresponding workflowArtifactRepository object, and an error if there is any.
+func (c *workflowArtifactRepositories) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.WorkflowArtifactRepository, err error) {
+	result = &v1alpha1.WorkflowArtifactRepository{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("workflowartifactrepositories").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of WorkflowArtifactRepositories that match those selectors.
+func (c *workflowArtifactRepositories) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.WorkflowArtifactRepositoryList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.WorkflowArtifactRepositoryList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("workflowartifactrepositories").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested workflowArtifactRepositories.
+func (c *workflowArtifactRepositories) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("workflowartifactrepositories").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a workflowArtifactRepository and creates it.  Returns the server's representation of the workflowArtifactRepository, and an error, if there is any.
+func (c *workflowArtifactRepositories) Create(ctx context.Context, workflowArtifactRepository *v1alpha1.WorkflowArtifactRepository, opts v1.CreateOptions) (result *v1alpha1.WorkflowArtifactRepository, err error) {
+	result = &v1alpha1.WorkflowArtifactRepository{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("workflowartifactrepositories").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(workflowArtifactRepository).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a workflowArtifactRepository and updates it. Returns the server's representation of the workflowArtifactRepository, and an error, if there is any.
+func (c *workflowArtifactRepositories) Update(ctx context.Context, workflowArtifactRepository *v1alpha1.WorkflowArtifactRepository, opts v1.UpdateOptions) (result *v1alpha1.WorkflowArtifactRepository, err error) {
+	result = &v1alpha1.WorkflowArtifactRepository{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("workflowartifactrepositories").
+		Name(workflowArtifactRepository.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(workflowArtifactRepository).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *workflowArtifactRepositories) UpdateStatus(ctx context.Context, workflowArtifactRepository *v1alpha1.WorkflowArtifactRepository, opts v1.UpdateOptions) (result *v1alpha1.WorkflowArtifactRepository, err error) {
+	result = &v1alpha1.WorkflowArtifactRepository{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("workflowartifactrepositories").
+		Name(workflowArtifactRepository.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(workflowArtifactRepository).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the workflowArtifactRepository and deletes it. Returns an error if one occurs.
+func (c *workflowArtifactRepositories) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("workflowartifactrepositories").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *workflowArtifactRepositories) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("workflowartifactrepositories").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched workflowArtifactRepository.
+func (c *workflowArtifactRepositories) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.WorkflowArtifactRepository, err error) {
+	result = &v1alpha1.WorkflowArtifactRepository{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("workflowartifactrepositories").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
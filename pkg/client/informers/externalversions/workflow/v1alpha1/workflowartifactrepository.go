@@ -0,0 +1,74 @@
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	time "time"
+
+	workflowv1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	versioned "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
+	internalinterfaces "github.com/argoproj/argo-workflows/v3/pkg/client/informers/externalversions/internalinterfaces"
+	v1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/client/listers/workflow/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// WorkflowArtifactRepositoryInformer provides access to a shared informer and lister for
+// WorkflowArtifactRepositories.
+type WorkflowArtifactRepositoryInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.WorkflowArtifactRepositoryLister
+}
+
+type workflowArtifactRepositoryInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewWorkflowArtifactRepositoryInformer constructs a new informer for WorkflowArtifactRepository type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewWorkflowArtifactRepositoryInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredWorkflowArtifactRepositoryInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredWorkflowArtifactRepositoryInformer constructs a new informer for WorkflowArtifactRepository type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredWorkflowArtifactRepositoryInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ArgoprojV1alpha1().WorkflowArtifactRepositories(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ArgoprojV1alpha1().WorkflowArtifactRepositories(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&workflowv1alpha1.WorkflowArtifactRepository{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *workflowArtifactRepositoryInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredWorkflowArtifactRepositoryInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *workflowArtifactRepositoryInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&workflowv1alpha1.WorkflowArtifactRepository{}, f.defaultInformer)
+}
+
+func (f *workflowArtifactRepositoryInformer) Lister() v1alpha1.WorkflowArtifactRepositoryLister {
+	return v1alpha1.NewWorkflowArtifactRepositoryLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// WorkflowArtifactRepositoryLister helps list WorkflowArtifactRepositories.
+// All objects returned here must be treated as read-only.
+type WorkflowArtifactRepositoryLister interface {
+	// List lists all WorkflowArtifactRepositories in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.WorkflowArtifactRepository, err error)
+	// WorkflowArtifactRepositories returns an object that can list and get WorkflowArtifactRepositories.
+	WorkflowArtifactRepositories(namespace string) WorkflowArtifactRepositoryNamespaceLister
+	WorkflowArtifactRepositoryListerExpansion
+}
+
+// workflowArtifactRepositoryLister implements the WorkflowArtifactRepositoryLister interface.
+type workflowArtifactRepositoryLister struct {
+	indexer cache.Indexer
+}
+
+// NewWorkflowArtifactRepositoryLister returns a new WorkflowArtifactRepositoryLister.
+func NewWorkflowArtifactRepositoryLister(indexer cache.Indexer) WorkflowArtifactRepositoryLister {
+	return &workflowArtifactRepositoryLister{indexer: indexer}
+}
+
+// List lists all WorkflowArtifactRepositories in the indexer.
+func (s *workflowArtifactRepositoryLister) List(selector labels.Selector) (ret []*v1alpha1.WorkflowArtifactRepository, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.WorkflowArtifactRepository))
+	})
+	return ret, err
+}
+
+// WorkflowArtifactRepositories returns an object that can list and get WorkflowArtifactRepositories.
+func (s *workflowArtifactRepositoryLister) WorkflowArtifactRepositories(namespace string) WorkflowArtifactRepositoryNamespaceLister {
+	return workflowArtifactRepositoryNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// WorkflowArtifactRepositoryNamespaceLister helps list and get WorkflowArtifactRepositories.
+// All objects returned here must be treated as read-only.
+type WorkflowArtifactRepositoryNamespaceLister interface {
+	// List lists all WorkflowArtifactRepositories in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.WorkflowArtifactRepository, err error)
+	// Get retrieves the WorkflowArtifactRepository from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.WorkflowArtifactRepository, error)
+	WorkflowArtifactRepositoryNamespaceListerExpansion
+}
+
+// workflowArtifactRepositoryNamespaceLister implements the WorkflowArtifactRepositoryNamespaceLister
+// interface.
+type workflowArtifactRepositoryNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all WorkflowArtifactRepositories in the indexer for a given namespace.
+func (s workflowArtifactRepositoryNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.WorkflowArtifactRepository, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.WorkflowArtifactRepository))
+	})
+	return ret, err
+}
+
+// Get retrieves the WorkflowArtifactRepository from the indexer for a given namespace and name.
+func (s workflowArtifactRepositoryNamespaceLister) Get(name string) (*v1alpha1.WorkflowArtifactRepository, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("workflowartifactrepository"), name)
+	}
+	return obj.(*v1alpha1.WorkflowArtifactRepository), nil
+}
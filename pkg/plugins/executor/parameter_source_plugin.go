@@ -0,0 +1,93 @@
+package executor
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// swagger:parameters resolveParameter
+type ResolveParameterRequest struct {
+	// in: body
+	// Required: true
+	Body ResolveParameterArgs
+}
+
+type ResolveParameterArgs struct {
+	// Required: true
+	Workflow *Workflow `json:"workflow"`
+	// The parameter to resolve, its valueFrom.plugin is the configuration of the plugin
+	// Required: true
+	Parameter *wfv1.Parameter `json:"parameter"`
+}
+
+// swagger:response resolveParameter
+type ResolveParameterResponse struct {
+	// in: body
+	Body ResolveParameterReply
+}
+
+type ResolveParameterReply struct {
+	// Value of the parameter, or empty if the plugin is not the source of the parameter
+	Value *string `json:"value,omitempty"`
+	// Lease of the value, for values that expire, such as dynamic credentials
+	Lease *Lease `json:"lease,omitempty"`
+}
+
+// Lease is the lease of a value got from a parameter source, e.g. a Vault lease
+type Lease struct {
+	// Required: true
+	ID string `json:"id"`
+	// Duration the value is valid for
+	// Required: true
+	Duration metav1.Duration `json:"duration"`
+	// Renewable is whether the lease can be renewed before it expires
+	Renewable bool `json:"renewable,omitempty"`
+}
+
+// GetDuration returns the duration of the lease, or zero if there is no lease
+func (l *Lease) GetDuration() time.Duration {
+	if l != nil {
+		return l.Duration.Duration
+	}
+	return 0
+}
+
+// swagger:parameters renewLease
+type RenewLeaseRequest struct {
+	// in: body
+	// Required: true
+	Body RenewLeaseArgs
+}
+
+type RenewLeaseArgs struct {
+	// Required: true
+	Workflow *Workflow `json:"workflow"`
+	// Required: true
+	Lease *Lease `json:"lease"`
+}
+
+// swagger:response renewLease
+type RenewLeaseResponse struct {
+	// in: body
+	Body RenewLeaseReply
+}
+
+type RenewLeaseReply struct {
+	// Lease renewed, or empty if the plugin did not grant the lease
+	Lease *Lease `json:"lease,omitempty"`
+}
+
+type ParameterSource interface {
+	// swagger:route POST /parameter.resolve resolveParameter
+	//     Responses:
+	//       200: resolveParameter
+	ResolveParameter(ctx context.Context, args ResolveParameterArgs, reply *ResolveParameterReply) error
+	// swagger:route POST /lease.renew renewLease
+	//     Responses:
+	//       200: renewLease
+	RenewLease(ctx context.Context, args RenewLeaseArgs, reply *RenewLeaseReply) error
+}
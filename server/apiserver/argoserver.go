@@ -3,6 +3,7 @@ package apiserver
 import (
 	"context"
 	"crypto/tls"
+	"database/sql"
 	"fmt"
 	"net"
 	"net/http"
@@ -15,6 +16,7 @@ import (
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/upper/db/v4"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
@@ -32,7 +34,9 @@ import (
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	eventpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/event"
 	eventsourcepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/eventsource"
+	executorpluginpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/executorplugin"
 	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
 	sensorpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/sensor"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
@@ -47,8 +51,12 @@ import (
 	"github.com/argoproj/argo-workflows/v3/server/clusterworkflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/server/cronworkflow"
 	"github.com/argoproj/argo-workflows/v3/server/event"
+	"github.com/argoproj/argo-workflows/v3/server/event/cloudevents"
+	"github.com/argoproj/argo-workflows/v3/server/event/kafka"
 	"github.com/argoproj/argo-workflows/v3/server/eventsource"
+	"github.com/argoproj/argo-workflows/v3/server/executorplugin"
 	"github.com/argoproj/argo-workflows/v3/server/info"
+	"github.com/argoproj/argo-workflows/v3/server/memoizationcache"
 	"github.com/argoproj/argo-workflows/v3/server/sensor"
 	"github.com/argoproj/argo-workflows/v3/server/static"
 	"github.com/argoproj/argo-workflows/v3/server/types"
@@ -57,12 +65,15 @@ import (
 	"github.com/argoproj/argo-workflows/v3/server/workflowarchive"
 	"github.com/argoproj/argo-workflows/v3/server/workflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/ui"
+	"github.com/argoproj/argo-workflows/v3/util"
 	grpcutil "github.com/argoproj/argo-workflows/v3/util/grpc"
+	envutil "github.com/argoproj/argo-workflows/v3/util/env"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/util/json"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	rbacutil "github.com/argoproj/argo-workflows/v3/util/rbac"
 	"github.com/argoproj/argo-workflows/v3/util/sqldb"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
 	"github.com/argoproj/argo-workflows/v3/workflow/events"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
@@ -89,6 +100,7 @@ type argoServer struct {
 	eventQueueSize           int
 	eventWorkerCount         int
 	eventAsyncDispatch       bool
+	eventQuota               event.Quota
 	xframeOptions            string
 	accessControlAllowOrigin string
 	apiRateLimiter           limiter.Store
@@ -113,6 +125,7 @@ type ArgoServerOpts struct {
 	EventOperationQueueSize  int
 	EventWorkerCount         int
 	EventAsyncDispatch       bool
+	EventQuota               event.Quota
 	XFrameOptions            string
 	AccessControlAllowOrigin string
 	APIRateLimit             uint64
@@ -183,6 +196,7 @@ func NewArgoServer(ctx context.Context, opts ArgoServerOpts) (*argoServer, error
 		eventQueueSize:           opts.EventOperationQueueSize,
 		eventWorkerCount:         opts.EventWorkerCount,
 		eventAsyncDispatch:       opts.EventAsyncDispatch,
+		eventQuota:               opts.EventQuota,
 		xframeOptions:            opts.XFrameOptions,
 		accessControlAllowOrigin: opts.AccessControlAllowOrigin,
 		apiRateLimiter:           store,
@@ -192,6 +206,25 @@ func NewArgoServer(ctx context.Context, opts ArgoServerOpts) (*argoServer, error
 	}, nil
 }
 
+// newMetrics creates the Argo Server's metrics, which are served on the /metrics endpoint.
+// The session is nil if persistence is not configured.
+func newMetrics(ctx context.Context, session db.Session) (*telemetry.Metrics, error) {
+	m, err := telemetry.NewMetrics(ctx, "argo-server", "argo_server", &telemetry.Config{Enabled: true})
+	if err != nil {
+		return nil, err
+	}
+	err = m.Populate(ctx,
+		telemetry.AddDBMetrics(func() *sql.DBStats {
+			return sqldb.Stats(session)
+		}),
+		telemetry.AddEventMetrics,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var backoff = wait.Backoff{
 	Steps:    5,
 	Duration: 500 * time.Millisecond,
@@ -213,12 +246,20 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 	instanceIDService := instanceid.NewService(config.InstanceID)
 	offloadRepo := persist.ExplosiveOffloadNodeStatusRepo
 	wfArchive := persist.NullWorkflowArchive
+	eventArchive := persist.NullEventArchive
 	persistence := config.Persistence
+	var session db.Session
 	if persistence != nil {
-		session, err := sqldb.CreateDBSession(ctx, as.clients.Kubernetes, as.namespace, persistence.DBConfig)
+		session, err = sqldb.CreateDBSession(ctx, as.clients.Kubernetes, as.namespace, persistence.DBConfig)
 		if err != nil {
 			log.WithFatal().Error(ctx, err.Error())
 		}
+	}
+	metrics, err := newMetrics(ctx, session)
+	if err != nil {
+		log.WithFatal().Error(ctx, err.Error())
+	}
+	if persistence != nil {
 		tableName, err := persist.GetTableName(persistence)
 		if err != nil {
 			log.WithFatal().Error(ctx, err.Error())
@@ -229,9 +270,15 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 		if err != nil {
 			log.WithError(err).WithFatal().Error(ctx, err.Error())
 		}
+		offloadRepo = persist.NewInstrumentedOffloadNodeStatusRepo(offloadRepo, metrics.DBQueryCompleted)
 		// we always enable the archive for the Argo Server, as the Argo Server does not write records, so you can
 		// disable the archiving - and still read old records
 		wfArchive = persist.NewWorkflowArchive(session, persistence.GetClusterName(), as.managedNamespace, instanceIDService)
+		wfArchive = persist.NewInstrumentedWorkflowArchive(wfArchive, metrics.DBQueryCompleted)
+		if persistence.EventArchive {
+			eventArchive = persist.NewEventArchive(session, persistence.GetClusterName(), instanceIDService)
+			go as.archivedEventGarbageCollector(ctx, eventArchive, time.Duration(persistence.EventArchiveTTL))
+		}
 	}
 	resourceCacheNamespace := getResourceCacheNamespace(as.managedNamespace)
 	wftmplStore, err := workflowtemplate.NewInformer(as.restConfig, resourceCacheNamespace)
@@ -249,15 +296,23 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 		cwftmplInformer = clusterworkflowtemplate.NewNullClusterWorkflowTemplate()
 	}
 	eventRecorderManager := events.NewEventRecorderManager(as.clients.Kubernetes)
-	artifactRepositories := artifactrepositories.New(as.clients.Kubernetes, as.managedNamespace, &config.ArtifactRepository)
-	artifactServer := artifacts.NewArtifactServer(as.gatekeeper, hydrator.New(offloadRepo), wfArchive, instanceIDService, artifactRepositories, log)
-	eventServer := event.NewController(ctx, instanceIDService, eventRecorderManager, as.eventQueueSize, as.eventWorkerCount, as.eventAsyncDispatch)
+	artifactRepositories := artifactrepositories.New(as.clients.Kubernetes, as.clients.Workflow, as.managedNamespace, &config.ArtifactRepository)
+	var urlSigner *artifacts.URLSigner
+	if c := config.ArtifactURLs; c != nil {
+		key, err := util.GetSecrets(ctx, as.clients.Kubernetes, as.namespace, c.SigningKeySecret.Name, c.SigningKeySecret.Key)
+		if err != nil {
+			log.WithFatal().Error(ctx, fmt.Sprintf("failed to get the signing key of artifact URLs: %v", err))
+		}
+		urlSigner = artifacts.NewURLSigner(key, c.GetMaxExpiry(), as.clients)
+	}
+	artifactServer := artifacts.NewArtifactServer(as.gatekeeper, hydrator.New(offloadRepo), wfArchive, instanceIDService, artifactRepositories, urlSigner, log)
+	eventServer := event.NewController(ctx, instanceIDService, eventRecorderManager, eventArchive, metrics, as.eventQueueSize, as.eventWorkerCount, as.eventAsyncDispatch, as.eventQuota)
 	wfArchiveServer := workflowarchive.NewWorkflowArchiveServer(wfArchive, offloadRepo, config.WorkflowDefaults)
 	wfStore, err := store.NewSQLiteStore(instanceIDService)
 	if err != nil {
 		log.WithFatal().Error(ctx, err.Error())
 	}
-	workflowServer := workflow.NewWorkflowServer(ctx, instanceIDService, offloadRepo, wfArchive, as.clients.Workflow, wfStore, wfStore, wftmplStore, cwftmplInformer, config.WorkflowDefaults, &resourceCacheNamespace)
+	workflowServer := workflow.NewWorkflowServer(ctx, instanceIDService, offloadRepo, wfArchive, as.clients.Workflow, wfStore, wfStore, wftmplStore, cwftmplInformer, config.WorkflowDefaults, config.RequireShutdownReason, &resourceCacheNamespace)
 	grpcServer := as.newGRPCServer(ctx, instanceIDService, workflowServer, wftmplStore, cwftmplInformer, wfArchiveServer, eventServer, config.Links, config.Columns, config.NavColor, config.WorkflowDefaults)
 	httpServer := as.newHTTPServer(ctx, port, artifactServer)
 
@@ -289,6 +344,7 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 		cwftmplInformer.Run(ctx, as.stopCh)
 	}
 	go eventServer.Run(ctx, as.stopCh)
+	as.runEventSources(ctx, config.EventSources, eventServer)
 	go workflowServer.Run(as.stopCh)
 	go func() { as.checkServeErr(ctx, "httpServer", http.Serve(conn, handler)) }()
 	url := "http://localhost" + address
@@ -304,6 +360,50 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 	<-as.stopCh
 }
 
+// archivedEventGarbageCollector periodically deletes archived events older than the TTL
+func (as *argoServer) archivedEventGarbageCollector(ctx context.Context, eventArchive persist.EventArchive, ttl time.Duration) {
+	if ttl == 0 {
+		return
+	}
+	log := logging.RequireLoggerFromContext(ctx).WithField("component", "archived_event_garbage_collector")
+	ctx = logging.WithLogger(ctx, log)
+	periodicity := envutil.LookupEnvDurationOr(ctx, "ARCHIVED_EVENT_GC_PERIOD", time.Hour)
+	ticker := time.NewTicker(periodicity)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-as.stopCh:
+			return
+		case <-ticker.C:
+			if err := eventArchive.DeleteExpiredEvents(ctx, ttl); err != nil {
+				log.WithError(err).Error(ctx, "Failed to delete archived events")
+			}
+		}
+	}
+}
+
+// runEventSources starts consuming from the configured event sources, dispatching as the Argo Server's service account
+func (as *argoServer) runEventSources(ctx context.Context, sources *config.EventSourcesConfig, eventServer *event.Controller) {
+	if sources == nil {
+		return
+	}
+	log := logging.RequireLoggerFromContext(ctx)
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		<-as.stopCh
+		cancel()
+	}()
+	ctx = context.WithValue(ctx, auth.WfKey, as.clients.Workflow)
+	ctx = context.WithValue(ctx, auth.KubeKey, as.clients.Kubernetes)
+	for _, source := range sources.Kafka {
+		consumer, err := kafka.NewConsumer(ctx, as.clients.Kubernetes, as.namespace, source, eventServer.Dispatch)
+		if err != nil {
+			log.WithFatal().Error(ctx, err.Error())
+		}
+		go consumer.Run(ctx)
+	}
+}
+
 func (as *argoServer) newGRPCServer(ctx context.Context, instanceIDService instanceid.Service, workflowServer workflowpkg.WorkflowServiceServer, wftmplStore types.WorkflowTemplateStore, cwftmplStore types.ClusterWorkflowTemplateStore, wfArchiveServer workflowarchivepkg.ArchivedWorkflowServiceServer, eventServer *event.Controller, links []*v1alpha1.Link, columns []*v1alpha1.Column, navColor string, wfDefaults *v1alpha1.Workflow) *grpc.Server {
 	serverLog := logging.RequireLoggerFromContext(ctx)
 
@@ -342,11 +442,13 @@ func (as *argoServer) newGRPCServer(ctx context.Context, instanceIDService insta
 	eventpkg.RegisterEventServiceServer(grpcServer, eventServer)
 	eventsourcepkg.RegisterEventSourceServiceServer(grpcServer, eventsource.NewEventSourceServer())
 	sensorpkg.RegisterSensorServiceServer(grpcServer, sensor.NewSensorServer())
+	memoizationcachepkg.RegisterMemoizationCacheServiceServer(grpcServer, memoizationcache.NewMemoizationCacheServer())
+	executorpluginpkg.RegisterExecutorPluginServiceServer(grpcServer, executorplugin.NewExecutorPluginServer())
 	workflowpkg.RegisterWorkflowServiceServer(grpcServer, workflowServer)
 	workflowtemplatepkg.RegisterWorkflowTemplateServiceServer(grpcServer, workflowtemplate.NewWorkflowTemplateServer(instanceIDService, wftmplStore, cwftmplStore))
 	cronworkflowpkg.RegisterCronWorkflowServiceServer(grpcServer, cronworkflow.NewCronWorkflowServer(instanceIDService, wftmplStore, cwftmplStore, wfDefaults))
 	workflowarchivepkg.RegisterArchivedWorkflowServiceServer(grpcServer, wfArchiveServer)
-	clusterwftemplatepkg.RegisterClusterWorkflowTemplateServiceServer(grpcServer, clusterworkflowtemplate.NewClusterWorkflowTemplateServer(instanceIDService, cwftmplStore, wfDefaults))
+	clusterwftemplatepkg.RegisterClusterWorkflowTemplateServiceServer(grpcServer, clusterworkflowtemplate.NewClusterWorkflowTemplateServer(instanceIDService, cwftmplStore, wfDefaults, as.namespace))
 	grpc_prometheus.Register(grpcServer)
 	return grpcServer
 }
@@ -393,12 +495,15 @@ func (as *argoServer) newHTTPServer(ctx context.Context, port int, artifactServe
 	gwMuxOpts := runtime.WithMarshalerOption(runtime.MIMEWildcard, new(json.JSONMarshaler))
 	gwmux := runtime.NewServeMux(gwMuxOpts,
 		runtime.WithIncomingHeaderMatcher(grpcutil.IncomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(grpcutil.OutgoingHeaderMatcher),
 		runtime.WithProtoErrorHandler(runtime.DefaultHTTPProtoErrorHandler),
 	)
 	mustRegisterGWHandler(infopkg.RegisterInfoServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(eventpkg.RegisterEventServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(eventsourcepkg.RegisterEventSourceServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(sensorpkg.RegisterSensorServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
+	mustRegisterGWHandler(memoizationcachepkg.RegisterMemoizationCacheServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
+	mustRegisterGWHandler(executorpluginpkg.RegisterExecutorPluginServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(workflowpkg.RegisterWorkflowServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(workflowtemplatepkg.RegisterWorkflowTemplateServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(cronworkflowpkg.RegisterCronWorkflowServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
@@ -408,7 +513,9 @@ func (as *argoServer) newHTTPServer(ctx context.Context, port int, artifactServe
 	mux.HandleFunc("/api/", func(w http.ResponseWriter, r *http.Request) {
 		// we must delete this header for API request to prevent "stream terminated by RST_STREAM with error code: PROTOCOL_ERROR" error
 		r.Header.Del("Connection")
-		webhookInterceptor(w, r, gwmux)
+		cloudevents.Interceptor(w, r, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			webhookInterceptor(w, r, gwmux)
+		}), int64(MaxGRPCMessageSize))
 	})
 
 	// emergency environment variable that allows you to disable the artifact service in case of problems
@@ -418,9 +525,13 @@ func (as *argoServer) newHTTPServer(ctx context.Context, port int, artifactServe
 		mux.HandleFunc("/artifacts-by-uid/", artifactServer.GetOutputArtifactByUID)
 		mux.HandleFunc("/input-artifacts-by-uid/", artifactServer.GetInputArtifactByUID)
 		mux.HandleFunc("/artifact-files/", artifactServer.GetArtifactFile)
+		mux.HandleFunc("/artifact-urls/", artifactServer.GetArtifactURL)
+		mux.HandleFunc("/signed-artifacts/", artifactServer.GetSignedArtifact)
+		mux.HandleFunc("/artifact-manifests/", artifactServer.GetArtifactManifest)
 	}
 	mux.Handle("/oauth2/redirect", handlers.ProxyHeaders(http.HandlerFunc(as.oAuth2Service.HandleRedirect)))
 	mux.Handle("/oauth2/callback", handlers.ProxyHeaders(http.HandlerFunc(as.oAuth2Service.HandleCallback)))
+	mux.HandleFunc("/oauth2/token-exchange", as.oAuth2Service.HandleTokenExchange)
 	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
 		if os.Getenv("ARGO_SERVER_METRICS_AUTH") != "false" {
 			md := metadata.New(map[string]string{"authorization": r.Header.Get("Authorization")})
@@ -38,6 +38,7 @@ type ArtifactServer struct {
 	instanceIDService    instanceid.Service
 	artDriverFactory     artifact.NewDriverFunc
 	artifactRepositories artifactrepositories.Interface
+	urlSigner            *URLSigner
 	logger               logging.Logger
 }
 
@@ -48,12 +49,12 @@ const (
 	Inputs  Direction = "inputs"
 )
 
-func NewArtifactServer(authN auth.Gatekeeper, hydrator hydrator.Interface, wfArchive sqldb.WorkflowArchive, instanceIDService instanceid.Service, artifactRepositories artifactrepositories.Interface, logger logging.Logger) *ArtifactServer {
-	return newArtifactServer(authN, hydrator, wfArchive, instanceIDService, artifact.NewDriver, artifactRepositories, logger)
+func NewArtifactServer(authN auth.Gatekeeper, hydrator hydrator.Interface, wfArchive sqldb.WorkflowArchive, instanceIDService instanceid.Service, artifactRepositories artifactrepositories.Interface, urlSigner *URLSigner, logger logging.Logger) *ArtifactServer {
+	return newArtifactServer(authN, hydrator, wfArchive, instanceIDService, artifact.NewDriver, artifactRepositories, urlSigner, logger)
 }
 
-func newArtifactServer(authN auth.Gatekeeper, hydrator hydrator.Interface, wfArchive sqldb.WorkflowArchive, instanceIDService instanceid.Service, artDriverFactory artifact.NewDriverFunc, artifactRepositories artifactrepositories.Interface, logger logging.Logger) *ArtifactServer {
-	return &ArtifactServer{authN, hydrator, wfArchive, instanceIDService, artDriverFactory, artifactRepositories, logger}
+func newArtifactServer(authN auth.Gatekeeper, hydrator hydrator.Interface, wfArchive sqldb.WorkflowArchive, instanceIDService instanceid.Service, artDriverFactory artifact.NewDriverFunc, artifactRepositories artifactrepositories.Interface, urlSigner *URLSigner, logger logging.Logger) *ArtifactServer {
+	return &ArtifactServer{authN, hydrator, wfArchive, instanceIDService, artDriverFactory, artifactRepositories, urlSigner, logger}
 }
 
 // nolint: contextcheck
@@ -363,6 +364,50 @@ func (a *ArtifactServer) getArtifactByUID(w http.ResponseWriter, r *http.Request
 	}
 }
 
+// GetArtifactManifest returns the manifest of the output artifacts of a workflow, which the controller writes to its
+// artifact repository when it completes if artifactManifest is enabled, e.g. /artifact-manifests/{namespace}/{name}
+func (a *ArtifactServer) GetArtifactManifest(w http.ResponseWriter, r *http.Request) {
+	requestPath := strings.SplitN(r.URL.Path, "/", 4)
+	if len(requestPath) != 4 {
+		a.httpBadRequestError(w)
+		return
+	}
+	namespace := requestPath[2]
+	workflowName := requestPath[3]
+
+	ctx, err := a.gateKeeping(r, types.NamespaceHolder(namespace))
+	if err != nil {
+		a.unauthorizedError(w)
+		return
+	}
+
+	a.logger.WithFields(logging.Fields{
+		"namespace":    namespace,
+		"workflowName": workflowName,
+	}).Info(ctx, "Download artifact manifest")
+
+	wf, err := a.getWorkflowAndValidate(ctx, namespace, workflowName)
+	if err != nil {
+		a.httpFromError(ctx, err, w)
+		return
+	}
+	if wf.Status.ArtifactManifest == nil {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	art := wf.Status.ArtifactManifest.DeepCopy()
+	driver, err := a.artDriverFactory(ctx, art, resources{auth.GetKubeClient(ctx), wf.Namespace})
+	if err != nil {
+		a.serverInternalError(ctx, err, w)
+		return
+	}
+	err = a.returnArtifact(ctx, w, art, driver)
+	if err != nil {
+		a.httpFromError(ctx, err, w)
+		return
+	}
+}
+
 func (a *ArtifactServer) gateKeeping(r *http.Request, ns types.NamespacedRequest) (context.Context, error) {
 	token := r.Header.Get("Authorization")
 	if token == "" {
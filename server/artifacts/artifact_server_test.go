@@ -65,6 +65,7 @@ var bucketsOfKeys = map[string][]string{
 		"my-wf/my-node-1/my-oss-artifact.zip",
 		"my-wf/my-node-1/my-s3-artifact.tgz",
 		"my-wf/my-node-inline/main.log",
+		"my-wf/my-wf/artifact-manifest.json",
 	},
 	"my-bucket-2": {
 		"my-wf/my-node-2/my-s3-artifact-bucket-2",
@@ -335,6 +336,18 @@ func newServer(t *testing.T) *ArtifactServer {
 				// a node without input/output artifacts
 				"my-node-no-artifacts": wfv1.NodeStatus{},
 			},
+			ArtifactManifest: &wfv1.Artifact{
+				Name: "artifact-manifest",
+				ArtifactLocation: wfv1.ArtifactLocation{
+					S3: &wfv1.S3Artifact{
+						Key: "my-wf/my-wf/artifact-manifest.json",
+						S3Bucket: wfv1.S3Bucket{
+							Bucket:   "my-bucket",
+							Endpoint: "my-endpoint",
+						},
+					},
+				},
+			},
 			StoredTemplates: map[string]wfv1.Template{
 				"namespaced/my-template/template-3": {
 					Name: "template-3",
@@ -371,7 +384,7 @@ func newServer(t *testing.T) *ArtifactServer {
 		},
 	})
 
-	return newArtifactServer(gatekeeper, hydratorfake.Noop, a, instanceid.NewService(instanceID), fakeArtifactDriverFactory, artifactRepositories, logging.RequireLoggerFromContext(ctx))
+	return newArtifactServer(gatekeeper, hydratorfake.Noop, a, instanceid.NewService(instanceID), fakeArtifactDriverFactory, artifactRepositories, nil, logging.RequireLoggerFromContext(ctx))
 }
 
 func TestArtifactServer_GetArtifactFile(t *testing.T) {
@@ -644,6 +657,35 @@ func TestArtifactServer_GetOutputArtifactWithoutInstanceID(t *testing.T) {
 	assert.NotEqual(t, 200, recorder.Result().StatusCode)
 }
 
+func TestArtifactServer_GetArtifactManifest(t *testing.T) {
+	s := newServer(t)
+	t.Run("Found", func(t *testing.T) {
+		r := &http.Request{}
+		r.URL = mustParse("/artifact-manifests/my-ns/my-wf")
+		recorder := httptest.NewRecorder()
+		s.GetArtifactManifest(recorder, r)
+		require.Equal(t, 200, recorder.Result().StatusCode)
+		assert.Equal(t, `filename="artifact-manifest.json"`, recorder.Header().Get("Content-Disposition"))
+		all, err := io.ReadAll(recorder.Result().Body)
+		require.NoError(t, err)
+		assert.Equal(t, "my-data", string(all))
+	})
+	t.Run("WithoutInstanceID", func(t *testing.T) {
+		r := &http.Request{}
+		r.URL = mustParse("/artifact-manifests/my-ns/your-wf")
+		recorder := httptest.NewRecorder()
+		s.GetArtifactManifest(recorder, r)
+		assert.NotEqual(t, 200, recorder.Result().StatusCode)
+	})
+	t.Run("InvalidRequestPath", func(t *testing.T) {
+		r := &http.Request{}
+		r.URL = mustParse("/artifact-manifests/my-ns")
+		recorder := httptest.NewRecorder()
+		s.GetArtifactManifest(recorder, r)
+		assert.Equal(t, 400, recorder.Result().StatusCode)
+	})
+}
+
 func TestArtifactServer_GetOutputArtifactByUID(t *testing.T) {
 	s := newServer(t)
 	r := &http.Request{}
@@ -0,0 +1,206 @@
+package artifacts
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	servertypes "github.com/argoproj/argo-workflows/v3/server/types"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// URLSigner signs the URLs of artifacts with HMAC-SHA256, so that they can be downloaded without a token until they
+// expire. Signed URLs are served with the clients of the Argo Server.
+type URLSigner struct {
+	key       []byte
+	maxExpiry time.Duration
+	clients   *servertypes.Clients
+}
+
+func NewURLSigner(key []byte, maxExpiry time.Duration, clients *servertypes.Clients) *URLSigner {
+	return &URLSigner{key: key, maxExpiry: maxExpiry, clients: clients}
+}
+
+// signature returns the signature of the path of the artifact of the workflow with the UID, until it expires. The UID
+// is signed so that the URL is not valid for a workflow re-created with the same name.
+func (s *URLSigner) signature(path string, uid types.UID, expires int64) string {
+	mac := hmac.New(sha256.New, s.key)
+	_, _ = fmt.Fprintf(mac, "%s\n%s\n%d", path, uid, expires)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// verify checks the expiry and signature of the query of a signed URL, and returns the UID of the workflow it was
+// signed for. It does not look up the workflow, so that unsigned requests are rejected before any API call.
+func (s *URLSigner) verify(path string, query url.Values, now time.Time) (types.UID, error) {
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid expires: %w", err)
+	}
+	if !now.Before(time.Unix(expires, 0)) {
+		return "", fmt.Errorf("URL expired at %s", time.Unix(expires, 0).UTC().Format(time.RFC3339))
+	}
+	uid := types.UID(query.Get("uid"))
+	if uid == "" || !hmac.Equal([]byte(query.Get("signature")), []byte(s.signature(path, uid, expires))) {
+		return "", fmt.Errorf("invalid signature")
+	}
+	return uid, nil
+}
+
+// serverContext returns the context with the clients of the Argo Server
+func (s *URLSigner) serverContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, auth.WfKey, s.clients.Workflow)
+	return context.WithValue(ctx, auth.KubeKey, s.clients.Kubernetes)
+}
+
+// ArtifactURL is a signed URL of an artifact
+type ArtifactURL struct {
+	// URL is the path and query of the URL on the Argo Server
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// parseSignedArtifactPath parses paths of the form:
+//
+//	/{prefix}/{namespace}/{workflowName}/{nodeID}/[inputs|outputs]/{artifactName}
+func parseSignedArtifactPath(p string) (namespace, workflowName, nodeID string, direction Direction, artifactName string, ok bool) {
+	requestPath := strings.Split(p, "/")
+	if len(requestPath) != 7 {
+		return "", "", "", "", "", false
+	}
+	direction = Direction(requestPath[5])
+	if direction != Outputs && direction != Inputs {
+		return "", "", "", "", "", false
+	}
+	return requestPath[2], requestPath[3], requestPath[4], direction, requestPath[6], true
+}
+
+// GetArtifactURL mints a signed URL of an artifact, which is valid for the expiresIn query parameter, or the max expiry
+// if not specified:
+//
+//	/artifact-urls/{namespace}/{workflowName}/{nodeID}/[inputs|outputs]/{artifactName}?expiresIn=10m
+//
+// nolint: contextcheck
+func (a *ArtifactServer) GetArtifactURL(w http.ResponseWriter, r *http.Request) {
+	if a.urlSigner == nil {
+		http.Error(w, "signed artifact URLs are not configured", http.StatusNotFound)
+		return
+	}
+	namespace, workflowName, nodeID, direction, artifactName, ok := parseSignedArtifactPath(r.URL.Path)
+	if !ok {
+		a.httpBadRequestError(w)
+		return
+	}
+	expiresIn := a.urlSigner.maxExpiry
+	if v := r.URL.Query().Get("expiresIn"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 || d > a.urlSigner.maxExpiry {
+			http.Error(w, fmt.Sprintf("expiresIn must be a duration greater than zero and at most %v", a.urlSigner.maxExpiry), http.StatusBadRequest)
+			return
+		}
+		expiresIn = d
+	}
+
+	ctx, err := a.gateKeeping(r, servertypes.NamespaceHolder(namespace))
+	if err != nil {
+		a.unauthorizedError(w)
+		return
+	}
+
+	wf, err := a.getWorkflowAndValidate(ctx, namespace, workflowName)
+	if err != nil {
+		a.httpFromError(ctx, err, w)
+		return
+	}
+	node, err := wf.Status.Nodes.Get(nodeID)
+	if err != nil || (direction == Inputs && node.Inputs.GetArtifactByName(artifactName) == nil) || (direction == Outputs && node.Outputs.GetArtifactByName(artifactName) == nil) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	expiresAt := time.Now().Add(expiresIn).Truncate(time.Second)
+	path := "/signed-artifacts" + strings.TrimPrefix(r.URL.Path, "/artifact-urls")
+	query := url.Values{
+		"uid":       []string{string(wf.UID)},
+		"expires":   []string{strconv.FormatInt(expiresAt.Unix(), 10)},
+		"signature": []string{a.urlSigner.signature(path, wf.UID, expiresAt.Unix())},
+	}
+
+	a.logger.WithFields(logging.Fields{
+		"namespace":    namespace,
+		"workflowName": workflowName,
+		"nodeID":       nodeID,
+		"artifactName": artifactName,
+		"isInput":      direction == Inputs,
+		"expiresAt":    expiresAt,
+	}).Info(ctx, "Signed artifact URL")
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(ArtifactURL{URL: path + "?" + query.Encode(), ExpiresAt: expiresAt.UTC()})
+}
+
+// GetSignedArtifact downloads an artifact of a URL signed by GetArtifactURL, without a token:
+//
+//	/signed-artifacts/{namespace}/{workflowName}/{nodeID}/[inputs|outputs]/{artifactName}?expires=...&signature=...&uid=...
+//
+// The expiry and signature are verified before the workflow is fetched with the clients of the Argo Server.
+//
+// nolint: contextcheck
+func (a *ArtifactServer) GetSignedArtifact(w http.ResponseWriter, r *http.Request) {
+	if a.urlSigner == nil {
+		http.Error(w, "signed artifact URLs are not configured", http.StatusNotFound)
+		return
+	}
+	namespace, workflowName, nodeID, direction, artifactName, ok := parseSignedArtifactPath(r.URL.Path)
+	if !ok {
+		a.httpBadRequestError(w)
+		return
+	}
+
+	uid, err := a.urlSigner.verify(r.URL.Path, r.URL.Query(), time.Now())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	ctx := logging.WithLogger(r.Context(), a.logger)
+	ctx = a.urlSigner.serverContext(ctx)
+
+	wf, err := a.getWorkflowAndValidate(ctx, namespace, workflowName)
+	if err == nil && wf.UID != uid {
+		err = fmt.Errorf("workflow UID %s does not match signed UID %s", wf.UID, uid)
+	}
+	if err != nil {
+		// do not reveal whether the workflow exists
+		a.logger.WithError(err).Debug(ctx, "Failed to get workflow of signed artifact URL")
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	a.logger.WithFields(logging.Fields{
+		"namespace":    namespace,
+		"workflowName": workflowName,
+		"nodeID":       nodeID,
+		"artifactName": artifactName,
+		"isInput":      direction == Inputs,
+	}).Info(ctx, "Download signed artifact")
+
+	art, driver, err := a.getArtifactAndDriver(ctx, nodeID, artifactName, direction == Inputs, wf, nil)
+	if err != nil {
+		a.serverInternalError(ctx, err, w)
+		return
+	}
+	if err := a.returnArtifact(ctx, w, art, driver); err != nil {
+		a.httpFromError(ctx, err, w)
+	}
+}
@@ -0,0 +1,137 @@
+package artifacts
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fakewfv1 "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/types"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func newSigningServer(t *testing.T) *ArtifactServer {
+	s := newServer(t)
+	ctx, err := s.gatekeeper.ContextWithRequest(logging.TestContext(t.Context()), nil)
+	require.NoError(t, err)
+	wfClient := auth.GetWfClient(ctx)
+	wf, err := wfClient.ArgoprojV1alpha1().Workflows("my-ns").Get(ctx, "my-wf", metav1.GetOptions{})
+	require.NoError(t, err)
+	wf.UID = "my-uid"
+	_, err = wfClient.ArgoprojV1alpha1().Workflows("my-ns").Update(ctx, wf, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	wfClient.(*fakewfv1.Clientset).ClearActions()
+	s.urlSigner = NewURLSigner([]byte("my-key"), time.Hour, &types.Clients{Workflow: wfClient, Kubernetes: auth.GetKubeClient(ctx)})
+	return s
+}
+
+func getArtifactURL(s *ArtifactServer, path string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	s.GetArtifactURL(recorder, &http.Request{URL: mustParse(path)})
+	return recorder
+}
+
+func getSignedArtifact(s *ArtifactServer, path string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	s.GetSignedArtifact(recorder, &http.Request{URL: mustParse(path)})
+	return recorder
+}
+
+func TestArtifactServer_SignedURLs(t *testing.T) {
+	t.Run("NotConfigured", func(t *testing.T) {
+		s := newServer(t)
+		assert.Equal(t, http.StatusNotFound, getArtifactURL(s, "/artifact-urls/my-ns/my-wf/my-node-1/outputs/my-s3-artifact").Code)
+		assert.Equal(t, http.StatusNotFound, getSignedArtifact(s, "/signed-artifacts/my-ns/my-wf/my-node-1/outputs/my-s3-artifact").Code)
+	})
+	t.Run("Signed", func(t *testing.T) {
+		s := newSigningServer(t)
+		recorder := getArtifactURL(s, "/artifact-urls/my-ns/my-wf/my-node-1/outputs/my-s3-artifact?expiresIn=10m")
+		require.Equal(t, http.StatusOK, recorder.Code)
+		var artifactURL ArtifactURL
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&artifactURL))
+		assert.True(t, strings.HasPrefix(artifactURL.URL, "/signed-artifacts/my-ns/my-wf/my-node-1/outputs/my-s3-artifact?"))
+		assert.WithinDuration(t, time.Now().Add(10*time.Minute), artifactURL.ExpiresAt, 2*time.Second)
+
+		recorder = getSignedArtifact(s, artifactURL.URL)
+		require.Equal(t, http.StatusOK, recorder.Code)
+		data, err := io.ReadAll(recorder.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "my-data", string(data))
+
+		t.Run("OtherArtifact", func(t *testing.T) {
+			other := strings.Replace(artifactURL.URL, "my-s3-artifact", "my-gcs-artifact", 1)
+			assert.Equal(t, http.StatusForbidden, getSignedArtifact(s, other).Code)
+		})
+		t.Run("NotSigned", func(t *testing.T) {
+			assert.Equal(t, http.StatusForbidden, getSignedArtifact(s, strings.Split(artifactURL.URL, "?")[0]).Code)
+		})
+		t.Run("OtherUID", func(t *testing.T) {
+			other := strings.Replace(artifactURL.URL, "uid=my-uid", "uid=other-uid", 1)
+			assert.Equal(t, http.StatusForbidden, getSignedArtifact(s, other).Code)
+		})
+	})
+	t.Run("RecreatedWorkflow", func(t *testing.T) {
+		s := newSigningServer(t)
+		path := "/signed-artifacts/my-ns/my-wf/my-node-1/outputs/my-s3-artifact"
+		expires := time.Now().Add(time.Minute).Unix()
+		recorder := getSignedArtifact(s, path+"?uid=old-uid&expires="+strconv.FormatInt(expires, 10)+"&signature="+s.urlSigner.signature(path, "old-uid", expires))
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+	t.Run("RejectedBeforeLookup", func(t *testing.T) {
+		path := "/signed-artifacts/my-ns/my-wf/my-node-1/outputs/my-s3-artifact"
+		expired := time.Now().Add(-time.Minute).Unix()
+		valid := time.Now().Add(time.Minute).Unix()
+		for name, tt := range map[string]struct {
+			query   func(s *URLSigner) string
+			message string
+		}{
+			"Missing": {func(*URLSigner) string { return "" }, "invalid expires"},
+			"MalformedExpires": {func(s *URLSigner) string {
+				return "?uid=my-uid&expires=soon&signature=" + s.signature(path, "my-uid", valid)
+			}, "invalid expires"},
+			"Expired": {func(s *URLSigner) string {
+				return "?uid=my-uid&expires=" + strconv.FormatInt(expired, 10) + "&signature=" + s.signature(path, "my-uid", expired)
+			}, "URL expired"},
+			"MissingSignature": {func(*URLSigner) string {
+				return "?uid=my-uid&expires=" + strconv.FormatInt(valid, 10)
+			}, "invalid signature"},
+			"MalformedSignature": {func(*URLSigner) string {
+				return "?uid=my-uid&expires=" + strconv.FormatInt(valid, 10) + "&signature=not-a-signature"
+			}, "invalid signature"},
+			"MissingUID": {func(s *URLSigner) string {
+				return "?expires=" + strconv.FormatInt(valid, 10) + "&signature=" + s.signature(path, "", valid)
+			}, "invalid signature"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				s := newSigningServer(t)
+				recorder := getSignedArtifact(s, path+tt.query(s.urlSigner))
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+				assert.Contains(t, recorder.Body.String(), tt.message)
+				assert.Empty(t, s.urlSigner.clients.Workflow.(*fakewfv1.Clientset).Actions())
+			})
+		}
+	})
+	t.Run("ExpiresInTooLong", func(t *testing.T) {
+		s := newSigningServer(t)
+		assert.Equal(t, http.StatusBadRequest, getArtifactURL(s, "/artifact-urls/my-ns/my-wf/my-node-1/outputs/my-s3-artifact?expiresIn=2h").Code)
+	})
+	t.Run("ArtifactNotFound", func(t *testing.T) {
+		s := newSigningServer(t)
+		assert.Equal(t, http.StatusNotFound, getArtifactURL(s, "/artifact-urls/my-ns/my-wf/my-node-1/inputs/my-s3-artifact").Code)
+	})
+	t.Run("InvalidPath", func(t *testing.T) {
+		s := newSigningServer(t)
+		assert.Equal(t, http.StatusBadRequest, getArtifactURL(s, "/artifact-urls/my-ns/my-wf/my-node-1/my-s3-artifact").Code)
+	})
+}
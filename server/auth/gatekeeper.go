@@ -200,6 +200,16 @@ func (s *gatekeeper) getClients(ctx context.Context, req interface{}) (*serverty
 		if err != nil {
 			return nil, nil, status.Error(codes.Unauthenticated, err.Error())
 		}
+		if claims.Scope != nil {
+			verb, err := requestVerb(ctx)
+			if err == nil {
+				err = claims.Scope.Allows(getNamespace(req), verb)
+			}
+			if err != nil {
+				logger.WithFields(addClaimsLogFields(claims, nil)).WithError(err).Info(ctx, "token scope does not allow request")
+				return nil, nil, status.Error(codes.PermissionDenied, err.Error())
+			}
+		}
 		if s.ssoIf.IsRBACEnabled() {
 			clients, err := s.rbacAuthorization(ctx, claims, req)
 			if err != nil {
@@ -228,6 +238,15 @@ func getNamespace(req interface{}) string {
 	return namespacedRequest.GetNamespace()
 }
 
+// requestVerb returns the verb of the gRPC method of the request. Requests that are not gRPC, such as artifact
+// downloads, are reads.
+func requestVerb(ctx context.Context) (string, error) {
+	if method, ok := grpc.Method(ctx); ok {
+		return authTypes.VerbForMethod(method)
+	}
+	return authTypes.VerbGet, nil
+}
+
 func precedence(serviceAccount *corev1.ServiceAccount) int {
 	i, _ := strconv.Atoi(serviceAccount.Annotations[common.AnnotationKeyRBACRulePrecedence])
 	return i
@@ -306,14 +325,13 @@ func (s *gatekeeper) rbacAuthorization(ctx context.Context, claims *authTypes.Cl
 		}
 	}
 	// important! write an audit entry (i.e. log entry) so we know which user performed an operation
-	logger.WithFields(logging.Fields{
+	logger.WithFields(addClaimsLogFields(claims, logging.Fields{
 		"serviceAccount":       delegatedAccount.Name,
 		"loginServiceAccount":  loginAccount.Name,
-		"subject":              claims.Subject,
 		"email":                claims.Email,
 		"ssoDelegationAllowed": ssoDelegationAllowed,
 		"ssoDelegated":         ssoDelegated,
-	}).Info(ctx, "selected SSO RBAC service account for user")
+	})).Info(ctx, "selected SSO RBAC service account for user")
 	return s.getClientsForServiceAccount(ctx, claims, delegatedAccount)
 }
 
@@ -334,6 +352,10 @@ func addClaimsLogFields(claims *authTypes.Claims, fields logging.Fields) logging
 	if claims.Email != "" {
 		fields["email"] = claims.Email
 	}
+	// links the use of an exchanged token to its exchange
+	if claims.Scope != nil {
+		fields["tokenId"] = claims.ID
+	}
 	return fields
 }
 
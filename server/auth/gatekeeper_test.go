@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -259,8 +260,37 @@ func TestServer_GetWFClient(t *testing.T) {
 		_, err = g.Context(x(logging.TestContext(t.Context()), "Bearer v2:whatever"))
 		require.EqualError(t, err, "rpc error: code = PermissionDenied desc = not allowed")
 	})
+	t.Run("SSO,scoped", func(t *testing.T) {
+		ssoIf := &ssomocks.Interface{}
+		ssoIf.On("Authorize", mock.Anything, mock.Anything).Return(&authTypes.Claims{
+			Claims: jwt.Claims{Subject: "my-sub", ID: "my-token-id"},
+			Scope:  &authTypes.Scope{Namespaces: []string{"user1-ns"}, Verbs: []string{"get", "create"}},
+		}, nil)
+		ssoIf.On("IsRBACEnabled").Return(false)
+		g, err := NewGatekeeper(Modes{SSO: true}, clients, &rest.Config{Username: "my-username"}, ssoIf, clientForAuthorization, "my-ns", "my-ns", true, resourceCache)
+		require.NoError(t, err)
+		ctx := x(logging.TestContext(t.Context()), "Bearer v2:whatever")
+		_, err = g.ContextWithRequest(ctx, servertypes.NamespaceHolder("user1-ns"))
+		require.NoError(t, err)
+		_, err = g.ContextWithRequest(grpc.NewContextWithServerTransportStream(ctx, methodStream("/workflow.WorkflowService/SubmitWorkflow")), servertypes.NamespaceHolder("user1-ns"))
+		require.NoError(t, err)
+		_, err = g.ContextWithRequest(grpc.NewContextWithServerTransportStream(ctx, methodStream("/workflow.WorkflowService/DeleteWorkflow")), servertypes.NamespaceHolder("user1-ns"))
+		require.EqualError(t, err, "rpc error: code = PermissionDenied desc = token is not allowed to delete")
+		_, err = g.ContextWithRequest(ctx, servertypes.NamespaceHolder("user2-ns"))
+		require.EqualError(t, err, `rpc error: code = PermissionDenied desc = token is not allowed in namespace "user2-ns"`)
+		_, err = g.Context(ctx)
+		require.EqualError(t, err, `rpc error: code = PermissionDenied desc = token is not allowed in namespace ""`)
+	})
 }
 
+// methodStream is a server transport stream of the gRPC method
+type methodStream string
+
+func (m methodStream) Method() string               { return string(m) }
+func (m methodStream) SetHeader(metadata.MD) error  { return nil }
+func (m methodStream) SendHeader(metadata.MD) error { return nil }
+func (m methodStream) SetTrailer(metadata.MD) error { return nil }
+
 func x(ctx context.Context, authorization string) context.Context {
 	return metadata.NewIncomingContext(ctx, metadata.New(map[string]string{"authorization": authorization}))
 }
@@ -0,0 +1,118 @@
+package sso
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/server/auth/types"
+)
+
+// sessionGroups are the groups of a session, and the refresh token they were last refreshed with
+type sessionGroups struct {
+	groups       []string
+	refreshToken string
+	refreshedAt  time.Time
+	expiry       time.Time
+}
+
+// sessionGroupsCache caches the groups of sessions, by the hash of their authorization, so that they are refreshed at
+// most once per refresh interval
+type sessionGroupsCache struct {
+	mutex    sync.Mutex
+	sessions map[string]sessionGroups
+	refresh  singleflight.Group
+}
+
+func newSessionGroupsCache() *sessionGroupsCache {
+	return &sessionGroupsCache{sessions: map[string]sessionGroups{}}
+}
+
+func (c *sessionGroupsCache) get(key string) (sessionGroups, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	session, ok := c.sessions[key]
+	return session, ok
+}
+
+// set caches the groups of the session, and removes the sessions that have expired
+func (c *sessionGroupsCache) set(key string, session sessionGroups, now time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for k, v := range c.sessions {
+		if now.After(v.expiry) {
+			delete(c.sessions, k)
+		}
+	}
+	c.sessions[key] = session
+}
+
+// sessionKey returns the key of the session of the authorization, so that the authorization is not kept in memory
+func (s *sso) sessionKey(authorization string) string {
+	sum := sha256.Sum256([]byte(authorization))
+	return hex.EncodeToString(sum[:])
+}
+
+// refreshGroups returns the groups of the session, refreshed with its refresh token if they were last refreshed longer
+// ago than the refresh interval. The last groups are returned if refreshing fails.
+func (s *sso) refreshGroups(authorization string, c *types.Claims) []string {
+	key := s.sessionKey(authorization)
+	session, ok := s.sessionGroups.get(key)
+	if !ok {
+		session = sessionGroups{groups: c.Groups, refreshToken: c.RefreshToken, refreshedAt: c.IssuedAt.Time(), expiry: c.Expiry.Time()}
+	}
+	if time.Since(session.refreshedAt) < s.groupsRefreshInterval {
+		return session.groups
+	}
+	groups, _, _ := s.sessionGroups.refresh.Do(key, func() (interface{}, error) {
+		ctx := context.WithValue(context.Background(), oauth2.HTTPClient, s.httpClient)
+		refreshed, err := s.refreshSession(ctx, session)
+		if err != nil {
+			s.logger.WithError(err).WithField("subject", c.Subject).Warn(ctx, "failed to refresh groups, using the last groups")
+			// do not retry until the next refresh interval
+			refreshed = session
+			refreshed.refreshedAt = time.Now()
+		}
+		s.sessionGroups.set(key, refreshed, time.Now())
+		return refreshed.groups, nil
+	})
+	return groups.([]string)
+}
+
+func (s *sso) refreshSession(ctx context.Context, session sessionGroups) (sessionGroups, error) {
+	token, err := s.config.TokenSource(ctx, &oauth2.Token{RefreshToken: session.refreshToken}).Token()
+	if err != nil {
+		return session, fmt.Errorf("failed to refresh the token: %w", err)
+	}
+	var idTokenClaims *types.Claims
+	if rawIDToken, ok := token.Extra("id_token").(string); ok {
+		idToken, err := s.idTokenVerifier.Verify(ctx, rawIDToken)
+		if err != nil {
+			return session, fmt.Errorf("failed to verify the refreshed id token: %w", err)
+		}
+		idTokenClaims = &types.Claims{}
+		if err := idToken.Claims(idTokenClaims); err != nil {
+			return session, fmt.Errorf("failed to get claims from the refreshed id token: %w", err)
+		}
+	} else if s.groupsClaimSource != config.GroupsClaimSourceAccessToken && s.userInfoPath == "" {
+		return session, fmt.Errorf("the refreshed token has no id token")
+	}
+	groups, err := s.getGroups(ctx, token, idTokenClaims)
+	if err != nil {
+		return session, err
+	}
+	session.groups = groups
+	// the refresh token may be rotated
+	if token.RefreshToken != "" {
+		session.refreshToken = token.RefreshToken
+	}
+	session.refreshedAt = time.Now()
+	return session, nil
+}
@@ -192,6 +192,52 @@ func (_c *Interface_HandleRedirect_Call) RunAndReturn(run func(writer http.Respo
 	return _c
 }
 
+// HandleTokenExchange provides a mock function for the type Interface
+func (_mock *Interface) HandleTokenExchange(writer http.ResponseWriter, request *http.Request) {
+	_mock.Called(writer, request)
+	return
+}
+
+// Interface_HandleTokenExchange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandleTokenExchange'
+type Interface_HandleTokenExchange_Call struct {
+	*mock.Call
+}
+
+// HandleTokenExchange is a helper method to define mock.On call
+//   - writer http.ResponseWriter
+//   - request *http.Request
+func (_e *Interface_Expecter) HandleTokenExchange(writer interface{}, request interface{}) *Interface_HandleTokenExchange_Call {
+	return &Interface_HandleTokenExchange_Call{Call: _e.mock.On("HandleTokenExchange", writer, request)}
+}
+
+func (_c *Interface_HandleTokenExchange_Call) Run(run func(writer http.ResponseWriter, request *http.Request)) *Interface_HandleTokenExchange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Interface_HandleTokenExchange_Call) Return() *Interface_HandleTokenExchange_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Interface_HandleTokenExchange_Call) RunAndReturn(run func(writer http.ResponseWriter, request *http.Request)) *Interface_HandleTokenExchange_Call {
+	_c.Run(run)
+	return _c
+}
+
 // IsRBACEnabled provides a mock function for the type Interface
 func (_mock *Interface) IsRBACEnabled() bool {
 	ret := _mock.Called()
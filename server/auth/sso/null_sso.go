@@ -26,3 +26,7 @@ func (n nullService) HandleRedirect(w http.ResponseWriter, _ *http.Request) {
 func (n nullService) HandleCallback(w http.ResponseWriter, _ *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
+
+func (n nullService) HandleTokenExchange(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
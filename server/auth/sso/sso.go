@@ -45,6 +45,7 @@ type Interface interface {
 	Authorize(authorization string) (*types.Claims, error)
 	HandleRedirect(writer http.ResponseWriter, request *http.Request)
 	HandleCallback(writer http.ResponseWriter, request *http.Request)
+	HandleTokenExchange(writer http.ResponseWriter, request *http.Request)
 	IsRBACEnabled() bool
 }
 
@@ -53,20 +54,27 @@ var _ Interface = &sso{}
 type Config = config.SSOConfig
 
 type sso struct {
-	config            *oauth2.Config
-	issuer            string
-	idTokenVerifier   *oidc.IDTokenVerifier
-	httpClient        *http.Client
-	baseHRef          string
-	secure            bool
-	privateKey        crypto.PrivateKey
-	encrypter         jose.Encrypter
-	rbacConfig        *config.RBACConfig
-	expiry            time.Duration
-	customClaimName   string
-	userInfoPath      string
-	filterGroupsRegex []*regexp.Regexp
-	logger            logging.Logger
+	config                *oauth2.Config
+	issuer                string
+	idTokenVerifier       *oidc.IDTokenVerifier
+	accessTokenVerifier   *oidc.IDTokenVerifier
+	httpClient            *http.Client
+	baseHRef              string
+	secure                bool
+	privateKey            crypto.PrivateKey
+	encrypter             jose.Encrypter
+	rbacConfig            *config.RBACConfig
+	expiry                time.Duration
+	customClaimName       string
+	groupsClaimPath       string
+	groupsClaimSource     string
+	userInfoPath          string
+	filterGroupsRegex     []*regexp.Regexp
+	groupsRefreshInterval time.Duration
+	sessionGroups         *sessionGroupsCache
+	// tokenExchangeMaxExpiry is zero if token exchange is disabled
+	tokenExchangeMaxExpiry time.Duration
+	logger                 logging.Logger
 }
 
 func (s *sso) IsRBACEnabled() bool {
@@ -109,6 +117,9 @@ func newSso(
 	if c.ClientSecret.Name == "" || c.ClientSecret.Key == "" {
 		return nil, fmt.Errorf("clientSecret empty")
 	}
+	if c.GroupsClaimSource != "" && c.GroupsClaimSource != config.GroupsClaimSourceIDToken && c.GroupsClaimSource != config.GroupsClaimSourceAccessToken {
+		return nil, fmt.Errorf("groupsClaimSource must be %s or %s", config.GroupsClaimSourceIDToken, config.GroupsClaimSourceAccessToken)
+	}
 	clientSecretObj, err := secretsIf.Get(ctx, c.ClientSecret.Name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
@@ -183,6 +194,8 @@ func newSso(
 		Scopes:       append(c.Scopes, oidc.ScopeOpenID),
 	}
 	idTokenVerifier := provider.Verifier(&oidc.Config{ClientID: config.ClientID})
+	// access tokens are not necessarily issued for the client
+	accessTokenVerifier := provider.Verifier(&oidc.Config{SkipClientIDCheck: true})
 	encrypter, err := jose.NewEncrypter(jose.A256GCM, jose.Recipient{Algorithm: jose.RSA_OAEP_256, Key: privateKey.Public()}, &jose.EncrypterOptions{Compression: jose.DEFLATE})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create JWT encrpytor: %w", err)
@@ -199,7 +212,12 @@ func newSso(
 		}
 	}
 
-	lf := logging.Fields{"redirectUrl": config.RedirectURL, "issuer": c.Issuer, "issuerAlias": "DISABLED", "clientId": c.ClientID, "scopes": config.Scopes, "insecureSkipVerify": c.InsecureSkipVerify, "filterGroupsRegex": c.FilterGroupsRegex}
+	var tokenExchangeMaxExpiry time.Duration
+	if c.TokenExchange != nil {
+		tokenExchangeMaxExpiry = c.TokenExchange.GetMaxExpiry()
+	}
+
+	lf := logging.Fields{"redirectUrl": config.RedirectURL, "issuer": c.Issuer, "issuerAlias": "DISABLED", "clientId": c.ClientID, "scopes": config.Scopes, "insecureSkipVerify": c.InsecureSkipVerify, "filterGroupsRegex": c.FilterGroupsRegex, "groupsClaimPath": c.GroupsClaimPath, "groupsClaimSource": c.GroupsClaimSource, "groupsRefreshInterval": c.GroupsRefreshInterval.Duration, "tokenExchangeMaxExpiry": tokenExchangeMaxExpiry}
 	if c.IssuerAlias != "" {
 		lf["issuerAlias"] = c.IssuerAlias
 	}
@@ -207,20 +225,26 @@ func newSso(
 	logger.Info(ctx, "SSO configuration")
 
 	return &sso{
-		config:            config,
-		idTokenVerifier:   idTokenVerifier,
-		baseHRef:          baseHRef,
-		httpClient:        httpClient,
-		secure:            secure,
-		privateKey:        privateKey,
-		encrypter:         encrypter,
-		rbacConfig:        c.RBAC,
-		expiry:            c.GetSessionExpiry(),
-		customClaimName:   c.CustomGroupClaimName,
-		userInfoPath:      c.UserInfoPath,
-		issuer:            c.Issuer,
-		filterGroupsRegex: filterGroupsRegex,
-		logger:            logger,
+		config:                 config,
+		idTokenVerifier:        idTokenVerifier,
+		accessTokenVerifier:    accessTokenVerifier,
+		baseHRef:               baseHRef,
+		httpClient:             httpClient,
+		secure:                 secure,
+		privateKey:             privateKey,
+		encrypter:              encrypter,
+		rbacConfig:             c.RBAC,
+		expiry:                 c.GetSessionExpiry(),
+		customClaimName:        c.CustomGroupClaimName,
+		groupsClaimPath:        c.GroupsClaimPath,
+		groupsClaimSource:      c.GroupsClaimSource,
+		userInfoPath:           c.UserInfoPath,
+		issuer:                 c.Issuer,
+		filterGroupsRegex:      filterGroupsRegex,
+		groupsRefreshInterval:  c.GroupsRefreshInterval.Duration,
+		sessionGroups:          newSessionGroupsCache(),
+		tokenExchangeMaxExpiry: tokenExchangeMaxExpiry,
+		logger:                 logger,
 	}, nil
 }
 
@@ -285,45 +309,19 @@ func (s *sso) HandleCallback(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(401)
 		return
 	}
-	// Default to groups claim but if customClaimName is set
-	// extract groups based on that claim key
-	groups := c.Groups
-	if s.customClaimName != "" {
-		groups, err = c.GetCustomGroup(s.customClaimName)
-		if err != nil {
-			s.logger.Warn(r.Context(), err.Error())
-		}
-	}
-	// Some SSO implementations (Okta) require a call to
-	// the OIDC user info path to get attributes like groups
-	if s.userInfoPath != "" {
-		groups, err = c.GetUserInfoGroups(s.httpClient, oauth2Token.AccessToken, s.issuer, s.userInfoPath)
-		if err != nil {
-			s.logger.WithField("userInfoPath", s.userInfoPath).WithError(err).Error(r.Context(), "failed to get groups claim from the given userInfoPath")
-			w.WriteHeader(401)
-			return
-		}
-	}
-
-	// only return groups that match at least one of the regexes
-	if len(s.filterGroupsRegex) > 0 {
-		var filteredGroups []string
-		for _, group := range groups {
-			for _, regex := range s.filterGroupsRegex {
-				if regex.MatchString(group) {
-					filteredGroups = append(filteredGroups, group)
-					break
-				}
-			}
-		}
-		groups = filteredGroups
+	groups, err := s.getGroups(ctx, oauth2Token, c)
+	if err != nil {
+		s.logger.WithError(err).Error(r.Context(), "failed to get groups")
+		w.WriteHeader(401)
+		return
 	}
 
 	argoClaims := &types.Claims{
 		Claims: jwt.Claims{
-			Issuer:  issuer,
-			Subject: c.Subject,
-			Expiry:  jwt.NewNumericDate(time.Now().Add(s.expiry)),
+			Issuer:   issuer,
+			Subject:  c.Subject,
+			Expiry:   jwt.NewNumericDate(time.Now().Add(s.expiry)),
+			IssuedAt: jwt.NewNumericDate(time.Now()),
 		},
 		Groups:                  groups,
 		Email:                   c.Email,
@@ -333,6 +331,9 @@ func (s *sso) HandleCallback(w http.ResponseWriter, r *http.Request) {
 		PreferredUsername:       c.PreferredUsername,
 		ServiceAccountNamespace: c.ServiceAccountNamespace,
 	}
+	if s.groupsRefreshInterval > 0 {
+		argoClaims.RefreshToken = oauth2Token.RefreshToken
+	}
 	raw, err := jwt.Encrypted(s.encrypter).Claims(argoClaims).CompactSerialize()
 	if err != nil {
 		s.logger.WithError(err).Error(r.Context(), "failed to encrypt and serialize the jwt token")
@@ -394,9 +395,73 @@ func (s *sso) Authorize(authorization string) (*types.Claims, error) {
 		return nil, fmt.Errorf("failed to validate claims: %v", err)
 	}
 
+	if s.groupsRefreshInterval > 0 && c.RefreshToken != "" {
+		c.Groups = s.refreshGroups(authorization, c)
+	}
+	// the refresh token is only for refreshing groups
+	c.RefreshToken = ""
+
 	return c, nil
 }
 
+// getGroups returns the groups of the user, from the claims of the ID token or access token, or the user info
+// endpoint, that match the filters. The claims of the ID token may be nil if they are not needed.
+func (s *sso) getGroups(ctx context.Context, oauth2Token *oauth2.Token, idTokenClaims *types.Claims) ([]string, error) {
+	c := idTokenClaims
+	if s.groupsClaimSource == config.GroupsClaimSourceAccessToken {
+		accessToken, err := s.accessTokenVerifier.Verify(ctx, oauth2Token.AccessToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to verify the access token: %w", err)
+		}
+		c = &types.Claims{}
+		if err := accessToken.Claims(c); err != nil {
+			return nil, fmt.Errorf("failed to get claims from the access token: %w", err)
+		}
+	}
+	if c == nil {
+		c = &types.Claims{}
+	}
+	// Default to groups claim but if groupsClaimPath or customClaimName is set
+	// extract groups based on that claim
+	groups := c.Groups
+	var err error
+	switch {
+	case s.groupsClaimPath != "":
+		groups, err = c.GetGroupsFromPath(s.groupsClaimPath)
+		if err != nil {
+			s.logger.Warn(ctx, err.Error())
+		}
+	case s.customClaimName != "":
+		groups, err = c.GetCustomGroup(s.customClaimName)
+		if err != nil {
+			s.logger.Warn(ctx, err.Error())
+		}
+	}
+	// Some SSO implementations (Okta) require a call to
+	// the OIDC user info path to get attributes like groups
+	if s.userInfoPath != "" {
+		groups, err = c.GetUserInfoGroups(s.httpClient, oauth2Token.AccessToken, s.issuer, s.userInfoPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get groups claim from the given userInfoPath %s: %w", s.userInfoPath, err)
+		}
+	}
+
+	// only return groups that match at least one of the regexes
+	if len(s.filterGroupsRegex) > 0 {
+		var filteredGroups []string
+		for _, group := range groups {
+			for _, regex := range s.filterGroupsRegex {
+				if regex.MatchString(group) {
+					filteredGroups = append(filteredGroups, group)
+					break
+				}
+			}
+		}
+		groups = filteredGroups
+	}
+	return groups, nil
+}
+
 func (s *sso) getRedirectURL(r *http.Request) string {
 	if s.config.RedirectURL != "" {
 		return s.config.RedirectURL
@@ -2,10 +2,13 @@ package sso
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/go-jose/go-jose/v3/jwt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/oauth2"
@@ -13,6 +16,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 
+	"github.com/argoproj/argo-workflows/v3/server/auth/types"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 )
 
@@ -199,3 +203,106 @@ func TestIsValidFinalRedirectURL(t *testing.T) {
 		})
 	}
 }
+
+func TestNewSsoInvalidGroupsClaimSource(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(ssoConfigSecret).CoreV1().Secrets(testNamespace)
+	config := Config{
+		Issuer:            "https://test-issuer",
+		ClientID:          getSecretKeySelector("argo-sso-secret", "client-id"),
+		ClientSecret:      getSecretKeySelector("argo-sso-secret", "client-secret"),
+		RedirectURL:       "https://dummy",
+		GroupsClaimSource: "refreshToken",
+	}
+	_, err := newSso(logging.TestContext(t.Context()), fakeOidcFactory, config, fakeClient, "/", false)
+	require.EqualError(t, err, "groupsClaimSource must be idToken or accessToken")
+}
+
+func TestRefreshGroups(t *testing.T) {
+	var refreshTokens []string
+	tokenStatus := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/token":
+			refreshTokens = append(refreshTokens, r.FormValue("refresh_token"))
+			w.WriteHeader(tokenStatus)
+			_, _ = w.Write([]byte(`{"access_token":"my-access-token","token_type":"Bearer","refresh_token":"my-rotated-refresh-token","expires_in":3600}`))
+		case "/user-info":
+			assert.Equal(t, "Bearer my-access-token", r.Header.Get("Authorization"))
+			_, _ = w.Write([]byte(`{"groups":["new-group"]}`))
+		}
+	}))
+	defer server.Close()
+
+	newRefreshingSso := func(t *testing.T) *sso {
+		fakeClient := fake.NewSimpleClientset(ssoConfigSecret).CoreV1().Secrets(testNamespace)
+		config := Config{
+			Issuer:                server.URL,
+			ClientID:              getSecretKeySelector("argo-sso-secret", "client-id"),
+			ClientSecret:          getSecretKeySelector("argo-sso-secret", "client-secret"),
+			RedirectURL:           "https://dummy",
+			UserInfoPath:          "/user-info",
+			GroupsRefreshInterval: metav1.Duration{Duration: time.Minute},
+		}
+		ssoInterface, err := newSso(logging.TestContext(t.Context()), fakeOidcFactory, config, fakeClient, "/", false)
+		require.NoError(t, err)
+		s := ssoInterface.(*sso)
+		s.config.Endpoint = oauth2.Endpoint{TokenURL: server.URL + "/token", AuthStyle: oauth2.AuthStyleInHeader}
+		s.httpClient = server.Client()
+		return s
+	}
+	authorization := func(t *testing.T, s *sso, refreshedAgo time.Duration) string {
+		raw, err := jwt.Encrypted(s.encrypter).Claims(&types.Claims{
+			Claims: jwt.Claims{
+				Issuer:   issuer,
+				Subject:  "my-subject",
+				Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+				IssuedAt: jwt.NewNumericDate(time.Now().Add(-refreshedAgo)),
+			},
+			Groups:       []string{"old-group"},
+			RefreshToken: "my-refresh-token",
+		}).CompactSerialize()
+		require.NoError(t, err)
+		return Prefix + raw
+	}
+
+	t.Run("NotDue", func(t *testing.T) {
+		refreshTokens = nil
+		s := newRefreshingSso(t)
+		claims, err := s.Authorize(authorization(t, s, time.Second))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"old-group"}, claims.Groups)
+		assert.Empty(t, claims.RefreshToken)
+		assert.Empty(t, refreshTokens)
+	})
+	t.Run("Due", func(t *testing.T) {
+		refreshTokens = nil
+		s := newRefreshingSso(t)
+		a := authorization(t, s, 2*time.Minute)
+		claims, err := s.Authorize(a)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"new-group"}, claims.Groups)
+		assert.Empty(t, claims.RefreshToken)
+		claims, err = s.Authorize(a)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"new-group"}, claims.Groups)
+		assert.Equal(t, []string{"my-refresh-token"}, refreshTokens)
+
+		key := s.sessionKey(a)
+		session, _ := s.sessionGroups.get(key)
+		assert.Equal(t, "my-rotated-refresh-token", session.refreshToken)
+	})
+	t.Run("Failed", func(t *testing.T) {
+		refreshTokens = nil
+		tokenStatus = http.StatusBadRequest
+		defer func() { tokenStatus = http.StatusOK }()
+		s := newRefreshingSso(t)
+		a := authorization(t, s, 2*time.Minute)
+		for range 2 {
+			claims, err := s.Authorize(a)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"old-group"}, claims.Groups)
+		}
+		assert.Len(t, refreshTokens, 1)
+	})
+}
@@ -0,0 +1,127 @@
+package sso
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/google/uuid"
+
+	"github.com/argoproj/argo-workflows/v3/server/auth/types"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// TokenExchangeRequest requests a token restricted to the namespaces and verbs
+type TokenExchangeRequest struct {
+	Namespaces []string `json:"namespaces"`
+	Verbs      []string `json:"verbs"`
+	// ExpiresIn is how long the token is valid for, e.g. 15m. Defaults to the max expiry.
+	ExpiresIn string `json:"expiresIn,omitempty"`
+}
+
+// TokenExchangeResponse is a token exchanged for an SSO session
+type TokenExchangeResponse struct {
+	// Token is the value of the Authorization header to use the token with
+	Token string `json:"token"`
+	// ID identifies the token in the audit log
+	ID        string    `json:"id"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// HandleTokenExchange exchanges the SSO session of the Authorization header for a short-lived token that is restricted to
+// namespaces and verbs, for automation to use instead of a service account token. The token has the identity of the
+// session, and is logged with its ID both when it is exchanged and when it is used.
+//
+// The session must be in the Authorization header, rather than a cookie, so that this cannot be requested cross-site.
+func (s *sso) HandleTokenExchange(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if s.tokenExchangeMaxExpiry == 0 {
+		http.Error(w, "token exchange is not configured", http.StatusNotFound)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	authorization := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, Prefix) {
+		http.Error(w, "an SSO session is required in the Authorization header", http.StatusUnauthorized)
+		return
+	}
+	c, err := s.Authorize(authorization)
+	if err != nil {
+		s.logger.WithError(err).Info(ctx, "failed to authorize token exchange")
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+	if c.Scope != nil {
+		http.Error(w, "exchanged tokens cannot be exchanged", http.StatusForbidden)
+		return
+	}
+
+	req := &TokenExchangeRequest{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request: %v", err), http.StatusBadRequest)
+		return
+	}
+	scope := &types.Scope{Namespaces: req.Namespaces, Verbs: req.Verbs}
+	if err := scope.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	expiresIn := s.tokenExchangeMaxExpiry
+	if req.ExpiresIn != "" {
+		d, err := time.ParseDuration(req.ExpiresIn)
+		if err != nil || d <= 0 || d > s.tokenExchangeMaxExpiry {
+			http.Error(w, fmt.Sprintf("expiresIn must be a duration greater than zero and at most %v", s.tokenExchangeMaxExpiry), http.StatusBadRequest)
+			return
+		}
+		expiresIn = d
+	}
+	now := time.Now()
+	expiresAt := now.Add(expiresIn)
+	// the token must not outlive the session
+	if c.Expiry != nil && expiresAt.After(c.Expiry.Time()) {
+		expiresAt = c.Expiry.Time()
+	}
+
+	id := uuid.NewString()
+	raw, err := jwt.Encrypted(s.encrypter).Claims(&types.Claims{
+		Claims: jwt.Claims{
+			Issuer:   issuer,
+			Subject:  c.Subject,
+			ID:       id,
+			IssuedAt: jwt.NewNumericDate(now),
+			Expiry:   jwt.NewNumericDate(expiresAt),
+		},
+		Groups:                  c.Groups,
+		Email:                   c.Email,
+		EmailVerified:           c.EmailVerified,
+		Name:                    c.Name,
+		ServiceAccountName:      c.ServiceAccountName,
+		PreferredUsername:       c.PreferredUsername,
+		ServiceAccountNamespace: c.ServiceAccountNamespace,
+		Scope:                   scope,
+	}).CompactSerialize()
+	if err != nil {
+		s.logger.WithError(err).Error(ctx, "failed to encrypt and serialize the exchanged token")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	// important! write an audit entry (i.e. log entry) so we know which user the token was exchanged for
+	s.logger.WithFields(logging.Fields{
+		"tokenId":    id,
+		"subject":    c.Subject,
+		"email":      c.Email,
+		"namespaces": scope.Namespaces,
+		"verbs":      scope.Verbs,
+		"expiresAt":  expiresAt.UTC(),
+	}).Info(ctx, "exchanged SSO session for token")
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(TokenExchangeResponse{Token: Prefix + raw, ID: id, ExpiresAt: expiresAt.UTC().Truncate(time.Second)})
+}
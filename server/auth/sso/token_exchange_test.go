@@ -0,0 +1,97 @@
+package sso
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/server/auth/types"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func newTokenExchangeSso(t *testing.T, tokenExchange *config.SSOTokenExchange) *sso {
+	fakeClient := fake.NewSimpleClientset(ssoConfigSecret).CoreV1().Secrets(testNamespace)
+	ssoInterface, err := newSso(logging.TestContext(t.Context()), fakeOidcFactory, Config{
+		Issuer:        "https://test-issuer",
+		ClientID:      getSecretKeySelector("argo-sso-secret", "client-id"),
+		ClientSecret:  getSecretKeySelector("argo-sso-secret", "client-secret"),
+		RedirectURL:   "https://dummy",
+		TokenExchange: tokenExchange,
+	}, fakeClient, "/", false)
+	require.NoError(t, err)
+	return ssoInterface.(*sso)
+}
+
+func sessionAuthorization(t *testing.T, s *sso, claims *types.Claims) string {
+	raw, err := jwt.Encrypted(s.encrypter).Claims(claims).CompactSerialize()
+	require.NoError(t, err)
+	return Prefix + raw
+}
+
+func exchangeToken(s *sso, authorization, body string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/oauth2/token-exchange", strings.NewReader(body))
+	r.Header.Set("Authorization", authorization)
+	s.HandleTokenExchange(recorder, r)
+	return recorder
+}
+
+func TestHandleTokenExchange(t *testing.T) {
+	session := &types.Claims{
+		Claims: jwt.Claims{Issuer: issuer, Subject: "my-subject", Expiry: jwt.NewNumericDate(time.Now().Add(time.Hour))},
+		Groups: []string{"my-group"},
+		Email:  "me@example.com",
+	}
+	t.Run("NotConfigured", func(t *testing.T) {
+		s := newTokenExchangeSso(t, nil)
+		assert.Equal(t, http.StatusNotFound, exchangeToken(s, sessionAuthorization(t, s, session), `{}`).Code)
+	})
+	t.Run("Exchanged", func(t *testing.T) {
+		s := newTokenExchangeSso(t, &config.SSOTokenExchange{})
+		recorder := exchangeToken(s, sessionAuthorization(t, s, session), `{"namespaces":["my-ns"],"verbs":["create","get"],"expiresIn":"10m"}`)
+		require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+		var response TokenExchangeResponse
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&response))
+		assert.NotEmpty(t, response.ID)
+		assert.WithinDuration(t, time.Now().Add(10*time.Minute), response.ExpiresAt, 2*time.Second)
+
+		claims, err := s.Authorize(response.Token)
+		require.NoError(t, err)
+		assert.Equal(t, response.ID, claims.ID)
+		assert.Equal(t, "my-subject", claims.Subject)
+		assert.Equal(t, "me@example.com", claims.Email)
+		assert.Equal(t, []string{"my-group"}, claims.Groups)
+		assert.Equal(t, &types.Scope{Namespaces: []string{"my-ns"}, Verbs: []string{"create", "get"}}, claims.Scope)
+
+		t.Run("Reexchanged", func(t *testing.T) {
+			assert.Equal(t, http.StatusForbidden, exchangeToken(s, response.Token, `{"namespaces":["my-ns"],"verbs":["get"]}`).Code)
+		})
+	})
+	t.Run("NotOutlivingSession", func(t *testing.T) {
+		s := newTokenExchangeSso(t, &config.SSOTokenExchange{})
+		expiring := *session
+		expiring.Expiry = jwt.NewNumericDate(time.Now().Add(time.Minute))
+		recorder := exchangeToken(s, sessionAuthorization(t, s, &expiring), `{"namespaces":["my-ns"],"verbs":["get"]}`)
+		require.Equal(t, http.StatusOK, recorder.Code)
+		var response TokenExchangeResponse
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&response))
+		assert.WithinDuration(t, time.Now().Add(time.Minute), response.ExpiresAt, 2*time.Second)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		s := newTokenExchangeSso(t, &config.SSOTokenExchange{})
+		authorization := sessionAuthorization(t, s, session)
+		assert.Equal(t, http.StatusUnauthorized, exchangeToken(s, "Bearer my-k8s-token", `{"namespaces":["my-ns"],"verbs":["get"]}`).Code)
+		assert.Equal(t, http.StatusBadRequest, exchangeToken(s, authorization, `{"verbs":["get"]}`).Code)
+		assert.Equal(t, http.StatusBadRequest, exchangeToken(s, authorization, `{"namespaces":["my-ns"],"verbs":["patch"]}`).Code)
+		assert.Equal(t, http.StatusBadRequest, exchangeToken(s, authorization, `{"namespaces":["my-ns"],"verbs":["get"],"expiresIn":"2h"}`).Code)
+	})
+}
@@ -4,20 +4,28 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"slices"
+	"strings"
 
+	"github.com/evilmonkeyinc/jsonpath"
 	"github.com/go-jose/go-jose/v3/jwt"
 )
 
 type Claims struct {
 	jwt.Claims
-	Groups                  []string               `json:"groups,omitempty"`
-	Email                   string                 `json:"email,omitempty"`
-	EmailVerified           bool                   `json:"-"`
-	Name                    string                 `json:"name,omitempty"`
-	ServiceAccountName      string                 `json:"service_account_name,omitempty"`
-	ServiceAccountNamespace string                 `json:"service_account_namespace,omitempty"`
-	PreferredUsername       string                 `json:"preferred_username,omitempty"`
-	RawClaim                map[string]interface{} `json:"-"`
+	Groups                  []string `json:"groups,omitempty"`
+	Email                   string   `json:"email,omitempty"`
+	EmailVerified           bool     `json:"-"`
+	Name                    string   `json:"name,omitempty"`
+	ServiceAccountName      string   `json:"service_account_name,omitempty"`
+	ServiceAccountNamespace string   `json:"service_account_namespace,omitempty"`
+	PreferredUsername       string   `json:"preferred_username,omitempty"`
+	// RefreshToken is the refresh token of an SSO session, used to refresh its groups
+	RefreshToken string `json:"refresh_token,omitempty"`
+	// Scope restricts a token exchanged for an SSO session
+	Scope    *Scope                 `json:"token_scope,omitempty"`
+	RawClaim map[string]interface{} `json:"-"`
 }
 
 type UserInfo struct {
@@ -85,13 +93,70 @@ func (c *Claims) GetCustomGroup(customKeyName string) ([]string, error) {
 	return newSlice, nil
 }
 
-func (c *Claims) GetUserInfoGroups(httpClient HTTPClient, accessToken, issuer, userInfoPath string) ([]string, error) {
-	url := fmt.Sprintf("%s%s", issuer, userInfoPath)
-	request, err := http.NewRequest("GET", url, nil)
+// GetGroupsFromPath is responsible for extracting groups from the claim at the JSONPath, e.g.
+// `resource_access.argo.roles`. Groups may be a string, or an array of strings.
+func (c *Claims) GetGroupsFromPath(path string) ([]string, error) {
+	if !strings.HasPrefix(path, "$") {
+		path = "$." + path
+	}
+	groups, err := jsonpath.Query(path, c.RawClaim)
+	if err != nil {
+		return nil, fmt.Errorf("no claim found for path %s: %w", path, err)
+	}
+	return groupNames(groups)
+}
+
+func groupNames(groups interface{}) ([]string, error) {
+	switch v := groups.(type) {
+	case string:
+		return []string{v}, nil
+	case []interface{}:
+		newSlice := []string{}
+		for _, a := range v {
+			names, err := groupNames(a)
+			if err != nil {
+				return nil, err
+			}
+			newSlice = append(newSlice, names...)
+		}
+		return newSlice, nil
+	default:
+		return nil, fmt.Errorf("group name %v was not a string", groups)
+	}
+}
 
+// maxUserInfoPages is the most pages of groups read from the user info endpoint
+const maxUserInfoPages = 100
+
+// GetUserInfoGroups reads the groups from the user info endpoint, following the `Link` headers of paginated responses
+// to the next page, which must be on the same host.
+func (c *Claims) GetUserInfoGroups(httpClient HTTPClient, accessToken, issuer, userInfoPath string) ([]string, error) {
+	pageURL, err := url.Parse(fmt.Sprintf("%s%s", issuer, userInfoPath))
 	if err != nil {
 		return nil, err
 	}
+	var groups []string
+	for page := 0; pageURL != nil; page++ {
+		if page == maxUserInfoPages {
+			return nil, fmt.Errorf("user info has more than %d pages of groups", maxUserInfoPages)
+		}
+		var pageGroups []string
+		pageGroups, pageURL, err = getUserInfoPage(httpClient, accessToken, pageURL)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, pageGroups...)
+	}
+	return groups, nil
+}
+
+// getUserInfoPage returns the groups of the page, and the URL of the next page if any
+func getUserInfoPage(httpClient HTTPClient, accessToken string, pageURL *url.URL) ([]string, *url.URL, error) {
+	request, err := http.NewRequest("GET", pageURL.String(), nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
 
 	bearer := fmt.Sprintf("Bearer %s", accessToken)
 	request.Header.Set("Authorization", bearer)
@@ -99,7 +164,7 @@ func (c *Claims) GetUserInfoGroups(httpClient HTTPClient, accessToken, issuer, u
 	response, err := httpClient.Do(request)
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	userInfo := UserInfo{}
@@ -108,8 +173,34 @@ func (c *Claims) GetUserInfoGroups(httpClient HTTPClient, accessToken, issuer, u
 	err = json.NewDecoder(response.Body).Decode(&userInfo)
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return userInfo.Groups, nil
+	next, err := nextPageURL(response.Header, pageURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	return userInfo.Groups, next, nil
+}
+
+// nextPageURL returns the URL of the `rel="next"` link of the header, e.g. `Link: </userinfo?page=2>; rel="next"`
+func nextPageURL(header http.Header, pageURL *url.URL) (*url.URL, error) {
+	for _, value := range header.Values("Link") {
+		for _, link := range strings.Split(value, ",") {
+			target, params, ok := strings.Cut(strings.TrimSpace(link), ";")
+			if !ok || !slices.Contains(strings.Fields(strings.ReplaceAll(params, ";", " ")), `rel="next"`) {
+				continue
+			}
+			next, err := pageURL.Parse(strings.Trim(strings.TrimSpace(target), "<>"))
+			if err != nil {
+				return nil, fmt.Errorf("invalid next page link %s: %w", target, err)
+			}
+			// the access token is sent to the next page, so it must not be on another host
+			if next.Scheme != pageURL.Scheme || next.Host != pageURL.Host {
+				return nil, fmt.Errorf("next page link %s is not on host %s", next, pageURL.Host)
+			}
+			return next, nil
+		}
+	}
+	return nil, nil
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/go-jose/go-jose/v3/jwt"
@@ -218,6 +219,29 @@ func TestGetCustomGroup(t *testing.T) {
 	})
 }
 
+func TestGetGroupsFromPath(t *testing.T) {
+	claims := &Claims{RawClaim: map[string]interface{}{
+		"resource_access": map[string]interface{}{
+			"argo":  map[string]interface{}{"roles": []interface{}{"admin", "viewer"}},
+			"other": map[string]interface{}{"roles": []interface{}{"other"}},
+		},
+		"team": "my-team",
+	}}
+	groups, err := claims.GetGroupsFromPath("resource_access.argo.roles")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"admin", "viewer"}, groups)
+	groups, err = claims.GetGroupsFromPath("$.team")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"my-team"}, groups)
+	groups, err = claims.GetGroupsFromPath("$.resource_access.*.roles")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"admin", "viewer", "other"}, groups)
+	_, err = claims.GetGroupsFromPath("resource_access.missing.roles")
+	require.ErrorContains(t, err, "no claim found for path $.resource_access.missing.roles")
+	_, err = (&Claims{RawClaim: map[string]interface{}{"groups": []interface{}{1.0}}}).GetGroupsFromPath("groups")
+	require.EqualError(t, err, "group name 1 was not a string")
+}
+
 type HTTPClientMock struct {
 	StatusCode int
 	Body       io.ReadCloser
@@ -243,4 +267,32 @@ func TestGetUserInfoGroups(t *testing.T) {
 		assert.Equal(t, []string{"Everyone"}, groups)
 		require.NoError(t, err)
 	})
+	t.Run("Paginated", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer my-token", r.Header.Get("Authorization"))
+			switch r.URL.Query().Get("page") {
+			case "":
+				w.Header().Add("Link", `</user-info?page=2>; rel="next", </user-info>; rel="first"`)
+				_ = json.NewEncoder(w).Encode(UserInfo{Groups: []string{"a"}})
+			case "2":
+				w.Header().Add("Link", `<`+"http://"+r.Host+`/user-info?page=3>; rel="next"`)
+				_ = json.NewEncoder(w).Encode(UserInfo{Groups: []string{"b"}})
+			default:
+				_ = json.NewEncoder(w).Encode(UserInfo{Groups: []string{"c"}})
+			}
+		}))
+		defer server.Close()
+		groups, err := (&Claims{}).GetUserInfoGroups(server.Client(), "my-token", server.URL, "/user-info")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c"}, groups)
+	})
+	t.Run("PaginatedOtherHost", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Add("Link", `<https://evil.example.com/user-info?page=2>; rel="next"`)
+			_ = json.NewEncoder(w).Encode(UserInfo{Groups: []string{"a"}})
+		}))
+		defer server.Close()
+		_, err := (&Claims{}).GetUserInfoGroups(server.Client(), "my-token", server.URL, "/user-info")
+		require.ErrorContains(t, err, "is not on host")
+	})
 }
@@ -0,0 +1,153 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Verbs that a scope may allow
+const (
+	VerbGet    = "get"
+	VerbList   = "list"
+	VerbWatch  = "watch"
+	VerbCreate = "create"
+	VerbUpdate = "update"
+	VerbDelete = "delete"
+)
+
+var verbs = []string{VerbGet, VerbList, VerbWatch, VerbCreate, VerbUpdate, VerbDelete}
+
+// Scope restricts the namespaces and verbs of the requests a token may be used for
+type Scope struct {
+	Namespaces []string `json:"namespaces"`
+	Verbs      []string `json:"verbs"`
+}
+
+func (s Scope) Validate() error {
+	if len(s.Namespaces) == 0 {
+		return fmt.Errorf("namespaces must not be empty")
+	}
+	if len(s.Verbs) == 0 {
+		return fmt.Errorf("verbs must not be empty")
+	}
+	for _, verb := range s.Verbs {
+		if !slices.Contains(verbs, verb) {
+			return fmt.Errorf("verb %q must be one of %v", verb, verbs)
+		}
+	}
+	return nil
+}
+
+// Allows returns an error if the scope does not allow the verb in the namespace. Requests that are not namespaced are
+// not allowed.
+func (s Scope) Allows(namespace, verb string) error {
+	if !slices.Contains(s.Namespaces, namespace) {
+		return fmt.Errorf("token is not allowed in namespace %q", namespace)
+	}
+	if !slices.Contains(s.Verbs, verb) {
+		return fmt.Errorf("token is not allowed to %s", verb)
+	}
+	return nil
+}
+
+// methodVerbs are the verbs of the gRPC methods of the API. A method that is not listed cannot be used with a scoped
+// token, so every new method must be added.
+var methodVerbs = map[string]string{
+	"/workflow.WorkflowService/CreateWorkflow":    VerbCreate,
+	"/workflow.WorkflowService/GetWorkflow":       VerbGet,
+	"/workflow.WorkflowService/ListWorkflows":     VerbList,
+	"/workflow.WorkflowService/WatchWorkflows":    VerbWatch,
+	"/workflow.WorkflowService/WatchEvents":       VerbWatch,
+	"/workflow.WorkflowService/DeleteWorkflow":    VerbDelete,
+	"/workflow.WorkflowService/RetryWorkflow":     VerbUpdate,
+	"/workflow.WorkflowService/ResubmitWorkflow":  VerbCreate,
+	"/workflow.WorkflowService/ResumeWorkflow":    VerbUpdate,
+	"/workflow.WorkflowService/SuspendWorkflow":   VerbUpdate,
+	"/workflow.WorkflowService/TerminateWorkflow": VerbUpdate,
+	"/workflow.WorkflowService/StopWorkflow":      VerbUpdate,
+	"/workflow.WorkflowService/SetWorkflow":       VerbUpdate,
+	"/workflow.WorkflowService/LintWorkflow":      VerbGet,
+	"/workflow.WorkflowService/PodLogs":           VerbGet,
+	"/workflow.WorkflowService/WorkflowLogs":      VerbGet,
+	"/workflow.WorkflowService/SubmitWorkflow":    VerbCreate,
+	"/workflow.WorkflowService/DiffWorkflows":     VerbGet,
+
+	"/workflowtemplate.WorkflowTemplateService/CreateWorkflowTemplate":        VerbCreate,
+	"/workflowtemplate.WorkflowTemplateService/GetWorkflowTemplate":           VerbGet,
+	"/workflowtemplate.WorkflowTemplateService/ListWorkflowTemplates":         VerbList,
+	"/workflowtemplate.WorkflowTemplateService/UpdateWorkflowTemplate":        VerbUpdate,
+	"/workflowtemplate.WorkflowTemplateService/DeleteWorkflowTemplate":        VerbDelete,
+	"/workflowtemplate.WorkflowTemplateService/LintWorkflowTemplate":          VerbGet,
+	"/workflowtemplate.WorkflowTemplateService/ListWorkflowTemplateRevisions": VerbList,
+
+	"/clusterworkflowtemplate.ClusterWorkflowTemplateService/CreateClusterWorkflowTemplate":        VerbCreate,
+	"/clusterworkflowtemplate.ClusterWorkflowTemplateService/GetClusterWorkflowTemplate":           VerbGet,
+	"/clusterworkflowtemplate.ClusterWorkflowTemplateService/ListClusterWorkflowTemplates":         VerbList,
+	"/clusterworkflowtemplate.ClusterWorkflowTemplateService/UpdateClusterWorkflowTemplate":        VerbUpdate,
+	"/clusterworkflowtemplate.ClusterWorkflowTemplateService/DeleteClusterWorkflowTemplate":        VerbDelete,
+	"/clusterworkflowtemplate.ClusterWorkflowTemplateService/LintClusterWorkflowTemplate":          VerbGet,
+	"/clusterworkflowtemplate.ClusterWorkflowTemplateService/ListClusterWorkflowTemplateRevisions": VerbList,
+
+	"/cronworkflow.CronWorkflowService/LintCronWorkflow":    VerbGet,
+	"/cronworkflow.CronWorkflowService/CreateCronWorkflow":  VerbCreate,
+	"/cronworkflow.CronWorkflowService/ListCronWorkflows":   VerbList,
+	"/cronworkflow.CronWorkflowService/GetCronWorkflow":     VerbGet,
+	"/cronworkflow.CronWorkflowService/UpdateCronWorkflow":  VerbUpdate,
+	"/cronworkflow.CronWorkflowService/DeleteCronWorkflow":  VerbDelete,
+	"/cronworkflow.CronWorkflowService/ResumeCronWorkflow":  VerbUpdate,
+	"/cronworkflow.CronWorkflowService/SuspendCronWorkflow": VerbUpdate,
+
+	// retrying or resubmitting an archived workflow creates a workflow
+	"/workflowarchive.ArchivedWorkflowService/ListArchivedWorkflows":           VerbList,
+	"/workflowarchive.ArchivedWorkflowService/GetArchivedWorkflow":             VerbGet,
+	"/workflowarchive.ArchivedWorkflowService/DeleteArchivedWorkflow":          VerbDelete,
+	"/workflowarchive.ArchivedWorkflowService/ListArchivedWorkflowLabelKeys":   VerbList,
+	"/workflowarchive.ArchivedWorkflowService/ListArchivedWorkflowLabelValues": VerbList,
+	"/workflowarchive.ArchivedWorkflowService/RetryArchivedWorkflow":           VerbCreate,
+	"/workflowarchive.ArchivedWorkflowService/ResubmitArchivedWorkflow":        VerbCreate,
+
+	// receiving or replaying an event submits workflows
+	"/event.EventService/ReceiveEvent":              VerbCreate,
+	"/event.EventService/ListWorkflowEventBindings": VerbList,
+	"/event.EventService/ListArchivedEvents":        VerbList,
+	"/event.EventService/ReplayArchivedEvent":       VerbCreate,
+
+	"/eventsource.EventSourceService/CreateEventSource": VerbCreate,
+	"/eventsource.EventSourceService/GetEventSource":    VerbGet,
+	"/eventsource.EventSourceService/DeleteEventSource": VerbDelete,
+	"/eventsource.EventSourceService/UpdateEventSource": VerbUpdate,
+	"/eventsource.EventSourceService/ListEventSources":  VerbList,
+	"/eventsource.EventSourceService/EventSourcesLogs":  VerbGet,
+	"/eventsource.EventSourceService/WatchEventSources": VerbWatch,
+
+	"/sensor.SensorService/ListSensors":  VerbList,
+	"/sensor.SensorService/SensorsLogs":  VerbGet,
+	"/sensor.SensorService/WatchSensors": VerbWatch,
+	"/sensor.SensorService/CreateSensor": VerbCreate,
+	"/sensor.SensorService/UpdateSensor": VerbUpdate,
+	"/sensor.SensorService/DeleteSensor": VerbDelete,
+	"/sensor.SensorService/GetSensor":    VerbGet,
+
+	"/executorplugin.ExecutorPluginService/ListExecutorPlugins":   VerbList,
+	"/executorplugin.ExecutorPluginService/GetExecutorPlugin":     VerbGet,
+	"/executorplugin.ExecutorPluginService/EnableExecutorPlugin":  VerbUpdate,
+	"/executorplugin.ExecutorPluginService/DisableExecutorPlugin": VerbUpdate,
+
+	"/memoizationcache.MemoizationCacheService/ListCacheEntries": VerbList,
+	"/memoizationcache.MemoizationCacheService/DeleteCacheEntry": VerbDelete,
+
+	"/info.InfoService/GetInfo":      VerbGet,
+	"/info.InfoService/GetVersion":   VerbGet,
+	"/info.InfoService/GetUserInfo":  VerbGet,
+	"/info.InfoService/CollectEvent": VerbCreate,
+}
+
+// VerbForMethod returns the verb of the gRPC method, e.g. "list" for "/workflow.WorkflowService/ListWorkflows", or an
+// error if the method is unknown, so that scoped tokens cannot be used for it.
+func VerbForMethod(fullMethod string) (string, error) {
+	verb, ok := methodVerbs[fullMethod]
+	if !ok {
+		return "", fmt.Errorf("token is not allowed to call %s", fullMethod)
+	}
+	return verb, nil
+}
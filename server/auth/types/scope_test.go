@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScope(t *testing.T) {
+	require.EqualError(t, Scope{}.Validate(), "namespaces must not be empty")
+	require.EqualError(t, Scope{Namespaces: []string{"my-ns"}}.Validate(), "verbs must not be empty")
+	require.EqualError(t, Scope{Namespaces: []string{"my-ns"}, Verbs: []string{"patch"}}.Validate(), `verb "patch" must be one of [get list watch create update delete]`)
+
+	s := Scope{Namespaces: []string{"my-ns"}, Verbs: []string{"get", "list"}}
+	require.NoError(t, s.Validate())
+	require.NoError(t, s.Allows("my-ns", "list"))
+	require.EqualError(t, s.Allows("my-ns", "create"), "token is not allowed to create")
+	require.EqualError(t, s.Allows("other-ns", "get"), `token is not allowed in namespace "other-ns"`)
+}
+
+func TestVerbForMethod(t *testing.T) {
+	for method, verb := range map[string]string{
+		"/workflow.WorkflowService/GetWorkflow":                            VerbGet,
+		"/workflow.WorkflowService/WorkflowLogs":                           VerbGet,
+		"/workflow.WorkflowService/LintWorkflow":                           VerbGet,
+		"/workflow.WorkflowService/DiffWorkflows":                          VerbGet,
+		"/workflow.WorkflowService/ListWorkflows":                          VerbList,
+		"/workflow.WorkflowService/WatchWorkflows":                         VerbWatch,
+		"/workflow.WorkflowService/CreateWorkflow":                         VerbCreate,
+		"/workflow.WorkflowService/SubmitWorkflow":                         VerbCreate,
+		"/workflow.WorkflowService/ResubmitWorkflow":                       VerbCreate,
+		"/event.EventService/ReceiveEvent":                                 VerbCreate,
+		"/event.EventService/ReplayArchivedEvent":                          VerbCreate,
+		"/workflowarchive.ArchivedWorkflowService/RetryArchivedWorkflow":   VerbCreate,
+		"/workflow.WorkflowService/DeleteWorkflow":                         VerbDelete,
+		"/workflow.WorkflowService/RetryWorkflow":                          VerbUpdate,
+		"/workflowtemplate.WorkflowTemplateService/UpdateWorkflowTemplate": VerbUpdate,
+	} {
+		actual, err := VerbForMethod(method)
+		require.NoError(t, err, method)
+		assert.Equal(t, verb, actual, method)
+	}
+	_, err := VerbForMethod("/workflow.WorkflowService/FooWorkflow")
+	require.EqualError(t, err, "token is not allowed to call /workflow.WorkflowService/FooWorkflow")
+}
@@ -14,6 +14,7 @@ import (
 	servertypes "github.com/argoproj/argo-workflows/v3/server/types"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/workflow/creator"
+	"github.com/argoproj/argo-workflows/v3/workflow/templaterevision"
 	"github.com/argoproj/argo-workflows/v3/workflow/validate"
 
 	serverutils "github.com/argoproj/argo-workflows/v3/server/utils"
@@ -23,13 +24,15 @@ type ClusterWorkflowTemplateServer struct {
 	instanceIDService instanceid.Service
 	cwftmplStore      servertypes.ClusterWorkflowTemplateStore
 	wfDefaults        *v1alpha1.Workflow
+	// namespace is where the controller records the revisions of templates
+	namespace string
 }
 
-func NewClusterWorkflowTemplateServer(instanceID instanceid.Service, cwftmplStore servertypes.ClusterWorkflowTemplateStore, wfDefaults *v1alpha1.Workflow) clusterwftmplpkg.ClusterWorkflowTemplateServiceServer {
+func NewClusterWorkflowTemplateServer(instanceID instanceid.Service, cwftmplStore servertypes.ClusterWorkflowTemplateStore, wfDefaults *v1alpha1.Workflow, namespace string) clusterwftmplpkg.ClusterWorkflowTemplateServiceServer {
 	if cwftmplStore == nil {
 		cwftmplStore = NewClusterWorkflowTemplateClientStore()
 	}
-	return &ClusterWorkflowTemplateServer{instanceID, cwftmplStore, wfDefaults}
+	return &ClusterWorkflowTemplateServer{instanceID, cwftmplStore, wfDefaults, namespace}
 }
 
 func (cwts *ClusterWorkflowTemplateServer) CreateClusterWorkflowTemplate(ctx context.Context, req *clusterwftmplpkg.ClusterWorkflowTemplateCreateRequest) (*v1alpha1.ClusterWorkflowTemplate, error) {
@@ -102,6 +105,14 @@ func (cwts *ClusterWorkflowTemplateServer) DeleteClusterWorkflowTemplate(ctx con
 	return &clusterwftmplpkg.ClusterWorkflowTemplateDeleteResponse{}, nil
 }
 
+func (cwts *ClusterWorkflowTemplateServer) ListClusterWorkflowTemplateRevisions(ctx context.Context, req *clusterwftmplpkg.ClusterWorkflowTemplateRevisionsRequest) (*v1alpha1.WorkflowTemplateRevisionList, error) {
+	revisions, err := templaterevision.List(ctx, auth.GetKubeClient(ctx), cwts.namespace, req.Name, true)
+	if err != nil {
+		return nil, serverutils.ToStatusError(err, codes.Internal)
+	}
+	return revisions, nil
+}
+
 func (cwts *ClusterWorkflowTemplateServer) LintClusterWorkflowTemplate(ctx context.Context, req *clusterwftmplpkg.ClusterWorkflowTemplateLintRequest) (*v1alpha1.ClusterWorkflowTemplate, error) {
 	cwts.instanceIDService.Label(req.Template)
 	creator.LabelCreator(ctx, req.Template)
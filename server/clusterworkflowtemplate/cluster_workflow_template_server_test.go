@@ -157,7 +157,7 @@ func getClusterWorkflowTemplateServer(t *testing.T) (clusterwftmplpkg.ClusterWor
 	ctx := context.WithValue(logging.TestContext(t.Context()), auth.WfKey, wfClientset)
 	ctx = context.WithValue(ctx, auth.KubeKey, kubeClientSet)
 	ctx = context.WithValue(ctx, auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}, Email: "my-sub@your.org"})
-	return NewClusterWorkflowTemplateServer(instanceid.NewService("my-instanceid"), nil, nil), ctx
+	return NewClusterWorkflowTemplateServer(instanceid.NewService("my-instanceid"), nil, nil, "argo"), ctx
 }
 
 func TestWorkflowTemplateServer_CreateClusterWorkflowTemplate(t *testing.T) {
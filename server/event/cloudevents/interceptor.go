@@ -0,0 +1,138 @@
+package cloudevents
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	pathPrefix      = "/api/v1/cloudevents/"
+	eventPathPrefix = "/api/v1/events/"
+	headerPrefix    = "Ce-"
+	structuredType  = "application/cloudevents+json"
+	batchType       = "application/cloudevents-batch+json"
+)
+
+// the attributes every CloudEvent must have, https://github.com/cloudevents/spec/blob/v1.0.2/cloudevents/spec.md#required-attributes
+var requiredAttributes = []string{"specversion", "id", "source", "type"}
+
+// Interceptor converts a CloudEvent sent to `/api/v1/cloudevents/{namespace}` into a request to the event API:
+// the event's type becomes the discriminator, and the event itself (attributes and data) becomes the payload.
+// Both binary and structured content modes are supported. Request bodies larger than maxBodySize are rejected.
+func Interceptor(w http.ResponseWriter, r *http.Request, next http.Handler, maxBodySize int64) {
+	if !strings.HasPrefix(r.URL.Path, pathPrefix) {
+		next.ServeHTTP(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	namespace := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, pathPrefix), "/")
+	if namespace == "" || strings.Contains(namespace, "/") {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodySize)
+	event, err := parse(r)
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		writeError(w, http.StatusRequestEntityTooLarge, err.Error())
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	r.URL.Path = eventPathPrefix + namespace + "/" + event["type"].(string)
+	r.URL.RawPath = ""
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	r.ContentLength = int64(len(body))
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	next.ServeHTTP(w, r)
+}
+
+// parse reads the CloudEvent from the request, returning it in the structured (JSON) format
+func parse(r *http.Request) (map[string]interface{}, error) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	var event map[string]interface{}
+	switch mediaType {
+	case batchType:
+		return nil, fmt.Errorf("batched CloudEvents are not supported")
+	case structuredType:
+		if err := json.Unmarshal(data, &event); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal structured CloudEvent: %w", err)
+		}
+	default:
+		event = binaryEvent(r.Header, mediaType, data)
+	}
+	for _, name := range requiredAttributes {
+		if v, ok := event[name].(string); !ok || v == "" {
+			return nil, fmt.Errorf("CloudEvent attribute %q is required", name)
+		}
+	}
+	if v := event["specversion"]; v != "1.0" {
+		return nil, fmt.Errorf("CloudEvent specversion %q is not supported", v)
+	}
+	if strings.Contains(event["type"].(string), "/") {
+		return nil, fmt.Errorf("CloudEvent type %q must not contain '/'", event["type"])
+	}
+	return event, nil
+}
+
+// binaryEvent creates an event from the `ce-` headers, with the request body as the event data
+func binaryEvent(header http.Header, mediaType string, data []byte) map[string]interface{} {
+	event := map[string]interface{}{}
+	for k, v := range header {
+		if strings.HasPrefix(k, headerPrefix) && len(v) > 0 && len(k) > len(headerPrefix) {
+			event[strings.ToLower(strings.TrimPrefix(k, headerPrefix))] = v[0]
+		}
+	}
+	if mediaType != "" {
+		event["datacontenttype"] = header.Get("Content-Type")
+	}
+	if len(data) == 0 {
+		return event
+	}
+	var v interface{}
+	switch {
+	case isJSON(mediaType) && json.Unmarshal(data, &v) == nil:
+		event["data"] = v
+	case utf8.Valid(data):
+		event["data"] = string(data)
+	default:
+		event["data_base64"] = base64.StdEncoding.EncodeToString(data)
+	}
+	return event
+}
+
+// isJSON returns true if the media type is JSON, or not specified, which the spec says implies JSON
+func isJSON(mediaType string) bool {
+	return mediaType == "" || mediaType == "application/json" || mediaType == "text/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	data, _ := json.Marshal(map[string]string{"message": message})
+	_, _ = w.Write(data)
+}
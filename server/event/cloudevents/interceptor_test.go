@@ -0,0 +1,126 @@
+package cloudevents
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingHandler struct {
+	path string
+	body string
+}
+
+func (h *recordingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.path = r.URL.Path
+	data, _ := io.ReadAll(r.Body)
+	h.body = string(data)
+}
+
+const testMaxBodySize = 1024
+
+func intercept(method, path, body string, headers map[string]string) (*recordingHandler, *httptest.ResponseRecorder) {
+	r := httptest.NewRequest(method, path, strings.NewReader(body))
+	for k, v := range headers {
+		r.Header.Set(k, v)
+	}
+	w := httptest.NewRecorder()
+	h := &recordingHandler{}
+	Interceptor(w, r, h, testMaxBodySize)
+	return h, w
+}
+
+func TestInterceptor(t *testing.T) {
+	binaryHeaders := func(contentType string) map[string]string {
+		return map[string]string{
+			"Ce-Specversion": "1.0",
+			"Ce-Id":          "1",
+			"Ce-Source":      "/my-source",
+			"Ce-Type":        "com.example.created",
+			"Ce-Subject":     "my-subject",
+			"Content-Type":   contentType,
+		}
+	}
+	t.Run("OtherPath", func(t *testing.T) {
+		h, _ := intercept("POST", "/api/v1/events/my-ns/my-d", `{}`, nil)
+		assert.Equal(t, "/api/v1/events/my-ns/my-d", h.path)
+		assert.JSONEq(t, `{}`, h.body)
+	})
+	t.Run("WrongMethod", func(t *testing.T) {
+		h, w := intercept("GET", "/api/v1/cloudevents/my-ns", "", nil)
+		assert.Empty(t, h.path)
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+	t.Run("TooLarge", func(t *testing.T) {
+		h, w := intercept("POST", "/api/v1/cloudevents/my-ns", strings.Repeat("x", testMaxBodySize+1), binaryHeaders("text/plain"))
+		assert.Empty(t, h.path)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+	t.Run("NoNamespace", func(t *testing.T) {
+		h, w := intercept("POST", "/api/v1/cloudevents/", "", binaryHeaders(""))
+		assert.Empty(t, h.path)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+	t.Run("Binary", func(t *testing.T) {
+		h, w := intercept("POST", "/api/v1/cloudevents/my-ns", `{"foo": "bar"}`, binaryHeaders("application/json"))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "/api/v1/events/my-ns/com.example.created", h.path)
+		assert.JSONEq(t, `{
+  "specversion": "1.0",
+  "id": "1",
+  "source": "/my-source",
+  "type": "com.example.created",
+  "subject": "my-subject",
+  "datacontenttype": "application/json",
+  "data": {"foo": "bar"}
+}`, h.body)
+	})
+	t.Run("BinaryText", func(t *testing.T) {
+		h, _ := intercept("POST", "/api/v1/cloudevents/my-ns/", `hello`, binaryHeaders("text/plain"))
+		assert.Equal(t, "/api/v1/events/my-ns/com.example.created", h.path)
+		assert.Contains(t, h.body, `"data":"hello"`)
+	})
+	t.Run("BinaryBytes", func(t *testing.T) {
+		h, _ := intercept("POST", "/api/v1/cloudevents/my-ns", "\xff\xfe", binaryHeaders("application/octet-stream"))
+		assert.Contains(t, h.body, `"data_base64":"//4="`)
+	})
+	t.Run("BinaryMissingAttribute", func(t *testing.T) {
+		headers := binaryHeaders("application/json")
+		delete(headers, "Ce-Id")
+		h, w := intercept("POST", "/api/v1/cloudevents/my-ns", `{}`, headers)
+		assert.Empty(t, h.path)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.JSONEq(t, `{"message": "CloudEvent attribute \"id\" is required"}`, w.Body.String())
+	})
+	t.Run("Structured", func(t *testing.T) {
+		body := `{"specversion": "1.0", "id": "1", "source": "/my-source", "type": "com.example.created", "data": {"foo": "bar"}}`
+		h, w := intercept("POST", "/api/v1/cloudevents/my-ns", body, map[string]string{"Content-Type": "application/cloudevents+json; charset=utf-8"})
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "/api/v1/events/my-ns/com.example.created", h.path)
+		assert.JSONEq(t, body, h.body)
+	})
+	t.Run("StructuredInvalid", func(t *testing.T) {
+		_, w := intercept("POST", "/api/v1/cloudevents/my-ns", `{`, map[string]string{"Content-Type": "application/cloudevents+json"})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("UnsupportedSpecVersion", func(t *testing.T) {
+		body := `{"specversion": "0.3", "id": "1", "source": "/my-source", "type": "com.example.created"}`
+		_, w := intercept("POST", "/api/v1/cloudevents/my-ns", body, map[string]string{"Content-Type": "application/cloudevents+json"})
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "specversion")
+	})
+	t.Run("TypeWithSlash", func(t *testing.T) {
+		body := `{"specversion": "1.0", "id": "1", "source": "/my-source", "type": "a/b"}`
+		_, w := intercept("POST", "/api/v1/cloudevents/my-ns", body, map[string]string{"Content-Type": "application/cloudevents+json"})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Batch", func(t *testing.T) {
+		_, w := intercept("POST", "/api/v1/cloudevents/my-ns", `[]`, map[string]string{"Content-Type": "application/cloudevents-batch+json"})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,51 @@
+package dispatch
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// IdempotencyStore remembers the idempotency keys of recently dispatched events,
+// so that concurrent or repeated deliveries of the same event submit at most one workflow.
+type IdempotencyStore struct {
+	mu   sync.Mutex
+	keys map[string]time.Time
+	now  func() time.Time
+}
+
+func NewIdempotencyStore() *IdempotencyStore {
+	return &IdempotencyStore{keys: make(map[string]time.Time), now: time.Now}
+}
+
+// Claim records the key, returning false if the key has already been claimed within the window.
+func (s *IdempotencyStore) Claim(key string, window time.Duration) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	// expire old keys, so the store stays small
+	for k, expiry := range s.keys {
+		if !now.Before(expiry) {
+			delete(s.keys, k)
+		}
+	}
+	if _, ok := s.keys[key]; ok {
+		return false
+	}
+	s.keys[key] = now.Add(window)
+	return true
+}
+
+// Release forgets the key, e.g. because the workflow could not be submitted and the event should be retried.
+func (s *IdempotencyStore) Release(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.keys, key)
+}
+
+// idempotencyKeyHash returns a label-safe hash of the binding's idempotency key
+func idempotencyKeyHash(bindingName, key string) string {
+	h := sha256.Sum256([]byte(bindingName + "/" + key))
+	return hex.EncodeToString(h[:16])
+}
@@ -0,0 +1,22 @@
+package dispatch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdempotencyStore(t *testing.T) {
+	now := time.Now()
+	s := NewIdempotencyStore()
+	s.now = func() time.Time { return now }
+	assert.True(t, s.Claim("a", time.Minute))
+	assert.False(t, s.Claim("a", time.Minute))
+	assert.True(t, s.Claim("b", time.Minute))
+	s.Release("b")
+	assert.True(t, s.Claim("b", time.Minute))
+	now = now.Add(time.Minute)
+	assert.True(t, s.Claim("a", time.Minute))
+	assert.Len(t, s.keys, 1)
+}
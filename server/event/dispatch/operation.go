@@ -3,8 +3,10 @@ package dispatch
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/expr-lang/expr"
 	"google.golang.org/grpc/metadata"
@@ -22,6 +24,7 @@ import (
 	jsonutil "github.com/argoproj/argo-workflows/v3/util/json"
 	"github.com/argoproj/argo-workflows/v3/util/labels"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
 	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/creator"
@@ -33,6 +36,8 @@ type Operation struct {
 	ctx               context.Context
 	eventRecorder     record.EventRecorder
 	instanceIDService instanceid.Service
+	idempotencyStore  *IdempotencyStore
+	metrics           *telemetry.Metrics
 	events            []wfv1.WorkflowEventBinding
 	env               map[string]interface{}
 }
@@ -42,7 +47,7 @@ func (o *Operation) Context() context.Context {
 	return o.ctx
 }
 
-func NewOperation(ctx context.Context, instanceIDService instanceid.Service, eventRecorder record.EventRecorder, events []wfv1.WorkflowEventBinding, namespace, discriminator string, payload *wfv1.Item) (*Operation, error) {
+func NewOperation(ctx context.Context, instanceIDService instanceid.Service, eventRecorder record.EventRecorder, idempotencyStore *IdempotencyStore, metrics *telemetry.Metrics, events []wfv1.WorkflowEventBinding, namespace, discriminator string, payload *wfv1.Item) (*Operation, error) {
 	env, err := expressionEnvironment(ctx, namespace, discriminator, payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create workflow template expression environment: %w", err)
@@ -51,6 +56,8 @@ func NewOperation(ctx context.Context, instanceIDService instanceid.Service, eve
 		ctx:               ctx,
 		eventRecorder:     eventRecorder,
 		instanceIDService: instanceIDService,
+		idempotencyStore:  idempotencyStore,
+		metrics:           metrics,
 		events:            events,
 		env:               env,
 	}, nil
@@ -84,7 +91,7 @@ func (o *Operation) Dispatch(ctx context.Context) error {
 	return nil
 }
 
-func (o *Operation) dispatch(ctx context.Context, wfeb wfv1.WorkflowEventBinding) (*wfv1.Workflow, error) {
+func (o *Operation) dispatch(ctx context.Context, wfeb wfv1.WorkflowEventBinding) (_ *wfv1.Workflow, err error) {
 	logger := logging.RequireLoggerFromContext(ctx)
 
 	selector := wfeb.Spec.Event.Selector
@@ -97,9 +104,37 @@ func (o *Operation) dispatch(ctx context.Context, wfeb wfv1.WorkflowEventBinding
 	if matched && submit != nil {
 		// nolint: contextcheck
 		client := auth.GetWfClient(o.ctx)
+		if err := o.validatePayload(ctx, wfeb); err != nil {
+			var rejected *payloadRejectedError
+			if errors.As(err, &rejected) {
+				o.metrics.EventPayloadRejected(ctx, wfeb.Namespace, wfeb.Name)
+			}
+			return nil, err
+		}
+		var idempotencyKey string
+		if idempotency := wfeb.Spec.Event.Idempotency; idempotency != nil {
+			key, err := o.evaluateStringExpression(idempotency.Key, "idempotency key")
+			if err != nil {
+				return nil, err
+			}
+			idempotencyKey = idempotencyKeyHash(wfeb.Name, key)
+			duplicate, err := o.isDuplicate(ctx, wfeb.Namespace, wfeb.Name, idempotencyKey, idempotency.GetWindow())
+			if err != nil {
+				return nil, err
+			}
+			if duplicate {
+				logger.WithFields(logging.Fields{"namespace": wfeb.Namespace, "event": wfeb.Name, "idempotencyKey": idempotencyKey}).Info(ctx, "Ignoring duplicate event")
+				return nil, nil
+			}
+			// if we fail to submit, then forget the key so a re-delivered (or retried) event is not ignored
+			defer func() {
+				if err != nil && o.idempotencyStore != nil {
+					o.idempotencyStore.Release(idempotencyKey)
+				}
+			}()
+		}
 		ref := wfeb.Spec.Submit.WorkflowTemplateRef
 		var tmpl wfv1.WorkflowSpecHolder
-		var err error
 		if ref.ClusterScope {
 			tmpl, err = client.ArgoprojV1alpha1().ClusterWorkflowTemplates().Get(ctx, ref.Name, metav1.GetOptions{})
 		} else {
@@ -128,6 +163,9 @@ func (o *Operation) dispatch(ctx context.Context, wfeb wfv1.WorkflowEventBinding
 		// nolint: contextcheck
 		creator.LabelCreator(o.ctx, wf)
 		labels.Label(wf, common.LabelKeyWorkflowEventBinding, wfeb.Name)
+		if idempotencyKey != "" {
+			labels.Label(wf, common.LabelKeyEventIdempotencyKey, idempotencyKey)
+		}
 		if submit.Arguments != nil {
 			for _, p := range submit.Arguments.Parameters {
 				if p.ValueFrom == nil {
@@ -157,6 +195,30 @@ func (o *Operation) dispatch(ctx context.Context, wfeb wfv1.WorkflowEventBinding
 	return nil, nil
 }
 
+// isDuplicate returns true if a workflow has already been submitted for the idempotency key within the window,
+// either by this server (which we remember) or by another replica (which we find by label).
+func (o *Operation) isDuplicate(ctx context.Context, namespace, bindingName, idempotencyKey string, window time.Duration) (bool, error) {
+	if o.idempotencyStore != nil && !o.idempotencyStore.Claim(idempotencyKey, window) {
+		return true, nil
+	}
+	// nolint: contextcheck
+	list, err := auth.GetWfClient(o.ctx).ArgoprojV1alpha1().Workflows(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s,%s=%s", common.LabelKeyWorkflowEventBinding, bindingName, common.LabelKeyEventIdempotencyKey, idempotencyKey),
+	})
+	if err != nil {
+		if o.idempotencyStore != nil {
+			o.idempotencyStore.Release(idempotencyKey)
+		}
+		return false, fmt.Errorf("failed to list workflows for idempotency key: %w", err)
+	}
+	for _, wf := range list.Items {
+		if time.Since(wf.CreationTimestamp.Time) < window {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (o *Operation) populateWorkflowMetadata(wf *wfv1.Workflow, metadata *metav1.ObjectMeta) error {
 	if len(metadata.Name) > 0 {
 		evalName, err := o.evaluateStringExpression(metadata.Name, "name")
@@ -228,6 +290,15 @@ func expressionEnvironment(ctx context.Context, namespace, discriminator string,
 
 func metaData(ctx context.Context) map[string]interface{} {
 	meta := make(map[string]interface{})
+	for k, v := range EventMetadata(ctx) {
+		meta[k] = v
+	}
+	return meta
+}
+
+// EventMetadata returns the incoming headers that are made available to expressions as `metadata`
+func EventMetadata(ctx context.Context) metadata.MD {
+	meta := metadata.MD{}
 	md, _ := metadata.FromIncomingContext(ctx)
 	for k, v := range md {
 		// only allow headers `X-`  headers, e.g. `X-Github-Action`
@@ -10,8 +10,13 @@ import (
 	"github.com/go-jose/go-jose/v3/jwt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"google.golang.org/grpc/metadata"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/record"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -20,6 +25,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/server/auth/types"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
@@ -64,7 +70,7 @@ func TestNewOperation(t *testing.T) {
 	recorder := record.NewFakeRecorder(6)
 
 	// act
-	operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), recorder, []wfv1.WorkflowEventBinding{
+	operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), recorder, NewIdempotencyStore(), nil, []wfv1.WorkflowEventBinding{
 		// test a malformed binding
 		{
 			ObjectMeta: metav1.ObjectMeta{Name: "malformed", Namespace: "my-ns"},
@@ -211,7 +217,7 @@ func Test_populateWorkflowMetadata(t *testing.T) {
 	recorder := record.NewFakeRecorder(10)
 
 	// act
-	operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), recorder, []wfv1.WorkflowEventBinding{
+	operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), recorder, NewIdempotencyStore(), nil, []wfv1.WorkflowEventBinding{
 		{
 			// No name specified
 			ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb-1", Namespace: "my-ns"},
@@ -416,3 +422,125 @@ func Test_expressionEnvironment(t *testing.T) {
 	assert.Contains(t, env, "metadata")
 	assert.Equal(t, map[string]interface{}{"foo": "bar"}, env["payload"], "make sure we parse an object as a map")
 }
+
+func TestIdempotency(t *testing.T) {
+	binding := wfv1.WorkflowEventBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb", Namespace: "my-ns"},
+		Spec: wfv1.WorkflowEventBindingSpec{
+			Event: wfv1.Event{
+				Selector:    "true",
+				Idempotency: &wfv1.EventIdempotency{Key: `metadata["x-delivery-id"][0]`},
+			},
+			Submit: &wfv1.Submit{WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "my-wft"}},
+		},
+	}
+	setup := func(t *testing.T, objects ...runtime.Object) (context.Context, *fake.Clientset) {
+		client := fake.NewSimpleClientset(append(objects, &wfv1.WorkflowTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wft", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyControllerInstanceID: "my-instanceid"}},
+		})...)
+		ctx := context.WithValue(logging.TestContext(t.Context()), auth.WfKey, client)
+		ctx = context.WithValue(ctx, auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}})
+		return ctx, client
+	}
+	deliver := func(t *testing.T, ctx context.Context, store *IdempotencyStore, deliveryID string) {
+		ctx = metadata.NewIncomingContext(ctx, metadata.MD{"x-delivery-id": []string{deliveryID}})
+		operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), record.NewFakeRecorder(1), store, nil, []wfv1.WorkflowEventBinding{binding}, "my-ns", "", &wfv1.Item{})
+		require.NoError(t, err)
+		require.NoError(t, operation.Dispatch(ctx))
+	}
+	t.Run("Redelivery", func(t *testing.T) {
+		ctx, client := setup(t)
+		store := NewIdempotencyStore()
+		deliver(t, ctx, store, "1")
+		deliver(t, ctx, store, "1")
+		deliver(t, ctx, store, "2")
+		list, err := client.ArgoprojV1alpha1().Workflows("my-ns").List(ctx, metav1.ListOptions{})
+		require.NoError(t, err)
+		var keys []string
+		for _, wf := range list.Items {
+			keys = append(keys, wf.Labels[common.LabelKeyEventIdempotencyKey])
+		}
+		assert.ElementsMatch(t, []string{idempotencyKeyHash("my-wfeb", "1"), idempotencyKeyHash("my-wfeb", "2")}, keys)
+	})
+	t.Run("SubmittedByAnotherReplica", func(t *testing.T) {
+		ctx, client := setup(t, &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{
+			Name:              "existing",
+			Namespace:         "my-ns",
+			CreationTimestamp: metav1.Now(),
+			Labels: map[string]string{
+				common.LabelKeyWorkflowEventBinding: "my-wfeb",
+				common.LabelKeyEventIdempotencyKey:  idempotencyKeyHash("my-wfeb", "1"),
+			},
+		}})
+		deliver(t, ctx, NewIdempotencyStore(), "1")
+		list, err := client.ArgoprojV1alpha1().Workflows("my-ns").List(ctx, metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Len(t, list.Items, 1)
+	})
+}
+
+func TestPayloadSchema(t *testing.T) {
+	schema := `{"type": "object", "required": ["message"], "properties": {"message": {"type": "string"}}}`
+	client := fake.NewSimpleClientset(&wfv1.WorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wft", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyControllerInstanceID: "my-instanceid"}},
+	})
+	kubeClient := kubefake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-schema", Namespace: "my-ns"},
+		Data:       map[string]string{"schema.json": schema},
+	})
+	ctx := context.WithValue(logging.TestContext(t.Context()), auth.WfKey, client)
+	ctx = context.WithValue(ctx, auth.KubeKey, kubeClient)
+	ctx = context.WithValue(ctx, auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}})
+	te := telemetry.NewTestMetricsExporter()
+	metrics, err := telemetry.NewMetrics(ctx, telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, sdkmetric.WithReader(te))
+	require.NoError(t, err)
+	require.NoError(t, metrics.Populate(ctx, telemetry.AddEventMetrics))
+
+	bindings := []wfv1.WorkflowEventBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "inline", Namespace: "my-ns"},
+			Spec: wfv1.WorkflowEventBindingSpec{
+				Event:  wfv1.Event{Selector: "true", Schema: &wfv1.EventSchema{Inline: &wfv1.Object{Value: json.RawMessage(schema)}}},
+				Submit: &wfv1.Submit{WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "my-wft"}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "config-map", Namespace: "my-ns"},
+			Spec: wfv1.WorkflowEventBindingSpec{
+				Event: wfv1.Event{Selector: "true", Schema: &wfv1.EventSchema{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "my-schema"},
+					Key:                  "schema.json",
+				}}},
+				Submit: &wfv1.Submit{WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "my-wft"}},
+			},
+		},
+	}
+	dispatch := func(payload string) (*record.FakeRecorder, error) {
+		recorder := record.NewFakeRecorder(2)
+		operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), recorder, nil, metrics, bindings, "my-ns", "", &wfv1.Item{Value: json.RawMessage(payload)})
+		require.NoError(t, err)
+		return recorder, operation.Dispatch(ctx)
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		_, err := dispatch(`{"message": "hello"}`)
+		require.NoError(t, err)
+		list, err := client.ArgoprojV1alpha1().Workflows("my-ns").List(ctx, metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Len(t, list.Items, 2)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		recorder, err := dispatch(`{"message": 1}`)
+		require.Error(t, err)
+		assert.Contains(t, <-recorder.Events, "payload does not match schema: message: Invalid type. Expected: string, given: integer")
+		for _, name := range []string{"inline", "config-map"} {
+			attribs := attribute.NewSet(attribute.String(telemetry.AttribEventNamespace, "my-ns"), attribute.String(telemetry.AttribEventBindingName, name))
+			val, err := te.GetInt64CounterValue(ctx, telemetry.InstrumentEventPayloadRejected.Name(), &attribs)
+			require.NoError(t, err)
+			assert.Equal(t, int64(1), val)
+		}
+		list, err := client.ArgoprojV1alpha1().Workflows("my-ns").List(ctx, metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Len(t, list.Items, 2)
+	})
+}
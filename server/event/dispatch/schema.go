@@ -0,0 +1,61 @@
+package dispatch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+)
+
+// validatePayload returns an error if the payload does not satisfy the binding's JSON Schema
+func (o *Operation) validatePayload(ctx context.Context, wfeb wfv1.WorkflowEventBinding) error {
+	schema := wfeb.Spec.Event.Schema
+	if schema == nil {
+		return nil
+	}
+	var loader gojsonschema.JSONLoader
+	switch {
+	case schema.Inline != nil:
+		loader = gojsonschema.NewBytesLoader(schema.Inline.Value)
+	case schema.ConfigMapKeyRef != nil:
+		ref := schema.ConfigMapKeyRef
+		// nolint: contextcheck
+		cm, err := auth.GetKubeClient(o.ctx).CoreV1().ConfigMaps(wfeb.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get schema config map \"%s\": %w", ref.Name, err)
+		}
+		data, ok := cm.Data[ref.Key]
+		if !ok {
+			return fmt.Errorf("schema config map \"%s\" does not have key \"%s\"", ref.Name, ref.Key)
+		}
+		loader = gojsonschema.NewStringLoader(data)
+	default:
+		return fmt.Errorf("schema must have either inline or configMapKeyRef")
+	}
+	result, err := gojsonschema.Validate(loader, gojsonschema.NewGoLoader(o.env["payload"]))
+	if err != nil {
+		return fmt.Errorf("failed to validate payload against schema: %w", err)
+	}
+	if !result.Valid() {
+		var msgs []string
+		for _, e := range result.Errors() {
+			msgs = append(msgs, e.String())
+		}
+		return &payloadRejectedError{reasons: msgs}
+	}
+	return nil
+}
+
+// payloadRejectedError is returned when the payload does not satisfy the schema
+type payloadRejectedError struct {
+	reasons []string
+}
+
+func (e *payloadRejectedError) Error() string {
+	return "payload does not match schema: " + strings.Join(e.reasons, "; ")
+}
@@ -2,18 +2,31 @@ package event
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
 
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	eventpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/event"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
 	"github.com/argoproj/argo-workflows/v3/server/event/dispatch"
+	grpcutil "github.com/argoproj/argo-workflows/v3/util/grpc"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
 	"github.com/argoproj/argo-workflows/v3/workflow/events"
 
 	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
@@ -22,26 +35,44 @@ import (
 type Controller struct {
 	instanceIDService    instanceid.Service
 	eventRecorderManager events.EventRecorderManager
+	idempotencyStore     *dispatch.IdempotencyStore
+	eventArchive         sqldb.EventArchive
+	metrics              *telemetry.Metrics
+	quotas               *namespaceQuotas
 	// a channel for operations to be executed async on
-	operationQueue chan dispatch.Operation
+	operationQueue chan queuedOperation
 	workerCount    int
 	asyncDispatch  bool
 }
 
+// queuedOperation is an operation waiting to be dispatched, with the function to release its namespace's quota
+type queuedOperation struct {
+	dispatch.Operation
+	release func()
+}
+
 var _ eventpkg.EventServiceServer = &Controller{}
 
-func NewController(ctx context.Context, instanceIDService instanceid.Service, eventRecorderManager events.EventRecorderManager, operationQueueSize, workerCount int, asyncDispatch bool) *Controller {
+func NewController(ctx context.Context, instanceIDService instanceid.Service, eventRecorderManager events.EventRecorderManager, eventArchive sqldb.EventArchive, metrics *telemetry.Metrics, operationQueueSize, workerCount int, asyncDispatch bool, quota Quota) *Controller {
 	logger := logging.RequireLoggerFromContext(ctx)
-	logger.WithFields(logging.Fields{"workerCount": workerCount, "operationQueueSize": operationQueueSize, "asyncDispatch": asyncDispatch}).Info(ctx, "Creating event controller")
+	logger.WithFields(logging.Fields{"workerCount": workerCount, "operationQueueSize": operationQueueSize, "asyncDispatch": asyncDispatch, "maxInFlight": quota.MaxInFlight, "maxPerMinute": quota.MaxPerMinute}).Info(ctx, "Creating event controller")
 
-	return &Controller{
+	s := &Controller{
 		instanceIDService:    instanceIDService,
 		eventRecorderManager: eventRecorderManager,
+		idempotencyStore:     dispatch.NewIdempotencyStore(),
+		eventArchive:         eventArchive,
+		metrics:              metrics,
+		quotas:               newNamespaceQuotas(quota),
 		//  so we can have `operationQueueSize` operations outstanding before we start putting back pressure on the senders
-		operationQueue: make(chan dispatch.Operation, operationQueueSize),
+		operationQueue: make(chan queuedOperation, operationQueueSize),
 		workerCount:    workerCount,
 		asyncDispatch:  asyncDispatch,
 	}
+	if err := metrics.ObserveEventQueue(func() int { return len(s.operationQueue) }, s.quotas.inFlightByNamespace); err != nil {
+		logger.WithError(err).Warn(ctx, "Failed to observe the event operation queue")
+	}
+	return s
 }
 
 // nolint: contextcheck
@@ -56,6 +87,7 @@ func (s *Controller) Run(ctx context.Context, stopCh <-chan struct{}) {
 			for operation := range s.operationQueue {
 				ctx := operation.Context()
 				_ = operation.Dispatch(ctx)
+				operation.release()
 			}
 		}()
 		wg.Add(1)
@@ -73,20 +105,19 @@ func (s *Controller) Run(ctx context.Context, stopCh <-chan struct{}) {
 }
 
 func (s *Controller) ReceiveEvent(ctx context.Context, req *eventpkg.EventRequest) (*eventpkg.EventResponse, error) {
-	options := metav1.ListOptions{}
-	s.instanceIDService.With(&options)
-
-	list, err := auth.GetWfClient(ctx).ArgoprojV1alpha1().WorkflowEventBindings(req.Namespace).List(ctx, options)
+	release, err := s.acquireQuota(ctx, req.Namespace)
 	if err != nil {
-		return nil, sutils.ToStatusError(err, codes.Internal)
+		return nil, err
 	}
-
-	operation, err := dispatch.NewOperation(ctx, s.instanceIDService, s.eventRecorderManager.Get(ctx, req.Namespace), list.Items, req.Namespace, req.Discriminator, req.Payload)
+	operation, err := s.newOperation(ctx, req.Namespace, req.Discriminator, req.Payload)
 	if err != nil {
+		release()
 		return nil, sutils.ToStatusError(err, codes.Internal)
 	}
+	s.archiveEvent(ctx, req.Namespace, req.Discriminator, req.Payload)
 
 	if !s.asyncDispatch {
+		defer release()
 		if err := operation.Dispatch(ctx); err != nil {
 			return nil, sutils.ToStatusError(err, codes.Internal)
 		}
@@ -94,13 +125,96 @@ func (s *Controller) ReceiveEvent(ctx context.Context, req *eventpkg.EventReques
 	}
 
 	select {
-	case s.operationQueue <- *operation:
+	case s.operationQueue <- queuedOperation{Operation: *operation, release: release}:
 		return &eventpkg.EventResponse{}, nil
 	default:
+		release()
 		return nil, sutils.ToStatusError(apierrors.NewServiceUnavailable("operation queue full"), codes.ResourceExhausted)
 	}
 }
 
+// acquireQuota takes a slot in the namespace's quota, returning a 429 error with a Retry-After header if it is exceeded
+func (s *Controller) acquireQuota(ctx context.Context, namespace string) (func(), error) {
+	release, reason, retryAfter := s.quotas.acquire(namespace, time.Now())
+	if release != nil {
+		return release, nil
+	}
+	s.metrics.EventQuotaExceeded(ctx, namespace, reason)
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if err := grpc.SetHeader(ctx, metadata.Pairs(grpcutil.RetryAfterHeader, strconv.Itoa(seconds))); err != nil {
+		logging.RequireLoggerFromContext(ctx).WithError(err).Warn(ctx, "Failed to set Retry-After header")
+	}
+	return nil, status.Errorf(codes.ResourceExhausted, "namespace %q exceeded its %s event quota, retry after %ds", namespace, strings.ReplaceAll(reason, "_", "-"), seconds)
+}
+
+// waitForQuota takes a slot in the namespace's quota, waiting until there is one. It is used for events that are
+// pulled rather than sent, e.g. from Kafka, which are delayed rather than rejected when the namespace is over quota.
+func (s *Controller) waitForQuota(ctx context.Context, namespace string) (func(), error) {
+	exceeded := false
+	for {
+		release, reason, retryAfter := s.quotas.acquire(namespace, time.Now())
+		if release != nil {
+			return release, nil
+		}
+		if !exceeded {
+			s.metrics.EventQuotaExceeded(ctx, namespace, reason)
+			exceeded = true
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(retryAfter):
+		}
+	}
+}
+
+// Dispatch synchronously dispatches an event that was not received via the API, e.g. one consumed from Kafka, once
+// the namespace's quota allows it. The context must contain the clients used to list the bindings and submit workflows.
+func (s *Controller) Dispatch(ctx context.Context, namespace, discriminator string, payload *wfv1.Item) error {
+	release, err := s.waitForQuota(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	defer release()
+	operation, err := s.newOperation(ctx, namespace, discriminator, payload)
+	if err != nil {
+		return err
+	}
+	s.archiveEvent(ctx, namespace, discriminator, payload)
+	return operation.Dispatch(ctx)
+}
+
+// archiveEvent stores the event, so it can be replayed. Failing to archive an event does not stop it being dispatched.
+func (s *Controller) archiveEvent(ctx context.Context, namespace, discriminator string, payload *wfv1.Item) {
+	if !s.eventArchive.IsEnabled() {
+		return
+	}
+	event := &sqldb.ArchivedEvent{
+		UID:           string(uuid.NewUUID()),
+		Namespace:     namespace,
+		Discriminator: discriminator,
+		Metadata:      dispatch.EventMetadata(ctx),
+		ReceivedAt:    time.Now(),
+	}
+	if payload != nil {
+		event.Payload = payload.Value
+	}
+	if err := s.eventArchive.ArchiveEvent(ctx, event); err != nil {
+		logging.RequireLoggerFromContext(ctx).WithError(err).WithField("namespace", namespace).Warn(ctx, "Failed to archive event")
+	}
+}
+
+func (s *Controller) newOperation(ctx context.Context, namespace, discriminator string, payload *wfv1.Item) (*dispatch.Operation, error) {
+	options := metav1.ListOptions{}
+	s.instanceIDService.With(&options)
+
+	list, err := auth.GetWfClient(ctx).ArgoprojV1alpha1().WorkflowEventBindings(namespace).List(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	return dispatch.NewOperation(ctx, s.instanceIDService, s.eventRecorderManager.Get(ctx, namespace), s.idempotencyStore, s.metrics, list.Items, namespace, discriminator, payload)
+}
+
 func (s *Controller) ListWorkflowEventBindings(ctx context.Context, in *eventpkg.ListWorkflowEventBindingsRequest) (*wfv1.WorkflowEventBindingList, error) {
 	listOptions := metav1.ListOptions{}
 	if in.ListOptions != nil {
@@ -112,3 +226,87 @@ func (s *Controller) ListWorkflowEventBindings(ctx context.Context, in *eventpkg
 	}
 	return eventBindings, nil
 }
+
+func (s *Controller) ListArchivedEvents(ctx context.Context, req *eventpkg.ListArchivedEventsRequest) (*eventpkg.ArchivedEventList, error) {
+	allowed, err := auth.CanI(ctx, "list", workflow.WorkflowEventBindingPlural, req.Namespace, "")
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("Permission denied, you are not allowed to list workflow event bindings in namespace \"%s\".", req.Namespace))
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 100
+	}
+	events, err := s.eventArchive.ListEvents(ctx, req.Namespace, req.Discriminator, limit, int(req.Offset))
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	items := make([]*eventpkg.ArchivedEvent, len(events))
+	for i, e := range events {
+		items[i] = toArchivedEvent(e)
+	}
+	return &eventpkg.ArchivedEventList{Items: items}, nil
+}
+
+// ReplayArchivedEvent dispatches an archived event again, as if it had just been received with the same headers.
+// As with received events, the caller's access token is used to submit workflows.
+func (s *Controller) ReplayArchivedEvent(ctx context.Context, req *eventpkg.ReplayArchivedEventRequest) (*eventpkg.EventResponse, error) {
+	event, err := s.eventArchive.GetEvent(ctx, req.Namespace, req.Uid)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	if event == nil {
+		return nil, status.Errorf(codes.NotFound, "archived event %q not found", req.Uid)
+	}
+	options := metav1.ListOptions{}
+	s.instanceIDService.With(&options)
+	list, err := auth.GetWfClient(ctx).ArgoprojV1alpha1().WorkflowEventBindings(req.Namespace).List(ctx, options)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	bindings := list.Items
+	if req.BindingName != "" {
+		bindings = nil
+		for _, b := range list.Items {
+			if b.Name == req.BindingName {
+				bindings = append(bindings, b)
+			}
+		}
+		if len(bindings) == 0 {
+			return nil, status.Errorf(codes.NotFound, "workflow event binding %q not found", req.BindingName)
+		}
+	}
+	release, err := s.acquireQuota(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	// the replayed event has the headers it was received with, not the headers of this request
+	ctx = metadata.NewIncomingContext(ctx, event.Metadata)
+	operation, err := dispatch.NewOperation(ctx, s.instanceIDService, s.eventRecorderManager.Get(ctx, req.Namespace), s.idempotencyStore, s.metrics, bindings, req.Namespace, event.Discriminator, &wfv1.Item{Value: event.Payload})
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	if err := operation.Dispatch(ctx); err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	return &eventpkg.EventResponse{}, nil
+}
+
+func toArchivedEvent(e sqldb.ArchivedEvent) *eventpkg.ArchivedEvent {
+	md := make(map[string]string, len(e.Metadata))
+	for k, v := range e.Metadata {
+		md[k] = strings.Join(v, ",")
+	}
+	receivedAt := metav1.NewTime(e.ReceivedAt)
+	return &eventpkg.ArchivedEvent{
+		Uid:           e.UID,
+		Namespace:     e.Namespace,
+		Discriminator: e.Discriminator,
+		Payload:       &wfv1.Item{Value: e.Payload},
+		Metadata:      md,
+		ReceivedAt:    &receivedAt,
+	}
+}
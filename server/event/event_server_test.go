@@ -4,13 +4,20 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	fakekube "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	eventpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/event"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
@@ -25,7 +32,7 @@ func TestController(t *testing.T) {
 	instanceIDService := instanceid.NewService("my-instanceid")
 	eventRecorderManager := events.NewEventRecorderManager(fakekube.NewSimpleClientset())
 	newController := func(asyncDispatch bool) *Controller {
-		return NewController(ctx, instanceIDService, eventRecorderManager, 1, 1, asyncDispatch)
+		return NewController(ctx, instanceIDService, eventRecorderManager, sqldb.NullEventArchive, nil, 1, 1, asyncDispatch, Quota{})
 	}
 	e1 := &eventpkg.EventRequest{Namespace: "my-ns", Payload: &wfv1.Item{}}
 	e2 := &eventpkg.EventRequest{}
@@ -60,4 +67,142 @@ func TestController(t *testing.T) {
 		_, err := s.ReceiveEvent(ctx, &eventpkg.EventRequest{Namespace: "my-ns", Payload: &wfv1.Item{Value: json.RawMessage("!")}})
 		require.EqualError(t, err, "rpc error: code = Internal desc = failed to create workflow template expression environment: json: error calling MarshalJSON for type *v1alpha1.Item: invalid character '!' looking for beginning of value")
 	})
+	t.Run("Quota", func(t *testing.T) {
+		s := NewController(ctx, instanceIDService, eventRecorderManager, sqldb.NullEventArchive, nil, 2, 1, true, Quota{MaxInFlight: 1})
+
+		_, err := s.ReceiveEvent(ctx, e1)
+		require.NoError(t, err)
+		_, err = s.ReceiveEvent(ctx, e1)
+		require.EqualError(t, err, `rpc error: code = ResourceExhausted desc = namespace "my-ns" exceeded its in-flight event quota, retry after 1s`)
+		_, err = s.ReceiveEvent(ctx, &eventpkg.EventRequest{Namespace: "my-other-ns"})
+		require.NoError(t, err, "other namespaces have their own quota")
+		assert.Equal(t, map[string]int{"my-ns": 1, "my-other-ns": 1}, s.quotas.inFlightByNamespace())
+
+		stopCh := make(chan struct{}, 1)
+		stopCh <- struct{}{}
+		s.Run(ctx, stopCh)
+
+		assert.Empty(t, s.quotas.inFlightByNamespace(), "quota released once dispatched")
+	})
+	t.Run("DispatchQuota", func(t *testing.T) {
+		s := NewController(ctx, instanceIDService, eventRecorderManager, sqldb.NullEventArchive, nil, 1, 1, false, Quota{MaxInFlight: 1})
+		release, err := s.acquireQuota(ctx, "my-ns")
+		require.NoError(t, err)
+		// the namespace is at its quota, so the dispatch waits until the event in flight has been dispatched
+		done := make(chan error)
+		go func() { done <- s.Dispatch(ctx, "my-ns", "", &wfv1.Item{}) }()
+		select {
+		case <-done:
+			t.Fatal("dispatched over quota")
+		case <-time.After(100 * time.Millisecond):
+		}
+		release()
+		require.NoError(t, <-done)
+		assert.Empty(t, s.quotas.inFlightByNamespace(), "quota released once dispatched")
+
+		cancelledCtx, cancel := context.WithCancel(ctx)
+		release, err = s.acquireQuota(ctx, "my-ns")
+		require.NoError(t, err)
+		defer release()
+		cancel()
+		require.ErrorIs(t, s.Dispatch(cancelledCtx, "my-ns", "", &wfv1.Item{}), context.Canceled)
+	})
+}
+
+type fakeEventArchive struct {
+	sqldb.EventArchive
+	events []sqldb.ArchivedEvent
+}
+
+func (a *fakeEventArchive) IsEnabled() bool { return true }
+
+func (a *fakeEventArchive) ArchiveEvent(_ context.Context, event *sqldb.ArchivedEvent) error {
+	a.events = append(a.events, *event)
+	return nil
+}
+
+func (a *fakeEventArchive) ListEvents(_ context.Context, namespace, discriminator string, limit, offset int) ([]sqldb.ArchivedEvent, error) {
+	return a.events, nil
+}
+
+func (a *fakeEventArchive) GetEvent(_ context.Context, namespace, uid string) (*sqldb.ArchivedEvent, error) {
+	for _, e := range a.events {
+		if e.Namespace == namespace && e.UID == uid {
+			return &e, nil
+		}
+	}
+	return nil, nil
+}
+
+func TestEventArchive(t *testing.T) {
+	binding := func(name, selector string) *wfv1.WorkflowEventBinding {
+		return &wfv1.WorkflowEventBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-ns"},
+			Spec: wfv1.WorkflowEventBindingSpec{
+				Event:  wfv1.Event{Selector: selector},
+				Submit: &wfv1.Submit{WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "my-wft"}},
+			},
+		}
+	}
+	clientset := fake.NewSimpleClientset(
+		&wfv1.WorkflowTemplate{ObjectMeta: metav1.ObjectMeta{Name: "my-wft", Namespace: "my-ns"}},
+		binding("my-wfeb", `metadata["x-foo"] == ["bar"]`),
+		binding("my-other-wfeb", `metadata["x-foo"] == ["bar"]`),
+	)
+	kubeClient := fakekube.NewSimpleClientset()
+	kubeClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &authorizationv1.SelfSubjectAccessReview{Status: authorizationv1.SubjectAccessReviewStatus{Allowed: true}}, nil
+	})
+	ctx := context.WithValue(logging.TestContext(t.Context()), auth.WfKey, clientset)
+	ctx = context.WithValue(ctx, auth.KubeKey, kubeClient)
+	archive := &fakeEventArchive{}
+	s := NewController(ctx, instanceid.NewService(""), events.NewEventRecorderManager(fakekube.NewSimpleClientset()), archive, nil, 1, 1, false, Quota{})
+
+	t.Run("Archived", func(t *testing.T) {
+		// the header does not match the bindings, so no workflow is submitted
+		ctx := metadata.NewIncomingContext(ctx, metadata.Pairs("x-foo", "baz", "authorization", "secret"))
+		_, err := s.ReceiveEvent(ctx, &eventpkg.EventRequest{Namespace: "my-ns", Discriminator: "my-d", Payload: &wfv1.Item{Value: json.RawMessage(`{"foo": 1}`)}})
+		require.NoError(t, err)
+		require.Len(t, archive.events, 1)
+		e := archive.events[0]
+		assert.NotEmpty(t, e.UID)
+		assert.Equal(t, "my-ns", e.Namespace)
+		assert.Equal(t, "my-d", e.Discriminator)
+		assert.Equal(t, map[string][]string{"x-foo": {"baz"}}, e.Metadata)
+		assert.JSONEq(t, `{"foo": 1}`, string(e.Payload))
+	})
+	t.Run("List", func(t *testing.T) {
+		list, err := s.ListArchivedEvents(ctx, &eventpkg.ListArchivedEventsRequest{Namespace: "my-ns"})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, map[string]string{"x-foo": "baz"}, list.Items[0].Metadata)
+	})
+	t.Run("ReplayNotFound", func(t *testing.T) {
+		_, err := s.ReplayArchivedEvent(ctx, &eventpkg.ReplayArchivedEventRequest{Namespace: "my-ns", Uid: "not-found"})
+		require.EqualError(t, err, `rpc error: code = NotFound desc = archived event "not-found" not found`)
+	})
+	t.Run("ReplayToBinding", func(t *testing.T) {
+		// fix the event, as if the binding was fixed, and replay it to one binding
+		archive.events[0].Metadata["x-foo"] = []string{"bar"}
+		archive.events = append(archive.events, sqldb.ArchivedEvent{UID: "my-uid", Namespace: "my-ns", Metadata: map[string][]string{"x-foo": {"bar"}}, Payload: []byte(`{}`), ReceivedAt: time.Now()})
+		_, err := s.ReplayArchivedEvent(ctx, &eventpkg.ReplayArchivedEventRequest{Namespace: "my-ns", Uid: "my-uid", BindingName: "my-wfeb"})
+		require.NoError(t, err)
+		list, err := clientset.ArgoprojV1alpha1().Workflows("my-ns").List(ctx, metav1.ListOptions{})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "my-wfeb", list.Items[0].Labels["workflows.argoproj.io/workflow-event-binding"])
+		assert.Len(t, archive.events, 2, "replayed events are not archived again")
+	})
+	t.Run("ReplayToMissingBinding", func(t *testing.T) {
+		_, err := s.ReplayArchivedEvent(ctx, &eventpkg.ReplayArchivedEventRequest{Namespace: "my-ns", Uid: "my-uid", BindingName: "missing"})
+		require.EqualError(t, err, `rpc error: code = NotFound desc = workflow event binding "missing" not found`)
+	})
+	t.Run("ReplayQuota", func(t *testing.T) {
+		s := NewController(ctx, instanceid.NewService(""), events.NewEventRecorderManager(fakekube.NewSimpleClientset()), archive, nil, 1, 1, false, Quota{MaxPerMinute: 1})
+		_, err := s.ReplayArchivedEvent(ctx, &eventpkg.ReplayArchivedEventRequest{Namespace: "my-ns", Uid: "my-uid"})
+		require.NoError(t, err)
+		_, err = s.ReplayArchivedEvent(ctx, &eventpkg.ReplayArchivedEventRequest{Namespace: "my-ns", Uid: "my-uid"})
+		require.ErrorContains(t, err, `rpc error: code = ResourceExhausted desc = namespace "my-ns" exceeded its per-minute event quota`)
+		assert.Empty(t, s.quotas.inFlightByNamespace(), "quota released once dispatched")
+	})
 }
@@ -0,0 +1,138 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+	"google.golang.org/grpc/metadata"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	kafkautil "github.com/argoproj/argo-workflows/v3/util/kafka"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// DispatchFunc dispatches an event to the namespace's WorkflowEventBindings, returning once the workflows are submitted
+type DispatchFunc func(ctx context.Context, namespace, discriminator string, payload *wfv1.Item) error
+
+// Consumer consumes messages from Kafka and dispatches each one as an event.
+// Offsets are only committed once the message has been dispatched, so messages are delivered at-least-once:
+// messages being processed when the server stops are redelivered when it restarts.
+type Consumer struct {
+	source   config.KafkaEventSource
+	client   *kgo.Client
+	dispatch DispatchFunc
+}
+
+// NewConsumer creates a consumer for the source. Secrets are read from the namespace.
+func NewConsumer(ctx context.Context, kubeclientset kubernetes.Interface, namespace string, source config.KafkaEventSource, dispatch DispatchFunc) (*Consumer, error) {
+	opts, err := clientOpts(ctx, kubeclientset, namespace, source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Kafka event source %q: %w", source.Name, err)
+	}
+	client, err := kgo.NewClient(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kafka client for event source %q: %w", source.Name, err)
+	}
+	return &Consumer{source: source, client: client, dispatch: dispatch}, nil
+}
+
+// Run consumes messages until the context is done
+func (c *Consumer) Run(ctx context.Context) {
+	logger := logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"eventSource": c.source.Name, "consumerGroup": c.source.GetConsumerGroup()})
+	ctx = logging.WithLogger(ctx, logger)
+	defer c.client.Close()
+	logger.WithField("topics", c.source.Topics).Info(ctx, "Consuming events from Kafka")
+	for {
+		fetches := c.client.PollFetches(ctx)
+		if fetches.IsClientClosed() || ctx.Err() != nil {
+			return
+		}
+		fetches.EachError(func(topic string, partition int32, err error) {
+			logger.WithError(err).WithFields(logging.Fields{"topic": topic, "partition": partition}).Warn(ctx, "Failed to fetch from Kafka")
+		})
+		var processed []*kgo.Record
+		fetches.EachRecord(func(r *kgo.Record) {
+			// once we are stopping, do not commit anything else, these records will be redelivered
+			if ctx.Err() != nil {
+				return
+			}
+			c.process(ctx, r)
+			processed = append(processed, r)
+		})
+		if len(processed) > 0 {
+			if err := c.client.CommitRecords(ctx, processed...); err != nil {
+				logger.WithError(err).Warn(ctx, "Failed to commit Kafka offsets")
+			}
+		}
+		c.client.AllowRebalance()
+	}
+}
+
+// process dispatches the record. Dispatch retries transient errors, and records other errors as events on the binding,
+// so a record that cannot be dispatched is logged and committed rather than blocking the partition forever.
+func (c *Consumer) process(ctx context.Context, r *kgo.Record) {
+	logger := logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"topic": r.Topic, "partition": r.Partition, "offset": r.Offset})
+	// nolint: contextcheck
+	if err := c.dispatch(withRecordMetadata(ctx, r), c.source.Namespace, r.Topic, payload(r.Value)); err != nil {
+		logger.WithError(err).Error(ctx, "Failed to dispatch Kafka message")
+	}
+}
+
+// withRecordMetadata exposes the record's topic, partition, offset, key and headers as `metadata` to event expressions
+func withRecordMetadata(ctx context.Context, r *kgo.Record) context.Context {
+	md := metadata.Pairs(
+		"x-kafka-topic", r.Topic,
+		"x-kafka-partition", strconv.Itoa(int(r.Partition)),
+		"x-kafka-offset", strconv.FormatInt(r.Offset, 10),
+	)
+	if len(r.Key) > 0 {
+		md.Append("x-kafka-key", string(r.Key))
+	}
+	for _, h := range r.Headers {
+		k := strings.ToLower(h.Key)
+		if !strings.HasPrefix(k, "x-") {
+			k = "x-" + k
+		}
+		md.Append(k, string(h.Value))
+	}
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// payload returns the message value, as JSON if it is valid JSON, otherwise as a string
+func payload(value []byte) *wfv1.Item {
+	if json.Valid(value) {
+		return &wfv1.Item{Value: value}
+	}
+	data, _ := json.Marshal(string(value))
+	return &wfv1.Item{Value: data}
+}
+
+func clientOpts(ctx context.Context, kubeclientset kubernetes.Interface, namespace string, source config.KafkaEventSource) ([]kgo.Opt, error) {
+	if source.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	if len(source.Topics) == 0 {
+		return nil, fmt.Errorf("topics is required")
+	}
+	if source.Namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+	opts, err := kafkautil.ClientOpts(ctx, kubeclientset, namespace, source.Brokers, source.TLS, source.SASL)
+	if err != nil {
+		return nil, err
+	}
+	return append(opts,
+		kgo.ConsumeTopics(source.Topics...),
+		kgo.ConsumerGroup(source.GetConsumerGroup()),
+		// we commit once the message has been dispatched
+		kgo.DisableAutoCommit(),
+		// do not let the partitions be re-assigned while we are dispatching records we have not yet committed
+		kgo.BlockRebalanceOnPoll(),
+	), nil
+}
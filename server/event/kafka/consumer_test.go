@@ -0,0 +1,110 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"google.golang.org/grpc/metadata"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func TestPayload(t *testing.T) {
+	assert.JSONEq(t, `{"foo": "bar"}`, string(payload([]byte(`{"foo": "bar"}`)).Value))
+	assert.JSONEq(t, `"hello"`, string(payload([]byte(`hello`)).Value))
+}
+
+func TestProcess(t *testing.T) {
+	ctx := logging.TestContext(context.Background())
+	var namespace, discriminator string
+	var md metadata.MD
+	var item *wfv1.Item
+	c := &Consumer{
+		source: config.KafkaEventSource{Namespace: "my-ns"},
+		dispatch: func(ctx context.Context, ns, d string, payload *wfv1.Item) error {
+			namespace, discriminator, item = ns, d, payload
+			md, _ = metadata.FromIncomingContext(ctx)
+			return nil
+		},
+	}
+	c.process(ctx, &kgo.Record{
+		Topic:     "my-topic",
+		Partition: 1,
+		Offset:    2,
+		Key:       []byte("my-key"),
+		Value:     []byte(`{"foo": "bar"}`),
+		Headers:   []kgo.RecordHeader{{Key: "X-Trace-Id", Value: []byte("1")}, {Key: "source", Value: []byte("my-source")}},
+	})
+	assert.Equal(t, "my-ns", namespace)
+	assert.Equal(t, "my-topic", discriminator)
+	assert.JSONEq(t, `{"foo": "bar"}`, string(item.Value))
+	assert.Equal(t, metadata.MD{
+		"x-kafka-topic":     {"my-topic"},
+		"x-kafka-partition": {"1"},
+		"x-kafka-offset":    {"2"},
+		"x-kafka-key":       {"my-key"},
+		"x-trace-id":        {"1"},
+		"x-source":          {"my-source"},
+	}, md)
+}
+
+func TestClientOpts(t *testing.T) {
+	ctx := logging.TestContext(context.Background())
+	kubeClient := fake.NewSimpleClientset(&apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "kafka", Namespace: "argo"},
+		Data:       map[string][]byte{"user": []byte("my-user"), "password": []byte("my-password"), "ca": []byte("not-a-cert")},
+	})
+	source := config.KafkaEventSource{Name: "my-source", Brokers: []string{"kafka:9092"}, Topics: []string{"my-topic"}, Namespace: "my-ns"}
+	secret := func(key string) apiv1.SecretKeySelector {
+		return apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "kafka"}, Key: key}
+	}
+	t.Run("Default", func(t *testing.T) {
+		opts, err := clientOpts(ctx, kubeClient, "argo", source)
+		require.NoError(t, err)
+		assert.Len(t, opts, 5)
+		assert.Equal(t, "argo-workflows-my-source", source.GetConsumerGroup())
+	})
+	t.Run("MissingTopics", func(t *testing.T) {
+		s := source
+		s.Topics = nil
+		_, err := clientOpts(ctx, kubeClient, "argo", s)
+		assert.EqualError(t, err, "topics is required")
+	})
+	t.Run("SASL", func(t *testing.T) {
+		for _, mechanism := range []string{"", "PLAIN", "SCRAM-SHA-256", "SCRAM-SHA-512"} {
+			s := source
+			s.SASL = &config.KafkaSASLConfig{Mechanism: mechanism, UserSecret: secret("user"), PasswordSecret: secret("password")}
+			opts, err := clientOpts(ctx, kubeClient, "argo", s)
+			require.NoError(t, err, mechanism)
+			assert.Len(t, opts, 6)
+		}
+	})
+	t.Run("UnsupportedSASL", func(t *testing.T) {
+		s := source
+		s.SASL = &config.KafkaSASLConfig{Mechanism: "GSSAPI", UserSecret: secret("user"), PasswordSecret: secret("password")}
+		_, err := clientOpts(ctx, kubeClient, "argo", s)
+		assert.EqualError(t, err, `unsupported SASL mechanism "GSSAPI"`)
+	})
+	t.Run("TLS", func(t *testing.T) {
+		s := source
+		s.TLS = &config.KafkaTLSConfig{InsecureSkipVerify: true}
+		opts, err := clientOpts(ctx, kubeClient, "argo", s)
+		require.NoError(t, err)
+		assert.Len(t, opts, 6)
+	})
+	t.Run("InvalidCA", func(t *testing.T) {
+		ca := secret("ca")
+		s := source
+		s.TLS = &config.KafkaTLSConfig{CASecret: &ca}
+		_, err := clientOpts(ctx, kubeClient, "argo", s)
+		assert.EqualError(t, err, "failed to parse CA certificate")
+	})
+}
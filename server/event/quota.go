@@ -0,0 +1,79 @@
+package event
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	quotaReasonInFlight  = "in_flight"
+	quotaReasonPerMinute = "per_minute"
+)
+
+// Quota limits the events each namespace can send, so one misbehaving sender cannot flood the cluster with workflows.
+// Zero means unlimited.
+type Quota struct {
+	// MaxInFlight is the maximum number of events that can be queued or being dispatched
+	MaxInFlight int
+	// MaxPerMinute is the maximum number of events that can be received per minute
+	MaxPerMinute int
+}
+
+type namespaceQuotas struct {
+	Quota
+	mu       sync.Mutex
+	inFlight map[string]int
+	limiters map[string]*rate.Limiter
+}
+
+func newNamespaceQuotas(quota Quota) *namespaceQuotas {
+	return &namespaceQuotas{Quota: quota, inFlight: make(map[string]int), limiters: make(map[string]*rate.Limiter)}
+}
+
+// acquire takes a slot for an event sent to the namespace, which must be released once it has been dispatched.
+// If the namespace is over quota, it returns the quota exceeded and how long the sender should wait before retrying.
+func (q *namespaceQuotas) acquire(namespace string, now time.Time) (release func(), reason string, retryAfter time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.MaxInFlight > 0 && q.inFlight[namespace] >= q.MaxInFlight {
+		return nil, quotaReasonInFlight, time.Second
+	}
+	if q.MaxPerMinute > 0 {
+		limiter, ok := q.limiters[namespace]
+		if !ok {
+			// allow a minute's worth of events in a burst
+			limiter = rate.NewLimiter(rate.Every(time.Minute/time.Duration(q.MaxPerMinute)), q.MaxPerMinute)
+			q.limiters[namespace] = limiter
+		}
+		r := limiter.ReserveN(now, 1)
+		if delay := r.DelayFrom(now); delay > 0 {
+			r.CancelAt(now)
+			return nil, quotaReasonPerMinute, delay
+		}
+	}
+	q.inFlight[namespace]++
+	var once sync.Once
+	return func() { once.Do(func() { q.release(namespace) }) }, "", 0
+}
+
+func (q *namespaceQuotas) release(namespace string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.inFlight[namespace]--
+	if q.inFlight[namespace] <= 0 {
+		delete(q.inFlight, namespace)
+	}
+}
+
+// inFlightByNamespace returns a copy of the number of events in flight in each namespace
+func (q *namespaceQuotas) inFlightByNamespace() map[string]int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	inFlight := make(map[string]int, len(q.inFlight))
+	for namespace, n := range q.inFlight {
+		inFlight[namespace] = n
+	}
+	return inFlight
+}
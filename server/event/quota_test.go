@@ -0,0 +1,50 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespaceQuotas(t *testing.T) {
+	now := time.Now()
+	t.Run("Unlimited", func(t *testing.T) {
+		q := newNamespaceQuotas(Quota{})
+		for i := 0; i < 100; i++ {
+			release, _, _ := q.acquire("my-ns", now)
+			require.NotNil(t, release)
+		}
+		assert.Equal(t, map[string]int{"my-ns": 100}, q.inFlightByNamespace())
+	})
+	t.Run("MaxInFlight", func(t *testing.T) {
+		q := newNamespaceQuotas(Quota{MaxInFlight: 1})
+		release, _, _ := q.acquire("my-ns", now)
+		require.NotNil(t, release)
+		_, reason, retryAfter := q.acquire("my-ns", now)
+		assert.Equal(t, quotaReasonInFlight, reason)
+		assert.Equal(t, time.Second, retryAfter)
+		release()
+		release()
+		assert.Empty(t, q.inFlightByNamespace(), "releasing twice is a no-op")
+		release, _, _ = q.acquire("my-ns", now)
+		assert.NotNil(t, release)
+	})
+	t.Run("MaxPerMinute", func(t *testing.T) {
+		q := newNamespaceQuotas(Quota{MaxPerMinute: 2})
+		for i := 0; i < 2; i++ {
+			release, _, _ := q.acquire("my-ns", now)
+			require.NotNil(t, release)
+			release()
+		}
+		release, reason, retryAfter := q.acquire("my-ns", now)
+		assert.Nil(t, release)
+		assert.Equal(t, quotaReasonPerMinute, reason)
+		assert.Equal(t, 30*time.Second, retryAfter)
+		release, _, _ = q.acquire("my-other-ns", now)
+		assert.NotNil(t, release, "other namespaces have their own quota")
+		release, _, _ = q.acquire("my-ns", now.Add(30*time.Second))
+		assert.NotNil(t, release, "rejected events do not use the quota")
+	})
+}
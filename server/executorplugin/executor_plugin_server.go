@@ -0,0 +1,278 @@
+package executorplugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	executorpluginpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/executorplugin"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/plugins/spec"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	plugin "github.com/argoproj/argo-workflows/v3/workflow/util/plugins"
+)
+
+// workflowPageSize is how many workflows are listed at once to count the plugins' invocations
+const workflowPageSize = 100
+
+const (
+	healthStatusHealthy   = "Healthy"
+	healthStatusUnhealthy = "Unhealthy"
+	healthStatusUnknown   = "Unknown"
+)
+
+type executorPluginServer struct{}
+
+// NewExecutorPluginServer returns a server to list the executor plugins installed in a namespace, i.e. the plugin
+// config maps, and to enable or disable them. It uses the credentials of the user.
+func NewExecutorPluginServer() executorpluginpkg.ExecutorPluginServiceServer {
+	return &executorPluginServer{}
+}
+
+func (s *executorPluginServer) ListExecutorPlugins(ctx context.Context, req *executorpluginpkg.ListExecutorPluginsRequest) (*executorpluginpkg.ExecutorPluginList, error) {
+	list, err := auth.GetKubeClient(ctx).CoreV1().ConfigMaps(req.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: common.LabelKeyConfigMapType + "=" + common.LabelValueTypeConfigMapExecutorPlugin,
+	})
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	u, err := s.getUsage(ctx, req.Namespace, true)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	items := make([]*executorpluginpkg.ExecutorPlugin, 0, len(list.Items))
+	for _, cm := range list.Items {
+		p, err := u.toExecutorPlugin(&cm)
+		if err != nil {
+			return nil, sutils.ToStatusError(err, codes.Internal)
+		}
+		items = append(items, p)
+	}
+	slices.SortFunc(items, func(a, b *executorpluginpkg.ExecutorPlugin) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return &executorpluginpkg.ExecutorPluginList{Items: items}, nil
+}
+
+func (s *executorPluginServer) GetExecutorPlugin(ctx context.Context, req *executorpluginpkg.GetExecutorPluginRequest) (*executorpluginpkg.ExecutorPlugin, error) {
+	cm, err := getConfigMap(ctx, req.Namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return s.toExecutorPlugin(ctx, cm, true)
+}
+
+func (s *executorPluginServer) EnableExecutorPlugin(ctx context.Context, req *executorpluginpkg.EnableExecutorPluginRequest) (*executorpluginpkg.ExecutorPlugin, error) {
+	return s.setDisabled(ctx, req.Namespace, req.Name, nil)
+}
+
+func (s *executorPluginServer) DisableExecutorPlugin(ctx context.Context, req *executorpluginpkg.DisableExecutorPluginRequest) (*executorpluginpkg.ExecutorPlugin, error) {
+	disabled := "true"
+	return s.setDisabled(ctx, req.Namespace, req.Name, &disabled)
+}
+
+// setDisabled sets the disabled annotation of the plugin's config map, or removes it if value is nil. The plugin is
+// returned without its invocation stats, which are costly to count.
+func (s *executorPluginServer) setDisabled(ctx context.Context, namespace, name string, value *string) (*executorpluginpkg.ExecutorPlugin, error) {
+	cm, err := getConfigMap(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]*string{common.AnnotationKeyExecutorPluginDisabled: value},
+		},
+	})
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	cm, err = auth.GetKubeClient(ctx).CoreV1().ConfigMaps(namespace).Patch(ctx, cm.Name, types.MergePatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	return s.toExecutorPlugin(ctx, cm, false)
+}
+
+func (s *executorPluginServer) toExecutorPlugin(ctx context.Context, cm *apiv1.ConfigMap, withStats bool) (*executorpluginpkg.ExecutorPlugin, error) {
+	u, err := s.getUsage(ctx, cm.Namespace, withStats)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	p, err := u.toExecutorPlugin(cm)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	return p, nil
+}
+
+// getConfigMap gets the config map of the named plugin
+func getConfigMap(ctx context.Context, namespace, name string) (*apiv1.ConfigMap, error) {
+	cm, err := auth.GetKubeClient(ctx).CoreV1().ConfigMaps(namespace).Get(ctx, name+"-executor-plugin", metav1.GetOptions{})
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	if cm.Labels[common.LabelKeyConfigMapType] != common.LabelValueTypeConfigMapExecutorPlugin {
+		return nil, sutils.ToStatusError(fmt.Errorf("config map %s is not an executor plugin", cm.Name), codes.InvalidArgument)
+	}
+	return cm, nil
+}
+
+// usage is what the health and invocation stats of the plugins in a namespace are determined from
+type usage struct {
+	agentPods []apiv1.Pod
+	stats     map[string]*executorpluginpkg.ExecutorPluginInvocationStats // plugin name -> stats, nil if not counted
+}
+
+// getUsage gets the agent pods of the namespace, and counts the plugins' invocations if withStats is true
+func (s *executorPluginServer) getUsage(ctx context.Context, namespace string, withStats bool) (*usage, error) {
+	pods, err := auth.GetKubeClient(ctx).CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: common.LabelKeyComponent + "=agent",
+	})
+	if err != nil {
+		return nil, err
+	}
+	u := &usage{agentPods: pods.Items}
+	if withStats {
+		u.stats = map[string]*executorpluginpkg.ExecutorPluginInvocationStats{}
+		if err := u.countInvocations(ctx, namespace); err != nil {
+			return nil, err
+		}
+	}
+	return u, nil
+}
+
+// countInvocations counts the plugin nodes of the workflows labelled as having run a plugin, a page at a time. The
+// nodes of workflows whose node status is offloaded, and of archived workflows, are not counted.
+func (u *usage) countInvocations(ctx context.Context, namespace string) error {
+	opts := metav1.ListOptions{LabelSelector: common.LabelKeyExecutorPlugin + "=true", Limit: workflowPageSize}
+	for {
+		wfs, err := auth.GetWfClient(ctx).ArgoprojV1alpha1().Workflows(namespace).List(ctx, opts)
+		if err != nil {
+			return err
+		}
+		for _, wf := range wfs.Items {
+			u.countWorkflowInvocations(&wf)
+		}
+		if wfs.Continue == "" {
+			return nil
+		}
+		opts.Continue = wfs.Continue
+	}
+}
+
+// countWorkflowInvocations counts the plugin nodes of the workflow
+func (u *usage) countWorkflowInvocations(wf *wfv1.Workflow) {
+	for _, node := range wf.Status.Nodes {
+		if node.Type != wfv1.NodeTypePlugin {
+			continue
+		}
+		tmpl := pluginTemplate(wf, &node)
+		if tmpl == nil || tmpl.Plugin == nil {
+			continue
+		}
+		name, err := tmpl.Plugin.Name()
+		if err != nil {
+			continue
+		}
+		stats, ok := u.stats[name]
+		if !ok {
+			stats = &executorpluginpkg.ExecutorPluginInvocationStats{}
+			u.stats[name] = stats
+		}
+		stats.Invocations++
+		switch node.Phase {
+		case wfv1.NodeSucceeded:
+			stats.Succeeded++
+		case wfv1.NodeFailed:
+			stats.Failed++
+		case wfv1.NodeError:
+			stats.Errored++
+		}
+		if !node.StartedAt.IsZero() && (stats.LastInvokedAt == nil || stats.LastInvokedAt.Before(&node.StartedAt)) {
+			stats.LastInvokedAt = node.StartedAt.DeepCopy()
+		}
+	}
+}
+
+// pluginTemplate returns the template a plugin node was created from, which may have been stored from a workflow template
+func pluginTemplate(wf *wfv1.Workflow, node *wfv1.NodeStatus) *wfv1.Template {
+	if node.TemplateName == "" && node.TemplateRef == nil {
+		return nil
+	}
+	scope, resourceName := node.GetTemplateScope()
+	if tmpl := wf.GetStoredTemplate(scope, resourceName, node); tmpl != nil {
+		return tmpl
+	}
+	return wf.GetTemplateByName(node.TemplateName)
+}
+
+func (u *usage) toExecutorPlugin(cm *apiv1.ConfigMap) (*executorpluginpkg.ExecutorPlugin, error) {
+	p, err := plugin.FromConfigMap(cm)
+	if err != nil {
+		return nil, fmt.Errorf("config map %s is not a valid executor plugin: %w", cm.Name, err)
+	}
+	stats, ok := u.stats[p.Name]
+	if !ok && u.stats != nil {
+		stats = &executorpluginpkg.ExecutorPluginInvocationStats{}
+	}
+	return &executorpluginpkg.ExecutorPlugin{
+		Name:            p.Name,
+		Namespace:       cm.Namespace,
+		ConfigMap:       cm.Name,
+		Image:           p.Spec.Sidecar.Container.Image,
+		Version:         imageVersion(p.Spec.Sidecar.Container.Image),
+		Needs:           cm.Annotations[common.AnnotationKeyVersion],
+		Description:     cm.Annotations[common.AnnotationKeyDescription],
+		Enabled:         cm.Annotations[common.AnnotationKeyExecutorPluginDisabled] != "true",
+		Health:          u.health(p),
+		InvocationStats: stats,
+	}, nil
+}
+
+// health is determined from the plugin's sidecars in the running agent pods, it is unknown if there are none
+func (u *usage) health(p *spec.Plugin) *executorpluginpkg.ExecutorPluginHealth {
+	sidecars := 0
+	var problems []string
+	for _, pod := range u.agentPods {
+		if pod.Status.Phase != apiv1.PodRunning {
+			continue
+		}
+		for _, s := range pod.Status.ContainerStatuses {
+			if s.Name != p.Spec.Sidecar.Container.Name {
+				continue
+			}
+			sidecars++
+			if !s.Ready {
+				problems = append(problems, fmt.Sprintf("not ready in %s (restarted %d times)", pod.Name, s.RestartCount))
+			}
+		}
+	}
+	switch {
+	case sidecars == 0:
+		return &executorpluginpkg.ExecutorPluginHealth{Status: healthStatusUnknown, Message: "no running agent pod has the plugin"}
+	case len(problems) > 0:
+		return &executorpluginpkg.ExecutorPluginHealth{Status: healthStatusUnhealthy, Message: strings.Join(problems, ", ")}
+	default:
+		return &executorpluginpkg.ExecutorPluginHealth{Status: healthStatusHealthy, Message: fmt.Sprintf("ready in %d agent pods", sidecars)}
+	}
+}
+
+// imageVersion returns the tag or digest of an image, defaulting to "latest"
+func imageVersion(image string) string {
+	if i := strings.LastIndex(image, "@"); i >= 0 {
+		return image[i+1:]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[i+1:]
+	}
+	return "latest"
+}
@@ -0,0 +1,142 @@
+package executorplugin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakekube "k8s.io/client-go/kubernetes/fake"
+
+	executorpluginpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/executorplugin"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wffake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func pluginConfigMap(name, image string, annotations map[string]string) *apiv1.ConfigMap {
+	return &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name + "-executor-plugin",
+			Namespace:   "my-ns",
+			Labels:      map[string]string{common.LabelKeyConfigMapType: common.LabelValueTypeConfigMapExecutorPlugin},
+			Annotations: annotations,
+		},
+		Data: map[string]string{
+			"sidecar.container": `
+name: ` + name + `
+image: ` + image + `
+ports: [{containerPort: 1234}]
+resources: {requests: {cpu: 100m}, limits: {cpu: 100m}}
+securityContext: {runAsNonRoot: true}
+`,
+		},
+	}
+}
+
+func TestExecutorPluginServer(t *testing.T) {
+	started := metav1.NewTime(time.Date(2020, 9, 21, 18, 12, 56, 0, time.UTC))
+	kubeClient := fakekube.NewSimpleClientset(
+		pluginConfigMap("hello", "argoproj/hello:v1.2", map[string]string{
+			common.AnnotationKeyVersion:     ">= v3.3",
+			common.AnnotationKeyDescription: "Says hello",
+		}),
+		pluginConfigMap("bye", "argoproj/bye", map[string]string{common.AnnotationKeyExecutorPluginDisabled: "true"}),
+		&apiv1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "not-a-plugin-executor-plugin", Namespace: "my-ns"}},
+		&apiv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wf-agent", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyComponent: "agent"}},
+			Status: apiv1.PodStatus{
+				Phase:             apiv1.PodRunning,
+				ContainerStatuses: []apiv1.ContainerStatus{{Name: "hello", Ready: false, RestartCount: 2}},
+			},
+		},
+	)
+	pluginWorkflow := func(name string, labels map[string]string) *wfv1.Workflow {
+		return &wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-ns", Labels: labels},
+			Spec: wfv1.WorkflowSpec{Templates: []wfv1.Template{
+				{Name: "hello", Plugin: &wfv1.Plugin{Object: wfv1.Object{Value: []byte(`{"hello":{}}`)}}},
+			}},
+			Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+				name + "-1": {ID: name + "-1", Type: wfv1.NodeTypePlugin, TemplateName: "hello", Phase: wfv1.NodeSucceeded, StartedAt: started},
+				name + "-2": {ID: name + "-2", Type: wfv1.NodeTypePlugin, TemplateName: "hello", Phase: wfv1.NodeError},
+				name + "-3": {ID: name + "-3", Type: wfv1.NodeTypePod, TemplateName: "hello", Phase: wfv1.NodeFailed},
+			}},
+		}
+	}
+	wfClient := wffake.NewSimpleClientset(
+		pluginWorkflow("my-wf", map[string]string{common.LabelKeyExecutorPlugin: "true"}),
+		// not labelled as having run a plugin, so not counted
+		pluginWorkflow("my-other-wf", nil),
+	)
+	ctx := context.WithValue(context.WithValue(logging.TestContext(t.Context()), auth.KubeKey, kubeClient), auth.WfKey, wfClient)
+	s := NewExecutorPluginServer()
+
+	t.Run("List", func(t *testing.T) {
+		list, err := s.ListExecutorPlugins(ctx, &executorpluginpkg.ListExecutorPluginsRequest{Namespace: "my-ns"})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 2)
+		assert.Equal(t, "bye", list.Items[0].Name)
+		assert.False(t, list.Items[0].Enabled)
+		assert.Equal(t, "latest", list.Items[0].Version)
+		assert.Equal(t, healthStatusUnknown, list.Items[0].Health.Status)
+		assert.Zero(t, list.Items[0].InvocationStats.Invocations)
+		assert.Equal(t, "hello", list.Items[1].Name)
+		assert.True(t, list.Items[1].Enabled)
+	})
+	t.Run("Get", func(t *testing.T) {
+		p, err := s.GetExecutorPlugin(ctx, &executorpluginpkg.GetExecutorPluginRequest{Namespace: "my-ns", Name: "hello"})
+		require.NoError(t, err)
+		assert.Equal(t, "hello-executor-plugin", p.ConfigMap)
+		assert.Equal(t, "argoproj/hello:v1.2", p.Image)
+		assert.Equal(t, "v1.2", p.Version)
+		assert.Equal(t, ">= v3.3", p.Needs)
+		assert.Equal(t, "Says hello", p.Description)
+		assert.Equal(t, healthStatusUnhealthy, p.Health.Status)
+		assert.Equal(t, "not ready in my-wf-agent (restarted 2 times)", p.Health.Message)
+		assert.Equal(t, int64(2), p.InvocationStats.Invocations)
+		assert.Equal(t, int64(1), p.InvocationStats.Succeeded)
+		assert.Equal(t, int64(1), p.InvocationStats.Errored)
+		assert.Equal(t, started.Unix(), p.InvocationStats.LastInvokedAt.Unix())
+	})
+	t.Run("GetNotAPlugin", func(t *testing.T) {
+		_, err := s.GetExecutorPlugin(ctx, &executorpluginpkg.GetExecutorPluginRequest{Namespace: "my-ns", Name: "not-a-plugin"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("GetNotFound", func(t *testing.T) {
+		_, err := s.GetExecutorPlugin(ctx, &executorpluginpkg.GetExecutorPluginRequest{Namespace: "my-ns", Name: "missing"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("Disable", func(t *testing.T) {
+		p, err := s.DisableExecutorPlugin(ctx, &executorpluginpkg.DisableExecutorPluginRequest{Namespace: "my-ns", Name: "hello"})
+		require.NoError(t, err)
+		assert.False(t, p.Enabled)
+		assert.Nil(t, p.InvocationStats, "stats are not counted")
+		cm, err := kubeClient.CoreV1().ConfigMaps("my-ns").Get(ctx, "hello-executor-plugin", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "true", cm.Annotations[common.AnnotationKeyExecutorPluginDisabled])
+		assert.Equal(t, "Says hello", cm.Annotations[common.AnnotationKeyDescription])
+	})
+	t.Run("Enable", func(t *testing.T) {
+		p, err := s.EnableExecutorPlugin(ctx, &executorpluginpkg.EnableExecutorPluginRequest{Namespace: "my-ns", Name: "bye"})
+		require.NoError(t, err)
+		assert.True(t, p.Enabled)
+		cm, err := kubeClient.CoreV1().ConfigMaps("my-ns").Get(ctx, "bye-executor-plugin", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotContains(t, cm.Annotations, common.AnnotationKeyExecutorPluginDisabled)
+	})
+}
+
+func TestImageVersion(t *testing.T) {
+	assert.Equal(t, "latest", imageVersion("argoproj/hello"))
+	assert.Equal(t, "latest", imageVersion("localhost:5000/argoproj/hello"))
+	assert.Equal(t, "v1", imageVersion("localhost:5000/argoproj/hello:v1"))
+	assert.Equal(t, "sha256:abc", imageVersion("argoproj/hello@sha256:abc"))
+}
@@ -0,0 +1,81 @@
+package memoizationcache
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
+)
+
+type memoizationCacheServer struct{}
+
+// NewMemoizationCacheServer returns a server to inspect and invalidate the entries of the memoization caches, i.e. the
+// config maps the controller saves the outputs of memoized nodes to. It uses the credentials of the user.
+func NewMemoizationCacheServer() memoizationcachepkg.MemoizationCacheServiceServer {
+	return &memoizationCacheServer{}
+}
+
+func (s *memoizationCacheServer) ListCacheEntries(ctx context.Context, req *memoizationcachepkg.ListCacheEntriesRequest) (*memoizationcachepkg.CacheEntryList, error) {
+	kubeClient := auth.GetKubeClient(ctx)
+	var configMaps []apiv1.ConfigMap
+	if req.Name != "" {
+		cm, err := kubeClient.CoreV1().ConfigMaps(req.Namespace).Get(ctx, req.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, sutils.ToStatusError(err, codes.Internal)
+		}
+		if cm.Labels[common.LabelKeyConfigMapType] != common.LabelValueTypeConfigMapCache {
+			return nil, sutils.ToStatusError(fmt.Errorf("config map %s is not a memoization cache", cm.Name), codes.InvalidArgument)
+		}
+		configMaps = append(configMaps, *cm)
+	} else {
+		list, err := kubeClient.CoreV1().ConfigMaps(req.Namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: common.LabelKeyConfigMapType + "=" + common.LabelValueTypeConfigMapCache,
+		})
+		if err != nil {
+			return nil, sutils.ToStatusError(err, codes.Internal)
+		}
+		configMaps = list.Items
+	}
+	items := make([]*memoizationcachepkg.CacheEntry, 0)
+	for _, cm := range configMaps {
+		entries, err := cache.EntriesOf(&cm)
+		if err != nil {
+			return nil, sutils.ToStatusError(err, codes.Internal)
+		}
+		for key, entry := range entries {
+			items = append(items, &memoizationcachepkg.CacheEntry{
+				CacheName:         cm.Name,
+				Key:               key,
+				NodeID:            entry.NodeID,
+				Outputs:           entry.Outputs,
+				CreationTimestamp: &entry.CreationTimestamp,
+				LastHitTimestamp:  &entry.LastHitTimestamp,
+			})
+		}
+	}
+	slices.SortFunc(items, func(a, b *memoizationcachepkg.CacheEntry) int {
+		if c := strings.Compare(a.CacheName, b.CacheName); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Key, b.Key)
+	})
+	return &memoizationcachepkg.CacheEntryList{Items: items}, nil
+}
+
+func (s *memoizationCacheServer) DeleteCacheEntry(ctx context.Context, req *memoizationcachepkg.DeleteCacheEntryRequest) (*memoizationcachepkg.CacheEntryDeletedResponse, error) {
+	c := cache.NewConfigMapCache(req.Namespace, auth.GetKubeClient(ctx), req.Name)
+	if err := c.Delete(ctx, req.Key); err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	return &memoizationcachepkg.CacheEntryDeletedResponse{}, nil
+}
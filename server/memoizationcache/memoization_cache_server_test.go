@@ -0,0 +1,79 @@
+package memoizationcache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakekube "k8s.io/client-go/kubernetes/fake"
+
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func cacheConfigMap(name string, data map[string]string) *apiv1.ConfigMap {
+	return &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "my-ns",
+			Labels:    map[string]string{common.LabelKeyConfigMapType: common.LabelValueTypeConfigMapCache},
+		},
+		Data: data,
+	}
+}
+
+func TestMemoizationCacheServer(t *testing.T) {
+	kubeClient := fakekube.NewSimpleClientset(
+		cacheConfigMap("cache-b", map[string]string{
+			"key-1": `{"nodeID":"wf-1","outputs":{"parameters":[{"name":"p","value":"1"}]},"creationTimestamp":"2020-09-21T18:12:56Z"}`,
+		}),
+		cacheConfigMap("cache-a", map[string]string{
+			"key-2": `{"nodeID":"wf-2","creationTimestamp":"2020-09-21T18:12:56Z"}`,
+			"key-1": `{"nodeID":"wf-3","creationTimestamp":"2020-09-21T18:12:56Z","lastHitTimestamp":"2020-09-22T18:12:56Z"}`,
+		}),
+		&apiv1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "not-a-cache", Namespace: "my-ns"}},
+	)
+	ctx := context.WithValue(logging.TestContext(t.Context()), auth.KubeKey, kubeClient)
+	s := NewMemoizationCacheServer()
+
+	t.Run("List", func(t *testing.T) {
+		list, err := s.ListCacheEntries(ctx, &memoizationcachepkg.ListCacheEntriesRequest{Namespace: "my-ns"})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 3)
+		assert.Equal(t, "cache-a", list.Items[0].CacheName)
+		assert.Equal(t, "key-1", list.Items[0].Key)
+		assert.Equal(t, "wf-3", list.Items[0].NodeID)
+		assert.Equal(t, "key-2", list.Items[1].Key)
+		assert.Equal(t, "cache-b", list.Items[2].CacheName)
+		assert.Equal(t, "1", list.Items[2].Outputs.Parameters[0].Value.String())
+	})
+	t.Run("ListByName", func(t *testing.T) {
+		list, err := s.ListCacheEntries(ctx, &memoizationcachepkg.ListCacheEntriesRequest{Namespace: "my-ns", Name: "cache-b"})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "wf-1", list.Items[0].NodeID)
+	})
+	t.Run("ListNotACache", func(t *testing.T) {
+		_, err := s.ListCacheEntries(ctx, &memoizationcachepkg.ListCacheEntriesRequest{Namespace: "my-ns", Name: "not-a-cache"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Delete", func(t *testing.T) {
+		_, err := s.DeleteCacheEntry(ctx, &memoizationcachepkg.DeleteCacheEntryRequest{Namespace: "my-ns", Name: "cache-a", Key: "key-1"})
+		require.NoError(t, err)
+		cm, err := kubeClient.CoreV1().ConfigMaps("my-ns").Get(ctx, "cache-a", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotContains(t, cm.Data, "key-1")
+		assert.Contains(t, cm.Data, "key-2")
+	})
+	t.Run("DeleteMissing", func(t *testing.T) {
+		_, err := s.DeleteCacheEntry(ctx, &memoizationcachepkg.DeleteCacheEntryRequest{Namespace: "my-ns", Name: "cache-a", Key: "key-3"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -0,0 +1,183 @@
+package workflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// ranSpec returns the spec the workflow ran, which for workflows that reference a workflow template is the spec stored
+// once the controller resolved it
+func ranSpec(wf *wfv1.Workflow) *wfv1.WorkflowSpec {
+	if wf.Status.StoredWorkflowSpec != nil {
+		return wf.Status.StoredWorkflowSpec
+	}
+	return &wf.Spec
+}
+
+// diffWorkflowSpecs returns the changes from the spec the old workflow ran to the spec the new workflow ran
+func diffWorkflowSpecs(oldWf, newWf *wfv1.Workflow) ([]*workflowpkg.WorkflowSpecChange, error) {
+	oldSpec, err := toJSONValue(ranSpec(oldWf))
+	if err != nil {
+		return nil, err
+	}
+	newSpec, err := toJSONValue(ranSpec(newWf))
+	if err != nil {
+		return nil, err
+	}
+	var changes []*workflowpkg.WorkflowSpecChange
+	if err := diffJSONValues("spec", oldSpec, newSpec, &changes); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+func toJSONValue(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	return value, json.Unmarshal(data, &value)
+}
+
+// diffJSONValues appends the changes from the old value to the new value at the path. Lists whose items all have
+// distinct names, such as templates, are compared by name rather than by index, so that inserting an item does not
+// change all the items after it.
+func diffJSONValues(path string, oldValue, newValue interface{}, changes *[]*workflowpkg.WorkflowSpecChange) error {
+	oldMap, oldIsMap := oldValue.(map[string]interface{})
+	newMap, newIsMap := newValue.(map[string]interface{})
+	if oldIsMap && newIsMap {
+		for _, k := range unionKeys(oldMap, newMap) {
+			if err := diffJSONValues(path+"."+k, oldMap[k], newMap[k], changes); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	oldList, oldIsList := oldValue.([]interface{})
+	newList, newIsList := newValue.([]interface{})
+	if oldIsList && newIsList {
+		oldNamed, oldOK := itemsByName(oldList)
+		newNamed, newOK := itemsByName(newList)
+		if oldOK && newOK {
+			for _, name := range unionKeys(oldNamed, newNamed) {
+				if err := diffJSONValues(fmt.Sprintf("%s[name=%s]", path, name), oldNamed[name], newNamed[name], changes); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		for i := 0; i < max(len(oldList), len(newList)); i++ {
+			var oldItem, newItem interface{}
+			if i < len(oldList) {
+				oldItem = oldList[i]
+			}
+			if i < len(newList) {
+				newItem = newList[i]
+			}
+			if err := diffJSONValues(fmt.Sprintf("%s[%d]", path, i), oldItem, newItem, changes); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if reflect.DeepEqual(oldValue, newValue) {
+		return nil
+	}
+	oldJSON, err := marshalJSONValue(oldValue)
+	if err != nil {
+		return err
+	}
+	newJSON, err := marshalJSONValue(newValue)
+	if err != nil {
+		return err
+	}
+	*changes = append(*changes, &workflowpkg.WorkflowSpecChange{Path: path, Old: oldJSON, New: newJSON})
+	return nil
+}
+
+// marshalJSONValue returns the JSON of the value, or an empty string if it is not set
+func marshalJSONValue(v interface{}) (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(v)
+	return string(data), err
+}
+
+// itemsByName returns the items of the list by their names, if they all have distinct names
+func itemsByName(list []interface{}) (map[string]interface{}, bool) {
+	items := make(map[string]interface{}, len(list))
+	for _, item := range list {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		name, _ := m["name"].(string)
+		if _, exists := items[name]; name == "" || exists {
+			return nil, false
+		}
+		items[name] = item
+	}
+	return items, true
+}
+
+func unionKeys[V any](a, b map[string]V) []string {
+	var keys []string
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// relativeNodeName returns the name of the node without the name of its workflow, so that the nodes of different
+// workflows can be matched, e.g. ".a[0].b", or an empty string for the root node
+func relativeNodeName(wf *wfv1.Workflow, node wfv1.NodeStatus) string {
+	return strings.TrimPrefix(node.Name, wf.Name)
+}
+
+// diffWorkflowNodes returns the nodes whose phases changed from the old workflow to the new workflow, including those
+// only one of them has
+func diffWorkflowNodes(oldWf, newWf *wfv1.Workflow) []*workflowpkg.WorkflowNodeChange {
+	oldNodes := map[string]wfv1.NodeStatus{}
+	for _, node := range oldWf.Status.Nodes {
+		oldNodes[relativeNodeName(oldWf, node)] = node
+	}
+	newNodes := map[string]wfv1.NodeStatus{}
+	for _, node := range newWf.Status.Nodes {
+		newNodes[relativeNodeName(newWf, node)] = node
+	}
+	var changes []*workflowpkg.WorkflowNodeChange
+	for _, name := range unionKeys(oldNodes, newNodes) {
+		oldNode, inOld := oldNodes[name]
+		newNode, inNew := newNodes[name]
+		if inOld && inNew && oldNode.Phase == newNode.Phase {
+			continue
+		}
+		change := &workflowpkg.WorkflowNodeChange{Name: name}
+		if inOld {
+			change.DisplayName = oldNode.DisplayName
+			change.OldPhase = string(oldNode.Phase)
+			change.OldMessage = oldNode.Message
+		}
+		if inNew {
+			change.DisplayName = newNode.DisplayName
+			change.NewPhase = string(newNode.Phase)
+			change.NewMessage = newNode.Message
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -58,12 +59,13 @@ type workflowServer struct {
 	wftmplStore           servertypes.WorkflowTemplateStore
 	cwftmplStore          servertypes.ClusterWorkflowTemplateStore
 	wfDefaults            *wfv1.Workflow
+	requireShutdownReason bool
 }
 
 var _ workflowpkg.WorkflowServiceServer = &workflowServer{}
 
 // NewWorkflowServer returns a new WorkflowServer
-func NewWorkflowServer(ctx context.Context, instanceIDService instanceid.Service, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, wfArchive sqldb.WorkflowArchive, wfClientSet versioned.Interface, wfLister store.WorkflowLister, wfStore store.WorkflowStore, wftmplStore servertypes.WorkflowTemplateStore, cwftmplStore servertypes.ClusterWorkflowTemplateStore, wfDefaults *wfv1.Workflow, namespace *string) *workflowServer {
+func NewWorkflowServer(ctx context.Context, instanceIDService instanceid.Service, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, wfArchive sqldb.WorkflowArchive, wfClientSet versioned.Interface, wfLister store.WorkflowLister, wfStore store.WorkflowStore, wftmplStore servertypes.WorkflowTemplateStore, cwftmplStore servertypes.ClusterWorkflowTemplateStore, wfDefaults *wfv1.Workflow, requireShutdownReason bool, namespace *string) *workflowServer {
 	ws := &workflowServer{
 		instanceIDService:     instanceIDService,
 		offloadNodeStatusRepo: offloadNodeStatusRepo,
@@ -73,6 +75,7 @@ func NewWorkflowServer(ctx context.Context, instanceIDService instanceid.Service
 		wftmplStore:           wftmplStore,
 		cwftmplStore:          cwftmplStore,
 		wfDefaults:            wfDefaults,
+		requireShutdownReason: requireShutdownReason,
 	}
 	if wfStore != nil && namespace != nil {
 		lw := &cache.ListWatch{
@@ -564,7 +567,15 @@ func (s *workflowServer) SuspendWorkflow(ctx context.Context, req *workflowpkg.W
 		return nil, sutils.ToStatusError(err, codes.InvalidArgument)
 	}
 
-	err = util.SuspendWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(wf.Namespace), wf.Name)
+	var resumeAt time.Time
+	if req.ResumeAt != "" {
+		resumeAt, err = time.Parse(time.RFC3339, req.ResumeAt)
+		if err != nil {
+			return nil, sutils.ToStatusError(fmt.Errorf("invalid resumeAt %q: %w", req.ResumeAt, err), codes.InvalidArgument)
+		}
+	}
+
+	err = util.SuspendWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(wf.Namespace), wf.Name, resumeAt)
 	if err != nil {
 		return nil, sutils.ToStatusError(err, codes.Internal)
 	}
@@ -590,7 +601,12 @@ func (s *workflowServer) TerminateWorkflow(ctx context.Context, req *workflowpkg
 		return nil, sutils.ToStatusError(err, codes.InvalidArgument)
 	}
 
-	err = util.TerminateWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(req.Namespace), wf.Name)
+	opts, err := s.shutdownOptions(req.Reason, req.GracePeriod)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.InvalidArgument)
+	}
+
+	err = util.TerminateWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(req.Namespace), wf.Name, *opts)
 	if err != nil {
 		return nil, sutils.ToStatusError(err, codes.Internal)
 	}
@@ -612,7 +628,12 @@ func (s *workflowServer) StopWorkflow(ctx context.Context, req *workflowpkg.Work
 	if err != nil {
 		return nil, sutils.ToStatusError(err, codes.InvalidArgument)
 	}
-	err = util.StopWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(req.Namespace), s.hydrator, wf.Name, req.NodeFieldSelector, req.Message)
+	opts, err := s.shutdownOptions(req.Reason, req.GracePeriod)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.InvalidArgument)
+	}
+	opts.Subtree = req.Subtree
+	err = util.StopWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(req.Namespace), s.hydrator, wf.Name, req.NodeFieldSelector, req.Message, *opts)
 	if err != nil {
 		return nil, sutils.ToStatusError(err, codes.Internal)
 	}
@@ -624,6 +645,25 @@ func (s *workflowServer) StopWorkflow(ctx context.Context, req *workflowpkg.Work
 	return wf, nil
 }
 
+// shutdownOptions returns the options of stopping or terminating a workflow, requiring a reason if configured to
+func (s *workflowServer) shutdownOptions(reason, gracePeriod string) (*util.ShutdownOptions, error) {
+	if s.requireShutdownReason && strings.TrimSpace(reason) == "" {
+		return nil, errors.New(errors.CodeBadRequest, "a reason is required to stop or terminate a workflow")
+	}
+	opts := &util.ShutdownOptions{Reason: reason}
+	if gracePeriod != "" {
+		d, err := time.ParseDuration(gracePeriod)
+		if err != nil {
+			return nil, fmt.Errorf("invalid grace period %q: %w", gracePeriod, err)
+		}
+		if d < 0 {
+			return nil, fmt.Errorf("invalid grace period %q: must not be negative", gracePeriod)
+		}
+		opts.GracePeriod = &d
+	}
+	return opts, nil
+}
+
 func (s *workflowServer) SetWorkflow(ctx context.Context, req *workflowpkg.WorkflowSetRequest) (*wfv1.Workflow, error) {
 	wfClient := auth.GetWfClient(ctx)
 	wf, err := s.getWorkflow(ctx, wfClient, req.Namespace, req.Name, metav1.GetOptions{})
@@ -652,9 +692,10 @@ func (s *workflowServer) SetWorkflow(ctx context.Context, req *workflowpkg.Workf
 	}
 
 	operation := util.SetOperationValues{
-		Phase:            phaseToSet,
-		Message:          req.Message,
-		OutputParameters: outputParams,
+		Phase:                           phaseToSet,
+		Message:                         req.Message,
+		OutputParameters:                outputParams,
+		AllowUndeclaredOutputParameters: req.AllowUndeclaredOutputParameters,
 	}
 
 	err = util.SetWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(req.Namespace), s.hydrator, wf.Name, req.NodeFieldSelector, operation)
@@ -830,3 +871,41 @@ func (s *workflowServer) SubmitWorkflow(ctx context.Context, req *workflowpkg.Wo
 	}
 	return wf, nil
 }
+
+func (s *workflowServer) DiffWorkflows(ctx context.Context, req *workflowpkg.WorkflowDiffRequest) (*workflowpkg.WorkflowDiffResponse, error) {
+	wfClient := auth.GetWfClient(ctx)
+	wf, err := s.getWorkflow(ctx, wfClient, req.Namespace, req.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	otherName := req.OtherName
+	if otherName == "" {
+		otherName = wf.Labels[common.LabelKeyPreviousWorkflowName]
+		if otherName == "" {
+			err := errors.Errorf(errors.CodeBadRequest, "workflow %s was not resubmitted from another workflow, so the workflow to compare it to is required", wf.Name)
+			return nil, sutils.ToStatusError(err, codes.InvalidArgument)
+		}
+	}
+	other, err := s.getWorkflow(ctx, wfClient, req.Namespace, otherName, metav1.GetOptions{})
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	for _, w := range []*wfv1.Workflow{wf, other} {
+		if err := s.validateWorkflow(w); err != nil {
+			return nil, sutils.ToStatusError(err, codes.InvalidArgument)
+		}
+		if err := s.hydrator.Hydrate(ctx, w); err != nil {
+			return nil, sutils.ToStatusError(err, codes.Internal)
+		}
+	}
+	specChanges, err := diffWorkflowSpecs(other, wf)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	return &workflowpkg.WorkflowDiffResponse{
+		Name:        wf.Name,
+		OtherName:   other.Name,
+		SpecChanges: specChanges,
+		NodeChanges: diffWorkflowNodes(other, wf),
+	}, nil
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -649,7 +651,7 @@ func getWorkflowServer(t *testing.T) (workflowpkg.WorkflowServiceServer, context
 	namespaceAll := metav1.NamespaceAll
 	wftmplStore := workflowtemplate.NewWorkflowTemplateClientStore()
 	cwftmplStore := clusterworkflowtemplate.NewClusterWorkflowTemplateClientStore()
-	server := NewWorkflowServer(ctx, instanceIDSvc, offloadNodeStatusRepo, archivedRepo, wfClientset, wfStore, wfStore, wftmplStore, cwftmplStore, nil, &namespaceAll)
+	server := NewWorkflowServer(ctx, instanceIDSvc, offloadNodeStatusRepo, archivedRepo, wfClientset, wfStore, wfStore, wftmplStore, cwftmplStore, nil, false, &namespaceAll)
 	return server, ctx
 }
 
@@ -874,6 +876,31 @@ func TestTerminateWorkflow(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestTerminateWorkflowWithReason(t *testing.T) {
+	server, ctx := getWorkflowServer(t)
+	t.Run("InvalidGracePeriod", func(t *testing.T) {
+		_, err := server.TerminateWorkflow(ctx, &workflowpkg.WorkflowTerminateRequest{Name: "hello-world-9tql2-run", Namespace: "workflows", GracePeriod: "-1s"})
+		require.ErrorContains(t, err, "must not be negative")
+	})
+	t.Run("ReasonRequired", func(t *testing.T) {
+		server.(*workflowServer).requireShutdownReason = true
+		defer func() { server.(*workflowServer).requireShutdownReason = false }()
+		_, err := server.TerminateWorkflow(ctx, &workflowpkg.WorkflowTerminateRequest{Name: "hello-world-9tql2-run", Namespace: "workflows"})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = server.StopWorkflow(ctx, &workflowpkg.WorkflowStopRequest{Name: "hello-world-9tql2-run", Namespace: "workflows", Reason: " "})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Reason", func(t *testing.T) {
+		wf, err := server.TerminateWorkflow(ctx, &workflowpkg.WorkflowTerminateRequest{Name: "hello-world-9tql2-run", Namespace: "workflows", Reason: "runaway costs", GracePeriod: "30s"})
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.ShutdownStrategyTerminate, wf.Spec.Shutdown)
+		assert.Equal(t, "runaway costs", wf.Annotations[common.AnnotationKeyShutdownReason])
+		assert.Equal(t, "30s", wf.Annotations[common.AnnotationKeyShutdownGracePeriod])
+	})
+}
+
 func TestStopWorkflow(t *testing.T) {
 	server, ctx := getWorkflowServer(t)
 	wf, err := getWorkflow(ctx, server, "workflows", "hello-world-9tql2-run")
@@ -888,6 +915,82 @@ func TestStopWorkflow(t *testing.T) {
 	assert.Equal(t, userEmailLabel, wf.Labels[common.LabelKeyActorEmail])
 }
 
+func TestDiffWorkflows(t *testing.T) {
+	server, ctx := getWorkflowServer(t)
+	wfClient := auth.GetWfClient(ctx).ArgoprojV1alpha1().Workflows("workflows")
+	failed := v1alpha1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  labels:
+    workflows.argoproj.io/controller-instanceid: my-instanceid
+spec:
+  entrypoint: main
+  arguments:
+    parameters: [{name: batch, value: "1"}]
+  templates:
+  - name: main
+    steps:
+    - - name: a
+        template: say
+  - name: say
+    container: {image: "my-image:v1"}
+status:
+  nodes:
+    my-wf: {name: my-wf, displayName: my-wf, phase: Failed}
+    my-wf-1: {name: "my-wf[0].a", displayName: a, phase: Failed, message: "exit code 1"}
+`)
+	_, err := wfClient.Create(ctx, failed, metav1.CreateOptions{})
+	require.NoError(t, err)
+	succeeded := v1alpha1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf-resubmitted
+  labels:
+    workflows.argoproj.io/controller-instanceid: my-instanceid
+    workflows.argoproj.io/resubmitted-from-workflow: my-wf
+spec:
+  entrypoint: main
+  arguments:
+    parameters: [{name: batch, value: "2"}]
+  templates:
+  - name: say
+    container: {image: "my-image:v2"}
+  - name: main
+    steps:
+    - - name: a
+        template: say
+status:
+  nodes:
+    my-wf-resubmitted: {name: my-wf-resubmitted, displayName: my-wf-resubmitted, phase: Succeeded}
+    my-wf-resubmitted-1: {name: "my-wf-resubmitted[0].a", displayName: a, phase: Succeeded}
+`)
+	_, err = wfClient.Create(ctx, succeeded, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	t.Run("ResubmittedFrom", func(t *testing.T) {
+		diff, err := server.DiffWorkflows(ctx, &workflowpkg.WorkflowDiffRequest{Namespace: "workflows", Name: "my-wf-resubmitted"})
+		require.NoError(t, err)
+		assert.Equal(t, "my-wf", diff.OtherName)
+		assert.Equal(t, []*workflowpkg.WorkflowSpecChange{
+			{Path: "spec.arguments.parameters[name=batch].value", Old: `"1"`, New: `"2"`},
+			{Path: "spec.templates[name=say].container.image", Old: `"my-image:v1"`, New: `"my-image:v2"`},
+		}, diff.SpecChanges)
+		assert.Equal(t, []*workflowpkg.WorkflowNodeChange{
+			{Name: "", DisplayName: "my-wf-resubmitted", OldPhase: "Failed", NewPhase: "Succeeded"},
+			{Name: "[0].a", DisplayName: "a", OldPhase: "Failed", NewPhase: "Succeeded", OldMessage: "exit code 1"},
+		}, diff.NodeChanges)
+	})
+	t.Run("Other", func(t *testing.T) {
+		diff, err := server.DiffWorkflows(ctx, &workflowpkg.WorkflowDiffRequest{Namespace: "workflows", Name: "my-wf", OtherName: "my-wf"})
+		require.NoError(t, err)
+		assert.Empty(t, diff.SpecChanges)
+		assert.Empty(t, diff.NodeChanges)
+	})
+	t.Run("NotResubmitted", func(t *testing.T) {
+		_, err := server.DiffWorkflows(ctx, &workflowpkg.WorkflowDiffRequest{Namespace: "workflows", Name: "my-wf"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
 func TestResubmitWorkflow(t *testing.T) {
 	server, ctx := getWorkflowServer(t)
 	t.Run("Labelled", func(t *testing.T) {
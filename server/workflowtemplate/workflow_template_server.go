@@ -18,6 +18,7 @@ import (
 	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/workflow/creator"
+	"github.com/argoproj/argo-workflows/v3/workflow/templaterevision"
 	"github.com/argoproj/argo-workflows/v3/workflow/validate"
 )
 
@@ -175,6 +176,14 @@ func (wts *WorkflowTemplateServer) DeleteWorkflowTemplate(ctx context.Context, r
 	return &workflowtemplatepkg.WorkflowTemplateDeleteResponse{}, nil
 }
 
+func (wts *WorkflowTemplateServer) ListWorkflowTemplateRevisions(ctx context.Context, req *workflowtemplatepkg.WorkflowTemplateRevisionsRequest) (*v1alpha1.WorkflowTemplateRevisionList, error) {
+	revisions, err := templaterevision.List(ctx, auth.GetKubeClient(ctx), req.Namespace, req.Name, false)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	return revisions, nil
+}
+
 func (wts *WorkflowTemplateServer) LintWorkflowTemplate(ctx context.Context, req *workflowtemplatepkg.WorkflowTemplateLintRequest) (*v1alpha1.WorkflowTemplate, error) {
 	wts.instanceIDService.Label(req.Template)
 	creator.LabelCreator(ctx, req.Template)
@@ -581,11 +581,29 @@ func (s *CLISuite) TestWorkflowDeleteByFieldSelector() {
 }
 
 func (s *CLISuite) TestWorkflowDeleteDryRun() {
+	var name string
 	s.Given().
+		Workflow("@smoke/basic.yaml").
 		When().
-		RunCli([]string{"delete", "--dry-run", "basic"}, func(t *testing.T, output string, err error) {
+		SubmitWorkflow().
+		WaitForWorkflow(fixtures.ToBeSucceeded).
+		Then().
+		ExpectWorkflow(func(t *testing.T, metadata *metav1.ObjectMeta, status *wfv1.WorkflowStatus) {
+			name = metadata.Name
+		}).
+		RunCli([]string{"delete", "--dry-run", name}, func(t *testing.T, output string, err error) {
 			require.NoError(t, err)
-			assert.Contains(t, output, "Workflow 'basic' deleted (dry-run)")
+			assert.Regexp(t, name+`\s+Succeeded`, output)
+			assert.Contains(t, output, "1 workflow would be deleted (dry-run): 1 Succeeded")
+			assert.NotContains(t, output, "' deleted")
+		}).
+		RunCli([]string{"delete", "--dry-run", "--all", "-l", "workflows.argoproj.io/test"}, func(t *testing.T, output string, err error) {
+			require.NoError(t, err)
+			assert.Regexp(t, name+`\s+Succeeded`, output)
+			assert.Contains(t, output, "1 workflow would be deleted (dry-run): 1 Succeeded")
+		}).
+		RunCli([]string{"get", name}, func(t *testing.T, output string, err error) {
+			require.NoError(t, err, "the workflow was not deleted")
 		})
 }
 
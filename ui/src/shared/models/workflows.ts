@@ -751,6 +751,12 @@ export interface WorkflowStatus {
      */
     storedTemplates: {[name: string]: Template};
 
+    /**
+     * TemplateDigests are the digests of the revisions of the WorkflowTemplates and ClusterWorkflowTemplates templates were resolved from,
+     * when the controller pins them instead of storing the resolved templates.
+     */
+    templateDigests?: {[name: string]: string};
+
     /**
      * ResourcesDuration tracks how much resources were requested.
      */
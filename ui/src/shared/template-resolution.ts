@@ -14,7 +14,8 @@ export function getResolvedTemplates(workflow: models.Workflow, node: models.Nod
         const templRef = resolveTemplateReference(scope.ResourceScope, scope.ResourceName, tmpTemplate, scope.CompatibilityMode);
         let tmpl = null;
         if (templRef.StorageNeeded) {
-            tmpl = workflow.status.storedTemplates[templRef.StoredTemplateName];
+            // templates are not stored if the controller pins the revisions of WorkflowTemplates instead
+            tmpl = workflow.status.storedTemplates?.[templRef.StoredTemplateName];
         } else if (tmpTemplate.template) {
             tmpl = execSpec(workflow).templates.find(item => item.name === tmpTemplate.template);
         }
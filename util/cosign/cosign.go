@@ -0,0 +1,46 @@
+// Package cosign verifies signatures made with a cosign (https://github.com/sigstore/cosign) key pair, e.g.
+// `cosign sign-blob --key cosign.key`.
+package cosign
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+)
+
+// PublicKeys are the ECDSA public keys of cosign key pairs
+type PublicKeys []*ecdsa.PublicKey
+
+// ParsePublicKeys parses PEM encoded public keys, field is the name of the config field they are from, for errors
+func ParsePublicKeys(field string, data []string) (PublicKeys, error) {
+	var keys PublicKeys
+	for i, d := range data {
+		block, _ := pem.Decode([]byte(d))
+		if block == nil {
+			return nil, fmt.Errorf("%s[%d] is not PEM encoded", field, i)
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%s[%d]: %w", field, i, err)
+		}
+		ecdsaKey, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("%s[%d] is not an ECDSA public key", field, i)
+		}
+		keys = append(keys, ecdsaKey)
+	}
+	return keys, nil
+}
+
+// Verifies returns whether one of the keys made the signature of the payload
+func (k PublicKeys) Verifies(payload, sig []byte) bool {
+	h := sha256.Sum256(payload)
+	for _, key := range k {
+		if ecdsa.VerifyASN1(key, h[:], sig) {
+			return true
+		}
+	}
+	return false
+}
@@ -15,10 +15,17 @@ func Expand(m map[string]interface{}) map[string]interface{} {
 // {"a.b": 1, "a": 2}
 // What should the result be? We remove the less-specific key.
 // {"a.b": 1, "a": 2} -> {"a.b": 1, "a": 2}
+// The exception is the `supplied` flag of an optional output parameter, which must not hide the parameter's value:
+// {"a.supplied": "true", "a": 2} -> {"a": 2}
 func removeConflicts(m map[string]interface{}) map[string]interface{} {
 	var keys []string
 	n := map[string]interface{}{}
 	for k, v := range m {
+		if parent, ok := strings.CutSuffix(k, ".supplied"); ok {
+			if _, ok := m[parent]; ok {
+				continue
+			}
+		}
 		keys = append(keys, k)
 		n[k] = v
 	}
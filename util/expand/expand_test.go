@@ -32,3 +32,14 @@ func TestExpand(t *testing.T) {
 		})
 	}
 }
+
+func TestExpandSupplied(t *testing.T) {
+	assert.Equal(t, map[string]interface{}{
+		"a": "foo",
+		"b": map[string]interface{}{"supplied": "false"},
+	}, Expand(map[string]interface{}{
+		"a":          "foo",
+		"a.supplied": "true",
+		"b.supplied": "false",
+	}))
+}
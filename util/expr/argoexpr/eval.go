@@ -21,3 +21,16 @@ func EvalBool(input string, env interface{}) (bool, error) {
 	}
 	return resultBool, nil
 }
+
+// EvalString evaluates the expression, and returns its result formatted as a string
+func EvalString(input string, env interface{}) (string, error) {
+	program, err := expr.Compile(input, expr.Env(env))
+	if err != nil {
+		return "", err
+	}
+	result, err := expr.Run(program, env)
+	if err != nil {
+		return "", fmt.Errorf("unable to evaluate expression '%s': %s", input, err)
+	}
+	return fmt.Sprint(result), nil
+}
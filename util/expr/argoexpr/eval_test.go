@@ -83,3 +83,30 @@ func TestEvalBool(t *testing.T) {
 		})
 	}
 }
+
+func TestEvalString(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		env     interface{}
+		want    string
+		wantErr bool
+	}{
+		{name: "string", input: "FOO", env: map[string]interface{}{"FOO": "bar"}, want: "bar"},
+		{name: "number", input: "FOO + 1", env: map[string]interface{}{"FOO": 1}, want: "2"},
+		{name: "bool", input: "FOO == 1", env: map[string]interface{}{"FOO": 1}, want: "true"},
+		{name: "invalid expression", input: "invalid expression", env: map[string]interface{}{}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EvalString(tt.input, tt.env)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EvalString() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("EvalString() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
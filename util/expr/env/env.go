@@ -26,6 +26,7 @@ func GetFuncMap(m map[string]interface{}) map[string]interface{} {
 	env["jsonpath"] = jsonPath
 	env["toJson"] = toJSON
 	env["sprig"] = sprigFuncMap
+	addEnabledFunctions(env)
 	return env
 }
 
@@ -0,0 +1,111 @@
+package env
+
+import (
+	"crypto/md5"  //nolint:gosec
+	"crypto/sha1" //nolint:gosec
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	functionsMu sync.RWMutex
+	// functions are the optional functions that can be enabled, keyed by name
+	functions = map[string]interface{}{
+		"uuid":         uuidFunc,
+		"hash":         hashFunc,
+		"regexReplace": regexReplace,
+		"formatTime":   formatTime,
+	}
+	// enabled are the optional functions added to the expression environment
+	enabled = map[string]interface{}{}
+)
+
+// RegisterFunction adds a function that can be enabled by name in the controller configuration.
+// It is intended for custom builds, which register their functions in an init function.
+func RegisterFunction(name string, fn interface{}) {
+	functionsMu.Lock()
+	defer functionsMu.Unlock()
+	functions[name] = fn
+}
+
+// EnableFunctions replaces the optional functions available in expressions, it returns an error if any are unknown
+func EnableFunctions(names []string) error {
+	functionsMu.Lock()
+	defer functionsMu.Unlock()
+	fns := make(map[string]interface{}, len(names))
+	for _, name := range names {
+		fn, ok := functions[name]
+		if !ok {
+			return fmt.Errorf("unknown expression function %q, must be one of %v", name, functionNames())
+		}
+		fns[name] = fn
+	}
+	enabled = fns
+	return nil
+}
+
+func functionNames() []string {
+	names := make([]string, 0, len(functions))
+	for name := range functions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func addEnabledFunctions(env map[string]interface{}) {
+	functionsMu.RLock()
+	defer functionsMu.RUnlock()
+	for name, fn := range enabled {
+		env[name] = fn
+	}
+}
+
+func uuidFunc() string {
+	return uuid.New().String()
+}
+
+// hashFunc returns the hex encoded digest of s using the given algorithm
+func hashFunc(algorithm string, s string) string {
+	var h hash.Hash
+	switch algorithm {
+	case "md5":
+		h = md5.New() //nolint:gosec
+	case "sha1":
+		h = sha1.New() //nolint:gosec
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		panic(fmt.Errorf("unknown hash algorithm %q, must be one of md5, sha1, sha256, sha512", algorithm))
+	}
+	h.Write([]byte(s))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func regexReplace(s string, pattern string, replacement string) string {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		panic(err)
+	}
+	return re.ReplaceAllString(s, replacement)
+}
+
+// formatTime formats an RFC3339 timestamp, such as `workflow.creationTimestamp.RFC3339`, using a Go time layout
+func formatTime(value string, layout string) string {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		panic(err)
+	}
+	return t.Format(layout)
+}
@@ -0,0 +1,45 @@
+package env
+
+import (
+	"testing"
+
+	"github.com/expr-lang/expr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnableFunctions(t *testing.T) {
+	defer func() { _ = EnableFunctions(nil) }()
+	eval := func(code string) (interface{}, error) {
+		return expr.Eval(code, GetFuncMap(map[string]interface{}{"ts": "2024-03-01T12:00:00Z"}))
+	}
+
+	_, err := eval(`hash('sha256', 'foo')`)
+	require.Error(t, err, "functions are not available until enabled")
+
+	require.EqualError(t, EnableFunctions([]string{"nope"}), `unknown expression function "nope", must be one of [formatTime hash regexReplace uuid]`)
+	require.NoError(t, EnableFunctions([]string{"uuid", "hash", "regexReplace", "formatTime"}))
+
+	v, err := eval(`hash('sha256', 'foo')`)
+	require.NoError(t, err)
+	assert.Equal(t, "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae", v)
+	v, err = eval(`regexReplace('feature/My_Branch', '[^a-zA-Z0-9]+', '-')`)
+	require.NoError(t, err)
+	assert.Equal(t, "feature-My-Branch", v)
+	v, err = eval(`formatTime(ts, '2006-01-02')`)
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03-01", v)
+	v, err = eval(`uuid()`)
+	require.NoError(t, err)
+	assert.Len(t, v, 36)
+	_, err = eval(`hash('crc', 'foo')`)
+	require.Error(t, err)
+
+	t.Run("RegisterFunction", func(t *testing.T) {
+		RegisterFunction("double", func(s string) string { return s + s })
+		require.NoError(t, EnableFunctions([]string{"double"}))
+		v, err := eval(`double('ab')`)
+		require.NoError(t, err)
+		assert.Equal(t, "abab", v)
+	})
+}
@@ -1,14 +1,19 @@
 package grpc
 
 import (
+	"fmt"
 	"net/http"
 	"net/textproto"
 	"strings"
 
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 )
 
+// RetryAfterHeader is the gRPC metadata key that is forwarded as the HTTP Retry-After header
+const RetryAfterHeader = "retry-after"
+
 func IncomingHeaderMatcher(key string) (string, bool) {
 	switch textproto.CanonicalMIMEHeaderKey(key) {
 	case
@@ -36,6 +41,15 @@ func IncomingHeaderMatcher(key string) (string, bool) {
 	}
 }
 
+// OutgoingHeaderMatcher forwards Retry-After as-is, so HTTP clients can back off, and
+// any other metadata with the gateway's default "Grpc-Metadata-" prefix.
+func OutgoingHeaderMatcher(key string) (string, bool) {
+	if key == RetryAfterHeader {
+		return "Retry-After", true
+	}
+	return fmt.Sprintf("%s%s", runtime.MetadataHeaderPrefix, key), true
+}
+
 // NewMuxHandler returns an HTTP handler that allows serving both gRPC and
 // HTTP requests over the same port, both with and without TLS enabled.
 // From: https://pkg.go.dev/golang.org/x/net@v0.41.0/http2/h2c#NewHandler
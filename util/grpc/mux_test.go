@@ -46,6 +46,15 @@ func TestIncomingHeaderMatcher(t *testing.T) {
 	}
 }
 
+func TestOutgoingHeaderMatcher(t *testing.T) {
+	key, valid := OutgoingHeaderMatcher("retry-after")
+	assert.True(t, valid)
+	assert.Equal(t, "Retry-After", key)
+	key, valid = OutgoingHeaderMatcher("x-foo")
+	assert.True(t, valid)
+	assert.Equal(t, "Grpc-Metadata-x-foo", key)
+}
+
 func TestNewMuxHandler(t *testing.T) {
 	grpcHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(201)
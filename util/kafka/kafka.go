@@ -0,0 +1,100 @@
+package kafka
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/util"
+)
+
+// ClientOpts returns the options to connect to the brokers, reading any TLS and SASL secrets from the namespace
+func ClientOpts(ctx context.Context, kubeclientset kubernetes.Interface, namespace string, brokers []string, tlsConfig *config.KafkaTLSConfig, saslConfig *config.KafkaSASLConfig) ([]kgo.Opt, error) {
+	if len(brokers) == 0 {
+		return nil, fmt.Errorf("brokers is required")
+	}
+	opts := []kgo.Opt{kgo.SeedBrokers(brokers...)}
+	getSecret := func(s *apiv1.SecretKeySelector) ([]byte, error) {
+		return util.GetSecrets(ctx, kubeclientset, namespace, s.Name, s.Key)
+	}
+	if tlsConfig != nil {
+		c, err := NewTLSConfig(tlsConfig, getSecret)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, kgo.DialTLSConfig(c))
+	}
+	if saslConfig != nil {
+		mechanism, err := newSASLMechanism(saslConfig, getSecret)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, kgo.SASL(mechanism))
+	}
+	return opts, nil
+}
+
+// NewTLSConfig creates a client TLS config, getting the CA and client certificates using getSecret
+func NewTLSConfig(c *config.KafkaTLSConfig, getSecret func(*apiv1.SecretKeySelector) ([]byte, error)) (*tls.Config, error) {
+	// nolint: gosec
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12, InsecureSkipVerify: c.InsecureSkipVerify}
+	if c.CASecret != nil {
+		ca, err := getSecret(c.CASecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get CA certificate: %w", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("failed to parse CA certificate")
+		}
+	}
+	if (c.CertSecret == nil) != (c.KeySecret == nil) {
+		return nil, fmt.Errorf("certSecret and keySecret must both be specified")
+	}
+	if c.CertSecret != nil {
+		cert, err := getSecret(c.CertSecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get client certificate: %w", err)
+		}
+		key, err := getSecret(c.KeySecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get client key: %w", err)
+		}
+		pair, err := tls.X509KeyPair(cert, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{pair}
+	}
+	return tlsConfig, nil
+}
+
+func newSASLMechanism(c *config.KafkaSASLConfig, getSecret func(*apiv1.SecretKeySelector) ([]byte, error)) (sasl.Mechanism, error) {
+	user, err := getSecret(&c.UserSecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SASL user: %w", err)
+	}
+	password, err := getSecret(&c.PasswordSecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SASL password: %w", err)
+	}
+	switch c.Mechanism {
+	case "", "PLAIN":
+		return plain.Auth{User: string(user), Pass: string(password)}.AsMechanism(), nil
+	case "SCRAM-SHA-256":
+		return scram.Auth{User: string(user), Pass: string(password)}.AsSha256Mechanism(), nil
+	case "SCRAM-SHA-512":
+		return scram.Auth{User: string(user), Pass: string(password)}.AsSha512Mechanism(), nil
+	default:
+		return nil, fmt.Errorf("unsupported SASL mechanism %q", c.Mechanism)
+	}
+}
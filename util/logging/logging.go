@@ -168,6 +168,17 @@ type Logger interface {
 	Level() Level
 }
 
+// SetLevel sets the level of the logger, and of the loggers derived from it, at runtime. It errors if the logger does
+// not support that.
+func SetLevel(logger Logger, level Level) error {
+	l, ok := logger.(interface{ SetLevel(level Level) })
+	if !ok {
+		return fmt.Errorf("logger %T does not support setting the level", logger)
+	}
+	l.SetLevel(level)
+	return nil
+}
+
 // RequireLoggerFromContext returns a logger from context, panics if not found
 // This should be used almost
 func RequireLoggerFromContext(ctx context.Context) Logger {
@@ -11,7 +11,7 @@ import (
 type slogLogger struct {
 	fields    Fields
 	logger    *slog.Logger
-	level     Level
+	level     *slog.LevelVar
 	hooks     map[Level][]Hook
 	withPanic bool
 	withFatal bool
@@ -51,13 +51,16 @@ func NewSlogLoggerCustom(logLevel Level, format LogType, out io.Writer, hooks ..
 		}
 	}
 
+	// the level is shared by the loggers derived from this one, so that setting it sets theirs too
+	level := &slog.LevelVar{}
+	level.Set(convertLevel(logLevel))
 	switch format {
 	case JSON:
-		handler = slog.NewJSONHandler(out, &slog.HandlerOptions{Level: convertLevel(logLevel)})
+		handler = slog.NewJSONHandler(out, &slog.HandlerOptions{Level: level})
 	case Text:
-		handler = slog.NewTextHandler(out, &slog.HandlerOptions{Level: convertLevel(logLevel)})
+		handler = slog.NewTextHandler(out, &slog.HandlerOptions{Level: level})
 	default:
-		handler = slog.NewJSONHandler(out, &slog.HandlerOptions{Level: convertLevel(logLevel)})
+		handler = slog.NewJSONHandler(out, &slog.HandlerOptions{Level: level})
 	}
 
 	f := make(Fields)
@@ -65,7 +68,7 @@ func NewSlogLoggerCustom(logLevel Level, format LogType, out io.Writer, hooks ..
 	s := slogLogger{
 		fields: f,
 		logger: l,
-		level:  logLevel,
+		level:  level,
 		hooks:  mappedHooks,
 	}
 	// nolint:contextcheck
@@ -74,7 +77,12 @@ func NewSlogLoggerCustom(logLevel Level, format LogType, out io.Writer, hooks ..
 }
 
 func (s *slogLogger) Level() Level {
-	return s.level
+	return convertSlogLevel(s.level.Level())
+}
+
+// SetLevel sets the level of the logger, and of the loggers derived from it
+func (s *slogLogger) SetLevel(level Level) {
+	s.level.Set(convertLevel(level))
 }
 
 func (s *slogLogger) WithFields(fields Fields) Logger {
@@ -212,6 +220,20 @@ func convertLevel(level Level) slog.Level {
 	}
 }
 
+// convertSlogLevel converts slog.Level to our Level type
+func convertSlogLevel(level slog.Level) Level {
+	switch {
+	case level <= slog.LevelDebug:
+		return Debug
+	case level <= slog.LevelInfo:
+		return Info
+	case level <= slog.LevelWarn:
+		return Warn
+	default:
+		return Error
+	}
+}
+
 func (s *slogLogger) InContext(ctx context.Context) (context.Context, Logger) {
 	return WithLogger(ctx, s), s
 }
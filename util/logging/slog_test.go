@@ -0,0 +1,29 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetLevel(t *testing.T) {
+	resetInitStorage()
+	var buf bytes.Buffer
+	ctx := context.Background()
+	logger := NewSlogLoggerCustom(Info, Text, &buf)
+	derived := logger.WithField("component", "test")
+
+	derived.Debug(ctx, "hidden")
+	assert.Empty(t, buf.String())
+
+	require.NoError(t, SetLevel(logger, Debug))
+	assert.Equal(t, Debug, logger.Level())
+	assert.Equal(t, Debug, derived.Level())
+	derived.Debug(ctx, "shown")
+	assert.Contains(t, buf.String(), "shown")
+
+	require.Error(t, SetLevel(InitLogger(), Debug))
+}
@@ -0,0 +1,54 @@
+package printer
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/printers"
+)
+
+const (
+	jsonPathOutputPrefix   = "jsonpath="
+	goTemplateOutputPrefix = "go-template="
+)
+
+// TemplateOutputPrefixes are the prefixes of the output formats which print an object with a template, as kubectl does,
+// e.g. `-o jsonpath={.metadata.name}` or `-o go-template={{.metadata.name}}`
+var TemplateOutputPrefixes = []string{jsonPathOutputPrefix, goTemplateOutputPrefix}
+
+// IsTemplateOutput returns whether the output format prints with a template
+func IsTemplateOutput(output string) bool {
+	for _, prefix := range TemplateOutputPrefixes {
+		if strings.HasPrefix(output, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// PrintTemplate prints the object with the JSONPath or Go template of the output format. As with kubectl, missing
+// keys are printed as empty, and no newline is printed after the template.
+func PrintTemplate(out io.Writer, output string, obj runtime.Object) error {
+	var printer printers.ResourcePrinter
+	switch {
+	case strings.HasPrefix(output, jsonPathOutputPrefix):
+		p, err := printers.NewJSONPathPrinter(strings.TrimPrefix(output, jsonPathOutputPrefix))
+		if err != nil {
+			return fmt.Errorf("error parsing jsonpath template: %w", err)
+		}
+		p.AllowMissingKeys(true)
+		printer = p
+	case strings.HasPrefix(output, goTemplateOutputPrefix):
+		p, err := printers.NewGoTemplatePrinter([]byte(strings.TrimPrefix(output, goTemplateOutputPrefix)))
+		if err != nil {
+			return fmt.Errorf("error parsing go-template: %w", err)
+		}
+		p.AllowMissingKeys(true)
+		printer = p
+	default:
+		return fmt.Errorf("unknown output mode: %s", output)
+	}
+	return printer.PrintObj(obj, out)
+}
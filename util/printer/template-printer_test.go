@@ -0,0 +1,49 @@
+package printer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestPrintTemplate(t *testing.T) {
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wf"},
+		Status:     wfv1.WorkflowStatus{Phase: wfv1.WorkflowSucceeded},
+	}
+	list := &wfv1.WorkflowList{Items: wfv1.Workflows{*wf, {ObjectMeta: metav1.ObjectMeta{Name: "my-other-wf"}}}}
+	t.Run("IsTemplateOutput", func(t *testing.T) {
+		assert.True(t, IsTemplateOutput("jsonpath={.metadata.name}"))
+		assert.True(t, IsTemplateOutput("go-template={{.metadata.name}}"))
+		assert.False(t, IsTemplateOutput("json"))
+	})
+	t.Run("JSONPath", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		require.NoError(t, PrintTemplate(out, "jsonpath={.metadata.name} {.status.phase} {.status.message}", wf))
+		assert.Equal(t, "my-wf Succeeded ", out.String())
+	})
+	t.Run("JSONPathList", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		require.NoError(t, PrintTemplate(out, "jsonpath={.items[*].metadata.name}", list))
+		assert.Equal(t, "my-wf my-other-wf", out.String())
+	})
+	t.Run("GoTemplate", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		require.NoError(t, PrintTemplate(out, `go-template={{range .items}}{{.metadata.name}}={{.status.phase}};{{end}}`, list))
+		assert.Equal(t, "my-wf=Succeeded;my-other-wf=<no value>;", out.String())
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		require.ErrorContains(t, PrintTemplate(&bytes.Buffer{}, "jsonpath={.metadata.name", wf), "error parsing jsonpath template")
+		require.ErrorContains(t, PrintTemplate(&bytes.Buffer{}, "go-template={{.metadata.name", wf), "error parsing go-template")
+	})
+	t.Run("PrintWorkflows", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		require.NoError(t, PrintWorkflows(list.Items, out, PrintOpts{Output: "jsonpath={.items[0].metadata.name}"}))
+		assert.Equal(t, "my-wf", out.String())
+	})
+}
@@ -16,6 +16,9 @@ import (
 )
 
 func PrintWorkflows(workflows wfv1.Workflows, out io.Writer, opts PrintOpts) error {
+	if IsTemplateOutput(opts.Output) {
+		return PrintTemplate(out, opts.Output, &wfv1.WorkflowList{Items: workflows})
+	}
 	if len(workflows) == 0 {
 		if opts.Output == "json" || opts.Output == "yaml" {
 			_, _ = fmt.Fprintln(out, "[]")
@@ -0,0 +1,39 @@
+package resource
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// CostForPod estimates the cost of the pod's resources duration, or returns nil if there is no pricing
+func CostForPod(pricing *config.Pricing, pod *corev1.Pod, d wfv1.ResourcesDuration) *wfv1.Amount {
+	if pricing == nil {
+		return nil
+	}
+	return newCost(pricing.Cost(pod.Spec.NodeSelector, d))
+}
+
+func newCost(v float64) *wfv1.Amount {
+	// rounded to a millionth, so that sums do not accumulate floating point noise
+	v = math.Round(v*1e6) / 1e6
+	return &wfv1.Amount{Value: json.Number(strconv.FormatFloat(v, 'f', -1, 64))}
+}
+
+// addCost adds the costs, either of which may be nil
+func addCost(a, b *wfv1.Amount) *wfv1.Amount {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	x, _ := a.Float64()
+	y, _ := b.Float64()
+	return newCost(x + y)
+}
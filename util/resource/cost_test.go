@@ -0,0 +1,37 @@
+package resource
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestCostForPod(t *testing.T) {
+	d := wfv1.ResourcesDuration{
+		corev1.ResourceCPU:    wfv1.NewResourceDuration(2 * time.Hour),
+		corev1.ResourceMemory: wfv1.NewResourceDuration(20 * time.Hour), // 20 x 100Mi hours
+		"nvidia.com/gpu":      wfv1.NewResourceDuration(time.Hour),
+	}
+	pod := &corev1.Pod{}
+	t.Run("NoPricing", func(t *testing.T) {
+		assert.Nil(t, CostForPod(nil, pod, d))
+	})
+	pricing := &config.Pricing{
+		Prices:        config.PricingRates{CPUHour: 0.5, MemoryGBHour: 0.1, GPUHour: 2},
+		NodePoolLabel: "pool",
+		NodePools:     map[string]config.PricingRates{"spot": {CPUHour: 0.1}},
+	}
+	t.Run("Default", func(t *testing.T) {
+		// 2 x 0.5 + 20 x 100 / 1024 x 0.1 + 2
+		assert.Equal(t, "3.195313", string(CostForPod(pricing, pod, d).Value))
+	})
+	t.Run("NodePool", func(t *testing.T) {
+		pod := &corev1.Pod{Spec: corev1.PodSpec{NodeSelector: map[string]string{"pool": "spot"}}}
+		assert.Equal(t, "0.2", string(CostForPod(pricing, pod, d).Value))
+	})
+}
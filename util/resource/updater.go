@@ -9,21 +9,24 @@ import (
 
 func UpdateResourceDurations(ctx context.Context, wf *wfv1.Workflow) {
 	wf.Status.ResourcesDuration = wfv1.ResourcesDuration{}
+	wf.Status.EstimatedCost = nil
 	for nodeID, node := range wf.Status.Nodes {
 		// pods are already calculated and so we do not need to compute them,
 		// AND they are the only node that should contribute to the total
 		if node.Type == wfv1.NodeTypePod {
 			wf.Status.ResourcesDuration = wf.Status.ResourcesDuration.Add(node.ResourcesDuration)
+			wf.Status.EstimatedCost = addCost(wf.Status.EstimatedCost, node.EstimatedCost)
 		} else if node.Fulfilled() {
 			// compute the sum of all children
-			node.ResourcesDuration = resourceDuration(ctx, wf, node, make(map[string]bool))
+			node.ResourcesDuration, node.EstimatedCost = resourceDuration(ctx, wf, node, make(map[string]bool))
 			wf.Status.Nodes.Set(ctx, nodeID, node)
 		}
 	}
 }
 
-func resourceDuration(ctx context.Context, wf *wfv1.Workflow, node wfv1.NodeStatus, visited map[string]bool) wfv1.ResourcesDuration {
+func resourceDuration(ctx context.Context, wf *wfv1.Workflow, node wfv1.NodeStatus, visited map[string]bool) (wfv1.ResourcesDuration, *wfv1.Amount) {
 	v := wfv1.ResourcesDuration{}
+	var cost *wfv1.Amount
 	for _, childID := range node.Children {
 		// we do not want to visit the same node twice, as will (a) do 2x work and (b) make `v` incorrect
 		if visited[childID] {
@@ -37,8 +40,11 @@ func resourceDuration(ctx context.Context, wf *wfv1.Workflow, node wfv1.NodeStat
 		}
 		if child.Type == wfv1.NodeTypePod {
 			v = v.Add(child.ResourcesDuration)
+			cost = addCost(cost, child.EstimatedCost)
 		}
-		v = v.Add(resourceDuration(ctx, wf, *child, visited))
+		childDuration, childCost := resourceDuration(ctx, wf, *child, visited)
+		v = v.Add(childDuration)
+		cost = addCost(cost, childCost)
 	}
-	return v
+	return v, cost
 }
@@ -40,3 +40,29 @@ status:
 	assert.Equal(t, wfv1.ResourcesDuration{"x": 3}, wf.Status.Nodes["root"].ResourcesDuration)
 	assert.Equal(t, wfv1.ResourcesDuration{"x": 3}, wf.Status.ResourcesDuration)
 }
+
+func TestUpdaterEstimatedCost(t *testing.T) {
+	wf := &wfv1.Workflow{}
+	ctx := logging.TestContext(t.Context())
+	wfv1.MustUnmarshal(`
+status:
+  nodes:
+    root:
+      phase: Succeeded
+      children: [pod-1, pod-2, pod-3]
+    pod-1:
+      phase: Succeeded
+      type: Pod
+      estimatedCost: 0.1
+    pod-2:
+      phase: Succeeded
+      type: Pod
+      estimatedCost: 0.2
+    pod-3:
+      phase: Succeeded
+      type: Pod
+`, wf)
+	UpdateResourceDurations(ctx, wf)
+	assert.Equal(t, "0.3", string(wf.Status.Nodes["root"].EstimatedCost.Value))
+	assert.Equal(t, "0.3", string(wf.Status.EstimatedCost.Value))
+}
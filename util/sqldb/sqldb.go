@@ -2,7 +2,10 @@ package sqldb
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"maps"
+	"strconv"
 	"time"
 
 	"github.com/upper/db/v4"
@@ -71,13 +74,8 @@ func createPostGresDBSessionWithCreds(cfg *config.PostgreSQLConfig, persistPool
 		Database: cfg.Database,
 	}
 
-	if cfg.SSL {
-		if cfg.SSLMode != "" {
-			options := map[string]string{
-				"sslmode": cfg.SSLMode,
-			}
-			settings.Options = options
-		}
+	if options := postgresOptions(cfg, persistPool); len(options) > 0 {
+		settings.Options = options
 	}
 
 	session, err := postgresqladp.Open(settings)
@@ -95,7 +93,7 @@ func createMySQLDBSessionWithCreds(cfg *config.MySQLConfig, persistPool *config.
 		Password: password,
 		Host:     cfg.GetHostname(),
 		Database: cfg.Database,
-		Options:  cfg.Options,
+		Options:  mysqlOptions(cfg, persistPool),
 	})
 	if err != nil {
 		return nil, err
@@ -119,6 +117,51 @@ func ConfigureDBSession(session db.Session, dbPool *config.ConnectionPool) db.Se
 		session.SetMaxOpenConns(dbPool.MaxOpenConns)
 		session.SetMaxIdleConns(dbPool.MaxIdleConns)
 		session.SetConnMaxLifetime(time.Duration(dbPool.ConnMaxLifetime))
+		session.SetConnMaxIdleTime(time.Duration(dbPool.ConnMaxIdleTime))
 	}
 	return session
 }
+
+// statementTimeout returns the configured statement timeout, or 0 if there is none
+func statementTimeout(dbPool *config.ConnectionPool) time.Duration {
+	if dbPool == nil {
+		return 0
+	}
+	return time.Duration(dbPool.StatementTimeout)
+}
+
+// postgresOptions returns the PostgreSQL connection options for the configuration
+func postgresOptions(cfg *config.PostgreSQLConfig, dbPool *config.ConnectionPool) map[string]string {
+	options := map[string]string{}
+	if cfg.SSL && cfg.SSLMode != "" {
+		options["sslmode"] = cfg.SSLMode
+	}
+	if timeout := statementTimeout(dbPool); timeout > 0 {
+		options["statement_timeout"] = strconv.FormatInt(timeout.Milliseconds(), 10)
+	}
+	return options
+}
+
+// mysqlOptions returns the MySQL connection options for the configuration, without modifying cfg.Options
+func mysqlOptions(cfg *config.MySQLConfig, dbPool *config.ConnectionPool) map[string]string {
+	options := make(map[string]string, len(cfg.Options)+1)
+	maps.Copy(options, cfg.Options)
+	if timeout := statementTimeout(dbPool); timeout > 0 {
+		// max_execution_time only applies to read-only SELECT statements in MySQL
+		options["max_execution_time"] = strconv.FormatInt(timeout.Milliseconds(), 10)
+	}
+	return options
+}
+
+// Stats returns the connection pool statistics for the session, or nil if they are unavailable
+func Stats(session db.Session) *sql.DBStats {
+	if session == nil {
+		return nil
+	}
+	sqlDB, ok := session.Driver().(*sql.DB)
+	if !ok {
+		return nil
+	}
+	stats := sqlDB.Stats()
+	return &stats
+}
@@ -0,0 +1,52 @@
+package sqldb
+
+import (
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+func TestPostgresOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      config.PostgreSQLConfig
+		pool     *config.ConnectionPool
+		expected map[string]string
+	}{
+		{"NoOptions", config.PostgreSQLConfig{}, nil, map[string]string{}},
+		{"SSLWithoutMode", config.PostgreSQLConfig{SSL: true}, nil, map[string]string{}},
+		{"SSLMode", config.PostgreSQLConfig{SSL: true, SSLMode: "require"}, nil, map[string]string{"sslmode": "require"}},
+		{"ZeroTimeout", config.PostgreSQLConfig{}, &config.ConnectionPool{}, map[string]string{}},
+		{"StatementTimeout", config.PostgreSQLConfig{}, &config.ConnectionPool{StatementTimeout: config.TTL(30 * time.Second)}, map[string]string{"statement_timeout": "30000"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, postgresOptions(&tt.cfg, tt.pool))
+		})
+	}
+}
+
+func TestMySQLOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  map[string]string
+		pool     *config.ConnectionPool
+		expected map[string]string
+	}{
+		{"NoOptions", nil, nil, map[string]string{}},
+		{"UserOptions", map[string]string{"tls": "true"}, nil, map[string]string{"tls": "true"}},
+		{"StatementTimeout", map[string]string{"tls": "true"}, &config.ConnectionPool{StatementTimeout: config.TTL(1500 * time.Millisecond)}, map[string]string{"tls": "true", "max_execution_time": "1500"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.MySQLConfig{Options: tt.options}
+			original := maps.Clone(tt.options)
+			assert.Equal(t, tt.expected, mysqlOptions(&cfg, tt.pool))
+			assert.Equal(t, original, cfg.Options, "the configured options must not be modified")
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package telemetry
+
+import (
+	"context"
+	"time"
+)
+
+// AddArtifactMetrics creates the instruments for artifact transfers by executors
+func AddArtifactMetrics(_ context.Context, m *Metrics) error {
+	for _, inst := range []BuiltinInstrument{
+		InstrumentArtifactTransferBytes,
+		InstrumentArtifactTransferDuration,
+		InstrumentArtifactTransferFailures,
+	} {
+		if err := m.CreateBuiltinInstrument(inst); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ArtifactTransfer records an artifact operation, and the bytes it transferred if it succeeded
+func (m *Metrics) ArtifactTransfer(ctx context.Context, driver, repository, operation string, bytes int64, duration time.Duration, err error) {
+	if m == nil {
+		return
+	}
+	attribs := InstAttribs{
+		{Name: AttribArtifactDriver, Value: driver},
+		{Name: AttribArtifactRepository, Value: repository},
+		{Name: AttribArtifactOperation, Value: operation},
+	}
+	m.Record(ctx, InstrumentArtifactTransferDuration.Name(), duration.Seconds(), attribs)
+	if err != nil {
+		m.AddInt(ctx, InstrumentArtifactTransferFailures.Name(), 1, attribs)
+		return
+	}
+	if bytes > 0 {
+		m.AddInt(ctx, InstrumentArtifactTransferBytes.Name(), bytes, attribs)
+	}
+}
@@ -2,35 +2,54 @@
 package telemetry
 
 const (
-	AttribBuildCompiler     string = `compiler`
-	AttribBuildDate         string = `build_date`
-	AttribBuildGitCommit    string = `git_commit`
-	AttribBuildGitTag       string = `git_tag`
-	AttribBuildGitTreeState string = `git_tree_state`
-	AttribBuildGoVersion    string = `go_version`
-	AttribBuildPlatform     string = `platform`
-	AttribBuildVersion      string = `version`
-	AttribConcurrencyPolicy string = `concurrency_policy`
-	AttribCronWFName        string = `name`
-	AttribCronWFNamespace   string = `namespace`
-	AttribDeprecatedFeature string = `feature`
-	AttribErrorCause        string = `cause`
-	AttribLogLevel          string = `level`
-	AttribNodePhase         string = `node_phase`
-	AttribPodNamespace      string = `namespace`
-	AttribPodPendingReason  string = `reason`
-	AttribPodPhase          string = `phase`
-	AttribQueueName         string = `queue_name`
-	AttribRecentlyStarted   string = `recently_started`
-	AttribRequestCode       string = `status_code`
-	AttribRequestKind       string = `kind`
-	AttribRequestVerb       string = `verb`
-	AttribTemplateCluster   string = `cluster_scope`
-	AttribTemplateName      string = `name`
-	AttribTemplateNamespace string = `namespace`
-	AttribWorkerType        string = `worker_type`
-	AttribWorkflowNamespace string = `namespace`
-	AttribWorkflowPhase     string = `phase`
-	AttribWorkflowStatus    string = `status`
-	AttribWorkflowType      string = `type`
+	AttribArtifactDriver       string = `driver`
+	AttribArtifactOperation    string = `operation`
+	AttribArtifactRepository   string = `repository`
+	AttribBuildCompiler        string = `compiler`
+	AttribBuildDate            string = `build_date`
+	AttribBuildGitCommit       string = `git_commit`
+	AttribBuildGitTag          string = `git_tag`
+	AttribBuildGitTreeState    string = `git_tree_state`
+	AttribBuildGoVersion       string = `go_version`
+	AttribBuildPlatform        string = `platform`
+	AttribBuildVersion         string = `version`
+	AttribCacheName            string = `cache_name`
+	AttribConcurrencyPolicy    string = `concurrency_policy`
+	AttribCronWFName           string = `name`
+	AttribCronWFNamespace      string = `namespace`
+	AttribDBConnectionState    string = `state`
+	AttribDBOperation          string = `operation`
+	AttribDeprecatedFeature    string = `feature`
+	AttribErrorCause           string = `cause`
+	AttribEventBindingName     string = `binding`
+	AttribEventNamespace       string = `namespace`
+	AttribEventQuotaReason     string = `reason`
+	AttribLeaderTransition     string = `transition`
+	AttribLogLevel             string = `level`
+	AttribMemoizationResult    string = `result`
+	AttribMetricName           string = `metric`
+	AttribMetricOverflow       string = `overflow`
+	AttribNodePhase            string = `node_phase`
+	AttribNodeTemplate         string = `template`
+	AttribNodeTemplateRef      string = `template_ref`
+	AttribPodNamespace         string = `namespace`
+	AttribPodPendingReason     string = `reason`
+	AttribPodPhase             string = `phase`
+	AttribQueueName            string = `queue_name`
+	AttribRateLimiter          string = `limiter`
+	AttribRecentlyStarted      string = `recently_started`
+	AttribRequestCode          string = `status_code`
+	AttribRequestKind          string = `kind`
+	AttribRequestVerb          string = `verb`
+	AttribResourceName         string = `resource`
+	AttribTemplateCluster      string = `cluster_scope`
+	AttribTemplateName         string = `name`
+	AttribTemplateNamespace    string = `namespace`
+	AttribWorkerType           string = `worker_type`
+	AttribWorkflowGroup        string = `group`
+	AttribWorkflowNamespace    string = `namespace`
+	AttribWorkflowPhase        string = `phase`
+	AttribWorkflowStatus       string = `status`
+	AttribWorkflowType         string = `type`
+	AttribWorkflowUpdateMethod string = `method`
 )
@@ -151,6 +151,8 @@ func validateMetrics(metrics *metricsList) {
 		switch metric.Type {
 		case "Float64Histogram":
 		case "Float64ObservableGauge":
+		case "Float64ObservableCounter":
+		case "Float64Counter":
 		case "Int64Counter":
 		case "Int64UpDownCounter":
 		case "Int64ObservableGauge":
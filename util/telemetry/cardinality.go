@@ -0,0 +1,109 @@
+package telemetry
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// overflowSet is the series that the measurements of new series are folded into once an instrument has its maximum
+// number of series
+var overflowSet = attribute.NewSet(attribute.String(AttribMetricOverflow, "true"))
+
+// seriesLimiter limits the number of series of an instrument
+type seriesLimiter struct {
+	mutex      sync.Mutex
+	max        int
+	series     map[attribute.Distinct]struct{}
+	overflowed bool
+}
+
+func newSeriesLimiter(maxSeries int) *seriesLimiter {
+	if maxSeries <= 0 {
+		return nil
+	}
+	return &seriesLimiter{max: maxSeries, series: make(map[attribute.Distinct]struct{})}
+}
+
+// admit returns whether the series may be recorded, and whether this is the first series that may not
+func (l *seriesLimiter) admit(set attribute.Set) (bool, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	key := set.Equivalent()
+	if _, ok := l.series[key]; ok {
+		return true, false
+	}
+	if len(l.series) < l.max {
+		l.series[key] = struct{}{}
+		return true, false
+	}
+	first := !l.overflowed
+	l.overflowed = true
+	return false, first
+}
+
+// forget frees the series' place, once it is no longer recorded
+func (l *seriesLimiter) forget(set attribute.Set) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	delete(l.series, set.Equivalent())
+}
+
+// maxSeries returns the maximum number of series of the named instrument, or zero for no maximum
+func (m *Metrics) maxSeries(name string) int {
+	if opts, ok := m.config.Modifiers[name]; ok && opts.MaxSeries > 0 {
+		return opts.MaxSeries
+	}
+	return m.config.MaxSeries
+}
+
+// limited returns whether any instrument has a maximum number of series
+func (c *Config) limited() bool {
+	if c.MaxSeries > 0 {
+		return true
+	}
+	for _, modifier := range c.Modifiers {
+		if modifier.MaxSeries > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func addCardinalityOverflowCounter(_ context.Context, m *Metrics) error {
+	return m.CreateBuiltinInstrument(InstrumentCardinalityOverflow)
+}
+
+// limitSeries returns the attributes to record the measurement with, which are the overflow series' if the
+// instrument has its maximum number of series
+func (i *Instrument) limitSeries(ctx context.Context, set attribute.Set) attribute.Set {
+	if i.limiter == nil {
+		return set
+	}
+	admitted, first := i.limiter.admit(set)
+	if admitted {
+		return set
+	}
+	if first {
+		if logger := logging.GetLoggerFromContextOrNil(ctx); logger != nil {
+			logger.WithFields(logging.Fields{"name": i.name, "maxSeries": i.limiter.max}).
+				Warn(ctx, "Metric has its maximum number of series, new series are folded into the overflow series")
+		}
+	}
+	if i.overflowCounter != nil {
+		i.overflowCounter.AddInt(ctx, 1, InstAttribs{{Name: AttribMetricName, Value: i.name}})
+	}
+	return overflowSet
+}
+
+// ForgetSeries frees the place of a series that is no longer recorded, such as a deleted custom metric's, so that
+// it does not count towards the instrument's maximum number of series
+func (i *Instrument) ForgetSeries(attribs InstAttribs) {
+	if i.limiter == nil {
+		return
+	}
+	i.limiter.forget(attribute.NewSet(i.keyValues(context.Background(), attribs)...))
+}
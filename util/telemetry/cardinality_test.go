@@ -0,0 +1,59 @@
+package telemetry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func TestMaxSeries(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	m, te, err := createTestMetrics(ctx, &Config{
+		Modifiers: map[string]Modifier{
+			nameTestingCounter: {MaxSeries: 2},
+		},
+	})
+	require.NoError(t, err)
+	for _, cause := range []string{"a", "b", "a", "c", "d"} {
+		m.AddInt(ctx, nameTestingCounter, 1, InstAttribs{{Name: AttribErrorCause, Value: cause}})
+	}
+
+	for cause, expected := range map[string]int64{"a": 2, "b": 1} {
+		attribs := attribute.NewSet(attribute.String(AttribErrorCause, cause))
+		val, err := te.GetInt64CounterValue(ctx, nameTestingCounter, &attribs)
+		require.NoError(t, err)
+		assert.Equal(t, expected, val, cause)
+	}
+	attribs := attribute.NewSet(attribute.String(AttribErrorCause, "c"))
+	_, err = te.GetInt64CounterValue(ctx, nameTestingCounter, &attribs)
+	require.Error(t, err, "folded into the overflow series")
+	val, err := te.GetInt64CounterValue(ctx, nameTestingCounter, &overflowSet)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), val)
+
+	attribs = attribute.NewSet(attribute.String(AttribMetricName, nameTestingCounter))
+	val, err = te.GetInt64CounterValue(ctx, InstrumentCardinalityOverflow.Name(), &attribs)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), val)
+
+	// a forgotten series frees its place
+	inst := m.GetInstrument(nameTestingCounter)
+	inst.ForgetSeries(InstAttribs{{Name: AttribErrorCause, Value: "b"}})
+	m.AddInt(ctx, nameTestingCounter, 1, InstAttribs{{Name: AttribErrorCause, Value: "c"}})
+	attribs = attribute.NewSet(attribute.String(AttribErrorCause, "c"))
+	val, err = te.GetInt64CounterValue(ctx, nameTestingCounter, &attribs)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), val)
+}
+
+func TestNoMaxSeries(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	m, _, err := createTestMetrics(ctx, &Config{})
+	require.NoError(t, err)
+	assert.Nil(t, m.GetInstrument(InstrumentCardinalityOverflow.Name()), "only created when metrics are limited")
+	assert.Nil(t, m.GetInstrument(nameTestingCounter).limiter)
+}
@@ -0,0 +1,87 @@
+package telemetry
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// DBStatsCallback is the function prototype to provide the persistence connection pool statistics.
+// It should return nil if persistence is not configured.
+type DBStatsCallback func() *sql.DBStats
+
+type dbPoolGauges struct {
+	callback    DBStatsCallback
+	connections *Instrument
+	maxOpen     *Instrument
+	wait        *Instrument
+}
+
+// AddDBMetrics creates the persistence instruments, observing the connection pool via callback if it is not nil
+func AddDBMetrics(callback DBStatsCallback) AddMetric {
+	return func(_ context.Context, m *Metrics) error {
+		for _, inst := range []BuiltinInstrument{
+			InstrumentDbPoolConnections,
+			InstrumentDbPoolMaxOpenConnections,
+			InstrumentDbPoolWaitDuration,
+			InstrumentDbQueryDuration,
+		} {
+			if err := m.CreateBuiltinInstrument(inst); err != nil {
+				return err
+			}
+		}
+		if callback == nil {
+			return nil
+		}
+		pool := dbPoolGauges{
+			callback:    callback,
+			connections: m.GetInstrument(InstrumentDbPoolConnections.Name()),
+			maxOpen:     m.GetInstrument(InstrumentDbPoolMaxOpenConnections.Name()),
+			wait:        m.GetInstrument(InstrumentDbPoolWaitDuration.Name()),
+		}
+		if err := pool.connections.RegisterCallback(m, pool.updateConnections); err != nil {
+			return err
+		}
+		if err := pool.maxOpen.RegisterCallback(m, pool.updateMaxOpen); err != nil {
+			return err
+		}
+		return pool.wait.RegisterCallback(m, pool.updateWait)
+	}
+}
+
+func (p *dbPoolGauges) updateConnections(ctx context.Context, o metric.Observer) error {
+	stats := p.callback()
+	if stats == nil {
+		return nil
+	}
+	p.connections.ObserveInt(ctx, o, int64(stats.InUse), InstAttribs{{Name: AttribDBConnectionState, Value: "in_use"}})
+	p.connections.ObserveInt(ctx, o, int64(stats.Idle), InstAttribs{{Name: AttribDBConnectionState, Value: "idle"}})
+	return nil
+}
+
+func (p *dbPoolGauges) updateMaxOpen(ctx context.Context, o metric.Observer) error {
+	stats := p.callback()
+	if stats == nil {
+		return nil
+	}
+	p.maxOpen.ObserveInt(ctx, o, int64(stats.MaxOpenConnections), InstAttribs{})
+	return nil
+}
+
+func (p *dbPoolGauges) updateWait(ctx context.Context, o metric.Observer) error {
+	stats := p.callback()
+	if stats == nil {
+		return nil
+	}
+	p.wait.ObserveFloat(ctx, o, stats.WaitDuration.Seconds(), InstAttribs{})
+	return nil
+}
+
+// DBQueryCompleted records the duration of a single persistence operation
+func (m *Metrics) DBQueryCompleted(ctx context.Context, operation string, duration time.Duration) {
+	m.Record(ctx, InstrumentDbQueryDuration.Name(), duration.Seconds(), InstAttribs{
+		{Name: AttribDBOperation, Value: operation},
+	})
+}
@@ -0,0 +1,51 @@
+package telemetry
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func TestDBMetrics(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	te := NewTestMetricsExporter()
+	m, err := NewMetrics(ctx, TestScopeName, TestScopeName, &Config{}, metric.WithReader(te))
+	require.NoError(t, err)
+	err = m.Populate(ctx, AddDBMetrics(func() *sql.DBStats {
+		return &sql.DBStats{MaxOpenConnections: 10, InUse: 3, Idle: 2, WaitDuration: 1500 * time.Millisecond}
+	}))
+	require.NoError(t, err)
+
+	inUse := attribute.NewSet(attribute.String(AttribDBConnectionState, "in_use"))
+	val, err := te.GetInt64GaugeValue(ctx, InstrumentDbPoolConnections.Name(), &inUse)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), val)
+
+	idle := attribute.NewSet(attribute.String(AttribDBConnectionState, "idle"))
+	val, err = te.GetInt64GaugeValue(ctx, InstrumentDbPoolConnections.Name(), &idle)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), val)
+
+	noAttribs := attribute.NewSet()
+	val, err = te.GetInt64GaugeValue(ctx, InstrumentDbPoolMaxOpenConnections.Name(), &noAttribs)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), val)
+
+	wait, err := te.GetFloat64CounterValue(ctx, InstrumentDbPoolWaitDuration.Name(), &noAttribs)
+	require.NoError(t, err)
+	assert.InDelta(t, 1.5, wait, 0.001)
+
+	m.DBQueryCompleted(ctx, "archive_workflow", 2*time.Second)
+	operation := attribute.NewSet(attribute.String(AttribDBOperation, "archive_workflow"))
+	data, err := te.GetFloat64HistogramData(ctx, InstrumentDbQueryDuration.Name(), &operation)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), data.Count)
+	assert.InDelta(t, 2.0, data.Sum, 0.001)
+}
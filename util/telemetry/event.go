@@ -0,0 +1,66 @@
+package telemetry
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// AddEventMetrics creates the instruments for the event API
+func AddEventMetrics(_ context.Context, m *Metrics) error {
+	for _, inst := range []BuiltinInstrument{
+		InstrumentEventInFlight,
+		InstrumentEventPayloadRejected,
+		InstrumentEventQueueDepth,
+		InstrumentEventQuotaExceeded,
+	} {
+		if err := m.CreateBuiltinInstrument(inst); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ObserveEventQueue observes the event operation queue's depth, and the number of events in flight in each namespace
+func (m *Metrics) ObserveEventQueue(depth func() int, inFlight func() map[string]int) error {
+	if m == nil {
+		return nil
+	}
+	depthInst := m.GetInstrument(InstrumentEventQueueDepth.Name())
+	err := depthInst.RegisterCallback(m, func(ctx context.Context, o metric.Observer) error {
+		depthInst.ObserveInt(ctx, o, int64(depth()), InstAttribs{})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	inFlightInst := m.GetInstrument(InstrumentEventInFlight.Name())
+	return inFlightInst.RegisterCallback(m, func(ctx context.Context, o metric.Observer) error {
+		for namespace, n := range inFlight() {
+			inFlightInst.ObserveInt(ctx, o, int64(n), InstAttribs{{Name: AttribEventNamespace, Value: namespace}})
+		}
+		return nil
+	})
+}
+
+// EventPayloadRejected records that a WorkflowEventBinding rejected an event's payload
+func (m *Metrics) EventPayloadRejected(ctx context.Context, namespace, binding string) {
+	if m == nil {
+		return
+	}
+	m.AddInt(ctx, InstrumentEventPayloadRejected.Name(), 1, InstAttribs{
+		{Name: AttribEventNamespace, Value: namespace},
+		{Name: AttribEventBindingName, Value: binding},
+	})
+}
+
+// EventQuotaExceeded records that an event was rejected because its namespace was over quota
+func (m *Metrics) EventQuotaExceeded(ctx context.Context, namespace, reason string) {
+	if m == nil {
+		return
+	}
+	m.AddInt(ctx, InstrumentEventQuotaExceeded.Name(), 1, InstAttribs{
+		{Name: AttribEventNamespace, Value: namespace},
+		{Name: AttribEventQuotaReason, Value: reason},
+	})
+}
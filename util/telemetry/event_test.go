@@ -0,0 +1,50 @@
+package telemetry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func TestEventMetrics(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	te := NewTestMetricsExporter()
+	m, err := NewMetrics(ctx, TestScopeName, TestScopeName, &Config{}, metric.WithReader(te))
+	require.NoError(t, err)
+	require.NoError(t, m.Populate(ctx, AddEventMetrics))
+
+	m.EventPayloadRejected(ctx, "my-ns", "my-wfeb")
+	m.EventPayloadRejected(ctx, "my-ns", "my-wfeb")
+
+	attribs := attribute.NewSet(attribute.String(AttribEventNamespace, "my-ns"), attribute.String(AttribEventBindingName, "my-wfeb"))
+	val, err := te.GetInt64CounterValue(ctx, InstrumentEventPayloadRejected.Name(), &attribs)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), val)
+
+	m.EventQuotaExceeded(ctx, "my-ns", "per_minute")
+	attribs = attribute.NewSet(attribute.String(AttribEventNamespace, "my-ns"), attribute.String(AttribEventQuotaReason, "per_minute"))
+	val, err = te.GetInt64CounterValue(ctx, InstrumentEventQuotaExceeded.Name(), &attribs)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), val)
+
+	require.NoError(t, m.ObserveEventQueue(func() int { return 3 }, func() map[string]int { return map[string]int{"my-ns": 2} }))
+	noAttribs := attribute.NewSet()
+	val, err = te.GetInt64GaugeValue(ctx, InstrumentEventQueueDepth.Name(), &noAttribs)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), val)
+	namespace := attribute.NewSet(attribute.String(AttribEventNamespace, "my-ns"))
+	val, err = te.GetInt64GaugeValue(ctx, InstrumentEventInFlight.Name(), &namespace)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), val)
+
+	// nil metrics are a no-op
+	var nilMetrics *Metrics
+	nilMetrics.EventPayloadRejected(ctx, "my-ns", "my-wfeb")
+	nilMetrics.EventQuotaExceeded(ctx, "my-ns", "in_flight")
+	require.NoError(t, nilMetrics.ObserveEventQueue(nil, nil))
+}
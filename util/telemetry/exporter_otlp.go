@@ -0,0 +1,66 @@
+package telemetry
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	metricsdk "go.opentelemetry.io/otel/sdk/metric"
+)
+
+// DefaultOTLPRetry is the OpenTelemetry default retry of failed pushes
+var DefaultOTLPRetry = otlpmetricgrpc.RetryConfig{
+	Enabled:         true,
+	InitialInterval: 5 * time.Second,
+	MaxInterval:     30 * time.Second,
+	MaxElapsedTime:  time.Minute,
+}
+
+// OTLPConfig configures the push of metrics to an OpenTelemetry collector, zero values leave the OpenTelemetry
+// defaults, which the OTEL_METRIC_EXPORT_* environment variables may set
+type OTLPConfig struct {
+	// Interval between pushes
+	Interval time.Duration
+	// Timeout of each push
+	Timeout time.Duration
+	// Retry of failed pushes
+	Retry *otlpmetricgrpc.RetryConfig
+	// ResourceAttributes are added to the resource of the metrics
+	ResourceAttributes map[string]string
+}
+
+func otlpEnabled() bool {
+	_, otlpEnabled := os.LookupEnv(`OTEL_EXPORTER_OTLP_ENDPOINT`)
+	_, otlpMetricsEnabled := os.LookupEnv(`OTEL_EXPORTER_OTLP_METRICS_ENDPOINT`)
+	return otlpEnabled || otlpMetricsEnabled
+}
+
+func (config *Config) otlpMetricsReader(ctx context.Context) (metricsdk.Reader, error) {
+	exporterOpts := []otlpmetricgrpc.Option{otlpmetricgrpc.WithTemporalitySelector(config.Temporality)}
+	var readerOpts []metricsdk.PeriodicReaderOption
+	if config.OTLP.Interval > 0 {
+		readerOpts = append(readerOpts, metricsdk.WithInterval(config.OTLP.Interval))
+	}
+	if config.OTLP.Timeout > 0 {
+		exporterOpts = append(exporterOpts, otlpmetricgrpc.WithTimeout(config.OTLP.Timeout))
+		readerOpts = append(readerOpts, metricsdk.WithTimeout(config.OTLP.Timeout))
+	}
+	if config.OTLP.Retry != nil {
+		exporterOpts = append(exporterOpts, otlpmetricgrpc.WithRetry(*config.OTLP.Retry))
+	}
+	otelExporter, err := otlpmetricgrpc.New(ctx, exporterOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return metricsdk.NewPeriodicReader(otelExporter, readerOpts...), nil
+}
+
+func (config *Config) resourceAttributes() []attribute.KeyValue {
+	attribs := make([]attribute.KeyValue, 0, len(config.OTLP.ResourceAttributes))
+	for key, value := range config.OTLP.ResourceAttributes {
+		attribs = append(attribs, attribute.String(key, value))
+	}
+	return attribs
+}
@@ -0,0 +1,40 @@
+package telemetry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func TestOTLPConfig(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Setenv(`OTEL_EXPORTER_OTLP_METRICS_ENDPOINT`, "http://localhost:4317")
+	m, te, err := createTestMetrics(ctx, &Config{
+		OTLP: OTLPConfig{
+			Interval:           time.Hour,
+			Timeout:            time.Second,
+			Retry:              &DefaultOTLPRetry,
+			ResourceAttributes: map[string]string{"cluster": "my-cluster"},
+		},
+	})
+	require.NoError(t, err)
+	defer func() {
+		// there is no collector to push to
+		_ = m.Shutdown(ctx)
+	}()
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, te.Collect(ctx, &rm))
+	cluster, ok := rm.Resource.Set().Value("cluster")
+	require.True(t, ok)
+	assert.Equal(t, "my-cluster", cluster.AsString())
+	service, ok := rm.Resource.Set().Value(attribute.Key("service.name"))
+	require.True(t, ok)
+	assert.Equal(t, TestScopeName, service.AsString())
+}
@@ -2,9 +2,11 @@ package telemetry
 
 import (
 	"context"
+	"crypto/subtle"
 	"crypto/tls"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/argoproj/argo-workflows/v3/util/logging"
@@ -74,20 +76,29 @@ func (m *Metrics) RunPrometheusServer(ctx context.Context, isDummy bool) {
 		if m.config.IgnoreErrors {
 			handlerOpts.ErrorHandling = promhttp.ContinueOnError
 		}
+		// exemplars are only exposed in the OpenMetrics format
+		handlerOpts.EnableOpenMetrics = TracingEnabled()
 		name = "prometheus metrics server"
 		mux.Handle(m.config.path(), promhttp.HandlerFor(promgo.DefaultGatherer, handlerOpts))
 	}
-	srv := &http.Server{Addr: fmt.Sprintf(":%v", m.config.port()), Handler: mux}
+	var handler http.Handler = mux
+	if m.config.BearerToken != nil {
+		handler = bearerTokenHandler(m.config.BearerToken, handler)
+	}
+	srv := &http.Server{Addr: fmt.Sprintf(":%v", m.config.port()), Handler: handler}
 
 	if m.config.Secure {
-		tlsMinVersion, err := env.GetInt("TLS_MIN_VERSION", tls.VersionTLS12)
-		if err != nil {
-			panic(err)
-		}
-		logger.Info(ctx, "Generating Self Signed TLS Certificates for Telemetry Servers")
-		tlsConfig, err := tlsutils.GenerateX509KeyPairTLSConfig(uint16(tlsMinVersion))
-		if err != nil {
-			panic(err)
+		tlsConfig := m.config.TLSConfig
+		if tlsConfig == nil {
+			tlsMinVersion, err := env.GetInt("TLS_MIN_VERSION", tls.VersionTLS12)
+			if err != nil {
+				panic(err)
+			}
+			logger.Info(ctx, "Generating Self Signed TLS Certificates for Telemetry Servers")
+			tlsConfig, err = tlsutils.GenerateX509KeyPairTLSConfig(uint16(tlsMinVersion))
+			if err != nil {
+				panic(err)
+			}
 		}
 		srv.TLSConfig = tlsConfig
 		go func() {
@@ -117,3 +128,17 @@ func (m *Metrics) RunPrometheusServer(ctx context.Context, isDummy bool) {
 		logger.WithFields(logging.Fields{"name": name, "port": m.config.port(), "path": m.config.path()}).Info(ctx, "Successfully shutdown prometheus server")
 	}
 }
+
+// bearerTokenHandler rejects requests which do not bear the current token
+func bearerTokenHandler(token func() string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		want := token()
+		got, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || want == "" || subtle.ConstantTimeCompare([]byte(got), []byte(want)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
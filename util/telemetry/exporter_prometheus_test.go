@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"sync"
 	"testing"
 	"time"
@@ -106,3 +107,33 @@ func TestDummyPrometheusServer(t *testing.T) {
 	cancel() // cancel and wait for server shutdown to prevent port conflicts with subsequent tests
 	wg.Wait()
 }
+
+func TestBearerTokenHandler(t *testing.T) {
+	token := "secret"
+	handler := bearerTokenHandler(func() string { return token }, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	for name, tc := range map[string]struct {
+		token         string
+		authorization string
+		status        int
+	}{
+		"Valid":         {token: "secret", authorization: "Bearer secret", status: http.StatusOK},
+		"Missing":       {token: "secret", status: http.StatusUnauthorized},
+		"Wrong":         {token: "secret", authorization: "Bearer other", status: http.StatusUnauthorized},
+		"Basic":         {token: "secret", authorization: "Basic secret", status: http.StatusUnauthorized},
+		"Empty secret":  {authorization: "Bearer ", status: http.StatusUnauthorized},
+		"Rotated token": {token: "rotated", authorization: "Bearer rotated", status: http.StatusOK},
+	} {
+		t.Run(name, func(t *testing.T) {
+			token = tc.token
+			req := httptest.NewRequest(http.MethodGet, DefaultPrometheusServerPath, nil)
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			assert.Equal(t, tc.status, rec.Code)
+		})
+	}
+}
@@ -14,6 +14,9 @@ type Instrument struct {
 	description string
 	otel        interface{}
 	userdata    interface{}
+	// limiter is nil if the instrument has no maximum number of series
+	limiter         *seriesLimiter
+	overflowCounter *Instrument
 }
 
 func (m *Metrics) preCreateCheck(name string) error {
@@ -36,6 +39,7 @@ const (
 	Int64ObservableGauge
 	Int64UpDownCounter
 	Int64Counter
+	Float64Counter
 )
 
 // InstrumentOption applies options to all instruments.
@@ -121,17 +125,29 @@ func (m *Metrics) CreateInstrument(instType instrumentType, name, desc, unit str
 		)
 		instPtr = &inst
 		err = insterr
+	case Float64Counter:
+		inst, insterr := (*m.otelMeter).Float64Counter(name,
+			metric.WithDescription(desc),
+			metric.WithUnit(unit),
+		)
+		instPtr = &inst
+		err = insterr
 	default:
 		return fmt.Errorf("internal error creating metric instrument of unknown type %v", instType)
 	}
 	if err != nil {
 		return err
 	}
-	m.AddInstrument(name, &Instrument{
+	inst := &Instrument{
 		name:        name,
 		description: desc,
 		otel:        instPtr,
-	})
+	}
+	if name != InstrumentCardinalityOverflow.Name() {
+		inst.limiter = newSeriesLimiter(m.maxSeries(name))
+		inst.overflowCounter = m.GetInstrument(InstrumentCardinalityOverflow.Name())
+	}
+	m.AddInstrument(name, inst)
 	return nil
 }
 
@@ -2,14 +2,13 @@ package telemetry
 
 import (
 	"context"
-	"os"
+	"crypto/tls"
 	"sync"
 	"time"
 
 	"go.opentelemetry.io/otel"
 
 	"go.opentelemetry.io/contrib/instrumentation/runtime"
-	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
 	"go.opentelemetry.io/otel/metric"
 	metricsdk "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/resource"
@@ -27,10 +26,19 @@ type Config struct {
 	Secure       bool
 	Modifiers    map[string]Modifier
 	Temporality  metricsdk.TemporalitySelector
+	// MaxSeries is the maximum number of series of each metric, unless its modifier sets it, zero for no maximum
+	MaxSeries int
+	// TLSConfig is the TLS config of the metrics server when Secure, a self-signed certificate is generated when nil
+	TLSConfig *tls.Config
+	// BearerToken returns the token requests to the metrics server must bear, requests are not authenticated when nil
+	BearerToken func() string
+	// OTLP configures the push of metrics to an OpenTelemetry collector
+	OTLP OTLPConfig
 }
 
 type Metrics struct {
 	otelMeter *metric.Meter
+	provider  *metricsdk.MeterProvider
 	config    *Config
 
 	// Ensures mutual exclusion in instruments
@@ -66,21 +74,19 @@ func (m *Metrics) IterateROInstruments(fn func(i *Instrument)) {
 func NewMetrics(ctx context.Context, serviceName, prometheusName string, config *Config, extraOpts ...metricsdk.Option) (*Metrics, error) {
 	res := resource.NewWithAttributes(
 		semconv.SchemaURL,
-		semconv.ServiceName(serviceName),
+		append(config.resourceAttributes(), semconv.ServiceName(serviceName))...,
 	)
 
 	options := make([]metricsdk.Option, 0)
 	options = append(options, metricsdk.WithResource(res))
-	_, otlpEnabled := os.LookupEnv(`OTEL_EXPORTER_OTLP_ENDPOINT`)
-	_, otlpMetricsEnabled := os.LookupEnv(`OTEL_EXPORTER_OTLP_METRICS_ENDPOINT`)
 	logger := logging.RequireLoggerFromContext(ctx)
-	if otlpEnabled || otlpMetricsEnabled {
+	if otlpEnabled() {
 		logger.Info(ctx, "Starting OTLP metrics exporter")
-		otelExporter, err := otlpmetricgrpc.New(ctx, otlpmetricgrpc.WithTemporalitySelector(config.Temporality))
+		otlpReader, err := config.otlpMetricsReader(ctx)
 		if err != nil {
 			return nil, err
 		}
-		options = append(options, metricsdk.WithReader(metricsdk.NewPeriodicReader(otelExporter)))
+		options = append(options, metricsdk.WithReader(otlpReader))
 	}
 
 	if config.Enabled {
@@ -106,13 +112,28 @@ func NewMetrics(ctx context.Context, serviceName, prometheusName string, config
 	meter := provider.Meter(serviceName)
 	metrics := &Metrics{
 		otelMeter:   &meter,
+		provider:    provider,
 		config:      config,
 		instruments: make(map[string]*Instrument),
 	}
+	if config.limited() {
+		if err := metrics.Populate(ctx, addCardinalityOverflowCounter); err != nil {
+			return nil, err
+		}
+	}
 
 	return metrics, nil
 }
 
+// Shutdown exports the metrics that have not been exported yet and stops the exporters, for processes that would
+// otherwise exit before their metrics are exported
+func (m *Metrics) Shutdown(ctx context.Context) error {
+	if m == nil || m.provider == nil {
+		return nil
+	}
+	return m.provider.Shutdown(ctx)
+}
+
 type AddMetric func(context.Context, *Metrics) error
 
 func (m *Metrics) Populate(ctx context.Context, adders ...AddMetric) error {
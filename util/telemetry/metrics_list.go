@@ -1,6 +1,84 @@
 // Code generated by util/telemetry/builder. DO NOT EDIT.
 package telemetry
 
+var InstrumentArtifactTransferBytes = BuiltinInstrument{
+	name:        "artifact_transfer_bytes",
+	description: "A counter of the bytes transferred to and from artifact repositories by executors",
+	unit:        "By",
+	instType:    Int64Counter,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribArtifactDriver,
+		},
+		{
+			name: AttribArtifactRepository,
+		},
+		{
+			name: AttribArtifactOperation,
+		},
+	},
+}
+
+var InstrumentArtifactTransferDuration = BuiltinInstrument{
+	name:        "artifact_transfer_duration",
+	description: "A histogram of the duration of artifact operations by executors",
+	unit:        "s",
+	instType:    Float64Histogram,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribArtifactDriver,
+		},
+		{
+			name: AttribArtifactRepository,
+		},
+		{
+			name: AttribArtifactOperation,
+		},
+	},
+	defaultBuckets: []float64{
+		0.100000,
+		0.500000,
+		1.000000,
+		5.000000,
+		10.000000,
+		30.000000,
+		60.000000,
+		300.000000,
+		600.000000,
+		1800.000000,
+	},
+}
+
+var InstrumentArtifactTransferFailures = BuiltinInstrument{
+	name:        "artifact_transfer_failures",
+	description: "A counter of the artifact operations by executors that failed",
+	unit:        "{operation}",
+	instType:    Int64Counter,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribArtifactDriver,
+		},
+		{
+			name: AttribArtifactRepository,
+		},
+		{
+			name: AttribArtifactOperation,
+		},
+	},
+}
+
+var InstrumentCardinalityOverflow = BuiltinInstrument{
+	name:        "cardinality_overflow",
+	description: "A counter of the measurements folded into the overflow series of metrics that have their maximum number of series",
+	unit:        "{measurement}",
+	instType:    Int64Counter,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribMetricName,
+		},
+	},
+}
+
 var InstrumentCronworkflowsConcurrencypolicyTriggered = BuiltinInstrument{
 	name:        "cronworkflows_concurrencypolicy_triggered",
 	description: "A counter of the number of times a CronWorkflow has triggered its `concurrencyPolicy` to limit the number of workflows running",
@@ -34,6 +112,55 @@ var InstrumentCronworkflowsTriggeredTotal = BuiltinInstrument{
 	},
 }
 
+var InstrumentDbPoolConnections = BuiltinInstrument{
+	name:        "db_pool_connections",
+	description: "A gauge of the number of connections in the persistence database connection pool",
+	unit:        "{connection}",
+	instType:    Int64ObservableGauge,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribDBConnectionState,
+		},
+	},
+}
+
+var InstrumentDbPoolMaxOpenConnections = BuiltinInstrument{
+	name:        "db_pool_max_open_connections",
+	description: "The maximum number of open connections allowed in the persistence database connection pool",
+	unit:        "{connection}",
+	instType:    Int64ObservableGauge,
+}
+
+var InstrumentDbPoolWaitDuration = BuiltinInstrument{
+	name:        "db_pool_wait_duration",
+	description: "The total time blocked waiting for a new connection from the persistence database connection pool",
+	unit:        "s",
+	instType:    Float64ObservableCounter,
+}
+
+var InstrumentDbQueryDuration = BuiltinInstrument{
+	name:        "db_query_duration",
+	description: "A histogram of the duration of persistence database operations",
+	unit:        "s",
+	instType:    Float64Histogram,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribDBOperation,
+		},
+	},
+	defaultBuckets: []float64{
+		0.010000,
+		0.050000,
+		0.100000,
+		0.500000,
+		1.000000,
+		2.000000,
+		5.000000,
+		10.000000,
+		30.000000,
+	},
+}
+
 var InstrumentDeprecatedFeature = BuiltinInstrument{
 	name:        "deprecated_feature",
 	description: "Incidents of deprecated feature being used",
@@ -62,6 +189,70 @@ var InstrumentErrorCount = BuiltinInstrument{
 	},
 }
 
+var InstrumentEstimatedCost = BuiltinInstrument{
+	name:        "estimated_cost",
+	description: "A counter of the estimated cost of completed workflows, by namespace",
+	unit:        "{currency}",
+	instType:    Float64Counter,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribWorkflowNamespace,
+		},
+		{
+			name: AttribWorkflowGroup,
+		},
+	},
+}
+
+var InstrumentEventInFlight = BuiltinInstrument{
+	name:        "event_in_flight",
+	description: "A gauge of the number of events queued or being dispatched by the Argo Server in each namespace",
+	unit:        "{event}",
+	instType:    Int64ObservableGauge,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribEventNamespace,
+		},
+	},
+}
+
+var InstrumentEventPayloadRejected = BuiltinInstrument{
+	name:        "event_payload_rejected",
+	description: "A counter of events rejected by a WorkflowEventBinding because the payload did not match its schema",
+	unit:        "{event}",
+	instType:    Int64Counter,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribEventNamespace,
+		},
+		{
+			name: AttribEventBindingName,
+		},
+	},
+}
+
+var InstrumentEventQueueDepth = BuiltinInstrument{
+	name:        "event_queue_depth",
+	description: "A gauge of the number of events waiting in the Argo Server's operation queue to be dispatched",
+	unit:        "{event}",
+	instType:    Int64ObservableGauge,
+}
+
+var InstrumentEventQuotaExceeded = BuiltinInstrument{
+	name:        "event_quota_exceeded",
+	description: "A counter of events rejected by the Argo Server because the namespace exceeded its quota",
+	unit:        "{event}",
+	instType:    Int64Counter,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribEventNamespace,
+		},
+		{
+			name: AttribEventQuotaReason,
+		},
+	},
+}
+
 var InstrumentGauge = BuiltinInstrument{
 	name:        "gauge",
 	description: "A gauge of the number of workflows currently in the cluster in each phase",
@@ -74,6 +265,25 @@ var InstrumentGauge = BuiltinInstrument{
 	},
 }
 
+var InstrumentInformerLag = BuiltinInstrument{
+	name:        "informer_lag",
+	description: "A histogram of the time the workflow informer takes to receive the controller's own updates of workflows",
+	unit:        "s",
+	instType:    Float64Histogram,
+	defaultBuckets: []float64{
+		0.050000,
+		0.100000,
+		0.200000,
+		0.500000,
+		1.000000,
+		2.000000,
+		5.000000,
+		10.000000,
+		30.000000,
+		60.000000,
+	},
+}
+
 var InstrumentIsLeader = BuiltinInstrument{
 	name:        "is_leader",
 	description: "Emits 1 if leader, 0 otherwise. Always 1 if leader election is disabled",
@@ -129,6 +339,18 @@ var InstrumentK8sRequestTotal = BuiltinInstrument{
 	},
 }
 
+var InstrumentLeaderTransitions = BuiltinInstrument{
+	name:        "leader_transitions",
+	description: "A counter of the times this controller started and stopped leading",
+	unit:        "{transition}",
+	instType:    Int64Counter,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribLeaderTransition,
+		},
+	},
+}
+
 var InstrumentLogMessages = BuiltinInstrument{
 	name:        "log_messages",
 	description: "A count of log messages emitted by the controller by log level: `error`, `warn` and `info`",
@@ -141,6 +363,24 @@ var InstrumentLogMessages = BuiltinInstrument{
 	},
 }
 
+var InstrumentMemoizationCacheLookups = BuiltinInstrument{
+	name:        "memoization_cache_lookups",
+	description: "A counter of the lookups of memoized nodes in their memoization cache",
+	unit:        "{lookup}",
+	instType:    Int64Counter,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribWorkflowNamespace,
+		},
+		{
+			name: AttribCacheName,
+		},
+		{
+			name: AttribMemoizationResult,
+		},
+	},
+}
+
 var InstrumentOperationDurationSeconds = BuiltinInstrument{
 	name:        "operation_duration_seconds",
 	description: "A histogram of durations of operations",
@@ -240,6 +480,8 @@ var InstrumentQueueDuration = BuiltinInstrument{
 		},
 	},
 	defaultBuckets: []float64{
+		0.010000,
+		0.050000,
 		0.100000,
 		0.200000,
 		0.500000,
@@ -264,6 +506,10 @@ var InstrumentQueueLatency = BuiltinInstrument{
 		},
 	},
 	defaultBuckets: []float64{
+		0.010000,
+		0.050000,
+		0.100000,
+		0.500000,
 		1.000000,
 		5.000000,
 		20.000000,
@@ -308,6 +554,73 @@ var InstrumentQueueUnfinishedWork = BuiltinInstrument{
 	},
 }
 
+var InstrumentRateLimit = BuiltinInstrument{
+	name:        "rate_limit",
+	description: "A gauge of the current limit of each rate limiter of the controller, in requests per second",
+	unit:        "{request}/s",
+	instType:    Float64ObservableGauge,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribRateLimiter,
+		},
+	},
+}
+
+var InstrumentResourcesDuration = BuiltinInstrument{
+	name:        "resources_duration",
+	description: "A counter of the resources duration of completed workflows, by namespace",
+	unit:        "{resource_second}",
+	instType:    Int64Counter,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribWorkflowNamespace,
+		},
+		{
+			name: AttribWorkflowGroup,
+		},
+		{
+			name: AttribResourceName,
+		},
+	},
+}
+
+var InstrumentTemplateDuration = BuiltinInstrument{
+	name:        "template_duration",
+	description: "A histogram of the duration of nodes by their template, for the templates allowed in the configuration",
+	unit:        "s",
+	instType:    Float64Histogram,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribNodeTemplate,
+		},
+		{
+			name: AttribNodeTemplateRef,
+		},
+		{
+			name: AttribNodePhase,
+		},
+	},
+	defaultBuckets: []float64{
+		1.000000,
+		5.000000,
+		10.000000,
+		30.000000,
+		60.000000,
+		300.000000,
+		600.000000,
+		1800.000000,
+		3600.000000,
+		10800.000000,
+	},
+}
+
+var InstrumentTimeSinceLastReconcile = BuiltinInstrument{
+	name:        "time_since_last_reconcile",
+	description: "The time since the leader last finished reconciling a workflow, or started leading if it has not yet",
+	unit:        "s",
+	instType:    Float64ObservableGauge,
+}
+
 var InstrumentTotalCount = BuiltinInstrument{
 	name:        "total_count",
 	description: "A counter of workflows that have entered each phase for tracking them through their life-cycle, by namespace",
@@ -383,6 +696,33 @@ var InstrumentWorkflowCondition = BuiltinInstrument{
 	},
 }
 
+var InstrumentWorkflowQueueInflight = BuiltinInstrument{
+	name:        "workflow_queue_inflight",
+	description: "A gauge of the workflows of each namespace that workers are operating on",
+	unit:        "{workflow}",
+	instType:    Int64UpDownCounter,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribWorkflowNamespace,
+		},
+	},
+}
+
+var InstrumentWorkflowUpdateConflicts = BuiltinInstrument{
+	name:        "workflow_update_conflicts",
+	description: "A counter of conflicts persisting workflows, because they changed since the controller read them",
+	unit:        "{conflict}",
+	instType:    Int64Counter,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribWorkflowNamespace,
+		},
+		{
+			name: AttribWorkflowUpdateMethod,
+		},
+	},
+}
+
 var InstrumentWorkflowtemplateRuntime = BuiltinInstrument{
 	name:        "workflowtemplate_runtime",
 	description: "A histogram of the runtime of workflows using `workflowTemplateRef` only",
@@ -10,6 +10,7 @@ type Modifier struct {
 	Disabled           bool
 	DisabledAttributes []string
 	HistogramBuckets   []float64
+	MaxSeries          int
 }
 
 // Create an opentelemetry 'view' which disables whole metrics or aggregates across attributes
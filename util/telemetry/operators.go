@@ -29,6 +29,23 @@ func (i *Instrument) AddInt(ctx context.Context, val int64, attribs InstAttribs)
 	}
 }
 
+func (m *Metrics) AddFloat(ctx context.Context, name string, val float64, attribs InstAttribs) {
+	if instrument := m.GetInstrument(name); instrument != nil {
+		instrument.AddFloat(ctx, val, attribs)
+	} else {
+		logging.RequireLoggerFromContext(ctx).WithField("name", name).Error(ctx, "Metrics addFloat() to non-existent metric")
+	}
+}
+
+func (i *Instrument) AddFloat(ctx context.Context, val float64, attribs InstAttribs) {
+	switch inst := i.otel.(type) {
+	case *metric.Float64Counter:
+		(*inst).Add(ctx, val, i.attributes(ctx, attribs))
+	default:
+		logging.RequireLoggerFromContext(ctx).WithField("name", i.name).WithField("type", i.otel).Error(ctx, "Metrics addFloat() to invalid type")
+	}
+}
+
 func (m *Metrics) Record(ctx context.Context, name string, val float64, attribs InstAttribs) {
 	if instrument := m.GetInstrument(name); instrument != nil {
 		instrument.Record(ctx, val, attribs)
@@ -95,6 +112,10 @@ type InstAttrib struct {
 }
 
 func (i *Instrument) attributes(ctx context.Context, labels InstAttribs) metric.MeasurementOption {
+	return metric.WithAttributeSet(i.limitSeries(ctx, attribute.NewSet(i.keyValues(ctx, labels)...)))
+}
+
+func (i *Instrument) keyValues(ctx context.Context, labels InstAttribs) []attribute.KeyValue {
 	attribs := make([]attribute.KeyValue, 0)
 	for _, label := range labels {
 		switch value := label.Value.(type) {
@@ -109,11 +130,13 @@ func (i *Instrument) attributes(ctx context.Context, labels InstAttribs) metric.
 		case float64:
 			attribs = append(attribs, attribute.Float64(label.Name, value))
 		default:
-			logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{
-				"name": i.name,
-				"type": label.Value,
-			}).Error(ctx, "Attempt to use label of unhandled type in metric")
+			if logger := logging.GetLoggerFromContextOrNil(ctx); logger != nil {
+				logger.WithFields(logging.Fields{
+					"name": i.name,
+					"type": label.Value,
+				}).Error(ctx, "Attempt to use label of unhandled type in metric")
+			}
 		}
 	}
-	return metric.WithAttributes(attribs...)
+	return attribs
 }
@@ -0,0 +1,49 @@
+package telemetry
+
+import (
+	"context"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+const tracerName = "github.com/argoproj/argo-workflows"
+
+// TracingEnabled returns whether traces are exported, which is when an OpenTelemetry traces endpoint is configured.
+// Histograms recorded within a sampled span have the span's trace as an exemplar.
+func TracingEnabled() bool {
+	_, ok := os.LookupEnv(`OTEL_EXPORTER_OTLP_TRACES_ENDPOINT`)
+	return ok
+}
+
+// InitTracing starts exporting traces via the OpenTelemetry protocol if tracing is enabled, and returns the function
+// that exports the remaining traces on shutdown
+func InitTracing(ctx context.Context, serviceName string) (func(context.Context) error, error) {
+	if !TracingEnabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+	logging.RequireLoggerFromContext(ctx).Info(ctx, "Starting OTLP traces exporter")
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	provider := tracesdk.NewTracerProvider(
+		tracesdk.WithBatcher(exporter),
+		tracesdk.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// StartSpan starts a span, which does nothing unless tracing is enabled
+func StartSpan(ctx context.Context, name string, attribs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attribs...))
+}
@@ -0,0 +1,43 @@
+package telemetry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func TestExemplars(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	m, te, err := createTestMetrics(ctx, &Config{})
+	require.NoError(t, err)
+
+	// outside of a span there is no exemplar
+	m.TestingHistogramRecord(ctx, 0.5)
+	attribs := attribute.NewSet()
+	data, err := te.GetFloat64HistogramData(ctx, nameTestingHistogram, &attribs)
+	require.NoError(t, err)
+	assert.Empty(t, data.Exemplars)
+
+	spanCtx, span := tracesdk.NewTracerProvider().Tracer(tracerName).Start(ctx, "test")
+	defer span.End()
+	m.TestingHistogramRecord(spanCtx, 2)
+	data, err = te.GetFloat64HistogramData(ctx, nameTestingHistogram, &attribs)
+	require.NoError(t, err)
+	require.Len(t, data.Exemplars, 1)
+	traceID := span.SpanContext().TraceID()
+	assert.Equal(t, traceID[:], data.Exemplars[0].TraceID)
+	assert.InDelta(t, 2.0, data.Exemplars[0].Value, 0.001)
+}
+
+func TestStartSpan(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	// without tracing enabled, the span is not recorded
+	_, span := StartSpan(ctx, "test", attribute.String("key", "value"))
+	defer span.End()
+	assert.False(t, span.IsRecording())
+}
@@ -0,0 +1,126 @@
+package tls
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/util"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// Loader loads a value, such as a certificate, from files or a Kubernetes secret
+type Loader[T any] func(ctx context.Context) (T, error)
+
+// Reloader holds a value which it reloads periodically, so rotated certificates and tokens are picked up without a
+// restart. A failed reload keeps the previous value.
+type Reloader[T any] struct {
+	name  string
+	load  Loader[T]
+	value atomic.Pointer[T]
+}
+
+// NewReloader loads the initial value, returning an error if it cannot be loaded
+func NewReloader[T any](ctx context.Context, name string, load Loader[T]) (*Reloader[T], error) {
+	r := &Reloader[T]{name: name, load: load}
+	value, err := load(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s: %w", name, err)
+	}
+	r.value.Store(&value)
+	return r, nil
+}
+
+// Get returns the most recently loaded value
+func (r *Reloader[T]) Get() T {
+	return *r.value.Load()
+}
+
+// Run reloads the value every period until the context is done
+func (r *Reloader[T]) Run(ctx context.Context, period time.Duration) {
+	wait.UntilWithContext(ctx, r.reload, period)
+}
+
+func (r *Reloader[T]) reload(ctx context.Context) {
+	value, err := r.load(ctx)
+	if err != nil {
+		if logger := logging.GetLoggerFromContextOrNil(ctx); logger != nil {
+			logger.WithField("name", r.name).WithError(err).Warn(ctx, "Failed to reload, keeping the previous value")
+		}
+		return
+	}
+	r.value.Store(&value)
+}
+
+// CertificateFromFiles loads a PEM encoded certificate and key from files
+func CertificateFromFiles(certFile, keyFile string) Loader[*tls.Certificate] {
+	return func(context.Context) (*tls.Certificate, error) {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		return &cert, nil
+	}
+}
+
+// CertificateFromSecret loads a certificate and key from the tls.crt and tls.key of a Kubernetes secret
+func CertificateFromSecret(kube kubernetes.Interface, namespace, name string) Loader[*tls.Certificate] {
+	return func(ctx context.Context) (*tls.Certificate, error) {
+		certpem, err := util.GetSecrets(ctx, kube, namespace, name, tlsCrtSecretKey)
+		if err != nil {
+			return nil, err
+		}
+		keypem, err := util.GetSecrets(ctx, kube, namespace, name, tlsKeySecretKey)
+		if err != nil {
+			return nil, err
+		}
+		cert, err := tls.X509KeyPair(certpem, keypem)
+		if err != nil {
+			return nil, err
+		}
+		return &cert, nil
+	}
+}
+
+// CertPoolFromFile loads a pool of PEM encoded CA certificates from a file
+func CertPoolFromFile(file string) Loader[*x509.CertPool] {
+	return func(context.Context) (*x509.CertPool, error) {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no certificates found in %s", file)
+		}
+		return pool, nil
+	}
+}
+
+// ServerTLSConfig returns a server TLS config which serves the current certificate and, if clientCAs is not nil,
+// requires client certificates signed by the current CAs
+func ServerTLSConfig(tlsMinVersion uint16, cert *Reloader[*tls.Certificate], clientCAs *Reloader[*x509.CertPool]) *tls.Config {
+	config := &tls.Config{
+		MinVersion: tlsMinVersion,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return cert.Get(), nil
+		},
+	}
+	if clientCAs != nil {
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+		config.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			c := config.Clone()
+			c.GetConfigForClient = nil
+			c.ClientCAs = clientCAs.Get()
+			return c, nil
+		}
+	}
+	return config
+}
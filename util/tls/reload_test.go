@@ -0,0 +1,96 @@
+package tls
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// writePEM writes a generated certificate and key to files
+func writePEM(t *testing.T) (string, string) {
+	t.Helper()
+	crt, key, err := generatePEM()
+	require.NoError(t, err)
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	require.NoError(t, os.WriteFile(certFile, crt, 0o600))
+	require.NoError(t, os.WriteFile(keyFile, key, 0o600))
+	return certFile, keyFile
+}
+
+func TestReloader(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("Initial load fails", func(t *testing.T) {
+		_, err := NewReloader(ctx, "test", func(context.Context) (string, error) { return "", errors.New("boom") })
+		require.Error(t, err)
+	})
+	t.Run("Reload keeps the previous value on error", func(t *testing.T) {
+		value, err := "first", error(nil)
+		r, err2 := NewReloader(ctx, "test", func(context.Context) (string, error) { return value, err })
+		require.NoError(t, err2)
+		assert.Equal(t, "first", r.Get())
+		value = "second"
+		r.reload(ctx)
+		assert.Equal(t, "second", r.Get())
+		value, err = "", errors.New("boom")
+		r.reload(ctx)
+		assert.Equal(t, "second", r.Get())
+	})
+}
+
+func TestCertificateFromFiles(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	cert, err := CertificateFromFiles(writePEM(t))(ctx)
+	require.NoError(t, err)
+	assert.NotEmpty(t, cert.Certificate)
+	_, err = CertificateFromFiles("testdata/empty_tls.crt", "testdata/empty_tls.key")(ctx)
+	require.Error(t, err)
+}
+
+func TestCertificateFromSecret(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	crt, key, err := generatePEM()
+	require.NoError(t, err)
+	kube := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "metrics-tls", Namespace: "argo"},
+		Data:       map[string][]byte{tlsCrtSecretKey: crt, tlsKeySecretKey: key},
+	})
+	cert, err := CertificateFromSecret(kube, "argo", "metrics-tls")(ctx)
+	require.NoError(t, err)
+	assert.NotEmpty(t, cert.Certificate)
+}
+
+func TestServerTLSConfig(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	certFile, keyFile := writePEM(t)
+	cert, err := NewReloader(ctx, "cert", CertificateFromFiles(certFile, keyFile))
+	require.NoError(t, err)
+	t.Run("Without client CAs", func(t *testing.T) {
+		config := ServerTLSConfig(tls.VersionTLS12, cert, nil)
+		got, err := config.GetCertificate(nil)
+		require.NoError(t, err)
+		assert.Equal(t, cert.Get(), got)
+		assert.Equal(t, tls.NoClientCert, config.ClientAuth)
+	})
+	t.Run("With client CAs", func(t *testing.T) {
+		clientCAs, err := NewReloader(ctx, "client CAs", CertPoolFromFile(certFile))
+		require.NoError(t, err)
+		config := ServerTLSConfig(tls.VersionTLS12, cert, clientCAs)
+		assert.Equal(t, tls.RequireAndVerifyClientCert, config.ClientAuth)
+		clientConfig, err := config.GetConfigForClient(nil)
+		require.NoError(t, err)
+		assert.Equal(t, clientCAs.Get(), clientConfig.ClientCAs)
+		assert.Equal(t, tls.RequireAndVerifyClientCert, clientConfig.ClientAuth)
+	})
+}
@@ -0,0 +1,99 @@
+package admission
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// costLimit stops a badly written policy from stalling the controller, it is the same as a Kubernetes ValidatingAdmissionPolicy's
+const costLimit = 1000000
+
+type policy struct {
+	config.AdmissionPolicy
+	program cel.Program
+}
+
+// Policies are compiled admission policies
+type Policies []policy
+
+// Compile checks that every policy is a valid CEL expression that returns a bool
+func Compile(policies []config.AdmissionPolicy) (Policies, error) {
+	// JSON numbers are doubles, so allow comparing them with integer literals, e.g. `object.spec.parallelism <= 10`
+	env, err := cel.NewEnv(cel.Variable("object", cel.DynType), cel.CrossTypeNumericComparisons(true))
+	if err != nil {
+		return nil, err
+	}
+	compiled := make(Policies, len(policies))
+	for i, p := range policies {
+		if p.Name == "" {
+			return nil, fmt.Errorf("admission policy %d must have a name", i)
+		}
+		ast, issues := env.Compile(p.Expression)
+		if issues.Err() != nil {
+			return nil, fmt.Errorf("admission policy %q is invalid: %w", p.Name, issues.Err())
+		}
+		if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+			return nil, fmt.Errorf("admission policy %q must return a bool, not %s", p.Name, ast.OutputType())
+		}
+		program, err := env.Program(ast, cel.CostLimit(costLimit))
+		if err != nil {
+			return nil, fmt.Errorf("admission policy %q is invalid: %w", p.Name, err)
+		}
+		compiled[i] = policy{AdmissionPolicy: p, program: program}
+	}
+	return compiled, nil
+}
+
+// Admit returns an error listing every policy the workflow violates.
+// The workflow's metadata is evaluated with the execution spec, so policies apply to workflowTemplateRefs too.
+func (p Policies) Admit(wf *wfv1.Workflow, spec *wfv1.WorkflowSpec) error {
+	if len(p) == 0 {
+		return nil
+	}
+	object, err := toObject(wf, spec)
+	if err != nil {
+		return err
+	}
+	var violations []string
+	for _, policy := range p {
+		if !policy.AppliesTo(wf.Namespace) {
+			continue
+		}
+		val, _, err := policy.program.Eval(map[string]interface{}{"object": object})
+		if err != nil {
+			// a policy that cannot be evaluated, e.g. because a field is missing, is a violation, so mistakes fail closed
+			violations = append(violations, fmt.Sprintf("%s: %v", policy.Name, err))
+			continue
+		}
+		if allowed, ok := val.Value().(bool); !ok || !allowed {
+			violations = append(violations, fmt.Sprintf("%s: %s", policy.Name, policy.message()))
+		}
+	}
+	if len(violations) > 0 {
+		return fmt.Errorf("workflow rejected by admission policies: %s", strings.Join(violations, "; "))
+	}
+	return nil
+}
+
+func (p policy) message() string {
+	if p.Message != "" {
+		return p.Message
+	}
+	return p.Expression
+}
+
+func toObject(wf *wfv1.Workflow, spec *wfv1.WorkflowSpec) (map[string]interface{}, error) {
+	wf = &wfv1.Workflow{TypeMeta: wf.TypeMeta, ObjectMeta: wf.ObjectMeta, Spec: *spec}
+	data, err := json.Marshal(wf)
+	if err != nil {
+		return nil, err
+	}
+	object := map[string]interface{}{}
+	return object, json.Unmarshal(data, &object)
+}
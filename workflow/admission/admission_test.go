@@ -0,0 +1,69 @@
+package admission
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+var wf = wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  namespace: my-ns
+  labels:
+    team: my-team
+spec:
+  entrypoint: main
+  parallelism: 5
+  templates:
+    - name: main
+      container:
+        image: registry.example.com/argo:latest
+`)
+
+func TestCompile(t *testing.T) {
+	_, err := Compile([]config.AdmissionPolicy{{Expression: "true"}})
+	require.EqualError(t, err, "admission policy 0 must have a name")
+	_, err = Compile([]config.AdmissionPolicy{{Name: "my-policy", Expression: "object."}})
+	require.ErrorContains(t, err, `admission policy "my-policy" is invalid`)
+	_, err = Compile([]config.AdmissionPolicy{{Name: "my-policy", Expression: "'foo'"}})
+	require.EqualError(t, err, `admission policy "my-policy" must return a bool, not string`)
+}
+
+func TestAdmit(t *testing.T) {
+	policies, err := Compile([]config.AdmissionPolicy{
+		{Name: "allowed-images", Expression: `object.spec.templates.all(t, !has(t.container) || t.container.image.startsWith("registry.example.com/"))`},
+		{Name: "required-labels", Expression: `has(object.metadata.labels) && "team" in object.metadata.labels`, Message: "workflows must have a team label"},
+		{Name: "max-parallelism", Expression: `has(object.spec.parallelism) && object.spec.parallelism <= 10`},
+		{Name: "no-host-path", Expression: `!has(object.spec.volumes) || object.spec.volumes.all(v, !has(v.hostPath))`},
+		{Name: "other-namespace", Expression: "false", Namespaces: []string{"other-ns"}},
+	})
+	require.NoError(t, err)
+
+	t.Run("Admitted", func(t *testing.T) {
+		require.NoError(t, policies.Admit(wf, &wf.Spec))
+	})
+	t.Run("Rejected", func(t *testing.T) {
+		spec := wf.Spec.DeepCopy()
+		spec.Templates[0].Container.Image = "docker.io/argo:latest"
+		parallelism := int64(20)
+		spec.Parallelism = &parallelism
+		rejected := wf.DeepCopy()
+		rejected.Labels = nil
+		err := policies.Admit(rejected, spec)
+		require.EqualError(t, err, `workflow rejected by admission policies: allowed-images: object.spec.templates.all(t, !has(t.container) || t.container.image.startsWith("registry.example.com/")); required-labels: workflows must have a team label; max-parallelism: has(object.spec.parallelism) && object.spec.parallelism <= 10`)
+	})
+	t.Run("EvaluationError", func(t *testing.T) {
+		policies, err := Compile([]config.AdmissionPolicy{{Name: "missing-field", Expression: `object.spec.missing == 1`}})
+		require.NoError(t, err)
+		err = policies.Admit(wf, &wf.Spec)
+		assert.EqualError(t, err, "workflow rejected by admission policies: missing-field: no such key: missing")
+	})
+	t.Run("NoPolicies", func(t *testing.T) {
+		require.NoError(t, Policies(nil).Admit(wf, &wf.Spec))
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/util/retry"
@@ -25,17 +26,22 @@ type Interface interface {
 	Get(ctx context.Context, ref *wfv1.ArtifactRepositoryRefStatus) (*wfv1.ArtifactRepository, error)
 }
 
-func New(kubernetesInterface kubernetes.Interface, namespace string, defaultArtifactRepository *wfv1.ArtifactRepository) Interface {
-	return &artifactRepositories{kubernetesInterface, namespace, defaultArtifactRepository}
+func New(kubernetesInterface kubernetes.Interface, wfClientset wfclientset.Interface, namespace string, defaultArtifactRepository *wfv1.ArtifactRepository) Interface {
+	return &artifactRepositories{kubernetesInterface, wfClientset, namespace, defaultArtifactRepository}
 }
 
 type artifactRepositories struct {
 	kubernetesInterface       kubernetes.Interface
+	wfClientset               wfclientset.Interface
 	namespace                 string
 	defaultArtifactRepository *wfv1.ArtifactRepository
 }
 
 func (s *artifactRepositories) Resolve(ctx context.Context, ref *wfv1.ArtifactRepositoryRef, workflowNamespace string) (*wfv1.ArtifactRepositoryRefStatus, error) {
+	if ref != nil && ref.WorkflowArtifactRepository != "" {
+		// a named repository must exist, so we do not fall back to the default
+		return s.get(ctx, &wfv1.ArtifactRepositoryRefStatus{Namespace: workflowNamespace, ArtifactRepositoryRef: wfv1.ArtifactRepositoryRef{WorkflowArtifactRepository: ref.WorkflowArtifactRepository}})
+	}
 	var refs []*wfv1.ArtifactRepositoryRefStatus
 	if ref != nil {
 		refs = []*wfv1.ArtifactRepositoryRefStatus{
@@ -82,6 +88,9 @@ func (s *artifactRepositories) get(ctx context.Context, ref *wfv1.ArtifactReposi
 			ArtifactRepository:    s.defaultArtifactRepository,
 		}, nil
 	}
+	if ref.WorkflowArtifactRepository != "" {
+		return s.getWorkflowArtifactRepository(ctx, ref)
+	}
 	var cm *v1.ConfigMap
 	namespace := ref.Namespace
 	configMap := ref.GetConfigMapOr("artifact-repositories")
@@ -111,3 +120,25 @@ func (s *artifactRepositories) get(ctx context.Context, ref *wfv1.ArtifactReposi
 		ArtifactRepository:    repo,
 	}, nil
 }
+
+func (s *artifactRepositories) getWorkflowArtifactRepository(ctx context.Context, ref *wfv1.ArtifactRepositoryRefStatus) (*wfv1.ArtifactRepositoryRefStatus, error) {
+	var repo *wfv1.WorkflowArtifactRepository
+	name := ref.WorkflowArtifactRepository
+	err := waitutil.Backoff(retry.DefaultRetry(ctx), func() (bool, error) {
+		var err error
+		repo, err = s.wfClientset.ArgoprojV1alpha1().WorkflowArtifactRepositories(ref.Namespace).Get(ctx, name, metav1.GetOptions{})
+		return !errorsutil.IsTransientErrQuiet(ctx, err), err
+	})
+	if err != nil {
+		return nil, fmt.Errorf(`error getting artifact repository "%s": %w`, name, err)
+	}
+	// fail fast rather than have every pod of the workflow fail to save its artifacts
+	if message, ok := repo.Status.Unreachable(); ok {
+		return nil, fmt.Errorf(`artifact repository "%s" is unreachable: %s`, name, message)
+	}
+	return &wfv1.ArtifactRepositoryRefStatus{
+		Namespace:             ref.Namespace,
+		ArtifactRepositoryRef: wfv1.ArtifactRepositoryRef{WorkflowArtifactRepository: name},
+		ArtifactRepository:    &repo.Spec,
+	}, nil
+}
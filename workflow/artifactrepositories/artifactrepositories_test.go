@@ -10,6 +10,7 @@ import (
 	kubefake "k8s.io/client-go/kubernetes/fake"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wffake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 )
 
@@ -22,7 +23,8 @@ func TestArtifactRepositories(t *testing.T) {
 		ArtifactRepository: defaultArtifactRepository,
 	}
 	k := kubefake.NewSimpleClientset()
-	i := New(k, "my-ctrl-ns", defaultArtifactRepository)
+	wf := wffake.NewSimpleClientset()
+	i := New(k, wf, "my-ctrl-ns", defaultArtifactRepository)
 	t.Run("Explicit.WorkflowNamespace", func(t *testing.T) {
 		ctx := logging.TestContext(t.Context())
 		_, err := k.CoreV1().ConfigMaps("my-wf-ns").Create(ctx, &corev1.ConfigMap{
@@ -75,6 +77,52 @@ s3:
 		err = k.CoreV1().ConfigMaps("my-ctrl-ns").Delete(ctx, "artifact-repositories", metav1.DeleteOptions{})
 		require.NoError(t, err)
 	})
+	t.Run("Explicit.WorkflowArtifactRepository", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		_, err := wf.ArgoprojV1alpha1().WorkflowArtifactRepositories("my-wf-ns").Create(ctx, &wfv1.WorkflowArtifactRepository{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-repo"},
+			Spec:       wfv1.ArtifactRepository{S3: &wfv1.S3ArtifactRepository{KeyFormat: "bar"}},
+			Status: wfv1.WorkflowArtifactRepositoryStatus{
+				Conditions: wfv1.Conditions{{Type: wfv1.ConditionTypeReachable, Status: metav1.ConditionTrue}},
+			},
+		}, metav1.CreateOptions{})
+		require.NoError(t, err)
+
+		ref, err := i.Resolve(ctx, &wfv1.ArtifactRepositoryRef{WorkflowArtifactRepository: "my-repo"}, "my-wf-ns")
+		require.NoError(t, err)
+		assert.Equal(t, "my-wf-ns", ref.Namespace)
+		assert.Equal(t, "my-repo", ref.WorkflowArtifactRepository)
+		assert.False(t, ref.Default)
+
+		repo, err := i.Get(ctx, ref)
+		require.NoError(t, err)
+		assert.Equal(t, &wfv1.ArtifactRepository{S3: &wfv1.S3ArtifactRepository{KeyFormat: "bar"}}, repo)
+
+		err = wf.ArgoprojV1alpha1().WorkflowArtifactRepositories("my-wf-ns").Delete(ctx, "my-repo", metav1.DeleteOptions{})
+		require.NoError(t, err)
+	})
+	t.Run("Explicit.WorkflowArtifactRepositoryUnreachable", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		_, err := wf.ArgoprojV1alpha1().WorkflowArtifactRepositories("my-wf-ns").Create(ctx, &wfv1.WorkflowArtifactRepository{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-repo"},
+			Spec:       wfv1.ArtifactRepository{S3: &wfv1.S3ArtifactRepository{KeyFormat: "bar"}},
+			Status: wfv1.WorkflowArtifactRepositoryStatus{
+				Conditions: wfv1.Conditions{{Type: wfv1.ConditionTypeReachable, Status: metav1.ConditionFalse, Message: "bucket not found"}},
+			},
+		}, metav1.CreateOptions{})
+		require.NoError(t, err)
+
+		_, err = i.Resolve(ctx, &wfv1.ArtifactRepositoryRef{WorkflowArtifactRepository: "my-repo"}, "my-wf-ns")
+		require.EqualError(t, err, `artifact repository "my-repo" is unreachable: bucket not found`)
+
+		err = wf.ArgoprojV1alpha1().WorkflowArtifactRepositories("my-wf-ns").Delete(ctx, "my-repo", metav1.DeleteOptions{})
+		require.NoError(t, err)
+	})
+	t.Run("Explicit.WorkflowArtifactRepositoryNotFound", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		_, err := i.Resolve(ctx, &wfv1.ArtifactRepositoryRef{WorkflowArtifactRepository: "my-repo"}, "my-wf-ns")
+		require.Error(t, err)
+	})
 	t.Run("Explicit.ConfigMapNotFound", func(t *testing.T) {
 		ctx := logging.TestContext(t.Context())
 		_, err := i.Resolve(ctx, &wfv1.ArtifactRepositoryRef{}, "my-wf-ns")
@@ -25,16 +25,20 @@ func CreateClientWithCertificate(clientCert, clientKey []byte) (*http.Client, er
 }
 
 func CreateOauth2Client(ctx context.Context, clientID, clientSecret, tokenURL string, scopes []string, endpointParams []wfv1.OAuth2EndpointParam) *http.Client {
+	return CreateOauth2Config(clientID, clientSecret, tokenURL, scopes, endpointParams).Client(ctx)
+}
+
+// CreateOauth2Config returns the config of an OAuth2 client that acquires tokens with the client credentials grant
+func CreateOauth2Config(clientID, clientSecret, tokenURL string, scopes []string, endpointParams []wfv1.OAuth2EndpointParam) *cc.Config {
 	values := url.Values{}
 	for _, endpointParam := range endpointParams {
 		values.Add(endpointParam.Key, endpointParam.Value)
 	}
-	conf := cc.Config{
+	return &cc.Config{
 		ClientID:       clientID,
 		ClientSecret:   clientSecret,
 		TokenURL:       tokenURL,
 		EndpointParams: values,
 		Scopes:         scopes,
 	}
-	return conf.Client(ctx)
 }
@@ -0,0 +1,144 @@
+package metrics
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"net/url"
+	"path/filepath"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// driver adds a metrics interceptor to measure the artifacts transferred
+type driver struct {
+	common.ArtifactDriver
+	metrics    *telemetry.Metrics
+	driver     string
+	repository string
+}
+
+// New returns the driver for the artifact, instrumented with the metrics if there are any
+func New(d common.ArtifactDriver, art *wfv1.Artifact, m *telemetry.Metrics) common.ArtifactDriver {
+	if m == nil {
+		return d
+	}
+	name, repository := location(art)
+	return &driver{ArtifactDriver: d, metrics: m, driver: name, repository: repository}
+}
+
+func (d *driver) Load(ctx context.Context, inputArtifact *wfv1.Artifact, path string) error {
+	t := time.Now()
+	err := d.ArtifactDriver.Load(ctx, inputArtifact, path)
+	var size int64
+	if err == nil {
+		size = sizeOf(path)
+	}
+	d.metrics.ArtifactTransfer(ctx, d.driver, d.repository, "load", size, time.Since(t), err)
+	return err
+}
+
+func (d *driver) OpenStream(ctx context.Context, inputArtifact *wfv1.Artifact) (io.ReadCloser, error) {
+	t := time.Now()
+	rc, err := d.ArtifactDriver.OpenStream(ctx, inputArtifact)
+	if err != nil {
+		d.metrics.ArtifactTransfer(ctx, d.driver, d.repository, "open_stream", 0, time.Since(t), err)
+		return nil, err
+	}
+	return &stream{ReadCloser: rc, ctx: ctx, driver: d, started: t}, nil
+}
+
+func (d *driver) Save(ctx context.Context, path string, outputArtifact *wfv1.Artifact) error {
+	t := time.Now()
+	err := d.ArtifactDriver.Save(ctx, path, outputArtifact)
+	var size int64
+	if err == nil {
+		size = sizeOf(path)
+	}
+	d.metrics.ArtifactTransfer(ctx, d.driver, d.repository, "save", size, time.Since(t), err)
+	return err
+}
+
+func (d *driver) Delete(ctx context.Context, artifact *wfv1.Artifact) error {
+	t := time.Now()
+	err := d.ArtifactDriver.Delete(ctx, artifact)
+	d.metrics.ArtifactTransfer(ctx, d.driver, d.repository, "delete", 0, time.Since(t), err)
+	return err
+}
+
+// stream counts the bytes read from a stream, and records the transfer when it is closed
+type stream struct {
+	io.ReadCloser
+	ctx     context.Context
+	driver  *driver
+	started time.Time
+	read    int64
+	err     error
+}
+
+func (s *stream) Read(p []byte) (int, error) {
+	n, err := s.ReadCloser.Read(p)
+	s.read += int64(n)
+	if err != nil && err != io.EOF {
+		s.err = err
+	}
+	return n, err
+}
+
+func (s *stream) Close() error {
+	err := s.ReadCloser.Close()
+	s.driver.metrics.ArtifactTransfer(s.ctx, s.driver.driver, s.driver.repository, "open_stream", s.read, time.Since(s.started), s.err)
+	return err
+}
+
+// location returns the name of the artifact's driver and its repository, i.e. the bucket, container or host
+func location(art *wfv1.Artifact) (string, string) {
+	switch {
+	case art.S3 != nil:
+		return "s3", art.S3.Bucket
+	case art.GCS != nil:
+		return "gcs", art.GCS.Bucket
+	case art.OSS != nil:
+		return "oss", art.OSS.Bucket
+	case art.Azure != nil:
+		return "azure", art.Azure.Container
+	case art.HTTP != nil:
+		return "http", host(art.HTTP.URL)
+	case art.Artifactory != nil:
+		return "artifactory", host(art.Artifactory.URL)
+	case art.Git != nil:
+		return "git", host(art.Git.Repo)
+	case art.HDFS != nil:
+		return "hdfs", ""
+	case art.Raw != nil:
+		return "raw", ""
+	}
+	return "", ""
+}
+
+// host returns the host of the URL, without any credentials or path, which could be sensitive or unbounded
+func host(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return u.Hostname()
+}
+
+// sizeOf returns the size of the file, or of the files in the directory, at the path
+func sizeOf(path string) int64 {
+	var size int64
+	_ = filepath.WalkDir(path, func(_ string, e fs.DirEntry, err error) error {
+		if err != nil || e.IsDir() {
+			return nil
+		}
+		if info, err := e.Info(); err == nil && info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
@@ -43,6 +43,32 @@ const (
 	// AnnotationKeyWorkflowUID is the uid of the workflow
 	AnnotationKeyWorkflowUID = workflow.WorkflowFullName + "/workflow-uid"
 
+	// AnnotationKeyWorkflowTemplateDigest is the digest of the revision of the workflow template a workflow runs,
+	// it is also recorded on the stored revisions of workflow templates
+	AnnotationKeyWorkflowTemplateDigest = workflow.WorkflowFullName + "/workflow-template-digest"
+
+	// AnnotationKeySignature is the base64 encoded cosign signature of the spec of a WorkflowTemplate or ClusterWorkflowTemplate
+	AnnotationKeySignature = workflow.WorkflowFullName + "/signature"
+
+	// AnnotationKeySuspendedByParent marks the workflows suspended because their parent was, so they are resumed with it
+	AnnotationKeySuspendedByParent = workflow.WorkflowFullName + "/suspended-by-parent"
+	// AnnotationKeyResumeAt is the RFC3339 time at which the controller resumes a suspended workflow
+	AnnotationKeyResumeAt = workflow.WorkflowFullName + "/resume-at"
+	// AnnotationKeyShutdownReason is why a workflow, or some of its nodes, were last stopped or terminated
+	AnnotationKeyShutdownReason = workflow.WorkflowFullName + "/shutdown-reason"
+	// AnnotationKeyShutdownGracePeriod is how long the pods of a workflow being stopped or terminated have to exit once
+	// signalled, e.g. "30s", before they are deleted
+	AnnotationKeyShutdownGracePeriod = workflow.WorkflowFullName + "/shutdown-grace-period"
+	// AnnotationKeyStoppedNodes are the comma separated IDs of the nodes whose subtrees are stopped
+	AnnotationKeyStoppedNodes = workflow.WorkflowFullName + "/stopped-nodes"
+
+	// AnnotationKeyExecutorPluginDisabled disables the executor plugin of a config map when it is "true"
+	AnnotationKeyExecutorPluginDisabled = workflow.WorkflowFullName + "/executor-plugin-disabled"
+	// AnnotationKeyVersion is the versions of Argo Workflows an executor plugin needs
+	AnnotationKeyVersion = workflow.WorkflowFullName + "/version"
+	// AnnotationKeyDescription is the description of an executor plugin
+	AnnotationKeyDescription = workflow.WorkflowFullName + "/description"
+
 	// AnnotationKeyPodNameVersion stores the pod naming convention version
 	AnnotationKeyPodNameVersion = workflow.WorkflowFullName + "/pod-name-format"
 
@@ -85,6 +111,8 @@ const (
 	LabelKeyWorkflowArchivingStatus = workflow.WorkflowFullName + "/workflow-archiving-status"
 	// LabelKeyWorkflow is the pod metadata label to indicate the associated workflow name
 	LabelKeyWorkflow = workflow.WorkflowFullName + "/workflow"
+	// LabelKeyPodDisruptionBudget is the pod metadata label to indicate the PDB of the pod's template, if it has one
+	LabelKeyPodDisruptionBudget = workflow.WorkflowFullName + "/pod-disruption-budget"
 	// LabelKeyComponent determines what component within a workflow, intentionally similar to app.kubernetes.io/component.
 	// See https://kubernetes.io/docs/concepts/overview/working-with-objects/common-labels/
 	LabelKeyComponent = workflow.WorkflowFullName + "/component"
@@ -94,10 +122,22 @@ const (
 	LabelKeyPreviousWorkflowName = workflow.WorkflowFullName + "/resubmitted-from-workflow"
 	// LabelKeyCronWorkflow is a label applied to Workflows that are started by a CronWorkflow
 	LabelKeyCronWorkflow = workflow.WorkflowFullName + "/cron-workflow"
+	// LabelKeyExecutorPlugin is a label applied to Workflows that ran an executor plugin template, so that the plugins'
+	// invocations can be counted without listing every Workflow
+	LabelKeyExecutorPlugin = workflow.WorkflowFullName + "/executor-plugin"
+	// LabelKeyParentWorkflow is a label applied to Workflows that are run by a childWorkflow template of another Workflow,
+	// or created by a resource template of another Workflow that cascades to them
+	LabelKeyParentWorkflow = workflow.WorkflowFullName + "/parent-workflow"
+	// LabelKeyCascade is a label applied to Workflows created by the templates of another Workflow, it is the
+	// operations on the other Workflow that cascade to them
+	LabelKeyCascade = workflow.WorkflowFullName + "/cascade"
 	// LabelKeyWorkflowTemplate is a label applied to Workflows that are submitted from Workflowtemplate
 	LabelKeyWorkflowTemplate = workflow.WorkflowFullName + "/workflow-template"
 	// LabelKeyWorkflowEventBinding is a label applied to Workflows that are submitted from a WorkflowEventBinding
 	LabelKeyWorkflowEventBinding = workflow.WorkflowFullName + "/workflow-event-binding"
+	// LabelKeyEventIdempotencyKey is a label applied to Workflows submitted from a WorkflowEventBinding with idempotency,
+	// its value is a hash of the event's idempotency key
+	LabelKeyEventIdempotencyKey = workflow.WorkflowFullName + "/event-idempotency-key"
 	// LabelKeyClusterWorkflowTemplate is a label applied to Workflows that are submitted from ClusterWorkflowtemplate
 	LabelKeyClusterWorkflowTemplate = workflow.WorkflowFullName + "/cluster-workflow-template"
 	// LabelKeyOnExit is a label applied to Pods that are run from onExit nodes, so that they are not shut down when stopping a Workflow
@@ -170,6 +210,8 @@ const (
 	EnvVarProgressFileTickDuration = "ARGO_PROGRESS_FILE_TICK_DURATION"
 	// EnvVarProgressFile is the file watched for reporting progress
 	EnvVarProgressFile = "ARGO_PROGRESS_FILE"
+	// EnvVarIntermediateParametersFile is the file watched for intermediate parameters, published while the container runs
+	EnvVarIntermediateParametersFile = "ARGO_INTERMEDIATE_PARAMETERS_FILE"
 	// EnvVarDefaultRequeueTime is the default requeue time for Workflow Informers. For more info, see rate_limiters.go
 	EnvVarDefaultRequeueTime = "DEFAULT_REQUEUE_TIME"
 	// EnvVarPodStatusCaptureFinalizer is used to prevent pod garbage collected before argo captures its exit status
@@ -239,6 +281,8 @@ const (
 	LocalVarDuration = "duration"
 	// LocalVarStatus is a step level variable (currently only available in metric emission) that tracks the duration of the step
 	LocalVarStatus = "status"
+	// LocalVarFailures is a variable (currently only available in finally) of a JSON list of the failed nodes of the steps or DAG
+	LocalVarFailures = "failures"
 	// LocalVarResourcesDuration is a step level variable (currently only available in metric emission) that tracks the resources duration of the step
 	LocalVarResourcesDuration = "resourcesDuration"
 	// LocalVarExitCode is a step level variable (currently only available in metric emission) that tracks the step's exit code
@@ -264,12 +308,18 @@ const (
 	// CACertificatesVolumeMountName is the name of the secret that contains the CA certificates.
 	CACertificatesVolumeMountName = "argo-workflows-agent-ca-certificates"
 
+	// ScratchVolumeName is the name of the ephemeral volume of a template's scratch
+	ScratchVolumeName = "argo-scratch"
+
 	// VarRunArgoPath is the standard path for the shared volume
 	VarRunArgoPath = "/var/run/argo"
 
 	// ArgoProgressPath defines the path to a file used for self reporting progress
 	ArgoProgressPath = VarRunArgoPath + "/progress"
 
+	// ArgoIntermediateParametersPath defines the path to a file used for publishing intermediate parameters
+	ArgoIntermediateParametersPath = VarRunArgoPath + "/intermediate-parameters"
+
 	ConfigMapName = "workflow-controller-configmap"
 )
 
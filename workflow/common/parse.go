@@ -21,7 +21,9 @@ var yamlSeparator = regexp.MustCompile(`\n---`)
 
 type ParseResult struct {
 	Object metav1.Object
-	Err    error
+	// Unstructured is the object before it was converted to its type, nil if it could not be parsed
+	Unstructured *unstructured.Unstructured
+	Err          error
 }
 
 func ParseObjects(ctx context.Context, body []byte, strict bool) []ParseResult {
@@ -32,10 +34,10 @@ func ParseObjects(ctx context.Context, body []byte, strict bool) []ParseResult {
 		err := jsonpkg.Unmarshal(body, un)
 		if un.GetKind() != "" && err != nil {
 			// only return an error if this is a kubernetes object, otherwise, ignore
-			return append(res, ParseResult{nil, err})
+			return append(res, ParseResult{Err: err})
 		}
 		v, err := toWorkflowTypeJSON(body, un.GetKind(), strict)
-		return append(res, ParseResult{v, err})
+		return append(res, ParseResult{Object: v, Unstructured: un, Err: err})
 	}
 
 	for i, text := range yamlSeparator.Split(string(body), -1) {
@@ -47,7 +49,7 @@ func ParseObjects(ctx context.Context, body []byte, strict bool) []ParseResult {
 		if err != nil {
 			// Only return an error if this is a kubernetes object, otherwise, print the error
 			if un.GetKind() != "" {
-				res = append(res, ParseResult{nil, err})
+				res = append(res, ParseResult{Err: err})
 			} else {
 				log.WithField("index", i).WithError(err).Error(ctx, "yaml file is not valid")
 			}
@@ -56,7 +58,7 @@ func ParseObjects(ctx context.Context, body []byte, strict bool) []ParseResult {
 		v, err := toWorkflowTypeYAML([]byte(text), un.GetKind(), strict)
 		if v != nil {
 			// only append when this is a Kubernetes object
-			res = append(res, ParseResult{v, err})
+			res = append(res, ParseResult{Object: v, Unstructured: un, Err: err})
 		}
 	}
 	return res
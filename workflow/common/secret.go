@@ -0,0 +1,7 @@
+package common
+
+import "context"
+
+// SecretGetter gets the value of the key of a secret, for parameters whose value is from a secret. It returns an error
+// with the code errors.CodeNotFound if the secret or the key do not exist.
+type SecretGetter func(ctx context.Context, namespace, name, key string) (string, error)
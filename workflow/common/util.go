@@ -154,12 +154,42 @@ func substituteAndGetConfigMapValue(ctx context.Context, inParam *wfv1.Parameter
 	return nil
 }
 
+func substituteAndGetSecretValue(ctx context.Context, inParam *wfv1.Parameter, globalParams Parameters, namespace string, secretGetter SecretGetter) error {
+	if secretGetter == nil {
+		return nil
+	}
+	replaceMap := make(map[string]interface{})
+	for k, v := range globalParams {
+		replaceMap[k] = v
+	}
+	name, err := substituteSecretKeyRefParam(ctx, inParam.ValueFrom.SecretKeyRef.Name, replaceMap)
+	if err != nil {
+		return err
+	}
+	key, err := substituteSecretKeyRefParam(ctx, inParam.ValueFrom.SecretKeyRef.Key, replaceMap)
+	if err != nil {
+		return err
+	}
+	value, err := secretGetter(ctx, namespace, name, key)
+	if err != nil {
+		if inParam.ValueFrom.Default != nil && errors.IsCode(errors.CodeNotFound, err) {
+			inParam.Value = inParam.ValueFrom.Default
+			return nil
+		}
+		return errors.Errorf(errors.CodeBadRequest, "unable to retrieve inputs.parameters.%s from Secret: %s", inParam.Name, err)
+	}
+	inParam.Value = wfv1.AnyStringPtr(value)
+	return nil
+}
+
 // ProcessArgs sets in the inputs, the values either passed via arguments, or the hardwired values
 // It substitutes:
 // * parameters in the template from the arguments
 // * global parameters (e.g. {{workflow.parameters.XX}}, {{workflow.name}}, {{workflow.status}})
 // * local parameters (e.g. {{pod.name}})
-func ProcessArgs(ctx context.Context, tmpl *wfv1.Template, args wfv1.ArgumentsProvider, globalParams, localParams Parameters, validateOnly bool, namespace string, configMapStore ConfigMapStore) (*wfv1.Template, error) {
+// The values of parameters from secrets are only got if secretGetter is not nil. Parameters from plugins are left
+// unresolved, for the agent to substitute.
+func ProcessArgs(ctx context.Context, tmpl *wfv1.Template, args wfv1.ArgumentsProvider, globalParams, localParams Parameters, validateOnly bool, namespace string, configMapStore ConfigMapStore, secretGetter SecretGetter) (*wfv1.Template, error) {
 	// For each input parameter:
 	// 1) check if was supplied as argument. if so use the supplied value from arg
 	// 2) if not, use default value.
@@ -173,8 +203,16 @@ func ProcessArgs(ctx context.Context, tmpl *wfv1.Template, args wfv1.ArgumentsPr
 		argParam := args.GetParameterByName(inParam.Name)
 		overwriteWithArguments(argParam, &inParam)
 
-		// substitute configmap string and get value from store
-		err := substituteAndGetConfigMapValue(ctx, &inParam, globalParams, namespace, configMapStore)
+		var err error
+		if inParam.IsFromPlugin() {
+			// the value is got by the agent when the node starts
+			inParam.Value = nil
+		} else if inParam.IsSecret() {
+			err = substituteAndGetSecretValue(ctx, &inParam, globalParams, namespace, secretGetter)
+		} else {
+			// substitute configmap string and get value from store
+			err = substituteAndGetConfigMapValue(ctx, &inParam, globalParams, namespace, configMapStore)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -202,10 +240,27 @@ func ProcessArgs(ctx context.Context, tmpl *wfv1.Template, args wfv1.ArgumentsPr
 			artifacts[i].Path = inArt.Path
 			artifacts[i].Mode = inArt.Mode
 			artifacts[i].RecurseMode = inArt.RecurseMode
+			if inArt.Aggregate != "" {
+				artifacts[i].Aggregate = inArt.Aggregate
+			}
 		}
 	}
 
-	return SubstituteParams(ctx, newTmpl, globalParams, localParams)
+	newTmpl, err := SubstituteParams(ctx, newTmpl, globalParams, localParams)
+	if err != nil {
+		return nil, err
+	}
+	placeholders := NewPlaceholderGenerator()
+	for _, inParam := range newTmpl.Inputs.Parameters {
+		// values that are not resolved yet, e.g. when validating, are validated once resolved
+		if inParam.Schema == nil || inParam.Value == nil || strings.Contains(inParam.Value.String(), "{{") || (validateOnly && placeholders.IsPlaceholder(inParam.Value.String())) {
+			continue
+		}
+		if err := inParam.Schema.ValidateValue(inParam.Value.String(), inParam.Enum); err != nil {
+			return nil, errors.Errorf(errors.CodeBadRequest, "inputs.parameters.%s: %s", inParam.Name, err.Error())
+		}
+	}
+	return newTmpl, nil
 }
 
 // substituteConfigMapKeyRefParam performs template substitution for ConfigMapKeyRef
@@ -221,8 +276,28 @@ func substituteConfigMapKeyRefParam(ctx context.Context, in string, replaceMap m
 	return replacedString, nil
 }
 
+// substituteSecretKeyRefParam performs template substitution for SecretKeyRef
+func substituteSecretKeyRefParam(ctx context.Context, in string, replaceMap map[string]interface{}) (string, error) {
+	tmpl, err := template.NewTemplate(in)
+	if err != nil {
+		return "", err
+	}
+	replacedString, err := tmpl.Replace(ctx, replaceMap, false)
+	if err != nil {
+		return "", fmt.Errorf("failed to substitute secretKeyRef: %w", err)
+	}
+	return replacedString, nil
+}
+
 // SubstituteParams returns a new copy of the template with global, pod, and input parameters substituted
 func SubstituteParams(ctx context.Context, tmpl *wfv1.Template, globalParams, localParams Parameters) (*wfv1.Template, error) {
+	// A child workflow substitutes its own spec, so only its arguments are substituted here
+	var childSpec *wfv1.WorkflowSpec
+	if tmpl.ChildWorkflow != nil {
+		childSpec = tmpl.ChildWorkflow.Spec.DeepCopy()
+		tmpl = tmpl.DeepCopy()
+		tmpl.ChildWorkflow.Spec = wfv1.WorkflowSpec{Arguments: childSpec.Arguments}
+	}
 	tmplBytes, err := json.Marshal(tmpl)
 	if err != nil {
 		return nil, errors.InternalWrapError(err)
@@ -277,6 +352,10 @@ func SubstituteParams(ctx context.Context, tmpl *wfv1.Template, globalParams, lo
 	if err != nil {
 		return nil, errors.InternalWrapError(err)
 	}
+	if childSpec != nil {
+		childSpec.Arguments = newTmpl.ChildWorkflow.Spec.Arguments
+		newTmpl.ChildWorkflow.Spec = *childSpec
+	}
 	return &newTmpl, nil
 }
 
@@ -302,6 +381,10 @@ func GenerateOnExitNodeName(parentNodeName string) string {
 	return fmt.Sprintf("%s.onExit", parentNodeName)
 }
 
+func GenerateFinallyNodeName(parentNodeName string) string {
+	return fmt.Sprintf("%s.finally", parentNodeName)
+}
+
 func IsDone(un *unstructured.Unstructured) bool {
 	return un.GetDeletionTimestamp() == nil &&
 		un.GetLabels()[LabelKeyCompleted] == "true" &&
@@ -1,14 +1,19 @@
 package common
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 )
@@ -242,13 +247,13 @@ func TestOverridableDefaultInputArts(t *testing.T) {
 	globalParams := make(map[string]string)
 	localParams := make(map[string]string)
 
-	newTmpl, err := ProcessArgs(ctx, &tmpl, &inputs, globalParams, localParams, false, "", nil)
+	newTmpl, err := ProcessArgs(ctx, &tmpl, &inputs, globalParams, localParams, false, "", nil, nil)
 	require.NoError(t, err)
 	assert.NotNil(t, newTmpl)
 	assert.Equal(t, newTmpl.Inputs.Artifacts[0].Raw.Data, rawArt.Data)
 
 	inputs.Artifacts = []wfv1.Artifact{inputArt}
-	newTmpl, err = ProcessArgs(ctx, &tmpl, &inputs, globalParams, localParams, false, "", nil)
+	newTmpl, err = ProcessArgs(ctx, &tmpl, &inputs, globalParams, localParams, false, "", nil, nil)
 	require.NoError(t, err)
 	assert.NotNil(t, newTmpl)
 	assert.Equal(t, newTmpl.Inputs.Artifacts[0].Raw.Data, inputRawArt.Data)
@@ -294,12 +299,12 @@ func TestOverridableTemplateInputParamsValue(t *testing.T) {
 	globalParams := make(map[string]string)
 	localParams := make(map[string]string)
 
-	newTmpl, err := ProcessArgs(ctx, &tmpl, &valueArgs, globalParams, localParams, false, "", configMapStore)
+	newTmpl, err := ProcessArgs(ctx, &tmpl, &valueArgs, globalParams, localParams, false, "", configMapStore, nil)
 	require.NoError(t, err)
 	assert.NotNil(t, newTmpl)
 	assert.Equal(t, newTmpl.Inputs.Parameters[0].Value.String(), valueArgs.Parameters[0].Value.String())
 
-	newTmpl, err = ProcessArgs(ctx, &tmpl, &valueFromArgs, globalParams, localParams, false, "", configMapStore)
+	newTmpl, err = ProcessArgs(ctx, &tmpl, &valueFromArgs, globalParams, localParams, false, "", configMapStore, nil)
 	require.NoError(t, err)
 	assert.NotNil(t, newTmpl)
 	assert.Equal(t, newTmpl.Inputs.Parameters[0].Value.String(), overrideConfigMapValue)
@@ -353,13 +358,85 @@ func TestOverridableTemplateInputParamsValueFrom(t *testing.T) {
 	globalParams := map[string]string{paramName: "overrideValue"}
 	localParams := make(map[string]string)
 
-	newTmpl, err := ProcessArgs(ctx, &tmpl, &valueArgs, globalParams, localParams, false, "", configMapStore)
+	newTmpl, err := ProcessArgs(ctx, &tmpl, &valueArgs, globalParams, localParams, false, "", configMapStore, nil)
 	require.NoError(t, err)
 	assert.NotNil(t, newTmpl)
 	assert.Equal(t, newTmpl.Inputs.Parameters[0].Value.String(), valueArgs.Parameters[0].Value.String())
 
-	newTmpl, err = ProcessArgs(ctx, &tmpl, &valueFromArgs, globalParams, localParams, false, "", configMapStore)
+	newTmpl, err = ProcessArgs(ctx, &tmpl, &valueFromArgs, globalParams, localParams, false, "", configMapStore, nil)
 	require.NoError(t, err)
 	assert.NotNil(t, newTmpl)
 	assert.Equal(t, newTmpl.Inputs.Parameters[0].Value.String(), overrideConfigMapValue)
 }
+
+func TestProcessArgsParameterSchema(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	tmpl := wfv1.Template{Name: "main", Inputs: wfv1.Inputs{Parameters: []wfv1.Parameter{
+		{Name: "parallelism", Schema: &wfv1.ParameterSchema{Type: wfv1.ParameterTypeInteger, Maximum: ptr.To(int64(10))}},
+	}}}
+	args := func(value string) *wfv1.Arguments {
+		return &wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "parallelism", Value: wfv1.AnyStringPtr(value)}}}
+	}
+	globalParams := Parameters{"workflow.parameters.parallelism": "ten"}
+
+	_, err := ProcessArgs(ctx, &tmpl, args("5"), globalParams, Parameters{}, false, "", nil, nil)
+	require.NoError(t, err)
+	_, err = ProcessArgs(ctx, &tmpl, args("11"), globalParams, Parameters{}, false, "", nil, nil)
+	require.EqualError(t, err, "inputs.parameters.parallelism: 11 is greater than the maximum 10")
+	_, err = ProcessArgs(ctx, &tmpl, args("{{workflow.parameters.parallelism}}"), globalParams, Parameters{}, false, "", nil, nil)
+	require.EqualError(t, err, `inputs.parameters.parallelism: "ten" is not an integer`, "validated once resolved")
+	_, err = ProcessArgs(ctx, &tmpl, args("{{steps.a.outputs.result}}"), globalParams, Parameters{}, true, "", nil, nil)
+	require.NoError(t, err, "placeholders are not validated")
+}
+
+func TestProcessArgsSecretKeyRef(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	tmpl := wfv1.Template{Name: "main", Inputs: wfv1.Inputs{Parameters: []wfv1.Parameter{{Name: "token"}}}}
+	args := func(name string, defaultValue *wfv1.AnyString) *wfv1.Arguments {
+		return &wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "token", ValueFrom: &wfv1.ValueFrom{
+			SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: name}, Key: "token"},
+			Default:      defaultValue,
+		}}}}
+	}
+	secretGetter := func(ctx context.Context, namespace, name, key string) (string, error) {
+		switch name {
+		case "my-secret":
+			return namespace + "/" + name + "/" + key, nil
+		case "forbidden":
+			return "", fmt.Errorf("forbidden")
+		}
+		return "", errors.Errorf(errors.CodeNotFound, "Secret '%s' does not exist", name)
+	}
+	globalParams := Parameters{"workflow.parameters.secret": "my-secret"}
+
+	newTmpl, err := ProcessArgs(ctx, &tmpl, args("{{workflow.parameters.secret}}", nil), globalParams, Parameters{}, false, "my-ns", nil, secretGetter)
+	require.NoError(t, err)
+	assert.Equal(t, "my-ns/my-secret/token", newTmpl.Inputs.Parameters[0].Value.String())
+	newTmpl, err = ProcessArgs(ctx, &tmpl, args("missing", wfv1.AnyStringPtr("default")), globalParams, Parameters{}, false, "my-ns", nil, secretGetter)
+	require.NoError(t, err)
+	assert.Equal(t, "default", newTmpl.Inputs.Parameters[0].Value.String())
+	_, err = ProcessArgs(ctx, &tmpl, args("missing", nil), globalParams, Parameters{}, false, "my-ns", nil, secretGetter)
+	require.EqualError(t, err, "unable to retrieve inputs.parameters.token from Secret: Secret 'missing' does not exist")
+	_, err = ProcessArgs(ctx, &tmpl, args("forbidden", wfv1.AnyStringPtr("default")), globalParams, Parameters{}, false, "my-ns", nil, secretGetter)
+	require.EqualError(t, err, "unable to retrieve inputs.parameters.token from Secret: forbidden")
+	newTmpl, err = ProcessArgs(ctx, &tmpl, args("my-secret", nil), globalParams, Parameters{}, true, "", nil, nil)
+	require.NoError(t, err, "not resolved without a secret getter")
+	assert.Nil(t, newTmpl.Inputs.Parameters[0].Value)
+}
+
+func TestProcessArgsPlugin(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	tmpl := wfv1.Template{
+		Name: "main",
+		Inputs: wfv1.Inputs{Parameters: []wfv1.Parameter{{Name: "token", ValueFrom: &wfv1.ValueFrom{
+			Plugin:  &wfv1.Plugin{Object: wfv1.Object{Value: json.RawMessage(`{"vault":{"path":"secret/data/{{workflow.parameters.name}}"}}`)}},
+			Default: wfv1.AnyStringPtr("anonymous"),
+		}}}},
+		HTTP: &wfv1.HTTP{URL: "https://example.com/{{inputs.parameters.token}}"},
+	}
+	newTmpl, err := ProcessArgs(ctx, &tmpl, &wfv1.Arguments{}, Parameters{"workflow.parameters.name": "token"}, Parameters{}, false, "my-ns", nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, newTmpl.Inputs.Parameters[0].Value, "resolved by the agent")
+	assert.JSONEq(t, `{"vault":{"path":"secret/data/token"}}`, string(newTmpl.Inputs.Parameters[0].ValueFrom.Plugin.Value))
+	assert.Equal(t, "https://example.com/{{inputs.parameters.token}}", newTmpl.HTTP.URL)
+}
@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+// adaptiveRateLimiterInterval is the least time between adjustments of the limit, so that a burst of throttled
+// requests only decreases it once
+const adaptiveRateLimiterInterval = time.Second
+
+// adaptiveRateLimiter is a rate limiter whose limit, if adaptive, adapts to the load of the Kubernetes API server
+type adaptiveRateLimiter struct {
+	*rate.Limiter
+	adaptive     *config.AdaptiveRateLimit
+	maxLimit     rate.Limit
+	mutex        sync.Mutex
+	lastAdjusted time.Time
+}
+
+func newAdaptiveRateLimiter(c config.ResourceRateLimit) *adaptiveRateLimiter {
+	l := &adaptiveRateLimiter{
+		Limiter:  rate.NewLimiter(rate.Limit(c.Limit), c.Burst),
+		adaptive: c.Adaptive,
+	}
+	if c.Adaptive != nil {
+		l.maxLimit = rate.Limit(c.Adaptive.GetMaxLimit(c.Limit))
+	}
+	return l
+}
+
+// isAPIServerThrottling returns whether the error is the Kubernetes API server throttling requests
+func isAPIServerThrottling(err error) bool {
+	return apierr.IsTooManyRequests(err) || apierr.IsServerTimeout(err) || apierr.IsTimeout(err)
+}
+
+// observe adapts the limit to the result of a request: it is increased if the request succeeded, and decreased if the
+// API server throttled it. Other errors do not change it.
+func (l *adaptiveRateLimiter) observe(err error) {
+	if l.adaptive == nil {
+		return
+	}
+	throttled := isAPIServerThrottling(err)
+	if err != nil && !throttled {
+		return
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	now := time.Now()
+	if now.Sub(l.lastAdjusted) < adaptiveRateLimiterInterval {
+		return
+	}
+	l.lastAdjusted = now
+	limit := l.Limit()
+	if throttled {
+		limit = max(limit*rate.Limit(l.adaptive.GetDecrease()), rate.Limit(l.adaptive.GetMinLimit()))
+	} else {
+		limit = min(limit+rate.Limit(l.adaptive.GetIncrease()), l.maxLimit)
+	}
+	l.SetLimitAt(now, limit)
+}
+
+// limited returns whether the rate limiter limits the rate at all, rather than being unlimited by default
+func (l *adaptiveRateLimiter) limited() bool {
+	return l.Limit() < math.MaxFloat32
+}
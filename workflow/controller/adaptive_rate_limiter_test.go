@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func TestAdaptiveRateLimiter(t *testing.T) {
+	throttled := apierr.NewTooManyRequests("too many requests", 1)
+	// allows the limit to be adjusted again
+	elapse := func(l *adaptiveRateLimiter) { l.lastAdjusted = l.lastAdjusted.Add(-adaptiveRateLimiterInterval) }
+
+	t.Run("Static", func(t *testing.T) {
+		l := newAdaptiveRateLimiter(config.ResourceRateLimit{Limit: 10, Burst: 20})
+		l.observe(throttled)
+		assert.Equal(t, rate.Limit(10), l.Limit())
+		assert.True(t, l.limited())
+	})
+	t.Run("Unlimited", func(t *testing.T) {
+		assert.False(t, newAdaptiveRateLimiter(config.Config{}.GetWorkflowUpdateRateLimit()).limited())
+	})
+	t.Run("Adaptive", func(t *testing.T) {
+		l := newAdaptiveRateLimiter(config.ResourceRateLimit{Limit: 10, Burst: 20, Adaptive: &config.AdaptiveRateLimit{MinLimit: 2, MaxLimit: 12, Increase: 1.5}})
+		l.observe(throttled)
+		assert.Equal(t, rate.Limit(5), l.Limit(), "decreased multiplicatively")
+		l.observe(throttled)
+		assert.Equal(t, rate.Limit(5), l.Limit(), "adjusted at most once per interval")
+		elapse(l)
+		l.observe(apierr.NewTimeoutError("timeout", 1))
+		assert.Equal(t, rate.Limit(2.5), l.Limit())
+		elapse(l)
+		l.observe(throttled)
+		assert.Equal(t, rate.Limit(2), l.Limit(), "not decreased below the min limit")
+		elapse(l)
+		l.observe(apierr.NewNotFound(schema.GroupResource{Resource: "pods"}, "my-pod"))
+		l.observe(errors.New("other error"))
+		assert.Equal(t, rate.Limit(2), l.Limit(), "other errors do not adjust the limit")
+		l.observe(nil)
+		assert.Equal(t, rate.Limit(3.5), l.Limit(), "increased additively")
+		for range 10 {
+			elapse(l)
+			l.observe(nil)
+		}
+		assert.Equal(t, rate.Limit(12), l.Limit(), "not increased above the max limit")
+	})
+	t.Run("Defaults", func(t *testing.T) {
+		l := newAdaptiveRateLimiter(config.ResourceRateLimit{Limit: 4, Burst: 4, Adaptive: &config.AdaptiveRateLimit{}})
+		l.observe(nil)
+		assert.Equal(t, rate.Limit(4), l.Limit(), "max limit defaults to the limit")
+		elapse(l)
+		l.observe(throttled)
+		assert.Equal(t, rate.Limit(2), l.Limit())
+		elapse(l)
+		l.observe(nil)
+		assert.Equal(t, rate.Limit(3), l.Limit())
+		assert.WithinDuration(t, time.Now(), l.lastAdjusted, time.Second)
+	})
+}
+
+func TestGetRateLimits(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx)
+	defer cancel()
+	assert.Empty(t, controller.getRateLimits(ctx))
+	controller.Config.ResourceRateLimit = &config.ResourceRateLimit{Limit: 10, Burst: 10}
+	controller.rateLimiter = controller.newRateLimiter()
+	assert.Equal(t, map[string]float64{"pod_creation": 10}, controller.getRateLimits(ctx))
+}
@@ -0,0 +1,159 @@
+package controller
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// AdminStatus is the state of the controller, reported by the admin endpoint
+type AdminStatus struct {
+	LogLevel logging.Level `json:"logLevel"`
+	// Running is whether the controller is running, rather than waiting to become the leader
+	Running bool `json:"running"`
+	// Draining is whether the controller is draining, and no longer reconciles workflows
+	Draining bool `json:"draining"`
+	// Workers is the number of workers, by queue
+	Workers map[string]int `json:"workers"`
+	// Queues is the number of items in each queue
+	Queues map[string]int `json:"queues,omitempty"`
+	// Informers is the number of objects in the cache of each informer
+	Informers map[string]int `json:"informers,omitempty"`
+}
+
+// AdminSettings are the settings that the admin endpoint can change at runtime. Unset settings are not changed.
+type AdminSettings struct {
+	LogLevel               string `json:"logLevel,omitempty"`
+	WorkflowWorkers        *int   `json:"workflowWorkers,omitempty"`
+	WorkflowArchiveWorkers *int   `json:"workflowArchiveWorkers,omitempty"`
+}
+
+// AdminHandler returns the handler of the admin endpoint, which serves pprof, the status of the controller, changes
+// its settings at runtime, and drains it. Requests must have the token in the Authorization header as a bearer token.
+func (wfc *WorkflowController) AdminHandler(token string) http.Handler {
+	mux := http.NewServeMux()
+	// pprof.Index serves the profiles by the path after "/debug/pprof/", so "/admin" is stripped from the paths
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.HandleFunc("GET /status", wfc.adminStatus)
+	mux.HandleFunc("POST /settings", wfc.adminSettings)
+	mux.HandleFunc("POST /drain", wfc.adminDrain)
+	handler := http.StripPrefix("/admin", mux)
+	expected := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+func (wfc *WorkflowController) adminStatus(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(wfc.getAdminStatus(r))
+}
+
+func (wfc *WorkflowController) getAdminStatus(r *http.Request) AdminStatus {
+	status := AdminStatus{
+		LogLevel: logging.RequireLoggerFromContext(r.Context()).Level(),
+		Running:  wfc.running.Load(),
+		Draining: wfc.draining.Load(),
+		Workers: map[string]int{
+			"workflow":        wfc.workflowWorkers.len(),
+			"workflowArchive": wfc.archiveWorkers.len(),
+		},
+	}
+	if !status.Running {
+		return status
+	}
+	status.Queues = map[string]int{
+		"workflow":        wfc.wfQueue.Len(),
+		"workflowArchive": wfc.wfArchiveQueue.Len(),
+		"podCleanup":      wfc.PodController.QueueLen(),
+	}
+	cached := func(informer cache.SharedIndexInformer) int {
+		return len(informer.GetStore().ListKeys())
+	}
+	status.Informers = map[string]int{
+		"workflows":               cached(wfc.wfInformer),
+		"workflowTemplates":       cached(wfc.wftmplInformer.Informer()),
+		"pods":                    wfc.PodController.CachedPods(),
+		"configMaps":              cached(wfc.configMapInformer),
+		"workflowTaskSets":        cached(wfc.wfTaskSetInformer.Informer()),
+		"workflowArtifactGCTasks": cached(wfc.artGCTaskInformer.Informer()),
+		"workflowTaskResults":     cached(wfc.taskResultInformer),
+	}
+	if wfc.cwftmplInformer != nil {
+		status.Informers["clusterWorkflowTemplates"] = cached(wfc.cwftmplInformer.Informer())
+	}
+	return status
+}
+
+func (wfc *WorkflowController) adminSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logging.RequireLoggerFromContext(ctx)
+	settings := &AdminSettings{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(settings); err != nil {
+		http.Error(w, fmt.Sprintf("invalid settings: %v", err), http.StatusBadRequest)
+		return
+	}
+	var level logging.Level
+	if settings.LogLevel != "" {
+		var err error
+		level, err = logging.ParseLevel(settings.LogLevel)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	for name, workers := range map[string]*int{"workflowWorkers": settings.WorkflowWorkers, "workflowArchiveWorkers": settings.WorkflowArchiveWorkers} {
+		if workers != nil && *workers < 0 {
+			http.Error(w, fmt.Sprintf("%s must not be negative", name), http.StatusBadRequest)
+			return
+		}
+	}
+	if (settings.WorkflowWorkers != nil || settings.WorkflowArchiveWorkers != nil) && !wfc.running.Load() {
+		http.Error(w, "workers cannot be changed until the controller is running, it may not be the leader", http.StatusServiceUnavailable)
+		return
+	}
+
+	changed := logging.Fields{}
+	if level != "" {
+		if err := logging.SetLevel(logger, level); err != nil {
+			http.Error(w, err.Error(), http.StatusNotImplemented)
+			return
+		}
+		changed["logLevel"] = level
+	}
+	if settings.WorkflowWorkers != nil {
+		wfc.workflowWorkers.resize(*settings.WorkflowWorkers)
+		changed["workflowWorkers"] = *settings.WorkflowWorkers
+	}
+	if settings.WorkflowArchiveWorkers != nil {
+		wfc.archiveWorkers.resize(*settings.WorkflowArchiveWorkers)
+		changed["workflowArchiveWorkers"] = *settings.WorkflowArchiveWorkers
+	}
+	// write an audit entry, as these settings are not in the configuration and are lost on restart
+	logger.WithFields(changed).Info(ctx, "changed controller settings")
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(wfc.getAdminStatus(r))
+}
+
+func (wfc *WorkflowController) adminDrain(w http.ResponseWriter, r *http.Request) {
+	if !wfc.RequestDrain() {
+		http.Error(w, "draining is disabled, or the controller is already draining", http.StatusConflict)
+		return
+	}
+	logging.RequireLoggerFromContext(r.Context()).Info(r.Context(), "drain requested")
+	w.WriteHeader(http.StatusAccepted)
+}
@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func TestAdminHandler(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx)
+	defer cancel()
+	controller.wfArchiveQueue = workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]())
+	defer controller.wfArchiveQueue.ShutDown()
+	logger := logging.NewSlogLogger(logging.Info, logging.Text)
+	handler := LogMiddleware(logger, controller.AdminHandler("my-token"))
+
+	serve := func(method, path, token, body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, path, strings.NewReader(body))
+		if token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+	status := func(w *httptest.ResponseRecorder) AdminStatus {
+		s := AdminStatus{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &s))
+		return s
+	}
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, serve(http.MethodGet, "/admin/status", "", "").Code)
+		assert.Equal(t, http.StatusUnauthorized, serve(http.MethodGet, "/admin/debug/pprof/", "wrong-token", "").Code)
+	})
+	t.Run("Pprof", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/admin/debug/pprof/goroutine", "my-token", "").Code)
+	})
+	t.Run("NotRunning", func(t *testing.T) {
+		w := serve(http.MethodGet, "/admin/status", "my-token", "")
+		require.Equal(t, http.StatusOK, w.Code)
+		s := status(w)
+		assert.False(t, s.Running)
+		assert.Nil(t, s.Queues)
+		assert.Equal(t, http.StatusServiceUnavailable, serve(http.MethodPost, "/admin/settings", "my-token", `{"workflowWorkers": 2}`).Code)
+	})
+	t.Run("Running", func(t *testing.T) {
+		controller.workflowWorkers.start(ctx, 1)
+		controller.archiveWorkers.start(ctx, 1)
+		controller.running.Store(true)
+		defer controller.running.Store(false)
+
+		s := status(serve(http.MethodGet, "/admin/status", "my-token", ""))
+		assert.True(t, s.Running)
+		assert.Equal(t, logging.Info, s.LogLevel)
+		assert.Equal(t, map[string]int{"workflow": 1, "workflowArchive": 1}, s.Workers)
+		assert.Contains(t, s.Queues, "workflow")
+		assert.Contains(t, s.Informers, "workflows")
+
+		assert.Equal(t, http.StatusBadRequest, serve(http.MethodPost, "/admin/settings", "my-token", `{"workflowWorkers": -1}`).Code)
+		assert.Equal(t, http.StatusBadRequest, serve(http.MethodPost, "/admin/settings", "my-token", `{"logLevel": "verbose"}`).Code)
+
+		w := serve(http.MethodPost, "/admin/settings", "my-token", `{"logLevel": "debug", "workflowWorkers": 3, "workflowArchiveWorkers": 0}`)
+		require.Equal(t, http.StatusOK, w.Code)
+		s = status(w)
+		assert.Equal(t, logging.Debug, s.LogLevel)
+		assert.Equal(t, map[string]int{"workflow": 3, "workflowArchive": 0}, s.Workers)
+		assert.Equal(t, logging.Debug, logger.Level())
+	})
+}
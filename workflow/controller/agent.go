@@ -9,8 +9,10 @@ import (
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 
+	"github.com/argoproj/argo-workflows/v3/config"
 	"github.com/argoproj/argo-workflows/v3/errors"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -148,7 +150,12 @@ func (woc *wfOperationCtx) createAgentPod(ctx context.Context) (*apiv1.Pod, erro
 	}
 
 	serviceAccountName := woc.execWf.Spec.ServiceAccountName
-	tokenVolume, tokenVolumeMount, err := woc.getServiceAccountTokenVolume(ctx, serviceAccountName)
+	executorServiceAccountName := serviceAccountName
+	var token *config.ExecutorServiceAccountToken
+	if m := woc.controller.Config.GetExecutorServiceAccount(woc.wf.Namespace); m != nil {
+		executorServiceAccountName, token = m.ServiceAccountName, m.Token
+	}
+	tokenVolume, tokenVolumeMount, err := woc.getExecutorTokenVolume(ctx, podName, executorServiceAccountName, token)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get token volumes: %w", err)
 	}
@@ -168,8 +175,8 @@ func (woc *wfOperationCtx) createAgentPod(ctx context.Context) (*apiv1.Pod, erro
 	}
 	agentCtrTemplate := apiv1.Container{
 		Command:         []string{"argoexec"},
-		Image:           woc.controller.executorImage(),
-		ImagePullPolicy: woc.controller.executorImagePullPolicy(),
+		Image:           woc.executorImage(),
+		ImagePullPolicy: woc.executorImagePullPolicy(),
 		Env:             envVars,
 		SecurityContext: common.MinimalCtrSC(),
 		Resources: apiv1.ResourceRequirements{
@@ -184,6 +191,14 @@ func (woc *wfOperationCtx) createAgentPod(ctx context.Context) (*apiv1.Pod, erro
 		},
 		VolumeMounts: podVolumeMounts,
 	}
+	// the executor defaults that select the workflow take precedence, e.g. to satisfy the LimitRange of the namespace
+	executorDefaults := woc.executorDefaults()
+	if executorDefaults.AgentResources != nil {
+		agentCtrTemplate.Resources = *executorDefaults.AgentResources.DeepCopy()
+	}
+	if executorDefaults.SecurityContext != nil {
+		agentCtrTemplate.SecurityContext = executorDefaults.SecurityContext.DeepCopy()
+	}
 	// the `init` container populates the shared empty-dir volume with tokens
 	agentInitCtr := agentCtrTemplate.DeepCopy()
 	agentInitCtr.Name = common.InitContainerName
@@ -239,9 +254,14 @@ func (woc *wfOperationCtx) createAgentPod(ctx context.Context) (*apiv1.Pod, erro
 		pod.Spec = *patchedPodSpec
 	}
 
+	if err := woc.applyPodSecurityStandard(pod); err != nil {
+		return nil, err
+	}
+
 	if woc.controller.Config.InstanceID != "" {
 		pod.Labels[common.LabelKeyControllerInstanceID] = woc.controller.Config.InstanceID
 	}
+	woc.addInformerLabels(pod)
 
 	log.Debug(ctx, "Creating Agent pod")
 
@@ -281,6 +301,14 @@ func (woc *wfOperationCtx) getExecutorPlugins(ctx context.Context) ([]apiv1.Cont
 				// only mount the token for this plugin, not others
 				SubPath: c.Name,
 			})
+			if c.LivenessProbe == nil {
+				// the kubelet restarts plugins that stop accepting connections, which the agent checks before calling them
+				c.LivenessProbe = &apiv1.Probe{
+					ProbeHandler: apiv1.ProbeHandler{
+						TCPSocket: &apiv1.TCPSocketAction{Port: intstr.FromInt32(c.Ports[0].ContainerPort)},
+					},
+				}
+			}
 			if s.AutomountServiceAccountToken {
 				volume, volumeMount, err := woc.getServiceAccountTokenVolume(ctx, plug.Name+"-executor-plugin")
 				if err != nil {
@@ -9,8 +9,11 @@ import (
 	apiv1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-workflows/v3/config"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/test/util"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/secrets"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
@@ -141,6 +144,28 @@ status:
 			assert.Equal(t, "virtual-node", pod.Spec.NodeName)
 		}
 	})
+	t.Run("CreateTaskSetWithExecutorServiceAccount", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		cancel, controller := newController(ctx, wf, ts, defaultServiceAccount)
+		defer cancel()
+		_, err := util.CreateServiceAccountWithToken(ctx, controller.kubeclientset, "default", "executor")
+		require.NoError(t, err)
+		controller.Config.ExecutorServiceAccounts = []config.ExecutorServiceAccount{{Namespaces: []string{"default"}, ServiceAccountName: "executor"}}
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		pods, err := woc.controller.kubeclientset.CoreV1().Pods("default").List(ctx, v1.ListOptions{})
+		require.NoError(t, err)
+		require.Len(t, pods.Items, 1)
+		pod := pods.Items[0]
+		assert.Empty(t, pod.Spec.ServiceAccountName)
+		var secretNames []string
+		for _, v := range pod.Spec.Volumes {
+			if strings.HasPrefix(v.Name, "kube-api-access-") {
+				secretNames = append(secretNames, v.Secret.SecretName)
+			}
+		}
+		assert.Equal(t, []string{secrets.TokenName("executor")}, secretNames)
+	})
 }
 
 func TestAssessAgentPodStatus(t *testing.T) {
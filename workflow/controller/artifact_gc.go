@@ -43,7 +43,7 @@ func (woc *wfOperationCtx) addArtifactGCFinalizer(ctx context.Context) {
 		if woc.wf.Status.ArtifactGCStatus.NotSpecified {
 			return // we already verified it's not required for this workflow
 		}
-		if woc.HasArtifactGC() {
+		if woc.HasArtifactGC(ctx) {
 			woc.log.Info(ctx, "adding artifact GC finalizer")
 			finalizers := append(woc.wf.GetFinalizers(), common.FinalizerArtifactGC)
 			woc.wf.SetFinalizers(finalizers)
@@ -77,7 +77,7 @@ func (woc *wfOperationCtx) garbageCollectArtifacts(ctx context.Context) error {
 	return nil
 }
 
-func (woc *wfOperationCtx) HasArtifactGC() bool {
+func (woc *wfOperationCtx) HasArtifactGC(ctx context.Context) bool {
 	// ArtifactGC can be defined on the Workflow level or on the Artifact level
 	// It may be defined in the Workflow itself or in a WorkflowTemplate referenced by the Workflow
 
@@ -102,6 +102,16 @@ func (woc *wfOperationCtx) HasArtifactGC() bool {
 		}
 	}
 
+	// or to the WorkflowTemplates it pinned, if the referenced templates are not stored
+	for _, template := range woc.pinnedTemplates(ctx) {
+		for _, artifact := range template.Outputs.Artifacts {
+			strategy := woc.execWf.GetArtifactGCStrategy(&artifact)
+			if strategy != wfv1.ArtifactGCStrategyUndefined && strategy != wfv1.ArtifactGCNever {
+				return true
+			}
+		}
+	}
+
 	return false
 }
 
@@ -334,8 +344,12 @@ func (woc *wfOperationCtx) addTemplateArtifactsToTasks(ctx context.Context, podN
 	for _, artifactSearchResult := range artifactSearchResults {
 		artifactNodeSpec, found := artifactsByNode[artifactSearchResult.NodeID]
 		if !found {
+			nodeArchiveLocation := archiveLocation
+			if !template.ArchiveLocation.HasLocation() {
+				nodeArchiveLocation = woc.nodeArchiveLocation(ctx, artifactSearchResult.NodeID, archiveLocation)
+			}
 			artifactsByNode[artifactSearchResult.NodeID] = wfv1.ArtifactNodeSpec{
-				ArchiveLocation: archiveLocation,
+				ArchiveLocation: nodeArchiveLocation,
 				Artifacts:       make(map[string]wfv1.Artifact),
 			}
 			artifactNodeSpec = artifactsByNode[artifactSearchResult.NodeID]
@@ -348,6 +362,21 @@ func (woc *wfOperationCtx) addTemplateArtifactsToTasks(ctx context.Context, podN
 
 }
 
+// nodeArchiveLocation returns the archive location of the artifact repository recorded on the node, if its template
+// had its own artifactRepositoryRef, otherwise it returns archiveLocation.
+func (woc *wfOperationCtx) nodeArchiveLocation(ctx context.Context, nodeID string, archiveLocation *wfv1.ArtifactLocation) *wfv1.ArtifactLocation {
+	node, err := woc.wf.Status.Nodes.Get(nodeID)
+	if err != nil || node.ArtifactRepositoryRef == nil {
+		return archiveLocation
+	}
+	repo, err := woc.controller.artifactRepositories.Get(ctx, node.ArtifactRepositoryRef)
+	if err != nil {
+		woc.log.WithError(err).WithField("nodeID", nodeID).Warn(ctx, "failed to get the node's artifact repository, using the default")
+		return archiveLocation
+	}
+	return repo.ToArtifactLocation()
+}
+
 // find WorkflowArtifactGCTask CRD object by name
 func (woc *wfOperationCtx) getArtifactTask(taskName string) (*wfv1.WorkflowArtifactGCTask, error) {
 	key := woc.wf.Namespace + "/" + taskName
@@ -435,8 +464,8 @@ func (woc *wfOperationCtx) createArtifactGCPod(ctx context.Context, strategy wfv
 			Containers: []corev1.Container{
 				{
 					Name:            common.MainContainerName,
-					Image:           woc.controller.executorImage(),
-					ImagePullPolicy: woc.controller.executorImagePullPolicy(),
+					Image:           woc.executorImage(),
+					ImagePullPolicy: woc.executorImagePullPolicy(),
 					Args:            append([]string{"artifact", "delete"}, woc.getExecutorLogOpts(ctx)...),
 					Env: []corev1.EnvVar{
 						{Name: common.EnvVarArtifactGCPodHash, Value: woc.artifactGCPodLabel(podName)},
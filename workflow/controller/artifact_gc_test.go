@@ -716,7 +716,7 @@ func TestWorkflowHasArtifactGC(t *testing.T) {
 			defer cancel()
 			woc := newWorkflowOperationCtx(ctx, wf, controller)
 
-			hasArtifact := woc.HasArtifactGC()
+			hasArtifact := woc.HasArtifactGC(ctx)
 
 			assert.Equal(t, tt.expectedResult, hasArtifact)
 		})
@@ -0,0 +1,159 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/env"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/template"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+var artifactManifestTimeout = env.LookupEnvDurationOr(logging.InitLoggerInContext(), "ARTIFACT_MANIFEST_TIMEOUT", 30*time.Second)
+
+// artifactManifestFileName is the name of the manifest, saved under the key of the workflow's archive location
+const artifactManifestFileName = "artifact-manifest.json"
+
+// artifactManifest lists the output artifacts of a workflow, so they can be found without the workflow
+type artifactManifest struct {
+	Workflow  artifactManifestWorkflow   `json:"workflow"`
+	Artifacts []artifactManifestArtifact `json:"artifacts"`
+}
+
+type artifactManifestWorkflow struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	UID       string `json:"uid"`
+}
+
+type artifactManifestArtifact struct {
+	NodeID       string `json:"nodeId"`
+	NodeName     string `json:"nodeName"`
+	TemplateName string `json:"templateName,omitempty"`
+	Name         string `json:"name"`
+	Key          string `json:"key"`
+	SizeBytes    int64  `json:"sizeBytes,omitempty"`
+	Checksum     string `json:"checksum,omitempty"`
+}
+
+// newArtifactManifest returns the manifest of the saved output artifacts of the workflow, sorted by node and name
+func newArtifactManifest(wf *wfv1.Workflow) *artifactManifest {
+	m := &artifactManifest{
+		Workflow:  artifactManifestWorkflow{Name: wf.Name, Namespace: wf.Namespace, UID: string(wf.UID)},
+		Artifacts: []artifactManifestArtifact{},
+	}
+	for _, node := range wf.Status.Nodes {
+		if node.Outputs == nil {
+			continue
+		}
+		for _, art := range node.Outputs.Artifacts {
+			key, err := art.GetKey()
+			if err != nil || key == "" {
+				continue
+			}
+			m.Artifacts = append(m.Artifacts, artifactManifestArtifact{
+				NodeID:       node.ID,
+				NodeName:     node.Name,
+				TemplateName: node.TemplateName,
+				Name:         art.Name,
+				Key:          key,
+				SizeBytes:    art.SizeBytes,
+				Checksum:     art.Checksum,
+			})
+		}
+	}
+	slices.SortFunc(m.Artifacts, func(a, b artifactManifestArtifact) int {
+		if c := strings.Compare(a.NodeID, b.NodeID); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return m
+}
+
+// artifactManifestLocation returns where to save the manifest, next to the workflow's logs and artifacts, i.e. under
+// the key of the archive location with the workflow's name as the pod name
+func (woc *wfOperationCtx) artifactManifestLocation(ctx context.Context) (*wfv1.ArtifactLocation, error) {
+	if woc.artifactRepository == nil || woc.artifactRepository.Get() == nil {
+		return nil, fmt.Errorf("no artifact repository is configured")
+	}
+	loc := woc.artifactRepository.ToArtifactLocation()
+	key, err := loc.GetKey()
+	if err != nil {
+		return nil, err
+	}
+	quoted, err := json.Marshal(key)
+	if err != nil {
+		return nil, err
+	}
+	replaced, err := template.Replace(ctx, string(quoted), woc.globalParams.Merge(common.Parameters{common.LocalVarPodName: woc.wf.Name}), true)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(replaced), &key); err != nil {
+		return nil, err
+	}
+	if err := loc.SetKey(path.Join(key, artifactManifestFileName)); err != nil {
+		return nil, err
+	}
+	return loc, nil
+}
+
+// writeArtifactManifest saves the manifest of the workflow's output artifacts to its artifact repository and records
+// where in its status. Failing to do so does not fail the workflow, it is reported in an event.
+func (woc *wfOperationCtx) writeArtifactManifest(ctx context.Context) {
+	if !woc.controller.Config.ArtifactManifest || woc.wf.Status.ArtifactManifest != nil {
+		return
+	}
+	art, err := woc.saveArtifactManifest(ctx)
+	if err != nil {
+		woc.log.WithError(err).Warn(ctx, "Failed to write artifact manifest")
+		woc.eventRecorder.Event(woc.wf, apiv1.EventTypeWarning, "ArtifactManifestFailed", fmt.Sprintf("Failed to write artifact manifest: %v", err))
+		return
+	}
+	woc.wf.Status.ArtifactManifest = art
+	woc.updated = true
+}
+
+func (woc *wfOperationCtx) saveArtifactManifest(ctx context.Context) (*wfv1.Artifact, error) {
+	loc, err := woc.artifactManifestLocation(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(newArtifactManifest(woc.wf))
+	if err != nil {
+		return nil, err
+	}
+	dir, err := os.MkdirTemp("", "artifact-manifest")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	localPath := filepath.Join(dir, artifactManifestFileName)
+	if err := os.WriteFile(localPath, data, 0o600); err != nil {
+		return nil, err
+	}
+	art := &wfv1.Artifact{Name: "artifact-manifest", ArtifactLocation: *loc, SizeBytes: int64(len(data))}
+	ctx, cancel := context.WithTimeout(ctx, artifactManifestTimeout)
+	defer cancel()
+	driver, err := artifact.NewDriver(ctx, art, artifactRepositoryResources{woc.controller.kubeclientset, woc.wf.Namespace})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create artifact driver: %w", err)
+	}
+	if err := driver.Save(ctx, localPath, art); err != nil {
+		return nil, err
+	}
+	return art, nil
+}
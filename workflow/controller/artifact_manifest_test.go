@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func TestNewArtifactManifest(t *testing.T) {
+	s3 := func(key string) wfv1.ArtifactLocation {
+		return wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}, Key: key}}
+	}
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", UID: "my-uid"},
+		Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+			"my-wf-2": {ID: "my-wf-2", Name: "my-wf[0].b", TemplateName: "b", Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{
+				{Name: "main-logs", ArtifactLocation: s3("my-wf/my-wf-2/main.log")},
+			}}},
+			"my-wf-1": {ID: "my-wf-1", Name: "my-wf[0].a", TemplateName: "a", Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{
+				{Name: "result", ArtifactLocation: s3("my-wf/my-wf-1/result.tgz"), SizeBytes: 12, Checksum: "sha256:abc"},
+				{Name: "not-saved", Path: "/tmp/not-saved"},
+			}}},
+			"my-wf": {ID: "my-wf", Name: "my-wf"},
+		}},
+	}
+
+	m := newArtifactManifest(wf)
+
+	assert.Equal(t, artifactManifestWorkflow{Name: "my-wf", Namespace: "my-ns", UID: "my-uid"}, m.Workflow)
+	assert.Equal(t, []artifactManifestArtifact{
+		{NodeID: "my-wf-1", NodeName: "my-wf[0].a", TemplateName: "a", Name: "result", Key: "my-wf/my-wf-1/result.tgz", SizeBytes: 12, Checksum: "sha256:abc"},
+		{NodeID: "my-wf-2", NodeName: "my-wf[0].b", TemplateName: "b", Name: "main-logs", Key: "my-wf/my-wf-2/main.log"},
+	}, m.Artifacts)
+}
+
+func TestArtifactManifestLocation(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx)
+	defer cancel()
+	wf := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns"}}
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.globalParams[common.GlobalVarWorkflowName] = "my-wf"
+
+	t.Run("NotConfigured", func(t *testing.T) {
+		woc.artifactRepository = &wfv1.ArtifactRepository{}
+		_, err := woc.artifactManifestLocation(ctx)
+		assert.EqualError(t, err, "no artifact repository is configured")
+	})
+	t.Run("KeyFormat", func(t *testing.T) {
+		woc.artifactRepository = &wfv1.ArtifactRepository{S3: &wfv1.S3ArtifactRepository{
+			S3Bucket:  wfv1.S3Bucket{Bucket: "my-bucket"},
+			KeyFormat: "archive/{{workflow.name}}/{{pod.name}}",
+		}}
+		loc, err := woc.artifactManifestLocation(ctx)
+		require.NoError(t, err)
+		key, err := loc.GetKey()
+		require.NoError(t, err)
+		assert.Equal(t, "archive/my-wf/my-wf/artifact-manifest.json", key)
+		assert.Equal(t, "my-bucket", loc.S3.Bucket)
+	})
+}
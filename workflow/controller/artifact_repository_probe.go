@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/env"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+)
+
+var (
+	artifactRepositoryProbePeriod  = env.LookupEnvDurationOr(logging.InitLoggerInContext(), "ARTIFACT_REPOSITORY_PROBE_PERIOD", 5*time.Minute)
+	artifactRepositoryProbeTimeout = env.LookupEnvDurationOr(logging.InitLoggerInContext(), "ARTIFACT_REPOSITORY_PROBE_TIMEOUT", 30*time.Second)
+)
+
+// artifactRepositoryProbeKey is listed to probe a repository, it is not expected to exist
+const artifactRepositoryProbeKey = ".argo-workflows-probe"
+
+// probeArtifactRepositories probes every WorkflowArtifactRepository and records whether it is reachable in its status
+func (wfc *WorkflowController) probeArtifactRepositories(ctx context.Context) {
+	logger := logging.RequireLoggerFromContext(ctx)
+	repos, err := wfc.wfclientset.ArgoprojV1alpha1().WorkflowArtifactRepositories(wfc.managedNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		logger.WithError(err).Error(ctx, "Failed to list artifact repositories")
+		return
+	}
+	for _, repo := range repos.Items {
+		condition := wfv1.Condition{Type: wfv1.ConditionTypeReachable, Status: metav1.ConditionTrue}
+		if reachable, err := wfc.probeArtifactRepository(ctx, &repo); err != nil {
+			condition.Status = metav1.ConditionUnknown
+			if !reachable {
+				condition.Status = metav1.ConditionFalse
+			}
+			condition.Message = err.Error()
+		}
+		repo.Status.Conditions.UpsertCondition(condition)
+		repo.Status.LastProbedAt = &metav1.Time{Time: time.Now()}
+		if _, err := wfc.wfclientset.ArgoprojV1alpha1().WorkflowArtifactRepositories(repo.Namespace).UpdateStatus(ctx, &repo, metav1.UpdateOptions{}); err != nil {
+			logger.WithError(err).WithFields(logging.Fields{"namespace": repo.Namespace, "name": repo.Name}).Error(ctx, "Failed to update artifact repository status")
+		}
+	}
+}
+
+// probeArtifactRepository lists the repository to check that it exists and that its credentials are valid.
+// If it returns an error, reachable is false when the repository is unreachable and true when the controller could not tell.
+func (wfc *WorkflowController) probeArtifactRepository(ctx context.Context, repo *wfv1.WorkflowArtifactRepository) (reachable bool, err error) {
+	switch {
+	case repo.Spec.Get() == nil:
+		return false, fmt.Errorf("no artifact repository is configured")
+	case repo.Spec.Artifactory != nil || repo.Spec.HDFS != nil:
+		return true, fmt.Errorf("probing is not supported for this type of artifact repository")
+	}
+	ctx, cancel := context.WithTimeout(ctx, artifactRepositoryProbeTimeout)
+	defer cancel()
+	art := &wfv1.Artifact{ArtifactLocation: *repo.Spec.ToArtifactLocation()}
+	if err := art.SetKey(artifactRepositoryProbeKey); err != nil {
+		return false, err
+	}
+	driver, err := artifact.NewDriver(ctx, art, artifactRepositoryResources{wfc.kubeclientset, repo.Namespace})
+	if err != nil {
+		// the controller may not be allowed to read the credentials, that does not mean the workflow pods are not
+		return apierr.IsForbidden(err), fmt.Errorf("failed to create artifact driver: %w", err)
+	}
+	_, err = driver.ListObjects(ctx, art)
+	if err != nil && !argoerrs.IsCode(argoerrs.CodeNotFound, err) {
+		return false, err
+	}
+	return true, nil
+}
+
+// artifactRepositoryResources gets the secrets and config maps an artifact repository references from its namespace
+type artifactRepositoryResources struct {
+	kubeclientset kubernetes.Interface
+	namespace     string
+}
+
+func (r artifactRepositoryResources) GetSecret(ctx context.Context, name, key string) (string, error) {
+	secret, err := r.kubeclientset.CoreV1().Secrets(r.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return string(secret.Data[key]), nil
+}
+
+func (r artifactRepositoryResources) GetConfigMapKey(ctx context.Context, name, key string) (string, error) {
+	configMap, err := r.kubeclientset.CoreV1().ConfigMaps(r.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return configMap.Data[key], nil
+}
@@ -0,0 +1,55 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func TestProbeArtifactRepositories(t *testing.T) {
+	repo := func(name string, spec wfv1.ArtifactRepository) *wfv1.WorkflowArtifactRepository {
+		return &wfv1.WorkflowArtifactRepository{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-ns"}, Spec: spec}
+	}
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx,
+		repo("empty", wfv1.ArtifactRepository{}),
+		repo("artifactory", wfv1.ArtifactRepository{Artifactory: &wfv1.ArtifactoryArtifactRepository{RepoURL: "http://my-repo"}}),
+		repo("missing-secret", wfv1.ArtifactRepository{S3: &wfv1.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{
+			Endpoint:        "my-endpoint",
+			Bucket:          "my-bucket",
+			AccessKeySecret: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "my-secret"}, Key: "accessKey"},
+			SecretKeySecret: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "my-secret"}, Key: "secretKey"},
+		}}}),
+	)
+	defer cancel()
+
+	controller.probeArtifactRepositories(ctx)
+
+	reachable := func(name string) wfv1.Condition {
+		r, err := controller.wfclientset.ArgoprojV1alpha1().WorkflowArtifactRepositories("my-ns").Get(ctx, name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotNil(t, r.Status.LastProbedAt)
+		require.Len(t, r.Status.Conditions, 1)
+		return r.Status.Conditions[0]
+	}
+	t.Run("NotConfigured", func(t *testing.T) {
+		c := reachable("empty")
+		assert.Equal(t, metav1.ConditionFalse, c.Status)
+		assert.Equal(t, "no artifact repository is configured", c.Message)
+	})
+	t.Run("NotSupported", func(t *testing.T) {
+		c := reachable("artifactory")
+		assert.Equal(t, metav1.ConditionUnknown, c.Status)
+	})
+	t.Run("MissingSecret", func(t *testing.T) {
+		c := reachable("missing-secret")
+		assert.Equal(t, metav1.ConditionFalse, c.Status)
+		assert.Contains(t, c.Message, "failed to create artifact driver")
+	})
+}
@@ -2,6 +2,9 @@ package cache
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"regexp"
 	"sync"
 	"time"
@@ -14,9 +17,28 @@ import (
 
 var cacheKeyRegex = regexp.MustCompile("^[a-zA-Z0-9][-a-zA-Z0-9]*$")
 
+// DefaultKey returns the key of a template memoized without one: the name of the template and a hash of its resolved
+// inputs, or just the hash for an inline template. Artifacts are hashed by their location rather than their content.
+func DefaultKey(tmpl *wfv1.Template) (string, error) {
+	data, err := json.Marshal(tmpl.Inputs)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(data)
+	key := hex.EncodeToString(hash[:])
+	if tmpl.Name != "" {
+		key = tmpl.Name + "-" + key
+	}
+	return key, nil
+}
+
 type MemoizationCache interface {
 	Load(ctx context.Context, key string) (*Entry, error)
 	Save(ctx context.Context, key string, nodeID string, value *wfv1.Outputs) error
+	// List returns the entries of the cache by key, without recording a hit
+	List(ctx context.Context) (map[string]*Entry, error)
+	// Delete deletes the entry of the key, so that the next workflow to use the key runs its node
+	Delete(ctx context.Context, key string) error
 }
 
 type Entry struct {
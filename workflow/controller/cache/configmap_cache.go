@@ -207,3 +207,67 @@ func (c *configMapCache) save(ctx context.Context, key string, nodeID string, va
 	}
 	return nil
 }
+
+func (c *configMapCache) List(ctx context.Context) (map[string]*Entry, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	cm, err := c.kubeClient.CoreV1().ConfigMaps(c.namespace).Get(ctx, c.name, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := c.validateConfigmap(ctx, cm); err != nil {
+		return nil, err
+	}
+	return EntriesOf(cm)
+}
+
+// EntriesOf returns the entries of a memoization cache config map by key
+func EntriesOf(cm *apiv1.ConfigMap) (map[string]*Entry, error) {
+	entries := make(map[string]*Entry, len(cm.Data))
+	for key, rawEntry := range cm.Data {
+		if rawEntry == "" {
+			continue
+		}
+		var entry Entry
+		if err := json.Unmarshal([]byte(rawEntry), &entry); err != nil {
+			return nil, fmt.Errorf("malformed cache entry %s: could not unmarshal JSON; unable to parse: %w", key, err)
+		}
+		entries[key] = &entry
+	}
+	return entries, nil
+}
+
+func (c *configMapCache) Delete(ctx context.Context, key string) error {
+	return retry.OnError(retry.DefaultBackoff, func(err error) bool {
+		return argoerr.IsTransientErr(ctx, err) || apierr.IsConflict(err)
+	}, func() error {
+		return c.delete(ctx, key)
+	})
+}
+
+func (c *configMapCache) delete(ctx context.Context, key string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	cm, err := c.kubeClient.CoreV1().ConfigMaps(c.namespace).Get(ctx, c.name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if err := c.validateConfigmap(ctx, cm); err != nil {
+		return err
+	}
+	if _, ok := cm.Data[key]; !ok {
+		return apierr.NewNotFound(apiv1.Resource("configmaps"), c.name+"/"+key)
+	}
+	delete(cm.Data, key)
+	_, err = c.kubeClient.CoreV1().ConfigMaps(c.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+	c.logInfo(ctx, logging.Fields{"key": key}, "Deleted ConfigMap cache entry")
+	return nil
+}
@@ -0,0 +1,216 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// executeChildWorkflow creates the child workflow of a childWorkflow template, and then reflects its phase in the node.
+// The child workflow is named after the node, so it is only ever created once.
+func (woc *wfOperationCtx) executeChildWorkflow(ctx context.Context, nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, opts *executeTemplateOpts) (*wfv1.NodeStatus, error) {
+	node, err := woc.wf.GetNodeByName(nodeName)
+	if err != nil {
+		node = woc.initializeExecutableNode(ctx, nodeName, wfv1.NodeTypeChildWorkflow, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodePending, opts.nodeFlag, true)
+	}
+	if node.Fulfilled() {
+		return node, nil
+	}
+	shutdown := !woc.GetShutdownStrategy().ShouldExecute(opts.onExitTemplate)
+	workflows := woc.controller.wfclientset.ArgoprojV1alpha1().Workflows(woc.wf.Namespace)
+	child, err := workflows.Get(ctx, node.ID, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		if shutdown {
+			return woc.markNodePhase(ctx, nodeName, wfv1.NodeFailed, fmt.Sprintf("workflow shutdown with strategy: %s", woc.GetShutdownStrategy())), nil
+		}
+		woc.log.WithField("nodeName", nodeName).Info(ctx, "Creating child workflow")
+		child, err = workflows.Create(ctx, woc.newChildWorkflow(node.ID, tmpl), metav1.CreateOptions{})
+		if apierr.IsAlreadyExists(err) {
+			child, err = workflows.Get(ctx, node.ID, metav1.GetOptions{})
+		}
+	}
+	if err != nil {
+		return woc.requeueIfTransientErr(ctx, err, nodeName)
+	}
+	if shutdown && tmpl.ChildWorkflow.GetCascade().Shutdown() && child.Spec.Shutdown != woc.GetShutdownStrategy() && !child.Status.Fulfilled() {
+		patch, err := json.Marshal(map[string]interface{}{"spec": map[string]interface{}{"shutdown": woc.GetShutdownStrategy()}})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := workflows.Patch(ctx, child.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			return woc.requeueIfTransientErr(ctx, err, nodeName)
+		}
+	}
+	switch child.Status.Phase {
+	case wfv1.WorkflowSucceeded:
+		outputs, err := childWorkflowOutputs(tmpl, child)
+		if err != nil {
+			return woc.markNodePhase(ctx, nodeName, wfv1.NodeFailed, err.Error()), nil
+		}
+		node.Outputs = outputs
+		woc.wf.Status.Nodes.Set(ctx, node.ID, *node)
+		return woc.markNodePhase(ctx, nodeName, wfv1.NodeSucceeded, child.Status.Message), nil
+	case wfv1.WorkflowFailed:
+		return woc.markNodePhase(ctx, nodeName, wfv1.NodeFailed, child.Status.Message), nil
+	case wfv1.WorkflowError:
+		return woc.markNodePhase(ctx, nodeName, wfv1.NodeError, child.Status.Message), nil
+	case wfv1.WorkflowRunning:
+		return woc.markNodePhase(ctx, nodeName, wfv1.NodeRunning, child.Status.Message), nil
+	}
+	return node, nil
+}
+
+// newChildWorkflow returns the child workflow of a childWorkflow template
+func (woc *wfOperationCtx) newChildWorkflow(name string, tmpl *wfv1.Template) *wfv1.Workflow {
+	child := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   woc.wf.Namespace,
+			Labels:      map[string]string{},
+			Annotations: tmpl.ChildWorkflow.Metadata.Annotations,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind)),
+			},
+		},
+		Spec: *tmpl.ChildWorkflow.Spec.DeepCopy(),
+	}
+	for k, v := range tmpl.ChildWorkflow.Metadata.Labels {
+		child.Labels[k] = v
+	}
+	child.Labels[common.LabelKeyParentWorkflow] = woc.wf.Name
+	child.Labels[common.LabelKeyCascade] = string(tmpl.ChildWorkflow.GetCascade())
+	if woc.controller.Config.InstanceID != "" {
+		child.Labels[common.LabelKeyControllerInstanceID] = woc.controller.Config.InstanceID
+	}
+	if child.Spec.ServiceAccountName == "" {
+		child.Spec.ServiceAccountName = woc.execWf.Spec.ServiceAccountName
+	}
+	return child
+}
+
+// childWorkflowOutputs returns the outputs of a childWorkflow template, which are the outputs the child workflow
+// exported with the same name
+func childWorkflowOutputs(tmpl *wfv1.Template, child *wfv1.Workflow) (*wfv1.Outputs, error) {
+	if !tmpl.Outputs.HasOutputs() {
+		return nil, nil
+	}
+	outputs := &wfv1.Outputs{}
+	for _, param := range tmpl.Outputs.Parameters {
+		var value *wfv1.AnyString
+		if child.Status.Outputs != nil {
+			for _, p := range child.Status.Outputs.Parameters {
+				if p.Name == param.Name {
+					value = p.Value
+				}
+			}
+		}
+		if value == nil && param.ValueFrom != nil {
+			value = param.ValueFrom.Default
+		}
+		if value == nil {
+			return nil, fmt.Errorf("child workflow %s did not output parameter %s", child.Name, param.Name)
+		}
+		param.Value = value
+		param.ValueFrom = nil
+		outputs.Parameters = append(outputs.Parameters, param)
+	}
+	for _, art := range tmpl.Outputs.Artifacts {
+		childArt := child.Status.Outputs.GetArtifactByName(art.Name)
+		if childArt == nil {
+			if art.Optional {
+				continue
+			}
+			return nil, fmt.Errorf("child workflow %s did not output artifact %s", child.Name, art.Name)
+		}
+		art.ArtifactLocation = childArt.ArtifactLocation
+		outputs.Artifacts = append(outputs.Artifacts, art)
+	}
+	return outputs, nil
+}
+
+// cascadeToChildWorkflows applies the shutdown strategy and the suspension of the workflow to the unfulfilled workflows
+// its templates created, as far as those templates cascade them. Only the children suspended because the workflow
+// was are resumed with it.
+func (woc *wfOperationCtx) cascadeToChildWorkflows(ctx context.Context) {
+	var children []*wfv1.Workflow
+	selector := labels.SelectorFromSet(labels.Set{common.LabelKeyParentWorkflow: woc.wf.Name})
+	err := cache.ListAllByNamespace(woc.controller.wfInformer.GetIndexer(), woc.wf.Namespace, selector, func(obj interface{}) {
+		un, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return
+		}
+		child, err := util.FromUnstructured(un)
+		if err != nil {
+			woc.log.WithError(err).WithField("childWorkflow", un.GetName()).Warn(ctx, "Failed to convert child workflow")
+			return
+		}
+		children = append(children, child)
+	})
+	if err != nil {
+		woc.log.WithError(err).Error(ctx, "Failed to list child workflows")
+		return
+	}
+	shutdown := woc.GetShutdownStrategy()
+	suspend := woc.ShouldSuspend()
+	for _, child := range children {
+		if child.Status.Fulfilled() || !woc.isParentOf(child) {
+			continue
+		}
+		cascade := wfv1.CascadeStrategy(child.Labels[common.LabelKeyCascade])
+		spec := map[string]interface{}{}
+		annotations := map[string]interface{}{}
+		if cascade.Shutdown() && shutdown.Enabled() && child.Spec.Shutdown != shutdown {
+			spec["shutdown"] = shutdown
+		}
+		if cascade.Suspend() {
+			suspended := child.Spec.Suspend != nil && *child.Spec.Suspend
+			_, suspendedByParent := child.Annotations[common.AnnotationKeySuspendedByParent]
+			if suspend && !suspended {
+				spec["suspend"] = true
+				annotations[common.AnnotationKeySuspendedByParent] = "true"
+			} else if !suspend && suspendedByParent {
+				if suspended {
+					spec["suspend"] = nil
+				}
+				annotations[common.AnnotationKeySuspendedByParent] = nil
+			}
+		}
+		if len(spec) == 0 && len(annotations) == 0 {
+			continue
+		}
+		patch, err := json.Marshal(map[string]interface{}{"metadata": map[string]interface{}{"annotations": annotations}, "spec": spec})
+		if err != nil {
+			woc.log.WithError(err).Error(ctx, "Failed to marshal child workflow patch")
+			continue
+		}
+		log := woc.log.WithField("childWorkflow", child.Name)
+		if _, err := woc.controller.wfclientset.ArgoprojV1alpha1().Workflows(child.Namespace).Patch(ctx, child.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			log.WithError(err).Warn(ctx, "Failed to cascade to child workflow")
+			woc.requeue()
+			continue
+		}
+		log.WithField("patch", string(patch)).Info(ctx, "Cascaded to child workflow")
+	}
+}
+
+// isParentOf returns whether the workflow is the parent of the child, rather than a previous workflow of the same name
+func (woc *wfOperationCtx) isParentOf(child *wfv1.Workflow) bool {
+	for _, ref := range child.OwnerReferences {
+		if ref.Kind == workflow.WorkflowKind && ref.Name == woc.wf.Name {
+			return ref.UID == woc.wf.UID
+		}
+	}
+	return true
+}
@@ -0,0 +1,282 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+var childWorkflow = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: child-workflow
+spec:
+  entrypoint: main
+  serviceAccountName: argo
+  arguments:
+    parameters:
+    - name: message
+      value: hello
+  templates:
+  - name: main
+    steps:
+    - - name: child
+        template: child
+        arguments:
+          parameters:
+          - name: message
+            value: '{{workflow.parameters.message}}'
+  - name: child
+    inputs:
+      parameters:
+      - name: message
+    outputs:
+      parameters:
+      - name: result
+      - name: missing
+        valueFrom:
+          default: none
+    childWorkflow:
+      metadata:
+        labels:
+          team: data
+      spec:
+        entrypoint: say
+        arguments:
+          parameters:
+          - name: message
+            value: '{{inputs.parameters.message}} world'
+        templates:
+        - name: say
+          container:
+            image: argoproj/argosay:v2
+            args: ['{{workflow.parameters.message}}']
+`
+
+func TestChildWorkflow(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(childWorkflow)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes.FindByDisplayName("child")
+	require.NotNil(t, node)
+	assert.Equal(t, wfv1.NodeTypeChildWorkflow, node.Type)
+
+	workflows := controller.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace)
+	child, err := workflows.Get(ctx, node.ID, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "child-workflow", child.Labels[common.LabelKeyParentWorkflow])
+	assert.Equal(t, "All", child.Labels[common.LabelKeyCascade])
+	assert.Equal(t, "data", child.Labels["team"])
+	assert.Equal(t, "argo", child.Spec.ServiceAccountName)
+	assert.Equal(t, "child-workflow", child.OwnerReferences[0].Name)
+	assert.Equal(t, "hello world", child.Spec.Arguments.Parameters[0].Value.String())
+	// the child substitutes its own spec
+	assert.Equal(t, []string{"{{workflow.parameters.message}}"}, child.Spec.Templates[0].Container.Args)
+
+	t.Run("Running", func(t *testing.T) {
+		child.Status.Phase = wfv1.WorkflowRunning
+		child, err = workflows.Update(ctx, child, metav1.UpdateOptions{})
+		require.NoError(t, err)
+		woc := newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		node := woc.wf.Status.Nodes.FindByDisplayName("child")
+		require.NotNil(t, node)
+		assert.Equal(t, wfv1.NodeRunning, node.Phase)
+	})
+
+	t.Run("Succeeded", func(t *testing.T) {
+		child.Status.Phase = wfv1.WorkflowSucceeded
+		child.Status.Outputs = &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "result", Value: wfv1.AnyStringPtr("42")}}}
+		_, err = workflows.Update(ctx, child, metav1.UpdateOptions{})
+		require.NoError(t, err)
+		woc := newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		node := woc.wf.Status.Nodes.FindByDisplayName("child")
+		require.NotNil(t, node)
+		assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+		require.NotNil(t, node.Outputs)
+		require.Len(t, node.Outputs.Parameters, 2)
+		assert.Equal(t, "42", node.Outputs.Parameters[0].Value.String())
+		assert.Equal(t, "none", node.Outputs.Parameters[1].Value.String())
+		assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	})
+}
+
+func TestChildWorkflowFailed(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(childWorkflow)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes.FindByDisplayName("child")
+	require.NotNil(t, node)
+
+	workflows := controller.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace)
+	child, err := workflows.Get(ctx, node.ID, metav1.GetOptions{})
+	require.NoError(t, err)
+	child.Status.Phase = wfv1.WorkflowFailed
+	child.Status.Message = "child failed"
+	_, err = workflows.Update(ctx, child, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	node = woc.wf.Status.Nodes.FindByDisplayName("child")
+	require.NotNil(t, node)
+	assert.Equal(t, wfv1.NodeFailed, node.Phase)
+	assert.Equal(t, "child failed", node.Message)
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+}
+
+func TestCascadeToChildWorkflows(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	parent := wfv1.MustUnmarshalWorkflow(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: parent
+  namespace: default
+  uid: parent-uid
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    suspend: {}
+`)
+	cancel, controller := newController(ctx, parent)
+	defer cancel()
+	workflows := controller.wfclientset.ArgoprojV1alpha1().Workflows(parent.Namespace)
+
+	// set updates the child in the API and in the informer
+	set := func(t *testing.T, child *wfv1.Workflow) {
+		t.Helper()
+		un, err := util.ToUnstructured(child)
+		require.NoError(t, err)
+		require.NoError(t, controller.wfInformer.GetIndexer().Update(un))
+	}
+	get := func(t *testing.T, name string) *wfv1.Workflow {
+		t.Helper()
+		child, err := workflows.Get(ctx, name, metav1.GetOptions{})
+		require.NoError(t, err)
+		set(t, child)
+		return child
+	}
+	for _, child := range []*wfv1.Workflow{
+		{ObjectMeta: metav1.ObjectMeta{Name: "all", Labels: map[string]string{common.LabelKeyCascade: "All"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "shutdown", Labels: map[string]string{common.LabelKeyCascade: "Shutdown"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "none", Labels: map[string]string{common.LabelKeyCascade: "None"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "suspended", Labels: map[string]string{common.LabelKeyCascade: "All"}}, Spec: wfv1.WorkflowSpec{Suspend: ptr.To(true)}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "other-parent", Labels: map[string]string{common.LabelKeyCascade: "All"}, OwnerReferences: []metav1.OwnerReference{{Kind: workflow.WorkflowKind, Name: "parent", UID: "other-uid"}}}},
+	} {
+		child.Namespace = parent.Namespace
+		child.Labels[common.LabelKeyParentWorkflow] = parent.Name
+		child, err := workflows.Create(ctx, child, metav1.CreateOptions{})
+		require.NoError(t, err)
+		set(t, child)
+	}
+	suspended := func(child *wfv1.Workflow) bool { return child.Spec.Suspend != nil && *child.Spec.Suspend }
+
+	t.Run("Suspend", func(t *testing.T) {
+		woc := newWorkflowOperationCtx(ctx, parent.DeepCopy(), controller)
+		woc.execWf.Spec.Suspend = ptr.To(true)
+		woc.cascadeToChildWorkflows(ctx)
+		child := get(t, "all")
+		assert.True(t, suspended(child))
+		assert.Equal(t, "true", child.Annotations[common.AnnotationKeySuspendedByParent])
+		assert.False(t, suspended(get(t, "shutdown")))
+		assert.False(t, suspended(get(t, "none")))
+		assert.Empty(t, get(t, "suspended").Annotations)
+		assert.False(t, suspended(get(t, "other-parent")))
+	})
+	t.Run("Resume", func(t *testing.T) {
+		woc := newWorkflowOperationCtx(ctx, parent.DeepCopy(), controller)
+		woc.cascadeToChildWorkflows(ctx)
+		child := get(t, "all")
+		assert.False(t, suspended(child))
+		assert.NotContains(t, child.Annotations, common.AnnotationKeySuspendedByParent)
+		// it was not suspended by its parent
+		assert.True(t, suspended(get(t, "suspended")))
+	})
+	t.Run("Terminate", func(t *testing.T) {
+		woc := newWorkflowOperationCtx(ctx, parent.DeepCopy(), controller)
+		woc.execWf.Spec.Shutdown = wfv1.ShutdownStrategyTerminate
+		woc.cascadeToChildWorkflows(ctx)
+		assert.Equal(t, wfv1.ShutdownStrategyTerminate, get(t, "all").Spec.Shutdown)
+		assert.Equal(t, wfv1.ShutdownStrategyTerminate, get(t, "shutdown").Spec.Shutdown)
+		assert.Empty(t, get(t, "none").Spec.Shutdown)
+		assert.Empty(t, get(t, "other-parent").Spec.Shutdown)
+	})
+}
+
+var resourceWorkflowCascade = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: resource-cascade
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    resource:
+      action: create
+      cascade: Shutdown
+      manifest: |
+        apiVersion: argoproj.io/v1alpha1
+        kind: Workflow
+        metadata:
+          generateName: child-
+        spec:
+          workflowTemplateRef:
+            name: build
+`
+
+func TestResourceWorkflowCascade(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(resourceWorkflowCascade)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	tmpl, err := getPodTemplate(&pods.Items[0])
+	require.NoError(t, err)
+	child := wfv1.Workflow{}
+	require.NoError(t, yaml.Unmarshal([]byte(tmpl.Resource.Manifest), &child))
+	assert.Equal(t, "resource-cascade", child.Labels[common.LabelKeyParentWorkflow])
+	assert.Equal(t, "Shutdown", child.Labels[common.LabelKeyCascade])
+
+	t.Run("NotWorkflow", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(resourceWorkflowCascade)
+		wf.Spec.Templates[0].Resource.Manifest = "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm\n"
+		cancel, controller := newController(ctx, wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		pods, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		require.Len(t, pods.Items, 1)
+		tmpl, err := getPodTemplate(&pods.Items[0])
+		require.NoError(t, err)
+		cm := apiv1.ConfigMap{}
+		require.NoError(t, yaml.Unmarshal([]byte(tmpl.Resource.Manifest), &cm))
+		assert.Empty(t, cm.Labels)
+	})
+}
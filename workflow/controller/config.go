@@ -2,20 +2,31 @@ package controller
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"time"
 
-	"golang.org/x/time/rate"
+	"github.com/upper/db/v4"
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-workflows/v3"
+	"github.com/argoproj/argo-workflows/v3/config"
 	persist "github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	"github.com/argoproj/argo-workflows/v3/util"
+	"github.com/argoproj/argo-workflows/v3/util/expr/env"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/util/sqldb"
+	"github.com/argoproj/argo-workflows/v3/workflow/admission"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
+	"github.com/argoproj/argo-workflows/v3/workflow/lifecycle"
+	"github.com/argoproj/argo-workflows/v3/workflow/notifications"
+	"github.com/argoproj/argo-workflows/v3/workflow/podsecurity"
+	"github.com/argoproj/argo-workflows/v3/workflow/templateimport"
+	"github.com/argoproj/argo-workflows/v3/workflow/templatesignature"
 )
 
 func (wfc *WorkflowController) updateConfig(ctx context.Context) error {
@@ -25,7 +36,7 @@ func (wfc *WorkflowController) updateConfig(ctx context.Context) error {
 		return err
 	}
 	logger.WithField("config", string(bytes)).Info(ctx, "Configuration")
-	wfc.artifactRepositories = artifactrepositories.New(wfc.kubeclientset, wfc.namespace, &wfc.Config.ArtifactRepository)
+	wfc.artifactRepositories = artifactrepositories.New(wfc.kubeclientset, wfc.wfclientset, wfc.namespace, &wfc.Config.ArtifactRepository)
 	wfc.offloadNodeStatusRepo = persist.ExplosiveOffloadNodeStatusRepo
 	wfc.wfArchive = persist.NullWorkflowArchive
 	wfc.archiveLabelSelector = labels.Everything()
@@ -47,13 +58,15 @@ func (wfc *WorkflowController) updateConfig(ctx context.Context) error {
 			}
 			logger.Info(ctx, "Persistence Session created successfully")
 			wfc.session = session
+			wfc.dbStatsSession.Store(session)
 		}
 		sqldb.ConfigureDBSession(wfc.session, persistence.ConnectionPool)
 		if persistence.NodeStatusOffload {
-			wfc.offloadNodeStatusRepo, err = persist.NewOffloadNodeStatusRepo(ctx, logger, wfc.session, persistence.GetClusterName(), tableName)
+			offloadNodeStatusRepo, err := persist.NewOffloadNodeStatusRepo(ctx, logger, wfc.session, persistence.GetClusterName(), tableName)
 			if err != nil {
 				return err
 			}
+			wfc.offloadNodeStatusRepo = persist.NewInstrumentedOffloadNodeStatusRepo(offloadNodeStatusRepo, wfc.observeDBQuery)
 			logger.Info(ctx, "Node status offloading is enabled")
 		} else {
 			logger.Info(ctx, "Node status offloading is disabled")
@@ -65,7 +78,8 @@ func (wfc *WorkflowController) updateConfig(ctx context.Context) error {
 			if err != nil {
 				return err
 			}
-			wfc.wfArchive = persist.NewWorkflowArchive(wfc.session, persistence.GetClusterName(), wfc.managedNamespace, instanceIDService)
+			wfArchive := persist.NewWorkflowArchive(wfc.session, persistence.GetClusterName(), wfc.managedNamespace, instanceIDService)
+			wfc.wfArchive = persist.NewInstrumentedWorkflowArchive(wfArchive, wfc.observeDBQuery)
 			logger.Info(ctx, "Workflow archiving is enabled")
 		} else {
 			logger.Info(ctx, "Workflow archiving is disabled")
@@ -74,9 +88,77 @@ func (wfc *WorkflowController) updateConfig(ctx context.Context) error {
 		logger.Info(ctx, "Persistence configuration disabled")
 	}
 
+	if wfc.lifecyclePublisher != nil {
+		wfc.lifecyclePublisher.Close()
+	}
+	wfc.lifecyclePublisher, err = lifecycle.NewPublisher(ctx, wfc.kubeclientset, wfc.namespace, wfc.Config.LifecycleEvents)
+	if err != nil {
+		return err
+	}
+	wfc.notificationSender = notifications.NewSender(wfc.kubeclientset, wfc.namespace, wfc.Config.Notifications)
+
+	wfc.admissionPolicies, err = admission.Compile(wfc.Config.AdmissionPolicies)
+	if err != nil {
+		return err
+	}
+
+	if err := env.EnableFunctions(wfc.Config.ExprFunctions); err != nil {
+		return err
+	}
+
+	wfc.templateImporter, err = templateimport.New(wfc.kubeclientset, wfc.Config.TemplateImports)
+	if err != nil {
+		return err
+	}
+
+	wfc.templateVerifier, err = templatesignature.New(wfc.Config.TemplateSignatures)
+	if err != nil {
+		return err
+	}
+
+	if s := wfc.Config.PodSecurityStandard; s != "" && s != podsecurity.Restricted {
+		return fmt.Errorf("podSecurityStandard %q is not supported, it must be %q", s, podsecurity.Restricted)
+	}
+
+	if err := wfc.Config.Informers.Validate(); err != nil {
+		return err
+	}
+
+	for _, d := range wfc.Config.ExecutorDefaults {
+		if err := d.Validate(); err != nil {
+			return fmt.Errorf("invalid selector in executor defaults: %w", err)
+		}
+	}
+
+	switch wfc.Config.StoredTemplates {
+	case "", config.StoredTemplatesResolved:
+	case config.StoredTemplatesPinned:
+		if wfc.Config.GetWorkflowTemplateRevisionHistoryLimit() <= 0 {
+			return fmt.Errorf("storedTemplates %q requires workflowTemplateRevisionHistoryLimit to be greater than zero", config.StoredTemplatesPinned)
+		}
+	default:
+		return fmt.Errorf("storedTemplates %q is not supported, it must be %q or %q", wfc.Config.StoredTemplates, config.StoredTemplatesResolved, config.StoredTemplatesPinned)
+	}
+
+	if err := wfc.Config.WorkflowQueueFairness.Validate(); err != nil {
+		return err
+	}
+	if wfc.wfQueueFairness != nil && wfc.Config.WorkflowQueueFairness != nil {
+		wfc.wfQueueFairness.setFairness(*wfc.Config.WorkflowQueueFairness)
+	}
+
+	wfc.sensitiveParametersKey = nil
+	if c := wfc.Config.SensitiveParameters; c != nil {
+		wfc.sensitiveParametersKey, err = util.GetSecrets(ctx, wfc.kubeclientset, wfc.namespace, c.EncryptionKeySecret.Name, c.EncryptionKeySecret.Key)
+		if err != nil {
+			return fmt.Errorf("failed to get the encryption key of sensitive parameters: %w", err)
+		}
+	}
+
 	wfc.hydrator = hydrator.New(wfc.offloadNodeStatusRepo)
 	wfc.updateEstimatorFactory(ctx)
 	wfc.rateLimiter = wfc.newRateLimiter()
+	wfc.workflowUpdateRateLimiter = wfc.newWorkflowUpdateRateLimiter()
 	wfc.maxStackDepth = wfc.getMaxStackDepth()
 
 	logger.WithField("executorImage", wfc.executorImage()).
@@ -102,9 +184,36 @@ func (wfc *WorkflowController) initDB(ctx context.Context) error {
 	return persist.Migrate(ctx, wfc.session, persistence.GetClusterName(), tableName)
 }
 
-func (wfc *WorkflowController) newRateLimiter() *rate.Limiter {
-	rateLimiter := wfc.Config.GetResourceRateLimit()
-	return rate.NewLimiter(rate.Limit(rateLimiter.Limit), rateLimiter.Burst)
+// observeDBQuery records the duration of a persistence operation, metrics may not exist yet during startup
+func (wfc *WorkflowController) observeDBQuery(ctx context.Context, operation string, duration time.Duration) {
+	if wfc.metrics != nil {
+		wfc.metrics.DBQueryCompleted(ctx, operation, duration)
+	}
+}
+
+// getDBStats returns the persistence connection pool statistics, or nil if persistence is not configured
+func (wfc *WorkflowController) getDBStats() *sql.DBStats {
+	session, _ := wfc.dbStatsSession.Load().(db.Session)
+	return sqldb.Stats(session)
+}
+
+func (wfc *WorkflowController) newRateLimiter() *adaptiveRateLimiter {
+	return newAdaptiveRateLimiter(wfc.Config.GetResourceRateLimit())
+}
+
+func (wfc *WorkflowController) newWorkflowUpdateRateLimiter() *adaptiveRateLimiter {
+	return newAdaptiveRateLimiter(wfc.Config.GetWorkflowUpdateRateLimit())
+}
+
+// getRateLimits returns the current limits of the rate limiters that limit the rate, by name
+func (wfc *WorkflowController) getRateLimits(_ context.Context) map[string]float64 {
+	limits := map[string]float64{}
+	for name, l := range map[string]*adaptiveRateLimiter{"pod_creation": wfc.rateLimiter, "workflow_update": wfc.workflowUpdateRateLimiter} {
+		if l != nil && l.limited() {
+			limits[name] = float64(l.Limit())
+		}
+	}
+	return limits
 }
 
 // executorImage returns the image to use for the workflow executor
@@ -2,18 +2,23 @@ package controller
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"os"
 	"slices"
 	"strconv"
+	"strings"
 	gosync "sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/upper/db/v4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
 
 	syncpkg "github.com/argoproj/pkg/sync"
-	"golang.org/x/time/rate"
 	apiv1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -44,6 +49,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/pkg/client/informers/externalversions"
 	wfextvv1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/client/informers/externalversions/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/pkg/plugins/spec"
+	argoutil "github.com/argoproj/argo-workflows/v3/util"
 	wfctx "github.com/argoproj/argo-workflows/v3/util/context"
 	"github.com/argoproj/argo-workflows/v3/util/deprecation"
 	"github.com/argoproj/argo-workflows/v3/util/env"
@@ -51,12 +57,15 @@ import (
 	rbacutil "github.com/argoproj/argo-workflows/v3/util/rbac"
 	"github.com/argoproj/argo-workflows/v3/util/retry"
 	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+	tlsutils "github.com/argoproj/argo-workflows/v3/util/tls"
 	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
+	"github.com/argoproj/argo-workflows/v3/workflow/admission"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/entrypoint"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/estimation"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/imagedigest"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/indexes"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/informer"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/pod"
@@ -64,8 +73,12 @@ import (
 	"github.com/argoproj/argo-workflows/v3/workflow/events"
 	"github.com/argoproj/argo-workflows/v3/workflow/gccontroller"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
+	"github.com/argoproj/argo-workflows/v3/workflow/lifecycle"
 	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
+	"github.com/argoproj/argo-workflows/v3/workflow/notifications"
 	"github.com/argoproj/argo-workflows/v3/workflow/sync"
+	"github.com/argoproj/argo-workflows/v3/workflow/templateimport"
+	"github.com/argoproj/argo-workflows/v3/workflow/templatesignature"
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
 	plugin "github.com/argoproj/argo-workflows/v3/workflow/util/plugins"
 )
@@ -95,6 +108,8 @@ type WorkflowController struct {
 	artifactRepositories artifactrepositories.Interface
 	// get images
 	entrypoint entrypoint.Interface
+	// resolve the digests of images
+	imageDigests imagedigest.Interface
 
 	// cliExecutorImage is the executor image as specified from the command line
 	cliExecutorImage string
@@ -107,35 +122,61 @@ type WorkflowController struct {
 	cliExecutorLogFormat string
 
 	// restConfig is used by controller to send a SIGUSR1 to the wait sidecar using remotecommand.NewSPDYExecutor().
-	restConfig       *rest.Config
-	kubeclientset    kubernetes.Interface
-	rateLimiter      *rate.Limiter
-	dynamicInterface dynamic.Interface
-	wfclientset      wfclientset.Interface
+	restConfig                *rest.Config
+	kubeclientset             kubernetes.Interface
+	rateLimiter               *adaptiveRateLimiter
+	workflowUpdateRateLimiter *adaptiveRateLimiter
+	// workflowUpdateMethod is how workflows are persisted, either update or patch
+	workflowUpdateMethod string
+	dynamicInterface     dynamic.Interface
+	wfclientset          wfclientset.Interface
 
 	// maxStackDepth is a configurable limit to the depth of the "stack", which is increased with every nested call to
 	// woc.executeTemplate and decreased when such calls return. This is used to prevent infinite recursion
 	maxStackDepth int
 
 	// datastructures to support the processing of workflows and workflow pods
-	wfInformer            cache.SharedIndexInformer
-	nsInformer            cache.SharedIndexInformer
-	wftmplInformer        wfextvv1alpha1.WorkflowTemplateInformer
-	cwftmplInformer       wfextvv1alpha1.ClusterWorkflowTemplateInformer
-	PodController         *pod.Controller // Currently public for woc to access, but would rather an accessor
-	configMapInformer     cache.SharedIndexInformer
-	wfQueue               workqueue.TypedRateLimitingInterface[string]
-	wfArchiveQueue        workqueue.TypedRateLimitingInterface[string]
-	throttler             sync.Throttler
-	workflowKeyLock       syncpkg.KeyLock // used to lock workflows for exclusive modification or access
-	session               db.Session
+	wfInformer        cache.SharedIndexInformer
+	nsInformer        cache.SharedIndexInformer
+	wftmplInformer    wfextvv1alpha1.WorkflowTemplateInformer
+	cwftmplInformer   wfextvv1alpha1.ClusterWorkflowTemplateInformer
+	PodController     *pod.Controller // Currently public for woc to access, but would rather an accessor
+	configMapInformer cache.SharedIndexInformer
+	wfQueue           workqueue.TypedRateLimitingInterface[string]
+	// wfQueueFairness stores the keys of wfQueue if workflowQueueFairness is configured
+	wfQueueFairness *namespaceFairQueue
+	wfArchiveQueue  workqueue.TypedRateLimitingInterface[string]
+	// workflowWorkers and archiveWorkers process wfQueue and wfArchiveQueue, and can be resized at runtime
+	workflowWorkers *workerPool
+	archiveWorkers  *workerPool
+	// notificationQueue holds the notifications of saved workflows, which notificationWorkers send
+	notificationQueue   workqueue.TypedInterface[notification]
+	notificationWorkers *workerPool
+	// running is whether Run has started the informers and the workers
+	running atomic.Bool
+	// draining is whether the controller is draining, and no longer reconciles workflows
+	draining      atomic.Bool
+	drainRequests chan struct{}
+	// lastReconcile is the time, in Unix nanoseconds, the leader last finished reconciling a workflow, or started leading
+	lastReconcile   atomic.Int64
+	informerLag     informerLag
+	throttler       sync.Throttler
+	workflowKeyLock syncpkg.KeyLock // used to lock workflows for exclusive modification or access
+	session         db.Session
+	// dbStatsSession is the session observed by the metrics callbacks, which run outside of the config update goroutine
+	dbStatsSession        atomic.Value
 	offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo
 	hydrator              hydrator.Interface
+	templateImporter      templateimport.Interface
+	templateVerifier      *templatesignature.Verifier
 	wfArchive             sqldb.WorkflowArchive
 	estimatorFactory      estimation.EstimatorFactory
 	syncManager           *sync.Manager
 	metrics               *metrics.Metrics
 	eventRecorderManager  events.EventRecorderManager
+	lifecyclePublisher    lifecycle.Publisher
+	notificationSender    notifications.Sender
+	admissionPolicies     admission.Policies
 	archiveLabelSelector  labels.Selector
 	cacheFactory          controllercache.Factory
 	wfTaskSetInformer     wfextvv1alpha1.WorkflowTaskSetInformer
@@ -153,6 +194,8 @@ type WorkflowController struct {
 	recentCompletions recentCompletions
 	// lastUnreconciledWorkflows is a map of workflows that have been recently unreconciled
 	lastUnreconciledWorkflows map[string]*wfv1.Workflow
+	// sensitiveParametersKey is the key to encrypt the values of sensitive parameters with, nil if not configured
+	sensitiveParametersKey []byte
 }
 
 const (
@@ -162,6 +205,7 @@ const (
 	clusterWorkflowTemplateResyncPeriod = 20 * time.Minute
 	workflowExistenceCheckPeriod        = 1 * time.Minute
 	workflowTaskSetResyncPeriod         = 20 * time.Minute
+	metricsReloadPeriod                 = 1 * time.Minute
 )
 
 var (
@@ -208,21 +252,33 @@ func NewWorkflowController(ctx context.Context, restConfig *rest.Config, kubecli
 		progressFileTickDuration:   env.LookupEnvDurationOr(ctx, common.EnvVarProgressFileTickDuration, 3*time.Second),
 	}
 
+	wfc.workflowUpdateMethod, err = workflowUpdateMethod()
+	if err != nil {
+		return nil, err
+	}
+
 	if executorPlugins {
 		wfc.executorPlugins = map[string]map[string]*spec.Plugin{}
 	}
 
 	wfc.UpdateConfig(ctx)
 	wfc.maxStackDepth = wfc.getMaxStackDepth()
+	metricsConfig, err := wfc.getMetricsServerConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
 	wfc.metrics, err = metrics.New(ctx,
 		`workflows-controller`,
 		`argo_workflows`,
-		wfc.getMetricsServerConfig(),
+		metricsConfig,
 		metrics.Callbacks{
 			PodPhase:          wfc.getPodPhaseMetrics,
 			WorkflowPhase:     wfc.getWorkflowPhaseMetrics,
 			WorkflowCondition: wfc.getWorkflowConditionMetrics,
 			IsLeader:          wfc.IsLeader,
+			DBStats:           wfc.getDBStats,
+			RateLimits:        wfc.getRateLimits,
+			LastReconcile:     wfc.getLastReconcile,
 		})
 	if err != nil {
 		return nil, err
@@ -230,11 +286,22 @@ func NewWorkflowController(ctx context.Context, restConfig *rest.Config, kubecli
 
 	deprecation.Initialize(wfc.metrics.DeprecatedFeature)
 	wfc.entrypoint = entrypoint.New(kubeclientset, wfc.Config.Images)
+	wfc.imageDigests = imagedigest.New(kubeclientset)
 
 	workqueue.SetProvider(wfc.metrics) // must execute SetProvider before we create the queues
-	wfc.wfQueue = wfc.metrics.RateLimiterWithBusyWorkers(ctx, &fixedItemIntervalRateLimiter{}, "workflow_queue")
+	if fairness := wfc.Config.WorkflowQueueFairness; fairness != nil {
+		wfc.wfQueueFairness = newNamespaceFairQueue(*fairness)
+		wfc.wfQueue = wfc.metrics.RateLimiterWithBusyWorkersAndQueue(ctx, &fixedItemIntervalRateLimiter{}, wfc.wfQueueFairness, "workflow_queue")
+	} else {
+		wfc.wfQueue = wfc.metrics.RateLimiterWithBusyWorkers(ctx, &fixedItemIntervalRateLimiter{}, "workflow_queue")
+	}
 	wfc.throttler = wfc.newThrottler()
 	wfc.wfArchiveQueue = wfc.metrics.RateLimiterWithBusyWorkers(ctx, workqueue.DefaultTypedControllerRateLimiter[string](), "workflow_archive_queue")
+	wfc.workflowWorkers = newWorkerPool(wfc.processNextItem)
+	wfc.archiveWorkers = newWorkerPool(wfc.processNextArchiveItem)
+	wfc.notificationQueue = workqueue.NewTyped[notification]()
+	wfc.notificationWorkers = newWorkerPool(wfc.processNextNotification)
+	wfc.drainRequests = make(chan struct{})
 
 	return &wfc, nil
 }
@@ -292,6 +359,10 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 	defer cancel()
 
 	defer wfc.wfQueue.ShutDown()
+	defer wfc.notificationQueue.ShutDown()
+
+	wfc.metrics.LeaderTransition(ctx, true)
+	defer wfc.metrics.LeaderTransition(ctx, false)
 
 	logger.WithFields(argo.GetVersion().Fields()).WithFields(logging.Fields{
 		"instanceID":         wfc.Config.InstanceID,
@@ -320,6 +391,9 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 	if err != nil {
 		logger.WithError(err).WithFatal().Error(ctx, "Failed to add workflow informer handlers")
 	}
+	if err := wfc.addTemplateRevisionHandler(ctx, wfc.wftmplInformer.Informer(), false); err != nil {
+		logger.WithError(err).WithFatal().Error(ctx, "Failed to add workflow template revision handler")
+	}
 	wfc.PodController = pod.NewController(ctx, &wfc.Config, wfc.restConfig, wfc.GetManagedNamespace(), wfc.kubeclientset, wfc.wfInformer, wfc.metrics, wfc.enqueueWfFromPodLabel)
 
 	wfc.updateEstimatorFactory(ctx)
@@ -379,16 +453,19 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 	go wfc.runCronController(ctx, cronWorkflowWorkers)
 
 	go wait.UntilWithContext(ctx, wfc.syncManager.CheckWorkflowExistence, workflowExistenceCheckPeriod)
+	go wait.JitterUntilWithContext(ctx, wfc.probeArtifactRepositories, artifactRepositoryProbePeriod, 0.1, true)
 
 	workerCtx, _ := logger.WithField("component", "workflow_worker").InContext(ctx)
-	for i := 0; i < wfWorkers; i++ {
-		go wait.UntilWithContext(workerCtx, wfc.runWorker, time.Second)
-	}
+	wfc.workflowWorkers.start(workerCtx, wfWorkers)
 
 	archiveCtx, _ := logger.WithField("component", "archive_worker").InContext(ctx)
-	for i := 0; i < wfArchiveWorkers; i++ {
-		go wait.UntilWithContext(archiveCtx, wfc.runArchiveWorker, time.Second)
-	}
+	wfc.archiveWorkers.start(archiveCtx, wfArchiveWorkers)
+
+	notificationCtx, _ := logger.WithField("component", "notification_worker").InContext(ctx)
+	wfc.notificationWorkers.start(notificationCtx, notificationWorkers)
+	wfc.lastReconcile.Store(time.Now().UnixNano())
+	wfc.running.Store(true)
+	defer wfc.running.Store(false)
 	if cacheGCPeriod != 0 {
 		go wait.JitterUntilWithContext(ctx, wfc.syncAllCacheForGC, cacheGCPeriod, 0.0, true)
 	}
@@ -442,7 +519,9 @@ func (wfc *WorkflowController) createSynchronizationManager(ctx context.Context)
 
 // list all running workflows to initialize throttler and syncManager
 func (wfc *WorkflowController) initManagers(ctx context.Context) error {
-	labelSelector := labels.NewSelector().Add(util.InstanceIDRequirement(wfc.Config.InstanceID))
+	labelSelector := labels.NewSelector().
+		Add(util.InstanceIDRequirement(wfc.Config.InstanceID)).
+		Add(wfc.Config.Informers.LabelRequirements()...)
 	req, _ := labels.NewRequirement(common.LabelKeyPhase, selection.Equals, []string{string(wfv1.WorkflowRunning)})
 	if req != nil {
 		labelSelector = labelSelector.Add(*req)
@@ -534,6 +613,9 @@ func (wfc *WorkflowController) createClusterWorkflowTemplateInformer(ctx context
 	logger := logging.RequireLoggerFromContext(ctx)
 	if rbacutil.HasAccessToClusterWorkflowTemplates(ctx, wfc.kubeclientset, wfc.namespace) {
 		wfc.cwftmplInformer = informer.NewTolerantClusterWorkflowTemplateInformer(wfc.dynamicInterface, clusterWorkflowTemplateResyncPeriod)
+		if err := wfc.addTemplateRevisionHandler(ctx, wfc.cwftmplInformer.Informer(), true); err != nil {
+			logger.WithError(err).WithFatal().Error(ctx, "Failed to add cluster workflow template revision handler")
+		}
 		go wfc.cwftmplInformer.Informer().Run(ctx.Done())
 
 		// since the above call is asynchronous, make sure we populate our cache before we try to use it later
@@ -686,20 +768,6 @@ func (wfc *WorkflowController) archivedWorkflowGarbageCollector(ctx context.Cont
 	}
 }
 
-func (wfc *WorkflowController) runWorker(ctx context.Context) {
-	defer runtimeutil.HandleCrashWithContext(ctx, runtimeutil.PanicHandlers...)
-
-	for wfc.processNextItem(ctx) {
-	}
-}
-
-func (wfc *WorkflowController) runArchiveWorker(ctx context.Context) {
-	defer runtimeutil.HandleCrashWithContext(ctx, runtimeutil.PanicHandlers...)
-
-	for wfc.processNextArchiveItem(ctx) {
-	}
-}
-
 // processNextItem is the worker logic for handling workflow updates
 func (wfc *WorkflowController) processNextItem(ctx context.Context) bool {
 	key, quit := wfc.wfQueue.Get()
@@ -707,6 +775,14 @@ func (wfc *WorkflowController) processNextItem(ctx context.Context) bool {
 		return false
 	}
 	defer wfc.wfQueue.Done(key)
+	if wfc.draining.Load() {
+		// the next leader reconciles the workflow
+		return false
+	}
+
+	namespace, _, _ := cache.SplitMetaNamespaceKey(key)
+	wfc.metrics.WorkflowQueueInflight(ctx, namespace, 1)
+	defer wfc.metrics.WorkflowQueueInflight(ctx, namespace, -1)
 
 	wfc.workflowKeyLock.Lock(key)
 	defer wfc.workflowKeyLock.Unlock(key)
@@ -776,9 +852,16 @@ func (wfc *WorkflowController) processNextItem(ctx context.Context) bool {
 		return true
 	}
 	ctx = wfctx.InjectObjectMeta(ctx, &woc.wf.ObjectMeta)
+	// the durations recorded during the reconciliation have its trace as their exemplar
+	ctx, span := telemetry.StartSpan(ctx, "reconcile workflow",
+		attribute.String("workflow.namespace", woc.wf.Namespace),
+		attribute.String("workflow.name", woc.wf.Name),
+	)
 	startTime := time.Now()
 	woc.operate(ctx)
 	wfc.metrics.OperationCompleted(ctx, time.Since(startTime).Seconds())
+	span.End()
+	wfc.lastReconcile.Store(time.Now().UnixNano())
 
 	// TODO: operate should return error if it was unable to operate properly
 	// so we can requeue the work for a later time
@@ -844,7 +927,8 @@ func (wfc *WorkflowController) enqueueWfFromPodLabel(pod *apiv1.Pod) error {
 
 func (wfc *WorkflowController) tweakListRequestListOptions(options *metav1.ListOptions) {
 	labelSelector := labels.NewSelector().
-		Add(util.InstanceIDRequirement(wfc.Config.InstanceID))
+		Add(util.InstanceIDRequirement(wfc.Config.InstanceID)).
+		Add(wfc.Config.Informers.LabelRequirements()...)
 	options.LabelSelector = labelSelector.String()
 	// `ResourceVersion=0` does not honor the `limit` in API calls, which results in making significant List calls
 	// without `limit`. For details, see https://github.com/argoproj/argo-workflows/pull/11343
@@ -853,7 +937,8 @@ func (wfc *WorkflowController) tweakListRequestListOptions(options *metav1.ListO
 
 func (wfc *WorkflowController) tweakWatchRequestListOptions(options *metav1.ListOptions) {
 	labelSelector := labels.NewSelector().
-		Add(util.InstanceIDRequirement(wfc.Config.InstanceID))
+		Add(util.InstanceIDRequirement(wfc.Config.InstanceID)).
+		Add(wfc.Config.Informers.LabelRequirements()...)
 	options.LabelSelector = labelSelector.String()
 }
 
@@ -962,11 +1047,16 @@ func (wfc *WorkflowController) addWorkflowInformerHandlers(ctx context.Context)
 				// is to be updated in the informer
 				UpdateFunc: func(old, new interface{}) {
 					oldWf, newWf := old.(*unstructured.Unstructured), new.(*unstructured.Unstructured)
+					key, err := cache.MetaNamespaceKeyFunc(new)
+					if err == nil {
+						if lag, ok := wfc.informerLag.received(key, newWf.GetResourceVersion(), time.Now()); ok {
+							wfc.metrics.InformerLag(ctx, lag.Seconds())
+						}
+					}
 					// this check is very important to prevent doing many reconciliations we do not need to do
 					if oldWf.GetResourceVersion() == newWf.GetResourceVersion() {
 						return
 					}
-					key, err := cache.MetaNamespaceKeyFunc(new)
 					if err == nil {
 						wfc.wfQueue.AddRateLimited(key)
 						priority, creation := getWfPriority(new)
@@ -995,6 +1085,7 @@ func (wfc *WorkflowController) addWorkflowInformerHandlers(ctx context.Context)
 
 					key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
 					if err == nil {
+						wfc.informerLag.deleted(key)
 						wfc.releaseAllWorkflowLocks(ctx, obj)
 						wfc.recordCompletedWorkflow(key)
 						// no need to add to the queue - this workflow is done
@@ -1031,6 +1122,22 @@ func (wfc *WorkflowController) addWorkflowInformerHandlers(ctx context.Context)
 	if err != nil {
 		return err
 	}
+	_, err = wfc.wfInformer.AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			un, ok := obj.(*unstructured.Unstructured)
+			return ok && un.GetLabels()[common.LabelKeyParentWorkflow] != ""
+		},
+		Handler: cache.ResourceEventHandlerFuncs{
+			// the parent of a child workflow reflects its phase, so needs to be reconciled when it changes
+			UpdateFunc: func(_, obj interface{}) {
+				un := obj.(*unstructured.Unstructured)
+				wfc.wfQueue.AddRateLimited(un.GetNamespace() + "/" + un.GetLabels()[common.LabelKeyParentWorkflow])
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
 	_, err = wfc.wfInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		DeleteFunc: func(obj interface{}) {
 			wf, ok := obj.(*unstructured.Unstructured)
@@ -1121,6 +1228,9 @@ func (wfc *WorkflowController) newConfigMapInformer(ctx context.Context) cache.S
 		indexes.ConfigMapLabelsIndex: indexes.ConfigMapIndexFunc,
 	}, func(opts *metav1.ListOptions) {
 		opts.LabelSelector = common.LabelKeyConfigMapType
+		for _, r := range wfc.Config.Informers.LabelRequirements() {
+			opts.LabelSelector += "," + r.String()
+		}
 	})
 	ctx, logger := logging.RequireLoggerFromContext(ctx).WithField("component", "config_map_informer").InContext(ctx)
 	logger.WithField("executorPlugins", wfc.executorPlugins != nil).Info(ctx, "Plugins")
@@ -1145,6 +1255,13 @@ func (wfc *WorkflowController) newConfigMapInformer(ctx context.Context) cache.S
 						}).WithError(err).Error(ctx, "failed to convert configmap to plugin")
 						return
 					}
+					if cm.GetAnnotations()[common.AnnotationKeyExecutorPluginDisabled] == "true" {
+						logger.WithFields(logging.Fields{
+							"namespace": cm.GetNamespace(),
+							"name":      cm.GetName(),
+						}).Info(ctx, "Executor plugin disabled")
+						return
+					}
 					if _, ok := wfc.executorPlugins[cm.GetNamespace()]; !ok {
 						wfc.executorPlugins[cm.GetNamespace()] = map[string]*spec.Plugin{}
 					}
@@ -1164,7 +1281,18 @@ func (wfc *WorkflowController) newConfigMapInformer(ctx context.Context) cache.S
 						}).WithError(err).Error(ctx, "failed to convert configmap to plugin")
 						return
 					}
-
+					// a disabled plugin is removed, so that agent pods created from now on no longer run it
+					if cm.GetAnnotations()[common.AnnotationKeyExecutorPluginDisabled] == "true" {
+						delete(wfc.executorPlugins[cm.GetNamespace()], cm.GetName())
+						logger.WithFields(logging.Fields{
+							"namespace": cm.GetNamespace(),
+							"name":      cm.GetName(),
+						}).Info(ctx, "Executor plugin disabled")
+						return
+					}
+					if _, ok := wfc.executorPlugins[cm.GetNamespace()]; !ok {
+						wfc.executorPlugins[cm.GetNamespace()] = map[string]*spec.Plugin{}
+					}
 					wfc.executorPlugins[cm.GetNamespace()][cm.GetName()] = p
 					logger.WithFields(logging.Fields{
 						"namespace": cm.GetNamespace(),
@@ -1195,13 +1323,32 @@ func (wfc *WorkflowController) updateEstimatorFactory(ctx context.Context) {
 // workflowController. Values in the workflow will be given the upper hand over the defaults.
 // The defaults for the workflow controller are set in the workflow-controller config map
 func (wfc *WorkflowController) setWorkflowDefaults(wf *wfv1.Workflow) error {
-	if wfc.Config.WorkflowDefaults != nil {
-		err := util.MergeTo(wfc.Config.WorkflowDefaults, wf)
+	wfDefaults, err := wfc.workflowDefaults(wf)
+	if err != nil {
+		return err
+	}
+	return util.MergeTo(wfDefaults, wf)
+}
+
+// workflowDefaults returns the defaults for the workflow, which may be nil.
+// The precedence, highest first, is: the last profile that selects the workflow, ..., the first profile that selects it, workflowDefaults.
+func (wfc *WorkflowController) workflowDefaults(wf *wfv1.Workflow) (*wfv1.Workflow, error) {
+	wfDefaults := wfc.Config.WorkflowDefaults
+	for _, profile := range wfc.Config.WorkflowDefaultsProfiles {
+		selected, err := profile.Selects(wf.Namespace, wf.Labels)
 		if err != nil {
-			return err
+			return nil, fmt.Errorf("invalid selector in workflow defaults profile %q: %w", profile.Name, err)
 		}
+		if !selected || profile.WorkflowDefaults == nil {
+			continue
+		}
+		merged := profile.WorkflowDefaults.DeepCopy()
+		if err := util.MergeTo(wfDefaults, merged); err != nil {
+			return nil, err
+		}
+		wfDefaults = merged
 	}
-	return nil
+	return wfDefaults, nil
 }
 
 func (wfc *WorkflowController) GetManagedNamespace() string {
@@ -1215,7 +1362,7 @@ func (wfc *WorkflowController) getMaxStackDepth() int {
 	return maxAllowedStackDepth
 }
 
-func (wfc *WorkflowController) getMetricsServerConfig() *telemetry.Config {
+func (wfc *WorkflowController) getMetricsServerConfig(ctx context.Context) (*telemetry.Config, error) {
 	// Metrics config
 	modifiers := make(map[string]telemetry.Modifier)
 	for name, modifier := range wfc.Config.MetricsConfig.Modifiers {
@@ -1223,6 +1370,7 @@ func (wfc *WorkflowController) getMetricsServerConfig() *telemetry.Config {
 			Disabled:           modifier.Disabled,
 			DisabledAttributes: modifier.DisabledAttributes,
 			HistogramBuckets:   modifier.HistogramBuckets,
+			MaxSeries:          modifier.MaxSeries,
 		}
 	}
 
@@ -1235,8 +1383,68 @@ func (wfc *WorkflowController) getMetricsServerConfig() *telemetry.Config {
 		Secure:       wfc.Config.MetricsConfig.GetSecure(true),
 		Modifiers:    modifiers,
 		Temporality:  wfc.Config.MetricsConfig.GetTemporality(),
+		MaxSeries:    wfc.Config.MetricsConfig.MaxSeries,
+		OTLP:         getOTLPConfig(wfc.Config.MetricsConfig.OTLP),
+	}
+	if tlsConfig := wfc.Config.MetricsConfig.TLS; tlsConfig != nil && metricsConfig.Secure {
+		tlsMinVersion := env.LookupEnvIntOr(ctx, "TLS_MIN_VERSION", tls.VersionTLS12)
+		load := tlsutils.CertificateFromFiles(tlsConfig.CertFile, tlsConfig.KeyFile)
+		if tlsConfig.SecretName != "" {
+			load = tlsutils.CertificateFromSecret(wfc.kubeclientset, wfc.namespace, tlsConfig.SecretName)
+		}
+		cert, err := tlsutils.NewReloader(ctx, "metrics server certificate", load)
+		if err != nil {
+			return nil, err
+		}
+		go cert.Run(ctx, metricsReloadPeriod)
+		var clientCAs *tlsutils.Reloader[*x509.CertPool]
+		if tlsConfig.ClientCAFile != "" {
+			clientCAs, err = tlsutils.NewReloader(ctx, "metrics server client CAs", tlsutils.CertPoolFromFile(tlsConfig.ClientCAFile))
+			if err != nil {
+				return nil, err
+			}
+			go clientCAs.Run(ctx, metricsReloadPeriod)
+		}
+		metricsConfig.TLSConfig = tlsutils.ServerTLSConfig(uint16(tlsMinVersion), cert, clientCAs)
+	}
+	if auth := wfc.Config.MetricsConfig.Auth; auth != nil && auth.BearerTokenSecret != nil {
+		selector := auth.BearerTokenSecret
+		token, err := tlsutils.NewReloader(ctx, "metrics server bearer token", func(ctx context.Context) (string, error) {
+			data, err := argoutil.GetSecrets(ctx, wfc.kubeclientset, wfc.namespace, selector.Name, selector.Key)
+			return strings.TrimSpace(string(data)), err
+		})
+		if err != nil {
+			return nil, err
+		}
+		go token.Run(ctx, metricsReloadPeriod)
+		metricsConfig.BearerToken = token.Get
 	}
-	return &metricsConfig
+	return &metricsConfig, nil
+}
+
+func getOTLPConfig(c *config.MetricsOTLPConfig) telemetry.OTLPConfig {
+	var otlp telemetry.OTLPConfig
+	if c == nil {
+		return otlp
+	}
+	duration := func(d *metav1.Duration, defaultValue time.Duration) time.Duration {
+		if d == nil {
+			return defaultValue
+		}
+		return d.Duration
+	}
+	otlp.Interval = duration(c.Interval, 0)
+	otlp.Timeout = duration(c.Timeout, 0)
+	if c.Retry != nil {
+		otlp.Retry = &otlpmetricgrpc.RetryConfig{
+			Enabled:         !c.Retry.Disabled,
+			InitialInterval: duration(c.Retry.InitialInterval, telemetry.DefaultOTLPRetry.InitialInterval),
+			MaxInterval:     duration(c.Retry.MaxInterval, telemetry.DefaultOTLPRetry.MaxInterval),
+			MaxElapsedTime:  duration(c.Retry.MaxElapsedTime, telemetry.DefaultOTLPRetry.MaxElapsedTime),
+		}
+	}
+	otlp.ResourceAttributes = c.ResourceAttributes
+	return otlp
 }
 
 func (wfc *WorkflowController) releaseAllWorkflowLocks(ctx context.Context, obj interface{}) {
@@ -1339,6 +1547,15 @@ func (wfc *WorkflowController) newArtGCTaskInformer() wfextvv1alpha1.WorkflowArt
 	return informer
 }
 
+// getLastReconcile returns the time the leader last finished reconciling a workflow, or started leading, and zero if
+// it is not running
+func (wfc *WorkflowController) getLastReconcile() time.Time {
+	if !wfc.running.Load() {
+		return time.Time{}
+	}
+	return time.Unix(0, wfc.lastReconcile.Load())
+}
+
 func (wfc *WorkflowController) IsLeader() bool {
 	// the wfc.wfInformer is nil if it is not the leader
 	return wfc.wfInformer != nil
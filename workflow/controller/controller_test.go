@@ -39,10 +39,13 @@ import (
 	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/entrypoint"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/estimation"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/imagedigest"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/pod"
 	"github.com/argoproj/argo-workflows/v3/workflow/events"
 	hydratorfake "github.com/argoproj/argo-workflows/v3/workflow/hydrator/fake"
+	"github.com/argoproj/argo-workflows/v3/workflow/lifecycle"
 	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
+	"github.com/argoproj/argo-workflows/v3/workflow/notifications"
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
 )
 
@@ -295,11 +298,14 @@ func newController(ctx context.Context, options ...interface{}) (context.CancelF
 		hydrator:                  hydratorfake.Noop,
 		estimatorFactory:          estimation.DummyEstimatorFactory,
 		eventRecorderManager:      &testEventRecorderManager{eventRecorder: record.NewFakeRecorder(64)},
+		lifecyclePublisher:        lifecycle.NullPublisher,
+		notificationSender:        notifications.NewSender(kube, "", nil),
 		archiveLabelSelector:      labels.Everything(),
 		cacheFactory:              controllercache.NewCacheFactory(kube, "default"),
 		progressPatchTickDuration: envutil.LookupEnvDurationOr(ctx, common.EnvVarProgressPatchTickDuration, 1*time.Minute),
 		progressFileTickDuration:  envutil.LookupEnvDurationOr(ctx, common.EnvVarProgressFileTickDuration, 3*time.Second),
 		maxStackDepth:             maxAllowedStackDepth,
+		workflowUpdateMethod:      workflowUpdateMethodUpdate,
 	}
 
 	for _, opt := range options {
@@ -314,9 +320,17 @@ func newController(ctx context.Context, options ...interface{}) (context.CancelF
 	{
 		wfc.metrics, testExporter, _ = metrics.CreateDefaultTestMetrics(ctx)
 		wfc.entrypoint = entrypoint.New(kube, wfc.Config.Images)
+		if wfc.imageDigests == nil {
+			wfc.imageDigests = imagedigest.New(kube)
+		}
 		wfc.wfQueue = workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]())
+		wfc.workflowWorkers = newWorkerPool(wfc.processNextItem)
+		wfc.archiveWorkers = newWorkerPool(wfc.processNextArchiveItem)
+		wfc.notificationQueue = workqueue.NewTyped[notification]()
+		wfc.notificationWorkers = newWorkerPool(wfc.processNextNotification)
 		wfc.throttler = wfc.newThrottler()
 		wfc.rateLimiter = wfc.newRateLimiter()
+		wfc.workflowUpdateRateLimiter = wfc.newWorkflowUpdateRateLimiter()
 	}
 
 	// always compare to WorkflowController.Run to see what this block of code should be doing
@@ -654,6 +668,64 @@ func TestAddingWorkflowDefaultVolumeClaimTemplate(t *testing.T) {
 	assert.Equal(t, workflow, wfv1.MustUnmarshalWorkflow(testDefaultVolumeClaimTemplateWf))
 }
 
+func TestWorkflowDefaultsProfiles(t *testing.T) {
+	cancel, controller := newController(logging.TestContext(t.Context()), func(controller *WorkflowController) {
+		controller.Config.WorkflowDefaults = &wfv1.Workflow{
+			Spec: wfv1.WorkflowSpec{ServiceAccountName: "default", Parallelism: ptr.To(int64(10))},
+		}
+		controller.Config.WorkflowDefaultsProfiles = []config.WorkflowDefaultsProfile{
+			{
+				Name:             "batch",
+				Namespaces:       []string{"batch"},
+				WorkflowDefaults: &wfv1.Workflow{Spec: wfv1.WorkflowSpec{ServiceAccountName: "batch", ActiveDeadlineSeconds: ptr.To(int64(3600))}},
+			},
+			{
+				Name:             "interactive",
+				Selector:         &metav1.LabelSelector{MatchLabels: map[string]string{"type": "interactive"}},
+				WorkflowDefaults: &wfv1.Workflow{Spec: wfv1.WorkflowSpec{ServiceAccountName: "interactive", Parallelism: ptr.To(int64(2))}},
+			},
+		}
+	})
+	defer cancel()
+	withDefaults := func(namespace string, labels map[string]string) *wfv1.Workflow {
+		wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+		wf.Namespace = namespace
+		wf.Labels = labels
+		require.NoError(t, controller.setWorkflowDefaults(wf))
+		return wf
+	}
+	t.Run("NoProfile", func(t *testing.T) {
+		wf := withDefaults("default", nil)
+		assert.Equal(t, "default", wf.Spec.ServiceAccountName)
+		assert.Equal(t, int64(10), *wf.Spec.Parallelism)
+		assert.Nil(t, wf.Spec.ActiveDeadlineSeconds)
+	})
+	t.Run("Namespace", func(t *testing.T) {
+		wf := withDefaults("batch", nil)
+		assert.Equal(t, "batch", wf.Spec.ServiceAccountName)
+		assert.Equal(t, int64(10), *wf.Spec.Parallelism, "merged with workflowDefaults")
+		assert.Equal(t, int64(3600), *wf.Spec.ActiveDeadlineSeconds)
+	})
+	t.Run("LaterProfileTakesPrecedence", func(t *testing.T) {
+		wf := withDefaults("batch", map[string]string{"type": "interactive"})
+		assert.Equal(t, "interactive", wf.Spec.ServiceAccountName)
+		assert.Equal(t, int64(2), *wf.Spec.Parallelism)
+		assert.Equal(t, int64(3600), *wf.Spec.ActiveDeadlineSeconds)
+	})
+	t.Run("WorkflowTakesPrecedence", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+		wf.Labels = map[string]string{"type": "interactive"}
+		wf.Spec.ServiceAccountName = "mine"
+		require.NoError(t, controller.setWorkflowDefaults(wf))
+		assert.Equal(t, "mine", wf.Spec.ServiceAccountName)
+		assert.Equal(t, int64(2), *wf.Spec.Parallelism)
+	})
+	t.Run("ConfigNotModified", func(t *testing.T) {
+		assert.Equal(t, "default", controller.Config.WorkflowDefaults.Spec.ServiceAccountName)
+		assert.Equal(t, "batch", controller.Config.WorkflowDefaultsProfiles[0].WorkflowDefaults.Spec.ServiceAccountName)
+	})
+}
+
 func TestNamespacedController(t *testing.T) {
 	kubeClient := fake.Clientset{}
 	allowed := false
@@ -1357,3 +1429,46 @@ func TestPodSpecPatchTemplateLevel(t *testing.T) {
 	require.NotNil(t, mainContainer)
 	assert.Equal(t, "25Mi", mainContainer.Resources.Requests.Memory().String())
 }
+
+func TestGetMetricsServerConfigAuth(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx)
+	defer cancel()
+	controller.namespace = "argo"
+	_, err := controller.kubeclientset.CoreV1().Secrets("argo").Create(ctx, &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "metrics-auth"},
+		Data:       map[string][]byte{"token": []byte("my-token\n")},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	controller.Config.MetricsConfig.Auth = &config.MetricsAuthConfig{
+		BearerTokenSecret: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "metrics-auth"}, Key: "token"},
+	}
+	metricsConfig, err := controller.getMetricsServerConfig(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, metricsConfig.BearerToken)
+	assert.Equal(t, "my-token", metricsConfig.BearerToken())
+
+	controller.Config.MetricsConfig.Auth.BearerTokenSecret.Key = "missing"
+	_, err = controller.getMetricsServerConfig(ctx)
+	require.Error(t, err)
+}
+
+func TestGetOTLPConfig(t *testing.T) {
+	assert.Equal(t, telemetry.OTLPConfig{}, getOTLPConfig(nil))
+
+	otlp := getOTLPConfig(&config.MetricsOTLPConfig{
+		Interval:           &metav1.Duration{Duration: 10 * time.Second},
+		Retry:              &config.MetricsOTLPRetryConfig{MaxElapsedTime: &metav1.Duration{Duration: 5 * time.Minute}},
+		ResourceAttributes: map[string]string{"cluster": "my-cluster"},
+	})
+	assert.Equal(t, 10*time.Second, otlp.Interval)
+	assert.Zero(t, otlp.Timeout)
+	require.NotNil(t, otlp.Retry)
+	assert.True(t, otlp.Retry.Enabled)
+	assert.Equal(t, telemetry.DefaultOTLPRetry.InitialInterval, otlp.Retry.InitialInterval)
+	assert.Equal(t, 5*time.Minute, otlp.Retry.MaxElapsedTime)
+	assert.Equal(t, map[string]string{"cluster": "my-cluster"}, otlp.ResourceAttributes)
+
+	assert.False(t, getOTLPConfig(&config.MetricsOTLPConfig{Retry: &config.MetricsOTLPRetryConfig{Disabled: true}}).Retry.Enabled)
+}
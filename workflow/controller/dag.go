@@ -11,10 +11,12 @@ import (
 	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/expr/argoexpr"
+	"github.com/argoproj/argo-workflows/v3/util/expr/env"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/util/template"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/expand"
 	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
 )
 
@@ -152,44 +154,38 @@ func (d *dagContext) assessDAGPhase(ctx context.Context, targetTasks []string, n
 	if err != nil {
 		return "", err
 	}
-	// BFS over the children of the DAG
-	uniqueQueue := newUniquePhaseNodeQueue(generatePhaseNodes(boundaryNode.Children, wfv1.NodeSucceeded)...)
-	for !uniqueQueue.empty() {
-		curr := uniqueQueue.pop()
-
-		node, err := nodes.Get(curr.nodeID)
-		if err != nil {
-			// this is okay, this means that
-			// we are still running
-			return wfv1.NodeRunning, nil
-		}
-		// We need to store the current branchPhase to remember the last completed phase in this branch so that we can apply it to omitted nodes
-		branchPhase := curr.phase
-
-		if !node.Fulfilled() {
-			return wfv1.NodeRunning, nil
+	// BFS over the children of the DAG, other than its finally node, which runs once the DAG tasks have completed
+	finallyNodeID := d.wf.NodeID(common.GenerateFinallyNodeName(d.boundaryName))
+	children := make([]string, 0, len(boundaryNode.Children))
+	for _, childID := range boundaryNode.Children {
+		if childID != finallyNodeID {
+			children = append(children, childID)
 		}
-
-		// Only overwrite the branchPhase if this node completed. (If it didn't we can just inherit our parent's branchPhase).
-		if node.Completed() {
-			branchPhase = node.Phase
-		}
-
-		// This node is a target task, so it will not have any children. Store or deduce its phase
-		if previousPhase, isTargetTask := targetTaskPhases[node.ID]; isTargetTask {
-			// Since we want Failed or Errored phases to have preference over Succeeded in case of ambiguity, only update
-			// the deduced phase of the target task if it is not already Failed or Errored.
-			// Note that if the target task is NOT omitted (i.e. it Completed), then this check is moot, because every time
-			// we arrive at said target task it will have the same branchPhase.
-			if !previousPhase.FailedOrError() {
-				targetTaskPhases[node.ID] = branchPhase
+	}
+	// The BFS visits a level at a time, assessing the nodes of a level in parallel if there are many, and then
+	// applying the results in the order of the level, so that the result is the same as visiting one node at a time.
+	uniqueQueue := newUniquePhaseNodeQueue(generatePhaseNodes(children, wfv1.NodeSucceeded)...)
+	for !uniqueQueue.empty() {
+		level, _ := parallelMap(uniqueQueue.popAll(), func(_ int, curr phaseNode) (assessedPhaseNode, error) {
+			return assessPhaseNode(curr, nodes), nil
+		})
+		for _, assessed := range level {
+			if !assessed.fulfilled {
+				// this is okay, this means that
+				// we are still running
+				return wfv1.NodeRunning, nil
 			}
-		}
-
-		if node.Type == wfv1.NodeTypeRetry {
-			uniqueQueue.add(generatePhaseNodes(getRetryNodeChildrenIds(node, nodes), branchPhase)...)
-		} else {
-			uniqueQueue.add(generatePhaseNodes(node.Children, branchPhase)...)
+			// This node is a target task, so it will not have any children. Store or deduce its phase
+			if previousPhase, isTargetTask := targetTaskPhases[assessed.nodeID]; isTargetTask {
+				// Since we want Failed or Errored phases to have preference over Succeeded in case of ambiguity, only update
+				// the deduced phase of the target task if it is not already Failed or Errored.
+				// Note that if the target task is NOT omitted (i.e. it Completed), then this check is moot, because every time
+				// we arrive at said target task it will have the same branchPhase.
+				if !previousPhase.FailedOrError() {
+					targetTaskPhases[assessed.nodeID] = assessed.branchPhase
+				}
+			}
+			uniqueQueue.add(generatePhaseNodes(assessed.children, assessed.branchPhase)...)
 		}
 	}
 
@@ -225,6 +221,35 @@ func (d *dagContext) assessDAGPhase(ctx context.Context, targetTasks []string, n
 	return result, nil
 }
 
+// assessedPhaseNode is a node visited by the BFS of assessDAGPhase
+type assessedPhaseNode struct {
+	nodeID string
+	// fulfilled is false if the node does not exist yet or is not fulfilled, i.e. the DAG is still running
+	fulfilled   bool
+	branchPhase wfv1.NodePhase
+	children    []string
+}
+
+// assessPhaseNode assesses a node of the BFS of assessDAGPhase. It only reads the nodes, so that it is safe to call
+// concurrently.
+func assessPhaseNode(curr phaseNode, nodes wfv1.Nodes) assessedPhaseNode {
+	node, ok := nodes[curr.nodeID]
+	if !ok || !node.Fulfilled() {
+		return assessedPhaseNode{nodeID: curr.nodeID}
+	}
+	// We need to store the current branchPhase to remember the last completed phase in this branch so that we can apply it to omitted nodes
+	branchPhase := curr.phase
+	// Only overwrite the branchPhase if this node completed. (If it didn't we can just inherit our parent's branchPhase).
+	if node.Completed() {
+		branchPhase = node.Phase
+	}
+	children := node.Children
+	if node.Type == wfv1.NodeTypeRetry {
+		children = getRetryNodeChildrenIds(&node, nodes)
+	}
+	return assessedPhaseNode{nodeID: node.ID, fulfilled: true, branchPhase: branchPhase, children: children}
+}
+
 func (woc *wfOperationCtx) executeDAG(ctx context.Context, nodeName string, tmplCtx *templateresolution.TemplateContext, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, opts *executeTemplateOpts) (*wfv1.NodeStatus, error) {
 
 	node, err := woc.wf.GetNodeByName(nodeName)
@@ -300,6 +325,11 @@ func (woc *wfOperationCtx) executeDAG(ctx context.Context, nodeName string, tmpl
 				woc.markNodeError(ctx, node.Name, err)
 				return node, err
 			}
+			_, err = woc.executeDAGHooks(ctx, dagCtx, scope, taskName, taskNode)
+			if err != nil {
+				woc.markNodeError(ctx, node.Name, err)
+				return node, err
+			}
 			if taskNode.Fulfilled() {
 				if taskNode.Completed() {
 					hasOnExitNode, onExitNode, err := woc.runOnExitNode(ctx, dagCtx.GetTask(ctx, taskName).GetExitHook(woc.execWf.Spec.Arguments), taskNode, dagCtx.boundaryID, dagCtx.tmplCtx, "tasks."+taskName, scope)
@@ -329,6 +359,16 @@ func (woc *wfOperationCtx) executeDAG(ctx context.Context, nodeName string, tmpl
 		if err != nil {
 			return nil, err
 		}
+		if tmpl.Finally != nil {
+			scope := createScope(tmpl)
+			for _, task := range tmpl.DAG.Tasks {
+				if taskNode := dagCtx.getTaskNode(ctx, task.Name); taskNode != nil {
+					woc.buildLocalScope(scope, fmt.Sprintf("tasks.%s", task.Name), taskNode)
+				}
+			}
+			node, _, err = woc.completeWithFinally(ctx, tmpl, nodeName, dagPhase, tmplCtx, scope)
+			return node, err
+		}
 		_ = woc.markNodePhase(ctx, nodeName, dagPhase)
 		return node, nil
 	}
@@ -389,7 +429,8 @@ func (woc *wfOperationCtx) executeDAG(ctx context.Context, nodeName string, tmpl
 	if err != nil {
 		return nil, err
 	}
-	return woc.markNodePhase(ctx, nodeName, wfv1.NodeSucceeded), nil
+	node, _, err = woc.completeWithFinally(ctx, tmpl, nodeName, wfv1.NodeSucceeded, tmplCtx, scope)
+	return node, err
 }
 
 func (woc *wfOperationCtx) updateOutboundNodesForTargetTasks(ctx context.Context, dagCtx *dagContext, targetTasks []string, nodeName string) error {
@@ -425,6 +466,11 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 	node := dagCtx.getTaskNode(ctx, taskName)
 	task := dagCtx.GetTask(ctx, taskName)
 	ctx, log := woc.log.WithField("taskName", taskName).InContext(ctx)
+	// A listening task evaluates its when again while it is suspended
+	if node != nil && node.Phase == wfv1.NodeRunning && listensForIntermediateParameters(task.When) {
+		woc.executeListeningTask(ctx, dagCtx, task, node.Name)
+		node = dagCtx.getTaskNode(ctx, taskName)
+	}
 	if node != nil && (node.Fulfilled() || node.Phase == wfv1.NodeRunning) {
 		scope, err := woc.buildLocalScopeFromTask(ctx, dagCtx, task)
 		if err != nil {
@@ -437,8 +483,12 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 		if err != nil {
 			woc.markNodeError(ctx, node.Name, err)
 		}
+		dagHooksCompleted, err := woc.executeDAGHooks(ctx, dagCtx, scope, taskName, node)
+		if err != nil {
+			woc.markNodeError(ctx, node.Name, err)
+		}
 		// Check all hooks are completes
-		if !hookCompleted {
+		if !hookCompleted || !dagHooksCompleted {
 			return
 		}
 	}
@@ -461,7 +511,7 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 			}
 		}
 
-		processedTmpl, err := common.ProcessArgs(ctx, tmpl, &task.Arguments, woc.globalParams, map[string]string{}, true, woc.wf.Namespace, woc.controller.configMapInformer.GetIndexer())
+		processedTmpl, err := common.ProcessArgs(ctx, tmpl, &task.Arguments, woc.globalParams, map[string]string{}, true, woc.wf.Namespace, woc.controller.configMapInformer.GetIndexer(), woc.getSecretValue)
 		if err != nil {
 			woc.markNodeError(ctx, node.Name, err)
 		}
@@ -552,6 +602,31 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 		}
 	}
 
+	// Only the selected branch of a oneOf group runs, the others are skipped
+	if oneOf := dagCtx.tmpl.DAG.GetOneOf(taskName); oneOf != nil && node == nil {
+		selected, err := woc.selectOneOfBranch(ctx, dagCtx, oneOf, task)
+		if err != nil {
+			woc.initializeNode(ctx, nodeName, wfv1.NodeTypeSkipped, dagTemplateScope, task, dagCtx.boundaryID, wfv1.NodeError, &wfv1.NodeFlag{}, true, err.Error())
+			connectDependencies(nodeName)
+			return
+		}
+		if selected != taskName {
+			skipReason := fmt.Sprintf("oneOf %s selected %s", oneOf.Name, selected)
+			woc.initializeNode(ctx, nodeName, wfv1.NodeTypeSkipped, dagTemplateScope, task, dagCtx.boundaryID, wfv1.NodeSkipped, &wfv1.NodeFlag{}, true, skipReason)
+			connectDependencies(nodeName)
+			return
+		}
+	}
+
+	// A suspend task whose when refers to the intermediate parameters of other tasks listens for them
+	if listensForIntermediateParameters(task.When) {
+		if node == nil {
+			connectDependencies(nodeName)
+		}
+		woc.executeListeningTask(ctx, dagCtx, task, nodeName)
+		return
+	}
+
 	// All our dependencies were satisfied and successful. It's our turn to run
 	// First resolve/substitute params/artifacts from our dependencies
 	newTask, err := woc.resolveDependencyReferences(ctx, dagCtx, task)
@@ -594,7 +669,7 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 			connectDependencies(taskNodeName)
 
 			// Check the task's when clause to decide if it should execute
-			proceed, err := shouldExecute(t.When)
+			proceed, err := expand.ShouldExecute(t.When)
 			if err != nil {
 				woc.initializeNode(ctx, taskNodeName, wfv1.NodeTypeSkipped, dagTemplateScope, task, dagCtx.boundaryID, wfv1.NodeError, &wfv1.NodeFlag{}, true, err.Error())
 				continue
@@ -657,6 +732,24 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 	}
 }
 
+// selectOneOfBranch evaluates the expression of the oneOf group of the task, and returns the task of the selected branch
+func (woc *wfOperationCtx) selectOneOfBranch(ctx context.Context, dagCtx *dagContext, oneOf *wfv1.DAGOneOf, task *wfv1.DAGTask) (string, error) {
+	// the branches have the same dependencies, and so the same scope
+	scope, err := woc.buildLocalScopeFromTask(ctx, dagCtx, task)
+	if err != nil {
+		return "", err
+	}
+	value, err := argoexpr.EvalString(oneOf.Expression, env.GetFuncMap(template.EnvMap(woc.globalParams.Merge(scope.getParameters()))))
+	if err != nil {
+		return "", fmt.Errorf("oneOf %s: %w", oneOf.Name, err)
+	}
+	branch := oneOf.Select(value)
+	if branch == nil {
+		return "", fmt.Errorf("oneOf %s: no branch for value %q", oneOf.Name, value)
+	}
+	return branch.Task, nil
+}
+
 func (woc *wfOperationCtx) buildLocalScopeFromTask(ctx context.Context, dagCtx *dagContext, task *wfv1.DAGTask) (*wfScope, error) {
 	// build up the scope
 	scope := createScope(dagCtx.tmpl)
@@ -728,7 +821,7 @@ func (woc *wfOperationCtx) resolveDependencyReferences(ctx context.Context, dagC
 
 	// If we are not executing, don't attempt to resolve any artifact references. We only check if we are executing after
 	// the initial parameter resolution, since it's likely that the "when" clause will contain parameter references.
-	proceed, err := shouldExecute(newTask.When)
+	proceed, err := expand.ShouldExecute(newTask.When)
 	if err != nil {
 		// If we got an error, it might be because our "when" clause contains a task-expansion parameter (e.g. {{item}}).
 		// Since we don't perform task-expansion until later and task-expansion parameters won't get resolved here,
@@ -789,37 +882,13 @@ func (d *dagContext) findLeafTaskNames(ctx context.Context, tasks []wfv1.DAGTask
 }
 
 // expandTask expands a single DAG task containing withItems, withParams, withSequence into multiple parallel tasks
-// We want to be lazy with expanding. Unfortunately this is not quite possible as the When field might rely on
-// expansion to work with the shouldExecute function. To address this we apply a trick, we try to expand, if we fail, we then
-// check shouldExecute, if shouldExecute returns false, we continue on as normal else error out
 func expandTask(ctx context.Context, task wfv1.DAGTask) ([]wfv1.DAGTask, error) {
-	var err error
-	var items []wfv1.Item
-	if len(task.WithItems) > 0 {
-		items = task.WithItems
-	} else if task.WithParam != "" {
-		err = json.Unmarshal([]byte(task.WithParam), &items)
-		if err != nil {
-			mustExec, mustExecErr := shouldExecute(task.When)
-			if mustExecErr != nil || mustExec {
-				return nil, errors.Errorf(errors.CodeBadRequest, "withParam value could not be parsed as a JSON list: %s: %v", strings.TrimSpace(task.WithParam), err)
-			}
-		}
-	} else if task.WithSequence != nil {
-		items, err = expandSequence(task.WithSequence)
-		if err != nil {
-			mustExec, mustExecErr := shouldExecute(task.When)
-			if mustExecErr != nil || mustExec {
-				return nil, err
-			}
-		}
-	} else {
+	if !task.ShouldExpand() {
 		return []wfv1.DAGTask{task}, nil
 	}
-
-	taskBytes, err := json.Marshal(task)
+	items, err := expand.Items(task.WithItems, task.WithParam, task.WithSequence, task.When)
 	if err != nil {
-		return nil, errors.InternalWrapError(err)
+		return nil, err
 	}
 
 	// these fields can be very large (>100m) and marshalling 10k x 100m = 6GB of memory used and
@@ -828,22 +897,25 @@ func expandTask(ctx context.Context, task wfv1.DAGTask) ([]wfv1.DAGTask, error)
 	task.WithParam = ""
 	task.WithSequence = nil
 
+	taskBytes, err := json.Marshal(task)
+	if err != nil {
+		return nil, errors.InternalWrapError(err)
+	}
+
 	tmpl, err := template.NewTemplate(string(taskBytes))
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse argo variable: %w", err)
 	}
-	expandedTasks := make([]wfv1.DAGTask, 0)
-	for i, item := range items {
+	return parallelMap(items, func(i int, item wfv1.Item) (wfv1.DAGTask, error) {
 		var newTask wfv1.DAGTask
-		newTaskName, err := processItem(ctx, tmpl, task.Name, i, item, &newTask, task.When)
+		newTaskName, err := expand.Item(ctx, tmpl, task.Name, i, item, &newTask, task.When)
 		if err != nil {
-			return nil, err
+			return newTask, err
 		}
 		newTask.Name = newTaskName
 		newTask.Template = task.Template
-		expandedTasks = append(expandedTasks, newTask)
-	}
-	return expandedTasks, nil
+		return newTask, nil
+	})
 }
 
 type TaskResults struct {
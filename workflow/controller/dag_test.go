@@ -3731,3 +3731,107 @@ func TestDagWftmplHookWithRetry(t *testing.T) {
 	finishNode := woc.wf.Status.Nodes.FindByDisplayName("finish")
 	assert.Equal(t, wfv1.NodeOmitted, finishNode.Phase)
 }
+
+var dagOneOf = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: dag-one-of
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: environment
+      value: production
+  templates:
+  - name: main
+    inputs:
+      parameters:
+      - name: environment
+        value: '{{workflow.parameters.environment}}'
+    dag:
+      tasks:
+      - name: build
+        template: whalesay
+      - name: deploy-production
+        template: whalesay
+        depends: build
+      - name: deploy-staging
+        template: whalesay
+        depends: build
+      - name: deploy-preview
+        template: whalesay
+        depends: build
+      - name: verify
+        template: whalesay
+        depends: deploy-production && deploy-staging && deploy-preview
+      oneOf:
+      - name: deploy
+        expression: inputs.parameters.environment
+        branches:
+        - task: deploy-production
+          values: [production, prod]
+        - task: deploy-staging
+          values: [staging]
+        - task: deploy-preview
+          default: true
+  - name: whalesay
+    container:
+      image: docker/whalesay
+      command: [cowsay]
+`
+
+func TestDAGOneOf(t *testing.T) {
+	for environment, selected := range map[string]string{"prod": "deploy-production", "staging": "deploy-staging", "other": "deploy-preview"} {
+		t.Run(environment, func(t *testing.T) {
+			wf := wfv1.MustUnmarshalWorkflow(dagOneOf)
+			wf.Spec.Arguments.Parameters[0].Value = wfv1.AnyStringPtr(environment)
+			ctx := logging.TestContext(t.Context())
+			cancel, controller := newController(ctx, wf)
+			defer cancel()
+
+			woc := newWorkflowOperationCtx(ctx, wf, controller)
+			woc.operate(ctx)
+			makePodsPhase(ctx, woc, v1.PodSucceeded)
+			woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+			woc.operate(ctx)
+
+			for _, task := range []string{"deploy-production", "deploy-staging", "deploy-preview"} {
+				node, err := woc.wf.GetNodeByName("dag-one-of." + task)
+				require.NoError(t, err)
+				if task == selected {
+					assert.Equal(t, wfv1.NodePending, node.Phase, task)
+				} else {
+					assert.Equal(t, wfv1.NodeSkipped, node.Phase, task)
+					assert.Equal(t, "oneOf deploy selected "+selected, node.Message)
+				}
+			}
+
+			makePodsPhase(ctx, woc, v1.PodSucceeded)
+			woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+			woc.operate(ctx)
+			node, err := woc.wf.GetNodeByName("dag-one-of.verify")
+			require.NoError(t, err)
+			assert.Equal(t, wfv1.NodePending, node.Phase)
+		})
+	}
+	t.Run("NoBranch", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(dagOneOf)
+		wf.Spec.Templates[0].DAG.OneOf[0].Branches[2].Default = false
+		wf.Spec.Templates[0].DAG.OneOf[0].Branches[2].Values = []string{"preview"}
+		wf.Spec.Arguments.Parameters[0].Value = wfv1.AnyStringPtr("other")
+		ctx := logging.TestContext(t.Context())
+		cancel, controller := newController(ctx, wf)
+		defer cancel()
+
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, v1.PodSucceeded)
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+
+		node, err := woc.wf.GetNodeByName("dag-one-of.deploy-production")
+		require.NoError(t, err)
+		assert.Equal(t, wfv1.NodeError, node.Phase)
+		assert.Equal(t, `oneOf deploy: no branch for value "other"`, node.Message)
+	})
+}
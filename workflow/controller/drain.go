@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// RequestDrain requests the controller to drain, and returns false if nothing drains it, i.e. draining is disabled
+// or the controller is already draining
+func (wfc *WorkflowController) RequestDrain() bool {
+	select {
+	case wfc.drainRequests <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// DrainRequests returns the requests to drain the controller
+func (wfc *WorkflowController) DrainRequests() <-chan struct{} {
+	return wfc.drainRequests
+}
+
+// Drain stops the controller from processing workflows so that another controller can take over cleanly: the
+// workflows being reconciled are finished, and the pending archive writes and pod clean-ups are flushed. Workflows that
+// are queued, but not being reconciled, are left to the next leader, which reconciles all workflows when it starts.
+// Drain returns when it has finished, or when the context is done.
+func (wfc *WorkflowController) Drain(ctx context.Context) {
+	logger := logging.RequireLoggerFromContext(ctx)
+	if !wfc.draining.CompareAndSwap(false, true) {
+		return
+	}
+	logger.Info(ctx, "draining, no more workflows will be reconciled")
+	if !wfc.running.Load() {
+		return
+	}
+	wfc.wfQueue.ShutDown()
+	if !waitUntil(ctx, wfc.workflowWorkers.wait) {
+		logger.Warn(ctx, "timed out waiting for workflows being reconciled")
+		return
+	}
+	logger.Info(ctx, "finished reconciling workflows, flushing archive writes and pod clean-ups")
+	if !waitUntil(ctx, func() {
+		wfc.wfArchiveQueue.ShutDownWithDrain()
+		wfc.archiveWorkers.wait()
+	}) {
+		logger.WithField("workflowArchiveQueue", wfc.wfArchiveQueue.Len()).Warn(ctx, "timed out waiting for archive writes")
+		return
+	}
+	if !waitUntil(ctx, wfc.PodController.Drain) {
+		logger.WithField("podCleanupQueue", wfc.PodController.QueueLen()).Warn(ctx, "timed out waiting for pod clean-ups")
+		return
+	}
+	logger.Info(ctx, "drained")
+}
+
+// waitUntil runs f, and returns whether it returned before the context was done
+func waitUntil(ctx context.Context, f func()) bool {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f()
+	}()
+	select {
+	case <-done:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
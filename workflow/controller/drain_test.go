@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func TestRequestDrain(t *testing.T) {
+	wfc := &WorkflowController{}
+	assert.False(t, wfc.RequestDrain(), "nothing drains the controller")
+
+	wfc.drainRequests = make(chan struct{})
+	requested := make(chan struct{})
+	go func() {
+		<-wfc.DrainRequests()
+		close(requested)
+	}()
+	assert.Eventually(t, wfc.RequestDrain, time.Second, 10*time.Millisecond)
+	<-requested
+}
+
+func TestDrain(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx)
+	defer cancel()
+	controller.wfArchiveQueue = workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]())
+
+	t.Run("Queued", func(t *testing.T) {
+		// queued workflows are left to the next leader
+		controller.wfQueue.Add("my-ns/my-wf")
+		controller.draining.Store(true)
+		defer controller.draining.Store(false)
+		assert.False(t, controller.processNextItem(ctx))
+		assert.Equal(t, 0, controller.wfQueue.Len())
+	})
+	t.Run("Drain", func(t *testing.T) {
+		controller.workflowWorkers.start(ctx, 2)
+		controller.archiveWorkers.start(ctx, 2)
+		controller.running.Store(true)
+
+		controller.Drain(ctx)
+		assert.True(t, controller.draining.Load())
+		assert.True(t, controller.wfQueue.ShuttingDown())
+		assert.True(t, controller.wfArchiveQueue.ShuttingDown())
+	})
+}
@@ -13,6 +13,14 @@ func (e *dummyEstimator) EstimateWorkflowDuration() wfv1.EstimatedDuration {
 	return wfv1.NewEstimatedDuration(time.Second)
 }
 
-func (e *dummyEstimator) EstimateNodeDuration(_ context.Context, nodeName string) wfv1.EstimatedDuration {
+func (e *dummyEstimator) EstimateNodeDuration(_ context.Context, nodeName, templateName string, templateRef *wfv1.TemplateRef) wfv1.EstimatedDuration {
 	return wfv1.NewEstimatedDuration(time.Second)
 }
+
+func (e *dummyEstimator) EstimateNodeDurationP90(templateName string, templateRef *wfv1.TemplateRef) wfv1.EstimatedDuration {
+	return 0
+}
+
+func (e *dummyEstimator) RecommendResources(nodes wfv1.Nodes) map[string]wfv1.ResourceRecommendation {
+	return recommendResources(nodes, nil)
+}
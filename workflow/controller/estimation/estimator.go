@@ -3,6 +3,7 @@ package estimation
 import (
 	"context"
 	"strings"
+	"time"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
@@ -11,12 +12,26 @@ import (
 // Estimator return estimations for how long workflows and nodes will take
 type Estimator interface {
 	EstimateWorkflowDuration() wfv1.EstimatedDuration
-	EstimateNodeDuration(ctx context.Context, nodeName string) wfv1.EstimatedDuration
+	// EstimateNodeDuration estimates the node from the median of the nodes of its template in archived workflows, or
+	// from the node of the same name of the baseline workflow, or the average of the baseline's nodes of the same
+	// template if there is none, e.g. because the node is an item of a loop
+	EstimateNodeDuration(ctx context.Context, nodeName, templateName string, templateRef *wfv1.TemplateRef) wfv1.EstimatedDuration
+	// EstimateNodeDurationP90 estimates the 90th percentile of the duration of the nodes of the template in archived
+	// workflows, zero if there are none
+	EstimateNodeDurationP90(templateName string, templateRef *wfv1.TemplateRef) wfv1.EstimatedDuration
+	// RecommendResources recommends the requests and limits of each template from the peak resource usage of the nodes
+	// and of the nodes of the archived workflows, nil if there is no usage
+	RecommendResources(nodes wfv1.Nodes) map[string]wfv1.ResourceRecommendation
 }
 
 type estimator struct {
 	wf         *wfv1.Workflow
 	baselineWF *wfv1.Workflow
+	// templateDurations are from the archived workflows, if any, as durations vary with the parameters of each
+	// workflow, so a single baseline is not enough
+	templateDurations templateDurations
+	// templateUsages are from the archived workflows, if any
+	templateUsages templateUsages
 }
 
 func (e *estimator) EstimateWorkflowDuration() wfv1.EstimatedDuration {
@@ -26,13 +41,19 @@ func (e *estimator) EstimateWorkflowDuration() wfv1.EstimatedDuration {
 	return wfv1.NewEstimatedDuration(e.baselineWF.Status.GetDuration())
 }
 
-func (e *estimator) EstimateNodeDuration(ctx context.Context, nodeName string) wfv1.EstimatedDuration {
+func (e *estimator) EstimateNodeDuration(ctx context.Context, nodeName, templateName string, templateRef *wfv1.TemplateRef) wfv1.EstimatedDuration {
+	if d := e.templateDurations.percentile(templateName, templateRef, 50); d > 0 {
+		return d
+	}
 	if e.baselineWF == nil {
 		return 0
 	}
 	oldNodeID := e.baselineWF.NodeID(strings.Replace(nodeName, e.wf.Name, e.baselineWF.Name, 1))
 	node, err := e.baselineWF.Status.Nodes.Get(oldNodeID)
 	if err != nil {
+		if d := e.averageTemplateDuration(templateName); d > 0 {
+			return d
+		}
 		logger := logging.RequireLoggerFromContext(ctx)
 		logger.WithField("nodeID", oldNodeID).Error(ctx, "was unable to obtain node for nodeID")
 		// inacurate but not going to break anything
@@ -40,3 +61,31 @@ func (e *estimator) EstimateNodeDuration(ctx context.Context, nodeName string) w
 	}
 	return wfv1.NewEstimatedDuration(node.GetDuration())
 }
+
+func (e *estimator) EstimateNodeDurationP90(templateName string, templateRef *wfv1.TemplateRef) wfv1.EstimatedDuration {
+	return e.templateDurations.percentile(templateName, templateRef, 90)
+}
+
+func (e *estimator) RecommendResources(nodes wfv1.Nodes) map[string]wfv1.ResourceRecommendation {
+	return recommendResources(nodes, e.templateUsages)
+}
+
+// averageTemplateDuration returns the average duration of the baseline's succeeded nodes of the template
+func (e *estimator) averageTemplateDuration(templateName string) wfv1.EstimatedDuration {
+	if templateName == "" {
+		return 0
+	}
+	var total time.Duration
+	count := 0
+	for _, node := range e.baselineWF.Status.Nodes {
+		if node.TemplateName != templateName || node.Phase != wfv1.NodeSucceeded {
+			continue
+		}
+		total += node.GetDuration()
+		count++
+	}
+	if count == 0 {
+		return 0
+	}
+	return wfv1.NewEstimatedDuration(total / time.Duration(count))
+}
@@ -3,6 +3,8 @@ package estimation
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -26,8 +28,24 @@ type estimatorFactory struct {
 	wfInformer cache.SharedIndexInformer
 	hydrator   hydrator.Interface
 	wfArchive  sqldb.WorkflowArchive
+	// sampleSize is the number of archived workflows the durations and resources of templates are estimated from
+	sampleSize int
+	// archived caches the durations and resource usage of the templates of archived workflows, as the archive is
+	// queried each time a workflow creates nodes
+	archived     map[string]cachedArchivedTemplates
+	archivedLock sync.Mutex
 }
 
+type cachedArchivedTemplates struct {
+	durations templateDurations
+	usages    templateUsages
+	expiresAt time.Time
+}
+
+// archivedTemplatesTTL is how long the durations and resource usage of the templates of archived workflows are cached
+// for
+const archivedTemplatesTTL = 5 * time.Minute
+
 var _ EstimatorFactory = &estimatorFactory{}
 
 var (
@@ -35,7 +53,13 @@ var (
 )
 
 func NewEstimatorFactory(ctx context.Context, wfInformer cache.SharedIndexInformer, hydrator hydrator.Interface, wfArchive sqldb.WorkflowArchive) EstimatorFactory {
-	return &estimatorFactory{wfInformer, hydrator, wfArchive}
+	return &estimatorFactory{
+		wfInformer: wfInformer,
+		hydrator:   hydrator,
+		wfArchive:  wfArchive,
+		sampleSize: env.LookupEnvIntOr(ctx, "WORKFLOW_DURATION_ESTIMATION_SAMPLE_SIZE", 20),
+		archived:   map[string]cachedArchivedTemplates{},
+	}
 }
 
 func (f *estimatorFactory) NewEstimator(ctx context.Context, wf *wfv1.Workflow) (Estimator, error) {
@@ -50,6 +74,16 @@ func (f *estimatorFactory) NewEstimator(ctx context.Context, wf *wfv1.Workflow)
 	} {
 		labelValue, exists := wf.Labels[labelName]
 		if exists {
+			requirements, err := labels.ParseToRequirements(labelName + "=" + labelValue)
+			if err != nil {
+				return defaultEstimator, fmt.Errorf("failed to parse selector to requirements: %v", err)
+			}
+			archived, err := f.archivedTemplates(ctx, wf.Namespace, requirements)
+			if err != nil {
+				return defaultEstimator, fmt.Errorf("failed to list archived workflows for estimator: %v", err)
+			}
+			defaultEstimator.templateDurations = archived.durations
+			defaultEstimator.templateUsages = archived.usages
 			objs, err := f.wfInformer.GetIndexer().ByIndex(indexName, indexes.MetaNamespaceLabelIndex(wf.Namespace, labelValue))
 			if err != nil {
 				return defaultEstimator, fmt.Errorf("failed to list workflows by index: %v", err)
@@ -77,19 +111,39 @@ func (f *estimatorFactory) NewEstimator(ctx context.Context, wf *wfv1.Workflow)
 				if err != nil {
 					return defaultEstimator, fmt.Errorf("failed hydrate last workflow: %w", err)
 				}
-				return &estimator{wf, newestWf}, nil
+				return &estimator{wf: wf, baselineWF: newestWf, templateDurations: archived.durations, templateUsages: archived.usages}, nil
 			}
 			// we failed to find a base-line in the live set, so we now look in the archive
-			requirements, err := labels.ParseToRequirements(labelName + "=" + labelValue)
-			if err != nil {
-				return defaultEstimator, fmt.Errorf("failed to parse selector to requirements: %v", err)
-			}
 			baselineWF, err := f.wfArchive.GetWorkflowForEstimator(ctx, wf.Namespace, requirements)
 			if err != nil {
 				return defaultEstimator, fmt.Errorf("failed to get archived workflow for estimator: %v", err)
 			}
-			return &estimator{wf, baselineWF}, nil
+			return &estimator{wf: wf, baselineWF: baselineWF, templateDurations: archived.durations, templateUsages: archived.usages}, nil
 		}
 	}
 	return defaultEstimator, nil
 }
+
+// archivedTemplates returns the durations and resource usage of the templates of the most recent archived workflows
+func (f *estimatorFactory) archivedTemplates(ctx context.Context, namespace string, requirements []labels.Requirement) (cachedArchivedTemplates, error) {
+	if !f.wfArchive.IsEnabled() || f.sampleSize <= 0 {
+		return cachedArchivedTemplates{}, nil
+	}
+	key := namespace + "/" + labels.NewSelector().Add(requirements...).String()
+	f.archivedLock.Lock()
+	defer f.archivedLock.Unlock()
+	if cached, ok := f.archived[key]; ok && time.Now().Before(cached.expiresAt) {
+		return cached, nil
+	}
+	wfs, err := f.wfArchive.ListWorkflowsForEstimator(ctx, namespace, requirements, f.sampleSize)
+	if err != nil {
+		return cachedArchivedTemplates{}, err
+	}
+	archived := cachedArchivedTemplates{
+		durations: newTemplateDurations(wfs),
+		usages:    newTemplateUsages(wfs),
+		expiresAt: time.Now().Add(archivedTemplatesTTL),
+	}
+	f.archived[key] = archived
+	return archived, nil
+}
@@ -60,6 +60,22 @@ metadata:
 	wfArchive.On("GetWorkflowForEstimator", mock.Anything, "my-ns", r).Return(testutil.MustUnmarshalWorkflow(`
 metadata:
   name: my-archived-wftmpl-baseline`), nil)
+	wfArchive.On("IsEnabled").Return(true)
+	wfArchive.On("ListWorkflowsForEstimator", mock.Anything, "my-ns", r, 20).Return(wfv1.Workflows{
+		*testutil.MustUnmarshalWorkflow(`
+metadata:
+  name: my-archived-wftmpl-baseline
+status:
+  nodes:
+    my-archived-wftmpl-baseline:
+      type: Pod
+      templateName: main
+      phase: Succeeded
+      startedAt: "2025-01-01T00:00:00Z"
+      finishedAt: "2025-01-01T00:01:00Z"
+`),
+	}, nil)
+	wfArchive.On("ListWorkflowsForEstimator", mock.Anything, "my-ns", mock.Anything, 20).Return(wfv1.Workflows{}, nil)
 	f := NewEstimatorFactory(ctx, informer, hydratorfake.Always, wfArchive)
 	t.Run("None", func(t *testing.T) {
 		p, err := f.NewEstimator(ctx, &wfv1.Workflow{})
@@ -111,5 +127,6 @@ metadata:
 		require.NotNil(t, e)
 		require.NotNil(t, e.baselineWF)
 		assert.Equal(t, "my-archived-wftmpl-baseline", e.baselineWF.Name)
+		assert.Equal(t, wfv1.EstimatedDuration(60), e.EstimateNodeDuration(ctx, "my-node", "main", nil))
 	})
 }
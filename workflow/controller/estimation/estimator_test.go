@@ -16,7 +16,7 @@ func Test_estimator(t *testing.T) {
 	a := metav1.Time{}
 	b := metav1.Time{Time: time.Time{}.Add(time.Second)}
 	p := &estimator{
-		&wfv1.Workflow{
+		wf: &wfv1.Workflow{
 			ObjectMeta: metav1.ObjectMeta{Name: "my-wf"},
 			Status: wfv1.WorkflowStatus{
 				Nodes: map[string]wfv1.NodeStatus{
@@ -26,7 +26,7 @@ func Test_estimator(t *testing.T) {
 				},
 			},
 		},
-		&wfv1.Workflow{
+		baselineWF: &wfv1.Workflow{
 			ObjectMeta: metav1.ObjectMeta{Name: "my-baseline"},
 			Status: wfv1.WorkflowStatus{
 				StartedAt:  a,
@@ -40,6 +40,27 @@ func Test_estimator(t *testing.T) {
 		},
 	}
 	assert.Equal(t, wfv1.EstimatedDuration(1), p.EstimateWorkflowDuration())
-	assert.Equal(t, wfv1.EstimatedDuration(1), p.EstimateNodeDuration(ctx, "my-wf"))
-	assert.Equal(t, wfv1.EstimatedDuration(1), p.EstimateNodeDuration(ctx, "1"))
+	assert.Equal(t, wfv1.EstimatedDuration(1), p.EstimateNodeDuration(ctx, "my-wf", "", nil))
+	assert.Equal(t, wfv1.EstimatedDuration(1), p.EstimateNodeDuration(ctx, "1", "", nil))
+}
+
+func Test_estimatorTemplateAverage(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	start := metav1.Time{}
+	finishedAfter := func(d time.Duration) metav1.Time { return metav1.Time{Time: start.Add(d)} }
+	p := &estimator{
+		wf: &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf"}},
+		baselineWF: &wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-baseline"},
+			Status: wfv1.WorkflowStatus{
+				Nodes: map[string]wfv1.NodeStatus{
+					"my-baseline-1": {TemplateName: "train", Phase: wfv1.NodeSucceeded, StartedAt: start, FinishedAt: finishedAfter(10 * time.Second)},
+					"my-baseline-2": {TemplateName: "train", Phase: wfv1.NodeSucceeded, StartedAt: start, FinishedAt: finishedAfter(20 * time.Second)},
+					"my-baseline-3": {TemplateName: "train", Phase: wfv1.NodeFailed, StartedAt: start, FinishedAt: finishedAfter(time.Second)},
+				},
+			},
+		},
+	}
+	assert.Equal(t, wfv1.EstimatedDuration(15), p.EstimateNodeDuration(ctx, "my-wf(0)", "train", nil), "average of the succeeded nodes of the template")
+	assert.Equal(t, wfv1.EstimatedDuration(0), p.EstimateNodeDuration(ctx, "my-wf(0)", "setup", nil), "no nodes of the template")
 }
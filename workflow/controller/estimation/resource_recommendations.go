@@ -0,0 +1,91 @@
+package estimation
+
+import (
+	"math"
+	"slices"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+const (
+	// requestPercentile is the percentile of the peak usage that is requested, so the odd outlier does not
+	// over-provision every run
+	requestPercentile = 90
+	// memoryLimitHeadroom is how much more memory than the largest peak usage the limit allows, as a container is
+	// killed when it exceeds its memory limit
+	memoryLimitHeadroom = 1.25
+	// mebibyte is what memory is rounded up to
+	mebibyte = 1024 * 1024
+)
+
+// templateUsages are the peak resource usages of the nodes of each template, keyed by the template
+type templateUsages map[string][]apiv1.ResourceList
+
+func newTemplateUsages(wfs wfv1.Workflows) templateUsages {
+	usages := templateUsages{}
+	for _, wf := range wfs {
+		usages.add(wf.Status.Nodes)
+	}
+	return usages
+}
+
+// add adds the peak usage of the pods of the nodes, whatever their phase, as a pod that failed from running out of
+// memory is the one that most needs a larger request
+func (u templateUsages) add(nodes wfv1.Nodes) {
+	for _, node := range nodes {
+		if node.Type != wfv1.NodeTypePod || len(node.PeakResourceUsage) == 0 {
+			continue
+		}
+		key := templateKey(node.TemplateName, node.TemplateRef)
+		if key == "" {
+			continue
+		}
+		u[key] = append(u[key], node.PeakResourceUsage)
+	}
+}
+
+// recommendResources recommends the requests and limits of each template from the peak usage of the nodes and of
+// the archived workflows
+func recommendResources(nodes wfv1.Nodes, archived templateUsages) map[string]wfv1.ResourceRecommendation {
+	usages := templateUsages{}
+	for key, u := range archived {
+		usages[key] = slices.Clone(u)
+	}
+	usages.add(nodes)
+	if len(usages) == 0 {
+		return nil
+	}
+	recommendations := map[string]wfv1.ResourceRecommendation{}
+	for key, u := range usages {
+		recommendation := wfv1.ResourceRecommendation{Requests: apiv1.ResourceList{}, Limits: apiv1.ResourceList{}, Samples: int64(len(u))}
+		if cpu := sortedUsage(u, apiv1.ResourceCPU, (*resource.Quantity).MilliValue); len(cpu) > 0 {
+			recommendation.Requests[apiv1.ResourceCPU] = *resource.NewMilliQuantity(max(1, cpu[nearestRank(len(cpu), requestPercentile)]), resource.DecimalSI)
+		}
+		if memory := sortedUsage(u, apiv1.ResourceMemory, (*resource.Quantity).Value); len(memory) > 0 {
+			recommendation.Requests[apiv1.ResourceMemory] = mebibytes(float64(memory[nearestRank(len(memory), requestPercentile)]))
+			recommendation.Limits[apiv1.ResourceMemory] = mebibytes(float64(memory[len(memory)-1]) * memoryLimitHeadroom)
+		}
+		recommendations[key] = recommendation
+	}
+	return recommendations
+}
+
+// sortedUsage returns the sorted usage of the resource, in the units returned by value
+func sortedUsage(usages []apiv1.ResourceList, name apiv1.ResourceName, value func(*resource.Quantity) int64) []int64 {
+	var values []int64
+	for _, usage := range usages {
+		if q, ok := usage[name]; ok {
+			values = append(values, value(&q))
+		}
+	}
+	slices.Sort(values)
+	return values
+}
+
+// mebibytes rounds the bytes up to a whole number of mebibytes, so recommendations read like hand-written requests
+func mebibytes(bytes float64) resource.Quantity {
+	return *resource.NewQuantity(max(1, int64(math.Ceil(bytes/mebibyte)))*mebibyte, resource.BinarySI)
+}
@@ -0,0 +1,48 @@
+package estimation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func Test_recommendResources(t *testing.T) {
+	usage := func(cpu, memory string) apiv1.ResourceList {
+		return apiv1.ResourceList{apiv1.ResourceCPU: resource.MustParse(cpu), apiv1.ResourceMemory: resource.MustParse(memory)}
+	}
+	ref := &wfv1.TemplateRef{Name: "my-wftmpl", Template: "train"}
+	archived := newTemplateUsages(wfv1.Workflows{
+		{Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+			"train": {Type: wfv1.NodeTypePod, TemplateRef: ref, Phase: wfv1.NodeSucceeded, PeakResourceUsage: usage("1", "1Gi")},
+			"dag":   {Type: wfv1.NodeTypeDAG, TemplateName: "main", Phase: wfv1.NodeSucceeded},
+		}}},
+		{Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+			"train": {Type: wfv1.NodeTypePod, TemplateRef: ref, Phase: wfv1.NodeFailed, PeakResourceUsage: usage("2", "2Gi")},
+		}}},
+	})
+	nodes := wfv1.Nodes{
+		"train": {Type: wfv1.NodeTypePod, TemplateRef: ref, Phase: wfv1.NodeSucceeded, PeakResourceUsage: usage("1500m", "1000Mi")},
+		"setup": {Type: wfv1.NodeTypePod, TemplateName: "setup", Phase: wfv1.NodeSucceeded, PeakResourceUsage: apiv1.ResourceList{apiv1.ResourceMemory: resource.MustParse("100000")}},
+		"other": {Type: wfv1.NodeTypePod, TemplateName: "other", Phase: wfv1.NodeSucceeded},
+	}
+
+	recommendations := recommendResources(nodes, archived)
+
+	assert.Len(t, recommendations, 2, "only templates with usage")
+	train := recommendations["my-wftmpl/train"]
+	assert.Equal(t, int64(3), train.Samples)
+	assert.Equal(t, "2", train.Requests.Cpu().String(), "90th percentile of 3 samples is the largest")
+	assert.Equal(t, "2Gi", train.Requests.Memory().String())
+	assert.Equal(t, "2560Mi", train.Limits.Memory().String(), "largest with headroom")
+	assert.NotContains(t, train.Limits, apiv1.ResourceCPU, "no CPU limit")
+	setup := recommendations["setup"]
+	assert.NotContains(t, setup.Requests, apiv1.ResourceCPU)
+	assert.Equal(t, "1Mi", setup.Requests.Memory().String(), "rounded up to mebibytes")
+	assert.Len(t, archived["my-wftmpl/train"], 2, "archived usage is not modified")
+
+	assert.Nil(t, recommendResources(wfv1.Nodes{"other": nodes["other"]}, nil))
+}
@@ -0,0 +1,65 @@
+package estimation
+
+import (
+	"slices"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// templateDurations are the durations of the succeeded nodes of each template of previous workflows, sorted, keyed by
+// the template
+type templateDurations map[string][]time.Duration
+
+// templateKey returns the key of the template of a node, its template reference if it has one, otherwise its name
+func templateKey(templateName string, templateRef *wfv1.TemplateRef) string {
+	if templateRef != nil {
+		key := templateRef.Name + "/" + templateRef.Template
+		if templateRef.ClusterScope {
+			key = "cluster/" + key
+		}
+		return key
+	}
+	return templateName
+}
+
+func newTemplateDurations(wfs wfv1.Workflows) templateDurations {
+	durations := templateDurations{}
+	for _, wf := range wfs {
+		for _, node := range wf.Status.Nodes {
+			if node.Phase != wfv1.NodeSucceeded || node.StartedAt.IsZero() || node.FinishedAt.IsZero() {
+				continue
+			}
+			// only pods and the like do work, the duration of steps and DAGs depends on their children
+			switch node.Type {
+			case wfv1.NodeTypePod, wfv1.NodeTypeContainer, wfv1.NodeTypeHTTP, wfv1.NodeTypePlugin:
+			default:
+				continue
+			}
+			key := templateKey(node.TemplateName, node.TemplateRef)
+			if key == "" {
+				continue
+			}
+			durations[key] = append(durations[key], node.GetDuration())
+		}
+	}
+	for _, d := range durations {
+		slices.Sort(d)
+	}
+	return durations
+}
+
+// percentile returns the p-th percentile of the durations of the template by the nearest-rank method, or zero if there
+// are none
+func (d templateDurations) percentile(templateName string, templateRef *wfv1.TemplateRef, p int) wfv1.EstimatedDuration {
+	durations := d[templateKey(templateName, templateRef)]
+	if len(durations) == 0 {
+		return 0
+	}
+	return wfv1.NewEstimatedDuration(durations[nearestRank(len(durations), p)])
+}
+
+// nearestRank returns the smallest index of n sorted values with at least p percent of the values at or below it
+func nearestRank(n, p int) int {
+	return max(0, min((p*n+99)/100-1, n-1))
+}
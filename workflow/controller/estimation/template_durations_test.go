@@ -0,0 +1,36 @@
+package estimation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func Test_templateDurations(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	node := func(templateName string, templateRef *wfv1.TemplateRef, phase wfv1.NodePhase, d time.Duration) wfv1.NodeStatus {
+		return wfv1.NodeStatus{Type: wfv1.NodeTypePod, TemplateName: templateName, TemplateRef: templateRef, Phase: phase, StartedAt: metav1.NewTime(start), FinishedAt: metav1.NewTime(start.Add(d))}
+	}
+	ref := &wfv1.TemplateRef{Name: "my-wftmpl", Template: "train"}
+	var wfs wfv1.Workflows
+	for i := 1; i <= 10; i++ {
+		wfs = append(wfs, wfv1.Workflow{Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+			"train": node("", ref, wfv1.NodeSucceeded, time.Duration(i)*time.Minute),
+			"setup": node("setup", nil, wfv1.NodeSucceeded, time.Second),
+			"fail":  node("setup", nil, wfv1.NodeFailed, time.Hour),
+			"dag":   {Type: wfv1.NodeTypeDAG, TemplateName: "main", Phase: wfv1.NodeSucceeded, StartedAt: metav1.NewTime(start), FinishedAt: metav1.NewTime(start.Add(time.Hour))},
+		}}})
+	}
+	d := newTemplateDurations(wfs)
+
+	assert.Equal(t, wfv1.EstimatedDuration(300), d.percentile("", ref, 50), "median by template reference")
+	assert.Equal(t, wfv1.EstimatedDuration(540), d.percentile("", ref, 90))
+	assert.Equal(t, wfv1.EstimatedDuration(0), d.percentile("", &wfv1.TemplateRef{Name: "my-wftmpl", Template: "train", ClusterScope: true}, 50), "cluster templates are distinct")
+	assert.Equal(t, wfv1.EstimatedDuration(1), d.percentile("setup", nil, 90), "failed nodes are ignored")
+	assert.Equal(t, wfv1.EstimatedDuration(0), d.percentile("main", nil, 50), "DAGs are ignored")
+	assert.Equal(t, wfv1.EstimatedDuration(0), templateDurations(nil).percentile("setup", nil, 50))
+}
@@ -46,12 +46,19 @@ func (woc *wfOperationCtx) applyExecutionControl(ctx context.Context, pod *apiv1
 				woc.log.WithField("podName", pod.Name).
 					WithField("shutdownStrategy", woc.GetShutdownStrategy()).
 					Info(ctx, "Terminating pod as part of workflow shutdown")
-				woc.controller.PodController.TerminateContainers(ctx, pod.Namespace, pod.Name)
+				woc.terminatePod(ctx, pod)
 				msg := fmt.Sprintf("workflow shutdown with strategy:  %s", woc.GetShutdownStrategy())
 				woc.handleExecutionControlError(ctx, nodeID, wfNodesLock, msg)
 				return
 			}
 		}
+		// Check if the node is in a subtree that was stopped
+		if woc.stoppedNodes[nodeID] {
+			woc.log.WithField("podName", pod.Name).Info(ctx, "Terminating pod as its subtree was stopped")
+			woc.terminatePod(ctx, pod)
+			woc.handleExecutionControlError(ctx, nodeID, wfNodesLock, woc.nodeStoppedMessage())
+			return
+		}
 		// Check if we are past the workflow deadline. If we are, and the pod is still pending
 		// then we should simply delete it and mark the pod as Failed
 		if woc.workflowDeadline != nil && time.Now().UTC().After(*woc.workflowDeadline) {
@@ -71,7 +78,7 @@ func (woc *wfOperationCtx) applyExecutionControl(ctx context.Context, pod *apiv1
 		if _, onExitPod := pod.Labels[common.LabelKeyOnExit]; !woc.GetShutdownStrategy().ShouldExecute(onExitPod) {
 			woc.log.WithField("podName", pod.Name).
 				Info(ctx, "Terminating on-exit pod")
-			woc.controller.PodController.TerminateContainers(ctx, pod.Namespace, pod.Name)
+			woc.terminatePod(ctx, pod)
 		}
 	}
 }
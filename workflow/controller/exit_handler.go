@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/expr/argoexpr"
@@ -57,11 +58,11 @@ func (woc *wfOperationCtx) resolveExitTmplArgument(ctx context.Context, args wfv
 	}
 	if outputs != nil {
 		for _, param := range outputs.Parameters {
-			value := ""
-			if param.Value != nil {
-				value = param.Value.String()
+			key := fmt.Sprintf("%s.outputs.parameters.%s", prefix, param.Name)
+			scope.addParamToScope(key, param.GetOutputValue())
+			if param.Optional {
+				scope.addParamToScope(key+".supplied", strconv.FormatBool(param.IsSupplied()))
 			}
-			scope.addParamToScope(fmt.Sprintf("%s.outputs.parameters.%s", prefix, param.Name), value)
 		}
 		for _, arts := range outputs.Artifacts {
 			scope.addArtifactToScope(fmt.Sprintf("%s.outputs.artifacts.%s", prefix, arts.Name), arts)
@@ -0,0 +1,161 @@
+// Package expand expands the steps and tasks with withItems, withParam and withSequence into a step or task per item.
+package expand
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Knetic/govaluate"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util"
+	"github.com/argoproj/argo-workflows/v3/util/template"
+)
+
+// Items returns the items to expand a step or task into, from its withItems, withParam or withSequence. It returns no
+// items if the step or task is not expanded.
+// We want to be lazy with expanding. Unfortunately this is not quite possible as the When field might rely on
+// expansion to work with the ShouldExecute function. To address this we apply a trick, we try to expand, if we fail, we then
+// check ShouldExecute, if ShouldExecute returns false, we continue on as normal else error out
+func Items(withItems []wfv1.Item, withParam string, withSequence *wfv1.Sequence, when string) ([]wfv1.Item, error) {
+	var err error
+	var items []wfv1.Item
+	if len(withItems) > 0 {
+		items = withItems
+	} else if withParam != "" {
+		err = json.Unmarshal([]byte(withParam), &items)
+		if err != nil {
+			mustExec, mustExecErr := ShouldExecute(when)
+			if mustExecErr != nil || mustExec {
+				return nil, errors.Errorf(errors.CodeBadRequest, "withParam value could not be parsed as a JSON list: %s: %v", strings.TrimSpace(withParam), err)
+			}
+		}
+	} else if withSequence != nil {
+		items, err = Sequence(withSequence)
+		if err != nil {
+			mustExec, mustExecErr := ShouldExecute(when)
+			if mustExecErr != nil || mustExec {
+				return nil, err
+			}
+		}
+	}
+	return items, nil
+}
+
+// Item substitutes the item into the template of a step or task, unmarshalling the result into obj, and returns the
+// name of the expanded step or task
+func Item(ctx context.Context, tmpl template.Template, name string, index int, item wfv1.Item, obj interface{}, whenCondition string) (string, error) {
+	// If when is not parameterised and evaluated to false, we are not executing nor resolving artifact,
+	// we allow parameter substitution to be Unresolved
+	// The parameterised when will get handle by the task-expansion
+	proceed, err := ShouldExecute(whenCondition)
+	return SubstituteItem(ctx, tmpl, name, index, item, obj, err == nil && !proceed)
+}
+
+// SubstituteItem is Item, substituting the item whether or not the step or task executes, leaving the other variables
+// unresolved if allowUnresolved
+func SubstituteItem(ctx context.Context, tmpl template.Template, name string, index int, item wfv1.Item, obj interface{}, allowUnresolved bool) (string, error) {
+	replaceMap := make(map[string]interface{})
+	var newName string
+
+	switch item.GetType() {
+	case wfv1.String, wfv1.Number, wfv1.Bool:
+		replaceMap["item"] = fmt.Sprintf("%v", item)
+		newName = generateNodeName(name, index, item)
+	case wfv1.Map:
+		// Handle the case when withItems is a list of maps.
+		// vals holds stringified versions of the map items which are incorporated as part of the step name.
+		// For example if the item is: {"name": "jesse","group":"developer"}
+		// the vals would be: ["name:jesse", "group:developer"]
+		// This would eventually be part of the step name (group:developer,name:jesse)
+		vals := make([]string, 0)
+		mapVal := item.GetMapVal()
+		for itemKey, itemVal := range mapVal {
+			replaceMap[fmt.Sprintf("item.%s", itemKey)] = fmt.Sprintf("%v", itemVal)
+			vals = append(vals, fmt.Sprintf("%s:%v", itemKey, itemVal))
+
+		}
+		jsonByteVal, err := json.Marshal(mapVal)
+		if err != nil {
+			return "", errors.InternalWrapError(err)
+		}
+		replaceMap["item"] = string(jsonByteVal)
+
+		// sort the values so that the name is deterministic
+		sort.Strings(vals)
+		newName = generateNodeName(name, index, strings.Join(vals, ","))
+	case wfv1.List:
+		listVal := item.GetListVal()
+		byteVal, err := json.Marshal(listVal)
+		if err != nil {
+			return "", errors.InternalWrapError(err)
+		}
+		replaceMap["item"] = string(byteVal)
+		newName = generateNodeName(name, index, listVal)
+	default:
+		return "", errors.Errorf(errors.CodeBadRequest, "withItems[%d] expected string, number, list, or map. received: %v", index, item)
+	}
+	newStepStr, err := tmpl.Replace(ctx, replaceMap, allowUnresolved)
+	if err != nil {
+		return "", err
+	}
+	err = json.Unmarshal([]byte(newStepStr), &obj)
+	if err != nil {
+		return "", errors.InternalWrapError(err)
+	}
+	return newName, nil
+}
+
+func generateNodeName(name string, index int, desc interface{}) string {
+	// Do not display parentheses in node name. Nodes are still guaranteed to be unique due to the index number
+	replacer := strings.NewReplacer("(", "", ")", "")
+	cleanName := replacer.Replace(fmt.Sprint(desc))
+	newName := fmt.Sprintf("%s(%d:%v)", name, index, cleanName)
+	if out := util.RecoverIndexFromNodeName(newName); out != index {
+		panic(fmt.Sprintf("unrecoverable digit in generateName; wanted '%d' and got '%d'", index, out))
+	}
+	return newName
+}
+
+// ShouldExecute evaluates a already substituted when expression to decide whether or not a step should execute
+func ShouldExecute(when string) (bool, error) {
+	if when == "" {
+		return true, nil
+	}
+	expression, err := govaluate.NewEvaluableExpression(when)
+	if err != nil {
+		if strings.Contains(err.Error(), "Invalid token") {
+			return false, errors.Errorf(errors.CodeBadRequest, "Invalid 'when' expression '%s': %v (hint: try wrapping the affected expression in quotes (\"))", when, err)
+		}
+		return false, errors.Errorf(errors.CodeBadRequest, "Invalid 'when' expression '%s': %v", when, err)
+	}
+	// The following loop converts govaluate variables (which we don't use), into strings. This
+	// allows us to have expressions like: "foo != bar" without requiring foo and bar to be quoted.
+	tokens := expression.Tokens()
+	for i, tok := range tokens {
+		switch tok.Kind {
+		case govaluate.VARIABLE:
+			tok.Kind = govaluate.STRING
+		default:
+			continue
+		}
+		tokens[i] = tok
+	}
+	expression, err = govaluate.NewEvaluableExpressionFromTokens(tokens)
+	if err != nil {
+		return false, errors.InternalWrapErrorf(err, "Failed to parse 'when' expression '%s': %v", when, err)
+	}
+	result, err := expression.Evaluate(nil)
+	if err != nil {
+		return false, errors.InternalWrapErrorf(err, "Failed to evaluate 'when' expresion '%s': %v", when, err)
+	}
+	boolRes, ok := result.(bool)
+	if !ok {
+		return false, errors.Errorf(errors.CodeBadRequest, "Expected boolean evaluation for '%s'. Got %v", when, result)
+	}
+	return boolRes, nil
+}
@@ -0,0 +1,41 @@
+package expand
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/template"
+)
+
+func TestGenerateNodeName(t *testing.T) {
+	assert.Equal(t, "sleep(10:ten)", generateNodeName("sleep", 10, "ten"))
+	item, err := wfv1.ParseItem(`[{"foo": "bar"}]`)
+	require.NoError(t, err)
+	assert.Equal(t, `sleep(10:[{"foo":"bar"}])`, generateNodeName("sleep", 10, item))
+	require.NoError(t, err)
+	item, err = wfv1.ParseItem("[10]")
+	require.NoError(t, err)
+	assert.Equal(t, `sleep(10:[10])`, generateNodeName("sleep", 10, item))
+}
+
+func Test_Item(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	task := wfv1.DAGTask{
+		WithParam: `[{"number": 2, "string": "foo", "list": [0, "1"], "json": {"number": 2, "string": "foo", "list": [0, "1"]}}]`,
+	}
+	taskBytes, err := json.Marshal(task)
+	require.NoError(t, err)
+	var items []wfv1.Item
+	wfv1.MustUnmarshal([]byte(task.WithParam), &items)
+
+	var newTask wfv1.DAGTask
+	tmpl, _ := template.NewTemplate(string(taskBytes))
+	newTaskName, err := Item(ctx, tmpl, "task-name", 0, items[0], &newTask, "")
+	require.NoError(t, err)
+	assert.Equal(t, `task-name(0:json:{"list":[0,"1"],"number":2,"string":"foo"},list:[0,"1"],number:2,string:foo)`, newTaskName)
+}
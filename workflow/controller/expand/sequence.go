@@ -0,0 +1,139 @@
+package expand
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// Sequence returns the items of a withSequence, which are numbers, or dates if it starts at one
+func Sequence(seq *wfv1.Sequence) ([]wfv1.Item, error) {
+	if seq.Start != nil {
+		if start, layout, ok := parseSequenceDate(seq.Start.String()); ok {
+			return expandDateSequence(seq, start, layout)
+		}
+	}
+	var start, end int
+	var err error
+	if seq.Start != nil {
+		start, err = strconv.Atoi(seq.Start.String())
+		if err != nil {
+			return nil, err
+		}
+	}
+	step := 1
+	if seq.Step != "" {
+		step, err = strconv.Atoi(seq.Step)
+		if err != nil {
+			return nil, err
+		}
+		if step <= 0 {
+			return nil, errors.Errorf(errors.CodeBadRequest, "withSequence step %d must be positive", step)
+		}
+	}
+	if seq.End != nil {
+		end, err = strconv.Atoi(seq.End.String())
+		if err != nil {
+			return nil, err
+		}
+	} else if seq.Count != nil {
+		count, err := strconv.Atoi(seq.Count.String())
+		if err != nil {
+			return nil, err
+		}
+		if count == 0 {
+			return []wfv1.Item{}, nil
+		}
+		end = start + (count-1)*step
+	} else {
+		return nil, errors.InternalError("neither end nor count was specified in withSequence")
+	}
+	items := make([]wfv1.Item, 0)
+	format := "%d"
+	if seq.Format != "" {
+		format = seq.Format
+	}
+	if start <= end {
+		for i := start; i <= end; i += step {
+			item, err := wfv1.ParseItem(`"` + fmt.Sprintf(format, i) + `"`)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+	} else {
+		for i := start; i >= end; i -= step {
+			item, err := wfv1.ParseItem(`"` + fmt.Sprintf(format, i) + `"`)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+// sequenceDateLayouts are the layouts of the dates a sequence can start and end at
+var sequenceDateLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02"}
+
+func parseSequenceDate(value string) (time.Time, string, bool) {
+	for _, layout := range sequenceDateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, layout, true
+		}
+	}
+	return time.Time{}, "", false
+}
+
+// expandDateSequence expands a sequence of dates, which are formatted with the layout of its start unless it has a format
+func expandDateSequence(seq *wfv1.Sequence, start time.Time, layout string) ([]wfv1.Item, error) {
+	step := 24 * time.Hour
+	if seq.Step != "" {
+		var err error
+		step, err = wfv1.ParseStringToDuration(seq.Step)
+		if err != nil {
+			return nil, err
+		}
+		if step <= 0 {
+			return nil, errors.Errorf(errors.CodeBadRequest, "withSequence step %s must be positive", seq.Step)
+		}
+	}
+	var end time.Time
+	if seq.End != nil {
+		var ok bool
+		end, _, ok = parseSequenceDate(seq.End.String())
+		if !ok {
+			return nil, errors.Errorf(errors.CodeBadRequest, "withSequence end %s is not a date", seq.End.String())
+		}
+	} else if seq.Count != nil {
+		count, err := strconv.Atoi(seq.Count.String())
+		if err != nil {
+			return nil, err
+		}
+		if count == 0 {
+			return []wfv1.Item{}, nil
+		}
+		end = start.Add(time.Duration(count-1) * step)
+	} else {
+		return nil, errors.InternalError("neither end nor count was specified in withSequence")
+	}
+	format := layout
+	if seq.Format != "" {
+		format = seq.Format
+	}
+	if end.Before(start) {
+		step = -step
+	}
+	items := make([]wfv1.Item, 0)
+	for t := start; (step > 0 && !t.After(end)) || (step < 0 && !t.Before(end)); t = t.Add(step) {
+		item, err := wfv1.ParseItem(`"` + t.Format(format) + `"`)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
@@ -0,0 +1,133 @@
+package expand
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	intstrutil "github.com/argoproj/argo-workflows/v3/util/intstr"
+)
+
+func itemStrVals(items []wfv1.Item) []string {
+	var vals []string
+	for _, item := range items {
+		vals = append(vals, item.GetStrVal())
+	}
+	return vals
+}
+
+func TestSequence(t *testing.T) {
+	var seq wfv1.Sequence
+	var items []wfv1.Item
+	var err error
+
+	seq = wfv1.Sequence{
+		Count: intstrutil.ParsePtr("10"),
+	}
+	items, err = Sequence(&seq)
+	require.NoError(t, err)
+	assert.Len(t, items, 10)
+	assert.Equal(t, "0", items[0].GetStrVal())
+	assert.Equal(t, "9", items[9].GetStrVal())
+
+	seq = wfv1.Sequence{
+		Start: intstrutil.ParsePtr("101"),
+		Count: intstrutil.ParsePtr("10"),
+	}
+	items, err = Sequence(&seq)
+	require.NoError(t, err)
+	assert.Len(t, items, 10)
+	assert.Equal(t, "101", items[0].GetStrVal())
+	assert.Equal(t, "110", items[9].GetStrVal())
+
+	seq = wfv1.Sequence{
+		Start: intstrutil.ParsePtr("50"),
+		End:   intstrutil.ParsePtr("60"),
+	}
+	items, err = Sequence(&seq)
+	require.NoError(t, err)
+	assert.Len(t, items, 11)
+	assert.Equal(t, "50", items[0].GetStrVal())
+	assert.Equal(t, "60", items[10].GetStrVal())
+
+	seq = wfv1.Sequence{
+		Start: intstrutil.ParsePtr("60"),
+		End:   intstrutil.ParsePtr("50"),
+	}
+	items, err = Sequence(&seq)
+	require.NoError(t, err)
+	assert.Len(t, items, 11)
+	assert.Equal(t, "60", items[0].GetStrVal())
+	assert.Equal(t, "50", items[10].GetStrVal())
+
+	seq = wfv1.Sequence{
+		Count: intstrutil.ParsePtr("0"),
+	}
+	items, err = Sequence(&seq)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+
+	seq = wfv1.Sequence{
+		Start: intstrutil.ParsePtr("8"),
+		End:   intstrutil.ParsePtr("8"),
+	}
+	items, err = Sequence(&seq)
+	require.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "8", items[0].GetStrVal())
+
+	seq = wfv1.Sequence{
+		Format: "testuser%02X",
+		Count:  intstrutil.ParsePtr("10"),
+		Start:  intstrutil.ParsePtr("1"),
+	}
+	items, err = Sequence(&seq)
+	require.NoError(t, err)
+	assert.Len(t, items, 10)
+	assert.Equal(t, "testuser01", items[0].GetStrVal())
+	assert.Equal(t, "testuser0A", items[9].GetStrVal())
+
+	seq = wfv1.Sequence{
+		Start: intstrutil.ParsePtr("0"),
+		End:   intstrutil.ParsePtr("10"),
+		Step:  "5",
+	}
+	items, err = Sequence(&seq)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0", "5", "10"}, itemStrVals(items))
+
+	seq = wfv1.Sequence{
+		Start: intstrutil.ParsePtr("2024-02-27"),
+		End:   intstrutil.ParsePtr("2024-03-01"),
+	}
+	items, err = Sequence(&seq)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2024-02-27", "2024-02-28", "2024-02-29", "2024-03-01"}, itemStrVals(items))
+
+	seq = wfv1.Sequence{
+		Start:  intstrutil.ParsePtr("2024-01-01T00:00:00Z"),
+		Count:  intstrutil.ParsePtr("3"),
+		Step:   "12h",
+		Format: "20060102-15",
+	}
+	items, err = Sequence(&seq)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"20240101-00", "20240101-12", "20240102-00"}, itemStrVals(items))
+
+	seq = wfv1.Sequence{
+		Start: intstrutil.ParsePtr("2024-01-03"),
+		End:   intstrutil.ParsePtr("2024-01-01"),
+	}
+	items, err = Sequence(&seq)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2024-01-03", "2024-01-02", "2024-01-01"}, itemStrVals(items))
+
+	seq = wfv1.Sequence{
+		Start: intstrutil.ParsePtr("2024-01-01"),
+		End:   intstrutil.ParsePtr("10"),
+	}
+	_, err = Sequence(&seq)
+	require.EqualError(t, err, "withSequence end 10 is not a date")
+}
@@ -1,4 +1,4 @@
-package controller
+package expand
 
 import (
 	"testing"
@@ -25,7 +25,7 @@ func TestShouldExecute(t *testing.T) {
 		"true == true",
 	}
 	for _, trueExp := range trueExpressions {
-		res, err := shouldExecute(trueExp)
+		res, err := ShouldExecute(trueExp)
 		require.NoError(t, err)
 		assert.True(t, res)
 	}
@@ -47,7 +47,7 @@ func TestShouldExecute(t *testing.T) {
 		"false == true",
 	}
 	for _, falseExp := range falseExpressions {
-		res, err := shouldExecute(falseExp)
+		res, err := ShouldExecute(falseExp)
 		require.NoError(t, err)
 		assert.False(t, res)
 	}
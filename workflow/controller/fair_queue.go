@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"sync"
+
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+// namespaceFairQueue stores the keys of the workflow queue so that workers are shared fairly between namespaces, by
+// weighted fair queuing. Each namespace has its own FIFO of keys, and the next key is popped from the namespace that
+// has been served least relative to its weight, so a namespace that queues many workflows only delays its own.
+type namespaceFairQueue struct {
+	mutex      sync.Mutex
+	fairness   config.QueueFairness
+	namespaces map[string]*namespaceQueue
+	// virtualTime is the virtual time at which the last popped key finished being served. A namespace that starts
+	// queueing keys starts at it, so that it cannot build up credit while it has none queued.
+	virtualTime float64
+	len         int
+}
+
+// namespaceQueue is the FIFO of keys of a namespace
+type namespaceQueue struct {
+	keys []string
+	// finish is the virtual time at which the last popped key of the namespace finished being served
+	finish float64
+}
+
+var _ workqueue.Queue[string] = &namespaceFairQueue{}
+
+func newNamespaceFairQueue(fairness config.QueueFairness) *namespaceFairQueue {
+	return &namespaceFairQueue{fairness: fairness, namespaces: map[string]*namespaceQueue{}}
+}
+
+// setFairness updates the weights of namespaces, it applies to keys popped after it
+func (q *namespaceFairQueue) setFairness(fairness config.QueueFairness) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.fairness = fairness
+}
+
+// Touch does nothing, a key that is added again keeps its place
+func (q *namespaceFairQueue) Touch(string) {}
+
+func (q *namespaceFairQueue) Push(key string) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	namespace, _, _ := cache.SplitMetaNamespaceKey(key)
+	nq, ok := q.namespaces[namespace]
+	if !ok {
+		nq = &namespaceQueue{finish: q.virtualTime}
+		q.namespaces[namespace] = nq
+	}
+	nq.keys = append(nq.keys, key)
+	q.len++
+}
+
+func (q *namespaceFairQueue) Len() int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return q.len
+}
+
+// Pop pops the first key of the namespace that would finish being served first. Ties are broken by namespace, so that
+// the order is deterministic.
+func (q *namespaceFairQueue) Pop() string {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	var next string
+	var nextFinish float64
+	found := false
+	for namespace, nq := range q.namespaces {
+		finish := nq.finish + 1/float64(q.fairness.GetWeight(namespace))
+		if !found || finish < nextFinish || finish == nextFinish && namespace < next {
+			next, nextFinish, found = namespace, finish, true
+		}
+	}
+	nq := q.namespaces[next]
+	key := nq.keys[0]
+	// allow the key to be garbage collected
+	nq.keys[0] = ""
+	nq.keys = nq.keys[1:]
+	nq.finish = nextFinish
+	q.virtualTime = nextFinish
+	// forget namespaces that have no keys queued, so that they start at the virtual time when they queue keys again
+	if len(nq.keys) == 0 {
+		delete(q.namespaces, next)
+	}
+	q.len--
+	return key
+}
@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+func popAll(q *namespaceFairQueue) []string {
+	var keys []string
+	for q.Len() > 0 {
+		keys = append(keys, q.Pop())
+	}
+	return keys
+}
+
+func TestNamespaceFairQueue(t *testing.T) {
+	t.Run("Burst", func(t *testing.T) {
+		q := newNamespaceFairQueue(config.QueueFairness{})
+		for i := range 100 {
+			q.Push(fmt.Sprintf("tenant-a/wf-%d", i))
+		}
+		q.Push("tenant-b/wf-0")
+		q.Push("tenant-b/wf-1")
+		keys := popAll(q)
+		require.Len(t, keys, 102)
+		// tenant-b does not wait for tenant-a's burst
+		assert.Equal(t, []string{"tenant-a/wf-0", "tenant-b/wf-0", "tenant-a/wf-1", "tenant-b/wf-1", "tenant-a/wf-2"}, keys[:5])
+		assert.Equal(t, "tenant-a/wf-99", keys[101])
+	})
+	t.Run("Weighted", func(t *testing.T) {
+		q := newNamespaceFairQueue(config.QueueFairness{NamespaceWeights: map[string]int{"tenant-b": 2}})
+		for i := range 4 {
+			q.Push(fmt.Sprintf("tenant-a/wf-%d", i))
+			q.Push(fmt.Sprintf("tenant-b/wf-%d", i))
+		}
+		assert.Equal(t, []string{"tenant-b/wf-0", "tenant-a/wf-0", "tenant-b/wf-1", "tenant-b/wf-2", "tenant-a/wf-1", "tenant-b/wf-3", "tenant-a/wf-2", "tenant-a/wf-3"}, popAll(q))
+	})
+	t.Run("NoCredit", func(t *testing.T) {
+		q := newNamespaceFairQueue(config.QueueFairness{})
+		for i := range 10 {
+			q.Push(fmt.Sprintf("tenant-a/wf-%d", i))
+		}
+		for range 5 {
+			q.Pop()
+		}
+		// tenant-b was idle while tenant-a was served, so it does not get the next five keys
+		q.Push("tenant-b/wf-0")
+		q.Push("tenant-b/wf-1")
+		assert.Equal(t, []string{"tenant-a/wf-5", "tenant-b/wf-0", "tenant-a/wf-6", "tenant-b/wf-1", "tenant-a/wf-7"}, popAll(q)[:5])
+	})
+}
+
+func TestWorkflowQueueFairness(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	cancel, wfc := newController(ctx)
+	defer cancel()
+	wfc.wfQueueFairness = newNamespaceFairQueue(config.QueueFairness{})
+	wfc.wfQueue = wfc.metrics.RateLimiterWithBusyWorkersAndQueue(ctx, &fixedItemIntervalRateLimiter{}, wfc.wfQueueFairness, "workflow_queue")
+	defer wfc.wfQueue.ShutDown()
+	wfc.wfQueue.Add("tenant-a/wf-0")
+	wfc.wfQueue.Add("tenant-a/wf-1")
+	wfc.wfQueue.Add("tenant-b/wf-0")
+
+	key, _ := wfc.wfQueue.Get()
+	assert.Equal(t, "tenant-a/wf-0", key)
+	key, _ = wfc.wfQueue.Get()
+	assert.Equal(t, "tenant-b/wf-0", key)
+
+	// the workflows do not exist, so they are only counted as in flight while they are processed
+	assert.True(t, wfc.processNextItem(ctx))
+	attribs := attribute.NewSet(attribute.String(telemetry.AttribWorkflowNamespace, "tenant-a"))
+	val, err := testExporter.GetInt64CounterValue(ctx, telemetry.InstrumentWorkflowQueueInflight.Name(), &attribs)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), val)
+}
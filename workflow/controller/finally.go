@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/expr/argoexpr"
+	"github.com/argoproj/argo-workflows/v3/util/expr/env"
+	"github.com/argoproj/argo-workflows/v3/util/template"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
+)
+
+// runFinallyNode runs the template's finally hook once its steps or DAG tasks have completed with the given phase.
+// It returns whether the finally node has been fulfilled (or there is nothing to run), and the finally node.
+func (woc *wfOperationCtx) runFinallyNode(ctx context.Context, tmpl *wfv1.Template, node *wfv1.NodeStatus, phase wfv1.NodePhase, tmplCtx *templateresolution.TemplateContext, scope *wfScope) (bool, *wfv1.NodeStatus, error) {
+	if tmpl.Finally == nil || !woc.GetShutdownStrategy().ShouldExecute(true) {
+		return true, nil, nil
+	}
+	finallyNodeName := common.GenerateFinallyNodeName(node.Name)
+	finallyNode, err := woc.wf.GetNodeByName(finallyNodeName)
+	if err == nil && finallyNode.Fulfilled() {
+		return true, finallyNode, nil
+	}
+	if scope == nil {
+		scope = createScope(tmpl)
+	}
+	scope.addParamToScope(common.LocalVarStatus, string(phase))
+	failures := woc.failedNodeStatuses(func(n wfv1.NodeStatus) bool {
+		inside := strings.HasPrefix(n.Name, node.Name+".") || strings.HasPrefix(n.Name, node.Name+"[")
+		return inside && !strings.HasPrefix(n.Name, finallyNodeName)
+	})
+	failuresBytes, err := json.Marshal(failures)
+	if err != nil {
+		return true, nil, err
+	}
+	// This strconv.Quote is necessary so that the escaped quotes are not removed during parameter substitution
+	scope.addParamToScope(common.LocalVarFailures, strconv.Quote(string(failuresBytes)))
+
+	if tmpl.Finally.Expression != "" {
+		execute, err := argoexpr.EvalBool(tmpl.Finally.Expression, env.GetFuncMap(template.EnvMap(woc.globalParams.Merge(scope.getParameters()))))
+		if err != nil {
+			return true, nil, err
+		}
+		if !execute {
+			return true, nil, nil
+		}
+	}
+	woc.log.WithField("lifeCycleHook", tmpl.Finally).Info(ctx, "Running finally")
+	args := tmpl.Finally.Arguments
+	if !args.IsEmpty() {
+		args, err = woc.resolveExitTmplArgument(ctx, args, "", nil, scope)
+		if err != nil {
+			return true, nil, err
+		}
+	}
+	finallyNode, err = woc.executeTemplate(ctx, finallyNodeName, &wfv1.WorkflowStep{Template: tmpl.Finally.Template, TemplateRef: tmpl.Finally.TemplateRef}, tmplCtx, args, &executeTemplateOpts{
+		boundaryID:     node.BoundaryID,
+		onExitTemplate: true,
+		nodeFlag:       &wfv1.NodeFlag{Hooked: true},
+	})
+	woc.addChildNode(ctx, node.Name, finallyNodeName)
+	if err != nil {
+		return true, finallyNode, err
+	}
+	return finallyNode != nil && finallyNode.Fulfilled(), finallyNode, nil
+}
+
+// completeWithFinally runs the template's finally hook, if any, and then marks the node with the phase its steps or
+// DAG tasks completed with. If the finally node fails, so does the node.
+// It returns the node, and whether it was marked.
+func (woc *wfOperationCtx) completeWithFinally(ctx context.Context, tmpl *wfv1.Template, nodeName string, phase wfv1.NodePhase, tmplCtx *templateresolution.TemplateContext, scope *wfScope, message ...string) (*wfv1.NodeStatus, bool, error) {
+	node, err := woc.wf.GetNodeByName(nodeName)
+	if err != nil {
+		return nil, false, err
+	}
+	completed, finallyNode, err := woc.runFinallyNode(ctx, tmpl, node, phase, tmplCtx, scope)
+	if err != nil {
+		return woc.markNodeError(ctx, nodeName, err), true, nil
+	}
+	if !completed {
+		return node, false, nil
+	}
+	if finallyNode != nil && finallyNode.FailedOrError() && !phase.FailedOrError() {
+		return woc.markNodePhase(ctx, nodeName, wfv1.NodeFailed, "finally: "+finallyNode.Message), true, nil
+	}
+	return woc.markNodePhase(ctx, nodeName, phase, message...), true, nil
+}
@@ -0,0 +1,151 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+var dagFinally = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: dag-finally
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: a
+        template: whalesay
+    finally:
+      template: cleanup
+      arguments:
+        parameters:
+        - name: status
+          value: '{{status}}'
+        - name: a-status
+          value: '{{tasks.a.status}}'
+  - name: whalesay
+    container:
+      image: docker/whalesay
+      command: [cowsay]
+  - name: cleanup
+    inputs:
+      parameters:
+      - name: status
+      - name: a-status
+    container:
+      image: docker/whalesay
+      command: [cowsay]
+`
+
+func TestDAGFinally(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(dagFinally)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	finallyNode, err := woc.wf.GetNodeByName("dag-finally.finally")
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodePending, finallyNode.Phase)
+	require.NotNil(t, finallyNode.Inputs)
+	assert.Equal(t, "Succeeded", finallyNode.Inputs.GetParameterByName("status").Value.String())
+	assert.Equal(t, "Succeeded", finallyNode.Inputs.GetParameterByName("a-status").Value.String())
+	dagNode, err := woc.wf.GetNodeByName("dag-finally")
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodeRunning, dagNode.Phase)
+
+	// only fail the finally pod
+	for _, obj := range controller.PodController.TestingPodInformer().GetStore().List() {
+		pod := obj.(*apiv1.Pod)
+		if !strings.Contains(pod.Name, "cleanup") {
+			require.NoError(t, controller.kubeclientset.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{}))
+			require.NoError(t, controller.PodController.TestingPodInformer().GetStore().Delete(obj))
+		}
+	}
+	makePodsPhase(ctx, woc, apiv1.PodFailed)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	dagNode, err = woc.wf.GetNodeByName("dag-finally")
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodeFailed, dagNode.Phase)
+	assert.Contains(t, dagNode.Message, "finally")
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+}
+
+var stepsFinally = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: steps-finally
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: a
+        template: whalesay
+    finally:
+      template: cleanup
+      arguments:
+        parameters:
+        - name: status
+          value: '{{status}}'
+        - name: failures
+          value: '{{failures}}'
+  - name: whalesay
+    container:
+      image: docker/whalesay
+      command: [cowsay]
+  - name: cleanup
+    inputs:
+      parameters:
+      - name: status
+      - name: failures
+    container:
+      image: docker/whalesay
+      command: [cowsay]
+`
+
+func TestStepsFinally(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(stepsFinally)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodFailed)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	finallyNode, err := woc.wf.GetNodeByName("steps-finally.finally")
+	require.NoError(t, err)
+	require.NotNil(t, finallyNode.Inputs)
+	assert.Equal(t, "Failed", finallyNode.Inputs.GetParameterByName("status").Value.String())
+	assert.Contains(t, finallyNode.Inputs.GetParameterByName("failures").Value.String(), "Pod failed")
+	stepsNode, err := woc.wf.GetNodeByName("steps-finally")
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodeRunning, stepsNode.Phase)
+
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	stepsNode, err = woc.wf.GetNodeByName("steps-finally")
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodeFailed, stepsNode.Phase)
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+}
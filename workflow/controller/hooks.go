@@ -108,6 +108,74 @@ func (woc *wfOperationCtx) executeTmplLifeCycleHook(ctx context.Context, scope *
 	return true, nil
 }
 
+// executeDAGHooks runs the hooks of the DAG that match the task of the node, which their arguments and expressions can
+// refer to as `task`. It returns whether all the hook nodes are fulfilled.
+func (woc *wfOperationCtx) executeDAGHooks(ctx context.Context, dagCtx *dagContext, scope *wfScope, taskName string, taskNode *wfv1.NodeStatus) (bool, error) {
+	if len(dagCtx.tmpl.DAG.Hooks) == 0 {
+		return true, nil
+	}
+	outputs := taskNode.Outputs
+	if lastChildNode := woc.possiblyGetRetryChildNode(taskNode); lastChildNode != nil {
+		outputs = lastChildNode.Outputs
+	}
+	scope.addParamToScope("task.name", taskName)
+	scope.addParamToScope("task.id", taskNode.ID)
+	scope.addParamToScope("task.status", string(taskNode.Phase))
+	scope.addParamToScope("task.message", taskNode.Message)
+	if outputs != nil {
+		if outputs.Result != nil {
+			scope.addParamToScope("task.outputs.result", *outputs.Result)
+		}
+		if outputs.ExitCode != nil {
+			scope.addParamToScope("task.exitCode", *outputs.ExitCode)
+		}
+	}
+	var hookNodes []*wfv1.NodeStatus
+	for _, hook := range dagCtx.tmpl.DAG.Hooks {
+		hookNodeName := generateLifeHookNodeName(taskNode.Name, hook.Name)
+		// To check a node was triggered
+		hookedNode, _ := woc.wf.GetNodeByName(hookNodeName)
+		if hookedNode == nil {
+			matches, err := hook.MatchesTask(taskName)
+			if err != nil {
+				return false, err
+			}
+			if !matches || !hook.MatchesPhase(taskNode.Phase) {
+				continue
+			}
+			if hook.Expression != "" {
+				execute, err := argoexpr.EvalBool(hook.Expression, env.GetFuncMap(template.EnvMap(woc.globalParams.Merge(scope.getParameters()))))
+				if err != nil {
+					return false, err
+				}
+				if !execute {
+					continue
+				}
+			}
+		}
+		woc.log.WithField("dagHook", hook.Name).WithField("node", hookNodeName).Info(ctx, "Running DAG hook")
+		resolvedArgs, err := woc.resolveExitTmplArgument(ctx, hook.Arguments, "task", outputs, scope)
+		if err != nil {
+			return false, err
+		}
+		hookNode, err := woc.executeTemplate(ctx, hookNodeName, &wfv1.WorkflowStep{Template: hook.Template, TemplateRef: hook.TemplateRef}, dagCtx.tmplCtx, resolvedArgs, &executeTemplateOpts{
+			boundaryID: dagCtx.boundaryID,
+			nodeFlag:   &wfv1.NodeFlag{Hooked: true},
+		})
+		if err != nil {
+			return false, err
+		}
+		woc.addChildNode(ctx, taskNode.Name, hookNodeName)
+		hookNodes = append(hookNodes, hookNode)
+	}
+	for _, hookNode := range hookNodes {
+		if hookNode != nil && !hookNode.Fulfilled() {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 func generateLifeHookNodeName(parentNodeName string, hookName string) string {
 	return fmt.Sprintf("%s.hooks.%s", parentNodeName, hookName)
 }
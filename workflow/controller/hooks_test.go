@@ -1258,3 +1258,86 @@ spec:
 	assert.Nil(t, node.NodeFlag)
 	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
 }
+
+var dagHooks = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: dag-hooks
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: deploy-a
+        template: whalesay
+      - name: deploy-b
+        template: whalesay
+      - name: test
+        template: whalesay
+      hooks:
+      - name: notify
+        tasks: deploy-.*
+        phases: [Failed, Error]
+        template: notify
+        arguments:
+          parameters:
+          - name: task
+            value: '{{task.name}}'
+          - name: status
+            value: '{{task.status}}'
+      - name: audit
+        expression: task.name == "test"
+        template: notify
+        arguments:
+          parameters:
+          - name: task
+            value: '{{task.name}}'
+          - name: status
+            value: '{{task.status}}'
+  - name: whalesay
+    container:
+      image: docker/whalesay
+      command: [cowsay]
+  - name: notify
+    inputs:
+      parameters:
+      - name: task
+      - name: status
+    container:
+      image: docker/whalesay
+      command: [cowsay]
+`
+
+func TestDAGHooks(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(dagHooks)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	for _, name := range []string{"deploy-a", "deploy-b", "test"} {
+		_, err := woc.wf.GetNodeByName("dag-hooks." + name + ".hooks.notify")
+		require.Error(t, err, name)
+	}
+	makePodsPhase(ctx, woc, apiv1.PodFailed)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	for _, name := range []string{"deploy-a", "deploy-b"} {
+		hookNode, err := woc.wf.GetNodeByName("dag-hooks." + name + ".hooks.notify")
+		require.NoError(t, err, name)
+		require.NotNil(t, hookNode.Inputs)
+		assert.Equal(t, name, hookNode.Inputs.GetParameterByName("task").Value.String())
+		assert.Equal(t, "Failed", hookNode.Inputs.GetParameterByName("status").Value.String())
+		assert.True(t, hookNode.NodeFlag.Hooked)
+		_, err = woc.wf.GetNodeByName("dag-hooks." + name + ".hooks.audit")
+		require.Error(t, err, name)
+	}
+	_, err := woc.wf.GetNodeByName("dag-hooks.test.hooks.notify")
+	require.Error(t, err)
+	hookNode, err := woc.wf.GetNodeByName("dag-hooks.test.hooks.audit")
+	require.NoError(t, err)
+	assert.Equal(t, "test", hookNode.Inputs.GetParameterByName("task").Value.String())
+}
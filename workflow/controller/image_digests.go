@@ -0,0 +1,115 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/imagedigest"
+)
+
+// resolveImageDigests resolves the tags of the images of the workflow's templates to digests when the workflow is
+// created. Images of templates referenced by templateRef, or set by parameters, are resolved when their first pod is
+// created.
+func (woc *wfOperationCtx) resolveImageDigests(ctx context.Context) error {
+	for _, tmpl := range woc.execWf.Spec.Templates {
+		for _, image := range templateImages(&tmpl) {
+			if !woc.pinsImage(image) {
+				continue
+			}
+			if _, err := woc.imageDigest(ctx, image); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// templateImages returns the images of the template's containers which are not set by parameters
+func templateImages(tmpl *wfv1.Template) []string {
+	var containers []apiv1.Container
+	if tmpl.Container != nil {
+		containers = append(containers, *tmpl.Container)
+	}
+	if tmpl.Script != nil {
+		containers = append(containers, tmpl.Script.Container)
+	}
+	if tmpl.ContainerSet != nil {
+		for _, c := range tmpl.ContainerSet.Containers {
+			containers = append(containers, c.Container)
+		}
+	}
+	for _, c := range tmpl.InitContainers {
+		containers = append(containers, c.Container)
+	}
+	for _, c := range tmpl.Sidecars {
+		containers = append(containers, c.Container)
+	}
+	var images []string
+	for _, c := range containers {
+		if c.Image != "" && !strings.Contains(c.Image, "{{") {
+			images = append(images, c.Image)
+		}
+	}
+	return images
+}
+
+// pinsImage returns whether the image is pinned to a digest, i.e. pinning is enabled and the image does not already
+// have a digest
+func (woc *wfOperationCtx) pinsImage(image string) bool {
+	return !strings.Contains(image, "@") && woc.controller.Config.ImageDigestPinning.Pins(image)
+}
+
+// imageDigest returns the digest the workflow pins the image to, resolving and recording it if it has not been, so
+// that every pod of the workflow runs the same image
+func (woc *wfOperationCtx) imageDigest(ctx context.Context, image string) (string, error) {
+	if digest, ok := woc.wf.Status.ImageDigests[image]; ok {
+		return digest, nil
+	}
+	digest, err := woc.controller.imageDigests.Resolve(ctx, image, imagedigest.Options{
+		Namespace: woc.wf.Namespace, ServiceAccountName: woc.execWf.Spec.ServiceAccountName, ImagePullSecrets: woc.execWf.Spec.ImagePullSecrets,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve the digest of image %q: %w", image, err)
+	}
+	if woc.wf.Status.ImageDigests == nil {
+		woc.wf.Status.ImageDigests = make(map[string]string)
+	}
+	woc.wf.Status.ImageDigests[image] = digest
+	woc.updated = true
+	return digest, nil
+}
+
+// pinImageDigests pins the images of the pod's containers to the digests of the workflow, recording them in the
+// node's status. It returns the images as they were, keyed by the pinned images.
+func (woc *wfOperationCtx) pinImageDigests(ctx context.Context, nodeID string, pod *apiv1.Pod) (map[string]string, error) {
+	images := make(map[string]string)
+	digests := make(map[string]string)
+	for _, containers := range [][]apiv1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+		for i, c := range containers {
+			if c.Image == woc.executorImage() || !woc.pinsImage(c.Image) {
+				continue
+			}
+			digest, err := woc.imageDigest(ctx, c.Image)
+			if err != nil {
+				return nil, err
+			}
+			containers[i].Image = c.Image + "@" + digest
+			images[containers[i].Image] = c.Image
+			digests[c.Image] = digest
+		}
+	}
+	if len(digests) == 0 {
+		return images, nil
+	}
+	node, err := woc.wf.Status.Nodes.Get(nodeID)
+	if err != nil {
+		return nil, err
+	}
+	node.ImageDigests = digests
+	woc.wf.Status.Nodes.Set(ctx, nodeID, *node)
+	return images, nil
+}
@@ -0,0 +1,122 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/imagedigest"
+)
+
+// fakeImageDigests resolves the images it has the digests of
+type fakeImageDigests map[string]string
+
+func (f fakeImageDigests) Resolve(_ context.Context, image string, _ imagedigest.Options) (string, error) {
+	if digest, ok := f[image]; ok {
+		return digest, nil
+	}
+	return "", fmt.Errorf("manifest unknown")
+}
+
+var imageDigestsWf = `
+metadata:
+  name: image-digests
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: a
+        template: say
+        withItems: [1, 2]
+    - - name: b
+        arguments:
+          parameters: [{name: image, value: "my-image"}]
+        template: say-image
+  - name: say
+    container:
+      image: my-image
+    sidecars:
+    - name: local
+      image: my-registry.local/sidecar:v1
+      command: [sleep]
+  - name: say-image
+    inputs:
+      parameters: [{name: image}]
+    container:
+      image: "{{inputs.parameters.image}}"
+`
+
+func TestImageDigestPinning(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(imageDigestsWf)
+	digests := fakeImageDigests{"my-image": "sha256:aaa"}
+	cancel, controller := newController(ctx, wf, func(wfc *WorkflowController) {
+		wfc.Config.ImageDigestPinning = &config.ImageDigestPinning{Enabled: true, Exclude: []string{"my-registry.local/*"}}
+		wfc.imageDigests = digests
+	})
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+	assert.Equal(t, map[string]string{"my-image": "sha256:aaa"}, woc.wf.Status.ImageDigests)
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 2)
+	for _, pod := range pods.Items {
+		for _, c := range pod.Spec.Containers {
+			switch c.Name {
+			case "main":
+				assert.Equal(t, "my-image@sha256:aaa", c.Image)
+				// the entrypoint is looked up by the image as it was written
+				assert.Contains(t, c.Command, "my-entrypoint")
+			case "local":
+				assert.Equal(t, "my-registry.local/sidecar:v1", c.Image)
+			}
+		}
+	}
+	a := woc.wf.Status.Nodes.FindByDisplayName("a(0:1)")
+	require.NotNil(t, a)
+	assert.Equal(t, map[string]string{"my-image": "sha256:aaa"}, a.ImageDigests)
+
+	// the tag is pushed to again, but the workflow's later pods still run the image it was created with
+	digests["my-image"] = "sha256:bbb"
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	assert.NotNil(t, woc.wf.Status.Nodes.FindByDisplayName("b"))
+	pods, err = listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 3)
+	for _, pod := range pods.Items {
+		for _, c := range pod.Spec.Containers {
+			if c.Name == "main" {
+				assert.Equal(t, "my-image@sha256:aaa", c.Image)
+			}
+		}
+	}
+}
+
+func TestImageDigestPinningUnresolved(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(imageDigestsWf)
+	cancel, controller := newController(ctx, wf, func(wfc *WorkflowController) {
+		wfc.Config.ImageDigestPinning = &config.ImageDigestPinning{Enabled: true}
+		wfc.imageDigests = fakeImageDigests{}
+	})
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowError, woc.wf.Status.Phase)
+	assert.Equal(t, `failed to resolve the digest of image "my-image": manifest unknown`, woc.wf.Status.Message)
+}
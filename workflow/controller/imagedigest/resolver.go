@@ -0,0 +1,61 @@
+package imagedigest
+
+import (
+	"context"
+
+	"github.com/google/go-containerregistry/pkg/authn/k8schain"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Interface resolves the tags of images to digests
+type Interface interface {
+	// Resolve returns the digest the image's tag refers to, e.g. "sha256:...", or its digest if it has one
+	Resolve(ctx context.Context, image string, options Options) (string, error)
+}
+
+type Options struct {
+	Namespace          string
+	ServiceAccountName string
+	ImagePullSecrets   []apiv1.LocalObjectReference
+}
+
+func New(kubernetesClient kubernetes.Interface) Interface {
+	return &containerRegistryResolver{kubernetesClient}
+}
+
+type containerRegistryResolver struct {
+	kubernetesClient kubernetes.Interface
+}
+
+func (r *containerRegistryResolver) Resolve(ctx context.Context, image string, options Options) (string, error) {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return "", err
+	}
+	if digest, ok := ref.(name.Digest); ok {
+		return digest.DigestStr(), nil
+	}
+	var secrets []string
+	for _, s := range options.ImagePullSecrets {
+		secrets = append(secrets, s.Name)
+	}
+	kc, err := k8schain.New(ctx, r.kubernetesClient, k8schain.Options{
+		Namespace:          options.Namespace,
+		ServiceAccountName: options.ServiceAccountName,
+		ImagePullSecrets:   secrets,
+	})
+	if err != nil {
+		return "", err
+	}
+	// a HEAD request, which unlike a GET does not count towards registries' rate limits
+	descriptor, err := remote.Head(ref, remote.WithAuthFromKeychain(kc), remote.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	return descriptor.Digest.String(), nil
+}
+
+var _ Interface = &containerRegistryResolver{}
@@ -0,0 +1,48 @@
+package controller
+
+import (
+	"sync"
+	"time"
+)
+
+// informerLag measures how long the workflow informer takes to receive the controller's own updates of workflows, by
+// remembering the resource version and time of the last update of each workflow until the informer receives it
+type informerLag struct {
+	mutex   sync.Mutex
+	updates map[string]workflowUpdate
+}
+
+type workflowUpdate struct {
+	resourceVersion string
+	updated         time.Time
+}
+
+// updated remembers that the controller updated the workflow to the resource version
+func (l *informerLag) updated(key, resourceVersion string, now time.Time) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.updates == nil {
+		l.updates = make(map[string]workflowUpdate)
+	}
+	l.updates[key] = workflowUpdate{resourceVersion: resourceVersion, updated: now}
+}
+
+// received returns how long ago the controller updated the workflow to the resource version the informer received, if
+// it did
+func (l *informerLag) received(key, resourceVersion string, now time.Time) (time.Duration, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	update, ok := l.updates[key]
+	if !ok || update.resourceVersion != resourceVersion {
+		return 0, false
+	}
+	delete(l.updates, key)
+	return now.Sub(update.updated), true
+}
+
+// deleted forgets the update of a deleted workflow, whose update the informer may never receive
+func (l *informerLag) deleted(key string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	delete(l.updates, key)
+}
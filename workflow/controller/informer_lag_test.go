@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInformerLag(t *testing.T) {
+	l := informerLag{}
+	now := time.Now()
+	_, ok := l.received("my-ns/my-wf", "1", now)
+	assert.False(t, ok, "not updated")
+
+	l.updated("my-ns/my-wf", "2", now)
+	_, ok = l.received("my-ns/my-wf", "1", now)
+	assert.False(t, ok, "older resource version")
+	lag, ok := l.received("my-ns/my-wf", "2", now.Add(time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, time.Second, lag)
+	_, ok = l.received("my-ns/my-wf", "2", now.Add(time.Second))
+	assert.False(t, ok, "already received")
+
+	l.updated("my-ns/my-wf", "3", now)
+	l.deleted("my-ns/my-wf")
+	assert.Empty(t, l.updates)
+}
@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/template"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/expand"
+	wfutil "github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// intermediateParameterRefRegex matches a reference to an intermediate parameter of a task, capturing the task name
+var intermediateParameterRefRegex = regexp.MustCompile(`tasks\.([-a-zA-Z0-9]+)\.intermediateParameters\.`)
+
+// listensForIntermediateParameters returns whether a task's when refers to the intermediate parameters of other tasks,
+// which validation only allows for suspend tasks
+func listensForIntermediateParameters(when string) bool {
+	return intermediateParameterRefRegex.MatchString(when)
+}
+
+// executeListeningTask executes a suspend task that listens for the intermediate parameters of other tasks. Its node is
+// suspended until its when is true, when it is resumed, rather than skipped if the when is false when its dependencies
+// complete. The when is evaluated again each time the workflow is reconciled, as it is when a running node publishes
+// its parameters. Once the tasks it listens to have completed, and so will not publish any more, a false when skips it.
+func (woc *wfOperationCtx) executeListeningTask(ctx context.Context, dagCtx *dagContext, task *wfv1.DAGTask, nodeName string) {
+	dagTemplateScope := dagCtx.tmplCtx.GetTemplateScope()
+
+	// The when is evaluated apart from the task, as it may not be resolvable yet
+	args := task.DeepCopy()
+	args.When = ""
+	newTask, err := woc.resolveDependencyReferences(ctx, dagCtx, args)
+	if err != nil {
+		woc.initializeNode(ctx, nodeName, wfv1.NodeTypeSkipped, dagTemplateScope, task, dagCtx.boundaryID, wfv1.NodeError, &wfv1.NodeFlag{}, true, err.Error())
+		return
+	}
+	proceed, final, err := woc.evaluateListeningWhen(ctx, dagCtx, task)
+	if err != nil {
+		woc.initializeNode(ctx, nodeName, wfv1.NodeTypeSkipped, dagTemplateScope, task, dagCtx.boundaryID, wfv1.NodeError, &wfv1.NodeFlag{}, true, err.Error())
+		return
+	}
+
+	node, err := woc.executeTemplate(ctx, nodeName, newTask, dagCtx.tmplCtx, newTask.Arguments, &executeTemplateOpts{boundaryID: dagCtx.boundaryID, onExitTemplate: dagCtx.onExitTemplate})
+	if err != nil {
+		_ = woc.markNodeError(ctx, nodeName, fmt.Errorf("task '%s' errored: %v", nodeName, err))
+		return
+	}
+	// The node may have been resumed by hand, or by its duration
+	if node == nil || node.Fulfilled() {
+		return
+	}
+	switch {
+	case proceed:
+		woc.log.WithField("nodeName", nodeName).Info(ctx, "resuming listening node")
+		if err := wfutil.OverrideOutputParametersWithDefault(node.Outputs); err != nil {
+			_ = woc.markNodeError(ctx, nodeName, err)
+			return
+		}
+		_ = woc.markNodePhase(ctx, nodeName, wfv1.NodeSucceeded, fmt.Sprintf("when '%s' evaluated true", task.When))
+	case final:
+		_ = woc.markNodePhase(ctx, nodeName, wfv1.NodeSkipped, fmt.Sprintf("when '%s' evaluated false", task.When))
+	}
+}
+
+// evaluateListeningWhen evaluates the when of a listening task with the intermediate parameters published so far. It
+// also returns whether the result is final, i.e. every task it listens to has completed.
+func (woc *wfOperationCtx) evaluateListeningWhen(ctx context.Context, dagCtx *dagContext, task *wfv1.DAGTask) (bool, bool, error) {
+	scope, err := woc.buildLocalScopeFromTask(ctx, dagCtx, task)
+	if err != nil {
+		return false, false, err
+	}
+	final := true
+	for _, match := range intermediateParameterRefRegex.FindAllStringSubmatch(task.When, -1) {
+		node := dagCtx.getTaskNode(ctx, match[1])
+		if node == nil {
+			final = false
+			continue
+		}
+		if !node.Fulfilled() {
+			final = false
+		}
+		for name, value := range node.IntermediateParameters {
+			scope.addParamToScope(fmt.Sprintf("tasks.%s.intermediateParameters.%s", match[1], name), value)
+		}
+	}
+	whenBytes, err := json.Marshal(task.When)
+	if err != nil {
+		return false, false, err
+	}
+	whenStr, err := template.Replace(ctx, string(whenBytes), woc.globalParams.Merge(scope.getParameters()), true)
+	if err != nil {
+		return false, false, err
+	}
+	var when string
+	if err := json.Unmarshal([]byte(whenStr), &when); err != nil {
+		return false, false, err
+	}
+	// Parameters that have not been published yet
+	if strings.Contains(when, "{{") {
+		return false, final, nil
+	}
+	proceed, err := expand.ShouldExecute(when)
+	if err != nil {
+		return false, false, err
+	}
+	return proceed, final, nil
+}
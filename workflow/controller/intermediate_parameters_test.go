@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+const listeningWf = `
+metadata:
+  name: listening
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: train
+        template: train
+      - name: wait-for-checkpoint
+        template: wait
+        when: "'{{tasks.train.intermediateParameters.stage}}' == checkpointed"
+      - name: evaluate
+        template: train
+        depends: wait-for-checkpoint.Succeeded
+  - name: train
+    container:
+      image: my-image
+  - name: wait
+    suspend: {}
+`
+
+func TestListeningTask(t *testing.T) {
+	publish := func(woc *wfOperationCtx, parameters map[string]string) {
+		node := woc.wf.Status.Nodes.Find(func(n wfv1.NodeStatus) bool { return n.DisplayName == "train" })
+		require.NotNil(t, node)
+		node.IntermediateParameters = parameters
+		woc.wf.Status.Nodes[node.ID] = *node
+	}
+	displayed := func(woc *wfOperationCtx, name string) *wfv1.NodeStatus {
+		return woc.wf.Status.Nodes.Find(func(n wfv1.NodeStatus) bool { return n.DisplayName == name })
+	}
+
+	t.Run("Resumed", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		cancel, controller := newController(ctx)
+		defer cancel()
+		woc := newWorkflowOperationCtx(ctx, wfv1.MustUnmarshalWorkflow(listeningWf), controller)
+		woc.operate(ctx)
+
+		wait := displayed(woc, "wait-for-checkpoint")
+		require.NotNil(t, wait)
+		assert.Equal(t, wfv1.NodeTypeSuspend, wait.Type)
+		assert.Equal(t, wfv1.NodeRunning, wait.Phase)
+
+		publish(woc, map[string]string{"stage": "loaded"})
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.NodeRunning, displayed(woc, "wait-for-checkpoint").Phase)
+		assert.Nil(t, displayed(woc, "evaluate"))
+
+		publish(woc, map[string]string{"stage": "checkpointed"})
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.NodeSucceeded, displayed(woc, "wait-for-checkpoint").Phase)
+		assert.False(t, displayed(woc, "train").Fulfilled(), "the task listened to is still running")
+		assert.NotNil(t, displayed(woc, "evaluate"))
+	})
+
+	t.Run("Skipped", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		cancel, controller := newController(ctx)
+		defer cancel()
+		woc := newWorkflowOperationCtx(ctx, wfv1.MustUnmarshalWorkflow(listeningWf), controller)
+		woc.operate(ctx)
+
+		publish(woc, map[string]string{"stage": "loaded"})
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+
+		wait := displayed(woc, "wait-for-checkpoint")
+		require.NotNil(t, wait)
+		assert.Equal(t, wfv1.NodeSkipped, wait.Phase)
+		assert.Contains(t, wait.Message, "evaluated false")
+		assert.Equal(t, wfv1.NodeOmitted, displayed(woc, "evaluate").Phase)
+	})
+}
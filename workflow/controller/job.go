@@ -0,0 +1,217 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/env"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// jobComponent is the component label of the pods of Jobs and JobSets, whose nodes are reconciled from their Job rather
+// than from their pods
+const jobComponent = "job"
+
+// jobStatusPollPeriod is how often the controller checks a Job or JobSet it created until it completes
+var jobStatusPollPeriod = env.LookupEnvDurationOr(logging.InitLoggerInContext(), "JOB_STATUS_POLL_PERIOD", 10*time.Second)
+
+// jobKind is how a kind of job is created, and how its conditions tell whether it completed or failed
+type jobKind struct {
+	gvr schema.GroupVersionResource
+	// podTemplatePaths are the paths of the lists of pod templates in the spec, and then of each pod template in them
+	podTemplatePaths [][]string
+	succeeded        string
+	failed           string
+}
+
+var jobKinds = map[wfv1.JobKind]jobKind{
+	wfv1.JobKindJob: {
+		gvr:              schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "jobs"},
+		podTemplatePaths: [][]string{{"template"}},
+		succeeded:        "Complete",
+		failed:           "Failed",
+	},
+	wfv1.JobKindJobSet: {
+		gvr:              schema.GroupVersionResource{Group: "jobset.x-k8s.io", Version: "v1alpha2", Resource: "jobsets"},
+		podTemplatePaths: [][]string{{"replicatedJobs"}, {"template", "spec", "template"}},
+		succeeded:        "Completed",
+		failed:           "Failed",
+	},
+}
+
+// executeJob creates the Job or JobSet of a job template, and then reflects its conditions in the node.
+// The Job is named after the node, so it is only ever created once.
+func (woc *wfOperationCtx) executeJob(ctx context.Context, nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, opts *executeTemplateOpts) (*wfv1.NodeStatus, error) {
+	node, err := woc.wf.GetNodeByName(nodeName)
+	if err != nil {
+		node = woc.initializeExecutableNode(ctx, nodeName, wfv1.NodeTypeJob, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodePending, opts.nodeFlag, true)
+	}
+	if node.Fulfilled() {
+		return node, nil
+	}
+	kind, ok := jobKinds[tmpl.Job.GetKind()]
+	if !ok {
+		return nil, fmt.Errorf("unknown job kind %q", tmpl.Job.Kind)
+	}
+	shutdown := !woc.GetShutdownStrategy().ShouldExecute(opts.onExitTemplate)
+	jobs := woc.controller.dynamicInterface.Resource(kind.gvr).Namespace(woc.wf.Namespace)
+	job, err := jobs.Get(ctx, node.ID, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		if shutdown {
+			return woc.markNodePhase(ctx, nodeName, wfv1.NodeFailed, fmt.Sprintf("workflow shutdown with strategy: %s", woc.GetShutdownStrategy())), nil
+		}
+		job, err = woc.newJob(node.ID, tmpl, kind)
+		if err != nil {
+			return nil, err
+		}
+		woc.log.WithFields(logging.Fields{"nodeName": nodeName, "kind": tmpl.Job.GetKind()}).Info(ctx, "Creating job")
+		job, err = jobs.Create(ctx, job, metav1.CreateOptions{})
+		if apierr.IsAlreadyExists(err) {
+			job, err = jobs.Get(ctx, node.ID, metav1.GetOptions{})
+		}
+	}
+	if err != nil {
+		return woc.requeueIfTransientErr(ctx, err, nodeName)
+	}
+	phase, message := jobPhase(job, kind)
+	if shutdown && !phase.Completed() {
+		propagation := metav1.DeletePropagationBackground
+		if err := jobs.Delete(ctx, job.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagation}); err != nil && !apierr.IsNotFound(err) {
+			return woc.requeueIfTransientErr(ctx, err, nodeName)
+		}
+		return woc.markNodePhase(ctx, nodeName, wfv1.NodeFailed, fmt.Sprintf("workflow shutdown with strategy: %s", woc.GetShutdownStrategy())), nil
+	}
+	if !phase.Completed() {
+		woc.requeueAfter(jobStatusPollPeriod)
+	}
+	return woc.markNodePhase(ctx, nodeName, phase, message), nil
+}
+
+// newJob returns the Job or JobSet of a job template, owned by the workflow. Its pods are labelled with the workflow and
+// annotated with the node, so their logs can be retrieved with the workflow's, and run as the workflow's service account
+// unless they set one.
+func (woc *wfOperationCtx) newJob(name string, tmpl *wfv1.Template, kind jobKind) (*unstructured.Unstructured, error) {
+	var spec map[string]interface{}
+	if len(tmpl.Job.Spec.Value) > 0 {
+		if err := json.Unmarshal(tmpl.Job.Spec.Value, &spec); err != nil {
+			return nil, fmt.Errorf("invalid job spec: %w", err)
+		}
+	}
+	if spec == nil {
+		spec = map[string]interface{}{}
+	}
+	err := forEachPodTemplate(spec, kind.podTemplatePaths, func(podTemplate map[string]interface{}) error {
+		if err := unstructured.SetNestedField(podTemplate, woc.wf.Name, "metadata", "labels", common.LabelKeyWorkflow); err != nil {
+			return err
+		}
+		if err := unstructured.SetNestedField(podTemplate, jobComponent, "metadata", "labels", common.LabelKeyComponent); err != nil {
+			return err
+		}
+		if err := unstructured.SetNestedField(podTemplate, name, "metadata", "annotations", common.AnnotationKeyNodeID); err != nil {
+			return err
+		}
+		serviceAccountName, _, _ := unstructured.NestedString(podTemplate, "spec", "serviceAccountName")
+		if serviceAccountName == "" && woc.execWf.Spec.ServiceAccountName != "" {
+			return unstructured.SetNestedField(podTemplate, woc.execWf.Spec.ServiceAccountName, "spec", "serviceAccountName")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid job spec: %w", err)
+	}
+	job := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	job.SetAPIVersion(kind.gvr.GroupVersion().String())
+	job.SetKind(string(tmpl.Job.GetKind()))
+	job.SetName(name)
+	job.SetNamespace(woc.wf.Namespace)
+	labels := map[string]string{}
+	for k, v := range tmpl.Job.Metadata.Labels {
+		labels[k] = v
+	}
+	labels[common.LabelKeyWorkflow] = woc.wf.Name
+	if woc.controller.Config.InstanceID != "" {
+		labels[common.LabelKeyControllerInstanceID] = woc.controller.Config.InstanceID
+	}
+	job.SetLabels(labels)
+	job.SetAnnotations(tmpl.Job.Metadata.Annotations)
+	job.SetOwnerReferences([]metav1.OwnerReference{
+		*metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind)),
+	})
+	return job, nil
+}
+
+// forEachPodTemplate calls f with each pod template in the spec, found by following the paths, each but the last of
+// which is a list
+func forEachPodTemplate(obj map[string]interface{}, paths [][]string, f func(map[string]interface{}) error) error {
+	if len(paths) == 1 {
+		podTemplate, _, err := unstructured.NestedMap(obj, paths[0]...)
+		if err != nil {
+			return err
+		}
+		if podTemplate == nil {
+			podTemplate = map[string]interface{}{}
+		}
+		if err := f(podTemplate); err != nil {
+			return err
+		}
+		return unstructured.SetNestedMap(obj, podTemplate, paths[0]...)
+	}
+	items, _, err := unstructured.NestedSlice(obj, paths[0]...)
+	if err != nil {
+		return err
+	}
+	for i, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%v[%d] is not an object", paths[0], i)
+		}
+		if err := forEachPodTemplate(m, paths[1:], f); err != nil {
+			return err
+		}
+		items[i] = m
+	}
+	return unstructured.SetNestedSlice(obj, items, paths[0]...)
+}
+
+// jobPhase returns the phase of the node of a Job or JobSet from its conditions. A suspended Job, e.g. one waiting to
+// be admitted by a queue, is pending.
+func jobPhase(job *unstructured.Unstructured, kind jobKind) (wfv1.NodePhase, string) {
+	conditions, _, _ := unstructured.NestedSlice(job.Object, "status", "conditions")
+	phase := wfv1.NodeRunning
+	message := ""
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["status"] != string(metav1.ConditionTrue) {
+			continue
+		}
+		conditionMessage, _ := condition["message"].(string)
+		switch condition["type"] {
+		case kind.succeeded:
+			return wfv1.NodeSucceeded, conditionMessage
+		case kind.failed:
+			if reason, _ := condition["reason"].(string); reason != "" && conditionMessage == "" {
+				conditionMessage = reason
+			}
+			return wfv1.NodeFailed, conditionMessage
+		case "Suspended":
+			phase, message = wfv1.NodePending, conditionMessage
+		}
+	}
+	return phase, message
+}
+
+// isJobPod returns whether the pod is run by a Job or JobSet
+func isJobPod(pod *apiv1.Pod) bool {
+	return pod.Labels[common.LabelKeyComponent] == jobComponent
+}
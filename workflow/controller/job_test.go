@@ -0,0 +1,245 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+var jobWorkflow = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: job-workflow
+spec:
+  entrypoint: train
+  serviceAccountName: argo
+  arguments:
+    parameters:
+    - name: workers
+      value: "4"
+  templates:
+  - name: train
+    job:
+      metadata:
+        labels:
+          kueue.x-k8s.io/queue-name: training
+      spec:
+        completionMode: Indexed
+        completions: '{{workflow.parameters.workers}}'
+        parallelism: '{{workflow.parameters.workers}}'
+        template:
+          spec:
+            restartPolicy: Never
+            containers:
+            - name: main
+              image: argoproj/argosay:v2
+`
+
+var jobSetWorkflow = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: jobset-workflow
+spec:
+  entrypoint: train
+  templates:
+  - name: train
+    job:
+      kind: JobSet
+      spec:
+        replicatedJobs:
+        - name: driver
+          template:
+            spec:
+              template:
+                spec:
+                  serviceAccountName: driver
+                  containers:
+                  - name: main
+                    image: argoproj/argosay:v2
+        - name: workers
+          replicas: 2
+          template:
+            spec:
+              template:
+                spec:
+                  containers:
+                  - name: main
+                    image: argoproj/argosay:v2
+`
+
+func setJobCondition(t *testing.T, job *unstructured.Unstructured, conditionType, message string) {
+	t.Helper()
+	require.NoError(t, unstructured.SetNestedSlice(job.Object, []interface{}{
+		map[string]interface{}{"type": conditionType, "status": "True", "message": message},
+	}, "status", "conditions"))
+}
+
+func TestJob(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(jobWorkflow)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes.FindByDisplayName("job-workflow")
+	require.NotNil(t, node)
+	assert.Equal(t, wfv1.NodeTypeJob, node.Type)
+	assert.Equal(t, wfv1.NodeRunning, node.Phase)
+
+	jobs := controller.dynamicInterface.Resource(jobKinds[wfv1.JobKindJob].gvr).Namespace(wf.Namespace)
+	job, err := jobs.Get(ctx, node.ID, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "batch/v1", job.GetAPIVersion())
+	assert.Equal(t, "Job", job.GetKind())
+	assert.Equal(t, "job-workflow", job.GetLabels()[common.LabelKeyWorkflow])
+	assert.Equal(t, "training", job.GetLabels()["kueue.x-k8s.io/queue-name"])
+	assert.Equal(t, "job-workflow", job.GetOwnerReferences()[0].Name)
+	completions, _, _ := unstructured.NestedString(job.Object, "spec", "completions")
+	assert.Equal(t, "4", completions)
+	podLabel, _, _ := unstructured.NestedString(job.Object, "spec", "template", "metadata", "labels", common.LabelKeyWorkflow)
+	assert.Equal(t, "job-workflow", podLabel)
+	serviceAccountName, _, _ := unstructured.NestedString(job.Object, "spec", "template", "spec", "serviceAccountName")
+	assert.Equal(t, "argo", serviceAccountName)
+
+	t.Run("Pods", func(t *testing.T) {
+		podLabels, _, _ := unstructured.NestedStringMap(job.Object, "spec", "template", "metadata", "labels")
+		podAnnotations, _, _ := unstructured.NestedStringMap(job.Object, "spec", "template", "metadata", "annotations")
+		assert.Equal(t, jobComponent, podLabels[common.LabelKeyComponent])
+		assert.Equal(t, node.ID, podAnnotations[common.AnnotationKeyNodeID])
+		// one of the Job's pods failed, which the Job may retry, so it does not fail the node
+		pod := &apiv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: node.ID + "-0-abcde", Namespace: wf.Namespace, Labels: podLabels, Annotations: podAnnotations},
+			Status:     apiv1.PodStatus{Phase: apiv1.PodFailed},
+		}
+		require.NoError(t, controller.PodController.TestingPodInformer().GetStore().Add(pod))
+		woc := newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		node := woc.wf.Status.Nodes.FindByDisplayName("job-workflow")
+		require.NotNil(t, node)
+		assert.Equal(t, wfv1.NodeRunning, node.Phase)
+		assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+		_, exists, err := controller.PodController.TestingPodInformer().GetStore().Get(pod)
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("Suspended", func(t *testing.T) {
+		setJobCondition(t, job, "Suspended", "Job suspended")
+		job, err = jobs.Update(ctx, job, metav1.UpdateOptions{})
+		require.NoError(t, err)
+		woc := newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		node := woc.wf.Status.Nodes.FindByDisplayName("job-workflow")
+		require.NotNil(t, node)
+		assert.Equal(t, wfv1.NodePending, node.Phase)
+		assert.Equal(t, "Job suspended", node.Message)
+	})
+
+	t.Run("Succeeded", func(t *testing.T) {
+		setJobCondition(t, job, "Complete", "Reached expected number of succeeded pods")
+		_, err = jobs.Update(ctx, job, metav1.UpdateOptions{})
+		require.NoError(t, err)
+		woc := newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		node := woc.wf.Status.Nodes.FindByDisplayName("job-workflow")
+		require.NotNil(t, node)
+		assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+		assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	})
+}
+
+func TestJobFailed(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(jobWorkflow)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes.FindByDisplayName("job-workflow")
+	require.NotNil(t, node)
+
+	jobs := controller.dynamicInterface.Resource(jobKinds[wfv1.JobKindJob].gvr).Namespace(wf.Namespace)
+	job, err := jobs.Get(ctx, node.ID, metav1.GetOptions{})
+	require.NoError(t, err)
+	setJobCondition(t, job, "Failed", "Job has reached the specified backoff limit")
+	_, err = jobs.Update(ctx, job, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	node = woc.wf.Status.Nodes.FindByDisplayName("job-workflow")
+	require.NotNil(t, node)
+	assert.Equal(t, wfv1.NodeFailed, node.Phase)
+	assert.Equal(t, "Job has reached the specified backoff limit", node.Message)
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+}
+
+func TestJobShutdown(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(jobWorkflow)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes.FindByDisplayName("job-workflow")
+	require.NotNil(t, node)
+
+	woc.wf.Spec.Shutdown = wfv1.ShutdownStrategyTerminate
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	node = woc.wf.Status.Nodes.FindByDisplayName("job-workflow")
+	require.NotNil(t, node)
+	assert.Equal(t, wfv1.NodeFailed, node.Phase)
+
+	jobs := controller.dynamicInterface.Resource(jobKinds[wfv1.JobKindJob].gvr).Namespace(wf.Namespace)
+	_, err := jobs.Get(ctx, node.ID, metav1.GetOptions{})
+	assert.True(t, apierr.IsNotFound(err))
+}
+
+func TestJobSet(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(jobSetWorkflow)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes.FindByDisplayName("jobset-workflow")
+	require.NotNil(t, node)
+
+	jobSets := controller.dynamicInterface.Resource(jobKinds[wfv1.JobKindJobSet].gvr).Namespace(wf.Namespace)
+	jobSet, err := jobSets.Get(ctx, node.ID, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "jobset.x-k8s.io/v1alpha2", jobSet.GetAPIVersion())
+	assert.Equal(t, "JobSet", jobSet.GetKind())
+	replicatedJobs, _, err := unstructured.NestedSlice(jobSet.Object, "spec", "replicatedJobs")
+	require.NoError(t, err)
+	require.Len(t, replicatedJobs, 2)
+	for _, replicatedJob := range replicatedJobs {
+		podLabel, _, _ := unstructured.NestedString(replicatedJob.(map[string]interface{}), "template", "spec", "template", "metadata", "labels", common.LabelKeyWorkflow)
+		assert.Equal(t, "jobset-workflow", podLabel)
+	}
+	serviceAccountName, _, _ := unstructured.NestedString(replicatedJobs[0].(map[string]interface{}), "template", "spec", "template", "spec", "serviceAccountName")
+	assert.Equal(t, "driver", serviceAccountName)
+
+	setJobCondition(t, jobSet, "Completed", "jobset completed successfully")
+	_, err = jobSets.Update(ctx, jobSet, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	node = woc.wf.Status.Nodes.FindByDisplayName("jobset-workflow")
+	require.NotNil(t, node)
+	assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+}
@@ -0,0 +1,46 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/notifications"
+)
+
+// notificationWorkers is the number of workers that send notifications, so that one slow channel does not hold up
+// the notifications of every other workflow
+const notificationWorkers = 4
+
+// notification is a message queued to be sent to a channel by the notification workers
+type notification struct {
+	channel string
+	message notifications.Message
+}
+
+// processNextNotification sends the next queued notification. A notification that cannot be sent, even after
+// retrying, is recorded as a warning event on its workflow.
+func (wfc *WorkflowController) processNextNotification(ctx context.Context) bool {
+	n, quit := wfc.notificationQueue.Get()
+	if quit {
+		return false
+	}
+	defer wfc.notificationQueue.Done(n)
+
+	if err := wfc.notificationSender.Send(ctx, n.channel, n.message); err != nil {
+		w := n.message.Workflow
+		logging.RequireLoggerFromContext(ctx).WithError(err).WithFields(logging.Fields{"namespace": w.Namespace, "workflow": w.Name, "channel": n.channel}).Warn(ctx, "Failed to send notification")
+		wf := &wfv1.Workflow{
+			TypeMeta:   metav1.TypeMeta{APIVersion: wfv1.SchemeGroupVersion.String(), Kind: workflow.WorkflowKind},
+			ObjectMeta: metav1.ObjectMeta{Namespace: w.Namespace, Name: w.Name, UID: types.UID(w.UID)},
+		}
+		wfc.eventRecorderManager.Get(ctx, w.Namespace).Event(wf, apiv1.EventTypeWarning, "WorkflowNotificationFailed", fmt.Sprintf("Failed to send notification to channel %q: %v", n.channel, err))
+	}
+	return true
+}
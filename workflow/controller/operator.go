@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"math"
 	"os"
 	"reflect"
@@ -25,6 +26,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/tools/cache"
@@ -36,7 +38,6 @@ import (
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
-	"github.com/argoproj/argo-workflows/v3/util"
 	"github.com/argoproj/argo-workflows/v3/util/diff"
 	envutil "github.com/argoproj/argo-workflows/v3/util/env"
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
@@ -57,10 +58,15 @@ import (
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/estimation"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/expand"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/indexes"
+	"github.com/argoproj/argo-workflows/v3/workflow/lifecycle"
 	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
+	"github.com/argoproj/argo-workflows/v3/workflow/notifications"
 	"github.com/argoproj/argo-workflows/v3/workflow/progress"
+	"github.com/argoproj/argo-workflows/v3/workflow/sensitive"
 	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
+	"github.com/argoproj/argo-workflows/v3/workflow/templaterevision"
 	wfutil "github.com/argoproj/argo-workflows/v3/workflow/util"
 	"github.com/argoproj/argo-workflows/v3/workflow/validate"
 )
@@ -111,9 +117,25 @@ type wfOperationCtx struct {
 
 	taskSet map[string]wfv1.Template
 
+	// pinnedSpecs caches the specs of the revisions of the templates the workflow pinned, by their template digest key,
+	// for the duration of the operation
+	pinnedSpecs map[string]*wfv1.WorkflowSpec
+
 	// currentStackDepth tracks the depth of the "stack", increased with every nested call to executeTemplate and decreased
 	// when such calls return. This is used to prevent infinite recursion
 	currentStackDepth int
+
+	// secrets caches the secrets of parameters for the duration of the operation
+	secrets map[string]*apiv1.Secret
+
+	// encrypter encrypts the values of sensitive parameters, it is created when first needed
+	encrypter *sensitive.Encrypter
+
+	// templatePDBs are the names of the PDBs of templates created, or found to exist, during the operation
+	templatePDBs map[string]bool
+
+	// stoppedNodes are the IDs of the nodes in the subtrees users stopped
+	stoppedNodes map[string]bool
 }
 
 var (
@@ -144,6 +166,25 @@ type failedNodeStatus struct {
 	FinishedAt   metav1.Time `json:"finishedAt"`
 }
 
+// failedNodeStatuses returns the failed nodes that match the filter
+func (woc *wfOperationCtx) failedNodeStatuses(filter func(wfv1.NodeStatus) bool) []failedNodeStatus {
+	var failures []failedNodeStatus
+	for _, node := range woc.wf.Status.Nodes {
+		if (node.Phase == wfv1.NodeFailed || node.Phase == wfv1.NodeError) && filter(node) {
+			failures = append(failures,
+				failedNodeStatus{
+					DisplayName:  node.DisplayName,
+					Message:      node.Message,
+					TemplateName: wfutil.GetTemplateFromNode(node),
+					Phase:        string(node.Phase),
+					PodName:      wfutil.GeneratePodName(woc.wf.Name, node.Name, wfutil.GetTemplateFromNode(node), node.ID, wfutil.GetPodNameVersion()),
+					FinishedAt:   node.FinishedAt,
+				})
+		}
+	}
+	return failures
+}
+
 // newWorkflowOperationCtx creates and initializes a new wfOperationCtx object.
 func newWorkflowOperationCtx(ctx context.Context, wf *wfv1.Workflow, wfc *WorkflowController) *wfOperationCtx {
 	// NEVER modify objects from the store. It's a read-only, local cache.
@@ -207,6 +248,12 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 
 	woc.log.WithFields(logging.Fields{"Phase": woc.wf.Status.Phase, "ResourceVersion": woc.wf.ObjectMeta.ResourceVersion}).Info(ctx, "Processing workflow")
 
+	// Sensitive parameters are decrypted for the operation, and encrypted again when the workflow is persisted
+	if err := woc.decryptSensitiveParameters(); err != nil {
+		woc.markWorkflowError(ctx, err)
+		return
+	}
+
 	// Set the Execute workflow spec for execution
 	// ExecWF is a runtime execution spec which merged from Wf, WFT and Wfdefault
 	err := woc.setExecWorkflow(ctx)
@@ -273,6 +320,9 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 		}
 	}
 
+	woc.stoppedNodes = woc.getStoppedNodes()
+	woc.recordShutdown(ctx)
+
 	// Populate the phase of all the nodes prior to execution
 	for _, node := range woc.wf.Status.Nodes {
 		woc.preExecutionNodeStatuses[node.ID] = *node.DeepCopy()
@@ -291,6 +341,11 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 			return
 		}
 
+		if err := woc.resolveImageDigests(ctx); err != nil {
+			woc.markWorkflowError(ctx, err)
+			return
+		}
+
 		woc.markWorkflowRunning(ctx)
 		setWfPodNamesAnnotation(woc.wf)
 
@@ -311,6 +366,7 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 			// However, pending and suspended nodes do not have created pods, and taskset nodes use the agent pod.
 			// Apply execution control to these nodes now since pod reconciliation does not take effect on them.
 			woc.failNodesWithoutCreatedPodsAfterDeadlineOrShutdown(ctx)
+			woc.stopNodeSubtrees(ctx)
 		}
 
 		if err != nil {
@@ -327,7 +383,12 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 		}
 	}
 
+	woc.cascadeToChildWorkflows(ctx)
+
 	if woc.ShouldSuspend() {
+		if woc.resumeIfDue(ctx) {
+			return
+		}
 		woc.log.Info(ctx, "workflow suspended")
 		return
 	}
@@ -402,20 +463,7 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 
 	woc.globalParams[common.GlobalVarWorkflowStatus] = string(workflowStatus)
 
-	var failures []failedNodeStatus
-	for _, node := range woc.wf.Status.Nodes {
-		if node.Phase == wfv1.NodeFailed || node.Phase == wfv1.NodeError {
-			failures = append(failures,
-				failedNodeStatus{
-					DisplayName:  node.DisplayName,
-					Message:      node.Message,
-					TemplateName: wfutil.GetTemplateFromNode(node),
-					Phase:        string(node.Phase),
-					PodName:      wfutil.GeneratePodName(woc.wf.Name, node.Name, wfutil.GetTemplateFromNode(node), node.ID, wfutil.GetPodNameVersion()),
-					FinishedAt:   node.FinishedAt,
-				})
-		}
-	}
+	failures := woc.failedNodeStatuses(func(wfv1.NodeStatus) bool { return true })
 	failedNodeBytes, err := json.Marshal(failures)
 	if err != nil {
 		woc.log.WithError(err).Error(ctx, "Error marshalling failed nodes list")
@@ -485,7 +533,7 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 
 	var workflowMessage string
 	if node.FailedOrError() && woc.GetShutdownStrategy().Enabled() {
-		workflowMessage = fmt.Sprintf("Stopped with strategy '%s'", woc.GetShutdownStrategy())
+		workflowMessage = woc.shutdownMessage()
 	} else {
 		workflowMessage = node.Message
 	}
@@ -617,7 +665,7 @@ func (woc *wfOperationCtx) getWorkflowDeadline() *time.Time {
 }
 
 // setGlobalParameters sets the globalParam map with global parameters
-func (woc *wfOperationCtx) setGlobalParameters(executionParameters wfv1.Arguments) error {
+func (woc *wfOperationCtx) setGlobalParameters(ctx context.Context, executionParameters wfv1.Arguments) error {
 	woc.globalParams[common.GlobalVarWorkflowName] = woc.wf.Name
 	woc.globalParams[common.GlobalVarWorkflowNamespace] = woc.wf.Namespace
 	woc.globalParams[common.GlobalVarWorkflowMainEntrypoint] = woc.execWf.Spec.Entrypoint
@@ -648,6 +696,18 @@ func (woc *wfOperationCtx) setGlobalParameters(executionParameters wfv1.Argument
 	for _, param := range executionParameters.Parameters {
 		if param.Value != nil {
 			woc.globalParams["workflow.parameters."+param.Name] = param.Value.String()
+		} else if param.IsSecret() {
+			value, err := woc.getSecretValue(ctx, woc.wf.Namespace, param.ValueFrom.SecretKeyRef.Name, param.ValueFrom.SecretKeyRef.Key)
+			if err != nil {
+				if param.ValueFrom.Default != nil && errors.IsCode(errors.CodeNotFound, err) {
+					woc.globalParams["workflow.parameters."+param.Name] = param.ValueFrom.Default.String()
+				} else {
+					return fmt.Errorf("failed to set global parameter %s from secret with name %s and key %s: %w",
+						param.Name, param.ValueFrom.SecretKeyRef.Name, param.ValueFrom.SecretKeyRef.Key, err)
+				}
+			} else {
+				woc.globalParams["workflow.parameters."+param.Name] = value
+			}
 		} else if param.ValueFrom != nil && param.ValueFrom.ConfigMapKeyRef != nil {
 			cmValue, err := common.GetConfigMapValue(woc.controller.configMapInformer.GetIndexer(), woc.wf.Namespace, param.ValueFrom.ConfigMapKeyRef.Name, param.ValueFrom.ConfigMapKeyRef.Key)
 			if err != nil {
@@ -729,6 +789,12 @@ func (woc *wfOperationCtx) persistUpdates(ctx context.Context) {
 		return
 	}
 
+	if err := woc.encryptSensitiveParameters(ctx); err != nil {
+		woc.log.WithError(err).Warn(ctx, "Failed to encrypt sensitive parameters, not updating workflow")
+		woc.requeue()
+		return
+	}
+
 	diff.LogChanges(ctx, woc.orig, woc.wf)
 
 	resource.UpdateResourceDurations(ctx, woc.wf)
@@ -761,7 +827,22 @@ func (woc *wfOperationCtx) persistUpdates(ctx context.Context) {
 		woc.log.WithError(err).Warn(ctx, "error updating taskset")
 	}
 
-	wf, err := wfClient.Update(ctx, woc.wf, metav1.UpdateOptions{})
+	// Publish before updating, so that if we fail to publish, the transitions happen again when we retry.
+	// Consumers may see a transition more than once, but never miss one.
+	if err := woc.publishLifecycleEvents(ctx, nodes); err != nil {
+		woc.log.WithError(err).Warn(ctx, "Failed to publish lifecycle events, not updating workflow")
+		woc.requeue()
+		return
+	}
+
+	if err := woc.controller.workflowUpdateRateLimiter.Wait(ctx); err != nil {
+		woc.log.WithError(err).Warn(ctx, "Failed to wait for the workflow update rate limiter, not updating workflow")
+		woc.requeue()
+		return
+	}
+	method := woc.controller.workflowUpdateMethod
+	wf, err := woc.updateWorkflow(ctx, wfClient, method)
+	woc.controller.workflowUpdateRateLimiter.observe(err)
 	if err != nil {
 		woc.log.WithField("error", err).WithField("reason", apierr.ReasonForError(err)).Warn(ctx, "Error updating workflow")
 		if argokubeerr.IsRequestEntityTooLargeErr(err) {
@@ -771,6 +852,7 @@ func (woc *wfOperationCtx) persistUpdates(ctx context.Context) {
 		if !apierr.IsConflict(err) {
 			return
 		}
+		woc.controller.metrics.WorkflowUpdateConflict(ctx, woc.wf.Namespace, method)
 		woc.log.Info(ctx, "Re-applying updates on latest version and retrying update")
 		wf, err := woc.reapplyUpdate(ctx, wfClient, nodes)
 		if err != nil {
@@ -789,12 +871,18 @@ func (woc *wfOperationCtx) persistUpdates(ctx context.Context) {
 
 	// Create WorkflowNode* events for nodes that have changed phase
 	woc.recordNodePhaseChangeEvents(ctx, woc.orig.Status.Nodes, woc.wf.Status.Nodes)
+	woc.recordTemplateDurations(ctx, woc.orig.Status.Nodes, woc.wf.Status.Nodes)
+	if !woc.orig.Status.Fulfilled() && woc.wf.Status.Fulfilled() {
+		woc.recordResourcesDuration(ctx)
+	}
 
 	if !woc.controller.hydrator.IsHydrated(woc.wf) {
 		panic("workflow should be hydrated")
 	}
 
 	woc.log.WithFields(logging.Fields{"resourceVersion": woc.wf.ResourceVersion, "phase": woc.wf.Status.Phase}).Info(ctx, "Workflow update successful")
+	woc.queueNotifications()
+	woc.controller.informerLag.updated(woc.wf.Namespace+"/"+woc.wf.Name, woc.wf.ResourceVersion, time.Now())
 
 	switch os.Getenv("INFORMER_WRITE_BACK") {
 	// By default we write back (as per v2.11), this does not reduce errors, but does reduce
@@ -841,6 +929,47 @@ func (woc *wfOperationCtx) deleteTaskResults(ctx context.Context) error {
 		)
 }
 
+const (
+	workflowUpdateMethodUpdate = "update"
+	workflowUpdateMethodPatch  = "patch"
+	// workflowFieldManager is the field manager the controller patches workflows as
+	workflowFieldManager = "workflow-controller"
+)
+
+// workflowUpdateMethod returns how the controller persists workflows, from the WORKFLOW_UPDATE_METHOD environment
+// variable
+func workflowUpdateMethod() (string, error) {
+	method := envutil.LookupEnvStringOr("WORKFLOW_UPDATE_METHOD", workflowUpdateMethodUpdate)
+	switch method {
+	case workflowUpdateMethodUpdate, workflowUpdateMethodPatch:
+		return method, nil
+	}
+	return "", fmt.Errorf("WORKFLOW_UPDATE_METHOD must be one of: %s, %s, got %q", workflowUpdateMethodUpdate, workflowUpdateMethodPatch, method)
+}
+
+// updateWorkflow persists the workflow. An update writes the whole workflow, and conflicts if the workflow changed
+// since it was read. A patch only writes the fields the controller changed, as a JSON merge patch, which for large
+// workflows is a much smaller write, and is not conditional on the resourceVersion, so it does not conflict when
+// someone else changed other fields of the workflow meanwhile, e.g. suspended it.
+func (woc *wfOperationCtx) updateWorkflow(ctx context.Context, wfClient v1alpha1.WorkflowInterface, method string) (*wfv1.Workflow, error) {
+	if method != workflowUpdateMethodPatch {
+		return wfClient.Update(ctx, woc.wf, metav1.UpdateOptions{})
+	}
+	oldData, err := json.Marshal(woc.orig)
+	if err != nil {
+		return nil, err
+	}
+	newData, err := json.Marshal(woc.wf)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := jsonpatch.CreateMergePatch(oldData, newData)
+	if err != nil {
+		return nil, err
+	}
+	return wfClient.Patch(ctx, woc.wf.Name, types.MergePatchType, patch, metav1.PatchOptions{FieldManager: workflowFieldManager})
+}
+
 func (woc *wfOperationCtx) writeBackToInformer() error {
 	un, err := wfutil.ToUnstructured(woc.wf)
 	if err != nil {
@@ -993,11 +1122,14 @@ func (woc *wfOperationCtx) processNodeRetries(ctx context.Context, node *wfv1.No
 		return woc.markNodePhase(ctx, node.Name, wfv1.NodeSucceeded), true, nil
 	}
 
-	if woc.GetShutdownStrategy().Enabled() || (woc.workflowDeadline != nil && time.Now().UTC().After(*woc.workflowDeadline)) {
+	if woc.GetShutdownStrategy().Enabled() || woc.stoppedNodes[lastChildNode.ID] || (woc.workflowDeadline != nil && time.Now().UTC().After(*woc.workflowDeadline)) {
 		var message string
-		if woc.GetShutdownStrategy().Enabled() {
-			message = fmt.Sprintf("Stopped with strategy '%s'", woc.GetShutdownStrategy())
-		} else {
+		switch {
+		case woc.GetShutdownStrategy().Enabled():
+			message = woc.shutdownMessage()
+		case woc.stoppedNodes[lastChildNode.ID]:
+			message = woc.nodeStoppedMessage()
+		default:
 			message = fmt.Sprintf("retry exceeded workflow deadline %s", *woc.workflowDeadline)
 		}
 		woc.log.Info(ctx, message)
@@ -1206,6 +1338,10 @@ func (woc *wfOperationCtx) podReconciliation(ctx context.Context) (error, bool)
 	var wg sync.WaitGroup
 
 	for _, pod := range podList {
+		if isJobPod(pod) {
+			// the node of a Job is reconciled from the Job, not its pods
+			continue
+		}
 		parallelPodNum <- pod.Name
 		wg.Add(1)
 		go func(pod *apiv1.Pod) {
@@ -1321,7 +1457,7 @@ func (woc *wfOperationCtx) failNodesWithoutCreatedPodsAfterDeadlineOrShutdown(ct
 		if woc.GetShutdownStrategy().Enabled() && !woc.GetShutdownStrategy().ShouldExecute(node.IsPartOfExitHandler(ctx, nodes)) {
 			// fail suspended nodes or taskset nodes when shutting down
 			if node.IsActiveSuspendNode() || node.IsTaskSetNode() {
-				message := fmt.Sprintf("Stopped with strategy '%s'", woc.GetShutdownStrategy())
+				message := woc.shutdownMessage()
 				woc.markNodePhase(ctx, node.Name, wfv1.NodeFailed, message)
 				continue
 			}
@@ -1546,6 +1682,7 @@ func (woc *wfOperationCtx) assessNodeStatus(ctx context.Context, pod *apiv1.Pod,
 	if new.Fulfilled() && new.FinishedAt.IsZero() {
 		new.FinishedAt = getLatestFinishedAt(pod)
 		new.ResourcesDuration = resource.DurationForPod(pod)
+		new.EstimatedCost = resource.CostForPod(woc.controller.Config.Pricing, pod, new.ResourcesDuration)
 	}
 
 	if !reflect.DeepEqual(old, new) {
@@ -1631,6 +1768,14 @@ func getPendingReason(pod *apiv1.Pod) string {
 			return ctrStatus.State.Waiting.Reason
 		}
 	}
+	// Pods are gated until external controllers, e.g. of queues, remove the gates
+	if len(pod.Spec.SchedulingGates) > 0 {
+		var gates []string
+		for _, gate := range pod.Spec.SchedulingGates {
+			gates = append(gates, gate.Name)
+		}
+		return fmt.Sprintf("%s: waiting for scheduling gates %s", apiv1.PodReasonSchedulingGated, strings.Join(gates, ", "))
+	}
 	// Example:
 	// - lastProbeTime: null
 	//   lastTransitionTime: 2018-08-29T06:38:36Z
@@ -2022,11 +2167,18 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 	localParams["node.name"] = nodeName
 
 	// Inputs has been processed with arguments already, so pass empty arguments.
-	processedTmpl, err := common.ProcessArgs(ctx, resolvedTmpl, &args, woc.globalParams, localParams, false, woc.wf.Namespace, woc.controller.configMapInformer.GetIndexer())
+	processedTmpl, err := common.ProcessArgs(ctx, resolvedTmpl, &args, woc.globalParams, localParams, false, woc.wf.Namespace, woc.controller.configMapInformer.GetIndexer(), woc.getSecretValue)
 	if err != nil {
 		return woc.initializeNodeOrMarkError(ctx, node, nodeName, templateScope, orgTmpl, opts.boundaryID, opts.nodeFlag, err), err
 	}
 
+	if processedTmpl.Memoize != nil && processedTmpl.Memoize.Key == "" {
+		processedTmpl.Memoize.Key, err = controllercache.DefaultKey(processedTmpl)
+		if err != nil {
+			return woc.initializeNodeOrMarkError(ctx, node, nodeName, templateScope, orgTmpl, opts.boundaryID, opts.nodeFlag, err), err
+		}
+	}
+
 	// Update displayName from processedTmpl
 	if displayName := processedTmpl.GetDisplayName(); node != nil && displayName != "" {
 		if !displayNameRegex.MatchString(displayName) {
@@ -2115,6 +2267,10 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 			}
 
 			hit := entry.Hit()
+			result := metrics.MemoizationMiss
+			if hit {
+				result = metrics.MemoizationHit
+			}
 			var outputs *wfv1.Outputs
 			if processedTmpl.Memoize.MaxAge != "" {
 				maxAge, err := time.ParseDuration(processedTmpl.Memoize.MaxAge)
@@ -2125,12 +2281,16 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 				maxAgeOutputs, ok := entry.GetOutputsWithMaxAge(maxAge)
 				if !ok {
 					// The outputs are expired, so this cache entry is not hit
+					if hit {
+						result = metrics.MemoizationExpired
+					}
 					hit = false
 				}
 				outputs = maxAgeOutputs
 			} else {
 				outputs = entry.GetOutputs()
 			}
+			woc.controller.metrics.MemoizationCacheLookup(ctx, woc.wf.Namespace, processedTmpl.Memoize.Cache.ConfigMap.Name, result)
 
 			memoizationStatus := &wfv1.MemoizationStatus{
 				Hit:       hit,
@@ -2168,7 +2328,8 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 		// Memoized nodes don't have StartedAt.
 		if node.StartedAt.IsZero() {
 			node.StartedAt = metav1.Time{Time: time.Now().UTC()}
-			node.EstimatedDuration = woc.estimateNodeDuration(ctx, node.Name)
+			node.EstimatedDuration = woc.estimateNodeDuration(ctx, node.Name, node.TemplateName, node.TemplateRef)
+			node.EstimatedDurationP90 = woc.estimateNodeDurationP90(ctx, node.TemplateName, node.TemplateRef)
 			woc.wf.Status.Nodes.Set(ctx, node.ID, *node)
 			woc.updated = true
 		}
@@ -2284,6 +2445,20 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 		}
 	}
 
+	// Pause the node if the workflow is being stepped through
+	if processedTmpl.IsLeaf() {
+		paused, err := woc.stepThrough(ctx, nodeName, node, templateScope, processedTmpl, orgTmpl, opts)
+		if err != nil {
+			return woc.initializeNodeOrMarkError(ctx, node, nodeName, templateScope, orgTmpl, opts.boundaryID, opts.nodeFlag, err), err
+		}
+		if paused {
+			if retryNodeName != "" {
+				nodeName = retryNodeName
+			}
+			return woc.wf.GetNodeByName(nodeName)
+		}
+	}
+
 	switch processedTmpl.GetType() {
 	case wfv1.TemplateTypeContainer:
 		node, err = woc.executeContainer(ctx, nodeName, templateScope, processedTmpl, orgTmpl, opts)
@@ -2305,6 +2480,10 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 		node = woc.executeHTTPTemplate(ctx, nodeName, templateScope, processedTmpl, orgTmpl, opts)
 	case wfv1.TemplateTypePlugin:
 		node = woc.executePluginTemplate(ctx, nodeName, templateScope, processedTmpl, orgTmpl, opts)
+	case wfv1.TemplateTypeChildWorkflow:
+		node, err = woc.executeChildWorkflow(ctx, nodeName, templateScope, processedTmpl, orgTmpl, opts)
+	case wfv1.TemplateTypeJob:
+		node, err = woc.executeJob(ctx, nodeName, templateScope, processedTmpl, orgTmpl, opts)
 	default:
 		err = errors.Errorf(errors.CodeBadRequest, "Template '%s' missing specification", processedTmpl.Name)
 		return woc.initializeNode(ctx, nodeName, wfv1.NodeTypeSkipped, templateScope, orgTmpl, opts.boundaryID, wfv1.NodeError, opts.nodeFlag, true, err.Error()), err
@@ -2421,7 +2600,8 @@ func (woc *wfOperationCtx) checkTemplateTimeout(tmpl *wfv1.Template, node *wfv1.
 		return nil, nil
 	}
 
-	if tmpl.Timeout != "" {
+	// plugin nodes time out in any phase, see checkPluginTimeout
+	if tmpl.Timeout != "" && node.Type != wfv1.NodeTypePlugin {
 		tmplTimeout, err := time.ParseDuration(tmpl.Timeout)
 		if err != nil {
 			return nil, fmt.Errorf("invalid timeout format. %v", err)
@@ -2429,7 +2609,8 @@ func (woc *wfOperationCtx) checkTemplateTimeout(tmpl *wfv1.Template, node *wfv1.
 
 		deadline := node.StartedAt.Add(tmplTimeout)
 
-		if node.Phase == wfv1.NodePending && time.Now().After(deadline) {
+		// a pod waiting for external controllers to remove its scheduling gates is queued, not stuck
+		if node.Phase == wfv1.NodePending && !isSchedulingGated(node) && time.Now().After(deadline) {
 			return nil, ErrTimeout
 		}
 		return &deadline, nil
@@ -2438,6 +2619,11 @@ func (woc *wfOperationCtx) checkTemplateTimeout(tmpl *wfv1.Template, node *wfv1.
 	return nil, nil
 }
 
+// isSchedulingGated returns whether the node is pending because its pod has scheduling gates
+func isSchedulingGated(node *wfv1.NodeStatus) bool {
+	return strings.HasPrefix(node.Message, apiv1.PodReasonSchedulingGated+":")
+}
+
 // recordWorkflowPhaseChange stores the metrics associated with the workflow phase changing
 func (woc *wfOperationCtx) recordWorkflowPhaseChange(ctx context.Context) {
 	phase := metrics.ConvertWorkflowPhase(woc.wf.Status.Phase)
@@ -2525,7 +2711,12 @@ func (woc *wfOperationCtx) markWorkflowPhase(ctx context.Context, phase wfv1.Wor
 		}
 		woc.wf.Labels[common.LabelKeyCompleted] = "true"
 		woc.wf.Status.Conditions.UpsertCondition(wfv1.Condition{Status: metav1.ConditionTrue, Type: wfv1.ConditionTypeCompleted})
+		woc.wf.Status.ResourceRecommendations = woc.getEstimator(ctx).RecommendResources(woc.wf.Status.Nodes)
+		woc.writeArtifactManifest(ctx)
 		err := woc.deletePDBResource(ctx)
+		if err == nil {
+			err = woc.deleteTemplatePDBResources(ctx)
+		}
 		if err != nil {
 			woc.wf.Status.Phase = wfv1.WorkflowError
 			woc.wf.Labels[common.LabelKeyPhase] = string(wfv1.NodeError)
@@ -2559,8 +2750,12 @@ func (woc *wfOperationCtx) estimateWorkflowDuration(ctx context.Context) wfv1.Es
 	return woc.getEstimator(ctx).EstimateWorkflowDuration()
 }
 
-func (woc *wfOperationCtx) estimateNodeDuration(ctx context.Context, nodeName string) wfv1.EstimatedDuration {
-	return woc.getEstimator(ctx).EstimateNodeDuration(ctx, nodeName)
+func (woc *wfOperationCtx) estimateNodeDuration(ctx context.Context, nodeName, templateName string, templateRef *wfv1.TemplateRef) wfv1.EstimatedDuration {
+	return woc.getEstimator(ctx).EstimateNodeDuration(ctx, nodeName, templateName, templateRef)
+}
+
+func (woc *wfOperationCtx) estimateNodeDurationP90(ctx context.Context, templateName string, templateRef *wfv1.TemplateRef) wfv1.EstimatedDuration {
+	return woc.getEstimator(ctx).EstimateNodeDurationP90(templateName, templateRef)
 }
 
 func (woc *wfOperationCtx) hasDaemonNodes() bool {
@@ -2634,9 +2829,21 @@ var (
 func (woc *wfOperationCtx) initializeExecutableNode(ctx context.Context, nodeName string, nodeType wfv1.NodeType, templateScope string, executeTmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, boundaryID string, phase wfv1.NodePhase, nodeFlag *wfv1.NodeFlag, omitTaskResultSycned bool, messages ...string) *wfv1.NodeStatus {
 	node := woc.initializeNode(ctx, nodeName, nodeType, templateScope, orgTmpl, boundaryID, phase, nodeFlag, omitTaskResultSycned)
 
-	// Set the input values to the node.
+	// Weight the progress of the node by the template's weight, or else by how long it took before
+	if executeTmpl.ProgressWeight != nil {
+		node.ProgressWeight = *executeTmpl.ProgressWeight
+	} else {
+		node.ProgressWeight = int64(node.EstimatedDuration)
+	}
+
+	// Set the input values to the node, except those from secrets.
 	if executeTmpl.Inputs.HasInputs() {
 		node.Inputs = executeTmpl.Inputs.DeepCopy()
+		for i, param := range node.Inputs.Parameters {
+			if param.IsSecret() {
+				node.Inputs.Parameters[i].Value = nil
+			}
+		}
 	}
 
 	// Set the MemoizationStatus
@@ -2732,8 +2939,9 @@ func (woc *wfOperationCtx) initializeNode(ctx context.Context, nodeName string,
 		Phase:             phase,
 		NodeFlag:          nodeFlag,
 		StartedAt:         metav1.Time{Time: time.Now().UTC()},
-		EstimatedDuration: woc.estimateNodeDuration(ctx, nodeName),
+		EstimatedDuration: woc.estimateNodeDuration(ctx, nodeName, orgTmpl.GetTemplateName(), orgTmpl.GetTemplateRef()),
 	}
+	node.EstimatedDurationP90 = woc.estimateNodeDurationP90(ctx, node.TemplateName, node.TemplateRef)
 
 	if executable(nodeType) && !omitTaskResultSynced {
 		tmp := true
@@ -2869,6 +3077,41 @@ func (woc *wfOperationCtx) recordNodePhaseEvent(ctx context.Context, node *wfv1.
 	)
 }
 
+// publishLifecycleEvents publishes the phase transitions made during this execution of the operator loop to the
+// configured message bus
+func (woc *wfOperationCtx) publishLifecycleEvents(ctx context.Context, nodes wfv1.Nodes) error {
+	c := woc.controller.Config.LifecycleEvents
+	if c == nil {
+		return nil
+	}
+	events := lifecycle.Transitions(woc.orig, woc.wf, nodes, c.IsNodesEnabled(), metav1.Now())
+	if len(events) == 0 {
+		return nil
+	}
+	return woc.controller.lifecyclePublisher.Publish(ctx, events)
+}
+
+// queueNotifications queues the workflow's notifications of the phase change made during this execution of the
+// operator loop, to be sent by the notification workers. They are queued once the workflow has been saved, so that
+// saving it again after a conflict does not send them twice, and a slow channel does not hold up the operator.
+func (woc *wfOperationCtx) queueNotifications() {
+	phase := woc.wf.Status.Phase
+	if phase == woc.orig.Status.Phase {
+		return
+	}
+	m := notifications.Message{
+		Workflow: notifications.Workflow{Namespace: woc.wf.Namespace, Name: woc.wf.Name, UID: string(woc.wf.UID)},
+		Phase:    string(phase),
+		Message:  woc.wf.Status.Message,
+		Time:     metav1.Now(),
+	}
+	for _, n := range woc.execWf.Spec.Notifications {
+		if n.NotifiesOf(phase) {
+			woc.controller.notificationQueue.Add(notification{channel: n.Channel, message: m})
+		}
+	}
+}
+
 // recordNodePhaseChangeEvents creates WorkflowNode Kubernetes events for each node
 // that has changes logged during this execution of the operator loop.
 func (woc *wfOperationCtx) recordNodePhaseChangeEvents(ctx context.Context, old wfv1.Nodes, new wfv1.Nodes) {
@@ -2902,6 +3145,50 @@ func (woc *wfOperationCtx) recordNodePhaseChangeEvents(ctx context.Context, old
 	}
 }
 
+// recordResourcesDuration adds the resources duration of the workflow, which has just completed, to the
+// resources_duration metric, and its estimated cost, if any, to the estimated_cost metric
+func (woc *wfOperationCtx) recordResourcesDuration(ctx context.Context) {
+	resourcesDuration := make(map[string]int64, len(woc.wf.Status.ResourcesDuration))
+	for name, duration := range woc.wf.Status.ResourcesDuration {
+		resourcesDuration[string(name)] = int64(duration)
+	}
+	group := ""
+	if label := woc.controller.Config.MetricsConfig.ResourcesDurationLabel; label != "" {
+		group = woc.wf.Labels[label]
+	}
+	woc.controller.metrics.ResourcesDuration(ctx, woc.wf.Namespace, group, resourcesDuration)
+	if woc.wf.Status.EstimatedCost != nil {
+		if cost, err := woc.wf.Status.EstimatedCost.Float64(); err == nil {
+			woc.controller.metrics.EstimatedCost(ctx, woc.wf.Namespace, group, cost)
+		}
+	}
+}
+
+// recordTemplateDurations records the durations of the nodes of allowed templates that finished during this execution
+// of the operator loop
+func (woc *wfOperationCtx) recordTemplateDurations(ctx context.Context, old wfv1.Nodes, new wfv1.Nodes) {
+	c := woc.controller.Config.MetricsConfig.TemplateDuration
+	if c == nil {
+		return
+	}
+	for nodeID, newNode := range new {
+		if (newNode.Phase != wfv1.NodeSucceeded && !newNode.Phase.FailedOrError()) || newNode.StartedAt.IsZero() || newNode.FinishedAt.IsZero() {
+			continue
+		}
+		if oldNode, exists := old[nodeID]; exists && oldNode.Phase == newNode.Phase {
+			continue
+		}
+		templateRef, template := "", newNode.TemplateName
+		if newNode.TemplateRef != nil {
+			templateRef, template = newNode.TemplateRef.Name, newNode.TemplateRef.Template
+		}
+		if !c.Allowed(templateRef, template) {
+			continue
+		}
+		woc.controller.metrics.TemplateDuration(ctx, newNode.FinishedAt.Sub(newNode.StartedAt.Time), templateRef, template, string(newNode.Phase))
+	}
+}
+
 // markNodeError is a convenience method to mark a node with an error and set the message from the error
 func (woc *wfOperationCtx) markNodeError(ctx context.Context, nodeName string, err error) *wfv1.NodeStatus {
 	woc.log.WithError(err).WithField("nodeName", nodeName).Error(ctx, "marking node as error")
@@ -3140,7 +3427,11 @@ func (woc *wfOperationCtx) getTemplateOutputsFromScope(ctx context.Context, tmpl
 					return nil, err
 				}
 			}
-			param.Value = wfv1.AnyStringPtr(val)
+			value, err := formatParameterValue(val)
+			if err != nil {
+				return nil, fmt.Errorf("unable to format output parameter %s: %w", param.Name, err)
+			}
+			param.Value = wfv1.AnyStringPtr(value)
 			param.ValueFrom = nil
 			outputs.Parameters = append(outputs.Parameters, param)
 		}
@@ -3337,7 +3628,11 @@ func (woc *wfOperationCtx) addOutputsToLocalScope(prefix string, outputs *wfv1.O
 		scope.addParamToScope(fmt.Sprintf("%s.exitCode", prefix), *outputs.ExitCode)
 	}
 	for _, param := range outputs.Parameters {
-		if param.Value != nil {
+		if param.Optional {
+			key := fmt.Sprintf("%s.outputs.parameters.%s", prefix, param.Name)
+			scope.addParamToScope(key, param.GetOutputValue())
+			scope.addParamToScope(key+".supplied", strconv.FormatBool(param.IsSupplied()))
+		} else if param.Value != nil {
 			scope.addParamToScope(fmt.Sprintf("%s.outputs.parameters.%s", prefix, param.Name), param.Value.String())
 		}
 	}
@@ -3405,17 +3700,23 @@ func (woc *wfOperationCtx) processAggregateNodeOutputs(scope *wfScope, prefix st
 	sort.Sort(loopNodes(childNodes))
 	paramList := make([]map[string]string, 0)
 	outputParamValueLists := make(map[string][]string)
+	outputArtifactLocations := make(map[string][]wfv1.ArtifactLocation)
 	resultsList := make([]wfv1.Item, 0)
 	for _, node := range childNodes {
 		if node.Outputs == nil || node.Phase != wfv1.NodeSucceeded {
 			continue
 		}
+		for _, a := range node.Outputs.Artifacts {
+			if a.ArtifactLocation.HasLocationOrKey() {
+				outputArtifactLocations[a.Name] = append(outputArtifactLocations[a.Name], a.ArtifactLocation)
+			}
+		}
 		if len(node.Outputs.Parameters) > 0 {
 			param := make(map[string]string)
 			for _, p := range node.Outputs.Parameters {
-				param[p.Name] = p.Value.String()
+				param[p.Name] = p.GetOutputValue()
 				outputParamValueList := outputParamValueLists[p.Name]
-				outputParamValueList = append(outputParamValueList, p.Value.String())
+				outputParamValueList = append(outputParamValueList, p.GetOutputValue())
 				outputParamValueLists[p.Name] = outputParamValueList
 			}
 			paramList = append(paramList, param)
@@ -3453,6 +3754,11 @@ func (woc *wfOperationCtx) processAggregateNodeOutputs(scope *wfScope, prefix st
 		}
 		scope.addParamToScope(key, valueListJSON)
 	}
+	// Adding per-output aggregated artifacts, which are loaded as one input artifact
+	for outputName, locations := range outputArtifactLocations {
+		key = fmt.Sprintf("%s.outputs.artifacts.%s", prefix, outputName)
+		scope.addArtifactToScope(key, wfv1.Artifact{Name: outputName, Aggregated: locations})
+	}
 	return nil
 }
 
@@ -3513,6 +3819,9 @@ func (woc *wfOperationCtx) addParamToGlobalScope(ctx context.Context, param wfv1
 		return
 	}
 	paramName := fmt.Sprintf("workflow.outputs.parameters.%s", param.GlobalName)
+	if param.Optional && !param.IsSupplied() {
+		param.Value = wfv1.AnyStringPtr(param.GetOutputValue())
+	}
 	if param.HasValue() {
 		woc.globalParams[paramName] = param.GetValue()
 	}
@@ -3588,15 +3897,27 @@ func (woc *wfOperationCtx) executeResource(ctx context.Context, nodeName string,
 
 	tmpl = tmpl.DeepCopy()
 
-	if tmpl.Resource.SetOwnerReference {
+	if tmpl.Resource.SetOwnerReference || tmpl.Resource.Cascade.Shutdown() {
 		obj := unstructured.Unstructured{}
 		err := yaml.Unmarshal([]byte(tmpl.Resource.Manifest), &obj)
 		if err != nil {
 			return node, err
 		}
 
-		ownerReferences := obj.GetOwnerReferences()
-		obj.SetOwnerReferences(append(ownerReferences, *metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind))))
+		if tmpl.Resource.SetOwnerReference {
+			ownerReferences := obj.GetOwnerReferences()
+			obj.SetOwnerReferences(append(ownerReferences, *metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind))))
+		}
+		// the labels are how the workflow finds the workflows to cascade to
+		if gvk := obj.GroupVersionKind(); tmpl.Resource.Cascade.Shutdown() && gvk.Group == workflow.Group && gvk.Kind == workflow.WorkflowKind {
+			labels := obj.GetLabels()
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			labels[common.LabelKeyParentWorkflow] = woc.wf.Name
+			labels[common.LabelKeyCascade] = string(tmpl.Resource.Cascade)
+			obj.SetLabels(labels)
+		}
 		bytes, err := yaml.Marshal(obj.Object)
 		if err != nil {
 			return node, err
@@ -3730,129 +4051,6 @@ func addRawOutputFields(node *wfv1.NodeStatus, tmpl *wfv1.Template) *wfv1.NodeSt
 	return node
 }
 
-func processItem(ctx context.Context, tmpl template.Template, name string, index int, item wfv1.Item, obj interface{}, whenCondition string) (string, error) {
-	replaceMap := make(map[string]interface{})
-	var newName string
-
-	switch item.GetType() {
-	case wfv1.String, wfv1.Number, wfv1.Bool:
-		replaceMap["item"] = fmt.Sprintf("%v", item)
-		newName = generateNodeName(name, index, item)
-	case wfv1.Map:
-		// Handle the case when withItems is a list of maps.
-		// vals holds stringified versions of the map items which are incorporated as part of the step name.
-		// For example if the item is: {"name": "jesse","group":"developer"}
-		// the vals would be: ["name:jesse", "group:developer"]
-		// This would eventually be part of the step name (group:developer,name:jesse)
-		vals := make([]string, 0)
-		mapVal := item.GetMapVal()
-		for itemKey, itemVal := range mapVal {
-			replaceMap[fmt.Sprintf("item.%s", itemKey)] = fmt.Sprintf("%v", itemVal)
-			vals = append(vals, fmt.Sprintf("%s:%v", itemKey, itemVal))
-
-		}
-		jsonByteVal, err := json.Marshal(mapVal)
-		if err != nil {
-			return "", errors.InternalWrapError(err)
-		}
-		replaceMap["item"] = string(jsonByteVal)
-
-		// sort the values so that the name is deterministic
-		sort.Strings(vals)
-		newName = generateNodeName(name, index, strings.Join(vals, ","))
-	case wfv1.List:
-		listVal := item.GetListVal()
-		byteVal, err := json.Marshal(listVal)
-		if err != nil {
-			return "", errors.InternalWrapError(err)
-		}
-		replaceMap["item"] = string(byteVal)
-		newName = generateNodeName(name, index, listVal)
-	default:
-		return "", errors.Errorf(errors.CodeBadRequest, "withItems[%d] expected string, number, list, or map. received: %v", index, item)
-	}
-	var newStepStr string
-	// If when is not parameterised and evaluated to false, we are not executing nor resolving artifact,
-	// we allow parameter substitution to be Unresolved
-	// The parameterised when will get handle by the task-expansion
-	proceed, err := shouldExecute(whenCondition)
-	if err == nil && !proceed {
-		newStepStr, err = tmpl.Replace(ctx, replaceMap, true)
-	} else {
-		newStepStr, err = tmpl.Replace(ctx, replaceMap, false)
-	}
-	if err != nil {
-		return "", err
-	}
-	err = json.Unmarshal([]byte(newStepStr), &obj)
-	if err != nil {
-		return "", errors.InternalWrapError(err)
-	}
-	return newName, nil
-}
-
-func generateNodeName(name string, index int, desc interface{}) string {
-	// Do not display parentheses in node name. Nodes are still guaranteed to be unique due to the index number
-	replacer := strings.NewReplacer("(", "", ")", "")
-	cleanName := replacer.Replace(fmt.Sprint(desc))
-	newName := fmt.Sprintf("%s(%d:%v)", name, index, cleanName)
-	if out := util.RecoverIndexFromNodeName(newName); out != index {
-		panic(fmt.Sprintf("unrecoverable digit in generateName; wanted '%d' and got '%d'", index, out))
-	}
-	return newName
-}
-
-func expandSequence(seq *wfv1.Sequence) ([]wfv1.Item, error) {
-	var start, end int
-	var err error
-	if seq.Start != nil {
-		start, err = strconv.Atoi(seq.Start.String())
-		if err != nil {
-			return nil, err
-		}
-	}
-	if seq.End != nil {
-		end, err = strconv.Atoi(seq.End.String())
-		if err != nil {
-			return nil, err
-		}
-	} else if seq.Count != nil {
-		count, err := strconv.Atoi(seq.Count.String())
-		if err != nil {
-			return nil, err
-		}
-		if count == 0 {
-			return []wfv1.Item{}, nil
-		}
-		end = start + count - 1
-	} else {
-		return nil, errors.InternalError("neither end nor count was specified in withSequence")
-	}
-	items := make([]wfv1.Item, 0)
-	format := "%d"
-	if seq.Format != "" {
-		format = seq.Format
-	}
-	if start <= end {
-		for i := start; i <= end; i++ {
-			item, err := wfv1.ParseItem(`"` + fmt.Sprintf(format, i) + `"`)
-			if err != nil {
-				return nil, err
-			}
-			items = append(items, item)
-		}
-	} else {
-		for i := start; i >= end; i-- {
-			item, err := wfv1.ParseItem(`"` + fmt.Sprintf(format, i) + `"`)
-			if err != nil {
-				return nil, err
-			}
-			items = append(items, item)
-		}
-	}
-	return items, nil
-}
-
 func (woc *wfOperationCtx) substituteParamsInVolumes(ctx context.Context, params map[string]string) error {
 	if woc.volumes == nil {
 		return nil
@@ -3884,7 +4082,14 @@ func (woc *wfOperationCtx) createTemplateContext(ctx context.Context, scope wfv1
 	} else {
 		clusterWorkflowTemplateGetter = &templateresolution.NullClusterWorkflowTemplateGetter{}
 	}
-	tplCtx := templateresolution.NewContext(templateresolution.WrapWorkflowTemplateLister(woc.controller.wftmplInformer.Lister().WorkflowTemplates(woc.wf.Namespace)), clusterWorkflowTemplateGetter, woc.execWf, woc.wf, woc.log)
+	wftmplGetter, clusterWorkflowTemplateGetter := woc.verifyingTemplateGetters(templateresolution.WrapWorkflowTemplateLister(woc.controller.wftmplInformer.Lister().WorkflowTemplates(woc.wf.Namespace)), clusterWorkflowTemplateGetter)
+	wftmplGetter, clusterWorkflowTemplateGetter = woc.pinningTemplateGetters(wftmplGetter, clusterWorkflowTemplateGetter)
+	// imported templates are verified by the importer instead
+	wftmplGetter = templateresolution.WithImports(wftmplGetter, woc.execWf.Spec.Imports, workflowTemplateImporter{woc})
+	tplCtx := templateresolution.NewContext(wftmplGetter, clusterWorkflowTemplateGetter, woc.execWf, woc.wf, woc.log)
+	if woc.pinsTemplates() {
+		tplCtx = tplCtx.WithoutStoringTemplates()
+	}
 
 	switch scope {
 	case wfv1.ResourceScopeNamespaced:
@@ -3935,7 +4140,7 @@ func (woc *wfOperationCtx) computeMetrics(ctx context.Context, metricList []*wfv
 		metricTmpl.Labels = metricTmplSubstituted.Labels
 		metricTmpl.When = metricTmplSubstituted.When
 
-		proceed, err := shouldExecute(metricTmpl.When)
+		proceed, err := expand.ShouldExecute(metricTmpl.When)
 		if err != nil {
 			woc.reportMetricEmissionError(ctx, fmt.Sprintf("unable to compute 'when' clause for metric '%s': %s", woc.wf.Name, err))
 			continue
@@ -4066,6 +4271,107 @@ func (woc *wfOperationCtx) deletePDBResource(ctx context.Context) error {
 	return nil
 }
 
+// templatePDBName returns the name of the PDB of the pods of a template, which is also the value of their
+// LabelKeyPodDisruptionBudget label, so it must be a valid label value
+func templatePDBName(wfName, tmplName string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(tmplName))
+	prefix := wfName
+	if len(prefix) > 52 {
+		prefix = prefix[:52]
+	}
+	return fmt.Sprintf("%s-%v", strings.TrimRight(prefix, "-."), h.Sum32())
+}
+
+// createTemplatePDBResource creates the PDB of the pods of the template, if it has one
+func (woc *wfOperationCtx) createTemplatePDBResource(ctx context.Context, tmpl *wfv1.Template) error {
+	if tmpl.PodDisruptionBudget == nil {
+		return nil
+	}
+	name := templatePDBName(woc.wf.Name, tmpl.Name)
+	if woc.templatePDBs[name] {
+		return nil
+	}
+	pdbSpec := *tmpl.PodDisruptionBudget.DeepCopy()
+	if pdbSpec.Selector == nil {
+		pdbSpec.Selector = &metav1.LabelSelector{}
+	}
+	// the selector is limited to the pods of the template in this workflow, so that it cannot overlap other workflows
+	if pdbSpec.Selector.MatchLabels == nil {
+		pdbSpec.Selector.MatchLabels = map[string]string{}
+	}
+	pdbSpec.Selector.MatchLabels[common.LabelKeyWorkflow] = woc.wf.Name
+	pdbSpec.Selector.MatchLabels[common.LabelKeyPodDisruptionBudget] = name
+
+	newPDB := policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				common.LabelKeyWorkflow:            woc.wf.Name,
+				common.LabelKeyPodDisruptionBudget: name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind)),
+			},
+		},
+		Spec: pdbSpec,
+	}
+	_, err := woc.controller.kubeclientset.PolicyV1().PodDisruptionBudgets(woc.wf.Namespace).Create(ctx, &newPDB, metav1.CreateOptions{})
+	if err != nil && !apierr.IsAlreadyExists(err) {
+		return err
+	}
+	if err == nil {
+		woc.log.WithFields(logging.Fields{"template": tmpl.Name, "pdb": name}).Info(ctx, "Created PDB resource for template.")
+	}
+	if woc.templatePDBs == nil {
+		woc.templatePDBs = map[string]bool{}
+	}
+	woc.templatePDBs[name] = true
+	return nil
+}
+
+// hasTemplatePDBs returns whether any template of the workflow has a PDB
+func (woc *wfOperationCtx) hasTemplatePDBs() bool {
+	for _, tmpl := range woc.execWf.Spec.Templates {
+		if tmpl.PodDisruptionBudget != nil {
+			return true
+		}
+	}
+	for _, tmpl := range woc.wf.Status.StoredTemplates {
+		if tmpl.PodDisruptionBudget != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteTemplatePDBResources deletes the PDBs of the templates of the workflow
+func (woc *wfOperationCtx) deleteTemplatePDBResources(ctx context.Context) error {
+	if !woc.hasTemplatePDBs() {
+		return nil
+	}
+	pdbs := woc.controller.kubeclientset.PolicyV1().PodDisruptionBudgets(woc.wf.Namespace)
+	list, err := pdbs.List(ctx, metav1.ListOptions{LabelSelector: common.LabelKeyWorkflow + "=" + woc.wf.Name + "," + common.LabelKeyPodDisruptionBudget})
+	if err != nil {
+		return err
+	}
+	for _, pdb := range list.Items {
+		err := waitutil.Backoff(retry.DefaultRetry(ctx), func() (bool, error) {
+			err := pdbs.Delete(ctx, pdb.Name, metav1.DeleteOptions{})
+			if apierr.IsNotFound(err) {
+				return true, nil
+			}
+			return !errorsutil.IsTransientErr(ctx, err), err
+		})
+		if err != nil {
+			woc.log.WithField("pdb", pdb.Name).WithError(err).Error(ctx, "Unable to delete PDB resource for template.")
+			return err
+		}
+		woc.log.WithField("pdb", pdb.Name).Info(ctx, "Deleted PDB resource for template.")
+	}
+	return nil
+}
+
 // Check if the output of this node is referenced elsewhere in the Workflow. If so, make sure to include it during
 // execution.
 func (woc *wfOperationCtx) includeScriptOutput(ctx context.Context, nodeName, boundaryID string) (bool, error) {
@@ -4102,8 +4408,24 @@ func (woc *wfOperationCtx) fetchWorkflowSpec(ctx context.Context) (wfv1.Workflow
 		woc.controller.metrics.CountWorkflowTemplate(ctx, metrics.WorkflowNew, woc.wf.Spec.WorkflowTemplateRef.Name, woc.wf.Namespace, true) // not-woc-misuse
 		specHolder, err = woc.controller.cwftmplInformer.Lister().Get(woc.wf.Spec.WorkflowTemplateRef.Name)                                  // not-woc-misuse
 	} else {
-		woc.controller.metrics.CountWorkflowTemplate(ctx, metrics.WorkflowNew, woc.wf.Spec.WorkflowTemplateRef.Name, woc.wf.Namespace, false)  // not-woc-misuse
-		specHolder, err = woc.controller.wftmplInformer.Lister().WorkflowTemplates(woc.wf.Namespace).Get(woc.wf.Spec.WorkflowTemplateRef.Name) // not-woc-misuse
+		ref := woc.wf.Spec.WorkflowTemplateRef // not-woc-misuse
+		namespace := ref.GetNamespace(woc.wf.Namespace)
+		if !woc.controller.Config.WorkflowTemplateGranted(namespace, ref.Name, woc.wf.Namespace) {
+			return nil, fmt.Errorf("WorkflowTemplate %s/%s has not been granted to namespace %s", namespace, ref.Name, woc.wf.Namespace)
+		}
+		woc.controller.metrics.CountWorkflowTemplate(ctx, metrics.WorkflowNew, ref.Name, woc.wf.Namespace, false)
+		specHolder, err = woc.controller.wftmplInformer.Lister().WorkflowTemplates(namespace).Get(ref.Name)
+	}
+	if err == nil {
+		err = woc.verifyTemplate(specHolder)
+	}
+	if woc.wf.Spec.WorkflowTemplateRef.Digest != "" { // not-woc-misuse
+		// a pinned revision can still be run once the template has been deleted
+		if apierr.IsNotFound(err) {
+			return woc.pinnedWorkflowSpec(ctx, nil)
+		} else if err == nil {
+			return woc.pinnedWorkflowSpec(ctx, specHolder)
+		}
 	}
 	if err != nil {
 		return nil, err
@@ -4142,14 +4464,30 @@ func (woc *wfOperationCtx) setExecWorkflow(ctx context.Context) error {
 
 	// Perform one-time workflow validation
 	if woc.wf.Status.Phase == wfv1.WorkflowUnknown {
-		validateOpts := validate.ValidateOpts{}
+		validateOpts := validate.ValidateOpts{TemplateImporter: workflowTemplateImporter{woc}, ImagePolicy: woc.controller.Config.ImagePolicy, PodSecurityStandard: woc.controller.Config.PodSecurityStandard, SkipStoringTemplates: woc.pinsTemplates()}
 		wftmplGetter := templateresolution.WrapWorkflowTemplateInterface(woc.controller.wfclientset.ArgoprojV1alpha1().WorkflowTemplates(woc.wf.Namespace))
 		cwftmplGetter := templateresolution.WrapClusterWorkflowTemplateInterface(woc.controller.wfclientset.ArgoprojV1alpha1().ClusterWorkflowTemplates())
+		wftmplGetter, cwftmplGetter = woc.verifyingTemplateGetters(wftmplGetter, cwftmplGetter)
+		wftmplGetter, cwftmplGetter = woc.pinningTemplateGetters(wftmplGetter, cwftmplGetter)
+
+		wfDefaults, err := woc.controller.workflowDefaults(woc.wf)
+		if err != nil {
+			woc.markWorkflowError(ctx, err)
+			return err
+		}
+
+		wf := woc.wf
+		if ref := woc.wf.Spec.WorkflowTemplateRef; ref != nil && (ref.Digest != "" || ref.GetNamespace(woc.wf.Namespace) != woc.wf.Namespace) { // not-woc-misuse
+			// the getters return the latest revision of the template in the workflow's namespace, so instead validate
+			// the stored spec, which the pinned revision or the template of the other namespace has been merged into
+			wf = &wfv1.Workflow{ObjectMeta: *woc.wf.ObjectMeta.DeepCopy(), Spec: *woc.wf.Status.StoredWorkflowSpec.DeepCopy()}
+			wf.Spec.WorkflowTemplateRef = nil
+		}
 
 		// Validate the execution wfSpec
-		err := waitutil.Backoff(retry.DefaultRetry(ctx),
+		err = waitutil.Backoff(retry.DefaultRetry(ctx),
 			func() (bool, error) {
-				validationErr := validate.ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, woc.wf, woc.controller.Config.WorkflowDefaults, validateOpts)
+				validationErr := validate.ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, wfDefaults, validateOpts)
 				if validationErr != nil {
 					return !errorsutil.IsTransientErr(ctx, validationErr), validationErr
 				}
@@ -4157,11 +4495,17 @@ func (woc *wfOperationCtx) setExecWorkflow(ctx context.Context) error {
 			})
 		if err != nil {
 			msg := fmt.Sprintf("invalid spec: %s", err.Error())
+			woc.wf.Status.Conditions.UpsertCondition(wfv1.Condition{Type: wfv1.ConditionTypeSpecError, Status: metav1.ConditionTrue, Message: msg})
 			woc.markWorkflowFailed(ctx, msg)
 			return err
 		}
+		if err := woc.controller.admissionPolicies.Admit(woc.wf, &woc.execWf.Spec); err != nil {
+			woc.wf.Status.Conditions.UpsertCondition(wfv1.Condition{Type: wfv1.ConditionTypeSpecError, Status: metav1.ConditionTrue, Message: err.Error()})
+			woc.markWorkflowFailed(ctx, err.Error())
+			return err
+		}
 	}
-	err := woc.setGlobalParameters(woc.execWf.Spec.Arguments)
+	err := woc.setGlobalParameters(ctx, woc.execWf.Spec.Arguments)
 	if err != nil {
 		woc.markWorkflowFailed(ctx, fmt.Sprintf("failed to set global parameters: %s", err.Error()))
 		return err
@@ -4203,6 +4547,30 @@ func (woc *wfOperationCtx) ShouldSuspend() bool {
 	return woc.execWf.Spec.Suspend != nil && *woc.execWf.Spec.Suspend
 }
 
+// resumeIfDue resumes a suspended workflow whose resume-at annotation has passed, returning true if it did so.
+// Otherwise the workflow is requeued for when it is due.
+func (woc *wfOperationCtx) resumeIfDue(ctx context.Context) bool {
+	value, ok := woc.wf.GetAnnotations()[common.AnnotationKeyResumeAt]
+	if !ok {
+		return false
+	}
+	resumeAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		woc.log.WithField("resumeAt", value).WithError(err).Warn(ctx, "invalid resume-at annotation, ignoring")
+		return false
+	}
+	if remaining := time.Until(resumeAt); remaining > 0 {
+		woc.requeueAfter(remaining)
+		return false
+	}
+	woc.log.WithField("resumeAt", value).Info(ctx, "resuming workflow, resume-at has passed")
+	woc.wf.Spec.Suspend = nil // not-woc-misuse
+	delete(woc.wf.Annotations, common.AnnotationKeyResumeAt)
+	woc.updated = true
+	woc.requeue()
+	return true
+}
+
 func (woc *wfOperationCtx) needsStoredWfSpecUpdate() bool {
 	// woc.wf.Status.StoredWorkflowSpec.Entrypoint == "" check is mainly to support  backward compatible with 2.11.x workflow to 2.12.x
 	// Need to recalculate StoredWorkflowSpec in 2.12.x format.
@@ -4213,7 +4581,10 @@ func (woc *wfOperationCtx) needsStoredWfSpecUpdate() bool {
 }
 
 func (woc *wfOperationCtx) setStoredWfSpec(ctx context.Context) error {
-	wfDefault := woc.controller.Config.WorkflowDefaults
+	wfDefault, err := woc.controller.workflowDefaults(woc.wf)
+	if err != nil {
+		return err
+	}
 	if wfDefault == nil {
 		wfDefault = &wfv1.Workflow{}
 	}
@@ -4229,6 +4600,15 @@ func (woc *wfOperationCtx) setStoredWfSpec(ctx context.Context) error {
 		// Join WFT and WfDefault metadata to Workflow metadata.
 		wfutil.JoinWorkflowMetaData(&woc.wf.ObjectMeta, &wfDefault.ObjectMeta)
 		workflowTemplateSpec = wftHolder.GetWorkflowSpec()
+		// record the revision the workflow runs, so it can be resubmitted with the same revision
+		digest, err := templaterevision.Digest(workflowTemplateSpec)
+		if err != nil {
+			return err
+		}
+		if woc.wf.Annotations == nil {
+			woc.wf.Annotations = map[string]string{}
+		}
+		woc.wf.Annotations[common.AnnotationKeyWorkflowTemplateDigest] = digest
 	}
 	// Update the Entrypoint, ShutdownStrategy and Suspend
 	if woc.needsStoredWfSpecUpdate() {
@@ -10,8 +10,10 @@ import (
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-workflows/v3/config"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
 )
 
 var basicMetric = `
@@ -880,3 +882,75 @@ func TestRuntimeMetrics(t *testing.T) {
 	require.NoError(t, err)
 	assert.InDelta(t, float64(1), value, 0.001)
 }
+
+func TestTemplateDurationMetric(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx)
+	defer cancel()
+	controller.Config.MetricsConfig.TemplateDuration = &config.TemplateDurationConfig{Templates: []string{"main", "my-wftmpl/*"}}
+	woc := newWorkflowOperationCtx(ctx, v1alpha1.MustUnmarshalWorkflow(`metadata: {name: my-wf, namespace: my-ns}`), controller)
+
+	started := metav1.NewTime(time.Now().Add(-time.Minute))
+	finished := metav1.NewTime(started.Add(30 * time.Second))
+	old := v1alpha1.Nodes{"running": {Phase: v1alpha1.NodeRunning, TemplateName: "main", StartedAt: started}}
+	woc.recordTemplateDurations(ctx, old, v1alpha1.Nodes{
+		"running":  {Phase: v1alpha1.NodeSucceeded, TemplateName: "main", StartedAt: started, FinishedAt: finished},
+		"ref":      {Phase: v1alpha1.NodeFailed, TemplateRef: &v1alpha1.TemplateRef{Name: "my-wftmpl", Template: "build"}, StartedAt: started, FinishedAt: finished},
+		"other":    {Phase: v1alpha1.NodeSucceeded, TemplateName: "other", StartedAt: started, FinishedAt: finished},
+		"skipped":  {Phase: v1alpha1.NodeSkipped, TemplateName: "main", StartedAt: started, FinishedAt: finished},
+		"inFlight": {Phase: v1alpha1.NodeRunning, TemplateName: "main", StartedAt: started},
+	})
+
+	attribs := attribute.NewSet(
+		attribute.String(telemetry.AttribNodeTemplate, "main"),
+		attribute.String(telemetry.AttribNodeTemplateRef, ""),
+		attribute.String(telemetry.AttribNodePhase, string(v1alpha1.NodeSucceeded)),
+	)
+	histogram, err := testExporter.GetFloat64HistogramData(ctx, telemetry.InstrumentTemplateDuration.Name(), &attribs)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), histogram.Count)
+	assert.InDelta(t, 30.0, histogram.Sum, 0.001)
+
+	attribs = attribute.NewSet(
+		attribute.String(telemetry.AttribNodeTemplate, "build"),
+		attribute.String(telemetry.AttribNodeTemplateRef, "my-wftmpl"),
+		attribute.String(telemetry.AttribNodePhase, string(v1alpha1.NodeFailed)),
+	)
+	histogram, err = testExporter.GetFloat64HistogramData(ctx, telemetry.InstrumentTemplateDuration.Name(), &attribs)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), histogram.Count)
+
+	attribs = attribute.NewSet(
+		attribute.String(telemetry.AttribNodeTemplate, "other"),
+		attribute.String(telemetry.AttribNodeTemplateRef, ""),
+		attribute.String(telemetry.AttribNodePhase, string(v1alpha1.NodeSucceeded)),
+	)
+	_, err = testExporter.GetFloat64HistogramData(ctx, telemetry.InstrumentTemplateDuration.Name(), &attribs)
+	require.Error(t, err, "not allowed")
+}
+
+func TestResourcesDurationMetric(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx)
+	defer cancel()
+	controller.Config.MetricsConfig.ResourcesDurationLabel = "team"
+	woc := newWorkflowOperationCtx(ctx, v1alpha1.MustUnmarshalWorkflow(`
+metadata: {name: my-wf, namespace: my-ns, labels: {team: my-team}}
+status:
+  phase: Succeeded
+  resourcesDuration: {cpu: 10, memory: 20}
+`), controller)
+	woc.recordResourcesDuration(ctx)
+	woc.recordResourcesDuration(ctx)
+
+	for resource, seconds := range map[string]int64{"cpu": 20, "memory": 40} {
+		attribs := attribute.NewSet(
+			attribute.String(telemetry.AttribWorkflowNamespace, "my-ns"),
+			attribute.String(telemetry.AttribWorkflowGroup, "my-team"),
+			attribute.String(telemetry.AttribResourceName, resource),
+		)
+		val, err := testExporter.GetInt64CounterValue(ctx, telemetry.InstrumentResourcesDuration.Name(), &attribs)
+		require.NoError(t, err)
+		assert.Equal(t, seconds, val)
+	}
+}
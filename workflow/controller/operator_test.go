@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -21,6 +22,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/fake"
 	batchfake "k8s.io/client-go/kubernetes/typed/batch/v1/fake"
@@ -32,13 +34,17 @@ import (
 	"github.com/argoproj/argo-workflows/v3/config"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	fakewfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
 	intstrutil "github.com/argoproj/argo-workflows/v3/util/intstr"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/util/strftime"
-	"github.com/argoproj/argo-workflows/v3/util/template"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+	"github.com/argoproj/argo-workflows/v3/workflow/admission"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
 	hydratorfake "github.com/argoproj/argo-workflows/v3/workflow/hydrator/fake"
+	"github.com/argoproj/argo-workflows/v3/workflow/lifecycle"
+	"github.com/argoproj/argo-workflows/v3/workflow/notifications"
 	"github.com/argoproj/argo-workflows/v3/workflow/sync"
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
 )
@@ -118,6 +124,81 @@ func Test_wfOperationCtx_reapplyUpdate(t *testing.T) {
 	})
 }
 
+func Test_workflowUpdateMethod(t *testing.T) {
+	method, err := workflowUpdateMethod()
+	require.NoError(t, err)
+	assert.Equal(t, workflowUpdateMethodUpdate, method)
+	t.Setenv("WORKFLOW_UPDATE_METHOD", "patch")
+	method, err = workflowUpdateMethod()
+	require.NoError(t, err)
+	assert.Equal(t, workflowUpdateMethodPatch, method)
+	t.Setenv("WORKFLOW_UPDATE_METHOD", "apply")
+	_, err = workflowUpdateMethod()
+	assert.EqualError(t, err, `WORKFLOW_UPDATE_METHOD must be one of: update, patch, got "apply"`)
+}
+
+func Test_wfOperationCtx_updateWorkflow(t *testing.T) {
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", ResourceVersion: "1"},
+		Status:     wfv1.WorkflowStatus{Phase: wfv1.WorkflowRunning, Nodes: wfv1.Nodes{"foo": wfv1.NodeStatus{Name: "my-foo", Phase: wfv1.NodeRunning}}},
+	}
+	conflict := func() k8stesting.ReactionFunc {
+		conflicted := false
+		return func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if conflicted {
+				return false, nil, nil
+			}
+			conflicted = true
+			return true, nil, apierr.NewConflict(schema.GroupResource{Group: "argoproj.io", Resource: "workflows"}, "my-wf", errors.New("the object has been modified"))
+		}
+	}
+	t.Run("Patch", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		cancel, controller := newController(ctx, wf.DeepCopy())
+		defer cancel()
+		wfClient := controller.wfclientset.ArgoprojV1alpha1().Workflows("my-ns")
+		woc := newWorkflowOperationCtx(ctx, wf.DeepCopy(), controller)
+		woc.wf.Status.Nodes["bar"] = wfv1.NodeStatus{Name: "my-bar", Phase: wfv1.NodePending}
+
+		updatedWf, err := woc.updateWorkflow(ctx, wfClient, workflowUpdateMethodPatch)
+		require.NoError(t, err)
+		assert.Equal(t, wfv1.NodePending, updatedWf.Status.Nodes["bar"].Phase)
+		assert.Equal(t, wfv1.NodeRunning, updatedWf.Status.Nodes["foo"].Phase)
+
+		actions := controller.wfclientset.(*fakewfclientset.Clientset).Actions()
+		action, ok := actions[len(actions)-1].(k8stesting.PatchAction)
+		require.True(t, ok)
+		assert.Equal(t, types.MergePatchType, action.GetPatchType())
+		// only the changed node is written, and not the resourceVersion, so the patch does not conflict
+		var patch map[string]interface{}
+		require.NoError(t, json.Unmarshal(action.GetPatch(), &patch))
+		require.Len(t, patch, 1)
+		status, ok := patch["status"].(map[string]interface{})
+		require.True(t, ok)
+		require.Len(t, status, 1)
+		nodes, ok := status["nodes"].(map[string]interface{})
+		require.True(t, ok)
+		require.Len(t, nodes, 1)
+		assert.Contains(t, nodes, "bar")
+	})
+	t.Run("Conflict", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		cancel, controller := newController(ctx, wf.DeepCopy())
+		defer cancel()
+		controller.wfclientset.(*fakewfclientset.Clientset).PrependReactor("update", "workflows", conflict())
+		woc := newWorkflowOperationCtx(ctx, wf.DeepCopy(), controller)
+		woc.wf.Status.Nodes["bar"] = wfv1.NodeStatus{Name: "my-bar", Phase: wfv1.NodePending}
+		woc.updated = true
+		woc.persistUpdates(ctx)
+		assert.Contains(t, woc.wf.Status.Nodes, "bar")
+
+		attribs := attribute.NewSet(attribute.String("namespace", "my-ns"), attribute.String("method", workflowUpdateMethodUpdate))
+		val, err := testExporter.GetInt64CounterValue(ctx, telemetry.InstrumentWorkflowUpdateConflicts.Name(), &attribs)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), val)
+	})
+}
+
 func TestResourcesDuration(t *testing.T) {
 	wf := wfv1.MustUnmarshalWorkflow(`
 metadata:
@@ -152,6 +233,56 @@ spec:
 	}), "zero node durations empty")
 }
 
+func TestEstimatedCost(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  namespace: my-ns
+spec:
+  entrypoint: main
+  templates:
+   - name: main
+     dag:
+       tasks:
+       - name: pod
+         template: pod
+   - name: pod
+     container:
+       image: my-image
+`)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf, func(wfc *WorkflowController) {
+		wfc.Config.Pricing = &config.Pricing{Prices: config.PricingRates{CPUHour: 10, MemoryGBHour: 1.024}}
+	})
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+
+	// each container ran for an hour
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, func(pod *apiv1.Pod, _ *wfOperationCtx) {
+		for _, c := range pod.Spec.Containers {
+			pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, apiv1.ContainerStatus{
+				Name: c.Name,
+				State: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{
+					StartedAt:  metav1.NewTime(time.Now().Add(-time.Hour)),
+					FinishedAt: metav1.Now(),
+				}},
+			})
+		}
+	})
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	// per container, the default 100m CPU costs 1 and the default 100Mi of memory costs 0.1
+	require.NotNil(t, woc.wf.Status.EstimatedCost)
+	assert.Equal(t, "2.2", string(woc.wf.Status.EstimatedCost.Value))
+	for _, node := range woc.wf.Status.Nodes {
+		require.NotNil(t, node.EstimatedCost, node.Name)
+		assert.Equal(t, "2.2", string(node.EstimatedCost.Value), node.Name)
+	}
+}
+
 func TestGlobalParamDuration(t *testing.T) {
 	wf := wfv1.MustUnmarshalWorkflow(`
 metadata:
@@ -2413,7 +2544,7 @@ func TestSuspendResume(t *testing.T) {
 
 	// suspend the workflow
 	ctx := logging.TestContext(t.Context())
-	err := util.SuspendWorkflow(ctx, wfcset, wf.Name)
+	err := util.SuspendWorkflow(ctx, wfcset, wf.Name, time.Time{})
 	require.NoError(t, err)
 	wf, err = wfcset.Get(ctx, wf.Name, metav1.GetOptions{})
 	require.NoError(t, err)
@@ -2439,6 +2570,48 @@ func TestSuspendResume(t *testing.T) {
 	assert.Len(t, pods.Items, 2)
 }
 
+// TestSuspendUntil tests that the controller resumes a workflow once its resume-at time has passed
+func TestSuspendUntil(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(stepsTemplateParallelismLimit)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	// suspend the workflow until an hour from now, operate should leave it suspended
+	ctx := logging.TestContext(t.Context())
+	err := util.SuspendWorkflow(ctx, wfcset, wf.Name, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	wf, err = wfcset.Get(ctx, wf.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	wf, err = wfcset.Get(ctx, wf.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, *wf.Spec.Suspend)
+	assert.Contains(t, wf.Annotations, common.AnnotationKeyResumeAt)
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	assert.Empty(t, pods.Items)
+
+	// move the resume time into the past, operate should resume it
+	err = util.SuspendWorkflow(ctx, wfcset, wf.Name, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	wf, err = wfcset.Get(ctx, wf.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	woc = newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	wf, err = wfcset.Get(ctx, wf.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, wf.Spec.Suspend)
+	assert.NotContains(t, wf.Annotations, common.AnnotationKeyResumeAt)
+
+	woc = newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	pods, err = listPods(ctx, woc)
+	require.NoError(t, err)
+	assert.Len(t, pods.Items, 2)
+}
+
 var suspendTemplateWithDeadline = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -2839,7 +3012,7 @@ func TestSuspendTemplateWithFailedResume(t *testing.T) {
 	assert.Empty(t, pods.Items)
 
 	// resume the workflow. verify resume workflow edits nodestatus correctly
-	err = util.StopWorkflow(ctx, wfcset, controller.hydrator, wf.Name, "inputs.parameters.param1.value=value1", "Step failed!")
+	err = util.StopWorkflow(ctx, wfcset, controller.hydrator, wf.Name, "inputs.parameters.param1.value=value1", "Step failed!", util.ShutdownOptions{})
 	require.NoError(t, err)
 	wf, err = wfcset.Get(ctx, wf.Name, metav1.GetOptions{})
 	require.NoError(t, err)
@@ -3291,6 +3464,43 @@ func TestAddGlobalParamToScope(t *testing.T) {
 	assert.Equal(t, newValue.String(), woc.globalParams["workflow.outputs.parameters.global-param2"])
 }
 
+func TestAddOptionalOutputParametersToScope(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	woc := newWoc(ctx)
+	scope := createScope(nil)
+	woc.addOutputsToLocalScope("steps.a", &wfv1.Outputs{Parameters: []wfv1.Parameter{
+		{Name: "missing", Optional: true, ValueFrom: &wfv1.ValueFrom{Path: "/missing"}},
+		{Name: "defaulted", Optional: true, ValueFrom: &wfv1.ValueFrom{Path: "/defaulted", Default: wfv1.AnyStringPtr("my-default")}},
+		{Name: "present", Optional: true, Value: wfv1.AnyStringPtr("my-value"), ValueFrom: &wfv1.ValueFrom{Path: "/present"}},
+	}}, scope)
+	params := scope.getParameters()
+	assert.Empty(t, params["steps.a.outputs.parameters.missing"])
+	assert.Equal(t, "false", params["steps.a.outputs.parameters.missing.supplied"])
+	assert.Equal(t, "my-default", params["steps.a.outputs.parameters.defaulted"])
+	assert.Equal(t, "false", params["steps.a.outputs.parameters.defaulted.supplied"])
+	assert.Equal(t, "my-value", params["steps.a.outputs.parameters.present"])
+	assert.Equal(t, "true", params["steps.a.outputs.parameters.present.supplied"])
+}
+
+func TestAggregateOutputArtifacts(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	woc := newWoc(ctx)
+	scope := createScope(nil)
+	s3 := func(key string) wfv1.ArtifactLocation {
+		return wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: key}}
+	}
+	err := woc.processAggregateNodeOutputs(scope, "steps.a", []wfv1.NodeStatus{
+		{Name: "a(1:b)", Phase: wfv1.NodeSucceeded, Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{{Name: "out", ArtifactLocation: s3("b")}}}},
+		{Name: "a(0:a)", Phase: wfv1.NodeSucceeded, Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{{Name: "out", ArtifactLocation: s3("a")}}}},
+		{Name: "a(2:c)", Phase: wfv1.NodeFailed, Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{{Name: "out", ArtifactLocation: s3("c")}}}},
+	})
+	require.NoError(t, err)
+	art, err := scope.resolveArtifact(ctx, &wfv1.Artifact{From: "{{steps.a.outputs.artifacts.out}}"})
+	require.NoError(t, err)
+	assert.True(t, art.HasLocationOrKey())
+	assert.Equal(t, []wfv1.ArtifactLocation{s3("a"), s3("b")}, art.Aggregated)
+}
+
 func TestAddGlobalArtifactToScope(t *testing.T) {
 	ctx := logging.TestContext(t.Context())
 	woc := newWoc(ctx)
@@ -3358,78 +3568,6 @@ func TestGlobalParamSubstitutionWithArtifact(t *testing.T) {
 	assert.Len(t, pods.Items, 1)
 }
 
-func TestExpandWithSequence(t *testing.T) {
-	var seq wfv1.Sequence
-	var items []wfv1.Item
-	var err error
-
-	seq = wfv1.Sequence{
-		Count: intstrutil.ParsePtr("10"),
-	}
-	items, err = expandSequence(&seq)
-	require.NoError(t, err)
-	assert.Len(t, items, 10)
-	assert.Equal(t, "0", items[0].GetStrVal())
-	assert.Equal(t, "9", items[9].GetStrVal())
-
-	seq = wfv1.Sequence{
-		Start: intstrutil.ParsePtr("101"),
-		Count: intstrutil.ParsePtr("10"),
-	}
-	items, err = expandSequence(&seq)
-	require.NoError(t, err)
-	assert.Len(t, items, 10)
-	assert.Equal(t, "101", items[0].GetStrVal())
-	assert.Equal(t, "110", items[9].GetStrVal())
-
-	seq = wfv1.Sequence{
-		Start: intstrutil.ParsePtr("50"),
-		End:   intstrutil.ParsePtr("60"),
-	}
-	items, err = expandSequence(&seq)
-	require.NoError(t, err)
-	assert.Len(t, items, 11)
-	assert.Equal(t, "50", items[0].GetStrVal())
-	assert.Equal(t, "60", items[10].GetStrVal())
-
-	seq = wfv1.Sequence{
-		Start: intstrutil.ParsePtr("60"),
-		End:   intstrutil.ParsePtr("50"),
-	}
-	items, err = expandSequence(&seq)
-	require.NoError(t, err)
-	assert.Len(t, items, 11)
-	assert.Equal(t, "60", items[0].GetStrVal())
-	assert.Equal(t, "50", items[10].GetStrVal())
-
-	seq = wfv1.Sequence{
-		Count: intstrutil.ParsePtr("0"),
-	}
-	items, err = expandSequence(&seq)
-	require.NoError(t, err)
-	assert.Empty(t, items)
-
-	seq = wfv1.Sequence{
-		Start: intstrutil.ParsePtr("8"),
-		End:   intstrutil.ParsePtr("8"),
-	}
-	items, err = expandSequence(&seq)
-	require.NoError(t, err)
-	assert.Len(t, items, 1)
-	assert.Equal(t, "8", items[0].GetStrVal())
-
-	seq = wfv1.Sequence{
-		Format: "testuser%02X",
-		Count:  intstrutil.ParsePtr("10"),
-		Start:  intstrutil.ParsePtr("1"),
-	}
-	items, err = expandSequence(&seq)
-	require.NoError(t, err)
-	assert.Len(t, items, 10)
-	assert.Equal(t, "testuser01", items[0].GetStrVal())
-	assert.Equal(t, "testuser0A", items[9].GetStrVal())
-}
-
 var metadataTemplate = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -4446,6 +4584,101 @@ func TestPDBCreationRaceDelete(t *testing.T) {
 	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
 }
 
+var templatePDBWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: my-template-pdb-wf
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: critical
+        template: critical
+        withItems: [1, 2]
+      - name: preemptible
+        template: preemptible
+  - name: critical
+    podDisruptionBudget:
+      maxUnavailable: 0
+    container:
+      image: docker/whalesay:latest
+  - name: preemptible
+    container:
+      image: docker/whalesay:latest
+`
+
+func TestTemplatePDB(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(templatePDBWf)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+	ctx := logging.TestContext(t.Context())
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+
+	name := templatePDBName(wf.Name, "critical")
+	pdbs, err := controller.kubeclientset.PolicyV1().PodDisruptionBudgets("").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, pdbs.Items, 1, "one PDB for the pods of the template")
+	pdb := pdbs.Items[0]
+	assert.Equal(t, name, pdb.Name)
+	assert.Equal(t, map[string]string{common.LabelKeyWorkflow: wf.Name, common.LabelKeyPodDisruptionBudget: name}, pdb.Spec.Selector.MatchLabels)
+
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 3)
+	selected := 0
+	for _, pod := range pods.Items {
+		if pod.Labels[common.LabelKeyPodDisruptionBudget] == name {
+			selected++
+		}
+	}
+	assert.Equal(t, 2, selected, "the fanned out pods of the template are selected")
+
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	pdbs, err = controller.kubeclientset.PolicyV1().PodDisruptionBudgets("").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, pdbs.Items, "the PDB is deleted on completion")
+}
+
+func TestResourceRecommendations(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+	ctx := logging.TestContext(t.Context())
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	assert.Nil(t, woc.wf.Status.ResourceRecommendations, "not until the workflow completes")
+
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+	// as reported by the wait container in the task result
+	node := woc.wf.Status.Nodes[woc.wf.NodeID(woc.wf.Name)]
+	node.PeakResourceUsage = apiv1.ResourceList{apiv1.ResourceCPU: resource.MustParse("100m"), apiv1.ResourceMemory: resource.MustParse("100Mi")}
+	woc.wf.Status.Nodes[node.ID] = node
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	recommendation, ok := woc.wf.Status.ResourceRecommendations["whalesay"]
+	require.True(t, ok)
+	assert.Equal(t, int64(1), recommendation.Samples)
+	assert.Equal(t, "100m", recommendation.Requests.Cpu().String())
+	assert.Equal(t, "100Mi", recommendation.Requests.Memory().String())
+	assert.Equal(t, "125Mi", recommendation.Limits.Memory().String())
+}
+
+func Test_templatePDBName(t *testing.T) {
+	assert.Equal(t, templatePDBName("my-wf", "a"), templatePDBName("my-wf", "a"))
+	assert.NotEqual(t, templatePDBName("my-wf", "a"), templatePDBName("my-wf", "b"))
+	assert.LessOrEqual(t, len(templatePDBName(strings.Repeat("x", 253), "a")), 63)
+}
+
 func TestStatusConditions(t *testing.T) {
 	wf := wfv1.MustUnmarshalWorkflow(pdbwf)
 	cancel, controller := newController(logging.TestContext(t.Context()), wf)
@@ -5438,17 +5671,6 @@ func TestNoOnExitWhenSkipped(t *testing.T) {
 	require.Error(t, err)
 }
 
-func TestGenerateNodeName(t *testing.T) {
-	assert.Equal(t, "sleep(10:ten)", generateNodeName("sleep", 10, "ten"))
-	item, err := wfv1.ParseItem(`[{"foo": "bar"}]`)
-	require.NoError(t, err)
-	assert.Equal(t, `sleep(10:[{"foo":"bar"}])`, generateNodeName("sleep", 10, item))
-	require.NoError(t, err)
-	item, err = wfv1.ParseItem("[10]")
-	require.NoError(t, err)
-	assert.Equal(t, `sleep(10:[10])`, generateNodeName("sleep", 10, item))
-}
-
 // This tests that we don't wait a backoff if it would exceed the maxDuration anyway.
 func TestPanicMetric(t *testing.T) {
 	wf := wfv1.MustUnmarshalWorkflow(noOnExitWhenSkipped)
@@ -6065,6 +6287,10 @@ func TestConfigMapCacheLoadOperateMaxAge(t *testing.T) {
 		assert.Equal(t, "foobar", node.Outputs.Parameters[0].Value.String())
 		assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
 	}
+	attribs := attribute.NewSet(attribute.String("namespace", wf.Namespace), attribute.String("cache_name", "whalesay-cache"), attribute.String("result", "hit"))
+	val, err := testExporter.GetInt64CounterValue(ctx, telemetry.InstrumentMemoizationCacheLookups.Name(), &attribs)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), val)
 
 	cancel()
 	cancel, controller = newController(logging.TestContext(t.Context()))
@@ -6082,6 +6308,10 @@ func TestConfigMapCacheLoadOperateMaxAge(t *testing.T) {
 		assert.Nil(t, node.Outputs)
 		assert.Equal(t, wfv1.NodePending, node.Phase)
 	}
+	attribs = attribute.NewSet(attribute.String("namespace", wf.Namespace), attribute.String("cache_name", "whalesay-cache"), attribute.String("result", "expired"))
+	val, err = testExporter.GetInt64CounterValue(ctx, telemetry.InstrumentMemoizationCacheLookups.Name(), &attribs)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), val)
 }
 
 var workflowStepCachedWithRetryStrategy = `
@@ -6947,23 +7177,6 @@ func TestFailSuspendedAndPendingNodesAfterShutdown(t *testing.T) {
 	})
 }
 
-func Test_processItem(t *testing.T) {
-	ctx := logging.TestContext(t.Context())
-	task := wfv1.DAGTask{
-		WithParam: `[{"number": 2, "string": "foo", "list": [0, "1"], "json": {"number": 2, "string": "foo", "list": [0, "1"]}}]`,
-	}
-	taskBytes, err := json.Marshal(task)
-	require.NoError(t, err)
-	var items []wfv1.Item
-	wfv1.MustUnmarshal([]byte(task.WithParam), &items)
-
-	var newTask wfv1.DAGTask
-	tmpl, _ := template.NewTemplate(string(taskBytes))
-	newTaskName, err := processItem(ctx, tmpl, "task-name", 0, items[0], &newTask, "")
-	require.NoError(t, err)
-	assert.Equal(t, `task-name(0:json:{"list":[0,"1"],"number":2,"string":"foo"},list:[0,"1"],number:2,string:foo)`, newTaskName)
-}
-
 var stepTimeoutWf = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -7019,6 +7232,29 @@ spec:
       args: ["hello world"]
 `
 
+func TestGetPendingReasonSchedulingGated(t *testing.T) {
+	pod := &apiv1.Pod{
+		Spec: apiv1.PodSpec{SchedulingGates: []apiv1.PodSchedulingGate{{Name: "example.com/queue"}, {Name: "example.com/quota"}}},
+		Status: apiv1.PodStatus{
+			Phase:      apiv1.PodPending,
+			Conditions: []apiv1.PodCondition{{Type: apiv1.PodScheduled, Status: apiv1.ConditionFalse, Reason: apiv1.PodReasonSchedulingGated}},
+		},
+	}
+	assert.Equal(t, "SchedulingGated: waiting for scheduling gates example.com/queue, example.com/quota", getPendingReason(pod))
+}
+
+func TestCheckTemplateTimeoutSchedulingGated(t *testing.T) {
+	woc := &wfOperationCtx{}
+	tmpl := &wfv1.Template{Timeout: "1s"}
+	node := &wfv1.NodeStatus{Phase: wfv1.NodePending, StartedAt: metav1.NewTime(time.Now().Add(-time.Minute))}
+	_, err := woc.checkTemplateTimeout(tmpl, node)
+	require.ErrorIs(t, err, ErrTimeout)
+
+	node.Message = "SchedulingGated: waiting for scheduling gates example.com/queue"
+	_, err = woc.checkTemplateTimeout(tmpl, node)
+	require.NoError(t, err, "gated pods are queued, not timed out")
+}
+
 func TestTemplateTimeoutDuration(t *testing.T) {
 	t.Run("Step Template Deadline", func(t *testing.T) {
 		wf := wfv1.MustUnmarshalWorkflow(stepTimeoutWf)
@@ -7424,7 +7660,7 @@ func TestWFWithRetryAndWithParam(t *testing.T) {
 		ctrs := pods.Items[0].Spec.Containers
 		assert.Len(t, ctrs, 2)
 		envs := ctrs[1].Env
-		assert.Len(t, envs, 7)
+		assert.Len(t, envs, 8)
 		assert.Equal(t, apiv1.EnvVar{Name: "ARGO_INCLUDE_SCRIPT_OUTPUT", Value: "true"}, envs[2])
 	})
 }
@@ -10595,6 +10831,85 @@ func TestMemoizationTemplateLevelCacheWithDagWithCache(t *testing.T) {
 	require.Nil(t, node, "Whalesay dag should not have been executed")
 }
 
+var workflowWithDagMemoizedByInputs = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  namespace: default
+  name: memoized-by-inputs
+spec:
+  entrypoint: entrypoint
+  arguments:
+    parameters:
+    - name: message
+      value: hello
+  templates:
+  - name: entrypoint
+    inputs:
+      parameters:
+      - name: message
+    memoize:
+      cache:
+        configMap:
+          name: cache-by-inputs
+    dag:
+      tasks:
+      - name: whalesay-task
+        template: whalesay
+        arguments:
+          parameters:
+          - name: message
+            value: "{{inputs.parameters.message}}"
+
+  - name: whalesay
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: docker/whalesay:latest
+      command: [cowsay, "{{inputs.parameters.message}}"]
+`
+
+func TestMemoizationDagByInputs(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx)
+	defer cancel()
+
+	run := func(name, message string) *wfv1.Workflow {
+		wf := wfv1.MustUnmarshalWorkflow(workflowWithDagMemoizedByInputs)
+		wf.Name = name
+		wf.Spec.Arguments.Parameters[0].Value = wfv1.AnyStringPtr(message)
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+		woc.operate(ctx)
+		return woc.wf
+	}
+
+	wf := run("first", "hello")
+	node := wf.Status.Nodes.Find(nodeWithTemplateName("entrypoint"))
+	require.NotNil(t, node)
+	assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+	assert.False(t, node.MemoizationStatus.Hit)
+	assert.Regexp(t, "^entrypoint-[0-9a-f]{64}$", node.MemoizationStatus.Key)
+	key := node.MemoizationStatus.Key
+	assert.NotNil(t, wf.Status.Nodes.Find(nodeWithTemplateName("whalesay")))
+
+	wf = run("same-inputs", "hello")
+	node = wf.Status.Nodes.Find(nodeWithTemplateName("entrypoint"))
+	require.NotNil(t, node)
+	assert.True(t, node.MemoizationStatus.Hit)
+	assert.Equal(t, key, node.MemoizationStatus.Key)
+	assert.Nil(t, wf.Status.Nodes.Find(nodeWithTemplateName("whalesay")), "the DAG should have been skipped")
+
+	wf = run("other-inputs", "goodbye")
+	node = wf.Status.Nodes.Find(nodeWithTemplateName("entrypoint"))
+	require.NotNil(t, node)
+	assert.False(t, node.MemoizationStatus.Hit)
+	assert.NotEqual(t, key, node.MemoizationStatus.Key)
+	assert.NotNil(t, wf.Status.Nodes.Find(nodeWithTemplateName("whalesay")))
+}
+
 var maxDepth = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -12155,3 +12470,344 @@ func TestMarksWFSucceeded(t *testing.T) {
 	woc.operate(ctx)
 	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
 }
+
+type fakeLifecyclePublisher struct {
+	events []lifecycle.Event
+	err    error
+}
+
+func (p *fakeLifecyclePublisher) Publish(_ context.Context, events []lifecycle.Event) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.events = append(p.events, events...)
+	return nil
+}
+
+func (p *fakeLifecyclePublisher) Close() {}
+
+func TestPublishLifecycleEvents(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	t.Run("Published", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		publisher := &fakeLifecyclePublisher{}
+		cancel, controller := newController(ctx, wf.DeepCopy(), func(wfc *WorkflowController) {
+			wfc.Config.LifecycleEvents = &config.LifecycleEvents{}
+			wfc.lifecyclePublisher = publisher
+		})
+		defer cancel()
+		woc := newWorkflowOperationCtx(ctx, wf.DeepCopy(), controller)
+		woc.operate(ctx)
+		require.Len(t, publisher.events, 2)
+		assert.Equal(t, lifecycle.EventTypeWorkflowPhaseChanged, publisher.events[0].Type)
+		assert.Equal(t, "Running", publisher.events[0].Phase)
+		assert.Equal(t, lifecycle.EventTypeNodePhaseChanged, publisher.events[1].Type)
+		assert.Equal(t, "Pending", publisher.events[1].Phase)
+	})
+	t.Run("NotPublished", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		publisher := &fakeLifecyclePublisher{err: errors.New("bus unavailable")}
+		cancel, controller := newController(ctx, wf.DeepCopy(), func(wfc *WorkflowController) {
+			wfc.Config.LifecycleEvents = &config.LifecycleEvents{}
+			wfc.lifecyclePublisher = publisher
+		})
+		defer cancel()
+		woc := newWorkflowOperationCtx(ctx, wf.DeepCopy(), controller)
+		woc.operate(ctx)
+		// the workflow is not updated, so the transitions will be published when we retry
+		persisted, err := controller.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Get(ctx, wf.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, persisted.Status.Phase)
+	})
+}
+
+type fakeNotificationSender struct {
+	sent map[string][]notifications.Message
+	err  error
+}
+
+func (s *fakeNotificationSender) Send(_ context.Context, channel string, m notifications.Message) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.sent[channel] = append(s.sent[channel], m)
+	return nil
+}
+
+// sendQueuedNotifications sends the notifications queued by the operator, like the notification workers do
+func sendQueuedNotifications(ctx context.Context, controller *WorkflowController) {
+	for controller.notificationQueue.Len() > 0 {
+		controller.processNextNotification(ctx)
+	}
+}
+
+func TestSendNotifications(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	wf.Spec.Notifications = []wfv1.Notification{
+		{Channel: "all", Events: []wfv1.WorkflowPhase{wfv1.WorkflowRunning, wfv1.WorkflowSucceeded}},
+		{Channel: "completed"},
+	}
+	operate := func(t *testing.T, sender *fakeNotificationSender) (*wfOperationCtx, *WorkflowController) {
+		ctx := logging.TestContext(t.Context())
+		cancel, controller := newController(ctx, wf.DeepCopy(), func(wfc *WorkflowController) {
+			wfc.notificationSender = sender
+		})
+		t.Cleanup(cancel)
+		woc := newWorkflowOperationCtx(ctx, wf.DeepCopy(), controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		sendQueuedNotifications(ctx, controller)
+		return woc, controller
+	}
+	t.Run("Sent", func(t *testing.T) {
+		sender := &fakeNotificationSender{sent: map[string][]notifications.Message{}}
+		woc, _ := operate(t, sender)
+		assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+		require.Len(t, sender.sent["all"], 2)
+		assert.Equal(t, "Running", sender.sent["all"][0].Phase)
+		assert.Equal(t, "Succeeded", sender.sent["all"][1].Phase)
+		require.Len(t, sender.sent["completed"], 1)
+		assert.Equal(t, "Succeeded", sender.sent["completed"][0].Phase)
+		assert.Equal(t, notifications.Workflow{Namespace: wf.Namespace, Name: wf.Name}, sender.sent["completed"][0].Workflow)
+	})
+	t.Run("NotSent", func(t *testing.T) {
+		woc, controller := operate(t, &fakeNotificationSender{err: errors.New("slack unavailable")})
+		// the workflow is not blocked by the failed notifications
+		assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+		events := controller.eventRecorderManager.(*testEventRecorderManager).eventRecorder.Events
+		var failed []string
+		for len(events) > 0 {
+			if e := <-events; strings.Contains(e, "WorkflowNotificationFailed") {
+				failed = append(failed, e)
+			}
+		}
+		assert.Len(t, failed, 3)
+	})
+	t.Run("NotSaved", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		sender := &fakeNotificationSender{sent: map[string][]notifications.Message{}}
+		cancel, controller := newController(ctx, wf.DeepCopy(), func(wfc *WorkflowController) {
+			wfc.notificationSender = sender
+		})
+		defer cancel()
+		controller.wfclientset.(*fakewfclientset.Clientset).PrependReactor("update", "workflows", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, errors.New("unavailable")
+		})
+		woc := newWorkflowOperationCtx(ctx, wf.DeepCopy(), controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+		// the notifications are sent once the workflow is saved, so they are not sent twice when it is retried
+		assert.Equal(t, 0, controller.notificationQueue.Len())
+		sendQueuedNotifications(ctx, controller)
+		assert.Empty(t, sender.sent)
+	})
+}
+
+func TestAdmissionPolicies(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	newWoc := func(ctx context.Context, expression string) *wfOperationCtx {
+		policies, err := admission.Compile([]config.AdmissionPolicy{{Name: "allowed-images", Expression: expression, Message: "images must be from registry.example.com"}})
+		require.NoError(t, err)
+		cancel, controller := newController(ctx, wf.DeepCopy(), func(wfc *WorkflowController) {
+			wfc.admissionPolicies = policies
+		})
+		t.Cleanup(cancel)
+		return newWorkflowOperationCtx(ctx, wf.DeepCopy(), controller)
+	}
+	t.Run("Admitted", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		woc := newWoc(ctx, `object.spec.templates.all(t, t.container.image == "docker/whalesay:latest")`)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+	})
+	t.Run("Rejected", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		woc := newWoc(ctx, `object.spec.templates.all(t, t.container.image.startsWith("registry.example.com/"))`)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+		assert.Equal(t, "workflow rejected by admission policies: allowed-images: images must be from registry.example.com", woc.wf.Status.Message)
+		require.Len(t, woc.wf.Status.Conditions, 2)
+		assert.Contains(t, woc.wf.Status.Conditions, wfv1.Condition{Type: wfv1.ConditionTypeSpecError, Status: metav1.ConditionTrue, Message: woc.wf.Status.Message})
+	})
+}
+
+func TestImagePolicy(t *testing.T) {
+	newWoc := func(ctx context.Context, wf *wfv1.Workflow, policy config.ImagePolicy) *wfOperationCtx {
+		cancel, controller := newController(ctx, wf.DeepCopy(), func(wfc *WorkflowController) {
+			wfc.Config.ImagePolicy = &policy
+		})
+		t.Cleanup(cancel)
+		return newWorkflowOperationCtx(ctx, wf.DeepCopy(), controller)
+	}
+	t.Run("Allowed", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		woc := newWoc(ctx, wfv1.MustUnmarshalWorkflow(helloWorldWf), config.ImagePolicy{Allowed: []string{"docker.io/docker/whalesay:*"}})
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+	})
+	t.Run("NotPinned", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		woc := newWoc(ctx, wfv1.MustUnmarshalWorkflow(helloWorldWf), config.ImagePolicy{RequireDigest: true})
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+		assert.Equal(t, `invalid spec: templates.whalesay.container.image: image "docker/whalesay:latest" is not pinned to a digest`, woc.wf.Status.Message)
+		assert.Contains(t, woc.wf.Status.Conditions, wfv1.Condition{Type: wfv1.ConditionTypeSpecError, Status: metav1.ConditionTrue, Message: woc.wf.Status.Message})
+	})
+	t.Run("Patched", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+		wf.Spec.PodSpecPatch = `{"containers":[{"name":"main","image":"evil"}]}`
+		woc := newWoc(ctx, wf, config.ImagePolicy{Allowed: []string{"docker.io/docker/whalesay:*"}})
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowError, woc.wf.Status.Phase)
+		assert.Contains(t, woc.wf.Status.Message, `container "main": image "evil" is not allowed`)
+		pods, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		assert.Empty(t, pods.Items)
+	})
+}
+
+func TestPodSecurityStandard(t *testing.T) {
+	newWoc := func(ctx context.Context, wf *wfv1.Workflow) *wfOperationCtx {
+		cancel, controller := newController(ctx, wf.DeepCopy(), func(wfc *WorkflowController) {
+			wfc.Config.PodSecurityStandard = "restricted"
+		})
+		t.Cleanup(cancel)
+		return newWorkflowOperationCtx(ctx, wf.DeepCopy(), controller)
+	}
+	t.Run("Hardened", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		woc := newWoc(ctx, wfv1.MustUnmarshalWorkflow(helloWorldWf))
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+		pods, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		require.Len(t, pods.Items, 1)
+		pod := pods.Items[0]
+		assert.True(t, *pod.Spec.SecurityContext.RunAsNonRoot)
+		assert.Equal(t, apiv1.SeccompProfileTypeRuntimeDefault, pod.Spec.SecurityContext.SeccompProfile.Type)
+		for _, c := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+			assert.False(t, *c.SecurityContext.AllowPrivilegeEscalation, c.Name)
+			assert.Contains(t, c.SecurityContext.Capabilities.Drop, apiv1.Capability("ALL"), c.Name)
+		}
+		wait := pod.Spec.Containers[0]
+		require.Equal(t, common.WaitContainerName, wait.Name)
+		assert.Equal(t, int64(8737), *wait.SecurityContext.RunAsUser, "the executor runs as a non-root user")
+	})
+	t.Run("Violated", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+		wf.Spec.Templates[0].Container.SecurityContext = &apiv1.SecurityContext{Privileged: ptr.To(true)}
+		woc := newWoc(ctx, wf)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+		assert.Equal(t, "invalid spec: spec.templates.whalesay: securityContext.privileged must not be true", woc.wf.Status.Message)
+		assert.Contains(t, woc.wf.Status.Conditions, wfv1.Condition{Type: wfv1.ConditionTypeSpecError, Status: metav1.ConditionTrue, Message: woc.wf.Status.Message})
+	})
+	t.Run("Patched", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+		wf.Spec.PodSpecPatch = `{"hostNetwork":true}`
+		woc := newWoc(ctx, wf)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowError, woc.wf.Status.Phase)
+		assert.Contains(t, woc.wf.Status.Message, "pod violates the restricted Pod Security Standard: hostNetwork must not be true")
+		pods, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		assert.Empty(t, pods.Items)
+	})
+}
+
+var extendsWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: extends
+spec:
+  entrypoint: say
+  templates:
+  - name: base
+    metadata:
+      labels:
+        team: data
+    container:
+      image: argoproj/argosay:v2
+      command: [/argosay]
+      env:
+      - name: FOO
+        value: foo
+  - name: say
+    extends:
+      template: base
+    container:
+      args: [echo, hello]
+`
+
+func TestExtends(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(extendsWorkflow)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	pod := pods.Items[0]
+	assert.Equal(t, "data", pod.Labels["team"])
+	main := pod.Spec.Containers[1]
+	assert.Equal(t, "argoproj/argosay:v2", main.Image)
+	assert.Contains(t, main.Env, apiv1.EnvVar{Name: "FOO", Value: "foo"})
+	assert.Equal(t, []string{"echo", "hello"}, main.Args)
+}
+
+func TestCrossNamespaceWorkflowTemplate(t *testing.T) {
+	wftmpl := wfv1.MustUnmarshalWorkflowTemplate(`apiVersion: argoproj.io/v1alpha1
+kind: WorkflowTemplate
+metadata:
+  name: build
+  namespace: shared
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: argoproj/argosay:v2
+      command: [echo]
+`)
+	run := func(t *testing.T, grants []config.WorkflowTemplateGrant) *wfv1.Workflow {
+		wf := wfv1.MustUnmarshalWorkflow(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: shared
+  namespace: default
+spec:
+  workflowTemplateRef:
+    name: build
+    namespace: shared
+`)
+		ctx := logging.TestContext(t.Context())
+		cancel, controller := newController(ctx, wf, wftmpl, func(controller *WorkflowController) {
+			controller.Config.WorkflowTemplateGrants = grants
+		})
+		defer cancel()
+
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		return woc.wf
+	}
+
+	t.Run("Granted", func(t *testing.T) {
+		wf := run(t, []config.WorkflowTemplateGrant{{Namespace: "shared", ConsumerNamespaces: []string{"default"}}})
+		assert.Equal(t, wfv1.WorkflowRunning, wf.Status.Phase)
+		assert.Equal(t, "main", wf.Status.StoredWorkflowSpec.Templates[0].Name)
+	})
+	t.Run("NotGranted", func(t *testing.T) {
+		wf := run(t, []config.WorkflowTemplateGrant{{Namespace: "shared", ConsumerNamespaces: []string{"other"}}})
+		assert.Equal(t, wfv1.WorkflowError, wf.Status.Phase)
+		assert.Equal(t, "WorkflowTemplate shared/build has not been granted to namespace default", wf.Status.Message)
+	})
+}
@@ -0,0 +1,69 @@
+package controller
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/argoproj/argo-workflows/v3/util/env"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// minParallelItems is the fewest items worth processing in parallel, for fewer the goroutines cost more than they save
+const minParallelItems = 1000
+
+// operationParallelism is the number of goroutines that expand withItems, withParam and withSequence, and assess the
+// phase of DAGs, for large numbers of items and nodes. One disables processing them in parallel.
+var operationParallelism = env.LookupEnvIntOr(logging.InitLoggerInContext(), "OPERATION_PARALLELISM", runtime.GOMAXPROCS(0))
+
+// parallelMap returns the result of f for each item, in the order of the items. If there are enough items, they are
+// split into contiguous chunks that are processed in parallel, so f must be safe to call concurrently. If f errors
+// for any item, the error of the first of those items is returned, like processing the items in order would. A panic
+// in f while processing in parallel is returned as the error of its item, as it cannot be recovered by the caller.
+func parallelMap[T, R any](items []T, f func(i int, item T) (R, error)) ([]R, error) {
+	results := make([]R, len(items))
+	workers := min(operationParallelism, len(items)/minParallelItems)
+	if workers <= 1 {
+		for i, item := range items {
+			r, err := f(i, item)
+			if err != nil {
+				return nil, err
+			}
+			results[i] = r
+		}
+		return results, nil
+	}
+	errs := make([]error, len(items))
+	chunk := (len(items) + workers - 1) / workers
+	var wg sync.WaitGroup
+	for start := 0; start < len(items); start += chunk {
+		end := min(start+chunk, len(items))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			i := start
+			defer func() {
+				if r := recover(); r != nil {
+					if err, ok := r.(error); ok {
+						errs[i] = err
+					} else {
+						errs[i] = fmt.Errorf("%v", r)
+					}
+				}
+			}()
+			for ; i < end; i++ {
+				results[i], errs[i] = f(i, items[i])
+				if errs[i] != nil {
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
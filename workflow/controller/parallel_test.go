@@ -0,0 +1,118 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func withOperationParallelism(t *testing.T, parallelism int) {
+	t.Helper()
+	previous := operationParallelism
+	operationParallelism = parallelism
+	t.Cleanup(func() { operationParallelism = previous })
+}
+
+func TestParallelMap(t *testing.T) {
+	withOperationParallelism(t, 4)
+	items := make([]int, 10*minParallelItems+1)
+	for i := range items {
+		items[i] = i
+	}
+	t.Run("Ordered", func(t *testing.T) {
+		results, err := parallelMap(items, func(i int, item int) (string, error) {
+			return strconv.Itoa(item), nil
+		})
+		require.NoError(t, err)
+		require.Len(t, results, len(items))
+		for i, r := range results {
+			assert.Equal(t, strconv.Itoa(i), r)
+		}
+	})
+	t.Run("FirstError", func(t *testing.T) {
+		_, err := parallelMap(items, func(i int, item int) (string, error) {
+			if item%3000 == 2999 {
+				return "", fmt.Errorf("item %d", item)
+			}
+			return "", nil
+		})
+		require.EqualError(t, err, "item 2999")
+	})
+	t.Run("Panic", func(t *testing.T) {
+		_, err := parallelMap(items, func(i int, item int) (string, error) {
+			if item == 2999 {
+				panic("item 2999")
+			}
+			return "", nil
+		})
+		require.EqualError(t, err, "item 2999")
+	})
+	t.Run("Empty", func(t *testing.T) {
+		results, err := parallelMap([]int{}, func(i int, item int) (int, error) { return item, nil })
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
+}
+
+func TestExpandTaskParallel(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	items := make([]wfv1.Item, 3*minParallelItems)
+	for i := range items {
+		item, err := wfv1.ParseItem(fmt.Sprintf(`{"id": %d}`, i))
+		require.NoError(t, err)
+		items[i] = item
+	}
+	task := wfv1.DAGTask{Name: "my-task", Template: "my-tmpl", WithItems: items, Arguments: wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "id", Value: wfv1.AnyStringPtr("{{item.id}}")}}}}
+
+	withOperationParallelism(t, 1)
+	sequential, err := expandTask(ctx, task)
+	require.NoError(t, err)
+	withOperationParallelism(t, 4)
+	parallel, err := expandTask(ctx, task)
+	require.NoError(t, err)
+	assert.Equal(t, sequential, parallel)
+	assert.Equal(t, "my-task(2999:id:2999)", parallel[2999].Name)
+	assert.Equal(t, "2999", parallel[2999].Arguments.Parameters[0].Value.String())
+}
+
+func TestAssessDAGPhaseParallel(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf"}}
+	d := &dagContext{boundaryName: "my-wf", boundaryID: "my-wf", wf: wf, tmpl: &wfv1.Template{DAG: &wfv1.DAGTemplate{}}, log: logging.RequireLoggerFromContext(ctx)}
+	// a wide DAG of tasks with a target task each, one of which fails
+	nodes := wfv1.Nodes{"my-wf": {ID: "my-wf", Type: wfv1.NodeTypeDAG}}
+	var targetTasks []string
+	for i := range 3 * minParallelItems {
+		task, target := fmt.Sprintf("task-%d", i), fmt.Sprintf("target-%d", i)
+		taskID, targetID := d.taskNodeID(task), d.taskNodeID(target)
+		phase := wfv1.NodeSucceeded
+		if i == 2000 {
+			phase = wfv1.NodeFailed
+		}
+		nodes[taskID] = wfv1.NodeStatus{ID: taskID, Phase: phase, Children: []string{targetID}}
+		nodes[targetID] = wfv1.NodeStatus{ID: targetID, Phase: wfv1.NodeOmitted}
+		boundary := nodes["my-wf"]
+		boundary.Children = append(boundary.Children, taskID)
+		nodes["my-wf"] = boundary
+		targetTasks = append(targetTasks, target)
+		d.tasks = append(d.tasks, wfv1.DAGTask{Name: task}, wfv1.DAGTask{Name: target, Depends: task})
+	}
+	for _, parallelism := range []int{1, 4} {
+		withOperationParallelism(t, parallelism)
+		phase, err := d.assessDAGPhase(ctx, targetTasks, nodes, false)
+		require.NoError(t, err)
+		assert.Equal(t, wfv1.NodeFailed, phase)
+	}
+
+	delete(nodes, d.taskNodeID("target-2999"))
+	phase, err := d.assessDAGPhase(ctx, targetTasks, nodes, false)
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodeRunning, phase)
+}
@@ -1,8 +1,6 @@
 package controller
 
 import (
-	"fmt"
-
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
@@ -22,7 +20,7 @@ func generatePhaseNodes(children []string, branchPhase wfv1.NodePhase) []phaseNo
 }
 
 type uniquePhaseNodeQueue struct {
-	seen  map[string]bool
+	seen  map[phaseNode]bool
 	queue []phaseNode
 }
 
@@ -36,7 +34,7 @@ type uniquePhaseNodeQueue struct {
 // adding it to the queue again.
 func newUniquePhaseNodeQueue(nodes ...phaseNode) *uniquePhaseNodeQueue {
 	uq := &uniquePhaseNodeQueue{
-		seen:  make(map[string]bool),
+		seen:  make(map[phaseNode]bool),
 		queue: []phaseNode{},
 	}
 	uq.add(nodes...)
@@ -46,9 +44,8 @@ func newUniquePhaseNodeQueue(nodes ...phaseNode) *uniquePhaseNodeQueue {
 // If a phaseNode has already existed, it will not be added silently
 func (uq *uniquePhaseNodeQueue) add(nodes ...phaseNode) {
 	for _, node := range nodes {
-		key := fmt.Sprintf("%s-%s", node.nodeID, node.phase)
-		if _, ok := uq.seen[key]; !ok {
-			uq.seen[key] = true
+		if !uq.seen[node] {
+			uq.seen[node] = true
 			uq.queue = append(uq.queue, node)
 		}
 	}
@@ -60,6 +57,13 @@ func (uq *uniquePhaseNodeQueue) pop() phaseNode {
 	return head
 }
 
+// popAll pops all the phaseNodes in the queue, i.e. the next level of a BFS
+func (uq *uniquePhaseNodeQueue) popAll() []phaseNode {
+	var all []phaseNode
+	all, uq.queue = uq.queue, []phaseNode{}
+	return all
+}
+
 func (uq *uniquePhaseNodeQueue) empty() bool {
 	return uq.len() == 0
 }
@@ -2,8 +2,11 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
 func (woc *wfOperationCtx) executePluginTemplate(ctx context.Context, nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, opts *executeTemplateOpts) *wfv1.NodeStatus {
@@ -13,9 +16,37 @@ func (woc *wfOperationCtx) executePluginTemplate(ctx context.Context, nodeName s
 			woc.log.Debug(ctx, "boundaryID was nil")
 		}
 		node = woc.initializeExecutableNode(ctx, nodeName, wfv1.NodeTypePlugin, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodePending, opts.nodeFlag, true)
+		if woc.wf.Labels == nil {
+			woc.wf.Labels = map[string]string{}
+		}
+		woc.wf.Labels[common.LabelKeyExecutorPlugin] = "true"
+	}
+	if node.Fulfilled() {
+		return node
 	}
-	if !node.Fulfilled() {
-		woc.taskSet[node.ID] = *tmpl
+	if timedOut, err := woc.checkPluginTimeout(tmpl, node); err != nil {
+		return woc.markNodeError(ctx, nodeName, err)
+	} else if timedOut {
+		return woc.markNodePhase(ctx, nodeName, wfv1.NodeError, fmt.Sprintf("plugin did not complete the node within its timeout of %s", tmpl.Timeout))
 	}
+	woc.taskSet[node.ID] = *tmpl
 	return node
 }
+
+// checkPluginTimeout returns whether the node exceeded the template's timeout, in any phase, because a plugin that hangs,
+// or requeues the node forever, would otherwise leave it running
+func (woc *wfOperationCtx) checkPluginTimeout(tmpl *wfv1.Template, node *wfv1.NodeStatus) (bool, error) {
+	if tmpl.Timeout == "" {
+		return false, nil
+	}
+	timeout, err := time.ParseDuration(tmpl.Timeout)
+	if err != nil {
+		return false, fmt.Errorf("invalid timeout format. %v", err)
+	}
+	deadline := node.StartedAt.Add(timeout)
+	if time.Now().After(deadline) {
+		return true, nil
+	}
+	woc.requeueAfter(time.Until(deadline))
+	return false, nil
+}
@@ -0,0 +1,107 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/plugins/spec"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func TestPluginTemplateTimeout(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: plugin-timeout
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      timeout: 1m
+      plugin:
+        hello: {}
+status:
+  phase: Running
+  startedAt: "2021-01-01T00:00:00Z"
+  nodes:
+    plugin-timeout:
+      id: plugin-timeout
+      name: plugin-timeout
+      displayName: plugin-timeout
+      type: Plugin
+      templateName: main
+      phase: Running
+      startedAt: "2021-01-01T00:00:00Z"
+`)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf, defaultServiceAccount)
+	defer cancel()
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	node, err := woc.wf.Status.Nodes.Get("plugin-timeout")
+	require.NoError(t, err)
+	// a running plugin node times out too, as an error of the plugin rather than a failure
+	assert.Equal(t, wfv1.NodeError, node.Phase)
+	assert.Equal(t, "plugin did not complete the node within its timeout of 1m", node.Message)
+}
+
+func TestPluginTemplateLabelsWorkflow(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: plugin-label
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      plugin:
+        hello: {}
+`)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf, defaultServiceAccount)
+	defer cancel()
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, "true", woc.wf.Labels[common.LabelKeyExecutorPlugin])
+}
+
+func TestPluginSidecarLivenessProbe(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: plugin-liveness
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      plugin:
+        hello: {}
+`)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf, defaultServiceAccount)
+	defer cancel()
+	probe := &apiv1.Probe{ProbeHandler: apiv1.ProbeHandler{Exec: &apiv1.ExecAction{Command: []string{"true"}}}}
+	controller.executorPlugins = map[string]map[string]*spec.Plugin{"default": {
+		"hello":   {Spec: spec.PluginSpec{Sidecar: spec.Sidecar{Container: apiv1.Container{Name: "hello", Ports: []apiv1.ContainerPort{{ContainerPort: 4355}}}}}},
+		"goodbye": {Spec: spec.PluginSpec{Sidecar: spec.Sidecar{Container: apiv1.Container{Name: "goodbye", Ports: []apiv1.ContainerPort{{ContainerPort: 4356}}, LivenessProbe: probe}}}},
+	}}
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	sidecars, _, err := woc.getExecutorPlugins(ctx)
+	require.NoError(t, err)
+	require.Len(t, sidecars, 2)
+	probes := map[string]*apiv1.Probe{}
+	for _, c := range sidecars {
+		probes[c.Name] = c.LivenessProbe
+	}
+	require.NotNil(t, probes["hello"])
+	assert.Equal(t, int32(4355), probes["hello"].TCPSocket.Port.IntVal)
+	assert.Equal(t, probe, probes["goodbye"])
+}
@@ -4,6 +4,7 @@ package pod
 import (
 	"context"
 	"fmt"
+	"time"
 
 	apiv1 "k8s.io/api/core/v1"
 )
@@ -36,6 +37,25 @@ func (c *Controller) DeletePod(ctx context.Context, namespace, name string) {
 	c.queuePodForCleanup(ctx, namespace, name, deletePod)
 }
 
+// ForceDeletePodAfter deletes the pod after the duration, without waiting for its containers to exit
+func (c *Controller) ForceDeletePodAfter(ctx context.Context, namespace, name string, duration time.Duration) {
+	if duration <= 0 {
+		c.queuePodForCleanup(ctx, namespace, name, forceDeletePod)
+		return
+	}
+	c.queuePodForCleanupAfter(ctx, namespace, name, forceDeletePod, duration)
+}
+
 func (c *Controller) RemoveFinalizer(ctx context.Context, namespace, name string) {
 	c.queuePodForCleanup(ctx, namespace, name, removeFinalizer)
 }
+
+// CachedPods returns the number of pods in the informer cache
+func (c *Controller) CachedPods() int {
+	return len(c.podInformer.GetStore().ListKeys())
+}
+
+// QueueLen returns the number of pods waiting to be cleaned up
+func (c *Controller) QueueLen() int {
+	return c.workqueue.Len()
+}
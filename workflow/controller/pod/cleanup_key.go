@@ -21,6 +21,7 @@ const (
 	terminateContainers podCleanupAction = "terminateContainers"
 	killContainers      podCleanupAction = "killContainers"
 	removeFinalizer     podCleanupAction = "removeFinalizer"
+	forceDeletePod      podCleanupAction = "forceDeletePod"
 )
 
 func newPodCleanupKey(namespace string, podName string, action podCleanupAction) podCleanupKey {
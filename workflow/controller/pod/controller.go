@@ -64,7 +64,7 @@ func NewController(ctx context.Context, config *argoConfig.Config, restConfig *r
 		kubeclientset: clientSet,
 		wfInformer:    wfInformer,
 		workqueue:     metrics.RateLimiterWithBusyWorkers(ctx, workqueue.DefaultTypedControllerRateLimiter[string](), "pod_cleanup_queue"),
-		podInformer:   newInformer(ctx, clientSet, &config.InstanceID, &namespace),
+		podInformer:   newInformer(ctx, clientSet, &config.InstanceID, &namespace, config.Informers),
 		log:           log,
 		callBack:      callback,
 		restConfig:    restConfig,
@@ -279,14 +279,15 @@ func (c *Controller) deletePodEvent(ctx context.Context, obj interface{}) {
 	c.commonPodEvent(ctx, pod, true)
 }
 
-func newWorkflowPodWatch(ctx context.Context, clientSet kubernetes.Interface, instanceID, namespace *string) *cache.ListWatch {
+func newWorkflowPodWatch(ctx context.Context, clientSet kubernetes.Interface, instanceID, namespace *string, requirements labels.Requirements) *cache.ListWatch {
 	c := clientSet.CoreV1().Pods(*namespace)
 	// completed=false
 	labelSelector := labels.NewSelector().
 		Add(*workflowReq).
 		// not sure if we should do this
 		Add(*incompleteReq).
-		Add(util.InstanceIDRequirement(*instanceID))
+		Add(util.InstanceIDRequirement(*instanceID)).
+		Add(requirements...)
 
 	listFunc := func(options metav1.ListOptions) (runtime.Object, error) {
 		options.LabelSelector = labelSelector.String()
@@ -315,13 +316,17 @@ func newWorkflowPodWatch(ctx context.Context, clientSet kubernetes.Interface, in
 	return &cache.ListWatch{ListFunc: listFunc, WatchFunc: watchFunc}
 }
 
-func newInformer(ctx context.Context, clientSet kubernetes.Interface, instanceID, namespace *string) cache.SharedIndexInformer {
-	source := newWorkflowPodWatch(ctx, clientSet, instanceID, namespace)
+func newInformer(ctx context.Context, clientSet kubernetes.Interface, instanceID, namespace *string, informers *argoConfig.InformersConfig) cache.SharedIndexInformer {
+	source := newWorkflowPodWatch(ctx, clientSet, instanceID, namespace, informers.LabelRequirements())
 	informer := cache.NewSharedIndexInformer(source, &apiv1.Pod{}, podResyncPeriod, cache.Indexers{
 		indexes.WorkflowIndex: indexes.MetaWorkflowIndexFunc,
 		indexes.NodeIDIndex:   indexes.MetaNodeIDIndexFunc,
 		indexes.PodPhaseIndex: indexes.PodPhaseIndexFunc,
 	})
+	if transform := newTrimTransform(informers.GetTrimPodFields()); transform != nil {
+		//nolint:errcheck // the error only happens if the informer has started, and it hasn't
+		informer.SetTransform(transform)
+	}
 	return informer
 }
 
@@ -332,3 +337,8 @@ func podFromObj(obj interface{}) (*apiv1.Pod, error) {
 	}
 	return pod, nil
 }
+
+// Drain shuts down the queue of pods to clean up, and waits until the pods being cleaned up have been
+func (c *Controller) Drain() {
+	c.workqueue.ShutDownWithDrain()
+}
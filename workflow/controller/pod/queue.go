@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	typedv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/utils/ptr"
 
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
@@ -146,6 +147,19 @@ func (c *Controller) processNextPodCleanupItem(ctx context.Context) bool {
 			if err != nil && !apierr.IsNotFound(err) {
 				return err
 			}
+		case forceDeletePod:
+			pods := c.kubeclientset.CoreV1().Pods(namespace)
+			if err := c.patchPodForCleanup(ctx, pods, namespace, podName, false); err != nil {
+				return err
+			}
+			propagation := metav1.DeletePropagationBackground
+			err := pods.Delete(ctx, podName, metav1.DeleteOptions{
+				PropagationPolicy:  &propagation,
+				GracePeriodSeconds: ptr.To(int64(0)),
+			})
+			if err != nil && !apierr.IsNotFound(err) {
+				return err
+			}
 		case removeFinalizer:
 			pods := c.kubeclientset.CoreV1().Pods(namespace)
 			if err := c.patchPodForCleanup(ctx, pods, namespace, podName, false); err != nil {
@@ -0,0 +1,43 @@
+package pod
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	argoConfig "github.com/argoproj/argo-workflows/v3/config"
+)
+
+var podFieldTrimmers = map[string]func(pod *apiv1.Pod){
+	argoConfig.PodFieldManagedFields:    func(pod *apiv1.Pod) { pod.ManagedFields = nil },
+	argoConfig.PodFieldVolumes:          func(pod *apiv1.Pod) { pod.Spec.Volumes = nil },
+	argoConfig.PodFieldInitContainers:   func(pod *apiv1.Pod) { pod.Spec.InitContainers = nil },
+	argoConfig.PodFieldAffinity:         func(pod *apiv1.Pod) { pod.Spec.Affinity = nil },
+	argoConfig.PodFieldTolerations:      func(pod *apiv1.Pod) { pod.Spec.Tolerations = nil },
+	argoConfig.PodFieldNodeSelector:     func(pod *apiv1.Pod) { pod.Spec.NodeSelector = nil },
+	argoConfig.PodFieldImagePullSecrets: func(pod *apiv1.Pod) { pod.Spec.ImagePullSecrets = nil },
+}
+
+// newTrimTransform returns a transform that removes the fields from pods before they are cached, or nil if there are
+// no fields to remove. Unknown fields are ignored, they are rejected when the configuration is validated.
+func newTrimTransform(fields []string) cache.TransformFunc {
+	var trimmers []func(pod *apiv1.Pod)
+	for _, field := range fields {
+		if trim, ok := podFieldTrimmers[field]; ok {
+			trimmers = append(trimmers, trim)
+		}
+	}
+	if len(trimmers) == 0 {
+		return nil
+	}
+	return func(obj interface{}) (interface{}, error) {
+		// deleted pods may be tombstones, which are left as they are
+		pod, ok := obj.(*apiv1.Pod)
+		if !ok {
+			return obj, nil
+		}
+		for _, trim := range trimmers {
+			trim(pod)
+		}
+		return pod, nil
+	}
+}
@@ -0,0 +1,64 @@
+package pod
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	argoConfig "github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func TestNewTrimTransform(t *testing.T) {
+	assert.Nil(t, newTrimTransform(nil))
+	assert.Nil(t, newTrimTransform([]string{"spec.containers"}))
+
+	transform := newTrimTransform([]string{argoConfig.PodFieldManagedFields, argoConfig.PodFieldVolumes})
+	obj, err := transform(&apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-pod", ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "workflow-controller"}}},
+		Spec: apiv1.PodSpec{
+			NodeName:                      "my-node",
+			TerminationGracePeriodSeconds: ptr.To(int64(30)),
+			Containers:                    []apiv1.Container{{Name: "main"}},
+			Volumes:                       []apiv1.Volume{{Name: "var-run-argo"}},
+		},
+	})
+	require.NoError(t, err)
+	pod := obj.(*apiv1.Pod)
+	assert.Equal(t, "my-pod", pod.Name)
+	assert.Empty(t, pod.ManagedFields)
+	assert.Empty(t, pod.Spec.Volumes)
+	assert.Equal(t, "my-node", pod.Spec.NodeName)
+	assert.Equal(t, int64(30), *pod.Spec.TerminationGracePeriodSeconds)
+	assert.Len(t, pod.Spec.Containers, 1)
+
+	tombstone := cache.DeletedFinalStateUnknown{Key: "my-ns/my-pod"}
+	obj, err = transform(tombstone)
+	require.NoError(t, err)
+	assert.Equal(t, tombstone, obj)
+}
+
+func TestNewWorkflowPodWatchLabelSelector(t *testing.T) {
+	newPod := func(name, team string) *apiv1.Pod {
+		return &apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-ns", Labels: map[string]string{
+			common.LabelKeyWorkflow:  "my-wf",
+			common.LabelKeyCompleted: "false",
+			"team":                   team,
+		}}}
+	}
+	clientSet := fake.NewSimpleClientset(newPod("data-pod", "data"), newPod("web-pod", "web"))
+	instanceID, namespace := "", "my-ns"
+	informers := &argoConfig.InformersConfig{LabelSelector: "team=data"}
+	obj, err := newWorkflowPodWatch(logging.TestContext(t.Context()), clientSet, &instanceID, &namespace, informers.LabelRequirements()).List(metav1.ListOptions{})
+	require.NoError(t, err)
+	pods := obj.(*apiv1.PodList).Items
+	require.Len(t, pods, 1)
+	assert.Equal(t, "data-pod", pods[0].Name)
+}
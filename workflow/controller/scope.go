@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
 
 	"github.com/expr-lang/expr"
 
@@ -88,6 +89,17 @@ func (s *wfScope) resolveParameter(p *wfv1.ValueFrom) (interface{}, error) {
 	}
 }
 
+// formatParameterValue formats the value an expression evaluates to as a parameter value. Lists and maps, e.g. from
+// aggregating the outputs of child nodes, are formatted as JSON so that other templates can parse them.
+func formatParameterValue(val interface{}) (string, error) {
+	switch reflect.ValueOf(val).Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		data, err := json.Marshal(val)
+		return string(data), err
+	}
+	return wfv1.ParseAnyString(val).String(), nil
+}
+
 func (s *wfScope) resolveArtifact(ctx context.Context, art *wfv1.Artifact) (*wfv1.Artifact, error) {
 	if art == nil || (art.From == "" && art.FromExpression == "") {
 		return nil, nil
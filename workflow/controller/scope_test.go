@@ -305,3 +305,31 @@ func TestResolveParameters(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal("5", result)
 }
+
+func TestResolveParameterAggregatingOutputs(t *testing.T) {
+	scope := createScope(nil)
+	scope.addParamToScope("tasks.count.outputs.parameters.count", `["1","5","3"]`)
+	scope.addParamToScope("tasks.count.outputs.parameters.updated", `["2024-01-02T00:00:00Z","2024-03-01T00:00:00Z","2024-02-01T00:00:00Z"]`)
+	scope.addParamToScope("tasks.extra.outputs.parameters.count", "4")
+
+	resolve := func(expression string) string {
+		t.Helper()
+		val, err := scope.resolveParameter(&wfv1.ValueFrom{Expression: expression})
+		require.NoError(t, err)
+		value, err := formatParameterValue(val)
+		require.NoError(t, err)
+		return value
+	}
+	t.Run("Sum", func(t *testing.T) {
+		assert.Equal(t, "13", resolve("sum(map(fromJSON(tasks.count.outputs.parameters.count), int(#))) + asInt(tasks.extra.outputs.parameters.count)"))
+	})
+	t.Run("Max", func(t *testing.T) {
+		assert.Equal(t, "2024-03-01T00:00:00Z", resolve("last(sort(fromJSON(tasks.count.outputs.parameters.updated)))"))
+	})
+	t.Run("List", func(t *testing.T) {
+		assert.Equal(t, "[1,5,3]", resolve("map(fromJSON(tasks.count.outputs.parameters.count), int(#))"))
+	})
+	t.Run("Map", func(t *testing.T) {
+		assert.JSONEq(t, `{"count":4}`, resolve("{count: asInt(tasks.extra.outputs.parameters.count)}"))
+	})
+}
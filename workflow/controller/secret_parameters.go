@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"context"
+
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+)
+
+// getSecretValue gets the value of a parameter from a secret. Secrets are got once per operation, as parameters are
+// resolved each time a node is reconciled.
+func (woc *wfOperationCtx) getSecretValue(ctx context.Context, namespace, name, key string) (string, error) {
+	cacheKey := namespace + "/" + name
+	secret, ok := woc.secrets[cacheKey]
+	if !ok {
+		var err error
+		secret, err = woc.controller.kubeclientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if apierr.IsNotFound(err) {
+			return "", errors.Errorf(errors.CodeNotFound, "Secret '%s' does not exist", name)
+		}
+		if err != nil {
+			return "", err
+		}
+		if woc.secrets == nil {
+			woc.secrets = make(map[string]*apiv1.Secret)
+		}
+		woc.secrets[cacheKey] = secret
+	}
+	value, ok := secret.Data[key]
+	if !ok {
+		return "", errors.Errorf(errors.CodeNotFound, "Secret '%s' does not have the key '%s'", name, key)
+	}
+	return string(value), nil
+}
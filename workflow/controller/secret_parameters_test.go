@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+var secretParameters = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: secret-parameters
+  namespace: default
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: password
+      valueFrom:
+        secretKeyRef:
+          name: my-secret
+          key: password
+  templates:
+  - name: main
+    inputs:
+      parameters:
+      - name: token
+        valueFrom:
+          secretKeyRef:
+            name: my-secret
+            key: token
+    container:
+      image: docker/whalesay
+      command: [cowsay]
+      args: ['{{inputs.parameters.token}}', '{{workflow.parameters.password}}']
+`
+
+func TestSecretParameters(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(secretParameters)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+	_, err := controller.kubeclientset.CoreV1().Secrets("default").Create(ctx, &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-secret"},
+		Data:       map[string][]byte{"token": []byte("my-token"), "password": []byte("my-password")},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	assert.Contains(t, pods.Items[0].Spec.Containers[1].Args, "my-token")
+	assert.Contains(t, pods.Items[0].Spec.Containers[1].Args, "my-password")
+
+	node, err := woc.wf.GetNodeByName("secret-parameters")
+	require.NoError(t, err)
+	require.NotNil(t, node.Inputs)
+	assert.Nil(t, node.Inputs.Parameters[0].Value, "not stored in the status")
+	assert.Equal(t, "my-secret", node.Inputs.Parameters[0].ValueFrom.SecretKeyRef.Name)
+	assert.NotContains(t, wfv1.MustMarshallJSON(woc.wf), "my-token")
+	assert.NotContains(t, wfv1.MustMarshallJSON(woc.wf), "my-password")
+}
+
+func TestSecretParametersNotFound(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(secretParameters)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+	assert.Contains(t, woc.wf.Status.Message, "failed to set global parameter password from secret with name my-secret and key password")
+}
@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj/argo-workflows/v3/workflow/sensitive"
+)
+
+var errSensitiveParametersNotConfigured = fmt.Errorf("sensitive parameters require the controller to be configured with sensitiveParameters")
+
+func (woc *wfOperationCtx) getEncrypter() (*sensitive.Encrypter, error) {
+	if woc.encrypter == nil {
+		if woc.controller.sensitiveParametersKey == nil {
+			return nil, errSensitiveParametersNotConfigured
+		}
+		encrypter, err := sensitive.NewEncrypter(woc.controller.sensitiveParametersKey)
+		if err != nil {
+			return nil, err
+		}
+		woc.encrypter = encrypter
+	}
+	return woc.encrypter, nil
+}
+
+// decryptSensitiveParameters decrypts the values of the sensitive parameters of the workflow for the operation
+func (woc *wfOperationCtx) decryptSensitiveParameters() error {
+	if !sensitive.HasEncrypted(woc.wf) {
+		return nil
+	}
+	encrypter, err := woc.getEncrypter()
+	if err != nil {
+		return err
+	}
+	return encrypter.DecryptWorkflow(woc.wf)
+}
+
+// encryptSensitiveParameters encrypts the values of the sensitive parameters of the workflow before it is persisted. If
+// the controller is not configured to encrypt them, the workflow errors, and the values are masked instead.
+func (woc *wfOperationCtx) encryptSensitiveParameters(ctx context.Context) error {
+	if !sensitive.HasPlaintext(woc.wf) {
+		return nil
+	}
+	encrypter, err := woc.getEncrypter()
+	if err == errSensitiveParametersNotConfigured {
+		if !woc.wf.Status.Fulfilled() {
+			woc.markWorkflowError(ctx, err)
+		}
+		sensitive.MaskWorkflow(woc.wf)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return encrypter.EncryptWorkflow(woc.wf)
+}
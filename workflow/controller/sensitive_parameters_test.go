@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/sensitive"
+)
+
+var sensitiveParameters = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: sensitive-parameters
+  namespace: default
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: password
+      value: my-password
+      sensitive: true
+  templates:
+  - name: main
+    steps:
+    - - name: login
+        template: login
+    - - name: use
+        template: use
+        arguments:
+          parameters:
+          - name: session
+            value: '{{steps.login.outputs.parameters.session}}'
+  - name: login
+    container:
+      image: docker/whalesay
+      command: [login]
+      args: ['{{workflow.parameters.password}}']
+    outputs:
+      parameters:
+      - name: session
+        sensitive: true
+        valueFrom:
+          path: /tmp/session
+  - name: use
+    inputs:
+      parameters:
+      - name: session
+        sensitive: true
+    container:
+      image: docker/whalesay
+      command: [cowsay]
+      args: ['{{inputs.parameters.session}}']
+`
+
+func TestSensitiveParameters(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(sensitiveParameters)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+	controller.sensitiveParametersKey = []byte("my-key")
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	assert.Contains(t, pods.Items[0].Spec.Containers[1].Args, "my-password")
+	assert.True(t, sensitive.IsEncrypted(woc.wf.Spec.Arguments.Parameters[0].Value.String()))
+	assert.NotContains(t, wfv1.MustMarshallJSON(woc.wf), "my-password")
+
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+	login, err := woc.wf.GetNodeByName("sensitive-parameters[0].login")
+	require.NoError(t, err)
+	login.Outputs = &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "session", Value: wfv1.AnyStringPtr("my-session"), Sensitive: true}}}
+	woc.wf.Status.Nodes.Set(ctx, login.ID, *login)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+	pods, err = listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 2)
+	var args []string
+	for _, pod := range pods.Items {
+		args = append(args, pod.Spec.Containers[1].Args...)
+	}
+	assert.Contains(t, args, "my-session")
+	node, err := woc.wf.GetNodeByName("sensitive-parameters[1].use")
+	require.NoError(t, err)
+	require.NotNil(t, node.Inputs)
+	assert.True(t, sensitive.IsEncrypted(node.Inputs.Parameters[0].Value.String()))
+	assert.NotContains(t, wfv1.MustMarshallJSON(woc.wf), "my-password")
+	assert.NotContains(t, wfv1.MustMarshallJSON(woc.wf), "my-session")
+}
+
+func TestSensitiveParametersNotConfigured(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(sensitiveParameters)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+
+	assert.Equal(t, wfv1.WorkflowError, woc.wf.Status.Phase)
+	assert.Contains(t, woc.wf.Status.Message, "sensitive parameters require the controller to be configured")
+	assert.Equal(t, sensitive.Mask, woc.wf.Spec.Arguments.Parameters[0].Value.String())
+	assert.NotContains(t, wfv1.MustMarshallJSON(woc.wf), "my-password")
+}
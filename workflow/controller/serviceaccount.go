@@ -4,24 +4,35 @@ import (
 	"context"
 	"fmt"
 
+	authenticationv1 "k8s.io/api/authentication/v1"
 	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/utils/ptr"
 
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
 func (woc *wfOperationCtx) getServiceAccountTokenVolume(ctx context.Context, serviceAccountName string) (*apiv1.Volume, *apiv1.VolumeMount, error) {
-	secretName, err := woc.getServiceAccountTokenName(ctx, serviceAccountName)
+	return woc.getExecutorTokenVolume(ctx, "", serviceAccountName, nil)
+}
+
+// getExecutorTokenVolume returns the volume of the token of the service account, requested for the pod if token is not
+// nil
+func (woc *wfOperationCtx) getExecutorTokenVolume(ctx context.Context, podName, serviceAccountName string, token *config.ExecutorServiceAccountToken) (*apiv1.Volume, *apiv1.VolumeMount, error) {
+	source, err := woc.getExecutorTokenVolumeSource(ctx, podName, serviceAccountName, token)
 	if err != nil {
 		return nil, nil, err
 	}
 	// Intentionally randomize the name so that plugins cannot determine it.
 	tokenVolumeName := fmt.Sprintf("kube-api-access-%s", rand.String(5))
 	return &apiv1.Volume{
-			Name: tokenVolumeName,
-			VolumeSource: apiv1.VolumeSource{
-				Secret: &apiv1.SecretVolumeSource{SecretName: secretName},
-			},
+			Name:         tokenVolumeName,
+			VolumeSource: source,
 		},
 		&apiv1.VolumeMount{
 			Name:      tokenVolumeName,
@@ -30,3 +41,95 @@ func (woc *wfOperationCtx) getServiceAccountTokenVolume(ctx context.Context, ser
 		},
 		nil
 }
+
+// executorServiceAccount returns the service account the executor of the template uses, and the token to request of it
+// if any. The executor service account of the template takes precedence over that of the workflow, which takes
+// precedence over the mapping of the namespace of the workflow.
+func (woc *wfOperationCtx) executorServiceAccount(tmpl *wfv1.Template) (string, *config.ExecutorServiceAccountToken) {
+	if tmpl.Executor != nil && tmpl.Executor.ServiceAccountName != "" {
+		return tmpl.Executor.ServiceAccountName, nil
+	}
+	if woc.execWf.Spec.Executor != nil && woc.execWf.Spec.Executor.ServiceAccountName != "" {
+		return woc.execWf.Spec.Executor.ServiceAccountName, nil
+	}
+	if m := woc.controller.Config.GetExecutorServiceAccount(woc.wf.Namespace); m != nil {
+		return m.ServiceAccountName, m.Token
+	}
+	return "", nil
+}
+
+// getExecutorTokenVolumeSource returns the volume source of the token of the service account. If token is nil, that
+// is the token secret of the service account. Otherwise, it is a token requested for the pod, projected with the CA
+// certificate and namespace, like the token of the service account of a pod.
+func (woc *wfOperationCtx) getExecutorTokenVolumeSource(ctx context.Context, podName, serviceAccountName string, token *config.ExecutorServiceAccountToken) (apiv1.VolumeSource, error) {
+	if token == nil {
+		secretName, err := woc.getServiceAccountTokenName(ctx, serviceAccountName)
+		if err != nil {
+			return apiv1.VolumeSource{}, err
+		}
+		return apiv1.VolumeSource{Secret: &apiv1.SecretVolumeSource{SecretName: secretName}}, nil
+	}
+	secretName, err := woc.requestServiceAccountToken(ctx, podName, serviceAccountName, *token)
+	if err != nil {
+		return apiv1.VolumeSource{}, err
+	}
+	return apiv1.VolumeSource{
+		Projected: &apiv1.ProjectedVolumeSource{
+			Sources: []apiv1.VolumeProjection{
+				{Secret: &apiv1.SecretProjection{
+					LocalObjectReference: apiv1.LocalObjectReference{Name: secretName},
+					Items:                []apiv1.KeyToPath{{Key: apiv1.ServiceAccountTokenKey, Path: apiv1.ServiceAccountTokenKey}},
+				}},
+				{ConfigMap: &apiv1.ConfigMapProjection{
+					LocalObjectReference: apiv1.LocalObjectReference{Name: "kube-root-ca.crt"},
+					Items:                []apiv1.KeyToPath{{Key: apiv1.ServiceAccountRootCAKey, Path: apiv1.ServiceAccountRootCAKey}},
+				}},
+				{DownwardAPI: &apiv1.DownwardAPIProjection{
+					Items: []apiv1.DownwardAPIVolumeFile{{Path: apiv1.ServiceAccountNamespaceKey, FieldRef: &apiv1.ObjectFieldSelector{APIVersion: "v1", FieldPath: "metadata.namespace"}}},
+				}},
+			},
+		},
+	}, nil
+}
+
+// requestServiceAccountToken requests a token of the service account for the pod, returning the name of the secret it
+// is stored in. The secret is owned by the workflow, and the token is bound to it, so that it is no longer valid once
+// the workflow is deleted.
+func (woc *wfOperationCtx) requestServiceAccountToken(ctx context.Context, podName, serviceAccountName string, token config.ExecutorServiceAccountToken) (string, error) {
+	name := podName + "-executor-token"
+	secrets := woc.controller.kubeclientset.CoreV1().Secrets(woc.wf.Namespace)
+	secret, err := secrets.Create(ctx, &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{common.LabelKeyWorkflow: woc.wf.Name},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind)),
+			},
+		},
+		Type: apiv1.SecretTypeOpaque,
+	}, metav1.CreateOptions{})
+	if apierr.IsAlreadyExists(err) {
+		secret, err = secrets.Get(ctx, name, metav1.GetOptions{})
+		if err == nil && len(secret.Data[apiv1.ServiceAccountTokenKey]) > 0 {
+			return name, nil
+		}
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to create the token secret of service account %s: %w", serviceAccountName, err)
+	}
+	request, err := woc.controller.kubeclientset.CoreV1().ServiceAccounts(woc.wf.Namespace).CreateToken(ctx, serviceAccountName, &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences:         token.Audiences,
+			ExpirationSeconds: ptr.To(int64(token.GetExpiration().Seconds())),
+			BoundObjectRef:    &authenticationv1.BoundObjectReference{Kind: "Secret", APIVersion: "v1", Name: name, UID: secret.UID},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to request a token of service account %s: %w", serviceAccountName, err)
+	}
+	secret.Data = map[string][]byte{apiv1.ServiceAccountTokenKey: []byte(request.Status.Token)}
+	if _, err := secrets.Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		return "", fmt.Errorf("failed to update the token secret of service account %s: %w", serviceAccountName, err)
+	}
+	return name, nil
+}
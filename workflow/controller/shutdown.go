@@ -0,0 +1,179 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/creator"
+	wfutil "github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// shutdownReason returns why the workflow, or some of its nodes, were stopped or terminated, if given
+func (woc *wfOperationCtx) shutdownReason() string {
+	return woc.wf.GetAnnotations()[common.AnnotationKeyShutdownReason]
+}
+
+// shutdownActor returns who stopped or terminated the workflow, if known
+func (woc *wfOperationCtx) shutdownActor() string {
+	labels := woc.wf.GetLabels()
+	switch creator.ActionType(labels[common.LabelKeyAction]) {
+	case creator.ActionStop, creator.ActionTerminate:
+	default:
+		return ""
+	}
+	if actor := labels[common.LabelKeyActorPreferredUsername]; actor != "" {
+		return actor
+	}
+	return labels[common.LabelKeyActor]
+}
+
+// withShutdownReason appends the reason, if given, to the message
+func (woc *wfOperationCtx) withShutdownReason(message string) string {
+	if reason := woc.shutdownReason(); reason != "" {
+		return message + ": " + reason
+	}
+	return message
+}
+
+// shutdownMessage is the message of the workflow and the nodes failed by its shutdown strategy
+func (woc *wfOperationCtx) shutdownMessage() string {
+	return woc.withShutdownReason(fmt.Sprintf("Stopped with strategy '%s'", woc.GetShutdownStrategy()))
+}
+
+// nodeStoppedMessage is the message of the nodes failed because their subtree was stopped
+func (woc *wfOperationCtx) nodeStoppedMessage() string {
+	return woc.withShutdownReason("Subtree stopped")
+}
+
+// shutdownGracePeriod returns how long pods have to exit once signalled before they are deleted, if set
+func (woc *wfOperationCtx) shutdownGracePeriod(ctx context.Context) *time.Duration {
+	v := woc.wf.GetAnnotations()[common.AnnotationKeyShutdownGracePeriod]
+	if v == "" {
+		return nil
+	}
+	gracePeriod, err := time.ParseDuration(v)
+	if err != nil {
+		woc.log.WithError(err).WithField("gracePeriod", v).Warn(ctx, "Ignoring invalid shutdown grace period")
+		return nil
+	}
+	return &gracePeriod
+}
+
+// terminatePod signals the containers of the pod to terminate, and deletes the pod once the grace period, if set,
+// has passed
+func (woc *wfOperationCtx) terminatePod(ctx context.Context, pod *apiv1.Pod) {
+	woc.controller.PodController.TerminateContainers(ctx, pod.Namespace, pod.Name)
+	if gracePeriod := woc.shutdownGracePeriod(ctx); gracePeriod != nil {
+		woc.controller.PodController.ForceDeletePodAfter(ctx, pod.Namespace, pod.Name, *gracePeriod)
+	}
+}
+
+// recordShutdown records who stopped or terminated the workflow, or some of its nodes, and why in a condition and
+// an event, once for each change
+func (woc *wfOperationCtx) recordShutdown(ctx context.Context) {
+	var parts []string
+	if woc.GetShutdownStrategy().Enabled() {
+		parts = append(parts, fmt.Sprintf("Stopped with strategy '%s'", woc.GetShutdownStrategy()))
+	}
+	if stopped := wfutil.GetStoppedNodes(woc.wf); len(stopped) > 0 {
+		var names []string
+		for _, nodeID := range stopped {
+			name := nodeID
+			if node, err := woc.wf.Status.Nodes.Get(nodeID); err == nil {
+				name = node.Name
+			}
+			names = append(names, name)
+		}
+		parts = append(parts, "Stopped the subtrees of "+strings.Join(names, ", "))
+	}
+	if len(parts) == 0 {
+		return
+	}
+	message := strings.Join(parts, ", ")
+	if actor := woc.shutdownActor(); actor != "" {
+		message += " by " + actor
+	}
+	message = woc.withShutdownReason(message)
+	for _, condition := range woc.wf.Status.Conditions {
+		if condition.Type == wfv1.ConditionTypeShutdown && condition.Message == message {
+			return
+		}
+	}
+	woc.wf.Status.Conditions.UpsertCondition(wfv1.Condition{
+		Type:    wfv1.ConditionTypeShutdown,
+		Status:  metav1.ConditionTrue,
+		Message: message,
+	})
+	woc.updated = true
+	woc.eventRecorder.Event(woc.wf, apiv1.EventTypeNormal, "WorkflowShutdown", message)
+}
+
+// getStoppedNodes returns the IDs of the nodes in the subtrees that were stopped: the stopped nodes, the attempts of
+// stopped retry nodes, and the nodes within their boundaries
+func (woc *wfOperationCtx) getStoppedNodes() map[string]bool {
+	stoppedNodes := map[string]bool{}
+	for _, nodeID := range wfutil.GetStoppedNodes(woc.wf) {
+		stoppedNodes[nodeID] = true
+		if node, err := woc.wf.Status.Nodes.Get(nodeID); err == nil && node.Type == wfv1.NodeTypeRetry {
+			for _, childID := range node.Children {
+				stoppedNodes[childID] = true
+			}
+		}
+	}
+	if len(stoppedNodes) == 0 {
+		return nil
+	}
+	inStoppedBoundary := map[string]bool{}
+	var isStopped func(nodeID string, depth int) bool
+	isStopped = func(nodeID string, depth int) bool {
+		if stoppedNodes[nodeID] {
+			return true
+		}
+		if stopped, ok := inStoppedBoundary[nodeID]; ok {
+			return stopped
+		}
+		node, err := woc.wf.Status.Nodes.Get(nodeID)
+		// the depth guards against boundaries that are, erroneously, cyclic
+		stopped := err == nil && node.BoundaryID != "" && depth < len(woc.wf.Status.Nodes) && isStopped(node.BoundaryID, depth+1)
+		inStoppedBoundary[nodeID] = stopped
+		return stopped
+	}
+	for nodeID := range woc.wf.Status.Nodes {
+		if isStopped(nodeID, 0) {
+			stoppedNodes[nodeID] = true
+		}
+	}
+	return stoppedNodes
+}
+
+// stopNodeSubtrees fails the nodes of the stopped subtrees that are not yet fulfilled, so that no more of them are
+// run. Nodes with pods are failed by execution control once their pods are terminated.
+func (woc *wfOperationCtx) stopNodeSubtrees(ctx context.Context) {
+	var nodeIDs []string
+	for nodeID := range woc.stoppedNodes {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	slices.Sort(nodeIDs)
+	for _, nodeID := range nodeIDs {
+		node, err := woc.wf.Status.Nodes.Get(nodeID)
+		if err != nil || node.Fulfilled() {
+			continue
+		}
+		if node.Type == wfv1.NodeTypePod {
+			if _, exists, err := woc.podExists(nodeID); err != nil || exists {
+				continue
+			}
+		}
+		woc.log.WithFields(logging.Fields{"nodeID": nodeID}).Info(ctx, "Failing node as its subtree was stopped")
+		woc.markNodePhase(ctx, node.Name, wfv1.NodeFailed, woc.nodeStoppedMessage())
+	}
+}
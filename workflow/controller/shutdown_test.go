@@ -0,0 +1,117 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+var stopSubtreeWf = `
+metadata:
+  name: stop-subtree
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: a
+        template: steps
+      - name: b
+        template: say
+  - name: steps
+    steps:
+    - - name: a1
+        template: say
+    - - name: a2
+        template: say
+  - name: say
+    container:
+      image: my-image
+`
+
+func TestStopNodeSubtree(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(stopSubtreeWf)
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodRunning)
+	a := woc.wf.Status.Nodes.FindByDisplayName("a")
+	require.NotNil(t, a)
+
+	// stop the subtree of a, as `argo stop --subtree` does
+	wf = woc.wf.DeepCopy()
+	wf.Annotations = map[string]string{
+		common.AnnotationKeyStoppedNodes:   a.ID,
+		common.AnnotationKeyShutdownReason: "bad input data",
+	}
+	wf.Labels[common.LabelKeyAction] = "Stop"
+	wf.Labels[common.LabelKeyActorPreferredUsername] = "admin"
+	woc = newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	a1 := woc.wf.Status.Nodes.FindByDisplayName("a1")
+	require.NotNil(t, a1)
+	assert.Equal(t, wfv1.NodeFailed, a1.Phase)
+	assert.Equal(t, "Subtree stopped: bad input data", a1.Message)
+	a = woc.wf.Status.Nodes.FindByDisplayName("a")
+	assert.Equal(t, wfv1.NodeFailed, a.Phase)
+	b := woc.wf.Status.Nodes.FindByDisplayName("b")
+	require.NotNil(t, b)
+	assert.Equal(t, wfv1.NodeRunning, b.Phase)
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+	assert.Equal(t, "Stopped the subtrees of stop-subtree.a by admin: bad input data", shutdownCondition(woc.wf))
+	assert.Contains(t, getEventsWithoutAnnotations(controller, len(controller.eventRecorderManager.(*testEventRecorderManager).eventRecorder.Events)), "Normal WorkflowShutdown Stopped the subtrees of stop-subtree.a by admin: bad input data")
+
+	// the rest of the workflow finishes, but a2 is never run
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	assert.Nil(t, woc.wf.Status.Nodes.FindByDisplayName("a2"))
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Nodes.FindByDisplayName("b").Phase)
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	assert.Len(t, pods.Items, 2)
+}
+
+func TestShutdownReason(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(stopSubtreeWf)
+	wf.Spec.Shutdown = wfv1.ShutdownStrategyTerminate
+	wf.Annotations = map[string]string{
+		common.AnnotationKeyShutdownReason:      "runaway costs",
+		common.AnnotationKeyShutdownGracePeriod: "30s",
+	}
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	assert.Equal(t, "Stopped with strategy 'Terminate': runaway costs", woc.shutdownMessage())
+	assert.Equal(t, ptr.To(30*time.Second), woc.shutdownGracePeriod(ctx))
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+	assert.Equal(t, "Stopped with strategy 'Terminate': runaway costs", woc.wf.Status.Message)
+	assert.Equal(t, "Stopped with strategy 'Terminate': runaway costs", shutdownCondition(woc.wf))
+}
+
+// shutdownCondition returns the message of the workflow's shutdown condition
+func shutdownCondition(wf *wfv1.Workflow) string {
+	for _, condition := range wf.Status.Conditions {
+		if condition.Type == wfv1.ConditionTypeShutdown {
+			return condition.Message
+		}
+	}
+	return ""
+}
@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wfutil "github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// stepThroughNodeName returns the name of the suspend node that pauses the workflow before the node runs
+func stepThroughNodeName(nodeName string) string {
+	return nodeName + ".stepThrough"
+}
+
+// stepThrough pauses a node before it runs when the workflow is being stepped through. A node that is about to be
+// created, and is selected, is created pending along with a suspend node, and stays pending until the suspend node is
+// resumed, e.g. by `argo resume --next`. If the suspend node fails, as it does when the workflow is stopped, so does the
+// node. It returns whether the node is paused.
+func (woc *wfOperationCtx) stepThrough(ctx context.Context, nodeName string, node *wfv1.NodeStatus, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, opts *executeTemplateOpts) (bool, error) {
+	suspendNodeName := stepThroughNodeName(nodeName)
+	suspendNode, err := woc.wf.GetNodeByName(suspendNodeName)
+	if err == nil {
+		switch {
+		case suspendNode.IsActiveSuspendNode():
+			return true, nil
+		case suspendNode.FailedOrError():
+			woc.markNodePhase(ctx, nodeName, wfv1.NodeFailed, suspendNode.Message)
+			return true, nil
+		}
+		return false, nil
+	}
+	stepThrough := woc.execWf.Spec.StepThrough
+	if node != nil || stepThrough == nil {
+		return false, nil
+	}
+	selector, err := wfutil.ParseNodeFieldSelector(stepThrough.NodeFieldSelector)
+	if err != nil {
+		return false, err
+	}
+	// the node is created so that it can be selected by its fields, and is run as any other pending node if it is not
+	node = woc.initializeExecutableNode(ctx, nodeName, wfutil.GetNodeType(tmpl), templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodePending, opts.nodeFlag, false)
+	if !selector.Matches(*node) {
+		return false, nil
+	}
+	node.Message = "paused for step-through"
+	woc.wf.Status.Nodes.Set(ctx, node.ID, *node)
+	woc.initializeNode(ctx, suspendNodeName, wfv1.NodeTypeSuspend, templateScope, orgTmpl, opts.boundaryID, wfv1.NodeRunning, &wfv1.NodeFlag{StepThrough: true}, true,
+		fmt.Sprintf("paused before %s", node.DisplayName))
+	return true, nil
+}
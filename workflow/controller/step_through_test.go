@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+var stepThroughWf = `
+metadata:
+  name: step-through
+  namespace: default
+spec:
+  entrypoint: main
+  stepThrough: {}
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: a
+        template: say
+      - name: b
+        template: say
+        dependencies: [a]
+  - name: say
+    container:
+      image: argoproj/argosay:v2
+`
+
+func TestStepThrough(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(stepThroughWf)
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+	pods := func(woc *wfOperationCtx) []apiv1.Pod {
+		list, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		return list.Items
+	}
+
+	// resume marks the next suspend node succeeded, as `argo resume --next` does
+	resume := func(woc *wfOperationCtx) *wfOperationCtx {
+		next := util.NextStepThroughNode(woc.wf)
+		require.NotNil(t, next)
+		next.Phase = wfv1.NodeSucceeded
+		woc.wf.Status.Nodes.Set(ctx, next.ID, *next)
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		return woc
+	}
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	a := woc.wf.Status.Nodes.FindByDisplayName("a")
+	require.NotNil(t, a)
+	assert.Equal(t, wfv1.NodePending, a.Phase)
+	assert.Equal(t, "paused before a", util.NextStepThroughNode(woc.wf).Message)
+	assert.True(t, woc.wf.Status.AnyActiveSuspendNode())
+	assert.Empty(t, pods(woc))
+
+	woc = resume(woc)
+	assert.Len(t, pods(woc), 1)
+	assert.Nil(t, util.NextStepThroughNode(woc.wf))
+
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	b := woc.wf.Status.Nodes.FindByDisplayName("b")
+	require.NotNil(t, b)
+	assert.Equal(t, wfv1.NodePending, b.Phase)
+	assert.Equal(t, "paused before b", util.NextStepThroughNode(woc.wf).Message)
+	assert.Len(t, pods(woc), 1)
+
+	woc = resume(woc)
+	assert.Len(t, pods(woc), 2)
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+}
+
+func TestStepThroughNodeFieldSelector(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(stepThroughWf)
+	wf.Spec.StepThrough.NodeFieldSelector = "displayName=b"
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+	pods := func(woc *wfOperationCtx) []apiv1.Pod {
+		list, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		return list.Items
+	}
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	assert.Nil(t, util.NextStepThroughNode(woc.wf))
+	assert.Len(t, pods(woc), 1)
+
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, "paused before b", util.NextStepThroughNode(woc.wf).Message)
+	assert.Len(t, pods(woc), 1)
+}
+
+func TestStepThroughStopped(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(stepThroughWf)
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	require.NotNil(t, util.NextStepThroughNode(woc.wf))
+
+	woc.wf.Spec.Shutdown = wfv1.ShutdownStrategyStop
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+	assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Nodes.FindByDisplayName("a").Phase)
+	assert.False(t, woc.wf.Status.AnyActiveSuspendNode())
+}
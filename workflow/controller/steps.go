@@ -9,7 +9,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/Knetic/govaluate"
 	v1 "k8s.io/api/core/v1"
 
 	"github.com/argoproj/argo-workflows/v3/errors"
@@ -18,6 +17,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/util/template"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/expand"
 	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
 )
 
@@ -122,7 +122,8 @@ func (woc *wfOperationCtx) executeSteps(ctx context.Context, nodeName string, tm
 			if err = woc.updateOutboundNodes(ctx, nodeName, tmpl); err != nil {
 				return nil, err
 			}
-			return woc.markNodePhase(ctx, nodeName, wfv1.NodeFailed, sgNode.Message), nil
+			node, _, err := woc.completeWithFinally(ctx, tmpl, nodeName, wfv1.NodeFailed, tmplCtx, stepsCtx.scope, sgNode.Message)
+			return node, err
 		}
 
 		// Add all outputs of each step in the group to the scope
@@ -191,7 +192,8 @@ func (woc *wfOperationCtx) executeSteps(ctx context.Context, nodeName string, tm
 			node.Phase = wfv1.NodeError
 		}
 	}
-	return woc.markNodePhase(ctx, nodeName, wfv1.NodeSucceeded), nil
+	node, _, err = woc.completeWithFinally(ctx, tmpl, nodeName, wfv1.NodeSucceeded, tmplCtx, stepsCtx.scope)
+	return node, err
 }
 
 // updateOutboundNodes set the outbound nodes from the last step group
@@ -262,7 +264,7 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 		childNodeName := fmt.Sprintf("%s.%s", sgNodeName, step.Name)
 
 		// Check the step's when clause to decide if it should execute
-		proceed, err := shouldExecute(step.When)
+		proceed, err := expand.ShouldExecute(step.When)
 		if err != nil {
 			woc.initializeNode(ctx, childNodeName, wfv1.NodeTypeSkipped, stepTemplateScope, &step, stepsCtx.boundaryID, wfv1.NodeError, &wfv1.NodeFlag{}, true, err.Error())
 			woc.addChildNode(ctx, sgNodeName, childNodeName)
@@ -366,45 +368,6 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 	return woc.markNodePhase(ctx, node.Name, wfv1.NodeSucceeded), nil
 }
 
-// shouldExecute evaluates a already substituted when expression to decide whether or not a step should execute
-func shouldExecute(when string) (bool, error) {
-	if when == "" {
-		return true, nil
-	}
-	expression, err := govaluate.NewEvaluableExpression(when)
-	if err != nil {
-		if strings.Contains(err.Error(), "Invalid token") {
-			return false, errors.Errorf(errors.CodeBadRequest, "Invalid 'when' expression '%s': %v (hint: try wrapping the affected expression in quotes (\"))", when, err)
-		}
-		return false, errors.Errorf(errors.CodeBadRequest, "Invalid 'when' expression '%s': %v", when, err)
-	}
-	// The following loop converts govaluate variables (which we don't use), into strings. This
-	// allows us to have expressions like: "foo != bar" without requiring foo and bar to be quoted.
-	tokens := expression.Tokens()
-	for i, tok := range tokens {
-		switch tok.Kind {
-		case govaluate.VARIABLE:
-			tok.Kind = govaluate.STRING
-		default:
-			continue
-		}
-		tokens[i] = tok
-	}
-	expression, err = govaluate.NewEvaluableExpressionFromTokens(tokens)
-	if err != nil {
-		return false, errors.InternalWrapErrorf(err, "Failed to parse 'when' expression '%s': %v", when, err)
-	}
-	result, err := expression.Evaluate(nil)
-	if err != nil {
-		return false, errors.InternalWrapErrorf(err, "Failed to evaluate 'when' expresion '%s': %v", when, err)
-	}
-	boolRes, ok := result.(bool)
-	if !ok {
-		return false, errors.Errorf(errors.CodeBadRequest, "Expected boolean evaluation for '%s'. Got %v", when, result)
-	}
-	return boolRes, nil
-}
-
 func errorFromChannel(errCh <-chan error) error {
 	select {
 	case err := <-errCh:
@@ -450,7 +413,7 @@ func (woc *wfOperationCtx) resolveReferences(ctx context.Context, stepGroup []wf
 
 		// If we are not executing, don't attempt to resolve any artifact references. We only check if we are executing after
 		// the initial parameter resolution, since it's likely that the "when" clause will contain parameter references.
-		proceed, err := shouldExecute(newStep.When)
+		proceed, err := expand.ShouldExecute(newStep.When)
 		if err != nil {
 			// If we got an error, it might be because our "when" clause contains a task-expansion parameter (e.g. {{item}}).
 			// Since we don't perform task-expansion until later and task-expansion parameters won't get resolved here,
@@ -544,35 +507,15 @@ func (woc *wfOperationCtx) expandStepGroup(ctx context.Context, sgNodeName strin
 }
 
 // expandStep expands a step containing withItems or withParams into multiple parallel steps
-// We want to be lazy with expanding. Unfortunately this is not quite possible as the When field might rely on
-// expansion to work with the shouldExecute function. To address this we apply a trick, we try to expand, if we fail, we then
-// check shouldExecute, if shouldExecute returns false, we continue on as normal else error out
 func (woc *wfOperationCtx) expandStep(ctx context.Context, step wfv1.WorkflowStep) ([]wfv1.WorkflowStep, error) {
-	var err error
-	expandedStep := make([]wfv1.WorkflowStep, 0)
-	var items []wfv1.Item
-	if len(step.WithItems) > 0 {
-		items = step.WithItems
-	} else if step.WithParam != "" {
-		err = json.Unmarshal([]byte(step.WithParam), &items)
-		if err != nil {
-			mustExec, mustExecErr := shouldExecute(step.When)
-			if mustExecErr != nil || mustExec {
-				return nil, errors.Errorf(errors.CodeBadRequest, "withParam value could not be parsed as a JSON list: %s: %v", strings.TrimSpace(step.WithParam), err)
-			}
-		}
-	} else if step.WithSequence != nil {
-		items, err = expandSequence(step.WithSequence)
-		if err != nil {
-			mustExec, mustExecErr := shouldExecute(step.When)
-			if mustExecErr != nil || mustExec {
-				return nil, err
-			}
-		}
-	} else {
+	if !step.ShouldExpand() {
 		// this should have been prevented in expandStepGroup()
 		return nil, errors.InternalError("expandStep() was called with withItems and withParam empty")
 	}
+	items, err := expand.Items(step.WithItems, step.WithParam, step.WithSequence, step.When)
+	if err != nil {
+		return nil, err
+	}
 
 	// these fields can be very large (>100m) and marshalling 10k x 100m = 6GB of memory used and
 	// very poor performance, so we just nil them out
@@ -589,17 +532,16 @@ func (woc *wfOperationCtx) expandStep(ctx context.Context, step wfv1.WorkflowSte
 		return nil, fmt.Errorf("unable to parse argo variable: %w", err)
 	}
 
-	for i, item := range items {
+	return parallelMap(items, func(i int, item wfv1.Item) (wfv1.WorkflowStep, error) {
 		var newStep wfv1.WorkflowStep
-		newStepName, err := processItem(ctx, t, step.Name, i, item, &newStep, step.When)
+		newStepName, err := expand.Item(ctx, t, step.Name, i, item, &newStep, step.When)
 		if err != nil {
-			return nil, err
+			return newStep, err
 		}
 		newStep.Name = newStepName
 		newStep.Template = step.Template
-		expandedStep = append(expandedStep, newStep)
-	}
-	return expandedStep, nil
+		return newStep, nil
+	})
 }
 
 func (woc *wfOperationCtx) prepareDefaultMetricScope() (map[string]string, map[string]func() float64) {
@@ -666,10 +608,9 @@ func (woc *wfOperationCtx) prepareMetricScope(node *wfv1.NodeStatus) (map[string
 		}
 		for _, param := range node.Outputs.Parameters {
 			key := fmt.Sprintf("outputs.parameters.%s", param.Name)
-			if param.Value == nil {
-				localScope[key] = ""
-			} else {
-				localScope[key] = param.Value.String()
+			localScope[key] = param.GetOutputValue()
+			if param.Optional {
+				localScope[key+".supplied"] = strconv.FormatBool(param.IsSupplied())
 			}
 		}
 	}
@@ -126,6 +126,12 @@ func (woc *wfOperationCtx) taskResultReconciliation(ctx context.Context) {
 		if result.Progress.IsValid() {
 			newNode.Progress = result.Progress
 		}
+		if len(result.PeakResourceUsage) > 0 {
+			newNode.PeakResourceUsage = result.PeakResourceUsage
+		}
+		if len(result.IntermediateParameters) > 0 {
+			newNode.IntermediateParameters = result.IntermediateParameters
+		}
 		if !reflect.DeepEqual(old, newNode) {
 			woc.log.
 				WithField("nodeID", nodeID).
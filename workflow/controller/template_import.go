@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/templateimport"
+	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
+)
+
+// workflowTemplateImporter imports the templates of a workflow with the credentials of its service account
+type workflowTemplateImporter struct {
+	woc *wfOperationCtx
+}
+
+var _ templateresolution.TemplateImporter = workflowTemplateImporter{}
+
+func (i workflowTemplateImporter) Import(ctx context.Context, imp wfv1.TemplateImport) (*wfv1.WorkflowTemplate, error) {
+	if i.woc.controller.templateImporter == nil {
+		return nil, errors.Errorf(errors.CodeBadRequest, "template import %q: templates cannot be imported", imp.Name)
+	}
+	return i.woc.controller.templateImporter.Import(ctx, imp, templateimport.Options{
+		Namespace:          i.woc.wf.Namespace,
+		ServiceAccountName: i.woc.execWf.Spec.ServiceAccountName,
+		ImagePullSecrets:   i.woc.execWf.Spec.ImagePullSecrets,
+	})
+}
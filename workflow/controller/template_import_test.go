@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/templateimport"
+)
+
+type fakeTemplateImporter func(imp wfv1.TemplateImport, options templateimport.Options) (*wfv1.WorkflowTemplate, error)
+
+func (f fakeTemplateImporter) Import(_ context.Context, imp wfv1.TemplateImport, options templateimport.Options) (*wfv1.WorkflowTemplate, error) {
+	return f(imp, options)
+}
+
+var workflowImportingTemplates = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: imports
+  namespace: default
+spec:
+  entrypoint: main
+  serviceAccountName: ci
+  imports:
+  - name: ci
+    registry: ghcr.io
+    repository: my-org/ci-templates
+    tag: v1
+  templates:
+  - name: main
+    steps:
+    - - name: build
+        templateRef:
+          name: ci
+          template: build
+`
+
+func TestTemplateImports(t *testing.T) {
+	run := func(t *testing.T, importer templateimport.Interface) *wfv1.Workflow {
+		wf := wfv1.MustUnmarshalWorkflow(workflowImportingTemplates)
+		ctx := logging.TestContext(t.Context())
+		cancel, controller := newController(ctx, wf)
+		defer cancel()
+		controller.templateImporter = importer
+
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		return woc.wf
+	}
+
+	t.Run("Imported", func(t *testing.T) {
+		var imported []templateimport.Options
+		wf := run(t, fakeTemplateImporter(func(imp wfv1.TemplateImport, options templateimport.Options) (*wfv1.WorkflowTemplate, error) {
+			imported = append(imported, options)
+			return wfv1.MustUnmarshalWorkflowTemplate(`metadata:
+  name: ci-templates
+spec:
+  templates:
+  - name: build
+    container:
+      image: golang
+      command: [go, build]
+`), nil
+		}))
+		assert.Equal(t, wfv1.WorkflowRunning, wf.Status.Phase, wf.Status.Message)
+		require.NotEmpty(t, imported)
+		assert.Equal(t, templateimport.Options{Namespace: "default", ServiceAccountName: "ci"}, imported[0])
+		node := wf.Status.Nodes.FindByDisplayName("build")
+		require.NotNil(t, node)
+		assert.Equal(t, "ci", node.TemplateRef.Name)
+		tmpl := wf.GetStoredTemplate(wfv1.ResourceScopeLocal, "", &wfv1.WorkflowStep{TemplateRef: &wfv1.TemplateRef{Name: "ci", Template: "build"}})
+		require.NotNil(t, tmpl)
+		assert.Equal(t, "golang", tmpl.Container.Image)
+	})
+	t.Run("NotImported", func(t *testing.T) {
+		wf := run(t, fakeTemplateImporter(func(imp wfv1.TemplateImport, options templateimport.Options) (*wfv1.WorkflowTemplate, error) {
+			return nil, fmt.Errorf("template import %q: not signed", imp.Name)
+		}))
+		assert.Equal(t, wfv1.WorkflowFailed, wf.Status.Phase)
+		assert.Contains(t, wf.Status.Message, `template import "ci": not signed`)
+	})
+}
@@ -0,0 +1,230 @@
+package controller
+
+import (
+	"context"
+	"strings"
+
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
+	"github.com/argoproj/argo-workflows/v3/workflow/templaterevision"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// addTemplateRevisionHandler records a revision of the templates the informer sees each time their spec changes, so
+// workflows can pin them. The revisions of ClusterWorkflowTemplates are recorded in the controller's namespace.
+func (wfc *WorkflowController) addTemplateRevisionHandler(ctx context.Context, informer cache.SharedIndexInformer, clusterScope bool) error {
+	limit := wfc.Config.GetWorkflowTemplateRevisionHistoryLimit()
+	if limit <= 0 {
+		return nil
+	}
+	record := func(obj interface{}) {
+		un, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return
+		}
+		// both kinds of template have the same schema
+		tmpl := &wfv1.WorkflowTemplate{}
+		if err := util.FromUnstructuredObj(un, tmpl); err != nil {
+			return
+		}
+		namespace := tmpl.Namespace
+		if clusterScope {
+			namespace = wfc.namespace
+		}
+		if err := templaterevision.Record(ctx, wfc.kubeclientset, namespace, tmpl.Name, clusterScope, &tmpl.Spec, limit); err != nil {
+			logging.RequireLoggerFromContext(ctx).WithError(err).WithFields(logging.Fields{"namespace": tmpl.Namespace, "name": tmpl.Name}).Warn(ctx, "Failed to record template revision")
+		}
+	}
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: record,
+		UpdateFunc: func(old, new interface{}) {
+			oldUn, ok := old.(*unstructured.Unstructured)
+			newUn, ok2 := new.(*unstructured.Unstructured)
+			if ok && ok2 && oldUn.GetGeneration() == newUn.GetGeneration() {
+				return
+			}
+			record(new)
+		},
+	})
+	return err
+}
+
+// pinnedWorkflowSpec returns the revision of the template the workflowTemplateRef pins by its digest. This is the
+// current spec of the template, if it has not changed (or the template does not exist, if current is nil).
+func (woc *wfOperationCtx) pinnedWorkflowSpec(ctx context.Context, current wfv1.WorkflowSpecHolder) (wfv1.WorkflowSpecHolder, error) {
+	ref := woc.wf.Spec.WorkflowTemplateRef // not-woc-misuse
+	if current != nil {
+		digest, err := templaterevision.Digest(current.GetWorkflowSpec())
+		if err != nil {
+			return nil, err
+		}
+		if digest == ref.Digest {
+			return current, nil
+		}
+	}
+	namespace := ref.GetNamespace(woc.wf.Namespace)
+	if ref.ClusterScope {
+		namespace = woc.controller.namespace
+	}
+	spec, err := templaterevision.Get(ctx, woc.controller.kubeclientset, namespace, ref.Name, ref.ClusterScope, ref.Digest)
+	if err != nil {
+		return nil, err
+	}
+	if ref.ClusterScope {
+		tmpl := &wfv1.ClusterWorkflowTemplate{Spec: *spec}
+		tmpl.Name = ref.Name
+		return tmpl, nil
+	}
+	tmpl := &wfv1.WorkflowTemplate{Spec: *spec}
+	tmpl.Name = ref.Name
+	tmpl.Namespace = namespace
+	return tmpl, nil
+}
+
+// templateDigestKey returns the key of the digest of a template in the status of the workflow
+func templateDigestKey(clusterScope bool, name string) string {
+	if clusterScope {
+		return string(wfv1.ResourceScopeCluster) + "/" + name
+	}
+	return string(wfv1.ResourceScopeNamespaced) + "/" + name
+}
+
+// pinnedTemplateSpec returns the spec of the revision of the template the workflow pinned, given the result of getting
+// the current template. The first time the workflow gets a template, it pins the current revision by its digest. The
+// spec is resolved once per operation.
+func (woc *wfOperationCtx) pinnedTemplateSpec(ctx context.Context, clusterScope bool, name string, current wfv1.WorkflowSpecHolder, err error) (*wfv1.WorkflowSpec, error) {
+	key := templateDigestKey(clusterScope, name)
+	if spec, ok := woc.pinnedSpecs[key]; ok {
+		return spec, nil
+	}
+	spec, err := woc.resolvePinnedTemplateSpec(ctx, clusterScope, name, current, err)
+	if err != nil {
+		return nil, err
+	}
+	if woc.pinnedSpecs == nil {
+		woc.pinnedSpecs = map[string]*wfv1.WorkflowSpec{}
+	}
+	woc.pinnedSpecs[key] = spec
+	return spec, nil
+}
+
+func (woc *wfOperationCtx) resolvePinnedTemplateSpec(ctx context.Context, clusterScope bool, name string, current wfv1.WorkflowSpecHolder, err error) (*wfv1.WorkflowSpec, error) {
+	key := templateDigestKey(clusterScope, name)
+	digest, ok := woc.wf.Status.TemplateDigests[key]
+	if !ok {
+		if err != nil {
+			return nil, err
+		}
+		digest, err := templaterevision.Digest(current.GetWorkflowSpec())
+		if err != nil {
+			return nil, err
+		}
+		if woc.wf.Status.TemplateDigests == nil {
+			woc.wf.Status.TemplateDigests = map[string]string{}
+		}
+		woc.wf.Status.TemplateDigests[key] = digest
+		woc.updated = true
+		return current.GetWorkflowSpec(), nil
+	}
+	if err == nil {
+		currentDigest, err := templaterevision.Digest(current.GetWorkflowSpec())
+		if err != nil {
+			return nil, err
+		}
+		if currentDigest == digest {
+			return current.GetWorkflowSpec(), nil
+		}
+	} else if !apierr.IsNotFound(err) {
+		// a pinned revision can still be used once the template has been deleted
+		return nil, err
+	}
+	namespace := woc.wf.Namespace
+	if clusterScope {
+		namespace = woc.controller.namespace
+	}
+	return templaterevision.Get(ctx, woc.controller.kubeclientset, namespace, name, clusterScope, digest)
+}
+
+// pinningWorkflowTemplateGetter gets the revisions of WorkflowTemplates the workflow pinned
+type pinningWorkflowTemplateGetter struct {
+	woc    *wfOperationCtx
+	getter templateresolution.WorkflowTemplateNamespacedGetter
+}
+
+func (g *pinningWorkflowTemplateGetter) Get(ctx context.Context, name string) (*wfv1.WorkflowTemplate, error) {
+	current, err := g.getter.Get(ctx, name)
+	spec, err := g.woc.pinnedTemplateSpec(ctx, false, name, current, err)
+	if err != nil {
+		return nil, err
+	}
+	if current != nil && spec == current.GetWorkflowSpec() {
+		return current, nil
+	}
+	tmpl := &wfv1.WorkflowTemplate{Spec: *spec}
+	tmpl.Name = name
+	tmpl.Namespace = g.woc.wf.Namespace
+	return tmpl, nil
+}
+
+// pinningClusterWorkflowTemplateGetter gets the revisions of ClusterWorkflowTemplates the workflow pinned
+type pinningClusterWorkflowTemplateGetter struct {
+	woc    *wfOperationCtx
+	getter templateresolution.ClusterWorkflowTemplateGetter
+}
+
+func (g *pinningClusterWorkflowTemplateGetter) Get(ctx context.Context, name string) (*wfv1.ClusterWorkflowTemplate, error) {
+	current, err := g.getter.Get(ctx, name)
+	spec, err := g.woc.pinnedTemplateSpec(ctx, true, name, current, err)
+	if err != nil {
+		return nil, err
+	}
+	if current != nil && spec == current.GetWorkflowSpec() {
+		return current, nil
+	}
+	tmpl := &wfv1.ClusterWorkflowTemplate{Spec: *spec}
+	tmpl.Name = name
+	return tmpl, nil
+}
+
+// pinsTemplates returns whether the controller pins the revisions of the templates workflows resolve templates from,
+// rather than storing the resolved templates
+func (woc *wfOperationCtx) pinsTemplates() bool {
+	return woc.controller.Config.StoredTemplates == config.StoredTemplatesPinned
+}
+
+// pinningTemplateGetters returns getters that get the revisions of templates the workflow pinned, if the controller
+// pins templates
+func (woc *wfOperationCtx) pinningTemplateGetters(wftmplGetter templateresolution.WorkflowTemplateNamespacedGetter, cwftmplGetter templateresolution.ClusterWorkflowTemplateGetter) (templateresolution.WorkflowTemplateNamespacedGetter, templateresolution.ClusterWorkflowTemplateGetter) {
+	if !woc.pinsTemplates() {
+		return wftmplGetter, cwftmplGetter
+	}
+	return &pinningWorkflowTemplateGetter{woc: woc, getter: wftmplGetter}, &pinningClusterWorkflowTemplateGetter{woc: woc, getter: cwftmplGetter}
+}
+
+// pinnedTemplates returns the templates of the revisions of the templates the workflow pinned, resolving those not
+// already resolved in this operation
+func (woc *wfOperationCtx) pinnedTemplates(ctx context.Context) []wfv1.Template {
+	var templates []wfv1.Template
+	for key := range woc.wf.Status.TemplateDigests {
+		if spec, ok := woc.pinnedSpecs[key]; ok {
+			templates = append(templates, spec.Templates...)
+			continue
+		}
+		scope, name, _ := strings.Cut(key, "/")
+		tmplCtx, err := woc.createTemplateContext(ctx, wfv1.ResourceScope(scope), name)
+		if err != nil {
+			woc.log.WithError(err).WithField("template", key).Warn(ctx, "Failed to get pinned template")
+			continue
+		}
+		if holder, ok := tmplCtx.GetCurrentTemplateBase().(wfv1.WorkflowSpecHolder); ok {
+			templates = append(templates, holder.GetWorkflowSpec().Templates...)
+		}
+	}
+	return templates
+}
@@ -0,0 +1,184 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/templaterevision"
+)
+
+var pinnedWorkflowTemplate = `apiVersion: argoproj.io/v1alpha1
+kind: WorkflowTemplate
+metadata:
+  name: pinned
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: argoproj/argosay:v2
+      command: [echo]
+      args: [new]
+`
+
+func TestPinnedWorkflowTemplate(t *testing.T) {
+	wftmpl := wfv1.MustUnmarshalWorkflowTemplate(pinnedWorkflowTemplate)
+	oldSpec := wftmpl.Spec.DeepCopy()
+	oldSpec.Templates[0].Container.Args = []string{"old"}
+	oldDigest, err := templaterevision.Digest(oldSpec)
+	require.NoError(t, err)
+	newDigest, err := templaterevision.Digest(&wftmpl.Spec)
+	require.NoError(t, err)
+
+	run := func(t *testing.T, digest string) *wfv1.Workflow {
+		wf := wfv1.MustUnmarshalWorkflow(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: pinned
+  namespace: default
+spec:
+  workflowTemplateRef:
+    name: pinned
+`)
+		wf.Spec.WorkflowTemplateRef.Digest = digest
+		ctx := logging.TestContext(t.Context())
+		cancel, controller := newController(ctx, wf, wftmpl)
+		defer cancel()
+		require.NoError(t, templaterevision.Record(ctx, controller.kubeclientset, wf.Namespace, "pinned", false, oldSpec, 10))
+
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		return woc.wf
+	}
+
+	t.Run("Latest", func(t *testing.T) {
+		wf := run(t, "")
+		assert.Equal(t, wfv1.WorkflowRunning, wf.Status.Phase)
+		assert.Equal(t, []string{"new"}, wf.Status.StoredWorkflowSpec.Templates[0].Container.Args)
+		assert.Equal(t, newDigest, wf.Annotations[common.AnnotationKeyWorkflowTemplateDigest])
+	})
+	t.Run("Current", func(t *testing.T) {
+		wf := run(t, newDigest)
+		assert.Equal(t, wfv1.WorkflowRunning, wf.Status.Phase)
+		assert.Equal(t, []string{"new"}, wf.Status.StoredWorkflowSpec.Templates[0].Container.Args)
+	})
+	t.Run("Previous", func(t *testing.T) {
+		wf := run(t, oldDigest)
+		assert.Equal(t, wfv1.WorkflowRunning, wf.Status.Phase)
+		assert.Equal(t, []string{"old"}, wf.Status.StoredWorkflowSpec.Templates[0].Container.Args)
+		assert.Equal(t, oldDigest, wf.Annotations[common.AnnotationKeyWorkflowTemplateDigest])
+	})
+	t.Run("Unknown", func(t *testing.T) {
+		wf := run(t, "sha256:unknown")
+		assert.Equal(t, wfv1.WorkflowError, wf.Status.Phase)
+		assert.Contains(t, wf.Status.Message, "not found")
+	})
+}
+
+func TestPinnedTemplateRefs(t *testing.T) {
+	wftmpl := wfv1.MustUnmarshalWorkflowTemplate(pinnedWorkflowTemplate)
+	oldSpec := wftmpl.Spec.DeepCopy()
+	oldSpec.Templates[0].Container.Args = []string{"old"}
+	oldDigest, err := templaterevision.Digest(oldSpec)
+	require.NoError(t, err)
+	newDigest, err := templaterevision.Digest(&wftmpl.Spec)
+	require.NoError(t, err)
+
+	run := func(t *testing.T, digests map[string]string) (*wfv1.Workflow, []string) {
+		wf := wfv1.MustUnmarshalWorkflow(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: pinned-refs
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: a
+        templateRef:
+          name: pinned
+          template: main
+`)
+		wf.Status.TemplateDigests = digests
+		ctx := logging.TestContext(t.Context())
+		cancel, controller := newController(ctx, wf, wftmpl)
+		defer cancel()
+		controller.Config.StoredTemplates = config.StoredTemplatesPinned
+		require.NoError(t, templaterevision.Record(ctx, controller.kubeclientset, wf.Namespace, "pinned", false, oldSpec, 10))
+
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		pods, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		require.Len(t, pods.Items, 1)
+		tmpl, err := getPodTemplate(&pods.Items[0])
+		require.NoError(t, err)
+		return woc.wf, tmpl.Container.Args
+	}
+
+	t.Run("Current", func(t *testing.T) {
+		wf, args := run(t, nil)
+		assert.Equal(t, wfv1.WorkflowRunning, wf.Status.Phase)
+		assert.Empty(t, wf.Status.StoredTemplates)
+		assert.Equal(t, map[string]string{"namespaced/pinned": newDigest}, wf.Status.TemplateDigests)
+		assert.Equal(t, []string{"new"}, args)
+	})
+	t.Run("Pinned", func(t *testing.T) {
+		wf, args := run(t, map[string]string{"namespaced/pinned": oldDigest})
+		assert.Equal(t, wfv1.WorkflowRunning, wf.Status.Phase)
+		assert.Empty(t, wf.Status.StoredTemplates)
+		assert.Equal(t, map[string]string{"namespaced/pinned": oldDigest}, wf.Status.TemplateDigests)
+		assert.Equal(t, []string{"old"}, args)
+	})
+}
+
+func TestPinnedTemplatesResolvedOnce(t *testing.T) {
+	wftmpl := wfv1.MustUnmarshalWorkflowTemplate(pinnedWorkflowTemplate)
+	oldSpec := wftmpl.Spec.DeepCopy()
+	oldSpec.Templates[0].Container.Args = []string{"old"}
+	oldDigest, err := templaterevision.Digest(oldSpec)
+	require.NoError(t, err)
+	wf := wfv1.MustUnmarshalWorkflow(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: pinned-refs
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: a
+        templateRef:
+          name: pinned
+          template: main
+`)
+	wf.Status.TemplateDigests = map[string]string{"namespaced/pinned": oldDigest}
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf, wftmpl)
+	defer cancel()
+	controller.Config.StoredTemplates = config.StoredTemplatesPinned
+	require.NoError(t, templaterevision.Record(ctx, controller.kubeclientset, wf.Namespace, "pinned", false, oldSpec, 10))
+	kube := controller.kubeclientset.(*fake.Clientset)
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	templates := woc.pinnedTemplates(ctx)
+	require.Len(t, templates, 1)
+	assert.Equal(t, []string{"old"}, templates[0].Container.Args)
+
+	actions := len(kube.Actions())
+	templates = woc.pinnedTemplates(ctx)
+	require.Len(t, templates, 1)
+	assert.Equal(t, []string{"old"}, templates[0].Container.Args)
+	assert.False(t, woc.HasArtifactGC(ctx))
+	assert.Len(t, kube.Actions(), actions, "the pinned revision is not got again")
+}
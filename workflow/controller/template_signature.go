@@ -0,0 +1,24 @@
+package controller
+
+import (
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
+)
+
+// verifyTemplate returns an error unless the template has been signed, if the controller verifies template signatures
+func (woc *wfOperationCtx) verifyTemplate(tmpl wfv1.WorkflowSpecHolder) error {
+	if woc.controller.templateVerifier == nil {
+		return nil
+	}
+	return woc.controller.templateVerifier.Verify(tmpl)
+}
+
+// verifyingTemplateGetters returns getters that only get templates that have been signed, if the controller verifies
+// template signatures
+func (woc *wfOperationCtx) verifyingTemplateGetters(wftmplGetter templateresolution.WorkflowTemplateNamespacedGetter, cwftmplGetter templateresolution.ClusterWorkflowTemplateGetter) (templateresolution.WorkflowTemplateNamespacedGetter, templateresolution.ClusterWorkflowTemplateGetter) {
+	if woc.controller.templateVerifier == nil {
+		return wftmplGetter, cwftmplGetter
+	}
+	return templateresolution.VerifyWorkflowTemplates(wftmplGetter, woc.controller.templateVerifier),
+		templateresolution.VerifyClusterWorkflowTemplates(cwftmplGetter, woc.controller.templateVerifier)
+}
@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/templatesignature"
+)
+
+var signedTemplate = `apiVersion: argoproj.io/v1alpha1
+kind: WorkflowTemplate
+metadata:
+  name: signed
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: docker/whalesay
+      command: [cowsay]
+`
+
+var workflowWithSignedTemplateRef = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: signed
+  namespace: default
+spec:
+  workflowTemplateRef:
+    name: signed
+`
+
+var workflowWithSignedTemplateStepRef = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: signed
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: step
+        templateRef:
+          name: signed
+          template: main
+`
+
+func TestTemplateSignatures(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	data, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	verifier, err := templatesignature.New(&config.TemplateSignatures{PublicKeys: []string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: data}))}})
+	require.NoError(t, err)
+
+	newTemplate := func(t *testing.T, signed bool) *wfv1.WorkflowTemplate {
+		wftmpl := wfv1.MustUnmarshalWorkflowTemplate(signedTemplate)
+		if signed {
+			payload, err := templatesignature.Payload(&wftmpl.Spec)
+			require.NoError(t, err)
+			h := sha256.Sum256(payload)
+			sig, err := ecdsa.SignASN1(rand.Reader, key, h[:])
+			require.NoError(t, err)
+			wftmpl.Annotations = map[string]string{common.AnnotationKeySignature: base64.StdEncoding.EncodeToString(sig)}
+		}
+		return wftmpl
+	}
+	run := func(t *testing.T, workflow string, signed bool) *wfv1.Workflow {
+		wf := wfv1.MustUnmarshalWorkflow(workflow)
+		ctx := logging.TestContext(t.Context())
+		cancel, controller := newController(ctx, wf, newTemplate(t, signed))
+		defer cancel()
+		controller.templateVerifier = verifier
+
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		return woc.wf
+	}
+
+	t.Run("WorkflowTemplateRef", func(t *testing.T) {
+		wf := run(t, workflowWithSignedTemplateRef, true)
+		assert.Equal(t, wfv1.WorkflowRunning, wf.Status.Phase, wf.Status.Message)
+	})
+	t.Run("UnsignedWorkflowTemplateRef", func(t *testing.T) {
+		wf := run(t, workflowWithSignedTemplateRef, false)
+		assert.Equal(t, wfv1.WorkflowError, wf.Status.Phase)
+		assert.Contains(t, wf.Status.Message, "WorkflowTemplate signed is not verified: it has no signature")
+	})
+	t.Run("TemplateRef", func(t *testing.T) {
+		wf := run(t, workflowWithSignedTemplateStepRef, true)
+		assert.Equal(t, wfv1.WorkflowRunning, wf.Status.Phase, wf.Status.Message)
+	})
+	t.Run("UnsignedTemplateRef", func(t *testing.T) {
+		wf := run(t, workflowWithSignedTemplateStepRef, false)
+		assert.Equal(t, wfv1.WorkflowFailed, wf.Status.Phase)
+		assert.Contains(t, wf.Status.Message, "WorkflowTemplate signed is not verified: it has no signature")
+	})
+}
@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"context"
+	"sync"
+
+	runtimeutil "k8s.io/apimachinery/pkg/util/runtime"
+)
+
+// workerPool runs workers that each process items until their queue is shut down. The number of workers can be
+// changed while they run.
+type workerPool struct {
+	mutex sync.Mutex
+	// process processes the next item, and returns false if the queue was shut down
+	process func(ctx context.Context) bool
+	// ctx is the context of the workers, nil until the pool is started
+	ctx   context.Context
+	stops []chan struct{}
+	size  int
+	// workers are the running workers, including removed workers that have not stopped yet
+	workers sync.WaitGroup
+}
+
+func newWorkerPool(process func(ctx context.Context) bool) *workerPool {
+	return &workerPool{process: process}
+}
+
+// start starts the workers
+func (p *workerPool) start(ctx context.Context, workers int) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.ctx = ctx
+	p.resizeLocked(workers)
+}
+
+// resize changes the number of workers. Removed workers stop after the item they are processing, or are waiting for.
+func (p *workerPool) resize(workers int) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.resizeLocked(workers)
+}
+
+func (p *workerPool) resizeLocked(workers int) {
+	p.size = workers
+	if p.ctx == nil {
+		return
+	}
+	for len(p.stops) < workers {
+		stop := make(chan struct{})
+		p.stops = append(p.stops, stop)
+		p.workers.Add(1)
+		go p.work(p.ctx, stop)
+	}
+	for len(p.stops) > workers {
+		close(p.stops[len(p.stops)-1])
+		p.stops = p.stops[:len(p.stops)-1]
+	}
+}
+
+// len returns the number of workers
+func (p *workerPool) len() int {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.size
+}
+
+// wait waits until the workers have stopped, i.e. until their queue was shut down or they were all removed
+func (p *workerPool) wait() {
+	p.workers.Wait()
+}
+
+func (p *workerPool) work(ctx context.Context, stop <-chan struct{}) {
+	defer p.workers.Done()
+	defer runtimeutil.HandleCrashWithContext(ctx, runtimeutil.PanicHandlers...)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-stop:
+			return
+		default:
+		}
+		if !p.process(ctx) {
+			return
+		}
+	}
+}
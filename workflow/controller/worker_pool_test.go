@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func TestWorkerPool(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	items := make(chan struct{})
+	var busy atomic.Int32
+	p := newWorkerPool(func(ctx context.Context) bool {
+		busy.Add(1)
+		defer busy.Add(-1)
+		select {
+		case <-ctx.Done():
+		case <-items:
+		}
+		return true
+	})
+	p.resize(2)
+	assert.Equal(t, 2, p.len())
+	assert.Equal(t, int32(0), busy.Load(), "workers must not run until the pool is started")
+
+	p.start(ctx, 3)
+	assert.Eventually(t, func() bool { return busy.Load() == 3 }, time.Second, 10*time.Millisecond)
+
+	p.resize(1)
+	assert.Equal(t, 1, p.len())
+	// the removed workers stop after their current item
+	assert.Eventually(t, func() bool {
+		select {
+		case items <- struct{}{}:
+		default:
+		}
+		return busy.Load() == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Never(t, func() bool { return busy.Load() != 1 }, 100*time.Millisecond, 10*time.Millisecond)
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"time"
 
@@ -13,9 +15,11 @@ import (
 
 	apiv1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
+	"github.com/argoproj/argo-workflows/v3/config"
 	"github.com/argoproj/argo-workflows/v3/errors"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -27,6 +31,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/entrypoint"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/indexes"
+	"github.com/argoproj/argo-workflows/v3/workflow/podsecurity"
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
 	"github.com/argoproj/argo-workflows/v3/workflow/validate"
 )
@@ -97,7 +102,7 @@ func (woc *wfOperationCtx) processPodSpecPatch(ctx context.Context, tmpl *wfv1.T
 	for _, patch := range toProcess {
 		newTmpl := tmpl.DeepCopy()
 		newTmpl.PodSpecPatch = patch
-		processedTmpl, err := common.ProcessArgs(ctx, newTmpl, &wfv1.Arguments{}, woc.globalParams, localParams, false, woc.wf.Namespace, woc.controller.configMapInformer.GetIndexer())
+		processedTmpl, err := common.ProcessArgs(ctx, newTmpl, &wfv1.Arguments{}, woc.globalParams, localParams, false, woc.wf.Namespace, woc.controller.configMapInformer.GetIndexer(), woc.getSecretValue)
 		if err != nil {
 			return nil, errors.Wrap(err, "", "Failed to substitute the PodSpecPatch variables")
 		}
@@ -107,6 +112,47 @@ func (woc *wfOperationCtx) processPodSpecPatch(ctx context.Context, tmpl *wfv1.T
 
 }
 
+// checkImagePolicy returns an error if the pod runs an image the image policy does not allow, other than the executor.
+// Unlike validation, it checks the images after parameters, defaults and patches have been applied.
+func (woc *wfOperationCtx) checkImagePolicy(pod *apiv1.Pod) error {
+	for _, c := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+		if c.Image == woc.executorImage() {
+			continue
+		}
+		if err := woc.controller.Config.ImagePolicy.Check(c.Image); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "container %q: %s", c.Name, err.Error())
+		}
+	}
+	return nil
+}
+
+// applyPodSecurityStandard hardens the pod to satisfy the configured Pod Security Standard, returning an error if its
+// settings violate it
+func (woc *wfOperationCtx) applyPodSecurityStandard(pod *apiv1.Pod) error {
+	if woc.controller.Config.PodSecurityStandard != podsecurity.Restricted {
+		return nil
+	}
+	// the executor image runs as root by default, but is able to run as the user of the non-root executor image
+	if pod.Spec.SecurityContext == nil || pod.Spec.SecurityContext.RunAsUser == nil {
+		for _, containers := range [][]apiv1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+			for i, c := range containers {
+				if c.Image != woc.executorImage() || (c.SecurityContext != nil && c.SecurityContext.RunAsUser != nil) {
+					continue
+				}
+				if c.SecurityContext == nil {
+					containers[i].SecurityContext = &apiv1.SecurityContext{}
+				}
+				containers[i].SecurityContext.RunAsUser = ptr.To(int64(8737))
+			}
+		}
+	}
+	podsecurity.Harden(&pod.Spec)
+	if err := podsecurity.Check(&pod.Spec); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "pod violates the restricted Pod Security Standard: %s", err.Error())
+	}
+	return nil
+}
+
 func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName string, mainCtrs []apiv1.Container, tmpl *wfv1.Template, opts *createWorkflowPodOpts) (*apiv1.Pod, error) {
 	nodeID := woc.wf.NodeID(nodeName)
 
@@ -231,8 +277,13 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 	if woc.controller.Config.InstanceID != "" {
 		pod.Labels[common.LabelKeyControllerInstanceID] = woc.controller.Config.InstanceID
 	}
+	woc.addInformerLabels(pod)
 
-	woc.addArchiveLocation(ctx, tmpl)
+	repo, err := woc.templateArtifactRepository(ctx, nodeName, tmpl)
+	if err != nil {
+		return nil, err
+	}
+	woc.addArchiveLocation(ctx, tmpl, repo)
 
 	err = woc.setupServiceAccount(ctx, pod, tmpl)
 	if err != nil {
@@ -274,6 +325,14 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 	pod.Spec.InitContainers = []apiv1.Container{initCtr}
 
 	woc.addSchedulingConstraints(ctx, pod, wfSpec, tmpl, nodeName)
+	if tmpl.GPU != nil {
+		if err := woc.addGPU(pod, tmpl); err != nil {
+			return nil, err
+		}
+	}
+	if err := woc.addPodDefaults(pod); err != nil {
+		return nil, err
+	}
 	woc.addMetadata(pod, tmpl)
 
 	// Set initial progress from pod metadata if exists.
@@ -302,6 +361,10 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		addScriptStagingVolume(pod)
 	}
 
+	if tmpl.Scratch != nil {
+		woc.addScratchVolume(pod, tmpl)
+	}
+
 	// addInitContainers, addSidecars and addOutputArtifactsVolumes should be called after all
 	// volumes have been manipulated in the main container since volumeMounts are mirrored
 	addInitContainers(ctx, pod, tmpl)
@@ -328,13 +391,17 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 	}
 
 	// only set tick durations/EnvVarProgressFile if progress is enabled.
-	// The progress is only monitored if the tick durations are >0.
+	// The progress, and the intermediate parameters, are only monitored if the tick durations are >0.
 	if woc.controller.progressPatchTickDuration != 0 && woc.controller.progressFileTickDuration != 0 {
 		envVars = append(envVars,
 			apiv1.EnvVar{
 				Name:  common.EnvVarProgressFile,
 				Value: common.ArgoProgressPath,
 			},
+			apiv1.EnvVar{
+				Name:  common.EnvVarIntermediateParametersFile,
+				Value: common.ArgoIntermediateParametersPath,
+			},
 			apiv1.EnvVar{
 				Name:  common.EnvVarProgressPatchTickDuration,
 				Value: woc.controller.progressPatchTickDuration.String(),
@@ -401,15 +468,33 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		pod.Spec = *patchedPodSpec
 	}
 
+	originalImages, err := woc.pinImageDigests(ctx, nodeID, pod)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := woc.checkImagePolicy(pod); err != nil {
+		return nil, err
+	}
+
+	if err := woc.applyPodSecurityStandard(pod); err != nil {
+		return nil, err
+	}
+
 	for i, c := range pod.Spec.Containers {
 		if c.Name != common.WaitContainerName {
 			// https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#notes
 			if len(c.Command) == 0 {
-				x, err := woc.controller.entrypoint.Lookup(ctx, c.Image, entrypoint.Options{
+				// the index lists images as they are written, rather than pinned to digests
+				image := c.Image
+				if original, ok := originalImages[image]; ok {
+					image = original
+				}
+				x, err := woc.controller.entrypoint.Lookup(ctx, image, entrypoint.Options{
 					Namespace: woc.wf.Namespace, ServiceAccountName: woc.execWf.Spec.ServiceAccountName, ImagePullSecrets: woc.execWf.Spec.ImagePullSecrets,
 				})
 				if err != nil {
-					return nil, fmt.Errorf("failed to look-up entrypoint/cmd for image %q, you must either explicitly specify the command, or list the image's command in the index: https://argo-workflows.readthedocs.io/en/latest/workflow-executors/#emissary-emissary: %w", c.Image, err)
+					return nil, fmt.Errorf("failed to look-up entrypoint/cmd for image %q, you must either explicitly specify the command, or list the image's command in the index: https://argo-workflows.readthedocs.io/en/latest/workflow-executors/#emissary-emissary: %w", image, err)
 				}
 				c.Command = x.Entrypoint
 				if c.Args == nil { // check nil rather than length, as zero-length is valid args
@@ -419,7 +504,7 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 			execCmd := append(append([]string{common.VarRunArgoPath + "/argoexec", "emissary"}, woc.getExecutorLogOpts(ctx)...), "--")
 			c.Command = append(execCmd, c.Command...)
 		}
-		if c.Image == woc.controller.executorImage() {
+		if c.Image == woc.executorImage() {
 			// mount tmp dir to wait container
 			c.VolumeMounts = append(c.VolumeMounts, apiv1.VolumeMount{
 				Name:      volumeTmpDir.Name,
@@ -528,6 +613,14 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		pod.Spec.ActiveDeadlineSeconds = &newActiveDeadlineSeconds
 	}
 
+	// Create the PDB of the pods of the template, if it has one, before the first of them
+	if tmpl.PodDisruptionBudget != nil {
+		pod.Labels[common.LabelKeyPodDisruptionBudget] = templatePDBName(woc.wf.Name, tmpl.Name)
+		if err := woc.createTemplatePDBResource(ctx, tmpl); err != nil {
+			return nil, err
+		}
+	}
+
 	if !woc.controller.rateLimiter.Allow() {
 		return nil, ErrResourceRateLimitReached
 	}
@@ -535,6 +628,7 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 	woc.log.WithFields(logging.Fields{"nodeName": nodeName, "podName": pod.Name}).Debug(ctx, "Creating Pod")
 
 	created, err := woc.controller.kubeclientset.CoreV1().Pods(woc.wf.ObjectMeta.Namespace).Create(ctx, pod, metav1.CreateOptions{})
+	woc.controller.rateLimiter.observe(err)
 	if err != nil {
 		if apierr.IsAlreadyExists(err) {
 			// workflow pod names are deterministic. We can get here if the
@@ -695,14 +789,80 @@ func (woc *wfOperationCtx) createVolumes(tmpl *wfv1.Template) []apiv1.Volume {
 	return volumes
 }
 
+// executorDefaults returns the executor defaults of the controller config that select the workflow, merged so that
+// later defaults take precedence over earlier ones
+func (woc *wfOperationCtx) executorDefaults() config.ExecutorDefaults {
+	var defaults config.ExecutorDefaults
+	for _, d := range woc.controller.Config.ExecutorDefaults {
+		// the selectors are validated when the config is loaded
+		if selected, _ := d.Selects(woc.wf.Namespace, woc.wf.Labels); !selected {
+			continue
+		}
+		if d.Image != "" {
+			defaults.Image = d.Image
+		}
+		if d.ImagePullPolicy != "" {
+			defaults.ImagePullPolicy = d.ImagePullPolicy
+		}
+		if d.Resources != nil {
+			defaults.Resources = d.Resources
+		}
+		if d.SecurityContext != nil {
+			defaults.SecurityContext = d.SecurityContext
+		}
+		if d.AgentResources != nil {
+			defaults.AgentResources = d.AgentResources
+		}
+	}
+	return defaults
+}
+
+// executorImage returns the image of the executor for the workflow
+func (woc *wfOperationCtx) executorImage() string {
+	if v := woc.executorDefaults().Image; v != "" {
+		return v
+	}
+	return woc.controller.executorImage()
+}
+
+// executorImagePullPolicy returns the imagePullPolicy of the executor for the workflow
+func (woc *wfOperationCtx) executorImagePullPolicy() apiv1.PullPolicy {
+	if v := woc.executorDefaults().ImagePullPolicy; v != "" {
+		return v
+	}
+	return woc.controller.executorImagePullPolicy()
+}
+
+// executorResources returns the resources and security context of the executor containers of the template.
+// The precedence, highest first, is: the template's executor, the workflow's executor, the executor defaults that
+// select the workflow, the executor of the controller config.
+func (woc *wfOperationCtx) executorResources(tmpl *wfv1.Template) (apiv1.ResourceRequirements, *apiv1.SecurityContext) {
+	resources := woc.controller.Config.GetExecutor().Resources
+	securityContext := woc.controller.Config.GetExecutor().SecurityContext
+	defaults := woc.executorDefaults()
+	for _, e := range []*wfv1.ExecutorConfig{{Resources: defaults.Resources, SecurityContext: defaults.SecurityContext}, woc.execWf.Spec.Executor, tmpl.Executor} {
+		if e == nil {
+			continue
+		}
+		if e.Resources != nil {
+			resources = *e.Resources
+		}
+		if e.SecurityContext != nil {
+			securityContext = e.SecurityContext
+		}
+	}
+	return *resources.DeepCopy(), securityContext.DeepCopy()
+}
+
 func (woc *wfOperationCtx) newExecContainer(name string, tmpl *wfv1.Template) *apiv1.Container {
+	resources, securityContext := woc.executorResources(tmpl)
 	exec := apiv1.Container{
 		Name:            name,
-		Image:           woc.controller.executorImage(),
-		ImagePullPolicy: woc.controller.executorImagePullPolicy(),
+		Image:           woc.executorImage(),
+		ImagePullPolicy: woc.executorImagePullPolicy(),
 		Env:             woc.createEnvVars(),
-		Resources:       woc.controller.Config.GetExecutor().Resources,
-		SecurityContext: woc.controller.Config.GetExecutor().SecurityContext,
+		Resources:       resources,
+		SecurityContext: securityContext,
 		Args:            woc.controller.Config.GetExecutor().Args,
 	}
 	// lock down resource pods by default
@@ -732,13 +892,7 @@ func (woc *wfOperationCtx) newExecContainer(name string, tmpl *wfv1.Template) *a
 		exec.Args = append(exec.Args, "--kubeconfig="+path)
 	}
 
-	executorServiceAccountName := ""
-	if tmpl.Executor != nil && tmpl.Executor.ServiceAccountName != "" {
-		executorServiceAccountName = tmpl.Executor.ServiceAccountName
-	} else if woc.execWf.Spec.Executor != nil && woc.execWf.Spec.Executor.ServiceAccountName != "" {
-		executorServiceAccountName = woc.execWf.Spec.Executor.ServiceAccountName
-	}
-	if executorServiceAccountName != "" {
+	if executorServiceAccountName, _ := woc.executorServiceAccount(tmpl); executorServiceAccountName != "" {
 		exec.VolumeMounts = append(exec.VolumeMounts, apiv1.VolumeMount{
 			Name:      common.ServiceAccountTokenVolumeName,
 			MountPath: common.ServiceAccountTokenMountPath,
@@ -779,6 +933,16 @@ func (woc *wfOperationCtx) addDNSConfig(pod *apiv1.Pod) {
 	}
 }
 
+// addInformerLabels copies the workflow labels required by the informers' label selector onto the pod, so that the
+// pod informer watches it
+func (woc *wfOperationCtx) addInformerLabels(pod *apiv1.Pod) {
+	for _, r := range woc.controller.Config.Informers.LabelRequirements() {
+		if v, ok := woc.wf.Labels[r.Key()]; ok {
+			pod.Labels[r.Key()] = v
+		}
+	}
+}
+
 // addSchedulingConstraints applies any node selectors or affinity rules to the pod, either set in the workflow or the template
 func (woc *wfOperationCtx) addSchedulingConstraints(ctx context.Context, pod *apiv1.Pod, wfSpec *wfv1.WorkflowSpec, tmpl *wfv1.Template, nodeName string) {
 	// Get boundaryNode Template (if specified)
@@ -810,6 +974,14 @@ func (woc *wfOperationCtx) addSchedulingConstraints(ctx context.Context, pod *ap
 	} else if len(wfSpec.Tolerations) > 0 {
 		pod.Spec.Tolerations = wfSpec.Tolerations
 	}
+	// Set scheduling gates (if specified)
+	if len(tmpl.SchedulingGates) > 0 {
+		pod.Spec.SchedulingGates = tmpl.SchedulingGates
+	} else if boundaryTemplate != nil && len(boundaryTemplate.SchedulingGates) > 0 {
+		pod.Spec.SchedulingGates = boundaryTemplate.SchedulingGates
+	} else if len(wfSpec.SchedulingGates) > 0 {
+		pod.Spec.SchedulingGates = wfSpec.SchedulingGates
+	}
 
 	// Set scheduler name (if specified)
 	if tmpl.SchedulerName != "" {
@@ -836,6 +1008,104 @@ func (woc *wfOperationCtx) addSchedulingConstraints(ctx context.Context, pod *ap
 	}
 }
 
+// addPodDefaults adds the pod defaults of the controller config that select the workflow, beneath the settings of the
+// template and the workflow. Defaults later in the list take precedence over earlier ones.
+func (woc *wfOperationCtx) addPodDefaults(pod *apiv1.Pod) error {
+	var defaults config.PodDefaults
+	for _, d := range woc.controller.Config.PodDefaults {
+		selected, err := d.Selects(woc.wf.Namespace, woc.wf.Labels)
+		if err != nil {
+			return fmt.Errorf("invalid selector in pod defaults: %w", err)
+		}
+		if !selected {
+			continue
+		}
+		if d.PriorityClassName != "" {
+			defaults.PriorityClassName = d.PriorityClassName
+		}
+		if d.RuntimeClassName != nil {
+			defaults.RuntimeClassName = d.RuntimeClassName
+		}
+		if len(d.NodeSelector) > 0 {
+			if defaults.NodeSelector == nil {
+				defaults.NodeSelector = map[string]string{}
+			}
+			maps.Copy(defaults.NodeSelector, d.NodeSelector)
+		}
+		defaults.Tolerations = append(defaults.Tolerations, d.Tolerations...)
+	}
+	if pod.Spec.PriorityClassName == "" {
+		pod.Spec.PriorityClassName = defaults.PriorityClassName
+	}
+	if pod.Spec.RuntimeClassName == nil {
+		pod.Spec.RuntimeClassName = defaults.RuntimeClassName
+	}
+	addNodeSelectorBeneath(pod, defaults.NodeSelector)
+	addTolerations(pod, defaults.Tolerations)
+	return nil
+}
+
+// addGPU expands the GPU request of the template using its accelerator profile. The GPUs are set as the limit of the
+// main container's resource, and the node selector, tolerations and runtime class of the profile are added to the pod,
+// beneath those of the template and the workflow.
+func (woc *wfOperationCtx) addGPU(pod *apiv1.Pod, tmpl *wfv1.Template) error {
+	gpu := tmpl.GPU
+	profile, ok := woc.controller.Config.GetAcceleratorProfile(gpu.Type)
+	if !ok {
+		return fmt.Errorf("no accelerator profile for GPU type '%s'", gpu.Type)
+	}
+	resourceName := profile.GetResourceName()
+	count := *resource.NewQuantity(int64(gpu.Count), resource.DecimalSI)
+	mainCtrNames := tmpl.GetMainContainerNames()
+	for i, ctr := range pod.Spec.Containers {
+		if !slices.Contains(mainCtrNames, ctr.Name) {
+			continue
+		}
+		// the request of an extended resource must equal its limit, and defaults to it
+		ctr.Resources.Limits = maps.Clone(ctr.Resources.Limits)
+		if ctr.Resources.Limits == nil {
+			ctr.Resources.Limits = apiv1.ResourceList{}
+		}
+		ctr.Resources.Limits[resourceName] = count
+		if _, ok := ctr.Resources.Requests[resourceName]; ok {
+			ctr.Resources.Requests = maps.Clone(ctr.Resources.Requests)
+			ctr.Resources.Requests[resourceName] = count
+		}
+		pod.Spec.Containers[i] = ctr
+	}
+	if pod.Spec.RuntimeClassName == nil {
+		pod.Spec.RuntimeClassName = profile.RuntimeClassName
+	}
+	addNodeSelectorBeneath(pod, profile.NodeSelector)
+	addTolerations(pod, profile.Tolerations)
+	return nil
+}
+
+// addNodeSelectorBeneath adds the node selector labels to the pod, the labels of the pod take precedence
+func addNodeSelectorBeneath(pod *apiv1.Pod, nodeSelector map[string]string) {
+	if len(nodeSelector) == 0 {
+		return
+	}
+	// the node selector of the pod may be the template's, so it is not modified
+	merged := maps.Clone(nodeSelector)
+	maps.Copy(merged, pod.Spec.NodeSelector)
+	pod.Spec.NodeSelector = merged
+}
+
+// addTolerations adds the tolerations to the pod, unless the pod already has them
+func addTolerations(pod *apiv1.Pod, tolerations []apiv1.Toleration) {
+	if len(tolerations) == 0 {
+		return
+	}
+	merged := slices.Clone(pod.Spec.Tolerations)
+	for _, t := range tolerations {
+		if !slices.ContainsFunc(merged, func(x apiv1.Toleration) bool { return x.MatchToleration(&t) }) {
+			merged = append(merged, t)
+		}
+	}
+	pod.Spec.Tolerations = merged
+}
+
 // GetBoundaryTemplate get a template through the nodeName
 func (woc *wfOperationCtx) GetBoundaryTemplate(ctx context.Context, nodeName string) (*wfv1.Template, error) {
 	node, err := woc.wf.GetNodeByName(nodeName)
@@ -1087,16 +1357,42 @@ func addOutputArtifactsVolumes(ctx context.Context, pod *apiv1.Pod, tmpl *wfv1.T
 	pod.Spec.Containers[waitCtrIndex] = *waitCtr
 }
 
-// addArchiveLocation conditionally updates the template with the default artifact repository
-// information configured in the controller, for the purposes of archiving outputs. This is skipped
-// for templates which do not need to archive anything, or have explicitly set an archive location
-// in the template.
-func (woc *wfOperationCtx) addArchiveLocation(ctx context.Context, tmpl *wfv1.Template) {
+// templateArtifactRepository returns the artifact repository the template's artifacts and logs are archived to.
+// If the template has an artifactRepositoryRef, it is resolved once and recorded on the node, so that
+// later reconciliations and artifact GC use the same repository.
+func (woc *wfOperationCtx) templateArtifactRepository(ctx context.Context, nodeName string, tmpl *wfv1.Template) (*wfv1.ArtifactRepository, error) {
+	if tmpl.ArtifactRepositoryRef == nil {
+		return woc.artifactRepository, nil
+	}
+	node, err := woc.wf.GetNodeByName(nodeName)
+	if err != nil {
+		return nil, err
+	}
+	if node.ArtifactRepositoryRef == nil {
+		ref, err := woc.controller.artifactRepositories.Resolve(ctx, tmpl.ArtifactRepositoryRef, woc.wf.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve artifact repository for template %q: %w", tmpl.Name, err)
+		}
+		node.ArtifactRepositoryRef = ref
+		woc.wf.Status.Nodes.Set(ctx, node.ID, *node)
+		woc.updated = true
+	}
+	repo, err := woc.controller.artifactRepositories.Get(ctx, node.ArtifactRepositoryRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get artifact repository %s: %w", node.ArtifactRepositoryRef, err)
+	}
+	return repo, nil
+}
+
+// addArchiveLocation conditionally updates the template with the given artifact repository
+// information, for the purposes of archiving outputs. This is skipped for templates which do not
+// need to archive anything, or have explicitly set an archive location in the template.
+func (woc *wfOperationCtx) addArchiveLocation(ctx context.Context, tmpl *wfv1.Template, repo *wfv1.ArtifactRepository) {
 	if tmpl.ArchiveLocation.HasLocation() {
 		// User explicitly set the location. nothing else to do.
 		return
 	}
-	archiveLogs := woc.IsArchiveLogs(tmpl)
+	archiveLogs := woc.IsArchiveLogs(tmpl, repo)
 	needLocation := archiveLogs
 	for _, art := range append(tmpl.Inputs.Artifacts, tmpl.Outputs.Artifacts...) {
 		if !art.HasLocation() {
@@ -1107,14 +1403,14 @@ func (woc *wfOperationCtx) addArchiveLocation(ctx context.Context, tmpl *wfv1.Te
 	if !needLocation {
 		return
 	}
-	tmpl.ArchiveLocation = woc.artifactRepository.ToArtifactLocation()
+	tmpl.ArchiveLocation = repo.ToArtifactLocation()
 	tmpl.ArchiveLocation.ArchiveLogs = &archiveLogs
 }
 
 // IsArchiveLogs determines if container should archive logs
-// priorities: controller(on) > template > workflow > controller(off)
-func (woc *wfOperationCtx) IsArchiveLogs(tmpl *wfv1.Template) bool {
-	archiveLogs := woc.artifactRepository.IsArchiveLogs()
+// priorities: repository(on) > template > workflow > repository(off)
+func (woc *wfOperationCtx) IsArchiveLogs(tmpl *wfv1.Template, repo *wfv1.ArtifactRepository) bool {
+	archiveLogs := repo.IsArchiveLogs()
 	if !archiveLogs {
 		if woc.execWf.Spec.ArchiveLogs != nil {
 			archiveLogs = *woc.execWf.Spec.ArchiveLogs
@@ -1144,24 +1440,14 @@ func (woc *wfOperationCtx) setupServiceAccount(ctx context.Context, pod *apiv1.P
 		pod.Spec.AutomountServiceAccountToken = automountServiceAccountToken
 	}
 
-	executorServiceAccountName := ""
-	if tmpl.Executor != nil && tmpl.Executor.ServiceAccountName != "" {
-		executorServiceAccountName = tmpl.Executor.ServiceAccountName
-	} else if woc.execWf.Spec.Executor != nil && woc.execWf.Spec.Executor.ServiceAccountName != "" {
-		executorServiceAccountName = woc.execWf.Spec.Executor.ServiceAccountName
-	}
-	if executorServiceAccountName != "" {
-		tokenName, err := woc.getServiceAccountTokenName(ctx, executorServiceAccountName)
+	if executorServiceAccountName, token := woc.executorServiceAccount(tmpl); executorServiceAccountName != "" {
+		source, err := woc.getExecutorTokenVolumeSource(ctx, pod.Name, executorServiceAccountName, token)
 		if err != nil {
 			return err
 		}
 		pod.Spec.Volumes = append(pod.Spec.Volumes, apiv1.Volume{
-			Name: common.ServiceAccountTokenVolumeName,
-			VolumeSource: apiv1.VolumeSource{
-				Secret: &apiv1.SecretVolumeSource{
-					SecretName: tokenName,
-				},
-			},
+			Name:         common.ServiceAccountTokenVolumeName,
+			VolumeSource: source,
 		})
 	} else if automountServiceAccountToken != nil && !*automountServiceAccountToken {
 		return errors.Errorf(errors.CodeBadRequest, "executor.serviceAccountName must not be empty if automountServiceAccountToken is false")
@@ -1169,6 +1455,45 @@ func (woc *wfOperationCtx) setupServiceAccount(ctx context.Context, pod *apiv1.P
 	return nil
 }
 
+// addScratchVolume adds the scratch of the template as a generic ephemeral volume, mounted in the main containers. The
+// claim of the volume is created by Kubernetes with the pod, and deleted with it.
+func (woc *wfOperationCtx) addScratchVolume(pod *apiv1.Pod, tmpl *wfv1.Template) {
+	scratch := tmpl.Scratch
+	spec := apiv1.PersistentVolumeClaimSpec{
+		AccessModes: []apiv1.PersistentVolumeAccessMode{apiv1.ReadWriteOnce},
+		Resources: apiv1.VolumeResourceRequirements{
+			Requests: apiv1.ResourceList{apiv1.ResourceStorage: scratch.StorageSize},
+		},
+	}
+	if scratch.StorageClass != "" {
+		spec.StorageClassName = ptr.To(scratch.StorageClass)
+	}
+	pod.Spec.Volumes = append(pod.Spec.Volumes, apiv1.Volume{
+		Name: common.ScratchVolumeName,
+		VolumeSource: apiv1.VolumeSource{
+			Ephemeral: &apiv1.EphemeralVolumeSource{
+				VolumeClaimTemplate: &apiv1.PersistentVolumeClaimTemplate{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{common.LabelKeyWorkflow: woc.wf.Name},
+					},
+					Spec: spec,
+				},
+			},
+		},
+	})
+	volMount := apiv1.VolumeMount{
+		Name:      common.ScratchVolumeName,
+		MountPath: scratch.GetMountPath(),
+	}
+	mainCtrNames := tmpl.GetMainContainerNames()
+	for i, ctr := range pod.Spec.Containers {
+		if slices.Contains(mainCtrNames, ctr.Name) {
+			ctr.VolumeMounts = append(ctr.VolumeMounts, volMount)
+			pod.Spec.Containers[i] = ctr
+		}
+	}
+}
+
 // addScriptStagingVolume sets up a shared staging volume between the init container
 // and main container for the purpose of holding the script source code for script templates
 func addScriptStagingVolume(pod *apiv1.Pod) {
@@ -6,15 +6,21 @@ import (
 	"fmt"
 	"path"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-workflows/v3/config"
@@ -22,6 +28,7 @@ import (
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/test/util"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/secrets"
 	armocks "github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories/mocks"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	wfutil "github.com/argoproj/argo-workflows/v3/workflow/util"
@@ -327,6 +334,86 @@ func TestTmplLevelExecutorServiceAccountName(t *testing.T) {
 	verifyServiceAccountTokenVolumeMount(t, waitCtr, "exec-sa-token", "/var/run/secrets/kubernetes.io/serviceaccount")
 }
 
+// TestNamespaceExecutorServiceAccount verifies the executor service account mapping of the namespace of the workflow.
+func TestNamespaceExecutorServiceAccount(t *testing.T) {
+	t.Run("Secret", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		woc := newWoc(ctx)
+		_, err := util.CreateServiceAccountWithToken(ctx, woc.controller.kubeclientset, "", "foo")
+		require.NoError(t, err)
+		woc.controller.Config.ExecutorServiceAccounts = []config.ExecutorServiceAccount{{ServiceAccountName: "foo"}}
+
+		pod := executeContainerPod(ctx, t, woc)
+		assert.Equal(t, "exec-sa-token", pod.Spec.Volumes[2].Name)
+		assert.Equal(t, secrets.TokenName("foo"), pod.Spec.Volumes[2].Secret.SecretName)
+		verifyServiceAccountTokenVolumeMount(t, pod.Spec.Containers[0], "exec-sa-token", "/var/run/secrets/kubernetes.io/serviceaccount")
+	})
+	t.Run("Token", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		woc := newWoc(ctx)
+		woc.controller.Config.ExecutorServiceAccounts = []config.ExecutorServiceAccount{
+			{Namespaces: []string{"other-ns"}, ServiceAccountName: "other"},
+			{Namespaces: []string{woc.wf.Namespace}, ServiceAccountName: "foo", Token: &config.ExecutorServiceAccountToken{Audiences: []string{"my-aud"}}},
+		}
+		var request *authenticationv1.TokenRequest
+		woc.controller.kubeclientset.(*fake.Clientset).PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			request = action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenRequest)
+			return true, &authenticationv1.TokenRequest{Status: authenticationv1.TokenRequestStatus{Token: "my-token"}}, nil
+		})
+
+		pod := executeContainerPod(ctx, t, woc)
+		require.NotNil(t, request)
+		assert.Equal(t, []string{"my-aud"}, request.Spec.Audiences)
+		assert.Equal(t, int64(86400), *request.Spec.ExpirationSeconds)
+		assert.Equal(t, pod.Name+"-executor-token", request.Spec.BoundObjectRef.Name)
+		volume := pod.Spec.Volumes[2]
+		assert.Equal(t, "exec-sa-token", volume.Name)
+		require.NotNil(t, volume.Projected)
+		assert.Equal(t, pod.Name+"-executor-token", volume.Projected.Sources[0].Secret.Name)
+		verifyServiceAccountTokenVolumeMount(t, pod.Spec.Containers[0], "exec-sa-token", "/var/run/secrets/kubernetes.io/serviceaccount")
+
+		secret, err := woc.controller.kubeclientset.CoreV1().Secrets(woc.wf.Namespace).Get(ctx, pod.Name+"-executor-token", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "my-token", string(secret.Data["token"]))
+		assert.Equal(t, woc.wf.Name, secret.OwnerReferences[0].Name)
+	})
+	t.Run("WorkflowExecutor", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		woc := newWoc(ctx)
+		_, err := util.CreateServiceAccountWithToken(ctx, woc.controller.kubeclientset, "", "foo")
+		require.NoError(t, err)
+		woc.controller.Config.ExecutorServiceAccounts = []config.ExecutorServiceAccount{{ServiceAccountName: "other", Token: &config.ExecutorServiceAccountToken{}}}
+		woc.execWf.Spec.Executor = &wfv1.ExecutorConfig{ServiceAccountName: "foo"}
+
+		pod := executeContainerPod(ctx, t, woc)
+		assert.Equal(t, secrets.TokenName("foo"), pod.Spec.Volumes[2].Secret.SecretName)
+	})
+}
+
+func TestInformerLabels(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	woc := newWoc(ctx)
+	woc.wf.Labels = map[string]string{"team": "data", "other": "label"}
+	woc.controller.Config.Informers = &config.InformersConfig{LabelSelector: "team=data,!archived"}
+
+	pod := executeContainerPod(ctx, t, woc)
+	assert.Equal(t, "data", pod.Labels["team"])
+	assert.NotContains(t, pod.Labels, "other")
+	assert.NotContains(t, pod.Labels, "archived")
+}
+
+func executeContainerPod(ctx context.Context, t *testing.T, woc *wfOperationCtx) apiv1.Pod {
+	t.Helper()
+	tmplCtx, err := woc.createTemplateContext(ctx, wfv1.ResourceScopeLocal, "")
+	require.NoError(t, err)
+	_, err = woc.executeContainer(ctx, woc.execWf.Spec.Entrypoint, tmplCtx.GetTemplateScope(), &woc.execWf.Spec.Templates[0], &wfv1.WorkflowStep{}, &executeTemplateOpts{})
+	require.NoError(t, err)
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	return pods.Items[0]
+}
+
 // TestCtrlLevelExecutorSecurityContext verifies the ability to carry forward Controller level SecurityContext to Podspec.
 func TestCtrlLevelExecutorSecurityContext(t *testing.T) {
 	var user int64 = 1000
@@ -437,6 +524,200 @@ func TestTolerations(t *testing.T) {
 	assert.Equal(t, "nvidia.com/gpu", pod.Spec.Tolerations[0].Key)
 }
 
+// TestSchedulingGates verifies the scheduling gates of the template, or else of the workflow, are set on the pod.
+func TestSchedulingGates(t *testing.T) {
+	for name, tt := range map[string]struct {
+		wf, tmpl []apiv1.PodSchedulingGate
+		want     string
+	}{
+		"Workflow": {wf: []apiv1.PodSchedulingGate{{Name: "example.com/queue"}}, want: "example.com/queue"},
+		"Template": {wf: []apiv1.PodSchedulingGate{{Name: "example.com/queue"}}, tmpl: []apiv1.PodSchedulingGate{{Name: "example.com/gpu-queue"}}, want: "example.com/gpu-queue"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx := logging.TestContext(t.Context())
+			woc := newWoc(ctx)
+			woc.execWf.Spec.SchedulingGates = tt.wf
+			woc.execWf.Spec.Templates[0].SchedulingGates = tt.tmpl
+			tmplCtx, err := woc.createTemplateContext(ctx, wfv1.ResourceScopeLocal, "")
+			require.NoError(t, err)
+
+			_, err = woc.executeContainer(ctx, woc.execWf.Spec.Entrypoint, tmplCtx.GetTemplateScope(), &woc.execWf.Spec.Templates[0], &wfv1.WorkflowStep{}, &executeTemplateOpts{})
+			require.NoError(t, err)
+			pods, err := listPods(ctx, woc)
+			require.NoError(t, err)
+			require.Len(t, pods.Items, 1)
+			require.Len(t, pods.Items[0].Spec.SchedulingGates, 1)
+			assert.Equal(t, tt.want, pods.Items[0].Spec.SchedulingGates[0].Name)
+		})
+	}
+}
+
+func TestPodDefaults(t *testing.T) {
+	gpu := apiv1.Toleration{Key: "gpu", Operator: apiv1.TolerationOpExists, Effect: apiv1.TaintEffectNoSchedule}
+	spot := apiv1.Toleration{Key: "spot", Operator: apiv1.TolerationOpExists, Effect: apiv1.TaintEffectNoSchedule}
+	createPod := func(t *testing.T, podDefaults []config.PodDefaults, tmpl func(*wfv1.Template)) apiv1.PodSpec {
+		t.Helper()
+		ctx := logging.TestContext(t.Context())
+		woc := newWoc(ctx)
+		woc.wf.Labels = map[string]string{"team": "data"}
+		woc.controller.Config.PodDefaults = podDefaults
+		if tmpl != nil {
+			tmpl(&woc.execWf.Spec.Templates[0])
+		}
+		tmplCtx, err := woc.createTemplateContext(ctx, wfv1.ResourceScopeLocal, "")
+		require.NoError(t, err)
+		_, err = woc.executeContainer(ctx, woc.execWf.Spec.Entrypoint, tmplCtx.GetTemplateScope(), &woc.execWf.Spec.Templates[0], &wfv1.WorkflowStep{}, &executeTemplateOpts{})
+		require.NoError(t, err)
+		pods, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		require.Len(t, pods.Items, 1)
+		return pods.Items[0].Spec
+	}
+	t.Run("Selected", func(t *testing.T) {
+		spec := createPod(t, []config.PodDefaults{
+			{PriorityClassName: "low", NodeSelector: map[string]string{"pool": "shared"}},
+			{
+				Selector:          &metav1.LabelSelector{MatchLabels: map[string]string{"team": "data"}},
+				PriorityClassName: "batch",
+				RuntimeClassName:  ptr.To("gvisor"),
+				NodeSelector:      map[string]string{"pool": "data"},
+				Tolerations:       []apiv1.Toleration{spot},
+			},
+			{Namespaces: []string{"other"}, PriorityClassName: "high"},
+		}, nil)
+		assert.Equal(t, "batch", spec.PriorityClassName, "later defaults take precedence")
+		assert.Equal(t, ptr.To("gvisor"), spec.RuntimeClassName)
+		assert.Equal(t, map[string]string{"pool": "data"}, spec.NodeSelector)
+		assert.Equal(t, []apiv1.Toleration{spot}, spec.Tolerations)
+	})
+	t.Run("BeneathTemplate", func(t *testing.T) {
+		spec := createPod(t, []config.PodDefaults{{
+			PriorityClassName: "batch",
+			NodeSelector:      map[string]string{"pool": "data", "zone": "a"},
+			Tolerations:       []apiv1.Toleration{spot, gpu},
+		}}, func(tmpl *wfv1.Template) {
+			tmpl.PriorityClassName = "high"
+			tmpl.NodeSelector = map[string]string{"pool": "gpu"}
+			tmpl.Tolerations = []apiv1.Toleration{gpu}
+		})
+		assert.Equal(t, "high", spec.PriorityClassName)
+		assert.Equal(t, map[string]string{"pool": "gpu", "zone": "a"}, spec.NodeSelector)
+		assert.Equal(t, []apiv1.Toleration{gpu, spot}, spec.Tolerations)
+	})
+	t.Run("NotSelected", func(t *testing.T) {
+		spec := createPod(t, []config.PodDefaults{{Namespaces: []string{"other"}, PriorityClassName: "batch"}}, nil)
+		assert.Empty(t, spec.PriorityClassName)
+	})
+}
+
+func TestGPU(t *testing.T) {
+	nvidia := apiv1.Toleration{Key: "nvidia.com/gpu", Operator: apiv1.TolerationOpExists, Effect: apiv1.TaintEffectNoSchedule}
+	tpu := apiv1.Toleration{Key: "google.com/tpu", Operator: apiv1.TolerationOpExists, Effect: apiv1.TaintEffectNoSchedule}
+	createPod := func(t *testing.T, profiles map[string]config.AcceleratorProfile, tmpl func(*wfv1.Template)) (apiv1.PodSpec, error) {
+		t.Helper()
+		ctx := logging.TestContext(t.Context())
+		woc := newWoc(ctx)
+		woc.controller.Config.AcceleratorProfiles = profiles
+		tmpl(&woc.execWf.Spec.Templates[0])
+		tmplCtx, err := woc.createTemplateContext(ctx, wfv1.ResourceScopeLocal, "")
+		require.NoError(t, err)
+		_, err = woc.executeContainer(ctx, woc.execWf.Spec.Entrypoint, tmplCtx.GetTemplateScope(), &woc.execWf.Spec.Templates[0], &wfv1.WorkflowStep{}, &executeTemplateOpts{})
+		if err != nil {
+			return apiv1.PodSpec{}, err
+		}
+		pods, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		require.Len(t, pods.Items, 1)
+		return pods.Items[0].Spec, nil
+	}
+	mainCtr := func(spec apiv1.PodSpec) apiv1.Container {
+		for _, c := range spec.Containers {
+			if c.Name == common.MainContainerName {
+				return c
+			}
+		}
+		return apiv1.Container{}
+	}
+	t.Run("Default", func(t *testing.T) {
+		spec, err := createPod(t, nil, func(tmpl *wfv1.Template) {
+			tmpl.GPU = &wfv1.GPURequest{Count: 2}
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "2", ptr.To(mainCtr(spec).Resources.Limits["nvidia.com/gpu"]).String())
+		assert.Equal(t, []apiv1.Toleration{nvidia}, spec.Tolerations)
+		assert.Empty(t, spec.NodeSelector)
+	})
+	t.Run("Profile", func(t *testing.T) {
+		spec, err := createPod(t, map[string]config.AcceleratorProfile{
+			"tpu": {
+				ResourceName:     "google.com/tpu",
+				NodeSelector:     map[string]string{"cloud.google.com/gke-tpu-accelerator": "tpu-v5-lite-podslice", "pool": "tpu"},
+				Tolerations:      []apiv1.Toleration{tpu},
+				RuntimeClassName: ptr.To("tpu"),
+			},
+		}, func(tmpl *wfv1.Template) {
+			tmpl.GPU = &wfv1.GPURequest{Count: 4, Type: "tpu"}
+			tmpl.NodeSelector = map[string]string{"pool": "reserved"}
+			tmpl.Container.Resources = apiv1.ResourceRequirements{
+				Requests: apiv1.ResourceList{apiv1.ResourceCPU: resource.MustParse("1"), "google.com/tpu": resource.MustParse("1")},
+			}
+		})
+		require.NoError(t, err)
+		resources := mainCtr(spec).Resources
+		assert.Equal(t, "4", ptr.To(resources.Limits["google.com/tpu"]).String())
+		assert.Equal(t, "4", ptr.To(resources.Requests["google.com/tpu"]).String(), "the request must equal the limit")
+		assert.Equal(t, "1", resources.Requests.Cpu().String())
+		assert.Equal(t, map[string]string{"cloud.google.com/gke-tpu-accelerator": "tpu-v5-lite-podslice", "pool": "reserved"}, spec.NodeSelector, "the template's labels take precedence")
+		assert.Equal(t, []apiv1.Toleration{tpu}, spec.Tolerations)
+		assert.Equal(t, ptr.To("tpu"), spec.RuntimeClassName)
+	})
+	t.Run("UnknownType", func(t *testing.T) {
+		_, err := createPod(t, nil, func(tmpl *wfv1.Template) {
+			tmpl.GPU = &wfv1.GPURequest{Count: 1, Type: "h100"}
+		})
+		require.EqualError(t, err, "no accelerator profile for GPU type 'h100'")
+	})
+}
+
+func TestScratchVolume(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	woc := newWoc(ctx)
+	woc.execWf.Spec.Templates[0].Scratch = &wfv1.ScratchVolume{StorageSize: resource.MustParse("50Gi"), StorageClass: "fast"}
+	tmplCtx, err := woc.createTemplateContext(ctx, wfv1.ResourceScopeLocal, "")
+	require.NoError(t, err)
+
+	_, err = woc.executeContainer(ctx, woc.execWf.Spec.Entrypoint, tmplCtx.GetTemplateScope(), &woc.execWf.Spec.Templates[0], &wfv1.WorkflowStep{}, &executeTemplateOpts{})
+	require.NoError(t, err)
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	pod := pods.Items[0]
+
+	var scratch *apiv1.Volume
+	for _, v := range pod.Spec.Volumes {
+		if v.Name == common.ScratchVolumeName {
+			scratch = &v
+		}
+	}
+	require.NotNil(t, scratch)
+	require.NotNil(t, scratch.Ephemeral, "a generic ephemeral volume, deleted with the pod")
+	claim := scratch.Ephemeral.VolumeClaimTemplate
+	assert.Equal(t, woc.wf.Name, claim.Labels[common.LabelKeyWorkflow])
+	assert.Equal(t, "fast", *claim.Spec.StorageClassName)
+	assert.Equal(t, "50Gi", claim.Spec.Resources.Requests.Storage().String())
+	mountPath := map[string]string{
+		common.MainContainerName: "/scratch",
+		// the wait container mirrors the mounts of the main container to save artifacts
+		common.WaitContainerName: filepath.Join(common.ExecutorMainFilesystemDir, "/scratch"),
+	}
+	for _, c := range pod.Spec.Containers {
+		mounted := slices.ContainsFunc(c.VolumeMounts, func(m apiv1.VolumeMount) bool {
+			return m.Name == common.ScratchVolumeName && m.MountPath == mountPath[c.Name]
+		})
+		assert.True(t, mounted, "mounted in %s", c.Name)
+	}
+}
+
 // TestMetadata verifies ability to carry forward annotations and labels
 func TestMetadata(t *testing.T) {
 	ctx := logging.TestContext(t.Context())
@@ -554,6 +835,59 @@ func TestConditionalArchiveLocation(t *testing.T) {
 	assert.Nil(t, tmpl.ArchiveLocation)
 }
 
+var templateArtifactRepositoryRefWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: template-artifact-repository-ref
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    artifactRepositoryRef:
+      configMap: my-artifact-repository
+      key: sensitive
+    container:
+      image: busybox
+    outputs:
+      artifacts:
+      - name: report
+        path: /tmp/report
+`
+
+// TestTemplateArtifactRepositoryRef verifies a template's artifactRepositoryRef is resolved, recorded on the node and
+// used for its archive location
+func TestTemplateArtifactRepositoryRef(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(templateArtifactRepositoryRefWf)
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+	sensitiveRef := &wfv1.ArtifactRepositoryRefStatus{
+		Namespace:             "argo",
+		ArtifactRepositoryRef: wfv1.ArtifactRepositoryRef{ConfigMap: "my-artifact-repository", Key: "sensitive"},
+	}
+	repos := &armocks.Interface{}
+	repos.On("Resolve", mock.Anything, (*wfv1.ArtifactRepositoryRef)(nil), mock.Anything).Return(&wfv1.ArtifactRepositoryRefStatus{}, nil)
+	repos.On("Resolve", mock.Anything, &sensitiveRef.ArtifactRepositoryRef, mock.Anything).Return(sensitiveRef, nil)
+	repos.On("Get", mock.Anything, &wfv1.ArtifactRepositoryRefStatus{}).Return(&wfv1.ArtifactRepository{S3: &wfv1.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Bucket: "default"}}}, nil)
+	repos.On("Get", mock.Anything, sensitiveRef).Return(&wfv1.ArtifactRepository{S3: &wfv1.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Bucket: "sensitive"}}}, nil)
+	controller.artifactRepositories = repos
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+
+	node := woc.wf.Status.Nodes.FindByDisplayName("template-artifact-repository-ref")
+	require.NotNil(t, node)
+	assert.Equal(t, sensitiveRef, node.ArtifactRepositoryRef)
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	tmpl, err := getPodTemplate(&pods.Items[0])
+	require.NoError(t, err)
+	require.NotNil(t, tmpl.ArchiveLocation)
+	assert.Equal(t, "sensitive", tmpl.ArchiveLocation.S3.Bucket)
+}
+
 // TestConditionalAddArchiveLocationTemplateArchiveLogs verifies we do  add archive location if it is needed for logs
 func TestConditionalAddArchiveLocationTemplateArchiveLogs(t *testing.T) {
 	tests := []struct {
@@ -1625,6 +1959,41 @@ func TestExecutorContainerCustomization(t *testing.T) {
 	assert.Equal(t, "536870912", waitCtr.Resources.Limits.Memory().AsDec().String())
 }
 
+func TestExecutorDefaults(t *testing.T) {
+	small := apiv1.ResourceRequirements{Limits: apiv1.ResourceList{apiv1.ResourceMemory: resource.MustParse("64Mi")}}
+	createPod := func(t *testing.T, tmpl *wfv1.Template) *apiv1.Pod {
+		t.Helper()
+		ctx := logging.TestContext(t.Context())
+		woc := newWoc(ctx)
+		woc.controller.Config.Executor = &apiv1.Container{
+			Resources: apiv1.ResourceRequirements{Limits: apiv1.ResourceList{apiv1.ResourceMemory: resource.MustParse("512Mi")}},
+		}
+		woc.controller.Config.ExecutorDefaults = []config.ExecutorDefaults{
+			{Namespaces: []string{woc.wf.Namespace}, Image: "registry.example.com/argoexec:latest", Resources: &small},
+			{Namespaces: []string{"other"}, Image: "other"},
+			{SecurityContext: &apiv1.SecurityContext{RunAsNonRoot: ptr.To(true)}},
+		}
+		pod, err := woc.createWorkflowPod(ctx, "", nil, tmpl, &createWorkflowPodOpts{})
+		require.NoError(t, err)
+		return pod
+	}
+	t.Run("Selected", func(t *testing.T) {
+		pod := createPod(t, &wfv1.Template{})
+		for _, c := range []apiv1.Container{pod.Spec.InitContainers[0], pod.Spec.Containers[0]} {
+			assert.Equal(t, "registry.example.com/argoexec:latest", c.Image, c.Name)
+			assert.Equal(t, "64Mi", c.Resources.Limits.Memory().String(), c.Name)
+			assert.Equal(t, ptr.To(true), c.SecurityContext.RunAsNonRoot, c.Name)
+		}
+	})
+	t.Run("Template", func(t *testing.T) {
+		large := apiv1.ResourceRequirements{Limits: apiv1.ResourceList{apiv1.ResourceMemory: resource.MustParse("1Gi")}}
+		pod := createPod(t, &wfv1.Template{Executor: &wfv1.ExecutorConfig{Resources: &large}})
+		waitCtr := pod.Spec.Containers[0]
+		assert.Equal(t, "1Gi", waitCtr.Resources.Limits.Memory().String(), "the template takes precedence")
+		assert.Equal(t, ptr.To(true), waitCtr.SecurityContext.RunAsNonRoot)
+	})
+}
+
 var helloWindowsWf = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -1982,6 +2351,10 @@ func TestProgressEnvVars(t *testing.T) {
 			Name:  common.EnvVarProgressFile,
 			Value: common.ArgoProgressPath,
 		})
+		assert.Contains(t, pod.Spec.Containers[0].Env, apiv1.EnvVar{
+			Name:  common.EnvVarIntermediateParametersFile,
+			Value: common.ArgoIntermediateParametersPath,
+		})
 		assert.Contains(t, pod.Spec.Containers[0].Env, apiv1.EnvVar{
 			Name:  common.EnvVarProgressPatchTickDuration,
 			Value: "1m0s",
@@ -171,7 +171,7 @@ func (woc *cronWfOperationCtx) persistUpdate(ctx context.Context) {
 }
 
 func (woc *cronWfOperationCtx) persistCurrentWorkflowStatus(ctx context.Context) {
-	woc.patch(ctx, map[string]interface{}{"status": map[string]interface{}{"active": woc.cronWf.Status.Active, "succeeded": woc.cronWf.Status.Succeeded, "failed": woc.cronWf.Status.Failed, "phase": woc.cronWf.Status.Phase}})
+	woc.patch(ctx, map[string]interface{}{"status": map[string]interface{}{"active": woc.cronWf.Status.Active, "succeeded": woc.cronWf.Status.Succeeded, "failed": woc.cronWf.Status.Failed, "phase": woc.cronWf.Status.Phase, "lastSuccessfulTime": woc.cronWf.Status.LastSuccessfulTime, "consecutiveFailures": woc.cronWf.Status.ConsecutiveFailures}})
 }
 
 func (woc *cronWfOperationCtx) patch(ctx context.Context, patch map[string]interface{}) {
@@ -288,7 +288,9 @@ func (woc *cronWfOperationCtx) enforceRuntimePolicy(ctx context.Context) (bool,
 func (woc *cronWfOperationCtx) terminateOutstandingWorkflows(ctx context.Context) error {
 	for _, wfObjectRef := range woc.cronWf.Status.Active {
 		woc.log.WithField("name", wfObjectRef.Name).Info(ctx, "stopping")
-		err := util.TerminateWorkflow(ctx, woc.wfClient, wfObjectRef.Name)
+		err := util.TerminateWorkflow(ctx, woc.wfClient, wfObjectRef.Name, util.ShutdownOptions{
+			Reason: fmt.Sprintf("replaced by a newer run of cron workflow %s", woc.cronWf.Name),
+		})
 		if err != nil {
 			if errors.IsNotFound(err) {
 				woc.log.WithField("name", wfObjectRef.Name).Warn(ctx, "workflow not found when trying to terminate outstanding workflows")
@@ -355,8 +357,9 @@ func (woc *cronWfOperationCtx) shouldOutstandingWorkflowsBeRun(ctx context.Conte
 }
 
 type fulfilledWfsPhase struct {
-	fulfilled bool
-	phase     v1alpha1.WorkflowPhase
+	fulfilled  bool
+	phase      v1alpha1.WorkflowPhase
+	finishedAt v1.Time
 }
 
 func (woc *cronWfOperationCtx) reconcileActiveWfs(ctx context.Context, workflows []v1alpha1.Workflow) error {
@@ -364,8 +367,9 @@ func (woc *cronWfOperationCtx) reconcileActiveWfs(ctx context.Context, workflows
 	currentWfsFulfilled := make(map[types.UID]fulfilledWfsPhase, len(workflows))
 	for _, wf := range workflows {
 		currentWfsFulfilled[wf.UID] = fulfilledWfsPhase{
-			fulfilled: wf.Status.Fulfilled(),
-			phase:     wf.Status.Phase,
+			fulfilled:  wf.Status.Fulfilled(),
+			phase:      wf.Status.Phase,
+			finishedAt: wf.Status.FinishedAt,
 		}
 		if !woc.cronWf.Status.HasActiveUID(wf.UID) && !wf.Status.Fulfilled() {
 			updated = true
@@ -378,7 +382,7 @@ func (woc *cronWfOperationCtx) reconcileActiveWfs(ctx context.Context, workflows
 			updated = true
 			woc.removeFromActiveList(objectRef.UID)
 			if found && fulfilled.fulfilled {
-				woc.updateWfPhaseCounter(fulfilled.phase)
+				woc.updateWfPhaseCounter(fulfilled.phase, fulfilled.finishedAt)
 				completed, err := woc.checkStopingCondition()
 				if err != nil {
 					return fmt.Errorf("failed to check CronWorkflow '%s' stopping condition: %s", woc.cronWf.Name, err)
@@ -479,17 +483,26 @@ func (woc *cronWfOperationCtx) reportCronWorkflowError(ctx context.Context, cond
 	} else {
 		if conditionType == v1alpha1.ConditionTypeSubmissionError {
 			woc.cronWf.Status.Failed++
+			woc.cronWf.Status.ConsecutiveFailures++
 		}
 		woc.metrics.CronWorkflowSubmissionError(ctx)
 	}
 }
 
-func (woc *cronWfOperationCtx) updateWfPhaseCounter(phase v1alpha1.WorkflowPhase) {
+func (woc *cronWfOperationCtx) updateWfPhaseCounter(phase v1alpha1.WorkflowPhase, finishedAt v1.Time) {
 	switch phase {
 	case v1alpha1.WorkflowError, v1alpha1.WorkflowFailed:
 		woc.cronWf.Status.Failed++
+		woc.cronWf.Status.ConsecutiveFailures++
 	case v1alpha1.WorkflowSucceeded:
 		woc.cronWf.Status.Succeeded++
+		woc.cronWf.Status.ConsecutiveFailures = 0
+		if finishedAt.IsZero() {
+			finishedAt = v1.Now()
+		}
+		if last := woc.cronWf.Status.LastSuccessfulTime; last == nil || finishedAt.After(last.Time) {
+			woc.cronWf.Status.LastSuccessfulTime = finishedAt.DeepCopy()
+		}
 	}
 }
 
@@ -819,3 +819,27 @@ func TestEvaluateWhenUnresolvedOutside(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, result)
 }
+
+func TestUpdateWfPhaseCounter(t *testing.T) {
+	woc := &cronWfOperationCtx{cronWf: &v1alpha1.CronWorkflow{}}
+	finishedAt := v1.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	woc.updateWfPhaseCounter(v1alpha1.WorkflowFailed, finishedAt)
+	woc.updateWfPhaseCounter(v1alpha1.WorkflowError, finishedAt)
+	assert.Equal(t, int64(2), woc.cronWf.Status.Failed)
+	assert.Equal(t, int64(2), woc.cronWf.Status.ConsecutiveFailures)
+	assert.Nil(t, woc.cronWf.Status.LastSuccessfulTime)
+
+	woc.updateWfPhaseCounter(v1alpha1.WorkflowSucceeded, finishedAt)
+	assert.Equal(t, int64(1), woc.cronWf.Status.Succeeded)
+	assert.Equal(t, int64(0), woc.cronWf.Status.ConsecutiveFailures)
+	require.NotNil(t, woc.cronWf.Status.LastSuccessfulTime)
+	assert.Equal(t, finishedAt.Time, woc.cronWf.Status.LastSuccessfulTime.Time)
+
+	// an older workflow succeeding later does not move the last successful time back
+	woc.updateWfPhaseCounter(v1alpha1.WorkflowSucceeded, v1.NewTime(finishedAt.Add(-time.Hour)))
+	assert.Equal(t, finishedAt.Time, woc.cronWf.Status.LastSuccessfulTime.Time)
+
+	woc.updateWfPhaseCounter(v1alpha1.WorkflowFailed, finishedAt)
+	assert.Equal(t, int64(1), woc.cronWf.Status.ConsecutiveFailures)
+}
@@ -44,11 +44,31 @@ type AgentExecutor struct {
 	Namespace         string
 	consideredTasks   *sync.Map
 	plugins           []executorplugins.TemplateExecutor
+	parameterSources  []executorplugins.ParameterSource
+	parameters        parameterCache
+	// oauth2TokenSources are the token sources of the OAuth2 clients of HTTP templates, by oauth2Client
+	oauth2TokenSources sync.Map
 }
 
 type templateExecutor = func(ctx context.Context, tmpl wfv1.Template, result *wfv1.NodeResult) (time.Duration, error)
 
-func NewAgentExecutor(clientSet kubernetes.Interface, restClient rest.Interface, config *rest.Config, namespace, workflowName, workflowUID string, plugins []executorplugins.TemplateExecutor) *AgentExecutor {
+// healthChecker is implemented by plugins whose health can be checked before they are called
+type healthChecker interface {
+	HealthCheck(ctx context.Context) error
+}
+
+// pluginError is an error calling the plugins, rather than a plugin failing the node, so it errors the node
+type pluginError struct{ err error }
+
+func (e *pluginError) Error() string {
+	return fmt.Sprintf("plugin error: %v", e.err)
+}
+
+func (e *pluginError) Unwrap() error {
+	return e.err
+}
+
+func NewAgentExecutor(clientSet kubernetes.Interface, restClient rest.Interface, config *rest.Config, namespace, workflowName, workflowUID string, plugins []executorplugins.TemplateExecutor, parameterSources []executorplugins.ParameterSource) *AgentExecutor {
 	return &AgentExecutor{
 		ClientSet:         clientSet,
 		RESTClient:        restClient,
@@ -58,6 +78,7 @@ func NewAgentExecutor(clientSet kubernetes.Interface, restClient rest.Interface,
 		WorkflowInterface: workflow.NewForConfigOrDie(config),
 		consideredTasks:   &sync.Map{},
 		plugins:           plugins,
+		parameterSources:  parameterSources,
 	}
 }
 
@@ -236,10 +257,17 @@ func (ae *AgentExecutor) processTask(ctx context.Context, tmpl wfv1.Template) (*
 	default:
 		return nil, 0, fmt.Errorf("agent cannot execute: unknown task type: %v", tmpl.GetType())
 	}
+	tmpl, err := ae.substituteParameters(ctx, tmpl)
+	if err != nil {
+		return nil, 0, err
+	}
 	result := &wfv1.NodeResult{}
 	requeue, err := executeTemplate(ctx, tmpl, result)
 	if err != nil {
 		result.Phase = wfv1.NodeFailed
+		if _, ok := err.(*pluginError); ok {
+			result.Phase = wfv1.NodeError
+		}
 		result.Message = err.Error()
 	}
 	return result, requeue, nil
@@ -354,6 +382,14 @@ func (ae *AgentExecutor) executeHTTPTemplateRequest(ctx context.Context, httpTem
 		}
 	}
 
+	body := []byte(httpTemplate.Body)
+	if httpTemplate.BodyFrom != nil {
+		body = httpTemplate.BodyFrom.Bytes
+	}
+	if err := ae.authenticateHTTPRequest(ctx, request, httpTemplate.Auth, body); err != nil {
+		return nil, err
+	}
+
 	response, err := httpClients[httpTemplate.InsecureSkipVerify].Do(request)
 	if err != nil {
 		return nil, err
@@ -362,20 +398,31 @@ func (ae *AgentExecutor) executeHTTPTemplateRequest(ctx context.Context, httpTem
 }
 
 func (ae *AgentExecutor) executePluginTemplate(ctx context.Context, tmpl wfv1.Template, result *wfv1.NodeResult) (time.Duration, error) {
+	// a plugin that hangs fails the call after the template's timeout, rather than stalling the node
+	if tmpl.Timeout != "" {
+		timeout, err := time.ParseDuration(tmpl.Timeout)
+		if err != nil {
+			return 0, fmt.Errorf("invalid timeout format: %w", err)
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
 	args := executorplugins.ExecuteTemplateArgs{
-		Workflow: &executorplugins.Workflow{
-			ObjectMeta: executorplugins.ObjectMeta{
-				Name:      ae.WorkflowName,
-				Namespace: ae.Namespace,
-				UID:       ae.workflowUID,
-			},
-		},
+		Workflow: ae.pluginWorkflow(),
 		Template: &tmpl,
 	}
 	reply := &executorplugins.ExecuteTemplateReply{}
+	var unhealthy []string
 	for _, plug := range ae.plugins {
+		if checker, ok := plug.(healthChecker); ok {
+			if err := checker.HealthCheck(ctx); err != nil {
+				unhealthy = append(unhealthy, err.Error())
+				continue
+			}
+		}
 		if err := plug.ExecuteTemplate(ctx, args, reply); err != nil {
-			return 0, err
+			return 0, &pluginError{err}
 		} else if reply.Node != nil {
 			*result = *reply.Node
 			if reply.Node.Phase == wfv1.NodeSucceeded {
@@ -384,9 +431,23 @@ func (ae *AgentExecutor) executePluginTemplate(ctx context.Context, tmpl wfv1.Te
 			return reply.GetRequeue(), nil
 		}
 	}
+	if len(unhealthy) > 0 {
+		return 0, &pluginError{fmt.Errorf("no healthy plugin executed the template: %s", strings.Join(unhealthy, "; "))}
+	}
 	return 0, fmt.Errorf("no plugin executed the template")
 }
 
+// pluginWorkflow returns the workflow as sent to plugins
+func (ae *AgentExecutor) pluginWorkflow() *executorplugins.Workflow {
+	return &executorplugins.Workflow{
+		ObjectMeta: executorplugins.ObjectMeta{
+			Name:      ae.WorkflowName,
+			Namespace: ae.Namespace,
+			UID:       ae.workflowUID,
+		},
+	}
+}
+
 func IsWorkflowCompleted(wts *wfv1.WorkflowTaskSet) bool {
 	return wts.Labels[common.LabelKeyCompleted] == "true"
 }
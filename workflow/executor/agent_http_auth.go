@@ -0,0 +1,122 @@
+package executor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"golang.org/x/oauth2"
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util"
+	artifacthttp "github.com/argoproj/argo-workflows/v3/workflow/artifacts/http"
+)
+
+// oauth2Client identifies an OAuth2 client, whose tokens are reused by all the requests that use it
+type oauth2Client struct {
+	tokenURL       string
+	clientID       string
+	clientSecret   string
+	scopes         string
+	endpointParams string
+}
+
+// authenticateHTTPRequest authenticates the request of an HTTP template, whose body is given to sign it
+func (ae *AgentExecutor) authenticateHTTPRequest(ctx context.Context, request *http.Request, auth *wfv1.HTTPTemplateAuth, body []byte) error {
+	switch {
+	case auth == nil:
+		return nil
+	case auth.OAuth2 != nil:
+		token, err := ae.oauth2Token(ctx, auth.OAuth2)
+		if err != nil {
+			return err
+		}
+		token.SetAuthHeader(request)
+	case auth.AWSSigV4 != nil:
+		credentials, err := ae.awsCredentials(ctx, auth.AWSSigV4)
+		if err != nil {
+			return err
+		}
+		payloadHash := sha256.Sum256(body)
+		if err := v4.NewSigner().SignHTTP(ctx, credentials, request, hex.EncodeToString(payloadHash[:]), auth.AWSSigV4.Service, auth.AWSSigV4.Region, time.Now()); err != nil {
+			return fmt.Errorf("failed to sign request: %w", err)
+		}
+	}
+	return nil
+}
+
+func (ae *AgentExecutor) getSecret(ctx context.Context, selector *apiv1.SecretKeySelector) (string, error) {
+	secret, err := util.GetSecrets(ctx, ae.ClientSet, ae.Namespace, selector.Name, selector.Key)
+	if err != nil {
+		return "", err
+	}
+	return string(secret), nil
+}
+
+// oauth2Token returns a token of the client, reusing the client's last token until it expires
+func (ae *AgentExecutor) oauth2Token(ctx context.Context, auth *wfv1.OAuth2Auth) (*oauth2.Token, error) {
+	clientID, err := ae.getSecret(ctx, auth.ClientIDSecret)
+	if err != nil {
+		return nil, err
+	}
+	clientSecret, err := ae.getSecret(ctx, auth.ClientSecretSecret)
+	if err != nil {
+		return nil, err
+	}
+	tokenURL, err := ae.getSecret(ctx, auth.TokenURLSecret)
+	if err != nil {
+		return nil, err
+	}
+	client := oauth2Client{
+		tokenURL:       tokenURL,
+		clientID:       clientID,
+		clientSecret:   clientSecret,
+		scopes:         strings.Join(auth.Scopes, " "),
+		endpointParams: fmt.Sprint(auth.EndpointParams),
+	}
+	source, ok := ae.oauth2TokenSources.Load(client)
+	if !ok {
+		// tokens are acquired with the agent's context, rather than that of the request that needed the first one
+		conf := artifacthttp.CreateOauth2Config(clientID, clientSecret, tokenURL, auth.Scopes, auth.EndpointParams)
+		source, _ = ae.oauth2TokenSources.LoadOrStore(client, conf.TokenSource(context.WithoutCancel(ctx)))
+	}
+	token, err := source.(oauth2.TokenSource).Token()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OAuth2 token: %w", err)
+	}
+	return token, nil
+}
+
+// awsCredentials returns the credentials from the secrets, or else the agent's default credentials
+func (ae *AgentExecutor) awsCredentials(ctx context.Context, auth *wfv1.AWSSigV4Auth) (aws.Credentials, error) {
+	if auth.AccessKeySecret == nil || auth.SecretKeySecret == nil {
+		cfg, err := config.LoadDefaultConfig(ctx, config.WithRegion(auth.Region))
+		if err != nil {
+			return aws.Credentials{}, err
+		}
+		return cfg.Credentials.Retrieve(ctx)
+	}
+	accessKey, err := ae.getSecret(ctx, auth.AccessKeySecret)
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+	secretKey, err := ae.getSecret(ctx, auth.SecretKeySecret)
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+	credentials := aws.Credentials{AccessKeyID: accessKey, SecretAccessKey: secretKey}
+	if auth.SessionTokenSecret != nil {
+		if credentials.SessionToken, err = ae.getSecret(ctx, auth.SessionTokenSecret); err != nil {
+			return aws.Credentials{}, err
+		}
+	}
+	return credentials, nil
+}
@@ -0,0 +1,111 @@
+package executor
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func secretKey(key string) *apiv1.SecretKeySelector {
+	return &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "my-secret"}, Key: key}
+}
+
+func TestHTTPTemplateOAuth2(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	var tokenRequests atomic.Int32
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests.Add(1)
+		if user, password, _ := r.BasicAuth(); user != "my-client" || password != "my-client-secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"access_token": "my-token", "token_type": "bearer", "expires_in": 3600}`)
+	}))
+	defer tokenServer.Close()
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer my-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer apiServer.Close()
+
+	ae := &AgentExecutor{
+		ClientSet: fake.NewSimpleClientset(&apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: "my-ns"},
+			Data: map[string][]byte{
+				"client-id":     []byte("my-client"),
+				"client-secret": []byte("my-client-secret"),
+				"token-url":     []byte(tokenServer.URL),
+			},
+		}),
+		Namespace:       "my-ns",
+		consideredTasks: &sync.Map{},
+	}
+	tmpl := wfv1.Template{HTTP: &wfv1.HTTP{
+		URL: apiServer.URL,
+		Auth: &wfv1.HTTPTemplateAuth{OAuth2: &wfv1.OAuth2Auth{
+			ClientIDSecret:     secretKey("client-id"),
+			ClientSecretSecret: secretKey("client-secret"),
+			TokenURLSecret:     secretKey("token-url"),
+		}},
+	}}
+	for range 2 {
+		result, _, err := ae.processTask(ctx, tmpl)
+		require.NoError(t, err)
+		assert.Equal(t, wfv1.NodeSucceeded, result.Phase, result.Message)
+	}
+	// the token is reused until it expires
+	assert.Equal(t, int32(1), tokenRequests.Load())
+}
+
+func TestHTTPTemplateAWSSigV4(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	var authorization, securityToken string
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		securityToken = r.Header.Get("X-Amz-Security-Token")
+	}))
+	defer apiServer.Close()
+
+	ae := &AgentExecutor{
+		ClientSet: fake.NewSimpleClientset(&apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: "my-ns"},
+			Data: map[string][]byte{
+				"access-key":    []byte("AKIDEXAMPLE"),
+				"secret-key":    []byte("my-secret-key"),
+				"session-token": []byte("my-session-token"),
+			},
+		}),
+		Namespace:       "my-ns",
+		consideredTasks: &sync.Map{},
+	}
+	result, _, err := ae.processTask(ctx, wfv1.Template{HTTP: &wfv1.HTTP{
+		Method: "POST",
+		URL:    apiServer.URL,
+		Body:   `{"hello": "world"}`,
+		Auth: &wfv1.HTTPTemplateAuth{AWSSigV4: &wfv1.AWSSigV4Auth{
+			Region:             "us-east-1",
+			Service:            "execute-api",
+			AccessKeySecret:    secretKey("access-key"),
+			SecretKeySecret:    secretKey("secret-key"),
+			SessionTokenSecret: secretKey("session-token"),
+		}},
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodeSucceeded, result.Phase, result.Message)
+	assert.Regexp(t, `^AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/\d{8}/us-east-1/execute-api/aws4_request, SignedHeaders=\S*host\S*, Signature=[0-9a-f]{64}$`, authorization)
+	assert.Equal(t, "my-session-token", securityToken)
+}
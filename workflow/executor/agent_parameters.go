@@ -0,0 +1,170 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	executorplugins "github.com/argoproj/argo-workflows/v3/pkg/plugins/executor"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/template"
+)
+
+// resolvedParameter is a value got from a parameter source plugin
+type resolvedParameter struct {
+	value   string
+	leaseID string
+	expires time.Time // zero if the value does not expire
+}
+
+func (p resolvedParameter) expired() bool {
+	return !p.expires.IsZero() && !time.Now().Before(p.expires)
+}
+
+// parameterCache caches the values got from parameter source plugins by the configuration of the plugin, until their
+// lease expires
+type parameterCache struct {
+	mu     sync.Mutex
+	values map[string]resolvedParameter
+}
+
+func (c *parameterCache) get(key string) (resolvedParameter, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.values[key]
+	if !ok || v.expired() {
+		return resolvedParameter{}, false
+	}
+	return v, true
+}
+
+func (c *parameterCache) put(key string, v resolvedParameter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.values == nil {
+		c.values = map[string]resolvedParameter{}
+	}
+	c.values[key] = v
+}
+
+// renew renews the lease of the value, returning false if the value is no longer of the lease
+func (c *parameterCache) renew(key, leaseID string, lease executorplugins.Lease) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.values[key]
+	if !ok || v.leaseID != leaseID {
+		return false
+	}
+	v.leaseID = lease.ID
+	v.expires = time.Now().Add(lease.GetDuration())
+	c.values[key] = v
+	return true
+}
+
+func (c *parameterCache) delete(key, leaseID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if v, ok := c.values[key]; ok && v.leaseID == leaseID {
+		delete(c.values, key)
+	}
+}
+
+// substituteParameters returns the template with the input parameters from parameter source plugins substituted
+func (ae *AgentExecutor) substituteParameters(ctx context.Context, tmpl wfv1.Template) (wfv1.Template, error) {
+	replaceMap := map[string]string{}
+	for _, param := range tmpl.Inputs.Parameters {
+		if !param.IsFromPlugin() {
+			continue
+		}
+		value, err := ae.resolveParameter(ctx, param)
+		if err != nil {
+			return tmpl, err
+		}
+		replaceMap["inputs.parameters."+param.Name] = value
+	}
+	if len(replaceMap) == 0 {
+		return tmpl, nil
+	}
+	data, err := json.Marshal(tmpl)
+	if err != nil {
+		return tmpl, err
+	}
+	s, err := template.Replace(ctx, string(data), replaceMap, true)
+	if err != nil {
+		return tmpl, err
+	}
+	var newTmpl wfv1.Template
+	if err := json.Unmarshal([]byte(s), &newTmpl); err != nil {
+		return tmpl, err
+	}
+	// plugins executing the template get the values from the inputs
+	for i, param := range newTmpl.Inputs.Parameters {
+		if value, ok := replaceMap["inputs.parameters."+param.Name]; ok {
+			newTmpl.Inputs.Parameters[i].Value = wfv1.AnyStringPtr(value)
+		}
+	}
+	return newTmpl, nil
+}
+
+func (ae *AgentExecutor) resolveParameter(ctx context.Context, param wfv1.Parameter) (string, error) {
+	key := string(param.ValueFrom.Plugin.Value)
+	if v, ok := ae.parameters.get(key); ok {
+		return v.value, nil
+	}
+	args := executorplugins.ResolveParameterArgs{Workflow: ae.pluginWorkflow(), Parameter: &param}
+	for _, source := range ae.parameterSources {
+		reply := &executorplugins.ResolveParameterReply{}
+		if err := source.ResolveParameter(ctx, args, reply); err != nil {
+			return "", fmt.Errorf("failed to resolve inputs.parameters.%s: %w", param.Name, err)
+		} else if reply.Value == nil {
+			continue
+		}
+		v := resolvedParameter{value: *reply.Value}
+		if lease := reply.Lease; lease.GetDuration() > 0 {
+			v.leaseID = lease.ID
+			v.expires = time.Now().Add(lease.GetDuration())
+			if lease.Renewable {
+				go ae.renewLease(ctx, source, key, *lease)
+			}
+		}
+		ae.parameters.put(key, v)
+		return v.value, nil
+	}
+	if param.ValueFrom.Default != nil {
+		return param.ValueFrom.Default.String(), nil
+	}
+	return "", fmt.Errorf("no plugin resolved inputs.parameters.%s", param.Name)
+}
+
+// renewLease renews the lease of a cached value when two thirds of its duration has passed, until it is no longer
+// renewable, or the value is no longer cached. A value whose lease fails to renew is removed from the cache, so that it
+// is resolved again the next time it is needed.
+func (ae *AgentExecutor) renewLease(ctx context.Context, source executorplugins.ParameterSource, key string, lease executorplugins.Lease) {
+	ctx, logger := logging.RequireLoggerFromContext(ctx).WithField("leaseID", lease.ID).InContext(ctx)
+	for lease.Renewable && lease.GetDuration() > 0 {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(lease.GetDuration() * 2 / 3):
+		}
+		id := lease.ID
+		reply := &executorplugins.RenewLeaseReply{}
+		if err := source.RenewLease(ctx, executorplugins.RenewLeaseArgs{Workflow: ae.pluginWorkflow(), Lease: &lease}, reply); err != nil {
+			logger.WithError(err).Warn(ctx, "Failed to renew lease")
+			ae.parameters.delete(key, id)
+			return
+		} else if reply.Lease == nil {
+			logger.Warn(ctx, "No plugin renewed the lease")
+			ae.parameters.delete(key, id)
+			return
+		}
+		lease = *reply.Lease
+		if !ae.parameters.renew(key, id, lease) {
+			return
+		}
+		logger.WithField("duration", lease.Duration).Debug(ctx, "Renewed lease")
+	}
+}
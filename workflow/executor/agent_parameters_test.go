@@ -0,0 +1,125 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	executorplugins "github.com/argoproj/argo-workflows/v3/pkg/plugins/executor"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+type fakeParameterSource struct {
+	mu       sync.Mutex
+	value    *string
+	lease    *executorplugins.Lease
+	resolved int
+	renewed  int
+}
+
+func (f *fakeParameterSource) ResolveParameter(_ context.Context, _ executorplugins.ResolveParameterArgs, reply *executorplugins.ResolveParameterReply) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.resolved++
+	reply.Value = f.value
+	reply.Lease = f.lease
+	return nil
+}
+
+func (f *fakeParameterSource) RenewLease(_ context.Context, args executorplugins.RenewLeaseArgs, reply *executorplugins.RenewLeaseReply) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.renewed++
+	reply.Lease = args.Lease
+	return nil
+}
+
+func (f *fakeParameterSource) counts() (int, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.resolved, f.renewed
+}
+
+func pluginParameterTemplate() v1alpha1.Template {
+	return v1alpha1.Template{
+		Inputs: v1alpha1.Inputs{
+			Parameters: []v1alpha1.Parameter{{
+				Name: "token",
+				ValueFrom: &v1alpha1.ValueFrom{
+					Plugin: &v1alpha1.Plugin{Object: v1alpha1.Object{Value: json.RawMessage(`{"vault":{"path":"secret/data/token"}}`)}},
+				},
+			}},
+		},
+		HTTP: &v1alpha1.HTTP{
+			URL:     "https://example.com",
+			Headers: v1alpha1.HTTPHeaders{{Name: "Authorization", Value: "Bearer {{inputs.parameters.token}}"}},
+		},
+	}
+}
+
+func TestAgentSubstituteParameters(t *testing.T) {
+	t.Run("Resolved", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		source := &fakeParameterSource{value: ptr.To("my-token")}
+		ae := &AgentExecutor{parameterSources: []executorplugins.ParameterSource{&fakeParameterSource{}, source}}
+		tmpl, err := ae.substituteParameters(ctx, pluginParameterTemplate())
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer my-token", tmpl.HTTP.Headers[0].Value)
+		assert.Equal(t, "my-token", tmpl.Inputs.Parameters[0].Value.String())
+		_, err = ae.substituteParameters(ctx, pluginParameterTemplate())
+		require.NoError(t, err)
+		resolved, _ := source.counts()
+		assert.Equal(t, 1, resolved, "the value is cached")
+	})
+	t.Run("Default", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		ae := &AgentExecutor{parameterSources: []executorplugins.ParameterSource{&fakeParameterSource{}}}
+		in := pluginParameterTemplate()
+		in.Inputs.Parameters[0].ValueFrom.Default = v1alpha1.AnyStringPtr("anonymous")
+		tmpl, err := ae.substituteParameters(ctx, in)
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer anonymous", tmpl.HTTP.Headers[0].Value)
+	})
+	t.Run("NotResolved", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		ae := &AgentExecutor{parameterSources: []executorplugins.ParameterSource{&fakeParameterSource{}}}
+		_, err := ae.substituteParameters(ctx, pluginParameterTemplate())
+		require.EqualError(t, err, "no plugin resolved inputs.parameters.token")
+	})
+	t.Run("LeaseExpired", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		source := &fakeParameterSource{value: ptr.To("my-token"), lease: &executorplugins.Lease{ID: "my-lease", Duration: metav1.Duration{Duration: time.Millisecond}}}
+		ae := &AgentExecutor{parameterSources: []executorplugins.ParameterSource{source}}
+		_, err := ae.substituteParameters(ctx, pluginParameterTemplate())
+		require.NoError(t, err)
+		time.Sleep(5 * time.Millisecond)
+		_, err = ae.substituteParameters(ctx, pluginParameterTemplate())
+		require.NoError(t, err)
+		resolved, renewed := source.counts()
+		assert.Equal(t, 2, resolved)
+		assert.Zero(t, renewed)
+	})
+	t.Run("LeaseRenewed", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		source := &fakeParameterSource{value: ptr.To("my-token"), lease: &executorplugins.Lease{ID: "my-lease", Duration: metav1.Duration{Duration: 30 * time.Millisecond}, Renewable: true}}
+		ae := &AgentExecutor{parameterSources: []executorplugins.ParameterSource{source}}
+		_, err := ae.substituteParameters(ctx, pluginParameterTemplate())
+		require.NoError(t, err)
+		assert.Eventually(t, func() bool {
+			_, renewed := source.counts()
+			return renewed >= 3
+		}, time.Second, 5*time.Millisecond)
+		_, err = ae.substituteParameters(ctx, pluginParameterTemplate())
+		require.NoError(t, err)
+		resolved, _ := source.counts()
+		assert.Equal(t, 1, resolved, "the renewed value is still cached")
+	})
+}
@@ -3,6 +3,7 @@ package executor
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"sync"
 	"testing"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -98,3 +100,73 @@ func (a alwaysSucceededPlugin) ExecuteTemplate(_ context.Context, _ executorplug
 	reply.Requeue = &metav1.Duration{Duration: a.requeue}
 	return nil
 }
+
+func TestAgentPluginErrors(t *testing.T) {
+	tmpl := v1alpha1.Template{
+		Timeout: "10ms",
+		Plugin: &v1alpha1.Plugin{
+			Object: v1alpha1.Object{Value: json.RawMessage(`{"key": "value"}`)},
+		},
+	}
+	tests := []struct {
+		name          string
+		plugin        executorplugins.TemplateExecutor
+		expectPhase   v1alpha1.NodePhase
+		expectMessage string
+	}{
+		{
+			name:          "plugin failed the node",
+			plugin:        &failedPlugin{},
+			expectPhase:   v1alpha1.NodeFailed,
+			expectMessage: "failed by plugin",
+		},
+		{
+			name:          "plugin could not be called",
+			plugin:        &hungPlugin{},
+			expectPhase:   v1alpha1.NodeError,
+			expectMessage: "plugin error: context deadline exceeded",
+		},
+		{
+			name:          "plugin is unhealthy",
+			plugin:        &unhealthyPlugin{},
+			expectPhase:   v1alpha1.NodeError,
+			expectMessage: "plugin error: no healthy plugin executed the template: connection refused",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := logging.TestContext(t.Context())
+			ae := &AgentExecutor{
+				consideredTasks: &sync.Map{},
+				plugins:         []executorplugins.TemplateExecutor{tc.plugin},
+			}
+			result, _, err := ae.processTask(ctx, tmpl)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectPhase, result.Phase)
+			assert.Equal(t, tc.expectMessage, result.Message)
+		})
+	}
+}
+
+type failedPlugin struct{}
+
+func (failedPlugin) ExecuteTemplate(_ context.Context, _ executorplugins.ExecuteTemplateArgs, reply *executorplugins.ExecuteTemplateReply) error {
+	reply.Node = &v1alpha1.NodeResult{
+		Phase:   v1alpha1.NodeFailed,
+		Message: "failed by plugin",
+	}
+	return nil
+}
+
+type hungPlugin struct{}
+
+func (hungPlugin) ExecuteTemplate(ctx context.Context, _ executorplugins.ExecuteTemplateArgs, _ *executorplugins.ExecuteTemplateReply) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+type unhealthyPlugin struct{ failedPlugin }
+
+func (unhealthyPlugin) HealthCheck(context.Context) error {
+	return errors.New("connection refused")
+}
@@ -33,6 +33,7 @@ In the main container, the emissary creates these files:
 * `/var/run/argo/ctr/${containerName}/exitcode` The container exit code.
 * `/var/run/argo/ctr/${containerName}/combined` A copy of stdout+stderr (if needed).
 * `/var/run/argo/ctr/${containerName}/stdout`  A copy of stdout (if needed).
+* `/var/run/argo/ctr/${containerName}/usage` The peak CPU and memory usage of the container, as JSON.
 
 If the container is named `main` it also copies base-layer artifacts to the shared volume:
 
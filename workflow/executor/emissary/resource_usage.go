@@ -0,0 +1,173 @@
+package emissary
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// ResourceUsageSampleInterval is how often the emissary samples the resource usage of its container
+const ResourceUsageSampleInterval = 5 * time.Second
+
+// CgroupRoot is where the cgroup of the container is mounted
+const CgroupRoot = "/sys/fs/cgroup"
+
+// ResourceUsageSampler samples the CPU and memory usage of the cgroup of a container to find its peak usage. Both
+// cgroup v2 and v1 are supported. Where neither is available, e.g. on Windows, there is no usage.
+//
+// The CPU is the most cores used between two samples, so bursts shorter than the interval are averaged out. The memory
+// is the largest working set, i.e. usage less inactive page cache, as the kubelet accounts it when evicting pods.
+type ResourceUsageSampler struct {
+	root       string
+	lock       sync.Mutex
+	lastCPU    time.Duration
+	lastAt     time.Time
+	peakCPU    int64 // millicores
+	peakMemory int64 // bytes
+}
+
+func NewResourceUsageSampler(root string) *ResourceUsageSampler {
+	s := &ResourceUsageSampler{root: root, lastAt: time.Now()}
+	s.lastCPU, _ = s.cpuUsage()
+	return s
+}
+
+// Run samples the usage every interval until the context is done
+func (s *ResourceUsageSampler) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.Sample()
+		}
+	}
+}
+
+// Sample records the CPU used since the previous sample and the memory in use now
+func (s *ResourceUsageSampler) Sample() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	now := time.Now()
+	if cpu, ok := s.cpuUsage(); ok {
+		if elapsed := now.Sub(s.lastAt); elapsed > 0 {
+			s.peakCPU = max(s.peakCPU, int64(cpu-s.lastCPU)*1000/int64(elapsed))
+		}
+		s.lastCPU, s.lastAt = cpu, now
+	}
+	if memory, ok := s.workingSet(); ok {
+		s.peakMemory = max(s.peakMemory, memory)
+	}
+}
+
+// PeakUsage takes a final sample and returns the peak usage, empty if it could not be sampled
+func (s *ResourceUsageSampler) PeakUsage() apiv1.ResourceList {
+	s.Sample()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	usage := apiv1.ResourceList{}
+	if s.peakCPU > 0 {
+		usage[apiv1.ResourceCPU] = *resource.NewMilliQuantity(s.peakCPU, resource.DecimalSI)
+	}
+	if s.peakMemory > 0 {
+		usage[apiv1.ResourceMemory] = *resource.NewQuantity(s.peakMemory, resource.BinarySI)
+	}
+	return usage
+}
+
+// cpuUsage returns the CPU time used by the cgroup since it was created
+func (s *ResourceUsageSampler) cpuUsage() (time.Duration, bool) {
+	// cgroup v2
+	if stat, err := readStat(filepath.Join(s.root, "cpu.stat")); err == nil {
+		if usec, ok := stat["usage_usec"]; ok {
+			return time.Duration(usec) * time.Microsecond, true
+		}
+	}
+	// cgroup v1
+	if nsec, err := readInt(filepath.Join(s.root, "cpuacct", "cpuacct.usage")); err == nil {
+		return time.Duration(nsec), true
+	}
+	return 0, false
+}
+
+// workingSet returns the memory used by the cgroup less its inactive page cache, which can be reclaimed
+func (s *ResourceUsageSampler) workingSet() (int64, bool) {
+	// cgroup v2
+	if usage, err := readInt(filepath.Join(s.root, "memory.current")); err == nil {
+		stat, _ := readStat(filepath.Join(s.root, "memory.stat"))
+		return max(0, usage-stat["inactive_file"]), true
+	}
+	// cgroup v1
+	if usage, err := readInt(filepath.Join(s.root, "memory", "memory.usage_in_bytes")); err == nil {
+		stat, _ := readStat(filepath.Join(s.root, "memory", "memory.stat"))
+		return max(0, usage-stat["total_inactive_file"]), true
+	}
+	return 0, false
+}
+
+func readInt(path string) (int64, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+}
+
+// readStat reads a flat keyed cgroup file, e.g. cpu.stat, of lines of "key value"
+func readStat(path string) (map[string]int64, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	stat := map[string]int64{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), " ")
+		if !ok {
+			continue
+		}
+		if v, err := strconv.ParseInt(value, 10, 64); err == nil {
+			stat[key] = v
+		}
+	}
+	return stat, scanner.Err()
+}
+
+func resourceUsagePath(containerName string) string {
+	return filepath.Join(common.VarRunArgoPath, "ctr", containerName, "usage")
+}
+
+// WriteResourceUsage writes the peak resource usage of the container for the wait container to report
+func WriteResourceUsage(containerName string, usage apiv1.ResourceList) error {
+	data, err := json.Marshal(usage)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(resourceUsagePath(containerName), data, 0o644) //nolint:gosec
+}
+
+func (e emissary) GetPeakResourceUsage(containerName string) (apiv1.ResourceList, error) {
+	data, err := os.ReadFile(filepath.Clean(resourceUsagePath(containerName)))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	usage := apiv1.ResourceList{}
+	return usage, json.Unmarshal(data, &usage)
+}
@@ -0,0 +1,56 @@
+package emissary
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+func writeCgroupFile(t *testing.T, path, data string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+}
+
+func TestResourceUsageSampler(t *testing.T) {
+	t.Run("CgroupV2", func(t *testing.T) {
+		root := t.TempDir()
+		writeCgroupFile(t, filepath.Join(root, "cpu.stat"), "usage_usec 0\nuser_usec 0\n")
+		writeCgroupFile(t, filepath.Join(root, "memory.current"), "300\n")
+		writeCgroupFile(t, filepath.Join(root, "memory.stat"), "anon 100\ninactive_file 100\n")
+		s := NewResourceUsageSampler(root)
+		s.Sample()
+		writeCgroupFile(t, filepath.Join(root, "memory.current"), "150\n")
+		s.lastAt = time.Now().Add(-time.Second)
+		writeCgroupFile(t, filepath.Join(root, "cpu.stat"), "usage_usec 500000\n")
+
+		usage := s.PeakUsage()
+
+		cpu := usage[apiv1.ResourceCPU]
+		assert.InDelta(t, 500, cpu.MilliValue(), 10, "half a core for a second")
+		assert.Equal(t, "200", usage.Memory().String(), "the largest working set")
+	})
+	t.Run("CgroupV1", func(t *testing.T) {
+		root := t.TempDir()
+		writeCgroupFile(t, filepath.Join(root, "cpuacct", "cpuacct.usage"), "0\n")
+		writeCgroupFile(t, filepath.Join(root, "memory", "memory.usage_in_bytes"), "1000\n")
+		writeCgroupFile(t, filepath.Join(root, "memory", "memory.stat"), "total_inactive_file 400\n")
+		s := NewResourceUsageSampler(root)
+		s.lastAt = time.Now().Add(-time.Second)
+		writeCgroupFile(t, filepath.Join(root, "cpuacct", "cpuacct.usage"), "2000000000\n")
+
+		usage := s.PeakUsage()
+
+		cpu := usage[apiv1.ResourceCPU]
+		assert.InDelta(t, 2000, cpu.MilliValue(), 50, "two cores for a second")
+		assert.Equal(t, "600", usage.Memory().String())
+	})
+	t.Run("NoCgroup", func(t *testing.T) {
+		assert.Empty(t, NewResourceUsageSampler(t.TempDir()).PeakUsage())
+	})
+}
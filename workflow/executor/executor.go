@@ -6,6 +6,8 @@ import (
 	"bufio"
 	"compress/gzip"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -40,9 +42,11 @@ import (
 	"github.com/argoproj/argo-workflows/v3/util/archive"
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
 	"github.com/argoproj/argo-workflows/v3/util/retry"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
 	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
 	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
 	artifactcommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	artifactmetrics "github.com/argoproj/argo-workflows/v3/workflow/artifacts/metrics"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	executorretry "github.com/argoproj/argo-workflows/v3/workflow/executor/retry"
 )
@@ -67,6 +71,8 @@ type WorkflowExecutor struct {
 	RESTClient          rest.Interface
 	Namespace           string
 	RuntimeExecutor     ContainerRuntimeExecutor
+	// Metrics of the artifacts transferred, nil if metrics are disabled
+	Metrics *telemetry.Metrics
 
 	// memoized configmaps
 	memoizedConfigMaps map[string]string
@@ -107,6 +113,9 @@ type ContainerRuntimeExecutor interface {
 
 	// Kill a list of containers first with a SIGTERM then with a SIGKILL after a grace period
 	Kill(ctx context.Context, containerNames []string, terminationGracePeriodDuration time.Duration) error
+
+	// GetPeakResourceUsage returns the peak CPU and memory usage of a completed container, nil if it is not known
+	GetPeakResourceUsage(containerName string) (apiv1.ResourceList, error)
 }
 
 // NewExecutor instantiates a new workflow executor
@@ -190,14 +199,6 @@ func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
-		driverArt, err := we.newDriverArt(&art)
-		if err != nil {
-			return fmt.Errorf("failed to load artifact '%s': %w", art.Name, err)
-		}
-		artDriver, err := we.InitDriver(ctx, driverArt)
-		if err != nil {
-			return err
-		}
 		// Determine the file path of where to load the artifact
 		var artPath string
 		mnt := common.FindOverlappingVolume(&we.Template, art.Path)
@@ -213,64 +214,117 @@ func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
 			artPath = path.Join(common.ExecutorMainFilesystemDir, art.Path)
 		}
 
-		// The artifact is downloaded to a temporary location, after which we determine if
-		// the file is a tarball or not. If it is, it is first extracted then renamed to
-		// the desired location. If not, it is simply renamed to the location.
-		tempArtPath := artPath + ".tmp"
-		// Ensure parent directory exist, create if missing
-		tempArtDir := filepath.Dir(tempArtPath)
-		if err := os.MkdirAll(tempArtDir, 0o700); err != nil {
-			return fmt.Errorf("failed to create artifact temporary parent directory %s: %w", tempArtDir, err)
+		if len(art.Aggregated) > 0 {
+			err = we.loadAggregatedArtifact(ctx, &art, artPath)
+		} else {
+			err = we.loadArtifact(ctx, &art, artPath)
+		}
+		var argoErr argoerrs.ArgoError
+		if art.Optional && errors.As(err, &argoErr) && argoErr.Code() == argoerrs.CodeNotFound {
+			logger.WithField("name", art.Name).Info(ctx, "Skipping optional input artifact that was not found")
+			continue
 		}
-		err = artDriver.Load(ctx, driverArt, tempArtPath)
 		if err != nil {
-			if art.Optional && argoerrs.IsCode(argoerrs.CodeNotFound, err) {
-				logger.WithField("name", art.Name).Info(ctx, "Skipping optional input artifact that was not found")
-				continue
-			}
-			return fmt.Errorf("artifact %s failed to load: %w", art.Name, err)
+			return err
 		}
 
-		isTar := false
-		isZip := false
-		if art.GetArchive().None != nil {
-			// explicitly not a tar
-			isTar = false
-			isZip = false
-		} else if art.GetArchive().Tar != nil {
-			// explicitly a tar
-			isTar = true
-		} else if art.GetArchive().Zip != nil {
-			// explicitly a zip
-			isZip = true
-		} else {
-			// auto-detect if tarball
-			// (don't try to autodetect zip files for backwards compatibility)
-			isTar, err = isTarball(ctx, tempArtPath)
+		logger.WithField("path", artPath).Info(ctx, "Successfully download file")
+		if art.Mode != nil {
+			err = chmod(artPath, *art.Mode, art.RecurseMode)
 			if err != nil {
 				return err
 			}
 		}
+	}
+	return nil
+}
 
-		if isTar {
-			err = untar(tempArtPath, artPath)
-			_ = os.Remove(tempArtPath)
-		} else if isZip {
-			err = unzip(ctx, tempArtPath, artPath)
-			_ = os.Remove(tempArtPath)
-		} else {
-			err = os.Rename(tempArtPath, artPath)
-		}
+// loadArtifact loads an artifact to the path, extracting it if it is an archive
+func (we *WorkflowExecutor) loadArtifact(ctx context.Context, art *wfv1.Artifact, artPath string) error {
+	driverArt, err := we.newDriverArt(art)
+	if err != nil {
+		return fmt.Errorf("failed to load artifact '%s': %w", art.Name, err)
+	}
+	artDriver, err := we.InitDriver(ctx, driverArt)
+	if err != nil {
+		return err
+	}
+
+	// The artifact is downloaded to a temporary location, after which we determine if
+	// the file is a tarball or not. If it is, it is first extracted then renamed to
+	// the desired location. If not, it is simply renamed to the location.
+	tempArtPath := artPath + ".tmp"
+	// Ensure parent directory exist, create if missing
+	tempArtDir := filepath.Dir(tempArtPath)
+	if err := os.MkdirAll(tempArtDir, 0o700); err != nil {
+		return fmt.Errorf("failed to create artifact temporary parent directory %s: %w", tempArtDir, err)
+	}
+	err = artDriver.Load(ctx, driverArt, tempArtPath)
+	if err != nil {
+		return fmt.Errorf("artifact %s failed to load: %w", art.Name, err)
+	}
+
+	isTar := false
+	isZip := false
+	if art.GetArchive().None != nil {
+		// explicitly not a tar
+		isTar = false
+		isZip = false
+	} else if art.GetArchive().Tar != nil {
+		// explicitly a tar
+		isTar = true
+	} else if art.GetArchive().Zip != nil {
+		// explicitly a zip
+		isZip = true
+	} else {
+		// auto-detect if tarball
+		// (don't try to autodetect zip files for backwards compatibility)
+		isTar, err = isTarball(ctx, tempArtPath)
 		if err != nil {
 			return err
 		}
+	}
 
-		logger.WithField("path", artPath).Info(ctx, "Successfully download file")
-		if art.Mode != nil {
-			err = chmod(artPath, *art.Mode, art.RecurseMode)
+	if isTar {
+		err = untar(tempArtPath, artPath)
+		_ = os.Remove(tempArtPath)
+	} else if isZip {
+		err = unzip(ctx, tempArtPath, artPath)
+		_ = os.Remove(tempArtPath)
+	} else {
+		err = os.Rename(tempArtPath, artPath)
+	}
+	return err
+}
+
+// loadAggregatedArtifact loads the artifacts of the nodes of a fan-out step or task into a sub-directory each, named after
+// its index, or writes a JSON manifest of their locations to the path
+func (we *WorkflowExecutor) loadAggregatedArtifact(ctx context.Context, art *wfv1.Artifact, artPath string) error {
+	if art.Aggregate == wfv1.ArtifactAggregateManifest {
+		locations := make([]wfv1.ArtifactLocation, len(art.Aggregated))
+		for i, location := range art.Aggregated {
+			driverArt, err := we.newDriverArt(&wfv1.Artifact{Name: art.Name, ArtifactLocation: location})
 			if err != nil {
-				return err
+				return fmt.Errorf("failed to load artifact '%s': %w", art.Name, err)
 			}
+			locations[i] = driverArt.ArtifactLocation
+		}
+		manifest, err := json.Marshal(locations)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(artPath), 0o700); err != nil {
+			return err
+		}
+		return os.WriteFile(artPath, manifest, 0o644)
+	}
+	if err := os.MkdirAll(artPath, 0o755); err != nil {
+		return err
+	}
+	for i, location := range art.Aggregated {
+		aggregatedArt := &wfv1.Artifact{Name: art.Name, ArtifactLocation: location, Archive: art.Archive}
+		if err := we.loadArtifact(ctx, aggregatedArt, filepath.Join(artPath, strconv.Itoa(i))); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -363,10 +417,32 @@ func (we *WorkflowExecutor) saveArtifact(ctx context.Context, containerName stri
 	if size == 0 {
 		logger.WithField("path", localArtPath).Warn(ctx, "The file is empty. It may not be uploaded successfully depending on the artifact driver")
 	}
+	if fi.Mode().IsRegular() {
+		checksum, err := fileChecksum(localArtPath)
+		if err != nil {
+			return false, err
+		}
+		art.SizeBytes = size
+		art.Checksum = checksum
+	}
 	err = we.saveArtifactFromFile(ctx, art, fileName, localArtPath)
 	return err == nil, err
 }
 
+// fileChecksum returns the sha256 checksum of a file, e.g. "sha256:..."
+func fileChecksum(filePath string) (string, error) {
+	f, err := os.Open(filepath.Clean(filePath))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
 // fileBase is probably path.Base(filePath), but can be something else
 func (we *WorkflowExecutor) saveArtifactFromFile(ctx context.Context, art *wfv1.Artifact, fileName, localArtPath string) error {
 	if !art.HasKey() {
@@ -447,12 +523,12 @@ func (we *WorkflowExecutor) stageArchiveFile(ctx context.Context, containerName
 		// instead of copying it from the container.
 		mountedArtPath := filepath.Join(common.ExecutorMainFilesystemDir, art.Path)
 		logger.WithFields(logging.Fields{"path": art.Path, "mountedArtPath": mountedArtPath}).Info(ctx, "Staging from mirrored volume mount")
+		if !file.Exists(mountedArtPath) {
+			return "", "", argoerrs.Errorf(argoerrs.CodeNotFound, "%s no such file or directory", art.Path)
+		}
 		if strategy.None != nil {
 			fileName := filepath.Base(art.Path)
 			logger.WithField("fileName", fileName).Info(ctx, "No compression strategy needed, staging skipped")
-			if !file.Exists(mountedArtPath) {
-				return "", "", argoerrs.Errorf(argoerrs.CodeNotFound, "%s no such file or directory", art.Path)
-			}
 			return fileName, mountedArtPath, nil
 		}
 		if strategy.Zip != nil {
@@ -593,6 +669,10 @@ func (we *WorkflowExecutor) SaveParameters(ctx context.Context) error {
 			logger.WithField("path", param.ValueFrom.Path).Info(ctx, "Copying from base image layer")
 			fileContents, err := we.RuntimeExecutor.GetFileContents(common.MainContainerName, param.ValueFrom.Path)
 			if err != nil {
+				if param.Optional && os.IsNotExist(err) {
+					logger.WithField("name", param.Name).Warn(ctx, "Ignoring optional output parameter which does not exist in path")
+					continue
+				}
 				// We have a default value to use instead of returning an error
 				if param.ValueFrom.Default != nil {
 					output = param.ValueFrom.Default
@@ -607,6 +687,10 @@ func (we *WorkflowExecutor) SaveParameters(ctx context.Context) error {
 			mountedPath := filepath.Join(common.ExecutorMainFilesystemDir, param.ValueFrom.Path)
 			data, err := os.ReadFile(filepath.Clean(mountedPath))
 			if err != nil {
+				if param.Optional && os.IsNotExist(err) {
+					logger.WithField("name", param.Name).Warn(ctx, "Ignoring optional output parameter which does not exist in path")
+					continue
+				}
 				// We have a default value to use instead of returning an error
 				if param.ValueFrom.Default != nil {
 					output = param.ValueFrom.Default
@@ -711,7 +795,10 @@ func (we *WorkflowExecutor) InitDriver(ctx context.Context, art *wfv1.Artifact)
 	if err == artifact.ErrUnsupportedDriver {
 		return nil, argoerrs.Errorf(argoerrs.CodeBadRequest, "Unsupported artifact driver for %s", art.Name)
 	}
-	return driver, err
+	if err != nil {
+		return nil, err
+	}
+	return artifactmetrics.New(driver, art, we.Metrics), nil
 }
 
 // GetConfigMapKey retrieves a configmap value and memoizes the result
@@ -878,7 +965,28 @@ func (we *WorkflowExecutor) InitializeOutput(ctx context.Context) {
 func (we *WorkflowExecutor) ReportOutputs(ctx context.Context, artifacts []wfv1.Artifact) error {
 	outputs := we.Template.Outputs.DeepCopy()
 	outputs.Artifacts = artifacts
-	return we.reportResult(ctx, wfv1.NodeResult{Outputs: outputs})
+	return we.reportResult(ctx, wfv1.NodeResult{Outputs: outputs, PeakResourceUsage: we.peakResourceUsage(ctx)})
+}
+
+// peakResourceUsage returns the sum of the peak resource usage of the main containers, nil if it is not known
+func (we *WorkflowExecutor) peakResourceUsage(ctx context.Context) apiv1.ResourceList {
+	var usage apiv1.ResourceList
+	for _, containerName := range we.Template.GetMainContainerNames() {
+		containerUsage, err := we.RuntimeExecutor.GetPeakResourceUsage(containerName)
+		if err != nil {
+			logging.RequireLoggerFromContext(ctx).WithField("containerName", containerName).WithError(err).Warn(ctx, "failed to get peak resource usage")
+			continue
+		}
+		for name, quantity := range containerUsage {
+			if usage == nil {
+				usage = apiv1.ResourceList{}
+			}
+			total := usage[name]
+			total.Add(quantity)
+			usage[name] = total
+		}
+	}
+	return usage
 }
 
 // ReportOutputsLogs updates the WorkflowTaskResult log fields
@@ -1166,9 +1274,12 @@ func chmod(artPath string, mode int32, recurse bool) error {
 func (we *WorkflowExecutor) Wait(ctx context.Context) error {
 	logger := logging.RequireLoggerFromContext(ctx)
 	containerNames := we.Template.GetMainContainerNames()
-	// only monitor progress if both tick durations are >0
+	// only monitor progress, and intermediate parameters, if both tick durations are >0
 	if we.annotationPatchTickDuration != 0 && we.readProgressFileTickDuration != 0 {
 		go we.monitorProgress(ctx, os.Getenv(common.EnvVarProgressFile))
+		if parametersFile := os.Getenv(common.EnvVarIntermediateParametersFile); parametersFile != "" {
+			go we.monitorIntermediateParameters(ctx, parametersFile)
+		}
 	} else {
 		logger.WithField("annotationPatchTickDuration", we.annotationPatchTickDuration).WithField("readProgressFileTickDuration", we.readProgressFileTickDuration).Info(ctx, "monitoring progress disabled")
 	}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -13,6 +15,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/utils/ptr"
@@ -86,6 +89,38 @@ func TestWorkflowExecutor_LoadArtifacts(t *testing.T) {
 	}
 }
 
+func TestWorkflowExecutor_LoadAggregatedArtifact(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	we := WorkflowExecutor{}
+	art := &wfv1.Artifact{
+		Name: "parts",
+		Aggregated: []wfv1.ArtifactLocation{
+			{Raw: &wfv1.RawArtifact{Data: "a"}},
+			{Raw: &wfv1.RawArtifact{Data: "b"}},
+		},
+	}
+
+	t.Run("Directory", func(t *testing.T) {
+		artPath := filepath.Join(t.TempDir(), "parts")
+		require.NoError(t, we.loadAggregatedArtifact(ctx, art, artPath))
+		for i, data := range []string{"a", "b"} {
+			content, err := os.ReadFile(filepath.Join(artPath, strconv.Itoa(i)))
+			require.NoError(t, err)
+			assert.Equal(t, data, string(content))
+		}
+	})
+
+	t.Run("Manifest", func(t *testing.T) {
+		art := art.DeepCopy()
+		art.Aggregate = wfv1.ArtifactAggregateManifest
+		artPath := filepath.Join(t.TempDir(), "parts.json")
+		require.NoError(t, we.loadAggregatedArtifact(ctx, art, artPath))
+		content, err := os.ReadFile(artPath)
+		require.NoError(t, err)
+		assert.JSONEq(t, `[{"raw":{"data":"a"}},{"raw":{"data":"b"}}]`, string(content))
+	})
+}
+
 func TestSaveParameters(t *testing.T) {
 	fakeClientset := fake.NewSimpleClientset()
 	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
@@ -234,6 +269,44 @@ func TestDefaultParametersEmptyString(t *testing.T) {
 	assert.Empty(t, we.Template.Outputs.Parameters[0].Value.String())
 }
 
+func TestOptionalParameters(t *testing.T) {
+	fakeClientset := fake.NewSimpleClientset()
+	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
+	templateWithOutParam := wfv1.Template{
+		Outputs: wfv1.Outputs{
+			Parameters: []wfv1.Parameter{
+				{
+					Name:      "my-out",
+					Optional:  true,
+					ValueFrom: &wfv1.ValueFrom{Path: "/path"},
+				},
+				{
+					Name:      "my-other-out",
+					Optional:  true,
+					ValueFrom: &wfv1.ValueFrom{Path: "/other-path"},
+				},
+			},
+		},
+	}
+	we := WorkflowExecutor{
+		PodName:         fakePodName,
+		Template:        templateWithOutParam,
+		ClientSet:       fakeClientset,
+		Namespace:       fakeNamespace,
+		RuntimeExecutor: &mockRuntimeExecutor,
+	}
+	mockRuntimeExecutor.On("GetFileContents", fakeContainerName, "/path").Return("", os.ErrNotExist)
+	mockRuntimeExecutor.On("GetFileContents", fakeContainerName, "/other-path").Return("my-value\n", nil)
+
+	ctx := logging.TestContext(t.Context())
+	err := we.SaveParameters(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, we.Template.Outputs.Parameters[0].Value)
+	assert.False(t, we.Template.Outputs.Parameters[0].IsSupplied())
+	assert.Equal(t, "my-value", we.Template.Outputs.Parameters[1].Value.String())
+	assert.True(t, we.Template.Outputs.Parameters[1].IsSupplied())
+}
+
 func TestIsTarball(t *testing.T) {
 	ctx := logging.TestContext(t.Context())
 	tests := []struct {
@@ -261,6 +334,17 @@ func TestIsTarball(t *testing.T) {
 	}
 }
 
+func TestFileChecksum(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(filePath, []byte("hello"), 0o600))
+	checksum, err := fileChecksum(filePath)
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", checksum)
+
+	_, err = fileChecksum(filepath.Join(t.TempDir(), "not-found"))
+	require.Error(t, err)
+}
+
 func TestUnzip(t *testing.T) {
 	ctx := logging.TestContext(t.Context())
 	zipPath := "testdata/file.zip"
@@ -537,6 +621,54 @@ func TestMonitorProgress(t *testing.T) {
 	assert.Equal(t, wfv1.Progress("100/100"), result.Progress)
 }
 
+func TestMonitorIntermediateParameters(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+
+	parametersFile := filepath.Join(t.TempDir(), "intermediate-parameters")
+
+	wfFake := argofake.NewSimpleClientset()
+	taskResults := wfFake.ArgoprojV1alpha1().WorkflowTaskResults(fakeNamespace)
+	we := NewExecutor(
+		ctx,
+		nil,
+		taskResults,
+		nil,
+		fakePodName,
+		fakePodUID,
+		fakeWorkflow,
+		fakeWorkflowUID,
+		fakeNodeID,
+		fakeNamespace,
+		&mocks.ContainerRuntimeExecutor{},
+		wfv1.Template{},
+		false,
+		time.Now(),
+		5*time.Millisecond,
+		time.Millisecond,
+	)
+
+	go we.monitorIntermediateParameters(ctx, parametersFile)
+
+	err := os.WriteFile(parametersFile, []byte("stage=loaded\nepoch=1\nstage=trained\n"), 0o600)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		result, err := taskResults.Get(ctx, fakeNodeID, metav1.GetOptions{})
+		return err == nil && result.IntermediateParameters["stage"] == "trained"
+	}, 5*time.Second, 10*time.Millisecond)
+	result, err := taskResults.Get(ctx, fakeNodeID, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"stage": "trained", "epoch": "1"}, result.IntermediateParameters)
+}
+
+func TestParseIntermediateParameters(t *testing.T) {
+	assert.Empty(t, parseIntermediateParameters(nil))
+	assert.Equal(t, map[string]string{"a": "1", "b": "x=y"}, parseIntermediateParameters([]byte("a=1\nb = x=y\n")))
+	assert.Equal(t, map[string]string{"a": "2"}, parseIntermediateParameters([]byte("a=1\na=2\n")), "later lines override earlier ones")
+	assert.Equal(t, map[string]string{"a": "1"}, parseIntermediateParameters([]byte("a=1\nb=partly-writ")), "the last line is partly written")
+	assert.Equal(t, map[string]string{"a": "1"}, parseIntermediateParameters([]byte("a=1\nno value\n{{bad}}=1\n")), "invalid lines are ignored")
+}
+
 func TestSaveLogs(t *testing.T) {
 	const artStorageError = "artifact storage is not configured; see the docs for setup instructions: https://argo-workflows.readthedocs.io/en/latest/configure-artifact-repository/"
 	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
@@ -562,6 +694,7 @@ func TestSaveLogs(t *testing.T) {
 
 func TestReportOutputs(t *testing.T) {
 	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
+	mockRuntimeExecutor.On("GetPeakResourceUsage", common.MainContainerName).Return(corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")}, nil)
 	mockTaskResultClient := argofake.NewSimpleClientset().ArgoprojV1alpha1().WorkflowTaskResults(fakeNamespace)
 	t.Run("Simple report output", func(t *testing.T) {
 		artifacts := []wfv1.Artifact{
@@ -579,6 +712,7 @@ func TestReportOutputs(t *testing.T) {
 			Template:         templateWithArtifacts,
 			RuntimeExecutor:  &mockRuntimeExecutor,
 			taskResultClient: mockTaskResultClient,
+			nodeID:           fakeNodeID,
 		}
 
 		ctx := logging.TestContext(t.Context())
@@ -586,6 +720,9 @@ func TestReportOutputs(t *testing.T) {
 
 		require.NoError(t, err)
 		assert.Empty(t, we.errors)
+		result, err := mockTaskResultClient.Get(ctx, fakeNodeID, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "1Gi", result.PeakResourceUsage.Memory().String())
 	})
 
 }
@@ -0,0 +1,77 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+var intermediateParameterNameRegex = regexp.MustCompile(`^[-_a-zA-Z0-9]+$`)
+
+// monitorIntermediateParameters monitors for the parameters the main container publishes in the parametersFile while
+// it runs, and reports them in the task result, so the controller shows them in the status of the node before it
+// completes.
+//
+// The function reads the file every `readProgressFileTickDuration`, and reports changes every
+// `annotationPatchTickDuration`, as for progress.
+func (we *WorkflowExecutor) monitorIntermediateParameters(ctx context.Context, parametersFile string) {
+	logger := logging.RequireLoggerFromContext(ctx)
+	patchTicker := time.NewTicker(we.annotationPatchTickDuration)
+	defer patchTicker.Stop()
+	fileTicker := time.NewTicker(we.readProgressFileTickDuration)
+	defer fileTicker.Stop()
+
+	parametersFile = filepath.Clean(parametersFile)
+	var parameters, reported map[string]string
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.WithError(ctx.Err()).Info(ctx, "stopping intermediate parameters monitor (context done)")
+			return
+		case <-patchTicker.C:
+			if maps.Equal(parameters, reported) {
+				continue
+			}
+			if err := we.reportResult(ctx, wfv1.NodeResult{IntermediateParameters: parameters}); err != nil {
+				logger.WithError(err).Info(ctx, "failed to report intermediate parameters")
+			} else {
+				reported = parameters
+			}
+		case <-fileTicker.C:
+			data, err := os.ReadFile(parametersFile)
+			if err != nil {
+				if !errors.Is(err, fs.ErrNotExist) {
+					logger.WithError(err).WithField("file", parametersFile).Info(ctx, "unable to watch file")
+				}
+				continue
+			}
+			parameters = parseIntermediateParameters(data)
+		}
+	}
+}
+
+// parseIntermediateParameters parses the lines of `name=value` of the parameters file. A later line overrides an
+// earlier one of the same name. The last line is ignored until it ends with a newline, as it may be partly written.
+func parseIntermediateParameters(data []byte) map[string]string {
+	lines := strings.Split(string(data), "\n")
+	parameters := make(map[string]string)
+	for _, line := range lines[:len(lines)-1] {
+		name, value, ok := strings.Cut(line, "=")
+		name = strings.TrimSpace(name)
+		if !ok || !intermediateParameterNameRegex.MatchString(name) {
+			continue
+		}
+		parameters[name] = strings.TrimSpace(value)
+	}
+	return parameters
+}
@@ -0,0 +1,34 @@
+package executor
+
+import (
+	"context"
+	"os"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+// NewMetrics returns the executor's metrics, or nil if no OpenTelemetry endpoint is configured, as executors are too
+// short-lived to be scraped
+func NewMetrics(ctx context.Context) (*telemetry.Metrics, error) {
+	_, otlpEnabled := os.LookupEnv(`OTEL_EXPORTER_OTLP_ENDPOINT`)
+	_, otlpMetricsEnabled := os.LookupEnv(`OTEL_EXPORTER_OTLP_METRICS_ENDPOINT`)
+	if !otlpEnabled && !otlpMetricsEnabled {
+		return nil, nil
+	}
+	m, err := telemetry.NewMetrics(ctx, "argo-executor", "argo_executor", &telemetry.Config{})
+	if err != nil {
+		return nil, err
+	}
+	if err := m.Populate(ctx, telemetry.AddArtifactMetrics); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// FlushMetrics exports the executor's metrics before it exits
+func (we *WorkflowExecutor) FlushMetrics(ctx context.Context) {
+	if err := we.Metrics.Shutdown(ctx); err != nil {
+		logging.RequireLoggerFromContext(ctx).WithError(err).Warn(ctx, "failed to flush metrics")
+	}
+}
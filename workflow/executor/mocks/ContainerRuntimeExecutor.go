@@ -10,6 +10,7 @@ import (
 	"time"
 
 	mock "github.com/stretchr/testify/mock"
+	v1 "k8s.io/api/core/v1"
 )
 
 // NewContainerRuntimeExecutor creates a new instance of ContainerRuntimeExecutor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -254,6 +255,68 @@ func (_c *ContainerRuntimeExecutor_GetOutputStream_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// GetPeakResourceUsage provides a mock function for the type ContainerRuntimeExecutor
+func (_mock *ContainerRuntimeExecutor) GetPeakResourceUsage(containerName string) (v1.ResourceList, error) {
+	ret := _mock.Called(containerName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPeakResourceUsage")
+	}
+
+	var r0 v1.ResourceList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (v1.ResourceList, error)); ok {
+		return returnFunc(containerName)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) v1.ResourceList); ok {
+		r0 = returnFunc(containerName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(v1.ResourceList)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(containerName)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ContainerRuntimeExecutor_GetPeakResourceUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPeakResourceUsage'
+type ContainerRuntimeExecutor_GetPeakResourceUsage_Call struct {
+	*mock.Call
+}
+
+// GetPeakResourceUsage is a helper method to define mock.On call
+//   - containerName string
+func (_e *ContainerRuntimeExecutor_Expecter) GetPeakResourceUsage(containerName interface{}) *ContainerRuntimeExecutor_GetPeakResourceUsage_Call {
+	return &ContainerRuntimeExecutor_GetPeakResourceUsage_Call{Call: _e.mock.On("GetPeakResourceUsage", containerName)}
+}
+
+func (_c *ContainerRuntimeExecutor_GetPeakResourceUsage_Call) Run(run func(containerName string)) *ContainerRuntimeExecutor_GetPeakResourceUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *ContainerRuntimeExecutor_GetPeakResourceUsage_Call) Return(resourceList v1.ResourceList, err error) *ContainerRuntimeExecutor_GetPeakResourceUsage_Call {
+	_c.Call.Return(resourceList, err)
+	return _c
+}
+
+func (_c *ContainerRuntimeExecutor_GetPeakResourceUsage_Call) RunAndReturn(run func(containerName string) (v1.ResourceList, error)) *ContainerRuntimeExecutor_GetPeakResourceUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Kill provides a mock function for the type ContainerRuntimeExecutor
 func (_mock *ContainerRuntimeExecutor) Kill(ctx context.Context, containerNames []string, terminationGracePeriodDuration time.Duration) error {
 	ret := _mock.Called(ctx, containerNames, terminationGracePeriodDuration)
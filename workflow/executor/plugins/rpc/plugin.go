@@ -24,3 +24,11 @@ func New(address, token string) *plugin {
 func (p *plugin) ExecuteTemplate(ctx context.Context, args executorplugins.ExecuteTemplateArgs, reply *executorplugins.ExecuteTemplateReply) error {
 	return p.Call(ctx, "template.execute", args, reply)
 }
+
+func (p *plugin) ResolveParameter(ctx context.Context, args executorplugins.ResolveParameterArgs, reply *executorplugins.ResolveParameterReply) error {
+	return p.Call(ctx, "parameter.resolve", args, reply)
+}
+
+func (p *plugin) RenewLease(ctx context.Context, args executorplugins.RenewLeaseArgs, reply *executorplugins.RenewLeaseReply) error {
+	return p.Call(ctx, "lease.renew", args, reply)
+}
@@ -0,0 +1,108 @@
+package lifecycle
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// SchemaVersion is the version of the event schema.
+// Fields may be added to a version, but are never removed or changed, that requires a new version.
+const SchemaVersion = "v1"
+
+type EventType string
+
+const (
+	EventTypeWorkflowPhaseChanged EventType = "WorkflowPhaseChanged"
+	EventTypeNodePhaseChanged     EventType = "NodePhaseChanged"
+)
+
+// Event is a workflow or node phase transition
+type Event struct {
+	SchemaVersion string    `json:"schemaVersion"`
+	Type          EventType `json:"type"`
+	// ID uniquely identifies the transition, so consumers can discard duplicates
+	ID       string      `json:"id"`
+	Time     metav1.Time `json:"time"`
+	Workflow Workflow    `json:"workflow"`
+	// Node is only set for node events
+	Node          *Node  `json:"node,omitempty"`
+	Phase         string `json:"phase"`
+	PreviousPhase string `json:"previousPhase,omitempty"`
+	Message       string `json:"message,omitempty"`
+}
+
+type Workflow struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	UID       string `json:"uid"`
+}
+
+type Node struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	DisplayName  string `json:"displayName"`
+	Type         string `json:"type"`
+	TemplateName string `json:"templateName,omitempty"`
+}
+
+// Transitions returns the events for the workflow's, and optionally its nodes', phase changes.
+// Workflow events come first, so a consumer sees a workflow running before any of its nodes.
+func Transitions(orig *wfv1.Workflow, wf *wfv1.Workflow, nodes wfv1.Nodes, includeNodes bool, now metav1.Time) []Event {
+	var events []Event
+	ref := Workflow{Namespace: wf.Namespace, Name: wf.Name, UID: string(wf.UID)}
+	if wf.Status.Phase != orig.Status.Phase && wf.Status.Phase != wfv1.WorkflowUnknown {
+		events = append(events, Event{
+			SchemaVersion: SchemaVersion,
+			Type:          EventTypeWorkflowPhaseChanged,
+			ID:            id(ref.UID, "", string(wf.Status.Phase), wf.Status.StartedAt),
+			Time:          now,
+			Workflow:      ref,
+			Phase:         string(wf.Status.Phase),
+			PreviousPhase: string(orig.Status.Phase),
+			Message:       wf.Status.Message,
+		})
+	}
+	if !includeNodes {
+		return events
+	}
+	var nodeEvents []Event
+	for nodeID, node := range nodes {
+		previous := orig.Status.Nodes[nodeID].Phase
+		if node.Phase == previous || node.Phase == "" {
+			continue
+		}
+		nodeEvents = append(nodeEvents, Event{
+			SchemaVersion: SchemaVersion,
+			Type:          EventTypeNodePhaseChanged,
+			ID:            id(ref.UID, node.ID, string(node.Phase), node.StartedAt),
+			Time:          now,
+			Workflow:      ref,
+			Node: &Node{
+				ID:           node.ID,
+				Name:         node.Name,
+				DisplayName:  node.DisplayName,
+				Type:         string(node.Type),
+				TemplateName: node.TemplateName,
+			},
+			Phase:         string(node.Phase),
+			PreviousPhase: string(previous),
+			Message:       node.Message,
+		})
+	}
+	// nodes is a map, so sort for a stable order
+	sort.Slice(nodeEvents, func(i, j int) bool { return nodeEvents[i].Node.ID < nodeEvents[j].Node.ID })
+	return append(events, nodeEvents...)
+}
+
+// id is derived from the transition, so a transition that is published more than once always has the same ID.
+// The start time distinguishes between transitions of a retried workflow.
+func id(workflowUID, nodeID, phase string, startedAt metav1.Time) string {
+	h := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s/%d", workflowUID, nodeID, phase, startedAt.UnixNano())))
+	return hex.EncodeToString(h[:16])
+}
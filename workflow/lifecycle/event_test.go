@@ -0,0 +1,65 @@
+package lifecycle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestTransitions(t *testing.T) {
+	now := metav1.Now()
+	orig := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "my-wf", UID: "my-uid"},
+		Status: wfv1.WorkflowStatus{
+			Phase: wfv1.WorkflowPending,
+			Nodes: wfv1.Nodes{
+				"a": {ID: "a", Phase: wfv1.NodeRunning},
+				"b": {ID: "b", Phase: wfv1.NodeRunning},
+			},
+		},
+	}
+	wf := orig.DeepCopy()
+	wf.Status.Phase = wfv1.WorkflowRunning
+	wf.Status.Nodes = wfv1.Nodes{
+		"a": {ID: "a", Name: "my-wf.a", DisplayName: "a", Type: wfv1.NodeTypePod, TemplateName: "main", Phase: wfv1.NodeSucceeded},
+		"b": {ID: "b", Phase: wfv1.NodeRunning},
+		"c": {ID: "c", Phase: wfv1.NodePending},
+	}
+
+	t.Run("WorkflowAndNodes", func(t *testing.T) {
+		events := Transitions(orig, wf, wf.Status.Nodes, true, now)
+		require.Len(t, events, 3)
+		assert.Equal(t, EventTypeWorkflowPhaseChanged, events[0].Type)
+		assert.Equal(t, SchemaVersion, events[0].SchemaVersion)
+		assert.Equal(t, Workflow{Namespace: "my-ns", Name: "my-wf", UID: "my-uid"}, events[0].Workflow)
+		assert.Equal(t, "Running", events[0].Phase)
+		assert.Equal(t, "Pending", events[0].PreviousPhase)
+		assert.Nil(t, events[0].Node)
+
+		assert.Equal(t, EventTypeNodePhaseChanged, events[1].Type)
+		assert.Equal(t, &Node{ID: "a", Name: "my-wf.a", DisplayName: "a", Type: "Pod", TemplateName: "main"}, events[1].Node)
+		assert.Equal(t, "Succeeded", events[1].Phase)
+		assert.Equal(t, "Running", events[1].PreviousPhase)
+
+		assert.Equal(t, "c", events[2].Node.ID)
+		assert.Empty(t, events[2].PreviousPhase)
+	})
+	t.Run("WorkflowOnly", func(t *testing.T) {
+		events := Transitions(orig, wf, wf.Status.Nodes, false, now)
+		require.Len(t, events, 1)
+		assert.Equal(t, EventTypeWorkflowPhaseChanged, events[0].Type)
+	})
+	t.Run("NoChange", func(t *testing.T) {
+		assert.Empty(t, Transitions(orig, orig, orig.Status.Nodes, true, now))
+	})
+	t.Run("StableID", func(t *testing.T) {
+		a := Transitions(orig, wf, wf.Status.Nodes, true, now)
+		b := Transitions(orig, wf, wf.Status.Nodes, true, metav1.Now())
+		assert.Equal(t, a[0].ID, b[0].ID)
+		assert.NotEqual(t, a[0].ID, a[1].ID)
+	})
+}
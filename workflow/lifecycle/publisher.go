@@ -0,0 +1,171 @@
+package lifecycle
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/twmb/franz-go/pkg/kgo"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/util"
+	kafkautil "github.com/argoproj/argo-workflows/v3/util/kafka"
+)
+
+// Publisher publishes events to a message bus.
+// Publish only returns once every event has been acknowledged, so the caller can retry on error.
+type Publisher interface {
+	Publish(ctx context.Context, events []Event) error
+	Close()
+}
+
+type nullPublisher struct{}
+
+func (nullPublisher) Publish(context.Context, []Event) error { return nil }
+
+func (nullPublisher) Close() {}
+
+// NullPublisher is used when lifecycle events are not configured
+var NullPublisher Publisher = nullPublisher{}
+
+// NewPublisher creates a publisher for the config, reading any secrets from the namespace
+func NewPublisher(ctx context.Context, kubeclientset kubernetes.Interface, namespace string, c *config.LifecycleEvents) (Publisher, error) {
+	switch {
+	case c == nil:
+		return NullPublisher, nil
+	case c.Kafka != nil && c.NATS != nil:
+		return nil, fmt.Errorf("lifecycle events must have only one of kafka or nats")
+	case c.Kafka != nil:
+		return newKafkaPublisher(ctx, kubeclientset, namespace, c.Kafka)
+	case c.NATS != nil:
+		return newNATSPublisher(ctx, kubeclientset, namespace, c.NATS)
+	default:
+		return nil, fmt.Errorf("lifecycle events must have one of kafka or nats")
+	}
+}
+
+type kafkaPublisher struct {
+	client *kgo.Client
+	topic  string
+}
+
+func newKafkaPublisher(ctx context.Context, kubeclientset kubernetes.Interface, namespace string, c *config.KafkaLifecycleEvents) (Publisher, error) {
+	if c.Topic == "" {
+		return nil, fmt.Errorf("lifecycle events kafka topic is required")
+	}
+	opts, err := kafkautil.ClientOpts(ctx, kubeclientset, namespace, c.Brokers, c.TLS, c.SASL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid lifecycle events kafka config: %w", err)
+	}
+	// all in-sync replicas must acknowledge each record, which is the default, but we depend on it
+	client, err := kgo.NewClient(append(opts, kgo.RequiredAcks(kgo.AllISRAcks()))...)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaPublisher{client: client, topic: c.Topic}, nil
+}
+
+func (p *kafkaPublisher) Publish(ctx context.Context, events []Event) error {
+	records := make([]*kgo.Record, len(events))
+	for i, e := range events {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		records[i] = &kgo.Record{
+			Topic: p.topic,
+			Key:   []byte(e.Workflow.UID),
+			Value: data,
+			Headers: []kgo.RecordHeader{
+				{Key: "schemaVersion", Value: []byte(e.SchemaVersion)},
+				{Key: "type", Value: []byte(e.Type)},
+				{Key: "id", Value: []byte(e.ID)},
+			},
+		}
+	}
+	return p.client.ProduceSync(ctx, records...).FirstErr()
+}
+
+func (p *kafkaPublisher) Close() {
+	p.client.Close()
+}
+
+type natsPublisher struct {
+	conn    *nats.Conn
+	js      jetstream.JetStream
+	subject string
+}
+
+func newNATSPublisher(ctx context.Context, kubeclientset kubernetes.Interface, namespace string, c *config.NATSLifecycleEvents) (Publisher, error) {
+	if c.URL == "" || c.Subject == "" {
+		return nil, fmt.Errorf("lifecycle events nats url and subject are required")
+	}
+	getSecret := func(s *apiv1.SecretKeySelector) (string, error) {
+		data, err := util.GetSecrets(ctx, kubeclientset, namespace, s.Name, s.Key)
+		return string(data), err
+	}
+	var opts []nats.Option
+	if c.TokenSecret != nil {
+		token, err := getSecret(c.TokenSecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get NATS token: %w", err)
+		}
+		opts = append(opts, nats.Token(token))
+	}
+	if c.UserSecret != nil && c.PasswordSecret != nil {
+		user, err := getSecret(c.UserSecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get NATS user: %w", err)
+		}
+		password, err := getSecret(c.PasswordSecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get NATS password: %w", err)
+		}
+		opts = append(opts, nats.UserInfo(user, password))
+	}
+	if c.CASecret != nil {
+		ca, err := getSecret(c.CASecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get NATS CA certificate: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(ca)) {
+			return nil, fmt.Errorf("failed to parse NATS CA certificate")
+		}
+		opts = append(opts, nats.Secure(&tls.Config{MinVersion: tls.VersionTLS12, RootCAs: pool}))
+	}
+	conn, err := nats.Connect(c.URL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &natsPublisher{conn: conn, js: js, subject: c.Subject}, nil
+}
+
+func (p *natsPublisher) Publish(ctx context.Context, events []Event) error {
+	for _, e := range events {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		// the message ID lets JetStream discard duplicates within the stream's duplicate window
+		if _, err := p.js.Publish(ctx, p.subject, data, jetstream.WithMsgID(e.ID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *natsPublisher) Close() {
+	p.conn.Close()
+}
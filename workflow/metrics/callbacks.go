@@ -1,8 +1,15 @@
 package metrics
 
+import (
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
 type Callbacks struct {
 	PodPhase          PodPhaseCallback
 	WorkflowPhase     WorkflowPhaseCallback
 	WorkflowCondition WorkflowConditionCallback
 	IsLeader          IsLeaderCallback
+	DBStats           telemetry.DBStatsCallback
+	RateLimits        RateLimitsCallback
+	LastReconcile     LastReconcileCallback
 }
@@ -0,0 +1,19 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+func addEstimatedCostCounter(_ context.Context, m *Metrics) error {
+	return m.CreateBuiltinInstrument(telemetry.InstrumentEstimatedCost)
+}
+
+// EstimatedCost adds the estimated cost of a completed workflow
+func (m *Metrics) EstimatedCost(ctx context.Context, namespace, group string, cost float64) {
+	m.AddFloat(ctx, telemetry.InstrumentEstimatedCost.Name(), cost, telemetry.InstAttribs{
+		{Name: telemetry.AttribWorkflowNamespace, Value: namespace},
+		{Name: telemetry.AttribWorkflowGroup, Value: group},
+	})
+}
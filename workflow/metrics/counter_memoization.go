@@ -0,0 +1,28 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+// MemoizationResult is the result of looking up a memoized node in its cache
+type MemoizationResult string
+
+const (
+	MemoizationHit     MemoizationResult = "hit"
+	MemoizationMiss    MemoizationResult = "miss"
+	MemoizationExpired MemoizationResult = "expired"
+)
+
+func addMemoizationCacheCounter(_ context.Context, m *Metrics) error {
+	return m.CreateBuiltinInstrument(telemetry.InstrumentMemoizationCacheLookups)
+}
+
+func (m *Metrics) MemoizationCacheLookup(ctx context.Context, namespace, cacheName string, result MemoizationResult) {
+	m.AddInt(ctx, telemetry.InstrumentMemoizationCacheLookups.Name(), 1, telemetry.InstAttribs{
+		{Name: telemetry.AttribWorkflowNamespace, Value: namespace},
+		{Name: telemetry.AttribCacheName, Value: cacheName},
+		{Name: telemetry.AttribMemoizationResult, Value: string(result)},
+	})
+}
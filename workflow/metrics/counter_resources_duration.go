@@ -0,0 +1,22 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+func addResourcesDurationCounter(_ context.Context, m *Metrics) error {
+	return m.CreateBuiltinInstrument(telemetry.InstrumentResourcesDuration)
+}
+
+// ResourcesDuration adds the resources duration of a completed workflow, in seconds of each resource
+func (m *Metrics) ResourcesDuration(ctx context.Context, namespace, group string, resourcesDuration map[string]int64) {
+	for resource, seconds := range resourcesDuration {
+		m.AddInt(ctx, telemetry.InstrumentResourcesDuration.Name(), seconds, telemetry.InstAttribs{
+			{Name: telemetry.AttribWorkflowNamespace, Value: namespace},
+			{Name: telemetry.AttribWorkflowGroup, Value: group},
+			{Name: telemetry.AttribResourceName, Value: resource},
+		})
+	}
+}
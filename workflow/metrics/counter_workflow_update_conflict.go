@@ -0,0 +1,18 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+func addWorkflowUpdateConflictCounter(_ context.Context, m *Metrics) error {
+	return m.CreateBuiltinInstrument(telemetry.InstrumentWorkflowUpdateConflicts)
+}
+
+func (m *Metrics) WorkflowUpdateConflict(ctx context.Context, namespace, method string) {
+	m.AddInt(ctx, telemetry.InstrumentWorkflowUpdateConflicts.Name(), 1, telemetry.InstAttribs{
+		{Name: telemetry.AttribWorkflowNamespace, Value: namespace},
+		{Name: telemetry.AttribWorkflowUpdateMethod, Value: method},
+	})
+}
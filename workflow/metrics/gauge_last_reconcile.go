@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// LastReconcileCallback is the function prototype to provide this gauge with the time the leader last finished
+// reconciling a workflow, or started leading. It is zero if the controller is not the leader.
+type LastReconcileCallback func() time.Time
+
+type lastReconcileGauge struct {
+	callback LastReconcileCallback
+	gauge    *telemetry.Instrument
+}
+
+func addLastReconcileGauge(_ context.Context, m *Metrics) error {
+	err := m.CreateBuiltinInstrument(telemetry.InstrumentTimeSinceLastReconcile)
+	if err != nil {
+		return err
+	}
+
+	name := telemetry.InstrumentTimeSinceLastReconcile.Name()
+	if m.callbacks.LastReconcile != nil {
+		lrGauge := lastReconcileGauge{
+			callback: m.callbacks.LastReconcile,
+			gauge:    m.GetInstrument(name),
+		}
+		return lrGauge.gauge.RegisterCallback(m.Metrics, lrGauge.update)
+	}
+	return nil
+}
+
+func (l *lastReconcileGauge) update(ctx context.Context, o metric.Observer) error {
+	last := l.callback()
+	if last.IsZero() {
+		return nil
+	}
+	l.gauge.ObserveFloat(ctx, o, time.Since(last).Seconds(), telemetry.InstAttribs{})
+	return nil
+}
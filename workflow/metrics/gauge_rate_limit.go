@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// RateLimitsCallback is the function prototype to provide this gauge with the current limits of the rate limiters
+type RateLimitsCallback func(ctx context.Context) map[string]float64
+
+type rateLimitGauge struct {
+	callback RateLimitsCallback
+	gauge    *telemetry.Instrument
+}
+
+func addRateLimitGauge(ctx context.Context, m *Metrics) error {
+	err := m.CreateBuiltinInstrument(telemetry.InstrumentRateLimit)
+	if err != nil {
+		return err
+	}
+
+	name := telemetry.InstrumentRateLimit.Name()
+	if m.callbacks.RateLimits != nil {
+		rlGauge := rateLimitGauge{
+			callback: m.callbacks.RateLimits,
+			gauge:    m.GetInstrument(name),
+		}
+		return rlGauge.gauge.RegisterCallback(m.Metrics, rlGauge.update)
+	}
+	return nil
+}
+
+func (r *rateLimitGauge) update(ctx context.Context, o metric.Observer) error {
+	for limiter, val := range r.callback(ctx) {
+		r.gauge.ObserveFloat(ctx, o, val, telemetry.InstAttribs{{Name: telemetry.AttribRateLimiter, Value: limiter}})
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+func addInformerLagHistogram(_ context.Context, m *Metrics) error {
+	return m.CreateBuiltinInstrument(telemetry.InstrumentInformerLag)
+}
+
+// InformerLag records how long the workflow informer took to receive an update of a workflow by the controller
+func (m *Metrics) InformerLag(ctx context.Context, lagSeconds float64) {
+	m.Record(ctx, telemetry.InstrumentInformerLag.Name(), lagSeconds, telemetry.InstAttribs{})
+}
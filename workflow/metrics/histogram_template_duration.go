@@ -0,0 +1,22 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+func addTemplateDurationHistogram(_ context.Context, m *Metrics) error {
+	return m.CreateBuiltinInstrument(telemetry.InstrumentTemplateDuration)
+}
+
+// TemplateDuration records the duration of a node that ran the template, whose templateRef is empty for templates in
+// the workflow
+func (m *Metrics) TemplateDuration(ctx context.Context, duration time.Duration, templateRef, template, phase string) {
+	m.Record(ctx, telemetry.InstrumentTemplateDuration.Name(), duration.Seconds(), telemetry.InstAttribs{
+		{Name: telemetry.AttribNodeTemplate, Value: template},
+		{Name: telemetry.AttribNodeTemplateRef, Value: templateRef},
+		{Name: telemetry.AttribNodePhase, Value: phase},
+	})
+}
@@ -39,3 +39,18 @@ func (l *leaderGauge) update(ctx context.Context, o metric.Observer) error {
 	l.gauge.ObserveInt(ctx, o, val, telemetry.InstAttribs{})
 	return nil
 }
+
+func addLeaderTransitionsCounter(_ context.Context, m *Metrics) error {
+	return m.CreateBuiltinInstrument(telemetry.InstrumentLeaderTransitions)
+}
+
+// LeaderTransition counts that the controller started or stopped leading
+func (m *Metrics) LeaderTransition(ctx context.Context, leading bool) {
+	transition := "stopped"
+	if leading {
+		transition = "started"
+	}
+	m.AddInt(ctx, telemetry.InstrumentLeaderTransitions.Name(), 1, telemetry.InstAttribs{
+		{Name: telemetry.AttribLeaderTransition, Value: transition},
+	})
+}
@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -47,3 +48,35 @@ func TestNotLeader(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, int64(0), val)
 }
+
+func TestLeaderTransition(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	m, te, err := createTestMetrics(ctx, &telemetry.Config{}, Callbacks{})
+	require.NoError(t, err)
+	m.LeaderTransition(ctx, true)
+	m.LeaderTransition(ctx, false)
+	m.LeaderTransition(ctx, true)
+	for transition, expected := range map[string]int64{"started": 2, "stopped": 1} {
+		attribs := attribute.NewSet(attribute.String(telemetry.AttribLeaderTransition, transition))
+		val, err := te.GetInt64CounterValue(ctx, telemetry.InstrumentLeaderTransitions.Name(), &attribs)
+		require.NoError(t, err)
+		assert.Equal(t, expected, val)
+	}
+}
+
+func TestTimeSinceLastReconcile(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	_, te, err := createTestMetrics(
+		ctx,
+		&telemetry.Config{},
+		Callbacks{
+			LastReconcile: func() time.Time {
+				return time.Now().Add(-time.Minute)
+			},
+		})
+	require.NoError(t, err)
+	attribs := attribute.NewSet()
+	val, err := te.GetFloat64GaugeValue(ctx, telemetry.InstrumentTimeSinceLastReconcile.Name(), &attribs)
+	require.NoError(t, err)
+	assert.InDelta(t, 60, val, 5)
+}
@@ -28,6 +28,7 @@ func New(ctx context.Context, serviceName, prometheusName string, config *teleme
 	err = m.Populate(ctx,
 		telemetry.AddVersion,
 		telemetry.AddDeprecationCounter,
+		telemetry.AddDBMetrics(callbacks.DBStats),
 	)
 	if err != nil {
 		return nil, err
@@ -42,9 +43,13 @@ func New(ctx context.Context, serviceName, prometheusName string, config *teleme
 
 	err = metrics.populate(ctx,
 		addIsLeader,
+		addLeaderTransitionsCounter,
+		addLastReconcileGauge,
+		addInformerLagHistogram,
 		addPodPhaseGauge,
 		addPodPhaseCounter,
 		addPodMissingCounter,
+		addMemoizationCacheCounter,
 		addPodPendingCounter,
 		addWorkflowPhaseGauge,
 		addCronWfTriggerCounter,
@@ -52,12 +57,17 @@ func New(ctx context.Context, serviceName, prometheusName string, config *teleme
 		addWorkflowPhaseCounter,
 		addWorkflowTemplateCounter,
 		addWorkflowTemplateHistogram,
+		addTemplateDurationHistogram,
 		addOperationDurationHistogram,
 		addErrorCounter,
 		addLogCounter,
 		addK8sRequests,
 		addWorkflowConditionGauge,
 		addWorkQueueMetrics,
+		addWorkflowUpdateConflictCounter,
+		addRateLimitGauge,
+		addResourcesDurationCounter,
+		addEstimatedCostCounter,
 	)
 	if err != nil {
 		return nil, err
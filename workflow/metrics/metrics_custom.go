@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 	"sync"
 	"time"
@@ -28,6 +29,9 @@ type customMetricValue struct {
 	labels          []*wfv1.MetricLabel
 	key             string
 	completed       bool
+	// ttl overrides the TTL of the metrics config for this value, if non-zero
+	ttl                time.Duration
+	deleteOnCompletion bool
 }
 
 type customMetricUserData struct {
@@ -51,6 +55,14 @@ func (ud *customMetricUserData) GetValue(key string) *customMetricValue {
 	return val
 }
 
+// expired returns whether the value has not been updated for its TTL, or the TTL of the metrics config
+func (cmv *customMetricValue) expired(ttl time.Duration) bool {
+	if cmv.ttl > 0 {
+		ttl = cmv.ttl
+	}
+	return ttl > 0 && time.Since(cmv.lastUpdated) > ttl
+}
+
 type realtimeTracker struct {
 	inst *telemetry.Instrument
 	key  string
@@ -181,12 +193,23 @@ func (m *Metrics) UpsertCustomMetric(ctx context.Context, metricSpec *wfv1.Prome
 	if !IsValidMetricName(metricSpec.Name) {
 		return fmt.Errorf("%s", invalidMetricNameError)
 	}
+	if metricSpec.Gauge != nil && metricSpec.Gauge.Operation == wfv1.GaugeOperationDelete {
+		m.deleteCustomMetricValue(metricSpec)
+		return nil
+	}
 	baseMetric, err := m.ensureBaseMetric(metricSpec, ownerKey)
 	if err != nil {
 		return err
 	}
 	metricValue := getOrCreateValue(baseMetric, metricSpec.GetKey(), metricSpec.Labels)
 	metricValue.lastUpdated = time.Now()
+	if metricSpec.Gauge != nil {
+		metricValue.ttl = 0
+		if metricSpec.Gauge.TTL != nil {
+			metricValue.ttl = metricSpec.Gauge.TTL.Duration
+		}
+		metricValue.deleteOnCompletion = metricSpec.Gauge.DeleteOnCompletion
+	}
 
 	metricType := metricSpec.GetMetricType()
 	switch {
@@ -226,21 +249,40 @@ func (m *Metrics) UpsertCustomMetric(ctx context.Context, metricSpec *wfv1.Prome
 	return nil
 }
 
+// deleteCustomMetricValue deletes the series of a custom metric, if it exists
+func (m *Metrics) deleteCustomMetricValue(metricSpec *wfv1.Prometheus) {
+	inst := m.GetInstrument(metricSpec.Name)
+	if inst == nil {
+		return
+	}
+	ud := customUserData(inst, false)
+	if ud == nil {
+		return
+	}
+	ud.mutex.Lock()
+	defer ud.mutex.Unlock()
+	if value, ok := ud.values[metricSpec.GetKey()]; ok {
+		delete(ud.values, metricSpec.GetKey())
+		inst.ForgetSeries(value.getLabels())
+	}
+}
+
+// attachCustomMetricToWorkflow tracks the realtime and delete on completion metrics of a workflow, which are handled
+// when the workflow completes or is deleted
 func (m *Metrics) attachCustomMetricToWorkflow(metricSpec *wfv1.Prometheus, ownerKey string) {
-	if metricSpec.IsRealtime() {
-		m.realtimeMutex.Lock()
-		defer m.realtimeMutex.Unlock()
-		// Must move to run each workflowkey
-		for key := range m.realtimeWorkflows {
-			if key == ownerKey {
-				return
-			}
-		}
-		m.realtimeWorkflows[ownerKey] = append(m.realtimeWorkflows[ownerKey], realtimeTracker{
-			inst: m.GetInstrument(metricSpec.Name),
-			key:  metricSpec.GetKey(),
-		})
+	if !metricSpec.IsRealtime() && (metricSpec.Gauge == nil || !metricSpec.Gauge.DeleteOnCompletion) {
+		return
+	}
+	m.realtimeMutex.Lock()
+	defer m.realtimeMutex.Unlock()
+	tracker := realtimeTracker{
+		inst: m.GetInstrument(metricSpec.Name),
+		key:  metricSpec.GetKey(),
 	}
+	if slices.Contains(m.realtimeWorkflows[ownerKey], tracker) {
+		return
+	}
+	m.realtimeWorkflows[ownerKey] = append(m.realtimeWorkflows[ownerKey], tracker)
 }
 
 func (m *Metrics) createCustomMetric(metricSpec *wfv1.Prometheus) error {
@@ -287,12 +329,14 @@ func (m *Metrics) runCustomGC(ttl time.Duration) {
 		}
 		ud.mutex.Lock()
 		for key, value := range ud.values {
-			if time.Since(value.lastUpdated) > ttl {
+			if value.expired(ttl) {
 				switch {
 				case value.rtValueFunc != nil && value.completed:
 					delete(ud.values, key)
+					baseMetric.ForgetSeries(value.getLabels())
 				case value.rtValueFunc == nil:
 					delete(ud.values, key)
+					baseMetric.ForgetSeries(value.getLabels())
 				}
 			}
 		}
@@ -301,11 +345,13 @@ func (m *Metrics) runCustomGC(ttl time.Duration) {
 }
 
 func (m *Metrics) customMetricsGC(ctx context.Context, ttl time.Duration) {
-	if ttl == 0 {
-		return
+	// gauges may have their own TTL, so collect at least every customMetricsGCPeriod
+	period := customMetricsGCPeriod
+	if ttl > 0 && ttl < period {
+		period = ttl
 	}
 
-	ticker := time.NewTicker(ttl)
+	ticker := time.NewTicker(period)
 	defer ticker.Stop()
 	for {
 		select {
@@ -317,6 +363,9 @@ func (m *Metrics) customMetricsGC(ctx context.Context, ttl time.Duration) {
 	}
 }
 
+// customMetricsGCPeriod is the longest period between collections of expired custom metrics
+const customMetricsGCPeriod = time.Minute
+
 type operation int
 
 const (
@@ -337,9 +386,17 @@ func (m *Metrics) handleRealtimeMetricsForWfUID(key string, op operation) {
 		switch op {
 		case Complete:
 			if value, ok := ud.values[metric.key]; ok && value != nil {
-				value.completed = true
+				if value.deleteOnCompletion {
+					metric.inst.ForgetSeries(value.getLabels())
+					delete(ud.values, metric.key)
+				} else {
+					value.completed = true
+				}
 			}
 		case Delete:
+			if value, ok := ud.values[metric.key]; ok && value != nil {
+				metric.inst.ForgetSeries(value.getLabels())
+			}
 			delete(ud.values, metric.key)
 		}
 		ud.mutex.Unlock()
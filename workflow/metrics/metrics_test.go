@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel/attribute"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/ptr"
 
@@ -232,3 +233,62 @@ func TestRealTimeMetricDeletion(t *testing.T) {
 	assert.Len(t, cm.values, 1)
 	assert.Len(t, m.realtimeWorkflows["456"], 1)
 }
+
+func TestCustomGaugeLifecycle(t *testing.T) {
+	config := telemetry.Config{
+		Enabled: true,
+		Path:    telemetry.DefaultPrometheusServerPath,
+		Port:    telemetry.DefaultPrometheusServerPort,
+	}
+	ctx, cancel := context.WithCancel(logging.TestContext(t.Context()))
+	defer cancel()
+	m, err := New(ctx, telemetry.TestScopeName, telemetry.TestScopeName, &config, Callbacks{})
+	require.NoError(t, err)
+
+	gauge := func(name, value string, modify func(g *wfv1.Gauge)) *wfv1.Prometheus {
+		g := &wfv1.Gauge{Value: value}
+		if modify != nil {
+			modify(g)
+		}
+		return &wfv1.Prometheus{Name: name, Help: name, Labels: []*wfv1.MetricLabel{{Key: "foo", Value: "bar"}}, Gauge: g}
+	}
+	values := func(name string) map[string]*customMetricValue {
+		return customUserData(m.GetCustomMetric(name), true).values
+	}
+
+	t.Run("Delete", func(t *testing.T) {
+		require.NoError(t, m.UpsertCustomMetric(ctx, gauge("deleted_gauge", "1", nil), "wf", nil))
+		assert.Len(t, values("deleted_gauge"), 1)
+		require.NoError(t, m.UpsertCustomMetric(ctx, gauge("deleted_gauge", "", func(g *wfv1.Gauge) { g.Operation = wfv1.GaugeOperationDelete }), "wf", nil))
+		assert.Empty(t, values("deleted_gauge"))
+		// deleting a metric that was never emitted does nothing
+		require.NoError(t, m.UpsertCustomMetric(ctx, gauge("never_emitted_gauge", "", func(g *wfv1.Gauge) { g.Operation = wfv1.GaugeOperationDelete }), "wf", nil))
+		assert.False(t, m.CustomMetricExists("never_emitted_gauge"))
+	})
+
+	t.Run("TTL", func(t *testing.T) {
+		require.NoError(t, m.UpsertCustomMetric(ctx, gauge("ttl_gauge", "1", func(g *wfv1.Gauge) { g.TTL = &metav1.Duration{Duration: time.Millisecond} }), "wf", nil))
+		require.NoError(t, m.UpsertCustomMetric(ctx, gauge("no_ttl_gauge", "1", nil), "wf", nil))
+		time.Sleep(10 * time.Millisecond)
+		// the metrics config has no TTL, so only the gauge with its own TTL expires
+		m.runCustomGC(0)
+		assert.Empty(t, values("ttl_gauge"))
+		assert.Len(t, values("no_ttl_gauge"), 1)
+	})
+
+	t.Run("DeleteOnCompletion", func(t *testing.T) {
+		require.NoError(t, m.UpsertCustomMetric(ctx, gauge("completion_gauge", "1", func(g *wfv1.Gauge) { g.DeleteOnCompletion = true }), "completing-wf", nil))
+		require.NoError(t, m.UpsertCustomMetric(ctx, gauge("completion_gauge", "2", func(g *wfv1.Gauge) { g.DeleteOnCompletion = true }), "completing-wf", nil))
+		require.NoError(t, m.UpsertCustomMetric(ctx, gauge("kept_gauge", "1", nil), "completing-wf", nil))
+		require.NoError(t, m.UpsertCustomMetric(ctx, &wfv1.Prometheus{Name: "completion_realtime", Help: "realtime", Gauge: &wfv1.Gauge{Realtime: ptr.To(true)}}, "completing-wf", func() float64 { return 1 }))
+		assert.Len(t, m.realtimeWorkflows["completing-wf"], 2, "each metric is tracked once")
+
+		m.CompleteRealtimeMetricsForWfUID("completing-wf")
+		assert.Empty(t, values("completion_gauge"))
+		assert.Len(t, values("kept_gauge"), 1)
+		require.Len(t, values("completion_realtime"), 1)
+		for _, value := range values("completion_realtime") {
+			assert.True(t, value.completed)
+		}
+	})
+}
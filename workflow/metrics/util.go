@@ -22,9 +22,16 @@ func IsValidMetricName(name string) bool {
 
 func ValidateMetricValues(metric *wfv1.Prometheus) error {
 	if metric.Gauge != nil {
-		if metric.Gauge.Value == "" {
+		if metric.Gauge.Operation == wfv1.GaugeOperationDelete {
+			if metric.IsRealtime() {
+				return errors.New("real-time metrics cannot be deleted with gauge.operation Delete")
+			}
+		} else if metric.Gauge.Value == "" {
 			return errors.New("missing gauge.value")
 		}
+		if metric.Gauge.TTL != nil && metric.Gauge.TTL.Duration <= 0 {
+			return errors.New("gauge.ttl must be greater than zero")
+		}
 		if metric.Gauge.Realtime != nil && *metric.Gauge.Realtime {
 			if strings.Contains(metric.Gauge.Value, "resourcesDuration.") {
 				return errors.New("'resourcesDuration.*' metrics cannot be used in real-time")
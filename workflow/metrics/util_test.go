@@ -2,8 +2,14 @@ package metrics
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
 func TestMetricNames(t *testing.T) {
@@ -24,3 +30,12 @@ func TestMetricNames(t *testing.T) {
 		assert.False(t, IsValidMetricName(name), name)
 	}
 }
+
+func TestValidateMetricValues(t *testing.T) {
+	require.EqualError(t, ValidateMetricValues(&wfv1.Prometheus{Gauge: &wfv1.Gauge{}}), "missing gauge.value")
+	require.NoError(t, ValidateMetricValues(&wfv1.Prometheus{Gauge: &wfv1.Gauge{Operation: wfv1.GaugeOperationDelete}}))
+	require.EqualError(t, ValidateMetricValues(&wfv1.Prometheus{Gauge: &wfv1.Gauge{Operation: wfv1.GaugeOperationDelete, Realtime: ptr.To(true)}}),
+		"real-time metrics cannot be deleted with gauge.operation Delete")
+	require.EqualError(t, ValidateMetricValues(&wfv1.Prometheus{Gauge: &wfv1.Gauge{Value: "1", TTL: &metav1.Duration{}}}), "gauge.ttl must be greater than zero")
+	require.NoError(t, ValidateMetricValues(&wfv1.Prometheus{Gauge: &wfv1.Gauge{Value: "1", TTL: &metav1.Duration{Duration: time.Minute}, DeleteOnCompletion: true}}))
+}
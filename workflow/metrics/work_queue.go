@@ -27,6 +27,10 @@ func addWorkQueueMetrics(_ context.Context, m *Metrics) error {
 	if err != nil {
 		return err
 	}
+	err = m.CreateBuiltinInstrument(telemetry.InstrumentWorkflowQueueInflight)
+	if err != nil {
+		return err
+	}
 	err = m.CreateBuiltinInstrument(telemetry.InstrumentQueueDepthGauge)
 	if err != nil {
 		return err
@@ -76,8 +80,22 @@ func addWorkQueueMetrics(_ context.Context, m *Metrics) error {
 }
 
 func (m *Metrics) RateLimiterWithBusyWorkers(ctx context.Context, workQueue workqueue.TypedRateLimiter[string], queueName string) workqueue.TypedRateLimitingInterface[string] {
+	return m.newBusyWorkersQueue(ctx, workqueue.NewTypedRateLimitingQueueWithConfig(workQueue, workqueue.TypedRateLimitingQueueConfig[string]{Name: queueName}), queueName)
+}
+
+// RateLimiterWithBusyWorkersAndQueue is RateLimiterWithBusyWorkers with the queue that stores the items that are ready,
+// rather than a FIFO
+func (m *Metrics) RateLimiterWithBusyWorkersAndQueue(ctx context.Context, workQueue workqueue.TypedRateLimiter[string], queue workqueue.Queue[string], queueName string) workqueue.TypedRateLimitingInterface[string] {
+	delayingQueue := workqueue.NewTypedDelayingQueueWithConfig(workqueue.TypedDelayingQueueConfig[string]{
+		Name:  queueName,
+		Queue: workqueue.NewTypedWithConfig(workqueue.TypedQueueConfig[string]{Name: queueName, Queue: queue}),
+	})
+	return m.newBusyWorkersQueue(ctx, workqueue.NewTypedRateLimitingQueueWithConfig(workQueue, workqueue.TypedRateLimitingQueueConfig[string]{Name: queueName, DelayingQueue: delayingQueue}), queueName)
+}
+
+func (m *Metrics) newBusyWorkersQueue(ctx context.Context, rateLimitingQueue workqueue.TypedRateLimitingInterface[string], queueName string) workqueue.TypedRateLimitingInterface[string] {
 	queue := workersBusyRateLimiterWorkQueue{
-		TypedRateLimitingInterface: workqueue.NewTypedRateLimitingQueueWithConfig(workQueue, workqueue.TypedRateLimitingQueueConfig[string]{Name: queueName}),
+		TypedRateLimitingInterface: rateLimitingQueue,
 		workerType:                 queueName,
 		busyGauge:                  m.GetInstrument(telemetry.InstrumentWorkersBusyCount.Name()),
 		ctx:                        ctx,
@@ -113,6 +131,13 @@ func (w workersBusyRateLimiterWorkQueue) Done(item string) {
 	w.workerFree(w.ctx)
 }
 
+// WorkflowQueueInflight adds delta to the number of workflows of the namespace that workers are operating on
+func (m *Metrics) WorkflowQueueInflight(ctx context.Context, namespace string, delta int64) {
+	m.AddInt(ctx, telemetry.InstrumentWorkflowQueueInflight.Name(), delta, telemetry.InstAttribs{
+		{Name: telemetry.AttribWorkflowNamespace, Value: namespace},
+	})
+}
+
 // Shim between kubernetes queue interface and otel
 type queueMetric struct {
 	name  string
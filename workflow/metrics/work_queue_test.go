@@ -43,8 +43,15 @@ func TestMetricsWorkQueue(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, int64(0), val)
 
+	latency, err := te.GetFloat64HistogramData(ctx, telemetry.InstrumentQueueLatency.Name(), &attribsQN)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), latency.Count)
+
 	queue.Done("A")
 	val, err = te.GetInt64CounterValue(ctx, telemetry.InstrumentWorkersBusyCount.Name(), &attribsWT)
 	require.NoError(t, err)
 	assert.Equal(t, int64(0), val)
+	duration, err := te.GetFloat64HistogramData(ctx, telemetry.InstrumentQueueDuration.Name(), &attribsQN)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), duration.Count)
 }
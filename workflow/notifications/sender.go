@@ -0,0 +1,168 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/util"
+	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
+)
+
+// Message is a notification of a workflow's phase change
+type Message struct {
+	Workflow Workflow    `json:"workflow"`
+	Phase    string      `json:"phase"`
+	Message  string      `json:"message,omitempty"`
+	Time     metav1.Time `json:"time"`
+}
+
+type Workflow struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	UID       string `json:"uid"`
+}
+
+// Title summarizes the message, e.g. as the subject of an email
+func (m Message) Title() string {
+	return fmt.Sprintf("Workflow %s/%s %s", m.Workflow.Namespace, m.Workflow.Name, m.Phase)
+}
+
+// Text is the message as plain text, for channels that are read by people
+func (m Message) Text() string {
+	text := m.Title()
+	if m.Message != "" {
+		text += ": " + m.Message
+	}
+	return text
+}
+
+// Sender sends notifications to the configured channels.
+// Send retries with a backoff, and returns an error if the notification could not be sent.
+type Sender interface {
+	Send(ctx context.Context, channel string, m Message) error
+}
+
+// DefaultBackoff is how Send retries
+var DefaultBackoff = wait.Backoff{Duration: time.Second, Factor: 2, Steps: 3}
+
+type sendMailFunc func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+
+type sender struct {
+	kubeclientset kubernetes.Interface
+	namespace     string
+	channels      map[string]config.NotificationChannel
+	client        *http.Client
+	backoff       wait.Backoff
+	sendMail      sendMailFunc
+}
+
+// NewSender creates a sender for the config, reading any secrets from the namespace each time it sends
+func NewSender(kubeclientset kubernetes.Interface, namespace string, c *config.Notifications) Sender {
+	s := &sender{
+		kubeclientset: kubeclientset,
+		namespace:     namespace,
+		client:        &http.Client{Timeout: 10 * time.Second},
+		backoff:       DefaultBackoff,
+		sendMail:      smtp.SendMail,
+	}
+	if c != nil {
+		s.channels = c.Channels
+	}
+	return s
+}
+
+func (s *sender) Send(ctx context.Context, channel string, m Message) error {
+	c, ok := s.channels[channel]
+	if !ok {
+		return fmt.Errorf("notification channel %q is not configured", channel)
+	}
+	return waitutil.Backoff(s.backoff, func() (bool, error) {
+		err := s.send(ctx, c, m)
+		return err == nil, err
+	})
+}
+
+func (s *sender) send(ctx context.Context, c config.NotificationChannel, m Message) error {
+	switch {
+	case c.Slack != nil:
+		url, err := s.getSecret(ctx, &c.Slack.URLSecret)
+		if err != nil {
+			return err
+		}
+		return s.post(ctx, url, "", map[string]string{"text": m.Text()})
+	case c.Email != nil:
+		return s.email(ctx, c.Email, m)
+	case c.Webhook != nil:
+		authorization := ""
+		if c.Webhook.AuthorizationSecret != nil {
+			var err error
+			authorization, err = s.getSecret(ctx, c.Webhook.AuthorizationSecret)
+			if err != nil {
+				return err
+			}
+		}
+		return s.post(ctx, c.Webhook.URL, authorization, m)
+	default:
+		return fmt.Errorf("notification channel must have one of slack, email or webhook")
+	}
+}
+
+func (s *sender) getSecret(ctx context.Context, selector *apiv1.SecretKeySelector) (string, error) {
+	data, err := util.GetSecrets(ctx, s.kubeclientset, s.namespace, selector.Name, selector.Key)
+	return strings.TrimSpace(string(data)), err
+}
+
+func (s *sender) post(ctx context.Context, url, authorization string, body any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("notification was not accepted: %s", resp.Status)
+	}
+	return nil
+}
+
+func (s *sender) email(ctx context.Context, c *config.EmailNotificationChannel, m Message) error {
+	var auth smtp.Auth
+	if c.UsernameSecret != nil && c.PasswordSecret != nil {
+		username, err := s.getSecret(ctx, c.UsernameSecret)
+		if err != nil {
+			return err
+		}
+		password, err := s.getSecret(ctx, c.PasswordSecret)
+		if err != nil {
+			return err
+		}
+		auth = smtp.PlainAuth("", username, password, c.Host)
+	}
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s\r\n", c.From, strings.Join(c.To, ", "), m.Title(), m.Text())
+	return s.sendMail(net.JoinHostPort(c.Host, strconv.Itoa(c.GetPort())), auth, c.From, c.To, []byte(msg))
+}
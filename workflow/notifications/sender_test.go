@@ -0,0 +1,93 @@
+package notifications
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func TestSender(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	m := Message{
+		Workflow: Workflow{Namespace: "argo", Name: "my-wf", UID: "my-uid"},
+		Phase:    "Failed",
+		Message:  "child 'a' failed",
+	}
+	var bodies []string
+	var authorizations []string
+	failures := 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(data))
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+	}))
+	defer server.Close()
+	kube := fake.NewSimpleClientset(&apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "notifications", Namespace: "argo"},
+		Data:       map[string][]byte{"slack-url": []byte(server.URL + "\n"), "token": []byte("Bearer my-token")},
+	})
+	secret := func(key string) *apiv1.SecretKeySelector {
+		return &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "notifications"}, Key: key}
+	}
+	var mail []string
+	s := NewSender(kube, "argo", &config.Notifications{Channels: map[string]config.NotificationChannel{
+		"slack":   {Slack: &config.SlackNotificationChannel{URLSecret: *secret("slack-url")}},
+		"webhook": {Webhook: &config.WebhookNotificationChannel{URL: server.URL, AuthorizationSecret: secret("token")}},
+		"email":   {Email: &config.EmailNotificationChannel{Host: "smtp", From: "argo@example.com", To: []string{"team@example.com"}}},
+	}}).(*sender)
+	s.backoff = wait.Backoff{Steps: 2}
+	s.sendMail = func(addr string, _ smtp.Auth, from string, to []string, msg []byte) error {
+		mail = append(mail, addr, from, to[0], string(msg))
+		return nil
+	}
+
+	t.Run("Webhook", func(t *testing.T) {
+		require.NoError(t, s.Send(ctx, "webhook", m))
+		require.Len(t, bodies, 1)
+		var got Message
+		require.NoError(t, json.Unmarshal([]byte(bodies[0]), &got))
+		assert.Equal(t, m.Workflow, got.Workflow)
+		assert.Equal(t, "Failed", got.Phase)
+		assert.Equal(t, "Bearer my-token", authorizations[0])
+	})
+	t.Run("Slack", func(t *testing.T) {
+		require.NoError(t, s.Send(ctx, "slack", m))
+		require.Len(t, bodies, 2)
+		assert.JSONEq(t, `{"text":"Workflow argo/my-wf Failed: child 'a' failed"}`, bodies[1])
+		assert.Empty(t, authorizations[1])
+	})
+	t.Run("Email", func(t *testing.T) {
+		require.NoError(t, s.Send(ctx, "email", m))
+		require.Len(t, mail, 4)
+		assert.Equal(t, "smtp:587", mail[0])
+		assert.Equal(t, "argo@example.com", mail[1])
+		assert.Equal(t, "team@example.com", mail[2])
+		assert.Contains(t, mail[3], "Subject: Workflow argo/my-wf Failed\r\n")
+	})
+	t.Run("Retries", func(t *testing.T) {
+		failures = 2
+		require.Error(t, s.Send(ctx, "webhook", m))
+		assert.Len(t, bodies, 2)
+	})
+	t.Run("NotConfigured", func(t *testing.T) {
+		require.EqualError(t, s.Send(ctx, "pager", m), `notification channel "pager" is not configured`)
+	})
+}
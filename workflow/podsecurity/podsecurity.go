@@ -0,0 +1,241 @@
+// Package podsecurity hardens pods to satisfy the "restricted" Pod Security Standard, and checks pods and workflows
+// against it: https://kubernetes.io/docs/concepts/security/pod-security-standards/
+package podsecurity
+
+import (
+	"fmt"
+	"slices"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// Restricted is the name of the "restricted" Pod Security Standard
+const Restricted = "restricted"
+
+// Harden sets the security context of the pod and its containers to satisfy the restricted standard, where they are not
+// set. Settings that are set are left as they are, and checked by Check.
+func Harden(spec *apiv1.PodSpec) {
+	if spec.SecurityContext == nil {
+		spec.SecurityContext = &apiv1.PodSecurityContext{}
+	}
+	sc := spec.SecurityContext
+	if sc.RunAsNonRoot == nil {
+		sc.RunAsNonRoot = ptr.To(true)
+	}
+	if sc.SeccompProfile == nil {
+		sc.SeccompProfile = &apiv1.SeccompProfile{Type: apiv1.SeccompProfileTypeRuntimeDefault}
+	}
+	for _, containers := range [][]apiv1.Container{spec.InitContainers, spec.Containers} {
+		for i := range containers {
+			hardenContainer(&containers[i])
+		}
+	}
+}
+
+func hardenContainer(c *apiv1.Container) {
+	if c.SecurityContext == nil {
+		c.SecurityContext = &apiv1.SecurityContext{}
+	}
+	sc := c.SecurityContext
+	if sc.AllowPrivilegeEscalation == nil {
+		sc.AllowPrivilegeEscalation = ptr.To(false)
+	}
+	if sc.Capabilities == nil {
+		sc.Capabilities = &apiv1.Capabilities{}
+	}
+	if !slices.Contains(sc.Capabilities.Drop, "ALL") {
+		sc.Capabilities.Drop = append(sc.Capabilities.Drop, "ALL")
+	}
+}
+
+// Check returns an error for the first setting of the pod that violates the restricted standard
+func Check(spec *apiv1.PodSpec) error {
+	if err := checkPod(spec); err != nil {
+		return err
+	}
+	for _, containers := range [][]apiv1.Container{spec.InitContainers, spec.Containers} {
+		for _, c := range containers {
+			if err := checkContainer(c); err != nil {
+				return fmt.Errorf("container %q: %w", c.Name, err)
+			}
+			if err := checkRequired(spec.SecurityContext, c.SecurityContext); err != nil {
+				return fmt.Errorf("container %q: %w", c.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// CheckWorkflowSpec returns an error for the first setting of the workflow that violates the restricted standard.
+// Settings that are not set are not errors, as the pods of the workflow are hardened.
+func CheckWorkflowSpec(spec *wfv1.WorkflowSpec) error {
+	if err := checkPod(&apiv1.PodSpec{SecurityContext: spec.SecurityContext, HostNetwork: spec.HostNetwork != nil && *spec.HostNetwork, Volumes: spec.Volumes}); err != nil {
+		return err
+	}
+	for _, tmpl := range spec.Templates {
+		if err := CheckTemplate(&tmpl); err != nil {
+			return fmt.Errorf("templates.%s: %w", tmpl.Name, err)
+		}
+	}
+	return nil
+}
+
+// CheckTemplate returns an error for the first setting of the template that violates the restricted standard
+func CheckTemplate(tmpl *wfv1.Template) error {
+	if err := checkPod(&apiv1.PodSpec{SecurityContext: tmpl.SecurityContext, Volumes: tmpl.Volumes}); err != nil {
+		return err
+	}
+	var containers []apiv1.Container
+	if tmpl.Container != nil {
+		containers = append(containers, *tmpl.Container)
+	}
+	if tmpl.Script != nil {
+		containers = append(containers, tmpl.Script.Container)
+	}
+	if tmpl.ContainerSet != nil {
+		for _, c := range tmpl.ContainerSet.Containers {
+			containers = append(containers, c.Container)
+		}
+	}
+	for _, c := range tmpl.InitContainers {
+		containers = append(containers, c.Container)
+	}
+	for _, c := range tmpl.Sidecars {
+		containers = append(containers, c.Container)
+	}
+	for _, c := range containers {
+		if err := checkContainer(c); err != nil {
+			if c.Name == "" {
+				return err
+			}
+			return fmt.Errorf("container %q: %w", c.Name, err)
+		}
+	}
+	return nil
+}
+
+var allowedVolumes = []string{"configMap", "csi", "downwardAPI", "emptyDir", "ephemeral", "persistentVolumeClaim", "projected", "secret"}
+
+func volumeType(v apiv1.Volume) string {
+	switch s := v.VolumeSource; {
+	case s.ConfigMap != nil:
+		return "configMap"
+	case s.CSI != nil:
+		return "csi"
+	case s.DownwardAPI != nil:
+		return "downwardAPI"
+	case s.EmptyDir != nil:
+		return "emptyDir"
+	case s.Ephemeral != nil:
+		return "ephemeral"
+	case s.PersistentVolumeClaim != nil:
+		return "persistentVolumeClaim"
+	case s.Projected != nil:
+		return "projected"
+	case s.Secret != nil:
+		return "secret"
+	case s.HostPath != nil:
+		return "hostPath"
+	default:
+		return "other"
+	}
+}
+
+// checkPod checks the settings of the pod that are not of its containers
+func checkPod(spec *apiv1.PodSpec) error {
+	if spec.HostNetwork {
+		return fmt.Errorf("hostNetwork must not be true")
+	}
+	if spec.HostPID {
+		return fmt.Errorf("hostPID must not be true")
+	}
+	if spec.HostIPC {
+		return fmt.Errorf("hostIPC must not be true")
+	}
+	for _, v := range spec.Volumes {
+		if t := volumeType(v); !slices.Contains(allowedVolumes, t) {
+			return fmt.Errorf("volumes.%s: %s volumes are not allowed", v.Name, t)
+		}
+	}
+	if sc := spec.SecurityContext; sc != nil {
+		if sc.RunAsNonRoot != nil && !*sc.RunAsNonRoot {
+			return fmt.Errorf("securityContext.runAsNonRoot must not be false")
+		}
+		if sc.RunAsUser != nil && *sc.RunAsUser == 0 {
+			return fmt.Errorf("securityContext.runAsUser must not be 0")
+		}
+		if err := checkSeccompProfile(sc.SeccompProfile); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkContainer checks the settings of the container that are set
+func checkContainer(c apiv1.Container) error {
+	for _, p := range c.Ports {
+		if p.HostPort != 0 {
+			return fmt.Errorf("ports.hostPort must not be set")
+		}
+	}
+	sc := c.SecurityContext
+	if sc == nil {
+		return nil
+	}
+	if sc.Privileged != nil && *sc.Privileged {
+		return fmt.Errorf("securityContext.privileged must not be true")
+	}
+	if sc.AllowPrivilegeEscalation != nil && *sc.AllowPrivilegeEscalation {
+		return fmt.Errorf("securityContext.allowPrivilegeEscalation must not be true")
+	}
+	if sc.RunAsNonRoot != nil && !*sc.RunAsNonRoot {
+		return fmt.Errorf("securityContext.runAsNonRoot must not be false")
+	}
+	if sc.RunAsUser != nil && *sc.RunAsUser == 0 {
+		return fmt.Errorf("securityContext.runAsUser must not be 0")
+	}
+	if err := checkSeccompProfile(sc.SeccompProfile); err != nil {
+		return err
+	}
+	if sc.Capabilities != nil {
+		for _, capability := range sc.Capabilities.Add {
+			if capability != "NET_BIND_SERVICE" {
+				return fmt.Errorf("securityContext.capabilities.add must only add NET_BIND_SERVICE, not %s", capability)
+			}
+		}
+	}
+	return nil
+}
+
+// checkRequired checks the settings of the container that must be set, on the container or the pod
+func checkRequired(podSC *apiv1.PodSecurityContext, sc *apiv1.SecurityContext) error {
+	if sc == nil {
+		sc = &apiv1.SecurityContext{}
+	}
+	if podSC == nil {
+		podSC = &apiv1.PodSecurityContext{}
+	}
+	if sc.AllowPrivilegeEscalation == nil {
+		return fmt.Errorf("securityContext.allowPrivilegeEscalation must be false")
+	}
+	if (sc.RunAsNonRoot == nil || !*sc.RunAsNonRoot) && (podSC.RunAsNonRoot == nil || !*podSC.RunAsNonRoot) {
+		return fmt.Errorf("securityContext.runAsNonRoot must be true")
+	}
+	if sc.SeccompProfile == nil && podSC.SeccompProfile == nil {
+		return fmt.Errorf("securityContext.seccompProfile.type must be RuntimeDefault or Localhost")
+	}
+	if sc.Capabilities == nil || !slices.Contains(sc.Capabilities.Drop, "ALL") {
+		return fmt.Errorf("securityContext.capabilities.drop must include ALL")
+	}
+	return nil
+}
+
+func checkSeccompProfile(p *apiv1.SeccompProfile) error {
+	if p != nil && p.Type != apiv1.SeccompProfileTypeRuntimeDefault && p.Type != apiv1.SeccompProfileTypeLocalhost {
+		return fmt.Errorf("securityContext.seccompProfile.type must be RuntimeDefault or Localhost, not %s", p.Type)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package podsecurity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestHarden(t *testing.T) {
+	spec := &apiv1.PodSpec{
+		InitContainers: []apiv1.Container{{Name: "init"}},
+		Containers: []apiv1.Container{
+			{Name: "wait"},
+			{Name: "main", SecurityContext: &apiv1.SecurityContext{Capabilities: &apiv1.Capabilities{Add: []apiv1.Capability{"NET_BIND_SERVICE"}}}},
+		},
+	}
+	require.EqualError(t, Check(spec), `container "init": securityContext.allowPrivilegeEscalation must be false`)
+	Harden(spec)
+	require.NoError(t, Check(spec))
+	assert.True(t, *spec.SecurityContext.RunAsNonRoot)
+	assert.Equal(t, apiv1.SeccompProfileTypeRuntimeDefault, spec.SecurityContext.SeccompProfile.Type)
+	assert.False(t, *spec.InitContainers[0].SecurityContext.AllowPrivilegeEscalation)
+	assert.Equal(t, []apiv1.Capability{"ALL"}, spec.Containers[0].SecurityContext.Capabilities.Drop)
+	assert.Equal(t, []apiv1.Capability{"NET_BIND_SERVICE"}, spec.Containers[1].SecurityContext.Capabilities.Add)
+
+	t.Run("Set", func(t *testing.T) {
+		spec := &apiv1.PodSpec{
+			SecurityContext: &apiv1.PodSecurityContext{RunAsNonRoot: ptr.To(false)},
+			Containers:      []apiv1.Container{{Name: "main", SecurityContext: &apiv1.SecurityContext{Privileged: ptr.To(true)}}},
+		}
+		Harden(spec)
+		require.EqualError(t, Check(spec), "securityContext.runAsNonRoot must not be false")
+		spec.SecurityContext.RunAsNonRoot = nil
+		Harden(spec)
+		require.EqualError(t, Check(spec), `container "main": securityContext.privileged must not be true`)
+	})
+}
+
+func TestCheckWorkflowSpec(t *testing.T) {
+	for name, tt := range map[string]struct {
+		spec wfv1.WorkflowSpec
+		err  string
+	}{
+		"NotSet": {
+			spec: wfv1.WorkflowSpec{Templates: []wfv1.Template{{Name: "main", Container: &apiv1.Container{}}}},
+		},
+		"HostNetwork": {
+			spec: wfv1.WorkflowSpec{HostNetwork: ptr.To(true)},
+			err:  "hostNetwork must not be true",
+		},
+		"HostPath": {
+			spec: wfv1.WorkflowSpec{Volumes: []apiv1.Volume{{Name: "docker", VolumeSource: apiv1.VolumeSource{HostPath: &apiv1.HostPathVolumeSource{Path: "/var/run/docker.sock"}}}}},
+			err:  "volumes.docker: hostPath volumes are not allowed",
+		},
+		"RunAsRoot": {
+			spec: wfv1.WorkflowSpec{SecurityContext: &apiv1.PodSecurityContext{RunAsUser: ptr.To(int64(0))}},
+			err:  "securityContext.runAsUser must not be 0",
+		},
+		"Unconfined": {
+			spec: wfv1.WorkflowSpec{Templates: []wfv1.Template{{Name: "main", SecurityContext: &apiv1.PodSecurityContext{SeccompProfile: &apiv1.SeccompProfile{Type: apiv1.SeccompProfileTypeUnconfined}}}}},
+			err:  "templates.main: securityContext.seccompProfile.type must be RuntimeDefault or Localhost, not Unconfined",
+		},
+		"Capabilities": {
+			spec: wfv1.WorkflowSpec{Templates: []wfv1.Template{{Name: "main", Script: &wfv1.ScriptTemplate{Container: apiv1.Container{SecurityContext: &apiv1.SecurityContext{Capabilities: &apiv1.Capabilities{Add: []apiv1.Capability{"SYS_ADMIN"}}}}}}}},
+			err:  "templates.main: securityContext.capabilities.add must only add NET_BIND_SERVICE, not SYS_ADMIN",
+		},
+		"Sidecar": {
+			spec: wfv1.WorkflowSpec{Templates: []wfv1.Template{{Name: "main", Sidecars: []wfv1.UserContainer{{Container: apiv1.Container{Name: "dind", SecurityContext: &apiv1.SecurityContext{Privileged: ptr.To(true)}}}}}}},
+			err:  `templates.main: container "dind": securityContext.privileged must not be true`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := CheckWorkflowSpec(&tt.spec)
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
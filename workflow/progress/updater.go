@@ -31,8 +31,8 @@ func UpdateProgress(ctx context.Context, wf *wfv1.Workflow) {
 			node.Progress = node.Progress.Complete()
 			wf.Status.Nodes.Set(ctx, nodeID, node)
 		}
-		// the total should only contain node that are valid
-		wf.Status.Progress = wf.Status.Progress.Add(node.Progress)
+		// the total should only contain node that are valid, each counting as much as its weight
+		wf.Status.Progress = wf.Status.Progress.Add(node.Progress.Weighted(node.ProgressWeight))
 	}
 	// For non-executable nodes, we sum up the children.
 	// It is quite possible for a succeeded node to contain failed children (e.g. continues-on failed flag is set)
@@ -80,7 +80,7 @@ func sumProgress(ctx context.Context, wf *wfv1.Workflow, node wfv1.NodeStatus, v
 		if executable(child.Type) {
 			v := child.Progress
 			if v.IsValid() {
-				progress = progress.Add(v)
+				progress = progress.Add(v.Weighted(child.ProgressWeight))
 			}
 		}
 	}
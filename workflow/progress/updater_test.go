@@ -38,6 +38,28 @@ func TestUpdater(t *testing.T) {
 	assert.Equal(t, wfv1.Progress("101/202"), wf.Status.Progress, "wf is sum total")
 }
 
+func TestUpdaterWeighted(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "wf"},
+		Status: wfv1.WorkflowStatus{
+			Nodes: wfv1.Nodes{
+				"setup": wfv1.NodeStatus{Phase: wfv1.NodeSucceeded, Type: wfv1.NodeTypePod, ProgressWeight: 1},
+				"train": wfv1.NodeStatus{Phase: wfv1.NodeRunning, Type: wfv1.NodeTypePod, Progress: wfv1.Progress("1/4"), ProgressWeight: 100},
+				"dag":   wfv1.NodeStatus{Children: []string{"setup", "train"}},
+			},
+		},
+	}
+
+	UpdateProgress(ctx, wf)
+
+	nodes := wf.Status.Nodes
+	assert.Equal(t, wfv1.Progress("1/1"), nodes["setup"].Progress, "node progress is not weighted")
+	assert.Equal(t, wfv1.Progress("1/4"), nodes["train"].Progress, "node progress is not weighted")
+	assert.Equal(t, wfv1.Progress("101/401"), nodes["dag"].Progress, "dag is the weighted sum")
+	assert.Equal(t, wfv1.Progress("101/401"), wf.Status.Progress, "wf is the weighted sum total")
+}
+
 func Test_executes(t *testing.T) {
 	assert.False(t, executable(""))
 	assert.True(t, executable(wfv1.NodeTypePod))
@@ -0,0 +1,189 @@
+// Package sensitive encrypts the values of the sensitive parameters of workflows, so that they cannot be read from the
+// status of the workflow, the archive or the API, while the controller can still use them.
+package sensitive
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// Mask is shown instead of the values of sensitive parameters
+const Mask = "******"
+
+const prefix = "encrypted:v1:"
+
+// IsEncrypted returns whether the value has been encrypted
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+// Encrypter encrypts and decrypts values with AES-GCM. A value it decrypted is encrypted to the same ciphertext again,
+// so that values that do not change do not change the workflow.
+type Encrypter struct {
+	aead        cipher.AEAD
+	ciphertexts map[string]string // plaintext -> ciphertext
+}
+
+// NewEncrypter returns an Encrypter with an AES-256 key derived from the key, which may be of any length
+func NewEncrypter(key []byte) (*Encrypter, error) {
+	k := sha256.Sum256(key)
+	block, err := aes.NewCipher(k[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Encrypter{aead: aead, ciphertexts: map[string]string{}}, nil
+}
+
+func (e *Encrypter) Encrypt(plaintext string) (string, error) {
+	if ciphertext, ok := e.ciphertexts[plaintext]; ok {
+		return ciphertext, nil
+	}
+	nonce := make([]byte, e.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	ciphertext := prefix + base64.StdEncoding.EncodeToString(e.aead.Seal(nonce, nonce, []byte(plaintext), nil))
+	e.ciphertexts[plaintext] = ciphertext
+	return ciphertext, nil
+}
+
+func (e *Encrypter) Decrypt(ciphertext string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(ciphertext, prefix))
+	if err != nil {
+		return "", err
+	}
+	n := e.aead.NonceSize()
+	if len(data) < n {
+		return "", fmt.Errorf("ciphertext is too short")
+	}
+	plaintext, err := e.aead.Open(nil, data[:n], data[n:], nil)
+	if err != nil {
+		return "", fmt.Errorf("%w, has the encryption key changed?", err)
+	}
+	e.ciphertexts[string(plaintext)] = ciphertext
+	return string(plaintext), nil
+}
+
+// EncryptWorkflow encrypts the values of the sensitive parameters of the workflow that are not encrypted
+func (e *Encrypter) EncryptWorkflow(wf *wfv1.Workflow) error {
+	return Parameters(wf, func(p *wfv1.Parameter) error {
+		if IsEncrypted(p.Value.String()) {
+			return nil
+		}
+		ciphertext, err := e.Encrypt(p.Value.String())
+		if err != nil {
+			return fmt.Errorf("failed to encrypt parameter %s: %w", p.Name, err)
+		}
+		p.Value = wfv1.AnyStringPtr(ciphertext)
+		return nil
+	})
+}
+
+// DecryptWorkflow decrypts the values of the sensitive parameters of the workflow that are encrypted
+func (e *Encrypter) DecryptWorkflow(wf *wfv1.Workflow) error {
+	return Parameters(wf, func(p *wfv1.Parameter) error {
+		if !IsEncrypted(p.Value.String()) {
+			return nil
+		}
+		plaintext, err := e.Decrypt(p.Value.String())
+		if err != nil {
+			return fmt.Errorf("failed to decrypt parameter %s: %w", p.Name, err)
+		}
+		p.Value = wfv1.AnyStringPtr(plaintext)
+		return nil
+	})
+}
+
+// HasPlaintext returns whether any sensitive parameter of the workflow has a value that is not encrypted
+func HasPlaintext(wf *wfv1.Workflow) bool {
+	found := false
+	_ = Parameters(wf, func(p *wfv1.Parameter) error {
+		found = found || !IsEncrypted(p.Value.String())
+		return nil
+	})
+	return found
+}
+
+// HasEncrypted returns whether any sensitive parameter of the workflow has a value that is encrypted
+func HasEncrypted(wf *wfv1.Workflow) bool {
+	found := false
+	_ = Parameters(wf, func(p *wfv1.Parameter) error {
+		found = found || IsEncrypted(p.Value.String())
+		return nil
+	})
+	return found
+}
+
+// MaskWorkflow replaces the values of the sensitive parameters of the workflow with the Mask
+func MaskWorkflow(wf *wfv1.Workflow) {
+	_ = Parameters(wf, func(p *wfv1.Parameter) error {
+		p.Value = wfv1.AnyStringPtr(Mask)
+		return nil
+	})
+}
+
+// Parameters calls f with each sensitive parameter with a value that the workflow stores: its arguments and those of
+// its stored spec, its outputs, and the inputs and outputs of its nodes. An argument is sensitive if it is marked as
+// sensitive in either the spec or the stored spec, e.g. by the WorkflowTemplate of the workflow, or it is encrypted.
+func Parameters(wf *wfv1.Workflow, f func(p *wfv1.Parameter) error) error {
+	arguments := map[string]bool{}
+	specs := []*wfv1.WorkflowSpec{&wf.Spec}
+	if wf.Status.StoredWorkflowSpec != nil {
+		specs = append(specs, wf.Status.StoredWorkflowSpec)
+	}
+	for _, spec := range specs {
+		for _, p := range spec.Arguments.Parameters {
+			if p.Sensitive {
+				arguments[p.Name] = true
+			}
+		}
+	}
+	visit := func(parameters []wfv1.Parameter, sensitive func(p wfv1.Parameter) bool) error {
+		for i, p := range parameters {
+			if p.Value == nil || !sensitive(p) {
+				continue
+			}
+			if err := f(&parameters[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// the arguments of a resubmitted workflow are those of the original workflow, which may be encrypted
+	isArgument := func(p wfv1.Parameter) bool { return arguments[p.Name] || IsEncrypted(p.Value.String()) }
+	isSensitive := func(p wfv1.Parameter) bool { return p.Sensitive }
+	for _, spec := range specs {
+		if err := visit(spec.Arguments.Parameters, isArgument); err != nil {
+			return err
+		}
+	}
+	if wf.Status.Outputs != nil {
+		if err := visit(wf.Status.Outputs.Parameters, isSensitive); err != nil {
+			return err
+		}
+	}
+	for _, node := range wf.Status.Nodes {
+		if node.Inputs != nil {
+			if err := visit(node.Inputs.Parameters, isSensitive); err != nil {
+				return err
+			}
+		}
+		if node.Outputs != nil {
+			if err := visit(node.Outputs.Parameters, isSensitive); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package sensitive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestEncrypter(t *testing.T) {
+	e, err := NewEncrypter([]byte("my-key"))
+	require.NoError(t, err)
+	ciphertext, err := e.Encrypt("my-secret")
+	require.NoError(t, err)
+	assert.True(t, IsEncrypted(ciphertext))
+	assert.NotContains(t, ciphertext, "my-secret")
+	t.Run("Stable", func(t *testing.T) {
+		again, err := e.Encrypt("my-secret")
+		require.NoError(t, err)
+		assert.Equal(t, ciphertext, again)
+	})
+	t.Run("Decrypt", func(t *testing.T) {
+		other, err := NewEncrypter([]byte("my-key"))
+		require.NoError(t, err)
+		plaintext, err := other.Decrypt(ciphertext)
+		require.NoError(t, err)
+		assert.Equal(t, "my-secret", plaintext)
+		again, err := other.Encrypt(plaintext)
+		require.NoError(t, err)
+		assert.Equal(t, ciphertext, again, "a decrypted value is encrypted to the same ciphertext")
+	})
+	t.Run("WrongKey", func(t *testing.T) {
+		other, err := NewEncrypter([]byte("other-key"))
+		require.NoError(t, err)
+		_, err = other.Decrypt(ciphertext)
+		require.ErrorContains(t, err, "has the encryption key changed?")
+	})
+}
+
+func testWorkflow() *wfv1.Workflow {
+	return &wfv1.Workflow{
+		Spec: wfv1.WorkflowSpec{
+			Arguments: wfv1.Arguments{Parameters: []wfv1.Parameter{
+				{Name: "token", Value: wfv1.AnyStringPtr("my-token")},
+				{Name: "message", Value: wfv1.AnyStringPtr("hello")},
+			}},
+		},
+		Status: wfv1.WorkflowStatus{
+			StoredWorkflowSpec: &wfv1.WorkflowSpec{
+				Arguments: wfv1.Arguments{Parameters: []wfv1.Parameter{
+					{Name: "token", Value: wfv1.AnyStringPtr("my-token"), Sensitive: true},
+				}},
+			},
+			Outputs: &wfv1.Outputs{Parameters: []wfv1.Parameter{
+				{Name: "session", Value: wfv1.AnyStringPtr("my-session"), Sensitive: true},
+			}},
+			Nodes: wfv1.Nodes{
+				"my-node": {
+					Inputs:  &wfv1.Inputs{Parameters: []wfv1.Parameter{{Name: "token", Value: wfv1.AnyStringPtr("my-token"), Sensitive: true}}},
+					Outputs: &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "result", Value: wfv1.AnyStringPtr("ok")}}},
+				},
+			},
+		},
+	}
+}
+
+func TestWorkflow(t *testing.T) {
+	wf := testWorkflow()
+	e, err := NewEncrypter([]byte("my-key"))
+	require.NoError(t, err)
+	assert.True(t, HasPlaintext(wf))
+	assert.False(t, HasEncrypted(wf))
+
+	require.NoError(t, e.EncryptWorkflow(wf))
+	assert.False(t, HasPlaintext(wf))
+	assert.True(t, HasEncrypted(wf))
+	assert.True(t, IsEncrypted(wf.Spec.Arguments.Parameters[0].Value.String()), "flagged by the stored spec")
+	assert.Equal(t, "hello", wf.Spec.Arguments.Parameters[1].Value.String())
+	assert.True(t, IsEncrypted(wf.Status.StoredWorkflowSpec.Arguments.Parameters[0].Value.String()))
+	assert.True(t, IsEncrypted(wf.Status.Outputs.Parameters[0].Value.String()))
+	assert.True(t, IsEncrypted(wf.Status.Nodes["my-node"].Inputs.Parameters[0].Value.String()))
+	assert.Equal(t, "ok", wf.Status.Nodes["my-node"].Outputs.Parameters[0].Value.String())
+
+	require.NoError(t, e.DecryptWorkflow(wf))
+	assert.Equal(t, testWorkflow(), wf)
+}
+
+func TestMaskWorkflow(t *testing.T) {
+	wf := testWorkflow()
+	MaskWorkflow(wf)
+	assert.Equal(t, Mask, wf.Spec.Arguments.Parameters[0].Value.String())
+	assert.Equal(t, "hello", wf.Spec.Arguments.Parameters[1].Value.String())
+	assert.Equal(t, Mask, wf.Status.Outputs.Parameters[0].Value.String())
+	assert.Equal(t, Mask, wf.Status.Nodes["my-node"].Inputs.Parameters[0].Value.String())
+	assert.False(t, HasEncrypted(wf))
+}
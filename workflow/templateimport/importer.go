@@ -0,0 +1,190 @@
+// Package templateimport imports WorkflowTemplates published as artifacts to OCI registries.
+//
+// The artifact must have a layer of media type MediaType, containing the YAML or JSON of the WorkflowTemplate, e.g.
+// as pushed by `oras push ghcr.io/my-org/ci-templates:v1 template.yaml:application/vnd.argoproj.workflow.template.v1+yaml`.
+package templateimport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/authn/k8schain"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/lru"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// MediaType is the media type of the layer containing the WorkflowTemplate
+const MediaType types.MediaType = "application/vnd.argoproj.workflow.template.v1+yaml"
+
+type Interface interface {
+	// Import returns the WorkflowTemplate of the import, pulled with the credentials of the options
+	Import(ctx context.Context, imp wfv1.TemplateImport, options Options) (*wfv1.WorkflowTemplate, error)
+}
+
+// Options are the credentials to pull the artifacts with, those of the service account of the workflow
+type Options struct {
+	Namespace          string
+	ServiceAccountName string
+	ImagePullSecrets   []apiv1.LocalObjectReference
+}
+
+type keychainFunc func(ctx context.Context, options Options) (authn.Keychain, error)
+
+type importer struct {
+	config    *config.TemplateImports
+	verifier  verifier
+	keychain  keychainFunc
+	remote    []remote.Option
+	mu        sync.Mutex
+	templates *lru.Cache // digest reference -> *wfv1.WorkflowTemplate, which are immutable
+	tags      *lru.Cache // tag reference -> taggedDigest
+}
+
+type taggedDigest struct {
+	digest  string
+	expires time.Time
+}
+
+func New(kubernetesClient kubernetes.Interface, config *config.TemplateImports) (Interface, error) {
+	v, err := newVerifier(config)
+	if err != nil {
+		return nil, err
+	}
+	return newImporter(config, v, func(ctx context.Context, options Options) (authn.Keychain, error) {
+		return k8schain.New(ctx, kubernetesClient, k8schain.Options{
+			Namespace:          options.Namespace,
+			ServiceAccountName: options.ServiceAccountName,
+			ImagePullSecrets:   imagePullSecretNames(options.ImagePullSecrets),
+		})
+	}), nil
+}
+
+func newImporter(config *config.TemplateImports, v verifier, keychain keychainFunc, options ...remote.Option) *importer {
+	return &importer{
+		config:    config,
+		verifier:  v,
+		keychain:  keychain,
+		remote:    options,
+		templates: lru.New(256),
+		tags:      lru.New(256),
+	}
+}
+
+func (i *importer) Import(ctx context.Context, imp wfv1.TemplateImport, options Options) (*wfv1.WorkflowTemplate, error) {
+	if !i.config.AllowsRegistry(imp.Registry) {
+		return nil, fmt.Errorf("template import %q: templates cannot be imported from registry %q", imp.Name, imp.Registry)
+	}
+	kc, err := i.keychain(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	remoteOptions := append([]remote.Option{remote.WithContext(ctx), remote.WithAuthFromKeychain(kc)}, i.remote...)
+	repo, err := name.NewRepository(imp.Registry + "/" + imp.Repository)
+	if err != nil {
+		return nil, fmt.Errorf("template import %q: %w", imp.Name, err)
+	}
+	digest := imp.Digest
+	if digest == "" {
+		digest, err = i.resolveTag(ctx, repo.Tag(imp.Tag), options.Namespace, remoteOptions)
+		if err != nil {
+			return nil, fmt.Errorf("template import %q: failed to resolve tag %q: %w", imp.Name, imp.Tag, err)
+		}
+	}
+	ref := repo.Digest(digest)
+	// credentials differ between namespaces, so the caches do too
+	key := options.Namespace + "/" + ref.String()
+	i.mu.Lock()
+	v, ok := i.templates.Get(key)
+	i.mu.Unlock()
+	if ok {
+		return v.(*wfv1.WorkflowTemplate), nil
+	}
+	logging.RequireLoggerFromContext(ctx).WithField("ref", ref.String()).Info(ctx, "Importing template")
+	tmpl, err := i.pull(ctx, ref, remoteOptions)
+	if err != nil {
+		return nil, fmt.Errorf("template import %q: %w", imp.Name, err)
+	}
+	i.mu.Lock()
+	i.templates.Add(key, tmpl)
+	i.mu.Unlock()
+	return tmpl, nil
+}
+
+func (i *importer) resolveTag(ctx context.Context, tag name.Tag, namespace string, options []remote.Option) (string, error) {
+	key := namespace + "/" + tag.String()
+	i.mu.Lock()
+	v, ok := i.tags.Get(key)
+	i.mu.Unlock()
+	if ok && time.Now().Before(v.(taggedDigest).expires) {
+		return v.(taggedDigest).digest, nil
+	}
+	desc, err := remote.Head(tag, options...)
+	if err != nil {
+		return "", err
+	}
+	digest := desc.Digest.String()
+	i.mu.Lock()
+	i.tags.Add(key, taggedDigest{digest: digest, expires: time.Now().Add(i.config.GetTagTTL())})
+	i.mu.Unlock()
+	return digest, nil
+}
+
+func (i *importer) pull(ctx context.Context, ref name.Digest, options []remote.Option) (*wfv1.WorkflowTemplate, error) {
+	if err := i.verifier.verify(ctx, ref, options); err != nil {
+		return nil, err
+	}
+	img, err := remote.Image(ref, options...)
+	if err != nil {
+		return nil, err
+	}
+	layers, err := img.Layers()
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range layers {
+		mediaType, err := l.MediaType()
+		if err != nil {
+			return nil, err
+		}
+		if mediaType != MediaType {
+			continue
+		}
+		// the layer is not compressed, and reading it verifies its digest
+		r, err := l.Compressed()
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(r)
+		_ = r.Close()
+		if err != nil {
+			return nil, err
+		}
+		tmpl := &wfv1.WorkflowTemplate{}
+		if err := yaml.Unmarshal(data, tmpl); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal the WorkflowTemplate: %w", err)
+		}
+		return tmpl, nil
+	}
+	return nil, fmt.Errorf("artifact %s has no layer of media type %s", ref, MediaType)
+}
+
+func imagePullSecretNames(secrets []apiv1.LocalObjectReference) []string {
+	var v []string
+	for _, s := range secrets {
+		v = append(v, s.Name)
+	}
+	return v
+}
@@ -0,0 +1,205 @@
+package templateimport
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+const templateYAML = `
+apiVersion: argoproj.io/v1alpha1
+kind: WorkflowTemplate
+metadata:
+  name: ci
+spec:
+  templates:
+  - name: build
+    container:
+      image: golang
+`
+
+func anonymous(context.Context, Options) (authn.Keychain, error) {
+	return authn.NewMultiKeychain(), nil
+}
+
+// push pushes the template to the repository of the registry, and returns its digest
+func push(t *testing.T, host, repository, tag, data string) string {
+	t.Helper()
+	img, err := mutate.Append(empty.Image, mutate.Addendum{Layer: static.NewLayer([]byte(data), MediaType)})
+	require.NoError(t, err)
+	ref, err := name.NewTag(fmt.Sprintf("%s/%s:%s", host, repository, tag))
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	return digest.String()
+}
+
+// sign pushes a cosign signature of the digest of the repository signed by the key
+func sign(t *testing.T, host, repository, digest string, key *ecdsa.PrivateKey) {
+	t.Helper()
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"%s/%s"},"image":{"docker-manifest-digest":"%s"},"type":"cosign container image signature"},"optional":null}`, host, repository, digest))
+	h := sha256.Sum256(payload)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, h[:])
+	require.NoError(t, err)
+	img, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer:       static.NewLayer(payload, "application/vnd.dev.cosign.simplesigning.v1+json"),
+		Annotations: map[string]string{signatureAnnotation: base64.StdEncoding.EncodeToString(sig)},
+	})
+	require.NoError(t, err)
+	ref, err := name.NewTag(fmt.Sprintf("%s/%s:%s", host, repository, strings.Replace(digest, ":", "-", 1)+".sig"))
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+}
+
+func publicKeyPEM(t *testing.T, key *ecdsa.PrivateKey) string {
+	t.Helper()
+	data, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: data}))
+}
+
+func newRegistry(t *testing.T) string {
+	t.Helper()
+	s := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(s.Close)
+	return strings.TrimPrefix(s.URL, "http://")
+}
+
+func TestImport(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	host := newRegistry(t)
+	digest := push(t, host, "ci-templates", "v1", templateYAML)
+	options := Options{Namespace: "my-ns"}
+
+	t.Run("Tag", func(t *testing.T) {
+		i := newImporter(nil, nil, anonymous)
+		tmpl, err := i.Import(ctx, wfv1.TemplateImport{Name: "ci", Registry: host, Repository: "ci-templates", Tag: "v1"}, options)
+		require.NoError(t, err)
+		assert.Equal(t, "build", tmpl.Spec.Templates[0].Name)
+	})
+	t.Run("Digest", func(t *testing.T) {
+		i := newImporter(nil, nil, anonymous)
+		tmpl, err := i.Import(ctx, wfv1.TemplateImport{Name: "ci", Registry: host, Repository: "ci-templates", Digest: digest}, options)
+		require.NoError(t, err)
+		assert.Equal(t, "golang", tmpl.Spec.Templates[0].Container.Image)
+	})
+	t.Run("Cached", func(t *testing.T) {
+		i := newImporter(nil, nil, anonymous)
+		imp := wfv1.TemplateImport{Name: "ci", Registry: host, Repository: "cached", Tag: "v1"}
+		push(t, host, "cached", "v1", templateYAML)
+		tmpl, err := i.Import(ctx, imp, options)
+		require.NoError(t, err)
+		// moving the tag has no effect until the resolved digest expires
+		push(t, host, "cached", "v1", strings.Replace(templateYAML, "golang", "alpine", 1))
+		cached, err := i.Import(ctx, imp, options)
+		require.NoError(t, err)
+		assert.Same(t, tmpl, cached)
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		i := newImporter(nil, nil, anonymous)
+		_, err := i.Import(ctx, wfv1.TemplateImport{Name: "ci", Registry: host, Repository: "ci-templates", Tag: "v2"}, options)
+		require.ErrorContains(t, err, `template import "ci": failed to resolve tag "v2"`)
+	})
+	t.Run("NoTemplate", func(t *testing.T) {
+		i := newImporter(nil, nil, anonymous)
+		img, err := mutate.Append(empty.Image, mutate.Addendum{Layer: static.NewLayer([]byte("{}"), "application/json")})
+		require.NoError(t, err)
+		ref, err := name.NewTag(host + "/other:v1")
+		require.NoError(t, err)
+		require.NoError(t, remote.Write(ref, img))
+		_, err = i.Import(ctx, wfv1.TemplateImport{Name: "ci", Registry: host, Repository: "other", Tag: "v1"}, options)
+		require.ErrorContains(t, err, "has no layer of media type "+string(MediaType))
+	})
+	t.Run("RegistryNotAllowed", func(t *testing.T) {
+		i := newImporter(&config.TemplateImports{Registries: []string{"ghcr.io"}}, nil, anonymous)
+		_, err := i.Import(ctx, wfv1.TemplateImport{Name: "ci", Registry: host, Repository: "ci-templates", Tag: "v1"}, options)
+		require.EqualError(t, err, fmt.Sprintf(`template import "ci": templates cannot be imported from registry %q`, host))
+	})
+}
+
+func TestImportSigned(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	host := newRegistry(t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	c := &config.TemplateImports{PublicKeys: []string{publicKeyPEM(t, key)}}
+	v, err := newVerifier(c)
+	require.NoError(t, err)
+	options := Options{Namespace: "my-ns"}
+
+	t.Run("Signed", func(t *testing.T) {
+		digest := push(t, host, "signed", "v1", templateYAML)
+		sign(t, host, "signed", digest, key)
+		_, err := newImporter(c, v, anonymous).Import(ctx, wfv1.TemplateImport{Name: "ci", Registry: host, Repository: "signed", Tag: "v1"}, options)
+		require.NoError(t, err)
+	})
+	t.Run("Unsigned", func(t *testing.T) {
+		push(t, host, "unsigned", "v1", templateYAML)
+		_, err := newImporter(c, v, anonymous).Import(ctx, wfv1.TemplateImport{Name: "ci", Registry: host, Repository: "unsigned", Tag: "v1"}, options)
+		require.ErrorContains(t, err, "failed to get the signatures of")
+	})
+	t.Run("SignedByOtherKey", func(t *testing.T) {
+		digest := push(t, host, "other-key", "v1", templateYAML)
+		sign(t, host, "other-key", digest, otherKey)
+		_, err := newImporter(c, v, anonymous).Import(ctx, wfv1.TemplateImport{Name: "ci", Registry: host, Repository: "other-key", Tag: "v1"}, options)
+		require.ErrorContains(t, err, "has not been signed by any of the public keys")
+	})
+	t.Run("SignatureOfOtherDigest", func(t *testing.T) {
+		digest := push(t, host, "other-digest", "v1", templateYAML)
+		otherDigest := push(t, host, "other-digest", "v2", strings.Replace(templateYAML, "golang", "alpine", 1))
+		// the signature of v2 copied to v1
+		payload := fmt.Sprintf(`{"critical":{"image":{"docker-manifest-digest":"%s"}}}`, otherDigest)
+		h := sha256.Sum256([]byte(payload))
+		sig, err := ecdsa.SignASN1(rand.Reader, key, h[:])
+		require.NoError(t, err)
+		img, err := mutate.Append(empty.Image, mutate.Addendum{
+			Layer:       static.NewLayer([]byte(payload), "application/vnd.dev.cosign.simplesigning.v1+json"),
+			Annotations: map[string]string{signatureAnnotation: base64.StdEncoding.EncodeToString(sig)},
+		})
+		require.NoError(t, err)
+		ref, err := name.NewTag(host + "/other-digest:" + strings.Replace(digest, ":", "-", 1) + ".sig")
+		require.NoError(t, err)
+		require.NoError(t, remote.Write(ref, img))
+		_, err = newImporter(c, v, anonymous).Import(ctx, wfv1.TemplateImport{Name: "ci", Registry: host, Repository: "other-digest", Tag: "v1"}, options)
+		require.ErrorContains(t, err, "has not been signed by any of the public keys")
+	})
+}
+
+func TestNewVerifier(t *testing.T) {
+	_, err := newVerifier(&config.TemplateImports{PublicKeys: []string{"foo"}})
+	require.EqualError(t, err, "templateImports.publicKeys[0] is not PEM encoded")
+	v, err := newVerifier(nil)
+	require.NoError(t, err)
+	ref, err := name.NewDigest("ghcr.io/foo@sha256:" + strings.Repeat("0", 64))
+	require.NoError(t, err)
+	// without public keys nothing is verified
+	require.NoError(t, v.verify(t.Context(), ref, nil))
+}
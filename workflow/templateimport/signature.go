@@ -0,0 +1,88 @@
+package templateimport
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/util/cosign"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// signatureAnnotation is the annotation of the layers of a cosign signature holding the signature of the layer's payload
+const signatureAnnotation = "dev.cosignproject.cosign/signature"
+
+// verifier verifies the cosign signatures of artifacts, signed with `cosign sign --key`
+type verifier cosign.PublicKeys
+
+func newVerifier(config *config.TemplateImports) (verifier, error) {
+	if config == nil {
+		return nil, nil
+	}
+	keys, err := cosign.ParsePublicKeys("templateImports.publicKeys", config.PublicKeys)
+	return verifier(keys), err
+}
+
+// simpleSigning is the payload cosign signs, see https://github.com/containers/image/blob/main/docs/containers-signature.5.md
+type simpleSigning struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// verify verifies that one of the public keys signed the artifact, if there are any public keys
+func (v verifier) verify(ctx context.Context, ref name.Digest, options []remote.Option) error {
+	if len(v) == 0 {
+		return nil
+	}
+	// cosign stores the signatures of an artifact in the tag "sha256-<hex>.sig" of its repository
+	sigRef := ref.Context().Tag(strings.Replace(ref.DigestStr(), ":", "-", 1) + ".sig")
+	img, err := remote.Image(sigRef, options...)
+	if err != nil {
+		return fmt.Errorf("failed to get the signatures of %s: %w", ref, err)
+	}
+	manifest, err := img.Manifest()
+	if err != nil {
+		return err
+	}
+	for _, desc := range manifest.Layers {
+		sig, err := base64.StdEncoding.DecodeString(desc.Annotations[signatureAnnotation])
+		if err != nil || len(sig) == 0 {
+			continue
+		}
+		layer, err := img.LayerByDigest(desc.Digest)
+		if err != nil {
+			return err
+		}
+		r, err := layer.Compressed()
+		if err != nil {
+			return err
+		}
+		payload, err := io.ReadAll(r)
+		_ = r.Close()
+		if err != nil {
+			return err
+		}
+		if !cosign.PublicKeys(v).Verifies(payload, sig) {
+			continue
+		}
+		signed := simpleSigning{}
+		if err := json.Unmarshal(payload, &signed); err != nil {
+			return err
+		}
+		if signed.Critical.Image.DockerManifestDigest == ref.DigestStr() {
+			logging.RequireLoggerFromContext(ctx).WithField("ref", ref.String()).Debug(ctx, "Verified template signature")
+			return nil
+		}
+	}
+	return fmt.Errorf("%s has not been signed by any of the public keys", ref)
+}
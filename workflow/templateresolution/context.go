@@ -2,10 +2,12 @@ package templateresolution
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
 
 	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -79,6 +81,8 @@ type TemplateContext struct {
 	tmplBase wfv1.TemplateHolder
 	// workflow is the Workflow where templates will be stored
 	workflow *wfv1.Workflow
+	// skipStoring is whether to not store the templates in the workflow
+	skipStoring bool
 	// log is a logging entry.
 	log logging.Logger
 }
@@ -157,8 +161,77 @@ func (tplCtx *TemplateContext) GetTemplateFromRef(ctx context.Context, tmplRef *
 	return template.DeepCopy(), nil
 }
 
-// GetTemplate returns a template found by template name or template ref.
+// GetTemplate returns a template found by template name or template ref, merged with any templates it extends.
 func (tplCtx *TemplateContext) GetTemplate(ctx context.Context, h wfv1.TemplateReferenceHolder) (*wfv1.Template, error) {
+	tmpl, err := tplCtx.getTemplate(ctx, h)
+	if err != nil || tmpl.Extends == nil {
+		return tmpl, err
+	}
+	// the template extends templates relative to where it was found
+	tmplCtx := tplCtx
+	if h.GetTemplate() == nil {
+		tmplCtx, err = tplCtx.WithTemplateHolder(ctx, h)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tmplCtx.extend(ctx, tmpl, make(map[string]bool))
+}
+
+// extend merges the template with the templates it extends, which the template's fields take precedence over
+func (tplCtx *TemplateContext) extend(ctx context.Context, tmpl *wfv1.Template, visited map[string]bool) (*wfv1.Template, error) {
+	if tmpl.Extends == nil {
+		return tmpl, nil
+	}
+	key := tplCtx.GetTemplateScope() + "/" + tmpl.Name
+	if visited[key] {
+		return nil, errors.Errorf(errors.CodeBadRequest, "template %s extends itself", tmpl.Name)
+	}
+	visited[key] = true
+	base, err := tplCtx.getTemplate(ctx, tmpl.Extends)
+	if err != nil {
+		return nil, fmt.Errorf("template %s extends %s: %w", tmpl.Name, tmpl.Extends.GetName(), err)
+	}
+	baseCtx, err := tplCtx.WithTemplateHolder(ctx, tmpl.Extends)
+	if err != nil {
+		return nil, err
+	}
+	base, err = baseCtx.extend(ctx, base, visited)
+	if err != nil {
+		return nil, err
+	}
+	return mergeExtends(base, tmpl)
+}
+
+// mergeExtends merges a template into the template it extends
+func mergeExtends(base, tmpl *wfv1.Template) (*wfv1.Template, error) {
+	tmplType := tmpl.GetType()
+	if tmplType == wfv1.TemplateTypeUnknown {
+		tmplType = base.GetType()
+	}
+	baseJSON, err := json.Marshal(base)
+	if err != nil {
+		return nil, err
+	}
+	tmplJSON, err := json.Marshal(tmpl)
+	if err != nil {
+		return nil, err
+	}
+	mergedJSON, err := strategicpatch.StrategicMergePatch(baseJSON, tmplJSON, wfv1.Template{})
+	if err != nil {
+		return nil, err
+	}
+	merged := &wfv1.Template{}
+	if err := json.Unmarshal(mergedJSON, merged); err != nil {
+		return nil, err
+	}
+	merged.SetType(tmplType)
+	merged.Name = tmpl.Name
+	merged.Extends = nil
+	return merged, nil
+}
+
+func (tplCtx *TemplateContext) getTemplate(ctx context.Context, h wfv1.TemplateReferenceHolder) (*wfv1.Template, error) {
 	tplCtx.log.Debug(ctx, "Getting the template")
 	if x := h.GetTemplate(); x != nil {
 		return x, nil
@@ -170,6 +243,16 @@ func (tplCtx *TemplateContext) GetTemplate(ctx context.Context, h wfv1.TemplateR
 	return nil, errors.Errorf(errors.CodeInternal, "failed to get a template")
 }
 
+// GetWorkflowTemplateGetter returns the getter of WorkflowTemplates.
+func (tplCtx *TemplateContext) GetWorkflowTemplateGetter() WorkflowTemplateNamespacedGetter {
+	return tplCtx.wftmplGetter
+}
+
+// GetClusterWorkflowTemplateGetter returns the getter of ClusterWorkflowTemplates.
+func (tplCtx *TemplateContext) GetClusterWorkflowTemplateGetter() ClusterWorkflowTemplateGetter {
+	return tplCtx.cwftmplGetter
+}
+
 // GetCurrentTemplateBase returns the current template base of the context.
 func (tplCtx *TemplateContext) GetCurrentTemplateBase() wfv1.TemplateHolder {
 	return tplCtx.tmplBase
@@ -213,7 +296,7 @@ func (tplCtx *TemplateContext) resolveTemplateImpl(ctx context.Context, tmplHold
 			return nil, nil, false, err
 		}
 		// Stored the found template.
-		if tplCtx.workflow != nil {
+		if tplCtx.workflow != nil && !tplCtx.skipStoring {
 			scope := tplCtx.tmplBase.GetResourceScope()
 			resourceName := tplCtx.tmplBase.GetName()
 			stored, err := tplCtx.workflow.SetStoredTemplate(scope, resourceName, tmplHolder, newTmpl)
@@ -261,7 +344,17 @@ func (tplCtx *TemplateContext) WithTemplateHolder(ctx context.Context, tmplHolde
 
 // WithTemplateBase creates new context with a wfv1.TemplateHolder.
 func (tplCtx *TemplateContext) WithTemplateBase(tmplBase wfv1.TemplateHolder) *TemplateContext {
-	return NewContext(tplCtx.wftmplGetter, tplCtx.cwftmplGetter, tmplBase, tplCtx.workflow, tplCtx.log)
+	newTmplCtx := NewContext(tplCtx.wftmplGetter, tplCtx.cwftmplGetter, tmplBase, tplCtx.workflow, tplCtx.log)
+	newTmplCtx.skipStoring = tplCtx.skipStoring
+	return newTmplCtx
+}
+
+// WithoutStoringTemplates returns a context that does not store the templates it resolves in the workflow, so they
+// are resolved again each time. Templates that have already been stored are still used.
+func (tplCtx *TemplateContext) WithoutStoringTemplates() *TemplateContext {
+	newTmplCtx := tplCtx.WithTemplateBase(tplCtx.tmplBase)
+	newTmplCtx.skipStoring = true
+	return newTmplCtx
 }
 
 // WithWorkflowTemplate creates new context with a wfv1.TemplateHolder.
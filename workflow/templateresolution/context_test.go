@@ -330,6 +330,27 @@ func TestResolveTemplate(t *testing.T) {
 	assert.Equal(t, "nested-whalesay-with-arguments", tmpl.Name)
 }
 
+func TestResolveTemplateWithoutStoring(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wfClientset := fakewfclientset.NewSimpleClientset()
+	err := createWorkflowTemplate(ctx, wfClientset, someWorkflowTemplateYaml)
+	require.NoError(t, err)
+
+	wf := &wfv1.Workflow{}
+	wftmpl := unmarshalWftmpl(baseWorkflowTemplateYaml)
+	log := logging.RequireLoggerFromContext(ctx)
+	tplCtx := NewContextFromClientSet(wfClientset.ArgoprojV1alpha1().WorkflowTemplates(metav1.NamespaceDefault), wfClientset.ArgoprojV1alpha1().ClusterWorkflowTemplates(), wftmpl, wf, log).WithoutStoringTemplates()
+
+	tmplHolder := wfv1.WorkflowStep{TemplateRef: &wfv1.TemplateRef{Name: "some-workflow-template", Template: "whalesay"}}
+	newCtx, tmpl, stored, err := tplCtx.ResolveTemplate(ctx, &tmplHolder)
+	require.NoError(t, err)
+	assert.Equal(t, "whalesay", tmpl.Name)
+	assert.False(t, stored)
+	assert.Empty(t, wf.Status.StoredTemplates)
+	// contexts derived from it do not store templates either
+	assert.True(t, newCtx.skipStoring)
+}
+
 func TestWithTemplateBase(t *testing.T) {
 	ctx := logging.TestContext(t.Context())
 	wfClientset := fakewfclientset.NewSimpleClientset()
@@ -362,3 +383,86 @@ func TestOnWorkflowTemplate(t *testing.T) {
 	tmpl := newCtx.tmplBase.GetTemplateByName("whalesay")
 	assert.NotNil(t, tmpl)
 }
+
+var extendingWorkflowTemplateYaml = `
+apiVersion: argoproj.io/v1alpha1
+kind: WorkflowTemplate
+metadata:
+  name: extending-workflow-template
+spec:
+  templates:
+  - name: base
+    metadata:
+      labels:
+        team: data
+    retryStrategy:
+      limit: "2"
+    inputs:
+      parameters:
+      - name: message
+        value: hello
+    container:
+      image: docker/whalesay
+      command: [cowsay]
+      args: ["{{inputs.parameters.message}}"]
+      env:
+      - name: FOO
+        value: foo
+  - name: extended
+    extends:
+      template: base
+    container:
+      env:
+      - name: BAR
+        value: bar
+  - name: extended-twice
+    extends:
+      template: extended
+    inputs:
+      parameters:
+      - name: message
+        value: goodbye
+  - name: extended-by-ref
+    extends:
+      templateRef:
+        name: another-workflow-template
+        template: whalesay
+    container:
+      command: [cowsay]
+  - name: loop-a
+    extends:
+      template: loop-b
+  - name: loop-b
+    extends:
+      template: loop-a
+`
+
+func TestGetTemplateExtends(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wfClientset := fakewfclientset.NewSimpleClientset()
+	require.NoError(t, createWorkflowTemplate(ctx, wfClientset, anotherWorkflowTemplateYaml))
+	wftmpl := unmarshalWftmpl(extendingWorkflowTemplateYaml)
+	log := logging.RequireLoggerFromContext(ctx)
+	tplCtx := NewContextFromClientSet(wfClientset.ArgoprojV1alpha1().WorkflowTemplates(metav1.NamespaceDefault), wfClientset.ArgoprojV1alpha1().ClusterWorkflowTemplates(), wftmpl, nil, log)
+
+	tmpl, err := tplCtx.GetTemplate(ctx, &wfv1.WorkflowStep{Template: "extended-twice"})
+	require.NoError(t, err)
+	assert.Equal(t, "extended-twice", tmpl.Name)
+	assert.Nil(t, tmpl.Extends)
+	assert.Equal(t, "data", tmpl.Metadata.Labels["team"])
+	assert.NotNil(t, tmpl.RetryStrategy)
+	require.NotNil(t, tmpl.Container)
+	assert.Equal(t, "docker/whalesay", tmpl.Container.Image)
+	assert.Equal(t, []string{"cowsay"}, tmpl.Container.Command)
+	assert.Len(t, tmpl.Container.Env, 2)
+	require.Len(t, tmpl.Inputs.Parameters, 1)
+	assert.Equal(t, "goodbye", tmpl.Inputs.Parameters[0].Value.String())
+
+	tmpl, err = tplCtx.GetTemplate(ctx, &wfv1.WorkflowStep{Template: "extended-by-ref"})
+	require.NoError(t, err)
+	assert.Equal(t, "docker/whalesay", tmpl.Container.Image)
+	assert.Equal(t, []string{"cowsay"}, tmpl.Container.Command)
+
+	_, err = tplCtx.GetTemplate(ctx, &wfv1.WorkflowStep{Template: "loop-a"})
+	require.EqualError(t, err, "template loop-a extends itself")
+}
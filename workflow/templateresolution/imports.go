@@ -0,0 +1,52 @@
+package templateresolution
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// TemplateImporter gets the WorkflowTemplates that workflows import from OCI registries.
+type TemplateImporter interface {
+	// Import returns the WorkflowTemplate of the import.
+	Import(ctx context.Context, imp wfv1.TemplateImport) (*wfv1.WorkflowTemplate, error)
+}
+
+// ErrImportNotResolved is returned when getting an imported WorkflowTemplate without an importer.
+var ErrImportNotResolved = errors.New(errors.CodeBadRequest, "template import cannot be resolved")
+
+type importingWorkflowTemplateGetter struct {
+	getter   WorkflowTemplateNamespacedGetter
+	imports  []wfv1.TemplateImport
+	importer TemplateImporter
+}
+
+// WithImports returns a getter that gets the WorkflowTemplates imported by the given imports by the name of the import,
+// and gets any other WorkflowTemplate from the getter. If the importer is nil, getting an import returns ErrImportNotResolved.
+func WithImports(getter WorkflowTemplateNamespacedGetter, imports []wfv1.TemplateImport, importer TemplateImporter) WorkflowTemplateNamespacedGetter {
+	if len(imports) == 0 {
+		return getter
+	}
+	return &importingWorkflowTemplateGetter{getter: getter, imports: imports, importer: importer}
+}
+
+func (g *importingWorkflowTemplateGetter) Get(ctx context.Context, name string) (*wfv1.WorkflowTemplate, error) {
+	for _, imp := range g.imports {
+		if imp.Name != name {
+			continue
+		}
+		if g.importer == nil {
+			return nil, ErrImportNotResolved
+		}
+		tmpl, err := g.importer.Import(ctx, imp)
+		if err != nil {
+			return nil, err
+		}
+		// templateRefs and the stored templates refer to the template by the name of the import
+		tmpl = tmpl.DeepCopy()
+		tmpl.Name = imp.Name
+		return tmpl, nil
+	}
+	return g.getter.Get(ctx, name)
+}
@@ -0,0 +1,55 @@
+package templateresolution
+
+import (
+	"context"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// TemplateVerifier verifies WorkflowTemplates and ClusterWorkflowTemplates before workflows may reference them.
+type TemplateVerifier interface {
+	// Verify returns an error if the template may not be referenced.
+	Verify(tmpl wfv1.WorkflowSpecHolder) error
+}
+
+type verifyingWorkflowTemplateGetter struct {
+	getter   WorkflowTemplateNamespacedGetter
+	verifier TemplateVerifier
+}
+
+// VerifyWorkflowTemplates returns a getter that verifies the WorkflowTemplates it gets from the getter.
+func VerifyWorkflowTemplates(getter WorkflowTemplateNamespacedGetter, verifier TemplateVerifier) WorkflowTemplateNamespacedGetter {
+	return &verifyingWorkflowTemplateGetter{getter: getter, verifier: verifier}
+}
+
+func (g *verifyingWorkflowTemplateGetter) Get(ctx context.Context, name string) (*wfv1.WorkflowTemplate, error) {
+	tmpl, err := g.getter.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := g.verifier.Verify(tmpl); err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
+
+type verifyingClusterWorkflowTemplateGetter struct {
+	getter   ClusterWorkflowTemplateGetter
+	verifier TemplateVerifier
+}
+
+// VerifyClusterWorkflowTemplates returns a getter that verifies the ClusterWorkflowTemplates it gets from the getter.
+func VerifyClusterWorkflowTemplates(getter ClusterWorkflowTemplateGetter, verifier TemplateVerifier) ClusterWorkflowTemplateGetter {
+	return &verifyingClusterWorkflowTemplateGetter{getter: getter, verifier: verifier}
+}
+
+func (g *verifyingClusterWorkflowTemplateGetter) Get(ctx context.Context, name string) (*wfv1.ClusterWorkflowTemplate, error) {
+	tmpl, err := g.getter.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := g.verifier.Verify(tmpl); err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
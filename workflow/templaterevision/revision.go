@@ -0,0 +1,145 @@
+// Package templaterevision records the revisions of WorkflowTemplates and ClusterWorkflowTemplates as
+// ControllerRevisions, so a workflowTemplateRef can pin a revision by its digest.
+package templaterevision
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// Digest returns the digest of a template's spec, which identifies the revision
+func Digest(spec *wfv1.WorkflowSpec) (string, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(data)), nil
+}
+
+func labelKey(clusterScope bool) string {
+	if clusterScope {
+		return common.LabelKeyClusterWorkflowTemplate
+	}
+	return common.LabelKeyWorkflowTemplate
+}
+
+// revisionName returns the name of the ControllerRevision, which is unique for each template and digest
+func revisionName(name string, clusterScope bool, digest string) string {
+	h := sha256.Sum256([]byte(fmt.Sprintf("%s/%v/%s", labelKey(clusterScope), name, digest)))
+	const maxPrefix = 253 - 11
+	if len(name) > maxPrefix {
+		name = name[:maxPrefix]
+	}
+	return name + "-" + hex.EncodeToString(h[:])[:10]
+}
+
+func list(ctx context.Context, client kubernetes.Interface, namespace, name string, clusterScope bool) ([]appsv1.ControllerRevision, error) {
+	revisions, err := client.AppsV1().ControllerRevisions(namespace).List(ctx, metav1.ListOptions{LabelSelector: labelKey(clusterScope) + "=" + name})
+	if err != nil {
+		return nil, err
+	}
+	items := revisions.Items
+	// newest first
+	sort.Slice(items, func(i, j int) bool { return items[i].Revision > items[j].Revision })
+	return items, nil
+}
+
+// Record stores the spec as the latest revision of the template, unless it is already stored, and then deletes the
+// oldest revisions so only limit are kept
+func Record(ctx context.Context, client kubernetes.Interface, namespace, name string, clusterScope bool, spec *wfv1.WorkflowSpec, limit int) error {
+	digest, err := Digest(spec)
+	if err != nil {
+		return err
+	}
+	revisions, err := list(ctx, client, namespace, name, clusterScope)
+	if err != nil {
+		return err
+	}
+	for _, r := range revisions {
+		if r.Annotations[common.AnnotationKeyWorkflowTemplateDigest] == digest {
+			return nil
+		}
+	}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return err
+	}
+	revision := &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        revisionName(name, clusterScope, digest),
+			Namespace:   namespace,
+			Labels:      map[string]string{labelKey(clusterScope): name},
+			Annotations: map[string]string{common.AnnotationKeyWorkflowTemplateDigest: digest},
+		},
+		Data:     runtime.RawExtension{Raw: data},
+		Revision: 1,
+	}
+	if len(revisions) > 0 {
+		revision.Revision = revisions[0].Revision + 1
+	}
+	if _, err := client.AppsV1().ControllerRevisions(namespace).Create(ctx, revision, metav1.CreateOptions{}); err != nil && !apierr.IsAlreadyExists(err) {
+		return err
+	}
+	for i := len(revisions) - 1; i >= 0 && i+1 >= limit; i-- {
+		err := client.AppsV1().ControllerRevisions(namespace).Delete(ctx, revisions[i].Name, metav1.DeleteOptions{})
+		if err != nil && !apierr.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Get returns the spec of the template's revision with the digest
+func Get(ctx context.Context, client kubernetes.Interface, namespace, name string, clusterScope bool, digest string) (*wfv1.WorkflowSpec, error) {
+	revisions, err := list(ctx, client, namespace, name, clusterScope)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range revisions {
+		if r.Annotations[common.AnnotationKeyWorkflowTemplateDigest] == digest {
+			spec := &wfv1.WorkflowSpec{}
+			return spec, json.Unmarshal(r.Data.Raw, spec)
+		}
+	}
+	resource := workflow.WorkflowTemplatePlural
+	if clusterScope {
+		resource = workflow.ClusterWorkflowTemplatePlural
+	}
+	return nil, apierr.NewNotFound(schema.GroupResource{Group: workflow.Group, Resource: resource}, fmt.Sprintf("%s@%s", name, digest))
+}
+
+// List returns the recorded revisions of the template, newest first
+func List(ctx context.Context, client kubernetes.Interface, namespace, name string, clusterScope bool) (*wfv1.WorkflowTemplateRevisionList, error) {
+	revisions, err := list(ctx, client, namespace, name, clusterScope)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]wfv1.WorkflowTemplateRevision, 0, len(revisions))
+	for _, r := range revisions {
+		item := wfv1.WorkflowTemplateRevision{
+			Digest:            r.Annotations[common.AnnotationKeyWorkflowTemplateDigest],
+			Revision:          r.Revision,
+			CreationTimestamp: r.CreationTimestamp,
+		}
+		if err := json.Unmarshal(r.Data.Raw, &item.Spec); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return &wfv1.WorkflowTemplateRevisionList{Items: items}, nil
+}
@@ -0,0 +1,52 @@
+package templaterevision
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes/fake"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestRecord(t *testing.T) {
+	ctx := t.Context()
+	client := fake.NewSimpleClientset()
+	spec := func(entrypoint string) *wfv1.WorkflowSpec {
+		return &wfv1.WorkflowSpec{Entrypoint: entrypoint, Templates: []wfv1.Template{{Name: entrypoint}}}
+	}
+	for _, entrypoint := range []string{"a", "b", "b", "c", "d"} {
+		require.NoError(t, Record(ctx, client, "argo", "my-wftmpl", false, spec(entrypoint), 3))
+	}
+	// a cluster template with the same name has its own revisions
+	require.NoError(t, Record(ctx, client, "argo", "my-wftmpl", true, spec("a"), 3))
+
+	revisions, err := List(ctx, client, "argo", "my-wftmpl", false)
+	require.NoError(t, err)
+	require.Len(t, revisions.Items, 3)
+	for i, entrypoint := range []string{"d", "c", "b"} {
+		item := revisions.Items[i]
+		assert.Equal(t, entrypoint, item.Spec.Entrypoint)
+		assert.Equal(t, int64(4-i), item.Revision)
+		digest, err := Digest(spec(entrypoint))
+		require.NoError(t, err)
+		assert.Equal(t, digest, item.Digest)
+	}
+
+	digest, err := Digest(spec("c"))
+	require.NoError(t, err)
+	assert.Regexp(t, "^sha256:[0-9a-f]{64}$", digest)
+	got, err := Get(ctx, client, "argo", "my-wftmpl", false, digest)
+	require.NoError(t, err)
+	assert.Equal(t, spec("c"), got)
+
+	// the oldest revision has been deleted
+	digest, err = Digest(spec("a"))
+	require.NoError(t, err)
+	_, err = Get(ctx, client, "argo", "my-wftmpl", false, digest)
+	assert.True(t, apierr.IsNotFound(err))
+	_, err = Get(ctx, client, "argo", "my-wftmpl", true, digest)
+	require.NoError(t, err)
+}
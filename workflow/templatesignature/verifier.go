@@ -0,0 +1,71 @@
+// Package templatesignature verifies the cosign signatures of WorkflowTemplates and ClusterWorkflowTemplates.
+//
+// The signature is of the Payload of the spec of the template, e.g. as signed by
+// `argo template payload my-template.yaml > payload.json && cosign sign-blob --key cosign.key payload.json`, and is
+// recorded in the annotation common.AnnotationKeySignature of the template. Keyless signatures are not supported, as
+// verifying them needs the transparency log to prove that the short-lived certificate was valid when it was used.
+package templatesignature
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/cosign"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// Payload returns the payload of the spec that is signed
+func Payload(spec *wfv1.WorkflowSpec) ([]byte, error) {
+	return json.Marshal(spec)
+}
+
+// Verifier verifies that templates were signed with one of the public keys
+type Verifier struct {
+	publicKeys cosign.PublicKeys
+}
+
+// New returns a Verifier of the config, or nil if there is no config
+func New(c *config.TemplateSignatures) (*Verifier, error) {
+	if c == nil {
+		return nil, nil
+	}
+	publicKeys, err := cosign.ParsePublicKeys("templateSignatures.publicKeys", c.PublicKeys)
+	if err != nil {
+		return nil, err
+	}
+	return &Verifier{publicKeys: publicKeys}, nil
+}
+
+// Verify returns an error unless the template has been signed with one of the public keys
+func (v *Verifier) Verify(tmpl wfv1.WorkflowSpecHolder) error {
+	if err := v.verify(tmpl); err != nil {
+		return fmt.Errorf("%s %s is not verified: %w", kind(tmpl), tmpl.GetName(), err)
+	}
+	return nil
+}
+
+func (v *Verifier) verify(tmpl wfv1.WorkflowSpecHolder) error {
+	annotations := tmpl.GetAnnotations()
+	sig, err := base64.StdEncoding.DecodeString(annotations[common.AnnotationKeySignature])
+	if err != nil || len(sig) == 0 {
+		return fmt.Errorf("it has no signature")
+	}
+	payload, err := Payload(tmpl.GetWorkflowSpec())
+	if err != nil {
+		return err
+	}
+	if !v.publicKeys.Verifies(payload, sig) {
+		return fmt.Errorf("it has not been signed with any of the public keys")
+	}
+	return nil
+}
+
+func kind(tmpl wfv1.WorkflowSpecHolder) string {
+	if _, ok := tmpl.(*wfv1.ClusterWorkflowTemplate); ok {
+		return "ClusterWorkflowTemplate"
+	}
+	return "WorkflowTemplate"
+}
@@ -0,0 +1,99 @@
+package templatesignature
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func newTemplate() *wfv1.WorkflowTemplate {
+	return &wfv1.WorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-template", Annotations: map[string]string{}},
+		Spec: wfv1.WorkflowSpec{Templates: []wfv1.Template{{
+			Name:      "main",
+			Container: &apiv1.Container{Image: "alpine"},
+		}}},
+	}
+}
+
+func newKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func publicKeyPEM(t *testing.T, key *ecdsa.PrivateKey) string {
+	t.Helper()
+	data, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: data}))
+}
+
+// sign signs the template like `cosign sign-blob` does
+func sign(t *testing.T, tmpl wfv1.WorkflowSpecHolder, key *ecdsa.PrivateKey) {
+	t.Helper()
+	payload, err := Payload(tmpl.GetWorkflowSpec())
+	require.NoError(t, err)
+	h := sha256.Sum256(payload)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, h[:])
+	require.NoError(t, err)
+	annotations := tmpl.GetAnnotations()
+	annotations[common.AnnotationKeySignature] = base64.StdEncoding.EncodeToString(sig)
+	tmpl.SetAnnotations(annotations)
+}
+
+func TestNew(t *testing.T) {
+	v, err := New(nil)
+	require.NoError(t, err)
+	assert.Nil(t, v)
+	_, err = New(&config.TemplateSignatures{PublicKeys: []string{"foo"}})
+	require.EqualError(t, err, "templateSignatures.publicKeys[0] is not PEM encoded")
+}
+
+func TestVerifyPublicKeys(t *testing.T) {
+	key := newKey(t)
+	v, err := New(&config.TemplateSignatures{PublicKeys: []string{publicKeyPEM(t, newKey(t)), publicKeyPEM(t, key)}})
+	require.NoError(t, err)
+
+	t.Run("Signed", func(t *testing.T) {
+		tmpl := newTemplate()
+		sign(t, tmpl, key)
+		require.NoError(t, v.Verify(tmpl))
+	})
+	t.Run("ClusterWorkflowTemplate", func(t *testing.T) {
+		tmpl := &wfv1.ClusterWorkflowTemplate{ObjectMeta: metav1.ObjectMeta{Name: "my-template", Annotations: map[string]string{}}, Spec: newTemplate().Spec}
+		sign(t, tmpl, key)
+		require.NoError(t, v.Verify(tmpl))
+		tmpl.Annotations = nil
+		require.EqualError(t, v.Verify(tmpl), "ClusterWorkflowTemplate my-template is not verified: it has no signature")
+	})
+	t.Run("Unsigned", func(t *testing.T) {
+		require.EqualError(t, v.Verify(newTemplate()), "WorkflowTemplate my-template is not verified: it has no signature")
+	})
+	t.Run("SignedWithOtherKey", func(t *testing.T) {
+		tmpl := newTemplate()
+		sign(t, tmpl, newKey(t))
+		require.EqualError(t, v.Verify(tmpl), "WorkflowTemplate my-template is not verified: it has not been signed with any of the public keys")
+	})
+	t.Run("Changed", func(t *testing.T) {
+		tmpl := newTemplate()
+		sign(t, tmpl, key)
+		tmpl.Spec.Templates[0].Container.Image = "evil"
+		require.EqualError(t, v.Verify(tmpl), "WorkflowTemplate my-template is not verified: it has not been signed with any of the public keys")
+	})
+}
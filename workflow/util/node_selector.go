@@ -0,0 +1,127 @@
+package util
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/fields"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// NodeFieldSelector selects nodes by their fields. As well as the operators of field selectors (`=`, `==` and `!=`), it
+// supports matching a field with a regular expression using `=~`, or not matching it using `!~`,
+// e.g. `templateName=~^process-,phase!~Succeeded|Skipped`, and matching a field against a set of values using `in` or
+// `notin`, e.g. `phase in (Failed,Error)`. Commas in regular expressions must be escaped as `\,`.
+type NodeFieldSelector struct {
+	selector fields.Selector
+	regexps  []nodeFieldRegexp
+	sets     []nodeFieldSet
+	raw      string
+}
+
+type nodeFieldRegexp struct {
+	field  string
+	regexp *regexp.Regexp
+	match  bool
+}
+
+type nodeFieldSet struct {
+	field  string
+	values []string
+	in     bool
+}
+
+// nodeFieldSetTerm matches a set term at the start of a selector, e.g. `phase in (Failed,Error)`
+var nodeFieldSetTerm = regexp.MustCompile(`^\s*([^\s,=!~()]+)\s+(in|notin)\s*\(([^)]*)\)\s*(?:,|$)`)
+
+// ParseNodeFieldSelector parses a node field selector
+func ParseNodeFieldSelector(selector string) (*NodeFieldSelector, error) {
+	s := &NodeFieldSelector{raw: strings.TrimSpace(selector)}
+	var terms []string
+	for rest := s.raw; rest != ""; {
+		if m := nodeFieldSetTerm.FindStringSubmatch(rest); m != nil {
+			set := nodeFieldSet{field: m[1], in: m[2] == "in"}
+			for _, v := range strings.Split(m[3], ",") {
+				if v = strings.TrimSpace(v); v != "" {
+					set.values = append(set.values, v)
+				}
+			}
+			if len(set.values) == 0 {
+				return nil, fmt.Errorf("invalid selector %q: empty set", strings.TrimSuffix(strings.TrimSpace(m[0]), ","))
+			}
+			s.sets = append(s.sets, set)
+			rest = rest[len(m[0]):]
+			continue
+		}
+		var term string
+		term, rest = nextTerm(rest)
+		op, match := "=~", true
+		i := strings.Index(term, op)
+		if j := strings.Index(term, "!~"); j >= 0 && (i < 0 || j < i) {
+			op, match, i = "!~", false, j
+		}
+		if i < 0 {
+			terms = append(terms, term)
+			continue
+		}
+		field := strings.TrimSpace(term[:i])
+		if field == "" {
+			return nil, fmt.Errorf("invalid selector %q: missing field name", term)
+		}
+		r, err := regexp.Compile(strings.ReplaceAll(term[i+len(op):], `\,`, ","))
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector %q: %w", term, err)
+		}
+		s.regexps = append(s.regexps, nodeFieldRegexp{field: field, regexp: r, match: match})
+	}
+	var err error
+	s.selector, err = fields.ParseSelector(strings.Join(terms, ","))
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// nextTerm splits the selector at the first comma which is not escaped, returning the term before it and the rest
+func nextTerm(selector string) (string, string) {
+	for i := 0; i < len(selector); i++ {
+		switch selector[i] {
+		case '\\':
+			i++
+		case ',':
+			return selector[:i], selector[i+1:]
+		}
+	}
+	return selector, ""
+}
+
+// Matches returns whether the node matches every term of the selector
+func (s *NodeFieldSelector) Matches(node wfv1.NodeStatus) bool {
+	if !SelectorMatchesNode(s.selector, node) {
+		return false
+	}
+	nodeFields := getNodeFields(node)
+	for _, r := range s.regexps {
+		if r.regexp.MatchString(nodeFields[r.field]) != r.match {
+			return false
+		}
+	}
+	for _, set := range s.sets {
+		if slices.Contains(set.values, nodeFields[set.field]) != set.in {
+			return false
+		}
+	}
+	return true
+}
+
+// Empty returns whether the selector matches every node
+func (s *NodeFieldSelector) Empty() bool {
+	return s.selector.Empty() && len(s.regexps) == 0 && len(s.sets) == 0
+}
+
+func (s *NodeFieldSelector) String() string {
+	return s.raw
+}
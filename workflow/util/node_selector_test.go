@@ -0,0 +1,71 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestParseNodeFieldSelector(t *testing.T) {
+	nodes := []wfv1.NodeStatus{
+		{ID: "n0", DisplayName: "process-0", TemplateName: "process", Phase: wfv1.NodeSucceeded},
+		{ID: "n1", DisplayName: "process-1", TemplateName: "process", Phase: wfv1.NodeFailed},
+		{ID: "n2", DisplayName: "process-12", TemplateName: "process", Phase: wfv1.NodeSucceeded},
+		{ID: "n3", DisplayName: "report", TemplateName: "report", Phase: wfv1.NodeSkipped},
+	}
+	matching := func(t *testing.T, selector string) []string {
+		s, err := ParseNodeFieldSelector(selector)
+		require.NoError(t, err)
+		var ids []string
+		for _, n := range nodes {
+			if s.Matches(n) {
+				ids = append(ids, n.ID)
+			}
+		}
+		return ids
+	}
+	t.Run("Empty", func(t *testing.T) {
+		s, err := ParseNodeFieldSelector("")
+		require.NoError(t, err)
+		assert.True(t, s.Empty())
+		assert.Equal(t, []string{"n0", "n1", "n2", "n3"}, matching(t, ""))
+	})
+	t.Run("Exact", func(t *testing.T) {
+		assert.Equal(t, []string{"n0", "n2"}, matching(t, "templateName=process,phase=Succeeded"))
+		assert.Equal(t, []string{"n1", "n3"}, matching(t, "phase!=Succeeded"))
+	})
+	t.Run("Regexp", func(t *testing.T) {
+		assert.Equal(t, []string{"n1", "n2"}, matching(t, "displayName=~^process-1"))
+		assert.Equal(t, []string{"n0", "n1"}, matching(t, `displayName=~^process-\d{1\,1}$`))
+		assert.Equal(t, []string{"n1"}, matching(t, "phase!~Succeeded|Skipped"))
+	})
+	t.Run("Set", func(t *testing.T) {
+		assert.Equal(t, []string{"n1", "n3"}, matching(t, "phase in (Failed,Skipped)"))
+		assert.Equal(t, []string{"n0", "n2"}, matching(t, "phase notin ( Failed , Skipped )"))
+		assert.Equal(t, []string{"n3"}, matching(t, "templateName in (report)"))
+	})
+	t.Run("Combined", func(t *testing.T) {
+		assert.Equal(t, []string{"n2"}, matching(t, "displayName=~^process-1,phase=Succeeded"))
+		assert.Equal(t, []string{"n1"}, matching(t, "phase in (Failed,Skipped),displayName=~^process-"))
+		assert.Equal(t, []string{"n0", "n1"}, matching(t, `displayName=~^process-\d$,phase in (Succeeded,Failed)`))
+	})
+	t.Run("String", func(t *testing.T) {
+		s, err := ParseNodeFieldSelector(" displayName=~^process-1,phase=Succeeded ")
+		require.NoError(t, err)
+		assert.False(t, s.Empty())
+		assert.Equal(t, "displayName=~^process-1,phase=Succeeded", s.String())
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := ParseNodeFieldSelector("displayName=~(")
+		require.Error(t, err)
+		_, err = ParseNodeFieldSelector("=~process")
+		require.Error(t, err)
+		_, err = ParseNodeFieldSelector("phase")
+		require.Error(t, err)
+		_, err = ParseNodeFieldSelector("phase in ()")
+		require.Error(t, err)
+	})
+}
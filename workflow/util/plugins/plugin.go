@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -95,3 +97,17 @@ func (p *Client) Call(ctx context.Context, method string, args interface{}, repl
 		}
 	})
 }
+
+// HealthCheck returns an error if the plugin does not accept connections, e.g. because its sidecar is restarting
+func (p *Client) HealthCheck(ctx context.Context) error {
+	u, err := url.Parse(p.address)
+	if err != nil {
+		return err
+	}
+	dialer := net.Dialer{Timeout: 5 * time.Second}
+	conn, err := dialer.DialContext(ctx, "tcp", u.Host)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"math/rand"
 	"net/http"
 	"os"
@@ -14,6 +15,7 @@ import (
 	"regexp"
 	nruntime "runtime"
 	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -52,6 +54,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
 	"github.com/argoproj/argo-workflows/v3/workflow/packer"
+	"github.com/argoproj/argo-workflows/v3/workflow/sensitive"
 	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
 	"github.com/argoproj/argo-workflows/v3/workflow/validate"
 )
@@ -366,7 +369,9 @@ func ReadParametersFile(file string, opts *wfv1.SubmitOpts) error {
 }
 
 // SuspendWorkflow suspends a workflow by setting spec.suspend to true. Retries conflict errors
-func SuspendWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, workflowName string) error {
+// SuspendWorkflow suspends a workflow by setting spec.suspend to true. If resumeAt is not zero, it is recorded in the
+// workflow so the controller resumes it once that time has passed.
+func SuspendWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, workflowName string, resumeAt time.Time) error {
 	err := waitutil.Backoff(retry.DefaultRetry(ctx), func() (bool, error) {
 		wf, err := wfIf.Get(ctx, workflowName, metav1.GetOptions{})
 		if err != nil {
@@ -375,7 +380,8 @@ func SuspendWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, workf
 		if IsWorkflowCompleted(wf) {
 			return false, errSuspendedCompletedWorkflow
 		}
-		if wf.Spec.Suspend == nil || !*wf.Spec.Suspend {
+		resumeAtChanged := setResumeAt(wf, resumeAt)
+		if wf.Spec.Suspend == nil || !*wf.Spec.Suspend || resumeAtChanged {
 			wf.Spec.Suspend = ptr.To(true)
 			creator.LabelActor(ctx, wf, creator.ActionSuspend)
 			_, err := wfIf.Update(ctx, wf, metav1.UpdateOptions{})
@@ -389,6 +395,26 @@ func SuspendWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, workf
 	return err
 }
 
+// setResumeAt records resumeAt in the workflow's annotations, or removes it if zero, and returns whether it changed.
+func setResumeAt(wf *wfv1.Workflow, resumeAt time.Time) bool {
+	value := ""
+	if !resumeAt.IsZero() {
+		value = resumeAt.UTC().Format(time.RFC3339)
+	}
+	if wf.GetAnnotations()[common.AnnotationKeyResumeAt] == value {
+		return false
+	}
+	if value == "" {
+		delete(wf.Annotations, common.AnnotationKeyResumeAt)
+	} else {
+		if wf.Annotations == nil {
+			wf.Annotations = map[string]string{}
+		}
+		wf.Annotations[common.AnnotationKeyResumeAt] = value
+	}
+	return true
+}
+
 func OverrideOutputParametersWithDefault(outputs *wfv1.Outputs) error {
 	if outputs == nil {
 		return nil
@@ -408,6 +434,21 @@ func OverrideOutputParametersWithDefault(outputs *wfv1.Outputs) error {
 
 // ResumeWorkflow resumes a workflow by setting spec.suspend to nil and any suspended nodes to Successful.
 // Retries conflict errors
+// NextStepThroughNode returns the active suspend node that has paused the workflow for step-through the longest, or
+// nil if there is none
+func NextStepThroughNode(wf *wfv1.Workflow) *wfv1.NodeStatus {
+	var next *wfv1.NodeStatus
+	for _, node := range wf.Status.Nodes {
+		if !node.IsActiveStepThroughNode() {
+			continue
+		}
+		if next == nil || node.StartedAt.Before(&next.StartedAt) || (node.StartedAt.Equal(&next.StartedAt) && node.Name < next.Name) {
+			next = node.DeepCopy()
+		}
+	}
+	return next
+}
+
 func ResumeWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, hydrator hydrator.Interface, workflowName string, nodeFieldSelector string) error {
 	uiMsg := ""
 	uim := creator.UserInfoMap(ctx)
@@ -433,6 +474,9 @@ func ResumeWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, hydrat
 				wf.Spec.Suspend = nil
 				workflowUpdated = true
 			}
+			if setResumeAt(wf, time.Time{}) {
+				workflowUpdated = true
+			}
 
 			// To resume a workflow with a suspended node we simply mark the node as Successful
 			for nodeID, node := range wf.Status.Nodes {
@@ -472,6 +516,11 @@ func ResumeWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, hydrat
 }
 
 func SelectorMatchesNode(selector fields.Selector, node wfv1.NodeStatus) bool {
+	return selector.Matches(getNodeFields(node))
+}
+
+// getNodeFields returns the fields of the node which can be selected
+func getNodeFields(node wfv1.NodeStatus) fields.Set {
 	nodeFields := fields.Set{
 		"displayName":  node.DisplayName,
 		"templateName": GetTemplateFromNode(node),
@@ -485,17 +534,20 @@ func SelectorMatchesNode(selector fields.Selector, node wfv1.NodeStatus) bool {
 	}
 	if node.Inputs != nil {
 		for _, inParam := range node.Inputs.Parameters {
-			nodeFields[fmt.Sprintf("inputs.parameters.%s.value", inParam.Name)] = inParam.Value.String()
+			if inParam.Value != nil {
+				nodeFields[fmt.Sprintf("inputs.parameters.%s.value", inParam.Name)] = inParam.Value.String()
+			}
 		}
 	}
-
-	return selector.Matches(nodeFields)
+	return nodeFields
 }
 
 type SetOperationValues struct {
 	Phase            wfv1.NodePhase
 	Message          string
 	OutputParameters map[string]string
+	// AllowUndeclaredOutputParameters adds output parameters a suspend node does not declare, rather than failing
+	AllowUndeclaredOutputParameters bool
 }
 
 func AddParamToGlobalScope(ctx context.Context, wf *wfv1.Workflow, param wfv1.Parameter) bool {
@@ -517,23 +569,38 @@ func AddParamToGlobalScope(ctx context.Context, wf *wfv1.Workflow, param wfv1.Pa
 	}
 	paramName := fmt.Sprintf("workflow.outputs.parameters.%s", param.GlobalName)
 	if index == -1 {
-		log.WithFields(logging.Fields{"paramName": paramName, "paramValue": param.Value}).Info(ctx, "setting param")
-		gParam := wfv1.Parameter{Name: param.GlobalName, Value: param.Value}
+		log.WithFields(logging.Fields{"paramName": paramName, "paramValue": loggedValue(param, param.Value)}).Info(ctx, "setting param")
+		gParam := wfv1.Parameter{Name: param.GlobalName, Value: param.Value, Sensitive: param.Sensitive}
 		wf.Status.Outputs.Parameters = append(wf.Status.Outputs.Parameters, gParam)
 		wfUpdated = true
 	} else {
 		prevVal := wf.Status.Outputs.Parameters[index].Value
 		if prevVal == nil || (param.Value != nil && *prevVal != *param.Value) {
-			log.WithFields(logging.Fields{"paramName": paramName, "prevValue": prevVal, "newValue": param.Value}).Info(ctx, "overwriting param")
+			log.WithFields(logging.Fields{"paramName": paramName, "prevValue": loggedValue(param, prevVal), "newValue": loggedValue(param, param.Value)}).Info(ctx, "overwriting param")
 			wf.Status.Outputs.Parameters[index].Value = param.Value
+			wf.Status.Outputs.Parameters[index].Sensitive = wf.Status.Outputs.Parameters[index].Sensitive || param.Sensitive
 			wfUpdated = true
 		}
 	}
 	return wfUpdated
 }
 
+// loggedValue returns the value of the parameter to log, which is masked if the parameter is sensitive
+func loggedValue(param wfv1.Parameter, value *wfv1.AnyString) *wfv1.AnyString {
+	if param.Sensitive && value != nil {
+		return wfv1.AnyStringPtr(sensitive.Mask)
+	}
+	return value
+}
+
 func updateSuspendedNode(ctx context.Context, wfIf v1alpha1.WorkflowInterface, hydrator hydrator.Interface, workflowName string, nodeFieldSelector string, values SetOperationValues, action creator.ActionType) error {
-	selector, err := fields.ParseSelector(nodeFieldSelector)
+	return updateNodes(ctx, wfIf, hydrator, workflowName, nodeFieldSelector, values, action, false)
+}
+
+// updateNodes sets the values on the active suspend nodes matching nodeFieldSelector and, if includeRetrying is true,
+// on the failed nodes whose retry node is still retrying them.
+func updateNodes(ctx context.Context, wfIf v1alpha1.WorkflowInterface, hydrator hydrator.Interface, workflowName string, nodeFieldSelector string, values SetOperationValues, action creator.ActionType, includeRetrying bool) error {
+	selector, err := ParseNodeFieldSelector(nodeFieldSelector)
 	if err != nil {
 		return err
 	}
@@ -548,57 +615,51 @@ func updateSuspendedNode(ctx context.Context, wfIf v1alpha1.WorkflowInterface, h
 			return false, err
 		}
 
+		retrying := map[string]bool{}
+		if includeRetrying && !IsWorkflowCompleted(wf) {
+			retrying = getRetryingNodeIDs(wf)
+		}
+
 		nodeUpdated := false
 		for nodeID, node := range wf.Status.Nodes {
-			if node.IsActiveSuspendNode() {
-				if SelectorMatchesNode(selector, node) {
-
-					// Update phase
-					if values.Phase != "" {
-						node.Phase = values.Phase
-						if values.Phase.Fulfilled(node.TaskResultSynced) {
-							node.FinishedAt = metav1.Time{Time: time.Now().UTC()}
-						}
-						nodeUpdated = true
-					}
+			if !node.IsActiveSuspendNode() && !retrying[nodeID] {
+				continue
+			}
+			if !selector.Matches(node) {
+				continue
+			}
 
-					// Update message
-					if values.Message != "" {
-						node.Message = values.Message
-						nodeUpdated = true
-					}
+			// Update phase
+			if values.Phase != "" {
+				node.Phase = values.Phase
+				if values.Phase.Fulfilled(node.TaskResultSynced) {
+					node.FinishedAt = metav1.Time{Time: time.Now().UTC()}
+				}
+				nodeUpdated = true
+			}
 
-					// Update output parameters
-					if len(values.OutputParameters) > 0 {
-						if node.Outputs == nil {
-							return true, fmt.Errorf("cannot set output parameters because node is not expecting any raw parameters")
-						}
-						for name, val := range values.OutputParameters {
-							hit := false
-							for i, param := range node.Outputs.Parameters {
-								if param.Name == name {
-									if param.ValueFrom == nil || param.ValueFrom.Supplied == nil {
-										return true, fmt.Errorf("cannot set output parameter '%s' because it does not use valueFrom.raw or it was already set", param.Name)
-									}
-									node.Outputs.Parameters[i].Value = wfv1.AnyStringPtr(val)
-									node.Outputs.Parameters[i].ValueFrom = nil
-									nodeUpdated = true
-									hit = true
-									AddParamToGlobalScope(ctx, wf, node.Outputs.Parameters[i])
-									break
-								}
-							}
-							if !hit {
-								return true, fmt.Errorf("node is not expecting output parameter '%s'", name)
-							}
-						}
-					}
-					wf.Status.Nodes.Set(ctx, nodeID, node)
+			// Update message
+			if values.Message != "" {
+				node.Message = values.Message
+				nodeUpdated = true
+			}
+
+			// Update output parameters
+			if len(values.OutputParameters) > 0 {
+				// a failed node never produced its outputs, so any of them may be supplied
+				arbitrary := retrying[nodeID] || values.AllowUndeclaredOutputParameters
+				if err := setNodeOutputParameters(ctx, wf, &node, values.OutputParameters, arbitrary, retrying[nodeID]); err != nil {
+					return true, err
 				}
+				nodeUpdated = true
 			}
+			wf.Status.Nodes.Set(ctx, nodeID, node)
 		}
 
 		if !nodeUpdated {
+			if includeRetrying {
+				return true, fmt.Errorf("set only targets suspend nodes and failed nodes being retried: no such nodes matching nodeFieldSelector: %s", nodeFieldSelector)
+			}
 			return true, fmt.Errorf("currently, set only targets suspend nodes: no suspend nodes matching nodeFieldSelector: %s", nodeFieldSelector)
 		}
 
@@ -621,6 +682,54 @@ func updateSuspendedNode(ctx context.Context, wfIf v1alpha1.WorkflowInterface, h
 	return err
 }
 
+// getRetryingNodeIDs returns the IDs of the failed nodes which are the last attempt of a retry node that has not
+// completed, i.e. the attempts the retry node is about to retry.
+func getRetryingNodeIDs(wf *wfv1.Workflow) map[string]bool {
+	ids := map[string]bool{}
+	for _, node := range wf.Status.Nodes {
+		if node.Type != wfv1.NodeTypeRetry || node.Fulfilled() || len(node.Children) == 0 {
+			continue
+		}
+		lastChildID := node.Children[len(node.Children)-1]
+		if child, ok := wf.Status.Nodes[lastChildID]; ok && child.FailedOrError() {
+			ids[lastChildID] = true
+		}
+	}
+	return ids
+}
+
+// setNodeOutputParameters sets the output parameters of the node. Declared parameters must be supplied ones that are
+// not yet set, unless overwrite is true. Undeclared parameters are added if arbitrary is true.
+func setNodeOutputParameters(ctx context.Context, wf *wfv1.Workflow, node *wfv1.NodeStatus, params map[string]string, arbitrary, overwrite bool) error {
+	if node.Outputs == nil {
+		if !arbitrary {
+			return fmt.Errorf("cannot set output parameters because node is not expecting any raw parameters")
+		}
+		node.Outputs = &wfv1.Outputs{}
+	}
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		i := slices.IndexFunc(node.Outputs.Parameters, func(p wfv1.Parameter) bool { return p.Name == name })
+		if i < 0 {
+			if !arbitrary {
+				return fmt.Errorf("node is not expecting output parameter '%s'", name)
+			}
+			node.Outputs.Parameters = append(node.Outputs.Parameters, wfv1.Parameter{Name: name})
+			i = len(node.Outputs.Parameters) - 1
+		} else if param := node.Outputs.Parameters[i]; !overwrite && (param.ValueFrom == nil || param.ValueFrom.Supplied == nil) {
+			return fmt.Errorf("cannot set output parameter '%s' because it does not use valueFrom.raw or it was already set", param.Name)
+		}
+		node.Outputs.Parameters[i].Value = wfv1.AnyStringPtr(params[name])
+		node.Outputs.Parameters[i].ValueFrom = nil
+		AddParamToGlobalScope(ctx, wf, node.Outputs.Parameters[i])
+	}
+	return nil
+}
+
 const letters = "abcdefghijklmnopqrstuvwxyz0123456789"
 
 // generates an insecure random string
@@ -664,6 +773,12 @@ func FormulateResubmitWorkflow(ctx context.Context, wf *wfv1.Workflow, memoized
 	// carry over the unmodified spec
 	newWF.Spec = wf.Spec
 
+	// run the same revision of the workflow template, rather than its latest revision
+	if ref := wf.Spec.WorkflowTemplateRef; ref != nil && ref.Digest == "" && wf.Annotations[common.AnnotationKeyWorkflowTemplateDigest] != "" {
+		newWF.Spec.WorkflowTemplateRef = ref.DeepCopy()
+		newWF.Spec.WorkflowTemplateRef.Digest = wf.Annotations[common.AnnotationKeyWorkflowTemplateDigest]
+	}
+
 	if newWF.Spec.ActiveDeadlineSeconds != nil && *newWF.Spec.ActiveDeadlineSeconds == 0 {
 		// if it was terminated, unset the deadline
 		newWF.Spec.ActiveDeadlineSeconds = nil
@@ -769,6 +884,7 @@ func FormulateResubmitWorkflow(ctx context.Context, wf *wfv1.Workflow, memoized
 	for id, tmpl := range wf.Status.StoredTemplates {
 		newWF.Status.StoredTemplates[id] = tmpl
 	}
+	newWF.Status.TemplateDigests = maps.Clone(wf.Status.TemplateDigests)
 
 	newWF.Status.Conditions = wfv1.Conditions{{Status: metav1.ConditionFalse, Type: wfv1.ConditionTypeCompleted}}
 	newWF.Status.Phase = wfv1.WorkflowUnknown
@@ -1355,12 +1471,12 @@ func getNodeIDsToReset(restartSuccessful bool, nodeFieldSelector string, nodes w
 		return nodeIDsToReset, nil
 	}
 
-	selector, err := fields.ParseSelector(nodeFieldSelector)
+	selector, err := ParseNodeFieldSelector(nodeFieldSelector)
 	if err != nil {
 		return nil, err
 	}
 	for _, node := range nodes {
-		if SelectorMatchesNode(selector, node) {
+		if selector.Matches(node) {
 			nodeIDsToReset[node.ID] = true
 		}
 	}
@@ -1383,18 +1499,108 @@ func IsWorkflowSuspended(wf *wfv1.Workflow) bool {
 	return false
 }
 
+// ShutdownOptions are how a workflow, or some of its nodes, are stopped or terminated
+type ShutdownOptions struct {
+	// Reason is why, recorded in the workflow's status and events
+	Reason string
+	// GracePeriod is how long pods have to exit once signalled before they are deleted, if set
+	GracePeriod *time.Duration
+	// Subtree stops the nodes selected by the node field selector and their descendants, letting the other nodes finish
+	Subtree bool
+}
+
+// annotations returns the annotations recording the options on the workflow
+func (o ShutdownOptions) annotations() map[string]interface{} {
+	annotations := map[string]interface{}{}
+	if o.Reason != "" {
+		annotations[common.AnnotationKeyShutdownReason] = o.Reason
+	}
+	if o.GracePeriod != nil {
+		annotations[common.AnnotationKeyShutdownGracePeriod] = o.GracePeriod.String()
+	}
+	return annotations
+}
+
 // TerminateWorkflow terminates a workflow by setting its spec.shutdown to ShutdownStrategyTerminate
-func TerminateWorkflow(ctx context.Context, wfClient v1alpha1.WorkflowInterface, name string) error {
-	return patchShutdownStrategy(ctx, wfClient, name, wfv1.ShutdownStrategyTerminate)
+func TerminateWorkflow(ctx context.Context, wfClient v1alpha1.WorkflowInterface, name string, opts ShutdownOptions) error {
+	return patchShutdownStrategy(ctx, wfClient, name, wfv1.ShutdownStrategyTerminate, opts)
 }
 
 // StopWorkflow terminates a workflow by setting its spec.shutdown to ShutdownStrategyStop
 // Or terminates a single resume step referenced by nodeFieldSelector
-func StopWorkflow(ctx context.Context, wfClient v1alpha1.WorkflowInterface, hydrator hydrator.Interface, name string, nodeFieldSelector string, message string) error {
+// Or stops the subtrees of the nodes referenced by nodeFieldSelector
+func StopWorkflow(ctx context.Context, wfClient v1alpha1.WorkflowInterface, hydrator hydrator.Interface, name string, nodeFieldSelector string, message string, opts ShutdownOptions) error {
+	if opts.Subtree {
+		if nodeFieldSelector == "" {
+			return fmt.Errorf("stopping a subtree requires a node field selector")
+		}
+		return stopNodeSubtrees(ctx, wfClient, hydrator, name, nodeFieldSelector, opts)
+	}
 	if len(nodeFieldSelector) > 0 {
 		return updateSuspendedNode(ctx, wfClient, hydrator, name, nodeFieldSelector, SetOperationValues{Phase: wfv1.NodeFailed, Message: message}, creator.ActionStop)
 	}
-	return patchShutdownStrategy(ctx, wfClient, name, wfv1.ShutdownStrategyStop)
+	return patchShutdownStrategy(ctx, wfClient, name, wfv1.ShutdownStrategyStop, opts)
+}
+
+// stopNodeSubtrees adds the nodes selected by the node field selector to the nodes whose subtrees the controller stops
+func stopNodeSubtrees(ctx context.Context, wfClient v1alpha1.WorkflowInterface, hydrator hydrator.Interface, name string, nodeFieldSelector string, opts ShutdownOptions) error {
+	selector, err := ParseNodeFieldSelector(nodeFieldSelector)
+	if err != nil {
+		return err
+	}
+	return waitutil.Backoff(retry.DefaultRetry(ctx), func() (bool, error) {
+		wf, err := wfClient.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return !errorsutil.IsTransientErr(ctx, err), err
+		}
+		if wf.Status.Fulfilled() {
+			return true, AlreadyShutdownError{wf.Name, wf.Namespace}
+		}
+		if err := hydrator.Hydrate(ctx, wf); err != nil {
+			return true, err
+		}
+		stopped := GetStoppedNodes(wf)
+		found := false
+		for _, node := range wf.Status.Nodes {
+			if node.Fulfilled() || !selector.Matches(node) {
+				continue
+			}
+			found = true
+			if !slices.Contains(stopped, node.ID) {
+				stopped = append(stopped, node.ID)
+			}
+		}
+		if !found {
+			return true, fmt.Errorf("currently, no running nodes matching the selector %s were found", nodeFieldSelector)
+		}
+		annotations := opts.annotations()
+		annotations[common.AnnotationKeyStoppedNodes] = strings.Join(stopped, ",")
+		// the resource version makes the patch conflict with concurrent changes to the stopped nodes
+		metadata := map[string]interface{}{
+			"annotations":     annotations,
+			"resourceVersion": wf.ResourceVersion,
+		}
+		if userActionLabel := creator.UserActionLabel(ctx, creator.ActionStop); userActionLabel != nil {
+			metadata["labels"] = userActionLabel
+		}
+		patch, err := json.Marshal(map[string]interface{}{"metadata": metadata})
+		if err != nil {
+			return true, errors.InternalWrapError(err)
+		}
+		_, err = wfClient.Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+		if apierr.IsConflict(err) {
+			return false, nil
+		}
+		return !errorsutil.IsTransientErr(ctx, err), err
+	})
+}
+
+// GetStoppedNodes returns the IDs of the nodes whose subtrees are stopped
+func GetStoppedNodes(wf *wfv1.Workflow) []string {
+	if v := wf.GetAnnotations()[common.AnnotationKeyStoppedNodes]; v != "" {
+		return strings.Split(v, ",")
+	}
+	return nil
 }
 
 type AlreadyShutdownError struct {
@@ -1407,12 +1613,16 @@ func (e AlreadyShutdownError) Error() string {
 }
 
 // patchShutdownStrategy patches the shutdown strategy to a workflow.
-func patchShutdownStrategy(ctx context.Context, wfClient v1alpha1.WorkflowInterface, name string, strategy wfv1.ShutdownStrategy) error {
+func patchShutdownStrategy(ctx context.Context, wfClient v1alpha1.WorkflowInterface, name string, strategy wfv1.ShutdownStrategy, opts ShutdownOptions) error {
 	patchObj := map[string]interface{}{
 		"spec": map[string]interface{}{
 			"shutdown": strategy,
 		},
 	}
+	metadata := map[string]interface{}{}
+	if annotations := opts.annotations(); len(annotations) > 0 {
+		metadata["annotations"] = annotations
+	}
 	var action creator.ActionType
 	switch strategy {
 	case wfv1.ShutdownStrategyTerminate:
@@ -1424,9 +1634,10 @@ func patchShutdownStrategy(ctx context.Context, wfClient v1alpha1.WorkflowInterf
 	}
 	userActionLabel := creator.UserActionLabel(ctx, action)
 	if userActionLabel != nil {
-		patchObj["metadata"] = map[string]interface{}{
-			"labels": userActionLabel,
-		}
+		metadata["labels"] = userActionLabel
+	}
+	if len(metadata) > 0 {
+		patchObj["metadata"] = metadata
 	}
 	var err error
 	patch, err := json.Marshal(patchObj)
@@ -1452,9 +1663,9 @@ func patchShutdownStrategy(ctx context.Context, wfClient v1alpha1.WorkflowInterf
 
 func SetWorkflow(ctx context.Context, wfClient v1alpha1.WorkflowInterface, hydrator hydrator.Interface, name string, nodeFieldSelector string, values SetOperationValues) error {
 	if nodeFieldSelector != "" {
-		return updateSuspendedNode(ctx, wfClient, hydrator, name, nodeFieldSelector, values, creator.ActionNone)
+		return updateNodes(ctx, wfClient, hydrator, name, nodeFieldSelector, values, creator.ActionNone, true)
 	}
-	return fmt.Errorf("'set' currently only targets suspend nodes, use a node field selector to target them")
+	return fmt.Errorf("'set' only targets suspend nodes and failed nodes being retried, use a node field selector to target them")
 }
 
 // Reads from stdin
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 
@@ -244,6 +245,39 @@ status:
   startedAt: "2020-04-10T15:21:23Z"
 `
 
+func TestSuspendWorkflowUntil(t *testing.T) {
+	wfIf := argofake.NewSimpleClientset().ArgoprojV1alpha1().Workflows("")
+	origWf := wfv1.MustUnmarshalWorkflow(suspendedWf)
+	ctx := logging.TestContext(t.Context())
+	_, err := wfIf.Create(ctx, origWf, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	resumeAt := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+	err = SuspendWorkflow(ctx, wfIf, "suspend", resumeAt)
+	require.NoError(t, err)
+	wf, err := wfIf.Get(ctx, "suspend", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, *wf.Spec.Suspend)
+	assert.Equal(t, "2026-01-02T15:04:05Z", wf.Annotations[common.AnnotationKeyResumeAt])
+
+	// suspending again without a time removes it
+	err = SuspendWorkflow(ctx, wfIf, "suspend", time.Time{})
+	require.NoError(t, err)
+	wf, err = wfIf.Get(ctx, "suspend", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, wf.Annotations, common.AnnotationKeyResumeAt)
+
+	// resuming removes it too
+	err = SuspendWorkflow(ctx, wfIf, "suspend", resumeAt)
+	require.NoError(t, err)
+	err = ResumeWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend", "")
+	require.NoError(t, err)
+	wf, err = wfIf.Get(ctx, "suspend", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, wf.Spec.Suspend)
+	assert.NotContains(t, wf.Annotations, common.AnnotationKeyResumeAt)
+}
+
 func TestResumeWorkflowByNodeName(t *testing.T) {
 	t.Run("Withought user info", func(t *testing.T) {
 		wfIf := argofake.NewSimpleClientset().ArgoprojV1alpha1().Workflows("")
@@ -313,7 +347,7 @@ func TestStopWorkflowByNodeName(t *testing.T) {
 	require.NoError(t, err)
 
 	// will return error as displayName does not match any nodes
-	err = StopWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend", "displayName=nonexistant", "error occurred")
+	err = StopWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend", "displayName=nonexistant", "error occurred", ShutdownOptions{})
 	require.Error(t, err)
 
 	// displayName didn't match suspend node so should still be running
@@ -321,7 +355,7 @@ func TestStopWorkflowByNodeName(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes.FindByDisplayName("approve").Phase)
 
-	err = StopWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend", "displayName=approve", "error occurred")
+	err = StopWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend", "displayName=approve", "error occurred", ShutdownOptions{})
 	require.NoError(t, err)
 
 	// displayName matched node so has succeeded
@@ -333,10 +367,42 @@ func TestStopWorkflowByNodeName(t *testing.T) {
 	origWf.Name = "succeeded-wf"
 	_, err = wfIf.Create(ctx, origWf, metav1.CreateOptions{})
 	require.NoError(t, err)
-	err = StopWorkflow(ctx, wfIf, hydratorfake.Noop, "succeeded-wf", "", "")
+	err = StopWorkflow(ctx, wfIf, hydratorfake.Noop, "succeeded-wf", "", "", ShutdownOptions{})
 	require.EqualError(t, err, "cannot shutdown a completed workflow: workflow: \"succeeded-wf\", namespace: \"\"")
 }
 
+func TestStopWorkflowSubtree(t *testing.T) {
+	wfIf := argofake.NewSimpleClientset().ArgoprojV1alpha1().Workflows("")
+	origWf := wfv1.MustUnmarshalWorkflow(suspendedWf)
+
+	ctx := logging.TestContext(t.Context())
+	_, err := wfIf.Create(ctx, origWf, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	err = StopWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend", "", "", ShutdownOptions{Subtree: true})
+	require.EqualError(t, err, "stopping a subtree requires a node field selector")
+
+	err = StopWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend", "displayName=nonexistant", "", ShutdownOptions{Subtree: true})
+	require.Error(t, err)
+
+	gracePeriod := time.Minute
+	opts := ShutdownOptions{Reason: "not needed", GracePeriod: &gracePeriod, Subtree: true}
+	for range 2 {
+		err = StopWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend", "displayName=approve", "", opts)
+		require.NoError(t, err)
+	}
+
+	// the node is left for the controller to stop, rather than failed here, and the workflow is not shut down
+	wf, err := wfIf.Get(ctx, "suspend", metav1.GetOptions{})
+	require.NoError(t, err)
+	node := wf.Status.Nodes.FindByDisplayName("approve")
+	assert.Equal(t, wfv1.NodeRunning, node.Phase)
+	assert.Equal(t, []string{node.ID}, GetStoppedNodes(wf))
+	assert.Equal(t, "not needed", wf.Annotations[common.AnnotationKeyShutdownReason])
+	assert.Equal(t, "1m0s", wf.Annotations[common.AnnotationKeyShutdownGracePeriod])
+	assert.False(t, wf.Spec.Shutdown.Enabled())
+}
+
 // Regression test for #6478
 func TestAddParamToGlobalScopeValueNil(t *testing.T) {
 	paramValue := wfv1.AnyString("test")
@@ -497,6 +563,92 @@ func TestUpdateSuspendedNode(t *testing.T) {
 	require.EqualError(t, err, "cannot set output parameters because node is not expecting any raw parameters")
 }
 
+var retryingWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: retrying
+spec:
+  entrypoint: flaky
+  templates:
+  - name: flaky
+    retryStrategy:
+      limit: 3
+      backoff:
+        duration: 1h
+    outputs:
+      parameters:
+      - name: result
+        valueFrom:
+          path: /tmp/result
+    container:
+      image: argoproj/argosay:v2
+status:
+  phase: Running
+  startedAt: "2020-06-25T18:01:56Z"
+  nodes:
+    retrying:
+      id: retrying
+      name: retrying
+      displayName: retrying
+      type: Retry
+      phase: Running
+      templateName: flaky
+      children:
+      - retrying-1
+    retrying-1:
+      id: retrying-1
+      name: retrying(0)
+      displayName: retrying(0)
+      type: Pod
+      phase: Failed
+      templateName: flaky
+`
+
+func TestSetWorkflow(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wfIf := argofake.NewSimpleClientset().ArgoprojV1alpha1().Workflows("")
+	_, err := wfIf.Create(ctx, wfv1.MustUnmarshalWorkflow(susWorkflow), metav1.CreateOptions{})
+	require.NoError(t, err)
+	_, err = wfIf.Create(ctx, wfv1.MustUnmarshalWorkflow(retryingWorkflow), metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	t.Run("UndeclaredOnSuspendNode", func(t *testing.T) {
+		values := SetOperationValues{OutputParameters: map[string]string{"reviewer": "alice"}}
+		err := SetWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend-template", "displayName=approve", values)
+		require.EqualError(t, err, "node is not expecting output parameter 'reviewer'")
+
+		values.AllowUndeclaredOutputParameters = true
+		err = SetWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend-template", "displayName=approve", values)
+		require.NoError(t, err)
+		wf, err := wfIf.Get(ctx, "suspend-template", metav1.GetOptions{})
+		require.NoError(t, err)
+		params := wf.Status.Nodes["suspend-template-kgfn7-2667278707"].Outputs.Parameters
+		require.Len(t, params, 3)
+		assert.Equal(t, "reviewer", params[2].Name)
+		assert.Equal(t, "alice", params[2].Value.String())
+	})
+
+	t.Run("FailedNodeBeingRetried", func(t *testing.T) {
+		values := SetOperationValues{Phase: wfv1.NodeSucceeded, OutputParameters: map[string]string{"result": "42"}}
+		err := SetWorkflow(ctx, wfIf, hydratorfake.Noop, "retrying", "displayName=retrying(0)", values)
+		require.NoError(t, err)
+		wf, err := wfIf.Get(ctx, "retrying", metav1.GetOptions{})
+		require.NoError(t, err)
+		node := wf.Status.Nodes["retrying-1"]
+		assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+		require.Len(t, node.Outputs.Parameters, 1)
+		assert.Equal(t, "result", node.Outputs.Parameters[0].Name)
+		assert.Equal(t, "42", node.Outputs.Parameters[0].Value.String())
+	})
+
+	t.Run("NotRetried", func(t *testing.T) {
+		// the retry node itself is neither suspended nor a failed attempt
+		err := SetWorkflow(ctx, wfIf, hydratorfake.Noop, "retrying", "displayName=retrying", SetOperationValues{Message: "x"})
+		require.EqualError(t, err, "set only targets suspend nodes and failed nodes being retried: no such nodes matching nodeFieldSelector: displayName=retrying")
+	})
+}
+
 func TestSelectorMatchesNode(t *testing.T) {
 	tests := map[string]struct {
 		selector string
@@ -667,6 +819,18 @@ func TestFormulateResubmitWorkflow(t *testing.T) {
 		assert.Equal(t, "test", wf.OwnerReferences[0].APIVersion)
 		assert.Equal(t, "testObj", wf.OwnerReferences[0].Name)
 	})
+	t.Run("PinWorkflowTemplateDigest", func(t *testing.T) {
+		wf := &wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{common.AnnotationKeyWorkflowTemplateDigest: "sha256:abc"},
+			},
+			Spec: wfv1.WorkflowSpec{WorkflowTemplateRef: &wfv1.WorkflowTemplateRef{Name: "my-wftmpl"}},
+		}
+		newWF, err := FormulateResubmitWorkflow(ctx, wf, false, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "sha256:abc", newWF.Spec.WorkflowTemplateRef.Digest)
+		assert.Empty(t, wf.Spec.WorkflowTemplateRef.Digest)
+	})
 	t.Run("OverrideCreatorLabels", func(t *testing.T) {
 		wf := &wfv1.Workflow{
 			ObjectMeta: metav1.ObjectMeta{
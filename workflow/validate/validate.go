@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path"
 	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -17,6 +19,7 @@ import (
 	apivalidation "k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/yaml"
 
+	"github.com/argoproj/argo-workflows/v3/config"
 	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util"
@@ -27,6 +30,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/hdfs"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
+	"github.com/argoproj/argo-workflows/v3/workflow/podsecurity"
 	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
 )
 
@@ -47,6 +51,21 @@ type ValidateOpts struct {
 	// Submit indicates that the current operation is a workflow submission. This will impose
 	// more stringent requirements (e.g. require input values for all spec arguments)
 	Submit bool
+
+	// TemplateImporter imports the WorkflowTemplates of spec.imports. If nil, the templates referencing an import are
+	// not validated, as the workflow controller validates them once it can import them
+	TemplateImporter templateresolution.TemplateImporter
+
+	// ImagePolicy restricts the images of the templates. Images that depend on inputs are not validated, as the workflow
+	// controller checks them when it creates the pods.
+	ImagePolicy *config.ImagePolicy
+
+	// PodSecurityStandard is the Pod Security Standard the settings of the workflow must not violate
+	PodSecurityStandard string
+
+	// SkipStoringTemplates indicates to not store the templates the workflow references in its status, as the workflow
+	// controller resolves them again from the revisions it pinned
+	SkipStoringTemplates bool
 }
 
 // templateValidationCtx is the context for validating a workflow spec
@@ -85,6 +104,9 @@ const (
 	anyItemMagicValue                    = "item.*"
 	anyWorkflowOutputParameterMagicValue = "workflow.outputs.parameters.*"
 	anyWorkflowOutputArtifactMagicValue  = "workflow.outputs.artifacts.*"
+	// anyIntermediateParameterMagicSuffix follows `tasks.<name>` to allow references to any intermediate parameter of
+	// the task, as they are not declared
+	anyIntermediateParameterMagicSuffix = ".intermediateParameters.*"
 	// The maximum length of maxCharsInObjectName is 63 characters because of the limitation of Kubernetes label
 	// For details, please refer to: https://stackoverflow.com/questions/50412837/kubernetes-label-name-63-character-limit
 	maxCharsInObjectName = 63
@@ -96,6 +118,9 @@ const (
 
 var placeholderGenerator = common.NewPlaceholderGenerator()
 
+// dagHookPhases are the phases of tasks that DAG hooks can run for
+var dagHookPhases = []wfv1.NodePhase{wfv1.NodeRunning, wfv1.NodeSucceeded, wfv1.NodeSkipped, wfv1.NodeFailed, wfv1.NodeError, wfv1.NodeOmitted}
+
 type FakeArguments struct{}
 
 func (args *FakeArguments) GetParameterByName(name string) *wfv1.Parameter {
@@ -137,11 +162,53 @@ func validateHooks(hooks wfv1.LifecycleHooks, hookBaseName string) error {
 	return nil
 }
 
+// validateImports validates the imports of WorkflowTemplates from OCI registries
+func validateImports(imports []wfv1.TemplateImport) error {
+	names := make(map[string]bool)
+	for i, imp := range imports {
+		prefix := fmt.Sprintf("imports[%d]", i)
+		if imp.Name == "" {
+			return errors.Errorf(errors.CodeBadRequest, "%s.name is required", prefix)
+		}
+		if names[imp.Name] {
+			return errors.Errorf(errors.CodeBadRequest, "%s.name '%s' is not unique", prefix, imp.Name)
+		}
+		names[imp.Name] = true
+		if imp.Registry == "" || imp.Repository == "" {
+			return errors.Errorf(errors.CodeBadRequest, "%s registry and repository are required", prefix)
+		}
+		if (imp.Tag == "") == (imp.Digest == "") {
+			return errors.Errorf(errors.CodeBadRequest, "%s must have exactly one of tag or digest", prefix)
+		}
+		if imp.Digest != "" && !strings.HasPrefix(imp.Digest, "sha256:") {
+			return errors.Errorf(errors.CodeBadRequest, "%s.digest '%s' must be a sha256 digest", prefix, imp.Digest)
+		}
+	}
+	return nil
+}
+
+// validateNotifications validates the notifications the controller sends when the workflow changes phase
+func validateNotifications(notifications []wfv1.Notification) error {
+	for i, n := range notifications {
+		prefix := fmt.Sprintf("notifications[%d]", i)
+		if n.Channel == "" {
+			return errors.Errorf(errors.CodeBadRequest, "%s.channel is required", prefix)
+		}
+		for _, event := range n.Events {
+			switch event {
+			case wfv1.WorkflowRunning, wfv1.WorkflowSucceeded, wfv1.WorkflowFailed, wfv1.WorkflowError:
+			default:
+				return errors.Errorf(errors.CodeBadRequest, "%s.events '%s' must be one of Running, Succeeded, Failed or Error", prefix, event)
+			}
+		}
+	}
+	return nil
+}
+
 // ValidateWorkflow accepts a workflow and performs validation against it.
 func ValidateWorkflow(ctx context.Context, wftmplGetter templateresolution.WorkflowTemplateNamespacedGetter, cwftmplGetter templateresolution.ClusterWorkflowTemplateGetter, wf *wfv1.Workflow, wfDefaults *wfv1.Workflow, opts ValidateOpts) error {
 	tctx := newTemplateValidationCtx(wf, opts)
 
-	tmplCtx := templateresolution.NewContext(wftmplGetter, cwftmplGetter, wf, wf, logging.RequireLoggerFromContext(ctx))
 	var wfSpecHolder wfv1.WorkflowSpecHolder
 	var wfTmplRef *wfv1.TemplateRef
 	var err error
@@ -150,6 +217,18 @@ func ValidateWorkflow(ctx context.Context, wftmplGetter templateresolution.Workf
 		return fmt.Errorf("workflow name %q must not be more than 63 characters long (currently %d)", wf.Name, len(wf.Name))
 	}
 
+	if err := validateImports(wf.Spec.Imports); err != nil {
+		return err
+	}
+
+	if err := validateNotifications(wf.Spec.Notifications); err != nil {
+		return err
+	}
+
+	if err := validatePodSecurity(opts, &wf.Spec); err != nil {
+		return err
+	}
+
 	entrypoint := wf.Spec.Entrypoint
 
 	hasWorkflowTemplateRef := wf.Spec.WorkflowTemplateRef != nil
@@ -159,6 +238,11 @@ func ValidateWorkflow(ctx context.Context, wftmplGetter templateresolution.Workf
 		if err != nil {
 			return err
 		}
+		if ref := wf.Spec.WorkflowTemplateRef; ref.Namespace != "" && ref.Namespace != wf.Namespace {
+			// the getters cannot get the templates of other namespaces, so the workflow controller validates the
+			// workflow once it has resolved the template
+			return validateArguments("spec.arguments.", wf.Spec.Arguments, true)
+		}
 		if wf.Spec.WorkflowTemplateRef.ClusterScope {
 			wfSpecHolder, err = cwftmplGetter.Get(ctx, wf.Spec.WorkflowTemplateRef.Name)
 		} else {
@@ -167,11 +251,23 @@ func ValidateWorkflow(ctx context.Context, wftmplGetter templateresolution.Workf
 		if err != nil {
 			return err
 		}
+		if err := validatePodSecurity(opts, wfSpecHolder.GetWorkflowSpec()); err != nil {
+			return err
+		}
 		if entrypoint == "" {
 			entrypoint = wfSpecHolder.GetWorkflowSpec().Entrypoint
 		}
 		wfTmplRef = wf.Spec.WorkflowTemplateRef.ToTemplateRef(entrypoint)
 	}
+	// the imports of the workflow take precedence over those of the referenced template, as they do once merged
+	imports := wf.Spec.Imports
+	if hasWorkflowTemplateRef {
+		imports = append(append([]wfv1.TemplateImport{}, imports...), wfSpecHolder.GetWorkflowSpec().Imports...)
+	}
+	tmplCtx := templateresolution.NewContext(templateresolution.WithImports(wftmplGetter, imports, opts.TemplateImporter), cwftmplGetter, wf, wf, logging.RequireLoggerFromContext(ctx))
+	if opts.SkipStoringTemplates {
+		tmplCtx = tmplCtx.WithoutStoringTemplates()
+	}
 	err = validateWorkflowFieldNames(wf.Spec.Templates)
 
 	wfArgs := wf.Spec.Arguments
@@ -332,6 +428,9 @@ func ValidateWorkflowTemplateRefFields(wfSpec wfv1.WorkflowSpec) error {
 	if len(wfSpec.Templates) > 0 {
 		return errors.Errorf(errors.CodeBadRequest, "Templates is invalid field in spec if workflow referred WorkflowTemplate reference")
 	}
+	if wfSpec.WorkflowTemplateRef != nil && wfSpec.WorkflowTemplateRef.ClusterScope && wfSpec.WorkflowTemplateRef.Namespace != "" {
+		return errors.Errorf(errors.CodeBadRequest, "workflowTemplateRef.namespace cannot be set for a ClusterWorkflowTemplate")
+	}
 	return nil
 }
 
@@ -462,7 +561,7 @@ func (tctx *templateValidationCtx) validateTemplate(ctx context.Context, tmpl *w
 		}
 	}
 
-	newTmpl, err := common.ProcessArgs(ctx, tmpl, args, tctx.globalParams, localParams, true, "", nil)
+	newTmpl, err := common.ProcessArgs(ctx, tmpl, args, tctx.globalParams, localParams, true, "", nil, nil)
 	if err != nil {
 		return errors.Errorf(errors.CodeBadRequest, "templates.%s %s", tmpl.Name, err)
 	}
@@ -497,8 +596,19 @@ func (tctx *templateValidationCtx) validateTemplate(ctx context.Context, tmpl *w
 	case wfv1.TemplateTypeDAG:
 		err = tctx.validateDAG(ctx, scope, tmplCtx, newTmpl, workflowTemplateValidation)
 	default:
-		err = tctx.validateLeaf(scope, tmplCtx, newTmpl, workflowTemplateValidation)
+		if newTmpl.Finally != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.finally is only valid for steps and DAG templates", tmpl.Name)
+		}
+		if newTmpl.ChildWorkflow != nil {
+			err = tctx.validateChildWorkflow(ctx, scope, tmplCtx, newTmpl, workflowTemplateValidation)
+		} else {
+			err = tctx.validateLeaf(scope, tmplCtx, newTmpl, workflowTemplateValidation)
+		}
+	}
+	if err != nil {
+		return err
 	}
+	err = tctx.validateFinally(ctx, scope, tmplCtx, newTmpl, workflowTemplateValidation)
 	if err != nil {
 		return err
 	}
@@ -572,6 +682,10 @@ func (tctx *templateValidationCtx) validateTemplateHolder(ctx context.Context, t
 	}
 
 	tmplCtx, resolvedTmpl, _, err := tmplCtx.ResolveTemplate(ctx, tmplHolder)
+	if err == templateresolution.ErrImportNotResolved {
+		logging.RequireLoggerFromContext(ctx).WithField("tmpl", common.GetTemplateHolderString(tmplHolder)).Warn(ctx, "template reference needs an import")
+		return nil, nil
+	}
 	if err != nil {
 		if argoerr, ok := err.(errors.ArgoError); ok && argoerr.Code() == errors.CodeNotFound {
 			if tmplRef != nil {
@@ -599,18 +713,18 @@ func (tctx *templateValidationCtx) validateTemplateHolder(ctx context.Context, t
 // validateTemplateType validates that only one template type is defined
 func validateTemplateType(tmpl *wfv1.Template) error {
 	numTypes := 0
-	for _, tmplType := range []interface{}{tmpl.Container, tmpl.ContainerSet, tmpl.Steps, tmpl.Script, tmpl.Resource, tmpl.DAG, tmpl.Suspend, tmpl.Data, tmpl.HTTP, tmpl.Plugin} {
+	for _, tmplType := range []interface{}{tmpl.Container, tmpl.ContainerSet, tmpl.Steps, tmpl.Script, tmpl.Resource, tmpl.DAG, tmpl.Suspend, tmpl.Data, tmpl.HTTP, tmpl.Plugin, tmpl.ChildWorkflow, tmpl.Job} {
 		if !reflect.ValueOf(tmplType).IsNil() {
 			numTypes++
 		}
 	}
 	switch numTypes {
 	case 0:
-		return errors.Errorf(errors.CodeBadRequest, "templates.%s template type unspecified. choose one of: container, containerSet, steps, script, resource, dag, suspend, template, template ref", tmpl.Name)
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s template type unspecified. choose one of: container, containerSet, steps, script, resource, dag, suspend, childWorkflow, job, template, template ref", tmpl.Name)
 	case 1:
 		// Do nothing
 	default:
-		return errors.Errorf(errors.CodeBadRequest, "templates.%s multiple template types specified. choose one of: container, containerSet, steps, script, resource, dag, suspend, template, template ref", tmpl.Name)
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s multiple template types specified. choose one of: container, containerSet, steps, script, resource, dag, suspend, childWorkflow, job, template, template ref", tmpl.Name)
 	}
 	return nil
 }
@@ -627,6 +741,13 @@ func validateInputs(tmpl *wfv1.Template) (map[string]interface{}, error) {
 	scope := make(map[string]interface{})
 	for _, param := range tmpl.Inputs.Parameters {
 		scope[fmt.Sprintf("inputs.parameters.%s", param.Name)] = true
+		err = validateParameterSchema(fmt.Sprintf("templates.%s.inputs.parameters.", tmpl.Name), param)
+		if err != nil {
+			return nil, err
+		}
+		if param.IsFromPlugin() && tmpl.HTTP == nil && tmpl.Plugin == nil {
+			return nil, errors.Errorf(errors.CodeBadRequest, "templates.%s.inputs.parameters.%s.valueFrom.plugin is only valid in http and plugin templates", tmpl.Name, param.Name)
+		}
 	}
 	if len(tmpl.Inputs.Parameters) > 0 {
 		scope["inputs.parameters"] = true
@@ -696,6 +817,8 @@ func resolveAllVariables(scope map[string]interface{}, globalParams map[string]s
 				// Allow runtime resolution of workflow output parameter names
 			} else if strings.HasPrefix(trimmedTag, "workflow.outputs.artifacts.") && allowAllWorkflowOutputArtifactRefs {
 				// Allow runtime resolution of workflow output artifact names
+			} else if prefix, _, ok := strings.Cut(trimmedTag, ".intermediateParameters."); ok && scope[prefix+anyIntermediateParameterMagicSuffix] != nil {
+				// Allow runtime resolution of the intermediate parameters a listening task refers to
 			} else if strings.HasPrefix(trimmedTag, "outputs.") {
 				// We are self referencing for metric emission, allow it.
 			} else if strings.HasPrefix(trimmedTag, common.GlobalVarWorkflowCreationTimestamp) {
@@ -729,9 +852,43 @@ func validateNonLeaf(tmpl *wfv1.Template) error {
 	if tmpl.ActiveDeadlineSeconds != nil {
 		return errors.Errorf(errors.CodeBadRequest, "templates.%s.activeDeadlineSeconds is only valid for leaf templates", tmpl.Name)
 	}
+	if tmpl.PodDisruptionBudget != nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.podDisruptionBudget is only valid for templates that run pods", tmpl.Name)
+	}
+	if tmpl.Scratch != nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.scratch is only valid for container, script and containerSet templates", tmpl.Name)
+	}
+	if tmpl.GPU != nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.gpu is only valid for container and script templates", tmpl.Name)
+	}
 	return nil
 }
 
+// validateChildWorkflow validates the spec of a childWorkflow template as a workflow of its own, and the rest of the template as a leaf.
+func (tctx *templateValidationCtx) validateChildWorkflow(ctx context.Context, scope map[string]interface{}, tmplCtx *templateresolution.TemplateContext, tmpl *wfv1.Template, workflowTemplateValidation bool) error {
+	if err := validateCascade(tmpl.ChildWorkflow.Cascade); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.childWorkflow.cascade %s", tmpl.Name, err.Error())
+	}
+	child := &wfv1.Workflow{Spec: *tmpl.ChildWorkflow.Spec.DeepCopy()}
+	err := ValidateWorkflow(ctx, tmplCtx.GetWorkflowTemplateGetter(), tmplCtx.GetClusterWorkflowTemplateGetter(), child, nil, ValidateOpts{Lint: tctx.Lint})
+	if err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.childWorkflow.spec: %s", tmpl.Name, err.Error())
+	}
+	leaf := tmpl.DeepCopy()
+	leaf.ChildWorkflow.Spec = wfv1.WorkflowSpec{Arguments: leaf.ChildWorkflow.Spec.Arguments}
+	return tctx.validateLeaf(scope, tmplCtx, leaf, workflowTemplateValidation)
+}
+
+// validateCascade validates the operations that cascade to the workflows a template creates
+func validateCascade(cascade wfv1.CascadeStrategy) error {
+	switch cascade {
+	case "", wfv1.CascadeAll, wfv1.CascadeShutdown, wfv1.CascadeNone:
+		return nil
+	default:
+		return fmt.Errorf("must be one of: %s, %s, %s", wfv1.CascadeAll, wfv1.CascadeShutdown, wfv1.CascadeNone)
+	}
+}
+
 func (tctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tmplCtx *templateresolution.TemplateContext, tmpl *wfv1.Template, workflowTemplateValidation bool) error {
 	tmplBytes, err := json.Marshal(tmpl)
 	if err != nil {
@@ -741,6 +898,9 @@ func (tctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tm
 	if err != nil {
 		return errors.Errorf(errors.CodeBadRequest, "templates.%s: %s", tmpl.Name, err.Error())
 	}
+	if err := tctx.validateImages(tmpl); err != nil {
+		return err
+	}
 	if tmpl.Container != nil {
 		// Ensure there are no collisions with volume mountPaths and artifact load paths
 		mountPaths := make(map[string]string)
@@ -783,6 +943,25 @@ func (tctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tm
 		}
 
 	}
+	if tmpl.HTTP != nil && tmpl.HTTP.Auth != nil {
+		if err := tmpl.HTTP.Auth.Validate(); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.auth %s", tmpl.Name, err.Error())
+		}
+	}
+	if tmpl.Job != nil {
+		switch tmpl.Job.GetKind() {
+		case wfv1.JobKindJob, wfv1.JobKindJobSet:
+			// OK
+		default:
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.job.kind must be one of: Job, JobSet", tmpl.Name)
+		}
+		if len(tmpl.Job.Spec.Value) == 0 || string(tmpl.Job.Spec.Value) == "null" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.job.spec is required", tmpl.Name)
+		}
+		if tmpl.Outputs.HasOutputs() {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.outputs is not supported for job templates", tmpl.Name)
+		}
+	}
 	if tmpl.Resource != nil {
 		if !placeholderGenerator.IsPlaceholder(tmpl.Resource.Action) {
 			switch tmpl.Resource.Action {
@@ -792,6 +971,13 @@ func (tctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tm
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.action must be one of: get, create, apply, delete, replace, patch", tmpl.Name)
 			}
 		}
+		if err := validateCascade(tmpl.Resource.Cascade); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.cascade %s", tmpl.Name, err.Error())
+		}
+		if tmpl.Resource.Cascade.Shutdown() && tmpl.Resource.Manifest == "" {
+			// the workflow controller labels the workflow of the manifest, so it can find it
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.cascade requires a manifest", tmpl.Name)
+		}
 		if tmpl.Resource.Action != "delete" && tmpl.Resource.Action != "get" {
 			if tmpl.Resource.Manifest == "" && tmpl.Resource.ManifestFrom == nil {
 				return errors.Errorf(errors.CodeBadRequest, "either templates.%s.resource.manifest or templates.%s.resource.manifestFrom must be specified", tmpl.Name, tmpl.Name)
@@ -851,12 +1037,148 @@ func (tctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tm
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.activeDeadlineSeconds must be a positive integer > 0 or an argo variable", tmpl.Name)
 		}
 	}
+	if err := validateTemplatePDB(tmpl); err != nil {
+		return err
+	}
+	if err := validateScratch(tmpl); err != nil {
+		return err
+	}
+	if err := validateGPU(tmpl); err != nil {
+		return err
+	}
+	if tmpl.ProgressWeight != nil && *tmpl.ProgressWeight < 0 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.progressWeight must not be negative", tmpl.Name)
+	}
 	if tmpl.Parallelism != nil {
 		return errors.Errorf(errors.CodeBadRequest, "templates.%s.parallelism is only valid for steps and dag templates", tmpl.Name)
 	}
 	return nil
 }
 
+// validateTemplatePDB validates the PDB of the pods of a template, whose selector the controller limits to the pods of
+// the template in the workflow, so it may not select by the labels the controller sets
+func validateTemplatePDB(tmpl *wfv1.Template) error {
+	pdb := tmpl.PodDisruptionBudget
+	if pdb == nil {
+		return nil
+	}
+	if !tmpl.IsPodType() {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.podDisruptionBudget is only valid for templates that run pods", tmpl.Name)
+	}
+	if pdb.MinAvailable != nil && pdb.MaxUnavailable != nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.podDisruptionBudget may not have both minAvailable and maxUnavailable", tmpl.Name)
+	}
+	if pdb.Selector != nil {
+		for _, key := range []string{common.LabelKeyWorkflow, common.LabelKeyPodDisruptionBudget} {
+			_, ok := pdb.Selector.MatchLabels[key]
+			ok = ok || slices.ContainsFunc(pdb.Selector.MatchExpressions, func(r v1.LabelSelectorRequirement) bool { return r.Key == key })
+			if ok {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.podDisruptionBudget.selector may not select by %s, the controller limits the selector to the pods of the template in the workflow", tmpl.Name, key)
+			}
+		}
+	}
+	return nil
+}
+
+// validateScratch validates the scratch volume of a template, which is mounted in its main containers
+func validateScratch(tmpl *wfv1.Template) error {
+	scratch := tmpl.Scratch
+	if scratch == nil {
+		return nil
+	}
+	switch tmpl.GetType() {
+	case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript, wfv1.TemplateTypeContainerSet:
+	default:
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.scratch is only valid for container, script and containerSet templates", tmpl.Name)
+	}
+	if scratch.StorageSize.Sign() <= 0 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.scratch.size must be greater than zero", tmpl.Name)
+	}
+	mountPath := scratch.GetMountPath()
+	if !path.IsAbs(mountPath) {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.scratch.mountPath '%s' must be an absolute path", tmpl.Name, mountPath)
+	}
+	for _, vol := range tmpl.Volumes {
+		if vol.Name == common.ScratchVolumeName {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.volumes may not have a volume named '%s', it is the volume of the scratch", tmpl.Name, common.ScratchVolumeName)
+		}
+	}
+	for _, volMount := range tmpl.GetVolumeMounts() {
+		if path.Clean(volMount.MountPath) == path.Clean(mountPath) {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.scratch.mountPath '%s' already mounted in volumeMounts.%s", tmpl.Name, mountPath, volMount.Name)
+		}
+	}
+	return nil
+}
+
+// validateGPU validates the GPU request of a template. Whether its type has an accelerator profile is only known to the
+// controller.
+func validateGPU(tmpl *wfv1.Template) error {
+	gpu := tmpl.GPU
+	if gpu == nil {
+		return nil
+	}
+	switch tmpl.GetType() {
+	case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript:
+	default:
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.gpu is only valid for container and script templates", tmpl.Name)
+	}
+	if gpu.Count <= 0 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.gpu.count must be greater than zero", tmpl.Name)
+	}
+	return nil
+}
+
+// validatePodSecurity validates the settings of the workflow spec against the Pod Security Standard
+func validatePodSecurity(opts ValidateOpts, spec *wfv1.WorkflowSpec) error {
+	if opts.PodSecurityStandard != podsecurity.Restricted {
+		return nil
+	}
+	if err := podsecurity.CheckWorkflowSpec(spec); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "spec.%s", err.Error())
+	}
+	return nil
+}
+
+// validateImages validates the images of the template against the image policy
+func (tctx *templateValidationCtx) validateImages(tmpl *wfv1.Template) error {
+	if tctx.ImagePolicy == nil {
+		return nil
+	}
+	var fields, images []string
+	add := func(field, image string) {
+		fields = append(fields, field)
+		images = append(images, image)
+	}
+	if tmpl.Container != nil {
+		add("container", tmpl.Container.Image)
+	}
+	if tmpl.Script != nil {
+		add("script", tmpl.Script.Image)
+	}
+	if tmpl.ContainerSet != nil {
+		for i, c := range tmpl.ContainerSet.Containers {
+			add(fmt.Sprintf("containerSet.containers[%d]", i), c.Image)
+		}
+	}
+	for i, c := range tmpl.InitContainers {
+		add(fmt.Sprintf("initContainers[%d]", i), c.Image)
+	}
+	for i, c := range tmpl.Sidecars {
+		add(fmt.Sprintf("sidecars[%d]", i), c.Image)
+	}
+	for i, image := range images {
+		// empty images are defaulted, and inputs are resolved, when the pod is created
+		if image == "" || strings.Contains(image, "{{") || strings.Contains(image, "placeholder-") {
+			continue
+		}
+		if err := tctx.ImagePolicy.Check(image); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.image: %s", tmpl.Name, fields[i], err.Error())
+		}
+	}
+	return nil
+}
+
 func validateArguments(prefix string, arguments wfv1.Arguments, allowEmptyValues bool) error {
 	err := validateArgumentsFieldNames(prefix, arguments)
 	if err != nil {
@@ -890,15 +1212,19 @@ func validateArgumentsValues(prefix string, arguments wfv1.Arguments, allowEmpty
 		}
 		if param.ValueFrom != nil {
 			// check for valid valueFrom sub-parameters
-			// INFO: default needs to be accompanied by ConfigMapKeyRef.
-			if param.ValueFrom.ConfigMapKeyRef == nil && param.ValueFrom.Event == "" && param.ValueFrom.Supplied == nil {
-				return errors.Errorf(errors.CodeBadRequest, "%s%s.valueFrom only allows: default, configMapKeyRef and supplied", prefix, param.Name)
+			// INFO: default needs to be accompanied by ConfigMapKeyRef or SecretKeyRef.
+			if param.ValueFrom.ConfigMapKeyRef == nil && param.ValueFrom.SecretKeyRef == nil && param.ValueFrom.Event == "" && param.ValueFrom.Supplied == nil {
+				return errors.Errorf(errors.CodeBadRequest, "%s%s.valueFrom only allows: default, configMapKeyRef, secretKeyRef and supplied", prefix, param.Name)
 			}
 			// check for invalid valueFrom sub-parameters
-			if param.ValueFrom.Path != "" || param.ValueFrom.JSONPath != "" || param.ValueFrom.Parameter != "" || param.ValueFrom.Expression != "" {
-				return errors.Errorf(errors.CodeBadRequest, "%s%s.valueFrom only allows: default, configMapKeyRef and supplied", prefix, param.Name)
+			if param.ValueFrom.Path != "" || param.ValueFrom.JSONPath != "" || param.ValueFrom.Parameter != "" || param.ValueFrom.Expression != "" || param.ValueFrom.Plugin != nil {
+				return errors.Errorf(errors.CodeBadRequest, "%s%s.valueFrom only allows: default, configMapKeyRef, secretKeyRef and supplied", prefix, param.Name)
 			}
 		}
+		err := validateParameterSchema(prefix, param)
+		if err != nil {
+			return err
+		}
 		// validate enum
 		if param.Enum != nil {
 			if len(param.Enum) == 0 {
@@ -933,6 +1259,29 @@ func validateArgumentsValues(prefix string, arguments wfv1.Arguments, allowEmpty
 	return nil
 }
 
+// validateParameterSchema checks the parameter's schema, and that its value and default are allowed by it.
+// Values that are not resolved until runtime are validated when the template is called.
+func validateParameterSchema(prefix string, param wfv1.Parameter) error {
+	if param.Schema == nil {
+		return nil
+	}
+	if err := param.Schema.Validate(param.Enum); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "%s%s.%s", prefix, param.Name, err.Error())
+	}
+	for _, field := range []struct {
+		name  string
+		value *wfv1.AnyString
+	}{{"value", param.Value}, {"default", param.Default}} {
+		if field.value == nil || strings.Contains(field.value.String(), "{{") {
+			continue
+		}
+		if err := param.Schema.ValidateValue(field.value.String(), param.Enum); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "%s%s.%s %s", prefix, param.Name, field.name, err.Error())
+		}
+	}
+	return nil
+}
+
 func (tctx *templateValidationCtx) validateSteps(ctx context.Context, scope map[string]interface{}, tmplCtx *templateresolution.TemplateContext, tmpl *wfv1.Template, workflowTemplateValidation bool) error {
 	err := validateNonLeaf(tmpl)
 	if err != nil {
@@ -1076,6 +1425,9 @@ func (tctx *templateValidationCtx) addOutputsToScope(ctx context.Context, tmpl *
 	}
 	for _, param := range tmpl.Outputs.Parameters {
 		scope[fmt.Sprintf("%s.outputs.parameters.%s", prefix, param.Name)] = true
+		if param.Optional {
+			scope[fmt.Sprintf("%s.outputs.parameters.%s.supplied", prefix, param.Name)] = true
+		}
 		if param.GlobalName != "" {
 			if !isParameter(param.GlobalName) {
 				globalParamName := fmt.Sprintf("workflow.outputs.parameters.%s", param.GlobalName)
@@ -1156,10 +1508,17 @@ func validateOutputs(scope map[string]interface{}, globalParams map[string]strin
 	}
 	for _, param := range tmpl.Outputs.Parameters {
 		paramRef := fmt.Sprintf("templates.%s.outputs.parameters.%s", tmpl.Name, param.Name)
-		err = validateOutputParameter(paramRef, &param)
+		if tmpl.ChildWorkflow != nil {
+			err = validateChildWorkflowOutputParameter(paramRef, &param)
+		} else {
+			err = validateOutputParameter(paramRef, &param)
+		}
 		if err != nil {
 			return err
 		}
+		if param.Optional && (param.ValueFrom == nil || param.ValueFrom.Path == "") {
+			return errors.Errorf(errors.CodeBadRequest, "%s.optional is only supported with valueFrom.path", paramRef)
+		}
 		if param.ValueFrom != nil {
 			tmplType := tmpl.GetType()
 			switch tmplType {
@@ -1220,6 +1579,15 @@ func validateOutputParameter(paramRef string, param *wfv1.Parameter) error {
 	return nil
 }
 
+// validateChildWorkflowOutputParameter verifies that an output of a childWorkflow template specifies no more than a default,
+// as its value is the child workflow's output of the same name
+func validateChildWorkflowOutputParameter(paramRef string, param *wfv1.Parameter) error {
+	if param.Value != nil || (param.ValueFrom != nil && *param.ValueFrom != (wfv1.ValueFrom{Default: param.ValueFrom.Default})) {
+		return errors.Errorf(errors.CodeBadRequest, "%s may only specify valueFrom.default, its value is the child workflow's output of the same name", paramRef)
+	}
+	return nil
+}
+
 // validateWorkflowFieldNames accepts a slice of structs and
 // verifies that the Name field of the structs are:
 // * unique
@@ -1390,6 +1758,9 @@ func (tctx *templateValidationCtx) validateDAG(ctx context.Context, scope map[st
 	if err = verifyNoCycles(ctx, tmpl, dagValidationCtx); err != nil {
 		return err
 	}
+	if err = validateDAGOneOf(tmpl, nameToTask); err != nil {
+		return err
+	}
 	err = resolveAllVariables(scope, tctx.globalParams, tmpl.DAG.Target, workflowTemplateValidation)
 	if err != nil {
 		return errors.Errorf(errors.CodeBadRequest, "templates.%s.targets %s", tmpl.Name, err.Error())
@@ -1432,6 +1803,18 @@ func (tctx *templateValidationCtx) validateDAG(ctx context.Context, scope map[st
 			}
 		}
 
+		// A suspend task can listen for the intermediate parameters of the other tasks in its when
+		if strings.Contains(task.When, ".intermediateParameters.") && resolvedTmpl.GetType() == wfv1.TemplateTypeSuspend {
+			if task.ShouldExpand() {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s cannot expand withItems, withParam or withSequence when its 'when' refers to intermediate parameters", tmpl.Name, task.Name)
+			}
+			for name := range nameToTask {
+				if name != task.Name {
+					taskScope["tasks."+name+anyIntermediateParameterMagicSuffix] = true
+				}
+			}
+		}
+
 		err = addItemsToScope(task.WithItems, task.WithParam, task.WithSequence, taskScope)
 		if err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
@@ -1455,6 +1838,168 @@ func (tctx *templateValidationCtx) validateDAG(ctx context.Context, scope map[st
 		}
 	}
 
+	return tctx.validateDAGHooks(ctx, scope, tmplCtx, tmpl, workflowTemplateValidation)
+}
+
+// validateDAGOneOf validates the oneOf groups of a DAG template. As only one of their branches runs, and the others are
+// skipped, the branches must be ready to run at the same time, and only the group decides whether they do.
+func validateDAGOneOf(tmpl *wfv1.Template, nameToTask map[string]wfv1.DAGTask) error {
+	names := make(map[string]bool)
+	branchTasks := make(map[string]bool)
+	for i, oneOf := range tmpl.DAG.OneOf {
+		if oneOf.Name == "" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.dag.oneOf[%d].name is required", tmpl.Name, i)
+		}
+		if names[oneOf.Name] {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.dag.oneOf.%s is not unique", tmpl.Name, oneOf.Name)
+		}
+		names[oneOf.Name] = true
+		prefix := fmt.Sprintf("templates.%s.dag.oneOf.%s", tmpl.Name, oneOf.Name)
+		if oneOf.Expression == "" {
+			return errors.Errorf(errors.CodeBadRequest, "%s.expression is required", prefix)
+		}
+		if len(oneOf.Branches) < 2 {
+			return errors.Errorf(errors.CodeBadRequest, "%s must have at least two branches", prefix)
+		}
+		values := make(map[string]bool)
+		hasDefault := false
+		first := nameToTask[oneOf.Branches[0].Task]
+		for _, branch := range oneOf.Branches {
+			task, ok := nameToTask[branch.Task]
+			if !ok {
+				return errors.Errorf(errors.CodeBadRequest, "%s.branches task '%s' not defined", prefix, branch.Task)
+			}
+			if branchTasks[branch.Task] {
+				return errors.Errorf(errors.CodeBadRequest, "%s.branches task '%s' is already a branch of a oneOf", prefix, branch.Task)
+			}
+			branchTasks[branch.Task] = true
+			if branch.Default {
+				if hasDefault {
+					return errors.Errorf(errors.CodeBadRequest, "%s can only have one default branch", prefix)
+				}
+				hasDefault = true
+			} else if len(branch.Values) == 0 {
+				return errors.Errorf(errors.CodeBadRequest, "%s.branches.%s must have values or be the default branch", prefix, branch.Task)
+			}
+			for _, value := range branch.Values {
+				if values[value] {
+					return errors.Errorf(errors.CodeBadRequest, "%s.branches.%s value %q selects more than one branch", prefix, branch.Task, value)
+				}
+				values[value] = true
+			}
+			if task.When != "" {
+				return errors.Errorf(errors.CodeBadRequest, "%s.branches.%s cannot have a 'when' condition", prefix, branch.Task)
+			}
+			if task.Depends != first.Depends || !sameElements(task.Dependencies, first.Dependencies) {
+				return errors.Errorf(errors.CodeBadRequest, "%s.branches.%s must have the same dependencies as %s", prefix, branch.Task, first.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func sameElements(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+// validateDAGHooks validates the hooks of a DAG template. Their references to the task that triggered them, such as
+// `{{task.outputs.parameters.message}}`, are resolved at runtime, as the task is only known then.
+func (tctx *templateValidationCtx) validateDAGHooks(ctx context.Context, scope map[string]interface{}, tmplCtx *templateresolution.TemplateContext, tmpl *wfv1.Template, workflowTemplateValidation bool) error {
+	names := make(map[string]bool)
+	for i, hook := range tmpl.DAG.Hooks {
+		if hook.Name == "" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.dag.hooks[%d].name is required", tmpl.Name, i)
+		}
+		if names[hook.Name] {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.dag.hooks.%s is not unique", tmpl.Name, hook.Name)
+		}
+		names[hook.Name] = true
+		prefix := fmt.Sprintf("templates.%s.dag.hooks.%s", tmpl.Name, hook.Name)
+		for _, phase := range hook.Phases {
+			if !slices.Contains(dagHookPhases, phase) {
+				return errors.Errorf(errors.CodeBadRequest, "%s.phases %q must be one of: %v", prefix, phase, dagHookPhases)
+			}
+		}
+		for _, task := range tmpl.DAG.Tasks {
+			matches, err := hook.MatchesTask(task.Name)
+			if err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "%s.tasks %s", prefix, err.Error())
+			}
+			if !matches {
+				continue
+			}
+			if _, ok := task.Hooks[wfv1.LifecycleEvent(hook.Name)]; ok {
+				return errors.Errorf(errors.CodeBadRequest, "%s has the same name as a hook of task %s", prefix, task.Name)
+			}
+		}
+		holder := &wfv1.WorkflowStep{Template: hook.Template, TemplateRef: hook.TemplateRef, Arguments: hook.Arguments}
+		_, err := tctx.validateTemplateHolder(ctx, holder, tmplCtx, &FakeArguments{}, workflowTemplateValidation)
+		if err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "%s %s", prefix, err.Error())
+		}
+		err = validateArguments(prefix+".arguments.", holder.Arguments, false)
+		if err != nil {
+			return err
+		}
+		argsBytes, err := json.Marshal(holder.Arguments)
+		if err != nil {
+			return errors.InternalWrapError(err)
+		}
+		err = resolveAllVariables(scope, tctx.globalParams, string(argsBytes), workflowTemplateValidation)
+		if err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "%s %s", prefix, err.Error())
+		}
+		// Validate the template again with actual arguments.
+		_, err = tctx.validateTemplateHolder(ctx, holder, tmplCtx, &holder.Arguments, workflowTemplateValidation)
+		if err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "%s %s", prefix, err.Error())
+		}
+	}
+	return nil
+}
+
+// validateFinally validates the finally hook of a steps or DAG template, which can refer to the outputs of all of its
+// steps or tasks, and to their status and failures
+func (tctx *templateValidationCtx) validateFinally(ctx context.Context, scope map[string]interface{}, tmplCtx *templateresolution.TemplateContext, tmpl *wfv1.Template, workflowTemplateValidation bool) error {
+	if tmpl.Finally == nil {
+		return nil
+	}
+	finallyScope := make(map[string]interface{})
+	for k, v := range scope {
+		finallyScope[k] = v
+	}
+	finallyScope[common.LocalVarStatus] = true
+	finallyScope[common.LocalVarFailures] = true
+	if tmpl.DAG != nil {
+		for _, task := range tmpl.DAG.Tasks {
+			finallyScope[fmt.Sprintf("tasks.%s.status", task.Name)] = true
+		}
+	}
+	finally := &wfv1.WorkflowStep{Template: tmpl.Finally.Template, TemplateRef: tmpl.Finally.TemplateRef, Arguments: tmpl.Finally.Arguments}
+	_, err := tctx.validateTemplateHolder(ctx, finally, tmplCtx, &FakeArguments{}, workflowTemplateValidation)
+	if err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.finally %s", tmpl.Name, err.Error())
+	}
+	err = validateArguments(fmt.Sprintf("templates.%s.finally.arguments.", tmpl.Name), finally.Arguments, false)
+	if err != nil {
+		return err
+	}
+	argsBytes, err := json.Marshal(finally.Arguments)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	err = resolveAllVariables(finallyScope, tctx.globalParams, string(argsBytes), workflowTemplateValidation)
+	if err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.finally %s", tmpl.Name, err.Error())
+	}
+	// Validate the template again with actual arguments.
+	_, err = tctx.validateTemplateHolder(ctx, finally, tmplCtx, &finally.Arguments, workflowTemplateValidation)
+	if err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.finally %s", tmpl.Name, err.Error())
+	}
 	return nil
 }
 
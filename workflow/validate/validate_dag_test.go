@@ -1059,7 +1059,7 @@ spec:
 
 func TestDAGMissingParamValueInTask(t *testing.T) {
 	err := validate(logging.TestContext(t.Context()), dagMissingParamValueInTask)
-	require.ErrorContains(t, err, ".valueFrom only allows: default, configMapKeyRef and supplied")
+	require.ErrorContains(t, err, ".valueFrom only allows: default, configMapKeyRef, secretKeyRef and supplied")
 }
 
 var dagArgParamValueFromConfigMapInTask = `
@@ -1122,5 +1122,5 @@ spec:
 
 func TestFailDAGArgParamValueFromPathInTask(t *testing.T) {
 	err := validate(logging.TestContext(t.Context()), failDagArgParamValueFromPathInTask)
-	require.ErrorContains(t, err, "valueFrom only allows: default, configMapKeyRef and supplied")
+	require.ErrorContains(t, err, "valueFrom only allows: default, configMapKeyRef, secretKeyRef and supplied")
 }
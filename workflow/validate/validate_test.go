@@ -2,6 +2,7 @@ package validate
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 	"strings"
 	"testing"
@@ -13,6 +14,7 @@ import (
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-workflows/v3/config"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	fakewfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
@@ -2257,6 +2259,32 @@ func TestValidateFieldsWithWFTRef(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestWorkflowWithCrossNamespaceWFTRef(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	// the template is resolved, and the workflow validated, by the controller
+	require.NoError(t, validate(ctx, `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: hello-world-
+spec:
+  workflowTemplateRef:
+    name: not-in-this-namespace
+    namespace: shared
+`))
+	require.EqualError(t, validate(ctx, `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: hello-world-
+spec:
+  workflowTemplateRef:
+    name: my-cwft
+    namespace: shared
+    clusterScope: true
+`), "workflowTemplateRef.namespace cannot be set for a ClusterWorkflowTemplate")
+}
+
 var invalidWfNoImage = `apiVersion: argoproj.io/v1alpha1
 kind: Workflow
 metadata:
@@ -3365,3 +3393,894 @@ func TestShouldCheckValidationToSpacedParameters(t *testing.T) {
 	// Do not allow leading or trailing spaces in parameters
 	require.ErrorContains(t, err, "failed to resolve {{  workflow.thisdoesnotexist  }}")
 }
+
+var workflowWithParameterSchema = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: parameter-schema-
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+      - name: parallelism
+        value: "%s"
+        schema:
+          type: integer
+          minimum: 1
+  templates:
+    - name: main
+      steps:
+        - - name: run
+            template: run
+            arguments:
+              parameters:
+                - name: mode
+                  value: "%s"
+                - name: parallelism
+                  value: "{{workflow.parameters.parallelism}}"
+    - name: run
+      inputs:
+        parameters:
+          - name: mode
+            enum: [fast, slow]
+            schema:
+              type: enum
+          - name: parallelism
+            schema:
+              type: integer
+      container:
+        image: argoproj/argosay:v2
+`
+
+func TestParameterSchema(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	require.NoError(t, validate(ctx, fmt.Sprintf(workflowWithParameterSchema, "10", "fast")))
+	require.EqualError(t, validate(ctx, fmt.Sprintf(workflowWithParameterSchema, "ten", "fast")), `spec.arguments.parallelism.value "ten" is not an integer`)
+	require.EqualError(t, validate(ctx, fmt.Sprintf(workflowWithParameterSchema, "0", "fast")), `spec.arguments.parallelism.value 0 is less than the minimum 1`)
+	require.ErrorContains(t, validate(ctx, fmt.Sprintf(workflowWithParameterSchema, "10", "fats")), `inputs.parameters.mode: "fats" is not one of the enum values`)
+}
+
+func TestParameterSchemaInvalid(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	err := validate(ctx, `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: parameter-schema-
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      inputs:
+        parameters:
+          - name: name
+            default: "Foo"
+            schema:
+              pattern: "^[a-z]+$"
+      container:
+        image: argoproj/argosay:v2
+`)
+	require.EqualError(t, err, `templates.main.inputs.parameters.name.default "Foo" does not match the pattern "^[a-z]+$"`)
+	err = validate(ctx, `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: parameter-schema-
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      inputs:
+        parameters:
+          - name: count
+            schema:
+              type: number
+      container:
+        image: argoproj/argosay:v2
+`)
+	require.EqualError(t, err, `templates.main.inputs.parameters.count.schema.type "number" must be one of: string, integer, boolean, enum`)
+}
+
+var workflowWithOptionalOutputParameter = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: optional-output-parameter-
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      steps:
+        - - name: produce
+            template: produce
+        - - name: consume
+            template: consume
+            when: "{{steps.produce.outputs.parameters.result.supplied}} == true"
+            arguments:
+              parameters:
+                - name: result
+                  value: "{{steps.produce.outputs.parameters.result}}"
+    - name: produce
+      outputs:
+        parameters:
+          - name: result
+            optional: true
+            valueFrom:
+              %s
+      container:
+        image: argoproj/argosay:v2
+    - name: consume
+      inputs:
+        parameters:
+          - name: result
+      container:
+        image: argoproj/argosay:v2
+`
+
+func TestOptionalOutputParameter(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	require.NoError(t, validate(ctx, fmt.Sprintf(workflowWithOptionalOutputParameter, "path: /tmp/result")))
+	require.EqualError(t, validate(ctx, fmt.Sprintf(workflowWithOptionalOutputParameter, "parameter: foo")), "templates.main.steps[0].produce templates.produce.outputs.parameters.result.optional is only supported with valueFrom.path")
+}
+
+var workflowWithFinally = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: finally-
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      dag:
+        tasks:
+          - name: a
+            template: %s
+      finally:
+        template: cleanup
+        arguments:
+          parameters:
+            - name: message
+              value: "%s"
+    - name: produce
+      outputs:
+        parameters:
+          - name: result
+            valueFrom:
+              path: /tmp/result
+      container:
+        image: argoproj/argosay:v2
+      %s
+    - name: cleanup
+      inputs:
+        parameters:
+          - name: message
+            value: ""
+      container:
+        image: argoproj/argosay:v2
+`
+
+func TestFinally(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	require.NoError(t, validate(ctx, fmt.Sprintf(workflowWithFinally, "produce", "{{status}} {{failures}} {{tasks.a.status}} {{tasks.a.outputs.parameters.result}}", "")))
+	require.ErrorContains(t, validate(ctx, fmt.Sprintf(workflowWithFinally, "produce", "{{tasks.b.status}}", "")), "templates.main.finally failed to resolve {{tasks.b.status}}")
+	require.ErrorContains(t, validate(ctx, fmt.Sprintf(workflowWithFinally, "cleanup", "", "finally: {template: cleanup}")), "templates.produce.finally is only valid for steps and DAG templates")
+}
+
+var workflowWithExtends = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: extends-
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      steps:
+        - - name: say
+            template: say
+            arguments:
+              parameters:
+                - name: message
+                  value: hello
+    - name: base
+      inputs:
+        parameters:
+          - name: message
+      container:
+        image: argoproj/argosay:v2
+        args: [echo, "{{inputs.parameters.message}}"]
+    - name: say
+      extends:
+        template: %s
+      container:
+        env:
+          - name: FOO
+            value: foo
+`
+
+func TestExtends(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	require.NoError(t, validate(ctx, fmt.Sprintf(workflowWithExtends, "base")))
+	require.ErrorContains(t, validate(ctx, fmt.Sprintf(workflowWithExtends, "say")), "template say extends itself")
+}
+
+var workflowWithChildWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: child-workflow-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: child
+        template: child
+    - - name: print
+        template: print
+        arguments:
+          parameters:
+          - name: result
+            value: '{{steps.child.outputs.parameters.result}}'
+  - name: child
+    outputs:
+      parameters:
+      - name: result
+%s
+    childWorkflow:
+      spec:
+        entrypoint: %s
+        arguments:
+          parameters:
+          - name: message
+            value: '{{workflow.name}}'
+        templates:
+        - name: say
+          container:
+            image: argoproj/argosay:v2
+            args: ['{{workflow.parameters.message}}']
+  - name: print
+    inputs:
+      parameters:
+      - name: result
+    container:
+      image: argoproj/argosay:v2
+      args: ['{{inputs.parameters.result}}']
+`
+
+func TestChildWorkflow(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	require.NoError(t, validate(ctx, fmt.Sprintf(workflowWithChildWorkflow, "", "say")))
+	require.NoError(t, validate(ctx, fmt.Sprintf(workflowWithChildWorkflow, "        valueFrom: {default: none}", "say")))
+	require.ErrorContains(t, validate(ctx, fmt.Sprintf(workflowWithChildWorkflow, "", "missing")), "templates.child.childWorkflow.spec: ")
+	require.ErrorContains(t, validate(ctx, fmt.Sprintf(workflowWithChildWorkflow, "        valueFrom: {path: /tmp/result}", "say")), "may only specify valueFrom.default")
+}
+
+var workflowWithJob = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: job-
+spec:
+  entrypoint: train
+  templates:
+  - name: train
+%s
+    job:
+      kind: %s
+%s
+`
+
+func TestJob(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	spec := "      spec:\n        template:\n          spec:\n            containers:\n            - name: main\n              image: argoproj/argosay:v2"
+	outputs := "    outputs:\n      parameters:\n      - name: result\n        valueFrom: {path: /tmp/result}"
+	require.NoError(t, validate(ctx, fmt.Sprintf(workflowWithJob, "", "Job", spec)))
+	require.NoError(t, validate(ctx, fmt.Sprintf(workflowWithJob, "", "JobSet", spec)))
+	require.EqualError(t, validate(ctx, fmt.Sprintf(workflowWithJob, "", "Deployment", spec)), "templates.train.job.kind must be one of: Job, JobSet")
+	require.EqualError(t, validate(ctx, fmt.Sprintf(workflowWithJob, "", "Job", "")), "templates.train.job.spec is required")
+	require.EqualError(t, validate(ctx, fmt.Sprintf(workflowWithJob, outputs, "Job", spec)), "templates.train.outputs is not supported for job templates")
+}
+
+var workflowWithResourceCascade = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: resource-cascade-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    inputs:
+      artifacts:
+      - name: manifest
+        path: /tmp/manifest.yaml
+        raw:
+          data: |
+            apiVersion: argoproj.io/v1alpha1
+            kind: Workflow
+    resource:
+      action: create
+      cascade: %s
+%s
+`
+
+func TestCascade(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	manifest := "      manifest: |\n        apiVersion: argoproj.io/v1alpha1\n        kind: Workflow"
+	manifestFrom := "      manifestFrom:\n        artifact:\n          name: manifest"
+	require.NoError(t, validate(ctx, fmt.Sprintf(workflowWithResourceCascade, "All", manifest)))
+	require.NoError(t, validate(ctx, fmt.Sprintf(workflowWithResourceCascade, "None", manifestFrom)))
+	require.EqualError(t, validate(ctx, fmt.Sprintf(workflowWithResourceCascade, "Shutdown", manifestFrom)), "templates.main.resource.cascade requires a manifest")
+	require.EqualError(t, validate(ctx, fmt.Sprintf(workflowWithResourceCascade, "Suspend", manifest)), "templates.main.resource.cascade must be one of: All, Shutdown, None")
+	require.ErrorContains(t, validate(ctx, strings.Replace(fmt.Sprintf(workflowWithChildWorkflow, "", "say"), "    childWorkflow:\n", "    childWorkflow:\n      cascade: Suspend\n", 1)), "templates.child.childWorkflow.cascade must be one of: All, Shutdown, None")
+}
+
+var workflowWithImports = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: imports-
+spec:
+  entrypoint: main
+  imports:
+  - name: ci
+    registry: ghcr.io
+    repository: my-org/ci-templates
+%s
+  templates:
+  - name: main
+    steps:
+    - - name: build
+        templateRef:
+          name: ci
+          template: build
+`
+
+type templateImporterFunc func(imp wfv1.TemplateImport) (*wfv1.WorkflowTemplate, error)
+
+func (f templateImporterFunc) Import(_ context.Context, imp wfv1.TemplateImport) (*wfv1.WorkflowTemplate, error) {
+	return f(imp)
+}
+
+func TestImports(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	validateImports := func(version string, importer templateresolution.TemplateImporter) error {
+		return ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, unmarshalWf(fmt.Sprintf(workflowWithImports, version)), nil, ValidateOpts{TemplateImporter: importer})
+	}
+	imported := templateImporterFunc(func(imp wfv1.TemplateImport) (*wfv1.WorkflowTemplate, error) {
+		return unmarshalWftmpl(`
+metadata:
+  name: ci-templates
+spec:
+  templates:
+  - name: build
+    container:
+      image: golang
+`), nil
+	})
+	t.Run("NotImported", func(t *testing.T) {
+		require.NoError(t, validateImports("    tag: v1", nil))
+	})
+	t.Run("Imported", func(t *testing.T) {
+		require.NoError(t, validateImports("    tag: v1", imported))
+	})
+	t.Run("TemplateNotFound", func(t *testing.T) {
+		err := validateImports("    tag: v1", templateImporterFunc(func(imp wfv1.TemplateImport) (*wfv1.WorkflowTemplate, error) {
+			return &wfv1.WorkflowTemplate{}, nil
+		}))
+		require.EqualError(t, err, "templates.main.steps[0].build template reference ci.build not found")
+	})
+	t.Run("Digest", func(t *testing.T) {
+		require.NoError(t, validateImports("    digest: sha256:"+strings.Repeat("0", 64), imported))
+		require.EqualError(t, validateImports("    digest: md5:0", imported), "imports[0].digest 'md5:0' must be a sha256 digest")
+	})
+	t.Run("TagAndDigest", func(t *testing.T) {
+		require.EqualError(t, validateImports("", imported), "imports[0] must have exactly one of tag or digest")
+		require.EqualError(t, validateImports("    tag: v1\n    digest: sha256:0", imported), "imports[0] must have exactly one of tag or digest")
+	})
+	t.Run("DuplicateName", func(t *testing.T) {
+		require.EqualError(t, validateImports("    tag: v1\n  - name: ci\n    registry: ghcr.io\n    repository: my-org/ci\n    tag: v1", imported), "imports[1].name 'ci' is not unique")
+	})
+}
+
+var workflowWithDAGHooks = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: dag-hooks-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: deploy
+        template: produce
+      - name: test
+        template: produce
+        hooks:
+          running:
+            expression: tasks.test.status == "Running"
+            template: notify
+            arguments:
+              parameters:
+              - name: message
+                value: running
+      hooks:
+      - name: %s
+        tasks: '%s'
+        phases: [%s]
+        template: notify
+        arguments:
+          parameters:
+          - name: message
+            value: '%s'
+  - name: produce
+    container:
+      image: alpine
+    outputs:
+      parameters:
+      - name: version
+        valueFrom:
+          path: /version
+  - name: notify
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: alpine
+`
+
+func TestDAGHooks(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	require.NoError(t, validate(ctx, fmt.Sprintf(workflowWithDAGHooks, "notify", "deploy|test", "Failed, Error", "{{task.name}} {{task.status}} {{task.message}} {{task.outputs.parameters.version}}")))
+	require.NoError(t, validate(ctx, fmt.Sprintf(workflowWithDAGHooks, "notify", "", "", "{{task.id}}")))
+	err := validate(ctx, fmt.Sprintf(workflowWithDAGHooks, "notify", "", "Pending", "{{task.name}}"))
+	require.ErrorContains(t, err, "templates.main.dag.hooks.notify.phases \"Pending\" must be one of")
+	err = validate(ctx, fmt.Sprintf(workflowWithDAGHooks, "notify", "(", "", "{{task.name}}"))
+	require.ErrorContains(t, err, "templates.main.dag.hooks.notify.tasks")
+	err = validate(ctx, fmt.Sprintf(workflowWithDAGHooks, "notify", "", "", "{{workflow.parameters.other}}"))
+	require.ErrorContains(t, err, "templates.main.dag.hooks.notify failed to resolve {{workflow.parameters.other}}")
+	err = validate(ctx, fmt.Sprintf(workflowWithDAGHooks, "running", "", "", "{{task.name}}"))
+	require.ErrorContains(t, err, "templates.main.dag.hooks.running has the same name as a hook of task test")
+}
+
+var workflowWithDAGOneOf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: dag-one-of-
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: environment
+      value: production
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: build
+        template: echo
+      - name: deploy-production
+        template: echo
+        depends: build
+      - name: deploy-staging
+        template: echo
+        depends: %s
+      - name: verify
+        template: echo
+        depends: deploy-production && deploy-staging
+      oneOf:
+      - name: deploy
+        expression: workflow.parameters.environment
+        branches:
+        - task: deploy-production
+          values: [production]
+        - task: %s
+          %s
+  - name: echo
+    container:
+      image: alpine
+`
+
+func TestDAGOneOf(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	require.NoError(t, validate(ctx, fmt.Sprintf(workflowWithDAGOneOf, "build", "deploy-staging", "default: true")))
+	require.NoError(t, validate(ctx, fmt.Sprintf(workflowWithDAGOneOf, "build", "deploy-staging", "values: [staging]")))
+	err := validate(ctx, fmt.Sprintf(workflowWithDAGOneOf, "build", "deploy-staging", "values: [production]"))
+	require.ErrorContains(t, err, `templates.main.dag.oneOf.deploy.branches.deploy-staging value "production" selects more than one branch`)
+	err = validate(ctx, fmt.Sprintf(workflowWithDAGOneOf, "build", "deploy-staging", "values: []"))
+	require.ErrorContains(t, err, "templates.main.dag.oneOf.deploy.branches.deploy-staging must have values or be the default branch")
+	err = validate(ctx, fmt.Sprintf(workflowWithDAGOneOf, "build", "deploy-other", "default: true"))
+	require.ErrorContains(t, err, "templates.main.dag.oneOf.deploy.branches task 'deploy-other' not defined")
+	err = validate(ctx, fmt.Sprintf(workflowWithDAGOneOf, "build", "deploy-production", "default: true"))
+	require.ErrorContains(t, err, "templates.main.dag.oneOf.deploy.branches task 'deploy-production' is already a branch of a oneOf")
+	err = validate(ctx, fmt.Sprintf(workflowWithDAGOneOf, "build.Failed", "deploy-staging", "default: true"))
+	require.ErrorContains(t, err, "templates.main.dag.oneOf.deploy.branches.deploy-staging must have the same dependencies as deploy-production")
+	err = validate(ctx, strings.Replace(fmt.Sprintf(workflowWithDAGOneOf, "build", "deploy-staging", "default: true"), "depends: build\n", "depends: build\n        when: 'true'\n", 1))
+	require.ErrorContains(t, err, "templates.main.dag.oneOf.deploy.branches.deploy-production cannot have a 'when' condition")
+}
+
+var workflowWithListeningTask = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: listening-task-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: train
+        template: train
+      - name: wait-for-checkpoint
+        template: %s
+        when: "'{{tasks.%s.intermediateParameters.stage}}' == checkpointed"
+        %s
+  - name: train
+    container:
+      image: alpine
+  - name: wait
+    suspend: {}
+`
+
+func TestListeningTask(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	require.NoError(t, validate(ctx, fmt.Sprintf(workflowWithListeningTask, "wait", "train", "")))
+	err := validate(ctx, fmt.Sprintf(workflowWithListeningTask, "train", "train", ""))
+	require.ErrorContains(t, err, "failed to resolve {{tasks.train.intermediateParameters.stage}}")
+	err = validate(ctx, fmt.Sprintf(workflowWithListeningTask, "wait", "other", ""))
+	require.ErrorContains(t, err, "failed to resolve {{tasks.other.intermediateParameters.stage}}")
+	err = validate(ctx, fmt.Sprintf(workflowWithListeningTask, "wait", "train", "withItems: [a, b]"))
+	require.ErrorContains(t, err, "templates.main.tasks.wait-for-checkpoint cannot expand withItems, withParam or withSequence when its 'when' refers to intermediate parameters")
+}
+
+var workflowWithSecretParameters = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: secret-parameters-
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: password
+      valueFrom:
+        secretKeyRef:
+          name: my-secret
+          key: password
+  templates:
+  - name: main
+    inputs:
+      parameters:
+      - name: token
+        valueFrom:
+          secretKeyRef:
+            name: my-secret
+            key: token
+    container:
+      image: alpine
+      args: ['{{inputs.parameters.token}}', '{{workflow.parameters.password}}']
+`
+
+func TestSecretParameters(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	require.NoError(t, validate(ctx, workflowWithSecretParameters))
+}
+
+var workflowWithPluginParameters = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: plugin-parameters-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    inputs:
+      parameters:
+      - name: token
+        valueFrom:
+          plugin:
+            vault:
+              path: secret/data/token
+    http:
+      url: https://example.com
+      headers:
+      - name: Authorization
+        value: 'Bearer {{inputs.parameters.token}}'
+`
+
+func TestPluginParameters(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	require.NoError(t, validate(ctx, workflowWithPluginParameters))
+	err := validate(ctx, strings.Replace(workflowWithPluginParameters, `    http:
+      url: https://example.com
+      headers:
+      - name: Authorization
+        value: 'Bearer {{inputs.parameters.token}}'`, `    container:
+      image: alpine
+      args: ['{{inputs.parameters.token}}']`, 1))
+	require.EqualError(t, err, "templates.main.inputs.parameters.token.valueFrom.plugin is only valid in http and plugin templates")
+	err = validate(ctx, strings.Replace(workflowWithPluginParameters, "  templates:\n", `  arguments:
+    parameters:
+    - name: token
+      valueFrom:
+        plugin:
+          vault:
+            path: secret/data/token
+  templates:
+`, 1))
+	require.EqualError(t, err, "spec.arguments.token.valueFrom only allows: default, configMapKeyRef, secretKeyRef and supplied")
+}
+
+var workflowWithImages = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: images-
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: image
+      value: alpine
+  templates:
+  - name: main
+    steps:
+    - - name: build
+        template: build
+      - name: test
+        template: test
+        arguments:
+          parameters:
+          - name: image
+            value: '{{workflow.parameters.image}}'
+  - name: build
+    container:
+      image: ghcr.io/my-org/builder:v1
+    sidecars:
+    - name: cache
+      image: redis
+  - name: test
+    inputs:
+      parameters:
+      - name: image
+    container:
+      image: '{{inputs.parameters.image}}'
+`
+
+func TestImagePolicy(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := unmarshalWf(workflowWithImages)
+	err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{ImagePolicy: &config.ImagePolicy{Allowed: []string{"ghcr.io/my-org/*", "docker.io/library/redis:*"}}})
+	require.NoError(t, err, "inputs are not validated")
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{ImagePolicy: &config.ImagePolicy{Allowed: []string{"ghcr.io/my-org/*"}}})
+	require.EqualError(t, err, `templates.main.steps[0].build templates.build.sidecars[0].image: image "redis" is not allowed, it must match one of ["ghcr.io/my-org/*"]`)
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{ImagePolicy: &config.ImagePolicy{RequireDigest: true}})
+	require.EqualError(t, err, `templates.main.steps[0].build templates.build.container.image: image "ghcr.io/my-org/builder:v1" is not pinned to a digest`)
+	wf.Spec.Templates[2].Container.Image = "{{workflow.parameters.image}}"
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{ImagePolicy: &config.ImagePolicy{Allowed: []string{"ghcr.io/my-org/*", "docker.io/library/redis:*"}}})
+	require.EqualError(t, err, `templates.main.steps[0].test templates.test.container.image: image "alpine" is not allowed, it must match one of ["ghcr.io/my-org/*" "docker.io/library/redis:*"]`)
+}
+
+var workflowWithPrivilegedSidecar = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: dind-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: docker:20.10
+      command: [docker, version]
+    sidecars:
+    - name: dind
+      image: docker:20.10-dind
+      securityContext:
+        privileged: true
+`
+
+func TestPodSecurityStandard(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := unmarshalWf(workflowWithPrivilegedSidecar)
+	err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.NoError(t, err)
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{PodSecurityStandard: "restricted"})
+	require.EqualError(t, err, `spec.templates.main: container "dind": securityContext.privileged must not be true`)
+}
+
+func TestTemplatePodDisruptionBudget(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := func(steps, tmpl string) string {
+		return `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: pdb-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+` + steps + `
+    steps:
+    - - name: a
+        template: a
+  - name: a
+` + tmpl + `
+    container:
+      image: argoproj/argosay:v2
+`
+	}
+	require.NoError(t, validate(ctx, wf("", `
+    podDisruptionBudget:
+      maxUnavailable: 0
+      selector:
+        matchLabels:
+          app: critical`)))
+	require.ErrorContains(t, validate(ctx, wf(`
+    podDisruptionBudget:
+      maxUnavailable: 0`, "")), "templates.main.podDisruptionBudget is only valid for templates that run pods")
+	require.ErrorContains(t, validate(ctx, wf("", `
+    podDisruptionBudget:
+      minAvailable: 1
+      maxUnavailable: 0`)), "may not have both minAvailable and maxUnavailable")
+	require.ErrorContains(t, validate(ctx, wf("", `
+    podDisruptionBudget:
+      maxUnavailable: 0
+      selector:
+        matchLabels:
+          workflows.argoproj.io/workflow: other`)), "may not select by workflows.argoproj.io/workflow")
+}
+
+func TestTemplateScratch(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := func(steps, tmpl string) string {
+		return `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: scratch-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+` + steps + `
+    steps:
+    - - name: a
+        template: a
+  - name: a
+` + tmpl + `
+    container:
+      image: argoproj/argosay:v2
+      volumeMounts:
+      - name: data
+        mountPath: /data
+    volumes:
+    - name: data
+      emptyDir: {}
+`
+	}
+	require.NoError(t, validate(ctx, wf("", `
+    scratch:
+      size: 50Gi
+      storageClass: fast`)))
+	require.ErrorContains(t, validate(ctx, wf(`
+    scratch:
+      size: 1Gi`, "")), "templates.main.scratch is only valid for container, script and containerSet templates")
+	require.ErrorContains(t, validate(ctx, wf("", `
+    scratch:
+      size: "0"`)), "templates.a.scratch.size must be greater than zero")
+	require.ErrorContains(t, validate(ctx, wf("", `
+    scratch:
+      size: 1Gi
+      mountPath: scratch`)), "must be an absolute path")
+	require.ErrorContains(t, validate(ctx, wf("", `
+    scratch:
+      size: 1Gi
+      mountPath: /data/`)), "templates.a.scratch.mountPath '/data/' already mounted in volumeMounts.data")
+}
+
+func TestTemplateGPU(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := func(steps, tmpl string) string {
+		return `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: gpu-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+` + steps + `
+    steps:
+    - - name: a
+        template: a
+  - name: a
+` + tmpl + `
+    container:
+      image: argoproj/argosay:v2
+`
+	}
+	require.NoError(t, validate(ctx, wf("", `
+    gpu:
+      count: 1
+      type: a100`)))
+	require.ErrorContains(t, validate(ctx, wf(`
+    gpu:
+      count: 1`, "")), "templates.main.gpu is only valid for container and script templates")
+	require.ErrorContains(t, validate(ctx, wf("", `
+    gpu:
+      count: 0`)), "templates.a.gpu.count must be greater than zero")
+}
+
+func TestNotifications(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := func(notifications string) string {
+		return `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: notifications-
+spec:
+  entrypoint: main
+  notifications:
+` + notifications + `
+  templates:
+  - name: main
+    container:
+      image: argoproj/argosay:v2
+`
+	}
+	require.NoError(t, validate(ctx, wf(`
+  - channel: slack
+  - channel: on-call
+    events: [Failed, Error]`)))
+	require.EqualError(t, validate(ctx, wf(`
+  - events: [Failed]`)), "notifications[0].channel is required")
+	require.EqualError(t, validate(ctx, wf(`
+  - channel: slack
+    events: [Pending]`)), "notifications[0].events 'Pending' must be one of Running, Succeeded, Failed or Error")
+}
+
+func TestHTTPTemplateAuth(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := func(auth string) string {
+		return `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: http-auth-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    http:
+      url: https://example.com
+      auth:
+` + auth
+	}
+	require.NoError(t, validate(ctx, wf(`
+        oauth2:
+          clientIDSecret: {name: my-secret, key: client-id}
+          clientSecretSecret: {name: my-secret, key: client-secret}
+          tokenURLSecret: {name: my-secret, key: token-url}`)))
+	require.NoError(t, validate(ctx, wf(`
+        awsSigV4: {region: us-east-1, service: execute-api}`)))
+	require.EqualError(t, validate(ctx, wf(`
+        oauth2:
+          clientIDSecret: {name: my-secret, key: client-id}`)), "templates.main.http.auth oauth2 requires clientIDSecret, clientSecretSecret and tokenURLSecret")
+	require.EqualError(t, validate(ctx, wf(`
+        awsSigV4:
+          region: us-east-1
+          service: execute-api
+          accessKeySecret: {name: my-secret, key: access-key}`)), "templates.main.http.auth awsSigV4 requires both accessKeySecret and secretKeySecret, or neither")
+}